@@ -27,6 +27,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 const (
@@ -82,6 +83,35 @@ func GetConfigs() map[string]*ClientConfig {
 	return confs
 }
 
+// GetConfigProfiles - Returns the available configs by profile name, the name of a
+// profile is its file name without the extension (e.g. "engagement-a" for engagement-a.cfg)
+func GetConfigProfiles() map[string]*ClientConfig {
+	configDir := GetConfigDir()
+	configFiles, err := os.ReadDir(configDir)
+	if err != nil {
+		return map[string]*ClientConfig{}
+	}
+	profiles := map[string]*ClientConfig{}
+	for _, confFile := range configFiles {
+		conf, err := ReadConfig(filepath.Join(configDir, confFile.Name()))
+		if err != nil {
+			continue
+		}
+		profiles[strings.TrimSuffix(confFile.Name(), filepath.Ext(confFile.Name()))] = conf
+	}
+	return profiles
+}
+
+// GetConfigProfileName - The profile name of a config, blank if it isn't in the configs dir
+func GetConfigProfileName(config *ClientConfig) string {
+	for name, profile := range GetConfigProfiles() {
+		if profile.Certificate == config.Certificate && profile.LHost == config.LHost && profile.LPort == config.LPort {
+			return name
+		}
+	}
+	return ""
+}
+
 // ReadConfig - Load config into struct
 func ReadConfig(confFilePath string) (*ClientConfig, error) {
 	confFile, err := os.Open(confFilePath)
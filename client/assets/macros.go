@@ -0,0 +1,62 @@
+package assets
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+const (
+	macrosFileName = "macros.json"
+)
+
+// Macro - A named sequence of commands, $1..$N are replaced with the macro's
+// arguments and $@ with all of them
+type Macro struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Commands    []string `json:"commands"`
+}
+
+// LoadMacros - Load the operator's macros from disk, keyed by name
+func LoadMacros() (map[string]*Macro, error) {
+	rootDir, _ := filepath.Abs(GetRootAppDir())
+	macros := map[string]*Macro{}
+	data, err := os.ReadFile(filepath.Join(rootDir, macrosFileName))
+	if os.IsNotExist(err) {
+		return macros, nil
+	}
+	if err != nil {
+		return macros, err
+	}
+	err = json.Unmarshal(data, &macros)
+	return macros, err
+}
+
+// SaveMacros - Save the operator's macros to disk
+func SaveMacros(macros map[string]*Macro) error {
+	rootDir, _ := filepath.Abs(GetRootAppDir())
+	data, err := json.MarshalIndent(macros, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(rootDir, macrosFileName), data, 0o600)
+}
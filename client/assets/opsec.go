@@ -0,0 +1,61 @@
+package assets
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/util/opsec"
+)
+
+const (
+	opsecPolicyFileName = "opsec.json"
+)
+
+// LoadOpsecRules - The operator's own opsec rules, they are checked in addition to the
+// server's policy so they can only make it stricter. A missing file has no rules.
+func LoadOpsecRules() ([]*clientpb.OpsecRule, error) {
+	rootDir, _ := filepath.Abs(GetRootAppDir())
+	data, err := os.ReadFile(filepath.Join(rootDir, opsecPolicyFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return []*clientpb.OpsecRule{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	policy := &clientpb.OpsecPolicy{}
+	err = json.Unmarshal(data, policy)
+	if err != nil {
+		return nil, err
+	}
+	err = opsec.Validate(policy)
+	if err != nil {
+		return nil, err
+	}
+	// Only the server's rules can grant overrides
+	for _, rule := range policy.Rules {
+		rule.Overrides = nil
+	}
+	return policy.Rules, nil
+}
@@ -38,6 +38,7 @@ type ClientSettings struct {
 	VimMode           bool   `json:"vim_mode"`
 	UserConnect       bool   `json:"user_connect"`
 	ConsoleLogs       bool   `json:"console_logs"`
+	AutoLootSize      int64  `json:"auto_loot_size"`
 }
 
 // LoadSettings - Load the client settings from disk
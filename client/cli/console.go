@@ -56,8 +56,9 @@ func consoleRunnerCmd(con *console.SliverConsoleClient, run bool) (pre, post fun
 			fmt.Printf("Connection to server failed %s", err)
 			return nil
 		}
+		con.SetConnectionProfile(assets.GetConfigProfileName(config), config, ln)
 
-		return console.StartClient(con, rpc, command.ServerCommands(con, nil), command.SliverCommands(con), run)
+		return console.StartClient(con, rpc, command.ServerCommandsFactory(nil), command.SliverCommands, run)
 	}
 
 	// Close the RPC connection once exiting
@@ -26,10 +26,22 @@ func implantCmd(con *console.SliverConsoleClient) *cobra.Command {
 	cmd.Flags().AddFlagSet(implantFlags)
 
 	// Prerunners (console setup, connection, etc)
-	cmd.PersistentPreRunE, cmd.PersistentPostRunE = makeRunners(cmd, con)
+	pre, post := makeRunners(cmd, con)
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := pre(cmd, args); err != nil {
+			return err
+		}
+		if err := con.CheckOpsecPolicy(cmd, args); err != nil {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return err
+		}
+		return nil
+	}
+	cmd.PersistentPostRunE = post
 
-	// Completions
-	makeCompleters(cmd, con)
+	// Completions, which only need the console setup
+	makeCompleters(cmd, con, pre)
 
 	return cmd
 }
@@ -39,8 +51,9 @@ func makeRunners(implantCmd *cobra.Command, con *console.SliverConsoleClient) (p
 
 	// The pre-run function connects to the server and sets up a "fake" console,
 	// so we can have access to active sessions/beacons, and other stuff needed.
-	pre = func(_ *cobra.Command, args []string) error {
+	pre = func(cmd *cobra.Command, args []string) error {
 		startConsole(implantCmd, args)
+		con.SetJSONOutput(cmd)
 
 		// Set the active target.
 		target, _ := implantCmd.Flags().GetString("use")
@@ -59,17 +72,17 @@ func makeRunners(implantCmd *cobra.Command, con *console.SliverConsoleClient) (p
 	return pre, closeConsole
 }
 
-func makeCompleters(cmd *cobra.Command, con *console.SliverConsoleClient) {
+func makeCompleters(cmd *cobra.Command, con *console.SliverConsoleClient, pre func(cmd *cobra.Command, args []string) error) {
 	comps := carapace.Gen(cmd)
 
 	comps.PreRun(func(cmd *cobra.Command, args []string) {
-		cmd.PersistentPreRunE(cmd, args)
+		pre(cmd, args)
 	})
 
 	// Bind completers to flags (wrap them to use the same pre-runners)
 	command.FlagComps(cmd, func(comp *carapace.ActionMap) {
 		(*comp)["use"] = carapace.ActionCallback(func(c carapace.Context) carapace.Action {
-			cmd.PersistentPreRunE(cmd, c.Args)
+			pre(cmd, c.Args)
 			return use.SessionIDCompleter(con)
 		})
 	})
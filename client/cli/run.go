@@ -0,0 +1,298 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/transport"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// Exit codes of the run command
+const (
+	// ExitSuccess - Every command completed without printing an error
+	ExitSuccess = 0
+	// ExitCommandError - A command printed an error
+	ExitCommandError = 1
+	// ExitUsageError - Unknown command, invalid flags, or no commands to run
+	ExitUsageError = 2
+	// ExitConnectionError - Could not connect to the server
+	ExitConnectionError = 3
+	// ExitTargetNotFound - The session or beacon does not exist
+	ExitTargetNotFound = 4
+	// ExitTimeout - Beacon tasks did not complete before the timeout
+	ExitTimeout = 5
+)
+
+var errBeaconTimeout = errors.New("timeout waiting for beacon task results")
+
+// exitError - An error with the exit code it should result in
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func runCmd(con *console.SliverConsoleClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run commands non-interactively",
+		Long: `Run a sequence of commands without starting the console, e.g.
+
+	sliver-client run --session WISE_OTTER -c 'ls; download /etc/shadow'
+	sliver-client run -c 'sessions --json'
+	sliver-client run --session WISE_OTTER --file commands.txt
+
+Commands are separated by ';' or new lines, lines starting with '#' are ignored. Without a
+session/beacon commands run in the server menu. Command output is written to stdout, all other
+messages (info, warnings, errors, events) are written to stderr.
+
+Beacon tasks are waited on before the next command runs, the exit code is the first of:
+  0 success, 1 a command printed an error, 2 usage error, 3 connection error,
+  4 session/beacon not found, 5 timeout waiting for beacon results.`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := runCommands(cmd, con)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s\n", err)
+				code := ExitCommandError
+				var exitErr *exitError
+				if errors.As(err, &exitErr) {
+					code = exitErr.code
+				}
+				os.Exit(code)
+			}
+			if 0 < con.ErrorCount() {
+				os.Exit(ExitCommandError)
+			}
+		},
+	}
+	cmd.Flags().StringP("session", "s", "", "session or beacon (name or id) to run the commands on")
+	cmd.Flags().StringArrayP("command", "c", []string{}, "commands to run, separated by ';'")
+	cmd.Flags().StringP("file", "f", "", "read commands from a file ('-' for stdin)")
+	cmd.Flags().StringP("config", "C", "", "operator config file (default: the only config in the configs directory)")
+	cmd.Flags().BoolP("keep-going", "k", false, "keep running commands after an error")
+	cmd.Flags().DurationP("beacon-timeout", "T", 10*time.Minute, "maximum time to wait for each command's beacon task results")
+	return cmd
+}
+
+func runCommands(cmd *cobra.Command, con *console.SliverConsoleClient) error {
+	lines, err := readCommands(cmd)
+	if err != nil {
+		return &exitError{ExitUsageError, err}
+	}
+	if len(lines) == 0 {
+		return &exitError{ExitUsageError, errors.New("no commands to run (see --command/--file)")}
+	}
+
+	err = connect(cmd, con)
+	if err != nil {
+		return &exitError{ExitConnectionError, err}
+	}
+	con.SetBatchMode()
+	con.Settings.BeaconAutoResults = true
+
+	if target, _ := cmd.Flags().GetString("session"); target != "" {
+		session, beacon := findTarget(con, target)
+		if session == nil && beacon == nil {
+			return &exitError{ExitTargetNotFound, fmt.Errorf("no session or beacon '%s'", target)}
+		}
+		con.ActiveTarget.Set(session, beacon)
+	}
+
+	keepGoing, _ := cmd.Flags().GetBool("keep-going")
+	beaconTimeout, _ := cmd.Flags().GetDuration("beacon-timeout")
+	for _, line := range lines {
+		args, err := shellquote.Split(line)
+		if err != nil {
+			return &exitError{ExitUsageError, fmt.Errorf("%s: %s", line, err)}
+		}
+		errorCount := con.ErrorCount()
+		err = executeCommand(con, args)
+		if err != nil && !errors.Is(err, console.ErrOpsecRefused) {
+			return &exitError{ExitUsageError, fmt.Errorf("%s: %s", line, err)}
+		}
+		err = waitForBeaconTasks(con, beaconTimeout)
+		if err != nil {
+			return &exitError{ExitTimeout, fmt.Errorf("%s: %s", line, err)}
+		}
+		if errorCount < con.ErrorCount() && !keepGoing {
+			return &exitError{ExitCommandError, fmt.Errorf("%s: failed", line)}
+		}
+	}
+	return nil
+}
+
+// readCommands - Commands from the --command flags and/or --file
+func readCommands(cmd *cobra.Command) ([]string, error) {
+	commands, _ := cmd.Flags().GetStringArray("command")
+	script := strings.Join(commands, "\n")
+	if filePath, _ := cmd.Flags().GetString("file"); filePath != "" {
+		var data []byte
+		var err error
+		if filePath == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(filePath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		script += "\n" + string(data)
+	}
+	return splitCommands(script), nil
+}
+
+// splitCommands - Split a script on new lines and unquoted semicolons
+func splitCommands(script string) []string {
+	commands := []string{}
+	current := &strings.Builder{}
+	var quote rune
+	escaped := false
+	flush := func() {
+		line := strings.TrimSpace(current.String())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			commands = append(commands, line)
+		}
+		current.Reset()
+	}
+	for _, char := range script {
+		switch {
+		case escaped:
+			escaped = false
+		case char == '\\' && quote != '\'':
+			escaped = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '\'' || char == '"':
+			quote = char
+		case char == ';' || char == '\n':
+			flush()
+			continue
+		}
+		current.WriteRune(char)
+	}
+	flush()
+	return commands
+}
+
+// connect - Connect to the server with the selected config, unlike the console
+// this never prompts for a config
+func connect(cmd *cobra.Command, con *console.SliverConsoleClient) error {
+	appDir := assets.GetRootAppDir()
+	logFile := initLogging(appDir)
+	defer logFile.Close()
+
+	var config *assets.ClientConfig
+	if configPath, _ := cmd.Flags().GetString("config"); configPath != "" {
+		var err error
+		config, err = assets.ReadConfig(configPath)
+		if err != nil {
+			return err
+		}
+	} else {
+		configs := assets.GetConfigs()
+		if len(configs) != 1 {
+			return fmt.Errorf("found %d configs in %s, select one with --config", len(configs), assets.GetConfigDir())
+		}
+		for _, conf := range configs {
+			config = conf
+		}
+	}
+
+	rpc, ln, err := transport.MTLSConnect(config)
+	if err != nil {
+		return fmt.Errorf("connection to server failed %s", err)
+	}
+	cobra.OnFinalize(func() { ln.Close() })
+	con.SetConnectionProfile(assets.GetConfigProfileName(config), config, ln)
+	return console.StartClient(con, rpc, command.ServerCommandsFactory(nil), command.SliverCommands, false)
+}
+
+func findTarget(con *console.SliverConsoleClient, target string) (*clientpb.Session, *clientpb.Beacon) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	sessions, err := con.Rpc.GetSessions(ctx, &commonpb.Empty{})
+	if err == nil {
+		for _, session := range sessions.Sessions {
+			if session.ID == target || session.Name == target || strings.HasPrefix(session.ID, target) {
+				return session, nil
+			}
+		}
+	}
+	beacons, err := con.Rpc.GetBeacons(ctx, &commonpb.Empty{})
+	if err == nil {
+		for _, beacon := range beacons.Beacons {
+			if beacon.ID == target || beacon.Name == target || strings.HasPrefix(beacon.ID, target) {
+				return nil, beacon
+			}
+		}
+	}
+	return nil, nil
+}
+
+// executeCommand - Run a single command with a fresh command tree, so flags
+// don't carry over from the previous command
+func executeCommand(con *console.SliverConsoleClient, args []string) error {
+	var root *cobra.Command
+	session, beacon := con.ActiveTarget.Get()
+	if session != nil || beacon != nil {
+		root = command.SliverCommands(con)()
+	} else {
+		root = command.ServerCommands(con, nil)()
+	}
+	root.SetArgs(args)
+	root.SetOut(os.Stdout)
+	root.SetErr(os.Stderr)
+	root.SilenceUsage = true
+	root.SilenceErrors = true
+	return root.Execute()
+}
+
+// waitForBeaconTasks - Wait for the results of the beacon tasks issued by the last command
+func waitForBeaconTasks(con *console.SliverConsoleClient, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for 0 < con.PendingBeaconTasks() {
+		if time.Now().After(deadline) {
+			return errBeaconTimeout
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return nil
+}
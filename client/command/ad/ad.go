@@ -0,0 +1,225 @@
+package ad
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// Queries - The subcommands of ad and what each lists
+var Queries = []struct {
+	Query string
+	Short string
+}{
+	{sliverpb.ADQueryUsers, "List the domain's users"},
+	{sliverpb.ADQueryGroups, "List the domain's groups"},
+	{sliverpb.ADQuerySPNs, "List enabled users with service principal names (kerberoastable)"},
+	{sliverpb.ADQueryTrusts, "List the domain's trusts"},
+	{sliverpb.ADQueryGPOs, "List the domain's group policy objects"},
+	{sliverpb.ADQueryDelegation, "List accounts trusted for unconstrained delegation"},
+}
+
+// column - A column of a query's table, format gets the values of the attribute
+type column struct {
+	header    string
+	attribute string
+	format    func(values []string) string
+}
+
+var columns = map[string][]column{
+	sliverpb.ADQueryUsers: {
+		{"Display Name", "displayName", first},
+		{"Flags", "userAccountControl", flags},
+		{"Admin", "adminCount", admin},
+		{"Password Set", "pwdLastSet", first},
+		{"Last Logon", "lastLogonTimestamp", first},
+		{"Groups", "memberOf", count},
+	},
+	sliverpb.ADQueryGroups: {
+		{"Type", "groupType", joined},
+		{"Admin", "adminCount", admin},
+		{"Members", "member", count},
+		{"Description", "description", first},
+	},
+	sliverpb.ADQuerySPNs: {
+		{"SPNs", "servicePrincipalName", lines},
+		{"Encryption", "msDS-SupportedEncryptionTypes", joined},
+		{"Admin", "adminCount", admin},
+		{"Password Set", "pwdLastSet", first},
+	},
+	sliverpb.ADQueryTrusts: {
+		{"Flat Name", "flatName", first},
+		{"Direction", "trustDirection", first},
+		{"Type", "trustType", first},
+		{"Attributes", "trustAttributes", joined},
+	},
+	sliverpb.ADQueryGPOs: {
+		{"GUID", "cn", first},
+		{"Path", "gPCFileSysPath", first},
+		{"Changed", "whenChanged", first},
+	},
+	sliverpb.ADQueryDelegation: {
+		{"Category", "objectCategory", category},
+		{"DNS Hostname", "dNSHostName", first},
+		{"Operating System", "operatingSystem", first},
+	},
+}
+
+// ADCmd - Run one of the AD queries on the remote system
+func ADCmd(cmd *cobra.Command, con *console.SliverConsoleClient, query string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	req := &sliverpb.ADEnumReq{Request: con.ActiveTarget.Request(cmd), Query: query}
+	req.Domain, _ = cmd.Flags().GetString("domain")
+	req.Server, _ = cmd.Flags().GetString("server")
+	req.Username, _ = cmd.Flags().GetString("username")
+	req.Password, _ = cmd.Flags().GetString("password")
+	req.LDAPS, _ = cmd.Flags().GetBool("ldaps")
+	req.InsecureSkipVerify, _ = cmd.Flags().GetBool("insecure-skip-verify")
+	req.Limit, _ = cmd.Flags().GetUint32("limit")
+	req.Timeout, _ = cmd.Flags().GetInt32("query-timeout")
+	if req.Username != "" && req.Password == "" {
+		con.PrintErrorf("A --password is required with --username\n")
+		return
+	}
+
+	adEnum, err := con.Rpc.ADEnum(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if adEnum.Response != nil && adEnum.Response.Async {
+		con.AddBeaconCallback(adEnum.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, adEnum)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintADEnum(adEnum, con)
+		})
+		con.PrintAsyncResponse(adEnum.Response)
+	} else {
+		PrintADEnum(adEnum, con)
+	}
+}
+
+// PrintADEnum - Print the objects returned by an AD query, with the columns of the query
+func PrintADEnum(adEnum *sliverpb.ADEnum, con *console.SliverConsoleClient) {
+	if adEnum.Response != nil && adEnum.Response.Err != "" {
+		con.PrintErrorf("%s\n", adEnum.Response.Err)
+		return
+	}
+	if len(adEnum.Objects) == 0 {
+		con.PrintInfof("No %s found in %s\n", adEnum.Query, adEnum.Domain)
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	header := table.Row{"Name"}
+	for _, column := range columns[adEnum.Query] {
+		header = append(header, column.header)
+	}
+	tw.AppendHeader(header)
+	for _, object := range adEnum.Objects {
+		row := table.Row{object.Name}
+		for _, column := range columns[adEnum.Query] {
+			row = append(row, column.format(attributeValues(object, column.attribute)))
+		}
+		tw.AppendRow(row)
+	}
+	con.Printf("%s\n", tw.Render())
+	if adEnum.Truncated {
+		con.PrintWarnf("More %s matched than the limit, the results were truncated\n", adEnum.Query)
+	}
+	server := ""
+	if adEnum.Server != "" {
+		server = fmt.Sprintf(" (%s)", adEnum.Server)
+	}
+	con.PrintInfof("%d %s of %s%s, saved as loot\n", len(adEnum.Objects), adEnum.Query, adEnum.Domain, server)
+}
+
+func attributeValues(object *sliverpb.ADObject, name string) []string {
+	for _, attribute := range object.Attributes {
+		if strings.EqualFold(attribute.Name, name) {
+			return attribute.Values
+		}
+	}
+	return nil
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func joined(values []string) string {
+	return strings.Join(values, ", ")
+}
+
+func lines(values []string) string {
+	return strings.Join(values, "\n")
+}
+
+func count(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", len(values))
+}
+
+// admin - Objects that are or were members of a protected group have an adminCount of 1
+func admin(values []string) string {
+	if first(values) == "1" {
+		return console.Bold + console.Red + "yes" + console.Normal
+	}
+	return ""
+}
+
+// flags - The notable userAccountControl flags, NORMAL_ACCOUNT is left out
+func flags(values []string) string {
+	notable := []string{}
+	for _, value := range values {
+		if value != "NORMAL_ACCOUNT" {
+			notable = append(notable, value)
+		}
+	}
+	return strings.Join(notable, ", ")
+}
+
+// category - The class name of an objectCategory dn, e.g. Computer of CN=Computer,CN=Schema,...
+func category(values []string) string {
+	rdn, _, _ := strings.Cut(first(values), ",")
+	_, name, _ := strings.Cut(rdn, "=")
+	return name
+}
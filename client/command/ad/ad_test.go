@@ -0,0 +1,43 @@
+package ad
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestColumns(t *testing.T) {
+	for _, query := range Queries {
+		if _, ok := columns[query.Query]; !ok {
+			t.Errorf("No columns for query %s", query.Query)
+		}
+	}
+	if name := category([]string{"CN=Computer,CN=Schema,CN=Configuration,DC=corp,DC=local"}); name != "Computer" {
+		t.Errorf("Unexpected category %s", name)
+	}
+	if notable := flags([]string{"NORMAL_ACCOUNT", "DONT_REQ_PREAUTH"}); notable != "DONT_REQ_PREAUTH" {
+		t.Errorf("Unexpected flags %s", notable)
+	}
+	object := &sliverpb.ADObject{Attributes: []*sliverpb.ADAttribute{{Name: "memberOf", Values: []string{"CN=A", "CN=B"}}}}
+	if members := count(attributeValues(object, "memberof")); members != "2" {
+		t.Errorf("Unexpected count %s", members)
+	}
+}
@@ -29,11 +29,13 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	app "github.com/reeflective/console"
+	"github.com/rsteube/carapace"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/extensions"
 	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
 	consts "github.com/bishopfox/sliver/client/constants"
@@ -93,6 +95,11 @@ type AliasManifest struct {
 	IsReflective bool         `json:"is_reflective"`
 	IsAssembly   bool         `json:"is_assembly"`
 
+	Arguments []*extensions.Argument `json:"arguments"`
+
+	Dependencies     []string `json:"dependencies"`
+	MinSliverVersion string   `json:"min_sliver_version"`
+
 	RootPath string `json:"-"`
 }
 
@@ -158,6 +165,9 @@ func LoadAlias(manifestPath string, cmd *cobra.Command, con *console.SliverConso
 	// for each alias command, add a new app command
 	helpMsg := fmt.Sprintf("[%s] %s", aliasManifest.Name, aliasManifest.Help)
 	longHelpMsg := help.FormatHelpTmpl(aliasManifest.LongHelp)
+	if argsHelp := extensions.ArgumentsHelp(aliasManifest.Arguments); argsHelp != "" {
+		longHelpMsg += "\n\n" + argsHelp
+	}
 	longHelpMsg += "\n\n⚠️  If you're having issues passing arguments to the alias please read:\n"
 	longHelpMsg += "https://github.com/BishopFox/sliver/wiki/Aliases-&-Extensions#aliases-command-parsing"
 	addAliasCmd := &cobra.Command{
@@ -192,6 +202,12 @@ func LoadAlias(manifestPath string, cmd *cobra.Command, con *console.SliverConso
 	f.BoolP("save", "s", false, "Save output to disk")
 	f.IntP("timeout", "t", defaultTimeout, "command timeout in seconds")
 	addAliasCmd.Flags().AddFlagSet(f)
+	extensions.AddArgumentFlags(addAliasCmd, aliasManifest.Arguments)
+
+	if 0 < len(aliasManifest.Arguments) {
+		comps := carapace.Gen(addAliasCmd)
+		extensions.ArgumentCompletions(addAliasCmd, comps, aliasManifest.Arguments)
+	}
 
 	cmd.AddCommand(addAliasCmd)
 
@@ -237,6 +253,10 @@ func ParseAliasManifest(data []byte) (*AliasManifest, error) {
 			return nil, fmt.Errorf("missing command.files.path in alias manifest")
 		}
 	}
+	err = extensions.ValidateArguments(alias.Arguments)
+	if err != nil {
+		return nil, err
+	}
 
 	return alias, nil
 }
@@ -269,7 +289,14 @@ func runAliasCommand(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	}
 	// args := ctx.Args.StringList("arguments")
 	var extArgs string
-	if len(aliasManifest.DefaultArgs) != 0 && len(args) == 0 {
+	if 0 < len(aliasManifest.Arguments) {
+		values, extra, err := extensions.ParseArguments(cmd, aliasManifest.Arguments, args)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		extArgs = extensions.FormatArguments(values, extra)
+	} else if len(aliasManifest.DefaultArgs) != 0 && len(args) == 0 {
 		extArgs = aliasManifest.DefaultArgs
 	} else {
 		extArgs = strings.Join(args, " ")
@@ -55,6 +55,8 @@ type ArmoryPackage struct {
 	PublicKey   string `json:"public_key"`
 
 	IsAlias bool `json:"-"`
+	// Version - The version to fetch, the latest version if blank
+	Version string `json:"-"`
 }
 
 // ArmoryBundle - A list of packages
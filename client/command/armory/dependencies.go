@@ -0,0 +1,203 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/alias"
+	"github.com/bishopfox/sliver/client/command/extensions"
+	"github.com/bishopfox/sliver/client/version"
+)
+
+const maxDepDepth = 10 // Arbitrary recursive limit for dependencies
+
+// packageManifest - The fields shared by alias and extension manifests that are
+// needed to resolve dependencies and compare versions
+type packageManifest struct {
+	Name             string   `json:"name"`
+	CommandName      string   `json:"command_name"`
+	Version          string   `json:"version"`
+	DependsOn        string   `json:"depends_on"`
+	Dependencies     []string `json:"dependencies"`
+	MinSliverVersion string   `json:"min_sliver_version"`
+
+	IsAlias  bool   `json:"-"`
+	RootPath string `json:"-"`
+}
+
+// packageDependency - A dependency on another package, optionally on a minimum version
+type packageDependency struct {
+	CommandName string
+	MinVersion  string
+}
+
+func (d *packageDependency) String() string {
+	if d.MinVersion == "" {
+		return d.CommandName
+	}
+	return fmt.Sprintf("%s>=%s", d.CommandName, d.MinVersion)
+}
+
+// Satisfied - Does the installed version satisfy the dependency
+func (d *packageDependency) Satisfied(installedVersion string) bool {
+	return d.MinVersion == "" || 0 <= compareVersions(installedVersion, d.MinVersion)
+}
+
+func parsePackageManifest(data []byte) (*packageManifest, error) {
+	manifest := &packageManifest{}
+	err := json.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.CommandName == "" {
+		return nil, fmt.Errorf("missing command_name in manifest")
+	}
+	return manifest, nil
+}
+
+// dependencies - The package's dependencies, depends_on (the extension that loads
+// this one) is always a dependency
+func (m *packageManifest) dependencies() ([]*packageDependency, error) {
+	deps := []*packageDependency{}
+	if m.DependsOn != "" && m.DependsOn != m.CommandName {
+		deps = append(deps, &packageDependency{CommandName: m.DependsOn})
+	}
+	for _, spec := range m.Dependencies {
+		dep, err := parseDependency(spec)
+		if err != nil {
+			return nil, err
+		}
+		if dep.CommandName == m.CommandName {
+			continue // Avoid infinite loop of something that depends on itself
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// parseDependency - Parse a "<command name>" or "<command name> >= <version>" dependency
+func parseDependency(spec string) (*packageDependency, error) {
+	name, minVersion, _ := strings.Cut(spec, ">=")
+	dep := &packageDependency{
+		CommandName: strings.TrimSpace(name),
+		MinVersion:  strings.TrimSpace(minVersion),
+	}
+	if dep.CommandName == "" || strings.ContainsAny(dep.CommandName, " <>=") {
+		return nil, fmt.Errorf("invalid dependency '%s'", spec)
+	}
+	if strings.Contains(spec, ">=") && dep.MinVersion == "" {
+		return nil, fmt.Errorf("invalid dependency '%s', missing version", spec)
+	}
+	return dep, nil
+}
+
+// checkSliverVersion - Returns an error if the package requires a newer client,
+// development builds (without a version) satisfy every requirement
+func checkSliverVersion(manifest *packageManifest) error {
+	if manifest.MinSliverVersion == "" || version.Version == "" {
+		return nil
+	}
+	if compareVersions(version.Version, manifest.MinSliverVersion) < 0 {
+		return fmt.Errorf("%s %s requires sliver %s or later (this is %s)",
+			manifest.CommandName, manifest.Version, manifest.MinSliverVersion, version.Version)
+	}
+	return nil
+}
+
+// compareVersions - Compare two dotted versions (an optional "v" prefix and pre-release/build
+// suffix are ignored), returns -1, 0, or 1. Non-numeric parts are compared as strings.
+func compareVersions(version1 string, version2 string) int {
+	parts1 := versionParts(version1)
+	parts2 := versionParts(version2)
+	for index := 0; index < len(parts1) || index < len(parts2); index++ {
+		part1, part2 := "0", "0"
+		if index < len(parts1) {
+			part1 = parts1[index]
+		}
+		if index < len(parts2) {
+			part2 = parts2[index]
+		}
+		number1, err1 := strconv.Atoi(part1)
+		number2, err2 := strconv.Atoi(part2)
+		switch {
+		case err1 == nil && err2 == nil && number1 != number2:
+			if number1 < number2 {
+				return -1
+			}
+			return 1
+		case (err1 != nil || err2 != nil) && part1 != part2:
+			if part1 < part2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(version string) []string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if index := strings.IndexAny(version, "-+"); index != -1 {
+		version = version[:index]
+	}
+	return strings.Split(version, ".")
+}
+
+// sameVersion - Versions are equal ignoring a "v" prefix, e.g. a release tag and manifest version
+func sameVersion(version1 string, version2 string) bool {
+	return strings.TrimPrefix(version1, "v") == strings.TrimPrefix(version2, "v")
+}
+
+// installedPackages - The manifests of the installed aliases and extensions by command name
+func installedPackages() map[string]*packageManifest {
+	installed := map[string]*packageManifest{}
+	readManifests := func(manifestPaths []string, isAlias bool) {
+		for _, manifestPath := range manifestPaths {
+			data, err := os.ReadFile(manifestPath)
+			if err != nil {
+				continue
+			}
+			manifest, err := parsePackageManifest(data)
+			if err != nil {
+				continue
+			}
+			manifest.IsAlias = isAlias
+			manifest.RootPath = filepath.Dir(manifestPath)
+			installed[manifest.CommandName] = manifest
+		}
+	}
+	readManifests(assets.GetInstalledExtensionManifests(), false)
+	readManifests(assets.GetInstalledAliasManifests(), true)
+	return installed
+}
+
+// manifestPath - Path to the manifest of an installed package
+func (m *packageManifest) manifestPath() string {
+	if m.IsAlias {
+		return filepath.Join(m.RootPath, alias.ManifestFileName)
+	}
+	return filepath.Join(m.RootPath, extensions.ManifestFileName)
+}
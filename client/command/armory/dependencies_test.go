@@ -0,0 +1,106 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		version1 string
+		version2 string
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"v1.0.0", "1.0.0", 0},
+		{"1.0", "1.0.0", 0},
+		{"1.0.1", "1.0.0", 1},
+		{"1.2.0", "1.10.0", -1},
+		{"v0.0.5", "v0.0.14", -1},
+		{"1.5.30-beta", "1.5.30", 0},
+		{"2.0.0", "1.99.99", 1},
+	}
+	for _, test := range tests {
+		result := compareVersions(test.version1, test.version2)
+		if result != test.expected {
+			t.Errorf("compareVersions(%s, %s) = %d, expected %d", test.version1, test.version2, result, test.expected)
+		}
+	}
+}
+
+func TestParseDependency(t *testing.T) {
+	dep, err := parseDependency("coff-loader")
+	if err != nil || dep.CommandName != "coff-loader" || dep.MinVersion != "" {
+		t.Fatalf("Unexpected dependency %v (%v)", dep, err)
+	}
+	dep, err = parseDependency("coff-loader >= v1.0.14")
+	if err != nil || dep.CommandName != "coff-loader" || dep.MinVersion != "v1.0.14" {
+		t.Fatalf("Unexpected dependency %v (%v)", dep, err)
+	}
+	if dep.Satisfied("1.0.13") {
+		t.Errorf("Expected 1.0.13 to not satisfy %s", dep)
+	}
+	if !dep.Satisfied("v1.1.0") {
+		t.Errorf("Expected v1.1.0 to satisfy %s", dep)
+	}
+	for _, invalid := range []string{"", ">=1.0.0", "coff-loader>=", "coff loader", "coff-loader<1.0.0"} {
+		if _, err := parseDependency(invalid); err == nil {
+			t.Errorf("Expected error for dependency '%s'", invalid)
+		}
+	}
+}
+
+func TestManifestDependencies(t *testing.T) {
+	manifest, err := parsePackageManifest([]byte(`{
+		"command_name": "nanodump",
+		"version": "0.0.5",
+		"depends_on": "coff-loader",
+		"dependencies": ["nanodump", "credman>=1.2.0"]
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to parse manifest: %s", err)
+	}
+	deps, err := manifest.dependencies()
+	if err != nil {
+		t.Fatalf("Failed to parse dependencies: %s", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("Expected 2 dependencies, got %d", len(deps))
+	}
+	if deps[0].String() != "coff-loader" || deps[1].String() != "credman>=1.2.0" {
+		t.Errorf("Unexpected dependencies %s, %s", deps[0], deps[1])
+	}
+}
+
+func TestGithubRelease(t *testing.T) {
+	releases := []GithubRelease{{TagName: "v1.1.0"}, {TagName: "v1.0.0"}}
+	release, err := githubRelease(releases, "")
+	if err != nil || release.TagName != "v1.1.0" {
+		t.Errorf("Expected latest release, got %v (%v)", release, err)
+	}
+	release, err = githubRelease(releases, "1.0.0")
+	if err != nil || release.TagName != "v1.0.0" {
+		t.Errorf("Expected v1.0.0 release, got %v (%v)", release, err)
+	}
+	_, err = githubRelease(releases, "0.9.0")
+	if err == nil {
+		t.Errorf("Expected error for missing version")
+	}
+}
@@ -0,0 +1,108 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const maxDiffValueLen = 60
+
+// manifestDiff - The changes between two manifests, one line per changed field:
+// "~ field: old -> new", "+ field: new", or "- field: old"
+func manifestDiff(oldManifest []byte, newManifest []byte) ([]string, error) {
+	var oldValue, newValue interface{}
+	err := json.Unmarshal(oldManifest, &oldValue)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(newManifest, &newValue)
+	if err != nil {
+		return nil, err
+	}
+	oldFields := map[string]string{}
+	flattenJSON("", oldValue, oldFields)
+	newFields := map[string]string{}
+	flattenJSON("", newValue, newFields)
+
+	keys := []string{}
+	for key := range oldFields {
+		keys = append(keys, key)
+	}
+	for key := range newFields {
+		if _, ok := oldFields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := []string{}
+	for _, key := range keys {
+		oldField, inOld := oldFields[key]
+		newField, inNew := newFields[key]
+		switch {
+		case inOld && inNew && oldField != newField:
+			changes = append(changes, fmt.Sprintf("~ %s: %s -> %s", key, truncateValue(oldField), truncateValue(newField)))
+		case inNew && !inOld:
+			changes = append(changes, fmt.Sprintf("+ %s: %s", key, truncateValue(newField)))
+		case inOld && !inNew:
+			changes = append(changes, fmt.Sprintf("- %s: %s", key, truncateValue(oldField)))
+		}
+	}
+	return changes, nil
+}
+
+// flattenJSON - Flatten a decoded JSON value into "a.b[0].c" -> value, empty
+// values are omitted so a missing and an empty field are the same
+func flattenJSON(prefix string, value interface{}, fields map[string]string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			flattenJSON(key, child, fields)
+		}
+	case []interface{}:
+		for index, child := range value {
+			flattenJSON(fmt.Sprintf("%s[%d]", prefix, index), child, fields)
+		}
+	case nil:
+	case string:
+		if value != "" {
+			fields[prefix] = value
+		}
+	default:
+		data, _ := json.Marshal(value)
+		if string(data) != "false" {
+			fields[prefix] = string(data)
+		}
+	}
+}
+
+func truncateValue(value string) string {
+	value = strings.ReplaceAll(value, "\n", " ")
+	if maxDiffValueLen < len(value) {
+		return value[:maxDiffValueLen-3] + "..."
+	}
+	return value
+}
@@ -0,0 +1,61 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestManifestDiff(t *testing.T) {
+	installed := []byte(`{
+		"command_name": "nanodump",
+		"version": "0.0.4",
+		"help": "dump lsass",
+		"is_assembly": false,
+		"files": [{"os": "windows", "arch": "amd64", "path": "nanodump.x64.o"}],
+		"arguments": [{"name": "pid", "type": "int"}]
+	}`)
+	available := []byte(`{
+		"command_name": "nanodump",
+		"version": "0.0.5",
+		"help": "dump lsass",
+		"files": [
+			{"os": "windows", "arch": "amd64", "path": "nanodump.x64.o"},
+			{"os": "windows", "arch": "386", "path": "nanodump.x86.o"}
+		],
+		"dependencies": ["coff-loader>=1.0.14"]
+	}`)
+	changes, err := manifestDiff(installed, available)
+	if err != nil {
+		t.Fatalf("Failed to diff manifests: %s", err)
+	}
+	expected := []string{
+		"- arguments[0].name: pid",
+		"- arguments[0].type: int",
+		"+ dependencies[0]: coff-loader>=1.0.14",
+		"+ files[1].arch: 386",
+		"+ files[1].os: windows",
+		"+ files[1].path: nanodump.x86.o",
+		"~ version: 0.0.4 -> 0.0.5",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected changes:\n%v\nexpected:\n%v", changes, expected)
+	}
+}
@@ -19,11 +19,12 @@ package armory
 */
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
-	"path/filepath"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -40,12 +41,16 @@ var ErrPackageNotFound = errors.New("package not found")
 
 // ArmoryInstallCmd - The armory install command
 func ArmoryInstallCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
-	name := args[0]
+	name, version := splitPackageVersion(args[0])
 	// name := ctx.Args.String("name")
 	if name == "" {
 		con.PrintErrorf("A package or bundle name is required")
 		return
 	}
+	if name == "all" && version != "" {
+		con.PrintErrorf("A version cannot be installed for all packages\n")
+		return
+	}
 	clientConfig := parseArmoryHTTPConfig(cmd)
 	refresh(clientConfig)
 	if name == "all" {
@@ -61,11 +66,11 @@ func ArmoryInstallCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 			return
 		}
 	}
-	err := installPackageByName(name, clientConfig, con)
+	err := installPackageByName(name, version, clientConfig, con)
 	if err == nil {
 		return
 	}
-	if err == ErrPackageNotFound {
+	if err == ErrPackageNotFound && version == "" {
 		bundles := bundlesInCache()
 		for _, bundle := range bundles {
 			if bundle.Name == name {
@@ -77,20 +82,26 @@ func ArmoryInstallCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	con.PrintErrorf("No package or bundle named '%s' was found", name)
 }
 
+// splitPackageVersion - Split a "<name>@<version>" argument
+func splitPackageVersion(arg string) (string, string) {
+	name, version, _ := strings.Cut(arg, "@")
+	return name, version
+}
+
 func installBundle(bundle *ArmoryBundle, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) {
 	for _, pkgName := range bundle.Packages {
-		err := installPackageByName(pkgName, clientConfig, con)
+		err := installPackageByName(pkgName, "", clientConfig, con)
 		if err != nil {
 			con.PrintErrorf("Failed to install '%s': %s", pkgName, err)
 		}
 	}
 }
 
-func installPackageByName(name string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) error {
+func installPackageByName(name string, version string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) error {
 	aliases, extensions := packagesInCache()
 	for _, alias := range aliases {
 		if alias.CommandName == name || name == "all" {
-			installAlias(alias, clientConfig, con)
+			installAlias(alias, version, clientConfig, con)
 			if name != "all" {
 				return nil
 			}
@@ -98,7 +109,7 @@ func installPackageByName(name string, clientConfig ArmoryHTTPConfig, con *conso
 	}
 	for _, ext := range extensions {
 		if ext.CommandName == name || name == "all" {
-			installExtension(ext, clientConfig, con)
+			installExtension(ext, version, clientConfig, con)
 			if name != "all" {
 				return nil
 			}
@@ -112,175 +123,172 @@ func installPackageByName(name string, clientConfig ArmoryHTTPConfig, con *conso
 	return ErrPackageNotFound
 }
 
-func installAlias(alias *alias.AliasManifest, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) {
-	err := installAliasPackageByName(alias.CommandName, clientConfig, con)
+func installAlias(alias *alias.AliasManifest, version string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) {
+	err := installPackage(alias.CommandName, version, clientConfig, con)
 	if err != nil {
-		con.PrintErrorf("Failed to install alias '%s': %s", alias.CommandName, err)
+		con.PrintErrorf("Failed to install alias '%s': %s\n", alias.CommandName, err)
 		return
 	}
 }
 
-func installAliasPackageByName(name string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) error {
-	var entry *pkgCacheEntry
-	pkgCache.Range(func(key, value interface{}) bool {
-		cacheEntry := value.(pkgCacheEntry)
-		if cacheEntry.Pkg.CommandName == name {
-			entry = &cacheEntry
-			return false
-		}
-		return true
-	})
-	if entry == nil {
-		return errors.New("package not found")
-	}
-	repoURL, err := url.Parse(entry.RepoURL)
+func installExtension(ext *extensions.ExtensionManifest, version string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) {
+	err := installPackage(ext.CommandName, version, clientConfig, con)
 	if err != nil {
-		return err
+		con.PrintErrorf("Failed to install extension '%s': %s\n", ext.CommandName, err)
+		return
 	}
+}
 
-	con.PrintInfof("Downloading alias ...")
+// installPackage - Install a version of a package (the latest if blank) and any of its
+// dependencies that are missing or older than required. Installing a specific version
+// pins the package to it.
+func installPackage(name string, version string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) error {
+	return installPackageWithDependencies(name, version, map[string]bool{}, clientConfig, con)
+}
 
-	var sig *minisign.Signature
-	var tarGz []byte
-	if pkgParser, ok := pkgParsers[repoURL.Hostname()]; ok {
-		sig, tarGz, err = pkgParser(entry.ArmoryConfig, &entry.Pkg, false, clientConfig)
-	} else {
-		sig, tarGz, err = DefaultArmoryPkgParser(entry.ArmoryConfig, &entry.Pkg, false, clientConfig)
+func installPackageWithDependencies(name string, version string, seen map[string]bool, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) error {
+	// We also need to look out for circular dependencies, so if we've already
+	// seen this package, we stop resolving
+	if seen[name] {
+		return nil
 	}
-	if err != nil {
-		return err
+	if maxDepDepth < len(seen) {
+		return errors.New("too many nested dependencies")
 	}
+	seen[name] = true
 
-	var publicKey minisign.PublicKey
-	publicKey.UnmarshalText([]byte(entry.Pkg.PublicKey))
-	rawSig, _ := sig.MarshalText()
-	valid := minisign.Verify(publicKey, tarGz, []byte(rawSig))
-	if !valid {
-		return errors.New("signature verification failed")
+	entry := pkgCacheEntryByName(name)
+	if entry == nil {
+		return ErrPackageNotFound
 	}
-
-	tmpFile, err := os.CreateTemp("", "sliver-armory-")
+	if entry.Pkg.IsAlias {
+		con.PrintInfof("Downloading alias ...")
+	} else {
+		con.PrintInfof("Downloading extension ...")
+	}
+	tarGz, manifest, err := downloadPackage(entry, version, clientConfig)
+	con.Printf(console.Clearln + "\r") // Clear download message
 	if err != nil {
 		return err
 	}
-	defer os.Remove(tmpFile.Name())
-	_, err = tmpFile.Write(tarGz)
+	err = checkSliverVersion(manifest)
 	if err != nil {
 		return err
 	}
-	tmpFile.Close()
-
-	con.Printf(console.Clearln + "\r") // Clear the line
-
-	installPath := alias.InstallFromFile(tmpFile.Name(), true, con)
-	if installPath == nil {
-		return errors.New("failed to install alias")
-	}
 
-	menuCmd := con.App.Menu(constants.ImplantMenu).Root()
-
-	_, err = alias.LoadAlias(filepath.Join(*installPath, alias.ManifestFileName), menuCmd, con)
+	deps, err := manifest.dependencies()
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-func installExtension(ext *extensions.ExtensionManifest, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) {
-	deps := make(map[string]struct{})
-	resolveExtensionPackageDependencies(ext.CommandName, deps, clientConfig, con)
-	sliverMenu := con.App.Menu(constants.ImplantMenu)
-	for dep := range deps {
-		if extensions.CmdExists(dep, sliverMenu.Command) {
+	installed := installedPackages()
+	for _, dep := range deps {
+		if installedDep, ok := installed[dep.CommandName]; ok && dep.Satisfied(installedDep.Version) {
 			continue // Dependency is already installed
 		}
-		err := installExtensionPackageByName(dep, clientConfig, con)
+		depEntry := pkgCacheEntryByName(dep.CommandName)
+		if depEntry == nil {
+			return fmt.Errorf("dependency '%s' not found in any armory", dep)
+		}
+		if available := depEntry.manifestVersion(); !dep.Satisfied(available) {
+			return fmt.Errorf("dependency '%s' is not available (the armory has %s)", dep, available)
+		}
+		if installedDep, ok := installed[dep.CommandName]; ok {
+			con.PrintInfof("Updating %s %s to satisfy %s\n", dep.CommandName, installedDep.Version, dep)
+		}
+		err = installPackageWithDependencies(dep.CommandName, "", seen, clientConfig, con)
 		if err != nil {
-			con.PrintErrorf("Failed to install extension dependency '%s': %s", dep, err)
-			return
+			return fmt.Errorf("failed to install dependency '%s': %s", dep.CommandName, err)
 		}
 	}
-	err := installExtensionPackageByName(ext.CommandName, clientConfig, con)
+
+	err = installTarGz(tarGz, manifest, installed[manifest.CommandName], con)
 	if err != nil {
-		con.PrintErrorf("Failed to install extension '%s': %s", ext.CommandName, err)
-		return
+		return err
 	}
+	if version != "" {
+		err = pinPackage(manifest.CommandName, manifest.Version)
+		if err != nil {
+			return err
+		}
+		con.PrintInfof("Pinned %s to %s, 'armory update' will not update it\n", manifest.CommandName, manifest.Version)
+	}
+	return nil
 }
 
-const maxDepDepth = 10 // Arbitrary recursive limit for dependencies
-
-func resolveExtensionPackageDependencies(name string, deps map[string]struct{}, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) {
+func pkgCacheEntryByName(name string) *pkgCacheEntry {
 	var entry *pkgCacheEntry
 	pkgCache.Range(func(key, value interface{}) bool {
 		cacheEntry := value.(pkgCacheEntry)
-		if cacheEntry.Pkg.CommandName == name {
+		if cacheEntry.Pkg.CommandName == name && cacheEntry.LastErr == nil {
 			entry = &cacheEntry
 			return false
 		}
 		return true
 	})
-	if entry == nil {
-		return
-	}
-
-	if entry.Extension.DependsOn == "" {
-		return // Avoid adding empty dependency
-	}
+	return entry
+}
 
-	if entry.Extension.DependsOn == name {
-		return // Avoid infinite loop of something that depends on itself
-	}
-	// We also need to look out for circular dependencies, so if we've already
-	// seen this dependency, we stop resolving
-	if _, ok := deps[entry.Extension.DependsOn]; ok {
-		return // Already resolved
+// manifestVersion - Version of the latest package in the cache
+func (e *pkgCacheEntry) manifestVersion() string {
+	if e.Pkg.IsAlias && e.Alias != nil {
+		return e.Alias.Version
 	}
-	if maxDepDepth < len(deps) {
-		return
+	if e.Extension != nil {
+		return e.Extension.Version
 	}
-	deps[entry.Extension.DependsOn] = struct{}{}
-	resolveExtensionPackageDependencies(entry.Extension.DependsOn, deps, clientConfig, con)
+	return ""
 }
 
-func installExtensionPackageByName(name string, clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) error {
-	var entry *pkgCacheEntry
-	pkgCache.Range(func(key, value interface{}) bool {
-		cacheEntry := value.(pkgCacheEntry)
-		if cacheEntry.Pkg.CommandName == name {
-			entry = &cacheEntry
-			return false
-		}
-		return true
-	})
-	if entry == nil {
-		return errors.New("package not found")
-	}
+// downloadPackage - Download and verify a package, the manifest is read from the
+// signature's trusted comment
+func downloadPackage(entry *pkgCacheEntry, version string, clientConfig ArmoryHTTPConfig) ([]byte, *packageManifest, error) {
 	repoURL, err := url.Parse(entry.RepoURL)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-
-	con.PrintInfof("Downloading extension ...")
+	armoryPkg := entry.Pkg
+	armoryPkg.Version = version
 
 	var sig *minisign.Signature
 	var tarGz []byte
 	if pkgParser, ok := pkgParsers[repoURL.Hostname()]; ok {
-		sig, tarGz, err = pkgParser(entry.ArmoryConfig, &entry.Pkg, false, clientConfig)
+		sig, tarGz, err = pkgParser(entry.ArmoryConfig, &armoryPkg, false, clientConfig)
 	} else {
-		sig, tarGz, err = DefaultArmoryPkgParser(entry.ArmoryConfig, &entry.Pkg, false, clientConfig)
+		sig, tarGz, err = DefaultArmoryPkgParser(entry.ArmoryConfig, &armoryPkg, false, clientConfig)
 	}
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	if sig == nil {
+		return nil, nil, errors.New("missing package signature")
 	}
 
 	var publicKey minisign.PublicKey
-	publicKey.UnmarshalText([]byte(entry.Pkg.PublicKey))
+	publicKey.UnmarshalText([]byte(armoryPkg.PublicKey))
 	rawSig, _ := sig.MarshalText()
 	valid := minisign.Verify(publicKey, tarGz, []byte(rawSig))
 	if !valid {
-		return errors.New("signature verification failed")
+		return nil, nil, errors.New("signature verification failed")
 	}
 
+	manifestData, err := base64.StdEncoding.DecodeString(sig.TrustedComment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to b64 decode trusted comment: %s", err)
+	}
+	manifest, err := parsePackageManifest(manifestData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse trusted manifest in pkg signature: %s", err)
+	}
+	manifest.IsAlias = armoryPkg.IsAlias
+	if version != "" && !sameVersion(manifest.Version, version) {
+		return nil, nil, fmt.Errorf("requested version %s but the armory returned %s", version, manifest.Version)
+	}
+	return tarGz, manifest, nil
+}
+
+// installTarGz - Install a downloaded package and load its command, the previously
+// installed version (if any) is kept so it can be rolled back to
+func installTarGz(tarGz []byte, manifest *packageManifest, previous *packageManifest, con *console.SliverConsoleClient) error {
 	tmpFile, err := os.CreateTemp("", "sliver-armory-")
 	if err != nil {
 		return err
@@ -294,18 +302,47 @@ func installExtensionPackageByName(name string, clientConfig ArmoryHTTPConfig, c
 	if err != nil {
 		return err
 	}
+	tmpFile.Close()
 
-	con.Printf(console.Clearln + "\r") // Clear download message
+	saved := false
+	if previous != nil && previous.IsAlias == manifest.IsAlias && previous.Version != manifest.Version {
+		err = saveRollback(previous)
+		if err != nil {
+			return fmt.Errorf("failed to save %s %s for rollback: %s", previous.CommandName, previous.Version, err)
+		}
+		saved = true
+	}
 
-	installPath := extensions.InstallFromFilePath(tmpFile.Name(), true, con)
+	var installPath *string
+	if manifest.IsAlias {
+		installPath = alias.InstallFromFile(tmpFile.Name(), true, con)
+	} else {
+		installPath = extensions.InstallFromFilePath(tmpFile.Name(), true, con)
+	}
 	if installPath == nil {
+		if saved {
+			restoreRollback(previous)
+		}
+		if manifest.IsAlias {
+			return errors.New("failed to install alias")
+		}
 		return errors.New("failed to install extension")
 	}
-	extCmd, err := extensions.LoadExtensionManifest(filepath.Join(*installPath, extensions.ManifestFileName))
+	manifest.RootPath = *installPath
+	return loadPackage(manifest, con)
+}
+
+// loadPackage - Load the command of an installed package
+func loadPackage(manifest *packageManifest, con *console.SliverConsoleClient) error {
+	if manifest.IsAlias {
+		menuCmd := con.App.Menu(constants.ImplantMenu).Root()
+		_, err := alias.LoadAlias(manifest.manifestPath(), menuCmd, con)
+		return err
+	}
+	extCmd, err := extensions.LoadExtensionManifest(manifest.manifestPath())
 	if err != nil {
 		return err
 	}
-
 	sliverMenu := con.App.Menu(constants.ImplantMenu)
 	//
 	// if extensions.CmdExists(extCmd.Name, sliverMenu.Command) {
@@ -79,9 +79,12 @@ func DefaultArmoryIndexParser(armoryConfig *assets.ArmoryConfig, clientConfig Ar
 		return nil, err
 	}
 
-	resp, body, _ := httpRequest(clientConfig, armoryConfig.RepoURL, armoryConfig, http.Header{})
+	resp, body, err := httpRequest(clientConfig, armoryConfig.RepoURL, armoryConfig, http.Header{})
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New("api returned non-200 status code")
+		return nil, fmt.Errorf("api returned non-200 status code: %d", resp.StatusCode)
 	}
 
 	indexResp := &armoryIndexResponse{}
@@ -122,12 +125,24 @@ func DefaultArmoryPkgParser(armoryConfig *assets.ArmoryConfig, armoryPkg *Armory
 		return nil, nil, err
 	}
 
-	resp, body, err := httpRequest(clientConfig, armoryConfig.RepoURL, armoryConfig, http.Header{})
+	pkgURL, err := url.Parse(armoryPkg.RepoURL)
 	if err != nil {
 		return nil, nil, err
 	}
+	if armoryPkg.Version != "" {
+		query := pkgURL.Query()
+		query.Set("version", armoryPkg.Version)
+		pkgURL.RawQuery = query.Encode()
+	}
+	resp, body, err := httpRequest(clientConfig, pkgURL.String(), armoryConfig, http.Header{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound && armoryPkg.Version != "" {
+		return nil, nil, fmt.Errorf("version %s not found", armoryPkg.Version)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, nil, errors.New("api returned non-200 status code")
+		return nil, nil, fmt.Errorf("api returned non-200 status code: %d", resp.StatusCode)
 	}
 	pkgResp := &armoryPkgResponse{}
 	err = json.Unmarshal(body, pkgResp)
@@ -140,7 +155,8 @@ func DefaultArmoryPkgParser(armoryConfig *assets.ArmoryConfig, armoryPkg *Armory
 	}
 	var tarGz []byte
 	if !sigOnly {
-		tarGzURL, err := url.Parse(armoryPkg.RepoURL)
+		// The url may be relative to the package's url
+		tarGzURL, err := pkgURL.Parse(pkgResp.TarGzURL)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -269,7 +285,10 @@ func GithubAPIArmoryPackageParser(armoryConfig *assets.ArmoryConfig, armoryPkg *
 	if err != nil {
 		return nil, nil, err
 	}
-	release := releases[0] // Latest only right now
+	release, err := githubRelease(releases, armoryPkg.Version)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	var sig *minisign.Signature
 	var tarGz []byte
@@ -294,15 +313,36 @@ func GithubAPIArmoryPackageParser(armoryConfig *assets.ArmoryConfig, armoryPkg *
 	return sig, tarGz, err
 }
 
+// githubRelease - The release of a version, or the latest release if version is blank,
+// releases are tagged with the version with or without a "v" prefix
+func githubRelease(releases []GithubRelease, version string) (*GithubRelease, error) {
+	if len(releases) < 1 {
+		return nil, errors.New("no releases found")
+	}
+	if version == "" {
+		return &releases[0], nil
+	}
+	for index := range releases {
+		if sameVersion(releases[index].TagName, version) {
+			return &releases[index], nil
+		}
+	}
+	return nil, fmt.Errorf("no release for version %s", version)
+}
+
 //
 // GitHub Parsers
 //
 
 // GithubArmoryPackageParser - Uses github.com instead of api.github.com to download packages
 func GithubArmoryPackageParser(_ *assets.ArmoryConfig, armoryPkg *ArmoryPackage, sigOnly bool, clientConfig ArmoryHTTPConfig) (*minisign.Signature, []byte, error) {
-	latestTag, err := githubLatestTagParser(armoryPkg, clientConfig)
-	if err != nil {
-		return nil, nil, err
+	latestTag := armoryPkg.Version
+	if latestTag == "" {
+		var err error
+		latestTag, err = githubLatestTagParser(armoryPkg, clientConfig)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	sigURL, err := url.Parse(armoryPkg.RepoURL)
@@ -436,6 +476,9 @@ func downloadRequest(clientConfig ArmoryHTTPConfig, reqURL string, armoryConfig
 		"Accept": {"application/octet-stream"},
 	}
 	resp, body, err := httpRequest(clientConfig, reqURL, armoryConfig, downloadHdr)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusFound {
 		return nil, fmt.Errorf("error downloading asset: http %d", resp.StatusCode)
 	}
@@ -0,0 +1,239 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/alias"
+	"github.com/bishopfox/sliver/client/command/extensions"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+const (
+	armoryPinsFileName = "armory-pins.json"
+	rollbackDirName    = "armory-rollback"
+)
+
+// ArmoryPinCmd - List pinned packages, or pin a package to its installed version
+func ArmoryPinCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	if len(args) == 0 {
+		PrintPins(loadPins(), installedPackages(), con)
+		return
+	}
+	name := args[0]
+	installed, ok := installedPackages()[name]
+	if !ok {
+		con.PrintErrorf("'%s' is not installed\n", name)
+		return
+	}
+	err := pinPackage(name, installed.Version)
+	if err != nil {
+		con.PrintErrorf("Failed to pin %s: %s\n", name, err)
+		return
+	}
+	con.PrintInfof("Pinned %s to %s\n", name, installed.Version)
+}
+
+// ArmoryUnpinCmd - Allow updates of a pinned package
+func ArmoryUnpinCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	name := args[0]
+	pins := loadPins()
+	if _, ok := pins[name]; !ok {
+		con.PrintErrorf("'%s' is not pinned\n", name)
+		return
+	}
+	delete(pins, name)
+	err := savePins(pins)
+	if err != nil {
+		con.PrintErrorf("Failed to unpin %s: %s\n", name, err)
+		return
+	}
+	con.PrintInfof("Unpinned %s\n", name)
+}
+
+// ArmoryRollbackCmd - Swap an installed package with the version it replaced
+func ArmoryRollbackCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	name := args[0]
+	current, ok := installedPackages()[name]
+	if !ok {
+		con.PrintErrorf("'%s' is not installed\n", name)
+		return
+	}
+	previous, err := rollbackPackage(current)
+	if err != nil {
+		con.PrintErrorf("Failed to roll back %s: %s\n", name, err)
+		return
+	}
+	err = loadPackage(previous, con)
+	if err != nil {
+		con.PrintErrorf("Failed to load %s %s: %s\n", name, previous.Version, err)
+		return
+	}
+	err = pinPackage(name, previous.Version)
+	if err != nil {
+		con.PrintErrorf("Failed to pin %s: %s\n", name, err)
+	}
+	con.PrintInfof("Rolled back %s from %s to %s, it is pinned to %s until unpinned\n",
+		name, current.Version, previous.Version, previous.Version)
+}
+
+// PrintPins - Print the pinned packages and their installed versions
+func PrintPins(pins map[string]string, installed map[string]*packageManifest, con *console.SliverConsoleClient) {
+	if len(pins) == 0 {
+		con.PrintInfof("No pinned packages\n")
+		return
+	}
+	names := []string{}
+	for name := range pins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Command Name",
+		"Pinned",
+		"Installed",
+	})
+	for _, name := range names {
+		installedVersion := console.Red + "not installed" + console.Normal
+		if manifest, ok := installed[name]; ok {
+			installedVersion = manifest.Version
+			if !sameVersion(manifest.Version, pins[name]) {
+				installedVersion = console.Orange + manifest.Version + console.Normal
+			}
+		}
+		tw.AppendRow(table.Row{name, pins[name], installedVersion})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// InstalledPackageCompleter - Completes the command names of installed aliases and extensions
+func InstalledPackageCompleter() carapace.Action {
+	return carapace.ActionCallback(func(ctx carapace.Context) carapace.Action {
+		results := []string{}
+		for name, manifest := range installedPackages() {
+			results = append(results, name, manifest.Version)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("installed packages")
+	})
+}
+
+// loadPins - Pinned versions by command name
+func loadPins() map[string]string {
+	pins := map[string]string{}
+	data, err := os.ReadFile(filepath.Join(assets.GetRootAppDir(), armoryPinsFileName))
+	if err != nil {
+		return pins
+	}
+	json.Unmarshal(data, &pins)
+	return pins
+}
+
+func savePins(pins map[string]string) error {
+	data, err := json.MarshalIndent(pins, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(assets.GetRootAppDir(), armoryPinsFileName), data, 0o600)
+}
+
+func pinPackage(name string, version string) error {
+	pins := loadPins()
+	pins[name] = version
+	return savePins(pins)
+}
+
+// rollbackPath - Where the version replaced by the last install of a package is kept
+func rollbackPath(manifest *packageManifest) string {
+	typeDir := assets.ExtensionsDirName
+	if manifest.IsAlias {
+		typeDir = assets.AliasesDirName
+	}
+	return filepath.Join(assets.GetRootAppDir(), rollbackDirName, typeDir, filepath.Base(manifest.CommandName))
+}
+
+// saveRollback - Move an installed package out of the way of its new version
+func saveRollback(installed *packageManifest) error {
+	savePath := rollbackPath(installed)
+	err := os.RemoveAll(savePath)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(savePath), 0o700)
+	if err != nil {
+		return err
+	}
+	return os.Rename(installed.RootPath, savePath)
+}
+
+// restoreRollback - Move a saved package back e.g. when installing its new version failed
+func restoreRollback(installed *packageManifest) error {
+	os.RemoveAll(installed.RootPath)
+	return os.Rename(rollbackPath(installed), installed.RootPath)
+}
+
+// rollbackPackage - Swap the installed package with the saved version, so rolling
+// back twice returns to the newer version
+func rollbackPackage(current *packageManifest) (*packageManifest, error) {
+	savePath := rollbackPath(current)
+	manifestFileName := extensions.ManifestFileName
+	if current.IsAlias {
+		manifestFileName = alias.ManifestFileName
+	}
+	data, err := os.ReadFile(filepath.Join(savePath, manifestFileName))
+	if err != nil {
+		return nil, errors.New("no previous version to roll back to")
+	}
+	previous, err := parsePackageManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	previous.IsAlias = current.IsAlias
+
+	swapPath := savePath + ".swap"
+	os.RemoveAll(swapPath)
+	err = os.Rename(current.RootPath, swapPath)
+	if err != nil {
+		return nil, err
+	}
+	err = os.Rename(savePath, current.RootPath)
+	if err != nil {
+		os.Rename(swapPath, current.RootPath)
+		return nil, err
+	}
+	err = os.Rename(swapPath, savePath)
+	if err != nil {
+		return nil, err
+	}
+	previous.RootPath = current.RootPath
+	return previous, nil
+}
@@ -0,0 +1,286 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/alias"
+	"github.com/bishopfox/sliver/client/command/extensions"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+const (
+	// defaultServePort - The server's default armory listener port
+	defaultServePort = 8443
+)
+
+// ArmoryPublishCmd - Publish an alias or extension to the server's private armory
+func ArmoryPublishCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	tarGz, err := readPackage(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Publishing %s (%s) ...\n", filepath.Base(args[0]), util.ByteCountBinary(int64(len(tarGz))))
+	pkg, err := con.Rpc.ArmoryPublish(context.Background(), &clientpb.ArmoryPublishReq{TarGz: tarGz})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Published %s %s (sha256 %s)\n", pkg.CommandName, pkg.Version, pkg.SHA256)
+}
+
+// ArmoryHostedCmd - List the packages published to the server's private armory
+func ArmoryHostedCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	packages, err := con.Rpc.ArmoryPackages(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(packages.Packages) == 0 {
+		con.PrintInfof("No packages published, see 'armory publish'\n")
+	} else {
+		PrintHostedPackages(packages.Packages, con)
+	}
+	con.PrintInfof("Armory public key: %s\n", packages.PublicKey)
+}
+
+// PrintHostedPackages - Print the published versions of private armory packages
+func PrintHostedPackages(packages []*clientpb.ArmoryPackageVersion, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Command Name",
+		"Version",
+		"Type",
+		"Size",
+		"SHA256",
+		"Published By",
+		"Published",
+	})
+	for _, pkg := range packages {
+		pkgType := "Extension"
+		if pkg.IsAlias {
+			pkgType = "Alias"
+		}
+		color := console.Normal
+		if pkg.Latest {
+			color = console.Green
+		}
+		tw.AppendRow(table.Row{
+			color + pkg.CommandName + console.Normal,
+			color + pkg.Version + console.Normal,
+			pkgType,
+			util.ByteCountBinary(pkg.Size),
+			pkg.SHA256[:16],
+			pkg.PublishedBy,
+			con.FormatDateDelta(time.Unix(pkg.PublishedAt, 0), true, false),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// ArmoryUnpublishCmd - Remove a package (or one version) from the private armory
+func ArmoryUnpublishCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	commandName := args[0]
+	version := ""
+	if 1 < len(args) {
+		version = args[1]
+	}
+	what := fmt.Sprintf("all versions of %s", commandName)
+	if version != "" {
+		what = fmt.Sprintf("%s %s", commandName, version)
+	}
+	confirm := false
+	prompt := &survey.Confirm{Message: fmt.Sprintf("Unpublish %s?", what)}
+	survey.AskOne(prompt, &confirm)
+	if !confirm {
+		return
+	}
+	_, err := con.Rpc.ArmoryUnpublish(context.Background(), &clientpb.ArmoryUnpublishReq{
+		CommandName: commandName,
+		Version:     version,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Unpublished %s\n", what)
+}
+
+// ArmoryServeCmd - Start a listener serving the private armory
+func ArmoryServeCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	lhost, _ := cmd.Flags().GetString("lhost")
+	lport, _ := cmd.Flags().GetUint32("lport")
+	secure, _ := cmd.Flags().GetBool("secure")
+	authorization, _ := cmd.Flags().GetString("authorization")
+	certPath, _ := cmd.Flags().GetString("cert")
+	keyPath, _ := cmd.Flags().GetString("key")
+	req := &clientpb.ArmoryListenerReq{
+		Host:          lhost,
+		Port:          lport,
+		Secure:        secure || certPath != "",
+		Authorization: authorization,
+	}
+	if certPath != "" || keyPath != "" {
+		var err error
+		req.Cert, err = os.ReadFile(certPath)
+		if err != nil {
+			con.PrintErrorf("Failed to read certificate: %s\n", err)
+			return
+		}
+		req.Key, err = os.ReadFile(keyPath)
+		if err != nil {
+			con.PrintErrorf("Failed to read key: %s\n", err)
+			return
+		}
+	}
+	listener, err := con.Rpc.StartArmoryListener(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Armory listener started as job #%d\n", listener.JobID)
+
+	scheme := "http"
+	if req.Secure {
+		scheme = "https"
+	}
+	if lhost == "" {
+		lhost = "<server>"
+	}
+	if lport == 0 {
+		lport = defaultServePort
+	}
+	config, _ := json.MarshalIndent([]*assets.ArmoryConfig{{
+		PublicKey:     listener.PublicKey,
+		RepoURL:       fmt.Sprintf("%s://%s:%d/index", scheme, lhost, lport),
+		Authorization: authorization,
+	}}, "", "  ")
+	con.PrintInfof("Add the armory to the armories.json file of each client:\n\n%s\n\n", config)
+}
+
+// readPackage - A package from a .tar.gz or a directory, the manifest is validated
+// the same way it is when the package is installed
+func readPackage(pkgPath string) ([]byte, error) {
+	fi, err := os.Stat(pkgPath)
+	if err != nil {
+		return nil, err
+	}
+	var tarGz []byte
+	var aliasManifest, extManifest []byte
+	if fi.IsDir() {
+		aliasManifest, _ = os.ReadFile(filepath.Join(pkgPath, alias.ManifestFileName))
+		extManifest, _ = os.ReadFile(filepath.Join(pkgPath, extensions.ManifestFileName))
+		if aliasManifest != nil || extManifest != nil {
+			tarGz, err = packageDir(pkgPath)
+		}
+	} else {
+		aliasManifest, _ = util.ReadFileFromTarGz(pkgPath, "./"+alias.ManifestFileName)
+		extManifest, _ = util.ReadFileFromTarGz(pkgPath, "./"+extensions.ManifestFileName)
+		if aliasManifest != nil || extManifest != nil {
+			tarGz, err = os.ReadFile(pkgPath)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case aliasManifest != nil:
+		_, err = alias.ParseAliasManifest(aliasManifest)
+	case extManifest != nil:
+		_, err = extensions.ParseExtensionManifest(extManifest)
+	default:
+		return nil, fmt.Errorf("no %s or %s in %s", alias.ManifestFileName, extensions.ManifestFileName, pkgPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest: %s", err)
+	}
+	return tarGz, nil
+}
+
+// packageDir - Create a .tar.gz of a directory in the layout the armory installs from
+func packageDir(dirPath string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	err := filepath.WalkDir(dirPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Skip hidden files and directories e.g. .git
+		if filePath != dirPath && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dirPath, filePath)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		err = tarWriter.WriteHeader(&tar.Header{
+			Name: "./" + filepath.ToSlash(relPath),
+			Mode: 0600,
+			Size: int64(len(data)),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tarWriter.Write(data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
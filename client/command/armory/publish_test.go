@@ -0,0 +1,94 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bishopfox/sliver/client/command/alias"
+	"github.com/bishopfox/sliver/util"
+)
+
+const testAliasManifest = `{
+	"name": "test1",
+	"command_name": "test1",
+	"version": "1.0.0",
+	"help": "some help",
+	"entrypoint": "foo",
+	"files": [
+		{
+			"os": "windows",
+			"arch": "amd64",
+			"path": "test1.dll"
+		}
+	]
+}`
+
+func TestPackageDir(t *testing.T) {
+	pkgDir := t.TempDir()
+	os.WriteFile(filepath.Join(pkgDir, alias.ManifestFileName), []byte(testAliasManifest), 0600)
+	os.WriteFile(filepath.Join(pkgDir, "test1.dll"), []byte("dll"), 0600)
+	os.MkdirAll(filepath.Join(pkgDir, ".git"), 0700)
+	os.WriteFile(filepath.Join(pkgDir, ".git", "config"), []byte("git"), 0600)
+
+	tarGz, err := readPackage(pkgDir)
+	if err != nil {
+		t.Fatalf("Failed to read package: %s", err)
+	}
+	tarGzPath := filepath.Join(t.TempDir(), "test1.tar.gz")
+	os.WriteFile(tarGzPath, tarGz, 0600)
+
+	manifest, err := util.ReadFileFromTarGz(tarGzPath, "./"+alias.ManifestFileName)
+	if err != nil || manifest == nil {
+		t.Fatalf("Expected manifest in package: %v", err)
+	}
+	dll, _ := util.ReadFileFromTarGz(tarGzPath, "./test1.dll")
+	if !bytes.Equal(dll, []byte("dll")) {
+		t.Errorf("Expected test1.dll in package, got %q", dll)
+	}
+	git, _ := util.ReadFileFromTarGz(tarGzPath, "./.git/config")
+	if git != nil {
+		t.Errorf("Expected hidden files to be skipped")
+	}
+
+	// The packaged .tar.gz can be published as is
+	data, err := readPackage(tarGzPath)
+	if err != nil {
+		t.Fatalf("Failed to read .tar.gz package: %s", err)
+	}
+	if !bytes.Equal(data, tarGz) {
+		t.Errorf("Expected .tar.gz to be published unchanged")
+	}
+}
+
+func TestReadPackageInvalid(t *testing.T) {
+	pkgDir := t.TempDir()
+	_, err := readPackage(pkgDir)
+	if err == nil {
+		t.Errorf("Expected error for package without a manifest")
+	}
+	os.WriteFile(filepath.Join(pkgDir, alias.ManifestFileName), []byte(`{"name": "test1"}`), 0600)
+	_, err = readPackage(pkgDir)
+	if err == nil {
+		t.Errorf("Expected error for invalid manifest")
+	}
+}
@@ -19,17 +19,23 @@ package armory
 */
 
 import (
+	"encoding/base64"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 
-	"github.com/bishopfox/sliver/client/assets"
-	"github.com/bishopfox/sliver/client/command/alias"
-	"github.com/bishopfox/sliver/client/command/extensions"
 	"github.com/bishopfox/sliver/client/console"
 )
 
+// packageUpdate - An installed package with a different version in the armory
+type packageUpdate struct {
+	Installed *packageManifest
+	Available *packageManifest
+	Changes   []string
+}
+
 // ArmoryUpdateCmd - Update all installed extensions/aliases
 func ArmoryUpdateCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
 	con.PrintInfof("Refreshing package cache ... ")
@@ -37,77 +43,94 @@ func ArmoryUpdateCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	refresh(clientConfig)
 	con.Printf(console.Clearln + "\r")
 
-	// Aliases
-	aliasUpdates := checkForAliasUpdates(clientConfig, con)
-	if 0 < len(aliasUpdates) {
-		con.PrintInfof("%d alias(es) out of date: %s\n", len(aliasUpdates), strings.Join(aliasUpdates, ", "))
-		for _, aliasName := range aliasUpdates {
-			err := installAliasPackageByName(aliasName, clientConfig, con)
-			if err != nil {
-				con.PrintErrorf("Failed to update %s: %s\n", aliasName, err)
-			}
+	updates := checkForUpdates(loadPins(), con)
+	if len(updates) == 0 {
+		con.PrintInfof("All packages up to date!\n")
+		return
+	}
+	names := []string{}
+	for _, update := range updates {
+		names = append(names, update.Installed.CommandName)
+	}
+	con.PrintInfof("%d package(s) out of date: %s\n", len(updates), strings.Join(names, ", "))
+	PrintUpdateDiffs(updates, con)
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		return
+	}
+	for _, update := range updates {
+		err := installPackage(update.Installed.CommandName, "", clientConfig, con)
+		if err != nil {
+			con.PrintErrorf("Failed to update %s: %s\n", update.Installed.CommandName, err)
 		}
-	} else {
-		con.PrintInfof("All aliases up to date!\n")
 	}
+}
 
-	// Extensions
-	extUpdates := checkForExtensionUpdates(clientConfig, con)
-	if 0 < len(extUpdates) {
-		con.PrintInfof("%d extension(s) out of date: %s\n", len(extUpdates), strings.Join(extUpdates, ", "))
-		for _, extName := range extUpdates {
-			err := installExtensionPackageByName(extName, clientConfig, con)
-			if err != nil {
-				con.PrintErrorf("Failed to update %s: %s\n", extName, err)
+// PrintUpdateDiffs - Print what each update changes in the package's manifest
+func PrintUpdateDiffs(updates []*packageUpdate, con *console.SliverConsoleClient) {
+	for _, update := range updates {
+		con.Printf("\n%s%s%s %s -> %s\n", console.Bold, update.Installed.CommandName, console.Normal,
+			update.Installed.Version, update.Available.Version)
+		for _, change := range update.Changes {
+			color := console.Orange
+			switch change[0] {
+			case '+':
+				color = console.Green
+			case '-':
+				color = console.Red
 			}
+			con.Printf("  %s%s%s\n", color, change, console.Normal)
 		}
-	} else {
-		con.PrintInfof("All extensions up to date!\n")
 	}
+	con.Println()
 }
 
-func checkForAliasUpdates(clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) []string {
-	cachedAliases, _ := packagesInCache()
-	results := []string{}
-	for _, aliasManifestPath := range assets.GetInstalledAliasManifests() {
-		data, err := os.ReadFile(aliasManifestPath)
+// checkForUpdates - Installed packages with a different version in the armory, pinned packages
+// and versions that require a newer client are skipped
+func checkForUpdates(pins map[string]string, con *console.SliverConsoleClient) []*packageUpdate {
+	updates := []*packageUpdate{}
+	for name, installed := range installedPackages() {
+		entry := pkgCacheEntryByName(name)
+		if entry == nil {
+			continue
+		}
+		availableData, err := base64.StdEncoding.DecodeString(entry.Sig.TrustedComment)
 		if err != nil {
 			continue
 		}
-		localManifest, err := alias.ParseAliasManifest(data)
+		available, err := parsePackageManifest(availableData)
 		if err != nil {
 			continue
 		}
-		for _, latestAlias := range cachedAliases {
-			// Right now we don't try to enforce any kind of versioning, it is assumed if the version from
-			// the armory differs at all from the local version, the extension is out of date.
-			if latestAlias.CommandName == localManifest.CommandName && latestAlias.Version != localManifest.Version {
-				results = append(results, localManifest.CommandName)
-			}
+		// Right now we don't try to enforce any kind of versioning, it is assumed if the version from
+		// the armory differs at all from the local version, the package is out of date.
+		if available.Version == installed.Version {
+			continue
 		}
-	}
-	return results
-}
-
-func checkForExtensionUpdates(clientConfig ArmoryHTTPConfig, con *console.SliverConsoleClient) []string {
-	_, cachedExtensions := packagesInCache()
-	results := []string{}
-	for _, extManifestPath := range assets.GetInstalledExtensionManifests() {
-		data, err := os.ReadFile(extManifestPath)
-		if err != nil {
+		if pinned, ok := pins[name]; ok {
+			con.PrintInfof("%s is pinned to %s, skipping %s\n", name, pinned, available.Version)
 			continue
 		}
-		localManifest, err := extensions.ParseExtensionManifest(data)
+		if err := checkSliverVersion(available); err != nil {
+			con.PrintWarnf("Skipping update: %s\n", err)
+			continue
+		}
+		installedData, err := os.ReadFile(installed.manifestPath())
 		if err != nil {
 			continue
 		}
-		for _, latestExt := range cachedExtensions {
-			// Right now we don't try to enforce any kind of versioning, it is assumed if the version from
-			// the armory differs at all from the local version, the extension is out of date.
-			if latestExt.CommandName == localManifest.CommandName && latestExt.Version != localManifest.Version {
-				results = append(results, localManifest.CommandName)
-			}
+		changes, err := manifestDiff(installedData, availableData)
+		if err != nil {
+			continue
 		}
+		updates = append(updates, &packageUpdate{
+			Installed: installed,
+			Available: available,
+			Changes:   changes,
+		})
 	}
-	return results
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Installed.CommandName < updates[j].Installed.CommandName
+	})
+	return updates
 }
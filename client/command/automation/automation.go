@@ -0,0 +1,192 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// AutomationCmd - List automation rules
+func AutomationCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	rules, err := con.Rpc.AutomationRules(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(rules.Rules) == 0 {
+		con.PrintInfof("No automation rules\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Event",
+		"Filters",
+		"Tasks",
+		"Enabled",
+		"Operator",
+		"Created",
+	})
+	for _, rule := range rules.Rules {
+		enabled := console.Green + "true" + console.Normal
+		if !rule.Enabled {
+			enabled = console.Red + "false" + console.Normal
+		}
+		tw.AppendRow(table.Row{
+			rule.Name,
+			rule.Event,
+			ruleFilters(rule),
+			strings.Join(rule.Tasks, "\n"),
+			enabled,
+			rule.Operator,
+			time.Unix(rule.CreatedAt, 0).Format(time.RFC1123),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// AutomationAddCmd - Add or replace an automation rule
+func AutomationAddCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	event, _ := cmd.Flags().GetString("event")
+	goos, _ := cmd.Flags().GetString("os")
+	hostname, _ := cmd.Flags().GetString("hostname")
+	username, _ := cmd.Flags().GetString("username")
+	tasks, _ := cmd.Flags().GetStringArray("task")
+	disabled, _ := cmd.Flags().GetBool("disabled")
+	if len(tasks) == 0 {
+		con.PrintErrorf("At least one --task is required\n")
+		return
+	}
+	rule, err := con.Rpc.AutomationRuleSave(context.Background(), &clientpb.AutomationRule{
+		Name:     args[0],
+		Event:    event,
+		OS:       goos,
+		Hostname: hostname,
+		Username: username,
+		Tasks:    tasks,
+		Enabled:  !disabled,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved automation rule %s (%d task(s) on %s)\n", rule.Name, len(rule.Tasks), rule.Event)
+}
+
+// AutomationRmCmd - Remove an automation rule
+func AutomationRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	_, err := con.Rpc.AutomationRuleRm(context.Background(), &clientpb.AutomationRule{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed automation rule %s\n", args[0])
+}
+
+// AutomationEnableCmd - Enable an automation rule
+func AutomationEnableCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	setEnabled(args[0], true, con)
+}
+
+// AutomationDisableCmd - Disable an automation rule without removing it
+func AutomationDisableCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	setEnabled(args[0], false, con)
+}
+
+func setEnabled(name string, enabled bool, con *console.SliverConsoleClient) {
+	rules, err := con.Rpc.AutomationRules(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, rule := range rules.Rules {
+		if rule.Name != name {
+			continue
+		}
+		rule.Enabled = enabled
+		_, err = con.Rpc.AutomationRuleSave(context.Background(), rule)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if enabled {
+			con.PrintInfof("Enabled automation rule %s\n", name)
+		} else {
+			con.PrintInfof("Disabled automation rule %s\n", name)
+		}
+		return
+	}
+	con.PrintErrorf("No automation rule named %s\n", name)
+}
+
+func ruleFilters(rule *clientpb.AutomationRule) string {
+	filters := []string{}
+	if rule.OS != "" {
+		filters = append(filters, fmt.Sprintf("os=%s", rule.OS))
+	}
+	if rule.Hostname != "" {
+		filters = append(filters, fmt.Sprintf("hostname=~%s", rule.Hostname))
+	}
+	if rule.Username != "" {
+		filters = append(filters, fmt.Sprintf("username=~%s", rule.Username))
+	}
+	if len(filters) == 0 {
+		return "any"
+	}
+	return strings.Join(filters, "\n")
+}
+
+// EventCompleter - Completes the events that can trigger a rule
+func EventCompleter() carapace.Action {
+	return carapace.ActionValuesDescribed(
+		consts.SessionOpenedEvent, "a session connects",
+		consts.BeaconRegisteredEvent, "a beacon registers",
+	).Tag("events")
+}
+
+// RuleNameCompleter - Completes automation rule names
+func RuleNameCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		rules, err := con.Rpc.AutomationRules(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to get automation rules: %s", err)
+		}
+		for _, rule := range rules.Rules {
+			results = append(results, rule.Name)
+			results = append(results, rule.Event)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("automation rules")
+	}
+	return carapace.ActionCallback(callback)
+}
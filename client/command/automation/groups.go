@@ -0,0 +1,258 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// GroupsCmd - List implant groups
+func GroupsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	groups, err := con.Rpc.ImplantGroups(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(groups.Groups) == 0 {
+		con.PrintInfof("No implant groups\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Filters",
+		"Sessions",
+		"Beacons",
+		"Operator",
+		"Created",
+	})
+	for _, group := range groups.Groups {
+		sessions, beacons := "?", "?"
+		members, err := con.Rpc.ImplantGroupMembers(context.Background(), group)
+		if err == nil {
+			sessions = fmt.Sprintf("%d", len(members.Sessions))
+			beacons = fmt.Sprintf("%d", len(members.Beacons))
+		}
+		tw.AppendRow(table.Row{
+			group.Name,
+			groupFilters(group),
+			sessions,
+			beacons,
+			group.Operator,
+			time.Unix(group.CreatedAt, 0).Format(time.RFC1123),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+func groupFilters(group *clientpb.ImplantGroup) string {
+	filters := []string{}
+	if group.OS != "" {
+		filters = append(filters, fmt.Sprintf("os=%s", group.OS))
+	}
+	if 0 < len(group.Tags) {
+		filters = append(filters, fmt.Sprintf("tag=%s", strings.Join(group.Tags, "|")))
+	}
+	if 0 < len(group.Subnets) {
+		filters = append(filters, fmt.Sprintf("subnet=%s", strings.Join(group.Subnets, "|")))
+	}
+	for _, id := range group.ImplantIDs {
+		filters = append(filters, fmt.Sprintf("+%s", strings.Split(id, "-")[0]))
+	}
+	return strings.Join(filters, "\n")
+}
+
+// GroupsAddCmd - Add or replace an implant group
+func GroupsAddCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	tags, _ := cmd.Flags().GetStringArray("tag")
+	subnets, _ := cmd.Flags().GetStringArray("subnet")
+	goos, _ := cmd.Flags().GetString("os")
+	implantIDs, _ := cmd.Flags().GetStringArray("implant")
+	group, err := con.Rpc.ImplantGroupSave(context.Background(), &clientpb.ImplantGroup{
+		Name:       args[0],
+		Tags:       tags,
+		Subnets:    subnets,
+		OS:         goos,
+		ImplantIDs: implantIDs,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	members, err := con.Rpc.ImplantGroupMembers(context.Background(), group)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved implant group %s (%d session(s), %d beacon(s))\n", group.Name, len(members.Sessions), len(members.Beacons))
+}
+
+// GroupsRmCmd - Remove an implant group
+func GroupsRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	_, err := con.Rpc.ImplantGroupRm(context.Background(), &clientpb.ImplantGroup{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed implant group %s\n", args[0])
+}
+
+// GroupsMembersCmd - List the sessions and beacons in an implant group
+func GroupsMembersCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	members, err := con.Rpc.ImplantGroupMembers(context.Background(), &clientpb.ImplantGroup{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(members.Sessions)+len(members.Beacons) == 0 {
+		con.PrintInfof("No sessions or beacons in %s\n", args[0])
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Type",
+		"Name",
+		"Hostname",
+		"Username",
+		"Operating System",
+		"Remote Address",
+	})
+	for _, session := range members.Sessions {
+		tw.AppendRow(table.Row{
+			strings.Split(session.ID, "-")[0],
+			"session",
+			session.Name,
+			session.Hostname,
+			session.Username,
+			fmt.Sprintf("%s/%s", session.OS, session.Arch),
+			session.RemoteAddress,
+		})
+	}
+	for _, beacon := range members.Beacons {
+		tw.AppendRow(table.Row{
+			strings.Split(beacon.ID, "-")[0],
+			"beacon",
+			beacon.Name,
+			beacon.Hostname,
+			beacon.Username,
+			fmt.Sprintf("%s/%s", beacon.OS, beacon.Arch),
+			beacon.RemoteAddress,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// GroupsTaskCmd - Run tasks against every member of an implant group
+func GroupsTaskCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	tasks, _ := cmd.Flags().GetStringArray("task")
+	if len(tasks) == 0 {
+		con.PrintErrorf("At least one --task is required\n")
+		return
+	}
+	con.PrintInfof("Tasking implant group %s ...\n", args[0])
+	results, err := con.Rpc.ImplantGroupTask(context.Background(), &clientpb.GroupTaskReq{
+		Group: args[0],
+		Tasks: tasks,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(results.Results) == 0 {
+		con.PrintInfof("No sessions or beacons in %s\n", args[0])
+		return
+	}
+	PrintGroupTaskResults(results.Results, con)
+}
+
+// PrintGroupTaskResults - Print the result of each task on each host, followed by any output
+func PrintGroupTaskResults(results []*clientpb.GroupTaskResult, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Hostname",
+		"Implant",
+		"Task",
+		"Result",
+	})
+	failed := 0
+	for _, result := range results {
+		var status string
+		switch {
+		case result.Err != "":
+			status = console.Red + result.Err + console.Normal
+			failed++
+		case result.TaskID != "":
+			status = fmt.Sprintf("queued (%s)", strings.Split(result.TaskID, "-")[0])
+		case result.LootID != "":
+			status = fmt.Sprintf(console.Green+"ok"+console.Normal+" (loot %s)", strings.Split(result.LootID, "-")[0])
+		default:
+			status = console.Green + "ok" + console.Normal
+		}
+		tw.AppendRow(table.Row{
+			result.Hostname,
+			fmt.Sprintf("%s (%s)", result.ImplantName, strings.Split(result.ImplantID, "-")[0]),
+			result.Task,
+			status,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+	for _, result := range results {
+		if strings.TrimSpace(result.Output) == "" {
+			continue
+		}
+		con.Println()
+		con.Printf(console.Bold+"%s (%s) %s:"+console.Normal+"\n", result.Hostname, result.ImplantName, result.Task)
+		con.Printf("%s\n", strings.TrimRight(result.Output, "\n"))
+	}
+	con.Println()
+	con.PrintInfof("%d task(s), %d failed, beacon output is saved as loot when the beacon checks in\n", len(results), failed)
+}
+
+// GroupNameCompleter - Completes implant group names
+func GroupNameCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		groups, err := con.Rpc.ImplantGroups(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to get implant groups: %s", err)
+		}
+		for _, group := range groups.Groups {
+			results = append(results, group.Name)
+			results = append(results, strings.ReplaceAll(groupFilters(group), "\n", " "))
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("implant groups")
+	}
+	return carapace.ActionCallback(callback)
+}
@@ -0,0 +1,249 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/command/use"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// SchedulesCmd - List scheduled tasks
+func SchedulesCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	scheduled, err := con.Rpc.ScheduledTasks(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(scheduled.Tasks) == 0 {
+		con.PrintInfof("No scheduled tasks\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Implant",
+		"Schedule",
+		"Tasks",
+		"Next Run",
+		"Last Run",
+		"Runs",
+		"Enabled",
+		"Operator",
+	})
+	for _, task := range scheduled.Tasks {
+		enabled := console.Green + "true" + console.Normal
+		if !task.Enabled {
+			enabled = console.Red + "false" + console.Normal
+		}
+		kind := "session"
+		if task.Beacon {
+			kind = "beacon"
+		}
+		tw.AppendRow(table.Row{
+			task.Name,
+			strings.Join([]string{task.ImplantName, task.Hostname, kind + " " + strings.Split(task.ImplantID, "-")[0]}, "\n"),
+			task.Schedule,
+			strings.Join(task.Tasks, "\n"),
+			scheduleTime(task.NextRun),
+			scheduleTime(task.LastRun),
+			task.Runs,
+			enabled,
+			task.Operator,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+func scheduleTime(unix int64) string {
+	if unix == 0 {
+		return "-"
+	}
+	return time.Unix(unix, 0).Format(time.RFC1123)
+}
+
+// SchedulesAddCmd - Add or replace a scheduled task
+func SchedulesAddCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	implantID, err := scheduleImplantID(cmd, con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	schedule, err := scheduleSpec(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	tasks, _ := cmd.Flags().GetStringArray("task")
+	disabled, _ := cmd.Flags().GetBool("disabled")
+	if len(tasks) == 0 {
+		con.PrintErrorf("At least one --task is required\n")
+		return
+	}
+	scheduled, err := con.Rpc.ScheduledTaskSave(context.Background(), &clientpb.ScheduledTask{
+		Name:      args[0],
+		ImplantID: implantID,
+		Schedule:  schedule,
+		Tasks:     tasks,
+		Enabled:   !disabled,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved scheduled task %s (%d task(s) on %s)\n", scheduled.Name, len(scheduled.Tasks), scheduled.Hostname)
+	if scheduled.NextRun != 0 {
+		con.PrintInfof("Next run %s\n", scheduleTime(scheduled.NextRun))
+	}
+}
+
+// scheduleImplantID - The session or beacon given with --implant, or the active one
+func scheduleImplantID(cmd *cobra.Command, con *console.SliverConsoleClient) (string, error) {
+	implantID, _ := cmd.Flags().GetString("implant")
+	if implantID == "" {
+		session, beacon := con.ActiveTarget.Get()
+		if session != nil {
+			return session.ID, nil
+		}
+		if beacon != nil {
+			return beacon.ID, nil
+		}
+		return "", errors.New("use a session or beacon, or set one with --implant")
+	}
+	session, beacon, err := use.SessionOrBeaconByID(implantID, con)
+	if err != nil {
+		return "", err
+	}
+	if session != nil {
+		return session.ID, nil
+	}
+	return beacon.ID, nil
+}
+
+// scheduleSpec - Exactly one of --at, --in, --every, or --cron, one time schedules are sent in UTC
+func scheduleSpec(cmd *cobra.Command) (string, error) {
+	at, _ := cmd.Flags().GetString("at")
+	in, _ := cmd.Flags().GetString("in")
+	every, _ := cmd.Flags().GetString("every")
+	cron, _ := cmd.Flags().GetString("cron")
+	count := 0
+	for _, value := range []string{at, in, every, cron} {
+		if value != "" {
+			count++
+		}
+	}
+	if count != 1 {
+		return "", errors.New("exactly one of --at, --in, --every, or --cron is required")
+	}
+	switch {
+	case at != "":
+		when, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			when, err = time.ParseInLocation("2006-01-02 15:04", at, time.Local)
+		}
+		if err != nil {
+			return "", errors.New("--at must be RFC 3339 (2006-01-02T15:04:05Z) or local time (2006-01-02 15:04)")
+		}
+		return "@at " + when.UTC().Format(time.RFC3339), nil
+	case in != "":
+		delay, err := time.ParseDuration(in)
+		if err != nil {
+			return "", err
+		}
+		return "@at " + time.Now().Add(delay).UTC().Format(time.RFC3339), nil
+	case every != "":
+		return "@every " + every, nil
+	}
+	return cron, nil
+}
+
+// SchedulesRmCmd - Remove a scheduled task
+func SchedulesRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	_, err := con.Rpc.ScheduledTaskRm(context.Background(), &clientpb.ScheduledTask{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed scheduled task %s\n", args[0])
+}
+
+// SchedulesEnableCmd - Enable a scheduled task
+func SchedulesEnableCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	setScheduleEnabled(args[0], true, con)
+}
+
+// SchedulesDisableCmd - Disable a scheduled task without removing it
+func SchedulesDisableCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	setScheduleEnabled(args[0], false, con)
+}
+
+func setScheduleEnabled(name string, enabled bool, con *console.SliverConsoleClient) {
+	scheduled, err := con.Rpc.ScheduledTasks(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, task := range scheduled.Tasks {
+		if task.Name != name {
+			continue
+		}
+		task.Enabled = enabled
+		_, err = con.Rpc.ScheduledTaskSave(context.Background(), task)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if enabled {
+			con.PrintInfof("Enabled scheduled task %s\n", name)
+		} else {
+			con.PrintInfof("Disabled scheduled task %s\n", name)
+		}
+		return
+	}
+	con.PrintErrorf("No scheduled task named %s\n", name)
+}
+
+// ScheduleNameCompleter - Completes scheduled task names
+func ScheduleNameCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		scheduled, err := con.Rpc.ScheduledTasks(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to get scheduled tasks: %s", err)
+		}
+		for _, task := range scheduled.Tasks {
+			results = append(results, task.Name)
+			results = append(results, task.Schedule)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("scheduled tasks")
+	}
+	return carapace.ActionCallback(callback)
+}
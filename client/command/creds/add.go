@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/credentials"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/spf13/cobra"
@@ -35,6 +36,7 @@ const (
 	UserColonHashNewlineFormat = "user:hash" // username:hash\n
 	HashNewlineFormat          = "hash"      // hash\n
 	CSVFormat                  = "csv"       // username,hash\n
+	AutoFormat                 = "auto"      // pwdump, shadow, net-NTLM, kerberos, user:hash, or hash\n
 )
 
 // CredsCmd - Add new credentials
@@ -44,25 +46,39 @@ func CredsAddCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 	plaintext, _ := cmd.Flags().GetString("plaintext")
 	hash, _ := cmd.Flags().GetString("hash")
 	hashTypeF, _ := cmd.Flags().GetString("hash-type")
-	hashType := parseHashTypeString(hashTypeF)
 	if plaintext == "" && hash == "" {
 		con.PrintErrorf("Either a plaintext or a hash must be provided\n")
 		return
 	}
-	if hashType == clientpb.HashType_INVALID {
-		con.PrintErrorf("Invalid hash type '%s'\n", hashTypeF)
-		return
+	hashType := clientpb.HashType_INVALID
+	if hashTypeF != "" {
+		hashType = parseHashTypeString(hashTypeF)
+		if hashType == clientpb.HashType_INVALID {
+			con.PrintErrorf("Invalid hash type '%s'\n", hashTypeF)
+			return
+		}
+	} else if hash != "" {
+		parsed := credentials.ParseCredential(hash)
+		if parsed == nil || parsed.HashType == clientpb.HashType_INVALID {
+			con.PrintErrorf("Could not detect the hash type, please specify --hash-type\n")
+			return
+		}
+		con.PrintInfof("Detected hash type %s\n", credentials.HashNameFromHashType(parsed.HashType))
+		hash, hashType = parsed.Hash, parsed.HashType
+		if username == "" {
+			username = parsed.Username
+		}
 	}
+	cred := &clientpb.Credential{
+		Collection: collection,
+		Username:   username,
+		Plaintext:  plaintext,
+		Hash:       hash,
+		HashType:   hashType,
+	}
+	setCredOrigin(cred, con)
 	_, err := con.Rpc.CredsAdd(context.Background(), &clientpb.Credentials{
-		Credentials: []*clientpb.Credential{
-			{
-				Collection: collection,
-				Username:   username,
-				Plaintext:  plaintext,
-				Hash:       hash,
-				HashType:   hashType,
-			},
-		},
+		Credentials: []*clientpb.Credential{cred},
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
@@ -86,10 +102,13 @@ func CredsAddHashFileCmd(cmd *cobra.Command, con *console.SliverConsoleClient, a
 		return
 	}
 	hashTypeF, _ := cmd.Flags().GetString("hash-type")
-	hashType := parseHashTypeString(hashTypeF)
-	if hashType == clientpb.HashType_INVALID {
-		con.PrintErrorf("Invalid hash type '%s'\n", hashTypeF)
-		return
+	hashType := clientpb.HashType_INVALID
+	if hashTypeF != "" {
+		hashType = parseHashTypeString(hashTypeF)
+		if hashType == clientpb.HashType_INVALID {
+			con.PrintErrorf("Invalid hash type '%s'\n", hashTypeF)
+			return
+		}
 	}
 
 	con.PrintInfof("Parsing file '%s' as '%s' format ...\n", filePath, fileFormat)
@@ -102,6 +121,8 @@ func CredsAddHashFileCmd(cmd *cobra.Command, con *console.SliverConsoleClient, a
 		creds, err = parseHashNewline(filePath, hashType)
 	case CSVFormat:
 		creds, err = parseCSV(filePath, hashType)
+	case AutoFormat:
+		creds, err = parseAuto(filePath, hashType)
 	default:
 		con.PrintErrorf("Invalid file format '%s', see 'creds add file --help'\n", fileFormat)
 		return
@@ -110,9 +131,22 @@ func CredsAddHashFileCmd(cmd *cobra.Command, con *console.SliverConsoleClient, a
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	detected := []*clientpb.Credential{}
 	for _, cred := range creds.Credentials {
+		if cred.HashType == clientpb.HashType_INVALID {
+			cred.HashType = credentials.SniffHashType(cred.Hash)
+		}
+		if cred.HashType == clientpb.HashType_INVALID {
+			continue
+		}
 		cred.Collection = collection
+		setCredOrigin(cred, con)
+		detected = append(detected, cred)
 	}
+	if skipped := len(creds.Credentials) - len(detected); 0 < skipped {
+		con.PrintWarnf("Skipping %d credential(s) with an unknown hash type, please specify --hash-type\n", skipped)
+	}
+	creds.Credentials = detected
 	con.PrintInfof("Adding %d credential(s) ...\n", len(creds.Credentials))
 	_, err = con.Rpc.CredsAdd(context.Background(), creds)
 	if err != nil {
@@ -211,3 +245,40 @@ func parseCSV(filePath string, hashType clientpb.HashType) (*clientpb.Credential
 	}
 	return creds, nil
 }
+
+// parseAuto - Detect the format of each line, an explicit hash type overrides
+// whatever was detected
+func parseAuto(filePath string, hashType clientpb.HashType) (*clientpb.Credentials, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	creds := &clientpb.Credentials{}
+	for scanner.Scan() {
+		cred := credentials.ParseCredential(scanner.Text())
+		if cred == nil {
+			continue
+		}
+		if hashType != clientpb.HashType_INVALID {
+			cred.HashType = hashType
+		}
+		creds.Credentials = append(creds.Credentials, cred)
+	}
+	return creds, scanner.Err()
+}
+
+// setCredOrigin - Credentials added while interacting with a session or beacon
+// are attributed to it and its host
+func setCredOrigin(cred *clientpb.Credential, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.Get()
+	if session != nil {
+		cred.OriginHostUUID = session.UUID
+		cred.OriginSessionID = session.ID
+	} else if beacon != nil {
+		cred.OriginHostUUID = beacon.UUID
+		cred.OriginSessionID = beacon.ID
+	}
+}
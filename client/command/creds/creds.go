@@ -107,6 +107,7 @@ func CredsHashFileFormatCompleter(con *console.SliverConsoleClient) carapace.Act
 		UserColonHashNewlineFormat, "One hash per line.",
 		HashNewlineFormat, "A file containing lines of 'username:hash' pairs.",
 		CSVFormat, "A CSV file containing 'username,hash' pairs (additional columns ignored).",
+		AutoFormat, "Detect pwdump, shadow, net-NTLM, Kerberos, 'username:hash', or hash lines.",
 	).Tag("hash file formats")
 }
 
@@ -0,0 +1,122 @@
+package creds
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/credentials"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// CredsExportCmd - Export uncracked hashes as a hashcat or john cracking job
+func CredsExportCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	format, _ := cmd.Flags().GetString("format")
+	collection, _ := cmd.Flags().GetString("collection")
+	hashTypeF, _ := cmd.Flags().GetString("hash-type")
+	save, _ := cmd.Flags().GetString("save")
+
+	creds, err := con.Rpc.Creds(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	hashType := clientpb.HashType_INVALID
+	if hashTypeF != "" {
+		hashType = parseHashTypeString(hashTypeF)
+		if hashType == clientpb.HashType_INVALID {
+			con.PrintErrorf("Invalid hash type '%s'\n", hashTypeF)
+			return
+		}
+	}
+	selected := []*clientpb.Credential{}
+	for _, cred := range creds.Credentials {
+		if collection != "" && cred.Collection != collection {
+			continue
+		}
+		if hashType != clientpb.HashType_INVALID && cred.HashType != hashType {
+			continue
+		}
+		selected = append(selected, cred)
+	}
+
+	files, err := credentials.CrackingJob(format, selected)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if save == "" {
+		save = "creds-" + format
+	}
+	err = os.MkdirAll(save, 0700)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		mode := os.FileMode(0600)
+		if name == credentials.CrackingJobScript {
+			mode = 0700
+		}
+		err = os.WriteFile(filepath.Join(save, name), files[name], mode)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Wrote %s\n", filepath.Join(save, name))
+	}
+}
+
+// CredsCrackedCmd - Import cracked results from hashcat or john
+func CredsCrackedCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	creds, err := con.Rpc.Creds(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	cracked := credentials.CrackedResults(creds.Credentials, data)
+	if len(cracked) == 0 {
+		con.PrintInfof("No new cracked credentials in %s\n", args[0])
+		return
+	}
+	_, err = con.Rpc.CredsUpdate(context.Background(), &clientpb.Credentials{Credentials: cracked})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Imported %d cracked credential(s)\n\n", len(cracked))
+	PrintCreds(cracked, con)
+}
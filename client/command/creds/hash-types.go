@@ -140,4 +140,6 @@ var hashTypes = map[string]string{
 	// Out of order
 	"BCRYPT_UNIX":       "bcrypt",
 	"SHA512_CRYPT_UNIX": "sha512crypt $6$, SHA512 (Unix)",
+	"SHA256_CRYPT_UNIX": "sha256crypt $5$, SHA256 (Unix)",
+	"MD5_CRYPT_UNIX":    "md5crypt, MD5 (Unix), Cisco-IOS $1$ (MD5)",
 }
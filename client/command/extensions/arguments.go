@@ -0,0 +1,382 @@
+package extensions
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Argument types, int/short/string/wstring/file are also the types BOFs can be passed
+const (
+	ArgTypeInt     = "int"
+	ArgTypeInteger = "integer"
+	ArgTypeShort   = "short"
+	ArgTypeString  = "string"
+	ArgTypeWString = "wstring"
+	ArgTypeFile    = "file"
+	ArgTypeBool    = "bool"
+
+	// argValuePlaceholder - Replaced by the value in an argument's format
+	argValuePlaceholder = "{}"
+
+	// argumentFlagAnnotation - Marks the flags generated for arguments, so they're
+	// never confused with the command's own flags
+	argumentFlagAnnotation = "extension-argument"
+)
+
+var (
+	flagNameSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+	errTooManyArguments = errors.New("too many arguments")
+)
+
+// Argument - A named and typed argument of an alias or extension. Arguments can be passed
+// positionally (in order) or with the --<name> flag generated for each argument.
+type Argument struct {
+	Name     string      `json:"name"`
+	Type     string      `json:"type"`
+	Desc     string      `json:"desc"`
+	Optional bool        `json:"optional"`
+	Default  interface{} `json:"default"`
+	Choices  []string    `json:"choices"`
+	Min      *int64      `json:"min"`
+	Max      *int64      `json:"max"`
+	Pattern  string      `json:"pattern"`
+
+	// Format - How the argument is added to a command line (aliases and DLL extensions),
+	// "{}" is replaced by the value e.g. "/user:{}". A bool argument adds its format when
+	// true, and "--<name>" if the format is blank.
+	Format string `json:"format"`
+}
+
+// ArgumentValue - The value of an argument, Set is false if an optional argument
+// without a default wasn't given
+type ArgumentValue struct {
+	Argument *Argument
+	Value    string
+	Set      bool
+}
+
+// FlagName - The name of the flag generated for the argument, blank if the name
+// has no letters or digits
+func (a *Argument) FlagName() string {
+	name := flagNameSeparators.ReplaceAllString(strings.ToLower(a.Name), "-")
+	return strings.Trim(name, "-")
+}
+
+// DefaultValue - The default as a string, and if the argument has one
+func (a *Argument) DefaultValue() (string, bool) {
+	switch value := a.Default.(type) {
+	case nil:
+		return "", false
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	default:
+		return fmt.Sprintf("%v", value), true
+	}
+}
+
+// Required - The argument must be given
+func (a *Argument) Required() bool {
+	_, hasDefault := a.DefaultValue()
+	return !a.Optional && !hasDefault
+}
+
+// Usage - One line describing the argument's type and constraints
+func (a *Argument) Usage() string {
+	usage := fmt.Sprintf("(%s) %s", a.Type, a.Desc)
+	if a.Required() {
+		usage += " (required)"
+	}
+	if value, ok := a.DefaultValue(); ok {
+		usage += fmt.Sprintf(" (default: %s)", value)
+	}
+	if 0 < len(a.Choices) {
+		usage += fmt.Sprintf(" (one of: %s)", strings.Join(a.Choices, ", "))
+	}
+	if a.Min != nil || a.Max != nil {
+		usage += fmt.Sprintf(" (range: %s)", a.rangeString())
+	}
+	return usage
+}
+
+func (a *Argument) rangeString() string {
+	min, max := "", ""
+	if a.Min != nil {
+		min = strconv.FormatInt(*a.Min, 10)
+	}
+	if a.Max != nil {
+		max = strconv.FormatInt(*a.Max, 10)
+	}
+	return min + ".." + max
+}
+
+// Validate - Returns an error if the value is not valid for the argument
+func (a *Argument) Validate(value string) error {
+	switch a.Type {
+	case ArgTypeInt, ArgTypeInteger, ArgTypeShort:
+		bits := 32
+		if a.Type == ArgTypeShort {
+			bits = 16
+		}
+		number, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return fmt.Errorf("'%s' is not an integer", value)
+		}
+		if number < -(int64(1)<<(bits-1)) || int64(1)<<bits <= number {
+			return fmt.Errorf("%s is out of range for a %d bit integer", value, bits)
+		}
+		if (a.Min != nil && number < *a.Min) || (a.Max != nil && *a.Max < number) {
+			return fmt.Errorf("%s is not in the range %s", value, a.rangeString())
+		}
+	case ArgTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("'%s' is not a bool", value)
+		}
+	case ArgTypeFile:
+		if fi, err := os.Stat(value); err != nil || fi.IsDir() {
+			return fmt.Errorf("file '%s' not found", value)
+		}
+	}
+	if 0 < len(a.Choices) {
+		valid := false
+		for _, choice := range a.Choices {
+			if value == choice {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("'%s' is not one of %s", value, strings.Join(a.Choices, ", "))
+		}
+	}
+	if a.Pattern != "" {
+		pattern, err := regexp.Compile(a.Pattern)
+		if err != nil {
+			return err
+		}
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("'%s' does not match %s", value, a.Pattern)
+		}
+	}
+	return nil
+}
+
+// ValidateArguments - Check the argument schema of a manifest, any name is accepted
+// since the flags are only generated for the names that can be flags
+func ValidateArguments(args []*Argument) error {
+	names := map[string]bool{}
+	for _, arg := range args {
+		if strings.TrimSpace(arg.Name) == "" {
+			return errors.New("missing argument name")
+		}
+		if names[arg.Name] {
+			return fmt.Errorf("duplicate argument name: %s", arg.Name)
+		}
+		names[arg.Name] = true
+		switch arg.Type {
+		case ArgTypeInt, ArgTypeInteger, ArgTypeShort, ArgTypeBool:
+		case ArgTypeString, ArgTypeWString, ArgTypeFile:
+		default:
+			return fmt.Errorf("invalid argument type: %s", arg.Type)
+		}
+		if arg.Pattern != "" {
+			if _, err := regexp.Compile(arg.Pattern); err != nil {
+				return fmt.Errorf("invalid pattern for argument %s: %s", arg.Name, err)
+			}
+		}
+		if arg.Min != nil && arg.Max != nil && *arg.Max < *arg.Min {
+			return fmt.Errorf("invalid range for argument %s: %s", arg.Name, arg.rangeString())
+		}
+		// Files are checked when the command runs, the default may be relative to the working dir
+		if value, ok := arg.DefaultValue(); ok && arg.Type != ArgTypeFile {
+			if err := arg.Validate(value); err != nil {
+				return fmt.Errorf("invalid default for argument %s: %s", arg.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// AddArgumentFlags - Add a --<name> flag for each argument, arguments whose flag would
+// conflict with one of the command's flags (or another argument's) can only be passed
+// positionally
+func AddArgumentFlags(cmd *cobra.Command, args []*Argument) {
+	for _, arg := range args {
+		name := arg.FlagName()
+		if name == "" || name == "help" || cmd.Flags().Lookup(name) != nil {
+			continue
+		}
+		if arg.Type == ArgTypeBool {
+			cmd.Flags().Bool(name, false, arg.Usage())
+		} else {
+			cmd.Flags().String(name, "", arg.Usage())
+		}
+		cmd.Flags().SetAnnotation(name, argumentFlagAnnotation, []string{arg.Name})
+	}
+}
+
+// argumentFlag - The flag generated for the argument, nil if it can only be passed positionally
+func argumentFlag(cmd *cobra.Command, arg *Argument) *pflag.Flag {
+	flag := cmd.Flags().Lookup(arg.FlagName())
+	if flag == nil {
+		return nil
+	}
+	if names := flag.Annotations[argumentFlagAnnotation]; len(names) == 0 || names[0] != arg.Name {
+		return nil
+	}
+	return flag
+}
+
+// ArgumentCompletions - Completions for the positional arguments and flags of the arguments
+func ArgumentCompletions(cmd *cobra.Command, comps *carapace.Carapace, args []*Argument) {
+	positional := []carapace.Action{}
+	flags := carapace.ActionMap{}
+	for _, arg := range args {
+		action := argumentAction(arg)
+		positional = append(positional, action.Usage(arg.Usage()))
+		if arg.Type != ArgTypeBool && argumentFlag(cmd, arg) != nil {
+			flags[arg.FlagName()] = action
+		}
+	}
+	comps.PositionalCompletion(positional...)
+	comps.FlagCompletion(flags)
+}
+
+func argumentAction(arg *Argument) carapace.Action {
+	switch {
+	case 0 < len(arg.Choices):
+		return carapace.ActionValues(arg.Choices...).Tag(arg.Name)
+	case arg.Type == ArgTypeFile:
+		return carapace.ActionFiles().Tag(arg.Name)
+	case arg.Type == ArgTypeBool:
+		return carapace.ActionValues("true", "false").Tag(arg.Name)
+	}
+	return carapace.ActionValues()
+}
+
+// ArgumentsHelp - Help text listing the arguments
+func ArgumentsHelp(args []*Argument) string {
+	if len(args) == 0 {
+		return ""
+	}
+	names := []string{}
+	width := 0
+	for _, arg := range args {
+		name := arg.FlagName()
+		if name == "" {
+			name = arg.Name
+		}
+		names = append(names, name)
+		if width < len(name) {
+			width = len(name)
+		}
+	}
+	lines := []string{"Arguments (positional in this order, or --<name>):"}
+	for i, arg := range args {
+		lines = append(lines, fmt.Sprintf("  %-*s  %s", width, names[i], arg.Usage()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ParseArguments - Values of the arguments from their flags and the positional words, positional
+// words fill the arguments that weren't given as flags in order. Returns the values in schema
+// order and any positional words left over.
+func ParseArguments(cmd *cobra.Command, args []*Argument, words []string) ([]*ArgumentValue, []string, error) {
+	values := []*ArgumentValue{}
+	for _, arg := range args {
+		value := &ArgumentValue{Argument: arg}
+		if flag := argumentFlag(cmd, arg); flag != nil && flag.Changed {
+			value.Value = flag.Value.String()
+			value.Set = true
+		}
+		values = append(values, value)
+	}
+	for _, value := range values {
+		if value.Set || len(words) == 0 {
+			continue
+		}
+		value.Value = words[0]
+		value.Set = true
+		words = words[1:]
+	}
+
+	missing := []string{}
+	for _, value := range values {
+		if !value.Set {
+			if defaultValue, ok := value.Argument.DefaultValue(); ok {
+				value.Value = defaultValue
+				value.Set = true
+			} else if !value.Argument.Optional {
+				missing = append(missing, "`"+value.Argument.Name+"`")
+			}
+			continue
+		}
+		if err := value.Argument.Validate(value.Value); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s: %s", value.Argument.Name, err)
+		}
+	}
+	if 0 < len(missing) {
+		return nil, nil, fmt.Errorf("required arguments %s were not provided", strings.Join(missing, ", "))
+	}
+	return values, words, nil
+}
+
+// FormatArguments - A command line from the argument values followed by the extra words
+func FormatArguments(values []*ArgumentValue, extra []string) string {
+	parts := []string{}
+	for _, value := range values {
+		if !value.Set {
+			continue
+		}
+		format := value.Argument.Format
+		if value.Argument.Type == ArgTypeBool {
+			if enabled, _ := strconv.ParseBool(value.Value); !enabled {
+				continue
+			}
+			if format == "" {
+				format = "--" + value.Argument.FlagName()
+			}
+			parts = append(parts, format)
+			continue
+		}
+		if format == "" {
+			format = argValuePlaceholder
+		}
+		parts = append(parts, strings.ReplaceAll(format, argValuePlaceholder, quoteArgument(value.Value)))
+	}
+	return strings.Join(append(parts, extra...), " ")
+}
+
+// quoteArgument - Quote values containing whitespace so they're a single argument
+func quoteArgument(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t") {
+		return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+	}
+	return value
+}
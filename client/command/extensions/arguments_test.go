@@ -0,0 +1,158 @@
+package extensions
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+const sampleArguments = `[
+	{"name": "pid", "type": "int", "desc": "process id", "min": 1},
+	{"name": "dump type", "type": "string", "desc": "dump type", "choices": ["full", "mini"], "default": "mini", "format": "/type:{}"},
+	{"name": "verbose", "type": "bool", "desc": "verbose output", "optional": true, "format": "/v"},
+	{"name": "out", "type": "string", "desc": "output path", "optional": true}
+]`
+
+func parseSampleArguments(t *testing.T) []*Argument {
+	args := []*Argument{}
+	err := json.Unmarshal([]byte(sampleArguments), &args)
+	if err != nil {
+		t.Fatalf("Failed to parse arguments: %s", err)
+	}
+	err = ValidateArguments(args)
+	if err != nil {
+		t.Fatalf("Failed to validate arguments: %s", err)
+	}
+	return args
+}
+
+func argumentsCmd(args []*Argument, flags []string) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	AddArgumentFlags(cmd, args)
+	cmd.Flags().Parse(flags)
+	return cmd
+}
+
+func TestValidateArguments(t *testing.T) {
+	invalid := []string{
+		`[{"name": "pid", "type": "float"}]`,
+		`[{"name": "pid", "type": "int"}, {"name": "pid", "type": "string"}]`,
+		`[{"name": " ", "type": "int"}]`,
+		`[{"name": "pid", "type": "int", "default": "abc"}]`,
+		`[{"name": "pid", "type": "int", "min": 10, "max": 1}]`,
+		`[{"name": "mode", "type": "string", "choices": ["a", "b"], "default": "c"}]`,
+		`[{"name": "user", "type": "string", "pattern": "("}]`,
+	}
+	for _, sample := range invalid {
+		args := []*Argument{}
+		json.Unmarshal([]byte(sample), &args)
+		if err := ValidateArguments(args); err == nil {
+			t.Errorf("Expected error for %s", sample)
+		}
+	}
+
+	// Names of existing manifests, the ones that can't be flags are positional only
+	valid := `[{"name": "--pid", "type": "int"}, {"name": "PID", "type": "int"}, {"name": "host:port", "type": "string"}, {"name": "(ldap) query", "type": "string"}, {"name": "???", "type": "string"}]`
+	args := []*Argument{}
+	json.Unmarshal([]byte(valid), &args)
+	if err := ValidateArguments(args); err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+	cmd := argumentsCmd(args, nil)
+	for flag, arg := range map[string]*Argument{"pid": args[0], "host-port": args[2], "ldap-query": args[3]} {
+		if argumentFlag(cmd, arg) == nil || argumentFlag(cmd, arg).Name != flag {
+			t.Errorf("Expected flag --%s for argument '%s'", flag, arg.Name)
+		}
+	}
+	if argumentFlag(cmd, args[1]) != nil || argumentFlag(cmd, args[4]) != nil {
+		t.Errorf("Expected no flags for '%s' and '%s'", args[1].Name, args[4].Name)
+	}
+}
+
+func TestParseArgumentsCommandFlags(t *testing.T) {
+	args := []*Argument{{Name: "timeout", Type: ArgTypeInt}}
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().Int("timeout", 60, "command timeout in seconds")
+	AddArgumentFlags(cmd, args)
+	cmd.Flags().Parse([]string{"--timeout", "5"})
+
+	// The command's own flag is never read as the argument
+	if _, _, err := ParseArguments(cmd, args, nil); err == nil {
+		t.Errorf("Expected the argument to be missing")
+	}
+	values, _, err := ParseArguments(cmd, args, []string{"30"})
+	if err != nil || values[0].Value != "30" {
+		t.Errorf("Unexpected values %v (%v)", values, err)
+	}
+}
+
+func TestParseArguments(t *testing.T) {
+	args := parseSampleArguments(t)
+
+	// Positional
+	values, extra, err := ParseArguments(argumentsCmd(args, nil), args, []string{"1234"})
+	if err != nil {
+		t.Fatalf("Failed to parse arguments: %s", err)
+	}
+	if len(extra) != 0 || values[0].Value != "1234" || values[1].Value != "mini" || values[2].Set || values[3].Set {
+		t.Errorf("Unexpected values %v %v", values, extra)
+	}
+
+	// Flags take precedence, positional words fill the remaining arguments in order
+	cmd := argumentsCmd(args, []string{"--dump-type", "full", "--verbose"})
+	values, extra, err = ParseArguments(cmd, args, []string{"1234", "out.dmp", "extra"})
+	if err != nil {
+		t.Fatalf("Failed to parse arguments: %s", err)
+	}
+	if values[1].Value != "full" || values[2].Value != "true" || values[3].Value != "out.dmp" {
+		t.Errorf("Unexpected values %v", values)
+	}
+	if len(extra) != 1 || extra[0] != "extra" {
+		t.Errorf("Unexpected extra words %v", extra)
+	}
+	cmdLine := FormatArguments(values, extra)
+	if cmdLine != "1234 /type:full /v out.dmp extra" {
+		t.Errorf("Unexpected command line '%s'", cmdLine)
+	}
+
+	// Validation
+	for _, words := range [][]string{{}, {"abc"}, {"0"}, {"1234", "huge"}} {
+		_, _, err = ParseArguments(argumentsCmd(args, nil), args, words)
+		if err == nil {
+			t.Errorf("Expected error for %v", words)
+		}
+	}
+	_, _, err = ParseArguments(argumentsCmd(args, []string{"--pid", "99999999999"}), args, nil)
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("Expected out of range error, got %v", err)
+	}
+}
+
+func TestFormatArgumentsQuoting(t *testing.T) {
+	args := []*Argument{{Name: "path", Type: ArgTypeString, Format: "/path:{}"}}
+	values := []*ArgumentValue{{Argument: args[0], Value: `C:\Program Files\x`, Set: true}}
+	cmdLine := FormatArguments(values, nil)
+	if cmdLine != `/path:"C:\Program Files\x"` {
+		t.Errorf("Unexpected command line '%s'", cmdLine)
+	}
+}
@@ -56,19 +56,22 @@ const (
 var loadedExtensions = map[string]*ExtensionManifest{}
 
 type ExtensionManifest struct {
-	Name            string               `json:"name"`
-	CommandName     string               `json:"command_name"`
-	Version         string               `json:"version"`
-	ExtensionAuthor string               `json:"extension_author"`
-	OriginalAuthor  string               `json:"original_author"`
-	RepoURL         string               `json:"repo_url"`
-	Help            string               `json:"help"`
-	LongHelp        string               `json:"long_help"`
-	Files           []*extensionFile     `json:"files"`
-	Arguments       []*extensionArgument `json:"arguments"`
-	Entrypoint      string               `json:"entrypoint"`
-	DependsOn       string               `json:"depends_on"`
-	Init            string               `json:"init"`
+	Name            string           `json:"name"`
+	CommandName     string           `json:"command_name"`
+	Version         string           `json:"version"`
+	ExtensionAuthor string           `json:"extension_author"`
+	OriginalAuthor  string           `json:"original_author"`
+	RepoURL         string           `json:"repo_url"`
+	Help            string           `json:"help"`
+	LongHelp        string           `json:"long_help"`
+	Files           []*extensionFile `json:"files"`
+	Arguments       []*Argument      `json:"arguments"`
+	Entrypoint      string           `json:"entrypoint"`
+	DependsOn       string           `json:"depends_on"`
+	Init            string           `json:"init"`
+
+	Dependencies     []string `json:"dependencies"`
+	MinSliverVersion string   `json:"min_sliver_version"`
 
 	RootPath string `json:"-"`
 }
@@ -79,13 +82,6 @@ type extensionFile struct {
 	Path string `json:"path"`
 }
 
-type extensionArgument struct {
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	Desc     string `json:"desc"`
-	Optional bool   `json:"optional"`
-}
-
 func (e *ExtensionManifest) getFileForTarget(cmdName string, targetOS string, targetArch string) (string, error) {
 	filePath := ""
 	for _, extFile := range e.Files {
@@ -188,7 +184,7 @@ func ExtensionRegisterCommand(extCmd *ExtensionManifest, cmd *cobra.Command, con
 	extensionCmd := &cobra.Command{
 		Use:   extCmd.CommandName,
 		Short: helpMsg,
-		Long:  help.FormatHelpTmpl(extCmd.LongHelp),
+		Long:  extensionLongHelp(extCmd),
 		Run: func(cmd *cobra.Command, args []string) {
 			runExtensionCmd(cmd, con, args)
 		},
@@ -201,11 +197,12 @@ func ExtensionRegisterCommand(extCmd *ExtensionManifest, cmd *cobra.Command, con
 	f.BoolP("save", "s", false, "Save output to disk")
 	f.IntP("timeout", "t", defaultTimeout, "command timeout in seconds")
 	extensionCmd.Flags().AddFlagSet(f)
+	AddArgumentFlags(extensionCmd, extCmd.Arguments)
 	extensionCmd.Flags().ParseErrorsWhitelist.UnknownFlags = true
 
 	// Completions
 	comps := carapace.Gen(extensionCmd)
-	makeExtensionArgCompleter(extCmd, cmd, comps)
+	ArgumentCompletions(extensionCmd, comps, extCmd.Arguments)
 
 	cmd.AddCommand(extensionCmd)
 }
@@ -352,6 +349,14 @@ func runExtensionCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	} else {
 		// Regular DLL
 		extArgs := strings.Join(args, " ")
+		if 0 < len(ext.Arguments) {
+			values, extra, err := ParseArguments(cmd, ext.Arguments, args)
+			if err != nil {
+				con.PrintErrorf("%s\n", err)
+				return
+			}
+			extArgs = FormatArguments(values, extra)
+		}
 		extensionArgs = []byte(extArgs)
 		extName = ext.CommandName
 		entryPoint = ext.Entrypoint
@@ -414,29 +419,23 @@ func getBOFArgs(cmd *cobra.Command, args []string, binPath string, ext *Extensio
 		Buffer: new(bytes.Buffer),
 	}
 
-	// Parse BOF arguments from grumble
-	missingRequiredArgs := make([]string, 0)
-
-	for _, arg := range ext.Arguments {
-		// If we don't have any positional words left to consume,
-		// add the remaining required extension arguments in the
-		// error message.
-		if len(args) == 0 {
-			if !arg.Optional {
-				missingRequiredArgs = append(missingRequiredArgs, "`"+arg.Name+"`")
-			}
+	values, extra, err := ParseArguments(cmd, ext.Arguments, args)
+	if err != nil {
+		return nil, err
+	}
+	if 0 < len(extra) {
+		return nil, errTooManyArguments
+	}
+	for _, value := range values {
+		// Optional arguments that weren't given are not packed
+		if !value.Set {
 			continue
 		}
-
-		// Else pop a word from the list
-		word := args[0]
-		args = args[1:]
-
-		switch arg.Type {
-		case "integer":
+		switch value.Argument.Type {
+		case ArgTypeInteger:
 			fallthrough
-		case "int":
-			val, err := strconv.Atoi(word)
+		case ArgTypeInt:
+			val, err := strconv.ParseInt(value.Value, 0, 64)
 			if err != nil {
 				return nil, err
 			}
@@ -444,8 +443,8 @@ func getBOFArgs(cmd *cobra.Command, args []string, binPath string, ext *Extensio
 			if err != nil {
 				return nil, err
 			}
-		case "short":
-			val, err := strconv.Atoi(word)
+		case ArgTypeShort:
+			val, err := strconv.ParseInt(value.Value, 0, 64)
 			if err != nil {
 				return nil, err
 			}
@@ -453,20 +452,30 @@ func getBOFArgs(cmd *cobra.Command, args []string, binPath string, ext *Extensio
 			if err != nil {
 				return nil, err
 			}
-		case "string":
-			err = argsBuffer.AddString(word)
+		case ArgTypeBool:
+			val, _ := strconv.ParseBool(value.Value)
+			packed := uint32(0)
+			if val {
+				packed = 1
+			}
+			err = argsBuffer.AddInt(packed)
 			if err != nil {
 				return nil, err
 			}
-		case "wstring":
-			err = argsBuffer.AddWString(word)
+		case ArgTypeString:
+			err = argsBuffer.AddString(value.Value)
+			if err != nil {
+				return nil, err
+			}
+		case ArgTypeWString:
+			err = argsBuffer.AddWString(value.Value)
 			if err != nil {
 				return nil, err
 			}
 		// Adding support for filepaths so we can
 		// send binary data like shellcodes to BOFs
-		case "file":
-			data, err := os.ReadFile(word)
+		case ArgTypeFile:
+			data, err := os.ReadFile(value.Value)
 			if err != nil {
 				return nil, err
 			}
@@ -477,11 +486,6 @@ func getBOFArgs(cmd *cobra.Command, args []string, binPath string, ext *Extensio
 		}
 	}
 
-	// Return if we have missing required arguments
-	if len(missingRequiredArgs) > 0 {
-		return nil, fmt.Errorf("required arguments %s were not provided", strings.Join(missingRequiredArgs, ", "))
-	}
-
 	parsedArgs, err := argsBuffer.GetBuffer()
 	if err != nil {
 		return nil, err
@@ -519,43 +523,21 @@ func CmdExists(name string, cmd *cobra.Command) bool {
 	return false
 }
 
-// makeExtensionArgParser builds the valid positional arguments cobra handler for the extension.
+// checkExtensionArgs - Check the extension's argument schema
 func checkExtensionArgs(extCmd *ExtensionManifest) error {
-	if 0 < len(extCmd.Arguments) {
-		for _, arg := range extCmd.Arguments {
-			switch arg.Type {
-			case "int", "integer", "short":
-			case "string", "wstring", "file":
-			default:
-				return fmt.Errorf("invalid argument type: %s", arg.Type)
-			}
-		}
-	}
-
-	return nil
+	return ValidateArguments(extCmd.Arguments)
 }
 
-// makeExtensionArgCompleter builds the positional arguments completer for the extension.
-func makeExtensionArgCompleter(extCmd *ExtensionManifest, _ *cobra.Command, comps *carapace.Carapace) {
-	var actions []carapace.Action
-
-	for _, arg := range extCmd.Arguments {
-		var action carapace.Action
-
-		switch arg.Type {
-		case "file":
-			action = carapace.ActionFiles().Tag("extension data")
+// extensionLongHelp - The manifest's long help followed by the arguments
+func extensionLongHelp(extCmd *ExtensionManifest) string {
+	longHelp := help.FormatHelpTmpl(extCmd.LongHelp)
+	if argsHelp := ArgumentsHelp(extCmd.Arguments); argsHelp != "" {
+		if longHelp != "" {
+			longHelp += "\n\n"
 		}
-
-		usage := fmt.Sprintf("(%s) %s", arg.Type, arg.Desc)
-		if arg.Optional {
-			usage += " (optional)"
-		}
-
-		actions = append(actions, action.Usage(usage))
+		longHelp += argsHelp
 	}
-
-	comps.PositionalCompletion(actions...)
+	return longHelp
 }
 
 func makeCommandPlatformFilters(extCmd *ExtensionManifest) map[string]string {
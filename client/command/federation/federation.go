@@ -0,0 +1,290 @@
+package federation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+// FederationCmd - List linked servers
+func FederationCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	links, err := con.Rpc.FederationLinks(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(links.Links) == 0 {
+		con.PrintInfof("No linked servers\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Host",
+		"Operator",
+		"Relay Events",
+		"Status",
+		"Linked",
+	})
+	for _, link := range links.Links {
+		status := console.Green + "connected" + console.Normal
+		if !link.Connected {
+			status = console.Red + "disconnected" + console.Normal
+			if link.Err != "" {
+				status += fmt.Sprintf(" (%s)", link.Err)
+			}
+		}
+		tw.AppendRow(table.Row{
+			link.Name,
+			link.Host,
+			link.Operator,
+			link.RelayEvents,
+			status,
+			time.Unix(link.CreatedAt, 0).Format(time.RFC1123),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// FederationLinkCmd - Link a server using one of its operator configs
+func FederationLinkCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	relayEvents, _ := cmd.Flags().GetBool("relay-events")
+	configData, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Linking server, this may take a few seconds ...\n")
+	link, err := con.Rpc.FederationLinkAdd(context.Background(), &clientpb.FederationLinkReq{
+		Name:        name,
+		Config:      configData,
+		RelayEvents: relayEvents,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Linked server %s (%s)\n", link.Name, link.Host)
+}
+
+// FederationUnlinkCmd - Remove a linked server
+func FederationUnlinkCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	_, err := con.Rpc.FederationLinkRm(context.Background(), &clientpb.FederationLinkReq{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Unlinked server %s\n", args[0])
+}
+
+// FederationSessionsCmd - List the sessions of linked servers
+func FederationSessionsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	view := federationView(cmd, con, &clientpb.FederationReq{Sessions: true})
+	if view == nil {
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Server", "ID", "Name", "Transport", "Remote Address", "Hostname", "Username", "Operating System", "Last Check-In"})
+	for _, server := range view.Servers {
+		for _, session := range server.Sessions {
+			tw.AppendRow(table.Row{
+				server.Name,
+				strings.Split(session.ID, "-")[0],
+				session.Name,
+				session.Transport,
+				session.RemoteAddress,
+				session.Hostname,
+				session.Username,
+				fmt.Sprintf("%s/%s", session.OS, session.Arch),
+				time.Unix(session.LastCheckin, 0).Format(time.RFC1123),
+			})
+		}
+	}
+	printTable(tw, "sessions", con)
+}
+
+// FederationBeaconsCmd - List the beacons of linked servers
+func FederationBeaconsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	view := federationView(cmd, con, &clientpb.FederationReq{Beacons: true})
+	if view == nil {
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Server", "ID", "Name", "Transport", "Remote Address", "Hostname", "Username", "Operating System", "Last Check-In", "Next Check-In"})
+	for _, server := range view.Servers {
+		for _, beacon := range server.Beacons {
+			tw.AppendRow(table.Row{
+				server.Name,
+				strings.Split(beacon.ID, "-")[0],
+				beacon.Name,
+				beacon.Transport,
+				beacon.RemoteAddress,
+				beacon.Hostname,
+				beacon.Username,
+				fmt.Sprintf("%s/%s", beacon.OS, beacon.Arch),
+				time.Unix(beacon.LastCheckin, 0).Format(time.RFC1123),
+				time.Unix(beacon.NextCheckin, 0).Format(time.RFC1123),
+			})
+		}
+	}
+	printTable(tw, "beacons", con)
+}
+
+// FederationLootCmd - List the loot of linked servers
+func FederationLootCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	view := federationView(cmd, con, &clientpb.FederationReq{Loot: true})
+	if view == nil {
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Server", "ID", "Name", "File Name", "Size", "Tags"})
+	for _, server := range view.Servers {
+		for _, lootItem := range server.Loot {
+			fileName := ""
+			if lootItem.File != nil {
+				fileName = lootItem.File.Name
+			}
+			tw.AppendRow(table.Row{
+				server.Name,
+				strings.Split(lootItem.ID, "-")[0],
+				lootItem.Name,
+				fileName,
+				util.ByteCountBinary(lootItem.Size),
+				strings.Join(lootItem.Tags, ", "),
+			})
+		}
+	}
+	printTable(tw, "loot", con)
+}
+
+// FederationFetchCmd - Fetch loot from a linked server
+func FederationFetchCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	local, _ := cmd.Flags().GetBool("local")
+	lootID, err := lootIDByPrefix(con, args[0], args[1])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	lootContent, err := con.Rpc.FederationLootContent(context.Background(), &clientpb.FederationLootReq{
+		Name:      args[0],
+		LootID:    lootID,
+		SaveLocal: local,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if local {
+		con.PrintInfof("Replicated loot to the local loot store as %s\n", strings.Split(lootContent.ID, "-")[0])
+	}
+	if save, _ := cmd.Flags().GetString("save"); save != "" {
+		savedTo, err := loot.SaveLootToDisk(cmd, lootContent)
+		if err != nil {
+			con.PrintErrorf("Failed to save loot %s\n", err)
+		}
+		if savedTo != "" {
+			con.PrintInfof("Saved loot to %s\n", savedTo)
+		}
+		return
+	}
+	loot.PrintLootFile(lootContent, con)
+}
+
+// lootIDByPrefix - Loot tables show the short ID, expand it to the full ID
+func lootIDByPrefix(con *console.SliverConsoleClient, server string, prefix string) (string, error) {
+	view, err := con.Rpc.FederationView(context.Background(), &clientpb.FederationReq{Name: server, Loot: true})
+	if err != nil {
+		return "", err
+	}
+	for _, federated := range view.Servers {
+		if federated.Err != "" {
+			return "", fmt.Errorf("%s: %s", federated.Name, federated.Err)
+		}
+		for _, lootItem := range federated.Loot {
+			if strings.HasPrefix(lootItem.ID, prefix) {
+				return lootItem.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no loot with id '%s' on %s", prefix, server)
+}
+
+func federationView(cmd *cobra.Command, con *console.SliverConsoleClient, req *clientpb.FederationReq) *clientpb.FederationView {
+	req.Name, _ = cmd.Flags().GetString("server")
+	view, err := con.Rpc.FederationView(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
+	if len(view.Servers) == 0 {
+		con.PrintInfof("No linked servers\n")
+		return nil
+	}
+	for _, server := range view.Servers {
+		if server.Err != "" {
+			con.PrintWarnf("%s: %s\n", server.Name, server.Err)
+		}
+	}
+	return view
+}
+
+func printTable(tw table.Writer, what string, con *console.SliverConsoleClient) {
+	if tw.Length() == 0 {
+		con.PrintInfof("No %s on linked servers\n", what)
+		return
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// LinkNameCompleter - Completes the names of linked servers
+func LinkNameCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		links, err := con.Rpc.FederationLinks(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to get linked servers: %s", err)
+		}
+		for _, link := range links.Links {
+			results = append(results, link.Name)
+			results = append(results, link.Host)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("linked servers")
+	}
+	return carapace.ActionCallback(callback)
+}
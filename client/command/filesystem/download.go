@@ -20,6 +20,7 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -48,13 +49,30 @@ func DownloadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 	remotePath := args[0]
 	recurse, _ := cmd.Flags().GetBool("recurse")
 
-	ctrl := make(chan bool)
-	con.SpinUntil(fmt.Sprintf("Downloading %s ...", remotePath), ctrl)
-	download, err := con.Rpc.Download(context.Background(), &sliverpb.DownloadReq{
+	req := &sliverpb.DownloadReq{
 		Request: con.ActiveTarget.Request(cmd),
 		Path:    remotePath,
 		Recurse: recurse,
-	})
+	}
+	if session != nil {
+		background, _ := cmd.Flags().GetBool("background")
+		localPath := "."
+		if 1 < len(args) {
+			localPath = args[1]
+		}
+		id := transfers.start(downloadDirection, session, remotePath, localPath, 0, background)
+		if background {
+			con.PrintInfof("Started transfer %d in the background, see 'transfers'\n", id)
+			go sessionDownload(id, req, cmd, args, con)
+		} else {
+			sessionDownload(id, req, cmd, args, con)
+		}
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Downloading %s ...", remotePath), ctrl)
+	download, err := con.Rpc.Download(context.Background(), req)
 	ctrl <- true
 	<-ctrl
 	if err != nil {
@@ -76,6 +94,34 @@ func DownloadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 	}
 }
 
+// sessionDownload - Download a file from a session, tracked as a transfer
+func sessionDownload(id int, req *sliverpb.DownloadReq, cmd *cobra.Command, args []string, con *console.SliverConsoleClient) {
+	started, _ := transfers.get(id)
+	var ctrl chan bool
+	if !started.Background {
+		ctrl = make(chan bool)
+		con.SpinUntilFunc(transferMessage(id), ctrl)
+	}
+	download, err := con.Rpc.Download(context.Background(), req)
+	if err == nil && download.Response != nil && download.Response.Err != "" {
+		err = errors.New(download.Response.Err)
+	}
+	if err == nil && download.Encoder == "gzip" {
+		// Decode here so the transfer's size is the size of the file(s)
+		download.Data, err = new(encoders.Gzip).Decode(download.Data)
+		download.Encoder = ""
+	}
+	finished, _ := transfers.finish(id, int64(len(download.GetData())), err)
+	if ctrl != nil {
+		ctrl <- true
+		<-ctrl
+	}
+	if err == nil {
+		HandleDownloadResponse(download, cmd, args, con)
+	}
+	printTransferDone(finished, con)
+}
+
 func prettifyDownloadName(path string) string {
 	nonAlphaNumericRegex, err := regexp.Compile("[^a-zA-Z0-9]+")
 	if err != nil {
@@ -177,11 +223,17 @@ func HandleDownloadResponse(download *sliverpb.Download, cmd *cobra.Command, arg
 		}
 
 		if _, err := os.Stat(dst); err == nil {
-			overwrite := false
-			prompt := &survey.Confirm{Message: "Overwrite local file?"}
-			survey.AskOne(prompt, &overwrite, nil)
-			if !overwrite {
-				return
+			if background, _ := cmd.Flags().GetBool("background"); background {
+				// We can't prompt while the console is in use, so don't overwrite
+				ext := filepath.Ext(dst)
+				dst = fmt.Sprintf("%s_%d%s", strings.TrimSuffix(dst, ext), time.Now().Unix(), ext)
+			} else {
+				overwrite := false
+				prompt := &survey.Confirm{Message: "Overwrite local file?"}
+				survey.AskOne(prompt, &overwrite, nil)
+				if !overwrite {
+					return
+				}
 			}
 		}
 
@@ -0,0 +1,280 @@
+package filesystem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+// Implants send a file in a single message so the progress of a transfer can't be
+// observed while it's in flight, instead progress and ETA are estimated from the
+// throughput of the session's previous transfers.
+
+const (
+	uploadDirection   = "upload"
+	downloadDirection = "download"
+
+	// maxFinishedTransfers - Finished transfers kept for the transfers command
+	maxFinishedTransfers = 25
+	progressBarWidth     = 20
+)
+
+var transfers = &transferTracker{
+	mutex:     &sync.Mutex{},
+	transfers: []*transfer{},
+	rates:     map[string]float64{},
+}
+
+// transfer - An upload or download on a session, sizes are of the file(s)
+// before compression
+type transfer struct {
+	ID          int
+	Direction   string
+	SessionID   string
+	SessionName string
+	Src         string
+	Dst         string
+	Size        int64 // Known up front for uploads, once finished for downloads
+	Background  bool
+	Started     time.Time
+	Finished    time.Time
+	Err         error
+
+	expectedRate float64 // Bytes per second, 0 if there's no previous transfer
+}
+
+type transferTracker struct {
+	mutex     *sync.Mutex
+	nextID    int
+	transfers []*transfer
+	rates     map[string]float64 // SessionID -> Bytes per second
+}
+
+// start - Track a new transfer, returns its ID
+func (t *transferTracker) start(direction string, session *clientpb.Session, src string, dst string, size int64, background bool) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.nextID++
+	t.transfers = append(t.transfers, &transfer{
+		ID:           t.nextID,
+		Direction:    direction,
+		SessionID:    session.ID,
+		SessionName:  session.Name,
+		Src:          src,
+		Dst:          dst,
+		Size:         size,
+		Background:   background,
+		Started:      time.Now(),
+		expectedRate: t.rates[session.ID],
+	})
+	return t.nextID
+}
+
+// finish - Mark a transfer as done, successful transfers update the session's throughput
+func (t *transferTracker) finish(id int, size int64, err error) (transfer, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, current := range t.transfers {
+		if current.ID != id {
+			continue
+		}
+		current.Finished = time.Now()
+		current.Err = err
+		if err == nil {
+			current.Size = size
+			if elapsed := current.Finished.Sub(current.Started).Seconds(); 0 < size && 0 < elapsed {
+				rate := float64(size) / elapsed
+				if previous, ok := t.rates[current.SessionID]; ok {
+					rate = (rate + previous) / 2
+				}
+				t.rates[current.SessionID] = rate
+			}
+		}
+		t.prune()
+		return *current, true
+	}
+	return transfer{}, false
+}
+
+// prune - Forget about the oldest finished transfers
+func (t *transferTracker) prune() {
+	finished := 0
+	for index := len(t.transfers) - 1; 0 <= index; index-- {
+		if t.transfers[index].Finished.IsZero() {
+			continue
+		}
+		finished++
+		if maxFinishedTransfers < finished {
+			t.transfers = append(t.transfers[:index], t.transfers[index+1:]...)
+		}
+	}
+}
+
+func (t *transferTracker) get(id int) (transfer, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, current := range t.transfers {
+		if current.ID == id {
+			return *current, true
+		}
+	}
+	return transfer{}, false
+}
+
+func (t *transferTracker) list() []transfer {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	all := []transfer{}
+	for _, current := range t.transfers {
+		all = append(all, *current)
+	}
+	return all
+}
+
+// elapsed - Time the transfer took, or has taken so far
+func (t transfer) elapsed() time.Duration {
+	if t.Finished.IsZero() {
+		return time.Since(t.Started)
+	}
+	return t.Finished.Sub(t.Started)
+}
+
+// estimate - Estimated progress (0-1) and time remaining of a running transfer,
+// ok is false if there's not enough information to estimate
+func (t transfer) estimate() (float64, time.Duration, bool) {
+	if t.Size <= 0 || t.expectedRate <= 0 {
+		return 0, 0, false
+	}
+	total := time.Duration(float64(t.Size) / t.expectedRate * float64(time.Second))
+	elapsed := t.elapsed()
+	if total <= elapsed {
+		return 0.99, 0, true // Slower than expected, but we know it's not done
+	}
+	return float64(elapsed) / float64(total), total - elapsed, true
+}
+
+// rate - The measured rate of a finished transfer, or the expected rate of a running one
+func (t transfer) rate() float64 {
+	if t.Finished.IsZero() {
+		return t.expectedRate
+	}
+	if seconds := t.elapsed().Seconds(); 0 < seconds {
+		return float64(t.Size) / seconds
+	}
+	return 0
+}
+
+// progress - One line description of the transfer's progress
+func (t transfer) progress() string {
+	if !t.Finished.IsZero() {
+		return fmt.Sprintf("%s in %s (%s/s)", util.ByteCountBinary(t.Size), formatDuration(t.elapsed()), util.ByteCountBinary(int64(t.rate())))
+	}
+	if fraction, eta, ok := t.estimate(); ok {
+		filled := int(fraction * progressBarWidth)
+		return fmt.Sprintf("[%s%s] %2d%% of %s ~%s/s ETA %s",
+			strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled), int(fraction*100),
+			util.ByteCountBinary(t.Size), util.ByteCountBinary(int64(t.expectedRate)), formatDuration(eta))
+	}
+	if 0 < t.Size {
+		return fmt.Sprintf("%s, %s elapsed", util.ByteCountBinary(t.Size), formatDuration(t.elapsed()))
+	}
+	return fmt.Sprintf("%s elapsed", formatDuration(t.elapsed()))
+}
+
+func (t transfer) status() string {
+	switch {
+	case t.Finished.IsZero():
+		return console.Bold + "running" + console.Normal
+	case t.Err != nil:
+		return console.Red + "failed" + console.Normal
+	default:
+		return console.Green + "done" + console.Normal
+	}
+}
+
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+// transferMessage - Spinner message for a foreground transfer
+func transferMessage(id int) func() string {
+	return func() string {
+		current, ok := transfers.get(id)
+		if !ok {
+			return ""
+		}
+		return fmt.Sprintf("%s -> %s %s", current.Src, current.Dst, current.progress())
+	}
+}
+
+// printTransferDone - Print the result of a finished transfer, background transfers
+// say which transfer finished since the operator may have moved on
+func printTransferDone(finished transfer, con *console.SliverConsoleClient) {
+	if finished.Background {
+		if finished.Err != nil {
+			con.PrintErrorf("Transfer %d (%s %s) failed: %s\n", finished.ID, finished.Direction, finished.Src, finished.Err)
+			return
+		}
+		con.PrintInfof("Transfer %d (%s %s -> %s) finished, %s\n", finished.ID, finished.Direction, finished.Src, finished.Dst, finished.progress())
+		return
+	}
+	if finished.Err != nil {
+		con.PrintErrorf("%s\n", finished.Err)
+		return
+	}
+	con.PrintInfof("Transferred %s\n", finished.progress())
+}
+
+// TransfersCmd - List running and recent uploads/downloads
+func TransfersCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	all := transfers.list()
+	if len(all) == 0 {
+		con.PrintInfof("No transfers\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"ID", "Session", "Direction", "Source", "Destination", "Progress", "Status"})
+	for _, current := range all {
+		tw.AppendRow(table.Row{
+			current.ID,
+			current.SessionName,
+			current.Direction,
+			current.Src,
+			current.Dst,
+			current.progress(),
+			current.status(),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
@@ -0,0 +1,68 @@
+package filesystem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestTransferTracker(t *testing.T) {
+	tracker := &transferTracker{mutex: &sync.Mutex{}, transfers: []*transfer{}, rates: map[string]float64{}}
+	session := &clientpb.Session{ID: "abcd", Name: "WISE_OTTER"}
+
+	first := tracker.start(uploadDirection, session, "a", "b", 1024, false)
+	current, _ := tracker.get(first)
+	if _, _, ok := current.estimate(); ok {
+		t.Errorf("expected no estimate without a previous transfer")
+	}
+	tracker.transfers[0].Started = time.Now().Add(-2 * time.Second)
+	tracker.finish(first, 1024, nil)
+	if rate := tracker.rates[session.ID]; rate < 400 || 600 < rate {
+		t.Errorf("unexpected rate %f", rate)
+	}
+
+	second := tracker.start(uploadDirection, session, "a", "b", 4096, false)
+	current, _ = tracker.get(second)
+	fraction, eta, ok := current.estimate()
+	if !ok || 0.1 < fraction || eta < 7*time.Second {
+		t.Errorf("unexpected estimate %f %s %v", fraction, eta, ok)
+	}
+
+	rate := tracker.rates[session.ID]
+	tracker.finish(second, 0, errors.New("failed"))
+	if tracker.rates[session.ID] != rate {
+		t.Errorf("failed transfers should not change the rate")
+	}
+
+	for i := 0; i < maxFinishedTransfers+5; i++ {
+		tracker.finish(tracker.start(downloadDirection, session, "a", "b", 0, true), 1, nil)
+	}
+	running := tracker.start(downloadDirection, session, "a", "b", 0, true)
+	if len(tracker.list()) != maxFinishedTransfers+1 {
+		t.Errorf("expected %d transfers, got %d", maxFinishedTransfers+1, len(tracker.list()))
+	}
+	if _, ok := tracker.get(running); !ok {
+		t.Errorf("running transfer was pruned")
+	}
+}
@@ -20,6 +20,7 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -79,16 +80,29 @@ func UploadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []stri
 	}
 	uploadGzip, _ := new(encoders.Gzip).Encode(fileBuf)
 
-	ctrl := make(chan bool)
-	con.SpinUntil(fmt.Sprintf("%s -> %s", src, dst), ctrl)
-	upload, err := con.Rpc.Upload(context.Background(), &sliverpb.UploadReq{
+	req := &sliverpb.UploadReq{
 		Request:  con.ActiveTarget.Request(cmd),
 		Path:     dst,
 		Data:     uploadGzip,
 		Encoder:  "gzip",
 		IsIOC:    isIOC,
 		FileName: fileName,
-	})
+	}
+	if session != nil {
+		background, _ := cmd.Flags().GetBool("background")
+		id := transfers.start(uploadDirection, session, src, dst, int64(len(fileBuf)), background)
+		if background {
+			con.PrintInfof("Started transfer %d in the background, see 'transfers'\n", id)
+			go sessionUpload(id, req, int64(len(fileBuf)), con)
+		} else {
+			sessionUpload(id, req, int64(len(fileBuf)), con)
+		}
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("%s -> %s", src, dst), ctrl)
+	upload, err := con.Rpc.Upload(context.Background(), req)
 	ctrl <- true
 	<-ctrl
 	if err != nil {
@@ -110,6 +124,29 @@ func UploadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []stri
 	}
 }
 
+// sessionUpload - Upload a file to a session, tracked as a transfer
+func sessionUpload(id int, req *sliverpb.UploadReq, size int64, con *console.SliverConsoleClient) {
+	started, _ := transfers.get(id)
+	var ctrl chan bool
+	if !started.Background {
+		ctrl = make(chan bool)
+		con.SpinUntilFunc(transferMessage(id), ctrl)
+	}
+	upload, err := con.Rpc.Upload(context.Background(), req)
+	if err == nil && upload.Response != nil && upload.Response.Err != "" {
+		err = errors.New(upload.Response.Err)
+	}
+	finished, _ := transfers.finish(id, size, err)
+	if ctrl != nil {
+		ctrl <- true
+		<-ctrl
+	}
+	if err == nil && !finished.Background {
+		PrintUpload(upload, con)
+	}
+	printTransferDone(finished, con)
+}
+
 // PrintUpload - Print the result of the upload command
 func PrintUpload(upload *sliverpb.Upload, con *console.SliverConsoleClient) {
 	if upload.Response != nil && upload.Response.Err != "" {
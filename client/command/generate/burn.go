@@ -0,0 +1,69 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// BurnCmd - Mark an implant build as compromised so listeners only serve it decoy content
+func BurnCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	unburn, _ := cmd.Flags().GetBool("unburn")
+
+	var name string
+	if 0 < len(args) {
+		name = args[0]
+	} else if session, beacon := con.ActiveTarget.Get(); session != nil {
+		name = session.Name
+	} else if beacon != nil {
+		name = beacon.Name
+	}
+	if name == "" {
+		con.PrintErrorf("No implant name specified and no session or beacon selected\n")
+		return
+	}
+	if !unburn {
+		confirm := false
+		prompt := &survey.Confirm{Message: fmt.Sprintf("Burn '%s'? All listeners will stop serving this implant", name)}
+		survey.AskOne(prompt, &confirm)
+		if !confirm {
+			return
+		}
+	}
+	_, err := con.Rpc.BurnImplant(context.Background(), &clientpb.BurnReq{
+		Name:   name,
+		Unburn: unburn,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if unburn {
+		con.PrintInfof("Removed %s from the burn list\n", name)
+	} else {
+		con.PrintInfof("Burned %s, listeners will only serve decoy content to it\n", name)
+	}
+}
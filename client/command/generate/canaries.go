@@ -3,8 +3,12 @@ package generate
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
 	"github.com/spf13/cobra"
 
 	"github.com/bishopfox/sliver/client/command/settings"
@@ -20,14 +24,84 @@ func CanariesCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 		con.PrintErrorf("Failed to list canaries %s", err)
 		return
 	}
+	tokens, err := con.Rpc.CanaryTokens(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("Failed to list canary tokens %s", err)
+		return
+	}
+	burnedOnly, _ := cmd.Flags().GetBool("burned")
 	if 0 < len(canaries.Canaries) {
-		burnedOnly, _ := cmd.Flags().GetBool("burned")
 		PrintCanaries(con, canaries.Canaries, burnedOnly)
-	} else {
+	}
+	if 0 < len(tokens.Tokens) {
+		PrintCanaryTokens(con, tokens.Tokens, burnedOnly)
+	}
+	if len(canaries.Canaries) == 0 && len(tokens.Tokens) == 0 {
 		con.PrintInfof("No canaries in database\n")
 	}
 }
 
+// CanaryTokenCmd - Generate an http, aws, or document canary
+func CanaryTokenCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	save, _ := cmd.Flags().GetString("save")
+	canaryType := cmd.Name()
+	token, err := con.Rpc.CanaryTokenNew(context.Background(), &clientpb.CanaryTokenReq{
+		Type: canaryType,
+		Name: name,
+		URL:  args[0],
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	shortID := strings.Split(token.ID, "-")[0]
+	con.PrintInfof("Generated %s canary %s\n", token.Type, shortID)
+	if token.File == nil {
+		con.PrintInfof("Canary URL: %s\n", token.URL)
+		return
+	}
+	if save == "" {
+		save = token.File.Name
+	}
+	if fi, err := os.Stat(save); err == nil && fi.IsDir() {
+		save = filepath.Join(save, token.File.Name)
+	}
+	err = os.WriteFile(save, token.File.Data, 0o600)
+	if err != nil {
+		con.PrintErrorf("Failed to save canary: %s\n", err)
+		return
+	}
+	if token.AccessKeyID != "" {
+		con.PrintInfof("Access key ID %s, endpoint %s\n", token.AccessKeyID, token.URL)
+	} else {
+		con.PrintInfof("Canary URL: %s\n", token.URL)
+	}
+	con.PrintInfof("Saved to %s\n", save)
+}
+
+// CanaryTokenRmCmd - Remove a canary token
+func CanaryTokenRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	tokens, err := con.Rpc.CanaryTokens(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, token := range tokens.Tokens {
+		if !strings.HasPrefix(token.ID, args[0]) {
+			continue
+		}
+		_, err = con.Rpc.CanaryTokenRm(context.Background(), token)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Removed canary %s\n", strings.Split(token.ID, "-")[0])
+		return
+	}
+	con.PrintErrorf("No canary with ID %s\n", args[0])
+}
+
 // PrintCanaries - Print the canaries tracked by the server
 func PrintCanaries(con *console.SliverConsoleClient, canaries []*clientpb.DNSCanary, burnedOnly bool) {
 	tw := table.NewWriter()
@@ -64,3 +138,62 @@ func PrintCanaries(con *console.SliverConsoleClient, canaries []*clientpb.DNSCan
 	}
 	con.Printf("%s\n", tw.Render())
 }
+
+// PrintCanaryTokens - Print the http, aws, and document canaries tracked by the server
+func PrintCanaryTokens(con *console.SliverConsoleClient, tokens []*clientpb.CanaryToken, burnedOnly bool) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Type",
+		"Name",
+		"URL",
+		"Triggered",
+		"Count",
+		"Latest Trigger",
+		"Latest Source",
+	})
+	for _, token := range tokens {
+		if burnedOnly && !token.Triggered {
+			continue
+		}
+		lineColor := console.Normal
+		latestTrigger := "Never"
+		if token.Triggered {
+			lineColor = console.Bold + console.Red
+			latestTrigger = fmt.Sprintf(lineColor+"%s"+console.Normal, token.LatestTrigger)
+		}
+		tokenURL := token.URL
+		if token.AccessKeyID != "" {
+			tokenURL = fmt.Sprintf("%s (%s)", token.URL, token.AccessKeyID)
+		}
+		tw.AppendRow(table.Row{
+			strings.Split(token.ID, "-")[0],
+			token.Type,
+			token.Name,
+			tokenURL,
+			fmt.Sprintf(lineColor+"%v"+console.Normal, token.Triggered),
+			token.Count,
+			latestTrigger,
+			token.LastSource,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// CanaryTokenIDCompleter - Completes canary token IDs
+func CanaryTokenIDCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		tokens, err := con.Rpc.CanaryTokens(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to get canaries: %s", err)
+		}
+		for _, token := range tokens.Tokens {
+			results = append(results, strings.Split(token.ID, "-")[0])
+			results = append(results, fmt.Sprintf("%s %s", token.Type, token.Name))
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("canaries")
+	}
+	return carapace.ActionCallback(callback)
+}
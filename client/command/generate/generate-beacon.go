@@ -14,6 +14,7 @@ import (
 var (
 	minBeaconInterval         = 5 * time.Second
 	ErrBeaconIntervalTooShort = fmt.Errorf("beacon interval must be %v or greater", minBeaconInterval)
+	ErrInvalidAdaptiveBeacon  = fmt.Errorf("idle threshold must be 60 seconds or greater, and idle factor between 1 and 100")
 )
 
 // GenerateBeaconCmd - The main command used to generate implant binaries
@@ -35,7 +36,7 @@ func GenerateBeaconCmd(cmd *cobra.Command, con *console.SliverConsoleClient, arg
 	if external, _ := cmd.Flags().GetBool("external-builder"); !external {
 		compile(config, save, con)
 	} else {
-		externalBuild(config, save, con)
+		externalBuild(config, save, externalBuildOptions(cmd), con)
 	}
 }
 
@@ -66,5 +67,15 @@ func parseBeaconFlags(cmd *cobra.Command, con *console.SliverConsoleClient, conf
 	beaconJitter, _ := cmd.Flags().GetInt64("jitter")
 	config.BeaconInterval = int64(interval)
 	config.BeaconJitter = int64(time.Duration(beaconJitter) * time.Second)
+
+	config.AdaptiveBeacon, _ = cmd.Flags().GetBool("adaptive")
+	if config.AdaptiveBeacon {
+		idleThreshold, _ := cmd.Flags().GetInt64("idle-threshold")
+		config.AdaptiveIdleThreshold = int64(time.Duration(idleThreshold) * time.Second)
+		config.AdaptiveIdleFactor, _ = cmd.Flags().GetUint32("idle-factor")
+		if idleThreshold < 60 || config.AdaptiveIdleFactor < 1 || 100 < config.AdaptiveIdleFactor {
+			return ErrInvalidAdaptiveBeacon
+		}
+	}
 	return nil
 }
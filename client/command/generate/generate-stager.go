@@ -73,6 +73,8 @@ func GenerateStagerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, arg
 	badChars, _ := cmd.Flags().GetString("badchars")
 	save, _ := cmd.Flags().GetString("save")
 	advOptions, _ := cmd.Flags().GetString("advanced")
+	payload, _ := cmd.Flags().GetString("payload")
+	handler, _ := cmd.Flags().GetString("handler")
 
 	bChars := make([]string, 0)
 	if len(badChars) > 0 {
@@ -104,6 +106,7 @@ func GenerateStagerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, arg
 		Protocol:   stageProto,
 		OS:         stageOS,
 		AdvOptions: advOptions,
+		Payload:    payload,
 	})
 	ctrl <- true
 	<-ctrl
@@ -129,4 +132,21 @@ func GenerateStagerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, arg
 		con.PrintInfof("Here's your stager:\n")
 		con.Println(string(stageFile.GetFile().GetData()))
 	}
+
+	if stageFile.GetHandler() == "" {
+		if handler != "" {
+			con.PrintWarnf("The stager is served by a sliver stage listener, not a multi/handler\n")
+		}
+		return
+	}
+	if handler == "" {
+		con.PrintInfof("Use --handler to save a multi/handler resource script for the stager\n")
+		return
+	}
+	err = os.WriteFile(handler, []byte(stageFile.GetHandler()), 0o600)
+	if err != nil {
+		con.PrintErrorf("Failed to write to: %s\n", handler)
+		return
+	}
+	con.PrintInfof("Handler resource script saved to: %s (msfconsole -r %s)\n", handler, handler)
 }
@@ -20,6 +20,10 @@ package generate
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -35,11 +39,13 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 
+	"github.com/bishopfox/sliver/client/command/network"
 	"github.com/bishopfox/sliver/client/console"
 	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/client/spin"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/util"
 )
 
@@ -60,6 +66,10 @@ const (
 	DefaultDNSLPort = 53
 	// DefaultTCPPivotPort is the default port for tcp pivots
 	DefaultTCPPivotPort = 9898
+	// DefaultExternalC2Port is the default port implants accept external c2 channel clients on
+	DefaultExternalC2Port = 2223
+	// DefaultExternalC2LPort is the default port for external c2 listeners
+	DefaultExternalC2LPort = 2222
 
 	// DefaultReconnect is the default reconnect time
 	DefaultReconnect = 60
@@ -71,6 +81,20 @@ const (
 
 const (
 	crossCompilerInfoURL = "https://github.com/BishopFox/sliver/wiki/Cross-Compiling-Implants"
+
+	// HTTPDriverGo - Pure Go http(s) driver, the default
+	HTTPDriverGo = "go"
+	// HTTPDriverWininet - WinINet http(s) driver, uses the user's proxy settings
+	HTTPDriverWininet = "wininet"
+	// HTTPDriverWinhttp - WinHTTP http(s) driver, works when running as a service
+	HTTPDriverWinhttp = "winhttp"
+
+	// ProxyModeAuto - Discover proxies with WPAD and PAC files, the default
+	ProxyModeAuto = "auto"
+	// ProxyModeManual - Only use statically configured proxies
+	ProxyModeManual = "manual"
+	// ProxyModeNone - Connect directly
+	ProxyModeNone = "none"
 )
 
 var (
@@ -80,17 +104,30 @@ var (
 		"darwin/arm64":  true,
 		"linux/386":     true,
 		"linux/amd64":   true,
+		"linux/arm64":   true,
 		"windows/386":   true,
 		"windows/amd64": true,
+		"windows/arm64": true,
 	}
 
+	// HTTPDrivers - Drivers of the http(s) c2 "driver" option and --http-driver
+	HTTPDrivers = []string{HTTPDriverGo, HTTPDriverWininet, HTTPDriverWinhttp}
+
+	// ProxyModes - Modes of the http(s) c2 "proxy-mode" option and --proxy-mode
+	ProxyModes = []string{ProxyModeAuto, ProxyModeManual, ProxyModeNone}
+
 	ErrNoExternalBuilder = errors.New("no external builders are available")
 	ErrNoValidBuilders   = errors.New("no valid external builders for target")
 )
 
 // GenerateCmd - The main command used to generate implant binaries
 func GenerateCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
-	config := parseCompileFlags(cmd, con)
+	var config *clientpb.ImplantConfig
+	if profileName, _ := cmd.Flags().GetString("profile"); profileName != "" {
+		config = profileCompileConfig(cmd, profileName, con)
+	} else {
+		config = parseCompileFlags(cmd, con)
+	}
 	if config == nil {
 		return
 	}
@@ -101,7 +138,7 @@ func GenerateCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 	if external, _ := cmd.Flags().GetBool("external-builder"); !external {
 		compile(config, save, con)
 	} else {
-		_, err := externalBuild(config, save, con)
+		_, err := externalBuild(config, save, externalBuildOptions(cmd), con)
 		if err != nil {
 			if err == ErrNoExternalBuilder {
 				con.PrintErrorf("There are no external builders currently connected to the server\n")
@@ -166,6 +203,29 @@ func saveLocation(save, DefaultName string, con *console.SliverConsoleClient) (s
 	return saveTo, nil
 }
 
+// profileCompileConfig - The config of a saved profile ('name' or 'name@version'),
+// only the name and sgn flags are applied on top of it
+func profileCompileConfig(cmd *cobra.Command, profileName string, con *console.SliverConsoleClient) *clientpb.ImplantConfig {
+	profile := GetImplantProfileByName(profileName, con)
+	if profile == nil {
+		con.PrintErrorf("No profile with name '%s'\n", profileName)
+		return nil
+	}
+	config := profile.Config
+	config.Name = ""
+	if nameF, _ := cmd.Flags().GetString("name"); nameF != "" {
+		config.Name = strings.ToLower(nameF)
+		if err := util.AllowedName(config.Name); err != nil {
+			con.PrintErrorf("%s\n", err)
+			return nil
+		}
+	}
+	if sgnDisabled, _ := cmd.Flags().GetBool("disable-sgn"); sgnDisabled {
+		config.SGNEnabled = false
+	}
+	return config
+}
+
 func nameOfOutputFormat(value clientpb.OutputFormat) string {
 	switch value {
 	case clientpb.OutputFormat_EXECUTABLE:
@@ -246,6 +306,14 @@ func parseCompileFlags(cmd *cobra.Command, con *console.SliverConsoleClient) *cl
 	}
 	c2s = append(c2s, tcpPivotC2...)
 
+	extC2F, _ := cmd.Flags().GetString("extc2")
+	extC2, err := ParseExternalC2c2(extC2F)
+	if err != nil {
+		con.PrintErrorf("%s\n", err.Error())
+		return nil
+	}
+	c2s = append(c2s, extC2...)
+
 	var symbolObfuscation bool
 	if debug, _ := cmd.Flags().GetBool("debug"); debug {
 		symbolObfuscation = false
@@ -254,8 +322,8 @@ func parseCompileFlags(cmd *cobra.Command, con *console.SliverConsoleClient) *cl
 		symbolObfuscation = !symbolObfuscation
 	}
 
-	if len(mtlsC2) == 0 && len(wgC2) == 0 && len(httpC2) == 0 && len(dnsC2) == 0 && len(namedPipeC2) == 0 && len(tcpPivotC2) == 0 {
-		con.PrintErrorf("Must specify at least one of --mtls, --wg, --http, --dns, --named-pipe, or --tcp-pivot\n")
+	if len(mtlsC2) == 0 && len(wgC2) == 0 && len(httpC2) == 0 && len(dnsC2) == 0 && len(namedPipeC2) == 0 && len(tcpPivotC2) == 0 && len(extC2) == 0 {
+		con.PrintErrorf("Must specify at least one of --mtls, --wg, --http, --dns, --named-pipe, --tcp-pivot, or --extc2\n")
 		return nil
 	}
 
@@ -326,10 +394,24 @@ func parseCompileFlags(cmd *cobra.Command, con *console.SliverConsoleClient) *cl
 		con.PrintErrorf("Shellcode format is currently only supported on Windows\n")
 		return nil
 	}
+	if configFormat == clientpb.OutputFormat_SHELLCODE && targetArch == "arm64" {
+		con.PrintErrorf("Shellcode format is not supported on arm64\n")
+		return nil
+	}
 	if len(namedPipeC2) > 0 && targetOS != "windows" {
 		con.PrintErrorf("Named pipe pivoting can only be used in Windows.")
 		return nil
 	}
+	httpDriver, _ := cmd.Flags().GetString("http-driver")
+	if err := setHTTPDriver(httpC2, strings.ToLower(httpDriver), targetOS); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
+	proxyMode, _ := cmd.Flags().GetString("proxy-mode")
+	if err := setProxyMode(httpC2, strings.ToLower(proxyMode)); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
 
 	// Check to see if we can *probably* build the target binary
 	if !checkBuildTargetCompatibility(configFormat, targetOS, targetArch, con) {
@@ -348,6 +430,55 @@ func parseCompileFlags(cmd *cobra.Command, con *console.SliverConsoleClient) *cl
 	}
 
 	netGo, _ := cmd.Flags().GetBool("netgo")
+	httpC2Version, _ := cmd.Flags().GetInt32("http-c2-version")
+	compress, _ := cmd.Flags().GetBool("compress")
+	spa, _ := cmd.Flags().GetBool("spa")
+	if spa && len(mtlsC2) == 0 {
+		con.PrintErrorf("--spa requires an mtls c2\n")
+		return nil
+	}
+	httpClientCert, _ := cmd.Flags().GetBool("http-client-cert")
+	if httpClientCert && len(httpC2) == 0 {
+		con.PrintErrorf("--http-client-cert requires an http(s) c2\n")
+		return nil
+	}
+	keyExchange, _ := cmd.Flags().GetString("kex")
+	configKeySource, configKeyLocation, configKey, err := parseConfigKeyFlags(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
+	deadManDays, deadManStateFile, deadManCleanup, err := parseDeadManFlags(cmd, targetOS)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
+	ccBackend, _ := cmd.Flags().GetString("cc-backend")
+	glibcVersion, _ := cmd.Flags().GetString("glibc")
+	if ccBackend != "" && ccBackend != sliverpb.CCBackendSystem && ccBackend != sliverpb.CCBackendZig {
+		con.PrintErrorf("Invalid cc backend: %s\n", ccBackend)
+		return nil
+	}
+	if glibcVersion != "" && (ccBackend != sliverpb.CCBackendZig || targetOS != "linux") {
+		con.PrintErrorf("--glibc requires --cc-backend zig and a linux target\n")
+		return nil
+	}
+	reproducible, _ := cmd.Flags().GetBool("reproducible")
+	artifactMarker, _ := cmd.Flags().GetBool("marker")
+	if reproducible && (isShellcode || 0 < len(canaryDomains)) {
+		con.PrintErrorf("Shellcode and canary domains can't be used in reproducible builds\n")
+		return nil
+	}
+	if keyExchange != "" && keyExchange != sliverpb.KeyExchangeX25519 && keyExchange != sliverpb.KeyExchangeX25519MLKEM768 {
+		con.PrintErrorf("Invalid key exchange: %s\n", keyExchange)
+		return nil
+	}
+	rawResolvers, _ := cmd.Flags().GetString("dns-resolvers")
+	dnsResolvers, err := network.ParseDNSResolvers(rawResolvers)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
 
 	// TODO: Use generics or something to check in a slice
 	connectionStrategy, _ := cmd.Flags().GetString("strategy")
@@ -397,6 +528,22 @@ func parseCompileFlags(cmd *cobra.Command, con *console.SliverConsoleClient) *cl
 		NetGoEnabled:           netGo,
 		TrafficEncodersEnabled: trafficEncodersEnabled,
 		Assets:                 trafficEncoderAssets,
+		HTTPC2ConfigVersion:    httpC2Version,
+		DNSResolvers:           strings.Join(dnsResolvers, ","),
+		CompressionEnabled:     compress,
+		KeyExchange:            keyExchange,
+		ConfigKeySource:        configKeySource,
+		ConfigKeyLocation:      configKeyLocation,
+		ConfigKey:              configKey,
+		DeadManSwitchDays:      deadManDays,
+		DeadManStateFile:       deadManStateFile,
+		DeadManCleanup:         deadManCleanup,
+		Reproducible:           reproducible,
+		CCBackend:              ccBackend,
+		GlibcVersion:           glibcVersion,
+		SPAEnabled:             spa,
+		HTTPClientCert:         httpClientCert,
+		ArtifactMarker:         artifactMarker,
 
 		DebugFile: debugFile,
 	}
@@ -404,6 +551,82 @@ func parseCompileFlags(cmd *cobra.Command, con *console.SliverConsoleClient) *cl
 	return config
 }
 
+// parseConfigKeyFlags - Parse the source of the key of an encrypted config, a random key is
+// generated if none was given
+func parseConfigKeyFlags(cmd *cobra.Command) (string, string, string, error) {
+	rawSource, _ := cmd.Flags().GetString("config-key-source")
+	configKey, _ := cmd.Flags().GetString("config-key")
+	configKey = strings.TrimSpace(configKey)
+	if rawSource == "" {
+		if configKey != "" {
+			return "", "", "", errors.New("--config-key requires --config-key-source")
+		}
+		return "", "", "", nil
+	}
+	source, location, _ := strings.Cut(rawSource, ":")
+	switch source {
+	case sliverpb.ConfigKeyFromArg, sliverpb.ConfigKeyFromBundle:
+		if location != "" {
+			return "", "", "", fmt.Errorf("invalid config key source: %s", rawSource)
+		}
+	case sliverpb.ConfigKeyFromEnv, sliverpb.ConfigKeyFromFile, sliverpb.ConfigKeyFromURL:
+		if location == "" || strings.Contains(location, "`") {
+			return "", "", "", fmt.Errorf("invalid config key source: %s", rawSource)
+		}
+	default:
+		return "", "", "", fmt.Errorf("invalid config key source: %s", rawSource)
+	}
+	if configKey == "" {
+		var err error
+		configKey, err = randomConfigKey()
+		if err != nil {
+			return "", "", "", err
+		}
+	}
+	return source, location, configKey, nil
+}
+
+func randomConfigKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// parseDeadManFlags - Parse the dead man's switch, the cleanup entries are joined by newlines
+func parseDeadManFlags(cmd *cobra.Command, targetOS string) (uint32, string, string, error) {
+	days, _ := cmd.Flags().GetUint32("dead-man-days")
+	stateFile, _ := cmd.Flags().GetString("dead-man-state")
+	entries, _ := cmd.Flags().GetStringArray("dead-man-cleanup")
+	if days == 0 {
+		if stateFile != "" || 0 < len(entries) {
+			return 0, "", "", errors.New("--dead-man-state and --dead-man-cleanup require --dead-man-days")
+		}
+		return 0, "", "", nil
+	}
+	stateFile = strings.TrimSpace(stateFile)
+	if strings.Contains(stateFile, "`") {
+		return 0, "", "", fmt.Errorf("invalid dead man's switch state file: %s", stateFile)
+	}
+	cleanup := []string{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == sliverpb.DeadManCleanupSelf:
+		case strings.HasPrefix(entry, sliverpb.DeadManCleanupFile) && len(sliverpb.DeadManCleanupFile) < len(entry):
+		case strings.HasPrefix(entry, sliverpb.DeadManCleanupRegistry) && targetOS == "windows" && strings.Contains(entry, "\\"):
+		default:
+			return 0, "", "", fmt.Errorf("invalid dead man's switch cleanup: %s", entry)
+		}
+		if strings.Contains(entry, "`") || strings.Contains(entry, "\n") {
+			return 0, "", "", fmt.Errorf("invalid dead man's switch cleanup: %s", entry)
+		}
+		cleanup = append(cleanup, entry)
+	}
+	return days, stateFile, strings.Join(cleanup, "\n"), nil
+}
+
 // parseTrafficEncoderArgs - parses the traffic encoder args and returns a bool indicating if traffic encoders are enabled
 func parseTrafficEncoderArgs(cmd *cobra.Command, httpC2Enabled bool, con *console.SliverConsoleClient) (bool, []*commonpb.File) {
 	trafficEncoders, _ := cmd.Flags().GetString("traffic-encoders")
@@ -443,6 +666,9 @@ func getTargets(targetOS string, targetArch string, con *console.SliverConsoleCl
 	if targetArch == "386" || targetArch == "x86" || strings.HasPrefix(targetArch, "32") {
 		targetArch = "386"
 	}
+	if targetArch == "aarch64" {
+		targetArch = "arm64"
+	}
 
 	target := fmt.Sprintf("%s/%s", targetOS, targetArch)
 	if _, ok := SupportedCompilerTargets[target]; !ok {
@@ -555,15 +781,25 @@ func hasValidC2AdvancedOptions(options url.Values) (bool, error) {
 			if _, err := strconv.Atoi(testValue); err != nil {
 				return false, fmt.Errorf("error parsing C2 option \"%s\": %s", key, err.Error())
 			}
+		case "proxy-mode":
+			if !isProxyMode(testValue) {
+				return false, fmt.Errorf("C2 option \"proxy-mode\" must be one of: %s", strings.Join(ProxyModes, ", "))
+			}
+			if proxy := options.Get("proxy"); testValue == ProxyModeNone && proxy != "" && proxy != "never" && proxy != "auto" {
+				return false, fmt.Errorf("C2 option \"proxy-mode\" can't be %s with a proxy", ProxyModeNone)
+			}
 		case "driver":
-			// If this is specified, then it should be wininet (the only alternative driver currently supported)
-			if testValue != "wininet" {
-				return false, fmt.Errorf("C2 option \"driver\" must be empty for the default driver or \"wininet\" for the wininet driver (Windows only)")
+			if !isHTTPDriver(testValue) {
+				return false, fmt.Errorf("C2 option \"driver\" must be empty for the default driver, or one of: %s (wininet and winhttp are Windows only)", strings.Join(HTTPDrivers, ", "))
 			}
 		case "force-http", "disable-accept-header", "disable-upgrade-header", "ask-proxy-creds", "force-base32":
 			if testValue != "true" && testValue != "false" {
 				return false, fmt.Errorf("C2 option \"%s\" must be a boolean value: true or false", key)
 			}
+		case "client-cert":
+			if !isCertThumbprint(testValue) {
+				return false, fmt.Errorf("C2 option \"client-cert\" must be the sha1 thumbprint of a certificate in the windows certificate store")
+			}
 		case "proxy":
 			proxyUri, err := url.Parse(testValue)
 			if err != nil {
@@ -600,6 +836,73 @@ func checkOptionValue(c2Options url.Values, option string, value string) bool {
 	}
 }
 
+func isHTTPDriver(driver string) bool {
+	for _, name := range HTTPDrivers {
+		if driver == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isCertThumbprint - Is value a sha1 certificate thumbprint, as shown by the windows certificate store
+func isCertThumbprint(value string) bool {
+	hash, err := hex.DecodeString(value)
+	return err == nil && len(hash) == sha1.Size
+}
+
+// setHTTPDriver - Use driver for every http(s) c2 that doesn't pick its own with the "driver" option
+func setHTTPDriver(httpC2s []*clientpb.ImplantC2, driver string, targetOS string) error {
+	if driver == "" {
+		return nil
+	}
+	if !isHTTPDriver(driver) {
+		return fmt.Errorf("unknown http driver %s, valid drivers are: %s", driver, strings.Join(HTTPDrivers, ", "))
+	}
+	if driver != HTTPDriverGo && targetOS != "windows" {
+		return fmt.Errorf("the %s http driver is Windows only", driver)
+	}
+	return setDefaultC2Option(httpC2s, "driver", driver)
+}
+
+func isProxyMode(mode string) bool {
+	for _, name := range ProxyModes {
+		if mode == name {
+			return true
+		}
+	}
+	return false
+}
+
+// setProxyMode - Use mode for every http(s) c2 that doesn't pick its own with the "proxy-mode" option
+func setProxyMode(httpC2s []*clientpb.ImplantC2, mode string) error {
+	if mode == "" {
+		return nil
+	}
+	if !isProxyMode(mode) {
+		return fmt.Errorf("unknown proxy mode %s, valid modes are: %s", mode, strings.Join(ProxyModes, ", "))
+	}
+	return setDefaultC2Option(httpC2s, "proxy-mode", mode)
+}
+
+// setDefaultC2Option - Set an advanced option of every c2 url that doesn't set its own
+func setDefaultC2Option(c2s []*clientpb.ImplantC2, key string, value string) error {
+	for _, c2 := range c2s {
+		uri, err := url.Parse(c2.URL)
+		if err != nil {
+			return err
+		}
+		options := uri.Query()
+		if options.Has(key) {
+			continue
+		}
+		options.Set(key, value)
+		uri.RawQuery = options.Encode()
+		c2.URL = uri.String()
+	}
+	return nil
+}
+
 func uriWithoutProxyOptions(uri *url.URL) {
 	options := uri.Query()
 	// If any of the options do not exist, there is no error
@@ -607,6 +910,7 @@ func uriWithoutProxyOptions(uri *url.URL) {
 	options.Del("proxy-username")
 	options.Del("proxy-password")
 	options.Del("ask-proxy-creds")
+	options.Del("proxy-mode")
 	options.Del("fallback")
 
 	uri.RawQuery = options.Encode()
@@ -647,7 +951,7 @@ func ParseHTTPc2(args string) ([]*clientpb.ImplantC2, error) {
 		/* If a proxy is defined and the operator wants to fallback to connecting directly, add
 		   a C2 that connects directly without the proxy settings.
 		*/
-		if checkOptionValue(uri.Query(), "fallback", "true") && uri.Query().Has("proxy") && !checkOptionValue(uri.Query(), "driver", "wininet") {
+		if checkOptionValue(uri.Query(), "fallback", "true") && uri.Query().Has("proxy") && !checkOptionValue(uri.Query(), "driver", "wininet") && !checkOptionValue(uri.Query(), "driver", "winhttp") {
 			uriWithoutProxyOptions(uri)
 			c2s = append(c2s, &clientpb.ImplantC2{
 				Priority: uint32(index + len(allArguments)),
@@ -778,7 +1082,44 @@ func ParseTCPPivotc2(args string) ([]*clientpb.ImplantC2, error) {
 	return c2s, nil
 }
 
-func externalBuild(config *clientpb.ImplantConfig, save string, con *console.SliverConsoleClient) (*commonpb.File, error) {
+// ParseExternalC2c2 - Parse external c2 connection string arg, the implant accepts
+// the channel client's connection on the address
+func ParseExternalC2c2(args string) ([]*clientpb.ImplantC2, error) {
+	c2s := []*clientpb.ImplantC2{}
+	if args == "" {
+		return c2s, nil
+	}
+	for index, arg := range strings.Split(args, ",") {
+		arg = strings.ToLower(arg)
+		if !strings.HasPrefix(arg, "extc2://") {
+			arg = fmt.Sprintf("extc2://%s", arg)
+		}
+		uri, err := url.Parse(arg)
+		if err != nil {
+			return nil, err
+		}
+		if uri.Hostname() == "" {
+			uri.Host = fmt.Sprintf("127.0.0.1:%s", uri.Port())
+		}
+		if uri.Port() == "" {
+			uri.Host = fmt.Sprintf("%s:%d", uri.Hostname(), DefaultExternalC2Port)
+		}
+		c2s = append(c2s, &clientpb.ImplantC2{
+			Priority: uint32(index),
+			URL:      uri.String(),
+		})
+	}
+	return c2s, nil
+}
+
+// externalBuildOptions - Parse the external builder queue flags
+func externalBuildOptions(cmd *cobra.Command) *clientpb.ExternalGenerateReq {
+	priority, _ := cmd.Flags().GetInt32("build-priority")
+	noCache, _ := cmd.Flags().GetBool("no-cache")
+	return &clientpb.ExternalGenerateReq{Priority: priority, NoCache: noCache}
+}
+
+func externalBuild(config *clientpb.ImplantConfig, save string, opts *clientpb.ExternalGenerateReq, con *console.SliverConsoleClient) (*commonpb.File, error) {
 	potentialBuilders, err := findExternalBuilders(config, con)
 	if err != nil {
 		return nil, err
@@ -820,6 +1161,8 @@ func externalBuild(config *clientpb.ImplantConfig, save string, con *console.Sli
 	externalImplantConfig, err := con.Rpc.GenerateExternal(context.Background(), &clientpb.ExternalGenerateReq{
 		Config:      config,
 		BuilderName: externalBuilder.Name,
+		Priority:    opts.Priority,
+		NoCache:     opts.NoCache,
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
@@ -828,7 +1171,20 @@ func externalBuild(config *clientpb.ImplantConfig, save string, con *console.Sli
 	con.Printf("done\n")
 
 	var name string
+	if externalImplantConfig.CachedBuildName != "" {
+		con.RemoveEventListener(listenerID)
+		con.PrintInfof("Found identical build %s (use --no-cache to force a new build)\n", externalImplantConfig.CachedBuildName)
+		name = externalImplantConfig.CachedBuildName
+		waiting = false
+	}
 	msgF := "Waiting for external builder to acknowledge build (template: %s) ... %s"
+	if builders, err := con.Rpc.Builders(context.Background(), &commonpb.Empty{}); err == nil && waiting {
+		for _, builder := range builders.Builders {
+			if builder.Name == externalBuilder.Name && 0 < builder.QueuedBuilds {
+				msgF = fmt.Sprintf("Queued behind %d other build(s) (template: %%s) ... %%s", builder.QueuedBuilds-1)
+			}
+		}
+	}
 	for waiting {
 		select {
 
@@ -897,6 +1253,10 @@ func externalBuild(config *clientpb.ImplantConfig, save string, con *console.Sli
 		return nil, err
 	}
 	con.PrintInfof("Implant saved to %s\n", saveTo)
+	printConfigKey(config, name, con)
+	if con.IsJSON() {
+		con.PrintJSON(newBuildResult(config, name, saveTo, generated.File.Data, elapsed))
+	}
 
 	return nil, nil
 }
@@ -968,9 +1328,53 @@ func compile(config *clientpb.ImplantConfig, save string, con *console.SliverCon
 		return nil, err
 	}
 	con.PrintInfof("Implant saved to %s\n", saveTo)
+	printConfigKey(config, generated.File.Name, con)
+	if con.IsJSON() {
+		con.PrintJSON(newBuildResult(config, generated.File.Name, saveTo, fileData, elapsed))
+	}
 	return generated.File, err
 }
 
+// printConfigKey - The implant can't be run without the key of its encrypted config,
+// which may have been generated randomly, json output includes it in the build result
+func printConfigKey(config *clientpb.ImplantConfig, name string, con *console.SliverConsoleClient) {
+	if config.ConfigKeySource == sliverpb.ConfigKeyFromBundle {
+		con.PrintInfof("Provisionable implant, generate bundles with: implants bundle %s\n", name)
+	} else if config.ConfigKeySource != "" {
+		con.PrintInfof("Config key: %s\n", config.ConfigKey)
+	}
+}
+
+// buildResult - The json output of generate commands
+type buildResult struct {
+	Name         string  `json:"name"`
+	Path         string  `json:"path"`
+	Size         int     `json:"size"`
+	SHA256       string  `json:"sha256"`
+	GOOS         string  `json:"goos"`
+	GOARCH       string  `json:"goarch"`
+	Format       string  `json:"format"`
+	IsBeacon     bool    `json:"is_beacon"`
+	BuildSeconds float64 `json:"build_seconds"`
+	ConfigKey    string  `json:"config_key,omitempty"`
+}
+
+func newBuildResult(config *clientpb.ImplantConfig, name string, saveTo string, data []byte, elapsed time.Duration) *buildResult {
+	digest := sha256.Sum256(data)
+	return &buildResult{
+		Name:         name,
+		Path:         saveTo,
+		Size:         len(data),
+		SHA256:       hex.EncodeToString(digest[:]),
+		GOOS:         config.GOOS,
+		GOARCH:       config.GOARCH,
+		Format:       config.Format.String(),
+		IsBeacon:     config.IsBeacon,
+		BuildSeconds: elapsed.Round(time.Millisecond).Seconds(),
+		ConfigKey:    config.ConfigKey,
+	}
+}
+
 func getLimitsString(config *clientpb.ImplantConfig) string {
 	limits := []string{}
 	if config.LimitDatetime != "" {
@@ -1036,6 +1440,11 @@ func hasCC(targetOS string, targetArch string, crossCompilers []*clientpb.CrossC
 }
 
 func warnMissingCrossCompiler(format clientpb.OutputFormat, targetOS string, targetArch string, con *console.SliverConsoleClient) bool {
+	toolchains, err := con.Rpc.Toolchains(context.Background(), &commonpb.Empty{})
+	if err == nil && toolchains.AutoInstall {
+		con.PrintInfof("The server will install a cross-compiler for %s/%s, this build may take a while\n", targetOS, targetArch)
+		return true
+	}
 	con.PrintWarnf("Missing cross-compiler for %s on %s/%s\n", nameOfOutputFormat(format), targetOS, targetArch)
 	switch targetOS {
 	case "windows":
@@ -1045,7 +1454,7 @@ func warnMissingCrossCompiler(format clientpb.OutputFormat, targetOS string, tar
 	case "linux":
 		con.PrintWarnf("The server cannot find an installation of musl-cross")
 	}
-	con.PrintWarnf("For more information please read %s\n", crossCompilerInfoURL)
+	con.PrintWarnf("Install one on the server with 'toolchains install', for more information please read %s\n", crossCompilerInfoURL)
 
 	confirm := false
 	prompt := &survey.Confirm{Message: "Try to compile anyways (will likely fail)?"}
@@ -1064,6 +1473,9 @@ func findExternalBuilders(config *clientpb.ImplantConfig, con *console.SliverCon
 
 	validBuilders := []*clientpb.Builder{}
 	for _, builder := range builders.Builders {
+		if config.CCBackend == sliverpb.CCBackendZig && config.Format == clientpb.OutputFormat_SHARED_LIB && !hasZig(builder) {
+			continue
+		}
 		for _, target := range builder.Targets {
 			if target.GOOS == config.GOOS && target.GOARCH == config.GOARCH && config.Format == target.Format {
 				validBuilders = append(validBuilders, builder)
@@ -1079,6 +1491,15 @@ func findExternalBuilders(config *clientpb.ImplantConfig, con *console.SliverCon
 	return validBuilders, nil
 }
 
+func hasZig(builder *clientpb.Builder) bool {
+	for _, toolchain := range builder.Toolchains {
+		if toolchain.Name == sliverpb.CCBackendZig {
+			return true
+		}
+	}
+	return false
+}
+
 func selectExternalBuilder(builders []*clientpb.Builder, con *console.SliverConsoleClient) (*clientpb.Builder, error) {
 	choices := []string{}
 	for _, builder := range builders {
@@ -0,0 +1,121 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// ImplantsBundleCmd - Generate a provisioning bundle for a provisionable implant build
+func ImplantsBundleCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	bundle, saveTo := saveProvisioningBundle(cmd, con, args[0], false)
+	if bundle == nil {
+		return
+	}
+	con.PrintInfof("Bundle for implant %s (%d bytes) saved to: %s\n", bundle.Name, len(bundle.File.Data), saveTo)
+	con.PrintInfof("Append it to the %s executable to provision the implant\n", args[0])
+	con.PrintWarnf("Anyone with the %s executable can decrypt the bundle\n", args[0])
+}
+
+// ImplantsPatchCmd - Patch the c2 config and keys of a new implant into a copy of a
+// provisionable build's executable, without recompiling it
+func ImplantsPatchCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	patched, saveTo := saveProvisioningBundle(cmd, con, args[0], true)
+	if patched == nil {
+		return
+	}
+	con.PrintInfof("Implant %s patched from %s saved to: %s\n", patched.Name, args[0], saveTo)
+}
+
+// saveProvisioningBundle - Returns nil if the bundle couldn't be generated or saved
+func saveProvisioningBundle(cmd *cobra.Command, con *console.SliverConsoleClient, implantName string, patch bool) (*clientpb.ProvisioningBundle, string) {
+	name, _ := cmd.Flags().GetString("name")
+	save, _ := cmd.Flags().GetString("save")
+	if save == "" {
+		save, _ = os.Getwd()
+	}
+	httpC2Version, _ := cmd.Flags().GetInt32("http-c2-version")
+	c2s, err := parseBundleC2Flags(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil, ""
+	}
+	if len(c2s) == 0 {
+		con.PrintErrorf("Must specify at least one c2 endpoint\n")
+		return nil, ""
+	}
+
+	bundle, err := con.Rpc.GenerateProvisioningBundle(context.Background(), &clientpb.ProvisioningBundleReq{
+		ImplantName:         implantName,
+		Name:                name,
+		C2:                  c2s,
+		HTTPC2ConfigVersion: httpC2Version,
+		Patch:               patch,
+	})
+	if err != nil {
+		con.PrintErrorf("Failed to generate bundle %s\n", err)
+		return nil, ""
+	}
+	saveTo, err := saveLocation(save, bundle.File.Name, con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil, ""
+	}
+	mode := os.FileMode(0o600)
+	if patch {
+		mode = 0o700
+	}
+	err = os.WriteFile(saveTo, bundle.File.Data, mode)
+	if err != nil {
+		con.PrintErrorf("Failed to write to %s\n", err)
+		return nil, ""
+	}
+	return bundle, saveTo
+}
+
+// parseBundleC2Flags - The c2s of a bundle, only transports compiled into the
+// provisionable build can be used
+func parseBundleC2Flags(cmd *cobra.Command) ([]*clientpb.ImplantC2, error) {
+	parsers := []struct {
+		flag  string
+		parse func(string) ([]*clientpb.ImplantC2, error)
+	}{
+		{"mtls", ParseMTLSc2},
+		{"http", ParseHTTPc2},
+		{"dns", ParseDNSc2},
+		{"named-pipe", ParseNamedPipec2},
+		{"tcp-pivot", ParseTCPPivotc2},
+	}
+	c2s := []*clientpb.ImplantC2{}
+	for _, parser := range parsers {
+		value, _ := cmd.Flags().GetString(parser.flag)
+		parsed, err := parser.parse(value)
+		if err != nil {
+			return nil, err
+		}
+		c2s = append(c2s, parsed...)
+	}
+	return c2s, nil
+}
@@ -0,0 +1,61 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// ImplantsLookupCmd - Trace a (leaked) sample back to the build it came from using the
+// marker embedded in builds generated with --marker
+func ImplantsLookupCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	filePath, _ := cmd.Flags().GetString("file")
+	req := &clientpb.ArtifactMarkerReq{}
+	if 0 < len(args) {
+		req.Marker = args[0]
+	}
+	if filePath != "" {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		req.Data = data
+	}
+	if req.Marker == "" && len(req.Data) == 0 {
+		con.PrintErrorf("Specify a marker or a --file to search for one\n")
+		return
+	}
+
+	provenance, err := con.Rpc.LookupArtifactMarker(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintArtifactProvenance(provenance, con)
+	if !provenance.Verified {
+		con.PrintErrorf("Signature is INVALID, the provenance record may have been tampered with\n")
+	}
+}
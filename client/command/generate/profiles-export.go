@@ -0,0 +1,100 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+// ProfilesExportCmd - Export a profile (or a previous version of it) as yaml
+func ProfilesExportCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	profile := GetImplantProfileByName(args[0], con)
+	if profile == nil {
+		con.PrintErrorf("No profile found with name '%s'\n", args[0])
+		return
+	}
+	data, err := profileToYAML(profile)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	save, _ := cmd.Flags().GetString("save")
+	if save == "" {
+		con.Printf("%s", data)
+		return
+	}
+	err = os.WriteFile(save, data, 0o600)
+	if err != nil {
+		con.PrintErrorf("Failed to write %s: %s\n", save, err)
+		return
+	}
+	con.PrintInfof("Exported profile %s (version %d) to %s\n", profile.Name, profile.Version, save)
+}
+
+// ProfilesImportCmd - Import a profile from a yaml file, importing over an existing
+// profile saves a new version of it
+func ProfilesImportCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	profile, err := profileFromYAML(data)
+	if err != nil {
+		con.PrintErrorf("Failed to parse profile: %s\n", err)
+		return
+	}
+	if name, _ := cmd.Flags().GetString("name"); name != "" {
+		profile.Name = name
+	}
+	if err := util.AllowedName(profile.Name); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	// WireGuard implants need their own peer address
+	for _, c2 := range profile.Config.C2 {
+		if strings.HasPrefix(c2.URL, "wg://") {
+			uniqueWGIP, err := con.Rpc.GenerateUniqueIP(context.Background(), &commonpb.Empty{})
+			if err != nil {
+				con.PrintErrorf("Failed to generate unique ip for wg peer tun interface\n")
+				return
+			}
+			profile.Config.WGPeerTunIP = net.ParseIP(uniqueWGIP.IP).String()
+			break
+		}
+	}
+
+	profile.Version = 0
+	saved, err := con.Rpc.SaveImplantProfile(context.Background(), profile)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Imported profile %s (version %d)\n", saved.Name, saved.Version)
+}
@@ -54,6 +54,10 @@ func ProfilesGenerateCmd(cmd *cobra.Command, con *console.SliverConsoleClient, a
 		if err != nil {
 			return
 		}
+		// Builds from a previous version don't replace the current version
+		if _, version, _ := ParseProfileRef(name); version != 0 {
+			return
+		}
 		profile.Config.Name = buildImplantName(implantFile.Name)
 		_, err = con.Rpc.SaveImplantProfile(context.Background(), profile)
 		if err != nil {
@@ -0,0 +1,84 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// ProfilesHistoryCmd - List the saved versions of a profile
+func ProfilesHistoryCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	history, err := con.Rpc.ImplantProfileHistory(context.Background(), &clientpb.ImplantProfileReq{
+		Name: args[0],
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(history.Profiles) == 0 {
+		con.PrintInfof("No saved versions of profile %s\n", args[0])
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Version",
+		"Saved",
+		"Implant Type",
+		"Platform",
+		"Command & Control",
+		"Format",
+		"HTTP C2 Version",
+	})
+	for _, profile := range history.Profiles {
+		config := profile.Config
+		implantType := "session"
+		if config.IsBeacon {
+			implantType = "beacon"
+		}
+		c2URLs := []string{}
+		for _, c2 := range config.C2 {
+			c2URLs = append(c2URLs, c2.URL)
+		}
+		httpC2Version := "current"
+		if config.HTTPC2ConfigVersion != 0 {
+			httpC2Version = fmt.Sprintf("%d", config.HTTPC2ConfigVersion)
+		}
+		tw.AppendRow(table.Row{
+			profile.Version,
+			time.Unix(profile.CreatedAt, 0).Format(time.RFC1123),
+			implantType,
+			fmt.Sprintf("%s/%s", config.GOOS, config.GOARCH),
+			strings.Join(c2URLs, "\n"),
+			nameOfOutputFormat(config.Format),
+			httpC2Version,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
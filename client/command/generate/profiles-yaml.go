@@ -0,0 +1,374 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+var (
+	// ErrInvalidProfileRef - Profile references are 'name' or 'name@version'
+	ErrInvalidProfileRef = errors.New("invalid profile reference, expected name or name@version")
+)
+
+// ProfileYAML - The exported form of an implant profile, this only contains the
+// options an operator chooses, per-build values (keys, certs, wg peer ips) are
+// generated again when the profile is imported
+type ProfileYAML struct {
+	Name    string `yaml:"name"`
+	Version uint32 `yaml:"version,omitempty"`
+
+	Target  ProfileTargetYAML  `yaml:"target"`
+	Beacon  *ProfileBeaconYAML `yaml:"beacon,omitempty"`
+	C2      ProfileC2YAML      `yaml:"c2"`
+	Evasion ProfileEvasionYAML `yaml:"evasion"`
+	Limits  ProfileLimitsYAML  `yaml:"limits,omitempty"`
+}
+
+// ProfileTargetYAML - Target platform and output format
+type ProfileTargetYAML struct {
+	OS        string `yaml:"os"`
+	Arch      string `yaml:"arch"`
+	Format    string `yaml:"format"`
+	Template  string `yaml:"template,omitempty"`
+	RunAtLoad bool   `yaml:"run_at_load,omitempty"`
+	NetGo     bool   `yaml:"netgo,omitempty"`
+
+	Reproducible   bool   `yaml:"reproducible,omitempty"`
+	CCBackend      string `yaml:"cc_backend,omitempty"`
+	GlibcVersion   string `yaml:"glibc_version,omitempty"`
+	ArtifactMarker bool   `yaml:"artifact_marker,omitempty"`
+}
+
+// ProfileBeaconYAML - Beacon options, omitted for session implants
+type ProfileBeaconYAML struct {
+	Interval time.Duration `yaml:"interval"`
+	Jitter   time.Duration `yaml:"jitter"`
+
+	Adaptive      bool          `yaml:"adaptive,omitempty"`
+	IdleThreshold time.Duration `yaml:"idle_threshold,omitempty"`
+	IdleFactor    uint32        `yaml:"idle_factor,omitempty"`
+}
+
+// ProfileC2YAML - Transports and connection behavior
+type ProfileC2YAML struct {
+	URLs              []string      `yaml:"urls"`
+	Strategy          string        `yaml:"strategy,omitempty"`
+	CanaryDomains     []string      `yaml:"canary_domains,omitempty"`
+	ReconnectInterval time.Duration `yaml:"reconnect_interval"`
+	PollTimeout       time.Duration `yaml:"poll_timeout"`
+	MaxErrors         uint32        `yaml:"max_errors"`
+	WGKeyExchangePort uint32        `yaml:"wg_key_exchange_port,omitempty"`
+	WGTcpCommsPort    uint32        `yaml:"wg_tcp_comms_port,omitempty"`
+	HTTPC2Version     int32         `yaml:"http_c2_version,omitempty"`
+	TrafficEncoders   []string      `yaml:"traffic_encoders,omitempty"`
+	DNSResolvers      string        `yaml:"dns_resolvers,omitempty"`
+	Compression       bool          `yaml:"compression,omitempty"`
+	KeyExchange       string        `yaml:"key_exchange,omitempty"`
+	ConfigKeySource   string        `yaml:"config_key_source,omitempty"`
+	ConfigKeyLocation string        `yaml:"config_key_location,omitempty"`
+	SPA               bool          `yaml:"spa,omitempty"`
+	HTTPClientCert    bool          `yaml:"http_client_cert,omitempty"`
+}
+
+// ProfileEvasionYAML - Debug and evasion options
+type ProfileEvasionYAML struct {
+	Debug            bool   `yaml:"debug"`
+	DebugFile        string `yaml:"debug_file,omitempty"`
+	Evasion          bool   `yaml:"evasion"`
+	ObfuscateSymbols bool   `yaml:"obfuscate_symbols"`
+	SGN              bool   `yaml:"sgn"`
+}
+
+// ProfileLimitsYAML - Execution limits
+type ProfileLimitsYAML struct {
+	DomainJoined bool   `yaml:"domain_joined,omitempty"`
+	Datetime     string `yaml:"datetime,omitempty"`
+	Hostname     string `yaml:"hostname,omitempty"`
+	Username     string `yaml:"username,omitempty"`
+	FileExists   string `yaml:"file_exists,omitempty"`
+	Locale       string `yaml:"locale,omitempty"`
+
+	DeadManDays      uint32   `yaml:"dead_man_days,omitempty"`
+	DeadManStateFile string   `yaml:"dead_man_state_file,omitempty"`
+	DeadManCleanup   []string `yaml:"dead_man_cleanup,omitempty"`
+}
+
+// ParseProfileRef - Split a 'name@version' profile reference, version 0 is the latest
+func ParseProfileRef(ref string) (string, uint32, error) {
+	name, versionStr, found := strings.Cut(ref, "@")
+	if name == "" {
+		return "", 0, ErrInvalidProfileRef
+	}
+	if !found {
+		return name, 0, nil
+	}
+	version, err := strconv.ParseUint(versionStr, 10, 32)
+	if err != nil || version == 0 {
+		return "", 0, ErrInvalidProfileRef
+	}
+	return name, uint32(version), nil
+}
+
+func profileToYAML(profile *clientpb.ImplantProfile) ([]byte, error) {
+	config := profile.Config
+	if config == nil {
+		return nil, fmt.Errorf("profile %s has no config", profile.Name)
+	}
+	export := &ProfileYAML{
+		Name:    profile.Name,
+		Version: profile.Version,
+		Target: ProfileTargetYAML{
+			OS:        config.GOOS,
+			Arch:      config.GOARCH,
+			Format:    formatFlagName(config.Format),
+			Template:  config.TemplateName,
+			RunAtLoad: config.RunAtLoad,
+			NetGo:     config.NetGoEnabled,
+
+			Reproducible:   config.Reproducible,
+			CCBackend:      config.CCBackend,
+			GlibcVersion:   config.GlibcVersion,
+			ArtifactMarker: config.ArtifactMarker,
+		},
+		C2: ProfileC2YAML{
+			URLs:              []string{},
+			Strategy:          config.ConnectionStrategy,
+			CanaryDomains:     config.CanaryDomains,
+			ReconnectInterval: time.Duration(config.ReconnectInterval),
+			PollTimeout:       time.Duration(config.PollTimeout),
+			MaxErrors:         config.MaxConnectionErrors,
+			WGKeyExchangePort: config.WGKeyExchangePort,
+			WGTcpCommsPort:    config.WGTcpCommsPort,
+			HTTPC2Version:     config.HTTPC2ConfigVersion,
+			DNSResolvers:      config.DNSResolvers,
+			Compression:       config.CompressionEnabled,
+			KeyExchange:       config.KeyExchange,
+			ConfigKeySource:   config.ConfigKeySource,
+			ConfigKeyLocation: config.ConfigKeyLocation,
+			SPA:               config.SPAEnabled,
+			HTTPClientCert:    config.HTTPClientCert,
+		},
+		Evasion: ProfileEvasionYAML{
+			Debug:            config.Debug,
+			DebugFile:        config.DebugFile,
+			Evasion:          config.Evasion,
+			ObfuscateSymbols: config.ObfuscateSymbols,
+			SGN:              config.SGNEnabled,
+		},
+		Limits: ProfileLimitsYAML{
+			DomainJoined: config.LimitDomainJoined,
+			Datetime:     config.LimitDatetime,
+			Hostname:     config.LimitHostname,
+			Username:     config.LimitUsername,
+			FileExists:   config.LimitFileExists,
+			Locale:       config.LimitLocale,
+
+			DeadManDays:      config.DeadManSwitchDays,
+			DeadManStateFile: config.DeadManStateFile,
+		},
+	}
+	if config.IsBeacon {
+		export.Beacon = &ProfileBeaconYAML{
+			Interval: time.Duration(config.BeaconInterval),
+			Jitter:   time.Duration(config.BeaconJitter),
+
+			Adaptive:      config.AdaptiveBeacon,
+			IdleThreshold: time.Duration(config.AdaptiveIdleThreshold),
+			IdleFactor:    config.AdaptiveIdleFactor,
+		}
+	}
+	for _, c2 := range config.C2 {
+		export.C2.URLs = append(export.C2.URLs, c2.URL)
+	}
+	if config.DeadManCleanup != "" {
+		export.Limits.DeadManCleanup = strings.Split(config.DeadManCleanup, "\n")
+	}
+	if config.TrafficEncodersEnabled {
+		for _, asset := range config.Assets {
+			export.C2.TrafficEncoders = append(export.C2.TrafficEncoders, strings.TrimSuffix(asset.Name, ".wasm"))
+		}
+	}
+	return yaml.Marshal(export)
+}
+
+func profileFromYAML(data []byte) (*clientpb.ImplantProfile, error) {
+	export := &ProfileYAML{}
+	err := yaml.Unmarshal(data, export)
+	if err != nil {
+		return nil, err
+	}
+	if export.Target.OS == "" || export.Target.Arch == "" {
+		return nil, errors.New("profile target os and arch are required")
+	}
+	if len(export.C2.URLs) == 0 {
+		return nil, errors.New("profile must have at least one c2 url")
+	}
+
+	format, err := formatFromFlagName(export.Target.Format)
+	if err != nil {
+		return nil, err
+	}
+	config := &clientpb.ImplantConfig{
+		GOOS:           strings.ToLower(export.Target.OS),
+		GOARCH:         strings.ToLower(export.Target.Arch),
+		TemplateName:   export.Target.Template,
+		Format:         format,
+		IsSharedLib:    format == clientpb.OutputFormat_SHARED_LIB,
+		IsService:      format == clientpb.OutputFormat_SERVICE,
+		IsShellcode:    format == clientpb.OutputFormat_SHELLCODE,
+		RunAtLoad:      export.Target.RunAtLoad,
+		NetGoEnabled:   export.Target.NetGo,
+		Reproducible:   export.Target.Reproducible,
+		CCBackend:      export.Target.CCBackend,
+		GlibcVersion:   export.Target.GlibcVersion,
+		ArtifactMarker: export.Target.ArtifactMarker,
+
+		CanaryDomains:       export.C2.CanaryDomains,
+		ConnectionStrategy:  export.C2.Strategy,
+		ReconnectInterval:   int64(export.C2.ReconnectInterval),
+		PollTimeout:         int64(export.C2.PollTimeout),
+		MaxConnectionErrors: export.C2.MaxErrors,
+		WGKeyExchangePort:   export.C2.WGKeyExchangePort,
+		WGTcpCommsPort:      export.C2.WGTcpCommsPort,
+		HTTPC2ConfigVersion: export.C2.HTTPC2Version,
+		DNSResolvers:        export.C2.DNSResolvers,
+		CompressionEnabled:  export.C2.Compression,
+		KeyExchange:         export.C2.KeyExchange,
+		ConfigKeySource:     export.C2.ConfigKeySource,
+		ConfigKeyLocation:   export.C2.ConfigKeyLocation,
+		SPAEnabled:          export.C2.SPA,
+		HTTPClientCert:      export.C2.HTTPClientCert,
+
+		Debug:            export.Evasion.Debug,
+		DebugFile:        export.Evasion.DebugFile,
+		Evasion:          export.Evasion.Evasion,
+		ObfuscateSymbols: export.Evasion.ObfuscateSymbols,
+		SGNEnabled:       export.Evasion.SGN,
+
+		LimitDomainJoined: export.Limits.DomainJoined,
+		LimitDatetime:     export.Limits.Datetime,
+		LimitHostname:     export.Limits.Hostname,
+		LimitUsername:     export.Limits.Username,
+		LimitFileExists:   export.Limits.FileExists,
+		LimitLocale:       export.Limits.Locale,
+
+		DeadManSwitchDays: export.Limits.DeadManDays,
+		DeadManStateFile:  export.Limits.DeadManStateFile,
+		DeadManCleanup:    strings.Join(export.Limits.DeadManCleanup, "\n"),
+	}
+	if config.TemplateName == "" {
+		config.TemplateName = "sliver"
+	}
+	if export.Beacon != nil {
+		config.IsBeacon = true
+		config.BeaconInterval = int64(export.Beacon.Interval)
+		config.BeaconJitter = int64(export.Beacon.Jitter)
+		config.AdaptiveBeacon = export.Beacon.Adaptive
+		config.AdaptiveIdleThreshold = int64(export.Beacon.IdleThreshold)
+		config.AdaptiveIdleFactor = export.Beacon.IdleFactor
+	}
+
+	config.C2, err = parseC2URLs(export.C2.URLs)
+	if err != nil {
+		return nil, err
+	}
+	// Config keys aren't exported, an imported profile gets a new one
+	if config.ConfigKeySource != "" {
+		config.ConfigKey, err = randomConfigKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if 0 < len(export.C2.TrafficEncoders) {
+		config.TrafficEncodersEnabled = true
+		for _, encoder := range export.C2.TrafficEncoders {
+			if !strings.HasSuffix(encoder, ".wasm") {
+				encoder += ".wasm"
+			}
+			config.Assets = append(config.Assets, &commonpb.File{Name: encoder})
+		}
+	}
+
+	return &clientpb.ImplantProfile{
+		Name:    export.Name,
+		Version: export.Version,
+		Config:  config,
+	}, nil
+}
+
+// parseC2URLs - Exported urls are already normalized by the generate flag parsers
+// (default ports, fallback c2s, etc.) so they're only validated and kept in order
+func parseC2URLs(urls []string) ([]*clientpb.ImplantC2, error) {
+	c2s := []*clientpb.ImplantC2{}
+	for index, rawURL := range urls {
+		uri, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		switch uri.Scheme {
+		case "mtls", "wg", "http", "https", "dns", "namedpipe", "tcppivot", "extc2":
+		default:
+			return nil, fmt.Errorf("invalid c2 url '%s'", rawURL)
+		}
+		c2s = append(c2s, &clientpb.ImplantC2{
+			Priority: uint32(index),
+			URL:      uri.String(),
+		})
+	}
+	return c2s, nil
+}
+
+func formatFlagName(format clientpb.OutputFormat) string {
+	switch format {
+	case clientpb.OutputFormat_SHARED_LIB:
+		return "shared"
+	case clientpb.OutputFormat_SHELLCODE:
+		return "shellcode"
+	case clientpb.OutputFormat_SERVICE:
+		return "service"
+	default:
+		return "exe"
+	}
+}
+
+func formatFromFlagName(name string) (clientpb.OutputFormat, error) {
+	switch strings.ToLower(name) {
+	case "", "exe":
+		return clientpb.OutputFormat_EXECUTABLE, nil
+	case "shared":
+		return clientpb.OutputFormat_SHARED_LIB, nil
+	case "shellcode":
+		return clientpb.OutputFormat_SHELLCODE, nil
+	case "service":
+		return clientpb.OutputFormat_SERVICE, nil
+	default:
+		return clientpb.OutputFormat_EXECUTABLE, fmt.Errorf("unknown output format '%s'", name)
+	}
+}
@@ -0,0 +1,204 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+const (
+	sampleProfile = `name: test1
+target:
+  os: linux
+  arch: amd64
+  format: shared
+  run_at_load: true
+beacon:
+  interval: 1m30s
+  jitter: 10s
+  adaptive: true
+  idle_threshold: 10m
+c2:
+  urls:
+    - mtls://example.com:8888
+    - https://example.com?driver=wininet
+  reconnect_interval: 1m
+  poll_timeout: 6m
+  max_errors: 1000
+  http_c2_version: 2
+  traffic_encoders:
+    - base64
+  spa: true
+  http_client_cert: true
+evasion:
+  debug: false
+  evasion: true
+  obfuscate_symbols: true
+  sgn: false
+limits:
+  hostname: foo
+`
+)
+
+func TestProfileFromYAML(t *testing.T) {
+	profile, err := profileFromYAML([]byte(sampleProfile))
+	if err != nil {
+		t.Fatalf("Error parsing profile: %s", err)
+	}
+	config := profile.Config
+	if profile.Name != "test1" {
+		t.Errorf("Expected name to be 'test1', got '%s'", profile.Name)
+	}
+	if config.GOOS != "linux" || config.GOARCH != "amd64" {
+		t.Errorf("Expected linux/amd64, got %s/%s", config.GOOS, config.GOARCH)
+	}
+	if config.Format != clientpb.OutputFormat_SHARED_LIB || !config.IsSharedLib {
+		t.Errorf("Expected shared library format, got %v", config.Format)
+	}
+	if !config.IsBeacon || config.BeaconInterval != int64(90*time.Second) {
+		t.Errorf("Expected beacon with 90s interval, got %v", time.Duration(config.BeaconInterval))
+	}
+	if !config.AdaptiveBeacon || config.AdaptiveIdleThreshold != int64(10*time.Minute) || config.AdaptiveIdleFactor != 0 {
+		t.Errorf("Unexpected adaptive beaconing %v %v %d", config.AdaptiveBeacon,
+			time.Duration(config.AdaptiveIdleThreshold), config.AdaptiveIdleFactor)
+	}
+	if len(config.C2) != 2 || config.C2[1].URL != "https://example.com?driver=wininet" || config.C2[1].Priority != 1 {
+		t.Errorf("Unexpected c2s %v", config.C2)
+	}
+	if !config.SPAEnabled {
+		t.Errorf("Expected spa to be enabled")
+	}
+	if !config.HTTPClientCert {
+		t.Errorf("Expected an http client certificate")
+	}
+	if config.HTTPC2ConfigVersion != 2 {
+		t.Errorf("Expected http c2 version 2, got %d", config.HTTPC2ConfigVersion)
+	}
+	if !config.TrafficEncodersEnabled || len(config.Assets) != 1 || config.Assets[0].Name != "base64.wasm" {
+		t.Errorf("Unexpected traffic encoders %v", config.Assets)
+	}
+	if config.TemplateName != "sliver" {
+		t.Errorf("Expected default template, got '%s'", config.TemplateName)
+	}
+	if config.LimitHostname != "foo" {
+		t.Errorf("Expected hostname limit 'foo', got '%s'", config.LimitHostname)
+	}
+}
+
+func TestProfileYAMLRoundTrip(t *testing.T) {
+	profile := &clientpb.ImplantProfile{
+		Name:    "test2",
+		Version: 3,
+		Config: &clientpb.ImplantConfig{
+			GOOS:                   "windows",
+			GOARCH:                 "amd64",
+			Format:                 clientpb.OutputFormat_SERVICE,
+			IsService:              true,
+			TemplateName:           "sliver",
+			C2:                     []*clientpb.ImplantC2{{URL: "dns://1.example.com"}},
+			ConnectionStrategy:     "r",
+			ReconnectInterval:      int64(time.Minute),
+			PollTimeout:            int64(360 * time.Second),
+			MaxConnectionErrors:    10,
+			SGNEnabled:             true,
+			LimitDomainJoined:      true,
+			TrafficEncodersEnabled: true,
+			Assets:                 []*commonpb.File{{Name: "hex.wasm"}},
+			DeadManSwitchDays:      30,
+			DeadManCleanup:         "self\nreg:HKCU\\Software\\Microsoft\\Windows\\CurrentVersion\\Run\\Updater",
+			CCBackend:              "zig",
+
+			// Per-build values are not exported
+			MtlsCert:    "cert",
+			WGPeerTunIP: "100.64.0.2",
+		},
+	}
+	data, err := profileToYAML(profile)
+	if err != nil {
+		t.Fatalf("Error exporting profile: %s", err)
+	}
+	imported, err := profileFromYAML(data)
+	if err != nil {
+		t.Fatalf("Error importing profile: %s\n%s", err, data)
+	}
+	config := imported.Config
+	if imported.Name != "test2" || imported.Version != 3 {
+		t.Errorf("Expected test2 version 3, got %s version %d", imported.Name, imported.Version)
+	}
+	if config.Format != clientpb.OutputFormat_SERVICE || !config.IsService {
+		t.Errorf("Expected service format, got %v", config.Format)
+	}
+	if config.IsBeacon {
+		t.Errorf("Expected session profile")
+	}
+	if len(config.C2) != 1 || config.C2[0].URL != "dns://1.example.com" {
+		t.Errorf("Unexpected c2s %v", config.C2)
+	}
+	if config.ConnectionStrategy != "r" || config.ReconnectInterval != int64(time.Minute) || config.MaxConnectionErrors != 10 {
+		t.Errorf("Unexpected connection options %v", config)
+	}
+	if !config.SGNEnabled || !config.LimitDomainJoined {
+		t.Errorf("Expected sgn and domain joined limit")
+	}
+	if len(config.Assets) != 1 || config.Assets[0].Name != "hex.wasm" {
+		t.Errorf("Unexpected traffic encoders %v", config.Assets)
+	}
+	if config.DeadManSwitchDays != 30 || config.DeadManCleanup != profile.Config.DeadManCleanup {
+		t.Errorf("Unexpected dead man's switch %d %q", config.DeadManSwitchDays, config.DeadManCleanup)
+	}
+	if config.CCBackend != "zig" {
+		t.Errorf("Unexpected cc backend %q", config.CCBackend)
+	}
+	if config.MtlsCert != "" || config.WGPeerTunIP != "" {
+		t.Errorf("Per-build values should not be exported")
+	}
+}
+
+func TestProfileFromYAMLInvalid(t *testing.T) {
+	invalid := []string{
+		"name: test\ntarget:\n  os: linux\n",
+		"name: test\ntarget:\n  os: linux\n  arch: amd64\nc2:\n  urls:\n    - ftp://example.com\n",
+		"name: test\ntarget:\n  os: linux\n  arch: amd64\n  format: dmg\nc2:\n  urls:\n    - mtls://example.com\n",
+	}
+	for _, sample := range invalid {
+		if _, err := profileFromYAML([]byte(sample)); err == nil {
+			t.Errorf("Expected error parsing profile:\n%s", sample)
+		}
+	}
+}
+
+func TestParseProfileRef(t *testing.T) {
+	name, version, err := ParseProfileRef("foo")
+	if err != nil || name != "foo" || version != 0 {
+		t.Errorf("Expected foo version 0, got %s version %d (%v)", name, version, err)
+	}
+	name, version, err = ParseProfileRef("foo@12")
+	if err != nil || name != "foo" || version != 12 {
+		t.Errorf("Expected foo version 12, got %s version %d (%v)", name, version, err)
+	}
+	for _, ref := range []string{"", "@1", "foo@", "foo@0", "foo@bar"} {
+		if _, _, err := ParseProfileRef(ref); err == nil {
+			t.Errorf("Expected error parsing '%s'", ref)
+		}
+	}
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/rsteube/carapace"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
@@ -55,6 +57,7 @@ func PrintProfiles(profiles []*clientpb.ImplantProfile, con *console.SliverConso
 	tw.SetStyle(settings.GetTableStyle(con))
 	tw.AppendHeader(table.Row{
 		"Profile Name",
+		"Version",
 		"Implant Type",
 		"Platform",
 		"Command & Control",
@@ -84,6 +87,7 @@ func PrintProfiles(profiles []*clientpb.ImplantProfile, con *console.SliverConso
 		}
 		tw.AppendRow(table.Row{
 			profile.Name,
+			profile.Version,
 			implantType,
 			fmt.Sprintf("%s/%s", config.GOOS, config.GOARCH),
 			strings.Join(c2URLs, "\n"),
@@ -106,19 +110,25 @@ func getImplantProfiles(con *console.SliverConsoleClient) []*clientpb.ImplantPro
 	return pbProfiles.Profiles
 }
 
-// GetImplantProfileByName - Get an implant profile by a specific name
+// GetImplantProfileByName - Get an implant profile by a specific name, a previous
+// version can be referenced with 'name@version'
 func GetImplantProfileByName(name string, con *console.SliverConsoleClient) *clientpb.ImplantProfile {
-	pbProfiles, err := con.Rpc.ImplantProfiles(context.Background(), &commonpb.Empty{})
+	profileName, version, err := ParseProfileRef(name)
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return nil
 	}
-	for _, profile := range pbProfiles.Profiles {
-		if profile.Name == name {
-			return profile
+	profile, err := con.Rpc.GetImplantProfile(context.Background(), &clientpb.ImplantProfileReq{
+		Name:    profileName,
+		Version: version,
+	})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			con.PrintErrorf("%s\n", err)
 		}
+		return nil
 	}
-	return nil
+	return profile
 }
 
 func populateProfileProperties(config *clientpb.ImplantConfig) map[string]string {
@@ -277,6 +287,10 @@ func PrintProfileInfo(name string, con *console.SliverConsoleClient) {
 	tw := table.NewWriter()
 
 	// Implant Basics
+	tw.AppendRow(table.Row{
+		"Profile Version",
+		profile.Version,
+	})
 	tw.AppendRow(table.Row{
 		"OS / Architecture",
 		properties["osarch"],
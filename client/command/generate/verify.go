@@ -0,0 +1,78 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// VerifyCmd - Verify a generated artifact against the server's signed provenance records
+func VerifyCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	provenance, err := con.Rpc.VerifyArtifact(context.Background(), &commonpb.File{
+		Name: filepath.Base(args[0]),
+		Data: data,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintArtifactProvenance(provenance, con)
+	if provenance.Verified {
+		con.PrintInfof("Signature is valid, artifact was generated by this server\n")
+	} else {
+		con.PrintErrorf("Signature is INVALID, the provenance record may have been tampered with\n")
+	}
+}
+
+// PrintArtifactProvenance - Print the details of a provenance record
+func PrintArtifactProvenance(provenance *clientpb.ArtifactProvenance, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendRows([]table.Row{
+		{"Name", provenance.Name},
+		{"Kind", provenance.Kind},
+		{"Created", time.Unix(provenance.CreatedAt, 0).Format(time.RFC1123)},
+		{"SHA256", provenance.SHA256},
+		{"Profile", provenance.ProfileName},
+		{"Builder", provenance.Builder},
+		{"Toolchain", provenance.Toolchain},
+		{"Signature", provenance.Signature},
+		{"Public Key", provenance.PublicKey},
+	})
+	con.Printf("%s\n", tw.Render())
+	if provenance.Config != "" {
+		con.Printf("\n%s\n", provenance.Config)
+	}
+}
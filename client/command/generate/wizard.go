@@ -0,0 +1,676 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+const (
+	wizardNewConfig = "New configuration"
+)
+
+var (
+	// wizardTransports - The C2 transports the wizard can configure, in the order they're prompted
+	wizardTransports = []string{"mtls", "wg", "http", "dns", "named-pipe", "tcp-pivot"}
+
+	wizardC2Parsers = map[string]func(string) ([]*clientpb.ImplantC2, error){
+		"mtls":       ParseMTLSc2,
+		"wg":         ParseWGc2,
+		"http":       ParseHTTPc2,
+		"dns":        ParseDNSc2,
+		"named-pipe": ParseNamedPipec2,
+		"tcp-pivot":  ParseTCPPivotc2,
+	}
+
+	wizardC2Examples = map[string]string{
+		"mtls":       "example.com:8888",
+		"wg":         "example.com:53",
+		"http":       "https://example.com",
+		"dns":        "1.example.com.",
+		"named-pipe": "192.168.1.1/pipe/name",
+		"tcp-pivot":  "192.168.1.1:9898",
+	}
+
+	wizardStrategies = map[string]string{
+		"Sequential":    "s",
+		"Random":        "r",
+		"Random domain": "rd",
+	}
+
+	errNoC2 = errors.New("at least one C2 endpoint is required")
+)
+
+// wizardStep - A group of prompts that fills in part of the config
+type wizardStep func(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error
+
+// GenerateWizardCmd - Build an implant by answering prompts instead of passing flags
+func GenerateWizardCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	config, err := wizardBaseConfig(con)
+	if err == nil {
+		steps := []wizardStep{
+			wizardImplantType,
+			wizardTarget,
+			wizardFormat,
+			wizardC2,
+			wizardEvasion,
+			wizardConnection,
+			wizardLimits,
+			wizardName,
+		}
+		for _, step := range steps {
+			err = step(config, con)
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		if errors.Is(err, terminal.InterruptErr) {
+			con.PrintWarnf("Cancelled\n")
+		} else {
+			con.PrintErrorf("%s\n", err)
+		}
+		return
+	}
+
+	con.Println()
+	PrintWizardSummary(config, con)
+	if !checkBuildTargetCompatibility(config.Format, config.GOOS, config.GOARCH, con) {
+		return
+	}
+
+	saveProfile := false
+	err = survey.AskOne(&survey.Confirm{Message: "Save these settings as a profile?"}, &saveProfile)
+	if err != nil {
+		return
+	}
+	if saveProfile {
+		profileName := ""
+		err = survey.AskOne(&survey.Input{Message: "Profile name:"}, &profileName, survey.WithValidator(survey.Required))
+		if err != nil {
+			return
+		}
+		resp, err := con.Rpc.SaveImplantProfile(context.Background(), &clientpb.ImplantProfile{
+			Name:   profileName,
+			Config: config,
+		})
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+		} else {
+			con.PrintInfof("Saved new implant profile %s\n", resp.Name)
+		}
+	}
+
+	build := true
+	err = survey.AskOne(&survey.Confirm{Message: "Build the implant now?", Default: true}, &build)
+	if err != nil || !build {
+		return
+	}
+	save, _ := cmd.Flags().GetString("save")
+	if save == "" {
+		save, _ = os.Getwd()
+	}
+	compile(config, save, con)
+}
+
+// wizardBaseConfig - The defaults of the generate flags, or an existing profile's config
+func wizardBaseConfig(con *console.SliverConsoleClient) (*clientpb.ImplantConfig, error) {
+	profiles := getImplantProfiles(con)
+	if len(profiles) == 0 {
+		return defaultWizardConfig(), nil
+	}
+	options := []string{wizardNewConfig}
+	for _, profile := range profiles {
+		options = append(options, profile.Name)
+	}
+	choice := ""
+	err := survey.AskOne(&survey.Select{Message: "Start from:", Options: options}, &choice)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		if profile.Name == choice {
+			config := profile.Config
+			config.Name = ""
+			return config, nil
+		}
+	}
+	return defaultWizardConfig(), nil
+}
+
+func defaultWizardConfig() *clientpb.ImplantConfig {
+	return &clientpb.ImplantConfig{
+		GOOS:                runtime.GOOS,
+		GOARCH:              "amd64",
+		Format:              clientpb.OutputFormat_EXECUTABLE,
+		ObfuscateSymbols:    true,
+		BeaconInterval:      int64(60 * time.Second),
+		BeaconJitter:        int64(30 * time.Second),
+		ReconnectInterval:   DefaultReconnect * int64(time.Second),
+		PollTimeout:         DefaultPollTimeout * int64(time.Second),
+		MaxConnectionErrors: DefaultMaxErrors,
+		WGKeyExchangePort:   DefaultWGKeyExPort,
+		WGTcpCommsPort:      DefaultWGNPort,
+	}
+}
+
+func wizardImplantType(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	implantType := "Session"
+	if config.IsBeacon {
+		implantType = "Beacon"
+	}
+	err := survey.AskOne(&survey.Select{
+		Message: "Implant type:",
+		Options: []string{"Session", "Beacon"},
+		Default: implantType,
+		Description: func(value string, _ int) string {
+			if value == "Beacon" {
+				return "periodically checks in for tasks"
+			}
+			return "interactive, stays connected"
+		},
+	}, &implantType)
+	if err != nil {
+		return err
+	}
+	config.IsBeacon = implantType == "Beacon"
+	if !config.IsBeacon {
+		return nil
+	}
+	interval, err := askDuration("Beacon interval:", time.Duration(config.BeaconInterval), minBeaconInterval)
+	if err != nil {
+		return err
+	}
+	jitter, err := askDuration("Beacon jitter:", time.Duration(config.BeaconJitter), 0)
+	if err != nil {
+		return err
+	}
+	config.BeaconInterval = int64(interval)
+	config.BeaconJitter = int64(jitter)
+	return nil
+}
+
+func wizardTarget(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	var targets map[string][]string
+	compiler, err := con.Rpc.GetCompiler(context.Background(), &commonpb.Empty{})
+	if err == nil {
+		targets = wizardTargets(compiler)
+	} else {
+		targets = wizardTargets(nil)
+	}
+	goos := []string{}
+	for targetOS := range targets {
+		goos = append(goos, targetOS)
+	}
+	sort.Strings(goos)
+
+	targetOS := ""
+	err = survey.AskOne(&survey.Select{
+		Message: "Target operating system:",
+		Options: goos,
+		Default: wizardDefault(goos, config.GOOS),
+	}, &targetOS)
+	if err != nil {
+		return err
+	}
+	targetArch := ""
+	err = survey.AskOne(&survey.Select{
+		Message: "Target architecture:",
+		Options: targets[targetOS],
+		Default: wizardDefault(targets[targetOS], config.GOARCH),
+	}, &targetArch)
+	if err != nil {
+		return err
+	}
+	config.GOOS = targetOS
+	config.GOARCH = targetArch
+	return nil
+}
+
+// wizardTargets - The architectures of each operating system the server can build for, without
+// compiler information the targets that are supported by default are used
+func wizardTargets(compiler *clientpb.Compiler) map[string][]string {
+	pairs := [][2]string{}
+	if compiler != nil {
+		for _, target := range compiler.Targets {
+			pairs = append(pairs, [2]string{target.GOOS, target.GOARCH})
+		}
+	}
+	if len(pairs) == 0 {
+		for target := range SupportedCompilerTargets {
+			parts := strings.SplitN(target, "/", 2)
+			pairs = append(pairs, [2]string{parts[0], parts[1]})
+		}
+	}
+	targets := map[string][]string{}
+	for _, pair := range pairs {
+		if !contains(targets[pair[0]], pair[1]) {
+			targets[pair[0]] = append(targets[pair[0]], pair[1])
+		}
+	}
+	for _, arches := range targets {
+		sort.Strings(arches)
+	}
+	return targets
+}
+
+func wizardFormat(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	formats := wizardFormats(config.GOOS)
+	format := ""
+	err := survey.AskOne(&survey.Select{
+		Message: "Output format:",
+		Options: formats,
+		Default: wizardDefault(formats, formatFlagName(config.Format)),
+	}, &format)
+	if err != nil {
+		return err
+	}
+	setWizardFormat(config, format)
+	if config.IsSharedLib {
+		err = survey.AskOne(&survey.Confirm{
+			Message: "Run the implant when the library is loaded?",
+			Default: config.RunAtLoad,
+		}, &config.RunAtLoad)
+	}
+	return err
+}
+
+// wizardFormats - The output formats that can be built for an operating system
+func wizardFormats(targetOS string) []string {
+	if targetOS == "windows" {
+		return []string{"exe", "shared", "service", "shellcode"}
+	}
+	return []string{"exe", "shared"}
+}
+
+// setWizardFormat - Set the output format the same way the --format flag does
+func setWizardFormat(config *clientpb.ImplantConfig, format string) {
+	config.IsSharedLib = false
+	config.IsService = false
+	config.IsShellcode = false
+	switch format {
+	case "shared":
+		config.Format = clientpb.OutputFormat_SHARED_LIB
+		config.IsSharedLib = true
+	case "service":
+		config.Format = clientpb.OutputFormat_SERVICE
+		config.IsService = true
+	case "shellcode":
+		if config.Format != clientpb.OutputFormat_SHELLCODE {
+			config.SGNEnabled = true // Enabled by default, same as the flags
+		}
+		config.Format = clientpb.OutputFormat_SHELLCODE
+		config.IsShellcode = true
+	default:
+		config.Format = clientpb.OutputFormat_EXECUTABLE
+	}
+	if !config.IsSharedLib {
+		config.RunAtLoad = false
+	}
+	if !config.IsShellcode {
+		config.SGNEnabled = false
+	}
+}
+
+func wizardC2(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	if 0 < len(config.C2) {
+		keep := true
+		err := survey.AskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Keep the profile's C2 endpoints (%s)?", strings.Join(c2URLs(config.C2), ", ")),
+			Default: true,
+		}, &keep)
+		if err != nil || keep {
+			return err
+		}
+	}
+
+	transports := []string{}
+	for _, transport := range wizardTransports {
+		if transport == "named-pipe" && config.GOOS != "windows" {
+			continue // Named pipe pivots are Windows only
+		}
+		transports = append(transports, transport)
+	}
+	selected := []string{}
+	err := survey.AskOne(&survey.MultiSelect{
+		Message: "C2 transports:",
+		Options: transports,
+	}, &selected, survey.WithValidator(survey.Required))
+	if err != nil {
+		return err
+	}
+
+	c2s := []*clientpb.ImplantC2{}
+	for _, transport := range selected {
+		for {
+			value := ""
+			err = survey.AskOne(&survey.Input{
+				Message: fmt.Sprintf("%s endpoints (comma separated):", transport),
+				Help:    fmt.Sprintf("e.g. %s", wizardC2Examples[transport]),
+			}, &value, survey.WithValidator(survey.Required))
+			if err != nil {
+				return err
+			}
+			transportC2s, err := wizardC2Parsers[transport](strings.TrimSpace(value))
+			if err != nil {
+				con.PrintErrorf("%s\n", err)
+				continue
+			}
+			c2s = append(c2s, transportC2s...)
+			break
+		}
+		if transport == "wg" {
+			err = wizardWireGuard(config, con)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(c2s) == 0 {
+		return errNoC2
+	}
+	config.C2 = c2s
+	return nil
+}
+
+func wizardWireGuard(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	keyExchange, err := askUint32("WireGuard key exchange port:", config.WGKeyExchangePort)
+	if err != nil {
+		return err
+	}
+	tcpComms, err := askUint32("WireGuard tcp comms port:", config.WGTcpCommsPort)
+	if err != nil {
+		return err
+	}
+	config.WGKeyExchangePort = keyExchange
+	config.WGTcpCommsPort = tcpComms
+	uniqueWGIP, err := con.Rpc.GenerateUniqueIP(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return fmt.Errorf("failed to generate unique ip for wg peer tun interface: %s", err)
+	}
+	config.WGPeerTunIP = net.ParseIP(uniqueWGIP.IP).String()
+	con.PrintInfof("Generated unique ip for wg peer tun interface: %s\n", config.WGPeerTunIP)
+	return nil
+}
+
+func wizardEvasion(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	const (
+		obfuscate = "Obfuscate symbols"
+		evasion   = "Evasion (overwrite user space hooks)"
+		sgn       = "Shikata Ga Nai shellcode encoder"
+		debug     = "Debug build (implies no symbol obfuscation)"
+		netgo     = "Pure Go network resolver (netgo)"
+		compress  = "Compress c2 messages (zstd)"
+	)
+	options := []string{obfuscate, evasion}
+	if config.IsShellcode {
+		options = append(options, sgn)
+	}
+	options = append(options, debug, netgo, compress)
+	defaults := []string{}
+	for option, enabled := range map[string]bool{
+		obfuscate: config.ObfuscateSymbols,
+		evasion:   config.Evasion,
+		sgn:       config.SGNEnabled,
+		debug:     config.Debug,
+		netgo:     config.NetGoEnabled,
+		compress:  config.CompressionEnabled,
+	} {
+		if enabled && contains(options, option) {
+			defaults = append(defaults, option)
+		}
+	}
+	selected := []string{}
+	err := survey.AskOne(&survey.MultiSelect{
+		Message: "Build options:",
+		Options: options,
+		Default: defaults,
+	}, &selected)
+	if err != nil {
+		return err
+	}
+	config.Debug = contains(selected, debug)
+	config.ObfuscateSymbols = contains(selected, obfuscate) && !config.Debug
+	config.Evasion = contains(selected, evasion)
+	config.SGNEnabled = contains(selected, sgn)
+	config.NetGoEnabled = contains(selected, netgo)
+	config.CompressionEnabled = contains(selected, compress)
+	return nil
+}
+
+func wizardConnection(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	customize := false
+	err := survey.AskOne(&survey.Confirm{
+		Message: "Customize the connection settings (strategy, reconnect, poll timeout, max errors)?",
+	}, &customize)
+	if err != nil || !customize {
+		return err
+	}
+
+	strategies := []string{"Sequential", "Random", "Random domain"}
+	strategy := strategies[0]
+	for name, value := range wizardStrategies {
+		if value == config.ConnectionStrategy {
+			strategy = name
+		}
+	}
+	err = survey.AskOne(&survey.Select{
+		Message: "Connection strategy:",
+		Options: strategies,
+		Default: strategy,
+	}, &strategy)
+	if err != nil {
+		return err
+	}
+	reconnect, err := askDuration("Reconnect interval:", time.Duration(config.ReconnectInterval), time.Second)
+	if err != nil {
+		return err
+	}
+	pollTimeout, err := askDuration("Poll timeout:", time.Duration(config.PollTimeout), time.Second)
+	if err != nil {
+		return err
+	}
+	maxErrors, err := askUint32("Max connection errors:", config.MaxConnectionErrors)
+	if err != nil {
+		return err
+	}
+	config.ConnectionStrategy = wizardStrategies[strategy]
+	if config.ConnectionStrategy == "s" {
+		config.ConnectionStrategy = ""
+	}
+	config.ReconnectInterval = int64(reconnect)
+	config.PollTimeout = int64(pollTimeout)
+	config.MaxConnectionErrors = maxErrors
+	return nil
+}
+
+func wizardLimits(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	customize := false
+	err := survey.AskOne(&survey.Confirm{
+		Message: "Limit where the implant will execute?",
+		Default: getLimitsString(config) != "",
+	}, &customize)
+	if err != nil || !customize {
+		return err
+	}
+	questions := []*survey.Question{
+		{Name: "LimitHostname", Prompt: &survey.Input{Message: "Hostname (blank for any):", Default: config.LimitHostname}},
+		{Name: "LimitUsername", Prompt: &survey.Input{Message: "Username (blank for any):", Default: config.LimitUsername}},
+		{Name: "LimitDomainJoined", Prompt: &survey.Confirm{Message: "Only domain joined machines?", Default: config.LimitDomainJoined}},
+		{
+			Name:   "LimitDatetime",
+			Prompt: &survey.Input{Message: "Expire after, RFC3339 (blank for never):", Default: config.LimitDatetime},
+			Validate: func(answer interface{}) error {
+				if value, _ := answer.(string); value != "" {
+					_, err := time.Parse(time.RFC3339, value)
+					return err
+				}
+				return nil
+			},
+		},
+		{Name: "LimitFileExists", Prompt: &survey.Input{Message: "Required file path (blank for none):", Default: config.LimitFileExists}},
+		{Name: "LimitLocale", Prompt: &survey.Input{Message: "Locale (blank for any):", Default: config.LimitLocale}},
+	}
+	return survey.Ask(questions, config)
+}
+
+func wizardName(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) error {
+	name := ""
+	err := survey.AskOne(&survey.Input{Message: "Implant name (blank for random):"}, &name, survey.WithValidator(func(answer interface{}) error {
+		if value, _ := answer.(string); value != "" {
+			return util.AllowedName(strings.ToLower(value))
+		}
+		return nil
+	}))
+	config.Name = strings.ToLower(name)
+	return err
+}
+
+// PrintWizardSummary - Print the settings the wizard will build with
+func PrintWizardSummary(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	for _, row := range wizardSummary(config) {
+		tw.AppendRow(row)
+	}
+	con.PrintInfof("Summary\n")
+	con.Printf("%s\n\n", tw.Render())
+}
+
+func wizardSummary(config *clientpb.ImplantConfig) []table.Row {
+	properties := populateProfileProperties(config)
+	name := config.Name
+	if name == "" {
+		name = "(random)"
+	}
+	rows := []table.Row{
+		{"Name", name},
+		{"Implant Type", properties["implanttype"]},
+	}
+	if config.IsBeacon {
+		rows = append(rows,
+			table.Row{"Beacon Interval", properties["beaconinterval"]},
+			table.Row{"Beacon Jitter", properties["beaconjitter"]},
+		)
+	}
+	rows = append(rows,
+		table.Row{"OS / Architecture", properties["osarch"]},
+		table.Row{"Implant Format", properties["format"]},
+		table.Row{"C2 Endpoints", properties["implantC2"]},
+		table.Row{"Connection Strategy", properties["connectstrat"]},
+		table.Row{"Reconnect Interval", properties["reconnect"]},
+		table.Row{"Poll Timeout", properties["polltimeout"]},
+		table.Row{"Max Connection Errors", properties["maxerrors"]},
+		table.Row{"Evasion is", properties["evasion"]},
+		table.Row{"Debugging is", properties["debugging"]},
+		table.Row{"Obfuscation of symbols is", properties["obsymbols"]},
+	)
+	if config.IsShellcode {
+		rows = append(rows, table.Row{"Shikata Ga Nai (SGN) is", properties["sgn"]})
+	}
+	if config.IsSharedLib {
+		rows = append(rows, table.Row{"Run at load", fmt.Sprintf("%v", config.RunAtLoad)})
+	}
+	if limits := getLimitsString(config); limits != "" {
+		rows = append(rows, table.Row{"Execution Limits", limits})
+	}
+	return rows
+}
+
+func askDuration(message string, value time.Duration, minimum time.Duration) (time.Duration, error) {
+	answer := ""
+	err := survey.AskOne(&survey.Input{
+		Message: message,
+		Default: value.String(),
+		Help:    "a duration e.g. 30s, 5m, 1h30m",
+	}, &answer, survey.WithValidator(func(answer interface{}) error {
+		duration, err := time.ParseDuration(answer.(string))
+		if err != nil {
+			return err
+		}
+		if duration < minimum {
+			return fmt.Errorf("must be %v or greater", minimum)
+		}
+		return nil
+	}))
+	if err != nil {
+		return 0, err
+	}
+	return time.ParseDuration(answer)
+}
+
+func askUint32(message string, value uint32) (uint32, error) {
+	answer := ""
+	err := survey.AskOne(&survey.Input{
+		Message: message,
+		Default: fmt.Sprintf("%d", value),
+	}, &answer, survey.WithValidator(func(answer interface{}) error {
+		_, err := strconv.ParseUint(answer.(string), 10, 32)
+		return err
+	}))
+	if err != nil {
+		return 0, err
+	}
+	result, err := strconv.ParseUint(answer, 10, 32)
+	return uint32(result), err
+}
+
+// wizardDefault - The value if it's one of the options, otherwise the first option
+func wizardDefault(options []string, value string) string {
+	if contains(options, value) || len(options) == 0 {
+		return value
+	}
+	return options[0]
+}
+
+func c2URLs(c2s []*clientpb.ImplantC2) []string {
+	urls := []string{}
+	for _, c2 := range c2s {
+		urls = append(urls, c2.URL)
+	}
+	return urls
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestWizardTargets(t *testing.T) {
+	targets := wizardTargets(&clientpb.Compiler{
+		Targets: []*clientpb.CompilerTarget{
+			{GOOS: "linux", GOARCH: "arm64", Format: clientpb.OutputFormat_EXECUTABLE},
+			{GOOS: "linux", GOARCH: "amd64", Format: clientpb.OutputFormat_EXECUTABLE},
+			{GOOS: "linux", GOARCH: "amd64", Format: clientpb.OutputFormat_SHARED_LIB},
+			{GOOS: "windows", GOARCH: "amd64", Format: clientpb.OutputFormat_SHELLCODE},
+		},
+	})
+	expected := map[string][]string{
+		"linux":   {"amd64", "arm64"},
+		"windows": {"amd64"},
+	}
+	if !reflect.DeepEqual(targets, expected) {
+		t.Fatalf("expected %v, got %v", expected, targets)
+	}
+
+	targets = wizardTargets(nil)
+	if !reflect.DeepEqual(targets["windows"], []string{"386", "amd64", "arm64"}) {
+		t.Fatalf("expected the default windows targets, got %v", targets["windows"])
+	}
+}
+
+func TestSetWizardFormat(t *testing.T) {
+	config := defaultWizardConfig()
+	setWizardFormat(config, "shellcode")
+	if config.Format != clientpb.OutputFormat_SHELLCODE || !config.IsShellcode || !config.SGNEnabled {
+		t.Fatalf("shellcode format not set: %v", config)
+	}
+
+	config.RunAtLoad = true
+	setWizardFormat(config, "shared")
+	if config.Format != clientpb.OutputFormat_SHARED_LIB || !config.IsSharedLib || config.IsShellcode {
+		t.Fatalf("shared format not set: %v", config)
+	}
+	if config.SGNEnabled {
+		t.Fatal("sgn should only be enabled for shellcode")
+	}
+
+	setWizardFormat(config, "exe")
+	if config.Format != clientpb.OutputFormat_EXECUTABLE || config.IsSharedLib || config.RunAtLoad {
+		t.Fatalf("exe format not set: %v", config)
+	}
+}
+
+func TestWizardFormats(t *testing.T) {
+	if formats := wizardFormats("linux"); contains(formats, "shellcode") || contains(formats, "service") {
+		t.Fatalf("shellcode and service are windows only: %v", formats)
+	}
+	if formats := wizardFormats("windows"); len(formats) != 4 {
+		t.Fatalf("expected every format for windows: %v", formats)
+	}
+}
+
+func TestWizardSummary(t *testing.T) {
+	config := defaultWizardConfig()
+	config.GOOS = "windows"
+	config.IsBeacon = true
+	config.C2 = []*clientpb.ImplantC2{{URL: "mtls://example.com:8888"}}
+	config.LimitHostname = "target"
+	setWizardFormat(config, "shellcode")
+
+	rows := map[string]interface{}{}
+	for _, row := range wizardSummary(config) {
+		rows[row[0].(string)] = row[1]
+	}
+	expected := map[string]interface{}{
+		"Name":                    "(random)",
+		"Implant Type":            "Beacon",
+		"Beacon Interval":         "60 seconds",
+		"C2 Endpoints":            "[1] mtls://example.com:8888",
+		"Implant Format":          "Shellcode",
+		"Shikata Ga Nai (SGN) is": "enabled",
+		"Execution Limits":        "hostname=target",
+	}
+	for key, value := range expected {
+		if rows[key] != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, rows[key])
+		}
+	}
+	if _, ok := rows["Run at load"]; ok {
+		t.Error("run at load should only be shown for shared libraries")
+	}
+}
@@ -0,0 +1,168 @@
+package graph
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+const (
+	serverNode   = "server"
+	listenerNode = "listener"
+	sessionNode  = "session"
+)
+
+// node - A server, listener, or session in the topology, the link is the label
+// of the edge from the node's parent (e.g. "pivot")
+type node struct {
+	id       string
+	kind     string
+	label    string
+	detail   string
+	link     string
+	children []*node
+}
+
+// GraphCmd - Show the listeners, sessions, and pivots as a topology
+func GraphCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	ctx, cancel := con.GrpcContext(cmd)
+	defer cancel()
+	jobs, err := con.Rpc.GetJobs(ctx, &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	pivotGraph, err := con.Rpc.PivotGraph(ctx, &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	root := buildTopology(jobs.Active, pivotGraph.Children)
+
+	format, _ := cmd.Flags().GetString("format")
+	save, _ := cmd.Flags().GetString("save")
+	var output string
+	switch strings.ToLower(format) {
+	case "text":
+		output = renderText(root, save == "")
+	case "dot":
+		output = renderDOT(root)
+	case "html":
+		output = renderHTML(root)
+	default:
+		con.PrintErrorf("Invalid format '%s', must be one of: text, dot, html\n", format)
+		return
+	}
+	if save == "" {
+		con.Printf("%s", output)
+		return
+	}
+	err = os.WriteFile(save, []byte(output), 0o600)
+	if err != nil {
+		con.PrintErrorf("Failed to write graph: %s\n", err)
+		return
+	}
+	con.PrintInfof("Saved graph to %s\n", save)
+}
+
+// buildTopology - The server, its listeners, the sessions that connected through each
+// listener, and the sessions pivoting through them. Sessions whose listener isn't known
+// (e.g. it was stopped) are under an "unknown listener" node.
+func buildTopology(jobs []*clientpb.Job, entries []*clientpb.PivotGraphEntry) *node {
+	root := &node{id: serverNode, kind: serverNode, label: "sliver server"}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID < jobs[j].ID
+	})
+	listeners := map[uint32]*node{}
+	for _, job := range jobs {
+		listener := &node{
+			id:    fmt.Sprintf("job-%d", job.ID),
+			kind:  listenerNode,
+			label: fmt.Sprintf("[%d] %s :%d", job.ID, job.Name, job.Port),
+		}
+		if 0 < len(job.Domains) {
+			listener.detail = strings.Join(job.Domains, ", ")
+		}
+		listeners[job.ID] = listener
+		root.children = append(root.children, listener)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	for _, entry := range entries {
+		listener, ok := listeners[entry.JobID]
+		if !ok {
+			listener, ok = listeners[0]
+			if !ok {
+				listener = &node{id: "job-0", kind: listenerNode, label: "unknown listener"}
+				listeners[0] = listener
+				root.children = append(root.children, listener)
+			}
+		}
+		listener.children = append(listener.children, sessionTree(entry, ""))
+	}
+	return root
+}
+
+func sessionTree(entry *clientpb.PivotGraphEntry, link string) *node {
+	session := &node{
+		id:    fmt.Sprintf("session-%d", entry.PeerID),
+		kind:  sessionNode,
+		label: entry.Name,
+		link:  link,
+	}
+	if entry.Session != nil {
+		session.id = "session-" + entry.Session.ID
+		session.detail = sessionDetail(entry.Session)
+	}
+	children := append([]*clientpb.PivotGraphEntry{}, entry.Children...)
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+	for _, child := range children {
+		session.children = append(session.children, sessionTree(child, "pivot"))
+	}
+	return session
+}
+
+func sessionDetail(session *clientpb.Session) string {
+	parts := []string{}
+	switch {
+	case session.Username != "" && session.Hostname != "":
+		parts = append(parts, session.Username+"@"+session.Hostname)
+	case session.Hostname != "":
+		parts = append(parts, session.Hostname)
+	}
+	if session.OS != "" {
+		parts = append(parts, session.OS+"/"+session.Arch)
+	}
+	if session.RemoteAddress != "" {
+		parts = append(parts, session.RemoteAddress)
+	}
+	return strings.Join(parts, " ")
+}
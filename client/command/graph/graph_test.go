@@ -0,0 +1,84 @@
+package graph
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func testTopology() *node {
+	jobs := []*clientpb.Job{
+		{ID: 2, Name: "https", Port: 443, Domains: []string{"example.com"}},
+		{ID: 1, Name: "mtls", Port: 8888},
+	}
+	entries := []*clientpb.PivotGraphEntry{
+		{
+			Name:    "WISE_OTTER",
+			JobID:   1,
+			Session: &clientpb.Session{ID: "a", Username: "alice", Hostname: "ws1", OS: "windows", Arch: "amd64"},
+			Children: []*clientpb.PivotGraphEntry{
+				{Name: "CALM_FOX", Session: &clientpb.Session{ID: "b", Hostname: "db1"}},
+			},
+		},
+		{Name: "LOST_OWL", JobID: 7, Session: &clientpb.Session{ID: "c"}},
+	}
+	return buildTopology(jobs, entries)
+}
+
+func TestBuildTopology(t *testing.T) {
+	root := testTopology()
+	if len(root.children) != 3 {
+		t.Fatalf("expected 2 listeners and an unknown listener, got %d", len(root.children))
+	}
+	if root.children[0].label != "[1] mtls :8888" || root.children[1].detail != "example.com" {
+		t.Errorf("expected listeners sorted by job id")
+	}
+	mtls := root.children[0]
+	if len(mtls.children) != 1 || mtls.children[0].label != "WISE_OTTER" {
+		t.Fatalf("expected WISE_OTTER under the mtls listener")
+	}
+	pivot := mtls.children[0].children[0]
+	if pivot.label != "CALM_FOX" || pivot.link != "pivot" {
+		t.Errorf("expected CALM_FOX to pivot through WISE_OTTER")
+	}
+	unknown := root.children[2]
+	if unknown.label != "unknown listener" || unknown.children[0].label != "LOST_OWL" {
+		t.Errorf("expected LOST_OWL under the unknown listener")
+	}
+}
+
+func TestRender(t *testing.T) {
+	root := testTopology()
+	text := renderText(root, false)
+	for _, expected := range []string{"sliver server\n", "├── [1] mtls :8888\n", "│   └── WISE_OTTER - alice@ws1 windows/amd64", "│       └── (pivot) CALM_FOX - db1\n", "    └── LOST_OWL\n"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("expected %q in:\n%s", expected, text)
+		}
+	}
+	dot := renderDOT(root)
+	if !strings.Contains(dot, `"session-a" -> "session-b" [label="pivot", style=dashed];`) {
+		t.Errorf("expected a pivot edge in:\n%s", dot)
+	}
+	if !strings.Contains(renderHTML(root), `<span class="session">WISE_OTTER</span>`) {
+		t.Errorf("expected WISE_OTTER in the html")
+	}
+}
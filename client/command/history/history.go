@@ -0,0 +1,155 @@
+package history
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// match - A history entry matching the search, higher scores are better matches
+type match struct {
+	entry *clientpb.TargetHistoryEntry
+	index int
+	score int
+}
+
+// HistoryCmd - Show or fuzzy search the command history of the active session/beacon
+func HistoryCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetID := ""
+	if session != nil {
+		targetID = session.ID
+	} else {
+		targetID = beacon.ID
+	}
+	history, err := con.Rpc.GetTargetHistory(context.Background(), &clientpb.TargetHistoryReq{TargetID: targetID})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	operator, _ := cmd.Flags().GetString("operator")
+	limit, _ := cmd.Flags().GetInt("limit")
+	matches := search(history.Entries, strings.Join(args, " "), operator)
+	if len(matches) == 0 {
+		con.PrintInfof("No history\n")
+		return
+	}
+	if 0 < limit && limit < len(matches) {
+		matches = matches[:limit]
+	}
+	con.Printf("%s\n", historyTable(matches, con))
+}
+
+// search - Entries fuzzy matching the query, best (and then most recent) first. Without
+// a query every entry matches and the most recent is first.
+func search(entries []*clientpb.TargetHistoryEntry, query string, operator string) []*match {
+	matches := []*match{}
+	for index, entry := range entries {
+		if operator != "" && !strings.EqualFold(entry.Operator, operator) {
+			continue
+		}
+		score, ok := fuzzyScore(entry.Line, query)
+		if !ok {
+			continue
+		}
+		matches = append(matches, &match{entry: entry, index: index, score: score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[j].score < matches[i].score
+		}
+		return matches[j].index < matches[i].index
+	})
+	return matches
+}
+
+// fuzzyScore - Whether the characters of the query appear in the line in order (ignoring
+// case and whitespace), consecutive characters and matches at the start of a word score higher
+func fuzzyScore(line string, query string) (int, bool) {
+	needle := []rune{}
+	for _, char := range strings.ToLower(query) {
+		if !unicode.IsSpace(char) {
+			needle = append(needle, char)
+		}
+	}
+	if len(needle) == 0 {
+		return 0, true
+	}
+	haystack := []rune(strings.ToLower(line))
+	score := 0
+	next := 0
+	previous := -2
+	for index, char := range haystack {
+		if char != needle[next] {
+			continue
+		}
+		score++
+		if index == previous+1 {
+			score += 2
+		}
+		if index == 0 || unicode.IsSpace(haystack[index-1]) || haystack[index-1] == '-' || haystack[index-1] == '/' {
+			score++
+		}
+		previous = index
+		next++
+		if next == len(needle) {
+			return score, true
+		}
+	}
+	return 0, false
+}
+
+func historyTable(matches []*match, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"#",
+		"Time",
+		"Operator",
+		"Command",
+	})
+	for _, match := range matches {
+		operator := match.entry.Operator
+		if operator == "" {
+			operator = "-"
+		}
+		tw.AppendRow(table.Row{
+			match.index + 1,
+			time.Unix(match.entry.CreatedAt, 0).Format(time.RFC1123),
+			operator,
+			match.entry.Line,
+		})
+	}
+	return tw.Render()
+}
@@ -0,0 +1,63 @@
+package history
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	if _, ok := fuzzyScore("download /etc/passwd", "dl pwd"); !ok {
+		t.Errorf("expected subsequence to match")
+	}
+	if _, ok := fuzzyScore("download /etc/passwd", "shadow"); ok {
+		t.Errorf("expected no match")
+	}
+	if _, ok := fuzzyScore("ls", ""); !ok {
+		t.Errorf("expected empty query to match")
+	}
+	exact, _ := fuzzyScore("ps -e chrome", "ps")
+	scattered, _ := fuzzyScore("upload setup.exe", "ps")
+	if exact <= scattered {
+		t.Errorf("expected consecutive match to score higher (%d <= %d)", exact, scattered)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	entries := []*clientpb.TargetHistoryEntry{
+		{Line: "ls", Operator: "alice"},
+		{Line: "ps", Operator: "bob"},
+		{Line: "upload setup.exe", Operator: "alice"},
+		{Line: "ps -e chrome", Operator: "alice"},
+	}
+	matches := search(entries, "", "")
+	if len(matches) != 4 || matches[0].entry.Line != "ps -e chrome" {
+		t.Fatalf("expected every entry, most recent first")
+	}
+	matches = search(entries, "ps", "")
+	if len(matches) != 3 || matches[0].entry.Line != "ps -e chrome" || matches[2].entry.Line != "upload setup.exe" {
+		t.Fatalf("unexpected matches %v", matches)
+	}
+	matches = search(entries, "ps", "BOB")
+	if len(matches) != 1 || matches[0].entry.Line != "ps" {
+		t.Fatalf("expected operator filter to match case-insensitively")
+	}
+}
@@ -0,0 +1,172 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// HostsCoverageCmd - Show the MITRE ATT&CK techniques used per host and for the engagement
+func HostsCoverageCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	since, _ := cmd.Flags().GetDuration("since")
+	perHost, _ := cmd.Flags().GetBool("per-host")
+	save, _ := cmd.Flags().GetString("save")
+
+	req := &clientpb.AttackCoverageReq{}
+	for _, arg := range args {
+		host, err := hostByPrefix(arg, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		req.HostUUIDs = append(req.HostUUIDs, host.HostUUID)
+	}
+	if 0 < since {
+		req.Since = time.Now().Add(-since).Unix()
+	}
+	coverage, err := con.Rpc.AttackCoverage(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	if save != "" {
+		data, err := exportCoverage(coverage)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		err = os.WriteFile(save, data, 0600)
+		if err != nil {
+			con.PrintErrorf("Failed to write coverage %s\n", err)
+			return
+		}
+		con.PrintInfof("Saved coverage of %d host(s) to %s\n", len(coverage.Hosts), save)
+		return
+	}
+
+	if len(coverage.Techniques) == 0 {
+		con.PrintInfof("No techniques used\n")
+		return
+	}
+	used := 0
+	for _, technique := range coverage.Techniques {
+		if 0 < technique.Count {
+			used++
+		}
+	}
+	con.Printf("%s\n", coverageTable(coverage.Techniques, hostsUsing(coverage), con))
+	con.PrintInfof("%d of %d tagged technique(s) used on %d host(s)\n", used, coverage.Tagged, len(coverage.Hosts))
+	if !perHost {
+		return
+	}
+	for _, host := range coverage.Hosts {
+		if len(host.Techniques) == 0 {
+			continue
+		}
+		con.Println()
+		con.Printf("%s (%s)\n", host.Host.Hostname, strings.Split(host.Host.HostUUID, "-")[0])
+		con.Printf("%s\n", coverageTable(host.Techniques, nil, con))
+	}
+}
+
+// hostsUsing - The number of hosts each technique was used on
+func hostsUsing(coverage *clientpb.AttackCoverage) map[string]int {
+	hostCounts := map[string]int{}
+	for _, host := range coverage.Hosts {
+		for _, technique := range host.Techniques {
+			if 0 < technique.Count {
+				hostCounts[technique.ID]++
+			}
+		}
+	}
+	return hostCounts
+}
+
+// coverageTable - The techniques in kill chain order, with the number of hosts
+// they were used on if hostCounts isn't nil
+func coverageTable(techniques []*clientpb.AttackTechnique, hostCounts map[string]int, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	header := table.Row{"Tactic", "ID", "Technique"}
+	if hostCounts != nil {
+		header = append(header, "Hosts")
+	}
+	header = append(header, "Count", "Failed", "Operators", "Last Seen")
+	tw.AppendHeader(header)
+	for _, technique := range techniques {
+		row := table.Row{technique.Tactic, technique.ID, technique.Name}
+		if hostCounts != nil {
+			row = append(row, hostCounts[technique.ID])
+		}
+		lastSeen := ""
+		if technique.LastSeen != 0 {
+			lastSeen = time.Unix(technique.LastSeen, 0).Format(time.RFC1123)
+		}
+		row = append(row, technique.Count, technique.Failed, strings.Join(technique.Operators, ", "), lastSeen)
+		tw.AppendRow(row)
+	}
+	return tw.Render()
+}
+
+// exportCoverage - One csv row per technique per host, times are UTC RFC3339 so they
+// can be compared with the detections
+func exportCoverage(coverage *clientpb.AttackCoverage) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+	writer.Write([]string{"hostname", "host_uuid", "tactic", "technique_id", "technique", "count", "failed", "operators", "first_seen", "last_seen"})
+	for _, host := range coverage.Hosts {
+		for _, technique := range host.Techniques {
+			writer.Write([]string{
+				host.Host.Hostname,
+				host.Host.HostUUID,
+				technique.Tactic,
+				technique.ID,
+				technique.Name,
+				fmt.Sprintf("%d", technique.Count),
+				fmt.Sprintf("%d", technique.Failed),
+				strings.Join(technique.Operators, ";"),
+				coverageTime(technique.FirstSeen),
+				coverageTime(technique.LastSeen),
+			})
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+func coverageTime(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,56 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestExportCoverage(t *testing.T) {
+	coverage := &clientpb.AttackCoverage{
+		Hosts: []*clientpb.HostAttackCoverage{{
+			Host: &clientpb.Host{Hostname: "WS01", HostUUID: "c5ad3b1e-0000-4000-8000-000000000000"},
+			Techniques: []*clientpb.AttackTechnique{
+				{ID: "T1057", Name: "Process Discovery", Tactic: "discovery", Count: 2, Operators: []string{"alice", "bob"}, FirstSeen: 1700000000, LastSeen: 1700000060},
+				{ID: "T1003.001", Name: "OS Credential Dumping: LSASS Memory", Tactic: "credential-access", Failed: 1},
+			},
+		}},
+	}
+	data, err := exportCoverage(coverage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected a header and two rows, got %v", records)
+	}
+	if records[1][3] != "T1057" || records[1][7] != "alice;bob" || records[1][8] != "2023-11-14T22:13:20Z" {
+		t.Fatalf("Unexpected row %v", records[1])
+	}
+	if records[2][6] != "1" || records[2][8] != "" {
+		t.Fatalf("Unexpected row %v", records[2])
+	}
+}
@@ -0,0 +1,109 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/network"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// HostsDiscoveredCmd - Show the hosts found by network discovery run from hosts
+func HostsDiscoveredCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	req := &clientpb.DiscoveredHostsReq{}
+	if 0 < len(args) {
+		host, err := hostByPrefix(args[0], con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		req.HostUUID = host.HostUUID
+	}
+	req.Address, _ = cmd.Flags().GetString("address")
+	discovered, err := con.Rpc.GetDiscoveredHosts(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(discovered.Hosts) == 0 {
+		con.PrintInfof("No discovered hosts, run 'discover' on a session or beacon\n")
+		return
+	}
+	allHosts, err := con.Rpc.Hosts(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	hostnames := map[string]string{}
+	for _, host := range allHosts.Hosts {
+		hostnames[host.HostUUID] = host.Hostname
+	}
+	con.Printf("%s\n", discoveredHostsTable(discovered.Hosts, hostnames, con))
+}
+
+func discoveredHostsTable(records []*clientpb.DiscoveredHostRecord, hostnames map[string]string, con *console.SliverConsoleClient) string {
+	sort.SliceStable(records, func(i, j int) bool {
+		return network.AddressLess(records[i].Host.GetAddress(), records[j].Host.GetAddress())
+	})
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Address",
+		"MAC",
+		"Hostnames",
+		"Roles",
+		"Shares",
+		"Sessions",
+		"Discovered From",
+		"Implant",
+		"Updated",
+	})
+	for _, record := range records {
+		host := record.Host
+		if host == nil {
+			continue
+		}
+		discoveredFrom, ok := hostnames[record.HostUUID]
+		if !ok {
+			discoveredFrom = record.HostUUID
+		}
+		tw.AppendRow(table.Row{
+			host.Address,
+			host.MAC,
+			strings.Join(host.Hostnames, ", "),
+			strings.Join(host.Roles, ", "),
+			network.FormatShares(host.Shares),
+			network.FormatSessions(host.Sessions),
+			discoveredFrom,
+			record.ImplantName,
+			con.FormatDateDelta(time.Unix(record.UpdatedAt, 0), true, false),
+		})
+	}
+	return tw.Render()
+}
@@ -0,0 +1,76 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// HostsExportCmd - Export the engagement as a STIX bundle or Attack Flow document
+func HostsExportCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	format, _ := cmd.Flags().GetString("format")
+	name, _ := cmd.Flags().GetString("name")
+	since, _ := cmd.Flags().GetDuration("since")
+	save, _ := cmd.Flags().GetString("save")
+
+	hostUUIDs := []string{}
+	for _, arg := range args {
+		host, err := hostByPrefix(arg, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		hostUUIDs = append(hostUUIDs, host.HostUUID)
+	}
+	req := &clientpb.EngagementExportReq{
+		Format:    strings.ToLower(format),
+		Name:      name,
+		HostUUIDs: hostUUIDs,
+	}
+	if 0 < since {
+		req.Since = time.Now().Add(-since).Unix()
+	}
+	file, err := con.Rpc.EngagementExport(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	saveTo := file.Name
+	if save != "" {
+		saveTo = save
+		if fi, err := os.Stat(save); err == nil && fi.IsDir() {
+			saveTo = filepath.Join(save, file.Name)
+		}
+	}
+	err = os.WriteFile(saveTo, file.Data, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to write export %s\n", err)
+		return
+	}
+	con.PrintInfof("Exported %s to %s\n", req.Format, saveTo)
+}
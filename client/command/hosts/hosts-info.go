@@ -0,0 +1,286 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// HostsInfoCmd - Show everything known about a host across all of its sessions and beacons
+func HostsInfoCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	var host *clientpb.Host
+	var err error
+	if 0 < len(args) {
+		host, err = hostByPrefix(args[0], con)
+	} else {
+		host, err = SelectHost(con)
+	}
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	limit, _ := cmd.Flags().GetInt32("history")
+	details, err := con.Rpc.HostDetails(context.Background(), &clientpb.HostDetailsReq{
+		HostUUID:     host.HostUUID,
+		HistoryLimit: limit,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	host = details.Host
+
+	con.Printf(console.Bold+"     Hostname: %s%s\n", console.Normal, host.Hostname)
+	con.Printf(console.Bold+"    Host UUID: %s%s\n", console.Normal, host.HostUUID)
+	con.Printf(console.Bold+"           OS: %s%s\n", console.Normal, host.OSVersion)
+	con.Printf(console.Bold+"       Locale: %s%s\n", console.Normal, host.Locale)
+	con.Printf(console.Bold+"         Tags: %s%s\n", console.Normal, strings.Join(host.Tags, ", "))
+	con.Printf(console.Bold+"First Contact: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(host.FirstContact, 0), true, false))
+	PrintHostSurvey(host, 13, con)
+	con.Println()
+
+	if 0 < len(details.Sessions)+len(details.Beacons) {
+		con.Printf("%s\n\n", hostImplantsTable(details, con))
+	} else {
+		con.PrintInfof("No sessions or beacons\n\n")
+	}
+
+	if 0 < len(details.Loot) {
+		con.Printf("%s\n\n", hostLootTable(details.Loot, con))
+	} else {
+		con.PrintInfof("No loot\n\n")
+	}
+
+	if 0 < len(details.History) {
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		con.Printf("%s\n\n", hostHistoryTable(details.History, verbose, con))
+	} else {
+		con.PrintInfof("No command history\n\n")
+	}
+
+	if len(details.Processes) == 0 {
+		con.PrintInfof("No process list, run 'ps' on a session or beacon\n")
+		return
+	}
+	showProcesses, _ := cmd.Flags().GetBool("processes")
+	if !showProcesses {
+		con.PrintInfof("%d process(es) listed by %s %s, use --processes to show them\n",
+			len(details.Processes), details.ProcessesImplantName,
+			con.FormatDateDelta(time.Unix(details.ProcessesAt, 0), true, false),
+		)
+		return
+	}
+	con.Printf("%s\n", hostProcessesTable(details.Processes, con))
+}
+
+// PrintHostSurvey - Print the survey implants collected when they registered on the host,
+// labels are right aligned to width
+func PrintHostSurvey(host *clientpb.Host, width int, con *console.SliverConsoleClient) {
+	survey := host.Survey
+	if survey == nil {
+		return
+	}
+	localAdmin := "no"
+	if survey.LocalAdmin {
+		localAdmin = "yes"
+	}
+	for _, field := range [][2]string{
+		{"Security", strings.Join(survey.SecurityProducts, ", ")},
+		{"Domain", survey.Domain},
+		{"Forest", survey.Forest},
+		{"Proxy", survey.Proxy},
+		{"Runtimes", strings.Join(survey.Runtimes, ", ")},
+		{"Virtualization", strings.Join(survey.Virtualization, ", ")},
+		{"Local Admin", localAdmin},
+		{"Surveyed", con.FormatDateDelta(time.Unix(host.SurveyedAt, 0), true, false)},
+	} {
+		if field[1] != "" {
+			con.Printf(console.Bold+"%*s: %s%s\n", width, field[0], console.Normal, field[1])
+		}
+	}
+}
+
+func hostImplantsTable(details *clientpb.HostDetails, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Name",
+		"Type",
+		"Transport",
+		"Remote Address",
+		"Username",
+		"Last Check-in",
+	})
+	for _, session := range details.Sessions {
+		tw.AppendRow(table.Row{
+			strings.Split(session.ID, "-")[0],
+			session.Name,
+			"session",
+			session.Transport,
+			session.RemoteAddress,
+			session.Username,
+			hostCheckin(session.LastCheckin, session.IsDead, con),
+		})
+	}
+	for _, beacon := range details.Beacons {
+		tw.AppendRow(table.Row{
+			strings.Split(beacon.ID, "-")[0],
+			beacon.Name,
+			"beacon",
+			beacon.Transport,
+			beacon.RemoteAddress,
+			beacon.Username,
+			hostCheckin(beacon.LastCheckin, beacon.IsDead, con),
+		})
+	}
+	return tw.Render()
+}
+
+func hostCheckin(lastCheckin int64, isDead bool, con *console.SliverConsoleClient) string {
+	if isDead {
+		return "dead"
+	}
+	return con.FormatDateDelta(time.Unix(lastCheckin, 0), true, false)
+}
+
+func hostLootTable(allLoot []*clientpb.Loot, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Name",
+		"File Type",
+		"Size",
+		"Tags",
+	})
+	for _, loot := range allLoot {
+		tw.AppendRow(table.Row{
+			strings.Split(loot.ID, "-")[0],
+			loot.Name,
+			loot.FileType.String(),
+			loot.Size,
+			strings.Join(loot.Tags, ", "),
+		})
+	}
+	return tw.Render()
+}
+
+func hostHistoryTable(history []*clientpb.HostCommand, verbose bool, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Time",
+		"Implant",
+		"Operator",
+		"Command",
+		"Technique",
+		"Arguments",
+		"Error",
+	})
+	// History is newest first, show it in the order it was run
+	for index := len(history) - 1; 0 <= index; index-- {
+		command := history[index]
+		arguments := command.Request
+		if !verbose && 60 < len(arguments) {
+			arguments = arguments[:57] + "..."
+		}
+		tw.AppendRow(table.Row{
+			time.Unix(command.CreatedAt, 0).Format(time.RFC1123),
+			command.ImplantName,
+			command.Operator,
+			command.Method,
+			command.TechniqueID,
+			arguments,
+			command.Error,
+		})
+	}
+	return tw.Render()
+}
+
+func hostProcessesTable(processes []*commonpb.Process, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"PID",
+		"PPID",
+		"Owner",
+		"Arch",
+		"Executable",
+	})
+	for _, process := range processes {
+		tw.AppendRow(table.Row{
+			process.Pid,
+			process.Ppid,
+			process.Owner,
+			process.Architecture,
+			process.Executable,
+		})
+	}
+	return tw.Render()
+}
+
+// hostByPrefix - Find a host by a host UUID prefix or hostname
+func hostByPrefix(prefix string, con *console.SliverConsoleClient) (*clientpb.Host, error) {
+	allHosts, err := con.Rpc.Hosts(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	var found *clientpb.Host
+	for _, host := range allHosts.Hosts {
+		if strings.HasPrefix(host.HostUUID, prefix) || strings.EqualFold(host.Hostname, prefix) {
+			if found != nil {
+				return nil, fmt.Errorf("'%s' matches more than one host", prefix)
+			}
+			found = host
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no host matching '%s'", prefix)
+	}
+	return found, nil
+}
+
+// HostIDCompleter - Completes host UUIDs
+func HostIDCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := make([]string, 0)
+		allHosts, err := con.Rpc.Hosts(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("failed to get hosts: %s", err)
+		}
+		for _, host := range allHosts.Hosts {
+			results = append(results, host.HostUUID, host.Hostname)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("hosts")
+	}
+	return carapace.ActionCallback(callback)
+}
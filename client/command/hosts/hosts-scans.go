@@ -0,0 +1,110 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/network"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// HostsScansCmd - Show the results of scans run from hosts
+func HostsScansCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	req := &clientpb.ScanResultsReq{}
+	if 0 < len(args) {
+		host, err := hostByPrefix(args[0], con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		req.HostUUID = host.HostUUID
+	}
+	req.Address, _ = cmd.Flags().GetString("address")
+	results, err := con.Rpc.GetScanResults(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(results.Results) == 0 {
+		con.PrintInfof("No scan results, run 'scan' on a session or beacon\n")
+		return
+	}
+	allHosts, err := con.Rpc.Hosts(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	hostnames := map[string]string{}
+	for _, host := range allHosts.Hosts {
+		hostnames[host.HostUUID] = host.Hostname
+	}
+	con.Printf("%s\n", scanResultsTable(results.Results, hostnames, con))
+}
+
+func scanResultsTable(results []*clientpb.ScanResult, hostnames map[string]string, con *console.SliverConsoleClient) string {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Address != results[j].Address {
+			return network.AddressLess(results[i].Address, results[j].Address)
+		}
+		return results[i].Port < results[j].Port
+	})
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Address",
+		"Port",
+		"Service",
+		"Banner",
+		"Scanned From",
+		"Implant",
+		"Updated",
+	})
+	for _, result := range results {
+		port, banner := "", network.FormatBanner(result.Banner)
+		if result.Port == 0 {
+			banner = "(up)"
+		} else {
+			port = strconv.Itoa(int(result.Port))
+		}
+		scannedFrom, ok := hostnames[result.HostUUID]
+		if !ok {
+			scannedFrom = result.HostUUID
+		}
+		tw.AppendRow(table.Row{
+			result.Address,
+			port,
+			network.ServiceName(result.Port),
+			banner,
+			scannedFrom,
+			result.ImplantName,
+			con.FormatDateDelta(time.Unix(result.UpdatedAt, 0), true, false),
+		})
+	}
+	return tw.Render()
+}
@@ -0,0 +1,194 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// Timeline export formats
+const (
+	TimelineText = "text"
+	TimelineCSV  = "csv"
+	TimelineJSON = "json"
+)
+
+// HostsTimelineCmd - Show everything that happened on a host in chronological order
+func HostsTimelineCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	var host *clientpb.Host
+	var err error
+	if 0 < len(args) {
+		host, err = hostByPrefix(args[0], con)
+	} else {
+		host, err = SelectHost(con)
+	}
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	format, _ := cmd.Flags().GetString("format")
+	format = strings.ToLower(format)
+	if format != TimelineText && format != TimelineCSV && format != TimelineJSON {
+		con.PrintErrorf("Invalid format '%s' (text, csv, json)\n", format)
+		return
+	}
+	timeline, err := con.Rpc.HostTimeline(context.Background(), &clientpb.Host{HostUUID: host.HostUUID})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	kinds, _ := cmd.Flags().GetStringSlice("kind")
+	events := filterTimeline(timeline.Events, kinds)
+
+	save, _ := cmd.Flags().GetString("save")
+	if format == TimelineText && save == "" {
+		if len(events) == 0 {
+			con.PrintInfof("No events\n")
+			return
+		}
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		con.Printf("%s\n", hostTimelineTable(events, verbose, con))
+		return
+	}
+	if format == TimelineText {
+		format = TimelineCSV
+	}
+	data, err := exportTimeline(timeline.Host, events, format)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if save == "" {
+		con.Printf("%s", data)
+		return
+	}
+	err = os.WriteFile(save, data, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to write timeline %s\n", err)
+		return
+	}
+	con.PrintInfof("Saved %d event(s) to %s\n", len(events), save)
+}
+
+// filterTimeline - Only the events of the given kinds, all events if no kinds are given
+func filterTimeline(events []*clientpb.TimelineEvent, kinds []string) []*clientpb.TimelineEvent {
+	if len(kinds) == 0 {
+		return events
+	}
+	filtered := []*clientpb.TimelineEvent{}
+	for _, event := range events {
+		for _, kind := range kinds {
+			if strings.EqualFold(event.Kind, kind) {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+type timelineRecord struct {
+	Time     string `json:"time"`
+	Hostname string `json:"hostname"`
+	Kind     string `json:"kind"`
+	Implant  string `json:"implant,omitempty"`
+	Operator string `json:"operator,omitempty"`
+	Summary  string `json:"summary"`
+	Error    string `json:"error,omitempty"`
+}
+
+// exportTimeline - Render events as csv or json, times are UTC RFC3339 so they sort
+// and can be compared with logs from the host
+func exportTimeline(host *clientpb.Host, events []*clientpb.TimelineEvent, format string) ([]byte, error) {
+	records := []timelineRecord{}
+	for _, event := range events {
+		records = append(records, timelineRecord{
+			Time:     time.Unix(event.Timestamp, 0).UTC().Format(time.RFC3339),
+			Hostname: host.Hostname,
+			Kind:     event.Kind,
+			Implant:  event.ImplantName,
+			Operator: event.Operator,
+			Summary:  event.Summary,
+			Error:    event.Error,
+		})
+	}
+	switch format {
+	case TimelineJSON:
+		return json.MarshalIndent(records, "", "  ")
+	case TimelineCSV:
+		buf := &bytes.Buffer{}
+		writer := csv.NewWriter(buf)
+		writer.Write([]string{"time", "hostname", "kind", "implant", "operator", "summary", "error"})
+		for _, record := range records {
+			writer.Write([]string{
+				record.Time, record.Hostname, record.Kind, record.Implant,
+				record.Operator, record.Summary, record.Error,
+			})
+		}
+		writer.Flush()
+		return buf.Bytes(), writer.Error()
+	}
+	return nil, fmt.Errorf("unsupported format '%s'", format)
+}
+
+func hostTimelineTable(events []*clientpb.TimelineEvent, verbose bool, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Time",
+		"Kind",
+		"Implant",
+		"Operator",
+		"Event",
+		"Error",
+	})
+	for _, event := range events {
+		summary := event.Summary
+		if !verbose && 80 < len(summary) {
+			summary = summary[:77] + "..."
+		}
+		kind := event.Kind
+		if event.Kind == "persistence" {
+			kind = console.Bold + console.Orange + kind + console.Normal
+		}
+		tw.AppendRow(table.Row{
+			time.Unix(event.Timestamp, 0).Format(time.RFC1123),
+			kind,
+			event.ImplantName,
+			event.Operator,
+			summary,
+			event.Error,
+		})
+	}
+	return tw.Render()
+}
@@ -0,0 +1,77 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestExportTimeline(t *testing.T) {
+	host := &clientpb.Host{Hostname: "WS01"}
+	events := []*clientpb.TimelineEvent{
+		{Timestamp: 0, Kind: "contact", Summary: "first contact with WS01"},
+		{Timestamp: 60, Kind: "persistence", ImplantName: "IMPLANT", Operator: "alice", Summary: `StartService {"ServiceName":"a,b"}`},
+		{Timestamp: 120, Kind: "file", ImplantName: "IMPLANT", Operator: "bob", Summary: "Rm", Error: "access denied"},
+	}
+
+	data, err := exportTimeline(host, events, TimelineCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 4 || lines[0] != "time,hostname,kind,implant,operator,summary,error" {
+		t.Fatalf("Unexpected csv %q", data)
+	}
+	if lines[2] != `1970-01-01T00:01:00Z,WS01,persistence,IMPLANT,alice,"StartService {""ServiceName"":""a,b""}",` {
+		t.Fatalf("Unexpected csv record %q", lines[2])
+	}
+
+	data, err = exportTimeline(host, events, TimelineJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := []timelineRecord{}
+	err = json.Unmarshal(data, &records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 || records[2].Error != "access denied" || records[0].Implant != "" {
+		t.Fatalf("Unexpected json %s", data)
+	}
+
+	_, err = exportTimeline(host, events, "xml")
+	if err == nil {
+		t.Fatal("Expected an unsupported format error")
+	}
+}
+
+func TestFilterTimeline(t *testing.T) {
+	events := []*clientpb.TimelineEvent{{Kind: "task"}, {Kind: "file"}, {Kind: "loot"}}
+	if len(filterTimeline(events, nil)) != 3 {
+		t.Fatal("Expected no kinds to keep every event")
+	}
+	filtered := filterTimeline(events, []string{"FILE", "loot"})
+	if len(filtered) != 2 || filtered[0].Kind != "file" || filtered[1].Kind != "loot" {
+		t.Fatalf("Unexpected events %v", filtered)
+	}
+}
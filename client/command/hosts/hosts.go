@@ -72,6 +72,7 @@ func hostsTable(hosts []*clientpb.Host, con *console.SliverConsoleClient) string
 		"Beacons",
 		"IOCs",
 		"Extensions",
+		"Tags",
 		"First Contact",
 	})
 	for _, host := range hosts {
@@ -90,6 +91,7 @@ func hostsTable(hosts []*clientpb.Host, con *console.SliverConsoleClient) string
 			hostBeacons(host.HostUUID, con),
 			len(host.IOCs),
 			len(host.ExtensionData),
+			strings.Join(host.Tags, ", "),
 			con.FormatDateDelta(time.Unix(host.FirstContact, 0), true, false),
 		})
 	}
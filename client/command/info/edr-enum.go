@@ -0,0 +1,110 @@
+package info
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// EDREnumCmd - Enumerate the AV/EDR products on the remote system
+func EDREnumCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	edrEnum, err := con.Rpc.EDREnum(context.Background(), &sliverpb.EDREnumReq{
+		Request: con.ActiveTarget.Request(cmd),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if edrEnum.Response != nil && edrEnum.Response.Async {
+		con.AddBeaconCallback(edrEnum.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, edrEnum)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintEDREnum(edrEnum, con)
+		})
+		con.PrintAsyncResponse(edrEnum.Response)
+	} else {
+		PrintEDREnum(edrEnum, con)
+	}
+}
+
+// PrintEDREnum - Print the security products and the components found of each
+func PrintEDREnum(edrEnum *sliverpb.EDREnum, con *console.SliverConsoleClient) {
+	if edrEnum.Response != nil && edrEnum.Response.Err != "" {
+		con.PrintErrorf("%s\n", edrEnum.Response.Err)
+		return
+	}
+	for _, warning := range edrEnum.Warnings {
+		con.PrintWarnf("%s\n", warning)
+	}
+	if len(edrEnum.Products) == 0 {
+		con.PrintInfof("No known security products found\n")
+	} else {
+		con.PrintInfof("Security products: %s\n", strings.Join(edrEnum.Products, ", "))
+	}
+	if len(edrEnum.Components) == 0 {
+		return
+	}
+
+	// Count the components of each kind so hooks and filters of unknown vendors
+	// stand out even without a known product
+	kinds := map[string]int{}
+	for _, component := range edrEnum.Components {
+		kinds[component.Kind]++
+	}
+	summary := []string{}
+	for _, kind := range []string{
+		sliverpb.EDRKindService, sliverpb.EDRKindDriver, sliverpb.EDRKindProcess,
+		sliverpb.EDRKindModule, sliverpb.EDRKindFile, sliverpb.EDRKindWFP, sliverpb.EDRKindHook,
+	} {
+		if 0 < kinds[kind] {
+			summary = append(summary, fmt.Sprintf("%d %s", kinds[kind], kind))
+		}
+	}
+	con.PrintInfof("Components: %s\n\n", strings.Join(summary, ", "))
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Product", "Kind", "Name", "Detail"})
+	for _, component := range edrEnum.Components {
+		product := component.Product
+		if product == "" {
+			product = console.Orange + "unknown" + console.Normal
+		}
+		tw.AppendRow(table.Row{product, component.Kind, component.Name, component.Detail})
+	}
+	con.Printf("%s\n", tw.Render())
+}
@@ -20,11 +20,13 @@ package info
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/bishopfox/sliver/client/command/hosts"
 	"github.com/bishopfox/sliver/client/command/use"
 	"github.com/bishopfox/sliver/client/console"
 	consts "github.com/bishopfox/sliver/client/constants"
@@ -77,10 +79,15 @@ func InfoCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string
 		con.Printf(console.Bold+"              Arch: %s%s\n", console.Normal, session.Arch)
 		con.Printf(console.Bold+"         Active C2: %s%s\n", console.Normal, session.ActiveC2)
 		con.Printf(console.Bold+"    Remote Address: %s%s\n", console.Normal, session.RemoteAddress)
+		if session.AddressInfo != nil {
+			con.Printf(console.Bold+"            Origin: %s%s\n", console.Normal, con.FormatAddressInfo(session.AddressInfo, true))
+		}
 		con.Printf(console.Bold+"         Proxy URL: %s%s\n", console.Normal, session.ProxyURL)
 		con.Printf(console.Bold+"Reconnect Interval: %s%s\n", console.Normal, time.Duration(session.ReconnectInterval).String())
 		con.Printf(console.Bold+"     First Contact: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(session.FirstContact, 0), true, false))
 		con.Printf(console.Bold+"      Last Checkin: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(session.LastCheckin, 0), true, false))
+		printTargetMetadata(session.Tags, session.Note, con)
+		printHostSurvey(session.UUID, con)
 
 	} else if beacon != nil {
 
@@ -98,18 +105,41 @@ func InfoCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string
 		con.Printf(console.Bold+"              Arch: %s%s\n", console.Normal, beacon.Arch)
 		con.Printf(console.Bold+"         Active C2: %s%s\n", console.Normal, beacon.ActiveC2)
 		con.Printf(console.Bold+"    Remote Address: %s%s\n", console.Normal, beacon.RemoteAddress)
+		if beacon.AddressInfo != nil {
+			con.Printf(console.Bold+"            Origin: %s%s\n", console.Normal, con.FormatAddressInfo(beacon.AddressInfo, true))
+		}
 		con.Printf(console.Bold+"         Proxy URL: %s%s\n", console.Normal, beacon.ProxyURL)
 		con.Printf(console.Bold+"          Interval: %s%s\n", console.Normal, time.Duration(beacon.Interval).String())
 		con.Printf(console.Bold+"            Jitter: %s%s\n", console.Normal, time.Duration(beacon.Jitter).String())
 		con.Printf(console.Bold+"     First Contact: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(beacon.FirstContact, 0), true, false))
 		con.Printf(console.Bold+"      Last Checkin: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(beacon.LastCheckin, 0), true, false))
-		con.Printf(console.Bold+"      Next Checkin: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(beacon.NextCheckin, 0), true, true))
+		con.Printf(console.Bold+"      Next Checkin: %s%s\n", console.Normal, con.FormatNextCheckin(beacon, true))
+		printTargetMetadata(beacon.Tags, beacon.Note, con)
+		printHostSurvey(beacon.UUID, con)
 
 	} else {
 		con.PrintErrorf("No target session, see `help %s`\n", consts.InfoStr)
 	}
 }
 
+func printTargetMetadata(tags []string, note string, con *console.SliverConsoleClient) {
+	if 0 < len(tags) {
+		con.Printf(console.Bold+"              Tags: %s%s\n", console.Normal, strings.Join(tags, ", "))
+	}
+	if note != "" {
+		con.Printf(console.Bold+"              Note: %s%s\n", console.Normal, note)
+	}
+}
+
+// printHostSurvey - The survey is stored on the host, implants that predate it don't send one
+func printHostSurvey(hostUUID string, con *console.SliverConsoleClient) {
+	host, err := con.Rpc.Host(context.Background(), &clientpb.Host{HostUUID: hostUUID})
+	if err != nil {
+		return
+	}
+	hosts.PrintHostSurvey(host, 18, con)
+}
+
 // PIDCmd - Get the active session's PID
 func PIDCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
 	session, beacon := con.ActiveTarget.GetInteractive()
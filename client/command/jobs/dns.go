@@ -43,16 +43,30 @@ func DNSListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args [
 	canaries, _ := cmd.Flags().GetBool("no-canaries")
 	persistent, _ := cmd.Flags().GetBool("persistent")
 	enforceOTP, _ := cmd.Flags().GetBool("disable-otp")
+	replayWindow, err := parseReplayWindow(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	req := &clientpb.DNSListenerReq{
+		Domains:      domains,
+		Host:         lhost,
+		Port:         lport,
+		Canaries:     !canaries,
+		Persistent:   persistent,
+		EnforceOTP:   !enforceOTP,
+		ReplayWindow: replayWindow,
+	}
+	if name, _ := cmd.Flags().GetString("listener-profile"); name != "" {
+		AddToListenerProfile(name, con, func(profile *clientpb.ListenerProfile) {
+			profile.DNS = append(profile.DNS, req)
+		})
+		return
+	}
 
 	con.PrintInfof("Starting DNS listener with parent domain(s) %v ...\n", domains)
-	dns, err := con.Rpc.StartDNSListener(context.Background(), &clientpb.DNSListenerReq{
-		Domains:    domains,
-		Host:       lhost,
-		Port:       lport,
-		Canaries:   !canaries,
-		Persistent: persistent,
-		EnforceOTP: !enforceOTP,
-	})
+	dns, err := con.Rpc.StartDNSListener(context.Background(), req)
 	con.Println()
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
@@ -0,0 +1,130 @@
+package jobs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// HTTPC2Cmd - Display or download the current HTTP C2 config
+func HTTPC2Cmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	config, err := con.Rpc.GetHTTPC2Config(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	save, _ := cmd.Flags().GetString("save")
+	if save == "" {
+		con.PrintInfof("HTTP C2 config version %d\n\n", config.Version)
+		con.Printf("%s\n", config.Config)
+		return
+	}
+	err = os.WriteFile(save, config.Config, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to write config %s\n", err)
+		return
+	}
+	con.PrintInfof("Saved HTTP C2 config version %d to %s\n", config.Version, save)
+}
+
+// HTTPC2SaveCmd - Upload a new version of the HTTP C2 config and apply it to running listeners
+func HTTPC2SaveCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	reload, err := con.Rpc.SaveHTTPC2Config(context.Background(), &clientpb.HTTPC2Config{Config: data})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved HTTP C2 config version %d\n", reload.Version)
+	printHTTPC2Reload(reload, con)
+}
+
+// HTTPC2ReloadCmd - Apply the HTTP C2 config on the server's disk to running listeners
+func HTTPC2ReloadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	reload, err := con.Rpc.ReloadHTTPC2Config(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	printHTTPC2Reload(reload, con)
+}
+
+// HTTPC2RedirectorCmd - Generate a redirector config that enforces the HTTP C2 profile
+func HTTPC2RedirectorCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	upstream, _ := cmd.Flags().GetString("upstream")
+	decoy, _ := cmd.Flags().GetString("decoy")
+	serverName, _ := cmd.Flags().GetString("server-name")
+	pathPrefix, _ := cmd.Flags().GetString("path-prefix")
+	port, _ := cmd.Flags().GetUint32("port")
+	version, _ := cmd.Flags().GetInt32("version")
+	save, _ := cmd.Flags().GetString("save")
+	if upstream == "" {
+		con.PrintErrorf("Must specify an --upstream listener url\n")
+		return
+	}
+
+	redirector, err := con.Rpc.GenerateRedirectorConfig(context.Background(), &clientpb.RedirectorReq{
+		Type:       args[0],
+		Version:    version,
+		Upstream:   upstream,
+		Decoy:      decoy,
+		ServerName: serverName,
+		PathPrefix: pathPrefix,
+		Port:       port,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if save == "" {
+		con.Printf("%s\n", redirector.Config)
+		return
+	}
+	err = os.WriteFile(save, redirector.Config, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to write config %s\n", err)
+		return
+	}
+	con.PrintInfof("Saved %s redirector for HTTP C2 config version %d to %s\n", redirector.Type, redirector.Version, save)
+}
+
+// RedirectorTypeCompleter - Completes the supported redirector config formats
+func RedirectorTypeCompleter() carapace.Action {
+	return carapace.ActionValues("nginx", "apache", "caddy", "haproxy").Tag("redirector types")
+}
+
+func printHTTPC2Reload(reload *clientpb.HTTPC2ConfigReload, con *console.SliverConsoleClient) {
+	if len(reload.JobIDs) == 0 {
+		con.PrintInfof("No running HTTP(S) listeners to reload\n")
+		return
+	}
+	con.PrintInfof("Applied config version %d to job(s) %s\n", reload.Version, jobIDsString(reload.JobIDs))
+}
@@ -49,9 +49,18 @@ func HTTPListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	acl, err := parseListenerACL(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	replayWindow, err := parseReplayWindow(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 
-	con.PrintInfof("Starting HTTP %s:%d listener ...\n", domain, lport)
-	http, err := con.Rpc.StartHTTPListener(context.Background(), &clientpb.HTTPListenerReq{
+	req := &clientpb.HTTPListenerReq{
 		Domain:          domain,
 		Website:         website,
 		Host:            lhost,
@@ -61,10 +70,64 @@ func HTTPListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		EnforceOTP:      !disableOTP,
 		LongPollTimeout: int64(longPollTimeout),
 		LongPollJitter:  int64(longPollJitter),
-	})
+		ReplayWindow:    replayWindow,
+		ACL:             acl,
+	}
+	if name, _ := cmd.Flags().GetString("listener-profile"); name != "" {
+		AddToListenerProfile(name, con, func(profile *clientpb.ListenerProfile) {
+			profile.HTTP = append(profile.HTTP, req)
+		})
+		return
+	}
+
+	con.PrintInfof("Starting HTTP %s:%d listener ...\n", domain, lport)
+	http, err := con.Rpc.StartHTTPListener(context.Background(), req)
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 	} else {
 		con.PrintInfof("Successfully started job #%d\n", http.JobID)
 	}
 }
+
+// parseReplayWindow - Parse the replay window flag, a window of 0 disables the replay
+// checks which is sent to the server as a negative window (0 is the server's default)
+func parseReplayWindow(cmd *cobra.Command) (int64, error) {
+	value, _ := cmd.Flags().GetString("replay-window")
+	replayWindow, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if replayWindow <= 0 {
+		return -1, nil
+	}
+	return int64(replayWindow), nil
+}
+
+// parseListenerACL - Parse the allow/deny and rate limit flags shared by the http(s)
+// listeners, returns nil if no rules were given
+func parseListenerACL(cmd *cobra.Command) (*clientpb.HTTPListenerACL, error) {
+	allowCIDRs, _ := cmd.Flags().GetStringSlice("allow-cidr")
+	denyCIDRs, _ := cmd.Flags().GetStringSlice("deny-cidr")
+	allowCountries, _ := cmd.Flags().GetStringSlice("allow-country")
+	denyCountries, _ := cmd.Flags().GetStringSlice("deny-country")
+	rateLimit, _ := cmd.Flags().GetUint32("rate-limit")
+	ban, _ := cmd.Flags().GetString("ban")
+	trustProxy, _ := cmd.Flags().GetBool("trust-proxy-headers")
+
+	banDuration, err := time.ParseDuration(ban)
+	if err != nil {
+		return nil, err
+	}
+	if len(allowCIDRs) == 0 && len(denyCIDRs) == 0 && len(allowCountries) == 0 && len(denyCountries) == 0 && rateLimit == 0 {
+		return nil, nil
+	}
+	return &clientpb.HTTPListenerACL{
+		AllowCIDRs:        allowCIDRs,
+		DenyCIDRs:         denyCIDRs,
+		AllowCountries:    allowCountries,
+		DenyCountries:     denyCountries,
+		RateLimit:         rateLimit,
+		BanDuration:       int64(banDuration),
+		TrustProxyHeaders: trustProxy,
+	}, nil
+}
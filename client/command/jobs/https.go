@@ -43,6 +43,19 @@ func HTTPSListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	persistent, _ := cmd.Flags().GetBool("persistent")
 	letsEncrypt, _ := cmd.Flags().GetBool("lets-encrypt")
 	disableRandomize, _ := cmd.Flags().GetBool("disable-randomized-jarm")
+	requireClientCert, _ := cmd.Flags().GetBool("require-client-cert")
+	acmeDNS, _ := cmd.Flags().GetString("acme-dns")
+	acmeDomains, _ := cmd.Flags().GetStringSlice("acme-domains")
+	if acmeDNS != "" {
+		letsEncrypt = true
+	} else if 0 < len(acmeDomains) {
+		con.PrintErrorf("--acme-domains requires a dns provider, see --acme-dns\n")
+		return
+	}
+	if acmeDNS != "" && domain == "" && len(acmeDomains) == 0 {
+		con.PrintErrorf("--acme-dns requires --domain or --acme-domains\n")
+		return
+	}
 
 	longPollTimeout, err := time.ParseDuration(pollTimeout)
 	if err != nil {
@@ -55,6 +68,17 @@ func HTTPSListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		return
 	}
 
+	acl, err := parseListenerACL(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	replayWindow, err := parseReplayWindow(cmd)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
 	cert, key, err := getLocalCertificatePair(cmd)
 	if err != nil {
 		con.Println()
@@ -62,22 +86,35 @@ func HTTPSListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		return
 	}
 
+	req := &clientpb.HTTPListenerReq{
+		Domain:            domain,
+		Website:           website,
+		Host:              lhost,
+		Port:              lport,
+		Secure:            true,
+		Cert:              cert,
+		Key:               key,
+		ACME:              letsEncrypt,
+		ACMEDNSProvider:   acmeDNS,
+		ACMEDomains:       acmeDomains,
+		Persistent:        persistent,
+		EnforceOTP:        !disableOTP,
+		LongPollTimeout:   int64(longPollTimeout),
+		LongPollJitter:    int64(longPollJitter),
+		RandomizeJARM:     !disableRandomize,
+		ReplayWindow:      replayWindow,
+		ACL:               acl,
+		RequireClientCert: requireClientCert,
+	}
+	if name, _ := cmd.Flags().GetString("listener-profile"); name != "" {
+		AddToListenerProfile(name, con, func(profile *clientpb.ListenerProfile) {
+			profile.HTTP = append(profile.HTTP, req)
+		})
+		return
+	}
+
 	con.PrintInfof("Starting HTTPS %s:%d listener ...\n", domain, lport)
-	https, err := con.Rpc.StartHTTPSListener(context.Background(), &clientpb.HTTPListenerReq{
-		Domain:          domain,
-		Website:         website,
-		Host:            lhost,
-		Port:            lport,
-		Secure:          true,
-		Cert:            cert,
-		Key:             key,
-		ACME:            letsEncrypt,
-		Persistent:      persistent,
-		EnforceOTP:      !disableOTP,
-		LongPollTimeout: int64(longPollTimeout),
-		LongPollJitter:  int64(longPollJitter),
-		RandomizeJARM:   !disableRandomize,
-	})
+	https, err := con.Rpc.StartHTTPSListener(context.Background(), req)
 	con.Println()
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
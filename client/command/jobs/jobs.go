@@ -42,6 +42,10 @@ func JobsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string
 		jobKill(uint32(pid), con)
 	} else if all, _ := cmd.Flags().GetBool("kill-all"); all {
 		killAllJobs(con)
+	} else if jobID, _ := cmd.Flags().GetInt32("drain"); jobID != -1 {
+		jobDrain(uint32(jobID), true, con)
+	} else if jobID, _ := cmd.Flags().GetInt32("undrain"); jobID != -1 {
+		jobDrain(uint32(jobID), false, con)
 	} else {
 		jobs, err := con.Rpc.GetJobs(context.Background(), &commonpb.Empty{})
 		if err != nil {
@@ -70,6 +74,8 @@ func PrintJobs(jobs map[uint32]*clientpb.Job, con *console.SliverConsoleClient)
 		"Name",
 		"Protocol",
 		"Port",
+		"Sessions",
+		"Draining",
 	})
 
 	var keys []int
@@ -85,6 +91,8 @@ func PrintJobs(jobs map[uint32]*clientpb.Job, con *console.SliverConsoleClient)
 			job.Name,
 			job.Protocol,
 			fmt.Sprintf("%d", job.Port),
+			fmt.Sprintf("%d", job.Sessions),
+			fmt.Sprintf("%v", job.Draining),
 		})
 	}
 	con.Printf("%s\n", tw.Render())
@@ -124,6 +132,22 @@ func jobKill(jobID uint32, con *console.SliverConsoleClient) {
 	}
 }
 
+func jobDrain(jobID uint32, draining bool, con *console.SliverConsoleClient) {
+	job, err := con.Rpc.DrainJob(context.Background(), &clientpb.JobDrainReq{
+		ID:       jobID,
+		Draining: draining,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if job.Draining {
+		con.PrintInfof("Job #%d is draining, %d session(s) still connected\n", job.ID, job.Sessions)
+	} else {
+		con.PrintInfof("Job #%d is accepting new implants\n", job.ID)
+	}
+}
+
 func killAllJobs(con *console.SliverConsoleClient) {
 	jobs, err := con.Rpc.GetJobs(context.Background(), &commonpb.Empty{})
 	if err != nil {
@@ -0,0 +1,222 @@
+package jobs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// ListenerProfilesCmd - Display saved listener profiles
+func ListenerProfilesCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	profiles, err := con.Rpc.ListenerProfiles(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(profiles.Profiles) == 0 {
+		con.PrintInfof("No listener profiles, see `%s --help`\n", cmd.Name())
+		return
+	}
+	PrintListenerProfiles(profiles.Profiles, con)
+}
+
+// ListenerProfilesNewCmd - Create a listener profile, or update the settings of an existing one
+func ListenerProfilesNewCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	name := args[0]
+	autoStart, _ := cmd.Flags().GetBool("autostart")
+	order, _ := cmd.Flags().GetInt32("order")
+	dependsOn, _ := cmd.Flags().GetString("depends-on")
+
+	profile := getListenerProfile(name, con)
+	if profile == nil {
+		profile = &clientpb.ListenerProfile{Name: name}
+	}
+	profile.AutoStart = autoStart
+	profile.StartOrder = order
+	profile.DependsOn = []string{}
+	for _, dependency := range strings.Split(dependsOn, ",") {
+		if dependency = strings.TrimSpace(dependency); dependency != "" {
+			profile.DependsOn = append(profile.DependsOn, dependency)
+		}
+	}
+	profile, err := con.Rpc.SaveListenerProfile(context.Background(), profile)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved listener profile %s\n", profile.Name)
+}
+
+// ListenerProfilesRmCmd - Remove a listener profile
+func ListenerProfilesRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	_, err := con.Rpc.RmListenerProfile(context.Background(), &clientpb.ListenerProfile{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed listener profile %s\n", args[0])
+}
+
+// ListenerProfilesStartCmd - Start a listener profile and its dependencies
+func ListenerProfilesStartCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	con.PrintInfof("Starting listener profile %s ...\n", args[0])
+	profile, err := con.Rpc.StartListenerProfile(context.Background(), &clientpb.ListenerProfile{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Listener profile %s is running as job(s) %s\n", profile.Name, jobIDsString(profile.JobIDs))
+}
+
+// ListenerProfilesStopCmd - Stop all jobs running from a listener profile
+func ListenerProfilesStopCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	profile, err := con.Rpc.StopListenerProfile(context.Background(), &clientpb.ListenerProfile{Name: args[0]})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(profile.JobIDs) == 0 {
+		con.PrintInfof("Listener profile %s is not running\n", profile.Name)
+		return
+	}
+	con.PrintInfof("Stopped job(s) %s\n", jobIDsString(profile.JobIDs))
+}
+
+// AddToListenerProfile - Add a listener to a profile (creating it if needed) instead of starting it
+func AddToListenerProfile(name string, con *console.SliverConsoleClient, add func(*clientpb.ListenerProfile)) {
+	profile := getListenerProfile(name, con)
+	if profile == nil {
+		profile = &clientpb.ListenerProfile{Name: name}
+	}
+	add(profile)
+	profile, err := con.Rpc.SaveListenerProfile(context.Background(), profile)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Added listener to profile %s (%d listener(s))\n", profile.Name, listenerCount(profile))
+}
+
+// PrintListenerProfiles - Print a table of listener profiles
+func PrintListenerProfiles(profiles []*clientpb.ListenerProfile, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Auto Start",
+		"Order",
+		"Depends On",
+		"Listeners",
+		"Jobs",
+	})
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].StartOrder != profiles[j].StartOrder {
+			return profiles[i].StartOrder < profiles[j].StartOrder
+		}
+		return profiles[i].Name < profiles[j].Name
+	})
+	for _, profile := range profiles {
+		tw.AppendRow(table.Row{
+			profile.Name,
+			profile.AutoStart,
+			profile.StartOrder,
+			strings.Join(profile.DependsOn, ", "),
+			listenersSummary(profile),
+			jobIDsString(profile.JobIDs),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// ListenerProfileNameCompleter - Completes the names of listener profiles
+func ListenerProfileNameCompleter(con *console.SliverConsoleClient) carapace.Action {
+	return carapace.ActionCallback(func(c carapace.Context) carapace.Action {
+		profiles, err := con.Rpc.ListenerProfiles(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to list listener profiles %s", err)
+		}
+		results := make([]string, 0)
+		for _, profile := range profiles.Profiles {
+			results = append(results, profile.Name)
+		}
+		if len(results) == 0 {
+			return carapace.ActionMessage("no listener profiles")
+		}
+		return carapace.ActionValues(results...).Tag("listener profiles").Usage("listener profile name")
+	})
+}
+
+func getListenerProfile(name string, con *console.SliverConsoleClient) *clientpb.ListenerProfile {
+	profiles, err := con.Rpc.ListenerProfiles(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil
+	}
+	for _, profile := range profiles.Profiles {
+		if profile.Name == name {
+			profile.JobIDs = nil
+			return profile
+		}
+	}
+	return nil
+}
+
+func listenerCount(profile *clientpb.ListenerProfile) int {
+	return len(profile.MTLS) + len(profile.WG) + len(profile.DNS) + len(profile.HTTP)
+}
+
+func listenersSummary(profile *clientpb.ListenerProfile) string {
+	listeners := []string{}
+	for _, mtls := range profile.MTLS {
+		listeners = append(listeners, fmt.Sprintf("mtls/%d", mtls.Port))
+	}
+	for _, wg := range profile.WG {
+		listeners = append(listeners, fmt.Sprintf("wg/%d", wg.Port))
+	}
+	for _, dns := range profile.DNS {
+		listeners = append(listeners, fmt.Sprintf("dns/%d", dns.Port))
+	}
+	for _, http := range profile.HTTP {
+		if http.Secure {
+			listeners = append(listeners, fmt.Sprintf("https/%d", http.Port))
+		} else {
+			listeners = append(listeners, fmt.Sprintf("http/%d", http.Port))
+		}
+	}
+	return strings.Join(listeners, ", ")
+}
+
+func jobIDsString(jobIDs []uint32) string {
+	ids := []string{}
+	for _, id := range jobIDs {
+		ids = append(ids, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(ids, ", ")
+}
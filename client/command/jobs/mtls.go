@@ -32,13 +32,31 @@ func MTLSListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	lhost, _ := cmd.Flags().GetString("lhost")
 	lport, _ := cmd.Flags().GetUint32("lport")
 	persistent, _ := cmd.Flags().GetBool("persistent")
+	spa, _ := cmd.Flags().GetBool("spa")
+	knockPorts, _ := cmd.Flags().GetUintSlice("knock-ports")
+	if 0 < len(knockPorts) && !spa {
+		con.PrintErrorf("--knock-ports requires --spa\n")
+		return
+	}
 
-	con.PrintInfof("Starting mTLS listener ...\n")
-	mtls, err := con.Rpc.StartMTLSListener(context.Background(), &clientpb.MTLSListenerReq{
+	req := &clientpb.MTLSListenerReq{
 		Host:       lhost,
 		Port:       lport,
 		Persistent: persistent,
-	})
+		SPA:        spa,
+	}
+	for _, knockPort := range knockPorts {
+		req.KnockPorts = append(req.KnockPorts, uint32(knockPort))
+	}
+	if name, _ := cmd.Flags().GetString("listener-profile"); name != "" {
+		AddToListenerProfile(name, con, func(profile *clientpb.ListenerProfile) {
+			profile.MTLS = append(profile.MTLS, req)
+		})
+		return
+	}
+
+	con.PrintInfof("Starting mTLS listener ...\n")
+	mtls, err := con.Rpc.StartMTLSListener(context.Background(), req)
 	con.Println()
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
@@ -23,17 +23,22 @@ import (
 	"compress/zlib"
 	"context"
 	"encoding/binary"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 
 	"github.com/bishopfox/sliver/util/encoders"
 
 	"github.com/bishopfox/sliver/client/command/generate"
+	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/util"
 )
 
@@ -47,6 +52,10 @@ func StageListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	prependSize, _ := cmd.Flags().GetBool("prepend-size")
 	compressF, _ := cmd.Flags().GetString("compress")
 	compress := strings.ToLower(compressF)
+	oneTime, _ := cmd.Flags().GetBool("one-time")
+	maxFetches, _ := cmd.Flags().GetUint32("max-fetches")
+	expires, _ := cmd.Flags().GetString("expires")
+	allowedIPs, _ := cmd.Flags().GetStringSlice("allow-ip")
 
 	if profileName == "" || listenerURL == "" {
 		con.PrintErrorf("Missing required flags, see `help stage-listener` for more info\n")
@@ -65,6 +74,19 @@ func StageListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		return
 	}
 
+	if oneTime {
+		maxFetches = 1
+	}
+	expiresAt := int64(0)
+	if expires != "" {
+		ttl, err := time.ParseDuration(expires)
+		if err != nil || ttl <= 0 {
+			con.PrintErrorf("Invalid --expires duration '%s'\n", expires)
+			return
+		}
+		expiresAt = time.Now().Add(ttl).Unix()
+	}
+
 	profile := generate.GetImplantProfileByName(profileName, con)
 	if profile == nil {
 		con.PrintErrorf("Profile not found\n")
@@ -149,10 +171,14 @@ func StageListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		ctrl := make(chan bool)
 		con.SpinUntil("Starting HTTP staging listener...", ctrl)
 		stageListener, err := con.Rpc.StartHTTPStagerListener(context.Background(), &clientpb.StagerListenerReq{
-			Protocol: clientpb.StageProtocol_HTTP,
-			Data:     stage2,
-			Host:     stagingURL.Hostname(),
-			Port:     uint32(stagingPort),
+			Protocol:    clientpb.StageProtocol_HTTP,
+			Data:        stage2,
+			Host:        stagingURL.Hostname(),
+			Port:        uint32(stagingPort),
+			ProfileName: profileName,
+			MaxFetches:  maxFetches,
+			ExpiresAt:   expiresAt,
+			AllowedIPs:  allowedIPs,
 		})
 		ctrl <- true
 		<-ctrl
@@ -175,13 +201,17 @@ func StageListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		ctrl := make(chan bool)
 		con.SpinUntil("Starting HTTPS staging listener...", ctrl)
 		stageListener, err := con.Rpc.StartHTTPStagerListener(context.Background(), &clientpb.StagerListenerReq{
-			Protocol: clientpb.StageProtocol_HTTPS,
-			Data:     stage2,
-			Host:     stagingURL.Hostname(),
-			Port:     uint32(stagingPort),
-			Cert:     cert,
-			Key:      key,
-			ACME:     letsEncrypt,
+			Protocol:    clientpb.StageProtocol_HTTPS,
+			Data:        stage2,
+			Host:        stagingURL.Hostname(),
+			Port:        uint32(stagingPort),
+			Cert:        cert,
+			Key:         key,
+			ACME:        letsEncrypt,
+			ProfileName: profileName,
+			MaxFetches:  maxFetches,
+			ExpiresAt:   expiresAt,
+			AllowedIPs:  allowedIPs,
 		})
 		ctrl <- true
 		<-ctrl
@@ -196,10 +226,14 @@ func StageListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 		ctrl := make(chan bool)
 		con.SpinUntil("Starting TCP staging listener...", ctrl)
 		stageListener, err := con.Rpc.StartTCPStagerListener(context.Background(), &clientpb.StagerListenerReq{
-			Protocol: clientpb.StageProtocol_TCP,
-			Data:     stage2,
-			Host:     stagingURL.Hostname(),
-			Port:     uint32(stagingPort),
+			Protocol:    clientpb.StageProtocol_TCP,
+			Data:        stage2,
+			Host:        stagingURL.Hostname(),
+			Port:        uint32(stagingPort),
+			ProfileName: profileName,
+			MaxFetches:  maxFetches,
+			ExpiresAt:   expiresAt,
+			AllowedIPs:  allowedIPs,
 		})
 		ctrl <- true
 		<-ctrl
@@ -224,6 +258,54 @@ func StageListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args
 	}
 }
 
+// StageFetchesCmd - List the requests made to stage listeners
+func StageFetchesCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	jobID, _ := cmd.Flags().GetUint32("job")
+	fetches, err := con.Rpc.GetStageFetches(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Time",
+		"Job",
+		"Profile",
+		"Protocol",
+		"Remote Address",
+		"User Agent",
+		"Path",
+		"Served",
+	})
+	count := 0
+	for _, fetch := range fetches.Fetches {
+		if jobID != 0 && fetch.JobID != jobID {
+			continue
+		}
+		served := "yes"
+		if !fetch.Served {
+			served = fmt.Sprintf("no (%s)", fetch.Reason)
+		}
+		tw.AppendRow(table.Row{
+			time.Unix(fetch.CreatedAt, 0).Format(time.RFC1123),
+			fmt.Sprintf("%d", fetch.JobID),
+			fetch.ProfileName,
+			fetch.Protocol,
+			fetch.RemoteAddress,
+			fetch.UserAgent,
+			fetch.Path,
+			served,
+		})
+		count++
+	}
+	if count == 0 {
+		con.PrintInfof("No stage requests\n")
+		return
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
 func prependPayloadSize(payload []byte) []byte {
 	payloadSize := uint32(len(payload))
 	lenBuf := make([]byte, 4)
@@ -34,13 +34,21 @@ func WGListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []
 	keyExchangePort, _ := cmd.Flags().GetUint32("key-port")
 	persistent, _ := cmd.Flags().GetBool("persistent")
 
-	con.PrintInfof("Starting Wireguard listener ...\n")
-	wg, err := con.Rpc.StartWGListener(context.Background(), &clientpb.WGListenerReq{
+	req := &clientpb.WGListenerReq{
 		Port:       lport,
 		NPort:      nport,
 		KeyPort:    keyExchangePort,
 		Persistent: persistent,
-	})
+	}
+	if name, _ := cmd.Flags().GetString("listener-profile"); name != "" {
+		AddToListenerProfile(name, con, func(profile *clientpb.ListenerProfile) {
+			profile.WG = append(profile.WG, req)
+		})
+		return
+	}
+
+	con.PrintInfof("Starting Wireguard listener ...\n")
+	wg, err := con.Rpc.StartWGListener(context.Background(), req)
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 	} else {
@@ -0,0 +1,62 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// LootExportCmd - Export loot and credentials as csv, json, or a zip archive
+func LootExportCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	format, _ := cmd.Flags().GetString("format")
+	groupBy, _ := cmd.Flags().GetString("group-by")
+	save, _ := cmd.Flags().GetString("save")
+
+	file, err := con.Rpc.LootExport(context.Background(), &clientpb.LootExportReq{
+		Format:  strings.ToLower(format),
+		GroupBy: strings.ToLower(groupBy),
+		Filter:  lootSearchReq(cmd, strings.Join(args, " ")),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	saveTo := file.Name
+	if save != "" {
+		saveTo = save
+		if fi, err := os.Stat(save); err == nil && fi.IsDir() {
+			saveTo = filepath.Join(save, file.Name)
+		}
+	}
+	err = os.WriteFile(saveTo, file.Data, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to write export %s\n", err)
+		return
+	}
+	con.PrintInfof("Exported loot to %s\n", saveTo)
+}
@@ -26,7 +26,8 @@ import (
 	"github.com/bishopfox/sliver/client/console"
 )
 
-// LootFetchCmd - Display the contents of or download a piece of loot
+// LootFetchCmd - Display the contents of or download a piece of loot, --view picks
+// a viewer for the content (image preview, paginated text, or hexdump)
 func LootFetchCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
 	loot, err := SelectLoot(cmd, con.Rpc)
 	if err != nil {
@@ -38,10 +39,18 @@ func LootFetchCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []s
 		con.PrintErrorf("%s\n", err)
 		return
 	}
-	PrintLootFile(loot, con)
+	view, _ := cmd.Flags().GetBool("view")
+	forceHex, _ := cmd.Flags().GetBool("hex")
+	if con.IsJSON() {
+		con.PrintJSON(loot)
+	} else if view || forceHex {
+		viewLoot(loot, forceHex, con)
+	} else {
+		PrintLootFile(loot, con)
+	}
 
 	if save, _ := cmd.Flags().GetString("save"); save != "" {
-		savedTo, err := saveLootToDisk(cmd, loot)
+		savedTo, err := SaveLootToDisk(cmd, loot)
 		if err != nil {
 			con.PrintErrorf("Failed to save loot %s\n", err)
 		}
@@ -44,6 +44,10 @@ func LootCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string
 		con.PrintErrorf("Failed to fetch loot %s\n", err)
 		return
 	}
+	if con.IsJSON() {
+		con.PrintJSON(allLoot)
+		return
+	}
 	PrintAllFileLootTable(allLoot, con)
 }
 
@@ -61,6 +65,7 @@ func PrintAllFileLootTable(allLoot *clientpb.AllLoot, con *console.SliverConsole
 		"File Name",
 		"Type",
 		"Size",
+		"Tags",
 	})
 	for _, loot := range allLoot.Loot {
 		if loot.File != nil {
@@ -70,6 +75,7 @@ func PrintAllFileLootTable(allLoot *clientpb.AllLoot, con *console.SliverConsole
 				loot.File.Name,
 				fileTypeToStr(loot.FileType),
 				util.ByteCountBinary(loot.Size),
+				strings.Join(loot.Tags, ", "),
 			})
 		}
 	}
@@ -95,8 +101,8 @@ func PrintLootFile(loot *clientpb.Loot, con *console.SliverConsoleClient) {
 	}
 }
 
-// Any loot with a "File" can be saved to disk
-func saveLootToDisk(cmd *cobra.Command, loot *clientpb.Loot) (string, error) {
+// SaveLootToDisk - Any loot with a "File" can be saved to disk, --save is the destination
+func SaveLootToDisk(cmd *cobra.Command, loot *clientpb.Loot) (string, error) {
 	if loot.File == nil {
 		return "", errors.New("loot does not contain a file")
 	}
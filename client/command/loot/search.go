@@ -0,0 +1,88 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// LootSearchCmd - Search loot and credentials
+func LootSearchCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	results, err := con.Rpc.LootSearch(context.Background(), lootSearchReq(cmd, strings.Join(args, " ")))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(results.Loot) == 0 && len(results.Credentials) == 0 {
+		con.PrintInfof("No matching loot\n")
+		return
+	}
+	if 0 < len(results.Loot) {
+		PrintAllFileLootTable(&clientpb.AllLoot{Loot: results.Loot}, con)
+	}
+	if 0 < len(results.Credentials) {
+		if 0 < len(results.Loot) {
+			con.Println()
+		}
+		printCredentialResults(results.Credentials, con)
+	}
+}
+
+// lootSearchReq - Build a search request from the common filter flags
+func lootSearchReq(cmd *cobra.Command, query string) *clientpb.LootSearchReq {
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	host, _ := cmd.Flags().GetString("host")
+	content, _ := cmd.Flags().GetBool("content")
+	return &clientpb.LootSearchReq{
+		Query:   query,
+		Tags:    tags,
+		Host:    host,
+		Content: content,
+	}
+}
+
+func printCredentialResults(credentials []*clientpb.Credential, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Username",
+		"Plaintext",
+		"Hash",
+		"Collection",
+	})
+	for _, cred := range credentials {
+		tw.AppendRow(table.Row{
+			strings.Split(cred.ID, "-")[0],
+			cred.Username,
+			cred.Plaintext,
+			cred.Hash,
+			cred.Collection,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
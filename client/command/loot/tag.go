@@ -0,0 +1,94 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+)
+
+// LootTagCmd - Add or remove tags on a piece of loot
+func LootTagCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	add, _ := cmd.Flags().GetStringSlice("add")
+	remove, _ := cmd.Flags().GetStringSlice("remove")
+	if len(add) == 0 && len(remove) == 0 {
+		con.PrintErrorf("Specify tags to --add and/or --remove\n")
+		return
+	}
+
+	var loot *clientpb.Loot
+	var err error
+	if 0 < len(args) {
+		loot, err = LootByID(con.Rpc, args[0])
+	} else {
+		loot, err = SelectLoot(cmd, con.Rpc)
+	}
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	loot, err = con.Rpc.LootTag(context.Background(), &clientpb.LootTagReq{
+		ID:     loot.ID,
+		Add:    add,
+		Remove: remove,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(loot.Tags) == 0 {
+		con.PrintInfof("%s has no tags\n", loot.Name)
+		return
+	}
+	con.PrintInfof("%s tags: %s\n", loot.Name, strings.Join(loot.Tags, ", "))
+}
+
+// LootByID - Find a piece of loot by its full ID or an unambiguous ID prefix
+func LootByID(rpc rpcpb.SliverRPCClient, id string) (*clientpb.Loot, error) {
+	allLoot, err := rpc.LootAll(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	var found *clientpb.Loot
+	for _, loot := range allLoot.Loot {
+		if loot.ID == id {
+			return loot, nil
+		}
+		if strings.HasPrefix(loot.ID, id) {
+			if found != nil {
+				return nil, fmt.Errorf("loot id '%s' is ambiguous", id)
+			}
+			found = loot
+		}
+	}
+	if found == nil {
+		return nil, errors.New("loot not found")
+	}
+	return found, nil
+}
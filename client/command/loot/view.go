@@ -0,0 +1,202 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/term"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	// maxImageWidth - Images are scaled down to the terminal width, but no wider than this
+	maxImageWidth = 160
+)
+
+// viewLoot - Display a piece of loot with the viewer for its content: images are
+// previewed in the terminal, text is paginated, and binaries are hexdumped
+func viewLoot(loot *clientpb.Loot, forceHex bool, con *console.SliverConsoleClient) {
+	if loot.File == nil || len(loot.File.Data) == 0 {
+		con.PrintInfof("No file data\n")
+		return
+	}
+	if loot.File.Name != "" {
+		con.PrintInfof("%sFile Name:%s %s (%d bytes)\n\n", console.Bold, console.Normal, loot.File.Name, len(loot.File.Data))
+	}
+	data := loot.File.Data
+	width, height := terminalSize()
+	switch {
+	case forceHex:
+		pageLines(hexdumpLines(data), height, con)
+	case isImage(data):
+		preview, err := renderImage(data, width)
+		if err != nil {
+			con.PrintErrorf("Failed to render image: %s\n", err)
+			return
+		}
+		con.Printf("%s", preview)
+	case loot.FileType == clientpb.FileType_TEXT || isText(data):
+		pageLines(textLines(data), height, con)
+	default:
+		pageLines(hexdumpLines(data), height, con)
+	}
+}
+
+// terminalSize - The size of the terminal, or 80x24 if stdout isn't a terminal
+func terminalSize() (int, int) {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// textLines - The lines of a text file, control characters (e.g. terminal escape
+// sequences from the target) are replaced so they can't mess with the terminal
+func textLines(data []byte) []string {
+	text := strings.ReplaceAll(string(bytes.ToValidUTF8(data, []byte("�"))), "\r\n", "\n")
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for index, line := range lines {
+		lines[index] = strings.Map(func(char rune) rune {
+			if char == '\t' || !unicode.IsControl(char) {
+				return char
+			}
+			return '.'
+		}, line)
+	}
+	return lines
+}
+
+// hexdumpLines - Offset, hex bytes, and printable characters, like "hexdump -C"
+func hexdumpLines(data []byte) []string {
+	return strings.Split(strings.TrimSuffix(hex.Dump(data), "\n"), "\n")
+}
+
+// pageLines - Print the lines a screen at a time, unless they fit in the terminal
+// or stdout isn't a terminal
+func pageLines(lines []string, height int, con *console.SliverConsoleClient) {
+	pageSize := height - 2
+	if len(lines) <= pageSize || pageSize < 1 || !term.IsTerminal(int(os.Stdout.Fd())) {
+		con.Printf("%s\n", strings.Join(lines, "\n"))
+		return
+	}
+	reader := bufio.NewReader(os.Stdin)
+	for start := 0; start < len(lines); start += pageSize {
+		end := start + pageSize
+		if len(lines) < end {
+			end = len(lines)
+		}
+		con.Printf("%s\n", strings.Join(lines[start:end], "\n"))
+		if end == len(lines) {
+			return
+		}
+		con.Printf(console.Bold+"-- %d/%d lines (%d%%) [enter] next page, [q] quit --"+console.Normal,
+			end, len(lines), 100*end/len(lines))
+		input, err := reader.ReadString('\n')
+		con.Printf(console.UpN+console.Clearln+"\r", 1)
+		if err != nil || strings.HasPrefix(strings.ToLower(strings.TrimSpace(input)), "q") {
+			return
+		}
+	}
+}
+
+func isImage(data []byte) bool {
+	_, _, err := image.DecodeConfig(bytes.NewReader(data))
+	return err == nil
+}
+
+// renderImage - Render an image with "▀" characters, the foreground color is the upper
+// pixel and the background color the lower pixel, which works in any terminal with
+// 24-bit color. Each character is the average of the pixels it covers.
+func renderImage(data []byte, width int) (string, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	bounds := img.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return "", fmt.Errorf("empty image")
+	}
+	if maxImageWidth < width {
+		width = maxImageWidth
+	}
+	if bounds.Dx() < width {
+		width = bounds.Dx()
+	}
+	scale := float64(bounds.Dx()) / float64(width)
+	rows := int(float64(bounds.Dy()) / scale)
+	if rows%2 == 1 {
+		rows++
+	}
+	if rows < 2 {
+		rows = 2
+	}
+
+	builder := &strings.Builder{}
+	for y := 0; y < rows; y += 2 {
+		for x := 0; x < width; x++ {
+			tr, tg, tb := averageColor(img, bounds, x, y, scale)
+			br, bg, bb := averageColor(img, bounds, x, y+1, scale)
+			fmt.Fprintf(builder, "\033[38;2;%d;%d;%dm\033[48;2;%d;%d;%dm▀", tr, tg, tb, br, bg, bb)
+		}
+		builder.WriteString(console.Normal + "\n")
+	}
+	return builder.String(), nil
+}
+
+// averageColor - The average 8-bit color of the pixels covered by a cell of the scaled image
+func averageColor(img image.Image, bounds image.Rectangle, x int, y int, scale float64) (uint32, uint32, uint32) {
+	minX := bounds.Min.X + int(float64(x)*scale)
+	maxX := bounds.Min.X + int(float64(x+1)*scale)
+	minY := bounds.Min.Y + int(float64(y)*scale)
+	maxY := bounds.Min.Y + int(float64(y+1)*scale)
+	if maxX <= minX {
+		maxX = minX + 1
+	}
+	if maxY <= minY {
+		maxY = minY + 1
+	}
+	var r, g, b, count uint64
+	for py := minY; py < maxY && py < bounds.Max.Y; py++ {
+		for px := minX; px < maxX && px < bounds.Max.X; px++ {
+			pr, pg, pb, _ := img.At(px, py).RGBA()
+			r += uint64(pr >> 8)
+			g += uint64(pg >> 8)
+			b += uint64(pb >> 8)
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, 0, 0
+	}
+	return uint32(r / count), uint32(g / count), uint32(b / count)
+}
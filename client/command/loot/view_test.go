@@ -0,0 +1,67 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestTextLines(t *testing.T) {
+	lines := textLines([]byte("one\r\ntwo\t2\n\x1b[2Jthree\n"))
+	if len(lines) != 3 || lines[0] != "one" || lines[1] != "two\t2" || lines[2] != ".[2Jthree" {
+		t.Errorf("unexpected lines %q", lines)
+	}
+}
+
+func TestHexdumpLines(t *testing.T) {
+	lines := hexdumpLines([]byte("0123456789abcdefXYZ"))
+	if len(lines) != 2 || !strings.HasPrefix(lines[1], "00000010  58 59 5a") {
+		t.Errorf("unexpected hexdump %q", lines)
+	}
+}
+
+func TestRenderImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 20; y++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	buf := &bytes.Buffer{}
+	png.Encode(buf, img)
+	if !isImage(buf.Bytes()) || isImage([]byte("not an image")) {
+		t.Fatalf("image detection failed")
+	}
+	preview, err := renderImage(buf.Bytes(), 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := strings.Split(strings.TrimSuffix(preview, "\n"), "\n")
+	if len(rows) != 5 {
+		t.Errorf("expected 5 rows for a 2:1 image 20 characters wide, got %d", len(rows))
+	}
+	if strings.Count(rows[0], "▀") != 20 || !strings.Contains(rows[0], "\033[38;2;255;0;0m") {
+		t.Errorf("unexpected row %q", rows[0])
+	}
+}
@@ -0,0 +1,57 @@
+package macros
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// MacrosAddCmd - Add or replace a macro
+func MacrosAddCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	commands, _ := cmd.Flags().GetStringArray("command")
+	description, _ := cmd.Flags().GetString("description")
+	if len(commands) == 0 {
+		con.PrintErrorf("A macro needs at least one --command\n")
+		return
+	}
+	macros, err := assets.LoadMacros()
+	if err != nil {
+		con.PrintErrorf("Failed to load macros: %s\n", err)
+		return
+	}
+	_, exists := macros[args[0]]
+	macros[args[0]] = &assets.Macro{
+		Name:        args[0],
+		Description: description,
+		Commands:    commands,
+	}
+	err = assets.SaveMacros(macros)
+	if err != nil {
+		con.PrintErrorf("Failed to save macros: %s\n", err)
+		return
+	}
+	if exists {
+		con.PrintInfof("Updated macro %s\n", args[0])
+	} else {
+		con.PrintInfof("Added macro %s\n", args[0])
+	}
+}
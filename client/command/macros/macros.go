@@ -0,0 +1,131 @@
+package macros
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/kballard/go-shellquote"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+var (
+	// macroArgPattern - $1..$N are replaced with an argument, $@ with all of them
+	macroArgPattern = regexp.MustCompile(`\$(\d+|@)`)
+
+	errMissingArgument = errors.New("missing macro argument")
+)
+
+// MacrosCmd - List the operator's macros
+func MacrosCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	macros, err := assets.LoadMacros()
+	if err != nil {
+		con.PrintErrorf("Failed to load macros: %s\n", err)
+		return
+	}
+	if len(macros) == 0 {
+		con.PrintInfof("No macros defined, see 'macros add --help'\n")
+		return
+	}
+	names := []string{}
+	for name := range macros {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Arguments",
+		"Commands",
+		"Description",
+	})
+	for _, name := range names {
+		macro := macros[name]
+		tw.AppendRow(table.Row{
+			macro.Name,
+			macroArgCount(macro),
+			strings.Join(macro.Commands, "; "),
+			macro.Description,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// MacroNameCompleter - Completes the names of the operator's macros
+func MacroNameCompleter() carapace.Action {
+	return carapace.ActionCallback(func(c carapace.Context) carapace.Action {
+		macros, err := assets.LoadMacros()
+		if err != nil {
+			return carapace.ActionMessage("failed to load macros: %s", err)
+		}
+		results := []string{}
+		for name, macro := range macros {
+			results = append(results, name, macro.Description)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("macros")
+	})
+}
+
+// macroArgCount - The number of positional arguments a macro uses
+func macroArgCount(macro *assets.Macro) int {
+	count := 0
+	for _, command := range macro.Commands {
+		for _, match := range macroArgPattern.FindAllStringSubmatch(command, -1) {
+			if index, err := strconv.Atoi(match[1]); err == nil && count < index {
+				count = index
+			}
+		}
+	}
+	return count
+}
+
+// expandMacro - Replace the argument placeholders in the macro's commands, arguments
+// are quoted so that they're passed to the command as a single argument
+func expandMacro(macro *assets.Macro, args []string) ([]string, error) {
+	if count := macroArgCount(macro); len(args) < count {
+		return nil, fmt.Errorf("%w: %s takes %d argument(s)", errMissingArgument, macro.Name, count)
+	}
+	commands := []string{}
+	for _, command := range macro.Commands {
+		commands = append(commands, macroArgPattern.ReplaceAllStringFunc(command, func(placeholder string) string {
+			if placeholder == "$@" {
+				return shellquote.Join(args...)
+			}
+			index, _ := strconv.Atoi(placeholder[1:])
+			if index == 0 {
+				return placeholder
+			}
+			return shellquote.Join(args[index-1])
+		}))
+	}
+	return commands, nil
+}
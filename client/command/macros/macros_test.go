@@ -0,0 +1,84 @@
+package macros
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"testing"
+
+	app "github.com/reeflective/console"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	consts "github.com/bishopfox/sliver/client/constants"
+)
+
+func TestExpandMacro(t *testing.T) {
+	macro := &assets.Macro{
+		Name:     "grab",
+		Commands: []string{"download $1 $2", "rm $1", "ls $@", "echo $0"},
+	}
+	if count := macroArgCount(macro); count != 2 {
+		t.Fatalf("expected 2 arguments, got %d", count)
+	}
+	_, err := expandMacro(macro, []string{"/tmp/a"})
+	if !errors.Is(err, errMissingArgument) {
+		t.Fatalf("expected a missing argument error, got %v", err)
+	}
+	commands, err := expandMacro(macro, []string{"/tmp/a b", "out"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"download '/tmp/a b' out", "rm '/tmp/a b'", "ls '/tmp/a b' out", "echo $0"}
+	for index, command := range expected {
+		if commands[index] != command {
+			t.Fatalf("expected '%s' got '%s'", command, commands[index])
+		}
+	}
+}
+
+func TestCheckCommand(t *testing.T) {
+	root := &cobra.Command{}
+	root.AddCommand(&cobra.Command{Use: "ls", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(&cobra.Command{
+		Use:         "shell",
+		Run:         func(*cobra.Command, []string) {},
+		Annotations: map[string]string{app.CommandFilterKey: consts.SessionCmdsFilter},
+	})
+	macrosCmd := &cobra.Command{Use: consts.MacrosStr}
+	macrosCmd.AddCommand(&cobra.Command{Use: consts.RunStr, Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(macrosCmd)
+
+	beaconFilters := []string{consts.SessionCmdsFilter, consts.WindowsCmdsFilter}
+	if err := checkCommand(root, "ls /tmp", beaconFilters); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCommand(root, "shell", []string{consts.BeaconCmdsFilter}); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkCommand(root, "shell", beaconFilters); err == nil {
+		t.Fatalf("expected session only command to be rejected on a beacon")
+	}
+	if err := checkCommand(root, "nope", beaconFilters); err == nil {
+		t.Fatalf("expected unknown command to be rejected")
+	}
+	if err := checkCommand(root, "macros run recon", beaconFilters); err == nil {
+		t.Fatalf("expected nested macro to be rejected")
+	}
+}
@@ -0,0 +1,46 @@
+package macros
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// MacrosRmCmd - Remove a macro
+func MacrosRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	macros, err := assets.LoadMacros()
+	if err != nil {
+		con.PrintErrorf("Failed to load macros: %s\n", err)
+		return
+	}
+	if _, ok := macros[args[0]]; !ok {
+		con.PrintErrorf("No macro named '%s'\n", args[0])
+		return
+	}
+	delete(macros, args[0])
+	err = assets.SaveMacros(macros)
+	if err != nil {
+		con.PrintErrorf("Failed to save macros: %s\n", err)
+		return
+	}
+	con.PrintInfof("Removed macro %s\n", args[0])
+}
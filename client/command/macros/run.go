@@ -0,0 +1,98 @@
+package macros
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+)
+
+// MacrosRunCmd - Run a macro's commands on the active session/beacon, beacon tasks
+// are queued one after the other
+func MacrosRunCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	macros, err := assets.LoadMacros()
+	if err != nil {
+		con.PrintErrorf("Failed to load macros: %s\n", err)
+		return
+	}
+	macro, ok := macros[args[0]]
+	if !ok {
+		con.PrintErrorf("No macro named '%s'\n", args[0])
+		return
+	}
+	commands, err := expandMacro(macro, args[1:])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	// Check every command before running any of them, so that a beacon isn't
+	// left with only part of the macro queued
+	for _, command := range commands {
+		err = checkCommand(cmd.Root(), command, con.ActiveTarget.Filters())
+		if err != nil {
+			con.PrintErrorf("%s: %s\n", macro.Name, err)
+			return
+		}
+	}
+
+	// The commands run on a console bound to the target, so the macro keeps running
+	// on it even if the operator switches to another target
+	bound := con.Bind(session, beacon, nil)
+	pending := con.PendingBeaconTasks()
+	for _, command := range commands {
+		con.PrintInfof("%s: %s\n", macro.Name, command)
+		errorCount := bound.ErrorCount()
+		err = bound.RunCommand(command)
+		if err == nil && errorCount < bound.ErrorCount() {
+			err = fmt.Errorf("failed, stopping")
+		}
+		if err != nil {
+			con.PrintErrorf("%s: %s\n", command, err)
+			return
+		}
+	}
+	if beacon != nil {
+		con.PrintInfof("Queued %d task(s) on %s\n", con.PendingBeaconTasks()-pending, beacon.Name)
+	}
+}
+
+// checkCommand - Returns an error if the command doesn't exist, isn't available for the
+// active session/beacon (e.g. an interactive command on a beacon), or is another macro
+func checkCommand(root *cobra.Command, command string, filters []string) error {
+	target, err := console.CheckCommand(root, command, filters)
+	if err != nil {
+		return err
+	}
+	for parent := target; parent != nil; parent = parent.Parent() {
+		if parent.Name() == consts.MacrosStr && parent.Parent() == root {
+			return fmt.Errorf("macros cannot run other macros")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package network
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// DiscoverCmd - Discover the hosts of the networks around the remote system
+func DiscoverCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	req := &sliverpb.DiscoverReq{Request: con.ActiveTarget.Request(cmd)}
+	req.ARP, _ = cmd.Flags().GetBool("arp")
+	req.Sweep, _ = cmd.Flags().GetBool("sweep")
+	req.NetBIOS, _ = cmd.Flags().GetBool("netbios")
+	req.MDNS, _ = cmd.Flags().GetBool("mdns")
+	req.SMB, _ = cmd.Flags().GetBool("smb")
+	req.LDAP, _ = cmd.Flags().GetBool("ldap")
+	req.Timeout, _ = cmd.Flags().GetInt32("query-timeout")
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		req.ARP, req.Sweep, req.NetBIOS, req.MDNS, req.SMB, req.LDAP = true, true, true, true, true, true
+	}
+	if !(req.ARP || req.Sweep || req.NetBIOS || req.MDNS || req.SMB || req.LDAP) {
+		req.ARP, req.NetBIOS, req.MDNS = true, true, true
+	}
+	for _, arg := range args {
+		req.Targets = append(req.Targets, strings.Split(arg, ",")...)
+	}
+
+	if beacon == nil {
+		targets := "the local networks"
+		if 0 < len(req.Targets) {
+			targets = strings.Join(req.Targets, ", ")
+		}
+		con.PrintInfof("Discovering hosts on %s ...\n", targets)
+	}
+	discover, err := con.Rpc.Discover(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if discover.Response != nil && discover.Response.Async {
+		con.AddBeaconCallback(discover.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, discover)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintDiscover(discover, con)
+		})
+		con.PrintAsyncResponse(discover.Response)
+	} else {
+		PrintDiscover(discover, con)
+	}
+}
+
+// PrintDiscover - Print the hosts found by network discovery
+func PrintDiscover(discover *sliverpb.Discover, con *console.SliverConsoleClient) {
+	if discover.Response != nil && discover.Response.Err != "" {
+		con.PrintErrorf("%s\n", discover.Response.Err)
+		return
+	}
+	for _, warning := range discover.Warnings {
+		con.PrintWarnf("%s\n", warning)
+	}
+	if len(discover.Hosts) == 0 {
+		con.PrintInfof("No hosts discovered\n")
+		return
+	}
+	con.Printf("%s\n", DiscoveredHostsTable(discover.Hosts, con))
+	con.PrintInfof("Discovered %d host(s)\n", len(discover.Hosts))
+}
+
+// DiscoveredHostsTable - A table of discovered hosts, with their shares and
+// logged on users when they were enumerated
+func DiscoveredHostsTable(hosts []*commonpb.DiscoveredHost, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Address", "MAC", "Hostnames", "Workgroup", "Roles", "Shares", "Sessions", "Sources"})
+	for _, host := range hosts {
+		tw.AppendRow(table.Row{
+			host.Address,
+			host.MAC,
+			strings.Join(host.Hostnames, ", "),
+			host.Workgroup,
+			strings.Join(host.Roles, ", "),
+			FormatShares(host.Shares),
+			FormatSessions(host.Sessions),
+			strings.Join(host.Sources, ", "),
+		})
+	}
+	return tw.Render()
+}
+
+// FormatShares - Share names, with the type of shares that aren't disks
+func FormatShares(shares []*commonpb.DiscoveredShare) string {
+	names := []string{}
+	for _, share := range shares {
+		if share.Type == "" || share.Type == "disk" {
+			names = append(names, share.Name)
+		} else {
+			names = append(names, fmt.Sprintf("%s (%s)", share.Name, share.Type))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// FormatSessions - The users logged on and the client each connected from
+func FormatSessions(sessions []*commonpb.DiscoveredSession) string {
+	users := []string{}
+	for _, session := range sessions {
+		users = append(users, fmt.Sprintf("%s@%s", session.Username, session.Client))
+	}
+	return strings.Join(users, ", ")
+}
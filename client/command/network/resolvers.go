@@ -0,0 +1,124 @@
+package network
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// C2Resolver - Resolve through the server instead of a dns server
+const C2Resolver = "c2"
+
+// ResolversCmd - Show or set the resolvers of the implant's name resolution
+func ResolversCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	reset, _ := cmd.Flags().GetBool("reset")
+	if reset && 0 < len(args) {
+		con.PrintErrorf("Resolvers can't be set and reset at the same time\n")
+		return
+	}
+	servers, err := ParseDNSResolvers(strings.Join(args, ","))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	resolvers, err := con.Rpc.DNSResolvers(context.Background(), &sliverpb.DNSResolversReq{
+		Request: con.ActiveTarget.Request(cmd),
+		Servers: servers,
+		Set:     reset || 0 < len(servers),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if resolvers.Response != nil && resolvers.Response.Async {
+		con.AddBeaconCallback(resolvers.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, resolvers)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintResolvers(resolvers, con)
+		})
+		con.PrintAsyncResponse(resolvers.Response)
+	} else {
+		PrintResolvers(resolvers, con)
+	}
+}
+
+// PrintResolvers - Print the implant's resolvers
+func PrintResolvers(resolvers *sliverpb.DNSResolvers, con *console.SliverConsoleClient) {
+	if resolvers.Response != nil && resolvers.Response.Err != "" {
+		con.PrintErrorf("%s\n", resolvers.Response.Err)
+		return
+	}
+	if len(resolvers.Servers) == 0 {
+		con.PrintInfof("Names are resolved by the system's dns servers\n")
+		return
+	}
+	for index, server := range resolvers.Servers {
+		if server == C2Resolver {
+			server = "c2 (resolved by the server)"
+		}
+		con.Printf("%d. %s\n", index+1, server)
+	}
+}
+
+// ParseDNSResolvers - Parse a comma separated list of resolvers, each is an address
+// with an optional port (53 by default) or "c2"
+func ParseDNSResolvers(value string) ([]string, error) {
+	servers := []string{}
+	for _, server := range strings.Split(value, ",") {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+		if strings.ToLower(server) == C2Resolver {
+			servers = append(servers, C2Resolver)
+			continue
+		}
+		host, port, err := net.SplitHostPort(server)
+		if err != nil {
+			host, port = strings.Trim(server, "[]"), "53"
+		}
+		if net.ParseIP(host) == nil {
+			return nil, fmt.Errorf("invalid resolver '%s', expected an ip address or '%s'", server, C2Resolver)
+		}
+		if number, err := strconv.ParseUint(port, 10, 16); err != nil || number == 0 {
+			return nil, fmt.Errorf("invalid resolver port '%s'", port)
+		}
+		servers = append(servers, net.JoinHostPort(host, port))
+	}
+	return servers, nil
+}
@@ -0,0 +1,44 @@
+package network
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestParseDNSResolvers(t *testing.T) {
+	servers, err := ParseDNSResolvers("1.1.1.1, C2,10.0.0.53:5353,2606:4700::1111,[::1]:53")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"1.1.1.1:53", "c2", "10.0.0.53:5353", "[2606:4700::1111]:53", "[::1]:53"}
+	if len(servers) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, servers)
+	}
+	for index := range expected {
+		if servers[index] != expected[index] {
+			t.Fatalf("expected %v, got %v", expected, servers)
+		}
+	}
+	for _, invalid := range []string{"dns.google", "1.1.1.1:0", "1.1.1.1:dns"} {
+		if _, err := ParseDNSResolvers(invalid); err == nil {
+			t.Errorf("expected %s to be invalid", invalid)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package network
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// TopPorts - Port spec of commonly open ports
+	TopPorts = "top"
+
+	maxBannerWidth = 60
+)
+
+var (
+	// topPorts - Commonly open TCP ports, scanned by default
+	topPorts = []uint32{
+		21, 22, 23, 25, 53, 80, 81, 88, 110, 111, 135, 139, 143, 389, 443, 445,
+		465, 587, 636, 993, 995, 1433, 1521, 2049, 2375, 3000, 3268, 3306, 3389,
+		5000, 5432, 5900, 5985, 5986, 6379, 8000, 8080, 8443, 8888, 9000, 9200,
+		9443, 11211, 27017,
+	}
+
+	// services - Well known services of common ports
+	services = map[uint32]string{
+		21:    "ftp",
+		22:    "ssh",
+		23:    "telnet",
+		25:    "smtp",
+		53:    "dns",
+		80:    "http",
+		81:    "http",
+		88:    "kerberos",
+		110:   "pop3",
+		111:   "rpcbind",
+		135:   "msrpc",
+		139:   "netbios-ssn",
+		143:   "imap",
+		389:   "ldap",
+		443:   "https",
+		445:   "smb",
+		465:   "smtps",
+		587:   "submission",
+		636:   "ldaps",
+		993:   "imaps",
+		995:   "pop3s",
+		1433:  "mssql",
+		1521:  "oracle",
+		2049:  "nfs",
+		2375:  "docker",
+		3000:  "http",
+		3268:  "globalcatldap",
+		3306:  "mysql",
+		3389:  "rdp",
+		5000:  "http",
+		5432:  "postgresql",
+		5900:  "vnc",
+		5985:  "winrm",
+		5986:  "winrm-https",
+		6379:  "redis",
+		8000:  "http",
+		8080:  "http-proxy",
+		8443:  "https-alt",
+		8888:  "http",
+		9000:  "http",
+		9200:  "elasticsearch",
+		9443:  "https-alt",
+		11211: "memcached",
+		27017: "mongodb",
+	}
+)
+
+// ScanCmd - TCP connect scan from the remote system
+func ScanCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	portSpec, _ := cmd.Flags().GetString("ports")
+	ports, err := ParsePorts(portSpec)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	ping, _ := cmd.Flags().GetBool("ping")
+	banners, _ := cmd.Flags().GetBool("banners")
+	connectTimeout, _ := cmd.Flags().GetInt32("connect-timeout")
+	concurrency, _ := cmd.Flags().GetInt32("concurrency")
+
+	targets := []string{}
+	for _, arg := range args {
+		targets = append(targets, strings.Split(arg, ",")...)
+	}
+
+	if beacon == nil {
+		con.PrintInfof("Scanning %d port(s) on %s ...\n", len(ports), strings.Join(targets, ", "))
+	}
+	// Open ports are printed as they're found, the table follows once the scan completes
+	request := con.ActiveTarget.Request(cmd)
+	stream := con.StreamTaskOutput(request, false)
+	scan, err := con.Rpc.Scan(context.Background(), &sliverpb.ScanReq{
+		Request:        request,
+		Targets:        targets,
+		Ports:          ports,
+		Ping:           ping,
+		Banners:        banners,
+		ConnectTimeout: connectTimeout,
+		Concurrency:    concurrency,
+	})
+	if stream != nil {
+		con.CloseTaskOutput(stream, false)
+	}
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if scan.Response != nil && scan.Response.Async {
+		con.AddBeaconCallback(scan.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, scan)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintScan(scan, con)
+		})
+		con.PrintAsyncResponse(scan.Response)
+	} else {
+		PrintScan(scan, con)
+	}
+}
+
+// PrintScan - Print the hosts and open ports found by a scan
+func PrintScan(scan *sliverpb.Scan, con *console.SliverConsoleClient) {
+	if scan.Response != nil && scan.Response.Err != "" {
+		con.PrintErrorf("%s\n", scan.Response.Err)
+		return
+	}
+	openPorts := 0
+	for _, host := range scan.Hosts {
+		openPorts += len(host.Ports)
+	}
+	if len(scan.Hosts) == 0 {
+		con.PrintInfof("Scanned %d address(es), nothing found\n", scan.Scanned)
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Address", "Hostname", "Port", "Service", "Banner"})
+	for _, host := range scan.Hosts {
+		if len(host.Ports) == 0 {
+			tw.AppendRow(table.Row{host.Address, host.Hostname, "", "", "(up)"})
+			continue
+		}
+		for _, port := range host.Ports {
+			tw.AppendRow(table.Row{host.Address, host.Hostname, port.Port, ServiceName(port.Port), FormatBanner(port.Banner)})
+		}
+	}
+	con.Printf("%s\n", tw.Render())
+	con.PrintInfof("Scanned %d address(es), %d host(s) up, %d open port(s)\n", scan.Scanned, len(scan.Hosts), openPorts)
+}
+
+// ParsePorts - Ports of a spec like "22,80,8000-8100", "top" includes the commonly open ports
+func ParsePorts(spec string) ([]uint32, error) {
+	seen := map[uint32]bool{}
+	ports := []uint32{}
+	add := func(port uint32) {
+		if !seen[port] {
+			seen[port] = true
+			ports = append(ports, port)
+		}
+	}
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if part == TopPorts {
+			for _, port := range topPorts {
+				add(port)
+			}
+			continue
+		}
+		first, last, isRange := strings.Cut(part, "-")
+		start, err := parsePort(first)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			end, err = parsePort(last)
+			if err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid port range '%s'", part)
+			}
+		}
+		for port := start; port <= end; port++ {
+			add(port)
+		}
+	}
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no ports to scan")
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports, nil
+}
+
+func parsePort(value string) (uint32, error) {
+	port, err := strconv.ParseUint(strings.TrimSpace(value), 10, 16)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("invalid port '%s'", value)
+	}
+	return uint32(port), nil
+}
+
+// ServiceName - The well known service of a port, if any
+func ServiceName(port uint32) string {
+	return services[port]
+}
+
+// FormatBanner - The first line of a banner with non-printable characters escaped,
+// truncated to fit in a table
+func FormatBanner(banner string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(banner), "\n")
+	line = strconv.Quote(strings.TrimRight(line, "\r"))
+	line = line[1 : len(line)-1]
+	if maxBannerWidth < len(line) {
+		line = line[:maxBannerWidth-3] + "..."
+	}
+	return line
+}
+
+// AddressLess - IPs sort numerically, hostnames after IPs
+func AddressLess(a string, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	switch {
+	case ipA != nil && ipB != nil:
+		return bytes.Compare(ipA.To16(), ipB.To16()) < 0
+	case ipA != nil:
+		return true
+	case ipB != nil:
+		return false
+	}
+	return a < b
+}
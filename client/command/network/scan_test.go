@@ -0,0 +1,71 @@
+package network
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePorts(t *testing.T) {
+	ports, err := ParsePorts("443, 22,80-82,22")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ports, []uint32{22, 80, 81, 82, 443}) {
+		t.Fatalf("unexpected ports %v", ports)
+	}
+	ports, err = ParsePorts("top,65535")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ports) != len(topPorts)+1 || ports[len(ports)-1] != 65535 {
+		t.Fatalf("unexpected ports %v", ports)
+	}
+	for _, spec := range []string{"", "0", "65536", "ssh", "90-80", "1-2-3"} {
+		if _, err := ParsePorts(spec); err == nil {
+			t.Errorf("expected an error for '%s'", spec)
+		}
+	}
+}
+
+func TestFormatBanner(t *testing.T) {
+	banner := FormatBanner("SSH-2.0-OpenSSH_8.9\r\nsecond line")
+	if banner != "SSH-2.0-OpenSSH_8.9" {
+		t.Fatalf("unexpected banner '%s'", banner)
+	}
+	banner = FormatBanner("\x00\x01binary")
+	if banner != `\x00\x01binary` {
+		t.Fatalf("unexpected banner '%s'", banner)
+	}
+	if len(FormatBanner(string(make([]byte, 1024)))) != maxBannerWidth {
+		t.Fatalf("banner was not truncated")
+	}
+}
+
+func TestAddressLess(t *testing.T) {
+	sorted := []string{"10.0.0.9", "10.0.0.10", "192.168.1.1", "dc01.corp"}
+	for i := range sorted {
+		for j := range sorted {
+			if AddressLess(sorted[i], sorted[j]) != (i < j) {
+				t.Errorf("AddressLess(%s, %s) != %v", sorted[i], sorted[j], i < j)
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package operators
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// PresenceCmd - Display which operators are interacting with which targets
+func PresenceCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	presence, err := con.Rpc.GetPresence(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(presence.Operators) == 0 && len(presence.Locks) == 0 {
+		con.PrintInfof("No operators are interacting with sessions or beacons\n")
+		return
+	}
+	displayPresence(presence, targetNames(con), con)
+}
+
+func displayPresence(presence *clientpb.Presence, names map[string]string, con *console.SliverConsoleClient) {
+	locks := map[string]*clientpb.TargetLock{}
+	for _, lock := range presence.Locks {
+		locks[lock.TargetID] = lock
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Target",
+		"Name",
+		"Operator",
+		"Since",
+		"Last Seen",
+		"Lock",
+	})
+	listed := map[string]bool{}
+	for _, operator := range presence.Operators {
+		lock := ""
+		if targetLock, ok := locks[operator.TargetID]; ok && targetLock.Operator == operator.Operator {
+			lock = lockDescription(targetLock)
+			listed[operator.TargetID] = true
+		}
+		tw.AppendRow(table.Row{
+			shortID(operator.TargetID),
+			names[operator.TargetID],
+			console.Bold + operator.Operator + console.Normal,
+			con.FormatDateDelta(time.Unix(operator.Since, 0), false, false),
+			con.FormatDateDelta(time.Unix(operator.LastSeen, 0), false, false),
+			lock,
+		})
+	}
+	// Locks held by operators that are no longer interacting with the target
+	for _, lock := range presence.Locks {
+		if listed[lock.TargetID] {
+			continue
+		}
+		tw.AppendRow(table.Row{
+			shortID(lock.TargetID),
+			names[lock.TargetID],
+			console.Bold + lock.Operator + console.Normal,
+			"",
+			"",
+			lockDescription(lock),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+func lockDescription(lock *clientpb.TargetLock) string {
+	desc := console.Bold + console.Red + "Locked" + console.Normal
+	if lock.Reason != "" {
+		desc += " (" + lock.Reason + ")"
+	}
+	return desc
+}
+
+// targetNames - Session and beacon IDs to names, targets that can't be found are left blank
+func targetNames(con *console.SliverConsoleClient) map[string]string {
+	names := map[string]string{}
+	sessions, err := con.Rpc.GetSessions(context.Background(), &commonpb.Empty{})
+	if err == nil {
+		for _, session := range sessions.Sessions {
+			names[session.ID] = session.Name
+		}
+	}
+	beacons, err := con.Rpc.GetBeacons(context.Background(), &commonpb.Empty{})
+	if err == nil {
+		for _, beacon := range beacons.Beacons {
+			names[beacon.ID] = beacon.Name
+		}
+	}
+	return names
+}
+
+// LockCmd - Lock the active session/beacon
+func LockCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	targetID := activeTargetID(con)
+	if targetID == "" {
+		return
+	}
+	reason, _ := cmd.Flags().GetString("reason")
+	force, _ := cmd.Flags().GetBool("force")
+	lock, err := con.Rpc.LockTarget(context.Background(), &clientpb.TargetLockReq{
+		TargetID: targetID,
+		Reason:   reason,
+		Force:    force,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		if !force {
+			con.PrintInfof("Use --force to take over the lock\n")
+		}
+		return
+	}
+	con.PrintInfof("Locked %s for %s\n", shortID(lock.TargetID), lock.Operator)
+}
+
+// UnlockCmd - Unlock the active session/beacon
+func UnlockCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	targetID := activeTargetID(con)
+	if targetID == "" {
+		return
+	}
+	force, _ := cmd.Flags().GetBool("force")
+	_, err := con.Rpc.UnlockTarget(context.Background(), &clientpb.TargetLockReq{
+		TargetID: targetID,
+		Force:    force,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Unlocked %s\n", shortID(targetID))
+}
+
+func activeTargetID(con *console.SliverConsoleClient) string {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session != nil {
+		return session.ID
+	}
+	if beacon != nil {
+		return beacon.ID
+	}
+	return ""
+}
+
+func shortID(id string) string {
+	return strings.Split(id, "-")[0]
+}
@@ -0,0 +1,241 @@
+package panes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// maxScrollback - Lines kept per pane
+	maxScrollback = 5000
+
+	tabWidth = 8
+	esc      = '\033'
+)
+
+// paneBuffer - A pane's output, color (SGR) sequences are kept and every other
+// escape sequence is dropped, carriage returns overwrite the current line
+type paneBuffer struct {
+	mutex   *sync.Mutex
+	lines   []string
+	current *strings.Builder
+	cr      bool
+	pending []byte // An incomplete rune or escape sequence
+	dirty   bool
+}
+
+func newPaneBuffer() *paneBuffer {
+	return &paneBuffer{
+		mutex:   &sync.Mutex{},
+		lines:   []string{},
+		current: &strings.Builder{},
+	}
+}
+
+func (b *paneBuffer) Write(data []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.dirty = true
+	written := len(data)
+	data = append(b.pending, data...)
+	b.pending = nil
+	for 0 < len(data) {
+		if data[0] == esc {
+			size, complete := escapeLen(data)
+			if !complete {
+				b.pending = append([]byte{}, data...)
+				return written, nil
+			}
+			if 2 < size && data[1] == '[' && data[size-1] == 'm' {
+				b.current.Write(data[:size])
+			}
+			data = data[size:]
+			continue
+		}
+		if !utf8.FullRune(data) {
+			b.pending = append([]byte{}, data...)
+			break
+		}
+		char, size := utf8.DecodeRune(data)
+		data = data[size:]
+		switch {
+		case char == '\n':
+			b.newline()
+		case char == '\r':
+			b.cr = true
+		case char == '\b':
+			line := b.current.String()
+			if _, size := utf8.DecodeLastRuneInString(line); 0 < size {
+				b.current.Reset()
+				b.current.WriteString(line[:len(line)-size])
+			}
+		case char == '\t':
+			b.overwrite()
+			b.current.WriteString(strings.Repeat(" ", tabWidth-visibleLen(b.current.String())%tabWidth))
+		case char < ' ' || char == 0x7f:
+		default:
+			b.overwrite()
+			b.current.WriteRune(char)
+		}
+	}
+	return written, nil
+}
+
+func (b *paneBuffer) newline() {
+	b.lines = append(b.lines, b.current.String())
+	if maxScrollback < len(b.lines) {
+		b.lines = b.lines[len(b.lines)-maxScrollback:]
+	}
+	b.current.Reset()
+	b.cr = false
+}
+
+// overwrite - Text after a carriage return replaces the line
+func (b *paneBuffer) overwrite() {
+	if b.cr {
+		b.current.Reset()
+		b.cr = false
+	}
+}
+
+// Clear - Remove all of the output
+func (b *paneBuffer) Clear() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.lines = []string{}
+	b.current.Reset()
+	b.cr = false
+	b.dirty = true
+}
+
+// Rows - The last height rows of output wrapped to width, skipping the last
+// scroll rows. The number of rows may be less than height.
+func (b *paneBuffer) Rows(width int, height int, scroll int) []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	lines := b.lines
+	if b.current.Len() != 0 {
+		lines = append(lines[:len(lines):len(lines)], b.current.String())
+	}
+	rows := []string{}
+	for index := len(lines) - 1; 0 <= index && len(rows) < height+scroll; index-- {
+		rows = append(wrapLine(lines[index], width), rows...)
+	}
+	if scroll < len(rows) {
+		rows = rows[:len(rows)-scroll]
+	} else {
+		rows = []string{}
+	}
+	if height < len(rows) {
+		rows = rows[len(rows)-height:]
+	}
+	return rows
+}
+
+// Changed - If there was output since the last call
+func (b *paneBuffer) Changed() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	dirty := b.dirty
+	b.dirty = false
+	return dirty
+}
+
+// escapeLen - The length of the escape sequence at the start of data, and whether it's complete
+func escapeLen(data []byte) (int, bool) {
+	if len(data) < 2 {
+		return len(data), false
+	}
+	switch data[1] {
+	case '[': // CSI, ends with a byte in the range 0x40-0x7e
+		for index := 2; index < len(data); index++ {
+			if 0x40 <= data[index] && data[index] <= 0x7e {
+				return index + 1, true
+			}
+		}
+		return len(data), false
+	case ']': // OSC, ends with BEL or ST
+		for index := 2; index < len(data); index++ {
+			if data[index] == '\a' {
+				return index + 1, true
+			}
+			if data[index] == esc && index+1 < len(data) && data[index+1] == '\\' {
+				return index + 2, true
+			}
+		}
+		return len(data), false
+	}
+	return 2, true
+}
+
+// visibleLen - The number of characters of s that are displayed
+func visibleLen(s string) int {
+	count := 0
+	for index := 0; index < len(s); {
+		if s[index] == esc {
+			size, _ := escapeLen([]byte(s[index:]))
+			index += size
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[index:])
+		index += size
+		count++
+	}
+	return count
+}
+
+// wrapLine - Split a line into rows of at most width visible characters
+func wrapLine(line string, width int) []string {
+	if width < 1 {
+		return []string{}
+	}
+	rows := []string{}
+	row := &strings.Builder{}
+	count := 0
+	for index := 0; index < len(line); {
+		if line[index] == esc {
+			size, _ := escapeLen([]byte(line[index:]))
+			row.WriteString(line[index : index+size])
+			index += size
+			continue
+		}
+		if count == width {
+			rows = append(rows, row.String())
+			row.Reset()
+			count = 0
+		}
+		_, size := utf8.DecodeRuneInString(line[index:])
+		row.WriteString(line[index : index+size])
+		index += size
+		count++
+	}
+	return append(rows, row.String())
+}
+
+// fit - Truncate or pad s to exactly width visible characters
+func fit(s string, width int) string {
+	rows := wrapLine(s, width)
+	if len(rows) == 0 {
+		return ""
+	}
+	return rows[0] + strings.Repeat(" ", width-visibleLen(rows[0]))
+}
@@ -0,0 +1,86 @@
+package panes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+func TestPaneBuffer(t *testing.T) {
+	buf := newPaneBuffer()
+	buf.Write([]byte("first\r\nprogress 10%\rprogress 100%\n"))
+	buf.Write([]byte(console.Red + "red" + console.Normal + "\033[2K\033]0;title\a\n"))
+	buf.Write([]byte("a\tb\nabc\b\bd\n"))
+
+	expected := []string{"first", "progress 100%", console.Red + "red" + console.Normal, "a       b", "ad"}
+	if rows := buf.Rows(80, 10, 0); !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %q, got %q", expected, rows)
+	}
+	if !buf.Changed() || buf.Changed() {
+		t.Fatal("expected a single change")
+	}
+
+	// Runes and escape sequences split across writes
+	buf.Clear()
+	data := []byte("é" + console.Green + "ok\n")
+	for _, b := range data {
+		buf.Write([]byte{b})
+	}
+	expected = []string{"é" + console.Green + "ok"}
+	if rows := buf.Rows(80, 10, 0); !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %q, got %q", expected, rows)
+	}
+}
+
+func TestPaneBufferRows(t *testing.T) {
+	buf := newPaneBuffer()
+	buf.Write([]byte("1\n2\n" + "abcdefgh\n" + "partial"))
+
+	if rows := buf.Rows(4, 3, 0); !reflect.DeepEqual(rows, []string{"efgh", "part", "ial"}) {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+	if rows := buf.Rows(4, 3, 2); !reflect.DeepEqual(rows, []string{"2", "abcd", "efgh"}) {
+		t.Fatalf("unexpected scrolled rows %q", rows)
+	}
+	if rows := buf.Rows(4, 3, 5); !reflect.DeepEqual(rows, []string{"1"}) {
+		t.Fatalf("unexpected scrolled rows %q", rows)
+	}
+	if rows := buf.Rows(4, 3, 6); len(rows) != 0 {
+		t.Fatalf("expected no rows, got %q", rows)
+	}
+}
+
+func TestFit(t *testing.T) {
+	colored := console.Bold + "abcdef" + console.Normal
+	if visibleLen(colored) != 6 {
+		t.Fatalf("expected 6 visible characters, got %d", visibleLen(colored))
+	}
+	if fitted := fit(colored, 3); fitted != console.Bold+"abc" {
+		t.Fatalf("unexpected %q", fitted)
+	}
+	if fitted := fit("ab", 4); fitted != "ab  " {
+		t.Fatalf("unexpected %q", fitted)
+	}
+	if line := inputLine("> ", "abcdef", 4); line != "def" {
+		t.Fatalf("unexpected input line %q", line)
+	}
+}
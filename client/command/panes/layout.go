@@ -0,0 +1,409 @@
+package panes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+const (
+	prefixKey = 0x02 // Ctrl-B
+	ctrlC     = 0x03
+	ctrlU     = 0x15
+	backspace = 0x7f
+
+	minPaneHeight   = 3
+	minPaneWidth    = 20
+	refreshInterval = 50 * time.Millisecond
+
+	altScreen   = "\033[?1049h"
+	mainScreen  = "\033[?1049l"
+	clearScreen = "\033[2J"
+	hideCursor  = "\033[?25l"
+	showCursor  = "\033[?25h"
+	reverse     = "\033[7m"
+	moveTo      = "\033[%d;%dH"
+
+	pageUp   = "\033[5~"
+	pageDown = "\033[6~"
+
+	statusLine = " Ctrl-B then: o next pane, 1-9 select pane, [ ] scroll, x close pane, d detach"
+	prefixLine = " Ctrl-B ..."
+)
+
+var errTooSmall = errors.New("terminal too small")
+
+// rect - A pane's position on the screen, rows and columns start at 1
+type rect struct {
+	row    int
+	col    int
+	height int
+	width  int
+}
+
+// layoutPanes - Split the screen between the panes, stacked or side by side, the
+// last row is reserved for the status line
+func layoutPanes(rows int, cols int, count int, columns bool) ([]rect, error) {
+	rects := []rect{}
+	if columns {
+		col := 1
+		for _, width := range splitSizes(cols-(count-1), count) {
+			if width < minPaneWidth || rows-1 < minPaneHeight {
+				return nil, errTooSmall
+			}
+			rects = append(rects, rect{row: 1, col: col, height: rows - 1, width: width})
+			col += width + 1 // Separator
+		}
+		return rects, nil
+	}
+	row := 1
+	for _, height := range splitSizes(rows-1, count) {
+		if height < minPaneHeight || cols < minPaneWidth {
+			return nil, errTooSmall
+		}
+		rects = append(rects, rect{row: row, col: 1, height: height, width: cols})
+		row += height
+	}
+	return rects, nil
+}
+
+// splitSizes - Split total into count sizes as evenly as possible
+func splitSizes(total int, count int) []int {
+	sizes := make([]int, count)
+	for index := range sizes {
+		sizes[index] = total / count
+		if index < total%count {
+			sizes[index]++
+		}
+	}
+	return sizes
+}
+
+// paneState - A pane and what the operator has typed into it
+type paneState struct {
+	pane   pane
+	input  []byte
+	scroll int
+}
+
+// screen - The split view, keys go to the focused pane unless they follow the prefix key
+type screen struct {
+	con     *console.SliverConsoleClient
+	panes   []*paneState
+	focus   int
+	columns bool
+	output  io.Writer // Console output while the screen is shown
+
+	prefix   bool
+	escape   []byte // An incomplete escape sequence from the keyboard
+	rows     int
+	cols     int
+	rects    []rect
+	dirty    bool
+	detached bool
+}
+
+func newScreen(con *console.SliverConsoleClient, panes []pane, output io.Writer, columns bool) *screen {
+	s := &screen{con: con, panes: []*paneState{}, output: output, columns: columns}
+	for _, p := range panes {
+		s.panes = append(s.panes, &paneState{pane: p})
+	}
+	return s
+}
+
+// run - Show the screen until the operator detaches or every pane has been closed,
+// the panes are closed when it returns
+func (s *screen) run() error {
+	defer s.closeAll()
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, oldState)
+	restoreOutput := s.con.RedirectOutput(func(format string, args ...any) (int, error) {
+		return fmt.Fprintf(s.output, format, args...)
+	})
+	defer restoreOutput()
+	os.Stdout.WriteString(altScreen)
+	defer os.Stdout.WriteString(showCursor + mainScreen)
+
+	keys := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
+	go readKeys(keys, done)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	s.dirty = true
+	for !s.detached && 0 < len(s.panes) {
+		select {
+		case data, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			s.handleKeys(data)
+		case <-ticker.C:
+		}
+		s.draw()
+	}
+	return nil
+}
+
+// readKeys - Read from stdin until the screen is closed, the read that's
+// in progress when it's closed is discarded
+func readKeys(keys chan<- []byte, done <-chan struct{}) {
+	defer close(keys)
+	buf := make([]byte, 256)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return
+		}
+		select {
+		case keys <- append([]byte{}, buf[:n]...):
+		case <-done:
+			return
+		}
+	}
+}
+
+func (s *screen) closeAll() {
+	for _, state := range s.panes {
+		state.pane.close()
+	}
+	s.panes = []*paneState{}
+}
+
+func (s *screen) handleKeys(data []byte) {
+	s.dirty = true
+	data = append(s.escape, data...)
+	s.escape = nil
+	for 0 < len(data) {
+		if data[0] == esc {
+			size, complete := escapeLen(data)
+			if !complete {
+				if 1 < len(data) {
+					s.escape = data
+				}
+				return
+			}
+			s.handleEscape(string(data[:size]))
+			data = data[size:]
+			continue
+		}
+		key := data[0]
+		data = data[1:]
+		switch {
+		case s.prefix:
+			s.prefix = false
+			s.command(key)
+		case key == prefixKey:
+			s.prefix = true
+		default:
+			s.key(key)
+		}
+	}
+}
+
+func (s *screen) handleEscape(seq string) {
+	switch seq {
+	case pageUp:
+		s.scroll(1)
+	case pageDown:
+		s.scroll(-1)
+	}
+}
+
+// command - A key pressed after the prefix key
+func (s *screen) command(key byte) {
+	switch {
+	case key == 'o' || key == '\t':
+		s.focus = (s.focus + 1) % len(s.panes)
+	case '1' <= key && key <= '9':
+		if index := int(key - '1'); index < len(s.panes) {
+			s.focus = index
+		}
+	case key == '[':
+		s.scroll(1)
+	case key == ']':
+		s.scroll(-1)
+	case key == 'x':
+		s.panes[s.focus].pane.close()
+		s.panes = append(s.panes[:s.focus], s.panes[s.focus+1:]...)
+		if len(s.panes) <= s.focus {
+			s.focus = len(s.panes) - 1
+		}
+		if s.focus < 0 {
+			s.focus = 0
+		}
+		s.rects = nil
+	case key == 'd':
+		s.detached = true
+	}
+}
+
+// scroll - Scroll the focused pane half a page up (1) or down (-1)
+func (s *screen) scroll(direction int) {
+	if len(s.rects) <= s.focus {
+		return
+	}
+	state := s.panes[s.focus]
+	width, height := s.rects[s.focus].width, s.rects[s.focus].height
+	scroll := state.scroll + direction*(height/2)
+	if scroll < 0 {
+		scroll = 0
+	}
+	// Don't scroll past the first row
+	if len(state.pane.buffer().Rows(width, 1, scroll)) == 0 {
+		return
+	}
+	state.scroll = scroll
+}
+
+// key - A key for the focused pane, which has a single line of input
+func (s *screen) key(key byte) {
+	state := s.panes[s.focus]
+	if state.pane.prompt() == "" {
+		return
+	}
+	switch {
+	case key == '\r' || key == '\n':
+		line := string(state.input)
+		state.input = nil
+		state.scroll = 0
+		state.pane.submit(line)
+	case key == backspace || key == '\b':
+		_, size := utf8.DecodeLastRune(state.input)
+		state.input = state.input[:len(state.input)-size]
+	case key == ctrlC:
+		state.input = nil
+		state.pane.interrupt()
+	case key == ctrlU:
+		state.input = nil
+	case ' ' <= key:
+		state.input = append(state.input, key)
+	}
+}
+
+// draw - Redraw the screen if anything has changed
+func (s *screen) draw() {
+	for _, state := range s.panes {
+		if state.pane.buffer().Changed() {
+			s.dirty = true
+		}
+	}
+	cols, rows, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return
+	}
+	if cols != s.cols || rows != s.rows || s.rects == nil {
+		s.cols, s.rows = cols, rows
+		s.rects, err = layoutPanes(rows, cols, len(s.panes), s.columns)
+		if err != nil {
+			os.Stdout.WriteString(clearScreen + fmt.Sprintf(moveTo, 1, 1) + "Terminal too small, Ctrl-B d to detach")
+			return
+		}
+		os.Stdout.WriteString(clearScreen)
+		s.dirty = true
+	}
+	if !s.dirty || len(s.rects) != len(s.panes) {
+		return
+	}
+	s.dirty = false
+	os.Stdout.WriteString(s.frame())
+}
+
+func (s *screen) frame() string {
+	frame := &strings.Builder{}
+	frame.WriteString(hideCursor)
+	cursor := ""
+	for index, state := range s.panes {
+		r := s.rects[index]
+		title := fmt.Sprintf(" %d: %s", index+1, state.pane.title())
+		if 0 < state.scroll {
+			title += fmt.Sprintf(" [scrolled %d]", state.scroll)
+		}
+		style := console.Bold
+		if index == s.focus {
+			style += reverse
+		}
+		fmt.Fprintf(frame, moveTo, r.row, r.col)
+		frame.WriteString(style + fit(title, r.width) + console.Normal)
+
+		height := r.height - 1
+		prompt := state.pane.prompt()
+		if prompt != "" {
+			height-- // Input line
+		}
+		rows := state.pane.buffer().Rows(r.width, height, state.scroll)
+		for line := 0; line < height; line++ {
+			text := ""
+			if offset := line - (height - len(rows)); 0 <= offset {
+				text = rows[offset]
+			}
+			fmt.Fprintf(frame, moveTo, r.row+1+line, r.col)
+			frame.WriteString(fit(text, r.width) + console.Normal)
+		}
+		if prompt != "" {
+			input := inputLine(prompt, string(state.input), r.width)
+			fmt.Fprintf(frame, moveTo, r.row+r.height-1, r.col)
+			frame.WriteString(fit(input, r.width) + console.Normal)
+			if index == s.focus {
+				cursor = fmt.Sprintf(moveTo, r.row+r.height-1, r.col+visibleLen(input)) + showCursor
+			}
+		}
+		if s.columns && 0 < index {
+			for row := r.row; row < r.row+r.height; row++ {
+				fmt.Fprintf(frame, moveTo+"│", row, r.col-1)
+			}
+		}
+	}
+	status := statusLine
+	if s.prefix {
+		status = prefixLine
+	}
+	fmt.Fprintf(frame, moveTo, s.rows, 1)
+	frame.WriteString(reverse + fit(status, s.cols) + console.Normal)
+	frame.WriteString(cursor)
+	return frame.String()
+}
+
+// inputLine - The prompt and input, only the end of the input is shown if it doesn't fit
+func inputLine(prompt string, input string, width int) string {
+	line := prompt + input
+	if visibleLen(line) < width {
+		return line
+	}
+	runes := []rune(input)
+	if width-1 < len(runes) {
+		runes = runes[len(runes)-(width-1):]
+	}
+	return string(runes)
+}
@@ -0,0 +1,82 @@
+package panes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestLayoutPanes(t *testing.T) {
+	rects, err := layoutPanes(24, 80, 3, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []rect{{1, 1, 8, 80}, {9, 1, 8, 80}, {17, 1, 7, 80}}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("expected %v, got %v", expected, rects)
+	}
+
+	rects, err = layoutPanes(24, 80, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []rect{{1, 1, 23, 40}, {1, 42, 23, 39}}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("expected %v, got %v", expected, rects)
+	}
+
+	if _, err := layoutPanes(10, 80, 4, false); err != errTooSmall {
+		t.Fatalf("expected %v, got %v", errTooSmall, err)
+	}
+	if _, err := layoutPanes(24, 50, 3, true); err != errTooSmall {
+		t.Fatalf("expected %v, got %v", errTooSmall, err)
+	}
+}
+
+func TestScreenKeys(t *testing.T) {
+	first, second := newTaskPane(nil, nil, &clientpb.Beacon{Name: "TEST"}), &eventsPane{buf: newPaneBuffer()}
+	s := newScreen(nil, []pane{first, second}, first.buf, false)
+	s.handleKeys([]byte{prefixKey, 'o'})
+	if s.focus != 1 {
+		t.Fatalf("expected focus on the second pane, got %d", s.focus)
+	}
+	s.handleKeys([]byte{prefixKey})
+	s.handleKeys([]byte{'1'})
+	if s.focus != 0 {
+		t.Fatalf("expected focus on the first pane, got %d", s.focus)
+	}
+	s.handleKeys([]byte("ab\033[A"))
+	s.handleKeys([]byte("é\033["))
+	s.handleKeys([]byte("Bc"))
+	s.handleKeys([]byte{backspace})
+	if input := string(s.panes[0].input); input != "abé" {
+		t.Fatalf("unexpected input %q", input)
+	}
+	s.handleKeys([]byte{backspace, ctrlU})
+	if len(s.panes[0].input) != 0 {
+		t.Fatalf("expected no input, got %q", s.panes[0].input)
+	}
+	s.handleKeys([]byte{prefixKey, 'd'})
+	if !s.detached {
+		t.Fatal("expected to detach")
+	}
+}
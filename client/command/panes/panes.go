@@ -0,0 +1,498 @@
+package panes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// maxPanes - Panes are selected with Ctrl-B 1-9
+	maxPanes = 9
+)
+
+var (
+	// interactiveCmds - Commands that take over the terminal and can't run in a pane
+	interactiveCmds = map[string]bool{
+		consts.ShellStr:      true,
+		consts.BackgroundStr: true,
+	}
+
+	// textEvents - Events with a human readable message as their data
+	textEvents = map[string]bool{
+		consts.CanaryEvent:         true,
+		consts.WatchtowerEvent:     true,
+		consts.BurnedContactEvent:  true,
+		consts.FederationEvent:     true,
+		consts.AutomationEvent:     true,
+		consts.StageFetchEvent:     true,
+		consts.TargetLockEvent:     true,
+		consts.TargetMetadataEvent: true,
+		consts.OpsecEvent:          true,
+		consts.BeaconOverdueEvent:  true,
+	}
+)
+
+// pane - A pane of the split view
+type pane interface {
+	title() string
+	// prompt - Shown in front of the input line, blank if the pane doesn't take input
+	prompt() string
+	// submit - The operator pressed enter
+	submit(line string)
+	// interrupt - The operator pressed Ctrl-C
+	interrupt()
+	buffer() *paneBuffer
+	close()
+}
+
+// PanesCmd - Split the terminal into panes, each bound to a session or beacon, with an event ticker
+func PanesCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	shells, _ := cmd.Flags().GetStringArray("shell")
+	tasks, _ := cmd.Flags().GetStringArray("task")
+	noEvents, _ := cmd.Flags().GetBool("no-events")
+	columns, _ := cmd.Flags().GetBool("columns")
+
+	if len(shells) == 0 && len(tasks) == 0 {
+		session, beacon := con.ActiveTarget.Get()
+		if session == nil && beacon == nil {
+			con.PrintErrorf("Specify --shell or --task targets, or select a session/beacon via `use`\n")
+			return
+		}
+		tasks = []string{targetID(session, beacon)}
+	}
+	count := len(shells) + len(tasks)
+	if !noEvents {
+		count++
+	}
+	if maxPanes < count {
+		con.PrintErrorf("At most %d panes are supported\n", maxPanes)
+		return
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		con.PrintErrorf("Panes require a terminal\n")
+		return
+	}
+	if 0 < len(shells) && !settings.IsUserAnAdult(con) {
+		return
+	}
+
+	panes := []pane{}
+	closeAll := func() {
+		for _, p := range panes {
+			p.close()
+		}
+	}
+	for _, arg := range shells {
+		session, _, err := findTarget(con, arg)
+		if err == nil && session == nil {
+			err = fmt.Errorf("%s is a beacon, shells require a session", arg)
+		}
+		if err == nil {
+			var shell *shellPane
+			shell, err = openShell(con, session)
+			if err == nil {
+				panes = append(panes, shell)
+			}
+		}
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			closeAll()
+			return
+		}
+	}
+	for _, arg := range tasks {
+		session, beacon, err := findTarget(con, arg)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			closeAll()
+			return
+		}
+		panes = append(panes, newTaskPane(con, session, beacon))
+	}
+
+	// Console output (e.g. events, beacon results that are no longer waited on)
+	// is shown in the event pane, or the first pane if there isn't one
+	output := io.Writer(panes[0].buffer())
+	if !noEvents {
+		events := newEventsPane(con)
+		panes = append(panes, events)
+		output = events.buffer()
+	}
+
+	err := newScreen(con, panes, output, columns).run()
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+	}
+}
+
+// findTarget - A session or beacon by name or ID prefix
+func findTarget(con *console.SliverConsoleClient, arg string) (*clientpb.Session, *clientpb.Beacon, error) {
+	if arg == "" {
+		return nil, nil, errors.New("no session or beacon specified")
+	}
+	sessions, err := con.Rpc.GetSessions(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, session := range sessions.Sessions {
+		if session.Name == arg || strings.HasPrefix(session.ID, arg) {
+			return session, nil, nil
+		}
+	}
+	beacons, err := con.Rpc.GetBeacons(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, beacon := range beacons.Beacons {
+		if beacon.Name == arg || strings.HasPrefix(beacon.ID, arg) {
+			return nil, beacon, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no session or beacon '%s'", arg)
+}
+
+func targetID(session *clientpb.Session, beacon *clientpb.Beacon) string {
+	if session != nil {
+		return session.ID
+	}
+	return beacon.ID
+}
+
+//
+// -------------------------- [ Shell ] --------------------------
+//
+
+// shellPane - An interactive shell on a session, without a PTY since the pane
+// only understands colors
+type shellPane struct {
+	con     *console.SliverConsoleClient
+	session *clientpb.Session
+	tunnel  *core.TunnelIO
+	pid     uint32
+	exited  int32
+	buf     *paneBuffer
+}
+
+func openShell(con *console.SliverConsoleClient, session *clientpb.Session) (*shellPane, error) {
+	rpcTunnel, err := con.Rpc.CreateTunnel(context.Background(), &sliverpb.Tunnel{
+		SessionID: session.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tunnel := core.GetTunnels().Start(rpcTunnel.TunnelID, rpcTunnel.SessionID)
+	p := &shellPane{con: con, session: session, tunnel: tunnel, buf: newPaneBuffer()}
+	shell, err := con.Rpc.Shell(context.Background(), &sliverpb.ShellReq{
+		Request:   p.request(),
+		EnablePTY: false,
+		TunnelID:  tunnel.ID,
+	})
+	if err == nil && shell.Response != nil && shell.Response.Err != "" {
+		err = errors.New(shell.Response.Err)
+	}
+	if err != nil {
+		p.close()
+		return nil, fmt.Errorf("%s: %s", session.Name, err)
+	}
+	p.pid = shell.Pid
+	go func() {
+		io.Copy(p.buf, tunnel)
+		atomic.StoreInt32(&p.exited, 1)
+		fmt.Fprintf(p.buf, "\n"+console.Bold+"Shell exited"+console.Normal+"\n")
+	}()
+	return p, nil
+}
+
+func (p *shellPane) request() *commonpb.Request {
+	return &commonpb.Request{SessionID: p.session.ID, Timeout: int64(time.Minute)}
+}
+
+func (p *shellPane) title() string {
+	title := fmt.Sprintf("shell %s (pid %d)", p.session.Name, p.pid)
+	if atomic.LoadInt32(&p.exited) == 1 {
+		title += " [exited]"
+	}
+	return title
+}
+
+func (p *shellPane) prompt() string {
+	if atomic.LoadInt32(&p.exited) == 1 {
+		return ""
+	}
+	return "$ "
+}
+
+func (p *shellPane) submit(line string) {
+	newline := "\n"
+	if p.session.OS == "windows" {
+		newline = "\r\n"
+	}
+	p.tunnel.Write([]byte(line + newline))
+}
+
+func (p *shellPane) interrupt() {
+	go func() {
+		resp, err := p.con.Rpc.ShellSignal(context.Background(), &sliverpb.ShellSignalReq{
+			Request:  p.request(),
+			Signal:   "SIGINT",
+			TunnelID: p.tunnel.ID,
+		})
+		if err == nil && resp.Response.GetErr() != "" {
+			err = errors.New(resp.Response.GetErr())
+		}
+		if err != nil {
+			fmt.Fprintf(p.buf, "\n"+console.Warn+"Failed to send SIGINT: %s\n", err)
+		}
+	}()
+}
+
+func (p *shellPane) buffer() *paneBuffer {
+	return p.buf
+}
+
+func (p *shellPane) close() {
+	p.con.Rpc.CloseTunnel(context.Background(), &sliverpb.Tunnel{
+		TunnelID:  p.tunnel.ID,
+		SessionID: p.session.ID,
+	})
+	core.GetTunnels().Close(p.tunnel.ID)
+}
+
+//
+// -------------------------- [ Task ] --------------------------
+//
+
+// taskPane - Runs commands on a session or beacon, `use` binds the pane to
+// another target without changing the console's active target
+type taskPane struct {
+	con     *console.SliverConsoleClient
+	mutex   *sync.Mutex
+	session *clientpb.Session
+	beacon  *clientpb.Beacon
+	running bool
+	closed  bool
+	buf     *paneBuffer
+}
+
+func newTaskPane(con *console.SliverConsoleClient, session *clientpb.Session, beacon *clientpb.Beacon) *taskPane {
+	return &taskPane{
+		con:     con,
+		mutex:   &sync.Mutex{},
+		session: session,
+		beacon:  beacon,
+		buf:     newPaneBuffer(),
+	}
+}
+
+func (p *taskPane) target() (*clientpb.Session, *clientpb.Beacon) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.session, p.beacon
+}
+
+func (p *taskPane) title() string {
+	session, beacon := p.target()
+	if session != nil {
+		return fmt.Sprintf("%s (session %s)", session.Name, strings.Split(session.ID, "-")[0])
+	}
+	return fmt.Sprintf("%s (beacon %s)", beacon.Name, strings.Split(beacon.ID, "-")[0])
+}
+
+func (p *taskPane) prompt() string {
+	session, beacon := p.target()
+	if session != nil {
+		return console.Bold + console.Red + session.Name + console.Normal + " > "
+	}
+	return console.Bold + console.Blue + beacon.Name + console.Normal + " > "
+}
+
+func (p *taskPane) submit(line string) {
+	line = strings.TrimSpace(line)
+	fmt.Fprintf(p.buf, "%s%s\n", p.prompt(), line)
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return
+	}
+	switch {
+	case fields[0] == consts.UseStr:
+		p.use(fields[1:])
+	case fields[0] == "clear":
+		p.buf.Clear()
+	case interactiveCmds[fields[0]]:
+		fmt.Fprintf(p.buf, console.Warn+"%s can't run in a pane\n", fields[0])
+	default:
+		p.run(line)
+	}
+}
+
+// use - Bind the pane to another session or beacon
+func (p *taskPane) use(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintf(p.buf, console.Warn+"usage: use <session/beacon>\n")
+		return
+	}
+	session, beacon, err := findTarget(p.con, args[0])
+	if err != nil {
+		fmt.Fprintf(p.buf, console.Warn+"%s\n", err)
+		return
+	}
+	p.mutex.Lock()
+	p.session, p.beacon = session, beacon
+	p.mutex.Unlock()
+	fmt.Fprintf(p.buf, console.Info+"Pane bound to %s\n", p.title())
+}
+
+func (p *taskPane) run(line string) {
+	p.mutex.Lock()
+	if p.running {
+		p.mutex.Unlock()
+		fmt.Fprintf(p.buf, console.Warn+"Wait for the last command to finish\n")
+		return
+	}
+	p.running = true
+	session, beacon := p.session, p.beacon
+	p.mutex.Unlock()
+
+	// The command runs on a console bound to the pane's target, beacon results are
+	// printed to the pane when they arrive
+	go func() {
+		err := p.con.Bind(session, beacon, p.printf).RunCommand(line)
+		p.mutex.Lock()
+		p.running = false
+		p.mutex.Unlock()
+		if err != nil {
+			fmt.Fprintf(p.buf, console.Warn+"%s\n", err)
+		}
+	}()
+}
+
+// printf - Print to the pane, results that arrive once it's closed are printed by
+// the console instead
+func (p *taskPane) printf(format string, args ...any) (int, error) {
+	p.mutex.Lock()
+	closed := p.closed
+	p.mutex.Unlock()
+	if closed {
+		p.con.Printf(format, args...)
+		return 0, nil
+	}
+	return fmt.Fprintf(p.buf, format, args...)
+}
+
+func (p *taskPane) interrupt() {}
+
+func (p *taskPane) buffer() *paneBuffer {
+	return p.buf
+}
+
+func (p *taskPane) close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.closed = true
+}
+
+//
+// -------------------------- [ Events ] --------------------------
+//
+
+// eventsPane - A ticker of server events and console messages
+type eventsPane struct {
+	con        *console.SliverConsoleClient
+	listenerID string
+	buf        *paneBuffer
+}
+
+func newEventsPane(con *console.SliverConsoleClient) *eventsPane {
+	listenerID, events := con.CreateEventListener()
+	p := &eventsPane{con: con, listenerID: listenerID, buf: newPaneBuffer()}
+	go func() {
+		for event := range events {
+			if msg := formatEvent(event); msg != "" {
+				fmt.Fprintf(p.buf, "%s %s\n", time.Now().Format(time.TimeOnly), msg)
+			}
+		}
+	}()
+	return p
+}
+
+// formatEvent - A single line describing an event, blank for events that aren't shown
+func formatEvent(event *clientpb.Event) string {
+	msg := console.Bold + event.EventType + console.Normal
+	switch {
+	case event.EventType == consts.BeaconRegisteredEvent:
+		beacon := &clientpb.Beacon{}
+		if proto.Unmarshal(event.Data, beacon) == nil {
+			msg += fmt.Sprintf(" %s - %s (%s)", beacon.Name, beacon.RemoteAddress, beacon.Hostname)
+		}
+	case event.Session != nil:
+		msg += fmt.Sprintf(" %s - %s (%s)", event.Session.Name, event.Session.RemoteAddress, event.Session.Hostname)
+	case event.Job != nil:
+		msg += fmt.Sprintf(" #%d (%s/%s)", event.Job.ID, event.Job.Protocol, event.Job.Name)
+	case event.Client != nil && event.Client.Operator != nil:
+		msg += " " + event.Client.Operator.Name
+	case textEvents[event.EventType]:
+	default:
+		return "" // Progress updates and other events with binary data
+	}
+	if textEvents[event.EventType] && 0 < len(event.Data) {
+		msg += " " + string(event.Data)
+	}
+	return msg
+}
+
+func (p *eventsPane) title() string {
+	return "events"
+}
+
+func (p *eventsPane) prompt() string {
+	return ""
+}
+
+func (p *eventsPane) submit(string) {}
+
+func (p *eventsPane) interrupt() {}
+
+func (p *eventsPane) buffer() *paneBuffer {
+	return p.buf
+}
+
+func (p *eventsPane) close() {
+	p.con.RemoveEventListener(p.listenerID)
+}
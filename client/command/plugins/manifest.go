@@ -0,0 +1,269 @@
+package plugins
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+)
+
+const (
+	// ManifestFileName - The manifest in the root of every plugin directory
+	ManifestFileName = "plugin.json"
+
+	// MenuServer - The command is added to the server menu
+	MenuServer = "server"
+	// MenuImplant - The command is added to the session/beacon menu
+	MenuImplant = "implant"
+
+	// FlagString, FlagBool, FlagInt - Supported plugin flag types
+	FlagString = "string"
+	FlagBool   = "bool"
+	FlagInt    = "int"
+
+	defaultTimeout = 60
+)
+
+var (
+	// Plugin name -> loaded plugin
+	loadedPlugins      = map[string]*PluginManifest{}
+	loadedPluginsMutex = &sync.RWMutex{}
+
+	// Plugin name/command -> true, conflicts are only reported once
+	conflicts = map[string]bool{}
+
+	validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// PluginFlag - A flag of a plugin command, the value is sent to the plugin
+// when the command is run
+type PluginFlag struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand"`
+	Type      string `json:"type"`
+	Default   string `json:"default"`
+	Help      string `json:"help"`
+}
+
+// PluginCommand - A console command provided by a plugin
+type PluginCommand struct {
+	Name     string        `json:"name"`
+	Help     string        `json:"help"`
+	LongHelp string        `json:"long_help"`
+	Menu     string        `json:"menu"`
+	Usage    string        `json:"usage"`
+	Flags    []*PluginFlag `json:"flags"`
+}
+
+// PluginManifest - The manifest of a plugin, the entrypoint is started once each
+// time one of its commands is run and talks to the client over stdin/stdout
+type PluginManifest struct {
+	Name           string           `json:"name"`
+	Version        string           `json:"version"`
+	OriginalAuthor string           `json:"original_author"`
+	RepoURL        string           `json:"repo_url"`
+	Entrypoint     []string         `json:"entrypoint"`
+	Commands       []*PluginCommand `json:"commands"`
+
+	RootPath string `json:"-"`
+}
+
+// ParsePluginManifest - Parse and validate a plugin manifest
+func ParsePluginManifest(data []byte) (*PluginManifest, error) {
+	manifest := &PluginManifest{}
+	err := json.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if !validName.MatchString(manifest.Name) {
+		return nil, fmt.Errorf("invalid plugin name '%s'", manifest.Name)
+	}
+	if len(manifest.Entrypoint) == 0 || manifest.Entrypoint[0] == "" {
+		return nil, errors.New("missing plugin entrypoint")
+	}
+	if len(manifest.Commands) == 0 {
+		return nil, errors.New("plugin has no commands")
+	}
+	names := map[string]bool{}
+	for _, command := range manifest.Commands {
+		if !validName.MatchString(command.Name) {
+			return nil, fmt.Errorf("invalid command name '%s'", command.Name)
+		}
+		if command.Menu == "" {
+			command.Menu = MenuImplant
+		}
+		if command.Menu != MenuServer && command.Menu != MenuImplant {
+			return nil, fmt.Errorf("command '%s' has invalid menu '%s'", command.Name, command.Menu)
+		}
+		key := command.Menu + "/" + command.Name
+		if names[key] {
+			return nil, fmt.Errorf("duplicate command '%s'", command.Name)
+		}
+		names[key] = true
+		if command.Help == "" {
+			return nil, fmt.Errorf("missing help for command '%s'", command.Name)
+		}
+		for _, flag := range command.Flags {
+			if !validName.MatchString(flag.Name) || 1 < len(flag.Shorthand) {
+				return nil, fmt.Errorf("command '%s' has invalid flag '%s'", command.Name, flag.Name)
+			}
+			if flag.Type == "" {
+				flag.Type = FlagString
+			}
+			if flag.Type != FlagString && flag.Type != FlagBool && flag.Type != FlagInt {
+				return nil, fmt.Errorf("flag '%s' has invalid type '%s'", flag.Name, flag.Type)
+			}
+		}
+	}
+	return manifest, nil
+}
+
+// LoadPlugin - Load a plugin's manifest, its commands are added to the menus
+// the next time they are built
+func LoadPlugin(manifestPath string) (*PluginManifest, error) {
+	manifestPath, err := filepath.Abs(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := os.Stat(manifestPath); err == nil && fi.IsDir() {
+		manifestPath = filepath.Join(manifestPath, ManifestFileName)
+	}
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := ParsePluginManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	manifest.RootPath = filepath.Dir(manifestPath)
+	loadedPluginsMutex.Lock()
+	loadedPlugins[manifest.Name] = manifest
+	loadedPluginsMutex.Unlock()
+	return manifest, nil
+}
+
+// getLoadedPlugins - The loaded plugins sorted by name
+func getLoadedPlugins() []*PluginManifest {
+	loadedPluginsMutex.RLock()
+	defer loadedPluginsMutex.RUnlock()
+	manifests := make([]*PluginManifest, 0, len(loadedPlugins))
+	for _, manifest := range loadedPlugins {
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+	return manifests
+}
+
+// RegisterCommands - Add the commands of all loaded plugins for a menu to its root
+// command, commands that conflict with an existing command are skipped
+func RegisterCommands(menu string, root *cobra.Command, con *console.SliverConsoleClient) {
+	for _, manifest := range getLoadedPlugins() {
+		for _, command := range manifest.Commands {
+			if command.Menu != menu {
+				continue
+			}
+			if existing, _, err := root.Find([]string{command.Name}); err == nil && existing != root {
+				key := manifest.Name + "/" + command.Name
+				loadedPluginsMutex.Lock()
+				reported := conflicts[key]
+				conflicts[key] = true
+				loadedPluginsMutex.Unlock()
+				if !reported {
+					con.PrintErrorf("Plugin %s: command '%s' already exists\n", manifest.Name, command.Name)
+				}
+				continue
+			}
+			root.AddCommand(makeCommand(manifest, command, con))
+		}
+	}
+}
+
+func makeCommand(manifest *PluginManifest, command *PluginCommand, con *console.SliverConsoleClient) *cobra.Command {
+	use := command.Name
+	if command.Usage != "" {
+		use += " " + command.Usage
+	}
+	longHelp := command.LongHelp
+	if longHelp == "" {
+		longHelp = command.Help
+	}
+	pluginCmd := &cobra.Command{
+		Use:   use,
+		Short: fmt.Sprintf("[%s] %s", manifest.Name, command.Help),
+		Long:  help.FormatHelpTmpl(longHelp),
+		Args:  cobra.ArbitraryArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			RunPluginCommand(cmd, con, manifest, command, args)
+		},
+		GroupID: consts.PluginHelpGroup,
+	}
+	f := pflag.NewFlagSet(command.Name, pflag.ContinueOnError)
+	for _, flag := range command.Flags {
+		switch flag.Type {
+		case FlagBool:
+			f.BoolP(flag.Name, flag.Shorthand, flag.Default == "true", flag.Help)
+		case FlagInt:
+			var value int64
+			fmt.Sscanf(flag.Default, "%d", &value)
+			f.Int64P(flag.Name, flag.Shorthand, value, flag.Help)
+		default:
+			f.StringP(flag.Name, flag.Shorthand, flag.Default, flag.Help)
+		}
+	}
+	if f.Lookup("timeout") == nil {
+		f.Int64("timeout", defaultTimeout, "grpc timeout in seconds")
+	}
+	pluginCmd.Flags().AddFlagSet(f)
+	return pluginCmd
+}
+
+// entrypoint - The plugin's command line, the executable is resolved relative
+// to the plugin directory if it exists there, otherwise it's looked up in $PATH
+func (p *PluginManifest) entrypoint() (string, []string, error) {
+	executable := p.Entrypoint[0]
+	local := filepath.Join(p.RootPath, filepath.FromSlash(executable))
+	if _, err := os.Stat(local); err == nil {
+		return local, p.Entrypoint[1:], nil
+	}
+	if filepath.IsAbs(executable) {
+		return executable, p.Entrypoint[1:], nil
+	}
+	path, err := exec.LookPath(executable)
+	if err != nil {
+		return "", nil, err
+	}
+	return path, p.Entrypoint[1:], nil
+}
@@ -0,0 +1,87 @@
+package plugins
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+)
+
+const samplePlugin = `{
+	"name": "recon",
+	"version": "1.0.0",
+	"entrypoint": ["python3", "recon.py"],
+	"commands": [
+		{
+			"name": "users",
+			"help": "Show logged on users",
+			"flags": [{"name": "all", "shorthand": "a", "type": "bool"}, {"name": "filter"}]
+		},
+		{"name": "report", "menu": "server", "help": "Write a report"}
+	]
+}`
+
+func TestParsePluginManifest(t *testing.T) {
+	manifest, err := ParsePluginManifest([]byte(samplePlugin))
+	if err != nil {
+		t.Fatalf("Error parsing plugin manifest: %s", err)
+	}
+	if manifest.Name != "recon" || len(manifest.Entrypoint) != 2 || len(manifest.Commands) != 2 {
+		t.Fatalf("Unexpected manifest %+v", manifest)
+	}
+	if manifest.Commands[0].Menu != MenuImplant {
+		t.Errorf("Expected default menu '%s', got '%s'", MenuImplant, manifest.Commands[0].Menu)
+	}
+	if manifest.Commands[1].Menu != MenuServer {
+		t.Errorf("Expected menu '%s', got '%s'", MenuServer, manifest.Commands[1].Menu)
+	}
+	if flag := manifest.Commands[0].Flags[1]; flag.Type != FlagString {
+		t.Errorf("Expected default flag type '%s', got '%s'", FlagString, flag.Type)
+	}
+}
+
+func TestParsePluginManifestInvalid(t *testing.T) {
+	invalid := map[string]string{
+		"name":       `"name": "recon"`,
+		"entrypoint": `"entrypoint": ["python3", "recon.py"]`,
+		"menu":       `"menu": "server"`,
+		"flag type":  `"type": "bool"`,
+	}
+	replacements := map[string]string{
+		"name":       `"name": "../recon"`,
+		"entrypoint": `"entrypoint": []`,
+		"menu":       `"menu": "beacon"`,
+		"flag type":  `"type": "float"`,
+	}
+	for name, old := range invalid {
+		data := strings.Replace(samplePlugin, old, replacements[name], 1)
+		if _, err := ParsePluginManifest([]byte(data)); err == nil {
+			t.Errorf("Expected an error for invalid %s", name)
+		}
+	}
+
+	duplicate := strings.Replace(samplePlugin, `"name": "report", "menu": "server"`, `"name": "users"`, 1)
+	if _, err := ParsePluginManifest([]byte(duplicate)); err == nil {
+		t.Errorf("Expected an error for a duplicate command")
+	}
+	// The same name in different menus is fine
+	if _, err := ParsePluginManifest([]byte(strings.Replace(samplePlugin, `"name": "report"`, `"name": "users"`, 1))); err != nil {
+		t.Errorf("Unexpected error %s", err)
+	}
+}
@@ -0,0 +1,377 @@
+package plugins
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+var loadInstalledOnce sync.Once
+
+// PluginsCmd - List loaded plugins and their commands
+func PluginsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	manifests := getLoadedPlugins()
+	if len(manifests) == 0 {
+		con.PrintInfof("No plugins loaded, see 'plugins install'\n")
+		return
+	}
+	installed := map[string]bool{}
+	for _, manifestPath := range assets.GetInstalledPluginManifests() {
+		installed[filepath.Dir(manifestPath)] = true
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Version",
+		"Commands",
+		"Installed",
+		"Author",
+		"Repository",
+	})
+	for _, manifest := range manifests {
+		commands := []string{}
+		for _, command := range manifest.Commands {
+			commands = append(commands, fmt.Sprintf("%s (%s)", command.Name, command.Menu))
+		}
+		isInstalled := ""
+		if installed[manifest.RootPath] {
+			isInstalled = "✅"
+		}
+		tw.AppendRow(table.Row{
+			manifest.Name,
+			manifest.Version,
+			strings.Join(commands, "\n"),
+			isInstalled,
+			manifest.OriginalAuthor,
+			manifest.RepoURL,
+		})
+	}
+	con.Println(tw.Render())
+}
+
+// PluginsLoadCmd - Load a plugin from a directory for this client session
+func PluginsLoadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	manifest, err := LoadPlugin(args[0])
+	if err != nil {
+		con.PrintErrorf("Failed to load plugin: %s\n", err)
+		return
+	}
+	con.PrintInfof("Loaded plugin %s (%d command(s))\n", manifest.Name, len(manifest.Commands))
+}
+
+// PluginsInstallCmd - Copy a plugin directory into the plugins directory
+func PluginsInstallCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	srcDir := args[0]
+	data, err := os.ReadFile(filepath.Join(srcDir, ManifestFileName))
+	if err != nil {
+		con.PrintErrorf("Error reading %s: %s\n", ManifestFileName, err)
+		return
+	}
+	manifest, err := ParsePluginManifest(data)
+	if err != nil {
+		con.PrintErrorf("Error parsing %s: %s\n", ManifestFileName, err)
+		return
+	}
+	installPath := filepath.Join(assets.GetPluginsDir(), manifest.Name)
+	if _, err := os.Stat(installPath); !os.IsNotExist(err) {
+		confirm := false
+		prompt := &survey.Confirm{Message: fmt.Sprintf("Plugin '%s' already exists, overwrite it?", manifest.Name)}
+		survey.AskOne(prompt, &confirm)
+		if !confirm {
+			return
+		}
+		err = os.RemoveAll(installPath)
+		if err != nil {
+			con.PrintErrorf("Failed to remove old plugin: %s\n", err)
+			return
+		}
+	}
+	err = copyDir(srcDir, installPath)
+	if err != nil {
+		os.RemoveAll(installPath)
+		con.PrintErrorf("Failed to install plugin: %s\n", err)
+		return
+	}
+	manifest, err = LoadPlugin(installPath)
+	if err != nil {
+		con.PrintErrorf("Failed to load plugin: %s\n", err)
+		return
+	}
+	con.PrintInfof("Installed plugin %s (%s)\n", manifest.Name, manifest.Version)
+}
+
+// PluginsRemoveCmd - Unload a plugin and remove it from the plugins directory
+func PluginsRemoveCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	name := args[0]
+	confirm := false
+	prompt := &survey.Confirm{Message: fmt.Sprintf("Remove '%s' plugin?", name)}
+	survey.AskOne(prompt, &confirm)
+	if !confirm {
+		return
+	}
+	err := RemovePlugin(name)
+	if err != nil {
+		con.PrintErrorf("Error removing plugin: %s\n", err)
+		return
+	}
+	con.PrintInfof("Plugin '%s' removed\n", name)
+}
+
+// RemovePlugin - Unload a plugin, plugins in the plugins directory are deleted
+func RemovePlugin(name string) error {
+	loadedPluginsMutex.Lock()
+	manifest, ok := loadedPlugins[name]
+	delete(loadedPlugins, name)
+	loadedPluginsMutex.Unlock()
+	if !ok {
+		return errors.New("plugin not loaded")
+	}
+	installPath := filepath.Join(assets.GetPluginsDir(), filepath.Base(name))
+	if manifest.RootPath != installPath {
+		return nil
+	}
+	return os.RemoveAll(installPath)
+}
+
+// LoadInstalledPlugins - Load the manifests of the installed plugins, only the first
+// call loads them, plugins installed afterwards are loaded by 'plugins install'
+func LoadInstalledPlugins(con *console.SliverConsoleClient) {
+	loadInstalledOnce.Do(func() {
+		for _, manifestPath := range assets.GetInstalledPluginManifests() {
+			_, err := LoadPlugin(manifestPath)
+			if err != nil {
+				con.PrintErrorf("Failed to load plugin %s: %s\n", filepath.Base(filepath.Dir(manifestPath)), err)
+			}
+		}
+	})
+}
+
+// PluginCompleter - Completes the names of loaded plugins
+func PluginCompleter() carapace.Action {
+	return carapace.ActionCallback(func(c carapace.Context) carapace.Action {
+		results := []string{}
+		for _, manifest := range getLoadedPlugins() {
+			results = append(results, manifest.Name, manifest.Version)
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("plugins")
+	})
+}
+
+// RunPluginCommand - Start the plugin and serve its messages until it exits
+func RunPluginCommand(cmd *cobra.Command, con *console.SliverConsoleClient, manifest *PluginManifest, command *PluginCommand, args []string) {
+	run := &RunMessage{
+		Type:     MsgRun,
+		Protocol: ProtocolVersion,
+		Plugin:   manifest.Name,
+		Command:  command.Name,
+		Args:     args,
+		Flags:    flagValues(cmd, command),
+	}
+	session := &pluginSession{
+		rpc:   con.Rpc,
+		print: printer(con),
+	}
+	session.timeout = time.Duration(flagInt(cmd, "timeout")) * time.Second
+	if session.timeout < 1 {
+		session.timeout = defaultTimeout * time.Second
+	}
+	if command.Menu == MenuImplant {
+		activeSession, activeBeacon := con.ActiveTarget.GetInteractive()
+		if activeSession == nil && activeBeacon == nil {
+			return
+		}
+		var err error
+		if activeSession != nil {
+			run.Session, err = marshaler.Marshal(activeSession)
+		} else {
+			run.Beacon, err = marshaler.Marshal(activeBeacon)
+		}
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		session.request = con.ActiveTarget.Request(cmd)
+		wait := session.timeout
+		if activeBeacon != nil {
+			wait += 2 * time.Duration(activeBeacon.Interval+activeBeacon.Jitter)
+		}
+		session.waitTask = waitTask(con, wait)
+	}
+
+	executable, entrypointArgs, err := manifest.entrypoint()
+	if err != nil {
+		con.PrintErrorf("Plugin %s: %s\n", manifest.Name, err)
+		return
+	}
+	proc := exec.Command(executable, entrypointArgs...)
+	proc.Dir = manifest.RootPath
+	stdin, err := proc.StdinPipe()
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	stdout, err := proc.StdoutPipe()
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	stderr, err := proc.StderrPipe()
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	err = proc.Start()
+	if err != nil {
+		con.PrintErrorf("Failed to start plugin %s: %s\n", manifest.Name, err)
+		return
+	}
+	go printStderr(stderr, con)
+
+	session.stdin = stdin
+	err = session.send(run)
+	if err == nil {
+		err = session.serve(stdout)
+	}
+	stdin.Close()
+	if err != nil {
+		con.PrintErrorf("Plugin %s: %s\n", manifest.Name, err)
+		proc.Process.Kill()
+	}
+	err = proc.Wait()
+	if err != nil {
+		con.PrintErrorf("Plugin %s exited: %s\n", manifest.Name, err)
+	}
+}
+
+// flagValues - The values of a command's plugin flags, with the flag's type
+func flagValues(cmd *cobra.Command, command *PluginCommand) map[string]any {
+	values := map[string]any{}
+	for _, flag := range command.Flags {
+		switch flag.Type {
+		case FlagBool:
+			values[flag.Name], _ = cmd.Flags().GetBool(flag.Name)
+		case FlagInt:
+			values[flag.Name] = flagInt(cmd, flag.Name)
+		default:
+			values[flag.Name], _ = cmd.Flags().GetString(flag.Name)
+		}
+	}
+	return values
+}
+
+func flagInt(cmd *cobra.Command, name string) int64 {
+	value, _ := cmd.Flags().GetInt64(name)
+	return value
+}
+
+func printer(con *console.SliverConsoleClient) func(string, string) {
+	return func(msgType string, text string) {
+		if msgType != MsgPrint && !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+		switch msgType {
+		case MsgInfo:
+			con.PrintInfof("%s", text)
+		case MsgSuccess:
+			con.PrintSuccessf("%s", text)
+		case MsgWarn:
+			con.PrintWarnf("%s", text)
+		case MsgError:
+			con.PrintErrorf("%s", text)
+		default:
+			con.Printf("%s", text)
+		}
+	}
+}
+
+// waitTask - Block until the results of a beacon task are received, nil if they
+// aren't received before the timeout
+func waitTask(con *console.SliverConsoleClient, timeout time.Duration) func(string) *clientpb.BeaconTask {
+	return func(taskID string) *clientpb.BeaconTask {
+		done := make(chan *clientpb.BeaconTask, 1)
+		con.AddBeaconCallback(taskID, func(task *clientpb.BeaconTask) {
+			done <- proto.Clone(task).(*clientpb.BeaconTask)
+		})
+		select {
+		case task := <-done:
+			return task
+		case <-time.After(timeout):
+			return nil
+		}
+	}
+}
+
+func printStderr(stderr io.Reader, con *console.SliverConsoleClient) {
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		con.Printf("%s\n", scanner.Text())
+	}
+}
+
+// copyDir - Copy the regular files of a directory, keeping their permissions
+func copyDir(srcDir string, dstDir string) error {
+	return filepath.WalkDir(srcDir, func(srcPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(srcDir, srcPath)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dstDir, relPath)
+		if entry.IsDir() {
+			return os.MkdirAll(dstPath, 0700)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(srcPath)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dstPath, data, info.Mode().Perm()|0600)
+	})
+}
@@ -0,0 +1,239 @@
+package plugins
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+)
+
+// The protocol is newline delimited json over the plugin's stdin/stdout. The client
+// sends a single "run" message, the plugin then sends output and rpc messages until
+// it exits. Each "rpc" message is answered with an "rpc_result" with the same id.
+const (
+	// ProtocolVersion - Sent to the plugin in the run message
+	ProtocolVersion = 1
+
+	// Client -> plugin
+	MsgRun       = "run"
+	MsgRPCResult = "rpc_result"
+
+	// Plugin -> client
+	MsgPrint   = "print"
+	MsgInfo    = "info"
+	MsgSuccess = "success"
+	MsgWarn    = "warn"
+	MsgError   = "error"
+	MsgRPC     = "rpc"
+
+	// maxMessageSize - Largest message a plugin can send, e.g. an upload request
+	maxMessageSize = 256 * 1024 * 1024
+)
+
+// RunMessage - Tells the plugin which command to run, Session/Beacon are the
+// active target (if any) as protobuf json
+type RunMessage struct {
+	Type     string          `json:"type"`
+	Protocol int             `json:"protocol"`
+	Plugin   string          `json:"plugin"`
+	Command  string          `json:"command"`
+	Args     []string        `json:"args"`
+	Flags    map[string]any  `json:"flags"`
+	Session  json.RawMessage `json:"session,omitempty"`
+	Beacon   json.RawMessage `json:"beacon,omitempty"`
+}
+
+// RPCResultMessage - The result of an rpc call, the response is protobuf json
+type RPCResultMessage struct {
+	Type     string          `json:"type"`
+	ID       int64           `json:"id"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// PluginMessage - A message from the plugin, either output (Text) or an rpc call
+// of Method with a protobuf json Request. The Request field of the request is set
+// to the active target if the plugin leaves it out, with Wait the result of a
+// beacon task is returned instead of the task.
+type PluginMessage struct {
+	Type    string          `json:"type"`
+	Text    string          `json:"text"`
+	ID      int64           `json:"id"`
+	Method  string          `json:"method"`
+	Request json.RawMessage `json:"request"`
+	Wait    bool            `json:"wait"`
+}
+
+var (
+	protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	requestName      = (&commonpb.Request{}).ProtoReflect().Descriptor().FullName()
+	responseName     = (&commonpb.Response{}).ProtoReflect().Descriptor().FullName()
+
+	marshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+)
+
+// pluginSession - Serves a single run of a plugin
+type pluginSession struct {
+	rpc      rpcpb.SliverRPCClient
+	request  *commonpb.Request
+	timeout  time.Duration
+	print    func(msgType string, text string)
+	waitTask func(taskID string) *clientpb.BeaconTask
+
+	stdin      io.Writer
+	stdinMutex sync.Mutex
+	wg         sync.WaitGroup
+}
+
+// serve - Handle messages from the plugin until its stdout is closed, rpc calls
+// are made concurrently so a plugin can wait on several beacon tasks at once
+func (s *pluginSession) serve(stdout io.Reader) error {
+	defer s.wg.Wait()
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		msg := &PluginMessage{}
+		err := json.Unmarshal(line, msg)
+		if err != nil {
+			// Not a message, show it rather than dropping it
+			s.print(MsgPrint, string(line)+"\n")
+			continue
+		}
+		switch msg.Type {
+		case MsgPrint, MsgInfo, MsgSuccess, MsgWarn, MsgError:
+			s.print(msg.Type, msg.Text)
+		case MsgRPC:
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				result := &RPCResultMessage{Type: MsgRPCResult, ID: msg.ID}
+				response, err := s.call(msg.Method, msg.Request, msg.Wait)
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.Response = response
+				}
+				s.send(result)
+			}()
+		default:
+			s.print(MsgWarn, fmt.Sprintf("unknown message type '%s'", msg.Type))
+		}
+	}
+	return scanner.Err()
+}
+
+func (s *pluginSession) send(msg any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.stdinMutex.Lock()
+	defer s.stdinMutex.Unlock()
+	_, err = s.stdin.Write(append(data, '\n'))
+	return err
+}
+
+// call - Call a unary rpc by name, streaming rpcs aren't supported
+func (s *pluginSession) call(method string, data json.RawMessage, wait bool) (json.RawMessage, error) {
+	rpcMethod := reflect.ValueOf(s.rpc).MethodByName(method)
+	if !rpcMethod.IsValid() {
+		return nil, fmt.Errorf("unknown rpc '%s'", method)
+	}
+	methodType := rpcMethod.Type()
+	if methodType.NumIn() != 3 || !methodType.IsVariadic() || !methodType.In(1).Implements(protoMessageType) ||
+		methodType.NumOut() != 2 || !methodType.Out(0).Implements(protoMessageType) {
+		return nil, fmt.Errorf("rpc '%s' is not supported", method)
+	}
+	req := reflect.New(methodType.In(1).Elem()).Interface().(proto.Message)
+	if 0 < len(data) && string(data) != "null" {
+		err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, req)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request: %s", err)
+		}
+	}
+	if s.request != nil {
+		if field := messageField(req, "Request", requestName); field != nil && !req.ProtoReflect().Has(field) {
+			req.ProtoReflect().Set(field, protoreflect.ValueOfMessage(proto.Clone(s.request).ProtoReflect()))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	results := rpcMethod.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+	if err, _ := results[1].Interface().(error); err != nil {
+		return nil, err
+	}
+	resp := results[0].Interface().(proto.Message)
+
+	if wait && s.waitTask != nil {
+		if taskID := asyncTaskID(resp); taskID != "" {
+			task := s.waitTask(taskID)
+			if task == nil {
+				return nil, errors.New("beacon task was not completed")
+			}
+			resp = resp.ProtoReflect().Type().New().Interface()
+			err := proto.Unmarshal(task.Response, resp)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return marshaler.Marshal(resp)
+}
+
+// messageField - The field of a message with the given name and message type
+func messageField(msg proto.Message, name protoreflect.Name, fullName protoreflect.FullName) protoreflect.FieldDescriptor {
+	field := msg.ProtoReflect().Descriptor().Fields().ByName(name)
+	if field == nil || field.Message() == nil || field.Message().FullName() != fullName {
+		return nil
+	}
+	return field
+}
+
+// asyncTaskID - The beacon task id of an async response
+func asyncTaskID(msg proto.Message) string {
+	field := messageField(msg, "Response", responseName)
+	if field == nil || !msg.ProtoReflect().Has(field) {
+		return ""
+	}
+	resp, ok := msg.ProtoReflect().Get(field).Message().Interface().(*commonpb.Response)
+	if !ok || !resp.Async {
+		return ""
+	}
+	return resp.TaskID
+}
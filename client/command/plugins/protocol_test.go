@@ -0,0 +1,164 @@
+package plugins
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// testRPC - Only implements the rpcs used by the tests
+type testRPC struct {
+	rpcpb.SliverRPCClient
+	lk     sync.Mutex
+	lastLs *sliverpb.LsReq
+}
+
+func (r *testRPC) Ls(ctx context.Context, in *sliverpb.LsReq, opts ...grpc.CallOption) (*sliverpb.Ls, error) {
+	r.lk.Lock()
+	r.lastLs = in
+	r.lk.Unlock()
+	if in.Request.Async {
+		return &sliverpb.Ls{Response: &commonpb.Response{Async: true, TaskID: "task1"}}, nil
+	}
+	return &sliverpb.Ls{Path: in.Path, Exists: true}, nil
+}
+
+func testSession(rpc *testRPC, stdin *bytes.Buffer, output *[]string) *pluginSession {
+	return &pluginSession{
+		rpc:     rpc,
+		request: &commonpb.Request{SessionID: "session1"},
+		timeout: time.Minute,
+		print: func(msgType string, text string) {
+			*output = append(*output, msgType+":"+text)
+		},
+		stdin: stdin,
+	}
+}
+
+func rpcResults(t *testing.T, stdin *bytes.Buffer) map[int64]*RPCResultMessage {
+	results := map[int64]*RPCResultMessage{}
+	for _, line := range strings.Split(strings.TrimSpace(stdin.String()), "\n") {
+		result := &RPCResultMessage{}
+		if err := json.Unmarshal([]byte(line), result); err != nil {
+			t.Fatalf("Invalid result %q: %s", line, err)
+		}
+		results[result.ID] = result
+	}
+	return results
+}
+
+func TestServe(t *testing.T) {
+	rpc := &testRPC{}
+	stdin := &bytes.Buffer{}
+	output := []string{}
+	session := testSession(rpc, stdin, &output)
+	stdout := strings.Join([]string{
+		`{"type":"info","text":"starting"}`,
+		`not json`,
+		`{"type":"rpc","id":1,"method":"Ls","request":{"Path":"/tmp"}}`,
+		`{"type":"rpc","id":2,"method":"NoSuchMethod"}`,
+		`{"type":"rpc","id":3,"method":"Events"}`,
+		`{"type":"rpc","id":4,"method":"Ls","request":{"Path":1}}`,
+	}, "\n")
+	err := session.serve(strings.NewReader(stdout))
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+	if len(output) != 2 || output[0] != "info:starting" || output[1] != "print:not json\n" {
+		t.Errorf("Unexpected output %q", output)
+	}
+
+	results := rpcResults(t, stdin)
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(results))
+	}
+	ls := &sliverpb.Ls{}
+	if results[1].Error != "" {
+		t.Fatalf("Unexpected error %s", results[1].Error)
+	}
+	if err := protojson.Unmarshal(results[1].Response, ls); err != nil || ls.Path != "/tmp" || !ls.Exists {
+		t.Errorf("Unexpected response %s (%v)", results[1].Response, err)
+	}
+	if rpc.lastLs == nil || rpc.lastLs.Request.GetSessionID() != "session1" {
+		t.Errorf("Expected the active target's request to be set")
+	}
+	for _, id := range []int64{2, 3, 4} {
+		if results[id].Error == "" {
+			t.Errorf("Expected an error for rpc %d", id)
+		}
+	}
+}
+
+func TestServeWait(t *testing.T) {
+	rpc := &testRPC{}
+	stdin := &bytes.Buffer{}
+	output := []string{}
+	session := testSession(rpc, stdin, &output)
+	session.request.Async = true
+	session.waitTask = func(taskID string) *clientpb.BeaconTask {
+		if taskID != "task1" {
+			t.Errorf("Unexpected task id %s", taskID)
+		}
+		data, _ := proto.Marshal(&sliverpb.Ls{Path: "/home", Exists: true})
+		return &clientpb.BeaconTask{ID: taskID, Response: data}
+	}
+	stdout := strings.Join([]string{
+		`{"type":"rpc","id":1,"method":"Ls","request":{"Path":"/home"},"wait":true}`,
+		`{"type":"rpc","id":2,"method":"Ls","request":{"Path":"/home"}}`,
+	}, "\n")
+	if err := session.serve(strings.NewReader(stdout)); err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+	results := rpcResults(t, stdin)
+
+	ls := &sliverpb.Ls{}
+	if err := protojson.Unmarshal(results[1].Response, ls); err != nil || ls.Path != "/home" || ls.Response.GetAsync() {
+		t.Errorf("Expected the task's result, got %s (%v)", results[1].Response, err)
+	}
+	ls = &sliverpb.Ls{}
+	if err := protojson.Unmarshal(results[2].Response, ls); err != nil || ls.Response.GetTaskID() != "task1" {
+		t.Errorf("Expected the task, got %s (%v)", results[2].Response, err)
+	}
+}
+
+func TestWaitTaskTimeout(t *testing.T) {
+	con := &console.SliverConsoleClient{
+		BeaconTaskCallbacks:      map[string]console.BeaconTaskCallback{},
+		BeaconTaskCallbacksMutex: &sync.Mutex{},
+	}
+	if task := waitTask(con, 10*time.Millisecond)("task1"); task != nil {
+		t.Errorf("Expected no task after the timeout, got %v", task)
+	}
+}
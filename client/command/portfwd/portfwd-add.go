@@ -69,6 +69,29 @@ func PortfwdAddCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []
 		bindAddr = fmt.Sprintf("127.0.0.1:%s", bindAddr)
 	}
 
+	if udp, _ := cmd.Flags().GetBool("udp"); udp {
+		udpProxy := &core.UDPProxy{
+			Rpc:        con.Rpc,
+			Session:    session,
+			BindAddr:   bindAddr,
+			RemoteAddr: remoteAddr,
+		}
+		err = udpProxy.Listen()
+		if err != nil {
+			con.PrintErrorf("Failed to bind %s\n", err)
+			return
+		}
+		core.Portfwds.AddUDP(udpProxy)
+		go func() {
+			err := udpProxy.Run()
+			if err != nil {
+				log.Printf("Proxy error %s", err)
+			}
+		}()
+		con.PrintInfof("Port forwarding %s -> %s:%s (udp)\n", bindAddr, remoteHost, remotePort)
+		return
+	}
+
 	tcpProxy := &tcpproxy.Proxy{}
 	channelProxy := &core.ChannelProxy{
 		Rpc:             con.Rpc,
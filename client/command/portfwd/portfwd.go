@@ -55,6 +55,7 @@ func PrintPortfwd(con *console.SliverConsoleClient) {
 		"Session ID",
 		"Bind Address",
 		"Remote Address",
+		"Protocol",
 	})
 	for _, p := range portfwds {
 		tw.AppendRow(table.Row{
@@ -62,6 +63,7 @@ func PrintPortfwd(con *console.SliverConsoleClient) {
 			p.SessionID,
 			p.BindAddr,
 			p.RemoteAddr,
+			p.Protocol,
 		})
 	}
 	con.Printf("%s\n", tw.Render())
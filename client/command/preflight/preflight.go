@@ -0,0 +1,104 @@
+package preflight
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// PreflightCmd - Check a build config and the running listeners for opsec mistakes
+func PreflightCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	profileName, _ := cmd.Flags().GetString("profile")
+	implantName, _ := cmd.Flags().GetString("implant")
+	skipInfra, _ := cmd.Flags().GetBool("skip-infra")
+	if profileName != "" && implantName != "" {
+		con.PrintErrorf("Use either --profile or --implant\n")
+		return
+	}
+	if profileName == "" && implantName == "" && skipInfra {
+		con.PrintErrorf("Nothing to check, use --profile or --implant to check a build\n")
+		return
+	}
+
+	result, err := con.Rpc.Preflight(context.Background(), &clientpb.PreflightReq{
+		ProfileName:        profileName,
+		ImplantName:        implantName,
+		SkipInfrastructure: skipInfra,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintPreflight(result, con)
+}
+
+// PrintPreflight - Print the grade and the findings
+func PrintPreflight(result *clientpb.Preflight, con *console.SliverConsoleClient) {
+	if len(result.Findings) == 0 {
+		con.PrintSuccessf("Grade %s, no findings\n", result.Grade)
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Severity",
+		"Check",
+		"Target",
+		"Finding",
+		"Remediation",
+	})
+	for _, finding := range result.Findings {
+		tw.AppendRow(table.Row{
+			severityColor(finding.Severity) + finding.Severity + console.Normal,
+			finding.Check,
+			finding.Target,
+			finding.Message,
+			finding.Remediation,
+		})
+	}
+	con.Printf("%s\n\n", tw.Render())
+	switch result.Grade {
+	case "A", "B":
+		con.PrintSuccessf("Grade %s (%d/100)\n", result.Grade, result.Score)
+	case "C", "D":
+		con.PrintWarnf("Grade %s (%d/100)\n", result.Grade, result.Score)
+	default:
+		con.PrintErrorf("Grade %s (%d/100)\n", result.Grade, result.Score)
+	}
+}
+
+func severityColor(severity string) string {
+	switch severity {
+	case "high":
+		return console.Bold + console.Red
+	case "medium":
+		return console.Orange
+	case "low":
+		return console.Blue
+	default:
+		return console.Normal
+	}
+}
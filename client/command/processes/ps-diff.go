@@ -0,0 +1,160 @@
+package processes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// ProcessDiff - Processes started and stopped between two process lists
+type ProcessDiff struct {
+	Started []*commonpb.Process
+	Stopped []*commonpb.Process
+}
+
+// DiffProcesses - Compare two process lists, a process is identified by its pid,
+// parent, and executable so a reused pid is reported as a new process
+func DiffProcesses(previous []*commonpb.Process, current []*commonpb.Process) *ProcessDiff {
+	key := func(proc *commonpb.Process) string {
+		return fmt.Sprintf("%d|%d|%s", proc.Pid, proc.Ppid, proc.Executable)
+	}
+	previousKeys := map[string]bool{}
+	for _, proc := range previous {
+		previousKeys[key(proc)] = true
+	}
+	currentKeys := map[string]bool{}
+	diff := &ProcessDiff{Started: []*commonpb.Process{}, Stopped: []*commonpb.Process{}}
+	for _, proc := range current {
+		currentKeys[key(proc)] = true
+		if !previousKeys[key(proc)] {
+			diff.Started = append(diff.Started, proc)
+		}
+	}
+	for _, proc := range previous {
+		if !currentKeys[key(proc)] {
+			diff.Stopped = append(diff.Stopped, proc)
+		}
+	}
+	SortProcessesByPID(diff.Started)
+	SortProcessesByPID(diff.Stopped)
+	return diff
+}
+
+// previousProcesses - The process list saved by the server the last time ps was run on
+// the target's host, nil if there isn't one
+func previousProcesses(session *clientpb.Session, beacon *clientpb.Beacon, con *console.SliverConsoleClient) (*clientpb.HostProcesses, error) {
+	hostUUID := ""
+	if session != nil {
+		hostUUID = session.UUID
+	} else if beacon != nil {
+		hostUUID = beacon.UUID
+	}
+	previous, err := con.Rpc.GetHostProcesses(context.Background(), &clientpb.HostProcessesReq{HostUUID: hostUUID})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	return previous, err
+}
+
+// PrintPSDiff - Prints the processes started and stopped since the previous process list
+func PrintPSDiff(os string, previous *clientpb.HostProcesses, ps *sliverpb.Ps, flags *pflag.FlagSet, con *console.SliverConsoleClient) {
+	if previous == nil {
+		con.PrintWarnf("No previous process list to compare to, showing all processes\n")
+		PrintPS(os, ps, false, flags, con)
+		return
+	}
+	diff := DiffProcesses(previous.Processes, ps.Processes)
+	since := con.FormatDateDelta(time.Unix(previous.CreatedAt, 0), true, false)
+	pidFilter, _ := flags.GetInt("pid")
+	exeFilter, _ := flags.GetString("exe")
+	ownerFilter, _ := flags.GetString("owner")
+	cmdLine, _ := flags.GetBool("print-cmdline")
+	matches := func(proc *commonpb.Process) bool {
+		if pidFilter != -1 && proc.Pid != int32(pidFilter) {
+			return false
+		}
+		if exeFilter != "" && !strings.Contains(strings.ToLower(proc.Executable), strings.ToLower(exeFilter)) {
+			return false
+		}
+		if ownerFilter != "" && !strings.Contains(strings.ToLower(proc.Owner), strings.ToLower(ownerFilter)) {
+			return false
+		}
+		return true
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	header := table.Row{"", "pid", "ppid", "owner", "arch", "executable"}
+	if os == "windows" {
+		header = append(header, "session")
+	}
+	tw.AppendHeader(header)
+	for _, proc := range diff.Started {
+		if matches(proc) {
+			tw.AppendRow(diffRow(os, "+", console.Bold+console.Green, proc, cmdLine))
+		}
+	}
+	for _, proc := range diff.Stopped {
+		if matches(proc) {
+			tw.AppendRow(diffRow(os, "-", console.Red, proc, cmdLine))
+		}
+	}
+	if 0 < tw.Length() {
+		con.Printf("%s\n", tw.Render())
+	}
+	con.PrintInfof("%d started, %d stopped since the process list from %s (%s)\n",
+		len(diff.Started), len(diff.Stopped), previous.ImplantName, since)
+
+	products := findKnownSecurityProducts(&sliverpb.Ps{Processes: diff.Started})
+	if 0 < len(products) {
+		sort.Strings(products)
+		con.PrintWarnf("Security Product(s) started: %s\n", strings.Join(products, ", "))
+	}
+}
+
+func diffRow(os string, change string, color string, proc *commonpb.Process, cmdLine bool) table.Row {
+	executable := proc.Executable
+	if cmdLine && 0 < len(proc.CmdLine) {
+		executable = strings.Join(proc.CmdLine, " ")
+	}
+	values := []interface{}{change, proc.Pid, proc.Ppid, proc.Owner, proc.Architecture, executable}
+	if os == "windows" {
+		values = append(values, proc.SessionID)
+	}
+	row := table.Row{}
+	for _, value := range values {
+		row = append(row, fmt.Sprintf(color+"%v"+console.Normal, value))
+	}
+	return row
+}
@@ -0,0 +1,49 @@
+package processes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+func TestDiffProcesses(t *testing.T) {
+	previous := []*commonpb.Process{
+		{Pid: 4, Ppid: 0, Executable: "System"},
+		{Pid: 100, Ppid: 4, Executable: "explorer.exe"},
+		{Pid: 200, Ppid: 100, Executable: "notepad.exe"},
+	}
+	current := []*commonpb.Process{
+		{Pid: 300, Ppid: 4, Executable: "MsMpEng.exe"},
+		{Pid: 4, Ppid: 0, Executable: "System"},
+		{Pid: 100, Ppid: 4, Executable: "explorer.exe"},
+		{Pid: 200, Ppid: 100, Executable: "cmd.exe"},
+	}
+	diff := DiffProcesses(previous, current)
+	if len(diff.Started) != 2 || diff.Started[0].Pid != 200 || diff.Started[1].Pid != 300 {
+		t.Fatalf("unexpected started processes %v", diff.Started)
+	}
+	if len(diff.Stopped) != 1 || diff.Stopped[0].Executable != "notepad.exe" {
+		t.Fatalf("unexpected stopped processes %v", diff.Stopped)
+	}
+	if diff := DiffProcesses(current, current); len(diff.Started) != 0 || len(diff.Stopped) != 0 {
+		t.Fatalf("expected no changes, got %v", diff)
+	}
+}
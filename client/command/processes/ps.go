@@ -104,6 +104,18 @@ func PsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string)
 	if session == nil && beacon == nil {
 		return
 	}
+	// The server replaces the saved process list with this one, so the
+	// previous list has to be fetched first
+	diff, _ := cmd.Flags().GetBool("diff")
+	var previous *clientpb.HostProcesses
+	if diff {
+		var err error
+		previous, err = previousProcesses(session, beacon, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+	}
 	ps, err := con.Rpc.Ps(context.Background(), &sliverpb.PsReq{
 		Request: con.ActiveTarget.Request(cmd),
 	})
@@ -119,6 +131,10 @@ func PsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string)
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			if diff {
+				PrintPSDiff(os, previous, ps, cmd.Flags(), con)
+				return
+			}
 			PrintPS(os, ps, false, cmd.Flags(), con)
 			products := findKnownSecurityProducts(ps)
 			if 0 < len(products) {
@@ -127,6 +143,8 @@ func PsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string)
 			}
 		})
 		con.PrintAsyncResponse(ps.Response)
+	} else if diff {
+		PrintPSDiff(os, previous, ps, cmd.Flags(), con)
 	} else {
 		PrintPS(os, ps, true, cmd.Flags(), con)
 		products := findKnownSecurityProducts(ps)
@@ -68,7 +68,7 @@ func LoadReactions() (int, error) {
 		core.Reactions.Remove(oldReaction.ID)
 	}
 	for _, reaction := range reactions {
-		if !isReactable(reaction) {
+		if !isReactable(reaction) || reaction.Validate() != nil {
 			continue
 		}
 		core.Reactions.Add(reaction)
@@ -61,11 +61,15 @@ func displayReactionsTable(eventType string, reactions []core.Reaction, con *con
 	tw.AppendHeader(table.Row{
 		"ID",
 		"Commands" + strings.Repeat(" ", slackSpace), // Leave space for title
+		"Filter",
+		"Stop On Error",
 	})
 	for _, react := range reactions {
 		tw.AppendRow(table.Row{
 			react.ID,
 			strings.Join(react.Commands, ","),
+			react.Filter.String(),
+			react.StopOnError,
 		})
 	}
 	con.Printf("%s\n", tw.Render())
@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/client/core"
 )
 
@@ -39,6 +40,11 @@ func ReactionSetCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args [
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	if filter := getFilter(cmd); filter != nil && !isTargetEvent(eventType) {
+		con.PrintErrorf("Filters can only be used with session and beacon events\n")
+		return
+	}
+	stopOnError, _ := cmd.Flags().GetBool("stop-on-error")
 	con.Println()
 	con.PrintInfof("Setting reaction to: %s\n", EventTypeToTitle(eventType))
 	con.Println()
@@ -54,15 +60,35 @@ func ReactionSetCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args [
 		}
 	}
 
-	reaction := core.Reactions.Add(core.Reaction{
-		EventType: eventType,
-		Commands:  commands,
-	})
+	reaction := core.Reaction{
+		EventType:   eventType,
+		Commands:    commands,
+		Filter:      getFilter(cmd),
+		StopOnError: stopOnError,
+	}
+	err = reaction.Validate()
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	reaction = core.Reactions.Add(reaction)
 
 	con.Println()
 	con.PrintInfof("Set reaction to %s (id: %d)\n", eventType, reaction.ID)
 }
 
+// getFilter - The session/beacon filter from the flags, nil if there isn't one
+func getFilter(cmd *cobra.Command) *core.ReactionFilter {
+	filter := &core.ReactionFilter{}
+	filter.OS, _ = cmd.Flags().GetStringSlice("os")
+	filter.Tags, _ = cmd.Flags().GetStringSlice("tag")
+	filter.Subnets, _ = cmd.Flags().GetStringSlice("subnet")
+	if filter.IsEmpty() {
+		return nil
+	}
+	return filter
+}
+
 func getEventType(cmd *cobra.Command, con *console.SliverConsoleClient) (string, error) {
 	rawEventType, _ := cmd.Flags().GetString("event")
 	if rawEventType == "" {
@@ -77,6 +103,15 @@ func getEventType(cmd *cobra.Command, con *console.SliverConsoleClient) (string,
 	}
 }
 
+// isTargetEvent - Events about a session or beacon, which can be filtered
+func isTargetEvent(eventType string) bool {
+	switch eventType {
+	case consts.SessionOpenedEvent, consts.SessionUpdateEvent, consts.SessionClosedEvent, consts.BeaconRegisteredEvent:
+		return true
+	}
+	return false
+}
+
 func selectEventType(con *console.SliverConsoleClient) (string, error) {
 	prompt := &survey.Select{
 		Message: "Select an event:",
@@ -98,7 +133,7 @@ func selectEventType(con *console.SliverConsoleClient) (string, error) {
 func userCommands() (string, error) {
 	text := ""
 	prompt := &survey.Multiline{
-		Message: "Enter commands: ",
+		Message: "Enter commands (one per line, 'sleep <duration>' to wait): ",
 	}
 	err := survey.AskOne(prompt, &text)
 	return text, err
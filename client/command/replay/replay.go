@@ -0,0 +1,225 @@
+package replay
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+// castHeader - The fields of an asciicast v2 header used for playback
+type castHeader struct {
+	Version int    `json:"version"`
+	Title   string `json:"title"`
+	Sliver  struct {
+		Kind string `json:"kind"`
+	} `json:"sliver"`
+}
+
+// ReplayCmd - List recordings, or replay a recording in the terminal
+func ReplayCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	sessionID, _ := cmd.Flags().GetString("session")
+	if len(args) == 0 {
+		recordings, err := con.Rpc.GetRecordings(context.Background(), &clientpb.RecordingReq{SessionID: sessionID})
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		PrintRecordings(recordings.Recordings, con)
+		return
+	}
+
+	recording, err := recordingByID(con, args[0])
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	file, err := con.Rpc.GetRecording(context.Background(), &clientpb.RecordingReq{ID: recording.ID})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	save, _ := cmd.Flags().GetString("save")
+	if save != "" {
+		saveTo := save
+		if fi, err := os.Stat(save); err == nil && fi.IsDir() {
+			saveTo = filepath.Join(save, file.Name)
+		}
+		err = os.WriteFile(saveTo, file.Data, 0600)
+		if err != nil {
+			con.PrintErrorf("Failed to write recording %s\n", err)
+			return
+		}
+		con.PrintInfof("Saved recording to %s\n", saveTo)
+		return
+	}
+
+	speed, _ := cmd.Flags().GetFloat64("speed")
+	maxIdle, _ := cmd.Flags().GetFloat64("max-idle")
+	if speed <= 0 {
+		con.PrintErrorf("Speed must be greater than zero\n")
+		return
+	}
+	err = play(con, file.Data, speed, maxIdle)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+	}
+}
+
+// PrintRecordings - Display a table of recordings
+func PrintRecordings(recordings []*clientpb.Recording, con *console.SliverConsoleClient) {
+	if len(recordings) == 0 {
+		con.PrintInfof("No recordings\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Kind",
+		"Session",
+		"Hostname",
+		"Operator",
+		"Started",
+		"Size",
+	})
+	for _, recording := range recordings {
+		tw.AppendRow(table.Row{
+			recording.ID,
+			recording.Kind,
+			recording.SessionName,
+			recording.Hostname,
+			recording.Operator,
+			time.Unix(recording.StartedAt, 0).Format(time.RFC1123),
+			util.ByteCountBinary(recording.Size),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// recordingByID - Match a full recording ID or a unique prefix of one
+func recordingByID(con *console.SliverConsoleClient, id string) (*clientpb.Recording, error) {
+	recordings, err := con.Rpc.GetRecordings(context.Background(), &clientpb.RecordingReq{})
+	if err != nil {
+		return nil, err
+	}
+	var match *clientpb.Recording
+	for _, recording := range recordings.Recordings {
+		if recording.ID == id {
+			return recording, nil
+		}
+		if strings.HasPrefix(recording.ID, id) {
+			if match != nil {
+				return nil, fmt.Errorf("recording id '%s' is ambiguous", id)
+			}
+			match = recording
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("no recording with id '%s'", id)
+	}
+	return match, nil
+}
+
+// play - Write the recorded output to the terminal with the original timing, shell
+// input is echoed back by the remote shell so only console input is shown
+func play(con *console.SliverConsoleClient, data []byte, speed float64, maxIdle float64) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		return errors.New("empty recording")
+	}
+	header := &castHeader{}
+	err := json.Unmarshal(scanner.Bytes(), header)
+	if err != nil || header.Version != 2 {
+		return errors.New("not an asciicast v2 recording")
+	}
+	showInput := header.Sliver.Kind == "console"
+
+	sigint := make(chan os.Signal, 1) // Catch keyboard interrupts
+	signal.Notify(sigint, os.Interrupt)
+	defer signal.Stop(sigint)
+
+	con.PrintInfof("Replaying %s (ctrl-c to stop)\n\n", header.Title)
+	last := 0.0
+	for scanner.Scan() {
+		var event []interface{}
+		err := json.Unmarshal(scanner.Bytes(), &event)
+		if err != nil || len(event) != 3 {
+			continue
+		}
+		elapsed, _ := event[0].(float64)
+		stream, _ := event[1].(string)
+		text, _ := event[2].(string)
+		if stream != "o" && !(showInput && stream == "i") {
+			continue
+		}
+		delay := elapsed - last
+		if 0 < maxIdle && maxIdle < delay {
+			delay = maxIdle
+		}
+		last = elapsed
+		select {
+		case <-sigint:
+			con.Printf("\n")
+			con.PrintWarnf("Replay stopped\n")
+			return nil
+		case <-time.After(time.Duration(delay / speed * float64(time.Second))):
+		}
+		con.Printf("%s", text)
+	}
+	con.Printf("\n")
+	return scanner.Err()
+}
+
+// RecordingIDCompleter - Completes recording IDs
+func RecordingIDCompleter(con *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := []string{}
+		recordings, err := con.Rpc.GetRecordings(context.Background(), &clientpb.RecordingReq{})
+		if err != nil {
+			return carapace.ActionMessage("Failed to get recordings: %s", err)
+		}
+		for _, recording := range recordings.Recordings {
+			results = append(results, recording.ID)
+			results = append(results, fmt.Sprintf("%s %s (%s)", recording.Kind, recording.SessionName, recording.Hostname))
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("recordings")
+	}
+	return carapace.ActionCallback(callback)
+}
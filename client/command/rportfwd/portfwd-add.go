@@ -50,7 +50,7 @@ func StartRportFwdListenerCmd(cmd *cobra.Command, con *console.SliverConsoleClie
 
 	forwardAddress, _ := cmd.Flags().GetString("remote")
 	// Check if the forward address is just a port number, if no host is specified
-	// we just forward to localhost client-side
+	// we just forward to localhost server-side
 	if portNumberOnlyRegexp.MatchString(forwardAddress) {
 		forwardAddress = fmt.Sprintf("127.0.0.1:%s", forwardAddress)
 	}
@@ -0,0 +1,347 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// implantTimeout - How long the implant has to respond to a task, one second
+	// less than the gRPC timeout so that the server times out first
+	implantTimeout = 59 * time.Second
+)
+
+var errTargetNotFound = errors.New("session or beacon not found")
+
+// module - The 'sliver' table, RPC errors are raised as lua errors which can be
+// caught with pcall()
+func (s *Script) module() *lua.LTable {
+	return s.state.SetFuncs(s.state.NewTable(), map[string]lua.LGFunction{
+		"sessions":     s.luaSessions,
+		"beacons":      s.luaBeacons,
+		"target":       s.luaTarget,
+		"tasks":        s.luaTasks,
+		"task":         s.luaTask,
+		"loot":         s.luaLoot,
+		"loot_content": s.luaLootContent,
+		"loot_add":     s.luaLootAdd,
+		"execute":      s.luaExecute,
+		"console":      s.luaConsole,
+		"on":           s.luaOn,
+		"info":         s.luaPrint(s.con.PrintInfof),
+		"success":      s.luaPrint(s.con.PrintSuccessf),
+		"warn":         s.luaPrint(s.con.PrintWarnf),
+		"error":        s.luaPrint(s.con.PrintErrorf),
+	})
+}
+
+// sliver.sessions() - All sessions
+func (s *Script) luaSessions(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	sessions, err := s.con.Rpc.GetSessions(ctx, &commonpb.Empty{})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(protoListToLua(L, sessions.Sessions))
+	return 1
+}
+
+// sliver.beacons() - All beacons
+func (s *Script) luaBeacons(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	beacons, err := s.con.Rpc.GetBeacons(ctx, &commonpb.Empty{})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(protoListToLua(L, beacons.Beacons))
+	return 1
+}
+
+// sliver.target(id_or_name) - The session or beacon and its kind ("session" or "beacon"), nil if not found
+func (s *Script) luaTarget(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	session, beacon, err := s.findTarget(ctx, L.CheckString(1))
+	switch {
+	case errors.Is(err, errTargetNotFound):
+		L.Push(lua.LNil)
+		return 1
+	case err != nil:
+		L.RaiseError("%s", err)
+		return 0
+	case session != nil:
+		L.Push(protoToLua(L, session))
+		L.Push(lua.LString("session"))
+	default:
+		L.Push(protoToLua(L, beacon))
+		L.Push(lua.LString("beacon"))
+	}
+	return 2
+}
+
+// sliver.tasks(beacon) - A beacon's tasks, without their request/response content
+func (s *Script) luaTasks(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	_, beacon, err := s.findTarget(ctx, L.CheckString(1))
+	if err == nil && beacon == nil {
+		err = errors.New("tasks are only available for beacons")
+	}
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	tasks, err := s.con.Rpc.GetBeaconTasks(ctx, &clientpb.Beacon{ID: beacon.ID})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(protoListToLua(L, tasks.Tasks))
+	return 1
+}
+
+// sliver.task(task_id) - A beacon task including its request/response content
+func (s *Script) luaTask(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	task, err := s.con.Rpc.GetBeaconTaskContent(ctx, &clientpb.BeaconTask{ID: L.CheckString(1)})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(protoToLua(L, task))
+	return 1
+}
+
+// sliver.loot() - All loot, without its content
+func (s *Script) luaLoot(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	allLoot, err := s.con.Rpc.LootAll(ctx, &commonpb.Empty{})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(protoListToLua(L, allLoot.Loot))
+	return 1
+}
+
+// sliver.loot_content(loot_id) - The content of a piece of loot
+func (s *Script) luaLootContent(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	loot, err := s.con.Rpc.LootContent(ctx, &clientpb.Loot{ID: L.CheckString(1)})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(lua.LString(loot.File.GetData()))
+	return 1
+}
+
+// sliver.loot_add(name, content) - Add a piece of text loot
+func (s *Script) luaLootAdd(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	name := L.CheckString(1)
+	loot, err := s.con.Rpc.LootAdd(ctx, &clientpb.Loot{
+		Name:     name,
+		FileType: clientpb.FileType_TEXT,
+		File: &commonpb.File{
+			Name: name,
+			Data: []byte(L.CheckString(2)),
+		},
+	})
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	L.Push(protoToLua(L, loot))
+	return 1
+}
+
+// sliver.execute(target, path [, args [, callback]]) - Execute a program and capture its
+// output. Sessions return the result, beacons return the task id and call the callback
+// with the result once the beacon checks in.
+func (s *Script) luaExecute(L *lua.LState) int {
+	ctx, cancel := s.con.GrpcContext(nil)
+	defer cancel()
+	session, beacon, err := s.findTarget(ctx, L.CheckString(1))
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+	path := L.CheckString(2)
+	args := []string{}
+	if argsTable := L.OptTable(3, nil); argsTable != nil {
+		for index := 1; index <= argsTable.Len(); index++ {
+			args = append(args, lua.LVAsString(argsTable.RawGetInt(index)))
+		}
+	}
+	callback := L.OptFunction(4, nil)
+
+	request := &commonpb.Request{Timeout: int64(implantTimeout)}
+	if session != nil {
+		request.SessionID = session.ID
+	} else {
+		request.BeaconID = beacon.ID
+		request.Async = true
+	}
+	exec, err := s.con.Rpc.Execute(ctx, &sliverpb.ExecuteReq{
+		Request: request,
+		Path:    path,
+		Args:    args,
+		Output:  true,
+	})
+	if err == nil && exec.Response != nil && exec.Response.Err != "" {
+		err = errors.New(exec.Response.Err)
+	}
+	if err != nil {
+		L.RaiseError("%s", err)
+		return 0
+	}
+
+	if !exec.Response.GetAsync() {
+		L.Push(protoToLua(L, exec))
+		return 1
+	}
+	if callback != nil {
+		s.con.AddBeaconCallback(exec.Response.TaskID, func(task *clientpb.BeaconTask) {
+			result := &sliverpb.Execute{}
+			if err := proto.Unmarshal(task.Response, result); err != nil {
+				s.con.PrintErrorf("Script %s: failed to decode response %s\n", s.Name, err)
+				return
+			}
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			if !s.closed {
+				s.call(callback, protoToLua(s.state, result))
+			}
+		})
+	}
+	L.Push(lua.LString(exec.Response.TaskID))
+	return 1
+}
+
+// sliver.console(command [, target]) - Run a console command on a session or beacon, by
+// default the one the operator is interacting with. The command runs on a console of its
+// own, the operator's active session/beacon is never changed. Raises an error if the
+// command fails or prints an error.
+func (s *Script) luaConsole(L *lua.LState) int {
+	line := L.CheckString(1)
+	session, beacon := s.con.ActiveTarget.Get()
+	if target := L.OptString(2, ""); target != "" {
+		ctx, cancel := s.con.GrpcContext(nil)
+		var err error
+		session, beacon, err = s.findTarget(ctx, target)
+		cancel()
+		if err != nil {
+			L.RaiseError("%s", err)
+			return 0
+		}
+	}
+	con := s.con.Bind(session, beacon, nil)
+	err := con.RunCommand(line)
+	if err == nil && 0 < con.ErrorCount() {
+		err = fmt.Errorf("'%s' failed", line)
+	}
+	if err != nil {
+		L.RaiseError("%s", err)
+	}
+	return 0
+}
+
+// sliver.on(event_type, handler) - Call the handler with each event of the type ("*" for all events)
+func (s *Script) luaOn(L *lua.LState) int {
+	eventType := L.CheckString(1)
+	s.handlers[eventType] = append(s.handlers[eventType], L.CheckFunction(2))
+	return 0
+}
+
+// sliver.info/success/warn/error(format, ...) - Print a message using string.format() formatting
+func (s *Script) luaPrint(printf func(string, ...any)) lua.LGFunction {
+	return func(L *lua.LState) int {
+		format := L.CheckString(1)
+		args := []any{}
+		for index := 2; index <= L.GetTop(); index++ {
+			args = append(args, luaToFormatArg(L.Get(index)))
+		}
+		printf("%s\n", fmt.Sprintf(format, args...))
+		return 0
+	}
+}
+
+// luaToFormatArg - Lua numbers are floats, integral values are formatted as integers
+// so that both %d and %s work as they do with string.format()
+func luaToFormatArg(value lua.LValue) any {
+	switch value := value.(type) {
+	case lua.LNumber:
+		if float64(value) == float64(int64(value)) {
+			return int64(value)
+		}
+		return float64(value)
+	case lua.LBool:
+		return bool(value)
+	case lua.LString:
+		return string(value)
+	}
+	return value.String()
+}
+
+// findTarget - Find a session or beacon by id, id prefix, or name
+func (s *Script) findTarget(ctx context.Context, target string) (*clientpb.Session, *clientpb.Beacon, error) {
+	if target == "" {
+		return nil, nil, errTargetNotFound
+	}
+	sessions, err := s.con.Rpc.GetSessions(ctx, &commonpb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, session := range sessions.Sessions {
+		if session.ID == target || session.Name == target || strings.HasPrefix(session.ID, target) {
+			return session, nil, nil
+		}
+	}
+	beacons, err := s.con.Rpc.GetBeacons(ctx, &commonpb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, beacon := range beacons.Beacons {
+		if beacon.ID == target || beacon.Name == target || strings.HasPrefix(beacon.ID, target) {
+			return nil, beacon, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: %s", errTargetNotFound, target)
+}
@@ -0,0 +1,121 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	lua "github.com/yuin/gopher-lua"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// protoToLua - Convert a protobuf message into a table keyed by the proto field
+// names, unset fields are nil, enums are their names, and bytes are strings
+func protoToLua(L *lua.LState, msg proto.Message) lua.LValue {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return lua.LNil
+	}
+	return messageToLua(L, msg.ProtoReflect())
+}
+
+// protoListToLua - Convert a list of protobuf messages into an array table
+func protoListToLua[T proto.Message](L *lua.LState, msgs []T) *lua.LTable {
+	table := L.NewTable()
+	for _, msg := range msgs {
+		table.Append(protoToLua(L, msg))
+	}
+	return table
+}
+
+// eventToLua - Convert an event, the data of events that carry a protobuf
+// message is also decoded into its own field
+func eventToLua(L *lua.LState, event *clientpb.Event) lua.LValue {
+	table := protoToLua(L, event).(*lua.LTable)
+	switch event.EventType {
+	case consts.BeaconRegisteredEvent:
+		beacon := &clientpb.Beacon{}
+		if proto.Unmarshal(event.Data, beacon) == nil {
+			table.RawSetString("Beacon", protoToLua(L, beacon))
+		}
+	case consts.BeaconTaskResultEvent:
+		task := &clientpb.BeaconTask{}
+		if proto.Unmarshal(event.Data, task) == nil {
+			table.RawSetString("Task", protoToLua(L, task))
+		}
+	}
+	return table
+}
+
+func messageToLua(L *lua.LState, msg protoreflect.Message) *lua.LTable {
+	table := L.NewTable()
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		table.RawSetString(string(field.Name()), fieldToLua(L, field, value))
+		return true
+	})
+	return table
+}
+
+func fieldToLua(L *lua.LState, field protoreflect.FieldDescriptor, value protoreflect.Value) lua.LValue {
+	switch {
+	case field.IsList():
+		list := value.List()
+		table := L.NewTable()
+		for index := 0; index < list.Len(); index++ {
+			table.Append(valueToLua(L, field, list.Get(index)))
+		}
+		return table
+	case field.IsMap():
+		table := L.NewTable()
+		value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			table.RawSet(valueToLua(L, field.MapKey(), key.Value()), valueToLua(L, field.MapValue(), value))
+			return true
+		})
+		return table
+	default:
+		return valueToLua(L, field, value)
+	}
+}
+
+func valueToLua(L *lua.LState, field protoreflect.FieldDescriptor, value protoreflect.Value) lua.LValue {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return lua.LBool(value.Bool())
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return lua.LString(enumValue.Name())
+		}
+		return lua.LNumber(value.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return lua.LNumber(value.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return lua.LNumber(value.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return lua.LNumber(value.Float())
+	case protoreflect.StringKind:
+		return lua.LString(value.String())
+	case protoreflect.BytesKind:
+		return lua.LString(value.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageToLua(L, value.Message())
+	}
+	return lua.LNil
+}
@@ -0,0 +1,57 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// ScriptsLoadCmd - Load a script, scripts stay loaded so their event handlers run
+// until they're unloaded or the client exits
+func ScriptsLoadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	scriptPath, err := resolveScriptPath(args[0])
+	if err != nil {
+		con.PrintErrorf("%s: %s\n", args[0], err)
+		return
+	}
+	script := NewScript(con, scriptPath, args[1:])
+	err = script.Execute()
+	if err != nil {
+		script.Close()
+		con.PrintErrorf("Failed to load %s: %s\n", scriptPath, err)
+		return
+	}
+
+	loadedScriptsMutex.Lock()
+	previous, reloaded := loadedScripts[script.Name]
+	loadedScripts[script.Name] = script
+	loadedScriptsMutex.Unlock()
+	if reloaded {
+		previous.Close()
+	}
+
+	script.Listen()
+	if reloaded {
+		con.PrintInfof("Reloaded script %s\n", script.Name)
+	} else {
+		con.PrintInfof("Loaded script %s\n", script.Name)
+	}
+}
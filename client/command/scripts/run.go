@@ -0,0 +1,45 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+)
+
+// ScriptsRunCmd - Run a script once, any event handlers it registers are discarded
+func ScriptsRunCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	scriptPath, err := resolveScriptPath(args[0])
+	if err != nil {
+		con.PrintErrorf("%s: %s\n", args[0], err)
+		return
+	}
+	script := NewScript(con, scriptPath, args[1:])
+	defer script.Close()
+	err = script.Execute()
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if 0 < len(script.Events()) {
+		con.PrintWarnf("Event handlers are only called for loaded scripts, see '%s %s'\n", consts.ScriptsStr, consts.LoadStr)
+	}
+}
@@ -0,0 +1,143 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	// allEvents - Handlers registered for this event type receive every event
+	allEvents = "*"
+)
+
+// Script - A lua script running in its own interpreter, the interpreter is not
+// safe for concurrent use so all calls into it must hold the mutex
+type Script struct {
+	Name     string
+	Path     string
+	LoadedAt time.Time
+
+	con        *console.SliverConsoleClient
+	state      *lua.LState
+	mutex      *sync.Mutex
+	handlers   map[string][]*lua.LFunction
+	listenerID string
+	closed     bool
+}
+
+// NewScript - Create an interpreter for the script at scriptPath, the args are
+// available to the script as the global 'arg' table (arg[0] is the script path)
+func NewScript(con *console.SliverConsoleClient, scriptPath string, args []string) *Script {
+	script := &Script{
+		Name:     strings.TrimSuffix(filepath.Base(scriptPath), filepath.Ext(scriptPath)),
+		Path:     scriptPath,
+		con:      con,
+		state:    lua.NewState(),
+		mutex:    &sync.Mutex{},
+		handlers: map[string][]*lua.LFunction{},
+	}
+	argTable := script.state.NewTable()
+	argTable.RawSetInt(0, lua.LString(scriptPath))
+	for _, arg := range args {
+		argTable.Append(lua.LString(arg))
+	}
+	script.state.SetGlobal("arg", argTable)
+	script.state.SetGlobal("sliver", script.module())
+	return script
+}
+
+// Execute - Run the script's top level code
+func (s *Script) Execute() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.LoadedAt = time.Now()
+	return s.state.DoFile(s.Path)
+}
+
+// Events - The event types the script has handlers for
+func (s *Script) Events() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	events := []string{}
+	for eventType := range s.handlers {
+		events = append(events, eventType)
+	}
+	return events
+}
+
+// Listen - Dispatch events to the script's handlers until the script is closed
+func (s *Script) Listen() {
+	if len(s.Events()) == 0 || s.listenerID != "" {
+		return
+	}
+	listenerID, events := s.con.CreateEventListener()
+	s.listenerID = listenerID
+	go s.dispatch(events)
+}
+
+// dispatch - Each event is handled in a goroutine of its own so that the events keep
+// being received while a handler runs, handlers still run one at a time but events
+// that arrive while a handler runs may be handled in any order
+func (s *Script) dispatch(events <-chan *clientpb.Event) {
+	for event := range events {
+		go s.handle(event)
+	}
+}
+
+func (s *Script) handle(event *clientpb.Event) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return
+	}
+	handlers := []*lua.LFunction{}
+	handlers = append(handlers, s.handlers[event.EventType]...)
+	handlers = append(handlers, s.handlers[allEvents]...)
+	for _, handler := range handlers {
+		s.call(handler, eventToLua(s.state, event))
+	}
+}
+
+// call - Call a lua function, the caller must hold the mutex
+func (s *Script) call(fn *lua.LFunction, args ...lua.LValue) {
+	err := s.state.CallByParam(lua.P{Fn: fn, NRet: 0, Protect: true}, args...)
+	if err != nil {
+		s.con.PrintErrorf("Script %s: %s\n", s.Name, err)
+	}
+}
+
+// Close - Stop dispatching events to the script and close its interpreter
+func (s *Script) Close() {
+	if s.listenerID != "" {
+		s.con.RemoveEventListener(s.listenerID)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.closed = true
+	s.state.Close()
+}
@@ -0,0 +1,116 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+const (
+	// ScriptExt - Extension of lua scripts
+	ScriptExt = ".lua"
+)
+
+var (
+	// loadedScripts - Scripts that stay loaded to handle events, by name
+	loadedScripts      = map[string]*Script{}
+	loadedScriptsMutex = &sync.Mutex{}
+)
+
+// ScriptsCmd - List the loaded scripts
+func ScriptsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	scripts := getLoadedScripts()
+	if len(scripts) == 0 {
+		con.PrintInfof("No scripts loaded\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Path",
+		"Events",
+		"Loaded",
+	})
+	for _, script := range scripts {
+		events := script.Events()
+		sort.Strings(events)
+		tw.AppendRow(table.Row{
+			script.Name,
+			script.Path,
+			strings.Join(events, ", "),
+			con.FormatDateDelta(script.LoadedAt, false, false),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// ScriptNameCompleter - Completes the names of loaded scripts
+func ScriptNameCompleter() carapace.Action {
+	return carapace.ActionCallback(func(c carapace.Context) carapace.Action {
+		results := []string{}
+		for _, script := range getLoadedScripts() {
+			results = append(results, script.Name)
+		}
+		return carapace.ActionValues(results...).Tag("scripts")
+	})
+}
+
+func getLoadedScripts() []*Script {
+	loadedScriptsMutex.Lock()
+	defer loadedScriptsMutex.Unlock()
+	scripts := []*Script{}
+	for _, script := range loadedScripts {
+		scripts = append(scripts, script)
+	}
+	sort.Slice(scripts, func(i, j int) bool {
+		return scripts[i].Name < scripts[j].Name
+	})
+	return scripts
+}
+
+// resolveScriptPath - Find a script by path, or by name in the scripts directory
+// (~/.sliver-client/scripts) with or without the .lua extension
+func resolveScriptPath(name string) (string, error) {
+	candidates := []string{name}
+	if !filepath.IsAbs(name) {
+		candidates = append(candidates, filepath.Join(assets.GetScriptsDir(), name))
+	}
+	for _, candidate := range candidates {
+		for _, scriptPath := range []string{candidate, candidate + ScriptExt} {
+			if fi, err := os.Stat(scriptPath); err == nil && !fi.IsDir() {
+				return filepath.Abs(scriptPath)
+			}
+		}
+	}
+	return "", errors.New("script not found")
+}
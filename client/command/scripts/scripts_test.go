@@ -0,0 +1,177 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const testScript = `
+loaded_args = arg[1] .. "," .. arg[2]
+handled = {}
+sliver.on("session-connected", function(event)
+    table.insert(handled, event.EventType .. ":" .. event.Session.Hostname)
+end)
+sliver.on("*", function(event)
+    table.insert(handled, "*:" .. event.EventType)
+end)
+`
+
+func writeTestScript(t *testing.T, source string) string {
+	scriptPath := filepath.Join(t.TempDir(), "test.lua")
+	err := os.WriteFile(scriptPath, []byte(source), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return scriptPath
+}
+
+func TestProtoToLua(t *testing.T) {
+	L := lua.NewState()
+	defer L.Close()
+
+	session := &clientpb.Session{
+		ID:       "abc",
+		Hostname: "host",
+		PID:      1234,
+		IsDead:   false,
+	}
+	table, ok := protoToLua(L, session).(*lua.LTable)
+	if !ok {
+		t.Fatalf("expected a table")
+	}
+	if table.RawGetString("Hostname").String() != "host" {
+		t.Fatalf("wrong hostname %s", table.RawGetString("Hostname"))
+	}
+	if table.RawGetString("PID") != lua.LNumber(1234) {
+		t.Fatalf("wrong pid %s", table.RawGetString("PID"))
+	}
+	if table.RawGetString("IsDead") != lua.LNil {
+		t.Fatalf("unset fields should be nil")
+	}
+
+	loot := &clientpb.Loot{FileType: clientpb.FileType_TEXT, Tags: []string{"a", "b"}}
+	table = protoToLua(L, loot).(*lua.LTable)
+	if table.RawGetString("FileType").String() != "TEXT" {
+		t.Fatalf("enums should be converted to their names, got %s", table.RawGetString("FileType"))
+	}
+	if tags := table.RawGetString("Tags").(*lua.LTable); tags.Len() != 2 || tags.RawGetInt(2).String() != "b" {
+		t.Fatalf("wrong tags")
+	}
+	if protoToLua(L, (*clientpb.Loot)(nil)) != lua.LNil {
+		t.Fatalf("nil messages should be nil")
+	}
+}
+
+func TestScriptEvents(t *testing.T) {
+	script := NewScript(nil, writeTestScript(t, testScript), []string{"foo", "bar"})
+	defer script.Close()
+	err := script.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script.Name != "test" {
+		t.Fatalf("wrong script name %s", script.Name)
+	}
+	if args := script.state.GetGlobal("loaded_args").String(); args != "foo,bar" {
+		t.Fatalf("wrong args %s", args)
+	}
+	if len(script.Events()) != 2 {
+		t.Fatalf("expected 2 event handlers, got %v", script.Events())
+	}
+
+	script.handle(&clientpb.Event{
+		EventType: consts.SessionOpenedEvent,
+		Session:   &clientpb.Session{Hostname: "host"},
+	})
+	script.handle(&clientpb.Event{EventType: consts.LootAddedEvent})
+
+	handled := script.state.GetGlobal("handled").(*lua.LTable)
+	expected := []string{"session-connected:host", "*:session-connected", "*:loot-added"}
+	if handled.Len() != len(expected) {
+		t.Fatalf("expected %d handled events, got %d", len(expected), handled.Len())
+	}
+	for index, value := range expected {
+		if handled.RawGetInt(index+1).String() != value {
+			t.Fatalf("expected %s got %s", value, handled.RawGetInt(index+1))
+		}
+	}
+}
+
+func TestScriptDispatch(t *testing.T) {
+	script := NewScript(nil, writeTestScript(t, testScript), []string{"foo", "bar"})
+	defer script.Close()
+	err := script.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make(chan *clientpb.Event)
+	done := make(chan struct{})
+	go func() {
+		script.dispatch(events)
+		close(done)
+	}()
+	// The sends don't wait for the handlers
+	script.mutex.Lock()
+	for i := 0; i < 3; i++ {
+		events <- &clientpb.Event{EventType: consts.LootAddedEvent}
+	}
+	close(events)
+	<-done
+	script.mutex.Unlock()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		script.mutex.Lock()
+		handled := script.state.GetGlobal("handled").(*lua.LTable).Len()
+		script.mutex.Unlock()
+		if handled == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 3 handled events, got %d", handled)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestResolveScriptPath(t *testing.T) {
+	scriptPath := writeTestScript(t, "")
+	resolved, err := resolveScriptPath(scriptPath)
+	if err != nil || resolved != scriptPath {
+		t.Fatalf("failed to resolve %s: %v", scriptPath, err)
+	}
+	resolved, err = resolveScriptPath(scriptPath[:len(scriptPath)-len(ScriptExt)])
+	if err != nil || resolved != scriptPath {
+		t.Fatalf("failed to resolve %s without the extension: %v", scriptPath, err)
+	}
+	_, err = resolveScriptPath(filepath.Join(filepath.Dir(scriptPath), "missing"))
+	if err == nil {
+		t.Fatalf("expected an error for a missing script")
+	}
+}
@@ -0,0 +1,39 @@
+package scripts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// ScriptsUnloadCmd - Unload a script, removing its event handlers
+func ScriptsUnloadCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	loadedScriptsMutex.Lock()
+	script, ok := loadedScripts[args[0]]
+	delete(loadedScripts, args[0])
+	loadedScriptsMutex.Unlock()
+	if !ok {
+		con.PrintErrorf("No script named '%s' is loaded\n", args[0])
+		return
+	}
+	script.Close()
+	con.PrintInfof("Unloaded script %s\n", script.Name)
+}
@@ -29,44 +29,76 @@ import (
 
 	"github.com/bishopfox/sliver/client/command/alias"
 	"github.com/bishopfox/sliver/client/command/armory"
+	"github.com/bishopfox/sliver/client/command/automation"
 	"github.com/bishopfox/sliver/client/command/beacons"
+	"github.com/bishopfox/sliver/client/command/bloodhound"
 	"github.com/bishopfox/sliver/client/command/builders"
+	"github.com/bishopfox/sliver/client/command/capture"
+	"github.com/bishopfox/sliver/client/command/connect"
 	"github.com/bishopfox/sliver/client/command/crack"
 	"github.com/bishopfox/sliver/client/command/creds"
 	"github.com/bishopfox/sliver/client/command/exit"
+	"github.com/bishopfox/sliver/client/command/federation"
 	"github.com/bishopfox/sliver/client/command/generate"
+	"github.com/bishopfox/sliver/client/command/graph"
 	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/command/hosts"
 	"github.com/bishopfox/sliver/client/command/info"
 	"github.com/bishopfox/sliver/client/command/jobs"
 	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/command/macros"
 	"github.com/bishopfox/sliver/client/command/monitor"
 	"github.com/bishopfox/sliver/client/command/operators"
+	"github.com/bishopfox/sliver/client/command/panes"
+	"github.com/bishopfox/sliver/client/command/plugins"
+	"github.com/bishopfox/sliver/client/command/preflight"
 	operator "github.com/bishopfox/sliver/client/command/prelude-operator"
 	"github.com/bishopfox/sliver/client/command/reaction"
+	"github.com/bishopfox/sliver/client/command/replay"
+	"github.com/bishopfox/sliver/client/command/scripts"
 	"github.com/bishopfox/sliver/client/command/sessions"
+	"github.com/bishopfox/sliver/client/command/serverconfig"
 	"github.com/bishopfox/sliver/client/command/settings"
 	sgn "github.com/bishopfox/sliver/client/command/shikata-ga-nai"
 	"github.com/bishopfox/sliver/client/command/taskmany"
+	"github.com/bishopfox/sliver/client/command/toolchains"
 	"github.com/bishopfox/sliver/client/command/update"
 	"github.com/bishopfox/sliver/client/command/use"
 	"github.com/bishopfox/sliver/client/command/websites"
 	"github.com/bishopfox/sliver/client/command/wireguard"
 	client "github.com/bishopfox/sliver/client/console"
 	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/client/credentials"
 	"github.com/bishopfox/sliver/client/licenses"
 )
 
+// ServerCommandsFactory returns the server commands for any console, the
+// commands run in the background are bound to consoles of their own.
+func ServerCommandsFactory(serverCmds func() []*cobra.Command) client.CommandsFactory {
+	return func(con *client.SliverConsoleClient) console.Commands {
+		return ServerCommands(con, serverCmds)
+	}
+}
+
 // ServerCommands returns all commands bound to the server menu, optionally
 // accepting a function returning a list of additional (admin) commands.
 func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.Command) console.Commands {
+	// The trees are rebuilt before each command, plugins are only loaded once
+	plugins.LoadInstalledPlugins(con)
+
 	serverCommands := func() *cobra.Command {
 		server := &cobra.Command{
 			Short: "Server commands",
 			CompletionOptions: cobra.CompletionOptions{
 				HiddenDefaultCmd: true,
 			},
+			PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+				con.SetJSONOutput(cmd)
+			},
 		}
+		Flags("", true, server, func(f *pflag.FlagSet) {
+			f.Bool(client.JSONFlagStr, false, "print json instead of tables (supported commands only)")
+		})
 
 		// Load Reactions
 		n, err := reaction.LoadReactions()
@@ -82,6 +114,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			{ID: consts.NetworkHelpGroup, Title: consts.NetworkHelpGroup},
 			{ID: consts.PayloadsHelpGroup, Title: consts.PayloadsHelpGroup},
 			{ID: consts.SliverHelpGroup, Title: consts.SliverHelpGroup},
+			{ID: consts.PluginHelpGroup, Title: consts.PluginHelpGroup},
 		}
 		server.AddGroup(groups...)
 
@@ -146,6 +179,57 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(aliasRemove).PositionalCompletion(alias.AliasCompleter())
 		aliasCmd.AddCommand(aliasRemove)
 
+		// [ Plugins ] ---------------------------------------------
+
+		pluginsCmd := &cobra.Command{
+			Use:   consts.PluginsStr,
+			Short: "List loaded client plugins",
+			Long:  help.GetHelpFor([]string{consts.PluginsStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				plugins.PluginsCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		server.AddCommand(pluginsCmd)
+
+		pluginsLoadCmd := &cobra.Command{
+			Use:   consts.LoadStr + " [PLUGIN]",
+			Short: "Load a plugin for this client session",
+			Long:  help.GetHelpFor([]string{consts.PluginsStr, consts.LoadStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				plugins.PluginsLoadCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(pluginsLoadCmd).PositionalCompletion(
+			carapace.ActionDirectories().Tag("plugin directory").Usage("path to the plugin directory"))
+		pluginsCmd.AddCommand(pluginsLoadCmd)
+
+		pluginsInstallCmd := &cobra.Command{
+			Use:   consts.InstallStr + " [PLUGIN]",
+			Short: "Install a plugin",
+			Long:  help.GetHelpFor([]string{consts.PluginsStr, consts.InstallStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				plugins.PluginsInstallCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(pluginsInstallCmd).PositionalCompletion(
+			carapace.ActionDirectories().Tag("plugin directory").Usage("path to the plugin directory"))
+		pluginsCmd.AddCommand(pluginsInstallCmd)
+
+		pluginsRemoveCmd := &cobra.Command{
+			Use:   consts.RmStr + " [PLUGIN]",
+			Short: "Remove a plugin",
+			Long:  help.GetHelpFor([]string{consts.PluginsStr, consts.RmStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				plugins.PluginsRemoveCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(pluginsRemoveCmd).PositionalCompletion(plugins.PluginCompleter())
+		pluginsCmd.AddCommand(pluginsRemoveCmd)
+
 		// [ Armory ] ---------------------------------------------
 
 		armoryCmd := &cobra.Command{
@@ -166,7 +250,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		server.AddCommand(armoryCmd)
 
 		armoryInstallCmd := &cobra.Command{
-			Use:   consts.InstallStr,
+			Use:   consts.InstallStr + " [PACKAGE[@VERSION]]",
 			Short: "Install an alias or extension",
 			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.InstallStr}),
 			Args:  cobra.ExactArgs(1),
@@ -186,8 +270,47 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 				armory.ArmoryUpdateCmd(cmd, con, args)
 			},
 		}
+		Flags("update", false, armoryUpdateCmd, func(f *pflag.FlagSet) {
+			f.BoolP("dry-run", "n", false, "only show what the updates would change")
+		})
 		armoryCmd.AddCommand(armoryUpdateCmd)
 
+		armoryPinCmd := &cobra.Command{
+			Use:   consts.PinStr + " [PACKAGE]",
+			Short: "Pin a package to its installed version, or list pinned packages",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.PinStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryPinCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(armoryPinCmd).PositionalCompletion(armory.InstalledPackageCompleter())
+		armoryCmd.AddCommand(armoryPinCmd)
+
+		armoryUnpinCmd := &cobra.Command{
+			Use:   consts.UnpinStr + " [PACKAGE]",
+			Short: "Allow updates of a pinned package",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.UnpinStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryUnpinCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(armoryUnpinCmd).PositionalCompletion(armory.InstalledPackageCompleter())
+		armoryCmd.AddCommand(armoryUnpinCmd)
+
+		armoryRollbackCmd := &cobra.Command{
+			Use:   consts.RollbackStr + " [PACKAGE]",
+			Short: "Roll a package back to the version its last install replaced",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.RollbackStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryRollbackCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(armoryRollbackCmd).PositionalCompletion(armory.InstalledPackageCompleter())
+		armoryCmd.AddCommand(armoryRollbackCmd)
+
 		armorySearchCmd := &cobra.Command{
 			Use:   consts.SearchStr,
 			Short: "Search for aliases and extensions by name (regex)",
@@ -200,6 +323,62 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(armorySearchCmd).PositionalCompletion(carapace.ActionValues().Usage("a name regular expression"))
 		armoryCmd.AddCommand(armorySearchCmd)
 
+		armoryPublishCmd := &cobra.Command{
+			Use:   consts.PublishStr + " [PACKAGE]",
+			Short: "Sign and publish an alias or extension to the server's private armory",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.PublishStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryPublishCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(armoryPublishCmd).PositionalCompletion(
+			carapace.ActionFiles().Usage("package directory or .tar.gz"))
+		armoryCmd.AddCommand(armoryPublishCmd)
+
+		armoryHostedCmd := &cobra.Command{
+			Use:   consts.HostedStr,
+			Short: "List the packages published to the server's private armory",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.HostedStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryHostedCmd(cmd, con, args)
+			},
+		}
+		armoryCmd.AddCommand(armoryHostedCmd)
+
+		armoryUnpublishCmd := &cobra.Command{
+			Use:   consts.UnpublishStr + " [COMMAND] [VERSION]",
+			Short: "Remove a package from the server's private armory",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.UnpublishStr}),
+			Args:  cobra.RangeArgs(1, 2),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryUnpublishCmd(cmd, con, args)
+			},
+		}
+		armoryCmd.AddCommand(armoryUnpublishCmd)
+
+		armoryServeCmd := &cobra.Command{
+			Use:   consts.ServeStr,
+			Short: "Start a listener serving the server's private armory",
+			Long:  help.GetHelpFor([]string{consts.ArmoryStr, consts.ServeStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				armory.ArmoryServeCmd(cmd, con, args)
+			},
+		}
+		Flags("serve", false, armoryServeCmd, func(f *pflag.FlagSet) {
+			f.StringP("lhost", "L", "", "interface to bind server to")
+			f.Uint32P("lport", "l", 8443, "tcp listen port")
+			f.BoolP("secure", "s", false, "serve over https (a self-signed certificate is used without --cert/--key)")
+			f.StringP("cert", "C", "", "PEM encoded certificate file")
+			f.StringP("key", "k", "", "PEM encoded private key file")
+			f.StringP("authorization", "a", "", "require this Authorization header value")
+		})
+		carapace.Gen(armoryServeCmd).FlagCompletion(carapace.ActionMap{
+			"cert": carapace.ActionFiles().Tag("certificate file"),
+			"key":  carapace.ActionFiles().Tag("key file"),
+		})
+		armoryCmd.AddCommand(armoryServeCmd)
+
 		// [ Update ] --------------------------------------------------------------
 
 		updateCmd := &cobra.Command{
@@ -251,10 +430,14 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		Flags("jobs", false, jobsCmd, func(f *pflag.FlagSet) {
 			f.Int32P("kill", "k", -1, "kill a background job")
 			f.BoolP("kill-all", "K", false, "kill all jobs")
+			f.Int32P("drain", "d", -1, "stop a listener from accepting new implants")
+			f.Int32P("undrain", "u", -1, "let a draining listener accept new implants again")
 			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 		FlagComps(jobsCmd, func(comp *carapace.ActionMap) {
 			(*comp)["kill"] = jobs.JobsIDCompleter(con)
+			(*comp)["drain"] = jobs.JobsIDCompleter(con)
+			(*comp)["undrain"] = jobs.JobsIDCompleter(con)
 		})
 		server.AddCommand(jobsCmd)
 
@@ -271,9 +454,34 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("lhost", "L", "", "interface to bind server to")
 			f.Uint32P("lport", "l", generate.DefaultMTLSLPort, "tcp listen port")
 			f.BoolP("persistent", "p", false, "make persistent across restarts")
+			f.Bool("spa", false, "drop connections from addresses that haven't sent a single packet authorization knock")
+			f.UintSlice("knock-ports", []uint{}, "udp ports implants knock on in order with --spa (default: the listener's port)")
+			f.StringP("listener-profile", "", "", "add listener to a listener profile instead of starting it")
+		})
+		FlagComps(mtlsCmd, func(comp *carapace.ActionMap) {
+			(*comp)["listener-profile"] = jobs.ListenerProfileNameCompleter(con)
 		})
 		server.AddCommand(mtlsCmd)
 
+		extC2Cmd := &cobra.Command{
+			Use:   consts.ExternalC2Str,
+			Short: "Start a Cobalt Strike External C2 listener",
+			Long:  help.GetHelpFor([]string{consts.ExternalC2Str}),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.ExternalC2ListenerCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		Flags("External C2 listener", false, extC2Cmd, func(f *pflag.FlagSet) {
+			f.StringP("lhost", "L", "", "interface to bind server to")
+			f.Uint32P("lport", "l", generate.DefaultExternalC2LPort, "tcp listen port")
+			f.StringP("stage", "s", "", "path to the stage (shellcode) sent to controllers")
+		})
+		FlagComps(extC2Cmd, func(comp *carapace.ActionMap) {
+			(*comp)["stage"] = carapace.ActionFiles().Tag("stage file")
+		})
+		server.AddCommand(extC2Cmd)
+
 		wgCmd := &cobra.Command{
 			Use:   consts.WGStr,
 			Short: "Start a WireGuard listener",
@@ -289,6 +497,10 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.Uint32P("nport", "n", generate.DefaultWGNPort, "virtual tun interface listen port")
 			f.Uint32P("key-port", "x", generate.DefaultWGKeyExPort, "virtual tun interface key exchange port")
 			f.BoolP("persistent", "p", false, "make persistent across restarts")
+			f.StringP("listener-profile", "", "", "add listener to a listener profile instead of starting it")
+		})
+		FlagComps(wgCmd, func(comp *carapace.ActionMap) {
+			(*comp)["listener-profile"] = jobs.ListenerProfileNameCompleter(con)
 		})
 		server.AddCommand(wgCmd)
 
@@ -307,7 +519,12 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("lhost", "L", "", "interface to bind server to")
 			f.Uint32P("lport", "l", generate.DefaultDNSLPort, "udp listen port")
 			f.BoolP("disable-otp", "D", false, "disable otp authentication")
+			f.StringP("replay-window", "", "10m", "reject key exchanges timestamped further than this from the server's clock (dns implants don't correct for clock skew), 0 disables")
 			f.BoolP("persistent", "p", false, "make persistent across restarts")
+			f.StringP("listener-profile", "", "", "add listener to a listener profile instead of starting it")
+		})
+		FlagComps(dnsCmd, func(comp *carapace.ActionMap) {
+			(*comp)["listener-profile"] = jobs.ListenerProfileNameCompleter(con)
 		})
 		server.AddCommand(dnsCmd)
 
@@ -328,7 +545,19 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("disable-otp", "D", false, "disable otp authentication")
 			f.StringP("long-poll-timeout", "T", "1s", "server-side long poll timeout")
 			f.StringP("long-poll-jitter", "J", "2s", "server-side long poll jitter")
+			f.StringP("replay-window", "", "10m", "reject replayed key exchanges and nonces within this window, 0 disables")
+			f.StringSliceP("allow-cidr", "", []string{}, "only serve c2 to clients in these cidr(s)")
+			f.StringSliceP("deny-cidr", "", []string{}, "serve decoy content to clients in these cidr(s)")
+			f.StringSliceP("allow-country", "", []string{}, "only serve c2 to clients from these countries (requires a geoip database)")
+			f.StringSliceP("deny-country", "", []string{}, "serve decoy content to clients from these countries (requires a geoip database)")
+			f.Uint32P("rate-limit", "", 0, "max requests per minute per client, 0 is unlimited")
+			f.StringP("ban", "", "10m", "ban clients that exceed the rate limit for this long")
+			f.BoolP("trust-proxy-headers", "", false, "apply rules to the X-Real-Ip/X-Forwarded-For address (e.g. behind a redirector)")
 			f.BoolP("persistent", "p", false, "make persistent across restarts")
+			f.StringP("listener-profile", "", "", "add listener to a listener profile instead of starting it")
+		})
+		FlagComps(httpCmd, func(comp *carapace.ActionMap) {
+			(*comp)["listener-profile"] = jobs.ListenerProfileNameCompleter(con)
 		})
 		server.AddCommand(httpCmd)
 
@@ -349,16 +578,225 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("disable-otp", "D", false, "disable otp authentication")
 			f.StringP("long-poll-timeout", "T", "1s", "server-side long poll timeout")
 			f.StringP("long-poll-jitter", "J", "2s", "server-side long poll jitter")
+			f.StringP("replay-window", "", "10m", "reject replayed key exchanges and nonces within this window, 0 disables")
 
 			f.StringP("cert", "c", "", "PEM encoded certificate file")
 			f.StringP("key", "k", "", "PEM encoded private key file")
 			f.BoolP("lets-encrypt", "e", false, "attempt to provision a let's encrypt certificate")
+			f.StringP("acme-dns", "", "", "use dns-01 challenges with a dns provider (route53, cloudflare)")
+			f.StringSliceP("acme-domains", "", []string{}, "certificate names for dns-01, may include wildcards (default: --domain)")
 			f.BoolP("disable-randomized-jarm", "E", false, "disable randomized jarm fingerprints")
+			f.BoolP("require-client-cert", "", false, "require implants to present their certificate (see generate --http-client-cert)")
+
+			f.StringSliceP("allow-cidr", "", []string{}, "only serve c2 to clients in these cidr(s)")
+			f.StringSliceP("deny-cidr", "", []string{}, "serve decoy content to clients in these cidr(s)")
+			f.StringSliceP("allow-country", "", []string{}, "only serve c2 to clients from these countries (requires a geoip database)")
+			f.StringSliceP("deny-country", "", []string{}, "serve decoy content to clients from these countries (requires a geoip database)")
+			f.Uint32P("rate-limit", "", 0, "max requests per minute per client, 0 is unlimited")
+			f.StringP("ban", "", "10m", "ban clients that exceed the rate limit for this long")
+			f.BoolP("trust-proxy-headers", "", false, "apply rules to the X-Real-Ip/X-Forwarded-For address (e.g. behind a redirector)")
 
 			f.BoolP("persistent", "p", false, "make persistent across restarts")
+			f.StringP("listener-profile", "", "", "add listener to a listener profile instead of starting it")
+		})
+		FlagComps(httpsCmd, func(comp *carapace.ActionMap) {
+			(*comp)["listener-profile"] = jobs.ListenerProfileNameCompleter(con)
+			(*comp)["acme-dns"] = carapace.ActionValues("route53", "cloudflare").Tag("dns providers")
 		})
 		server.AddCommand(httpsCmd)
 
+		listenersCmd := &cobra.Command{
+			Use:   consts.ListenersStr,
+			Short: "Manage listener profiles",
+			Long:  help.GetHelpFor([]string{consts.ListenersStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.ListenerProfilesCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		Flags("listeners", true, listenersCmd, func(f *pflag.FlagSet) {
+			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		server.AddCommand(listenersCmd)
+
+		listenersNewCmd := &cobra.Command{
+			Use:   consts.NewStr,
+			Short: "Create a listener profile or update its settings",
+			Long:  help.GetHelpFor([]string{consts.ListenersStr, consts.NewStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.ListenerProfilesNewCmd(cmd, con, args)
+			},
+		}
+		Flags("listeners", false, listenersNewCmd, func(f *pflag.FlagSet) {
+			f.BoolP("autostart", "a", false, "start the profile when the server starts")
+			f.Int32P("order", "o", 0, "start order relative to other profiles")
+			f.StringP("depends-on", "d", "", "comma separated list of profiles to start first")
+		})
+		carapace.Gen(listenersNewCmd).PositionalCompletion(jobs.ListenerProfileNameCompleter(con))
+		listenersCmd.AddCommand(listenersNewCmd)
+
+		listenersRmCmd := &cobra.Command{
+			Use:   consts.RmStr,
+			Short: "Remove a listener profile",
+			Long:  help.GetHelpFor([]string{consts.ListenersStr, consts.RmStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.ListenerProfilesRmCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(listenersRmCmd).PositionalCompletion(jobs.ListenerProfileNameCompleter(con))
+		listenersCmd.AddCommand(listenersRmCmd)
+
+		listenersStartCmd := &cobra.Command{
+			Use:   consts.StartStr,
+			Short: "Start a listener profile and its dependencies",
+			Long:  help.GetHelpFor([]string{consts.ListenersStr, consts.StartStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.ListenerProfilesStartCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(listenersStartCmd).PositionalCompletion(jobs.ListenerProfileNameCompleter(con))
+		listenersCmd.AddCommand(listenersStartCmd)
+
+		listenersStopCmd := &cobra.Command{
+			Use:   consts.StopStr,
+			Short: "Stop all jobs started from a listener profile",
+			Long:  help.GetHelpFor([]string{consts.ListenersStr, consts.StopStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.ListenerProfilesStopCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(listenersStopCmd).PositionalCompletion(jobs.ListenerProfileNameCompleter(con))
+		listenersCmd.AddCommand(listenersStopCmd)
+
+		httpC2Cmd := &cobra.Command{
+			Use:   consts.HTTPC2Str,
+			Short: "View or update the HTTP C2 config",
+			Long:  help.GetHelpFor([]string{consts.HTTPC2Str}),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.HTTPC2Cmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		Flags("http-c2", true, httpC2Cmd, func(f *pflag.FlagSet) {
+			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		Flags("http-c2", false, httpC2Cmd, func(f *pflag.FlagSet) {
+			f.StringP("save", "s", "", "save the config to a local file")
+		})
+		FlagComps(httpC2Cmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles("json").Tag("config file")
+		})
+		server.AddCommand(httpC2Cmd)
+
+		httpC2SaveCmd := &cobra.Command{
+			Use:   consts.SaveStr,
+			Short: "Save a new version of the HTTP C2 config and apply it to running listeners",
+			Long:  help.GetHelpFor([]string{consts.HTTPC2Str, consts.SaveStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.HTTPC2SaveCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(httpC2SaveCmd).PositionalCompletion(carapace.ActionFiles("json").Tag("config file"))
+		httpC2Cmd.AddCommand(httpC2SaveCmd)
+
+		httpC2ReloadCmd := &cobra.Command{
+			Use:   consts.ReloadStr,
+			Short: "Apply the HTTP C2 config on the server to running listeners",
+			Long:  help.GetHelpFor([]string{consts.HTTPC2Str, consts.ReloadStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.HTTPC2ReloadCmd(cmd, con, args)
+			},
+		}
+		httpC2Cmd.AddCommand(httpC2ReloadCmd)
+
+		httpC2RedirectorCmd := &cobra.Command{
+			Use:   consts.RedirectorStr,
+			Short: "Generate a redirector config that only forwards HTTP C2 profile traffic",
+			Long:  help.GetHelpFor([]string{consts.HTTPC2Str, consts.RedirectorStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.HTTPC2RedirectorCmd(cmd, con, args)
+			},
+		}
+		Flags("redirector", false, httpC2RedirectorCmd, func(f *pflag.FlagSet) {
+			f.StringP("upstream", "u", "", "url of the sliver http(s) listener, e.g. https://10.0.0.1:443")
+			f.StringP("decoy", "d", "", "url of a decoy site for requests that do not match the profile (default: 404)")
+			f.StringP("server-name", "n", "", "redirector domain name")
+			f.StringP("path-prefix", "P", "", "path of the implant's c2 url, if any")
+			f.Uint32P("port", "p", 80, "redirector listen port")
+			f.Int32P("version", "V", 0, "http c2 config version (default: current)")
+			f.StringP("save", "s", "", "save the config to a local file")
+		})
+		FlagComps(httpC2RedirectorCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles().Tag("config file")
+		})
+		carapace.Gen(httpC2RedirectorCmd).PositionalCompletion(jobs.RedirectorTypeCompleter())
+		httpC2Cmd.AddCommand(httpC2RedirectorCmd)
+
+		reloadConfigCmd := &cobra.Command{
+			Use:   consts.ReloadConfigStr,
+			Short: "Reload the server config without restarting the server",
+			Long:  help.GetHelpFor([]string{consts.ReloadConfigStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				serverconfig.ReloadConfigCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		Flags("reload-config", true, reloadConfigCmd, func(f *pflag.FlagSet) {
+			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		server.AddCommand(reloadConfigCmd)
+
+		captureCmd := &cobra.Command{
+			Use:   consts.CaptureStr,
+			Short: "Show decrypted envelopes captured for debugging transports",
+			Long:  help.GetHelpFor([]string{consts.CaptureStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				capture.CaptureCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		Flags("capture", true, captureCmd, func(f *pflag.FlagSet) {
+			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		Flags("capture", false, captureCmd, func(f *pflag.FlagSet) {
+			f.StringP("session", "i", "", "only show envelopes of this session or beacon")
+			f.Uint32P("limit", "l", 50, "number of most recent envelopes to show (0 is all of them)")
+			f.BoolP("payloads", "p", false, "show a hex dump of the envelope payloads")
+		})
+		server.AddCommand(captureCmd)
+
+		captureStartCmd := &cobra.Command{
+			Use:   consts.StartStr,
+			Short: "Start capturing envelopes",
+			Long:  help.GetHelpFor([]string{consts.CaptureStr, consts.StartStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				capture.CaptureStartCmd(cmd, con, args)
+			},
+		}
+		Flags("capture", false, captureStartCmd, func(f *pflag.FlagSet) {
+			f.BoolP("payloads", "p", false, "keep the decrypted envelope payloads, not just the metadata")
+			f.Uint32P("max-entries", "m", 0, "size of the ring buffer (default 1000)")
+			f.BoolP("file", "f", false, "also append envelopes to a file on the server")
+			f.StringP("session", "i", "", "only capture envelopes of this session or beacon")
+		})
+		captureCmd.AddCommand(captureStartCmd)
+
+		captureStopCmd := &cobra.Command{
+			Use:   consts.StopStr,
+			Short: "Stop capturing envelopes",
+			Long:  help.GetHelpFor([]string{consts.CaptureStr, consts.StopStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				capture.CaptureStopCmd(cmd, con, args)
+			},
+		}
+		captureCmd.AddCommand(captureStopCmd)
+
 		stageCmd := &cobra.Command{
 			Use:   consts.StageListenerStr,
 			Short: "Start a stager listener",
@@ -369,7 +807,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			GroupID: consts.NetworkHelpGroup,
 		}
 		Flags("stage listener", false, stageCmd, func(f *pflag.FlagSet) {
-			f.StringP("profile", "p", "", "implant profile name to link with the listener")
+			f.StringP("profile", "p", "", "implant profile to link with the listener (name or name@version)")
 			f.StringP("url", "u", "", "URL to which the stager will call back to")
 			f.StringP("cert", "c", "", "path to PEM encoded certificate file (HTTPS only)")
 			f.StringP("key", "k", "", "path to PEM encoded private key file (HTTPS only)")
@@ -379,6 +817,10 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.String("rc4-encrypt-key", "", "encrypt stage with RC4 encryption key")
 			f.StringP("compress", "C", "none", "compress the stage before encrypting (zlib, gzip, deflate9, none)")
 			f.BoolP("prepend-size", "P", false, "prepend the size of the stage to the payload (to use with MSF stagers)")
+			f.BoolP("one-time", "1", false, "only serve the stage once")
+			f.Uint32P("max-fetches", "m", 0, "maximum number of times the stage is served (0 is unlimited)")
+			f.StringP("expires", "E", "", "stop serving the stage after a duration (e.g. 30m, 2h)")
+			f.StringSliceP("allow-ip", "a", []string{}, "only serve the stage to these addresses or cidrs")
 		})
 		FlagComps(stageCmd, func(comp *carapace.ActionMap) {
 			(*comp)["profile"] = generate.ProfileNameCompleter(con)
@@ -388,6 +830,22 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		})
 		server.AddCommand(stageCmd)
 
+		stageFetchesCmd := &cobra.Command{
+			Use:   consts.FetchesStr,
+			Short: "List every request made to stage listeners",
+			Long:  help.GetHelpFor([]string{consts.StageListenerStr, consts.FetchesStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				jobs.StageFetchesCmd(cmd, con, args)
+			},
+		}
+		Flags("fetches", false, stageFetchesCmd, func(f *pflag.FlagSet) {
+			f.Uint32P("job", "j", 0, "only show requests to this job")
+		})
+		FlagComps(stageFetchesCmd, func(comp *carapace.ActionMap) {
+			(*comp)["job"] = jobs.JobsIDCompleter(con)
+		})
+		stageCmd.AddCommand(stageFetchesCmd)
+
 		// [ Operators ] --------------------------------------------------------------
 
 		operatorsCmd := &cobra.Command{
@@ -404,6 +862,20 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		})
 		server.AddCommand(operatorsCmd)
 
+		presenceCmd := &cobra.Command{
+			Use:   consts.PresenceStr,
+			Short: "Show which operators are interacting with sessions and beacons",
+			Long:  help.GetHelpFor([]string{consts.PresenceStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				operators.PresenceCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		Flags("presence", false, presenceCmd, func(f *pflag.FlagSet) {
+			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		server.AddCommand(presenceCmd)
+
 		// Server-only commands.
 		if serverCmds != nil {
 			server.AddGroup(&cobra.Group{ID: consts.MultiplayerHelpGroup, Title: consts.MultiplayerHelpGroup})
@@ -431,7 +903,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("clean", "C", false, "clean out any sessions marked as [DEAD]")
 			f.BoolP("force", "F", false, "force session action without waiting for results")
 
-			f.StringP("filter", "f", "", "filter sessions by substring")
+			f.StringP("filter", "f", "", "filter sessions by substring, or by tag:<tag>, note:<text>, or color:<color>")
 			f.StringP("filter-re", "e", "", "filter sessions by regular expression")
 		})
 		FlagComps(sessionsCmd, func(comp *carapace.ActionMap) {
@@ -453,6 +925,28 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		})
 		sessionsCmd.AddCommand(sessionsPruneCmd)
 
+		// [ Graph ] --------------------------------------------------------------
+
+		graphCmd := &cobra.Command{
+			Use:   consts.GraphStr,
+			Short: "Show listeners, sessions, and pivots as a topology",
+			Long:  help.GetHelpFor([]string{consts.GraphStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				graph.GraphCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverHelpGroup,
+		}
+		Flags("graph", false, graphCmd, func(f *pflag.FlagSet) {
+			f.IntP("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+			f.StringP("format", "f", "text", "output format (text, dot, html)")
+			f.StringP("save", "s", "", "save the graph to a file")
+		})
+		FlagComps(graphCmd, func(comp *carapace.ActionMap) {
+			(*comp)["format"] = carapace.ActionValues("text", "dot", "html")
+			(*comp)["save"] = carapace.ActionFiles()
+		})
+		server.AddCommand(graphCmd)
+
 		// [ Use ] --------------------------------------------------------------
 
 		useCmd := &cobra.Command{
@@ -470,6 +964,27 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(useCmd).PositionalCompletion(use.BeaconAndSessionIDCompleter(con))
 		server.AddCommand(useCmd)
 
+		// [ Connect ] ----------------------------------------------------------
+
+		connectCmd := &cobra.Command{
+			Use:   consts.ConnectStr,
+			Short: "Switch to another server (config profile)",
+			Long:  help.GetHelpFor([]string{consts.ConnectStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				connect.ConnectCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		Flags("", false, connectCmd, func(f *pflag.FlagSet) {
+			f.StringP("close", "x", "", "disconnect from a background server")
+		})
+		FlagComps(connectCmd, func(comp *carapace.ActionMap) {
+			(*comp)["close"] = connect.ConnectionCompleter(con)
+		})
+		carapace.Gen(connectCmd).PositionalCompletion(connect.ProfileCompleter())
+		server.AddCommand(connectCmd)
+
 		useSessionCmd := &cobra.Command{
 			Use:   consts.SessionsStr,
 			Short: "Switch the active session",
@@ -566,6 +1081,15 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 				settings.SettingsConsoleLogs(ctx, con)
 			},
 		})
+		settingsCmd.AddCommand(&cobra.Command{
+			Use:   consts.AutoLootStr,
+			Short: "Save command output larger than a size as loot and only show a preview",
+			Long:  help.GetHelpFor([]string{consts.SettingsStr, consts.AutoLootStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				settings.SettingsAutoLootCmd(cmd, con, args)
+			},
+		})
 		server.AddCommand(settingsCmd)
 
 		// [ Info ] --------------------------------------------------------------
@@ -635,6 +1159,8 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("skip-symbols", "l", false, "skip symbol obfuscation")
 			f.StringP("template", "I", "sliver", "implant code template")
 			f.BoolP("external-builder", "E", false, "use an external builder")
+			f.Int32P("build-priority", "", 0, "external build queue priority, higher priority builds start first")
+			f.BoolP("no-cache", "", false, "always start a new external build, even if an identical build exists")
 			f.BoolP("disable-sgn", "G", false, "disable shikata ga nai shellcode encoder")
 
 			f.StringP("canary", "c", "", "canary domain(s)")
@@ -645,6 +1171,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("dns", "n", "", "dns connection strings")
 			f.StringP("named-pipe", "p", "", "named-pipe connection strings")
 			f.StringP("tcp-pivot", "i", "", "tcp-pivot connection strings")
+			f.StringP("extc2", "", "", "extc2 connection strings (local address the channel client connects to)")
 
 			f.Uint32P("key-exchange", "X", generate.DefaultWGKeyExPort, "wg key-exchange port")
 			f.Uint32P("tcp-comms", "T", generate.DefaultWGNPort, "wg c2 comms port")
@@ -652,6 +1179,23 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("run-at-load", "R", false, "run the implant entrypoint from DllMain/Constructor (shared library only)")
 			f.BoolP("netgo", "q", false, "force the use of netgo")
 			f.StringP("traffic-encoders", "A", "", "comma separated list of traffic encoders to enable")
+			f.Int32("http-c2-version", 0, "http c2 config version to generate with (0 is the current version)")
+			f.String("dns-resolvers", "", "comma separated resolvers of the implant's name resolution (ip[:port] or 'c2')")
+			f.Bool("compress", false, "zstd compress c2 messages larger than 1 KiB")
+			f.Bool("spa", false, "send a single packet authorization knock before each mtls connection")
+			f.String("http-driver", "", "http(s) c2 driver (go, wininet, winhttp), winhttp works when running as a service")
+			f.String("proxy-mode", "", "http(s) c2 proxy discovery (auto, manual, none), auto uses WPAD/PAC files (default: auto)")
+			f.Bool("http-client-cert", false, "present the implant's certificate to https c2s (see https --require-client-cert)")
+			f.String("kex", "", "http/dns/pivot session key exchange (x25519-mlkem768, x25519), defaults to x25519-mlkem768")
+			f.String("config-key-source", "", "encrypt the c2 urls and http c2 profile with a key the implant gets at runtime (arg, env:NAME, file:PATH, url:URL, or bundle)")
+			f.String("config-key", "", "key of the encrypted c2 config (default: random)")
+			f.Uint32("dead-man-days", 0, "clean up and exit after this many days without reaching any c2 (0 is disabled)")
+			f.String("dead-man-state", "", "file that keeps the time of the last c2 contact across restarts")
+			f.StringArray("dead-man-cleanup", []string{}, "persistence to remove before a dead man's switch exit (file:PATH, reg:HIVE\\KEY\\VALUE, or self), can be repeated")
+			f.Bool("reproducible", false, "build the same file every time the implant is rebuilt (verify with 'implants verify')")
+			f.Bool("marker", false, "embed a unique marker to trace leaked samples back to the build (see 'implants lookup')")
+			f.String("cc-backend", "", "c cross-compiler of shared library builds (system, zig), defaults to the first one found")
+			f.String("glibc", "", "oldest glibc a zig built linux shared library runs on, e.g. 2.17 (default: static musl)")
 
 			f.StringP("strategy", "Z", "", "specify a connection strategy (r = random, rd = random domain, s = sequential)")
 			f.Int64P("reconnect", "j", generate.DefaultReconnect, "attempt to reconnect every n second(s)")
@@ -667,13 +1211,18 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 
 			f.StringP("format", "f", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' (for dynamic libraries), 'service' (see: `psexec` for more info) and 'shellcode' (windows only)")
 			f.StringP("save", "s", "", "directory/file to the binary to")
+			f.String("profile", "", "generate from a saved implant profile (name or name@version)")
 		})
 		FlagComps(generateCmd, func(comp *carapace.ActionMap) {
+			(*comp)["profile"] = generate.ProfileNameCompleter(con)
 			(*comp)["debug-file"] = carapace.ActionFiles()
 			(*comp)["os"] = generate.OSCompleter(con)
 			(*comp)["arch"] = generate.ArchCompleter(con)
 			(*comp)["strategy"] = carapace.ActionValuesDescribed([]string{"r", "random", "rd", "random domain", "s", "sequential"}...).Tag("C2 strategy")
+			(*comp)["kex"] = carapace.ActionValuesDescribed([]string{"x25519-mlkem768", "hybrid post-quantum", "x25519", "legacy"}...).Tag("key exchanges")
 			(*comp)["format"] = generate.FormatCompleter()
+			(*comp)["http-driver"] = carapace.ActionValues(generate.HTTPDrivers...).Tag("http drivers")
+			(*comp)["proxy-mode"] = carapace.ActionValues(generate.ProxyModes...).Tag("proxy modes")
 			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save implant")
 		})
 		server.AddCommand(generateCmd)
@@ -692,6 +1241,9 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.Int64P("minutes", "M", 0, "beacon interval minutes")
 			f.Int64P("seconds", "S", 60, "beacon interval seconds")
 			f.Int64P("jitter", "J", 30, "beacon interval jitter in seconds")
+			f.Bool("adaptive", false, "check in less often while the host is idle, locked, or on battery")
+			f.Int64("idle-threshold", 300, "seconds without user input after which the host is idle (with --adaptive)")
+			f.Uint32("idle-factor", 4, "beacon interval multiplier while the host is idle or locked (with --adaptive)")
 
 			// Generate flags
 			f.StringP("os", "o", "windows", "operating system")
@@ -703,6 +1255,8 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("skip-symbols", "l", false, "skip symbol obfuscation")
 			f.StringP("template", "I", "sliver", "implant code template")
 			f.BoolP("external-builder", "E", false, "use an external builder")
+			f.Int32P("build-priority", "", 0, "external build queue priority, higher priority builds start first")
+			f.BoolP("no-cache", "", false, "always start a new external build, even if an identical build exists")
 			f.BoolP("disable-sgn", "G", false, "disable shikata ga nai shellcode encoder")
 
 			f.StringP("canary", "c", "", "canary domain(s)")
@@ -720,6 +1274,23 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("run-at-load", "R", false, "run the implant entrypoint from DllMain/Constructor (shared library only)")
 			f.BoolP("netgo", "q", false, "force the use of netgo")
 			f.StringP("traffic-encoders", "A", "", "comma separated list of traffic encoders to enable")
+			f.Int32("http-c2-version", 0, "http c2 config version to generate with (0 is the current version)")
+			f.String("dns-resolvers", "", "comma separated resolvers of the implant's name resolution (ip[:port] or 'c2')")
+			f.Bool("compress", false, "zstd compress c2 messages larger than 1 KiB")
+			f.Bool("spa", false, "send a single packet authorization knock before each mtls connection")
+			f.String("http-driver", "", "http(s) c2 driver (go, wininet, winhttp), winhttp works when running as a service")
+			f.String("proxy-mode", "", "http(s) c2 proxy discovery (auto, manual, none), auto uses WPAD/PAC files (default: auto)")
+			f.Bool("http-client-cert", false, "present the implant's certificate to https c2s (see https --require-client-cert)")
+			f.String("kex", "", "http/dns/pivot session key exchange (x25519-mlkem768, x25519), defaults to x25519-mlkem768")
+			f.String("config-key-source", "", "encrypt the c2 urls and http c2 profile with a key the implant gets at runtime (arg, env:NAME, file:PATH, url:URL, or bundle)")
+			f.String("config-key", "", "key of the encrypted c2 config (default: random)")
+			f.Uint32("dead-man-days", 0, "clean up and exit after this many days without reaching any c2 (0 is disabled)")
+			f.String("dead-man-state", "", "file that keeps the time of the last c2 contact across restarts")
+			f.StringArray("dead-man-cleanup", []string{}, "persistence to remove before a dead man's switch exit (file:PATH, reg:HIVE\\KEY\\VALUE, or self), can be repeated")
+			f.Bool("reproducible", false, "build the same file every time the implant is rebuilt (verify with 'implants verify')")
+			f.Bool("marker", false, "embed a unique marker to trace leaked samples back to the build (see 'implants lookup')")
+			f.String("cc-backend", "", "c cross-compiler of shared library builds (system, zig), defaults to the first one found")
+			f.String("glibc", "", "oldest glibc a zig built linux shared library runs on, e.g. 2.17 (default: static musl)")
 
 			f.StringP("strategy", "Z", "", "specify a connection strategy (r = random, rd = random domain, s = sequential)")
 			f.Int64P("reconnect", "j", generate.DefaultReconnect, "attempt to reconnect every n second(s)")
@@ -741,7 +1312,10 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			(*comp)["os"] = generate.OSCompleter(con)
 			(*comp)["arch"] = generate.ArchCompleter(con)
 			(*comp)["strategy"] = carapace.ActionValuesDescribed([]string{"r", "random", "rd", "random domain", "s", "sequential"}...).Tag("C2 strategy")
+			(*comp)["kex"] = carapace.ActionValuesDescribed([]string{"x25519-mlkem768", "hybrid post-quantum", "x25519", "legacy"}...).Tag("key exchanges")
 			(*comp)["format"] = generate.FormatCompleter()
+			(*comp)["http-driver"] = carapace.ActionValues(generate.HTTPDrivers...).Tag("http drivers")
+			(*comp)["proxy-mode"] = carapace.ActionValues(generate.ProxyModes...).Tag("proxy modes")
 			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save implant")
 		})
 		generateCmd.AddCommand(generateBeaconCmd)
@@ -764,6 +1338,12 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("badchars", "b", "", "bytes to exclude from stage shellcode")
 			f.StringP("save", "s", "", "directory to save the generated stager to")
 			f.StringP("advanced", "d", "", "Advanced options for the stager using URI query syntax (option1=value1&option2=value2...)")
+			f.StringP("payload", "m", "", "msf stager payload for a multi/handler (e.g. meterpreter/reverse_https)")
+			f.StringP("handler", "H", "", "save a multi/handler resource script for the stager to this file")
+		})
+		FlagComps(generateStagerCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionDirectories().Tag("directory to save the stager")
+			(*comp)["handler"] = carapace.ActionFiles().Tag("resource script file")
 		})
 		generateCmd.AddCommand(generateStagerCmd)
 
@@ -777,6 +1357,45 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		}
 		generateCmd.AddCommand(generateInfoCmd)
 
+		generateWizardCmd := &cobra.Command{
+			Use:   consts.WizardStr,
+			Short: "Generate a new implant by answering prompts",
+			Long:  help.GetHelpFor([]string{consts.GenerateStr, consts.WizardStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.GenerateWizardCmd(cmd, con, args)
+			},
+		}
+		Flags("wizard", false, generateWizardCmd, func(f *pflag.FlagSet) {
+			f.StringP("save", "s", "", "directory/file to the binary to")
+		})
+		FlagComps(generateWizardCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save implant")
+		})
+		generateCmd.AddCommand(generateWizardCmd)
+
+		generateLoaderCmd := &cobra.Command{
+			Use:   consts.LoaderStr,
+			Short: "Generate memory-only loader one-liners for a staged build",
+			Long:  help.GetHelpFor([]string{consts.GenerateStr, consts.LoaderStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.GenerateLoaderCmd(cmd, con, args)
+			},
+		}
+		Flags("loader", false, generateLoaderCmd, func(f *pflag.FlagSet) {
+			f.StringP("url", "u", "", "url the stage is served from")
+			f.StringP("implant", "i", "", "name of the staged implant build")
+			f.StringP("profile", "p", "", "name of the staged implant profile")
+			f.StringSliceP("type", "T", []string{}, "loader types to print (powershell, bash, python), default all")
+			f.Bool("prepend-size", false, "the stage is served with its size prepended")
+			f.Bool("insecure", false, "skip certificate verification of https stage urls")
+		})
+		FlagComps(generateLoaderCmd, func(comp *carapace.ActionMap) {
+			(*comp)["implant"] = generate.ImplantBuildNameCompleter(con)
+			(*comp)["profile"] = generate.ProfileNameCompleter(con)
+			(*comp)["type"] = carapace.ActionValues(generate.LoaderTypes...).Tag("loader types")
+		})
+		generateCmd.AddCommand(generateLoaderCmd)
+
 		// Traffic Encoder SubCommands
 		trafficEncodersCmd := &cobra.Command{
 			Use:   consts.TrafficEncodersStr,
@@ -836,6 +1455,19 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(regenerateCmd).PositionalCompletion(generate.ImplantBuildNameCompleter(con))
 		server.AddCommand(regenerateCmd)
 
+		verifyCmd := &cobra.Command{
+			Use:   consts.VerifyStr,
+			Short: "Verify the provenance of a generated artifact",
+			Long:  help.GetHelpFor([]string{consts.VerifyStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.VerifyCmd(cmd, con, args)
+			},
+			GroupID: consts.PayloadsHelpGroup,
+		}
+		carapace.Gen(verifyCmd).PositionalCompletion(carapace.ActionFiles().Tag("artifact file"))
+		server.AddCommand(verifyCmd)
+
 		// [ Profiles ] --------------------------------------------------------------
 
 		profilesCmd := &cobra.Command{
@@ -898,6 +1530,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("dns", "n", "", "dns connection strings")
 			f.StringP("named-pipe", "p", "", "named-pipe connection strings")
 			f.StringP("tcp-pivot", "i", "", "tcp-pivot connection strings")
+			f.StringP("extc2", "", "", "extc2 connection strings (local address the channel client connects to)")
 
 			f.Uint32P("key-exchange", "X", generate.DefaultWGKeyExPort, "wg key-exchange port")
 			f.Uint32P("tcp-comms", "T", generate.DefaultWGNPort, "wg c2 comms port")
@@ -907,6 +1540,23 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 
 			f.BoolP("netgo", "q", false, "force the use of netgo")
 			f.StringP("traffic-encoders", "A", "", "comma separated list of traffic encoders to enable")
+			f.Int32("http-c2-version", 0, "http c2 config version to generate with (0 is the current version)")
+			f.String("dns-resolvers", "", "comma separated resolvers of the implant's name resolution (ip[:port] or 'c2')")
+			f.Bool("compress", false, "zstd compress c2 messages larger than 1 KiB")
+			f.Bool("spa", false, "send a single packet authorization knock before each mtls connection")
+			f.String("http-driver", "", "http(s) c2 driver (go, wininet, winhttp), winhttp works when running as a service")
+			f.String("proxy-mode", "", "http(s) c2 proxy discovery (auto, manual, none), auto uses WPAD/PAC files (default: auto)")
+			f.Bool("http-client-cert", false, "present the implant's certificate to https c2s (see https --require-client-cert)")
+			f.String("kex", "", "http/dns/pivot session key exchange (x25519-mlkem768, x25519), defaults to x25519-mlkem768")
+			f.String("config-key-source", "", "encrypt the c2 urls and http c2 profile with a key the implant gets at runtime (arg, env:NAME, file:PATH, url:URL, or bundle)")
+			f.String("config-key", "", "key of the encrypted c2 config (default: random)")
+			f.Uint32("dead-man-days", 0, "clean up and exit after this many days without reaching any c2 (0 is disabled)")
+			f.String("dead-man-state", "", "file that keeps the time of the last c2 contact across restarts")
+			f.StringArray("dead-man-cleanup", []string{}, "persistence to remove before a dead man's switch exit (file:PATH, reg:HIVE\\KEY\\VALUE, or self), can be repeated")
+			f.Bool("reproducible", false, "build the same file every time the implant is rebuilt (verify with 'implants verify')")
+			f.Bool("marker", false, "embed a unique marker to trace leaked samples back to the build (see 'implants lookup')")
+			f.String("cc-backend", "", "c cross-compiler of shared library builds (system, zig), defaults to the first one found")
+			f.String("glibc", "", "oldest glibc a zig built linux shared library runs on, e.g. 2.17 (default: static musl)")
 
 			f.StringP("template", "I", "sliver", "implant code template")
 
@@ -928,7 +1578,10 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			(*comp)["os"] = generate.OSCompleter(con)
 			(*comp)["arch"] = generate.ArchCompleter(con)
 			(*comp)["strategy"] = carapace.ActionValuesDescribed([]string{"r", "random", "rd", "random domain", "s", "sequential"}...).Tag("C2 strategy")
+			(*comp)["kex"] = carapace.ActionValuesDescribed([]string{"x25519-mlkem768", "hybrid post-quantum", "x25519", "legacy"}...).Tag("key exchanges")
 			(*comp)["format"] = generate.FormatCompleter()
+			(*comp)["http-driver"] = carapace.ActionValues(generate.HTTPDrivers...).Tag("http drivers")
+			(*comp)["proxy-mode"] = carapace.ActionValues(generate.ProxyModes...).Tag("proxy modes")
 			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save implant")
 		})
 		carapace.Gen(profilesNewCmd).PositionalCompletion(carapace.ActionValues().Usage("name of the session profile (optional)"))
@@ -949,6 +1602,9 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.Int64P("minutes", "M", 0, "beacon interval minutes")
 			f.Int64P("seconds", "S", 60, "beacon interval seconds")
 			f.Int64P("jitter", "J", 30, "beacon interval jitter in seconds")
+			f.Bool("adaptive", false, "check in less often while the host is idle, locked, or on battery")
+			f.Int64("idle-threshold", 300, "seconds without user input after which the host is idle (with --adaptive)")
+			f.Uint32("idle-factor", 4, "beacon interval multiplier while the host is idle or locked (with --adaptive)")
 			f.BoolP("disable-sgn", "G", false, "disable shikata ga nai shellcode encoder")
 
 			// Generate flags
@@ -977,6 +1633,23 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("run-at-load", "R", false, "run the implant entrypoint from DllMain/Constructor (shared library only)")
 			f.BoolP("netgo", "q", false, "force the use of netgo")
 			f.StringP("traffic-encoders", "A", "", "comma separated list of traffic encoders to enable")
+			f.Int32("http-c2-version", 0, "http c2 config version to generate with (0 is the current version)")
+			f.String("dns-resolvers", "", "comma separated resolvers of the implant's name resolution (ip[:port] or 'c2')")
+			f.Bool("compress", false, "zstd compress c2 messages larger than 1 KiB")
+			f.Bool("spa", false, "send a single packet authorization knock before each mtls connection")
+			f.String("http-driver", "", "http(s) c2 driver (go, wininet, winhttp), winhttp works when running as a service")
+			f.String("proxy-mode", "", "http(s) c2 proxy discovery (auto, manual, none), auto uses WPAD/PAC files (default: auto)")
+			f.Bool("http-client-cert", false, "present the implant's certificate to https c2s (see https --require-client-cert)")
+			f.String("kex", "", "http/dns/pivot session key exchange (x25519-mlkem768, x25519), defaults to x25519-mlkem768")
+			f.String("config-key-source", "", "encrypt the c2 urls and http c2 profile with a key the implant gets at runtime (arg, env:NAME, file:PATH, url:URL, or bundle)")
+			f.String("config-key", "", "key of the encrypted c2 config (default: random)")
+			f.Uint32("dead-man-days", 0, "clean up and exit after this many days without reaching any c2 (0 is disabled)")
+			f.String("dead-man-state", "", "file that keeps the time of the last c2 contact across restarts")
+			f.StringArray("dead-man-cleanup", []string{}, "persistence to remove before a dead man's switch exit (file:PATH, reg:HIVE\\KEY\\VALUE, or self), can be repeated")
+			f.Bool("reproducible", false, "build the same file every time the implant is rebuilt (verify with 'implants verify')")
+			f.Bool("marker", false, "embed a unique marker to trace leaked samples back to the build (see 'implants lookup')")
+			f.String("cc-backend", "", "c cross-compiler of shared library builds (system, zig), defaults to the first one found")
+			f.String("glibc", "", "oldest glibc a zig built linux shared library runs on, e.g. 2.17 (default: static musl)")
 
 			f.StringP("template", "I", "sliver", "implant code template")
 
@@ -998,7 +1671,10 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			(*comp)["os"] = generate.OSCompleter(con)
 			(*comp)["arch"] = generate.ArchCompleter(con)
 			(*comp)["strategy"] = carapace.ActionValuesDescribed([]string{"r", "random", "rd", "random domain", "s", "sequential"}...).Tag("C2 strategy")
+			(*comp)["kex"] = carapace.ActionValuesDescribed([]string{"x25519-mlkem768", "hybrid post-quantum", "x25519", "legacy"}...).Tag("key exchanges")
 			(*comp)["format"] = generate.FormatCompleter()
+			(*comp)["http-driver"] = carapace.ActionValues(generate.HTTPDrivers...).Tag("http drivers")
+			(*comp)["proxy-mode"] = carapace.ActionValues(generate.ProxyModes...).Tag("proxy modes")
 			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save implant")
 		})
 		carapace.Gen(profilesNewBeaconCmd).PositionalCompletion(carapace.ActionValues().Usage("name of the beacon profile (optional)"))
@@ -1028,6 +1704,51 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(profilesInfoCmd).PositionalCompletion(generate.ProfileNameCompleter(con))
 		profilesCmd.AddCommand(profilesInfoCmd)
 
+		profilesHistoryCmd := &cobra.Command{
+			Use:   consts.HistoryStr,
+			Short: "List the saved versions of a profile",
+			Long:  help.GetHelpFor([]string{consts.ProfilesStr, consts.HistoryStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.ProfilesHistoryCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(profilesHistoryCmd).PositionalCompletion(generate.ProfileNameCompleter(con))
+		profilesCmd.AddCommand(profilesHistoryCmd)
+
+		profilesExportCmd := &cobra.Command{
+			Use:   consts.ExportStr,
+			Short: "Export a profile as yaml",
+			Long:  help.GetHelpFor([]string{consts.ProfilesStr, consts.ExportStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.ProfilesExportCmd(cmd, con, args)
+			},
+		}
+		Flags("profiles", false, profilesExportCmd, func(f *pflag.FlagSet) {
+			f.StringP("save", "s", "", "save the profile to a file instead of printing it")
+		})
+		FlagComps(profilesExportCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles().Tag("yaml file")
+		})
+		carapace.Gen(profilesExportCmd).PositionalCompletion(generate.ProfileNameCompleter(con))
+		profilesCmd.AddCommand(profilesExportCmd)
+
+		profilesImportCmd := &cobra.Command{
+			Use:   consts.ImportStr,
+			Short: "Import a profile from a yaml file",
+			Long:  help.GetHelpFor([]string{consts.ProfilesStr, consts.ImportStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.ProfilesImportCmd(cmd, con, args)
+			},
+		}
+		Flags("profiles", false, profilesImportCmd, func(f *pflag.FlagSet) {
+			f.StringP("name", "n", "", "save the profile with a different name")
+		})
+		carapace.Gen(profilesImportCmd).PositionalCompletion(carapace.ActionFiles("yaml", "yml").Tag("yaml file"))
+		profilesCmd.AddCommand(profilesImportCmd)
+
 		implantBuildsCmd := &cobra.Command{
 			Use:   consts.ImplantBuildsStr,
 			Short: "List implant builds",
@@ -1067,25 +1788,185 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(implantsRmCmd).PositionalCompletion(generate.ImplantBuildNameCompleter(con))
 		implantBuildsCmd.AddCommand(implantsRmCmd)
 
-		canariesCmd := &cobra.Command{
-			Use:   consts.CanariesStr,
-			Short: "List previously generated canaries",
-			Long:  help.GetHelpFor([]string{consts.CanariesStr}),
+		implantsBundleCmd := &cobra.Command{
+			Use:   consts.BundleStr,
+			Short: "Generate a provisioning bundle for a provisionable implant build",
+			Long:  help.GetHelpFor([]string{consts.ImplantBuildsStr, consts.BundleStr}),
+			Args:  cobra.ExactArgs(1),
 			Run: func(cmd *cobra.Command, args []string) {
-				generate.CanariesCmd(cmd, con, args)
+				generate.ImplantsBundleCmd(cmd, con, args)
 			},
-			GroupID: consts.PayloadsHelpGroup,
 		}
-		Flags("canaries", false, canariesCmd, func(f *pflag.FlagSet) {
-			f.BoolP("burned", "b", false, "show only triggered/burned canaries")
-			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		Flags("bundle", false, implantsBundleCmd, func(f *pflag.FlagSet) {
+			f.StringP("name", "N", "", "name of the provisioned implant (default: random)")
+			f.StringP("mtls", "m", "", "mtls connection strings")
+			f.StringP("http", "b", "", "http(s) connection strings")
+			f.StringP("dns", "n", "", "dns connection strings")
+			f.StringP("named-pipe", "p", "", "named-pipe connection strings")
+			f.StringP("tcp-pivot", "i", "", "tcp-pivot connection strings")
+			f.Int32("http-c2-version", 0, "http c2 config version of the bundle (default: the build's)")
+			f.StringP("save", "s", "", "directory/file to save the bundle to")
 		})
+		FlagComps(implantsBundleCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save bundle")
+		})
+		carapace.Gen(implantsBundleCmd).PositionalCompletion(generate.ImplantBuildNameCompleter(con))
+		implantBuildsCmd.AddCommand(implantsBundleCmd)
 
-		// [ Websites ] ---------------------------------------------
-
-		websitesCmd := &cobra.Command{
-			Use:   consts.WebsitesStr,
-			Short: "Host static content (used with HTTP C2)",
+		implantsPatchCmd := &cobra.Command{
+			Use:   consts.PatchStr,
+			Short: "Patch a new c2 config and keys into a provisionable implant build without recompiling",
+			Long:  help.GetHelpFor([]string{consts.ImplantBuildsStr, consts.PatchStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.ImplantsPatchCmd(cmd, con, args)
+			},
+		}
+		Flags("patch", false, implantsPatchCmd, func(f *pflag.FlagSet) {
+			f.StringP("name", "N", "", "name of the patched implant (default: random)")
+			f.StringP("mtls", "m", "", "mtls connection strings")
+			f.StringP("http", "b", "", "http(s) connection strings")
+			f.StringP("dns", "n", "", "dns connection strings")
+			f.StringP("named-pipe", "p", "", "named-pipe connection strings")
+			f.StringP("tcp-pivot", "i", "", "tcp-pivot connection strings")
+			f.Int32("http-c2-version", 0, "http c2 config version of the patched implant (default: the build's)")
+			f.StringP("save", "s", "", "directory/file to save the patched implant to")
+		})
+		FlagComps(implantsPatchCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save implant")
+		})
+		carapace.Gen(implantsPatchCmd).PositionalCompletion(generate.ImplantBuildNameCompleter(con))
+		implantBuildsCmd.AddCommand(implantsPatchCmd)
+
+		implantsVerifyCmd := &cobra.Command{
+			Use:   consts.VerifyStr,
+			Short: "Rebuild a reproducible implant build and compare it to the saved build",
+			Long:  help.GetHelpFor([]string{consts.ImplantBuildsStr, consts.VerifyStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.ImplantsVerifyCmd(cmd, con, args)
+			},
+		}
+		Flags("verify", false, implantsVerifyCmd, func(f *pflag.FlagSet) {
+			f.StringP("file", "f", "", "deployed implant file to compare to the saved build")
+		})
+		FlagComps(implantsVerifyCmd, func(comp *carapace.ActionMap) {
+			(*comp)["file"] = carapace.ActionFiles().Tag("implant file")
+		})
+		carapace.Gen(implantsVerifyCmd).PositionalCompletion(generate.ImplantBuildNameCompleter(con))
+		implantBuildsCmd.AddCommand(implantsVerifyCmd)
+
+		implantsLookupCmd := &cobra.Command{
+			Use:   consts.LookupStr,
+			Short: "Trace a leaked sample back to its build using the embedded marker",
+			Long:  help.GetHelpFor([]string{consts.ImplantBuildsStr, consts.LookupStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.ImplantsLookupCmd(cmd, con, args)
+			},
+		}
+		Flags("lookup", false, implantsLookupCmd, func(f *pflag.FlagSet) {
+			f.StringP("file", "f", "", "sample to search for a marker")
+		})
+		FlagComps(implantsLookupCmd, func(comp *carapace.ActionMap) {
+			(*comp)["file"] = carapace.ActionFiles().Tag("sample file")
+		})
+		implantBuildsCmd.AddCommand(implantsLookupCmd)
+
+		burnCmd := &cobra.Command{
+			Use:   consts.BurnStr,
+			Short: "Mark an implant build as compromised",
+			Long:  help.GetHelpFor([]string{consts.BurnStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.BurnCmd(cmd, con, args)
+			},
+			GroupID: consts.PayloadsHelpGroup,
+		}
+		Flags("burn", false, burnCmd, func(f *pflag.FlagSet) {
+			f.BoolP("unburn", "u", false, "remove the implant build from the burn list")
+		})
+		carapace.Gen(burnCmd).PositionalCompletion(generate.ImplantBuildNameCompleter(con))
+		server.AddCommand(burnCmd)
+
+		canariesCmd := &cobra.Command{
+			Use:   consts.CanariesStr,
+			Short: "List previously generated canaries",
+			Long:  help.GetHelpFor([]string{consts.CanariesStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.CanariesCmd(cmd, con, args)
+			},
+			GroupID: consts.PayloadsHelpGroup,
+		}
+		Flags("canaries", false, canariesCmd, func(f *pflag.FlagSet) {
+			f.BoolP("burned", "b", false, "show only triggered/burned canaries")
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		server.AddCommand(canariesCmd)
+
+		canaryTokenCmds := []*cobra.Command{
+			{Use: consts.HttpStr, Short: "Generate a URL that triggers when it is requested"},
+			{Use: consts.AWSStr, Short: "Generate AWS credentials that trigger when they are used"},
+			{Use: consts.DocumentStr, Short: "Generate a Word document that triggers when it is opened"},
+		}
+		for _, canaryTokenCmd := range canaryTokenCmds {
+			canaryTokenCmd.Long = help.GetHelpFor([]string{consts.CanariesStr, canaryTokenCmd.Use})
+			canaryTokenCmd.Args = cobra.ExactArgs(1)
+			canaryTokenCmd.Run = func(cmd *cobra.Command, args []string) {
+				generate.CanaryTokenCmd(cmd, con, args)
+			}
+			Flags("canaries", false, canaryTokenCmd, func(f *pflag.FlagSet) {
+				f.StringP("name", "n", "", "name of the canary (e.g. where it will be placed)")
+				if canaryTokenCmd.Use != consts.HttpStr {
+					f.StringP("save", "s", "", "save the generated file to this path")
+				}
+			})
+			if canaryTokenCmd.Use != consts.HttpStr {
+				FlagComps(canaryTokenCmd, func(comp *carapace.ActionMap) {
+					(*comp)["save"] = carapace.ActionFiles()
+				})
+			}
+			carapace.Gen(canaryTokenCmd).PositionalCompletion(carapace.ActionValues().Usage("http(s) listener url"))
+			canariesCmd.AddCommand(canaryTokenCmd)
+		}
+
+		canariesRmCmd := &cobra.Command{
+			Use:   consts.RmStr,
+			Short: "Remove an http, aws, or document canary",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				generate.CanaryTokenRmCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(canariesRmCmd).PositionalCompletion(generate.CanaryTokenIDCompleter(con))
+		canariesCmd.AddCommand(canariesRmCmd)
+
+		// [ Preflight ] ---------------------------------------------
+		preflightCmd := &cobra.Command{
+			Use:     consts.PreflightStr,
+			Short:   "Check a build config and the running listeners for opsec mistakes",
+			Long:    help.GetHelpFor([]string{consts.PreflightStr}),
+			GroupID: consts.PayloadsHelpGroup,
+			Run: func(cmd *cobra.Command, args []string) {
+				preflight.PreflightCmd(cmd, con, args)
+			},
+		}
+		Flags("preflight", false, preflightCmd, func(f *pflag.FlagSet) {
+			f.StringP("profile", "p", "", "check the config of an implant profile")
+			f.StringP("implant", "i", "", "check the config of an implant build")
+			f.BoolP("skip-infra", "I", false, "do not check the running listeners")
+		})
+		FlagComps(preflightCmd, func(comp *carapace.ActionMap) {
+			(*comp)["profile"] = generate.ProfileNameCompleter(con)
+			(*comp)["implant"] = generate.ImplantBuildNameCompleter(con)
+		})
+		server.AddCommand(preflightCmd)
+
+		// [ Websites ] ---------------------------------------------
+
+		websitesCmd := &cobra.Command{
+			Use:   consts.WebsitesStr,
+			Short: "Host static content (used with HTTP C2)",
 			Long:  help.GetHelpFor([]string{consts.WebsitesStr}),
 			Run: func(cmd *cobra.Command, args []string) {
 				websites.WebsitesCmd(cmd, con, args)
@@ -1185,7 +2066,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.BoolP("kill-all", "K", false, "kill all beacons")
 			f.BoolP("force", "F", false, "force killing the beacon")
 
-			f.StringP("filter", "f", "", "filter beacons by substring")
+			f.StringP("filter", "f", "", "filter beacons by substring, or by tag:<tag>, note:<text>, or color:<color>")
 			f.StringP("filter-re", "e", "", "filter beacons by regular expression")
 		})
 		FlagComps(beaconsCmd, func(comp *carapace.ActionMap) {
@@ -1367,6 +2248,8 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		Flags("loot", false, lootFetchCmd, func(f *pflag.FlagSet) {
 			f.StringP("save", "s", "", "save loot to a local file")
 			f.StringP("filter", "f", "", "filter based on loot type")
+			f.BoolP("view", "v", false, "preview images, paginate text, and hexdump binaries")
+			f.BoolP("hex", "x", false, "hexdump the loot, even if it is text or an image")
 		})
 		FlagComps(lootFetchCmd, func(comp *carapace.ActionMap) {
 			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save loot")
@@ -1385,8 +2268,90 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("filter", "f", "", "filter based on loot type")
 		})
 
+		lootTagCmd := &cobra.Command{
+			Use:   consts.TagStr,
+			Short: "Add or remove tags on a piece of loot",
+			Long:  help.GetHelpFor([]string{consts.LootStr, consts.TagStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				loot.LootTagCmd(cmd, con, args)
+			},
+		}
+		lootCmd.AddCommand(lootTagCmd)
+		Flags("loot", false, lootTagCmd, func(f *pflag.FlagSet) {
+			f.StringSliceP("add", "a", []string{}, "tag(s) to add")
+			f.StringSliceP("remove", "r", []string{}, "tag(s) to remove")
+		})
+		carapace.Gen(lootTagCmd).PositionalCompletion(carapace.ActionValues().Usage("loot id (default: interactive selection)"))
+
+		lootSearchCmd := &cobra.Command{
+			Use:   consts.SearchStr,
+			Short: "Search loot and credentials",
+			Long:  help.GetHelpFor([]string{consts.LootStr, consts.SearchStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				loot.LootSearchCmd(cmd, con, args)
+			},
+		}
+		lootCmd.AddCommand(lootSearchCmd)
+		Flags("loot", false, lootSearchCmd, func(f *pflag.FlagSet) {
+			f.StringSliceP("tag", "t", []string{}, "only match loot with these tag(s)")
+			f.StringP("host", "H", "", "only match loot from this host (hostname or host id)")
+			f.BoolP("content", "c", false, "also search the contents of text loot")
+		})
+		carapace.Gen(lootSearchCmd).PositionalCompletion(carapace.ActionValues().Usage("search terms"))
+
+		lootExportCmd := &cobra.Command{
+			Use:   consts.ExportStr,
+			Short: "Export loot and credentials as csv, json, or a zip archive",
+			Long:  help.GetHelpFor([]string{consts.LootStr, consts.ExportStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				loot.LootExportCmd(cmd, con, args)
+			},
+		}
+		lootCmd.AddCommand(lootExportCmd)
+		Flags("loot", false, lootExportCmd, func(f *pflag.FlagSet) {
+			f.StringP("format", "F", "zip", "export format (csv, json, zip)")
+			f.StringP("group-by", "g", "host", "group zip exports by (host, tag)")
+			f.StringP("save", "s", "", "save export to a local file or directory")
+			f.StringSliceP("tag", "t", []string{}, "only export loot with these tag(s)")
+			f.StringP("host", "H", "", "only export loot from this host (hostname or host id)")
+			f.BoolP("content", "c", false, "also search the contents of text loot")
+		})
+		FlagComps(lootExportCmd, func(comp *carapace.ActionMap) {
+			(*comp)["format"] = carapace.ActionValues("csv", "json", "zip").Tag("export format")
+			(*comp)["group-by"] = carapace.ActionValues("host", "tag").Tag("zip grouping")
+			(*comp)["save"] = carapace.ActionFiles().Tag("directory/file to save export")
+		})
+		carapace.Gen(lootExportCmd).PositionalCompletion(carapace.ActionValues().Usage("optional search terms"))
+
 		server.AddCommand(lootCmd)
 
+		// [ BloodHound ] ------------------------------------------------------------
+		bloodhoundCmd := &cobra.Command{
+			Use:     consts.BloodHoundStr,
+			Short:   "Ingest BloodHound collections",
+			Long:    help.GetHelpFor([]string{consts.BloodHoundStr}),
+			GroupID: consts.SliverHelpGroup,
+		}
+		bloodhoundIngestCmd := &cobra.Command{
+			Use:   consts.IngestStr + " [ZIP]",
+			Short: "Ingest a collection zip as loot and upload it to BloodHound",
+			Long:  help.GetHelpFor([]string{consts.BloodHoundStr, consts.IngestStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				bloodhound.BloodHoundIngestCmd(cmd, con, args)
+			},
+		}
+		Flags("bloodhound", false, bloodhoundIngestCmd, func(f *pflag.FlagSet) {
+			f.BoolP("upload", "u", false, "upload to the configured BloodHound instance")
+			f.StringP("host", "H", "", "host id the collection was run on")
+		})
+		FlagComps(bloodhoundIngestCmd, func(comp *carapace.ActionMap) {
+			(*comp)["host"] = hosts.HostIDCompleter(con)
+		})
+		carapace.Gen(bloodhoundIngestCmd).PositionalCompletion(carapace.ActionFiles("zip").Usage("collection zip"))
+		bloodhoundCmd.AddCommand(bloodhoundIngestCmd)
+		server.AddCommand(bloodhoundCmd)
+
 		// [ Credentials ] ------------------------------------------------------------
 		credsCmd := &cobra.Command{
 			Use:     consts.CredsStr,
@@ -1415,7 +2380,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.StringP("username", "u", "", "username for the credential")
 			f.StringP("plaintext", "p", "", "plaintext for the credential")
 			f.StringP("hash", "P", "", "hash of the credential")
-			f.StringP("hash-type", "H", "", "hash type of the credential")
+			f.StringP("hash-type", "H", "", "hash type of the credential (default: auto-detect)")
 		})
 		FlagComps(credsAddCmd, func(comp *carapace.ActionMap) {
 			(*comp)["hash-type"] = creds.CredsHashTypeCompleter(con)
@@ -1433,7 +2398,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		}
 		Flags("", false, credsAddFileCmd, func(f *pflag.FlagSet) {
 			f.StringP("collection", "c", "", "name of collection")
-			f.StringP("file-format", "F", creds.HashNewlineFormat, "file format of the credential file")
+			f.StringP("file-format", "F", creds.AutoFormat, "file format of the credential file")
 			f.StringP("hash-type", "H", "", "hash type of the credential")
 		})
 		FlagComps(credsAddFileCmd, func(comp *carapace.ActionMap) {
@@ -1455,6 +2420,373 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		carapace.Gen(credsRmCmd).PositionalCompletion(creds.CredsCredentialIDCompleter(con).Usage("id of credential to remove (leave empty to select)"))
 		credsCmd.AddCommand(credsRmCmd)
 
+		credsExportCmd := &cobra.Command{
+			Use:   consts.ExportStr,
+			Short: "Export uncracked hashes as a hashcat or john cracking job",
+			Long:  help.GetHelpFor([]string{consts.CredsStr, consts.ExportStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				creds.CredsExportCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, credsExportCmd, func(f *pflag.FlagSet) {
+			f.StringP("format", "F", credentials.HashcatJob, "cracking job format (hashcat, john)")
+			f.StringP("collection", "c", "", "only export hashes from this collection")
+			f.StringP("hash-type", "H", "", "only export hashes of this type")
+			f.StringP("save", "s", "", "directory to save the job files (default: ./creds-<format>)")
+		})
+		FlagComps(credsExportCmd, func(comp *carapace.ActionMap) {
+			(*comp)["format"] = carapace.ActionValues(credentials.HashcatJob, credentials.JohnJob).Tag("cracking job format")
+			(*comp)["collection"] = creds.CredsCollectionCompleter(con)
+			(*comp)["hash-type"] = creds.CredsHashTypeCompleter(con)
+			(*comp)["save"] = carapace.ActionDirectories().Tag("directory to save job files")
+		})
+		credsCmd.AddCommand(credsExportCmd)
+
+		credsCrackedCmd := &cobra.Command{
+			Use:   consts.CrackedStr,
+			Short: "Import cracked results from a hashcat or john pot file",
+			Long:  help.GetHelpFor([]string{consts.CredsStr, consts.CrackedStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				creds.CredsCrackedCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(credsCrackedCmd).PositionalCompletion(carapace.ActionFiles().Tag("pot file or --show output"))
+		credsCmd.AddCommand(credsCrackedCmd)
+
+		// [ Replay ] --------------------------------------------------------------------
+
+		replayCmd := &cobra.Command{
+			Use:   consts.ReplayStr,
+			Short: "List and replay recorded shells and session consoles",
+			Long:  help.GetHelpFor([]string{consts.ReplayStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				replay.ReplayCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverHelpGroup,
+		}
+		Flags("replay", false, replayCmd, func(f *pflag.FlagSet) {
+			f.StringP("session", "s", "", "only list recordings of this session (id or name)")
+			f.Float64P("speed", "x", 1, "playback speed multiplier")
+			f.Float64P("max-idle", "i", 2, "limit pauses to this many seconds (0 for no limit)")
+			f.StringP("save", "S", "", "save the asciicast file instead of replaying it")
+		})
+		FlagComps(replayCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles()
+		})
+		carapace.Gen(replayCmd).PositionalCompletion(replay.RecordingIDCompleter(con))
+		server.AddCommand(replayCmd)
+
+		// [ Federation ] ----------------------------------------------------------------
+
+		federationCmd := &cobra.Command{
+			Use:   consts.FederationStr,
+			Short: "Link other Sliver servers and browse their sessions, beacons, and loot",
+			Long:  help.GetHelpFor([]string{consts.FederationStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		server.AddCommand(federationCmd)
+
+		federationLinkCmd := &cobra.Command{
+			Use:   consts.LinkStr,
+			Short: "Link a server using one of its operator configs",
+			Long:  help.GetHelpFor([]string{consts.FederationStr, consts.LinkStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationLinkCmd(cmd, con, args)
+			},
+		}
+		Flags("federation", false, federationLinkCmd, func(f *pflag.FlagSet) {
+			f.StringP("name", "n", "", "name of the linked server (default: its hostname)")
+			f.BoolP("relay-events", "e", false, "relay the linked server's events to this server's operators")
+		})
+		carapace.Gen(federationLinkCmd).PositionalCompletion(carapace.ActionFiles().Tag("operator config"))
+		federationCmd.AddCommand(federationLinkCmd)
+
+		federationUnlinkCmd := &cobra.Command{
+			Use:   consts.UnlinkStr,
+			Short: "Remove a linked server",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationUnlinkCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(federationUnlinkCmd).PositionalCompletion(federation.LinkNameCompleter(con))
+		federationCmd.AddCommand(federationUnlinkCmd)
+
+		federationSessionsCmd := &cobra.Command{
+			Use:   consts.SessionsStr,
+			Short: "List the sessions of linked servers",
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationSessionsCmd(cmd, con, args)
+			},
+		}
+		federationCmd.AddCommand(federationSessionsCmd)
+
+		federationBeaconsCmd := &cobra.Command{
+			Use:   consts.BeaconsStr,
+			Short: "List the beacons of linked servers",
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationBeaconsCmd(cmd, con, args)
+			},
+		}
+		federationCmd.AddCommand(federationBeaconsCmd)
+
+		federationLootCmd := &cobra.Command{
+			Use:   consts.LootStr,
+			Short: "List the loot of linked servers",
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationLootCmd(cmd, con, args)
+			},
+		}
+		federationCmd.AddCommand(federationLootCmd)
+		for _, cmd := range []*cobra.Command{federationSessionsCmd, federationBeaconsCmd, federationLootCmd} {
+			Flags("federation", false, cmd, func(f *pflag.FlagSet) {
+				f.StringP("server", "s", "", "only list this linked server")
+			})
+			FlagComps(cmd, func(comp *carapace.ActionMap) {
+				(*comp)["server"] = federation.LinkNameCompleter(con)
+			})
+		}
+
+		federationFetchCmd := &cobra.Command{
+			Use:   consts.FetchStr,
+			Short: "Fetch a piece of loot from a linked server",
+			Long:  help.GetHelpFor([]string{consts.FederationStr, consts.FetchStr}),
+			Args:  cobra.ExactArgs(2),
+			Run: func(cmd *cobra.Command, args []string) {
+				federation.FederationFetchCmd(cmd, con, args)
+			},
+		}
+		Flags("federation", false, federationFetchCmd, func(f *pflag.FlagSet) {
+			f.StringP("save", "s", "", "save loot to a local file")
+			f.BoolP("local", "l", false, "replicate the loot into this server's loot store")
+		})
+		FlagComps(federationFetchCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles()
+		})
+		carapace.Gen(federationFetchCmd).PositionalCompletion(
+			federation.LinkNameCompleter(con),
+			carapace.ActionValues().Usage("loot id"),
+		)
+		federationCmd.AddCommand(federationFetchCmd)
+
+		// [ Automation ] ---------------------------------------------------------------
+
+		automationCmd := &cobra.Command{
+			Use:   consts.AutomationStr,
+			Short: "Manage server-side rules that task new sessions and beacons",
+			Long:  help.GetHelpFor([]string{consts.AutomationStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.AutomationCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		server.AddCommand(automationCmd)
+
+		automationAddCmd := &cobra.Command{
+			Use:   consts.AddStr,
+			Short: "Add or replace an automation rule",
+			Long:  help.GetHelpFor([]string{consts.AutomationStr, consts.AddStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.AutomationAddCmd(cmd, con, args)
+			},
+		}
+		Flags("automation", false, automationAddCmd, func(f *pflag.FlagSet) {
+			f.StringP("event", "e", consts.SessionOpenedEvent, "event that triggers the rule")
+			f.StringP("os", "o", "", "only run on implants with this operating system")
+			f.StringP("hostname", "H", "", "only run on hostnames matching this regular expression")
+			f.StringP("username", "u", "", "only run on usernames matching this regular expression")
+			f.StringArrayP("task", "t", []string{}, "task to run, can be repeated (run in order)")
+			f.BoolP("disabled", "d", false, "save the rule without enabling it")
+		})
+		FlagComps(automationAddCmd, func(comp *carapace.ActionMap) {
+			(*comp)["event"] = automation.EventCompleter()
+			(*comp)["os"] = carapace.ActionValues("windows", "linux", "darwin")
+		})
+		carapace.Gen(automationAddCmd).PositionalCompletion(automation.RuleNameCompleter(con))
+		automationCmd.AddCommand(automationAddCmd)
+
+		automationRmCmd := &cobra.Command{
+			Use:   consts.RmStr,
+			Short: "Remove an automation rule",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.AutomationRmCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(automationRmCmd).PositionalCompletion(automation.RuleNameCompleter(con))
+		automationCmd.AddCommand(automationRmCmd)
+
+		automationEnableCmd := &cobra.Command{
+			Use:   consts.EnableStr,
+			Short: "Enable an automation rule",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.AutomationEnableCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(automationEnableCmd).PositionalCompletion(automation.RuleNameCompleter(con))
+		automationCmd.AddCommand(automationEnableCmd)
+
+		automationDisableCmd := &cobra.Command{
+			Use:   consts.DisableStr,
+			Short: "Disable an automation rule without removing it",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.AutomationDisableCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(automationDisableCmd).PositionalCompletion(automation.RuleNameCompleter(con))
+		automationCmd.AddCommand(automationDisableCmd)
+
+		// [ Schedules ] -----------------------------------------------------------------
+
+		schedulesCmd := &cobra.Command{
+			Use:   consts.SchedulesStr,
+			Short: "Manage tasks the server runs on a session or beacon at a time or on a schedule",
+			Long:  help.GetHelpFor([]string{consts.SchedulesStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.SchedulesCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		server.AddCommand(schedulesCmd)
+
+		schedulesAddCmd := &cobra.Command{
+			Use:   consts.AddStr,
+			Short: "Add or replace a scheduled task",
+			Long:  help.GetHelpFor([]string{consts.SchedulesStr, consts.AddStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.SchedulesAddCmd(cmd, con, args)
+			},
+		}
+		Flags("schedules", false, schedulesAddCmd, func(f *pflag.FlagSet) {
+			f.StringP("implant", "i", "", "session or beacon id (defaults to the active session or beacon)")
+			f.StringP("at", "a", "", "run once at this time")
+			f.StringP("in", "I", "", "run once after this duration (e.g. 30m)")
+			f.StringP("every", "e", "", "run at this interval (e.g. 1h)")
+			f.StringP("cron", "c", "", "run on this cron schedule, in UTC (e.g. '0 9 * * 1-5')")
+			f.StringArrayP("task", "t", []string{}, "task to run, can be repeated (run in order)")
+			f.BoolP("disabled", "d", false, "save the scheduled task without enabling it")
+		})
+		FlagComps(schedulesAddCmd, func(comp *carapace.ActionMap) {
+			(*comp)["implant"] = use.BeaconAndSessionIDCompleter(con)
+		})
+		carapace.Gen(schedulesAddCmd).PositionalCompletion(automation.ScheduleNameCompleter(con))
+		schedulesCmd.AddCommand(schedulesAddCmd)
+
+		schedulesRmCmd := &cobra.Command{
+			Use:   consts.RmStr,
+			Short: "Remove a scheduled task",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.SchedulesRmCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(schedulesRmCmd).PositionalCompletion(automation.ScheduleNameCompleter(con))
+		schedulesCmd.AddCommand(schedulesRmCmd)
+
+		schedulesEnableCmd := &cobra.Command{
+			Use:   consts.EnableStr,
+			Short: "Enable a scheduled task",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.SchedulesEnableCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(schedulesEnableCmd).PositionalCompletion(automation.ScheduleNameCompleter(con))
+		schedulesCmd.AddCommand(schedulesEnableCmd)
+
+		schedulesDisableCmd := &cobra.Command{
+			Use:   consts.DisableStr,
+			Short: "Disable a scheduled task without removing it",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.SchedulesDisableCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(schedulesDisableCmd).PositionalCompletion(automation.ScheduleNameCompleter(con))
+		schedulesCmd.AddCommand(schedulesDisableCmd)
+
+		// [ Groups ] --------------------------------------------------------------------
+
+		groupsCmd := &cobra.Command{
+			Use:   consts.GroupsStr,
+			Short: "Manage groups of sessions and beacons, and task every member of a group",
+			Long:  help.GetHelpFor([]string{consts.GroupsStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.GroupsCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverHelpGroup,
+		}
+		server.AddCommand(groupsCmd)
+
+		groupsAddCmd := &cobra.Command{
+			Use:   consts.AddStr,
+			Short: "Add or replace an implant group",
+			Long:  help.GetHelpFor([]string{consts.GroupsStr, consts.AddStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.GroupsAddCmd(cmd, con, args)
+			},
+		}
+		Flags("groups", false, groupsAddCmd, func(f *pflag.FlagSet) {
+			f.StringArrayP("tag", "T", []string{}, "implants on hosts with this tag, can be repeated")
+			f.StringArrayP("subnet", "s", []string{}, "implants with a remote address in this subnet, can be repeated")
+			f.StringP("os", "o", "", "implants with this operating system")
+			f.StringArrayP("implant", "i", []string{}, "session or beacon id that is always a member, can be repeated")
+		})
+		FlagComps(groupsAddCmd, func(comp *carapace.ActionMap) {
+			(*comp)["os"] = carapace.ActionValues("windows", "linux", "darwin")
+			(*comp)["implant"] = use.BeaconAndSessionIDCompleter(con)
+		})
+		carapace.Gen(groupsAddCmd).PositionalCompletion(automation.GroupNameCompleter(con))
+		groupsCmd.AddCommand(groupsAddCmd)
+
+		groupsRmCmd := &cobra.Command{
+			Use:   consts.RmStr,
+			Short: "Remove an implant group",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.GroupsRmCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(groupsRmCmd).PositionalCompletion(automation.GroupNameCompleter(con))
+		groupsCmd.AddCommand(groupsRmCmd)
+
+		groupsMembersCmd := &cobra.Command{
+			Use:   consts.MembersStr,
+			Short: "List the sessions and beacons in an implant group",
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.GroupsMembersCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(groupsMembersCmd).PositionalCompletion(automation.GroupNameCompleter(con))
+		groupsCmd.AddCommand(groupsMembersCmd)
+
+		groupsTaskCmd := &cobra.Command{
+			Use:   consts.TaskStr,
+			Short: "Run tasks against every member of an implant group",
+			Long:  help.GetHelpFor([]string{consts.GroupsStr, consts.TaskStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				automation.GroupsTaskCmd(cmd, con, args)
+			},
+		}
+		Flags("groups", false, groupsTaskCmd, func(f *pflag.FlagSet) {
+			f.StringArrayP("task", "t", []string{}, "task to run, can be repeated (run in order)")
+		})
+		carapace.Gen(groupsTaskCmd).PositionalCompletion(automation.GroupNameCompleter(con))
+		groupsCmd.AddCommand(groupsTaskCmd)
+
 		// [ Hosts ] ---------------------------------------------------------------------
 
 		hostsCmd := &cobra.Command{
@@ -1481,6 +2813,116 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		}
 		hostsCmd.AddCommand(hostsRmCmd)
 
+		hostsInfoCmd := &cobra.Command{
+			Use:   consts.InfoStr,
+			Short: "Show the sessions, beacons, loot, command history, and processes of a host",
+			Long:  help.GetHelpFor([]string{consts.HostsStr, consts.InfoStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				hosts.HostsInfoCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, hostsInfoCmd, func(f *pflag.FlagSet) {
+			f.Int32P("history", "n", 50, "number of commands to show from the host's history")
+			f.BoolP("processes", "p", false, "show the host's most recent process list")
+			f.BoolP("verbose", "v", false, "do not truncate command arguments")
+		})
+		carapace.Gen(hostsInfoCmd).PositionalCompletion(hosts.HostIDCompleter(con))
+		hostsCmd.AddCommand(hostsInfoCmd)
+
+		hostsTimelineCmd := &cobra.Command{
+			Use:   consts.TimelineStr,
+			Short: "Show everything that happened on a host in chronological order",
+			Long:  help.GetHelpFor([]string{consts.HostsStr, consts.TimelineStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				hosts.HostsTimelineCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, hostsTimelineCmd, func(f *pflag.FlagSet) {
+			f.StringP("format", "F", "text", "output format (text, csv, json)")
+			f.StringP("save", "s", "", "save the timeline to a local file (csv unless --format json)")
+			f.StringSliceP("kind", "k", []string{}, "only show events of these kind(s)")
+			f.BoolP("verbose", "v", false, "do not truncate events")
+		})
+		FlagComps(hostsTimelineCmd, func(comp *carapace.ActionMap) {
+			(*comp)["format"] = carapace.ActionValues("text", "csv", "json").Tag("output format")
+			(*comp)["save"] = carapace.ActionFiles().Tag("file to save timeline")
+			(*comp)["kind"] = carapace.ActionValues("contact", "session", "beacon", "task", "file", "persistence", "loot").Tag("event kinds")
+		})
+		carapace.Gen(hostsTimelineCmd).PositionalCompletion(hosts.HostIDCompleter(con))
+		hostsCmd.AddCommand(hostsTimelineCmd)
+
+		hostsExportCmd := &cobra.Command{
+			Use:   consts.ExportStr,
+			Short: "Export hosts, implants, and observed techniques as STIX or Attack Flow",
+			Long:  help.GetHelpFor([]string{consts.HostsStr, consts.ExportStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				hosts.HostsExportCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, hostsExportCmd, func(f *pflag.FlagSet) {
+			f.StringP("format", "F", "stix", "export format (stix, attack-flow)")
+			f.StringP("name", "n", "", "engagement name")
+			f.DurationP("since", "S", 0, "only include commands run in the last duration (e.g. 48h)")
+			f.StringP("save", "s", "", "save to file or directory (default: current directory)")
+		})
+		FlagComps(hostsExportCmd, func(comp *carapace.ActionMap) {
+			(*comp)["format"] = carapace.ActionValues("stix", "attack-flow").Tag("export format")
+			(*comp)["save"] = carapace.ActionFiles().Tag("file to save export")
+		})
+		carapace.Gen(hostsExportCmd).PositionalAnyCompletion(hosts.HostIDCompleter(con))
+		hostsCmd.AddCommand(hostsExportCmd)
+
+		hostsCoverageCmd := &cobra.Command{
+			Use:   consts.CoverageStr,
+			Short: "Show the MITRE ATT&CK techniques used per host and for the engagement",
+			Long:  help.GetHelpFor([]string{consts.HostsStr, consts.CoverageStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				hosts.HostsCoverageCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, hostsCoverageCmd, func(f *pflag.FlagSet) {
+			f.DurationP("since", "S", 0, "only include commands run in the last duration (e.g. 48h)")
+			f.BoolP("per-host", "p", false, "also show the techniques used on each host")
+			f.StringP("save", "s", "", "save the coverage of each host to a local csv file")
+		})
+		FlagComps(hostsCoverageCmd, func(comp *carapace.ActionMap) {
+			(*comp)["save"] = carapace.ActionFiles().Tag("file to save coverage")
+		})
+		carapace.Gen(hostsCoverageCmd).PositionalAnyCompletion(hosts.HostIDCompleter(con))
+		hostsCmd.AddCommand(hostsCoverageCmd)
+
+		hostsScansCmd := &cobra.Command{
+			Use:   consts.ScansStr,
+			Short: "Show the open ports found by scans run from hosts",
+			Long:  help.GetHelpFor([]string{consts.HostsStr, consts.ScansStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				hosts.HostsScansCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, hostsScansCmd, func(f *pflag.FlagSet) {
+			f.StringP("address", "a", "", "only show results for a scanned address")
+		})
+		carapace.Gen(hostsScansCmd).PositionalCompletion(hosts.HostIDCompleter(con))
+		hostsCmd.AddCommand(hostsScansCmd)
+
+		hostsDiscoveredCmd := &cobra.Command{
+			Use:   consts.DiscoveredStr,
+			Short: "Show the hosts found by network discovery run from hosts",
+			Long:  help.GetHelpFor([]string{consts.HostsStr, consts.DiscoveredStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				hosts.HostsDiscoveredCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, hostsDiscoveredCmd, func(f *pflag.FlagSet) {
+			f.StringP("address", "a", "", "only show a discovered address")
+		})
+		carapace.Gen(hostsDiscoveredCmd).PositionalCompletion(hosts.HostIDCompleter(con))
+		hostsCmd.AddCommand(hostsDiscoveredCmd)
+
 		hostsIOCCmd := &cobra.Command{
 			Use:   consts.IOCStr,
 			Short: "Manage tracked IOCs on a given host",
@@ -1525,6 +2967,10 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		reactionCmd.AddCommand(reactionSetCmd)
 		Flags("reactions", false, reactionSetCmd, func(f *pflag.FlagSet) {
 			f.StringP("event", "e", "", "specify the event type to react to")
+			f.StringSliceP("os", "o", []string{}, "only react to sessions/beacons running one of these operating systems")
+			f.StringSliceP("tag", "t", []string{}, "only react to sessions/beacons with all of these tags")
+			f.StringSliceP("subnet", "n", []string{}, "only react to sessions/beacons connecting from one of these subnets")
+			f.BoolP("stop-on-error", "s", false, "stop running the reaction's commands after a command fails")
 		})
 
 		FlagComps(reactionSetCmd, func(comp *carapace.ActionMap) {
@@ -1536,6 +2982,7 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 				consts.CanaryEvent,
 				consts.WatchtowerEvent,
 			)
+			(*comp)["os"] = carapace.ActionValues("windows", "linux", "darwin")
 		})
 
 		reactionUnsetCmd := &cobra.Command{
@@ -1574,6 +3021,95 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		}
 		reactionCmd.AddCommand(reactionReloadCmd)
 
+		// [ Scripts ] -----------------------------------------------------------------
+
+		scriptsCmd := &cobra.Command{
+			Use:   consts.ScriptsStr,
+			Short: "Manage lua scripts",
+			Long:  help.GetHelpFor([]string{consts.ScriptsStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				scripts.ScriptsCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverHelpGroup,
+		}
+		server.AddCommand(scriptsCmd)
+
+		scriptsLoadCmd := &cobra.Command{
+			Use:   consts.LoadStr + " [SCRIPT] [ARGS...]",
+			Short: "Load a script, its event handlers run until it's unloaded",
+			Long:  help.GetHelpFor([]string{consts.ScriptsStr, consts.LoadStr}),
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				scripts.ScriptsLoadCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(scriptsLoadCmd).PositionalCompletion(carapace.ActionFiles("lua").Tag("lua script"))
+		scriptsCmd.AddCommand(scriptsLoadCmd)
+
+		scriptsUnloadCmd := &cobra.Command{
+			Use:   consts.UnloadStr + " [NAME]",
+			Short: "Unload a script",
+			Long:  help.GetHelpFor([]string{consts.ScriptsStr, consts.UnloadStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				scripts.ScriptsUnloadCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(scriptsUnloadCmd).PositionalCompletion(scripts.ScriptNameCompleter())
+		scriptsCmd.AddCommand(scriptsUnloadCmd)
+
+		scriptsRunCmd := &cobra.Command{
+			Use:   consts.RunStr + " [SCRIPT] [ARGS...]",
+			Short: "Run a script once",
+			Long:  help.GetHelpFor([]string{consts.ScriptsStr, consts.RunStr}),
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				scripts.ScriptsRunCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(scriptsRunCmd).PositionalCompletion(carapace.ActionFiles("lua").Tag("lua script"))
+		scriptsCmd.AddCommand(scriptsRunCmd)
+
+		// [ Macros ] -----------------------------------------------------------------
+
+		macrosCmd := &cobra.Command{
+			Use:   consts.MacrosStr,
+			Short: "Manage command macros",
+			Long:  help.GetHelpFor([]string{consts.MacrosStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				macros.MacrosCmd(cmd, con, args)
+			},
+			GroupID: consts.GenericHelpGroup,
+		}
+		server.AddCommand(macrosCmd)
+
+		macrosAddCmd := &cobra.Command{
+			Use:   consts.AddStr + " [NAME]",
+			Short: "Add or replace a macro",
+			Long:  help.GetHelpFor([]string{consts.MacrosStr, consts.AddStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				macros.MacrosAddCmd(cmd, con, args)
+			},
+		}
+		macrosCmd.AddCommand(macrosAddCmd)
+		Flags("macros", false, macrosAddCmd, func(f *pflag.FlagSet) {
+			f.StringArrayP("command", "c", []string{}, "command to run, can be repeated ($1..$N and $@ are replaced with the macro's arguments)")
+			f.StringP("description", "d", "", "description of the macro")
+		})
+
+		macrosRmCmd := &cobra.Command{
+			Use:   consts.RmStr + " [NAME]",
+			Short: "Remove a macro",
+			Long:  help.GetHelpFor([]string{consts.MacrosStr, consts.RmStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				macros.MacrosRmCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(macrosRmCmd).PositionalCompletion(macros.MacroNameCompleter())
+		macrosCmd.AddCommand(macrosRmCmd)
+
 		// [ Prelude's Operator ] ------------------------------------------------------------
 		operatorCmd := &cobra.Command{
 			Use:     consts.PreludeOperatorStr,
@@ -1620,6 +3156,43 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 
+		// [ Toolchains ] ------------------------------------------------------------
+		toolchainsCmd := &cobra.Command{
+			Use:   consts.ToolchainsStr,
+			Short: "List the server's cross-compilers and toolchains",
+			Long:  help.GetHelpFor([]string{consts.ToolchainsStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				toolchains.ToolchainsCmd(cmd, con, args)
+			},
+			GroupID: consts.PayloadsHelpGroup,
+		}
+		server.AddCommand(toolchainsCmd)
+
+		toolchainsInstallCmd := &cobra.Command{
+			Use:   consts.InstallStr,
+			Short: "Download and install a cross-compiler toolchain on the server",
+			Long:  help.GetHelpFor([]string{consts.ToolchainsStr, consts.InstallStr}),
+			Args:  cobra.NoArgs,
+			Run: func(cmd *cobra.Command, args []string) {
+				toolchains.ToolchainsInstallCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, toolchainsInstallCmd, func(f *pflag.FlagSet) {
+			f.StringP("version", "v", "", "zig version to install (default: the server config's version)")
+		})
+		toolchainsCmd.AddCommand(toolchainsInstallCmd)
+
+		toolchainsRmCmd := &cobra.Command{
+			Use:   consts.RmStr,
+			Short: "Remove a toolchain from the server",
+			Long:  help.GetHelpFor([]string{consts.ToolchainsStr, consts.RmStr}),
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				toolchains.ToolchainsRmCmd(cmd, con, args)
+			},
+		}
+		toolchainsCmd.AddCommand(toolchainsRmCmd)
+
 		// [ Crack ] ------------------------------------------------------------
 		crackCmd := &cobra.Command{
 			Use:     consts.CrackStr,
@@ -1761,6 +3334,21 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 		}
 		server.AddCommand(taskmanyCmd)
 
+		taskmanyRunCmd := &cobra.Command{
+			Use:   consts.RunStr,
+			Short: "Run any command on selected beacons and sessions, results grouped by host",
+			Long:  help.GetHelpFor([]string{consts.TaskmanyStr, consts.RunStr}),
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				taskmany.TaskmanyRunCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, taskmanyRunCmd, func(f *pflag.FlagSet) {
+			f.DurationP("wait", "w", taskmany.DefaultWait, "how long to wait for beacon results before showing the output")
+		})
+		taskmanyRunCmd.Flags().SetInterspersed(false)
+		taskmanyCmd.AddCommand(taskmanyRunCmd)
+
 		// Add the relevant beacon commands as a subcommand to taskmany
 		taskmanyCmds := map[string]bool{
 			consts.ExecuteStr:     true,
@@ -1781,6 +3369,8 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 			consts.NetstatStr:     true,
 			consts.PsStr:          true,
 			consts.IfconfigStr:    true,
+			consts.EDREnumStr:     true,
+			consts.DiscoverStr:    true,
 		}
 
 		for _, c := range SliverCommands(con)().Commands() {
@@ -1789,6 +3379,35 @@ func ServerCommands(con *client.SliverConsoleClient, serverCmds func() []*cobra.
 				taskmanyCmd.AddCommand(taskmany.WrapCommand(c, con))
 			}
 		}
+
+		// [ Panes ] -----------------------------------------------
+
+		panesCmd := &cobra.Command{
+			Use:     consts.PanesStr,
+			Short:   "Split the terminal into panes to work with several sessions/beacons at once",
+			Long:    help.GetHelpFor([]string{consts.PanesStr}),
+			GroupID: consts.GenericHelpGroup,
+			Run: func(cmd *cobra.Command, args []string) {
+				panes.PanesCmd(cmd, con, args)
+			},
+		}
+		Flags("", false, panesCmd, func(f *pflag.FlagSet) {
+			f.StringArrayP("shell", "s", []string{}, "open a shell pane on a session (name or id)")
+			f.StringArrayP("task", "t", []string{}, "open a task pane on a session or beacon (name or id)")
+			f.BoolP("no-events", "E", false, "don't show the event ticker pane")
+			f.BoolP("columns", "c", false, "place the panes side by side instead of stacked")
+		})
+		FlagComps(panesCmd, func(comp *carapace.ActionMap) {
+			(*comp)["shell"] = use.SessionIDCompleter(con)
+			(*comp)["task"] = use.BeaconAndSessionIDCompleter(con)
+		})
+		server.AddCommand(panesCmd)
+
+		// [ Plugins ] ---------------------------------------------
+
+		// Added last so they can't replace any of the commands above
+		plugins.RegisterCommands(plugins.MenuServer, server, con)
+
 		// [ Post-command declaration setup]-----------------------------------------
 
 		// Everything below this line should preferably not be any command binding
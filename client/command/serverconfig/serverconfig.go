@@ -0,0 +1,48 @@
+package serverconfig
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// ReloadConfigCmd - Reload the server config on the server's disk without restarting it
+func ReloadConfigCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	reload, err := con.Rpc.ReloadServerConfig(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Reloaded: %s\n", strings.Join(reload.Reloaded, ", "))
+	components := []string{}
+	for component := range reload.Errors {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+	for _, component := range components {
+		con.PrintErrorf("Failed to reload %s: %s\n", component, reload.Errors[component])
+	}
+}
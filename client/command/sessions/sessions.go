@@ -31,6 +31,7 @@ import (
 
 	"github.com/bishopfox/sliver/client/command/kill"
 	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/command/tags"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
@@ -102,6 +103,10 @@ func SessionsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 			con.PrintErrorf("Invalid session name or session number: %s\n", interact)
 		}
 	} else {
+		if con.IsJSON() {
+			con.PrintJSON(sessions)
+			return
+		}
 		filter, _ := cmd.Flags().GetString("filter")
 		var filterRegex *regexp.Regexp
 		if filter != "" {
@@ -143,11 +148,13 @@ func PrintSessions(sessions map[string]*clientpb.Session, filter string, filterR
 			"Name",
 			"Transport",
 			"Remote Address",
+			"Origin",
 			"Hostname",
 			"Username",
 			"Operating System",
 			"Locale",
 			"Last Message",
+			"Tags",
 			"Health",
 		})
 	} else {
@@ -167,10 +174,12 @@ func PrintSessions(sessions map[string]*clientpb.Session, filter string, filterR
 	})
 
 	for _, session := range sessions {
-		color := console.Normal
-		if con.ActiveTarget.GetSession() != nil && con.ActiveTarget.GetSession().ID == session.ID {
-			color = console.Green
+		matched, isMetadataFilter := tags.MatchFilter(filter, session.Tags, session.Note, session.Color)
+		if isMetadataFilter && !matched {
+			continue
 		}
+		activeSession := con.ActiveTarget.GetSession()
+		color := tags.RowColor(session.Color, activeSession != nil && activeSession.ID == session.ID)
 		var SessionHealth string
 		if session.IsDead {
 			SessionHealth = console.Bold + console.Red + "[DEAD]" + console.Normal
@@ -190,11 +199,13 @@ func PrintSessions(sessions map[string]*clientpb.Session, filter string, filterR
 				fmt.Sprintf(color+"%s"+console.Normal, session.Name),
 				fmt.Sprintf(color+"%s"+console.Normal, session.Transport),
 				fmt.Sprintf(color+"%s"+console.Normal, session.RemoteAddress),
+				fmt.Sprintf(color+"%s"+console.Normal, con.FormatAddressInfo(session.AddressInfo, false)),
 				fmt.Sprintf(color+"%s"+console.Normal, session.Hostname),
 				fmt.Sprintf(color+"%s"+console.Normal, username),
 				fmt.Sprintf(color+"%s/%s"+console.Normal, session.OS, session.Arch),
 				fmt.Sprintf(color+"%s"+console.Normal, session.Locale),
 				con.FormatDateDelta(time.Unix(session.LastCheckin, 0), wideTermWidth, false),
+				fmt.Sprintf(color+"%s"+console.Normal, strings.Join(session.Tags, ",")),
 				burned + SessionHealth,
 			}
 		} else {
@@ -214,11 +225,11 @@ func PrintSessions(sessions map[string]*clientpb.Session, filter string, filterR
 			row = append(row, entry)
 		}
 		// Apply filters if any
-		if filter == "" && filterRegex == nil {
+		if (filter == "" || isMetadataFilter) && filterRegex == nil {
 			tw.AppendRow(row)
 		} else {
 			for _, rowEntry := range rowEntries {
-				if filter != "" {
+				if filter != "" && !isMetadataFilter {
 					if strings.Contains(rowEntry, filter) {
 						tw.AppendRow(row)
 						break
@@ -27,6 +27,7 @@ import (
 
 	"github.com/bishopfox/sliver/client/assets"
 	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/util"
 )
 
 // SettingsCmd - The client settings command
@@ -50,6 +51,7 @@ func SettingsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []st
 	tw.AppendRow(table.Row{"Always Overflow", con.Settings.AlwaysOverflow, "Disable table pagination"})
 	tw.AppendRow(table.Row{"Vim Mode", con.Settings.VimMode, "Navigation mode, vim style"})
 	tw.AppendRow(table.Row{"Console Logs", con.Settings.ConsoleLogs, "Log console output to disk"})
+	tw.AppendRow(table.Row{"Auto Loot", autoLootSize(con.Settings.AutoLootSize), "Save command output larger than this as loot"})
 	con.Printf("%s\n", tw.Render())
 }
 
@@ -111,6 +113,43 @@ func SettingsSmallTerm(cmd *cobra.Command, con *console.SliverConsoleClient, arg
 	con.PrintInfof("Small terminal width set to %d\n", con.Settings.SmallTermWidth)
 }
 
+// SettingsAutoLootCmd - Set the size above which command output is saved as loot, 0 disables it
+func SettingsAutoLootCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	var err error
+	if con.Settings == nil {
+		con.Settings, err = assets.LoadSettings()
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+	}
+	result := ""
+	if 0 < len(args) {
+		result = args[0]
+	} else {
+		prompt := &survey.Input{Message: "Save output larger than (e.g. 64k, 0 to disable):"}
+		err = survey.AskOne(prompt, &result)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+	}
+	size, err := console.ParseSize(result)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.Settings.AutoLootSize = size
+	con.PrintInfof("Auto loot = %s\n", autoLootSize(con.Settings.AutoLootSize))
+}
+
+func autoLootSize(size int64) string {
+	if size <= 0 {
+		return "disabled"
+	}
+	return util.ByteCountBinary(size)
+}
+
 // SettingsTablesCmd - The client settings command
 func SettingsTablesCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
 	var err error
@@ -85,10 +85,13 @@ func runInteractive(cmd *cobra.Command, shellPath string, noPty bool, con *conso
 	// Start() takes an RPC tunnel and creates a local Reader/Writer tunnel object
 	tunnel := core.GetTunnels().Start(rpcTunnel.TunnelID, rpcTunnel.SessionID)
 
+	rows, cols := terminalSize()
 	shell, err := con.Rpc.Shell(context.Background(), &sliverpb.ShellReq{
 		Request:   con.ActiveTarget.Request(cmd),
 		Path:      shellPath,
 		EnablePTY: !noPty,
+		Rows:      rows,
+		Cols:      cols,
 		TunnelID:  tunnel.ID,
 	})
 	if err != nil {
@@ -120,6 +123,18 @@ func runInteractive(cmd *cobra.Command, shellPath string, noPty bool, con *conso
 			return
 		}
 	}
+	restoreOutput := enableUTF8Output()
+	defer restoreOutput()
+
+	// In raw mode Ctrl-C/Ctrl-Z are sent to the PTY as input, otherwise they are
+	// signals to us and have to be forwarded
+	ctxControl, cancelControl := context.WithCancel(context.Background())
+	defer cancelControl()
+	if !noPty {
+		go watchResize(ctxControl, shell.TunnelID, cmd, con)
+	} else {
+		go forwardSignals(ctxControl, shell.TunnelID, cmd, con)
+	}
 
 	log.Printf("Starting stdin/stdout shell ...")
 	go func() {
@@ -145,3 +160,44 @@ func runInteractive(cmd *cobra.Command, shellPath string, noPty bool, con *conso
 	log.Printf("Exit interactive")
 	bufio.NewWriter(os.Stdout).Flush()
 }
+
+// terminalSize - The size of the operator's terminal, zero if it's not a terminal
+func terminalSize() (uint32, uint32) {
+	cols, rows, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return 0, 0
+	}
+	return uint32(rows), uint32(cols)
+}
+
+func resizeShell(tunnelID uint64, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	rows, cols := terminalSize()
+	if rows == 0 || cols == 0 {
+		return
+	}
+	resize, err := con.Rpc.ShellResize(context.Background(), &sliverpb.ShellResizeReq{
+		Request:  con.ActiveTarget.Request(cmd),
+		Rows:     rows,
+		Cols:     cols,
+		TunnelID: tunnelID,
+	})
+	if err != nil {
+		log.Printf("Failed to resize shell: %s", err)
+	} else if resize.Response.GetErr() != "" {
+		log.Printf("Failed to resize shell: %s", resize.Response.GetErr())
+	}
+}
+
+func signalShell(tunnelID uint64, signal string, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	log.Printf("Forwarding %s to shell", signal)
+	resp, err := con.Rpc.ShellSignal(context.Background(), &sliverpb.ShellSignalReq{
+		Request:  con.ActiveTarget.Request(cmd),
+		Signal:   signal,
+		TunnelID: tunnelID,
+	})
+	if err != nil {
+		log.Printf("Failed to send %s: %s", signal, err)
+	} else if resp.Response.GetErr() != "" {
+		log.Printf("Failed to send %s: %s", signal, resp.Response.GetErr())
+	}
+}
@@ -0,0 +1,75 @@
+//go:build !windows
+
+package shell
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// watchResize - Resize the remote PTY whenever our terminal is resized
+func watchResize(ctx context.Context, tunnelID uint64, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-winch:
+			resizeShell(tunnelID, cmd, con)
+		}
+	}
+}
+
+// forwardSignals - Forward Ctrl-C, Ctrl-\, and Ctrl-Z to the remote command instead
+// of letting them interrupt (or suspend) the console
+func forwardSignals(ctx context.Context, tunnelID uint64, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTSTP)
+	defer signal.Stop(signals)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-signals:
+			switch sig {
+			case syscall.SIGINT:
+				signalShell(tunnelID, "SIGINT", cmd, con)
+			case syscall.SIGQUIT:
+				signalShell(tunnelID, "SIGQUIT", cmd, con)
+			case syscall.SIGTSTP:
+				signalShell(tunnelID, "SIGTSTP", cmd, con)
+			}
+		}
+	}
+}
+
+// enableUTF8Output - Terminals are expected to handle UTF-8 already
+func enableUTF8Output() func() {
+	return func() {}
+}
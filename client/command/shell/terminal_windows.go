@@ -0,0 +1,89 @@
+package shell
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+const (
+	utf8CodePage = 65001
+
+	// resizePollInterval - Windows consoles don't signal resizes, so we poll
+	resizePollInterval = 500 * time.Millisecond
+)
+
+var (
+	kernel32               = syscall.NewLazyDLL("kernel32.dll")
+	procGetConsoleOutputCP = kernel32.NewProc("GetConsoleOutputCP")
+	procSetConsoleOutputCP = kernel32.NewProc("SetConsoleOutputCP")
+)
+
+// watchResize - Resize the remote PTY whenever our terminal is resized
+func watchResize(ctx context.Context, tunnelID uint64, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	rows, cols := terminalSize()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resizePollInterval):
+			newRows, newCols := terminalSize()
+			if newRows != rows || newCols != cols {
+				rows, cols = newRows, newCols
+				resizeShell(tunnelID, cmd, con)
+			}
+		}
+	}
+}
+
+// forwardSignals - Forward Ctrl-C to the remote command instead of letting it interrupt the console
+func forwardSignals(ctx context.Context, tunnelID uint64, cmd *cobra.Command, con *console.SliverConsoleClient) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			signalShell(tunnelID, "SIGINT", cmd, con)
+		}
+	}
+}
+
+// enableUTF8Output - Switch the console to the UTF-8 code page so remote output renders
+// correctly, returns a function that restores the previous code page
+func enableUTF8Output() func() {
+	previous, _, _ := procGetConsoleOutputCP.Call()
+	if previous == 0 || previous == utf8CodePage {
+		return func() {}
+	}
+	procSetConsoleOutputCP.Call(utf8CodePage)
+	return func() {
+		procSetConsoleOutputCP.Call(previous)
+	}
+}
@@ -19,14 +19,18 @@ package command
 */
 
 import (
+	"time"
+
 	"github.com/reeflective/console"
 	"github.com/rsteube/carapace"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/ad"
 	"github.com/bishopfox/sliver/client/command/alias"
 	"github.com/bishopfox/sliver/client/command/backdoor"
+	"github.com/bishopfox/sliver/client/command/bloodhound"
 	"github.com/bishopfox/sliver/client/command/completers"
 	"github.com/bishopfox/sliver/client/command/cursed"
 	"github.com/bishopfox/sliver/client/command/dllhijack"
@@ -36,10 +40,14 @@ import (
 	"github.com/bishopfox/sliver/client/command/filesystem"
 	"github.com/bishopfox/sliver/client/command/generate"
 	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/command/history"
 	"github.com/bishopfox/sliver/client/command/info"
 	"github.com/bishopfox/sliver/client/command/kill"
+	"github.com/bishopfox/sliver/client/command/macros"
 	"github.com/bishopfox/sliver/client/command/network"
+	"github.com/bishopfox/sliver/client/command/operators"
 	"github.com/bishopfox/sliver/client/command/pivots"
+	"github.com/bishopfox/sliver/client/command/plugins"
 	"github.com/bishopfox/sliver/client/command/portfwd"
 	"github.com/bishopfox/sliver/client/command/privilege"
 	"github.com/bishopfox/sliver/client/command/processes"
@@ -50,9 +58,11 @@ import (
 	"github.com/bishopfox/sliver/client/command/sessions"
 	"github.com/bishopfox/sliver/client/command/shell"
 	"github.com/bishopfox/sliver/client/command/socks"
+	"github.com/bishopfox/sliver/client/command/tags"
 	"github.com/bishopfox/sliver/client/command/tasks"
 	"github.com/bishopfox/sliver/client/command/use"
 	"github.com/bishopfox/sliver/client/command/wasm"
+	"github.com/bishopfox/sliver/client/command/watch"
 	"github.com/bishopfox/sliver/client/command/wireguard"
 	client "github.com/bishopfox/sliver/client/console"
 	consts "github.com/bishopfox/sliver/client/constants"
@@ -60,13 +70,33 @@ import (
 
 // SliverCommands returns all commands bound to the implant menu.
 func SliverCommands(con *client.SliverConsoleClient) console.Commands {
+	// The trees are rebuilt before each command, plugins are only loaded once
+	plugins.LoadInstalledPlugins(con)
+
 	sliverCommands := func() *cobra.Command {
 		sliver := &cobra.Command{
 			Short: "Implant commands",
 			CompletionOptions: cobra.CompletionOptions{
 				HiddenDefaultCmd: true,
 			},
+			PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+				con.SetJSONOutput(cmd)
+				if err := con.CheckOpsecPolicy(cmd, args); err != nil {
+					cmd.SilenceErrors = true
+					cmd.SilenceUsage = true
+					return err
+				}
+				con.StartAutoLoot(cmd, args)
+				return nil
+			},
+			PersistentPostRun: func(cmd *cobra.Command, _ []string) {
+				con.FinishAutoLoot()
+			},
 		}
+		Flags("", true, sliver, func(f *pflag.FlagSet) {
+			f.Bool(client.JSONFlagStr, false, "print json instead of tables (supported commands only)")
+			f.String(client.AutoLootFlagStr, "", "save output larger than this size as loot and only show a preview (e.g. 64k, 0 to disable)")
+		})
 
 		groups := []*cobra.Group{
 			{ID: consts.SliverCoreHelpGroup, Title: consts.SliverCoreHelpGroup},
@@ -78,6 +108,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			{ID: consts.ProcessHelpGroup, Title: consts.ProcessHelpGroup},
 			{ID: consts.AliasHelpGroup, Title: consts.AliasHelpGroup},
 			{ID: consts.ExtensionHelpGroup, Title: consts.ExtensionHelpGroup},
+			{ID: consts.PluginHelpGroup, Title: consts.PluginHelpGroup},
 		}
 		sliver.AddGroup(groups...)
 
@@ -159,7 +190,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.BoolP("clean", "C", false, "clean out any sessions marked as [DEAD]")
 			f.BoolP("force", "F", false, "force session action without waiting for results")
 
-			f.StringP("filter", "f", "", "filter sessions by substring")
+			f.StringP("filter", "f", "", "filter sessions by substring, or by tag:<tag>, note:<text>, or color:<color>")
 			f.StringP("filter-re", "e", "", "filter sessions by regular expression")
 		})
 		FlagComps(sessionsCmd, func(comp *carapace.ActionMap) {
@@ -210,6 +241,118 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 
+		lockCmd := &cobra.Command{
+			Use:   consts.LockStr,
+			Short: "Lock the active session/beacon so other operators can't task it",
+			Long:  help.GetHelpFor([]string{consts.LockStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				operators.LockCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(lockCmd)
+		Flags("lock", false, lockCmd, func(f *pflag.FlagSet) {
+			f.StringP("reason", "r", "", "reason shown to other operators")
+			f.BoolP("force", "F", false, "take over another operator's lock")
+		})
+
+		unlockCmd := &cobra.Command{
+			Use:   consts.UnlockStr,
+			Short: "Unlock the active session/beacon",
+			Long:  help.GetHelpFor([]string{consts.UnlockStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				operators.UnlockCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(unlockCmd)
+		Flags("unlock", false, unlockCmd, func(f *pflag.FlagSet) {
+			f.BoolP("force", "F", false, "break another operator's lock")
+		})
+
+		tagCmd := &cobra.Command{
+			Use:   consts.TagStr,
+			Short: "Add or remove tags on the active session/beacon",
+			Long:  help.GetHelpFor([]string{consts.TagStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				tags.TagCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(tagCmd)
+		Flags("tag", false, tagCmd, func(f *pflag.FlagSet) {
+			f.StringSliceP("add", "a", []string{}, "tags to add")
+			f.StringSliceP("remove", "r", []string{}, "tags to remove")
+		})
+
+		noteCmd := &cobra.Command{
+			Use:   consts.NoteStr + " [TEXT...]",
+			Short: "Show or set the note on the active session/beacon",
+			Long:  help.GetHelpFor([]string{consts.NoteStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				tags.NoteCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(noteCmd)
+		Flags("note", false, noteCmd, func(f *pflag.FlagSet) {
+			f.BoolP("clear", "c", false, "clear the note")
+		})
+
+		colorCmd := &cobra.Command{
+			Use:   consts.ColorStr + " [COLOR]",
+			Short: "Set the color the active session/beacon is displayed in",
+			Long:  help.GetHelpFor([]string{consts.ColorStr}),
+			Args:  cobra.MaximumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				tags.ColorCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(colorCmd)
+		Flags("color", false, colorCmd, func(f *pflag.FlagSet) {
+			f.BoolP("clear", "c", false, "clear the color")
+		})
+		carapace.Gen(colorCmd).PositionalCompletion(tags.ColorCompleter())
+
+		historyCmd := &cobra.Command{
+			Use:   consts.HistoryStr + " [QUERY...]",
+			Short: "Show or fuzzy search the command history of the active session/beacon",
+			Long:  help.GetHelpFor([]string{consts.HistoryStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				history.HistoryCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(historyCmd)
+		Flags("history", false, historyCmd, func(f *pflag.FlagSet) {
+			f.StringP("operator", "o", "", "only show commands run by an operator")
+			f.IntP("limit", "l", 25, "maximum number of commands to show (0 for all)")
+		})
+
+		macrosCmd := &cobra.Command{
+			Use:   consts.MacrosStr,
+			Short: "List command macros",
+			Long:  help.GetHelpFor([]string{consts.MacrosStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				macros.MacrosCmd(cmd, con, args)
+			},
+			GroupID: consts.SliverCoreHelpGroup,
+		}
+		sliver.AddCommand(macrosCmd)
+
+		macrosRunCmd := &cobra.Command{
+			Use:   consts.RunStr + " [NAME] [ARGS...]",
+			Short: "Run a macro on the active session/beacon",
+			Long:  help.GetHelpFor([]string{consts.MacrosStr, consts.RunStr}),
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				macros.MacrosRunCmd(cmd, con, args)
+			},
+		}
+		carapace.Gen(macrosRunCmd).PositionalCompletion(macros.MacroNameCompleter())
+		macrosCmd.AddCommand(macrosRunCmd)
+
 		openSessionCmd := &cobra.Command{
 			Use:   consts.InteractiveStr,
 			Short: "Task a beacon to open an interactive session (Beacon only)",
@@ -325,15 +468,43 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 
 		cancelCmd := &cobra.Command{
 			Use:   consts.CancelStr,
-			Short: "Cancel a pending beacon task",
+			Short: "Cancel pending beacon tasks",
 			Long:  help.GetHelpFor([]string{consts.TasksStr, consts.CancelStr}),
-			Args:  cobra.RangeArgs(0, 1),
 			Run: func(cmd *cobra.Command, args []string) {
 				tasks.TasksCancelCmd(cmd, con, args)
 			},
 		}
+		Flags("", false, cancelCmd, func(f *pflag.FlagSet) {
+			f.BoolP("all", "a", false, "cancel all pending tasks")
+		})
 		tasksCmd.AddCommand(cancelCmd)
-		carapace.Gen(cancelCmd).PositionalCompletion(tasks.BeaconPendingTasksCompleter(con).Usage("beacon task ID"))
+		carapace.Gen(cancelCmd).PositionalAnyCompletion(tasks.BeaconPendingTasksCompleter(con).Usage("beacon task ID"))
+
+		pendingCmd := &cobra.Command{
+			Use:   consts.PendingStr,
+			Short: "List pending beacon tasks in the order they will be sent",
+			Long:  help.GetHelpFor([]string{consts.TasksStr, consts.PendingStr}),
+			Args:  cobra.NoArgs,
+			Run: func(cmd *cobra.Command, args []string) {
+				tasks.TasksPendingCmd(cmd, con, args)
+			},
+		}
+		tasksCmd.AddCommand(pendingCmd)
+
+		moveCmd := &cobra.Command{
+			Use:   consts.MoveStr,
+			Short: "Move a pending beacon task to a new position in the queue",
+			Long:  help.GetHelpFor([]string{consts.TasksStr, consts.MoveStr}),
+			Args:  cobra.ExactArgs(2),
+			Run: func(cmd *cobra.Command, args []string) {
+				tasks.TasksMoveCmd(cmd, con, args)
+			},
+		}
+		tasksCmd.AddCommand(moveCmd)
+		carapace.Gen(moveCmd).PositionalCompletion(
+			tasks.BeaconPendingTasksCompleter(con).Usage("beacon task ID"),
+			carapace.ActionValues("first", "last").Usage("new position (1 is sent first)"),
+		)
 
 		// [ Info ] --------------------------------------------------------------
 
@@ -422,6 +593,52 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 
+		edrEnumCmd := &cobra.Command{
+			Use:   consts.EDREnumStr,
+			Short: "Enumerate the AV/EDR products on the remote system",
+			Long:  help.GetHelpFor([]string{consts.EDREnumStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				info.EDREnumCmd(cmd, con, args)
+			},
+			GroupID: consts.InfoHelpGroup,
+		}
+		sliver.AddCommand(edrEnumCmd)
+		Flags("", false, edrEnumCmd, func(f *pflag.FlagSet) {
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+
+		adCmd := &cobra.Command{
+			Use:     consts.ADStr,
+			Short:   "Query Active Directory over LDAP from the remote system",
+			Long:    help.GetHelpFor([]string{consts.ADStr}),
+			GroupID: consts.InfoHelpGroup,
+		}
+		sliver.AddCommand(adCmd)
+		Flags("ad", true, adCmd, func(f *pflag.FlagSet) {
+			f.StringP("domain", "d", "", "domain to query (default: the implant's domain)")
+			f.StringP("server", "s", "", "domain controller to query (default: found from the domain)")
+			f.StringP("username", "u", "", "bind as user@domain or DOMAIN\\user (default: the implant's user, windows only)")
+			f.StringP("password", "p", "", "password of --username")
+			f.BoolP("ldaps", "S", false, "connect with ldaps (default: StartTLS)")
+			f.BoolP("insecure-skip-verify", "k", false, "don't verify the domain controller's certificate")
+			f.Uint32P("limit", "l", 0, "maximum number of objects (0 for all)")
+			f.Int32P("query-timeout", "T", 30, "timeout of each ldap operation in seconds")
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+		for _, query := range ad.Queries {
+			query := query
+			adQueryCmd := &cobra.Command{
+				Use:   query.Query,
+				Short: query.Short,
+				Long:  help.GetHelpFor([]string{consts.ADStr}),
+				Args:  cobra.NoArgs,
+				Run: func(cmd *cobra.Command, args []string) {
+					ad.ADCmd(cmd, con, query.Query)
+				},
+			}
+			adCmd.AddCommand(adQueryCmd)
+		}
+
 		// [ Shell ] --------------------------------------------------------------
 
 		shellCmd := &cobra.Command{
@@ -509,6 +726,32 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 		carapace.Gen(executeAssemblyCmd).PositionalCompletion(carapace.ActionFiles().Usage("path to assembly file (required)"))
 		carapace.Gen(executeAssemblyCmd).PositionalAnyCompletion(carapace.ActionValues().Usage("arguments to pass to the assembly entrypoint (optional)"))
 
+		sharpHoundCmd := &cobra.Command{
+			Use:   consts.SharpHoundStr + " [SHARPHOUND] [ARGS]",
+			Short: "Run SharpHound, download the collection, and ingest it (Windows Only)",
+			Long:  help.GetHelpFor([]string{consts.SharpHoundStr}),
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				bloodhound.SharpHoundCmd(cmd, con, args)
+			},
+			GroupID:     consts.ExecutionHelpGroup,
+			Annotations: hideCommand(consts.WindowsCmdsFilter),
+		}
+		sliver.AddCommand(sharpHoundCmd)
+		Flags("", false, sharpHoundCmd, func(f *pflag.FlagSet) {
+			f.StringP("collection", "c", "Default", "collection methods (e.g. Default, All, DCOnly, Session)")
+			f.StringP("directory", "d", "C:\\Windows\\Temp", "remote directory SharpHound writes the collection to")
+			f.StringP("process", "p", "notepad.exe", "hosting process to inject into")
+			f.BoolP("in-process", "i", false, "run in the current sliver process")
+			f.BoolP("keep", "k", false, "don't remove the collection from the target")
+			f.BoolP("upload", "u", false, "upload to the configured BloodHound instance")
+
+			f.Int64P("timeout", "t", 600, "grpc timeout in seconds")
+		})
+		sharpHoundCmd.Flags().ParseErrorsWhitelist.UnknownFlags = true
+		carapace.Gen(sharpHoundCmd).PositionalCompletion(carapace.ActionFiles().Usage("path to SharpHound.exe (required)"))
+		carapace.Gen(sharpHoundCmd).PositionalAnyCompletion(carapace.ActionValues().Usage("additional SharpHound arguments (optional)"))
+
 		executeShellcodeCmd := &cobra.Command{
 			Use:   consts.ExecuteShellcodeStr,
 			Short: "Executes the given shellcode in the sliver process",
@@ -646,6 +889,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 		sliver.AddCommand(msfInjectCmd)
 		Flags("", false, msfInjectCmd, func(f *pflag.FlagSet) {
 			f.IntP("pid", "p", -1, "pid to inject into")
+			f.StringP("spawn", "s", "", "start this process (with arguments) and inject into it instead of --pid")
 			f.StringP("payload", "m", "meterpreter_reverse_https", "msf payload")
 			f.StringP("lhost", "L", "", "listen host")
 			f.IntP("lport", "l", 4444, "listen port")
@@ -932,6 +1176,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.StringP("file-type", "F", "", "force a specific file type (binary/text) if looting")
 			f.StringP("name", "n", "", "name to assign the download if looting")
 			f.BoolP("recurse", "r", false, "recursively download all files in a directory")
+			f.BoolP("background", "b", false, "download in the background (sessions only)")
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 		carapace.Gen(downloadCmd).PositionalCompletion(
@@ -952,6 +1197,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 		sliver.AddCommand(uploadCmd)
 		Flags("", false, uploadCmd, func(f *pflag.FlagSet) {
 			f.BoolP("ioc", "i", false, "track uploaded file as an ioc")
+			f.BoolP("background", "b", false, "upload in the background (sessions only)")
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 		carapace.Gen(uploadCmd).PositionalCompletion(
@@ -959,6 +1205,18 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			carapace.ActionValues().Usage("path to the file or directory to upload to (optional)"),
 		)
 
+		transfersCmd := &cobra.Command{
+			Use:   consts.TransfersStr,
+			Short: "List running and recent uploads/downloads",
+			Long:  help.GetHelpFor([]string{consts.TransfersStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				filesystem.TransfersCmd(cmd, con, args)
+			},
+			GroupID:     consts.FilesystemHelpGroup,
+			Annotations: hideCommand(consts.BeaconCmdsFilter),
+		}
+		sliver.AddCommand(transfersCmd)
+
 		memfilesCmd := &cobra.Command{
 			Use:     consts.MemfilesStr,
 			Short:   "List current memfiles",
@@ -1033,6 +1291,63 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 
+		scanCmd := &cobra.Command{
+			Use:   consts.ScanStr,
+			Short: "TCP connect scan from the remote system",
+			Long:  help.GetHelpFor([]string{consts.ScanStr}),
+			Args:  cobra.MinimumNArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				network.ScanCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		sliver.AddCommand(scanCmd)
+		Flags("", false, scanCmd, func(f *pflag.FlagSet) {
+			f.StringP("ports", "p", network.TopPorts, "ports to scan, e.g. 22,80,8000-8100 ('top' for common ports)")
+			f.BoolP("ping", "P", false, "only port scan hosts that respond to a tcp ping")
+			f.BoolP("banners", "b", false, "grab service banners")
+			f.Int32P("connect-timeout", "T", 1000, "connect timeout in milliseconds")
+			f.Int32P("concurrency", "n", 100, "number of concurrent connections")
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+
+		discoverCmd := &cobra.Command{
+			Use:   consts.DiscoverStr,
+			Short: "Discover the hosts of the networks around the remote system",
+			Long:  help.GetHelpFor([]string{consts.DiscoverStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				network.DiscoverCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		sliver.AddCommand(discoverCmd)
+		Flags("", false, discoverCmd, func(f *pflag.FlagSet) {
+			f.BoolP("arp", "A", false, "read the arp cache")
+			f.BoolP("sweep", "S", false, "arp sweep the targets")
+			f.BoolP("netbios", "N", false, "query netbios names")
+			f.BoolP("mdns", "M", false, "query mdns names")
+			f.BoolP("smb", "s", false, "enumerate smb shares and sessions")
+			f.BoolP("ldap", "L", false, "query the rootDSE of ldap servers")
+			f.BoolP("all", "a", false, "use every discovery method")
+			f.Int32P("query-timeout", "T", 1000, "timeout of each query in milliseconds")
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+
+		resolversCmd := &cobra.Command{
+			Use:   consts.ResolversStr,
+			Short: "Show or set the resolvers of the implant's name resolution",
+			Long:  help.GetHelpFor([]string{consts.ResolversStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				network.ResolversCmd(cmd, con, args)
+			},
+			GroupID: consts.NetworkHelpGroup,
+		}
+		sliver.AddCommand(resolversCmd)
+		Flags("", false, resolversCmd, func(f *pflag.FlagSet) {
+			f.BoolP("reset", "r", false, "use the system's dns servers again")
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+
 		// [ Processes ] ---------------------------------------------
 
 		psCmd := &cobra.Command{
@@ -1053,10 +1368,71 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.BoolP("overflow", "O", false, "overflow terminal width (display truncated rows)")
 			f.IntP("skip-pages", "S", 0, "skip the first n page(s)")
 			f.BoolP("tree", "T", false, "print process tree")
+			f.BoolP("diff", "d", false, "only show processes started/stopped since the last process list")
 
 			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
 		})
 
+		watchCmd := &cobra.Command{
+			Use:   consts.WatchStr,
+			Short: "Re-run ps, netstat, or ifconfig on an interval and highlight changes",
+			Long:  help.GetHelpFor([]string{consts.WatchStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				cmd.Help()
+			},
+			GroupID:     consts.InfoHelpGroup,
+			Annotations: hideCommand(consts.BeaconCmdsFilter),
+		}
+		sliver.AddCommand(watchCmd)
+		Flags("watch", true, watchCmd, func(f *pflag.FlagSet) {
+			f.DurationP("interval", "i", 5*time.Second, "time between runs")
+			f.IntP("count", "n", 0, "stop after n runs (0 runs until enter is pressed)")
+			f.BoolP("changes", "c", false, "only show new and gone rows")
+
+			f.Int64P("timeout", "t", defaultTimeout, "grpc timeout in seconds")
+		})
+
+		watchPsCmd := &cobra.Command{
+			Use:   consts.PsStr,
+			Short: "Watch remote processes",
+			Long:  help.GetHelpFor([]string{consts.WatchStr, consts.PsStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				watch.WatchPsCmd(cmd, con, args)
+			},
+		}
+		watchCmd.AddCommand(watchPsCmd)
+		Flags("", false, watchPsCmd, func(f *pflag.FlagSet) {
+			f.StringP("exe", "e", "", "filter based on executable name")
+			f.StringP("owner", "o", "", "filter based on owner")
+		})
+
+		watchNetstatCmd := &cobra.Command{
+			Use:   consts.NetstatStr,
+			Short: "Watch network connections",
+			Long:  help.GetHelpFor([]string{consts.WatchStr, consts.NetstatStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				watch.WatchNetstatCmd(cmd, con, args)
+			},
+		}
+		watchCmd.AddCommand(watchNetstatCmd)
+		Flags("", false, watchNetstatCmd, func(f *pflag.FlagSet) {
+			f.BoolP("tcp", "T", true, "display information about TCP sockets")
+			f.BoolP("udp", "u", false, "display information about UDP sockets")
+			f.BoolP("ip4", "4", true, "display information about IPv4 sockets")
+			f.BoolP("ip6", "6", false, "display information about IPv6 sockets")
+			f.BoolP("listen", "l", false, "display information about listening sockets")
+		})
+
+		watchIfconfigCmd := &cobra.Command{
+			Use:   consts.IfconfigStr,
+			Short: "Watch network interfaces",
+			Long:  help.GetHelpFor([]string{consts.WatchStr, consts.IfconfigStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				watch.WatchIfconfigCmd(cmd, con, args)
+			},
+		}
+		watchCmd.AddCommand(watchIfconfigCmd)
+
 		procdumpCmd := &cobra.Command{
 			Use:   consts.ProcdumpStr,
 			Short: "Dump process memory",
@@ -1661,6 +2037,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 		Flags("", false, addCmd, func(f *pflag.FlagSet) {
 			f.StringP("remote", "r", "", "remote target host:port (e.g., 10.0.0.1:445)")
 			f.StringP("bind", "b", "127.0.0.1:8080", "bind port forward to interface")
+			f.BoolP("udp", "U", false, "forward udp instead of tcp")
 		})
 		FlagComps(addCmd, func(comp *carapace.ActionMap) {
 			(*comp)["bind"] = completers.ClientInterfacesCompleter()
@@ -1711,6 +2088,7 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			f.StringP("host", "H", "127.0.0.1", "Bind a Socks5 Host")
 			f.StringP("port", "P", "1081", "Bind a Socks5 Port")
 			f.StringP("user", "u", "", "socks5 auth username (will generate random password)")
+			f.Int64P("max-conns", "m", 0, "maximum concurrent connections, new connections over the limit are closed (0 is unlimited)")
 		})
 		FlagComps(socksStartCmd, func(comp *carapace.ActionMap) {
 			(*comp)["host"] = completers.ClientInterfacesCompleter()
@@ -1732,6 +2110,56 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 			(*comp)["id"] = socks.SocksIDCompleter(con)
 		})
 
+		socksConnectionsCmd := &cobra.Command{
+			Use:   consts.ConnectionsStr,
+			Short: "List the connections through the SOCKS5 proxies",
+			Long:  help.GetHelpFor([]string{consts.Socks5Str, consts.ConnectionsStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				socks.SocksConnectionsCmd(cmd, con, args)
+			},
+		}
+		socksCmd.AddCommand(socksConnectionsCmd)
+		Flags("", false, socksConnectionsCmd, func(f *pflag.FlagSet) {
+			f.Uint64P("id", "i", 0, "only list the connections of this socks5 id")
+		})
+		FlagComps(socksConnectionsCmd, func(comp *carapace.ActionMap) {
+			(*comp)["id"] = socks.SocksIDCompleter(con)
+		})
+
+		socksKillCmd := &cobra.Command{
+			Use:   consts.KillStr + " [CONNECTION_ID...]",
+			Short: "Close connections through a SOCKS5 proxy",
+			Long:  help.GetHelpFor([]string{consts.Socks5Str, consts.KillStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				socks.SocksKillCmd(cmd, con, args)
+			},
+		}
+		socksCmd.AddCommand(socksKillCmd)
+		Flags("", false, socksKillCmd, func(f *pflag.FlagSet) {
+			f.Uint64P("id", "i", 0, "close all of the connections of this socks5 id")
+		})
+		FlagComps(socksKillCmd, func(comp *carapace.ActionMap) {
+			(*comp)["id"] = socks.SocksIDCompleter(con)
+		})
+		carapace.Gen(socksKillCmd).PositionalAnyCompletion(socks.SocksConnIDCompleter(con).Usage("connection id"))
+
+		socksLimitCmd := &cobra.Command{
+			Use:   consts.LimitStr,
+			Short: "Set the concurrent connection limit of a SOCKS5 proxy",
+			Long:  help.GetHelpFor([]string{consts.Socks5Str, consts.LimitStr}),
+			Run: func(cmd *cobra.Command, args []string) {
+				socks.SocksLimitCmd(cmd, con, args)
+			},
+		}
+		socksCmd.AddCommand(socksLimitCmd)
+		Flags("", false, socksLimitCmd, func(f *pflag.FlagSet) {
+			f.Uint64P("id", "i", 0, "id of the socks5 proxy")
+			f.Int64P("max-conns", "m", 0, "maximum concurrent connections (0 is unlimited)")
+		})
+		FlagComps(socksLimitCmd, func(comp *carapace.ActionMap) {
+			(*comp)["id"] = socks.SocksIDCompleter(con)
+		})
+
 		// [ WireGuard ] --------------------------------------------------------------
 
 		wgPortFwdCmd := &cobra.Command{
@@ -1991,6 +2419,11 @@ func SliverCommands(con *client.SliverConsoleClient) console.Commands {
 		}
 		wasmCmd.AddCommand(wasmLsCmd)
 
+		// [ Plugins ] ---------------------------------------------
+
+		// Added last so they can't replace any of the commands above
+		plugins.RegisterCommands(plugins.MenuImplant, sliver, con)
+
 		// [ Post-command declaration setup ]----------------------------------------
 
 		// Everything below this line should preferably not be any command binding
@@ -0,0 +1,92 @@
+package socks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/util"
+)
+
+// SocksConnectionsCmd - Display the connections through the socks proxies
+func SocksConnectionsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	socksID, _ := cmd.Flags().GetUint64("id")
+	conns := core.SocksProxies.Connections(socksID)
+	if len(conns) == 0 {
+		con.PrintInfof("No socks5 connections\n")
+		return
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].Started.Before(conns[j].Started)
+	})
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Proxy",
+		"Source",
+		"Destination",
+		"Sent",
+		"Received",
+		"Age",
+	})
+	for _, conn := range conns {
+		destination := conn.Destination
+		if destination == "" {
+			destination = "(handshake)"
+		}
+		tw.AppendRow(table.Row{
+			conn.TunnelID,
+			conn.ProxyID,
+			conn.Source,
+			destination,
+			util.ByteCountBinary(int64(conn.Sent)),
+			util.ByteCountBinary(int64(conn.Received)),
+			time.Since(conn.Started).Round(time.Second),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// SocksConnIDCompleter - Completes the ids of connections through the socks proxies
+func SocksConnIDCompleter(_ *console.SliverConsoleClient) carapace.Action {
+	callback := func(_ carapace.Context) carapace.Action {
+		results := make([]string, 0)
+		for _, conn := range core.SocksProxies.Connections(0) {
+			results = append(results, strconv.FormatUint(conn.TunnelID, 10))
+			results = append(results, fmt.Sprintf("%s -> %s (socks %d)", conn.Source, conn.Destination, conn.ProxyID))
+		}
+		if len(results) == 0 {
+			return carapace.ActionMessage("no socks connections")
+		}
+		return carapace.ActionValuesDescribed(results...).Tag("socks connections")
+	}
+	return carapace.ActionCallback(callback)
+}
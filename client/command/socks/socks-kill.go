@@ -0,0 +1,60 @@
+package socks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+)
+
+// SocksKillCmd - Close connections through the socks proxies
+func SocksKillCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	socksID, _ := cmd.Flags().GetUint64("id")
+	if len(args) == 0 && socksID == 0 {
+		con.PrintErrorf("Must specify connection id(s) or a socks5 id\n")
+		return
+	}
+	tunnelIDs := []uint64{}
+	for _, arg := range args {
+		tunnelID, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			con.PrintErrorf("Invalid connection id '%s'\n", arg)
+			return
+		}
+		tunnelIDs = append(tunnelIDs, tunnelID)
+	}
+	if socksID != 0 {
+		for _, conn := range core.SocksProxies.Connections(socksID) {
+			tunnelIDs = append(tunnelIDs, conn.TunnelID)
+		}
+	}
+	closed := 0
+	for _, tunnelID := range tunnelIDs {
+		if core.SocksProxies.CloseConnection(tunnelID) {
+			closed++
+		} else {
+			con.PrintErrorf("No socks5 connection with id %d\n", tunnelID)
+		}
+	}
+	con.PrintInfof("Closed %d connection(s)\n", closed)
+}
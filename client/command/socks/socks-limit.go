@@ -0,0 +1,49 @@
+package socks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+)
+
+// SocksLimitCmd - Set the number of concurrent connections a socks proxy allows
+func SocksLimitCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	socksID, _ := cmd.Flags().GetUint64("id")
+	if socksID < 1 {
+		con.PrintErrorf("Must specify a valid socks5 id\n")
+		return
+	}
+	maxConnections, _ := cmd.Flags().GetInt64("max-conns")
+	if maxConnections < 0 {
+		con.PrintErrorf("Max connections cannot be negative\n")
+		return
+	}
+	if !core.SocksProxies.SetMaxConnections(socksID, maxConnections) {
+		con.PrintErrorf("No socks5 with id %d\n", socksID)
+		return
+	}
+	if maxConnections == 0 {
+		con.PrintInfof("Removed the connection limit of socks5 %d\n", socksID)
+	} else {
+		con.PrintInfof("Limited socks5 %d to %d concurrent connection(s)\n", socksID, maxConnections)
+	}
+}
@@ -40,6 +40,12 @@ func SocksStartCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []
 		return
 	}
 
+	maxConnections, _ := cmd.Flags().GetInt64("max-conns")
+	if maxConnections < 0 {
+		con.PrintErrorf("Max connections cannot be negative\n")
+		return
+	}
+
 	// listener
 	host, _ := cmd.Flags().GetString("host")
 	port, _ := cmd.Flags().GetString("port")
@@ -76,6 +82,7 @@ func SocksStartCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []
 		Password:        password,
 		KeepAlivePeriod: 60 * time.Second,
 		DialTimeout:     30 * time.Second,
+		MaxConnections:  maxConnections,
 	}
 
 	go func(channelProxy *core.TcpProxy) {
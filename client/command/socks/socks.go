@@ -30,6 +30,7 @@ import (
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/util"
 )
 
 // SocksCmd - Display information about tunneled port forward(s)
@@ -51,9 +52,27 @@ func SocksCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []strin
 		"Bind Address",
 		"Username",
 		"Passwords",
+		"Connections",
+		"Sent",
+		"Received",
+		"Rejected",
 	})
 	for _, p := range socks {
-		tw.AppendRow(table.Row{p.ID, p.SessionID, p.BindAddr, p.Username, p.Password})
+		connections := fmt.Sprintf("%d", p.Connections)
+		if 0 < p.MaxConnections {
+			connections = fmt.Sprintf("%d/%d", p.Connections, p.MaxConnections)
+		}
+		tw.AppendRow(table.Row{
+			p.ID,
+			p.SessionID,
+			p.BindAddr,
+			p.Username,
+			p.Password,
+			connections,
+			util.ByteCountBinary(int64(p.Sent)),
+			util.ByteCountBinary(int64(p.Received)),
+			p.Rejected,
+		})
 	}
 
 	con.Printf("%s\n", tw.Render())
@@ -0,0 +1,85 @@
+package tags
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rsteube/carapace"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// ColorCmd - Set the color the active session/beacon is displayed in
+func ColorCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	clear, _ := cmd.Flags().GetBool("clear")
+	if len(args) == 0 && !clear {
+		con.PrintInfof("Colors: %s\n", strings.Join(colorNames(), ", "))
+		return
+	}
+	color := ""
+	if !clear {
+		color = strings.ToLower(args[0])
+		if !validColor(color) {
+			con.PrintErrorf("Invalid color '%s', must be one of: %s\n", args[0], strings.Join(colorNames(), ", "))
+			return
+		}
+	}
+	_, err := updateMetadata(con, &clientpb.TargetMetadataReq{
+		Color:       color,
+		UpdateColor: true,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if clear {
+		con.PrintInfof("Color cleared\n")
+	} else {
+		con.PrintInfof("Color set to %s%s%s\n", console.TargetColors[color], color, console.Normal)
+	}
+}
+
+// ColorCompleter - Completes the target colors
+func ColorCompleter() carapace.Action {
+	return carapace.ActionValues(colorNames()...).Tag("colors")
+}
+
+func colorNames() []string {
+	names := append([]string{}, consts.TargetColors...)
+	sort.Strings(names)
+	return names
+}
+
+func validColor(color string) bool {
+	for _, name := range consts.TargetColors {
+		if name == color {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package tags
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// NoteCmd - Show or set the note on the active session/beacon
+func NoteCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	clear, _ := cmd.Flags().GetBool("clear")
+	if len(args) == 0 && !clear {
+		_, note, _, err := getMetadata(con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if note == "" {
+			con.PrintInfof("No note\n")
+			return
+		}
+		con.Printf("%s\n", note)
+		return
+	}
+	_, err := updateMetadata(con, &clientpb.TargetMetadataReq{
+		Note:       strings.Join(args, " "),
+		UpdateNote: true,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if clear {
+		con.PrintInfof("Note cleared\n")
+	} else {
+		con.PrintInfof("Note saved\n")
+	}
+}
@@ -0,0 +1,142 @@
+package tags
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// TagCmd - Add or remove tags on the active session/beacon
+func TagCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	add, _ := cmd.Flags().GetStringSlice("add")
+	remove, _ := cmd.Flags().GetStringSlice("remove")
+	if len(add) == 0 && len(remove) == 0 {
+		tags, _, _, err := getMetadata(con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if len(tags) == 0 {
+			con.PrintInfof("No tags\n")
+			return
+		}
+		con.Printf("%s\n", strings.Join(tags, ", "))
+		return
+	}
+	metadata, err := updateMetadata(con, &clientpb.TargetMetadataReq{
+		AddTags:    add,
+		RemoveTags: remove,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(metadata.Tags) == 0 {
+		con.PrintInfof("No tags\n")
+	} else {
+		con.PrintInfof("Tags: %s\n", strings.Join(metadata.Tags, ", "))
+	}
+}
+
+// MatchFilter - Match a tag:<tag>, note:<text>, or color:<color> filter against a
+// session/beacon, ok is false if the filter isn't one of these
+func MatchFilter(filter string, tags []string, note string, color string) (matched bool, ok bool) {
+	key, value, found := strings.Cut(filter, ":")
+	if !found {
+		return false, false
+	}
+	switch strings.ToLower(key) {
+	case "tag":
+		for _, tag := range tags {
+			if strings.EqualFold(tag, value) {
+				return true, true
+			}
+		}
+		return false, true
+	case "note":
+		return strings.Contains(strings.ToLower(note), strings.ToLower(value)), true
+	case "color":
+		return strings.EqualFold(color, value), true
+	}
+	return false, false
+}
+
+// RowColor - The color to display a session/beacon in, the active target is always green
+func RowColor(color string, active bool) string {
+	if active {
+		return console.Green
+	}
+	if ansiColor, ok := console.TargetColors[color]; ok {
+		return ansiColor
+	}
+	return console.Normal
+}
+
+// updateMetadata - Update the active target's metadata, and the cached copy of the target
+func updateMetadata(con *console.SliverConsoleClient, req *clientpb.TargetMetadataReq) (*clientpb.TargetMetadata, error) {
+	session, beacon := con.ActiveTarget.Get()
+	if session != nil {
+		req.TargetID = session.ID
+	} else if beacon != nil {
+		req.TargetID = beacon.ID
+	}
+	metadata, err := con.Rpc.UpdateTargetMetadata(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	if session != nil {
+		session.Tags, session.Note, session.Color = metadata.Tags, metadata.Note, metadata.Color
+	} else if beacon != nil {
+		beacon.Tags, beacon.Note, beacon.Color = metadata.Tags, metadata.Note, metadata.Color
+	}
+	return metadata, nil
+}
+
+// getMetadata - The active target's current tags, note, and color
+func getMetadata(con *console.SliverConsoleClient) ([]string, string, string, error) {
+	session, beacon := con.ActiveTarget.Get()
+	if beacon != nil {
+		beacon, err := con.Rpc.GetBeacon(context.Background(), &clientpb.Beacon{ID: beacon.ID})
+		if err != nil {
+			return nil, "", "", err
+		}
+		return beacon.Tags, beacon.Note, beacon.Color, nil
+	}
+	sessions, err := con.Rpc.GetSessions(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil, "", "", err
+	}
+	for _, current := range sessions.Sessions {
+		if current.ID == session.ID {
+			return current.Tags, current.Note, current.Color, nil
+		}
+	}
+	return session.Tags, session.Note, session.Color, nil
+}
@@ -0,0 +1,69 @@
+package tags
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+)
+
+func TestMatchFilter(t *testing.T) {
+	tags := []string{"dc", "prod"}
+	note := "Primary domain controller"
+	for _, test := range []struct {
+		filter  string
+		matched bool
+		ok      bool
+	}{
+		{"tag:dc", true, true},
+		{"TAG:Prod", true, true},
+		{"tag:d", false, true},
+		{"note:domain", true, true},
+		{"note:workstation", false, true},
+		{"color:red", true, true},
+		{"color:blue", false, true},
+		{"dc", false, false},
+		{"http://example", false, false},
+		{"", false, false},
+	} {
+		matched, ok := MatchFilter(test.filter, tags, note, "red")
+		if matched != test.matched || ok != test.ok {
+			t.Errorf("MatchFilter(%q) = %v, %v, expected %v, %v", test.filter, matched, ok, test.matched, test.ok)
+		}
+	}
+}
+
+func TestTargetColors(t *testing.T) {
+	for _, color := range consts.TargetColors {
+		if _, ok := console.TargetColors[color]; !ok {
+			t.Errorf("No ANSI code for target color %q", color)
+		}
+		if !validColor(color) {
+			t.Errorf("Target color %q is not valid", color)
+		}
+	}
+	if len(console.TargetColors) != len(consts.TargetColors) {
+		t.Errorf("Expected %d ANSI target colors, got %d", len(consts.TargetColors), len(console.TargetColors))
+	}
+	if validColor("pink") {
+		t.Errorf("Expected 'pink' to be an invalid color")
+	}
+}
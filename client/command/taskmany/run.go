@@ -0,0 +1,168 @@
+package taskmany
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+)
+
+// DefaultWait - How long to wait for beacon results by default
+const DefaultWait = time.Minute
+
+// hostOutputs - The outputs of the targets on a single host
+type hostOutputs struct {
+	hostname string
+	outputs  []*console.TargetOutput
+}
+
+// TaskmanyRunCmd - Run a command line on sessions and beacons selected from a
+// checkbox list, the output is shown grouped by host once every target has run it
+func TaskmanyRunCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	line := shellquote.Join(args...)
+	sessions, beacons, err := SelectMultipleBeaconsAndSessions(con)
+	if err != nil {
+		con.Println()
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.Println()
+
+	outputs := []*console.TargetOutput{}
+	skipped := 0
+	run := func(output *console.TargetOutput, err error, name string) {
+		if err != nil {
+			con.PrintErrorf("%s: %s\n", name, err)
+			return
+		}
+		outputs = append(outputs, output)
+	}
+	for _, session := range sessions {
+		if session.IsDead {
+			skipped++
+			continue
+		}
+		con.PrintInfof("Running '%s' on %s ...\n", line, session.Name)
+		output, err := con.RunOnTarget(session, nil, line)
+		run(output, err, session.Name)
+	}
+	for _, beacon := range beacons {
+		if beacon.IsDead {
+			skipped++
+			continue
+		}
+		con.PrintInfof("Tasking %s with '%s' ...\n", beacon.Name, line)
+		output, err := con.RunOnTarget(nil, beacon, line)
+		run(output, err, beacon.Name)
+	}
+	if len(outputs) == 0 {
+		return
+	}
+
+	wait, _ := cmd.Flags().GetDuration("wait")
+	if 0 < len(beacons) && 0 < wait {
+		con.PrintInfof("Waiting up to %s for beacon results ...\n", wait)
+	}
+	waiting := con.WaitForOutputs(outputs, wait)
+	con.Println()
+
+	for _, host := range groupByHost(outputs) {
+		con.Printf(console.Bold+"━━ %s "+console.Normal+"(%d target(s))\n\n", host.hostname, len(host.outputs))
+		for _, output := range host.outputs {
+			con.Printf("%s\n", targetHeading(output))
+			text := strings.TrimSpace(output.String())
+			if text == "" {
+				text = "(no output)"
+			}
+			con.Printf("%s\n\n", text)
+		}
+	}
+
+	errors := 0
+	for _, output := range outputs {
+		if 0 < output.Errors {
+			errors++
+		}
+	}
+	con.PrintInfof("Ran '%s' on %d target(s), %d with errors\n", line, len(outputs), errors)
+	if 0 < skipped {
+		con.PrintWarnf("Skipped %d dead session(s)/beacon(s)\n", skipped)
+	}
+	if 0 < waiting {
+		con.PrintWarnf("%d beacon(s) have not returned results yet, they are printed when they arrive\n", waiting)
+	}
+}
+
+// groupByHost - Outputs grouped by hostname, hosts and targets are sorted by name
+func groupByHost(outputs []*console.TargetOutput) []*hostOutputs {
+	hosts := map[string]*hostOutputs{}
+	for _, output := range outputs {
+		hostname := targetHostname(output)
+		if _, ok := hosts[hostname]; !ok {
+			hosts[hostname] = &hostOutputs{hostname: hostname}
+		}
+		hosts[hostname].outputs = append(hosts[hostname].outputs, output)
+	}
+	grouped := []*hostOutputs{}
+	for _, host := range hosts {
+		sort.SliceStable(host.outputs, func(i, j int) bool {
+			return targetName(host.outputs[i]) < targetName(host.outputs[j])
+		})
+		grouped = append(grouped, host)
+	}
+	sort.Slice(grouped, func(i, j int) bool {
+		return strings.ToLower(grouped[i].hostname) < strings.ToLower(grouped[j].hostname)
+	})
+	return grouped
+}
+
+func targetHostname(output *console.TargetOutput) string {
+	if output.Session != nil {
+		return output.Session.Hostname
+	}
+	return output.Beacon.Hostname
+}
+
+func targetName(output *console.TargetOutput) string {
+	if output.Session != nil {
+		return output.Session.Name
+	}
+	return output.Beacon.Name
+}
+
+func targetHeading(output *console.TargetOutput) string {
+	var kind, id, username string
+	if output.Session != nil {
+		kind, id, username = "session", output.Session.ID, output.Session.Username
+	} else {
+		kind, id, username = "beacon", output.Beacon.ID, output.Beacon.Username
+	}
+	heading := fmt.Sprintf(console.Bold+"%s"+console.Normal+" (%s %s, %s)", targetName(output), kind, strings.Split(id, "-")[0], username)
+	if 0 < output.Errors {
+		heading += console.Red + " [error]" + console.Normal
+	}
+	return heading
+}
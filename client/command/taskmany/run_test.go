@@ -0,0 +1,41 @@
+package taskmany
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestGroupByHost(t *testing.T) {
+	outputs := []*console.TargetOutput{
+		{Beacon: &clientpb.Beacon{Name: "QUIET_OTTER", Hostname: "WS01"}},
+		{Session: &clientpb.Session{Name: "LOUD_BADGER", Hostname: "dc01"}},
+		{Session: &clientpb.Session{Name: "CALM_FOX", Hostname: "WS01"}},
+	}
+	hosts := groupByHost(outputs)
+	if len(hosts) != 2 || hosts[0].hostname != "dc01" || hosts[1].hostname != "WS01" {
+		t.Fatalf("unexpected hosts %v", hosts)
+	}
+	if len(hosts[1].outputs) != 2 || targetName(hosts[1].outputs[0]) != "CALM_FOX" {
+		t.Fatalf("unexpected outputs for WS01")
+	}
+}
@@ -31,10 +31,12 @@ import (
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/bishopfox/sliver/client/command/ad"
 	"github.com/bishopfox/sliver/client/command/environment"
 	"github.com/bishopfox/sliver/client/command/exec"
 	"github.com/bishopfox/sliver/client/command/extensions"
 	"github.com/bishopfox/sliver/client/command/filesystem"
+	"github.com/bishopfox/sliver/client/command/info"
 	"github.com/bishopfox/sliver/client/command/network"
 	"github.com/bishopfox/sliver/client/command/privilege"
 	"github.com/bishopfox/sliver/client/command/processes"
@@ -101,6 +103,10 @@ func TasksFetchCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []
 		con.PrintErrorf("Failed to fetch task content: %s\n", err)
 		return
 	}
+	if con.IsJSON() {
+		con.PrintJSON(task)
+		return
+	}
 	PrintTask(task, con)
 }
 
@@ -142,13 +148,22 @@ func PrintTask(task *clientpb.BeaconTask, con *console.SliverConsoleClient) {
 
 	tw.AppendRow(table.Row{"Request Size", util.ByteCountBinary(int64(len(task.Request)))})
 	if !time.Unix(task.CompletedAt, 0).IsZero() {
-		tw.AppendRow(table.Row{"Response Size", util.ByteCountBinary(int64(len(task.Response)))})
+		size := task.ResponseSize
+		if size == 0 {
+			size = int64(len(task.Response)) // Completed before response sizes were recorded
+		}
+		tw.AppendRow(table.Row{"Response Size", util.ByteCountBinary(size)})
+	}
+	if task.ResponseLootID != "" {
+		tw.AppendRow(table.Row{"Response Loot", task.ResponseLootID})
 	}
 	tw.AppendSeparator()
 	con.Printf("%s\n", tw.Render())
 	if !time.Unix(task.CompletedAt, 0).IsZero() {
 		con.Println()
-		if 0 < len(task.Response) {
+		if task.ResponseLootID != "" {
+			con.PrintWarnf("Task result exceeds the server's result size limit, retrieve it with: loot fetch %s\n", task.ResponseLootID)
+		} else if 0 < len(task.Response) {
 			renderTaskResponse(task, con)
 		} else {
 			con.PrintInfof("No task response\n")
@@ -513,6 +528,33 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 		}
 		network.PrintNetstat(netstat, beacon.PID, beacon.ActiveC2, false, con)
 
+	case sliverpb.MsgDiscoverReq:
+		discover := &sliverpb.Discover{}
+		err := proto.Unmarshal(task.Response, discover)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		network.PrintDiscover(discover, con)
+
+	case sliverpb.MsgEDREnumReq:
+		edrEnum := &sliverpb.EDREnum{}
+		err := proto.Unmarshal(task.Response, edrEnum)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		info.PrintEDREnum(edrEnum, con)
+
+	case sliverpb.MsgADEnumReq:
+		adEnum := &sliverpb.ADEnum{}
+		err := proto.Unmarshal(task.Response, adEnum)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		ad.PrintADEnum(adEnum, con)
+
 	// ---------------------
 	// Privilege commands
 	// ---------------------
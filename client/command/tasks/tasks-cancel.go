@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -9,56 +10,53 @@ import (
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 )
 
-// TasksCancelCmd - Cancel a beacon task before it's sent to the implant
+// TasksCancelCmd - Cancel beacon tasks before they're sent to the implant
 func TasksCancelCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
 	beacon := con.ActiveTarget.GetBeaconInteractive()
 	if beacon == nil {
 		return
 	}
 
-	var idArg string
-	if len(args) > 0 {
-		idArg = args[0]
+	tasks, err := pendingTasks(beacon, con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(tasks) == 0 {
+		con.PrintErrorf("No pending tasks for beacon\n")
+		return
 	}
-	var task *clientpb.BeaconTask
-	var err error
-	if idArg == "" {
-		beaconTasks, err := con.Rpc.GetBeaconTasks(context.Background(), &clientpb.Beacon{ID: beacon.ID})
-		if err != nil {
-			con.PrintErrorf("%s\n", err)
-			return
-		}
-		tasks := []*clientpb.BeaconTask{}
-		for _, task := range beaconTasks.Tasks {
-			if task.State == "pending" {
-				tasks = append(tasks, task)
-			}
-		}
-		if len(tasks) == 0 {
-			con.PrintErrorf("No pending tasks for beacon\n")
-			return
-		}
 
-		task, err = SelectBeaconTask(tasks)
+	all, _ := cmd.Flags().GetBool("all")
+	var cancel []*clientpb.BeaconTask
+	switch {
+	case all:
+		cancel = tasks
+	case len(args) == 0:
+		task, err := SelectBeaconTask(tasks)
 		if err != nil {
 			con.PrintErrorf("%s\n", err)
 			return
 		}
 		con.Printf(console.UpN+console.Clearln, 1)
-	} else {
-		task, err = con.Rpc.GetBeaconTaskContent(context.Background(), &clientpb.BeaconTask{ID: idArg})
-		if err != nil {
-			con.PrintErrorf("%s\n", err)
-			return
+		cancel = append(cancel, task)
+	default:
+		for _, idArg := range args {
+			task, err := pendingTaskByPrefix(tasks, idArg)
+			if err != nil {
+				con.PrintErrorf("%s\n", err)
+				return
+			}
+			cancel = append(cancel, task)
 		}
 	}
 
-	if task != nil {
-		task, err := con.Rpc.CancelBeaconTask(context.Background(), task)
+	for _, task := range cancel {
+		task, err := con.Rpc.CancelBeaconTask(context.Background(), &clientpb.BeaconTask{ID: task.ID})
 		if err != nil {
 			con.PrintErrorf("%s\n", err)
-			return
+			continue
 		}
-		con.PrintInfof("Task %s canceled\n", task.ID)
+		con.PrintInfof("Task %s (%s) canceled\n", strings.Split(task.ID, "-")[0], strings.TrimSuffix(task.Description, "Req"))
 	}
 }
@@ -0,0 +1,183 @@
+package tasks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// TasksPendingCmd - List a beacon's pending tasks in the order they will be sent
+func TasksPendingCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	beacon := con.ActiveTarget.GetBeaconInteractive()
+	if beacon == nil {
+		return
+	}
+	pending, err := pendingTasks(beacon, con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(pending) == 0 {
+		con.PrintInfof("No pending tasks\n")
+		return
+	}
+	con.Printf("%s\n", pendingTasksTable(pending, con))
+	nextCheckin := time.Unix(beacon.NextCheckin, 0)
+	con.PrintInfof("Tasks are sent in this order at the next check-in (%s)\n", con.FormatDateDelta(nextCheckin, true, true))
+}
+
+// TasksMoveCmd - Move a pending task to a new position in the queue
+func TasksMoveCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	beacon := con.ActiveTarget.GetBeaconInteractive()
+	if beacon == nil {
+		return
+	}
+	pending, err := pendingTasks(beacon, con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	position, err := parsePosition(args[1], len(pending))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	reordered, err := moveTask(pending, args[0], position)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	result, err := con.Rpc.ReorderBeaconTasks(context.Background(), &clientpb.BeaconTasks{
+		BeaconID: beacon.ID,
+		Tasks:    reordered,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.Printf("%s\n", pendingTasksTable(result.Tasks, con))
+}
+
+// pendingTasks - A beacon's pending tasks in the order they will be sent
+func pendingTasks(beacon *clientpb.Beacon, con *console.SliverConsoleClient) ([]*clientpb.BeaconTask, error) {
+	beaconTasks, err := con.Rpc.GetBeaconTasks(context.Background(), &clientpb.Beacon{ID: beacon.ID})
+	if err != nil {
+		return nil, err
+	}
+	pending := []*clientpb.BeaconTask{}
+	for _, task := range beaconTasks.Tasks {
+		if task.State == "pending" {
+			pending = append(pending, task)
+		}
+	}
+	sortPending(pending)
+	return pending, nil
+}
+
+func sortPending(tasks []*clientpb.BeaconTask) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Position != tasks[j].Position {
+			return tasks[i].Position < tasks[j].Position
+		}
+		return tasks[i].CreatedAt < tasks[j].CreatedAt
+	})
+}
+
+// parsePosition - A 1-based queue position, or "first"/"last"
+func parsePosition(value string, count int) (int, error) {
+	switch strings.ToLower(value) {
+	case "first":
+		return 1, nil
+	case "last":
+		return count, nil
+	}
+	position, err := strconv.Atoi(value)
+	if err != nil || position < 1 || count < position {
+		return 0, fmt.Errorf("invalid position '%s', must be 1-%d, first, or last", value, count)
+	}
+	return position, nil
+}
+
+// pendingTaskByPrefix - The pending task with an ID starting with the prefix
+func pendingTaskByPrefix(tasks []*clientpb.BeaconTask, prefix string) (*clientpb.BeaconTask, error) {
+	var found *clientpb.BeaconTask
+	for _, task := range tasks {
+		if strings.HasPrefix(task.ID, prefix) {
+			if found != nil {
+				return nil, fmt.Errorf("'%s' matches more than one pending task", prefix)
+			}
+			found = task
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no pending task matching '%s'", prefix)
+	}
+	return found, nil
+}
+
+// moveTask - The tasks with the task matching the id (prefix) moved to a 1-based position
+func moveTask(tasks []*clientpb.BeaconTask, id string, position int) ([]*clientpb.BeaconTask, error) {
+	task, err := pendingTaskByPrefix(tasks, id)
+	if err != nil {
+		return nil, err
+	}
+	if position < 1 || len(tasks) < position {
+		return nil, fmt.Errorf("invalid position %d", position)
+	}
+	moved := []*clientpb.BeaconTask{}
+	for _, other := range tasks {
+		if other != task {
+			moved = append(moved, other)
+		}
+	}
+	moved = append(moved[:position-1], append([]*clientpb.BeaconTask{task}, moved[position-1:]...)...)
+	return moved, nil
+}
+
+func pendingTasksTable(tasks []*clientpb.BeaconTask, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"#",
+		"ID",
+		"Message Type",
+		"Created",
+	})
+	for index, task := range tasks {
+		tw.AppendRow(table.Row{
+			index + 1,
+			strings.Split(task.ID, "-")[0],
+			strings.TrimSuffix(task.Description, "Req"),
+			time.Unix(task.CreatedAt, 0).Format(time.RFC1123),
+		})
+	}
+	return tw.Render()
+}
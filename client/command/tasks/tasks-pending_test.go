@@ -0,0 +1,81 @@
+package tasks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func taskIDs(tasks []*clientpb.BeaconTask) string {
+	ids := ""
+	for _, task := range tasks {
+		ids += task.ID[:1]
+	}
+	return ids
+}
+
+func TestMoveTask(t *testing.T) {
+	tasks := []*clientpb.BeaconTask{{ID: "a-1"}, {ID: "b-2"}, {ID: "c-3"}, {ID: "d-4"}}
+	for _, test := range []struct {
+		id       string
+		position int
+		expected string
+	}{
+		{"d", 1, "dabc"},
+		{"a", 4, "bcda"},
+		{"b", 3, "acbd"},
+		{"c-3", 3, "abcd"},
+	} {
+		moved, err := moveTask(tasks, test.id, test.position)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if taskIDs(moved) != test.expected {
+			t.Errorf("move %s to %d: expected %s, got %s", test.id, test.position, test.expected, taskIDs(moved))
+		}
+	}
+	if taskIDs(tasks) != "abcd" {
+		t.Fatalf("tasks were modified: %s", taskIDs(tasks))
+	}
+	if _, err := moveTask(tasks, "e", 1); err == nil {
+		t.Fatal("expected an error for an unknown task")
+	}
+	if _, err := moveTask(tasks, "", 1); err == nil {
+		t.Fatal("expected an error for an ambiguous prefix")
+	}
+	if _, err := moveTask(tasks, "a", 5); err == nil {
+		t.Fatal("expected an error for an invalid position")
+	}
+}
+
+func TestParsePosition(t *testing.T) {
+	for value, expected := range map[string]int{"first": 1, "LAST": 5, "3": 3} {
+		position, err := parsePosition(value, 5)
+		if err != nil || position != expected {
+			t.Errorf("parsePosition(%s): expected %d, got %d (%v)", value, expected, position, err)
+		}
+	}
+	for _, value := range []string{"0", "6", "top"} {
+		if _, err := parsePosition(value, 5); err == nil {
+			t.Errorf("expected an error for '%s'", value)
+		}
+	}
+}
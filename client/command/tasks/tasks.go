@@ -43,6 +43,10 @@ func TasksCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []strin
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	if con.IsJSON() {
+		con.PrintJSON(beaconTasks)
+		return
+	}
 	PrintBeaconTasks(beaconTasks.Tasks, cmd, con)
 }
 
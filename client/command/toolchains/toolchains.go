@@ -0,0 +1,137 @@
+package toolchains
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2022  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// ToolchainsCmd - List the server's cross-compilers and installed toolchains
+func ToolchainsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	toolchains, err := con.Rpc.Toolchains(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(toolchains.CrossCompilers) == 0 {
+		con.PrintInfof("No cross-compilers found\n")
+	} else {
+		PrintCrossCompilers(toolchains.CrossCompilers, con)
+	}
+	if 0 < len(toolchains.Toolchains) {
+		con.Println()
+		PrintToolchains(toolchains.Toolchains, con)
+	}
+	if 0 < len(toolchains.MissingTargets) {
+		missing := []string{}
+		for _, target := range toolchains.MissingTargets {
+			missing = append(missing, fmt.Sprintf("%s/%s", target.GOOS, target.GOARCH))
+		}
+		con.Println()
+		con.PrintWarnf("No cross-compiler for shared libraries targeting: %s\n", strings.Join(missing, ", "))
+		if toolchains.AutoInstall {
+			con.PrintInfof("A toolchain will be installed by the first build that needs one\n")
+		} else {
+			con.PrintInfof("Install one with 'toolchains install'\n")
+		}
+	}
+}
+
+// PrintCrossCompilers - Print the cross-compiler of each target
+func PrintCrossCompilers(crossCompilers []*clientpb.CrossCompiler, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Target", "CC", "CXX"})
+	for _, crossCompiler := range crossCompilers {
+		tw.AppendRow(table.Row{
+			fmt.Sprintf("%s/%s", crossCompiler.TargetGOOS, crossCompiler.TargetGOARCH),
+			crossCompiler.CCPath,
+			crossCompiler.CXXPath,
+		})
+	}
+	tw.SortBy([]table.SortBy{{Name: "Target", Mode: table.Asc}})
+	con.Printf("%s\n", tw.Render())
+}
+
+// PrintToolchains - Print installed toolchains
+func PrintToolchains(toolchains []*clientpb.Toolchain, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Toolchain", "Version", "Active", "Path", "Targets"})
+	for _, toolchain := range toolchains {
+		targets := []string{}
+		for _, target := range toolchain.Targets {
+			targets = append(targets, fmt.Sprintf("%s/%s", target.GOOS, target.GOARCH))
+		}
+		active := ""
+		if toolchain.Active {
+			active = "yes"
+		}
+		tw.AppendRow(table.Row{
+			toolchain.Name,
+			toolchain.Version,
+			active,
+			toolchain.Path,
+			strings.Join(targets, "\n"),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// ToolchainsInstallCmd - Download and install a toolchain on the server
+func ToolchainsInstallCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	version, _ := cmd.Flags().GetString("version")
+	ctrl := make(chan bool)
+	con.SpinUntil("Installing toolchain, please wait ...", ctrl)
+	toolchain, err := con.Rpc.ToolchainInstall(context.Background(), &clientpb.ToolchainReq{
+		Version: version,
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Installed %s %s to %s\n", toolchain.Name, toolchain.Version, toolchain.Path)
+	if !toolchain.Active {
+		con.PrintWarnf("Builds use another installed version, set zig_version in the server config to use this one\n")
+	}
+}
+
+// ToolchainsRmCmd - Remove a toolchain from the server
+func ToolchainsRmCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	_, err := con.Rpc.ToolchainRemove(context.Background(), &clientpb.ToolchainReq{
+		Version: args[0],
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed toolchain %s\n", args[0])
+}
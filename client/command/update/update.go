@@ -138,7 +138,8 @@ func VerboseVersionsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, ar
 	con.PrintInfof("Client %s - %s/%s\n", clientVer, runtime.GOOS, runtime.GOARCH)
 	clientCompiledAt, _ := version.Compiled()
 	con.Printf("    Compiled at %s\n", clientCompiledAt)
-	con.Printf("    Compiled with %s\n\n", version.GoVersion)
+	con.Printf("    Compiled with %s\n", version.GoVersion)
+	con.Printf("    API v%d (supports server api v%d and later)\n\n", version.APIVersion, version.MinAPIVersion)
 
 	con.Println()
 	con.PrintInfof("Server v%d.%d.%d - %s - %s/%s\n",
@@ -146,6 +147,15 @@ func VerboseVersionsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, ar
 		serverVer.OS, serverVer.Arch)
 	serverCompiledAt := time.Unix(serverVer.CompiledAt, 0)
 	con.Printf("    Compiled at %s\n", serverCompiledAt)
+	if serverVer.APIVersion == 0 {
+		con.Printf("    API unknown (predates api versioning)\n")
+	} else {
+		con.Printf("    API v%d (supports client api v%d and later)\n", serverVer.APIVersion, serverVer.MinAPIVersion)
+		con.Printf("    Implant protocol v%d\n", serverVer.ImplantProtocolVersion)
+	}
+	if err := version.CheckAPIVersion("server", serverVer.APIVersion, serverVer.MinAPIVersion); err != nil {
+		con.PrintWarnf("%s\n", err)
+	}
 }
 
 func updateSavePath(cmd *cobra.Command) (string, error) {
@@ -49,6 +49,17 @@ func UseCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string)
 		idArg = args[0]
 	}
 
+	// server/id switches to another server first
+	if server, id, found := strings.Cut(idArg, "/"); found {
+		conn, err := con.Connect(server)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Active server %s\n", conn.Name)
+		idArg = id
+	}
+
 	// idArg := ctx.Args.String("id")
 	if idArg != "" {
 		session, beacon, err = SessionOrBeaconByID(idArg, con)
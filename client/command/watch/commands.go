@@ -0,0 +1,129 @@
+package watch
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// WatchPsCmd - Watch the remote system's processes
+func WatchPsCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	exeFilter, _ := cmd.Flags().GetString("exe")
+	ownerFilter, _ := cmd.Flags().GetString("owner")
+	watch(cmd, con, "ps", func() (*snapshot, error) {
+		ps, err := con.Rpc.Ps(context.Background(), &sliverpb.PsReq{
+			Request: con.ActiveTarget.Request(cmd),
+		})
+		if err != nil {
+			return nil, err
+		}
+		processes := ps.Processes
+		sort.Slice(processes, func(i, j int) bool {
+			return processes[i].Pid < processes[j].Pid
+		})
+		snap := newSnapshot(table.Row{"pid", "ppid", "owner", "arch", "executable"})
+		for _, proc := range processes {
+			if exeFilter != "" && !strings.Contains(strings.ToLower(proc.Executable), strings.ToLower(exeFilter)) {
+				continue
+			}
+			if ownerFilter != "" && !strings.Contains(strings.ToLower(proc.Owner), strings.ToLower(ownerFilter)) {
+				continue
+			}
+			snap.add(joinKey(proc.Pid, proc.Executable), table.Row{proc.Pid, proc.Ppid, proc.Owner, proc.Architecture, proc.Executable})
+		}
+		return snap, nil
+	})
+}
+
+// WatchNetstatCmd - Watch the remote system's network connections
+func WatchNetstatCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	listening, _ := cmd.Flags().GetBool("listen")
+	ip4, _ := cmd.Flags().GetBool("ip4")
+	ip6, _ := cmd.Flags().GetBool("ip6")
+	tcp, _ := cmd.Flags().GetBool("tcp")
+	udp, _ := cmd.Flags().GetBool("udp")
+	watch(cmd, con, "netstat", func() (*snapshot, error) {
+		netstat, err := con.Rpc.Netstat(context.Background(), &sliverpb.NetstatReq{
+			Request:   con.ActiveTarget.Request(cmd),
+			TCP:       tcp,
+			UDP:       udp,
+			Listening: listening,
+			IP4:       ip4,
+			IP6:       ip6,
+		})
+		if err != nil {
+			return nil, err
+		}
+		snap := newSnapshot(table.Row{"Protocol", "Local Address", "Foreign Address", "State", "PID/Program name"})
+		for _, entry := range netstat.Entries {
+			pid := ""
+			if entry.Process != nil {
+				pid = fmt.Sprintf("%d/%s", entry.Process.Pid, entry.Process.Executable)
+			}
+			srcAddr := fmt.Sprintf("%s:%d", entry.LocalAddr.Ip, entry.LocalAddr.Port)
+			dstAddr := fmt.Sprintf("%s:%d", entry.RemoteAddr.Ip, entry.RemoteAddr.Port)
+			snap.add(joinKey(entry.Protocol, srcAddr, dstAddr, entry.SkState, pid), table.Row{entry.Protocol, srcAddr, dstAddr, entry.SkState, pid})
+		}
+		return snap, nil
+	})
+}
+
+// WatchIfconfigCmd - Watch the remote system's network interfaces
+func WatchIfconfigCmd(cmd *cobra.Command, con *console.SliverConsoleClient, args []string) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	watch(cmd, con, "ifconfig", func() (*snapshot, error) {
+		ifconfig, err := con.Rpc.Ifconfig(context.Background(), &sliverpb.IfconfigReq{
+			Request: con.ActiveTarget.Request(cmd),
+		})
+		if err != nil {
+			return nil, err
+		}
+		interfaces := ifconfig.NetInterfaces
+		sort.Slice(interfaces, func(i, j int) bool {
+			return interfaces[i].Index < interfaces[j].Index
+		})
+		snap := newSnapshot(table.Row{"Interface", "IP Address", "MAC Address"})
+		for _, iface := range interfaces {
+			for _, ip := range iface.IPAddresses {
+				snap.add(joinKey(iface.Name, ip), table.Row{iface.Name, ip, iface.MAC})
+			}
+		}
+		return snap, nil
+	})
+}
@@ -0,0 +1,177 @@
+package watch
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+)
+
+const (
+	clearScreen = "\033[H\033[2J"
+
+	newColor  = console.Bold + console.Green
+	goneColor = console.Red
+)
+
+// snapshot - The output of one run of a watched command, rows are keyed so
+// that runs can be compared
+type snapshot struct {
+	header table.Row
+	keys   []string
+	rows   map[string]table.Row
+}
+
+func newSnapshot(header table.Row) *snapshot {
+	return &snapshot{header: header, keys: []string{}, rows: map[string]table.Row{}}
+}
+
+func (s *snapshot) add(key string, row table.Row) {
+	if _, ok := s.rows[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.rows[key] = row
+}
+
+// diff - Keys that are in the snapshot but not the baseline, and keys that are
+// in the baseline but no longer in the snapshot
+func (s *snapshot) diff(baseline *snapshot) ([]string, []string) {
+	added := []string{}
+	for _, key := range s.keys {
+		if _, ok := baseline.rows[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	removed := []string{}
+	for _, key := range baseline.keys {
+		if _, ok := s.rows[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	return added, removed
+}
+
+// fetchFunc - Runs the watched command once
+type fetchFunc func() (*snapshot, error)
+
+// watch - Re-run a command on an interval until enter is pressed, rows that weren't in the
+// first run are highlighted and rows that have disappeared since are shown at the bottom
+func watch(cmd *cobra.Command, con *console.SliverConsoleClient, name string, fetch fetchFunc) {
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval < time.Second {
+		con.PrintErrorf("Interval must be at least 1s\n")
+		return
+	}
+	count, _ := cmd.Flags().GetInt("count")
+	changesOnly, _ := cmd.Flags().GetBool("changes")
+
+	// Only wait for enter if we'll loop forever, otherwise the goroutine would be
+	// left reading stdin after we return
+	var done <-chan bool
+	if count == 0 {
+		done = waitForInput()
+	}
+	var baseline *snapshot
+	for run := 1; ; run++ {
+		current, err := fetch()
+		con.Printf(clearScreen)
+		con.Printf(console.Bold+"Every %s: %s"+console.Normal+" (%s)\n\n", interval, name, time.Now().Format(time.TimeOnly))
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+		} else {
+			if baseline == nil {
+				baseline = current
+			}
+			renderSnapshot(current, baseline, changesOnly, con)
+		}
+		if 0 < count && count <= run {
+			return
+		}
+		if done != nil {
+			con.Printf("\nPress enter to stop.\n")
+		}
+		select {
+		case <-done:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func renderSnapshot(current *snapshot, baseline *snapshot, changesOnly bool, con *console.SliverConsoleClient) {
+	added, removed := current.diff(baseline)
+	isNew := map[string]bool{}
+	for _, key := range added {
+		isNew[key] = true
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(current.header)
+	for _, key := range current.keys {
+		if isNew[key] {
+			tw.AppendRow(colorRow(current.rows[key], newColor))
+		} else if !changesOnly {
+			tw.AppendRow(current.rows[key])
+		}
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		tw.AppendRow(colorRow(baseline.rows[key], goneColor))
+	}
+	if 0 < tw.Length() {
+		con.Printf("%s\n", tw.Render())
+	}
+	con.Printf("\n%s%d new%s, %s%d gone%s since the first run\n",
+		newColor, len(added), console.Normal, goneColor, len(removed), console.Normal)
+}
+
+func colorRow(row table.Row, color string) table.Row {
+	colored := table.Row{}
+	for _, value := range row {
+		colored = append(colored, fmt.Sprintf(color+"%v"+console.Normal, value))
+	}
+	return colored
+}
+
+func waitForInput() <-chan bool {
+	done := make(chan bool, 1)
+	go func() {
+		defer close(done)
+		fmt.Scanf("\n")
+		done <- true
+	}()
+	return done
+}
+
+func joinKey(values ...interface{}) string {
+	parts := []string{}
+	for _, value := range values {
+		parts = append(parts, fmt.Sprintf("%v", value))
+	}
+	return strings.Join(parts, "|")
+}
@@ -0,0 +1,52 @@
+package watch
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+func TestSnapshotDiff(t *testing.T) {
+	baseline := newSnapshot(table.Row{"pid", "executable"})
+	baseline.add(joinKey(1, "init"), table.Row{1, "init"})
+	baseline.add(joinKey(100, "bash"), table.Row{100, "bash"})
+	baseline.add(joinKey(200, "sleep"), table.Row{200, "sleep"})
+
+	current := newSnapshot(table.Row{"pid", "executable"})
+	current.add(joinKey(1, "init"), table.Row{1, "init"})
+	current.add(joinKey(100, "bash"), table.Row{100, "bash"})
+	current.add(joinKey(300, "keepass"), table.Row{300, "keepass"})
+	current.add(joinKey(200, "vim"), table.Row{200, "vim"}) // PID reused
+
+	added, removed := current.diff(baseline)
+	if !reflect.DeepEqual(added, []string{"300|keepass", "200|vim"}) {
+		t.Errorf("unexpected added rows %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"200|sleep"}) {
+		t.Errorf("unexpected removed rows %v", removed)
+	}
+
+	added, removed = baseline.diff(baseline)
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("expected no changes, got %v %v", added, removed)
+	}
+}
@@ -0,0 +1,282 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+)
+
+const (
+	// AutoLootFlagStr - Persistent flag of the implant menu, overrides the auto loot
+	// setting for a single command
+	AutoLootFlagStr = consts.AutoLootStr
+
+	// AutoLootTag - The tag of the loot created from command output
+	AutoLootTag = "output"
+
+	defaultPageLines = 20
+)
+
+var (
+	// autoLootExempt - Commands that take over the terminal or redraw their output
+	autoLootExempt = map[string]bool{
+		consts.ShellStr: true,
+		consts.WatchStr: true,
+		consts.Cursed:   true,
+	}
+
+	errInvalidSize = errors.New("invalid size, expected e.g. 4096, 64k, or 1m")
+)
+
+// ParseSize - Parse a size in bytes with an optional k/m/g suffix
+func ParseSize(value string) (int64, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	value = strings.TrimSuffix(strings.TrimSuffix(value, "b"), "i")
+	multiplier := int64(1)
+	if value != "" {
+		switch value[len(value)-1] {
+		case 'k':
+			multiplier = 1024
+		case 'm':
+			multiplier = 1024 * 1024
+		case 'g':
+			multiplier = 1024 * 1024 * 1024
+		}
+	}
+	if 1 < multiplier {
+		value = value[:len(value)-1]
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, errInvalidSize
+	}
+	return size * multiplier, nil
+}
+
+// autoLoot - The output of a single command, it's held back until the command is done and
+// if it's larger than the threshold it's saved as loot and only a preview is printed
+type autoLoot struct {
+	con       *SliverConsoleClient
+	threshold int64
+	name      string
+	session   *clientpb.Session
+	beacon    *clientpb.Beacon
+
+	mutex *sync.Mutex
+	buf   *bytes.Buffer
+
+	restore func() // Print the console's output as usual again
+}
+
+func (a *autoLoot) write(format string, args ...any) (int, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return fmt.Fprintf(a.buf, format, args...)
+}
+
+// forTask - A new auto loot for the result of one of the command's beacon tasks
+func (a *autoLoot) forTask(taskID string) *autoLoot {
+	return &autoLoot{
+		con:       a.con,
+		threshold: a.threshold,
+		name:      fmt.Sprintf("%s (task %s)", a.name, strings.Split(taskID, "-")[0]),
+		session:   a.session,
+		beacon:    a.beacon,
+		mutex:     &sync.Mutex{},
+		buf:       &bytes.Buffer{},
+	}
+}
+
+// StartAutoLoot - Called before every implant command runs, holds back the command's
+// output if auto loot is enabled by the settings or the command's flag
+func (con *SliverConsoleClient) StartAutoLoot(cmd *cobra.Command, args []string) {
+	con.autoLoot = nil
+	if con.IsCLI || con.bound || con.jsonOutput || con.capture != nil {
+		return
+	}
+	threshold := con.Settings.AutoLootSize
+	if flag := cmd.Flags().Lookup(AutoLootFlagStr); flag != nil && flag.Changed {
+		var err error
+		threshold, err = ParseSize(flag.Value.String())
+		if err != nil {
+			con.PrintWarnf("--%s: %s\n", AutoLootFlagStr, err)
+			return
+		}
+	}
+	if threshold <= 0 || autoLootExempt[topLevelCommand(cmd).Name()] {
+		return
+	}
+	session, beacon := con.ActiveTarget.Get()
+	if session == nil && beacon == nil {
+		return
+	}
+	a := &autoLoot{
+		con:       con,
+		threshold: threshold,
+		name:      commandLine(cmd, args),
+		session:   session,
+		beacon:    beacon,
+		mutex:     &sync.Mutex{},
+		buf:       &bytes.Buffer{},
+	}
+	con.autoLoot = a
+	a.restore = con.holdOutput(a.write)
+}
+
+// FinishAutoLoot - Called after every implant command, prints or loots the held back output
+func (con *SliverConsoleClient) FinishAutoLoot() {
+	a := con.autoLoot
+	if a == nil {
+		return
+	}
+	con.autoLoot = nil
+	a.restore()
+	a.finish(true)
+}
+
+// autoLootCallback - Hold back the output of a beacon task's results as well
+func (con *SliverConsoleClient) autoLootCallback(a *autoLoot, taskID string, callback BeaconTaskCallback) BeaconTaskCallback {
+	return func(task *clientpb.BeaconTask) {
+		result := a.forTask(taskID)
+		con.captureMutex.Lock()
+		restore := con.holdOutput(result.write)
+		callback(task)
+		restore()
+		con.captureMutex.Unlock()
+		result.finish(false)
+	}
+}
+
+// finish - Print the output, or save it as loot and print the first page of it. The
+// rest can be paged through if the command is still in the foreground.
+func (a *autoLoot) finish(page bool) {
+	a.mutex.Lock()
+	output := a.buf.String()
+	a.mutex.Unlock()
+	if int64(len(output)) <= a.threshold {
+		a.con.forwardPrintf("%s", output)
+		return
+	}
+
+	loot, err := a.save([]byte(output))
+	if err != nil {
+		a.con.PrintErrorf("Failed to save output as loot: %s\n", err)
+		a.con.forwardPrintf("%s", output)
+		return
+	}
+	lines := strings.SplitAfter(output, "\n")
+	pageLines := terminalPageLines()
+	shown := pageLines
+	if len(lines) < shown {
+		shown = len(lines)
+	}
+	preview := strings.Join(lines[:shown], "")
+	if !strings.HasSuffix(preview, "\n") {
+		preview += "\n"
+	}
+	a.con.forwardPrintf("%s", preview)
+	a.con.PrintInfof("Output is %s (%d lines), saved as loot %s (%s)\n",
+		util.ByteCountBinary(int64(len(output))), len(lines), loot.Name, strings.Split(loot.ID, "-")[0])
+
+	reader := bufio.NewReader(os.Stdin)
+	for page && shown < len(lines) && term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Printf(Bold+"-- %d/%d lines, enter for more, q to stop --"+Normal, shown, len(lines))
+		answer, err := reader.ReadString('\n')
+		if err != nil || strings.TrimSpace(strings.ToLower(answer)) == "q" {
+			break
+		}
+		next := shown + pageLines
+		if len(lines) < next {
+			next = len(lines)
+		}
+		// Replace the prompt with the next page
+		a.con.forwardPrintf(UpN+Clearln+"%s", 1, strings.Join(lines[shown:next], ""))
+		shown = next
+	}
+}
+
+func (a *autoLoot) save(data []byte) (*clientpb.Loot, error) {
+	var targetName, hostUUID string
+	if a.session != nil {
+		targetName, hostUUID = a.session.Name, a.session.UUID
+	} else {
+		targetName, hostUUID = a.beacon.Name, a.beacon.UUID
+	}
+	fileName := fmt.Sprintf("%s_%s.txt", targetName, time.Now().Format("20060102150405"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	return a.con.Rpc.LootAdd(ctx, &clientpb.Loot{
+		Name:           fmt.Sprintf("%s: %s", targetName, a.name),
+		FileType:       clientpb.FileType_TEXT,
+		OriginHostUUID: hostUUID,
+		Tags:           []string{AutoLootTag},
+		File: &commonpb.File{
+			Name: fileName,
+			Data: data,
+		},
+	})
+}
+
+// terminalPageLines - The number of lines that fit on a page, leaving room for the prompt
+func terminalPageLines() int {
+	_, rows, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || rows < defaultPageLines/2 {
+		return defaultPageLines
+	}
+	return rows - 4
+}
+
+func topLevelCommand(cmd *cobra.Command) *cobra.Command {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
+	}
+	return cmd
+}
+
+// commandLine - The command with its arguments and the flags that were set
+func commandLine(cmd *cobra.Command, args []string) string {
+	parts := []string{}
+	for parent := cmd; parent.HasParent(); parent = parent.Parent() {
+		parts = append([]string{parent.Name()}, parts...)
+	}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if flag.Name != AutoLootFlagStr {
+			parts = append(parts, fmt.Sprintf("--%s %s", flag.Name, flag.Value.String()))
+		}
+	})
+	return strings.Join(append(parts, args...), " ")
+}
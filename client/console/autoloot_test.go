@@ -0,0 +1,128 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestParseSize(t *testing.T) {
+	sizes := map[string]int64{
+		"0":      0,
+		"4096":   4096,
+		"64k":    64 * 1024,
+		"64KB":   64 * 1024,
+		"1MiB":   1024 * 1024,
+		" 2g ":   2 * 1024 * 1024 * 1024,
+		"100b":   100,
+		"1024  ": 1024,
+	}
+	for value, expected := range sizes {
+		size, err := ParseSize(value)
+		if err != nil || size != expected {
+			t.Errorf("%q: expected %d, got %d (%v)", value, expected, size, err)
+		}
+	}
+	for _, value := range []string{"", "k", "-1", "1x", "1.5m"} {
+		if _, err := ParseSize(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestAutoLootBelowThreshold(t *testing.T) {
+	printed := &bytes.Buffer{}
+	con := &SliverConsoleClient{
+		printfMutex: &sync.RWMutex{},
+		printf: func(format string, args ...any) (int, error) {
+			return fmt.Fprintf(printed, format, args...)
+		},
+	}
+	a := &autoLoot{con: con, threshold: 16, mutex: &sync.Mutex{}, buf: &bytes.Buffer{}}
+	a.write("%s\n", "small")
+	if printed.Len() != 0 {
+		t.Fatal("output should be held back until the command is done")
+	}
+	a.finish(false)
+	if printed.String() != "small\n" {
+		t.Fatalf("unexpected output %q", printed.String())
+	}
+}
+
+func TestAutoLootHoldOutput(t *testing.T) {
+	printed := &bytes.Buffer{}
+	printedMutex := &sync.Mutex{}
+	con := &SliverConsoleClient{
+		printfMutex: &sync.RWMutex{},
+		printf: func(format string, args ...any) (int, error) {
+			printedMutex.Lock()
+			defer printedMutex.Unlock()
+			return fmt.Fprintf(printed, format, args...)
+		},
+	}
+	a := &autoLoot{con: con, threshold: 1024, mutex: &sync.Mutex{}, buf: &bytes.Buffer{}}
+
+	// Other goroutines keep printing while the output is held back
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				con.statusf("message\n")
+			}
+		}()
+	}
+	a.restore = con.holdOutput(a.write)
+	con.forwardPrintf("%s\n", "output")
+	a.restore()
+	wg.Wait()
+
+	if a.buf.String() != "output\n" {
+		t.Fatalf("expected only the output to be held back, got %q", a.buf.String())
+	}
+	con.forwardPrintf("%s\n", "after")
+	if !bytes.HasSuffix(printed.Bytes(), []byte("after\n")) || bytes.Count(printed.Bytes(), []byte("message\n")) != 400 {
+		t.Fatalf("unexpected output %q", printed.String())
+	}
+}
+
+func TestCommandLine(t *testing.T) {
+	root := &cobra.Command{}
+	parent := &cobra.Command{Use: "socks5"}
+	cmd := &cobra.Command{Use: "start"}
+	cmd.Flags().String("host", "", "")
+	cmd.Flags().String(AutoLootFlagStr, "", "")
+	root.AddCommand(parent)
+	parent.AddCommand(cmd)
+	cmd.Flags().Set("host", "10.0.0.1")
+	cmd.Flags().Set(AutoLootFlagStr, "1k")
+
+	if line := commandLine(cmd, []string{"arg"}); line != "socks5 start --host 10.0.0.1 arg" {
+		t.Fatalf("unexpected command line %q", line)
+	}
+	if top := topLevelCommand(cmd); top != parent {
+		t.Fatalf("expected %s, got %s", parent.Name(), top.Name())
+	}
+}
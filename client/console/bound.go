@@ -0,0 +1,109 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// printfWriter - Command output that cobra writes directly (e.g. help)
+type printfWriter func(format string, args ...any) (int, error)
+
+func (w printfWriter) Write(data []byte) (int, error) {
+	return w("%s", data)
+}
+
+// Bind - A console for commands run in the background (scripts, reactions, panes, etc.)
+// on the session or beacon, or on the server without one. It has its own active target
+// and error count and prints with printf, so neither the operator's active target nor
+// their output is changed while it runs. A nil printf prints to the operator's console.
+func (con *SliverConsoleClient) Bind(session *clientpb.Session, beacon *clientpb.Beacon, printf func(format string, args ...any) (int, error)) *SliverConsoleClient {
+	statusPrintf := printf
+	if printf == nil {
+		printf = con.forwardPrintf
+		statusPrintf = con.statusf
+	}
+	bound := &SliverConsoleClient{
+		App:                      con.App,
+		Rpc:                      con.Rpc,
+		EventListeners:           con.EventListeners,
+		eventListenersMutex:      con.eventListenersMutex,
+		BeaconTaskCallbacks:      con.BeaconTaskCallbacks,
+		BeaconTaskCallbacksMutex: con.BeaconTaskCallbacksMutex,
+		Settings:                 con.Settings,
+		IsServer:                 con.IsServer,
+		IsCLI:                    con.IsCLI,
+		jsonHandler:              con.jsonHandler,
+		printf:                   printf,
+		statusPrintf:             statusPrintf,
+		printfMutex:              &sync.RWMutex{},
+		serverCommands:           con.serverCommands,
+		sliverCommands:           con.sliverCommands,
+		captureMutex:             &sync.Mutex{},
+		connections:              con.connections,
+		taskOutputs:              con.taskOutputs,
+		bound:                    true,
+	}
+	bound.ActiveTarget = &ActiveTarget{
+		session:   session,
+		beacon:    beacon,
+		observers: map[int]Observer{},
+		con:       bound,
+	}
+	return bound
+}
+
+// forwardPrintf - Print with whatever the console prints with when it's called
+func (con *SliverConsoleClient) forwardPrintf(format string, args ...any) (int, error) {
+	printf, _ := con.output()
+	return printf(format, args...)
+}
+
+// RunCommand - Run a command line with a fresh command tree, the implant commands if
+// the console has an active target, else the server commands. Unlike a menu's
+// RunCommand the command's error is returned, the errors it printed are counted by
+// ErrorCount.
+func (con *SliverConsoleClient) RunCommand(line string) error {
+	args, err := shellquote.Split(line)
+	if err != nil {
+		return fmt.Errorf("%s: %s", line, err)
+	}
+	var root *cobra.Command
+	session, beacon := con.ActiveTarget.Get()
+	if session != nil || beacon != nil {
+		root = con.sliverCommands(con)()
+	} else {
+		root = con.serverCommands(con)()
+	}
+	if _, err = CheckCommand(root, line, con.ActiveTarget.Filters()); err != nil {
+		return err
+	}
+	root.SetArgs(args)
+	root.SetOut(printfWriter(con.forwardPrintf))
+	root.SetErr(printfWriter(con.statusf))
+	root.SilenceUsage = true
+	root.SilenceErrors = true
+	return root.Execute()
+}
@@ -0,0 +1,166 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/reeflective/console"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slog"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// testCommands - An implant menu with a command that prints the target, one that
+// prints an error and one that fails
+func testCommands(con *SliverConsoleClient) console.Commands {
+	return func() *cobra.Command {
+		root := &cobra.Command{}
+		root.AddCommand(&cobra.Command{
+			Use: "whoami",
+			Run: func(cmd *cobra.Command, args []string) {
+				session, _ := con.ActiveTarget.Get()
+				con.Printf("%s\n", session.Name)
+			},
+		}, &cobra.Command{
+			Use: "warn",
+			Run: func(cmd *cobra.Command, args []string) {
+				con.PrintErrorf("failed\n")
+			},
+		}, &cobra.Command{
+			Use: "fail",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return errors.New("fail")
+			},
+		})
+		return root
+	}
+}
+
+func TestBindRunCommand(t *testing.T) {
+	operator := &bytes.Buffer{}
+	con := &SliverConsoleClient{
+		ActiveTarget:   &ActiveTarget{observers: map[int]Observer{}},
+		jsonHandler:    slog.NewJSONHandler(io.Discard, nil),
+		sliverCommands: testCommands,
+		printfMutex:    &sync.RWMutex{},
+		printf: func(format string, args ...any) (int, error) {
+			return fmt.Fprintf(operator, format, args...)
+		},
+	}
+	con.ActiveTarget.con = con
+	con.ActiveTarget.session = &clientpb.Session{Name: "operator"}
+
+	output := &bytes.Buffer{}
+	bound := con.Bind(&clientpb.Session{Name: "bound"}, nil, func(format string, args ...any) (int, error) {
+		return fmt.Fprintf(output, format, args...)
+	})
+	if err := bound.RunCommand("whoami"); err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "bound\n" || operator.Len() != 0 {
+		t.Fatalf("expected the bound target's output, got %q (operator %q)", output.String(), operator.String())
+	}
+	if err := bound.RunCommand("fail"); err == nil {
+		t.Fatal("expected the command's error")
+	}
+	bound.RunCommand("warn")
+	if bound.ErrorCount() != 1 || con.ErrorCount() != 0 {
+		t.Fatalf("expected the error to be counted by the bound console only, got %d/%d", bound.ErrorCount(), con.ErrorCount())
+	}
+
+	bound.ActiveTarget.Set(nil, &clientpb.Beacon{Name: "beacon"})
+	if session, beacon := con.ActiveTarget.Get(); session.Name != "operator" || beacon != nil {
+		t.Fatal("the operator's active target was changed")
+	}
+
+	forwarded := con.Bind(&clientpb.Session{Name: "forwarded"}, nil, nil)
+	if err := forwarded.RunCommand("whoami"); err != nil {
+		t.Fatal(err)
+	}
+	if operator.String() != "forwarded\n" {
+		t.Fatalf("expected the output on the operator's console, got %q", operator.String())
+	}
+}
+
+func TestRemoveEventListener(t *testing.T) {
+	con := &SliverConsoleClient{EventListeners: &sync.Map{}, eventListenersMutex: &sync.RWMutex{}}
+	listenerID, events := con.CreateEventListener()
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			con.triggerEventListeners(&clientpb.Event{EventType: "test"})
+		}()
+	}
+	con.RemoveEventListener(listenerID)
+	wg.Wait()
+	received := 0
+	for range events {
+		received++
+	}
+	if 100 < received {
+		t.Fatalf("expected events beyond the buffer to be dropped, got %d", received)
+	}
+}
+
+func TestRunOnTarget(t *testing.T) {
+	operator := &bytes.Buffer{}
+	con := &SliverConsoleClient{
+		ActiveTarget:             &ActiveTarget{observers: map[int]Observer{}},
+		BeaconTaskCallbacks:      map[string]BeaconTaskCallback{},
+		BeaconTaskCallbacksMutex: &sync.Mutex{},
+		jsonHandler:              slog.NewJSONHandler(io.Discard, nil),
+		sliverCommands:           testCommands,
+		printfMutex:              &sync.RWMutex{},
+		printf: func(format string, args ...any) (int, error) {
+			return fmt.Fprintf(operator, format, args...)
+		},
+	}
+	con.ActiveTarget.con = con
+
+	output, err := con.RunOnTarget(&clientpb.Session{Name: "target"}, nil, "whoami")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "target\n" || operator.Len() != 0 {
+		t.Fatalf("expected the output to be captured, got %q (operator %q)", output.String(), operator.String())
+	}
+	if session, _ := con.ActiveTarget.Get(); session != nil {
+		t.Fatal("the operator's active target was changed")
+	}
+	failed, err := con.RunOnTarget(&clientpb.Session{Name: "target"}, nil, "warn")
+	if err != nil || failed.Errors != 1 || con.ErrorCount() != 0 {
+		t.Fatalf("expected the error to be counted by the output only, got %v (%v)", failed, err)
+	}
+
+	// Results that arrive once they're no longer waited on are printed as usual
+	con.WaitForOutputs([]*TargetOutput{output}, 0)
+	output.printf("late\n")
+	if output.String() != "target\n" || operator.String() != "late\n" {
+		t.Fatalf("expected late output on the operator's console, got %q (operator %q)", output.String(), operator.String())
+	}
+}
@@ -0,0 +1,127 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// TargetOutput - The captured output of a command run on a session or beacon, including
+// the results of any beacon tasks it issued that arrived while they were being waited on
+type TargetOutput struct {
+	Session *clientpb.Session
+	Beacon  *clientpb.Beacon
+	Errors  int
+
+	con     *SliverConsoleClient
+	mutex   *sync.Mutex
+	buf     *bytes.Buffer
+	taskIDs []string
+	done    bool
+}
+
+// printf - Capture the output, once the results are no longer waited on they're
+// printed to the operator's console as usual
+func (o *TargetOutput) printf(format string, args ...any) (int, error) {
+	o.mutex.Lock()
+	if o.done {
+		o.mutex.Unlock()
+		return o.con.forwardPrintf(format, args...)
+	}
+	defer o.mutex.Unlock()
+	return fmt.Fprintf(o.buf, format, args...)
+}
+
+func (o *TargetOutput) addTask(taskID string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.taskIDs = append(o.taskIDs, taskID)
+}
+
+// String - The output captured so far
+func (o *TargetOutput) String() string {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.buf.String()
+}
+
+// RunOnTarget - Run a command line on a session or beacon with its output captured
+// instead of printed, on a console bound to the target so the operator's active target
+// and output are left alone
+func (con *SliverConsoleClient) RunOnTarget(session *clientpb.Session, beacon *clientpb.Beacon, line string) (*TargetOutput, error) {
+	output := &TargetOutput{
+		Session: session,
+		Beacon:  beacon,
+		con:     con,
+		mutex:   &sync.Mutex{},
+		buf:     &bytes.Buffer{},
+		taskIDs: []string{},
+	}
+	bound := con.Bind(session, beacon, output.printf)
+	bound.capture = output
+	err := bound.RunCommand(line)
+	output.Errors = bound.ErrorCount()
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// WaitForOutputs - Wait for the results of the beacon tasks issued by the commands, up to
+// the timeout. Results that arrive afterwards are printed as usual. Returns the number of
+// outputs still waiting for results.
+func (con *SliverConsoleClient) WaitForOutputs(outputs []*TargetOutput, timeout time.Duration) int {
+	deadline := time.Now().Add(timeout)
+	for {
+		waiting := 0
+		for _, output := range outputs {
+			output.mutex.Lock()
+			taskIDs := output.taskIDs
+			output.mutex.Unlock()
+			con.BeaconTaskCallbacksMutex.Lock()
+			for _, taskID := range taskIDs {
+				if _, ok := con.BeaconTaskCallbacks[taskID]; ok {
+					waiting++
+					break
+				}
+			}
+			con.BeaconTaskCallbacksMutex.Unlock()
+		}
+		if waiting == 0 || time.Now().After(deadline) {
+			for _, output := range outputs {
+				output.mutex.Lock()
+				output.done = true
+				output.mutex.Unlock()
+			}
+			return waiting
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+// RedirectOutput - Print to printf instead of the terminal until restore is called,
+// used by commands that take over the whole terminal
+func (con *SliverConsoleClient) RedirectOutput(printf func(format string, args ...any) (int, error)) (restore func()) {
+	return con.setOutput(printf, printf)
+}
@@ -0,0 +1,241 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/client/assets"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/client/transport"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+)
+
+var (
+	// ErrNotConnected - No connection to a server with the given name
+	ErrNotConnected = errors.New("not connected to server")
+	// ErrActiveConnection - The active connection cannot be closed, switch to another server first
+	ErrActiveConnection = errors.New("cannot close the active connection")
+
+	// prefixedEvents - Events printed as text, prefixed with the server's name when
+	// connected to more than one server
+	prefixedEvents = map[string]bool{
+		consts.CanaryEvent:         true,
+		consts.WatchtowerEvent:     true,
+		consts.BurnedContactEvent:  true,
+		consts.FederationEvent:     true,
+		consts.AutomationEvent:     true,
+		consts.StageFetchEvent:     true,
+		consts.TargetLockEvent:     true,
+		consts.TargetMetadataEvent: true,
+		consts.OpsecEvent:          true,
+		consts.BeaconOverdueEvent:  true,
+	}
+)
+
+// ServerConnection - A connection to a server. The console can be connected to several
+// servers at once, commands use the active connection and the other connections only
+// print their events, with the server's name as a prefix of session and beacon names.
+type ServerConnection struct {
+	Name   string
+	Config *assets.ClientConfig
+	Rpc    rpcpb.SliverRPCClient
+
+	conn         *grpc.ClientConn
+	tunnelStream rpcpb.SliverRPC_TunnelDataClient
+}
+
+type connections struct {
+	mutex  *sync.RWMutex
+	all    []*ServerConnection
+	active *ServerConnection
+}
+
+// SetConnectionProfile - Name the connection that is passed to StartClient, so it can
+// be told apart from connections to other servers
+func (con *SliverConsoleClient) SetConnectionProfile(name string, config *assets.ClientConfig, conn *grpc.ClientConn) {
+	if name == "" && config != nil {
+		name = config.LHost
+	}
+	con.connections.mutex.Lock()
+	defer con.connections.mutex.Unlock()
+	con.connections.active = &ServerConnection{Name: name, Config: config, conn: conn}
+}
+
+// startConnection - Register the connection StartClient was called with
+func (con *SliverConsoleClient) startConnection(rpc rpcpb.SliverRPCClient) {
+	con.connections.mutex.Lock()
+	primary := con.connections.active
+	if primary == nil {
+		primary = &ServerConnection{Name: "local"}
+	}
+	primary.Rpc = rpc
+	con.connections.all = []*ServerConnection{primary}
+	con.connections.active = primary
+	con.connections.mutex.Unlock()
+
+	err := openTunnelStream(primary)
+	if err != nil {
+		log.Printf("Failed to open tunnel data stream: %s", err)
+	} else {
+		core.GetTunnels().SetStream(primary.tunnelStream)
+	}
+	go con.startEventLoop(primary)
+}
+
+// openTunnelStream - Tunnels started on a server use its stream, so they keep working
+// after switching to another server
+func openTunnelStream(conn *ServerConnection) error {
+	stream, err := conn.Rpc.TunnelData(context.Background())
+	if err != nil {
+		return err
+	}
+	conn.tunnelStream = stream
+	go core.RecvTunnelData(stream)
+	return nil
+}
+
+// Connections - The servers the console is connected to, in the order they were connected
+func (con *SliverConsoleClient) Connections() []*ServerConnection {
+	con.connections.mutex.RLock()
+	defer con.connections.mutex.RUnlock()
+	return append([]*ServerConnection{}, con.connections.all...)
+}
+
+// ActiveConnection - The connection commands are run on
+func (con *SliverConsoleClient) ActiveConnection() *ServerConnection {
+	con.connections.mutex.RLock()
+	defer con.connections.mutex.RUnlock()
+	return con.connections.active
+}
+
+func (con *SliverConsoleClient) connection(name string) *ServerConnection {
+	con.connections.mutex.RLock()
+	defer con.connections.mutex.RUnlock()
+	for _, conn := range con.connections.all {
+		if conn.Name == name {
+			return conn
+		}
+	}
+	return nil
+}
+
+// Connect - Make a server the active connection, connecting to it with the config
+// profile of the same name if the console isn't connected to it yet
+func (con *SliverConsoleClient) Connect(name string) (*ServerConnection, error) {
+	conn := con.connection(name)
+	if conn == nil {
+		config, ok := assets.GetConfigProfiles()[name]
+		if !ok {
+			return nil, fmt.Errorf("no config profile '%s' in %s", name, assets.GetConfigDir())
+		}
+		rpc, grpcConn, err := transport.MTLSConnect(config)
+		if err != nil {
+			return nil, fmt.Errorf("connection to %s:%d failed %s", config.LHost, config.LPort, err)
+		}
+		conn = &ServerConnection{Name: name, Config: config, Rpc: rpc, conn: grpcConn}
+		err = openTunnelStream(conn)
+		if err != nil {
+			grpcConn.Close()
+			return nil, err
+		}
+		con.connections.mutex.Lock()
+		con.connections.all = append(con.connections.all, conn)
+		con.connections.mutex.Unlock()
+		go con.startEventLoop(conn)
+	}
+	con.switchConnection(conn)
+	return conn, nil
+}
+
+// switchConnection - Make a connection the active one, the active session/beacon
+// belongs to the previous server so it is backgrounded first
+func (con *SliverConsoleClient) switchConnection(conn *ServerConnection) {
+	if con.ActiveConnection() == conn {
+		return
+	}
+	con.ActiveTarget.Set(nil, nil)
+	con.connections.mutex.Lock()
+	con.connections.active = conn
+	con.Rpc = conn.Rpc
+	con.connections.mutex.Unlock()
+	if conn.tunnelStream != nil {
+		core.GetTunnels().SetStream(conn.tunnelStream)
+	}
+	con.opsecPolicy = nil
+}
+
+// CloseConnection - Disconnect from a server that isn't the active connection
+func (con *SliverConsoleClient) CloseConnection(name string) error {
+	con.connections.mutex.Lock()
+	defer con.connections.mutex.Unlock()
+	for index, conn := range con.connections.all {
+		if conn.Name != name {
+			continue
+		}
+		if conn == con.connections.active {
+			return ErrActiveConnection
+		}
+		con.connections.all = append(con.connections.all[:index], con.connections.all[index+1:]...)
+		if conn.conn != nil {
+			return conn.conn.Close()
+		}
+		return nil
+	}
+	return ErrNotConnected
+}
+
+// isActiveConnection - Whether the connection is the one commands are run on
+func (con *SliverConsoleClient) isActiveConnection(conn *ServerConnection) bool {
+	return con.ActiveConnection() == conn
+}
+
+// connectionName - Prefix a session or beacon name with its server's name, but only
+// when connected to more than one server
+func (con *SliverConsoleClient) connectionName(conn *ServerConnection, name string) string {
+	if len(con.Connections()) < 2 {
+		return name
+	}
+	return fmt.Sprintf("%s/%s", conn.Name, name)
+}
+
+// prefixEvent - A copy of the event with the server's name added to its session
+// name or message, for printing
+func (con *SliverConsoleClient) prefixEvent(conn *ServerConnection, event *clientpb.Event) *clientpb.Event {
+	if len(con.Connections()) < 2 {
+		return event
+	}
+	event = proto.Clone(event).(*clientpb.Event)
+	if event.Session != nil {
+		event.Session.Name = con.connectionName(conn, event.Session.Name)
+	}
+	if prefixedEvents[event.EventType] && event.Session == nil {
+		event.Data = []byte(fmt.Sprintf("[%s] %s", conn.Name, event.Data))
+	}
+	return event
+}
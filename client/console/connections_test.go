@@ -0,0 +1,78 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func testConnections(names ...string) *SliverConsoleClient {
+	con := &SliverConsoleClient{connections: &connections{mutex: &sync.RWMutex{}}}
+	for _, name := range names {
+		con.connections.all = append(con.connections.all, &ServerConnection{Name: name})
+	}
+	con.connections.active = con.connections.all[0]
+	return con
+}
+
+func TestPrefixEvent(t *testing.T) {
+	event := &clientpb.Event{
+		EventType: consts.SessionOpenedEvent,
+		Session:   &clientpb.Session{Name: "WISE_OTTER"},
+	}
+	con := testConnections("a")
+	if display := con.prefixEvent(con.connections.all[0], event); display.Session.Name != "WISE_OTTER" {
+		t.Errorf("single connection: expected no prefix, got %s", display.Session.Name)
+	}
+
+	con = testConnections("a", "b")
+	display := con.prefixEvent(con.connections.all[1], event)
+	if display.Session.Name != "b/WISE_OTTER" {
+		t.Errorf("expected b/WISE_OTTER, got %s", display.Session.Name)
+	}
+	if event.Session.Name != "WISE_OTTER" {
+		t.Errorf("original event was modified: %s", event.Session.Name)
+	}
+
+	text := &clientpb.Event{EventType: consts.TargetLockEvent, Data: []byte("alice locked 1234abcd")}
+	if display := con.prefixEvent(con.connections.all[0], text); string(display.Data) != "[a] alice locked 1234abcd" {
+		t.Errorf("unexpected text event data: %s", display.Data)
+	}
+}
+
+func TestCloseConnection(t *testing.T) {
+	con := testConnections("a", "b")
+	if err := con.CloseConnection("a"); !errors.Is(err, ErrActiveConnection) {
+		t.Errorf("expected ErrActiveConnection, got %v", err)
+	}
+	if err := con.CloseConnection("c"); !errors.Is(err, ErrNotConnected) {
+		t.Errorf("expected ErrNotConnected, got %v", err)
+	}
+	if err := con.CloseConnection("b"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if connections := con.Connections(); len(connections) != 1 || connections[0].Name != "a" {
+		t.Errorf("expected only a to be connected, got %v", connections)
+	}
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/util"
 )
 
 const (
@@ -83,10 +84,25 @@ const (
 	Success = Bold + Green + "[+] " + Normal
 )
 
+// TargetColors - The ANSI code of each of the constants.TargetColors
+var TargetColors = map[string]string{
+	"red":    Red,
+	"green":  Green,
+	"orange": Orange,
+	"blue":   Blue,
+	"purple": Purple,
+	"cyan":   Cyan,
+	"gray":   Gray,
+}
+
 // Observer - A function to call when the sessions changes
 type (
 	Observer           func(*clientpb.Session, *clientpb.Beacon)
 	BeaconTaskCallback func(*clientpb.BeaconTask)
+
+	// CommandsFactory - Binds a menu's commands to a console, commands run in the
+	// background are bound to a console of their own (see Bind)
+	CommandsFactory func(con *SliverConsoleClient) console.Commands
 )
 
 type SliverConsoleClient struct {
@@ -102,6 +118,30 @@ type SliverConsoleClient struct {
 
 	jsonHandler slog.Handler
 	printf      func(format string, args ...any) (int, error)
+	jsonOutput  bool
+
+	statusPrintf func(format string, args ...any) (int, error)
+	printfMutex  *sync.RWMutex // Guards printf and statusPrintf, auto loot swaps them
+	errorCount   int32
+
+	history        *targetHistory
+	serverCommands CommandsFactory
+	sliverCommands CommandsFactory
+	bound          bool
+
+	eventListenersMutex *sync.RWMutex
+
+	capture      *TargetOutput
+	captureMutex *sync.Mutex
+
+	autoLoot *autoLoot
+
+	opsecPolicy        *clientpb.OpsecPolicy
+	opsecPolicyFetched time.Time
+
+	connections *connections
+
+	taskOutputs *sync.Map // stream ID -> *TaskOutputStream
 }
 
 // NewConsole creates the sliver client (and console), creating menus and prompts.
@@ -118,15 +158,21 @@ func NewConsole(isServer bool) *SliverConsoleClient {
 			observerID: 0,
 		},
 		EventListeners:           &sync.Map{},
+		eventListenersMutex:      &sync.RWMutex{},
 		BeaconTaskCallbacks:      map[string]BeaconTaskCallback{},
 		BeaconTaskCallbacksMutex: &sync.Mutex{},
 		IsServer:                 isServer,
+		captureMutex:             &sync.Mutex{},
+		printfMutex:              &sync.RWMutex{},
 		Settings:                 settings,
+		connections:              &connections{mutex: &sync.RWMutex{}},
+		taskOutputs:              &sync.Map{},
 	}
 
 	// The active target needs access to the console
 	// to automatically switch between command menus.
 	con.ActiveTarget.con = con
+	con.history = newTargetHistory(con)
 
 	// Readline-shell (edition) settings
 	if settings.VimMode {
@@ -151,6 +197,10 @@ func NewConsole(isServer bool) *SliverConsoleClient {
 	sliver.Prompt().Primary = con.GetPrompt
 	sliver.AddInterrupt(io.EOF, con.exitImplantMenu) // Ctrl-D
 
+	// Shared by every operator through the server, restored when the
+	// active target changes
+	sliver.AddHistorySource("session history", con.history)
+
 	con.App.SetPrintLogo(func(_ *console.Console) {
 		con.PrintLogo()
 	})
@@ -161,7 +211,7 @@ func NewConsole(isServer bool) *SliverConsoleClient {
 // Init requires a working RPC connection to the sliver server, and 2 different sets of commands.
 // If run is true, the console application is started, making this call blocking. Otherwise, commands and
 // RPC connection are bound to the console (making the console ready to run), but the console does not start.
-func StartClient(con *SliverConsoleClient, rpc rpcpb.SliverRPCClient, serverCmds, sliverCmds console.Commands, run bool) error {
+func StartClient(con *SliverConsoleClient, rpc rpcpb.SliverRPCClient, serverCmds, sliverCmds CommandsFactory, run bool) error {
 	con.Rpc = rpc
 	con.IsCLI = !run
 
@@ -170,21 +220,28 @@ func StartClient(con *SliverConsoleClient, rpc rpcpb.SliverRPCClient, serverCmds
 	// If ran from a system shell, however, those queries will block because
 	// the system shell is in control of stdin. So just use the classic Printf.
 	if con.IsCLI {
-		con.printf = fmt.Printf
+		con.setPrintf(fmt.Printf)
 	} else {
-		con.printf = con.App.TransientPrintf
+		con.setPrintf(con.App.TransientPrintf)
 	}
 
 	// Bind commands to the app
 	server := con.App.Menu(consts.ServerMenu)
-	server.SetCommands(serverCmds)
+	server.SetCommands(serverCmds(con))
 
 	sliver := con.App.Menu(consts.ImplantMenu)
-	sliver.SetCommands(sliverCmds)
+	sliver.SetCommands(sliverCmds(con))
+	con.serverCommands = serverCmds
+	con.sliverCommands = sliverCmds
 
-	// Events
-	go con.startEventLoop()
-	go core.TunnelLoop(rpc)
+	// Let other operators know which target we're interacting with
+	con.ActiveTarget.AddObserver(con.updatePresence)
+	if !con.IsCLI {
+		con.ActiveTarget.AddObserver(con.history.load)
+	}
+
+	// Events and tunnels
+	con.startConnection(rpc)
 
 	// console logger
 	if con.Settings.ConsoleLogs {
@@ -212,8 +269,8 @@ func StartClient(con *SliverConsoleClient, rpc rpcpb.SliverRPCClient, serverCmds
 	return nil
 }
 
-func (con *SliverConsoleClient) startEventLoop() {
-	eventStream, err := con.Rpc.Events(context.Background(), &commonpb.Empty{})
+func (con *SliverConsoleClient) startEventLoop(conn *ServerConnection) {
+	eventStream, err := conn.Rpc.Events(context.Background(), &commonpb.Empty{})
 	if err != nil {
 		fmt.Printf(Warn+"%s\n", err)
 		return
@@ -224,28 +281,65 @@ func (con *SliverConsoleClient) startEventLoop() {
 			return
 		}
 
-		go con.triggerEventListeners(event)
+		// Events of the other servers are only printed, scripts, reactions, etc.
+		// only see the events of the server commands are run on
+		active := con.isActiveConnection(conn)
+		if active {
+			go con.triggerEventListeners(event)
+		}
+		display := con.prefixEvent(conn, event)
 
 		// Trigger event based on type
 		switch event.EventType {
 
 		case consts.CanaryEvent:
-			con.PrintEventErrorf(Bold+"WARNING: %s%s has been burned (DNS Canary)", Normal, event.Session.Name)
-			sessions := con.GetSessionsByName(event.Session.Name)
+			if event.Session == nil {
+				con.PrintEventErrorf(Bold+"WARNING: %s%s", Normal, string(display.Data))
+				break
+			}
+			con.PrintEventErrorf(Bold+"WARNING: %s%s has been burned (DNS Canary)", Normal, display.Session.Name)
+			sessions := con.getSessionsByName(conn.Rpc, event.Session.Name)
 			for _, session := range sessions {
 				shortID := strings.Split(session.ID, "-")[0]
 				con.PrintErrorf("\t🔥 Session %s is affected", shortID)
 			}
 
 		case consts.WatchtowerEvent:
-			msg := string(event.Data)
-			con.PrintEventErrorf(Bold+"WARNING: %s%s has been burned (seen on %s)", Normal, event.Session.Name, msg)
-			sessions := con.GetSessionsByName(event.Session.Name)
+			if event.Session == nil {
+				con.PrintEventErrorf(Bold+"WARNING: %s%s", Normal, string(display.Data))
+				break
+			}
+			con.PrintEventErrorf(Bold+"WARNING: %s%s has been burned (seen on %s)", Normal, display.Session.Name, string(event.Data))
+			sessions := con.getSessionsByName(conn.Rpc, event.Session.Name)
 			for _, session := range sessions {
 				shortID := strings.Split(session.ID, "-")[0]
 				con.PrintErrorf("\t🔥 Session %s is affected", shortID)
 			}
 
+		case consts.BurnedContactEvent:
+			con.PrintEventErrorf(Bold+"WARNING: %sBurned implant %s", Normal, string(display.Data))
+
+		case consts.FederationEvent:
+			con.PrintEventInfof("%s", string(display.Data))
+
+		case consts.AutomationEvent:
+			con.PrintEventInfof("%s", string(display.Data))
+
+		case consts.StageFetchEvent:
+			con.PrintEventInfof("%s", string(display.Data))
+
+		case consts.TargetLockEvent:
+			con.PrintEventInfof("%s", string(display.Data))
+
+		case consts.TargetMetadataEvent:
+			con.PrintEventInfof("%s", string(display.Data))
+
+		case consts.OpsecEvent:
+			con.PrintEventErrorf("%s", string(display.Data))
+
+		case consts.BeaconOverdueEvent:
+			con.PrintEventErrorf(Bold+"WARNING: %s%s", Normal, string(display.Data))
+
 		case consts.JoinedEvent:
 			if con.Settings.UserConnect {
 				con.PrintInfof("%s has joined the game", event.Client.Operator.Name)
@@ -260,15 +354,15 @@ func (con *SliverConsoleClient) startEventLoop() {
 			con.PrintErrorf("Job #%d stopped (%s/%s)", job.ID, job.Protocol, job.Name)
 
 		case consts.SessionOpenedEvent:
-			session := event.Session
+			session := display.Session
 			currentTime := time.Now().Format(time.RFC1123)
 			shortID := strings.Split(session.ID, "-")[0]
 			con.PrintEventInfof("Session %s %s - %s (%s) - %s/%s - %v",
 				shortID, session.Name, session.RemoteAddress, session.Hostname, session.OS, session.Arch, currentTime)
 
 			// Prelude Operator
-			if prelude.ImplantMapper != nil {
-				err = prelude.ImplantMapper.AddImplant(session, nil)
+			if active && prelude.ImplantMapper != nil {
+				err = prelude.ImplantMapper.AddImplant(event.Session, nil)
 				if err != nil {
 					con.PrintErrorf("Could not add session to Operator: %s", err)
 				}
@@ -281,7 +375,7 @@ func (con *SliverConsoleClient) startEventLoop() {
 			con.PrintInfof("Session %s has been updated - %v", shortID, currentTime)
 
 		case consts.SessionClosedEvent:
-			session := event.Session
+			session := display.Session
 			currentTime := time.Now().Format(time.RFC1123)
 			shortID := strings.Split(session.ID, "-")[0]
 			con.PrintEventErrorf("Lost session %s %s - %s (%s) - %s/%s - %v",
@@ -293,8 +387,8 @@ func (con *SliverConsoleClient) startEventLoop() {
 				con.ActiveTarget.Set(nil, nil)
 				con.PrintErrorf("Active session disconnected")
 			}
-			if prelude.ImplantMapper != nil {
-				err = prelude.ImplantMapper.RemoveImplant(session)
+			if active && prelude.ImplantMapper != nil {
+				err = prelude.ImplantMapper.RemoveImplant(event.Session)
 				if err != nil {
 					con.PrintErrorf("Could not remove session from Operator: %s", err)
 				}
@@ -307,10 +401,10 @@ func (con *SliverConsoleClient) startEventLoop() {
 			currentTime := time.Now().Format(time.RFC1123)
 			shortID := strings.Split(beacon.ID, "-")[0]
 			con.PrintEventInfof("Beacon %s %s - %s (%s) - %s/%s - %v",
-				shortID, beacon.Name, beacon.RemoteAddress, beacon.Hostname, beacon.OS, beacon.Arch, currentTime)
+				shortID, con.connectionName(conn, beacon.Name), beacon.RemoteAddress, beacon.Hostname, beacon.OS, beacon.Arch, currentTime)
 
 			// Prelude Operator
-			if prelude.ImplantMapper != nil {
+			if active && prelude.ImplantMapper != nil {
 				err = prelude.ImplantMapper.AddImplant(beacon, func(taskID string, cb func(*clientpb.BeaconTask)) {
 					con.AddBeaconCallback(taskID, cb)
 				})
@@ -320,11 +414,16 @@ func (con *SliverConsoleClient) startEventLoop() {
 			}
 
 		case consts.BeaconTaskResultEvent:
-			con.triggerBeaconTaskCallback(event.Data)
+			con.triggerBeaconTaskCallback(conn, event.Data)
+
+		case consts.TaskOutputEvent:
+			con.triggerTaskOutput(event)
 
 		}
 
-		con.triggerReactions(event)
+		if active {
+			con.triggerReactions(event)
+		}
 	}
 }
 
@@ -336,8 +435,11 @@ func (con *SliverConsoleClient) CreateEventListener() (string, <-chan *clientpb.
 	return listenerID.String(), listener
 }
 
-// RemoveEventListener - removes an event listener given its id
+// RemoveEventListener - removes an event listener given its id, its channel is
+// closed once no event is being sent on it
 func (con *SliverConsoleClient) RemoveEventListener(listenerID string) {
+	con.eventListenersMutex.Lock()
+	defer con.eventListenersMutex.Unlock()
 	value, ok := con.EventListeners.LoadAndDelete(listenerID)
 	if ok {
 		close(value.(chan *clientpb.Event))
@@ -345,51 +447,22 @@ func (con *SliverConsoleClient) RemoveEventListener(listenerID string) {
 }
 
 func (con *SliverConsoleClient) triggerEventListeners(event *clientpb.Event) {
+	con.eventListenersMutex.RLock()
+	defer con.eventListenersMutex.RUnlock()
 	con.EventListeners.Range(func(key, value interface{}) bool {
 		listener := value.(chan *clientpb.Event)
-		listener <- event // Do not block while sending the event to the listener
+		// Do not block while sending the event to the listener, events are
+		// dropped for listeners that don't keep up
+		select {
+		case listener <- event:
+		default:
+		}
 		return true
 	})
 }
 
-func (con *SliverConsoleClient) triggerReactions(event *clientpb.Event) {
-	reactions := core.Reactions.On(event.EventType)
-	if len(reactions) == 0 {
-		return
-	}
-
-	// We need some special handling for SessionOpenedEvent to
-	// set the new session as the active session
-	currentActiveSession, currentActiveBeacon := con.ActiveTarget.Get()
-	defer func() {
-		con.ActiveTarget.Set(currentActiveSession, currentActiveBeacon)
-	}()
-
-	if event.EventType == consts.SessionOpenedEvent {
-		con.ActiveTarget.Set(nil, nil)
-
-		con.ActiveTarget.Set(event.Session, nil)
-	} else if event.EventType == consts.BeaconRegisteredEvent {
-		con.ActiveTarget.Set(nil, nil)
-
-		beacon := &clientpb.Beacon{}
-		proto.Unmarshal(event.Data, beacon)
-		con.ActiveTarget.Set(nil, beacon)
-	}
-
-	for _, reaction := range reactions {
-		for _, line := range reaction.Commands {
-			con.PrintInfof(Bold+"Execute reaction: '%s'"+Normal, line)
-			err := con.App.ActiveMenu().RunCommand(line)
-			if err != nil {
-				con.PrintErrorf("Reaction command error: %s\n", err)
-			}
-		}
-	}
-}
-
 // triggerBeaconTaskCallback - Triggers the callback for a beacon task
-func (con *SliverConsoleClient) triggerBeaconTaskCallback(data []byte) {
+func (con *SliverConsoleClient) triggerBeaconTaskCallback(conn *ServerConnection, data []byte) {
 	task := &clientpb.BeaconTask{}
 	err := proto.Unmarshal(data, task)
 	if err != nil {
@@ -398,34 +471,55 @@ func (con *SliverConsoleClient) triggerBeaconTaskCallback(data []byte) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	beacon, _ := con.Rpc.GetBeacon(ctx, &clientpb.Beacon{ID: task.BeaconID})
+	beacon, _ := conn.Rpc.GetBeacon(ctx, &clientpb.Beacon{ID: task.BeaconID})
 
 	// If the callback is not in our map then we don't do anything, the beacon task
 	// was either issued by another operator in multiplayer mode or the client process
-	// was restarted between the time the task was created and when the server got the result
+	// was restarted between the time the task was created and when the server got the result.
+	// The mutex isn't held while the callback runs, which may issue tasks of its own.
 	con.BeaconTaskCallbacksMutex.Lock()
-	defer con.BeaconTaskCallbacksMutex.Unlock()
-	if callback, ok := con.BeaconTaskCallbacks[task.ID]; ok {
+	callback, ok := con.BeaconTaskCallbacks[task.ID]
+	con.BeaconTaskCallbacksMutex.Unlock()
+	if ok {
 		if con.Settings.BeaconAutoResults {
 			if beacon != nil {
-				con.PrintEventSuccessf("%s completed task %s", beacon.Name, strings.Split(task.ID, "-")[0])
+				con.PrintEventSuccessf("%s completed task %s", con.connectionName(conn, beacon.Name), strings.Split(task.ID, "-")[0])
 			}
-			task_content, err := con.Rpc.GetBeaconTaskContent(ctx, &clientpb.BeaconTask{
+			task_content, err := conn.Rpc.GetBeaconTaskContent(ctx, &clientpb.BeaconTask{
 				ID: task.ID,
 			})
 			con.Printf(Clearln + "\r")
-			if err == nil {
+			if err == nil && task_content.ResponseLootID != "" {
+				con.PrintWarnf("Task result (%s) exceeds the server's result size limit, retrieve it with: loot fetch %s\n",
+					util.ByteCountBinary(task_content.ResponseSize), task_content.ResponseLootID)
+			} else if err == nil {
 				callback(task_content)
 			} else {
 				con.PrintErrorf("Could not get beacon task content: %s", err)
 			}
 			con.Println()
 		}
+		con.BeaconTaskCallbacksMutex.Lock()
 		delete(con.BeaconTaskCallbacks, task.ID)
+		con.BeaconTaskCallbacksMutex.Unlock()
 	}
 }
 
+// PendingBeaconTasks - The number of beacon tasks issued by this client that are
+// still waiting for results
+func (con *SliverConsoleClient) PendingBeaconTasks() int {
+	con.BeaconTaskCallbacksMutex.Lock()
+	defer con.BeaconTaskCallbacksMutex.Unlock()
+	return len(con.BeaconTaskCallbacks)
+}
+
 func (con *SliverConsoleClient) AddBeaconCallback(taskID string, callback BeaconTaskCallback) {
+	if con.capture != nil {
+		con.capture.addTask(taskID)
+	}
+	if con.autoLoot != nil {
+		callback = con.autoLootCallback(con.autoLoot, taskID, callback)
+	}
 	con.BeaconTaskCallbacksMutex.Lock()
 	defer con.BeaconTaskCallbacksMutex.Unlock()
 	con.BeaconTaskCallbacks[taskID] = callback
@@ -436,6 +530,9 @@ func (con *SliverConsoleClient) GetPrompt() string {
 	if con.IsServer {
 		prompt = Bold + "[server] " + Normal + Underline + "sliver" + Normal
 	}
+	if conn := con.ActiveConnection(); conn != nil && 1 < len(con.Connections()) {
+		prompt = Bold + fmt.Sprintf("[%s] ", conn.Name) + Normal + prompt
+	}
 	if con.ActiveTarget.GetSession() != nil {
 		prompt += fmt.Sprintf(Bold+Red+" (%s)%s", con.ActiveTarget.GetSession().Name, Normal)
 	} else if con.ActiveTarget.GetBeacon() != nil {
@@ -464,8 +561,11 @@ func (con *SliverConsoleClient) PrintLogo() {
 		fmt.Printf(Info+"Client %s\r\n", version.FullVersion())
 	}
 	fmt.Println(Info + "Welcome to the sliver shell, please type 'help' for options\r")
-	if serverVer.Major != int32(version.SemanticVersion()[0]) {
-		fmt.Printf(Warn + "Warning: Client and server may be running incompatible versions.\r\n")
+	if serverVer.APIVersion == 0 {
+		fmt.Printf(Warn + "Warning: The server predates api versioning, some commands may not be supported.\r\n")
+	} else if serverVer.APIVersion != version.APIVersion {
+		fmt.Printf(Warn+"Warning: Client api v%d and server api v%d differ, commands added since the older one are not supported.\r\n",
+			version.APIVersion, serverVer.APIVersion)
 	}
 	con.CheckLastUpdate()
 }
@@ -520,7 +620,11 @@ func (con *SliverConsoleClient) GetSession(arg string) *clientpb.Session {
 
 // GetSessionsByName - Return all sessions for an Implant by name
 func (con *SliverConsoleClient) GetSessionsByName(name string) []*clientpb.Session {
-	sessions, err := con.Rpc.GetSessions(context.Background(), &commonpb.Empty{})
+	return con.getSessionsByName(con.Rpc, name)
+}
+
+func (con *SliverConsoleClient) getSessionsByName(rpc rpcpb.SliverRPCClient, name string) []*clientpb.Session {
+	sessions, err := rpc.GetSessions(context.Background(), &commonpb.Empty{})
 	if err != nil {
 		fmt.Printf(Warn+"%s\n", err)
 		return nil
@@ -582,9 +686,22 @@ func (c *SliverConsoleClient) exitImplantMenu(_ *console.Console) {
 }
 
 func (con *SliverConsoleClient) SpinUntil(message string, ctrl chan bool) {
+	if con.IsCLI && con.jsonOutput {
+		go spin.Until(os.Stderr, message, ctrl)
+		return
+	}
 	go spin.Until(os.Stdout, message, ctrl)
 }
 
+// SpinUntilFunc - Like SpinUntil, but the message is re-rendered on every frame
+func (con *SliverConsoleClient) SpinUntilFunc(message func() string, ctrl chan bool) {
+	if con.IsCLI && con.jsonOutput {
+		go spin.UntilFunc(os.Stderr, message, ctrl)
+		return
+	}
+	go spin.UntilFunc(os.Stdout, message, ctrl)
+}
+
 // FormatDateDelta - Generate formatted date string of the time delta between then and now
 func (con *SliverConsoleClient) FormatDateDelta(t time.Time, includeDate bool, color bool) string {
 	nextTime := t.Format(time.UnixDate)
@@ -613,6 +730,39 @@ func (con *SliverConsoleClient) FormatDateDelta(t time.Time, includeDate bool, c
 	return interval
 }
 
+// FormatNextCheckin - When a beacon is expected to check in next, or how long it's overdue
+// by and how many check-ins it missed
+func (con *SliverConsoleClient) FormatNextCheckin(beacon *clientpb.Beacon, includeDate bool) string {
+	nextCheckin := time.Unix(beacon.NextCheckin, 0)
+	if beacon.MissedCheckins == 0 {
+		return con.FormatDateDelta(nextCheckin, includeDate, true)
+	}
+	overdue := time.Since(nextCheckin).Round(time.Second)
+	if includeDate {
+		return fmt.Sprintf("%s%s (overdue by %s, %d missed)%s", Bold+Red, nextCheckin.Format(time.UnixDate), overdue, beacon.MissedCheckins, Normal)
+	}
+	return fmt.Sprintf("%soverdue by %s (%d missed)%s", Bold+Red, overdue, beacon.MissedCheckins, Normal)
+}
+
+// FormatAddressInfo - Summary of an implant's remote address enrichment, e.g. "US AS13335",
+// verbose includes the ASN organization and reverse DNS name
+func (con *SliverConsoleClient) FormatAddressInfo(info *clientpb.AddressInfo, verbose bool) string {
+	parts := []string{}
+	if info.GetCountry() != "" {
+		parts = append(parts, info.GetCountry())
+	}
+	if info.GetASN() != 0 {
+		parts = append(parts, fmt.Sprintf("AS%d", info.GetASN()))
+		if verbose && info.GetASNOrg() != "" {
+			parts = append(parts, info.GetASNOrg())
+		}
+	}
+	if verbose && info.GetReverseDNS() != "" {
+		parts = append(parts, fmt.Sprintf("(%s)", info.GetReverseDNS()))
+	}
+	return strings.Join(parts, " ")
+}
+
 // GrpcContext - Generate a context for a GRPC request, if no grumble context or an invalid flag is provided 60 seconds is used instead
 func (con *SliverConsoleClient) GrpcContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
 	if cmd == nil {
@@ -627,6 +777,38 @@ func (con *SliverConsoleClient) GrpcContext(cmd *cobra.Command) (context.Context
 	return context.WithTimeout(context.Background(), timeout)
 }
 
+// updatePresence - Tell the server which target the operator is interacting with, and
+// warn if other operators are already working it
+func (con *SliverConsoleClient) updatePresence(session *clientpb.Session, beacon *clientpb.Beacon) {
+	if con.Rpc == nil {
+		return
+	}
+	targetID := ""
+	if session != nil {
+		targetID = session.ID
+	} else if beacon != nil {
+		targetID = beacon.ID
+	}
+	presence, err := con.Rpc.SetPresence(context.Background(), &clientpb.PresenceReq{TargetID: targetID})
+	if err != nil || targetID == "" {
+		return
+	}
+	for _, lock := range presence.Locks {
+		msg := fmt.Sprintf("Locked by %s since %s", lock.Operator, time.Unix(lock.LockedAt, 0).Format(time.RFC1123))
+		if lock.Reason != "" {
+			msg += fmt.Sprintf(" (%s)", lock.Reason)
+		}
+		con.PrintWarnf("%s\n", msg)
+	}
+	if 0 < len(presence.Operators) {
+		others := []string{}
+		for _, operator := range presence.Operators {
+			others = append(others, operator.Operator)
+		}
+		con.PrintInfof("Also interacting with this target: %s\n", strings.Join(others, ", "))
+	}
+}
+
 //
 // -------------------------- [ Active Target ] --------------------------
 //
@@ -730,6 +912,12 @@ func (s *ActiveTarget) Set(session *clientpb.Session, beacon *clientpb.Beacon) {
 		return
 	}
 
+	// Bound consoles have no menus or observers
+	if s.con.bound {
+		s.session, s.beacon = session, beacon
+		return
+	}
+
 	defer s.con.ExposeCommands()
 
 	// Backgrounding
@@ -779,6 +967,10 @@ func (s *ActiveTarget) Set(session *clientpb.Session, beacon *clientpb.Beacon) {
 
 // Background - Background the active session
 func (s *ActiveTarget) Background() {
+	if s.con.bound {
+		s.session, s.beacon = nil, nil
+		return
+	}
 	defer s.con.App.ShowCommands()
 
 	s.session = nil
@@ -802,12 +994,19 @@ func (con *SliverConsoleClient) ExposeCommands() {
 		return
 	}
 
+	// Use all defined filters.
+	con.App.HideCommands(con.ActiveTarget.Filters()...)
+}
+
+// Filters - The command filters that apply to the active session/beacon, commands
+// annotated with any of them are not available for the target
+func (s *ActiveTarget) Filters() []string {
 	filters := make([]string, 0)
 
 	// Target type.
 	switch {
-	case con.ActiveTarget.session != nil:
-		session := con.ActiveTarget.session
+	case s.session != nil:
+		session := s.session
 		filters = append(filters, consts.BeaconCmdsFilter)
 
 		// Operating system
@@ -820,8 +1019,8 @@ func (con *SliverConsoleClient) ExposeCommands() {
 			filters = append(filters, consts.WireguardCmdsFilter)
 		}
 
-	case con.ActiveTarget.beacon != nil:
-		beacon := con.ActiveTarget.beacon
+	case s.beacon != nil:
+		beacon := s.beacon
 		filters = append(filters, consts.SessionCmdsFilter)
 
 		// Operating system
@@ -834,9 +1033,7 @@ func (con *SliverConsoleClient) ExposeCommands() {
 			filters = append(filters, consts.WireguardCmdsFilter)
 		}
 	}
-
-	// Use all defined filters.
-	con.App.HideCommands(filters...)
+	return filters
 }
 
 var abilities = []string{
@@ -0,0 +1,113 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+var errHistoryOutOfRange = errors.New("history index out of range")
+
+// targetHistory - The implant menu's history source, the command lines of the active
+// session/beacon are stored by the server so every operator's client shares them
+type targetHistory struct {
+	con      *SliverConsoleClient
+	mutex    *sync.Mutex
+	targetID string
+	entries  []*clientpb.TargetHistoryEntry
+}
+
+func newTargetHistory(con *SliverConsoleClient) *targetHistory {
+	return &targetHistory{
+		con:     con,
+		mutex:   &sync.Mutex{},
+		entries: []*clientpb.TargetHistoryEntry{},
+	}
+}
+
+// load - Fetch the history of the new active target, an observer of the active target
+func (h *targetHistory) load(session *clientpb.Session, beacon *clientpb.Beacon) {
+	targetID := ""
+	if session != nil {
+		targetID = session.ID
+	} else if beacon != nil {
+		targetID = beacon.ID
+	}
+	entries := []*clientpb.TargetHistoryEntry{}
+	if targetID != "" && h.con.Rpc != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		history, err := h.con.Rpc.GetTargetHistory(ctx, &clientpb.TargetHistoryReq{TargetID: targetID})
+		if err == nil {
+			entries = history.Entries
+		}
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.targetID = targetID
+	h.entries = entries
+}
+
+// Write - Record a line locally and on the server
+func (h *targetHistory) Write(line string) (int, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.entries = append(h.entries, &clientpb.TargetHistoryEntry{
+		Line:      line,
+		CreatedAt: time.Now().Unix(),
+	})
+	if h.targetID != "" && h.con.Rpc != nil {
+		req := &clientpb.TargetHistoryReq{TargetID: h.targetID, Line: line}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			h.con.Rpc.AddTargetHistory(ctx, req)
+		}()
+	}
+	return len(h.entries), nil
+}
+
+// GetLine - The line at a position, oldest first
+func (h *targetHistory) GetLine(pos int) (string, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if pos < 0 || len(h.entries) <= pos {
+		return "", errHistoryOutOfRange
+	}
+	return h.entries[pos].Line, nil
+}
+
+// Len - The number of lines
+func (h *targetHistory) Len() int {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return len(h.entries)
+}
+
+// Dump - All of the entries
+func (h *targetHistory) Dump() interface{} {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return append([]*clientpb.TargetHistoryEntry{}, h.entries...)
+}
@@ -0,0 +1,88 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// JSONFlagStr - Persistent flag of the server and implant menus, commands that
+	// support it print a single json document instead of tables/messages
+	JSONFlagStr = "json"
+)
+
+// SetJSONOutput - Called before every command runs. When running from a system shell
+// the usual human readable messages are written to stderr so stdout only contains json.
+func (con *SliverConsoleClient) SetJSONOutput(cmd *cobra.Command) {
+	con.jsonOutput, _ = cmd.Flags().GetBool(JSONFlagStr)
+	if !con.IsCLI || con.bound {
+		return
+	}
+	if con.jsonOutput {
+		con.setPrintf(func(format string, args ...any) (int, error) {
+			return fmt.Fprintf(os.Stderr, format, args...)
+		})
+	} else {
+		con.setPrintf(fmt.Printf)
+	}
+}
+
+// IsJSON - True if the current command should print json
+func (con *SliverConsoleClient) IsJSON() bool {
+	return con.jsonOutput
+}
+
+// PrintJSON - Print a value as indented json, protobuf messages are marshaled
+// with their field names so the output is stable across versions
+func (con *SliverConsoleClient) PrintJSON(value any) {
+	var data []byte
+	var err error
+	if msg, ok := value.(proto.Message); ok {
+		data, err = protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		}.Marshal(msg)
+	} else {
+		data, err = json.Marshal(value)
+	}
+	if err != nil {
+		con.PrintErrorf("Failed to marshal json: %s\n", err)
+		return
+	}
+	// protojson deliberately randomizes its whitespace
+	indented := &bytes.Buffer{}
+	err = json.Indent(indented, data, "", "  ")
+	if err != nil {
+		con.PrintErrorf("Failed to marshal json: %s\n", err)
+		return
+	}
+	if con.IsCLI && !con.bound {
+		fmt.Fprintf(os.Stdout, "%s\n", indented.String())
+		return
+	}
+	con.Printf("%s\n", indented.String())
+}
@@ -26,6 +26,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/moloch--/asciicast"
@@ -151,11 +152,72 @@ func (con *SliverConsoleClient) PrintAsyncResponse(resp *commonpb.Response) {
 	con.PrintInfof("Tasked beacon %s (%s)", beacon.Name, strings.Split(resp.TaskID, "-")[0])
 }
 
+// statusf - Info, warning, error, and event messages are written to stderr in
+// batch mode so stdout only contains the output of the commands
+func (con *SliverConsoleClient) statusf(format string, args ...any) (int, error) {
+	printf, statusPrintf := con.output()
+	if statusPrintf != nil {
+		return statusPrintf(format, args...)
+	}
+	return printf(format, args...)
+}
+
+// output - The functions the console currently prints output and messages with
+func (con *SliverConsoleClient) output() (printf, statusPrintf func(format string, args ...any) (int, error)) {
+	con.printfMutex.RLock()
+	defer con.printfMutex.RUnlock()
+	return con.printf, con.statusPrintf
+}
+
+// setOutput - Replace the functions the console prints output and messages with, returns
+// a function that restores the previous ones
+func (con *SliverConsoleClient) setOutput(printf, statusPrintf func(format string, args ...any) (int, error)) (restore func()) {
+	con.printfMutex.Lock()
+	defer con.printfMutex.Unlock()
+	previous, previousStatus := con.printf, con.statusPrintf
+	con.printf, con.statusPrintf = printf, statusPrintf
+	return func() {
+		con.printfMutex.Lock()
+		defer con.printfMutex.Unlock()
+		con.printf, con.statusPrintf = previous, previousStatus
+	}
+}
+
+// setPrintf - Replace the function the console prints output with
+func (con *SliverConsoleClient) setPrintf(printf func(format string, args ...any) (int, error)) {
+	con.printfMutex.Lock()
+	defer con.printfMutex.Unlock()
+	con.printf = printf
+}
+
+// holdOutput - Print output with write until restore is called, messages are still
+// printed as usual
+func (con *SliverConsoleClient) holdOutput(write func(format string, args ...any) (int, error)) (restore func()) {
+	printf, statusPrintf := con.output()
+	if statusPrintf == nil {
+		statusPrintf = printf
+	}
+	return con.setOutput(write, statusPrintf)
+}
+
+// SetBatchMode - Used when running commands non-interactively from a system shell
+func (con *SliverConsoleClient) SetBatchMode() {
+	con.setOutput(fmt.Printf, func(format string, args ...any) (int, error) {
+		return fmt.Fprintf(os.Stderr, format, args...)
+	})
+}
+
+// ErrorCount - The number of errors printed by commands, used to determine the
+// exit code of non-interactive commands
+func (con *SliverConsoleClient) ErrorCount() int {
+	return int(atomic.LoadInt32(&con.errorCount))
+}
+
 func (con *SliverConsoleClient) Printf(format string, args ...any) {
 	logger := slog.NewLogLogger(con.jsonHandler, slog.LevelInfo)
 	logger.Printf(format, args...)
 
-	con.printf(format, args...)
+	con.forwardPrintf(format, args...)
 }
 
 // Println prints an output without status and immediately below the last line of output.
@@ -163,7 +225,7 @@ func (con *SliverConsoleClient) Println(args ...any) {
 	logger := slog.New(con.jsonHandler)
 	format := strings.Repeat("%s", len(args))
 	logger.Info(fmt.Sprintf(format, args))
-	con.printf(format, args...)
+	con.forwardPrintf(format, args...)
 }
 
 // PrintInfof prints an info message immediately below the last line of output.
@@ -172,7 +234,7 @@ func (con *SliverConsoleClient) PrintInfof(format string, args ...any) {
 
 	logger.Info(fmt.Sprintf(format, args...))
 
-	con.printf(Clearln+Info+format, args...)
+	con.statusf(Clearln+Info+format, args...)
 }
 
 // PrintSuccessf prints a success message immediately below the last line of output.
@@ -181,7 +243,7 @@ func (con *SliverConsoleClient) PrintSuccessf(format string, args ...any) {
 
 	logger.Info(fmt.Sprintf(format, args...))
 
-	con.printf(Clearln+Success+format, args...)
+	con.statusf(Clearln+Success+format, args...)
 }
 
 // PrintWarnf a warning message immediately below the last line of output.
@@ -190,7 +252,7 @@ func (con *SliverConsoleClient) PrintWarnf(format string, args ...any) {
 
 	logger.Warn(fmt.Sprintf(format, args...))
 
-	con.printf(Clearln+"⚠️  "+Normal+format, args...)
+	con.statusf(Clearln+"⚠️  "+Normal+format, args...)
 }
 
 // PrintErrorf prints an error message immediately below the last line of output.
@@ -198,8 +260,9 @@ func (con *SliverConsoleClient) PrintErrorf(format string, args ...any) {
 	logger := slog.New(con.jsonHandler)
 
 	logger.Error(fmt.Sprintf(format, args...))
+	atomic.AddInt32(&con.errorCount, 1)
 
-	con.printf(Clearln+Warn+format, args...)
+	con.statusf(Clearln+Warn+format, args...)
 }
 
 // PrintEventInfof prints an info message with a leading/trailing newline for emphasis.
@@ -208,7 +271,7 @@ func (con *SliverConsoleClient) PrintEventInfof(format string, args ...any) {
 
 	logger.Info(fmt.Sprintf(format, args...))
 
-	con.printf(Clearln+"\r\n"+Info+format+"\r", args...)
+	con.statusf(Clearln+"\r\n"+Info+format+"\r", args...)
 }
 
 // PrintEventErrorf prints an error message with a leading/trailing newline for emphasis.
@@ -217,7 +280,7 @@ func (con *SliverConsoleClient) PrintEventErrorf(format string, args ...any) {
 
 	logger.Error(fmt.Sprintf(format, args...))
 
-	con.printf(Clearln+"\r\n"+Warn+format+"\r", args...)
+	con.statusf(Clearln+"\r\n"+Warn+format+"\r", args...)
 }
 
 // PrintEventSuccessf a success message with a leading/trailing newline for emphasis.
@@ -226,5 +289,5 @@ func (con *SliverConsoleClient) PrintEventSuccessf(format string, args ...any) {
 
 	logger.Info(fmt.Sprintf(format, args...))
 
-	con.printf(Clearln+"\r\n"+Success+format+"\r", args...)
+	con.statusf(Clearln+"\r\n"+Success+format+"\r", args...)
 }
@@ -0,0 +1,128 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util/opsec"
+)
+
+const (
+	// opsecPolicyTTL - How long the server's policy is cached, so policy changes are
+	// picked up without reconnecting
+	opsecPolicyTTL = time.Minute
+)
+
+// ErrOpsecRefused - The command was not run because of the opsec policy
+var ErrOpsecRefused = errors.New("refused by opsec policy")
+
+// CheckOpsecPolicy - Warn, prompt, or refuse before running a command the opsec policy
+// classifies as risky. Blocks are also enforced by the server, checking them here means
+// operators that may override a block are asked first.
+func (con *SliverConsoleClient) CheckOpsecPolicy(cmd *cobra.Command, args []string) error {
+	policy := con.getOpsecPolicy()
+	rules := opsec.CommandRules(policy.Rules, commandPath(cmd), commandLine(cmd, args))
+	rule := opsec.Strictest(rules)
+	if rule == nil {
+		return nil
+	}
+	name := rule.Category
+	if rule.Description != "" {
+		name += fmt.Sprintf(" (%s)", rule.Description)
+	}
+	switch opsec.Rank(rule.Action) {
+	case opsec.Rank(opsec.ActionWarn):
+		con.PrintWarnf("OPSEC: %s\n", name)
+		return nil
+
+	case opsec.Rank(opsec.ActionPrompt):
+		if con.confirmOpsec(fmt.Sprintf("OPSEC: %s, continue?", name)) {
+			return nil
+		}
+		con.PrintErrorf("Refused by the '%s' opsec policy\n", rule.Category)
+
+	default:
+		if !opsec.CanOverride(rule, policy.Operator) {
+			con.PrintErrorf("Blocked by the '%s' opsec policy: %s\n", rule.Category, name)
+			return ErrOpsecRefused
+		}
+		if con.confirmOpsec(fmt.Sprintf("OPSEC: %s is blocked by the engagement rules, override?", name)) {
+			return nil
+		}
+		con.PrintErrorf("Refused by the '%s' opsec policy\n", rule.Category)
+	}
+	return ErrOpsecRefused
+}
+
+// getOpsecPolicy - The server's policy with the operator's own rules added, if the
+// server's policy can't be fetched only the operator's rules are checked
+func (con *SliverConsoleClient) getOpsecPolicy() *clientpb.OpsecPolicy {
+	if con.opsecPolicy == nil || opsecPolicyTTL < time.Since(con.opsecPolicyFetched) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		policy, err := con.Rpc.GetOpsecPolicy(ctx, &commonpb.Empty{})
+		if err != nil {
+			policy = &clientpb.OpsecPolicy{}
+		} else {
+			con.opsecPolicyFetched = time.Now()
+		}
+		local, err := assets.LoadOpsecRules()
+		if err != nil {
+			con.PrintWarnf("Failed to load opsec rules: %s\n", err)
+		}
+		policy.Rules = append(policy.Rules, local...)
+		con.opsecPolicy = policy
+	}
+	return con.opsecPolicy
+}
+
+// confirmOpsec - Ask the operator to confirm, commands that aren't run from an
+// interactive terminal (scripts, reactions, taskmany) are never confirmed
+func (con *SliverConsoleClient) confirmOpsec(msg string) bool {
+	if con.capture != nil || !term.IsTerminal(int(os.Stdin.Fd())) {
+		con.PrintWarnf("OPSEC: confirmation required, run the command interactively\n")
+		return false
+	}
+	confirm := false
+	prompt := &survey.Confirm{Message: msg}
+	survey.AskOne(prompt, &confirm, nil)
+	return confirm
+}
+
+// commandPath - The command's name and the names of its parents, without the menu's root
+func commandPath(cmd *cobra.Command) string {
+	parts := []string{}
+	for parent := cmd; parent.HasParent(); parent = parent.Parent() {
+		parts = append([]string{parent.Name()}, parts...)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,214 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/reeflective/console"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// CheckCommand - Find the command a line runs, returns an error if the command doesn't exist
+// or isn't available for a target with the given filters (e.g. an interactive command on a beacon)
+func CheckCommand(root *cobra.Command, line string, filters []string) (*cobra.Command, error) {
+	args, err := shellquote.Split(line)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", line, err)
+	}
+	target, _, err := root.Find(args)
+	if err != nil || target == root {
+		return nil, fmt.Errorf("unknown command '%s'", line)
+	}
+	for parent := target; parent != nil; parent = parent.Parent() {
+		for _, filter := range strings.Split(parent.Annotations[console.CommandFilterKey], ",") {
+			for _, activeFilter := range filters {
+				if filter != "" && filter == activeFilter {
+					return nil, fmt.Errorf("'%s' is not available for this target", strings.TrimSpace(target.CommandPath()))
+				}
+			}
+		}
+	}
+	return target, nil
+}
+
+// triggerReactions - Run the reactions to an event, reactions run in the background
+// so that delays don't hold up the event loop
+func (con *SliverConsoleClient) triggerReactions(event *clientpb.Event) {
+	reactions := core.Reactions.On(event.EventType)
+	if len(reactions) == 0 {
+		return
+	}
+	session, beacon := reactionTarget(event)
+	activeSession, activeBeacon := con.ActiveTarget.Get()
+	for _, reaction := range reactions {
+		if !reaction.Filter.IsEmpty() && !con.reactionMatches(reaction.Filter, session, beacon) {
+			continue
+		}
+		// Only new sessions and beacons become the target of the commands, other
+		// reactions run on whatever the operator was interacting with
+		if event.EventType == consts.SessionOpenedEvent || event.EventType == consts.BeaconRegisteredEvent {
+			go con.runReaction(reaction, session, beacon)
+		} else {
+			go con.runReaction(reaction, activeSession, activeBeacon)
+		}
+	}
+}
+
+// reactionTarget - The session or beacon an event is about, if any
+func reactionTarget(event *clientpb.Event) (*clientpb.Session, *clientpb.Beacon) {
+	if event.EventType == consts.BeaconRegisteredEvent {
+		beacon := &clientpb.Beacon{}
+		if proto.Unmarshal(event.Data, beacon) != nil || beacon.ID == "" {
+			return nil, nil
+		}
+		return nil, beacon
+	}
+	return event.Session, nil
+}
+
+// reactionMatches - Check a session/beacon against a reaction's filter, events that
+// aren't about a session or beacon never match a filter
+func (con *SliverConsoleClient) reactionMatches(filter *core.ReactionFilter, session *clientpb.Session, beacon *clientpb.Beacon) bool {
+	var os, remoteAddress string
+	var tags []string
+	switch {
+	case session != nil:
+		os, remoteAddress, tags = session.OS, session.RemoteAddress, session.Tags
+	case beacon != nil:
+		os, remoteAddress, tags = beacon.OS, beacon.RemoteAddress, beacon.Tags
+	default:
+		return false
+	}
+	// Tags are assigned by operators, the event's copy of the target may not have them
+	if 0 < len(filter.Tags) {
+		tags = con.targetTags(session, beacon)
+	}
+	return filter.Matches(os, tags, remoteAddress)
+}
+
+func (con *SliverConsoleClient) targetTags(session *clientpb.Session, beacon *clientpb.Beacon) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if beacon != nil {
+		beacon, err := con.Rpc.GetBeacon(ctx, &clientpb.Beacon{ID: beacon.ID})
+		if err != nil {
+			return []string{}
+		}
+		return beacon.Tags
+	}
+	sessions, err := con.Rpc.GetSessions(ctx, &commonpb.Empty{})
+	if err != nil {
+		return []string{}
+	}
+	for _, other := range sessions.Sessions {
+		if other.ID == session.ID {
+			return other.Tags
+		}
+	}
+	return []string{}
+}
+
+// runReaction - Run a reaction's commands in order on the session/beacon, or on the
+// server without one. Each reaction runs on a console of its own, so reactions neither
+// wait for each other nor change the operator's active target, and only the errors of
+// its own commands stop it. On beacons the commands are queued as tasks.
+func (con *SliverConsoleClient) runReaction(reaction core.Reaction, session *clientpb.Session, beacon *clientpb.Beacon) {
+	name := fmt.Sprintf("Reaction %d", reaction.ID)
+	bound := con.Bind(session, beacon, nil)
+	err := bound.checkReaction(reaction)
+	if err != nil {
+		con.PrintErrorf("%s: %s\n", name, err)
+		return
+	}
+
+	pending := con.PendingBeaconTasks()
+	steps := [][]string{}
+	current := []string{}
+	for _, line := range reaction.Commands {
+		if _, ok, _ := core.ReactionDelay(line); ok {
+			if 0 < len(current) {
+				steps = append(steps, current)
+				current = []string{}
+			}
+			steps = append(steps, []string{line})
+			continue
+		}
+		current = append(current, line)
+	}
+	if 0 < len(current) {
+		steps = append(steps, current)
+	}
+	for _, step := range steps {
+		if delay, ok, _ := core.ReactionDelay(step[0]); ok {
+			time.Sleep(delay)
+			continue
+		}
+		for _, line := range step {
+			con.PrintInfof(Bold+"%s: '%s'"+Normal+"\n", name, line)
+			errorCount := bound.ErrorCount()
+			err = bound.RunCommand(line)
+			if err == nil && reaction.StopOnError && errorCount < bound.ErrorCount() {
+				err = fmt.Errorf("'%s' failed, stopping", line)
+			} else if err != nil {
+				err = fmt.Errorf("%s: %s", line, err)
+			}
+			if err != nil {
+				con.PrintErrorf("%s: %s\n", name, err)
+				return
+			}
+		}
+	}
+	if beacon != nil {
+		con.PrintInfof("%s: queued %d task(s) on %s\n", name, con.PendingBeaconTasks()-pending, beacon.Name)
+	}
+}
+
+// checkReaction - Check every command before running any of them, so that a beacon isn't
+// left with only part of a reaction queued
+func (con *SliverConsoleClient) checkReaction(reaction core.Reaction) error {
+	session, beacon := con.ActiveTarget.Get()
+	if session == nil && beacon == nil {
+		return nil
+	}
+	root := con.sliverCommands(con)()
+	for _, line := range reaction.Commands {
+		if _, ok, err := core.ReactionDelay(line); ok {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		_, err := CheckCommand(root, line, con.ActiveTarget.Filters())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
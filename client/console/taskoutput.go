@@ -0,0 +1,132 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// taskOutputTimeout - How long to wait for the rest of the output once the task
+	// completed, events and the response aren't delivered in order
+	taskOutputTimeout = 10 * time.Second
+)
+
+// TaskOutputStream - The output of a session task, printed as the implant sends it
+type TaskOutputStream struct {
+	ID string
+
+	ignoreStderr bool
+	mutex        *sync.Mutex
+	stdout       *bytes.Buffer
+	stderr       *bytes.Buffer
+
+	next    uint64
+	pending map[uint64]*sliverpb.TaskOutput
+	done    chan struct{}
+}
+
+// StreamTaskOutput - Ask the implant to stream the request's output, which is printed
+// as it arrives instead of when the task completes. Returns nil for beacon requests,
+// or when the output is printed as json.
+func (con *SliverConsoleClient) StreamTaskOutput(request *commonpb.Request, ignoreStderr bool) *TaskOutputStream {
+	if request == nil || request.Async || con.jsonOutput {
+		return nil
+	}
+	id, _ := uuid.NewV4()
+	stream := &TaskOutputStream{
+		ID:           id.String(),
+		ignoreStderr: ignoreStderr,
+		mutex:        &sync.Mutex{},
+		stdout:       &bytes.Buffer{},
+		stderr:       &bytes.Buffer{},
+		pending:      map[uint64]*sliverpb.TaskOutput{},
+		done:         make(chan struct{}),
+	}
+	request.StreamID = stream.ID
+	con.taskOutputs.Store(stream.ID, stream)
+	return stream
+}
+
+// CloseTaskOutput - Stop printing the stream's output and return all of the output
+// that was streamed. Wait for the rest of it if the task completed and its response
+// has no output, implants that don't stream output include all of it in the response.
+func (con *SliverConsoleClient) CloseTaskOutput(stream *TaskOutputStream, wait bool) ([]byte, []byte) {
+	if wait {
+		select {
+		case <-stream.done:
+		case <-time.After(taskOutputTimeout):
+			con.PrintWarnf("Timeout waiting for the rest of the output\n")
+		}
+	}
+	con.taskOutputs.Delete(stream.ID)
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+	return stream.stdout.Bytes(), stream.stderr.Bytes()
+}
+
+// triggerTaskOutput - Print the output of one of our streams in order, other
+// operators' streams are ignored
+func (con *SliverConsoleClient) triggerTaskOutput(event *clientpb.Event) {
+	output := &sliverpb.TaskOutput{}
+	err := proto.Unmarshal(event.Data, output)
+	if err != nil {
+		return
+	}
+	value, ok := con.taskOutputs.Load(output.StreamID)
+	if !ok {
+		return
+	}
+	stream := value.(*TaskOutputStream)
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+	if output.Sequence < stream.next {
+		return
+	}
+	stream.pending[output.Sequence] = output
+	for {
+		output, ok := stream.pending[stream.next]
+		if !ok {
+			return
+		}
+		delete(stream.pending, stream.next)
+		stream.next++
+		stream.stdout.Write(output.Stdout)
+		stream.stderr.Write(output.Stderr)
+		if 0 < len(output.Stdout) {
+			con.Printf("%s", output.Stdout)
+		}
+		if 0 < len(output.Stderr) && !stream.ignoreStderr {
+			con.Printf("%s", output.Stderr)
+		}
+		if output.Done {
+			close(stream.done)
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestTaskOutputInOrder(t *testing.T) {
+	printed := &bytes.Buffer{}
+	con := &SliverConsoleClient{
+		jsonHandler: slog.NewTextHandler(&bytes.Buffer{}, nil),
+		printfMutex: &sync.RWMutex{},
+		printf: func(format string, args ...any) (int, error) {
+			return fmt.Fprintf(printed, format, args...)
+		},
+		taskOutputs: &sync.Map{},
+	}
+	request := &commonpb.Request{SessionID: "abc"}
+	stream := con.StreamTaskOutput(request, true)
+	if stream == nil || request.StreamID != stream.ID {
+		t.Fatal("expected the request to be streamed")
+	}
+
+	// Events aren't delivered in order, and other streams are ignored
+	outputs := []*sliverpb.TaskOutput{
+		{StreamID: stream.ID, Sequence: 1, Stdout: []byte("world"), Stderr: []byte("oops")},
+		{StreamID: "other", Sequence: 0, Stdout: []byte("nope")},
+		{StreamID: stream.ID, Sequence: 2, Done: true},
+		{StreamID: stream.ID, Sequence: 0, Stdout: []byte("hello ")},
+		{StreamID: stream.ID, Sequence: 0, Stdout: []byte("again")},
+	}
+	for _, output := range outputs {
+		data, _ := proto.Marshal(output)
+		con.triggerTaskOutput(&clientpb.Event{Data: data})
+	}
+	stdout, stderr := con.CloseTaskOutput(stream, true)
+	if string(stdout) != "hello world" || string(stderr) != "oops" {
+		t.Fatalf("unexpected output %q %q", stdout, stderr)
+	}
+	if printed.String() != "hello world" {
+		t.Fatalf("unexpected printed output %q", printed.String())
+	}
+	if _, ok := con.taskOutputs.Load(stream.ID); ok {
+		t.Fatal("expected the stream to be removed")
+	}
+}
+
+func TestTaskOutputBeacon(t *testing.T) {
+	con := &SliverConsoleClient{taskOutputs: &sync.Map{}}
+	if con.StreamTaskOutput(&commonpb.Request{Async: true, BeaconID: "abc"}, false) != nil {
+		t.Fatal("expected beacon output not to be streamed")
+	}
+}
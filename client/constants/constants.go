@@ -35,6 +35,9 @@ const (
 	ServerMenu  = ""
 )
 
+// TargetColors - The colors operators can assign to sessions and beacons
+var TargetColors = []string{"red", "green", "orange", "blue", "purple", "cyan", "gray"}
+
 // Events
 const (
 	// UpdateStr - "update"
@@ -60,12 +63,33 @@ const (
 	// LeftEvent - Player left the game
 	LeftEvent = "client-left"
 
-	// CanaryEvent - A DNS canary was triggered
+	// CanaryEvent - A DNS canary or canary token was triggered
 	CanaryEvent = "canary"
 
 	// WatchtowerEvent - An implant hash has been identified on a threat intel platform
 	WatchtowerEvent = "watchtower"
 
+	// BurnedContactEvent - A burned implant contacted a listener
+	BurnedContactEvent = "burned-contact"
+
+	// FederationEvent - An event relayed from a linked server
+	FederationEvent = "federation"
+
+	// AutomationEvent - A server-side automation rule ran a task
+	AutomationEvent = "automation"
+
+	// StageFetchEvent - A stage listener received a request for its stage
+	StageFetchEvent = "stage-fetch"
+
+	// TargetLockEvent - An operator locked, unlocked, or took over a session/beacon
+	TargetLockEvent = "target-lock"
+
+	// TargetMetadataEvent - An operator changed the tags, note, or color of a session/beacon
+	TargetMetadataEvent = "target-metadata"
+
+	// OpsecEvent - An rpc was blocked by the opsec policy, or an operator overrode a block
+	OpsecEvent = "opsec"
+
 	// StartedEvent - Job was started
 	JobStartedEvent = "job-started"
 	// StoppedEvent - Job was stopped
@@ -92,9 +116,15 @@ const (
 	// BeaconRegisteredEvent - First connection from a new beacon
 	BeaconRegisteredEvent = "beacon-registered"
 
+	// BeaconOverdueEvent - A beacon missed several expected check-ins
+	BeaconOverdueEvent = "beacon-overdue"
+
 	// BeaconTaskResult - Beacon task completed with a result
 	BeaconTaskResultEvent = "beacon-taskresult"
 
+	// TaskOutputEvent - Partial output of a streamed session task
+	TaskOutputEvent = "task-output"
+
 	// ExternalBuildEvent
 	ExternalBuildEvent          = "external-build"
 	AcknowledgeBuildEvent       = "external-acknowledge"
@@ -118,29 +148,46 @@ const (
 
 // Commands
 const (
-	OperatorsStr       = "operators"
-	NewOperatorStr     = "new-operator"
-	KickOperatorStr    = "kick-operator"
-	MultiplayerModeStr = "multiplayer"
+	OperatorsStr        = "operators"
+	NewOperatorStr      = "new-operator"
+	KickOperatorStr     = "kick-operator"
+	OperatorCertsStr    = "operator-certs"
+	RevokeOperatorStr   = "revoke-operator"
+	RotateOperatorCAStr = "rotate-operator-ca"
+	MultiplayerModeStr  = "multiplayer"
+	DashboardStr        = "dashboard"
+	MythicBridgeStr     = "mythic-bridge"
 
 	SessionsStr        = "sessions"
 	BackgroundStr      = "background"
 	InfoStr            = "info"
 	UseStr             = "use"
 	TaskmanyStr        = "taskmany"
+	PanesStr           = "panes"
 	ReconfigStr        = "reconfig"
 	PruneStr           = "prune"
 	TasksStr           = "tasks"
 	CancelStr          = "cancel"
+	PendingStr         = "pending"
+	MoveStr            = "move"
 	GenerateStr        = "generate"
 	RegenerateStr      = "regenerate"
+	VerifyStr          = "verify"
+	LookupStr          = "lookup"
+	BurnStr            = "burn"
+	BundleStr          = "bundle"
+	PatchStr           = "patch"
 	CompilerInfoStr    = "info"
 	MsfStagerStr       = "msf-stager"
+	LoaderStr          = "loader"
+	WizardStr          = "wizard"
+	PreflightStr       = "preflight"
 	ProfilesStr        = "profiles"
 	BeaconStr          = "beacon"
 	BeaconsStr         = "beacons"
 	WatchStr           = "watch"
 	SettingsStr        = "settings"
+	AutoLootStr        = "auto-loot"
 	SearchStr          = "search"
 	TrafficEncodersStr = "traffic-encoders"
 
@@ -156,21 +203,53 @@ const (
 	SaveStr    = "save"
 	ReloadStr  = "reload"
 	LoadStr    = "load"
+	UnloadStr  = "unload"
+	RunStr     = "run"
 	TablesStr  = "tables"
 	DetailsStr = "details"
 	GraphStr   = "graph"
 
+	PublishStr   = "publish"
+	UnpublishStr = "unpublish"
+	HostedStr    = "hosted"
+	ServeStr     = "serve"
+	PinStr       = "pin"
+	UnpinStr     = "unpin"
+	RollbackStr  = "rollback"
+
 	LootStr       = "loot"
+	BloodHoundStr = "bloodhound"
+	SharpHoundStr = "sharphound"
+	ADStr         = "ad"
+	IngestStr     = "ingest"
 	LootLocalStr  = "local"
 	LootRemoteStr = "remote"
 	FetchStr      = "fetch"
 	CredsStr      = "creds"
 	FileStr       = "file"
 
-	RenameStr = "rename"
+	RenameStr  = "rename"
+	TagStr     = "tag"
+	ExportStr  = "export"
+	CrackedStr = "cracked"
+	ReplayStr  = "replay"
+
+	FederationStr = "federation"
+	LinkStr       = "link"
+	UnlinkStr     = "unlink"
+
+	AutomationStr = "automation"
+	EnableStr     = "enable"
+	DisableStr    = "disable"
+	SchedulesStr  = "schedules"
+	GroupsStr     = "groups"
+	MembersStr    = "members"
+	TaskStr       = "task"
 
 	ImplantBuildsStr = "implants"
 	CanariesStr      = "canaries"
+	AWSStr           = "aws"
+	DocumentStr      = "document"
 
 	JobsStr        = "jobs"
 	MtlsStr        = "mtls"
@@ -178,8 +257,25 @@ const (
 	DnsStr         = "dns"
 	HttpStr        = "http"
 	HttpsStr       = "https"
+	ListenersStr   = "listeners"
+	HTTPC2Str      = "http-c2"
+	RedirectorStr  = "redirector"
 	NamedPipeStr   = "named-pipe"
 	TCPListenerStr = "tcp"
+	ExternalC2Str  = "extc2"
+
+	ReloadConfigStr = "reload-config"
+
+	FetchesStr = "fetches"
+
+	ImportStr  = "import"
+	HistoryStr = "history"
+
+	CaptureStr = "capture"
+
+	PresenceStr = "presence"
+	LockStr     = "lock"
+	UnlockStr   = "unlock"
 
 	MsfStr       = "msf"
 	MsfInjectStr = "msf-inject"
@@ -194,24 +290,30 @@ const (
 	GetGIDStr = "getgid"
 	WhoamiStr = "whoami"
 
+	EDREnumStr = "edr-enum"
+
 	ShellStr   = "shell"
 	ExecuteStr = "execute"
 
-	LsStr       = "ls"
-	MvStr       = "mv"
-	CpStr       = "cp"
-	RmStr       = "rm"
-	MkdirStr    = "mkdir"
-	CdStr       = "cd"
-	PwdStr      = "pwd"
-	CatStr      = "cat"
-	DownloadStr = "download"
-	UploadStr   = "upload"
-	IfconfigStr = "ifconfig"
-	NetstatStr  = "netstat"
-	ChmodStr    = "chmod"
-	ChownStr    = "chown"
-	ChtimesStr  = "chtimes"
+	LsStr        = "ls"
+	MvStr        = "mv"
+	CpStr        = "cp"
+	RmStr        = "rm"
+	MkdirStr     = "mkdir"
+	CdStr        = "cd"
+	PwdStr       = "pwd"
+	CatStr       = "cat"
+	DownloadStr  = "download"
+	UploadStr    = "upload"
+	TransfersStr = "transfers"
+	IfconfigStr  = "ifconfig"
+	NetstatStr   = "netstat"
+	ScanStr      = "scan"
+	DiscoverStr  = "discover"
+	ResolversStr = "resolvers"
+	ChmodStr     = "chmod"
+	ChownStr     = "chown"
+	ChtimesStr   = "chtimes"
 
 	MemfilesStr = "memfiles"
 
@@ -231,6 +333,7 @@ const (
 	ListStr             = "list"
 	ArmoryStr           = "armory"
 	AliasesStr          = "aliases"
+	PluginsStr          = "plugins"
 	StageListenerStr    = "stage-listener"
 
 	WebsitesStr       = "websites"
@@ -265,10 +368,21 @@ const (
 	Socks5Str   = "socks5"
 	RportfwdStr = "rportfwd"
 
-	ReactionStr = "reaction"
+	ConnectionsStr = "connections"
+	LimitStr       = "limit"
 
-	HostsStr = "hosts"
-	IOCStr   = "ioc"
+	ReactionStr = "reaction"
+	ScriptsStr  = "scripts"
+	MacrosStr   = "macros"
+	NoteStr     = "note"
+	ColorStr    = "color"
+
+	HostsStr      = "hosts"
+	IOCStr        = "ioc"
+	ScansStr      = "scans"
+	DiscoveredStr = "discovered"
+	TimelineStr   = "timeline"
+	CoverageStr   = "coverage"
 
 	LicensesStr = "licenses"
 
@@ -285,7 +399,8 @@ const (
 	CursedEdge     = "edge"
 	CursedCookies  = "cookies"
 
-	BuildersStr = "builders"
+	BuildersStr   = "builders"
+	ToolchainsStr = "toolchains"
 
 	CrackStr     = "crack"
 	StationsStr  = "stations"
@@ -313,6 +428,7 @@ const (
 
 	AliasHelpGroup     = "Sliver - 3rd Party macros"
 	ExtensionHelpGroup = "Sliver - 3rd Party extensions"
+	PluginHelpGroup    = "3rd Party plugins"
 
 	// Useless
 	SliverWinHelpGroup   = "Sliver - Windows"
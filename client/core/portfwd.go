@@ -48,22 +48,34 @@ var (
 // PortfwdMeta - Metadata about a portfwd listener
 type PortfwdMeta struct {
 	ID         int
+	Protocol   string
 	SessionID  string
 	BindAddr   string
 	RemoteAddr string
 }
 
-// Portfwd - Tracks portfwd<->tcpproxy
+// Portfwd - Tracks portfwd<->tcpproxy, or the udp proxy of a udp portfwd
 type Portfwd struct {
 	ID           int
 	TCPProxy     *tcpproxy.Proxy
 	ChannelProxy *ChannelProxy
+	UDPProxy     *UDPProxy
 }
 
 // GetMetadata - Get metadata about the portfwd
 func (p *Portfwd) GetMetadata() *PortfwdMeta {
+	if p.UDPProxy != nil {
+		return &PortfwdMeta{
+			ID:         p.ID,
+			Protocol:   "udp",
+			SessionID:  p.UDPProxy.Session.ID,
+			BindAddr:   p.UDPProxy.BindAddr,
+			RemoteAddr: p.UDPProxy.RemoteAddr,
+		}
+	}
 	return &PortfwdMeta{
 		ID:         p.ID,
+		Protocol:   "tcp",
 		SessionID:  p.ChannelProxy.Session.ID,
 		BindAddr:   p.ChannelProxy.BindAddr,
 		RemoteAddr: p.ChannelProxy.RemoteAddr,
@@ -88,12 +100,28 @@ func (f *portfwds) Add(tcpProxy *tcpproxy.Proxy, channelProxy *ChannelProxy) *Po
 	return portfwd
 }
 
-// Remove - Remove a TCP proxy instance
+// AddUDP - Add a UDP proxy instance
+func (f *portfwds) AddUDP(udpProxy *UDPProxy) *Portfwd {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	portfwd := &Portfwd{
+		ID:       nextPortfwdID(),
+		UDPProxy: udpProxy,
+	}
+	f.forwards[portfwd.ID] = portfwd
+	return portfwd
+}
+
+// Remove - Remove a TCP/UDP proxy instance
 func (f *portfwds) Remove(portfwdID int) bool {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 	if portfwd, ok := f.forwards[portfwdID]; ok {
-		portfwd.TCPProxy.Close()
+		if portfwd.UDPProxy != nil {
+			portfwd.UDPProxy.Close()
+		} else {
+			portfwd.TCPProxy.Close()
+		}
 		delete(f.forwards, portfwdID)
 		return true
 	}
@@ -192,29 +220,34 @@ func (p *ChannelProxy) Host() string {
 }
 
 func (p *ChannelProxy) dialImplant(ctx context.Context) (*TunnelIO, error) {
+	return dialImplant(ctx, p.Rpc, p.Session, p.Host(), p.Port(), sliverpb.PortFwdProtoTCP)
+}
+
+// dialImplant - Create a tunnel and have the implant connect it to host:port
+func dialImplant(ctx context.Context, rpc rpcpb.SliverRPCClient, session *clientpb.Session, host string, port uint32, protocol int32) (*TunnelIO, error) {
 
 	log.Printf("[tcpproxy] Dialing implant to create tunnel ...")
 
 	// Create an RPC tunnel, then start it before binding the shell to the newly created tunnel
-	rpcTunnel, err := p.Rpc.CreateTunnel(ctx, &sliverpb.Tunnel{
-		SessionID: p.Session.ID,
+	rpcTunnel, err := rpc.CreateTunnel(ctx, &sliverpb.Tunnel{
+		SessionID: session.ID,
 	})
 	if err != nil {
 		log.Printf("[tcpproxy] Failed to dial implant %s", err)
 		return nil, err
 	}
 
-	log.Printf("[tcpproxy] Created new tunnel with id %d (session %s)", rpcTunnel.TunnelID, p.Session.ID)
+	log.Printf("[tcpproxy] Created new tunnel with id %d (session %s)", rpcTunnel.TunnelID, session.ID)
 	tunnel := GetTunnels().Start(rpcTunnel.TunnelID, rpcTunnel.SessionID)
 
-	log.Printf("[tcpproxy] Binding tunnel to portfwd %d", p.Port())
-	portfwdResp, err := p.Rpc.Portfwd(ctx, &sliverpb.PortfwdReq{
+	log.Printf("[tcpproxy] Binding tunnel to portfwd %d", port)
+	portfwdResp, err := rpc.Portfwd(ctx, &sliverpb.PortfwdReq{
 		Request: &commonpb.Request{
-			SessionID: p.Session.ID,
+			SessionID: session.ID,
 		},
-		Host:     p.Host(),
-		Port:     p.Port(),
-		Protocol: sliverpb.PortFwdProtoTCP,
+		Host:     host,
+		Port:     port,
+		Protocol: protocol,
 		TunnelID: tunnel.ID,
 	})
 	if err != nil {
@@ -222,9 +255,9 @@ func (p *ChannelProxy) dialImplant(ctx context.Context) (*TunnelIO, error) {
 	}
 	// Close tunnel in case of error on the implant side
 	if portfwdResp.Response != nil && portfwdResp.Response.Err != "" {
-		p.Rpc.CloseTunnel(ctx, &sliverpb.Tunnel{
+		rpc.CloseTunnel(ctx, &sliverpb.Tunnel{
 			TunnelID:  tunnel.ID,
-			SessionID: p.Session.ID,
+			SessionID: session.ID,
 		})
 		return nil, errors.New(portfwdResp.Response.Err)
 	}
@@ -0,0 +1,250 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"log"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// UDPIdleTimeout - Close a udp tunnel after this long without traffic, the
+	// implant closes its side after the same amount of time
+	UDPIdleTimeout = 2 * time.Minute
+
+	maxDatagramSize = 65535
+)
+
+var errDatagramTooLarge = errors.New("datagram too large")
+
+// UDP tunnels carry datagrams over the tunnel's byte stream, each datagram is
+// prefixed with its length (big endian uint16)
+
+// encodeDatagram - Length prefix a datagram
+func encodeDatagram(datagram []byte) ([]byte, error) {
+	if maxDatagramSize < len(datagram) {
+		return nil, errDatagramTooLarge
+	}
+	frame := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(frame, uint16(len(datagram)))
+	copy(frame[2:], datagram)
+	return frame, nil
+}
+
+// datagramDecoder - Splits tunnel data back into datagrams, a datagram can span
+// more than one write and a write can contain more than one datagram
+type datagramDecoder struct {
+	buf []byte
+}
+
+func (d *datagramDecoder) Write(data []byte) {
+	d.buf = append(d.buf, data...)
+}
+
+// Next - The next complete datagram, if any
+func (d *datagramDecoder) Next() ([]byte, bool) {
+	if len(d.buf) < 2 {
+		return nil, false
+	}
+	size := int(binary.BigEndian.Uint16(d.buf))
+	if len(d.buf) < 2+size {
+		return nil, false
+	}
+	datagram := d.buf[2 : 2+size]
+	d.buf = d.buf[2+size:]
+	return datagram, true
+}
+
+// udpTunnel - A udp tunnel to one remote address
+type udpTunnel struct {
+	tunnel   *TunnelIO
+	lastSeen int64
+}
+
+func (u *udpTunnel) touch() {
+	atomic.StoreInt64(&u.lastSeen, time.Now().UnixNano())
+}
+
+func (u *udpTunnel) idle() bool {
+	return UDPIdleTimeout < time.Since(time.Unix(0, atomic.LoadInt64(&u.lastSeen)))
+}
+
+// Send - Send a datagram to the implant
+func (u *udpTunnel) Send(datagram []byte) error {
+	frame, err := encodeDatagram(datagram)
+	if err != nil {
+		return err
+	}
+	u.touch()
+	_, err = u.tunnel.Write(frame)
+	return err
+}
+
+// Recv - Call handler with each datagram from the implant, until the tunnel is
+// closed or idle
+func (u *udpTunnel) Recv(handler func([]byte)) {
+	decoder := &datagramDecoder{}
+	ticker := time.NewTicker(UDPIdleTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case data, ok := <-u.tunnel.Recv:
+			if !ok {
+				return
+			}
+			u.touch()
+			decoder.Write(data)
+			for datagram, ok := decoder.Next(); ok; datagram, ok = decoder.Next() {
+				handler(datagram)
+			}
+		case <-ticker.C:
+			if u.idle() {
+				return
+			}
+		}
+	}
+}
+
+// dialUDPTunnel - Create a udp tunnel to address, through the implant
+func dialUDPTunnel(rpc rpcpb.SliverRPCClient, session *clientpb.Session, address string) (*udpTunnel, error) {
+	host, port, err := splitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	tunnel, err := dialImplant(ctx, rpc, session, host, port, sliverpb.PortFwdProtoUDP)
+	if err != nil {
+		return nil, err
+	}
+	udp := &udpTunnel{tunnel: tunnel}
+	udp.touch()
+	return udp, nil
+}
+
+// closeUDPTunnel - Close both sides of a udp tunnel
+func closeUDPTunnel(rpc rpcpb.SliverRPCClient, udp *udpTunnel) {
+	GetTunnels().Close(udp.tunnel.ID)
+	rpc.CloseTunnel(context.Background(), &sliverpb.Tunnel{
+		TunnelID:  udp.tunnel.ID,
+		SessionID: udp.tunnel.SessionID,
+	})
+}
+
+func splitHostPort(address string) (string, uint32, error) {
+	host, rawPort, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := net.LookupPort("udp", rawPort)
+	if err != nil {
+		return "", 0, err
+	}
+	return host, uint32(port), nil
+}
+
+// UDPProxy - Forwards datagrams sent to a local udp port to a remote address
+// through the implant, each local client address gets its own tunnel
+type UDPProxy struct {
+	Rpc     rpcpb.SliverRPCClient
+	Session *clientpb.Session
+
+	BindAddr   string
+	RemoteAddr string
+
+	conn    net.PacketConn
+	clients map[string]*udpTunnel
+	mutex   sync.Mutex
+}
+
+// Listen - Bind the local udp port
+func (p *UDPProxy) Listen() error {
+	conn, err := net.ListenPacket("udp", p.BindAddr)
+	if err != nil {
+		return err
+	}
+	p.conn = conn
+	p.clients = map[string]*udpTunnel{}
+	return nil
+}
+
+// Run - Forward datagrams until the proxy is closed
+func (p *UDPProxy) Run() error {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := p.conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		client, err := p.client(addr)
+		if err != nil {
+			log.Printf("[udpproxy] Failed to create tunnel for %s: %s", addr, err)
+			continue
+		}
+		err = client.Send(buf[:n])
+		if err != nil {
+			log.Printf("[udpproxy] Failed to send datagram from %s: %s", addr, err)
+		}
+	}
+}
+
+func (p *UDPProxy) client(addr net.Addr) (*udpTunnel, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if client, ok := p.clients[addr.String()]; ok {
+		return client, nil
+	}
+	client, err := dialUDPTunnel(p.Rpc, p.Session, p.RemoteAddr)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[addr.String()] = client
+	go func() {
+		client.Recv(func(datagram []byte) {
+			p.conn.WriteTo(datagram, addr)
+		})
+		p.mutex.Lock()
+		delete(p.clients, addr.String())
+		p.mutex.Unlock()
+		closeUDPTunnel(p.Rpc, client)
+	}()
+	return client, nil
+}
+
+// Close - Stop listening and close every tunnel
+func (p *UDPProxy) Close() error {
+	err := p.conn.Close()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for addr, client := range p.clients {
+		closeUDPTunnel(p.Rpc, client)
+		delete(p.clients, addr)
+	}
+	return err
+}
@@ -19,7 +19,12 @@ package core
 */
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
 	"sync"
+	"time"
 
 	consts "github.com/bishopfox/sliver/client/constants"
 )
@@ -111,9 +116,145 @@ func (r *reactions) All() []Reaction {
 	return reactions
 }
 
-// Reaction - Metadata about a portfwd listener
+// Reaction - Commands to run when an event occurs, commands are run in order and a
+// "sleep <duration>" command waits before running the next one
 type Reaction struct {
-	ID        int      `json:"-"`
-	EventType string   `json:"event_type"`
-	Commands  []string `json:"commands"`
+	ID          int             `json:"-"`
+	EventType   string          `json:"event_type"`
+	Commands    []string        `json:"commands"`
+	Filter      *ReactionFilter `json:"filter,omitempty"`
+	StopOnError bool            `json:"stop_on_error,omitempty"`
+}
+
+// ReactionFilter - Only react to events of sessions/beacons matching every non-empty
+// field, i.e. one of the operating systems, all of the tags, and one of the subnets
+type ReactionFilter struct {
+	OS      []string `json:"os,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	Subnets []string `json:"subnets,omitempty"`
+}
+
+// ReactionSleepStr - The pseudo-command that delays the next command of a reaction
+const ReactionSleepStr = "sleep"
+
+// Validate - Check the reaction's filter and delays
+func (r *Reaction) Validate() error {
+	if len(r.Commands) == 0 {
+		return errors.New("reaction has no commands")
+	}
+	for _, line := range r.Commands {
+		if _, ok, err := ReactionDelay(line); ok && err != nil {
+			return err
+		}
+	}
+	if r.Filter != nil {
+		for _, subnet := range r.Filter.Subnets {
+			if _, err := parseSubnet(subnet); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReactionDelay - Parse a "sleep <duration>" command, ok is false for any other command
+func ReactionDelay(line string) (time.Duration, bool, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || fields[0] != ReactionSleepStr {
+		return 0, false, nil
+	}
+	if len(fields) != 2 {
+		return 0, true, fmt.Errorf("invalid delay '%s', expected %s <duration>", line, ReactionSleepStr)
+	}
+	delay, err := time.ParseDuration(fields[1])
+	if err != nil || delay < 0 {
+		return 0, true, fmt.Errorf("invalid delay '%s'", fields[1])
+	}
+	return delay, true, nil
+}
+
+// IsEmpty - The filter matches everything
+func (f *ReactionFilter) IsEmpty() bool {
+	return f == nil || (len(f.OS) == 0 && len(f.Tags) == 0 && len(f.Subnets) == 0)
+}
+
+// Matches - Check a session/beacon's OS, operator assigned tags, and remote address
+// against the filter, OS and tags are case-insensitive
+func (f *ReactionFilter) Matches(os string, tags []string, remoteAddress string) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if 0 < len(f.OS) && !containsFold(f.OS, os) {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !containsFold(tags, tag) {
+			return false
+		}
+	}
+	if 0 < len(f.Subnets) {
+		host, _, err := net.SplitHostPort(remoteAddress)
+		if err != nil {
+			host = remoteAddress
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return false
+		}
+		for _, subnet := range f.Subnets {
+			network, err := parseSubnet(subnet)
+			if err == nil && network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// String - A short description of the filter
+func (f *ReactionFilter) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+	parts := []string{}
+	if 0 < len(f.OS) {
+		parts = append(parts, "os:"+strings.Join(f.OS, "|"))
+	}
+	for _, tag := range f.Tags {
+		parts = append(parts, "tag:"+tag)
+	}
+	if 0 < len(f.Subnets) {
+		parts = append(parts, "subnet:"+strings.Join(f.Subnets, "|"))
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseSubnet - A CIDR, or a single address
+func parseSubnet(subnet string) (*net.IPNet, error) {
+	if !strings.Contains(subnet, "/") {
+		ip := net.ParseIP(subnet)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid subnet '%s'", subnet)
+		}
+		bits := 8 * len(ip.To4())
+		if bits == 0 {
+			bits = 8 * net.IPv6len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, network, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet '%s'", subnet)
+	}
+	return network, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,75 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReactionFilterMatches(t *testing.T) {
+	filter := &ReactionFilter{
+		OS:      []string{"windows"},
+		Tags:    []string{"dc"},
+		Subnets: []string{"10.0.0.0/8", "192.168.1.5"},
+	}
+	tests := []struct {
+		os      string
+		tags    []string
+		address string
+		match   bool
+	}{
+		{"Windows", []string{"DC", "prod"}, "10.1.2.3:49152", true},
+		{"windows", []string{"dc"}, "192.168.1.5:443", true},
+		{"linux", []string{"dc"}, "10.1.2.3:49152", false},
+		{"windows", []string{"prod"}, "10.1.2.3:49152", false},
+		{"windows", []string{"dc"}, "192.168.1.6:443", false},
+		{"windows", []string{"dc"}, "not-an-address", false},
+	}
+	for _, test := range tests {
+		if filter.Matches(test.os, test.tags, test.address) != test.match {
+			t.Errorf("expected %v for %s %v %s", test.match, test.os, test.tags, test.address)
+		}
+	}
+	var empty *ReactionFilter
+	if !empty.Matches("linux", nil, "") {
+		t.Errorf("expected an empty filter to match")
+	}
+}
+
+func TestReactionValidate(t *testing.T) {
+	reaction := &Reaction{Commands: []string{"ls", "sleep 5s", "ps"}}
+	if err := reaction.Validate(); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	delay, ok, _ := ReactionDelay("sleep 5s")
+	if !ok || delay != 5*time.Second {
+		t.Errorf("expected a 5s delay, got %s", delay)
+	}
+	for _, invalid := range []*Reaction{
+		{},
+		{Commands: []string{"sleep"}},
+		{Commands: []string{"sleep soon"}},
+		{Commands: []string{"ls"}, Filter: &ReactionFilter{Subnets: []string{"10.0.0.0/33"}}},
+	} {
+		if invalid.Validate() == nil {
+			t.Errorf("expected %v to be invalid", invalid.Commands)
+		}
+	}
+}
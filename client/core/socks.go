@@ -22,6 +22,7 @@ import (
 	"context"
 	"log"
 	"net"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -39,10 +40,16 @@ var (
 		tcpProxies: map[uint64]*SocksProxy{},
 		mutex:      &sync.RWMutex{},
 	}
+	// SocksConnPool - TunnelID -> *SocksConn of every proxied connection
 	SocksConnPool = sync.Map{}
 	SocksProxyID  = (uint64)(0)
 )
 
+const (
+	// maxHandshakeSize - Give up looking for the destination after this many bytes
+	maxHandshakeSize = 1024
+)
+
 // PortfwdMeta - Metadata about a portfwd listener
 type SocksProxyMeta struct {
 	ID        uint64
@@ -50,8 +57,27 @@ type SocksProxyMeta struct {
 	BindAddr  string
 	Username  string
 	Password  string
+
+	Connections    int
+	MaxConnections int64
+	Sent           uint64
+	Received       uint64
+	Rejected       uint64
+}
+
+// SocksConnMeta - Metadata about a connection through a proxy
+type SocksConnMeta struct {
+	TunnelID    uint64
+	ProxyID     uint64
+	Source      string
+	Destination string
+	Sent        uint64
+	Received    uint64
+	Started     time.Time
 }
+
 type TcpProxy struct {
+	ID      uint64
 	Rpc     rpcpb.SliverRPCClient
 	Session *clientpb.Session
 
@@ -61,21 +87,201 @@ type TcpProxy struct {
 	Listener        net.Listener
 	KeepAlivePeriod time.Duration
 	DialTimeout     time.Duration
+
+	// MaxConnections - Concurrent connections allowed through the proxy, new
+	// connections over the limit are closed (0 is unlimited)
+	MaxConnections int64
+
+	sent     uint64
+	received uint64
+	rejected uint64
 }
 
 func (tcp *TcpProxy) Stop() error {
 	err := tcp.Listener.Close()
 
-	// Closing all connections in pool
+	// Closing all of the proxy's connections in the pool
 	SocksConnPool.Range(func(key, value interface{}) bool {
-		con := value.(net.Conn)
+		conn := value.(*SocksConn)
+		if conn.ProxyID == tcp.ID {
+			conn.Close()
+		}
+		return true
+	})
 
-		con.Close()
+	return err
+}
 
-		return err == nil
+// Connections - The number of open connections through the proxy
+func (tcp *TcpProxy) Connections() int {
+	count := 0
+	SocksConnPool.Range(func(key, value interface{}) bool {
+		if value.(*SocksConn).ProxyID == tcp.ID {
+			count++
+		}
+		return true
 	})
+	return count
+}
 
-	return err
+// SocksConn - A connection through a proxy, the destination is read from the
+// client's socks handshake
+type SocksConn struct {
+	TunnelID uint64
+	ProxyID  uint64
+	Started  time.Time
+
+	conn        net.Conn
+	proxy       *TcpProxy
+	sent        uint64
+	received    uint64
+	mutex       sync.Mutex
+	handshake   []byte
+	destination string
+
+	// The udp relay and client of a udp associate
+	relay      net.PacketConn
+	clientAddr net.Addr
+}
+
+// Write - Write data from the implant to the client
+func (c *SocksConn) Write(data []byte) (int, error) {
+	n, err := c.conn.Write(data)
+	atomic.AddUint64(&c.received, uint64(n))
+	atomic.AddUint64(&c.proxy.received, uint64(n))
+	return n, err
+}
+
+// Close - Close the client's connection
+func (c *SocksConn) Close() error {
+	return c.conn.Close()
+}
+
+// Metadata - Get metadata about the connection
+func (c *SocksConn) Metadata() *SocksConnMeta {
+	c.mutex.Lock()
+	destination := c.destination
+	c.mutex.Unlock()
+	return &SocksConnMeta{
+		TunnelID:    c.TunnelID,
+		ProxyID:     c.ProxyID,
+		Source:      c.conn.RemoteAddr().String(),
+		Destination: destination,
+		Sent:        atomic.LoadUint64(&c.sent),
+		Received:    atomic.LoadUint64(&c.received),
+		Started:     c.Started,
+	}
+}
+
+// sniff - Record data sent by the client, until the destination is known. Returns
+// the client's request if it's a udp associate that starts with this data, those
+// are handled by the client instead of being forwarded to the implant.
+func (c *SocksConn) sniff(data []byte) *SocksRequest {
+	atomic.AddUint64(&c.sent, uint64(len(data)))
+	atomic.AddUint64(&c.proxy.sent, uint64(len(data)))
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.destination != "" || maxHandshakeSize < len(c.handshake) {
+		return nil
+	}
+	offset := len(c.handshake)
+	c.handshake = append(c.handshake, data...)
+	request, ok := ParseSocksRequest(c.handshake, c.proxy.Username != "")
+	if !ok {
+		return nil
+	}
+	c.handshake = nil
+	if request.Command == socksUDPAssociate {
+		c.destination = "(udp associate)"
+		if request.Offset == offset {
+			return request
+		}
+		return nil
+	}
+	c.destination = request.Destination
+	return nil
+}
+
+const (
+	socksUDPAssociate = 3
+)
+
+// SocksRequest - A client's socks5 request
+type SocksRequest struct {
+	Command     byte
+	Destination string
+	Offset      int // Where the request starts in the client's data
+}
+
+// ParseSocksRequest - Parse a socks5 request from the data the client sent, auth
+// is whether the client has to authenticate first
+func ParseSocksRequest(data []byte, auth bool) (*SocksRequest, bool) {
+	// Greeting: VER NMETHODS METHODS...
+	if len(data) < 2 || data[0] != 5 {
+		return nil, false
+	}
+	offset := 2 + int(data[1])
+	if auth {
+		// Username/password: VER ULEN UNAME PLEN PASSWD
+		if len(data) < offset+2 {
+			return nil, false
+		}
+		offset += 2 + int(data[offset+1])
+		if len(data) < offset+1 {
+			return nil, false
+		}
+		offset += 1 + int(data[offset])
+	}
+	// Request: VER CMD RSV ATYP DST.ADDR DST.PORT
+	if len(data) < offset+4 || data[offset] != 5 {
+		return nil, false
+	}
+	destination, _, ok := parseSocksAddr(data[offset+3:])
+	if !ok {
+		return nil, false
+	}
+	return &SocksRequest{
+		Command:     data[offset+1],
+		Destination: destination,
+		Offset:      offset,
+	}, true
+}
+
+// parseSocksAddr - Parse an ATYP DST.ADDR DST.PORT address, returns its size
+func parseSocksAddr(data []byte) (string, int, bool) {
+	if len(data) < 1 {
+		return "", 0, false
+	}
+	offset := 1
+	var host string
+	switch data[0] {
+	case 1:
+		if len(data) < offset+net.IPv4len+2 {
+			return "", 0, false
+		}
+		host = net.IP(data[offset : offset+net.IPv4len]).String()
+		offset += net.IPv4len
+	case 3:
+		if len(data) < offset+1 {
+			return "", 0, false
+		}
+		size := int(data[offset])
+		if len(data) < offset+1+size+2 {
+			return "", 0, false
+		}
+		host = string(data[offset+1 : offset+1+size])
+		offset += 1 + size
+	case 4:
+		if len(data) < offset+net.IPv6len+2 {
+			return "", 0, false
+		}
+		host = net.IP(data[offset : offset+net.IPv6len]).String()
+		offset += net.IPv6len
+	default:
+		return "", 0, false
+	}
+	port := int(data[offset])<<8 | int(data[offset+1])
+	return net.JoinHostPort(host, strconv.Itoa(port)), offset + 2, true
 }
 
 // SocksProxy - Tracks portfwd<->tcpproxy
@@ -92,6 +298,12 @@ func (p *SocksProxy) GetMetadata() *SocksProxyMeta {
 		BindAddr:  p.ChannelProxy.BindAddr,
 		Username:  p.ChannelProxy.Username,
 		Password:  p.ChannelProxy.Password,
+
+		Connections:    p.ChannelProxy.Connections(),
+		MaxConnections: atomic.LoadInt64(&p.ChannelProxy.MaxConnections),
+		Sent:           atomic.LoadUint64(&p.ChannelProxy.sent),
+		Received:       atomic.LoadUint64(&p.ChannelProxy.received),
+		Rejected:       atomic.LoadUint64(&p.ChannelProxy.rejected),
 	}
 }
 
@@ -109,6 +321,7 @@ func (f *socksProxy) Add(tcpProxy *TcpProxy) *SocksProxy {
 		ID:           nextSocksProxyID(),
 		ChannelProxy: tcpProxy,
 	}
+	tcpProxy.ID = Sockser.ID
 	f.tcpProxies[Sockser.ID] = Sockser
 
 	return Sockser
@@ -132,13 +345,20 @@ func (f *socksProxy) Start(tcpProxy *TcpProxy) error {
 			}
 
 			if v, ok := SocksConnPool.Load(socksData.TunnelID); ok {
-				conn := v.(net.Conn)
+				conn := v.(*SocksConn)
 
 				if socksData.CloseConn {
 					conn.Close()
 					SocksConnPool.Delete(socksData.TunnelID)
 					continue
 				}
+				if socksData.Datagram {
+					err := conn.writeDatagram(socksData.Data)
+					if err != nil {
+						log.Printf("Failed to write datagram to udp relay, %s\n", err)
+					}
+					continue
+				}
 				log.Printf("[socks] agent to Server To (Client to User) Data Sequence %d , Data Size %d \n", FromImplantSequence, len(socksData.Data))
 				//fmt.Printf("recv data len %d \n", len(p.Data))
 				_, err := conn.Write(socksData.Data)
@@ -156,6 +376,14 @@ func (f *socksProxy) Start(tcpProxy *TcpProxy) error {
 			log.Printf("Failed to accept new listener, probably already closed: %s\n", err)
 			break
 		}
+		maxConnections := atomic.LoadInt64(&tcpProxy.MaxConnections)
+		if 0 < maxConnections && maxConnections <= int64(tcpProxy.Connections()) {
+			log.Printf("[socks] %s reached the limit of %d connections, closing %s\n",
+				tcpProxy.BindAddr, maxConnections, connection.RemoteAddr())
+			atomic.AddUint64(&tcpProxy.rejected, 1)
+			connection.Close()
+			continue
+		}
 		rpcSocks, err := tcpProxy.Rpc.CreateSocks(context.Background(), &sliverpb.Socks{
 			SessionID: tcpProxy.Session.ID,
 		})
@@ -164,7 +392,14 @@ func (f *socksProxy) Start(tcpProxy *TcpProxy) error {
 			break
 		}
 
-		go connect(connection, proxy, &sliverpb.SocksData{
+		conn := &SocksConn{
+			TunnelID: rpcSocks.TunnelID,
+			ProxyID:  tcpProxy.ID,
+			Started:  time.Now(),
+			conn:     connection,
+			proxy:    tcpProxy,
+		}
+		go connect(conn, proxy, &sliverpb.SocksData{
 			Username: tcpProxy.Username,
 			Password: tcpProxy.Password,
 			TunnelID: rpcSocks.TunnelID,
@@ -201,6 +436,43 @@ func (f *socksProxy) List() []*SocksProxyMeta {
 	return socksProxy
 }
 
+// Connections - List the connections through a proxy, or all proxies if the id is zero
+func (f *socksProxy) Connections(socksId uint64) []*SocksConnMeta {
+	conns := []*SocksConnMeta{}
+	SocksConnPool.Range(func(key, value interface{}) bool {
+		conn := value.(*SocksConn)
+		if socksId == 0 || conn.ProxyID == socksId {
+			conns = append(conns, conn.Metadata())
+		}
+		return true
+	})
+	return conns
+}
+
+// CloseConnection - Close a single connection, the implant closes its side when
+// it receives the close from the client
+func (f *socksProxy) CloseConnection(tunnelID uint64) bool {
+	v, ok := SocksConnPool.Load(tunnelID)
+	if !ok {
+		return false
+	}
+	v.(*SocksConn).Close()
+	return true
+}
+
+// SetMaxConnections - Set a proxy's concurrent connection limit (0 is unlimited),
+// connections that are already open are not closed
+func (f *socksProxy) SetMaxConnections(socksId uint64, maxConnections int64) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	socks, ok := f.tcpProxies[socksId]
+	if !ok {
+		return false
+	}
+	atomic.StoreInt64(&socks.ChannelProxy.MaxConnections, maxConnections)
+	return true
+}
+
 func nextSocksProxyID() uint64 {
 	return atomic.AddUint64(&SocksProxyID, 1)
 }
@@ -209,30 +481,36 @@ const leakyBufSize = 4108 // data.len(2) + hmacsha1(10) + data(4096)
 
 var leakyBuf = leaky.NewLeakyBuf(2048, leakyBufSize)
 
-func connect(conn net.Conn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliverpb.SocksData) {
+func connect(conn *SocksConn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliverpb.SocksData) {
 
 	SocksConnPool.Store(frame.TunnelID, conn)
 
+	var ToImplantSequence uint64 = 0
 	defer func() {
 		// It's neccessary to close and remove connection once we done with it
 		c, ok := SocksConnPool.LoadAndDelete(frame.TunnelID)
 		if !ok {
 			return
 		}
-		conn := c.(net.Conn)
-
-		conn.Close()
+		c.(*SocksConn).Close()
+
+		// Let the implant know so it closes its connection to the destination
+		stream.Send(&sliverpb.SocksData{
+			CloseConn: true,
+			TunnelID:  frame.TunnelID,
+			Sequence:  ToImplantSequence,
+			Request:   frame.Request,
+		})
 
 		log.Printf("[socks] connection closed")
 	}()
 
-	log.Printf("tcp conn %q<--><-->%q \n", conn.LocalAddr(), conn.RemoteAddr())
+	log.Printf("tcp conn %q<--><-->%q \n", conn.conn.LocalAddr(), conn.conn.RemoteAddr())
 
 	buff := leakyBuf.Get()
 	defer leakyBuf.Put(buff)
-	var ToImplantSequence uint64 = 0
 	for {
-		n, err := conn.Read(buff)
+		n, err := conn.conn.Read(buff)
 
 		if err != nil {
 			log.Printf("[socks] (User to Client) failed to read data, %s ", err)
@@ -241,6 +519,10 @@ func connect(conn net.Conn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliv
 			return
 		}
 		if n > 0 {
+			if request := conn.sniff(buff[:n]); request != nil {
+				conn.associate(stream, frame, &ToImplantSequence)
+				return
+			}
 			frame.Data = buff[:n]
 			frame.Sequence = ToImplantSequence
 			log.Printf("[socks] (User to Client) to Server to agent  Data Sequence %d , Data Size %d \n", ToImplantSequence, len(frame.Data))
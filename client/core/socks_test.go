@@ -0,0 +1,203 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestParseSocksRequest(t *testing.T) {
+	greeting := []byte{5, 1, 0}
+	domain := append([]byte{5, 1, 0, 3, 11}, []byte("example.com")...)
+	domain = append(domain, 0x01, 0xbb)
+	ipv4 := []byte{5, 1, 0, 1, 10, 0, 0, 1, 0x00, 0x50}
+	ipv6 := append([]byte{5, 1, 0, 4}, net.ParseIP("2001:db8::1").To16()...)
+	ipv6 = append(ipv6, 0x1f, 0x90)
+	auth := append([]byte{1, 4}, []byte("user")...)
+	auth = append(auth, 3)
+	auth = append(auth, []byte("pwd")...)
+
+	tests := []struct {
+		data        []byte
+		auth        bool
+		destination string
+		ok          bool
+	}{
+		{append(greeting, domain...), false, "example.com:443", true},
+		{append(greeting, ipv4...), false, "10.0.0.1:80", true},
+		{append(greeting, ipv6...), false, "[2001:db8::1]:8080", true},
+		{append(append([]byte{5, 1, 2}, auth...), ipv4...), true, "10.0.0.1:80", true},
+		{greeting, false, "", false},
+		{append(greeting, domain[:8]...), false, "", false},
+		{append([]byte{5, 1, 2}, auth[:4]...), true, "", false},
+		{[]byte{4, 1, 0, 80, 10, 0, 0, 1}, false, "", false},
+	}
+	for _, test := range tests {
+		request, ok := ParseSocksRequest(test.data, test.auth)
+		destination := ""
+		if ok {
+			destination = request.Destination
+		}
+		if destination != test.destination || ok != test.ok {
+			t.Errorf("%v: expected (%s, %v), got (%s, %v)", test.data, test.destination, test.ok, destination, ok)
+		}
+	}
+
+	request, ok := ParseSocksRequest(append([]byte{5, 1, 0}, 5, 3, 0, 1, 0, 0, 0, 0, 0, 0), false)
+	if !ok || request.Command != socksUDPAssociate || request.Offset != 3 {
+		t.Fatalf("expected a udp associate at offset 3, got %v", request)
+	}
+}
+
+func TestSocksConnAssociate(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	conn := &SocksConn{conn: server, proxy: &TcpProxy{}}
+
+	if conn.sniff([]byte{5, 1, 0}) != nil {
+		t.Fatal("greeting is not a request")
+	}
+	request := conn.sniff([]byte{5, 3, 0, 1, 0, 0, 0, 0, 0, 0})
+	if request == nil || request.Command != socksUDPAssociate {
+		t.Fatalf("expected a udp associate request, got %v", request)
+	}
+	if meta := conn.Metadata(); meta.Destination != "(udp associate)" {
+		t.Fatalf("unexpected destination %s", meta.Destination)
+	}
+
+	// A request pipelined with the greeting has already been forwarded
+	pipelined := &SocksConn{conn: server, proxy: &TcpProxy{}}
+	if pipelined.sniff([]byte{5, 1, 0, 5, 3, 0, 1, 0, 0, 0, 0, 0, 0}) != nil {
+		t.Fatal("a pipelined request should not be handled locally")
+	}
+}
+
+func TestSocksDatagrams(t *testing.T) {
+	for _, source := range []string{"10.0.0.1:53", "[2001:db8::1]:88", "example.com:123"} {
+		addr, err := encodeSocksAddr(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packet := append(append([]byte{0, 0, 0}, addr...), "data"...)
+		destination, datagram, err := parseSocksDatagram(packet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if destination != source || string(datagram) != "data" {
+			t.Errorf("expected (%s, data), got (%s, %s)", source, destination, datagram)
+		}
+	}
+	if _, _, err := parseSocksDatagram([]byte{0, 0, 1, 1, 10, 0, 0, 1, 0, 53}); err != errFragmentedDatagram {
+		t.Errorf("expected fragmented datagram error, got %v", err)
+	}
+	if _, _, err := parseSocksDatagram([]byte{0, 0, 0, 1, 10, 0}); err != errInvalidSocksDatagram {
+		t.Errorf("expected invalid datagram error, got %v", err)
+	}
+}
+
+func TestSocksConnWriteDatagram(t *testing.T) {
+	relay, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skip(err)
+	}
+	defer relay.Close()
+	client, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skip(err)
+	}
+	defer client.Close()
+
+	proxy := &TcpProxy{}
+	conn := &SocksConn{proxy: proxy}
+	packet := []byte{0, 0, 0, 1, 10, 0, 0, 1, 0, 53, 'd', 'n', 's'}
+	if err := conn.writeDatagram(packet); err != errNoAssociation {
+		t.Fatalf("expected no association error, got %v", err)
+	}
+
+	// Datagrams from the implant are relayed as-is to the client
+	conn.relay, conn.clientAddr = relay, client.LocalAddr()
+	if err := conn.writeDatagram(packet); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, err := client.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != string(packet) {
+		t.Fatalf("expected %v, got %v", packet, buf[:n])
+	}
+	if conn.received != 3 || proxy.received != 3 {
+		t.Fatalf("expected 3 bytes received, got %d (proxy %d)", conn.received, proxy.received)
+	}
+}
+
+func TestDatagramDecoder(t *testing.T) {
+	first, _ := encodeDatagram([]byte("first"))
+	second, _ := encodeDatagram([]byte("second"))
+	empty, _ := encodeDatagram([]byte{})
+	stream := append(append(first, empty...), second...)
+
+	// Datagrams split across writes in every possible place
+	for split := 0; split <= len(stream); split++ {
+		decoder := &datagramDecoder{}
+		datagrams := []string{}
+		for _, data := range [][]byte{stream[:split], stream[split:]} {
+			decoder.Write(data)
+			for datagram, ok := decoder.Next(); ok; datagram, ok = decoder.Next() {
+				datagrams = append(datagrams, string(datagram))
+			}
+		}
+		if len(datagrams) != 3 || datagrams[0] != "first" || datagrams[1] != "" || datagrams[2] != "second" {
+			t.Fatalf("split %d: unexpected datagrams %q", split, datagrams)
+		}
+	}
+	if _, err := encodeDatagram(make([]byte, maxDatagramSize+1)); err != errDatagramTooLarge {
+		t.Fatalf("expected too large error, got %v", err)
+	}
+}
+
+func TestSocksConnSniff(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	proxy := &TcpProxy{ID: 1}
+	conn := &SocksConn{TunnelID: 2, ProxyID: 1, Started: time.Now(), conn: server, proxy: proxy}
+
+	// The handshake can be split across reads
+	conn.sniff([]byte{5, 1, 0})
+	conn.sniff([]byte{5, 1, 0, 1, 192, 168})
+	if meta := conn.Metadata(); meta.Destination != "" {
+		t.Fatalf("expected no destination yet, got %s", meta.Destination)
+	}
+	conn.sniff([]byte{1, 1, 0x01, 0xbb})
+	conn.sniff([]byte("GET / HTTP/1.1\r\n"))
+
+	meta := conn.Metadata()
+	if meta.Destination != "192.168.1.1:443" {
+		t.Fatalf("expected destination 192.168.1.1:443, got %s", meta.Destination)
+	}
+	if meta.Sent != 29 || proxy.sent != 29 {
+		t.Fatalf("expected 29 bytes sent, got %d (proxy %d)", meta.Sent, proxy.sent)
+	}
+}
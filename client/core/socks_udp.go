@@ -0,0 +1,169 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	errInvalidSocksDatagram = errors.New("invalid socks5 udp datagram")
+	errFragmentedDatagram   = errors.New("fragmented socks5 udp datagrams are not supported")
+	errNoAssociation        = errors.New("no socks5 udp associate on the connection")
+)
+
+// associate - Handle a udp associate request locally, the client's datagrams are
+// relayed to the implant over the connection's tunnel until it closes the tcp
+// connection. sequence is the tunnel's next sequence number.
+func (c *SocksConn) associate(stream rpcpb.SliverRPC_SocksProxyClient, frame *sliverpb.SocksData, sequence *uint64) {
+	localAddr, ok := c.conn.LocalAddr().(*net.TCPAddr)
+	if !ok {
+		return
+	}
+	relay, err := net.ListenUDP("udp", &net.UDPAddr{IP: localAddr.IP})
+	if err != nil {
+		log.Printf("[socks] Failed to start udp relay: %s", err)
+		c.Write([]byte{5, 1, 0, 1, 0, 0, 0, 0, 0, 0}) // General failure
+		return
+	}
+	defer relay.Close()
+	bindAddr, err := encodeSocksAddr(relay.LocalAddr().String())
+	if err != nil {
+		return
+	}
+	_, err = c.Write(append([]byte{5, 0, 0}, bindAddr...))
+	if err != nil {
+		return
+	}
+	log.Printf("[socks] udp relay %s for %s", relay.LocalAddr(), c.conn.RemoteAddr())
+	c.mutex.Lock()
+	c.relay = relay
+	c.mutex.Unlock()
+
+	// The association lasts as long as the tcp connection
+	go func() {
+		io.Copy(io.Discard, c.conn)
+		relay.Close()
+	}()
+
+	clientIP := c.conn.RemoteAddr().(*net.TCPAddr).IP
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := relay.ReadFrom(buf)
+		if err != nil {
+			break
+		}
+		// Only the client that made the request can use the relay
+		if udpAddr, ok := addr.(*net.UDPAddr); !ok || !udpAddr.IP.Equal(clientIP) {
+			continue
+		}
+		destination, datagram, err := parseSocksDatagram(buf[:n])
+		if err != nil {
+			log.Printf("[socks] %s", err)
+			continue
+		}
+		c.mutex.Lock()
+		c.clientAddr = addr
+		c.mutex.Unlock()
+		atomic.AddUint64(&c.sent, uint64(len(datagram)))
+		atomic.AddUint64(&c.proxy.sent, uint64(len(datagram)))
+
+		// The implant parses the destination from the datagram's header
+		err = stream.Send(&sliverpb.SocksData{
+			Data:     buf[:n],
+			Datagram: true,
+			Sequence: *sequence,
+			TunnelID: frame.TunnelID,
+			Request:  frame.Request,
+		})
+		if err != nil {
+			log.Printf("[socks] Failed to send datagram to %s: %s", destination, err)
+			break
+		}
+		*sequence++
+	}
+}
+
+// writeDatagram - Write a datagram from the implant to the client's udp relay
+func (c *SocksConn) writeDatagram(packet []byte) error {
+	c.mutex.Lock()
+	relay, clientAddr := c.relay, c.clientAddr
+	c.mutex.Unlock()
+	if relay == nil || clientAddr == nil {
+		return errNoAssociation
+	}
+	_, datagram, err := parseSocksDatagram(packet)
+	if err != nil {
+		return err
+	}
+	_, err = relay.WriteTo(packet, clientAddr)
+	if err != nil {
+		return err
+	}
+	atomic.AddUint64(&c.received, uint64(len(datagram)))
+	atomic.AddUint64(&c.proxy.received, uint64(len(datagram)))
+	return nil
+}
+
+// parseSocksDatagram - Parse a client's udp datagram: RSV FRAG ATYP DST.ADDR DST.PORT DATA
+func parseSocksDatagram(packet []byte) (string, []byte, error) {
+	if len(packet) < 4 {
+		return "", nil, errInvalidSocksDatagram
+	}
+	if packet[2] != 0 {
+		return "", nil, errFragmentedDatagram
+	}
+	destination, size, ok := parseSocksAddr(packet[3:])
+	if !ok {
+		return "", nil, errInvalidSocksDatagram
+	}
+	return destination, packet[3+size:], nil
+}
+
+// encodeSocksAddr - Encode host:port as ATYP ADDR PORT
+func encodeSocksAddr(address string) ([]byte, error) {
+	host, rawPort, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	var addr []byte
+	if ip := net.ParseIP(host); ip == nil {
+		if 255 < len(host) {
+			return nil, errInvalidSocksDatagram
+		}
+		addr = append([]byte{3, byte(len(host))}, host...)
+	} else if ip4 := ip.To4(); ip4 != nil {
+		addr = append([]byte{1}, ip4...)
+	} else {
+		addr = append([]byte{4}, ip.To16()...)
+	}
+	return append(addr, byte(port>>8), byte(port)), nil
+}
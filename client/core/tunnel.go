@@ -43,6 +43,12 @@ func TunnelLoop(rpc rpcpb.SliverRPCClient) error {
 
 	GetTunnels().SetStream(stream)
 
+	return RecvTunnelData(stream)
+}
+
+// RecvTunnelData - Distributes the messages of a tunnel data stream to the tunnels,
+// returns when the stream is closed. Each server connection has its own stream.
+func RecvTunnelData(stream rpcpb.SliverRPC_TunnelDataClient) error {
 	for {
 		log.Printf("Waiting for TunnelData ...")
 		incoming, err := stream.Recv()
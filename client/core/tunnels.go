@@ -82,17 +82,17 @@ func (t *tunnels) Get(tunnelID uint64) *TunnelIO {
 
 // send - safe way to send a message to the stream
 // protobuf stream allow only one writer at a time, so just in case there is a mutex for it
-func (t *tunnels) send(tunnelData *sliverpb.TunnelData) error {
+func (t *tunnels) send(stream rpcpb.SliverRPC_TunnelDataClient, tunnelData *sliverpb.TunnelData) error {
 	t.streamMutex.Lock()
 	defer t.streamMutex.Unlock()
 
-	if t.stream == nil {
+	if stream == nil {
 		return errors.New("uninitizlied stream")
 	}
 
 	log.Printf("Private send to stream, tunnelId: %d", tunnelData.TunnelID)
 
-	return t.stream.Send(tunnelData)
+	return stream.Send(tunnelData)
 }
 
 // Start - Add a tunnel to the core mapper
@@ -104,6 +104,12 @@ func (t *tunnels) Start(tunnelID uint64, sessionID string) *TunnelIO {
 
 	(*t.tunnels)[tunnelID] = tunnel
 
+	// The tunnel keeps using the stream of the server it was started on,
+	// even if the client switches to another server
+	t.streamMutex.Lock()
+	stream := t.stream
+	t.streamMutex.Unlock()
+
 	go func(tunnel *TunnelIO) {
 		tunnel.Open()
 		log.Printf("Tunnel now is open, %d", tunnelID)
@@ -111,7 +117,7 @@ func (t *tunnels) Start(tunnelID uint64, sessionID string) *TunnelIO {
 		for data := range tunnel.Send {
 			log.Printf("Send %d bytes on tunnel %d", len(data), tunnel.ID)
 
-			err := t.send(&sliverpb.TunnelData{
+			err := t.send(stream, &sliverpb.TunnelData{
 				TunnelID:  tunnel.ID,
 				SessionID: tunnel.SessionID,
 				Data:      data,
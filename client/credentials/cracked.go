@@ -0,0 +1,106 @@
+package credentials
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// johnTags - Prefixes john adds to some hashes in its pot file
+var johnTags = map[clientpb.HashType]string{
+	clientpb.HashType_NTLM: "$NT$",
+	clientpb.HashType_LM:   "$LM$",
+	clientpb.HashType_MD5:  "$dynamic_0$",
+}
+
+// CrackedResults - Match cracked results against the uncracked credentials, returns
+// the credentials that were cracked with their plaintext set. Supported results are
+// hashcat pot files and --show output (hash:plaintext), john pot files, and the
+// "john --show" output written by a john cracking job (credential id:plaintext).
+func CrackedResults(creds []*clientpb.Credential, results []byte) []*clientpb.Credential {
+	byID := map[string]*clientpb.Credential{}
+	byHash := map[string][]*clientpb.Credential{}
+	byLogin := map[string][]*clientpb.Credential{}
+	for _, cred := range creds {
+		if cred.Hash == "" || cred.IsCracked {
+			continue
+		}
+		byID[cred.ID] = cred
+		hash := strings.ToLower(cred.Hash)
+		byHash[hash] = append(byHash[hash], cred)
+		if tag, ok := johnTags[cred.HashType]; ok {
+			byHash[strings.ToLower(tag)+hash] = append(byHash[strings.ToLower(tag)+hash], cred)
+		}
+		if login := strings.Index(cred.Hash, ":"); 0 < login {
+			byLogin[cred.Hash[:login]] = append(byLogin[cred.Hash[:login]], cred)
+		}
+	}
+
+	cracked := []*clientpb.Credential{}
+	crack := func(cred *clientpb.Credential, plaintext string) {
+		if cred.IsCracked {
+			return
+		}
+		cred.Plaintext = decodeHexPlaintext(plaintext)
+		cred.IsCracked = true
+		cracked = append(cracked, cred)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(results))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		for index := 0; index < len(line); index++ {
+			if line[index] != ':' {
+				continue
+			}
+			prefix, plaintext := line[:index], line[index+1:]
+			if cred, ok := byID[prefix]; ok {
+				crack(cred, plaintext)
+			}
+			for _, cred := range byHash[strings.ToLower(prefix)] {
+				crack(cred, plaintext)
+			}
+			// john --show prints login:plaintext followed by the rest of the input fields
+			for _, cred := range byLogin[prefix] {
+				rest := cred.Hash[len(prefix):]
+				if strings.HasSuffix(plaintext, rest) && len(rest) <= len(plaintext) {
+					crack(cred, plaintext[:len(plaintext)-len(rest)])
+				}
+			}
+		}
+	}
+	return cracked
+}
+
+// decodeHexPlaintext - Hashcat writes plaintexts containing separators or
+// non-printable characters as "$HEX[...]"
+func decodeHexPlaintext(plaintext string) string {
+	if strings.HasPrefix(plaintext, "$HEX[") && strings.HasSuffix(plaintext, "]") {
+		data, err := hex.DecodeString(plaintext[5 : len(plaintext)-1])
+		if err == nil {
+			return string(data)
+		}
+	}
+	return plaintext
+}
@@ -42,6 +42,12 @@ var (
 		clientpb.HashType_NET_NTLM_V2:                AllHashTypes[clientpb.HashType_NET_NTLM_V2],
 		clientpb.HashType_NET_NTLM_V2_NT:             AllHashTypes[clientpb.HashType_NET_NTLM_V2_NT],
 
+		// Unix
+		clientpb.HashType_MD5_CRYPT_UNIX:    AllHashTypes[clientpb.HashType_MD5_CRYPT_UNIX],
+		clientpb.HashType_SHA256_CRYPT_UNIX: AllHashTypes[clientpb.HashType_SHA256_CRYPT_UNIX],
+		clientpb.HashType_SHA512_CRYPT_UNIX: AllHashTypes[clientpb.HashType_SHA512_CRYPT_UNIX],
+		clientpb.HashType_BCRYPT_UNIX:       AllHashTypes[clientpb.HashType_BCRYPT_UNIX],
+
 		// Common hashes
 		clientpb.HashType_MD5:      AllHashTypes[clientpb.HashType_MD5],
 		clientpb.HashType_SHA1:     AllHashTypes[clientpb.HashType_SHA1],
@@ -117,6 +123,13 @@ var (
 		// ...
 
 		clientpb.HashType_MACOS_10_8: "macOS v10.8+ (PBKDF2-SHA512)",
+
+		// Out of order
+
+		clientpb.HashType_BCRYPT_UNIX:       "bcrypt",
+		clientpb.HashType_SHA512_CRYPT_UNIX: "sha512crypt $6$, SHA512 (Unix)",
+		clientpb.HashType_SHA256_CRYPT_UNIX: "sha256crypt $5$, SHA256 (Unix)",
+		clientpb.HashType_MD5_CRYPT_UNIX:    "md5crypt, MD5 (Unix), Cisco-IOS $1$ (MD5)",
 	}
 )
 
@@ -0,0 +1,155 @@
+package credentials
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	// HashcatJob - Hash files and a hashcat script, one attack per hash mode
+	HashcatJob = "hashcat"
+	// JohnJob - Hash files and a john script, one run per john format
+	JohnJob = "john"
+
+	// CrackingJobScript - The generated script that runs every attack in the job
+	CrackingJobScript = "crack.sh"
+
+	hashcatPotFile  = "sliver.potfile"
+	johnPotFile     = "sliver.pot"
+	johnCrackedFile = "cracked.txt"
+)
+
+var (
+	// ErrUnknownCrackingJob - Unsupported cracking job format
+	ErrUnknownCrackingJob = errors.New("unknown cracking job format, must be one of: hashcat, john")
+	// ErrNoUncrackedHashes - Nothing to crack
+	ErrNoUncrackedHashes = errors.New("no uncracked hashes")
+
+	// JohnFormats - John the Ripper format names for the hash types john supports
+	JohnFormats = map[clientpb.HashType]string{
+		clientpb.HashType_MD4:                        "raw-md4",
+		clientpb.HashType_MD5:                        "raw-md5",
+		clientpb.HashType_SHA1:                       "raw-sha1",
+		clientpb.HashType_SHA2_256:                   "raw-sha256",
+		clientpb.HashType_SHA2_512:                   "raw-sha512",
+		clientpb.HashType_LM:                         "lm",
+		clientpb.HashType_NTLM:                       "nt",
+		clientpb.HashType_DCC:                        "mscash",
+		clientpb.HashType_DCC2:                       "mscash2",
+		clientpb.HashType_NET_NTLM_V1:                "netntlm",
+		clientpb.HashType_NET_NTLM_V2:                "netntlmv2",
+		clientpb.HashType_KERBEROS_23_SA_REQ_PREAUTH: "krb5pa-md5",
+		clientpb.HashType_KERBEROS_23_TGS_REP:        "krb5tgs",
+		clientpb.HashType_KERBEROS_23_AS_REP:         "krb5asrep",
+		clientpb.HashType_MD5_CRYPT_UNIX:             "md5crypt",
+		clientpb.HashType_SHA256_CRYPT_UNIX:          "sha256crypt",
+		clientpb.HashType_SHA512_CRYPT_UNIX:          "sha512crypt",
+		clientpb.HashType_BCRYPT_UNIX:                "bcrypt",
+	}
+)
+
+// CrackingJob - Generate the hash files and a script to crack every uncracked hash
+// with hashcat or john, returns a map of file names to file contents. The script
+// writes its results to a pot file that can be re-imported with "creds cracked".
+func CrackingJob(format string, creds []*clientpb.Credential) (map[string][]byte, error) {
+	if format != HashcatJob && format != JohnJob {
+		return nil, ErrUnknownCrackingJob
+	}
+	byType := map[clientpb.HashType][]*clientpb.Credential{}
+	for _, cred := range creds {
+		if cred.Hash == "" || cred.IsCracked || cred.HashType == clientpb.HashType_INVALID {
+			continue
+		}
+		if _, ok := JohnFormats[cred.HashType]; format == JohnJob && !ok {
+			continue
+		}
+		byType[cred.HashType] = append(byType[cred.HashType], cred)
+	}
+	if len(byType) == 0 {
+		return nil, ErrNoUncrackedHashes
+	}
+	hashTypes := []clientpb.HashType{}
+	for hashType := range byType {
+		hashTypes = append(hashTypes, hashType)
+	}
+	sort.Slice(hashTypes, func(i, j int) bool { return hashTypes[i] < hashTypes[j] })
+
+	files := map[string][]byte{}
+	script := bytes.NewBufferString("#!/bin/sh\n")
+	script.WriteString(fmt.Sprintf("# Generated by Sliver, import the results with: creds cracked %s\n", jobResultsFile(format)))
+	script.WriteString("WORDLIST=\"${WORDLIST:-wordlist.txt}\"\n\n")
+	for _, hashType := range hashTypes {
+		hashFile := hashJobFileName(format, hashType)
+		files[hashFile] = hashJobFile(format, byType[hashType])
+		script.WriteString(fmt.Sprintf("# %d %s hash(es)\n", len(byType[hashType]), HashNameFromHashType(hashType)))
+		switch format {
+		case HashcatJob:
+			script.WriteString(fmt.Sprintf("hashcat -m %d -a 0 --potfile-path %s %s \"$WORDLIST\"\n\n", int32(hashType), hashcatPotFile, hashFile))
+		case JohnJob:
+			johnFormat := JohnFormats[hashType]
+			script.WriteString(fmt.Sprintf("john --format=%s --pot=%s --wordlist=\"$WORDLIST\" %s\n", johnFormat, johnPotFile, hashFile))
+			script.WriteString(fmt.Sprintf("john --show --format=%s --pot=%s %s >> %s\n\n", johnFormat, johnPotFile, hashFile, johnCrackedFile))
+		}
+	}
+	files[CrackingJobScript] = script.Bytes()
+	return files, nil
+}
+
+func jobResultsFile(format string) string {
+	if format == JohnJob {
+		return johnCrackedFile
+	}
+	return hashcatPotFile
+}
+
+func hashJobFileName(format string, hashType clientpb.HashType) string {
+	if format == JohnJob {
+		return fmt.Sprintf("john-%s.txt", JohnFormats[hashType])
+	}
+	return fmt.Sprintf("hashcat-%d.txt", int32(hashType))
+}
+
+// hashJobFile - Hashcat gets the bare (de-duplicated) hashes, john gets the
+// credential ID as the login so "john --show" output maps back to the credential.
+// Hashes that already contain a login (e.g. net-NTLM) are written as-is.
+func hashJobFile(format string, creds []*clientpb.Credential) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	seen := map[string]bool{}
+	for _, cred := range creds {
+		if format == HashcatJob {
+			if seen[cred.Hash] {
+				continue
+			}
+			seen[cred.Hash] = true
+			buf.WriteString(cred.Hash + "\n")
+		} else if strings.Contains(cred.Hash, ":") {
+			buf.WriteString(cred.Hash + "\n")
+		} else {
+			buf.WriteString(cred.ID + ":" + cred.Hash + "\n")
+		}
+	}
+	return buf.Bytes()
+}
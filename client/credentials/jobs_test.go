@@ -0,0 +1,127 @@
+package credentials
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestParseCredential(t *testing.T) {
+	testCases := []struct {
+		input    string
+		username string
+		hash     string
+		hashType clientpb.HashType
+	}{
+		{
+			input:    "CORP\\alice:1104:aad3b435b51404eeaad3b435b51404ee:B4B9B02E6F09A9BD760F388B67351E2B:::",
+			username: "CORP\\alice",
+			hash:     "b4b9b02e6f09a9bd760f388b67351e2b",
+			hashType: clientpb.HashType_NTLM,
+		},
+		{
+			input:    "root:$6$52450745$k5ka2p8bFuSmoVT1tzOyyuaREkkKBcCNqoDKzYiJL9RaE8yMnPgh2XzzF0NDrUhgrcLwg78xs1w5pJiypEdFX/:19000:0:99999:7:::",
+			username: "root",
+			hash:     "$6$52450745$k5ka2p8bFuSmoVT1tzOyyuaREkkKBcCNqoDKzYiJL9RaE8yMnPgh2XzzF0NDrUhgrcLwg78xs1w5pJiypEdFX/",
+			hashType: clientpb.HashType_SHA512_CRYPT_UNIX,
+		},
+		{
+			input:    "$krb5tgs$23$*svc_sql$CORP.LOCAL$MSSQLSvc/db01*$b548e10f5694ae018d7ad63c257af7dc$35e8e45658860bc31a859b41a08989265f4ef8afd75652ab4d7a30ef151bf6350d879ae189a8cb769e01fa573c6315232b37e4bcad9105520640a781e5fd85c09615e78267e494f433f067cc6958200a82f70627ce0eebc2ac445729c2a8a0255dc3ede2c4973d2d93ac8c1a56b26444df300cb93045d05ff2326affaa3ae97f5cd866c14b78a459f0933a550e0b6507bf8af27c2391ef69fbdd649dd059a4b9ae2440c853a361ee9ebda9fa6fbed4ab85f5b5235be828a8a851ee8e62bd366184f774401f6b73bcf5c84dbe164d6d0279fb22765d1e2631da7f2452529b19e3aa8e4ecda227de36d1bf1d443c7709f5fab0cba899b4aa5ac9d2406717e97f2598a98ba37ea9e8e0de2da9df1f4e6ba81f329b7a12e5c040887bb5f274dd70c2a30f7fb978fe194c4",
+			username: "CORP.LOCAL\\svc_sql",
+			hashType: clientpb.HashType_KERBEROS_23_TGS_REP,
+		},
+		{
+			input:    "admin::N46iSNekpT:08ca45b7d7ea58ee:88dcbe4446168966a153a0064958dac6:5c7830315c7830310000000000000b45c67103d07d7b95acd12ffa11230e0000000052920b85f78d013c31cdb3b92f5d765c783030",
+			username: "N46iSNekpT\\admin",
+			hashType: clientpb.HashType_NET_NTLM_V2,
+		},
+	}
+	for _, tc := range testCases {
+		cred := ParseCredential(tc.input)
+		if cred == nil {
+			t.Fatalf("Failed to parse %s", tc.input)
+		}
+		if cred.Username != tc.username {
+			t.Errorf("Expected username %s, got %s", tc.username, cred.Username)
+		}
+		if tc.hash != "" && cred.Hash != tc.hash {
+			t.Errorf("Expected hash %s, got %s", tc.hash, cred.Hash)
+		}
+		if cred.HashType != tc.hashType {
+			t.Errorf("Expected hash type %s, got %s", tc.hashType, cred.HashType)
+		}
+	}
+	if ParseCredential("daemon:*:19000:0:99999:7:::") != nil {
+		t.Error("Expected locked shadow entry to be skipped")
+	}
+}
+
+func TestCrackingJobRoundTrip(t *testing.T) {
+	netNTLM := "admin::N46iSNekpT:08ca45b7d7ea58ee:88dcbe4446168966a153a0064958dac6:5c7830315c7830310000000000000b45c67103d07d7b95acd12ffa11230e0000000052920b85f78d013c31cdb3b92f5d765c783030"
+	creds := []*clientpb.Credential{
+		{ID: "07f3a8a4-0a4c-4a4b-8c5e-6f1df2d2b1a1", Username: "alice", Hash: "b4b9b02e6f09a9bd760f388b67351e2b", HashType: clientpb.HashType_NTLM},
+		{ID: "5d0c5b4e-2b51-4c8f-9f5b-1f7459bfc0e2", Username: "bob", Hash: "b4b9b02e6f09a9bd760f388b67351e2b", HashType: clientpb.HashType_NTLM},
+		{ID: "a3f1c7e0-2d8e-4c4e-8f1a-4c1d5a7d9e13", Username: "admin", Hash: netNTLM, HashType: clientpb.HashType_NET_NTLM_V2},
+		{ID: "c0d6d9b4-9e0f-4a52-a4a6-7d3b8f2b6c55", Username: "carol", Hash: "8743b52063cd84097a65d1633f5c74f5", HashType: clientpb.HashType_MD5, IsCracked: true},
+	}
+
+	files, err := CrackingJob(HashcatJob, creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(files["hashcat-1000.txt"]) != "b4b9b02e6f09a9bd760f388b67351e2b\n" {
+		t.Errorf("Expected de-duplicated NTLM hash file, got %q", files["hashcat-1000.txt"])
+	}
+	if _, ok := files["hashcat-0.txt"]; ok {
+		t.Error("Expected cracked hashes to be skipped")
+	}
+	if !strings.Contains(string(files[CrackingJobScript]), "hashcat -m 5600") {
+		t.Errorf("Expected a net-NTLMv2 attack in the job script")
+	}
+
+	files, err = CrackingJob(JohnJob, creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(files["john-nt.txt"]), creds[0].ID+":") {
+		t.Errorf("Expected john hash file to use credential ids, got %q", files["john-nt.txt"])
+	}
+
+	// hashcat pot file, john --show output, and a john pot file entry
+	results := strings.Join([]string{
+		"B4B9B02E6F09A9BD760F388B67351E2B:$HEX[686173683a636174]",
+		"admin:hashcat" + netNTLM[len("admin"):],
+		"$NT$31d6cfe0d16ae931b73c59d7e0c089c0:",
+	}, "\n")
+	cracked := CrackedResults(creds, []byte(results))
+	if len(cracked) != 3 {
+		t.Fatalf("Expected 3 cracked credentials, got %d", len(cracked))
+	}
+	for _, cred := range cracked[:2] {
+		if cred.Plaintext != "hash:cat" || !cred.IsCracked {
+			t.Errorf("Expected %s to be cracked as 'hash:cat', got %q", cred.Username, cred.Plaintext)
+		}
+	}
+	if cracked[2].Plaintext != "hashcat" {
+		t.Errorf("Expected net-NTLMv2 plaintext 'hashcat', got %q", cracked[2].Plaintext)
+	}
+}
@@ -0,0 +1,98 @@
+package credentials
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// ParseCredential - Parse a single line of tool output into a credential, the
+// username is extracted where the format contains one (pwdump/secretsdump,
+// /etc/shadow, Responder net-NTLM, and Kerberos TGS-REP/AS-REP hashes). Returns
+// nil for blank lines, comments, and shadow entries without a password hash.
+// The hash type is INVALID if it could not be detected.
+func ParseCredential(line string) *clientpb.Credential {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	// admin:500:aad3b435b51404eeaad3b435b51404ee:b4b9b02e6f09a9bd760f388b67351e2b:::
+	if username, ntHash := parsePwdump(line); ntHash != "" {
+		return &clientpb.Credential{Username: username, Hash: ntHash, HashType: clientpb.HashType_NTLM}
+	}
+
+	// user::domain:challenge:response... the whole line is the hash
+	if hashType := sniffNetNTLM(line); hashType != clientpb.HashType_INVALID {
+		parts := strings.Split(line, ":")
+		return &clientpb.Credential{Username: parts[2] + "\\" + parts[0], Hash: line, HashType: hashType}
+	}
+
+	if strings.HasPrefix(line, "$krb5") {
+		return &clientpb.Credential{Username: kerberosUsername(line), Hash: line, HashType: SniffHashType(line)}
+	}
+
+	parts := strings.Split(line, ":")
+	if 2 <= len(parts) && !strings.HasPrefix(line, "$") {
+		// root:$6$salt$hash:19000:0:99999:7:::
+		if len(parts) == 9 && !strings.HasPrefix(parts[1], "$") {
+			return nil // locked or passwordless account
+		}
+		if hashType := SniffHashType(parts[1]); hashType != clientpb.HashType_INVALID {
+			return &clientpb.Credential{Username: parts[0], Hash: parts[1], HashType: hashType}
+		}
+		if len(parts) == 2 && !strings.ContainsAny(parts[0], " \t") {
+			return &clientpb.Credential{Username: parts[0], Hash: parts[1], HashType: clientpb.HashType_INVALID}
+		}
+	}
+
+	hashType := SniffHashType(line)
+	if hashType == clientpb.HashType_NTLM {
+		line = strings.ToLower(strings.TrimPrefix(line, "$NT$"))
+	}
+	return &clientpb.Credential{Hash: line, HashType: hashType}
+}
+
+// kerberosUsername - Extract the username from a roasted Kerberos hash
+//
+//	$krb5tgs$23$*user$realm$spn*$checksum$edata
+//	$krb5asrep$23$user@realm:checksum$edata
+func kerberosUsername(hash string) string {
+	if start := strings.Index(hash, "*"); start != -1 {
+		fields := strings.Split(hash[start+1:], "$")
+		if 2 <= len(fields) && fields[0] != "" {
+			return fields[1] + "\\" + fields[0]
+		}
+	}
+	if strings.HasPrefix(hash, "$krb5asrep$") {
+		fields := strings.SplitN(hash, "$", 4)
+		if len(fields) == 4 {
+			if end := strings.IndexAny(fields[3], ":"); end != -1 {
+				principal := fields[3][:end]
+				if at := strings.Index(principal, "@"); at != -1 {
+					return principal[at+1:] + "\\" + principal[:at]
+				}
+				return principal
+			}
+		}
+	}
+	return ""
+}
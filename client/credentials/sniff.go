@@ -59,17 +59,92 @@ func SniffHashType(unknownHash string) clientpb.HashType {
 	if strings.HasPrefix(unknownHash, "$krb5pa$18$") {
 		return clientpb.HashType_KERBEROS_18_PREAUTH
 	}
-	// $2a$
-	if strings.HasPrefix(unknownHash, "$2a$") {
+	// $2a$, $2b$, $2y$
+	if strings.HasPrefix(unknownHash, "$2a$") || strings.HasPrefix(unknownHash, "$2b$") || strings.HasPrefix(unknownHash, "$2y$") {
 		return clientpb.HashType_BCRYPT_UNIX
 	}
 	// $6$
 	if strings.HasPrefix(unknownHash, "$6$") {
 		return clientpb.HashType_SHA512_CRYPT_UNIX
 	}
+	// $5$
+	if strings.HasPrefix(unknownHash, "$5$") {
+		return clientpb.HashType_SHA256_CRYPT_UNIX
+	}
+	// $1$
+	if strings.HasPrefix(unknownHash, "$1$") {
+		return clientpb.HashType_MD5_CRYPT_UNIX
+	}
+	// $NT$b4b9b02e6f09a9bd760f388b67351e2b (john)
+	if strings.HasPrefix(unknownHash, "$NT$") && isHex(unknownHash[4:], 32) {
+		return clientpb.HashType_NTLM
+	}
 	// SCRYPT:...
 	if strings.HasPrefix(unknownHash, "SCRYPT:") {
 		return clientpb.HashType_SCRYPT
 	}
+	if hashType := sniffNetNTLM(unknownHash); hashType != clientpb.HashType_INVALID {
+		return hashType
+	}
+	// admin:500:aad3b435b51404eeaad3b435b51404ee:b4b9b02e6f09a9bd760f388b67351e2b:::
+	if _, ntHash := parsePwdump(unknownHash); ntHash != "" {
+		return clientpb.HashType_NTLM
+	}
+	return clientpb.HashType_INVALID
+}
+
+// sniffNetNTLM - Responder/hashcat style challenge responses
+//
+//	v1: user::domain:lm response (48 hex):nt response (48 hex):challenge (16 hex)
+//	v2: user::domain:challenge (16 hex):nt proof (32 hex):blob (hex)
+func sniffNetNTLM(unknownHash string) clientpb.HashType {
+	parts := strings.Split(unknownHash, ":")
+	if len(parts) != 6 || parts[0] == "" || parts[1] != "" {
+		return clientpb.HashType_INVALID
+	}
+	if isHex(parts[3], 48) && isHex(parts[4], 48) && isHex(parts[5], 16) {
+		return clientpb.HashType_NET_NTLM_V1
+	}
+	if isHex(parts[3], 16) && isHex(parts[4], 32) && isHex(parts[5], -1) {
+		return clientpb.HashType_NET_NTLM_V2
+	}
 	return clientpb.HashType_INVALID
 }
+
+// parsePwdump - Parse a pwdump/secretsdump line (user:rid:lm:nt:::) returning
+// the username and NT hash, the NT hash is blank if the line is not pwdump
+func parsePwdump(line string) (string, string) {
+	parts := strings.Split(line, ":")
+	if len(parts) < 4 || parts[0] == "" {
+		return "", ""
+	}
+	if !isDecimal(parts[1]) || !isHex(parts[2], 32) || !isHex(parts[3], 32) {
+		return "", ""
+	}
+	return parts[0], strings.ToLower(parts[3])
+}
+
+// isHex - Check if value is hex encoded, with an exact length if length is not negative
+func isHex(value string, length int) bool {
+	if value == "" || (0 <= length && len(value) != length) {
+		return false
+	}
+	for _, c := range value {
+		if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') && !('A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDecimal(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || '9' < c {
+			return false
+		}
+	}
+	return true
+}
@@ -85,6 +85,41 @@ func TestSniffHashType(t *testing.T) {
 			input:    "SCRYPT:",
 			expected: clientpb.HashType_SCRYPT,
 		},
+		{
+			name:     "SHA256-Crypt hash",
+			input:    "$5$rounds=5000$GX7BopJZJxPc/KEK$le16UF8I2Anb.rOrn22AUPWvzUETDGefUmAV8AZkGcD",
+			expected: clientpb.HashType_SHA256_CRYPT_UNIX,
+		},
+		{
+			name:     "MD5-Crypt hash",
+			input:    "$1$28772684$iEwNOgGugqO9.bIz5sk8k/",
+			expected: clientpb.HashType_MD5_CRYPT_UNIX,
+		},
+		{
+			name:     "BCrypt 2y hash",
+			input:    "$2y$05$/VT2Xs2dMd8GJKfrXhjYP.DkTjOVrY12yDN7/6I8ZV0q/1lEohLru",
+			expected: clientpb.HashType_BCRYPT_UNIX,
+		},
+		{
+			name:     "NetNTLMv2 hash",
+			input:    "admin::N46iSNekpT:08ca45b7d7ea58ee:88dcbe4446168966a153a0064958dac6:5c7830315c7830310000000000000b45c67103d07d7b95acd12ffa11230e0000000052920b85f78d013c31cdb3b92f5d765c783030",
+			expected: clientpb.HashType_NET_NTLM_V2,
+		},
+		{
+			name:     "NetNTLMv1 hash",
+			input:    "u4-netntlm::kNS:338d08f8e26de93300000000000000000000000000000000:9526fb8c23a90751cdd619b6cea564742e1e4bf33006ba41:cb8086049ec4736c",
+			expected: clientpb.HashType_NET_NTLM_V1,
+		},
+		{
+			name:     "NTLM pwdump",
+			input:    "Administrator:500:aad3b435b51404eeaad3b435b51404ee:31d6cfe0d16ae931b73c59d7e0c089c0:::",
+			expected: clientpb.HashType_NTLM,
+		},
+		{
+			name:     "NTLM john",
+			input:    "$NT$31d6cfe0d16ae931b73c59d7e0c089c0",
+			expected: clientpb.HashType_NTLM,
+		},
 		{
 			name:     "Invalid hash",
 			input:    "invalid_hash",
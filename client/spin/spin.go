@@ -97,12 +97,18 @@ func (s *Spinner) Reset() {
 
 // Until - Spin until ctrl channel signals
 func Until(stdout io.Writer, msg string, ctrl chan bool) {
+	UntilFunc(stdout, func() string { return msg }, ctrl)
+}
+
+// UntilFunc - Spin until ctrl channel signals, msg is called on every frame
+// so the message can change e.g. to show progress
+func UntilFunc(stdout io.Writer, msg func() string, ctrl chan bool) {
 	defer close(ctrl)
 	s := New()
 	for {
 		select {
 		case <-time.After(100 * time.Millisecond):
-			fmt.Fprintf(stdout, clearln+" %s  %s", s.Next(), msg)
+			fmt.Fprintf(stdout, clearln+" %s  %s", s.Next(), msg())
 		case <-ctrl:
 			fmt.Fprintf(stdout, "%s", clearln)
 			ctrl <- true
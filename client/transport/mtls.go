@@ -73,6 +73,8 @@ func MTLSConnect(config *assets.ClientConfig) (rpcpb.SliverRPCClient, *grpc.Clie
 		grpc.WithPerRPCCredentials(callCreds),
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(ClientMaxReceiveMessageSize)),
+		grpc.WithChainUnaryInterceptor(apiVersionUnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(apiVersionStreamClientInterceptor),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	defer cancel()
@@ -80,7 +82,13 @@ func MTLSConnect(config *assets.ClientConfig) (rpcpb.SliverRPCClient, *grpc.Clie
 	if err != nil {
 		return nil, nil, err
 	}
-	return rpcpb.NewSliverRPCClient(connection), connection, nil
+	rpc := rpcpb.NewSliverRPCClient(connection)
+	err = negotiateAPIVersion(ctx, rpc)
+	if err != nil {
+		connection.Close()
+		return nil, nil, err
+	}
+	return rpc, connection, nil
 }
 
 func getTLSConfig(caCertificate string, certificate string, privateKey string) (*tls.Config, error) {
@@ -0,0 +1,68 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bishopfox/sliver/client/version"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+)
+
+// apiVersionUnaryClientInterceptor - Send the client's API version with every call
+func apiVersionUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(withAPIVersion(ctx), method, req, reply, cc, opts...)
+	return unimplementedError(method, err)
+}
+
+// apiVersionStreamClientInterceptor - Send the client's API version with every stream
+func apiVersionStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(withAPIVersion(ctx), desc, cc, method, opts...)
+	return stream, unimplementedError(method, err)
+}
+
+func withAPIVersion(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, version.APIVersionKey, strconv.Itoa(version.APIVersion))
+}
+
+// unimplementedError - An older server doesn't know the rpcs added since, say so
+// instead of grpc's "unknown method"
+func unimplementedError(method string, err error) error {
+	if status.Code(err) != codes.Unimplemented {
+		return err
+	}
+	return status.Errorf(codes.Unimplemented, "%s is not supported by the server, it's probably older than the client (upgrade the server)", method)
+}
+
+// negotiateAPIVersion - Check the server's API version is compatible with the client's,
+// GetVersion is the one rpc every version of the server supports
+func negotiateAPIVersion(ctx context.Context, rpc rpcpb.SliverRPCClient) error {
+	serverVer, err := rpc.GetVersion(ctx, &commonpb.Empty{})
+	if err != nil {
+		return err
+	}
+	return version.CheckAPIVersion("server", serverVer.APIVersion, serverVer.MinAPIVersion)
+}
@@ -0,0 +1,55 @@
+package version
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// APIVersion - Version of the client/server grpc API. It's incremented when an rpc
+	// or field is removed or its meaning changes, adding rpcs and fields doesn't
+	// change it (see README.md for the compatibility guarantees).
+	APIVersion = 1
+
+	// MinAPIVersion - The oldest API version of the other side this binary works with,
+	// clients and servers that predate API versioning are version 0
+	MinAPIVersion = 0
+
+	// APIVersionKey - The grpc metadata key of the client's API version
+	APIVersionKey = "sliver-api-version"
+)
+
+// ErrIncompatibleAPI - The client and server API versions are incompatible
+var ErrIncompatibleAPI = errors.New("incompatible api versions")
+
+// CheckAPIVersion - Check the API version of the other side (peer is "client" or
+// "server") and the oldest version it supports, the error says who has to upgrade
+func CheckAPIVersion(peer string, version int32, minVersion int32) error {
+	if version < MinAPIVersion {
+		return fmt.Errorf("%w: the %s speaks api v%d but v%d or later is required, upgrade the %s",
+			ErrIncompatibleAPI, peer, version, MinAPIVersion, peer)
+	}
+	if APIVersion < minVersion {
+		return fmt.Errorf("%w: the %s requires api v%d or later but this is v%d, upgrade this binary",
+			ErrIncompatibleAPI, peer, minVersion, APIVersion)
+	}
+	return nil
+}
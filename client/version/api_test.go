@@ -0,0 +1,42 @@
+package version
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckAPIVersion(t *testing.T) {
+	if err := CheckAPIVersion("server", APIVersion, MinAPIVersion); err != nil {
+		t.Fatalf("expected the same version to be compatible, got %s", err)
+	}
+	if err := CheckAPIVersion("server", APIVersion+1, APIVersion); err != nil {
+		t.Fatalf("expected a newer compatible server to be supported, got %s", err)
+	}
+	err := CheckAPIVersion("server", APIVersion+1, APIVersion+1)
+	if !errors.Is(err, ErrIncompatibleAPI) {
+		t.Fatalf("expected an incompatible api error, got %v", err)
+	}
+	if 0 < MinAPIVersion {
+		if err := CheckAPIVersion("client", MinAPIVersion-1, 0); !errors.Is(err, ErrIncompatibleAPI) {
+			t.Fatalf("expected an incompatible api error, got %v", err)
+		}
+	}
+}
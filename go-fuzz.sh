@@ -0,0 +1,52 @@
+#!/bin/bash
+
+
+# Sliver Implant Framework
+# Copyright (C) 2019  Bishop Fox
+
+# This program is free software: you can redistribute it and/or modify
+# it under the terms of the GNU General Public License as published by
+# the Free Software Foundation, either version 3 of the License, or
+# (at your option) any later version.
+
+# This program is distributed in the hope that it will be useful,
+# but WITHOUT ANY WARRANTY; without even the implied warranty of
+# MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+# GNU General Public License for more details.
+
+# You should have received a copy of the GNU General Public License
+# along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+
+# The seed corpus of each fuzz target runs with the unit tests (go-tests.sh),
+# this runs the fuzzer on each target for FUZZTIME (default 1m), e.g.:
+#   FUZZTIME=10m ./go-fuzz.sh
+FUZZTIME=${FUZZTIME:-1m}
+TAGS=osusergo,netgo,go_sqlite
+
+# fuzz <package> <target> [tags]
+fuzz() {
+    if go test -tags=$3 -run '^$' -fuzz "^$2\$" -fuzztime $FUZZTIME $1 ; then
+        :
+    else
+        exit 1
+    fi
+}
+
+## Envelope decoders
+fuzz ./server/c2 FuzzMTLSReadEnvelope server,$TAGS
+fuzz ./server/c2 FuzzWGReadEnvelope server,$TAGS
+
+## DNS messages
+fuzz ./server/c2 FuzzDNSDecodeSubdata server,$TAGS
+fuzz ./server/c2 FuzzDNSPendingEnvelope server,$TAGS
+
+## HTTP encoders
+fuzz ./server/c2 FuzzHTTPDecode server,$TAGS
+for target in FuzzBase32 FuzzBase58 FuzzBase64 FuzzEnglish FuzzGzip FuzzHex FuzzPNG ; do
+    fuzz ./util/encoders $target server,$TAGS
+done
+
+## WinINet driver
+fuzz ./implant/sliver/transports/httpclient/drivers/win/wininet FuzzGetHeaders
+fuzz ./implant/sliver/transports/httpclient/drivers/win/wininet FuzzGetCookies
@@ -83,6 +83,13 @@ else
     exit 1
 fi
 
+# implant / sliver / transports / httpclient
+if go test ./implant/sliver/transports/httpclient/... ; then
+    :
+else
+    exit 1
+fi
+
 ## Server
 
 # server / assets / traffic encoders
@@ -115,6 +122,14 @@ else
     exit 1
 fi
 
+# server / toolchains
+if go test -tags=server,$TAGS ./server/toolchains ; then
+    :
+else
+    cat ~/.sliver/logs/sliver.log
+    exit 1
+fi
+
 # server / certs
 if go test -tags=server,$TAGS ./server/certs ; then
     :
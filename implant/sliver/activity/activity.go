@@ -0,0 +1,89 @@
+package activity
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if and .Config.Debug .Config.AdaptiveBeacon}}
+	"log"
+	// {{end}}
+
+	// {{if .Config.AdaptiveBeacon}}
+	"strconv"
+	// {{end}}
+
+	"time"
+)
+
+// State - The user's activity on the host, as far as it can be told without
+// running anything
+type State struct {
+	Idle      time.Duration // Since the last user input, zero if unknown
+	Locked    bool
+	OnBattery bool
+}
+
+var (
+	// Enabled - Set if beacons check in less often while the host isn't in use
+	Enabled = false
+
+	// {{if .Config.AdaptiveBeacon}}
+	idleThreshold = `{{.Config.AdaptiveIdleThreshold}}`
+	idleFactor    = `{{.Config.AdaptiveIdleFactor}}`
+	// {{end}}
+)
+
+func init() {
+	// {{if .Config.AdaptiveBeacon}}
+	Enabled = true
+	// {{end}}
+}
+
+// Scale - Scale a beacon's sleep to the user's activity, so most check ins blend in
+// with the traffic of a machine that's in use
+func Scale(duration time.Duration) time.Duration {
+	// {{if .Config.AdaptiveBeacon}}
+	if Enabled {
+		threshold, _ := strconv.ParseInt(idleThreshold, 10, 64)
+		factor, _ := strconv.ParseInt(idleFactor, 10, 64)
+		state := GetState()
+		multiplier := Multiplier(state, time.Duration(threshold), factor)
+		// {{if .Config.Debug}}
+		log.Printf("[activity] idle %s locked %v on battery %v: sleep x%d", state.Idle, state.Locked, state.OnBattery, multiplier)
+		// {{end}}
+		duration *= time.Duration(multiplier)
+	}
+	// {{end}}
+	return duration
+}
+
+// Multiplier - The configured interval is used while the user is active, it's factor
+// times longer once the host is idle or locked, and doubled again on battery power
+func Multiplier(state State, threshold time.Duration, factor int64) int64 {
+	multiplier := int64(1)
+	if state.Locked || (0 < threshold && threshold <= state.Idle) {
+		multiplier = factor
+	}
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	if state.OnBattery {
+		multiplier *= 2
+	}
+	return multiplier
+}
@@ -0,0 +1,79 @@
+package activity
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const powerSupplies = "/sys/class/power_supply"
+
+// GetState - Idle time is taken from the terminals' access times (like w(1) does),
+// so input to graphical sessions isn't seen. Screen locks aren't detected.
+func GetState() State {
+	return State{
+		Idle:      ttyIdle(),
+		OnBattery: onBattery(),
+	}
+}
+
+func ttyIdle() time.Duration {
+	ttys, _ := filepath.Glob("/dev/pts/[0-9]*")
+	consoles, _ := filepath.Glob("/dev/tty[0-9]*")
+	var lastInput time.Time
+	for _, tty := range append(ttys, consoles...) {
+		var stat syscall.Stat_t
+		if syscall.Stat(tty, &stat) != nil {
+			continue
+		}
+		accessed := time.Unix(int64(stat.Atim.Sec), int64(stat.Atim.Nsec))
+		if lastInput.Before(accessed) {
+			lastInput = accessed
+		}
+	}
+	if lastInput.IsZero() {
+		return 0
+	}
+	return time.Since(lastInput)
+}
+
+func onBattery() bool {
+	supplies, err := os.ReadDir(powerSupplies)
+	if err != nil {
+		return false
+	}
+	battery := false
+	for _, supply := range supplies {
+		supplyType, _ := os.ReadFile(filepath.Join(powerSupplies, supply.Name(), "type"))
+		switch strings.TrimSpace(string(supplyType)) {
+		case "Battery":
+			battery = true
+		case "Mains", "USB":
+			online, _ := os.ReadFile(filepath.Join(powerSupplies, supply.Name(), "online"))
+			if strings.TrimSpace(string(online)) == "1" {
+				return false
+			}
+		}
+	}
+	return battery
+}
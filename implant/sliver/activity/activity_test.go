@@ -0,0 +1,46 @@
+package activity
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMultiplier(t *testing.T) {
+	threshold := 5 * time.Minute
+	for _, test := range []struct {
+		state    State
+		expected int64
+	}{
+		{State{}, 1},
+		{State{Idle: time.Minute}, 1},
+		{State{Idle: 10 * time.Minute}, 4},
+		{State{Idle: time.Minute, Locked: true}, 4},
+		{State{Idle: time.Minute, OnBattery: true}, 2},
+		{State{Idle: time.Hour, OnBattery: true}, 8},
+	} {
+		if multiplier := Multiplier(test.state, threshold, 4); multiplier != test.expected {
+			t.Errorf("%+v: expected x%d, got x%d", test.state, test.expected, multiplier)
+		}
+	}
+	if multiplier := Multiplier(State{Locked: true}, threshold, 0); multiplier != 1 {
+		t.Errorf("Expected an invalid factor to be ignored, got x%d", multiplier)
+	}
+}
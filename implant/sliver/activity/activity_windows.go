@@ -0,0 +1,60 @@
+package activity
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+// GetState - Input and the desktop are per session, so an implant running in session 0
+// (e.g. as a service) sees a host that's always active and unlocked
+func GetState() State {
+	state := State{}
+	var session uint32
+	err := windows.ProcessIdToSessionId(windows.GetCurrentProcessId(), &session)
+	if err != nil || session == 0 {
+		return state
+	}
+	info := syscalls.LASTINPUTINFO{}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	if syscalls.GetLastInputInfo(&info) == nil {
+		// The last input is the 32-bit tick count, which wraps every ~49 days
+		ticks := uint32(windows.DurationSinceBoot().Milliseconds())
+		state.Idle = time.Duration(ticks-info.DwTime) * time.Millisecond
+	}
+
+	// The input desktop is winlogon's while the workstation is locked, which
+	// can't be opened by the user
+	desktop, err := syscalls.OpenInputDesktop(0, false, syscalls.DESKTOP_READOBJECTS)
+	if err != nil {
+		state.Locked = true
+	} else {
+		syscalls.CloseDesktop(desktop)
+	}
+
+	power := syscalls.SYSTEM_POWER_STATUS{}
+	if syscalls.GetSystemPowerStatus(&power) == nil {
+		state.OnBattery = power.ACLineStatus == syscalls.AC_LINE_OFFLINE
+	}
+	return state
+}
@@ -0,0 +1,322 @@
+package ad
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bishopfox/sliver/implant/sliver/ldap"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+
+	// userAccountControl flags
+	uacAccountDisable       = 0x2
+	uacServerTrustAccount   = 0x2000
+	uacTrustedForDelegation = 0x80000
+
+	// 100ns intervals between the FILETIME and unix epochs
+	filetimeEpoch = 116444736000000000
+	filetimeNever = 0x7fffffffffffffff
+)
+
+var (
+	// ErrNoBaseDN - Neither the domain nor the server's rootDSE gave a dn to search
+	ErrNoBaseDN = errors.New("could not determine the domain's base dn")
+)
+
+// query - What each ADQuery searches for, Name is the attribute an object is
+// listed by and Attributes are the other attributes returned
+type query struct {
+	Filter     ldap.Filter
+	Name       string
+	Attributes []string
+}
+
+var queries = map[string]query{
+	sliverpb.ADQueryUsers: {
+		Filter: ldap.And(ldap.Equal("objectCategory", "person"), ldap.Equal("objectClass", "user")),
+		Name:   "sAMAccountName",
+		Attributes: []string{
+			"userPrincipalName", "displayName", "description", "memberOf", "userAccountControl",
+			"adminCount", "pwdLastSet", "lastLogonTimestamp", "objectSid",
+		},
+	},
+	sliverpb.ADQueryGroups: {
+		Filter:     ldap.Equal("objectCategory", "group"),
+		Name:       "sAMAccountName",
+		Attributes: []string{"description", "groupType", "adminCount", "member", "objectSid"},
+	},
+	sliverpb.ADQuerySPNs: {
+		Filter: ldap.And(
+			ldap.Equal("objectCategory", "person"),
+			ldap.Equal("objectClass", "user"),
+			ldap.Present("servicePrincipalName"),
+			ldap.Not(ldap.BitAnd("userAccountControl", uacAccountDisable)),
+		),
+		Name: "sAMAccountName",
+		Attributes: []string{
+			"servicePrincipalName", "memberOf", "adminCount", "pwdLastSet",
+			"msDS-SupportedEncryptionTypes", "userAccountControl",
+		},
+	},
+	sliverpb.ADQueryTrusts: {
+		Filter:     ldap.Equal("objectClass", "trustedDomain"),
+		Name:       "trustPartner",
+		Attributes: []string{"flatName", "trustDirection", "trustType", "trustAttributes", "securityIdentifier"},
+	},
+	sliverpb.ADQueryGPOs: {
+		Filter:     ldap.Equal("objectCategory", "groupPolicyContainer"),
+		Name:       "displayName",
+		Attributes: []string{"cn", "gPCFileSysPath", "flags", "whenCreated", "whenChanged"},
+	},
+	sliverpb.ADQueryDelegation: {
+		Filter: ldap.And(
+			ldap.BitAnd("userAccountControl", uacTrustedForDelegation),
+			ldap.Not(ldap.BitAnd("userAccountControl", uacServerTrustAccount)),
+		),
+		Name:       "sAMAccountName",
+		Attributes: []string{"objectCategory", "dNSHostName", "operatingSystem", "userAccountControl", "servicePrincipalName"},
+	},
+}
+
+// searcher - An LDAP client bound to the domain, ldap.Conn or the system's LDAP library
+type searcher interface {
+	RootDSE(attributes ...string) (*ldap.Entry, error)
+	Search(baseDN string, filter ldap.Filter, attributes []string, limit int) ([]*ldap.Entry, error)
+	Close() error
+}
+
+// Enumerate - Run a query against the domain
+func Enumerate(req *sliverpb.ADEnumReq) (*sliverpb.ADEnum, error) {
+	if _, ok := queries[req.Query]; !ok {
+		return nil, fmt.Errorf("unknown query '%s'", req.Query)
+	}
+	timeout := time.Duration(req.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	client, server, err := connect(req, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	adEnum, err := enumerate(client, req)
+	if err != nil {
+		return nil, err
+	}
+	adEnum.Server = server
+	return adEnum, nil
+}
+
+func enumerate(client searcher, req *sliverpb.ADEnumReq) (*sliverpb.ADEnum, error) {
+	q := queries[req.Query]
+	baseDN := ldap.BaseDN(req.Domain)
+	if baseDN == "" {
+		rootDSE, err := client.RootDSE("defaultNamingContext")
+		if err != nil {
+			return nil, err
+		}
+		baseDN = rootDSE.First("defaultNamingContext")
+		if baseDN == "" {
+			return nil, ErrNoBaseDN
+		}
+	}
+	entries, err := client.Search(baseDN, q.Filter, append([]string{q.Name}, q.Attributes...), int(req.Limit))
+	truncated := errors.Is(err, ldap.ErrTruncated)
+	if err != nil && !truncated {
+		return nil, err
+	}
+
+	adEnum := &sliverpb.ADEnum{
+		Query:     req.Query,
+		Domain:    ldap.DomainName(baseDN),
+		Truncated: truncated,
+	}
+	for _, entry := range entries {
+		object := &sliverpb.ADObject{DN: entry.DN, Name: entry.First(q.Name)}
+		for _, name := range q.Attributes {
+			values := formatValues(name, entry.Values(name))
+			if 0 < len(values) {
+				object.Attributes = append(object.Attributes, &sliverpb.ADAttribute{Name: name, Values: values})
+			}
+		}
+		adEnum.Objects = append(adEnum.Objects, object)
+	}
+	sort.SliceStable(adEnum.Objects, func(i, j int) bool {
+		return strings.ToLower(adEnum.Objects[i].Name) < strings.ToLower(adEnum.Objects[j].Name)
+	})
+	return adEnum, nil
+}
+
+// formatValues - Attribute values as text, binary and encoded values are decoded
+// so the results can be read without knowing the schema
+func formatValues(name string, values [][]byte) []string {
+	formatted := []string{}
+	for _, value := range values {
+		switch strings.ToLower(name) {
+		case "objectsid", "securityidentifier":
+			formatted = append(formatted, formatSID(value))
+		case "pwdlastset", "lastlogontimestamp":
+			formatted = append(formatted, formatFiletime(string(value)))
+		case "whencreated", "whenchanged":
+			formatted = append(formatted, formatGeneralizedTime(string(value)))
+		case "useraccountcontrol":
+			formatted = append(formatted, formatFlags(string(value), uacFlags)...)
+		case "grouptype":
+			formatted = append(formatted, formatFlags(string(value), groupTypeFlags)...)
+		case "trustattributes":
+			formatted = append(formatted, formatFlags(string(value), trustAttributeFlags)...)
+		case "msds-supportedencryptiontypes":
+			formatted = append(formatted, formatFlags(string(value), encryptionTypeFlags)...)
+		case "trustdirection":
+			formatted = append(formatted, formatEnum(string(value), trustDirections))
+		case "trusttype":
+			formatted = append(formatted, formatEnum(string(value), trustTypes))
+		default:
+			if utf8.Valid(value) {
+				formatted = append(formatted, string(value))
+			} else {
+				formatted = append(formatted, hex.EncodeToString(value))
+			}
+		}
+	}
+	return formatted
+}
+
+// formatSID - The S-1-... form of a binary SID
+func formatSID(sid []byte) string {
+	if len(sid) < 8 || len(sid) != 8+4*int(sid[1]) {
+		return hex.EncodeToString(sid)
+	}
+	authority := uint64(0)
+	for _, b := range sid[2:8] {
+		authority = authority<<8 | uint64(b)
+	}
+	formatted := fmt.Sprintf("S-%d-%d", sid[0], authority)
+	for i := 8; i < len(sid); i += 4 {
+		formatted += "-" + strconv.FormatUint(uint64(binary.LittleEndian.Uint32(sid[i:])), 10)
+	}
+	return formatted
+}
+
+// formatFiletime - A FILETIME attribute (100ns intervals since 1601) as a UTC time
+func formatFiletime(value string) string {
+	filetime, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	if filetime == 0 || filetime == filetimeNever {
+		return "never"
+	}
+	return time.Unix(0, (filetime-filetimeEpoch)*100).UTC().Format(time.RFC3339)
+}
+
+// formatGeneralizedTime - A time like 20230102150405.0Z in RFC3339
+func formatGeneralizedTime(value string) string {
+	parsed, err := time.Parse("20060102150405.0Z", value)
+	if err != nil {
+		return value
+	}
+	return parsed.UTC().Format(time.RFC3339)
+}
+
+type flag struct {
+	bit  uint32
+	name string
+}
+
+var (
+	uacFlags = []flag{
+		{0x1, "SCRIPT"}, {0x2, "ACCOUNTDISABLE"}, {0x8, "HOMEDIR_REQUIRED"}, {0x10, "LOCKOUT"},
+		{0x20, "PASSWD_NOTREQD"}, {0x40, "PASSWD_CANT_CHANGE"}, {0x80, "ENCRYPTED_TEXT_PWD_ALLOWED"},
+		{0x100, "TEMP_DUPLICATE_ACCOUNT"}, {0x200, "NORMAL_ACCOUNT"}, {0x800, "INTERDOMAIN_TRUST_ACCOUNT"},
+		{0x1000, "WORKSTATION_TRUST_ACCOUNT"}, {0x2000, "SERVER_TRUST_ACCOUNT"},
+		{0x10000, "DONT_EXPIRE_PASSWORD"}, {0x20000, "MNS_LOGON_ACCOUNT"}, {0x40000, "SMARTCARD_REQUIRED"},
+		{0x80000, "TRUSTED_FOR_DELEGATION"}, {0x100000, "NOT_DELEGATED"}, {0x200000, "USE_DES_KEY_ONLY"},
+		{0x400000, "DONT_REQ_PREAUTH"}, {0x800000, "PASSWORD_EXPIRED"},
+		{0x1000000, "TRUSTED_TO_AUTH_FOR_DELEGATION"}, {0x4000000, "PARTIAL_SECRETS_ACCOUNT"},
+	}
+	groupTypeFlags = []flag{
+		{0x1, "SYSTEM"}, {0x2, "GLOBAL"}, {0x4, "DOMAIN_LOCAL"}, {0x8, "UNIVERSAL"},
+		{0x10, "APP_BASIC"}, {0x20, "APP_QUERY"}, {0x80000000, "SECURITY"},
+	}
+	trustAttributeFlags = []flag{
+		{0x1, "NON_TRANSITIVE"}, {0x2, "UPLEVEL_ONLY"}, {0x4, "QUARANTINED_DOMAIN"},
+		{0x8, "FOREST_TRANSITIVE"}, {0x10, "CROSS_ORGANIZATION"}, {0x20, "WITHIN_FOREST"},
+		{0x40, "TREAT_AS_EXTERNAL"}, {0x80, "USES_RC4_ENCRYPTION"}, {0x200, "CROSS_ORGANIZATION_NO_TGT_DELEGATION"},
+		{0x400, "PIM_TRUST"},
+	}
+	encryptionTypeFlags = []flag{
+		{0x1, "DES_CBC_CRC"}, {0x2, "DES_CBC_MD5"}, {0x4, "RC4_HMAC"},
+		{0x8, "AES128_CTS_HMAC_SHA1_96"}, {0x10, "AES256_CTS_HMAC_SHA1_96"},
+	}
+	trustDirections = []string{"disabled", "inbound", "outbound", "bidirectional"}
+	trustTypes      = []string{"", "downlevel", "uplevel", "mit", "dce"}
+)
+
+// formatFlags - The names of the flags set in an integer attribute, bits without
+// a name are kept as hex (groupType is signed, so it's parsed as 32 bits)
+func formatFlags(value string, flags []flag) []string {
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return []string{value}
+	}
+	bits := uint32(parsed)
+	names := []string{}
+	for _, flag := range flags {
+		if bits&flag.bit != 0 {
+			names = append(names, flag.name)
+			bits &^= flag.bit
+		}
+	}
+	if bits != 0 {
+		names = append(names, fmt.Sprintf("0x%x", bits))
+	}
+	return names
+}
+
+func formatEnum(value string, names []string) string {
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 || len(names) <= index || names[index] == "" {
+		return value
+	}
+	return names[index]
+}
+
+// splitUsername - The user and domain of user@domain or DOMAIN\user
+func splitUsername(username string) (string, string) {
+	if domain, user, ok := strings.Cut(username, `\`); ok {
+		return user, domain
+	}
+	if user, domain, ok := strings.Cut(username, "@"); ok {
+		return user, domain
+	}
+	return username, ""
+}
@@ -0,0 +1,92 @@
+//go:build !windows
+
+package ad
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/ldap"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	// ErrNoCredentials - Only Windows implants can bind as their own user
+	ErrNoCredentials = errors.New("a username and password are required on this platform")
+
+	// ErrNoServer - The domain controller can't be found without a domain
+	ErrNoServer = errors.New("a server or domain is required on this platform")
+)
+
+// connect - Simple bind to a domain controller with the request's credentials, over
+// ldaps or StartTLS since the password is sent as is
+func connect(req *sliverpb.ADEnumReq, timeout time.Duration) (searcher, string, error) {
+	if req.Username == "" {
+		return nil, "", ErrNoCredentials
+	}
+	server := req.Server
+	if server == "" {
+		domain := req.Domain
+		if domain == "" {
+			_, domain = splitUsername(req.Username)
+		}
+		if domain == "" || !strings.Contains(domain, ".") {
+			return nil, "", ErrNoServer
+		}
+		server = domainController(domain)
+	}
+	config := &tls.Config{InsecureSkipVerify: req.InsecureSkipVerify}
+	var conn *ldap.Conn
+	var err error
+	if req.LDAPS {
+		conn, err = ldap.Dial(server, config, timeout)
+	} else {
+		conn, err = ldap.Dial(server, nil, timeout)
+		if err == nil {
+			err = conn.StartTLS(config)
+			if err != nil {
+				conn.Close()
+			}
+		}
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	err = conn.Bind(req.Username, req.Password)
+	if err != nil {
+		conn.Close()
+		return nil, "", err
+	}
+	return conn, server, nil
+}
+
+// domainController - A domain controller from the domain's SRV records, or the
+// domain itself since its A records are those of the domain controllers
+func domainController(domain string) string {
+	_, records, err := net.LookupSRV("ldap", "tcp", "dc._msdcs."+domain)
+	if err != nil || len(records) == 0 {
+		return domain
+	}
+	return strings.TrimSuffix(records[0].Target, ".")
+}
@@ -0,0 +1,141 @@
+package ad
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bishopfox/sliver/implant/sliver/ldap"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+type fakeSearcher struct {
+	baseDN  string
+	filter  string
+	entries []*ldap.Entry
+}
+
+func (f *fakeSearcher) RootDSE(attributes ...string) (*ldap.Entry, error) {
+	return &ldap.Entry{Attributes: map[string][][]byte{"defaultNamingContext": {[]byte("DC=corp,DC=local")}}}, nil
+}
+
+func (f *fakeSearcher) Search(baseDN string, filter ldap.Filter, attributes []string, limit int) ([]*ldap.Entry, error) {
+	f.baseDN, f.filter = baseDN, filter.String()
+	if 0 < limit && limit < len(f.entries) {
+		return f.entries[:limit], ldap.ErrTruncated
+	}
+	return f.entries, nil
+}
+
+func (f *fakeSearcher) Close() error {
+	return nil
+}
+
+func TestEnumerate(t *testing.T) {
+	client := &fakeSearcher{entries: []*ldap.Entry{
+		{DN: "CN=svc_sql,CN=Users,DC=corp,DC=local", Attributes: map[string][][]byte{
+			"sAMAccountName":       {[]byte("svc_sql")},
+			"servicePrincipalName": {[]byte("MSSQLSvc/db01.corp.local:1433")},
+			"userAccountControl":   {[]byte("66048")},
+			"pwdLastSet":           {[]byte("133000000000000000")},
+		}},
+		{DN: "CN=krbtgt,CN=Users,DC=corp,DC=local", Attributes: map[string][][]byte{
+			"sAMAccountName": {[]byte("Administrator")},
+		}},
+	}}
+	adEnum, err := enumerate(client, &sliverpb.ADEnumReq{Query: sliverpb.ADQuerySPNs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.baseDN != "DC=corp,DC=local" || adEnum.Domain != "corp.local" || adEnum.Truncated {
+		t.Fatalf("Unexpected result %v", adEnum)
+	}
+	if client.filter != "(&(objectCategory=person)(objectClass=user)(servicePrincipalName=*)(!(userAccountControl:1.2.840.113556.1.4.803:=2)))" {
+		t.Errorf("Unexpected filter %s", client.filter)
+	}
+	if len(adEnum.Objects) != 2 || adEnum.Objects[0].Name != "Administrator" {
+		t.Fatalf("Expected objects sorted by name, got %v", adEnum.Objects)
+	}
+	attributes := map[string][]string{}
+	for _, attribute := range adEnum.Objects[1].Attributes {
+		attributes[attribute.Name] = attribute.Values
+	}
+	if uac := attributes["userAccountControl"]; len(uac) != 2 || uac[0] != "NORMAL_ACCOUNT" || uac[1] != "DONT_EXPIRE_PASSWORD" {
+		t.Errorf("Unexpected userAccountControl %v", uac)
+	}
+	if pwdLastSet := attributes["pwdLastSet"]; len(pwdLastSet) != 1 || pwdLastSet[0] != "2022-06-18T04:26:40Z" {
+		t.Errorf("Unexpected pwdLastSet %v", pwdLastSet)
+	}
+
+	adEnum, err = enumerate(client, &sliverpb.ADEnumReq{Query: sliverpb.ADQuerySPNs, Domain: "child.corp.local", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.baseDN != "DC=child,DC=corp,DC=local" || !adEnum.Truncated || len(adEnum.Objects) != 1 {
+		t.Errorf("Unexpected truncated result %v", adEnum)
+	}
+}
+
+func TestFormatValues(t *testing.T) {
+	sid := []byte{1, 5, 0, 0, 0, 0, 0, 5, 21, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0, 0xf4, 0x01, 0, 0}
+	for _, test := range []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{"objectSid", string(sid), []string{"S-1-5-21-1-2-3-500"}},
+		{"groupType", "-2147483646", []string{"GLOBAL", "SECURITY"}},
+		{"trustDirection", "3", []string{"bidirectional"}},
+		{"trustType", "7", []string{"7"}},
+		{"trustAttributes", "4104", []string{"FOREST_TRANSITIVE", "0x1000"}},
+		{"lastLogonTimestamp", "0", []string{"never"}},
+		{"whenChanged", "20230102150405.0Z", []string{"2023-01-02T15:04:05Z"}},
+		{"description", "\xff\xfe", []string{"fffe"}},
+	} {
+		values := formatValues(test.name, [][]byte{[]byte(test.value)})
+		if len(values) != len(test.expected) {
+			t.Errorf("Expected %v for %s, got %v", test.expected, test.name, values)
+			continue
+		}
+		for i := range values {
+			if values[i] != test.expected[i] {
+				t.Errorf("Expected %v for %s, got %v", test.expected, test.name, values)
+			}
+		}
+	}
+}
+
+func TestSplitUsername(t *testing.T) {
+	for username, expected := range map[string][2]string{
+		`CORP\alice`:       {"alice", "CORP"},
+		"alice@corp.local": {"alice", "corp.local"},
+		"alice":            {"alice", ""},
+	} {
+		if user, domain := splitUsername(username); user != expected[0] || domain != expected[1] {
+			t.Errorf("Expected %v for %s, got %s %s", expected, username, user, domain)
+		}
+	}
+}
+
+func TestUnknownQuery(t *testing.T) {
+	if _, err := Enumerate(&sliverpb.ADEnumReq{Query: "computers"}); err == nil || errors.Is(err, ldap.ErrTruncated) {
+		t.Errorf("Expected an unknown query to fail, got %v", err)
+	}
+}
@@ -0,0 +1,281 @@
+package ad
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"runtime"
+	"time"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/ldap"
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/sys/windows"
+)
+
+// pageSize - Number of entries of each page of a search
+const pageSize = 500
+
+// wldapClient - A connection of the system's LDAP library, which can bind with the
+// implant's own credentials
+type wldapClient struct {
+	ld      uintptr
+	timeout *syscalls.LDAP_TIMEVAL
+}
+
+// connect - Bind to the request's server, or a domain controller of the request's
+// domain or the implant's domain. Without a username the implant binds as its
+// user (or the user it impersonates) using negotiate.
+func connect(req *sliverpb.ADEnumReq, timeout time.Duration) (searcher, string, error) {
+	var host *uint16
+	target := req.Server
+	if target == "" {
+		target = req.Domain
+	}
+	if target != "" {
+		var err error
+		host, err = windows.UTF16PtrFromString(target)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	var ld uintptr
+	if req.LDAPS {
+		ld = syscalls.LdapSslInit(host, syscalls.LDAP_SSL_PORT, 1)
+	} else {
+		ld = syscalls.LdapInit(host, syscalls.LDAP_PORT)
+	}
+	if ld == 0 {
+		return nil, "", ldapError(syscalls.LdapGetLastError())
+	}
+	client := &wldapClient{ld: ld, timeout: &syscalls.LDAP_TIMEVAL{Sec: int32(timeout / time.Second)}}
+	err := client.bind(req)
+	if err != nil {
+		client.Close()
+		return nil, "", err
+	}
+	return client, client.hostName(), nil
+}
+
+func (c *wldapClient) bind(req *sliverpb.ADEnumReq) error {
+	version, off, on := uint32(syscalls.LDAP_VERSION3), uint32(syscalls.LDAP_OPT_OFF), uint32(syscalls.LDAP_OPT_ON)
+	options := map[int32]*uint32{
+		syscalls.LDAP_OPT_PROTOCOL_VERSION: &version,
+		syscalls.LDAP_OPT_REFERRALS:        &off,
+	}
+	if !req.LDAPS {
+		options[syscalls.LDAP_OPT_SIGN] = &on // Domain controllers may require signing
+	}
+	for option, value := range options {
+		if code := syscalls.LdapSetOption(c.ld, option, unsafe.Pointer(value)); code != syscalls.LDAP_SUCCESS {
+			return ldapError(code)
+		}
+	}
+	if code := syscalls.LdapConnect(c.ld, c.timeout); code != syscalls.LDAP_SUCCESS {
+		return ldapError(code)
+	}
+
+	if req.Username == "" {
+		return ldapError(syscalls.LdapBindS(c.ld, nil, nil, syscalls.LDAP_AUTH_NEGOTIATE))
+	}
+	user, domain := splitUsername(req.Username)
+	identity := &syscalls.SEC_WINNT_AUTH_IDENTITY_W{Flags: syscalls.SEC_WINNT_AUTH_IDENTITY_UNICODE}
+	var err error
+	identity.User, identity.UserLength, err = utf16Length(user)
+	if err != nil {
+		return err
+	}
+	identity.Domain, identity.DomainLength, err = utf16Length(domain)
+	if err != nil {
+		return err
+	}
+	identity.Password, identity.PasswordLength, err = utf16Length(req.Password)
+	if err != nil {
+		return err
+	}
+	return ldapError(syscalls.LdapBindS(c.ld, nil, unsafe.Pointer(identity), syscalls.LDAP_AUTH_NEGOTIATE))
+}
+
+// hostName - The domain controller the client is connected to
+func (c *wldapClient) hostName() string {
+	var host *uint16 // Owned by the connection, so it isn't freed
+	if syscalls.LdapGetOption(c.ld, syscalls.LDAP_OPT_HOST_NAME, unsafe.Pointer(&host)) != syscalls.LDAP_SUCCESS || host == nil {
+		return ""
+	}
+	return windows.UTF16PtrToString(host)
+}
+
+// RootDSE - Read the rootDSE
+func (c *wldapClient) RootDSE(attributes ...string) (*ldap.Entry, error) {
+	attributeList, err := attributeList(attributes)
+	if err != nil {
+		return nil, err
+	}
+	base, _ := windows.UTF16PtrFromString("")
+	filter, _ := windows.UTF16PtrFromString(ldap.Present("objectClass").String())
+	var res uintptr
+	code := syscalls.LdapSearchS(c.ld, base, syscalls.LDAP_SCOPE_BASE, filter, &attributeList[0], 0, &res)
+	if res != 0 {
+		defer syscalls.LdapMsgfree(res)
+	}
+	if code != syscalls.LDAP_SUCCESS {
+		return nil, ldapError(code)
+	}
+	entries := c.entries(res)
+	if len(entries) == 0 {
+		return nil, ldap.ErrNoEntry
+	}
+	return entries[0], nil
+}
+
+// Search - Paged subtree search, like ldap.Conn's
+func (c *wldapClient) Search(baseDN string, filter ldap.Filter, attributes []string, limit int) ([]*ldap.Entry, error) {
+	attributeList, err := attributeList(attributes)
+	if err != nil {
+		return nil, err
+	}
+	base, err := windows.UTF16PtrFromString(baseDN)
+	if err != nil {
+		return nil, err
+	}
+	filterString, err := windows.UTF16PtrFromString(filter.String())
+	if err != nil {
+		return nil, err
+	}
+	search := syscalls.LdapSearchInitPage(c.ld, base, syscalls.LDAP_SCOPE_SUBTREE, filterString, &attributeList[0], 0, 0, 0, 0, 0, 0)
+	if search == 0 {
+		return nil, ldapError(syscalls.LdapGetLastError())
+	}
+	defer syscalls.LdapSearchAbandonPage(c.ld, search)
+	// The search may refer to the base, filter, and attributes until it's abandoned
+	defer runtime.KeepAlive(attributeList)
+	defer runtime.KeepAlive(base)
+	defer runtime.KeepAlive(filterString)
+
+	entries := []*ldap.Entry{}
+	for {
+		var res uintptr
+		var total uint32
+		code := syscalls.LdapGetNextPageS(c.ld, search, c.timeout, pageSize, &total, &res)
+		if res != 0 {
+			entries = append(entries, c.entries(res)...)
+			syscalls.LdapMsgfree(res)
+		}
+		if 0 < limit && limit < len(entries) {
+			return entries[:limit], ldap.ErrTruncated
+		}
+		switch code {
+		case syscalls.LDAP_SUCCESS:
+		case syscalls.LDAP_NO_RESULTS_RETURNED:
+			return entries, nil
+		case syscalls.LDAP_SIZELIMIT_EXCEEDED:
+			return entries, ldap.ErrTruncated
+		default:
+			return entries, ldapError(code)
+		}
+	}
+}
+
+// Close - Unbind and free the connection
+func (c *wldapClient) Close() error {
+	return ldapError(syscalls.LdapUnbind(c.ld))
+}
+
+// entries - The entries of a search result message
+func (c *wldapClient) entries(res uintptr) []*ldap.Entry {
+	entries := []*ldap.Entry{}
+	for entry := syscalls.LdapFirstEntry(c.ld, res); entry != 0; entry = syscalls.LdapNextEntry(c.ld, entry) {
+		result := &ldap.Entry{Attributes: map[string][][]byte{}}
+		if dn := syscalls.LdapGetDn(c.ld, entry); dn != 0 {
+			result.DN = utf16At(dn)
+			syscalls.LdapMemfree(dn)
+		}
+		var ber uintptr
+		for attribute := syscalls.LdapFirstAttribute(c.ld, entry, &ber); attribute != 0; attribute = syscalls.LdapNextAttribute(c.ld, entry, ber) {
+			name := utf16At(attribute)
+			syscalls.LdapMemfree(attribute)
+			namePtr, err := windows.UTF16PtrFromString(name)
+			if err != nil {
+				continue
+			}
+			values := syscalls.LdapGetValuesLen(c.ld, entry, namePtr)
+			if values != 0 {
+				result.Attributes[name] = bervalsAt(values, syscalls.LdapCountValuesLen(values))
+				syscalls.LdapValueFreeLen(values)
+			}
+		}
+		if ber != 0 {
+			syscalls.BerFree(ber, 0)
+		}
+		entries = append(entries, result)
+	}
+	return entries
+}
+
+// ldapError - The error of a result code, nil on success
+func ldapError(code uint32) error {
+	if code == syscalls.LDAP_SUCCESS {
+		return nil
+	}
+	return &ldap.ResultError{Code: int(code), Message: utf16At(syscalls.LdapErr2String(code))}
+}
+
+// attributeList - A null terminated array of attribute names
+func attributeList(attributes []string) ([]*uint16, error) {
+	list := []*uint16{}
+	for _, attribute := range attributes {
+		name, err := windows.UTF16PtrFromString(attribute)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, name)
+	}
+	return append(list, nil), nil
+}
+
+func utf16Length(value string) (*uint16, uint32, error) {
+	if value == "" {
+		return nil, 0, nil
+	}
+	encoded, err := windows.UTF16FromString(value)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &encoded[0], uint32(len(encoded) - 1), nil
+}
+
+// The strings and values returned by wldap32 are allocated by it rather than Go,
+// so their addresses are reinterpreted as pointers instead of being converted
+// (which go vet can't tell apart from converting the address of Go memory)
+
+func utf16At(address uintptr) string {
+	if address == 0 {
+		return ""
+	}
+	return windows.UTF16PtrToString(*(**uint16)(unsafe.Pointer(&address)))
+}
+
+// bervalsAt - Copies of the values of a berval array
+func bervalsAt(address uintptr, count uint32) [][]byte {
+	values := [][]byte{}
+	for _, berval := range unsafe.Slice(*(***syscalls.LDAP_BERVAL)(unsafe.Pointer(&address)), count) {
+		values = append(values, append([]byte{}, unsafe.Slice(berval.Val, berval.Len)...))
+	}
+	return values
+}
@@ -0,0 +1,213 @@
+package chunks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ackTimeout - How long to wait for the server to acknowledge a chunk
+	ackTimeout = 60 * time.Second
+)
+
+var (
+	transferID = uint64(0)
+
+	// outgoing - Transfer ID -> chan *sliverpb.ChunkAck of the envelopes being sent
+	outgoing = &sync.Map{}
+
+	mutex    = &sync.Mutex{}
+	incoming = map[uint64]*incomingTransfer{}
+
+	// ErrTransferCanceled - The server canceled a chunked transfer
+	ErrTransferCanceled = errors.New("server canceled the transfer")
+	// ErrAckTimeout - The server didn't acknowledge the chunks in time
+	ErrAckTimeout = errors.New("timeout waiting for chunk acknowledgement")
+)
+
+type incomingTransfer struct {
+	envelope *sliverpb.Envelope
+	received uint32
+	count    uint32
+	chunks   map[uint32]bool
+}
+
+// chunkCount - Number of chunks data of the size is split into
+func chunkCount(size uint64) uint32 {
+	return uint32((size + sliverpb.ChunkSize - 1) / sliverpb.ChunkSize)
+}
+
+// chunkBounds - The part of the data of the size that the chunk holds
+func chunkBounds(index uint32, size uint64) (uint64, uint64) {
+	start := uint64(index) * sliverpb.ChunkSize
+	end := start + sliverpb.ChunkSize
+	if size < end {
+		end = size
+	}
+	if size < start {
+		start = size
+	}
+	return start, end
+}
+
+// Send - Send an envelope, as chunks if its data is larger than ChunkSize. Blocks
+// while ChunkWindow chunks are waiting to be acknowledged, and returns an error if
+// the server cancels the transfer or stops acknowledging it.
+func Send(connection chan<- *sliverpb.Envelope, envelope *sliverpb.Envelope) error {
+	size := uint64(len(envelope.Data))
+	if size <= sliverpb.ChunkSize || sliverpb.MaxChunkedSize < size {
+		connection <- envelope
+		return nil
+	}
+	id := atomic.AddUint64(&transferID, 1)
+	acks := make(chan *sliverpb.ChunkAck, sliverpb.ChunkWindow)
+	outgoing.Store(id, acks)
+	defer outgoing.Delete(id)
+
+	count := chunkCount(size)
+	acknowledged := uint32(0)
+	for index := uint32(0); index < count; index++ {
+		for sliverpb.ChunkWindow <= index-acknowledged {
+			select {
+			case ack := <-acks:
+				if ack.Cancel {
+					// {{if .Config.Debug}}
+					log.Printf("[chunks] server canceled transfer %d", id)
+					// {{end}}
+					return ErrTransferCanceled
+				}
+				if acknowledged < ack.Received {
+					acknowledged = ack.Received
+				}
+			case <-time.After(ackTimeout):
+				// {{if .Config.Debug}}
+				log.Printf("[chunks] timeout waiting for transfer %d", id)
+				// {{end}}
+				return ErrAckTimeout
+			}
+		}
+		start, end := chunkBounds(index, size)
+		data, err := proto.Marshal(&sliverpb.Chunk{
+			TransferID:   id,
+			Index:        index,
+			Size:         size,
+			EnvelopeID:   envelope.ID,
+			EnvelopeType: envelope.Type,
+			Data:         envelope.Data[start:end],
+		})
+		if err != nil {
+			return err
+		}
+		connection <- &sliverpb.Envelope{Type: sliverpb.MsgChunk, Data: data}
+	}
+	return nil
+}
+
+// Ack - Deliver the server's acknowledgement to the transfer it's for
+func Ack(data []byte) {
+	ack := &sliverpb.ChunkAck{}
+	err := proto.Unmarshal(data, ack)
+	if err != nil {
+		return
+	}
+	if acks, ok := outgoing.Load(ack.TransferID); ok {
+		select {
+		case acks.(chan *sliverpb.ChunkAck) <- ack:
+		default:
+		}
+	}
+}
+
+// Receive - Add a chunk from the server to its transfer and acknowledge it, returns
+// the envelope once every chunk was received
+func Receive(connection chan<- *sliverpb.Envelope, data []byte) *sliverpb.Envelope {
+	chunk := &sliverpb.Chunk{}
+	err := proto.Unmarshal(data, chunk)
+	if err != nil {
+		return nil
+	}
+	envelope, ack := receive(chunk)
+	data, err = proto.Marshal(ack)
+	if err == nil {
+		connection <- &sliverpb.Envelope{Type: sliverpb.MsgChunkAck, Data: data}
+	}
+	return envelope
+}
+
+func receive(chunk *sliverpb.Chunk) (*sliverpb.Envelope, *sliverpb.ChunkAck) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	cancel := &sliverpb.ChunkAck{TransferID: chunk.TransferID, Cancel: true}
+	transfer, ok := incoming[chunk.TransferID]
+	if !ok {
+		if sliverpb.MaxChunkedSize < chunk.Size {
+			return nil, cancel
+		}
+		transfer = &incomingTransfer{
+			envelope: &sliverpb.Envelope{
+				ID:   chunk.EnvelopeID,
+				Type: chunk.EnvelopeType,
+				Data: make([]byte, chunk.Size),
+			},
+			count:  chunkCount(chunk.Size),
+			chunks: map[uint32]bool{},
+		}
+		incoming[chunk.TransferID] = transfer
+	}
+
+	start, end := chunkBounds(chunk.Index, chunk.Size)
+	valid := chunk.Index < transfer.count && !transfer.chunks[chunk.Index] &&
+		uint64(len(transfer.envelope.Data)) == chunk.Size && uint64(len(chunk.Data)) == end-start
+	if !valid {
+		// {{if .Config.Debug}}
+		log.Printf("[chunks] invalid chunk %d of transfer %d", chunk.Index, chunk.TransferID)
+		// {{end}}
+		delete(incoming, chunk.TransferID)
+		return nil, cancel
+	}
+	copy(transfer.envelope.Data[start:end], chunk.Data)
+	transfer.chunks[chunk.Index] = true
+	transfer.received++
+
+	ack := &sliverpb.ChunkAck{TransferID: chunk.TransferID, Received: transfer.received}
+	if transfer.received < transfer.count {
+		return nil, ack
+	}
+	delete(incoming, chunk.TransferID)
+	return transfer.envelope, ack
+}
+
+// Reset - Drop the incomplete transfers of a session that ended
+func Reset() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	incoming = map[uint64]*incomingTransfer{}
+}
@@ -0,0 +1,113 @@
+package chunks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSendSmall(t *testing.T) {
+	connection := make(chan *sliverpb.Envelope, 1)
+	envelope := &sliverpb.Envelope{ID: 1, Data: []byte("hello")}
+	Send(connection, envelope)
+	if <-connection != envelope {
+		t.Fatal("expected the envelope to be sent as is")
+	}
+}
+
+func TestSendReceive(t *testing.T) {
+	defer Reset()
+	data := make([]byte, (sliverpb.ChunkWindow+1)*sliverpb.ChunkSize+1)
+	rand.Read(data)
+
+	// The sender's chunks are received and acknowledged by the other end
+	toReceiver := make(chan *sliverpb.Envelope)
+	toSender := make(chan *sliverpb.Envelope, 100)
+	go func() {
+		Send(toReceiver, &sliverpb.Envelope{ID: 7, Type: sliverpb.MsgDownload, Data: data})
+		close(toReceiver)
+	}()
+	go func() {
+		for ack := range toSender {
+			Ack(ack.Data)
+		}
+	}()
+	var envelope *sliverpb.Envelope
+	chunks := 0
+	for chunk := range toReceiver {
+		if chunk.Type != sliverpb.MsgChunk {
+			t.Fatalf("unexpected envelope type %d", chunk.Type)
+		}
+		chunks++
+		if received := Receive(toSender, chunk.Data); received != nil {
+			envelope = received
+		}
+	}
+	close(toSender)
+	if chunks != sliverpb.ChunkWindow+2 {
+		t.Fatalf("unexpected number of chunks %d", chunks)
+	}
+	if envelope == nil || envelope.ID != 7 || envelope.Type != sliverpb.MsgDownload || !bytes.Equal(envelope.Data, data) {
+		t.Fatal("reassembled envelope doesn't match")
+	}
+}
+
+func TestSendCanceled(t *testing.T) {
+	data := make([]byte, (sliverpb.ChunkWindow+1)*sliverpb.ChunkSize)
+	connection := make(chan *sliverpb.Envelope, sliverpb.ChunkWindow)
+	result := make(chan error)
+	go func() {
+		result <- Send(connection, &sliverpb.Envelope{ID: 7, Data: data})
+	}()
+	chunk := &sliverpb.Chunk{}
+	proto.Unmarshal((<-connection).Data, chunk)
+	cancel, _ := proto.Marshal(&sliverpb.ChunkAck{TransferID: chunk.TransferID, Cancel: true})
+	for {
+		select {
+		case <-connection:
+			continue
+		case err := <-result:
+			if err != ErrTransferCanceled {
+				t.Fatalf("expected %v, got %v", ErrTransferCanceled, err)
+			}
+			return
+		default:
+			Ack(cancel)
+		}
+	}
+}
+
+func TestReceiveInvalid(t *testing.T) {
+	defer Reset()
+	size := uint64(2*sliverpb.ChunkSize + 1)
+	if envelope, ack := receive(&sliverpb.Chunk{TransferID: 1, Index: 0, Size: size, Data: []byte{1}}); envelope != nil || !ack.Cancel {
+		t.Fatal("expected a short chunk to cancel the transfer")
+	}
+	if envelope, ack := receive(&sliverpb.Chunk{TransferID: 2, Index: 3, Size: size, Data: []byte{1}}); envelope != nil || !ack.Cancel {
+		t.Fatal("expected an out of range chunk to cancel the transfer")
+	}
+	if len(incoming) != 0 {
+		t.Fatal("expected canceled transfers to be removed")
+	}
+}
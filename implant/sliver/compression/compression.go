@@ -0,0 +1,93 @@
+package compression
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+
+	// {{if .Config.CompressionEnabled}}
+	"github.com/klauspost/compress/zstd"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	// Enabled - Set if the implant was generated with compression, the server only
+	// compresses the envelopes it sends us if we tell it when we register
+	Enabled = false
+
+	// ErrNotEnabled - Received a compressed envelope without compression
+	ErrNotEnabled = errors.New("compression is not enabled")
+
+	// {{if .Config.CompressionEnabled}}
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+	// {{end}}
+)
+
+func init() {
+	// {{if .Config.CompressionEnabled}}
+	encoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithLowerEncoderMem(true))
+	decoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true),
+		zstd.WithDecoderMaxMemory(sliverpb.MaxDecompressedSize))
+	Enabled = true
+	// {{end}}
+}
+
+// Compress - Returns a copy of the envelope with zstd compressed data if compression is
+// enabled and the data is at least CompressionThreshold bytes, otherwise (or if compressing
+// doesn't make it smaller) the envelope itself
+func Compress(envelope *sliverpb.Envelope) *sliverpb.Envelope {
+	if !Enabled || envelope.Compressed || len(envelope.Data) < sliverpb.CompressionThreshold {
+		return envelope
+	}
+	// {{if .Config.CompressionEnabled}}
+	data := encoder.EncodeAll(envelope.Data, make([]byte, 0, len(envelope.Data)))
+	if len(data) < len(envelope.Data) {
+		return &sliverpb.Envelope{
+			ID:                 envelope.ID,
+			Type:               envelope.Type,
+			Data:               data,
+			UnknownMessageType: envelope.UnknownMessageType,
+			Compressed:         true,
+		}
+	}
+	// {{end}}
+	return envelope
+}
+
+// Decompress - Decompress the data of a compressed envelope in place
+func Decompress(envelope *sliverpb.Envelope) error {
+	if !envelope.Compressed {
+		return nil
+	}
+	if !Enabled {
+		return ErrNotEnabled
+	}
+	// {{if .Config.CompressionEnabled}}
+	data, err := decoder.DecodeAll(envelope.Data, nil)
+	if err != nil {
+		return err
+	}
+	envelope.Data = data
+	envelope.Compressed = false
+	// {{end}}
+	return nil
+}
@@ -0,0 +1,66 @@
+package compression
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestCompress(t *testing.T) {
+	data := bytes.Repeat([]byte("drwxr-xr-x  root  root  4.0 KiB  Mon Jan 01 00:00:00 2024  bin\n"), 200)
+	envelope := &sliverpb.Envelope{ID: 7, Type: sliverpb.MsgLs, Data: data}
+	compressed := Compress(envelope)
+	if !compressed.Compressed || len(data) <= len(compressed.Data) {
+		t.Fatal("expected the envelope to be compressed")
+	}
+	if envelope.Compressed || !bytes.Equal(envelope.Data, data) {
+		t.Fatal("compress modified the original envelope")
+	}
+	err := Decompress(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compressed.Compressed || compressed.ID != 7 || compressed.Type != sliverpb.MsgLs || !bytes.Equal(compressed.Data, data) {
+		t.Fatal("decompressed envelope doesn't match")
+	}
+}
+
+func TestCompressSkipped(t *testing.T) {
+	small := &sliverpb.Envelope{Data: bytes.Repeat([]byte("a"), sliverpb.CompressionThreshold-1)}
+	if Compress(small) != small {
+		t.Fatal("expected small envelopes not to be compressed")
+	}
+	random := make([]byte, 4*sliverpb.CompressionThreshold)
+	rand.Read(random)
+	incompressible := &sliverpb.Envelope{Data: random}
+	if Compress(incompressible) != incompressible {
+		t.Fatal("expected incompressible envelopes not to be compressed")
+	}
+}
+
+func TestDecompressInvalid(t *testing.T) {
+	err := Decompress(&sliverpb.Envelope{Data: []byte("not zstd"), Compressed: true})
+	if err == nil {
+		t.Fatal("expected invalid data to fail")
+	}
+}
@@ -25,9 +25,12 @@ var (
 	SliverName = `{{.Name}}`
 )
 
-// Message - Fake message for embedding canaries
+// Message - Fake message for embedding canaries, and the build's artifact marker
 type Message struct {
 	Command string `c2:"[[GenerateCanary]]"`
+	// {{if .Config.Marker}}
+	Revision string `{{ArtifactMarkerTag}}`
+	// {{end}}
 }
 
 // never obfuscate the Message type
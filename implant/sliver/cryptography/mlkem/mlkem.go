@@ -0,0 +1,122 @@
+// Package mlkem wraps circl's ML-KEM-768 key encapsulation mechanism (FIPS 203),
+// the post-quantum half of the hybrid session key exchange. It's used by both the
+// implant and the server, so it must not contain any implant template directives.
+package mlkem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"github.com/cloudflare/circl/kem/mlkem/mlkem768"
+)
+
+const (
+	// SeedSize - Size of a decapsulation key seed (d || z)
+	SeedSize = mlkem768.KeySeedSize
+	// EncapsulationKeySize - Size of an encoded encapsulation key
+	EncapsulationKeySize = mlkem768.PublicKeySize
+	// CiphertextSize - Size of a ciphertext
+	CiphertextSize = mlkem768.CiphertextSize
+	// SharedKeySize - Size of the shared key
+	SharedKeySize = mlkem768.SharedKeySize
+)
+
+var (
+	// ErrInvalidEncapsulationKey - The encapsulation key has the wrong size or isn't reduced
+	ErrInvalidEncapsulationKey = errors.New("invalid ml-kem encapsulation key")
+	// ErrInvalidCiphertext - The ciphertext has the wrong size
+	ErrInvalidCiphertext = errors.New("invalid ml-kem ciphertext")
+	// ErrInvalidSeed - The seed has the wrong size
+	ErrInvalidSeed = errors.New("invalid ml-kem seed")
+)
+
+// DecapsulationKey - An ML-KEM-768 decapsulation (private) key
+type DecapsulationKey struct {
+	seed []byte
+	sk   *mlkem768.PrivateKey
+	ek   []byte
+}
+
+// GenerateKey - Generate a random decapsulation key
+func GenerateKey() (*DecapsulationKey, error) {
+	seed := make([]byte, SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return NewDecapsulationKey(seed)
+}
+
+// NewDecapsulationKey - Derive a decapsulation key from its seed (d || z)
+func NewDecapsulationKey(seed []byte) (*DecapsulationKey, error) {
+	if len(seed) != SeedSize {
+		return nil, ErrInvalidSeed
+	}
+	pk, sk := mlkem768.NewKeyFromSeed(seed)
+	dk := &DecapsulationKey{
+		seed: append([]byte{}, seed...),
+		sk:   sk,
+		ek:   make([]byte, EncapsulationKeySize),
+	}
+	pk.Pack(dk.ek)
+	return dk, nil
+}
+
+// Bytes - The seed of the key
+func (dk *DecapsulationKey) Bytes() []byte {
+	return append([]byte{}, dk.seed...)
+}
+
+// EncapsulationKey - The encoded encapsulation (public) key
+func (dk *DecapsulationKey) EncapsulationKey() []byte {
+	return append([]byte{}, dk.ek...)
+}
+
+// Decapsulate - The shared key of a ciphertext, an invalid ciphertext of the
+// right size results in a pseudorandom key (implicit rejection)
+func (dk *DecapsulationKey) Decapsulate(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) != CiphertextSize {
+		return nil, ErrInvalidCiphertext
+	}
+	sharedKey := make([]byte, SharedKeySize)
+	dk.sk.DecapsulateTo(sharedKey, ciphertext)
+	return sharedKey, nil
+}
+
+// Encapsulate - Generate a shared key and its ciphertext for an encoded encapsulation key
+func Encapsulate(encapsulationKey []byte) ([]byte, []byte, error) {
+	m := make([]byte, mlkem768.EncapsulationSeedSize)
+	if _, err := rand.Read(m); err != nil {
+		return nil, nil, err
+	}
+	return encapsulate(encapsulationKey, m)
+}
+
+// encapsulate - Encapsulate with the message m, which must be random outside of tests
+func encapsulate(encapsulationKey []byte, m []byte) ([]byte, []byte, error) {
+	pk := &mlkem768.PublicKey{}
+	if err := pk.Unpack(encapsulationKey); err != nil {
+		return nil, nil, ErrInvalidEncapsulationKey
+	}
+	sharedKey := make([]byte, SharedKeySize)
+	ciphertext := make([]byte, CiphertextSize)
+	pk.EncapsulateTo(ciphertext, sharedKey, m)
+	return sharedKey, ciphertext, nil
+}
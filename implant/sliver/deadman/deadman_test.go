@@ -0,0 +1,103 @@
+package deadman
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func setup(t *testing.T, lastContact time.Time) (string, *int) {
+	dir := t.TempDir()
+	stateFile = filepath.Join(dir, "state")
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(lastContact.Unix()))
+	os.WriteFile(stateFile, data, 0600)
+	loadState = sync.Once{}
+	lastSaved = time.Time{}
+	exitCode := -1
+	exit = func(code int) { exitCode = code }
+	return dir, &exitCode
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Now()
+	if expired(now.Add(-48*time.Hour), now, 0) {
+		t.Fatal("a zero limit should never expire")
+	}
+	if expired(now.Add(-23*time.Hour), now, 24*time.Hour) {
+		t.Fatal("expired before the limit")
+	}
+	if !expired(now.Add(-25*time.Hour), now, 24*time.Hour) {
+		t.Fatal("didn't expire after the limit")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	Enabled, days = true, "2"
+	defer func() { Enabled = false }()
+
+	dir, exitCode := setup(t, time.Now().Add(-24*time.Hour))
+	persistence := filepath.Join(dir, "persistence")
+	os.WriteFile(persistence, []byte("persistence"), 0600)
+	cleanup = pb.DeadManCleanupFile + persistence + "\n"
+	Check()
+	if *exitCode != -1 {
+		t.Fatal("exited before the timeout")
+	}
+
+	dir, exitCode = setup(t, time.Now().Add(-72*time.Hour))
+	persistence = filepath.Join(dir, "persistence")
+	os.WriteFile(persistence, []byte("persistence"), 0600)
+	cleanup = pb.DeadManCleanupFile + persistence + "\n"
+	Check()
+	if *exitCode != 0 {
+		t.Fatal("didn't exit after the timeout")
+	}
+	for _, path := range []string{persistence, stateFile} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("%s wasn't removed", path)
+		}
+	}
+}
+
+func TestContact(t *testing.T) {
+	Enabled, days, cleanup = true, "2", ""
+	defer func() { Enabled = false }()
+
+	_, exitCode := setup(t, time.Now().Add(-72*time.Hour))
+	Contact()
+	Check()
+	if *exitCode != -1 {
+		t.Fatal("exited after contact")
+	}
+
+	// The contact is persisted for the next run
+	loadState = sync.Once{}
+	Check()
+	if *exitCode != -1 {
+		t.Fatal("contact wasn't saved")
+	}
+}
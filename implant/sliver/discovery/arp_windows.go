@@ -0,0 +1,61 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+// arpTable - The entries of the ip net table (the ARP cache)
+func arpTable() ([]arpEntry, error) {
+	var size uint32
+	err := syscalls.GetIpNetTable(nil, &size, false)
+	if err != nil && !errors.Is(err, windows.ERROR_INSUFFICIENT_BUFFER) {
+		return nil, err
+	}
+	if size < 4 {
+		return []arpEntry{}, nil
+	}
+	table := make([]byte, size)
+	err = syscalls.GetIpNetTable(&table[0], &size, false)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []arpEntry{}
+	count := binary.LittleEndian.Uint32(table)
+	rowSize := uint32(unsafe.Sizeof(syscalls.MIB_IPNETROW{}))
+	for i := uint32(0); i < count && 4+(i+1)*rowSize <= uint32(len(table)); i++ {
+		row := (*syscalls.MIB_IPNETROW)(unsafe.Pointer(&table[4+i*rowSize]))
+		if row.Type == syscalls.MIB_IPNET_TYPE_INVALID || row.PhysAddrLen != 6 {
+			continue
+		}
+		entries = append(entries, arpEntry{
+			address: net.IP(row.Addr[:]).String(),
+			mac:     formatMAC(row.PhysAddr[:6]),
+		})
+	}
+	return entries, nil
+}
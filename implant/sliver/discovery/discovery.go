@@ -0,0 +1,433 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ldap"
+	"github.com/bishopfox/sliver/implant/sliver/scan"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// Sources of a discovered host, the method that found it
+const (
+	SourceARP     = "arp"
+	SourceNetBIOS = "netbios"
+	SourceMDNS    = "mdns"
+	SourceSMB     = "smb"
+	SourceLDAP    = "ldap"
+)
+
+// Roles of a discovered host
+const (
+	RoleDomainController = "domain-controller"
+	RoleFileServer       = "file-server"
+	RolePrintServer      = "print-server"
+	RoleLDAPServer       = "ldap-server"
+)
+
+// Types of a discovered share
+const (
+	ShareTypeDisk    = "disk"
+	ShareTypePrinter = "printer"
+	ShareTypeDevice  = "device"
+	ShareTypeIPC     = "ipc"
+)
+
+const (
+	netbiosPort = "137"
+	mdnsPort    = "5353"
+	smbPort     = "445"
+	ldapPort    = "389"
+
+	defaultTimeout = time.Second
+	concurrency    = 64
+
+	// maxLocalPrefix - Local networks larger than this are narrowed down to the /24
+	// of the implant's address, larger networks have to be targeted explicitly
+	maxLocalPrefix = 22
+
+	// MaxAddresses - The most addresses a single discovery can cover (a /20), each
+	// address is probed by every method so this is far less than a scan
+	MaxAddresses = 4096
+)
+
+var (
+	// ErrNoLocalNetworks - No targets were given and no interface has an IPv4 network
+	ErrNoLocalNetworks = errors.New("no local IPv4 networks")
+	// ErrTooManyAddresses - The targets cover more than MaxAddresses
+	ErrTooManyAddresses = fmt.Errorf("targets cover more than %d addresses", MaxAddresses)
+
+	errNotSupported = errors.New("not supported on this platform")
+	// errNoAnswer - The address didn't answer, which isn't worth a warning
+	errNoAnswer = errors.New("no answer")
+)
+
+// Discover - Find hosts on the network with each method of the request, the hosts
+// found by several methods are merged into one record per address
+func Discover(req *sliverpb.DiscoverReq) (*sliverpb.Discover, error) {
+	timeout := time.Duration(req.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	d := &discoverer{
+		timeout: timeout,
+		hosts:   map[string]*commonpb.DiscoveredHost{},
+	}
+
+	targets := req.Targets
+	if len(targets) == 0 {
+		targets = localNetworks()
+		if len(targets) == 0 && (req.Sweep || req.NetBIOS || req.MDNS || req.SMB || req.LDAP) {
+			return nil, ErrNoLocalNetworks
+		}
+	}
+	addresses := []string{}
+	if req.Sweep || req.NetBIOS || req.MDNS || req.SMB || req.LDAP {
+		var err error
+		addresses, err = scan.ExpandTargets(targets)
+		if err == scan.ErrTooManyAddresses || MaxAddresses < len(addresses) {
+			return nil, ErrTooManyAddresses
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Sweep {
+		for address, mac := range sweep(addresses, timeout) {
+			d.add(address, SourceARP, func(host *commonpb.DiscoveredHost) {
+				host.MAC = mac
+			})
+		}
+	}
+	if req.ARP || req.Sweep {
+		entries, err := arpTable()
+		if err != nil {
+			d.warn(SourceARP, err)
+		}
+		for _, entry := range entries {
+			mac := entry.mac
+			d.add(entry.address, SourceARP, func(host *commonpb.DiscoveredHost) {
+				host.MAC = mac
+			})
+		}
+	}
+	if req.NetBIOS {
+		d.each(SourceNetBIOS, addresses, d.netbios)
+	}
+	if req.MDNS {
+		d.each(SourceMDNS, addresses, d.mdns)
+	}
+	if req.SMB {
+		d.each(SourceSMB, addresses, d.smb)
+	}
+	if req.LDAP {
+		d.each(SourceLDAP, addresses, d.ldap)
+	}
+
+	hosts := []*commonpb.DiscoveredHost{}
+	for _, host := range d.hosts {
+		hosts = append(hosts, host)
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		return bytes.Compare(net.ParseIP(hosts[i].Address).To16(), net.ParseIP(hosts[j].Address).To16()) < 0
+	})
+	return &sliverpb.Discover{Hosts: hosts, Warnings: d.warnings}, nil
+}
+
+type discoverer struct {
+	timeout  time.Duration
+	mutex    sync.Mutex
+	hosts    map[string]*commonpb.DiscoveredHost
+	warnings []string
+}
+
+// add - Merge what a method found about an address into the address's record
+func (d *discoverer) add(address string, source string, update func(*commonpb.DiscoveredHost)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	host, ok := d.hosts[address]
+	if !ok {
+		host = &commonpb.DiscoveredHost{Address: address}
+		d.hosts[address] = host
+	}
+	host.Sources = appendUnique(host.Sources, source)
+	update(host)
+}
+
+func (d *discoverer) warn(source string, err error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.warnings = append(d.warnings, fmt.Sprintf("%s: %s", source, err))
+}
+
+// each - Run a method against every address, most addresses don't answer so only
+// the first of the other errors is a warning
+func (d *discoverer) each(source string, addresses []string, method func(string) error) {
+	var firstErr error
+	var failed int
+	var errMutex sync.Mutex
+	jobs := make(chan string)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < concurrency && i < len(addresses); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for address := range jobs {
+				err := method(address)
+				if err == nil || errors.Is(err, errNoAnswer) {
+					continue
+				}
+				// {{if .Config.Debug}}
+				log.Printf("[discovery] %s %s: %s", source, address, err)
+				// {{end}}
+				errMutex.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s %w", address, err)
+				}
+				failed++
+				errMutex.Unlock()
+			}
+		}()
+	}
+	for _, address := range addresses {
+		jobs <- address
+		errMutex.Lock()
+		notSupported := errors.Is(firstErr, errNotSupported)
+		errMutex.Unlock()
+		if notSupported {
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	switch {
+	case errors.Is(firstErr, errNotSupported):
+		d.warn(source, errNotSupported)
+	case 1 < failed:
+		d.warn(source, fmt.Errorf("%d addresses failed, e.g. %s", failed, firstErr))
+	case firstErr != nil:
+		d.warn(source, firstErr)
+	}
+}
+
+// netbios - Names, workgroup, and MAC address from a NetBIOS node status query
+func (d *discoverer) netbios(address string) error {
+	response, err := exchangeUDP(address, netbiosPort, nodeStatusRequest(queryID()), d.timeout)
+	if err != nil {
+		return err
+	}
+	status, err := parseNodeStatus(response)
+	if err != nil {
+		return err
+	}
+	d.add(address, SourceNetBIOS, func(host *commonpb.DiscoveredHost) {
+		if status.name != "" {
+			host.Hostnames = appendUnique(host.Hostnames, status.name)
+		}
+		if status.workgroup != "" {
+			host.Workgroup = status.workgroup
+		}
+		if status.mac != "" && host.MAC == "" {
+			host.MAC = status.mac
+		}
+		host.Roles = appendUnique(host.Roles, status.roles...)
+	})
+	return nil
+}
+
+// mdns - The hostname from a reverse lookup sent straight to the address's mDNS
+// responder (a "legacy unicast" query, which responders answer directly)
+func (d *discoverer) mdns(address string) error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil
+	}
+	id := queryID()
+	query, err := reverseQuery(ip, id)
+	if err != nil {
+		return err
+	}
+	response, err := exchangeUDP(address, mdnsPort, query, d.timeout)
+	if err != nil {
+		return err
+	}
+	names, err := parseReverseResponse(response, id)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return errNoAnswer
+	}
+	d.add(address, SourceMDNS, func(host *commonpb.DiscoveredHost) {
+		host.Hostnames = appendUnique(host.Hostnames, names...)
+	})
+	return nil
+}
+
+// smb - Shares and sessions of hosts with smb open
+func (d *discoverer) smb(address string) error {
+	if !isOpen(address, smbPort, d.timeout) {
+		return errNoAnswer
+	}
+	shares, sessions, err := smbEnum(address)
+	if err != nil {
+		return err
+	}
+	d.add(address, SourceSMB, func(host *commonpb.DiscoveredHost) {
+		host.Shares = shares
+		host.Sessions = sessions
+		for _, share := range shares {
+			switch {
+			case share.Type == ShareTypeDisk && !strings.HasSuffix(share.Name, "$"):
+				host.Roles = appendUnique(host.Roles, RoleFileServer)
+			case share.Type == ShareTypePrinter:
+				host.Roles = appendUnique(host.Roles, RolePrintServer)
+			}
+		}
+	})
+	return nil
+}
+
+// ldap - Domain and hostname of domain controllers from their rootDSE
+func (d *discoverer) ldap(address string) error {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(address, ldapPort), d.timeout)
+	if err != nil {
+		return errNoAnswer
+	}
+	client := ldap.NewConn(conn, d.timeout)
+	defer client.Close()
+	rootDSE, err := client.RootDSE("dnsHostName", "defaultNamingContext")
+	if err != nil {
+		return err
+	}
+	d.add(address, SourceLDAP, func(host *commonpb.DiscoveredHost) {
+		if hostname := rootDSE.First("dnsHostName"); hostname != "" {
+			host.Hostnames = appendUnique(host.Hostnames, hostname)
+		}
+		if domain := ldap.DomainName(rootDSE.First("defaultNamingContext")); domain != "" {
+			host.Workgroup = domain
+			host.Roles = appendUnique(host.Roles, RoleDomainController)
+		} else {
+			host.Roles = appendUnique(host.Roles, RoleLDAPServer)
+		}
+	})
+	return nil
+}
+
+// localNetworks - The IPv4 networks of the implant's interfaces
+func localNetworks() []string {
+	networks := []string{}
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return networks
+	}
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+				networks = appendUnique(networks, localNetwork(ipNet))
+			}
+		}
+	}
+	return networks
+}
+
+// localNetwork - The network of an interface address, no larger than maxLocalPrefix
+func localNetwork(ipNet *net.IPNet) string {
+	ones, _ := ipNet.Mask.Size()
+	if ones < maxLocalPrefix {
+		ones = 24
+	}
+	mask := net.CIDRMask(ones, 32)
+	return (&net.IPNet{IP: ipNet.IP.To4().Mask(mask), Mask: mask}).String()
+}
+
+// isOpen - A tcp port accepts connections
+func isOpen(address string, port string, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(address, port), timeout)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// exchangeUDP - Send a query and wait for the first response from the address
+func exchangeUDP(address string, port string, query []byte, timeout time.Duration) ([]byte, error) {
+	conn, err := net.Dial("udp", net.JoinHostPort(address, port))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+	_, err = conn.Write(query)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]byte, 2048)
+	n, err := conn.Read(response)
+	if err != nil {
+		return nil, errNoAnswer // Timed out, or refused (an icmp port unreachable)
+	}
+	return response[:n], nil
+}
+
+// queryID - A random id of a udp query
+func queryID() uint16 {
+	return uint16(rand.Intn(0x10000))
+}
+
+// appendUnique - Append the values that aren't in the list yet (ignoring case)
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if strings.EqualFold(existing, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
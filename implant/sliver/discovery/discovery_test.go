@@ -0,0 +1,143 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestNodeStatus(t *testing.T) {
+	request := nodeStatusRequest(0x1234)
+	if len(request) != 50 || binary.BigEndian.Uint16(request) != 0x1234 {
+		t.Fatalf("Unexpected request %x", request)
+	}
+	if !bytes.Equal(request[13:15], []byte("CK")) || request[45] != 0x00 || request[47] != nbstatType {
+		t.Fatalf("Unexpected wildcard name query %x", request)
+	}
+
+	// Response of a domain controller, the answer's name is a copy of the question's
+	response := append([]byte{}, request[:12]...)
+	binary.BigEndian.PutUint16(response[2:], 0x8400)
+	binary.BigEndian.PutUint16(response[4:], 0)
+	binary.BigEndian.PutUint16(response[6:], 1)
+	response = append(response, request[12:46]...)
+	response = append(response, 0x00, 0x21, 0x00, 0x01, 0, 0, 0, 0, 0x00, 0x00)
+	names := []struct {
+		name   string
+		suffix byte
+		flags  uint16
+	}{
+		{"DC01", 0x00, 0x0400},
+		{"CORP", 0x00, 0x8400},
+		{"DC01", 0x20, 0x0400},
+		{"CORP", 0x1c, 0x8400},
+	}
+	response = append(response, byte(len(names)))
+	for _, name := range names {
+		entry := []byte(name.name + "               ")[:15]
+		entry = append(entry, name.suffix)
+		response = binary.BigEndian.AppendUint16(append(response, entry...), name.flags)
+	}
+	response = append(response, 0x00, 0x15, 0x5d, 0x01, 0x02, 0x03)
+
+	status, err := parseNodeStatus(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.name != "DC01" || status.workgroup != "CORP" || status.mac != "00:15:5d:01:02:03" {
+		t.Errorf("Unexpected node status %+v", status)
+	}
+	if !reflect.DeepEqual(status.roles, []string{RoleFileServer, RoleDomainController}) {
+		t.Errorf("Unexpected roles %v", status.roles)
+	}
+	if _, err := parseNodeStatus(response[:60]); err == nil {
+		t.Error("Expected a truncated response to fail")
+	}
+}
+
+func TestReverseLookup(t *testing.T) {
+	query, err := reverseQuery(net.ParseIP("192.168.1.20"), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := dnsmessage.Message{}
+	if err := message.Unpack(query); err != nil {
+		t.Fatal(err)
+	}
+	if message.Questions[0].Name.String() != "20.1.168.192.in-addr.arpa." {
+		t.Fatalf("Unexpected question %s", message.Questions[0].Name)
+	}
+
+	message.Header.Response = true
+	name, _ := dnsmessage.NewName("macbook.local.")
+	message.Answers = []dnsmessage.Resource{{
+		Header: dnsmessage.ResourceHeader{Name: message.Questions[0].Name, Class: dnsmessage.ClassINET},
+		Body:   &dnsmessage.PTRResource{PTR: name},
+	}}
+	response, err := message.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := parseReverseResponse(response, 7)
+	if err != nil || !reflect.DeepEqual(names, []string{"macbook.local"}) {
+		t.Errorf("Unexpected names %v (%v)", names, err)
+	}
+	if _, err := parseReverseResponse(response, 8); !errors.Is(err, errNoAnswer) {
+		t.Errorf("Expected a response to another query to be ignored, got %v", err)
+	}
+}
+
+func TestLocalNetwork(t *testing.T) {
+	for cidr, expected := range map[string]string{
+		"192.168.1.20/24": "192.168.1.0/24",
+		"10.1.2.3/22":     "10.1.0.0/22",
+		"10.1.2.3/8":      "10.1.2.0/24",
+		"172.16.5.4/30":   "172.16.5.4/30",
+	} {
+		ip, ipNet, _ := net.ParseCIDR(cidr)
+		ipNet.IP = ip
+		if network := localNetwork(ipNet); network != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, cidr, network)
+		}
+	}
+}
+
+func TestAppendUnique(t *testing.T) {
+	list := appendUnique([]string{"DC01"}, "dc01", "dc01.corp.local", "dc01.corp.local")
+	if !reflect.DeepEqual(list, []string{"DC01", "dc01.corp.local"}) {
+		t.Errorf("Unexpected list %v", list)
+	}
+}
+
+func TestDiscoverTooManyAddresses(t *testing.T) {
+	for _, targets := range [][]string{{"10.0.0.0/19"}, {"10.0.0.0/8"}} {
+		_, err := Discover(&sliverpb.DiscoverReq{Targets: targets, NetBIOS: true})
+		if err != ErrTooManyAddresses {
+			t.Errorf("Expected too many addresses for %v, got %v", targets, err)
+		}
+	}
+}
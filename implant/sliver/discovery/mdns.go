@@ -0,0 +1,65 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// reverseQuery - A PTR query for the in-addr.arpa name of an IPv4 address
+func reverseQuery(ip net.IP, id uint16) ([]byte, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("'%s' isn't an IPv4 address", ip)
+	}
+	name, err := dnsmessage.NewName(fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa.", ip4[3], ip4[2], ip4[1], ip4[0]))
+	if err != nil {
+		return nil, err
+	}
+	message := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: id},
+		Questions: []dnsmessage.Question{
+			{Name: name, Type: dnsmessage.TypePTR, Class: dnsmessage.ClassINET},
+		},
+	}
+	return message.Pack()
+}
+
+// parseReverseResponse - The hostnames of the PTR answers to the query
+func parseReverseResponse(response []byte, id uint16) ([]string, error) {
+	message := dnsmessage.Message{}
+	err := message.Unpack(response)
+	if err != nil {
+		return nil, err
+	}
+	if message.Header.ID != id || !message.Header.Response {
+		return nil, errNoAnswer
+	}
+	names := []string{}
+	for _, answer := range message.Answers {
+		if ptr, ok := answer.Body.(*dnsmessage.PTRResource); ok {
+			names = appendUnique(names, strings.TrimSuffix(ptr.PTR.String(), "."))
+		}
+	}
+	return names, nil
+}
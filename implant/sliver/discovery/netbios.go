@@ -0,0 +1,121 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+const (
+	nbstatType  = 0x21
+	nbnameGroup = 0x8000
+
+	// Suffixes of NetBIOS names
+	nbSuffixWorkstation      = 0x00
+	nbSuffixFileServer       = 0x20
+	nbSuffixDomainMaster     = 0x1b
+	nbSuffixDomainController = 0x1c
+)
+
+var errShortNodeStatus = errors.New("short node status response")
+
+type nodeStatus struct {
+	name      string
+	workgroup string
+	mac       string
+	roles     []string
+}
+
+// nodeStatusRequest - A NetBIOS node status (NBSTAT) query for the wildcard name
+func nodeStatusRequest(id uint16) []byte {
+	request := make([]byte, 12, 50)
+	binary.BigEndian.PutUint16(request[0:], id)
+	binary.BigEndian.PutUint16(request[4:], 1) // One question
+	request = append(request, 0x20)
+	name := make([]byte, 16)
+	name[0] = '*'
+	for _, b := range name {
+		request = append(request, 'A'+(b>>4), 'A'+(b&0x0f))
+	}
+	request = append(request, 0x00)
+	request = binary.BigEndian.AppendUint16(request, nbstatType)
+	request = binary.BigEndian.AppendUint16(request, 0x0001) // IN
+	return request
+}
+
+// parseNodeStatus - The computer name, workgroup, roles, and MAC address of a node
+// status response
+func parseNodeStatus(response []byte) (*nodeStatus, error) {
+	if len(response) < 12 || binary.BigEndian.Uint16(response[6:]) == 0 {
+		return nil, errShortNodeStatus
+	}
+	offset := 12
+	// Skip the answer's name, which is labels or a pointer
+	for offset < len(response) {
+		length := int(response[offset])
+		if length&0xc0 == 0xc0 {
+			offset += 2
+			break
+		}
+		offset += 1 + length
+		if length == 0 {
+			break
+		}
+	}
+	// Type, class, ttl, and rdata length
+	if len(response) < offset+10+1 || binary.BigEndian.Uint16(response[offset:]) != nbstatType {
+		return nil, errShortNodeStatus
+	}
+	offset += 10
+	count := int(response[offset])
+	offset++
+	if len(response) < offset+count*18 {
+		return nil, errShortNodeStatus
+	}
+
+	status := &nodeStatus{}
+	for i := 0; i < count; i++ {
+		entry := response[offset+i*18 : offset+(i+1)*18]
+		name := strings.TrimRight(string(entry[:15]), " \x00")
+		suffix := entry[15]
+		group := binary.BigEndian.Uint16(entry[16:])&nbnameGroup != 0
+		switch {
+		case suffix == nbSuffixWorkstation && !group && status.name == "":
+			status.name = name
+		case suffix == nbSuffixWorkstation && group && status.workgroup == "":
+			status.workgroup = name
+		case suffix == nbSuffixFileServer && !group:
+			status.roles = appendUnique(status.roles, RoleFileServer)
+		case suffix == nbSuffixDomainController && group, suffix == nbSuffixDomainMaster && !group:
+			status.roles = appendUnique(status.roles, RoleDomainController)
+		}
+	}
+
+	// The statistics start with the unit id, which is the MAC address (or zero)
+	offset += count * 18
+	if offset+6 <= len(response) {
+		mac := response[offset : offset+6]
+		if binary.BigEndian.Uint32(mac) != 0 || binary.BigEndian.Uint16(mac[4:]) != 0 {
+			status.mac = formatMAC(mac)
+		}
+	}
+	return status, nil
+}
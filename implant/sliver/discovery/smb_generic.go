@@ -0,0 +1,30 @@
+//go:build !windows
+
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+// smbEnum - Share and session enumeration uses the Windows network apis
+func smbEnum(address string) ([]*commonpb.DiscoveredShare, []*commonpb.DiscoveredSession, error) {
+	return nil, nil, errNotSupported
+}
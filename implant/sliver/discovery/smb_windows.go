@@ -0,0 +1,74 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"golang.org/x/sys/windows"
+)
+
+var shareTypes = map[uint32]string{
+	syscalls.STYPE_DISKTREE: ShareTypeDisk,
+	syscalls.STYPE_PRINTQ:   ShareTypePrinter,
+	syscalls.STYPE_DEVICE:   ShareTypeDevice,
+	syscalls.STYPE_IPC:      ShareTypeIPC,
+}
+
+// smbEnum - The shares of a server, and its sessions if the implant's user may list
+// them (only admins can on recent versions of Windows)
+func smbEnum(address string) ([]*commonpb.DiscoveredShare, []*commonpb.DiscoveredSession, error) {
+	server, err := windows.UTF16PtrFromString(`\\` + address)
+	if err != nil {
+		return nil, nil, err
+	}
+	var buf *byte
+	var read, total uint32
+	err = syscalls.NetShareEnum(server, 1, &buf, syscalls.MAX_PREFERRED_LENGTH, &read, &total, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	shares := []*commonpb.DiscoveredShare{}
+	for _, info := range unsafe.Slice((*syscalls.SHARE_INFO_1)(unsafe.Pointer(buf)), read) {
+		shares = append(shares, &commonpb.DiscoveredShare{
+			Name:   windows.UTF16PtrToString(info.Netname),
+			Type:   shareTypes[info.Type&syscalls.STYPE_MASK],
+			Remark: windows.UTF16PtrToString(info.Remark),
+		})
+	}
+	windows.NetApiBufferFree(buf)
+
+	sessions := []*commonpb.DiscoveredSession{}
+	buf = nil
+	err = syscalls.NetSessionEnum(server, nil, nil, 10, &buf, syscalls.MAX_PREFERRED_LENGTH, &read, &total, nil)
+	if err == nil {
+		for _, info := range unsafe.Slice((*syscalls.SESSION_INFO_10)(unsafe.Pointer(buf)), read) {
+			sessions = append(sessions, &commonpb.DiscoveredSession{
+				Client:        windows.UTF16PtrToString(info.Cname),
+				Username:      windows.UTF16PtrToString(info.Username),
+				ActiveSeconds: info.Time,
+				IdleSeconds:   info.IdleTime,
+			})
+		}
+		windows.NetApiBufferFree(buf)
+	}
+	return shares, sessions, nil
+}
@@ -0,0 +1,60 @@
+//go:build !windows
+
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// discardPort - Datagrams are sent to the discard port, sending one makes the
+// kernel resolve the address's MAC (hosts that are up answer the ARP request
+// whether or not anything listens on the port)
+const discardPort = "9"
+
+// sweep - Fill the ARP cache with the MAC of every address on a local network,
+// the MACs are read from the ARP cache afterwards
+func sweep(addresses []string, timeout time.Duration) map[string]string {
+	jobs := make(chan string)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < concurrency && i < len(addresses); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for address := range jobs {
+				conn, err := net.Dial("udp", net.JoinHostPort(address, discardPort))
+				if err != nil {
+					continue
+				}
+				conn.Write([]byte{0})
+				conn.Close()
+			}
+		}()
+	}
+	for _, address := range addresses {
+		jobs <- address
+	}
+	close(jobs)
+	wg.Wait()
+	time.Sleep(timeout) // Wait for the ARP replies
+	return map[string]string{}
+}
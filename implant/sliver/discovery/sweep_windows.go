@@ -0,0 +1,64 @@
+package discovery
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+)
+
+// sweep - Resolve the MAC of every address with SendARP, which also adds them to
+// the ARP cache (SendARP has its own timeout)
+func sweep(addresses []string, timeout time.Duration) map[string]string {
+	macs := map[string]string{}
+	mutex := &sync.Mutex{}
+	jobs := make(chan string)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < concurrency && i < len(addresses); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for address := range jobs {
+				ip := net.ParseIP(address).To4()
+				if ip == nil {
+					continue
+				}
+				mac := make([]byte, 8)
+				size := uint32(len(mac))
+				err := syscalls.SendARP(binary.LittleEndian.Uint32(ip), 0, &mac[0], &size)
+				if err != nil || size != 6 {
+					continue
+				}
+				mutex.Lock()
+				macs[address] = formatMAC(mac)
+				mutex.Unlock()
+			}
+		}()
+	}
+	for _, address := range addresses {
+		jobs <- address
+	}
+	close(jobs)
+	wg.Wait()
+	return macs
+}
@@ -0,0 +1,195 @@
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// products - Service, process, driver, and module names (lower case, without an
+// extension) of AV/EDR agents
+var products = map[string]string{
+	"windefend":              "Microsoft Defender",
+	"msmpeng":                "Microsoft Defender",
+	"wdfilter":               "Microsoft Defender",
+	"wdboot":                 "Microsoft Defender",
+	"wdnisdrv":               "Microsoft Defender",
+	"sense":                  "Microsoft Defender for Endpoint",
+	"mssense":                "Microsoft Defender for Endpoint",
+	"wdavdaemon":             "Microsoft Defender for Endpoint",
+	"mdatp":                  "Microsoft Defender for Endpoint",
+	"csagent":                "CrowdStrike Falcon",
+	"csfalconservice":        "CrowdStrike Falcon",
+	"csdevicecontrol":        "CrowdStrike Falcon",
+	"umppc":                  "CrowdStrike Falcon",
+	"falcon-sensor":          "CrowdStrike Falcon",
+	"falcond":                "CrowdStrike Falcon",
+	"falcon_lsm_serviceable": "CrowdStrike Falcon",
+	"sentinelagent":          "SentinelOne",
+	"sentinelmonitor":        "SentinelOne",
+	"inprocessclient64":      "SentinelOne",
+	"inprocessclient":        "SentinelOne",
+	"s1-agent":               "SentinelOne",
+	"sentineld":              "SentinelOne",
+	"cbdefense":              "Carbon Black",
+	"carbonblack":            "Carbon Black",
+	"carbonblackk":           "Carbon Black",
+	"ctiuser":                "Carbon Black",
+	"repmgr":                 "Carbon Black",
+	"cbagentd":               "Carbon Black",
+	"cyserver":               "Cortex XDR",
+	"cyvrmtgn":               "Cortex XDR",
+	"cyinjct":                "Cortex XDR",
+	"traps_pmd":              "Cortex XDR",
+	"xagt":                   "Trellix HX",
+	"fekern":                 "Trellix HX",
+	"mfemms":                 "Trellix/McAfee Endpoint Security",
+	"mfehidk":                "Trellix/McAfee Endpoint Security",
+	"sepmasterservice":       "Symantec Endpoint Protection",
+	"symevnt":                "Symantec Endpoint Protection",
+	"ekrn":                   "ESET",
+	"eamonm":                 "ESET",
+	"tmccsf":                 "Trend Micro",
+	"ntrtscan":               "Trend Micro",
+	"tmcomm":                 "Trend Micro",
+	"savservice":             "Sophos",
+	"sophos mcs agent":       "Sophos",
+	"sophosed":               "Sophos",
+	"hmpalert":               "Sophos",
+	"cylancesvc":             "Cylance",
+	"cyoptics":               "Cylance",
+	"cyprotectdrv64":         "Cylance",
+	"elastic-endpoint":       "Elastic Endpoint",
+	"elastic-agent":          "Elastic Agent",
+	"sysmon":                 "Sysmon",
+	"sysmon64":               "Sysmon",
+	"sysmondrv":              "Sysmon",
+	"osqueryd":               "osquery",
+	"auditd":                 "auditd",
+	"wazuh-agentd":           "Wazuh",
+	"ossec-agentd":           "OSSEC",
+	"qualys-cloud-agent":     "Qualys",
+}
+
+// Product - The product a service, process, driver, or module name belongs to
+func Product(name string) string {
+	name = strings.ToLower(strings.TrimSpace(filepath.Base(name)))
+	if product, ok := products[name]; ok {
+		return product
+	}
+	return products[strings.TrimSuffix(name, filepath.Ext(name))]
+}
+
+// MatchProducts - The sorted, unique products any of the names belong to
+func MatchProducts(names []string) []string {
+	found := map[string]bool{}
+	for _, name := range names {
+		if product := Product(name); product != "" {
+			found[product] = true
+		}
+	}
+	return sortedKeys(found)
+}
+
+// check - One way of finding the components of security products, a check that
+// fails becomes a warning and the others still run
+type check struct {
+	name string
+	run  func() ([]*sliverpb.EDRComponent, error)
+}
+
+// Enumerate - Look for AV/EDR products, every platform has its own checks but they
+// only read files, the registry, and local APIs (nothing is executed)
+func Enumerate() *sliverpb.EDREnum {
+	components := []*sliverpb.EDRComponent{}
+	warnings := []string{}
+	for _, check := range checks() {
+		found, err := check.run()
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", check.name, err))
+		}
+		components = append(components, found...)
+	}
+	found := map[string]bool{}
+	for _, component := range components {
+		if component.Product != "" {
+			found[component.Product] = true
+		}
+	}
+	sort.SliceStable(components, func(i, j int) bool {
+		if components[i].Product != components[j].Product {
+			return components[i].Product < components[j].Product
+		}
+		return components[i].Kind < components[j].Kind
+	})
+	return &sliverpb.EDREnum{
+		Products:   sortedKeys(found),
+		Components: components,
+		Warnings:   warnings,
+	}
+}
+
+// jumpTarget - The destination of an x86/x64 jmp at the start of code (that is at
+// address), hooks overwrite the prologue of a function with one
+func jumpTarget(code []byte, address uint64) (uint64, bool) {
+	switch {
+	case 5 <= len(code) && code[0] == 0xe9: // jmp rel32
+		return address + 5 + uint64(int64(int32(binary.LittleEndian.Uint32(code[1:5])))), true
+	case 2 <= len(code) && code[0] == 0xeb: // jmp rel8
+		return address + 2 + uint64(int64(int8(code[1]))), true
+	case 6 <= len(code) && code[0] == 0x68 && code[5] == 0xc3: // push imm32; ret
+		return uint64(binary.LittleEndian.Uint32(code[1:5])), true
+	case 12 <= len(code) && code[0] == 0x48 && code[1] == 0xb8 && code[10] == 0xff && code[11] == 0xe0: // mov rax, imm64; jmp rax
+		return binary.LittleEndian.Uint64(code[2:10]), true
+	}
+	return 0, false
+}
+
+// patched - The in memory code of a function doesn't match the code on disk, other
+// than a 32-bit absolute address the loader relocated by delta
+func patched(disk []byte, memory []byte, delta uint32) bool {
+	if len(disk) != len(memory) || bytes.Equal(disk, memory) {
+		return len(disk) != len(memory)
+	}
+	for offset := 0; offset+4 <= len(disk); offset++ {
+		relocated := append([]byte{}, disk...)
+		address := binary.LittleEndian.Uint32(disk[offset:]) + delta
+		binary.LittleEndian.PutUint32(relocated[offset:], address)
+		if bytes.Equal(relocated, memory) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
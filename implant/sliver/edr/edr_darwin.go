@@ -0,0 +1,99 @@
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// installPaths - Install paths of AV/EDR agents
+var installPaths = map[string]string{
+	"/Library/CS/falcond":                             "CrowdStrike Falcon",
+	"/Applications/Falcon.app":                        "CrowdStrike Falcon",
+	"/Library/Sentinel":                               "SentinelOne",
+	"/Applications/Microsoft Defender.app":            "Microsoft Defender for Endpoint",
+	"/Applications/VMware Carbon Black Cloud":         "Carbon Black",
+	"/Library/Application Support/PaloAltoNetworks":   "Cortex XDR",
+	"/Library/Elastic/Endpoint":                       "Elastic Endpoint",
+	"/Applications/JamfProtect.app":                   "Jamf Protect",
+	"/Library/Application Support/Objective-See/LuLu": "LuLu",
+	"/usr/local/bin/osqueryd":                         "osquery",
+}
+
+// bundleIDs - Bundle identifier prefixes of the system extensions of AV/EDR agents
+var bundleIDs = map[string]string{
+	"com.crowdstrike.falcon": "CrowdStrike Falcon",
+	"com.sentinelone":        "SentinelOne",
+	"com.microsoft.wdav":     "Microsoft Defender for Endpoint",
+	"com.vmware.carbonblack": "Carbon Black",
+	"com.paloaltonetworks":   "Cortex XDR",
+	"co.elastic":             "Elastic Endpoint",
+	"com.jamf.protect":       "Jamf Protect",
+	"com.objective-see":      "Objective-See",
+	"com.sophos":             "Sophos",
+	"com.trendmicro":         "Trend Micro",
+}
+
+func checks() []check {
+	return []check{
+		{"processes", processes},
+		{"system-extensions", systemExtensions},
+		{"files", func() ([]*sliverpb.EDRComponent, error) { return installed(installPaths), nil }},
+		{"hooks", func() ([]*sliverpb.EDRComponent, error) {
+			return preloaded("DYLD_INSERT_LIBRARIES", os.Getenv("DYLD_INSERT_LIBRARIES")), nil
+		}},
+	}
+}
+
+// InstalledProducts - The sorted, unique products with any of their install paths present
+func InstalledProducts() []string {
+	found := map[string]bool{}
+	for _, component := range installed(installPaths) {
+		found[component.Product] = true
+	}
+	return sortedKeys(found)
+}
+
+// systemExtensions - Endpoint security and network extensions of security products
+func systemExtensions() ([]*sliverpb.EDRComponent, error) {
+	extensions, err := filepath.Glob("/Library/SystemExtensions/*/*.systemextension")
+	if err != nil {
+		return nil, err
+	}
+	components := []*sliverpb.EDRComponent{}
+	for _, extension := range extensions {
+		bundleID := strings.TrimSuffix(filepath.Base(extension), ".systemextension")
+		for prefix, product := range bundleIDs {
+			if strings.HasPrefix(strings.ToLower(bundleID), prefix) {
+				components = append(components, &sliverpb.EDRComponent{
+					Kind:    sliverpb.EDRKindDriver,
+					Name:    bundleID,
+					Product: product,
+					Detail:  extension,
+				})
+				break
+			}
+		}
+	}
+	return components, nil
+}
@@ -0,0 +1,137 @@
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// installPaths - Install paths of AV/EDR agents
+var installPaths = map[string]string{
+	"/opt/CrowdStrike":          "CrowdStrike Falcon",
+	"/opt/sentinelone":          "SentinelOne",
+	"/opt/microsoft/mdatp":      "Microsoft Defender for Endpoint",
+	"/opt/carbonblack":          "Carbon Black",
+	"/opt/traps":                "Cortex XDR",
+	"/opt/Elastic/Endpoint":     "Elastic Endpoint",
+	"/opt/sophos-spl":           "Sophos",
+	"/opt/isec/ens":             "Trellix/McAfee Endpoint Security",
+	"/opt/ds_agent":             "Trend Micro",
+	"/opt/cisco/amp":            "Cisco Secure Endpoint",
+	"/var/ossec":                "Wazuh",
+	"/usr/local/qualys":         "Qualys",
+	"/etc/osquery/osquery.conf": "osquery",
+}
+
+// systemdUnitDirs - Where the unit files of installed services are
+var systemdUnitDirs = []string{"/etc/systemd/system", "/lib/systemd/system", "/usr/lib/systemd/system"}
+
+func checks() []check {
+	return []check{
+		{"services", services},
+		{"processes", processes},
+		{"kernel-modules", kernelModules},
+		{"files", func() ([]*sliverpb.EDRComponent, error) { return installed(installPaths), nil }},
+		{"hooks", hooks},
+	}
+}
+
+// services - The systemd units of security products
+func services() ([]*sliverpb.EDRComponent, error) {
+	components := []*sliverpb.EDRComponent{}
+	seen := map[string]bool{}
+	for _, dir := range systemdUnitDirs {
+		units, _ := filepath.Glob(filepath.Join(dir, "*.service"))
+		for _, unit := range units {
+			name := strings.TrimSuffix(filepath.Base(unit), ".service")
+			if product := Product(name); product != "" && !seen[name] {
+				seen[name] = true
+				components = append(components, &sliverpb.EDRComponent{
+					Kind:    sliverpb.EDRKindService,
+					Name:    name,
+					Product: product,
+					Detail:  unit,
+				})
+			}
+		}
+	}
+	return components, nil
+}
+
+// kernelModules - Loaded kernel modules of security products
+func kernelModules() ([]*sliverpb.EDRComponent, error) {
+	modules, err := os.Open("/proc/modules")
+	if err != nil {
+		return nil, err
+	}
+	defer modules.Close()
+	components := []*sliverpb.EDRComponent{}
+	scanner := bufio.NewScanner(modules)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if product := Product(fields[0]); product != "" {
+			components = append(components, &sliverpb.EDRComponent{
+				Kind:    sliverpb.EDRKindModule,
+				Name:    fields[0],
+				Product: product,
+				Detail:  "kernel module",
+			})
+		}
+	}
+	return components, scanner.Err()
+}
+
+// hooks - Libraries the loader injects into every process, and libraries of security
+// products mapped into the implant's process
+func hooks() ([]*sliverpb.EDRComponent, error) {
+	components := preloaded("LD_PRELOAD", os.Getenv("LD_PRELOAD"))
+	if preload, err := os.ReadFile("/etc/ld.so.preload"); err == nil {
+		components = append(components, preloaded("/etc/ld.so.preload", string(preload))...)
+	}
+	maps, err := os.ReadFile("/proc/self/maps")
+	if err != nil {
+		return components, err
+	}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(string(maps), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 6 || seen[fields[5]] {
+			continue
+		}
+		seen[fields[5]] = true
+		name := strings.TrimPrefix(strings.SplitN(filepath.Base(fields[5]), ".so", 2)[0], "lib")
+		if product := Product(name); product != "" {
+			components = append(components, &sliverpb.EDRComponent{
+				Kind:    sliverpb.EDRKindModule,
+				Name:    fields[5],
+				Product: product,
+				Detail:  "mapped into the implant's process",
+			})
+		}
+	}
+	return components, nil
+}
@@ -0,0 +1,71 @@
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchProducts(t *testing.T) {
+	products := MatchProducts([]string{"WinDefend", "Sense", "CSAgent", "CSFalconService", "Spooler", "sysmon64\n", "MsMpEng.exe", "WdFilter.sys"})
+	expected := []string{"CrowdStrike Falcon", "Microsoft Defender", "Microsoft Defender for Endpoint", "Sysmon"}
+	if !reflect.DeepEqual(products, expected) {
+		t.Errorf("Expected %v, got %v", expected, products)
+	}
+	if products := MatchProducts([]string{"bash", "sshd"}); len(products) != 0 {
+		t.Errorf("Expected no products, got %v", products)
+	}
+}
+
+func TestJumpTarget(t *testing.T) {
+	for _, test := range []struct {
+		code    []byte
+		address uint64
+		target  uint64
+		isJump  bool
+	}{
+		{[]byte{0xe9, 0xfb, 0x0f, 0x00, 0x00}, 0x1000, 0x2000, true},
+		{[]byte{0xe9, 0xfb, 0xef, 0xff, 0xff}, 0x2000, 0x1000, true},
+		{[]byte{0xeb, 0x10}, 0x1000, 0x1012, true},
+		{[]byte{0x68, 0x00, 0x00, 0x40, 0x00, 0xc3}, 0x1000, 0x400000, true},
+		{[]byte{0x48, 0xb8, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x7f, 0x00, 0x00, 0xff, 0xe0}, 0x1000, 0x7ffe00000000, true},
+		{[]byte{0x4c, 0x8b, 0xd1, 0xb8, 0x18}, 0x1000, 0, false},
+		{[]byte{0xe9}, 0x1000, 0, false},
+	} {
+		target, isJump := jumpTarget(test.code, test.address)
+		if target != test.target || isJump != test.isJump {
+			t.Errorf("Expected %x %v for %x, got %x %v", test.target, test.isJump, test.code, target, isJump)
+		}
+	}
+}
+
+func TestPatched(t *testing.T) {
+	syscallStub := []byte{0x4c, 0x8b, 0xd1, 0xb8, 0x18}
+	if patched(syscallStub, syscallStub, 0) {
+		t.Error("Expected identical code to not be patched")
+	}
+	if !patched(syscallStub, []byte{0xe9, 0xfb, 0x0f, 0x00, 0x00}, 0) {
+		t.Error("Expected a jmp to be patched")
+	}
+	// jmp dword ptr [0x10001000] relocated by 0x5000
+	if patched([]byte{0xff, 0x25, 0x00, 0x10, 0x00, 0x10}, []byte{0xff, 0x25, 0x00, 0x60, 0x00, 0x10}, 0x5000) {
+		t.Error("Expected a relocated address to not be patched")
+	}
+}
@@ -0,0 +1,64 @@
+//go:build linux || darwin
+
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// installed - The install paths that are present
+func installed(paths map[string]string) []*sliverpb.EDRComponent {
+	components := []*sliverpb.EDRComponent{}
+	for path, product := range paths {
+		if _, err := os.Stat(path); err == nil {
+			components = append(components, &sliverpb.EDRComponent{
+				Kind:    sliverpb.EDRKindFile,
+				Name:    path,
+				Product: product,
+			})
+		}
+	}
+	return components
+}
+
+// preloaded - Libraries in an environment variable that the loader injects into
+// every process it starts (LD_PRELOAD, DYLD_INSERT_LIBRARIES)
+func preloaded(variable string, libraries string) []*sliverpb.EDRComponent {
+	components := []*sliverpb.EDRComponent{}
+	for _, library := range strings.FieldsFunc(libraries, func(r rune) bool {
+		return r == ':' || r == ' ' || r == '\n' || r == '\t'
+	}) {
+		if strings.HasPrefix(library, "#") {
+			continue
+		}
+		components = append(components, &sliverpb.EDRComponent{
+			Kind:    sliverpb.EDRKindHook,
+			Name:    library,
+			Product: Product(strings.TrimPrefix(filepath.Base(library), "lib")),
+			Detail:  variable,
+		})
+	}
+	return components
+}
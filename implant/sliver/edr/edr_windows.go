@@ -0,0 +1,470 @@
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+const (
+	// Load order group of anti-virus file system minifilters
+	antiVirusAltitudeMin = 320000
+	antiVirusAltitudeMax = 329999
+
+	serviceDisabled = 4
+	// Number of bytes of each exported function that are compared, enough for a jmp rel32
+	prologueSize = 5
+	// Number of bytes read to find the destination of a hook
+	jumpSize = 12
+	// Number of entries of each wfp enum call
+	wfpEnumSize = 256
+)
+
+// hookedLibraries - Libraries loaded by every process whose exports are commonly hooked
+var hookedLibraries = []string{"ntdll.dll", "kernelbase.dll", "kernel32.dll"}
+
+type module struct {
+	name string
+	path string
+	base uintptr
+	size uintptr
+}
+
+func checks() []check {
+	return []check{
+		{"services", services},
+		{"processes", processes},
+		{"modules", modules},
+		{"wfp-filters", wfpFilters},
+		{"hooks", hooks},
+	}
+}
+
+// services - Services and drivers of security products, and drivers of unknown
+// vendors registered as anti-virus minifilters
+func services() ([]*sliverpb.EDRComponent, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SYSTEM\CurrentControlSet\Services`, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	names, err := key.ReadSubKeyNames(-1)
+	if err != nil {
+		return nil, err
+	}
+	components := []*sliverpb.EDRComponent{}
+	for _, name := range names {
+		serviceKey, err := registry.OpenKey(key, name, registry.QUERY_VALUE)
+		if err != nil {
+			continue
+		}
+		serviceType, _, _ := serviceKey.GetIntegerValue("Type")
+		start, _, _ := serviceKey.GetIntegerValue("Start")
+		imagePath, _, _ := serviceKey.GetStringValue("ImagePath")
+		serviceKey.Close()
+
+		kind := sliverpb.EDRKindService
+		if serviceType&(windows.SERVICE_KERNEL_DRIVER|windows.SERVICE_FILE_SYSTEM_DRIVER) != 0 {
+			kind = sliverpb.EDRKindDriver
+		}
+		product := Product(name)
+		if product == "" {
+			product = Product(imageName(imagePath))
+		}
+		detail := imagePath
+		if altitude := minifilterAltitude(key, name); antiVirusAltitudeMin <= altitude && altitude <= antiVirusAltitudeMax {
+			detail = fmt.Sprintf("anti-virus minifilter (altitude %d) %s", altitude, imagePath)
+		} else if product == "" {
+			continue
+		}
+		if start == serviceDisabled {
+			detail += " (disabled)"
+		}
+		components = append(components, &sliverpb.EDRComponent{
+			Kind:    kind,
+			Name:    name,
+			Product: product,
+			Detail:  strings.TrimSpace(detail),
+		})
+	}
+	return components, nil
+}
+
+// imageName - The executable of a service's ImagePath, which can be quoted and have arguments
+func imageName(imagePath string) string {
+	imagePath = strings.TrimSpace(imagePath)
+	if strings.HasPrefix(imagePath, "\"") {
+		if end := strings.Index(imagePath[1:], "\""); 0 <= end {
+			return filepath.Base(imagePath[1 : end+1])
+		}
+	}
+	if fields := strings.Fields(imagePath); 0 < len(fields) {
+		return filepath.Base(fields[0])
+	}
+	return ""
+}
+
+// minifilterAltitude - The altitude of a minifilter driver's default instance, or 0
+func minifilterAltitude(services registry.Key, name string) int {
+	instances, err := registry.OpenKey(services, name+`\Instances`, registry.QUERY_VALUE)
+	if err != nil {
+		return 0
+	}
+	defer instances.Close()
+	instance, _, err := instances.GetStringValue("DefaultInstance")
+	if err != nil {
+		return 0
+	}
+	instanceKey, err := registry.OpenKey(instances, instance, registry.QUERY_VALUE)
+	if err != nil {
+		return 0
+	}
+	defer instanceKey.Close()
+	altitude, _, _ := instanceKey.GetStringValue("Altitude")
+	value, _ := strconv.ParseFloat(altitude, 64)
+	return int(value)
+}
+
+// loadedModules - The libraries loaded into the implant's process
+func loadedModules() ([]module, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPMODULE|windows.TH32CS_SNAPMODULE32, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseHandle(snapshot)
+	modules := []module{}
+	entry := windows.ModuleEntry32{Size: uint32(windows.SizeofModuleEntry32)}
+	for err = windows.Module32First(snapshot, &entry); err == nil; err = windows.Module32Next(snapshot, &entry) {
+		modules = append(modules, module{
+			name: windows.UTF16ToString(entry.Module[:]),
+			path: windows.UTF16ToString(entry.ExePath[:]),
+			base: entry.ModBaseAddr,
+			size: uintptr(entry.ModBaseSize),
+		})
+	}
+	return modules, nil
+}
+
+// modules - Libraries of security products that were injected into the implant's process
+func modules() ([]*sliverpb.EDRComponent, error) {
+	loaded, err := loadedModules()
+	if err != nil {
+		return nil, err
+	}
+	components := []*sliverpb.EDRComponent{}
+	for _, module := range loaded {
+		if product := Product(module.name); product != "" {
+			components = append(components, &sliverpb.EDRComponent{
+				Kind:    sliverpb.EDRKindModule,
+				Name:    module.name,
+				Product: product,
+				Detail:  module.path,
+			})
+		}
+	}
+	return components, nil
+}
+
+// hooks - Exported functions of commonly hooked libraries whose prologue in the
+// implant's process differs from the library on disk
+func hooks() ([]*sliverpb.EDRComponent, error) {
+	loaded, err := loadedModules()
+	if err != nil {
+		return nil, err
+	}
+	systemDir, err := windows.GetSystemDirectory()
+	if err != nil {
+		return nil, err
+	}
+	components := []*sliverpb.EDRComponent{}
+	errs := []string{}
+	for _, library := range hookedLibraries {
+		found, err := hookedFunctions(library, filepath.Join(systemDir, library), loaded)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s %s", library, err))
+			continue
+		}
+		components = append(components, found...)
+	}
+	if 0 < len(errs) {
+		return components, errors.New(strings.Join(errs, ", "))
+	}
+	return components, nil
+}
+
+func hookedFunctions(library string, path string, loaded []module) ([]*sliverpb.EDRComponent, error) {
+	var base uintptr
+	for _, module := range loaded {
+		if strings.EqualFold(module.name, library) {
+			base = module.base
+		}
+	}
+	if base == 0 {
+		return nil, errors.New("not loaded")
+	}
+	file, err := pe.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// Absolute addresses in the prologues are relocated by the difference between
+	// where the library was loaded and its preferred base
+	var delta uint32
+	switch header := file.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		delta = uint32(base) - header.ImageBase
+	case *pe.OptionalHeader64:
+		delta = uint32(uint64(base) - header.ImageBase)
+	}
+
+	functions, err := exportedFunctions(base)
+	if err != nil {
+		return nil, err
+	}
+	components := []*sliverpb.EDRComponent{}
+	for name, rva := range functions {
+		disk := make([]byte, prologueSize)
+		if !readCode(file, rva, disk) {
+			continue
+		}
+		memory, err := readMemory(base+uintptr(rva), jumpSize)
+		if err != nil {
+			continue
+		}
+		if !patched(disk, memory[:prologueSize], delta) {
+			continue
+		}
+		component := &sliverpb.EDRComponent{
+			Kind:   sliverpb.EDRKindHook,
+			Name:   fmt.Sprintf("%s!%s", library, name),
+			Detail: "modified prologue",
+		}
+		if target, ok := jumpTarget(memory, uint64(base+uintptr(rva))); ok {
+			component.Detail = fmt.Sprintf("jmp to 0x%x", target)
+			for _, module := range loaded {
+				if module.base <= uintptr(target) && uintptr(target) < module.base+module.size {
+					component.Detail = fmt.Sprintf("jmp to %s+0x%x", module.name, uintptr(target)-module.base)
+					component.Product = Product(module.name)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
+
+// readCode - Read code at an rva from the library on disk, only executable sections
+// are read because data exports are changed at runtime
+func readCode(file *pe.File, rva uint32, code []byte) bool {
+	for _, section := range file.Sections {
+		if section.Characteristics&pe.IMAGE_SCN_MEM_EXECUTE == 0 {
+			continue
+		}
+		if section.VirtualAddress <= rva && rva+uint32(len(code)) <= section.VirtualAddress+section.Size {
+			_, err := section.ReadAt(code, int64(rva-section.VirtualAddress))
+			return err == nil
+		}
+	}
+	return false
+}
+
+// exportedFunctions - The rva of each named export of a loaded library, from its
+// export directory in memory (forwarded exports are left out)
+func exportedFunctions(base uintptr) (map[string]uint32, error) {
+	dosHeader, err := readMemory(base, 0x40)
+	if err != nil {
+		return nil, err
+	}
+	ntHeaders := base + uintptr(binary.LittleEndian.Uint32(dosHeader[0x3c:]))
+	headers, err := readMemory(ntHeaders, 4+20+112+8)
+	if err != nil {
+		return nil, err
+	}
+	optionalHeader := headers[4+20:]
+	dataDirectory := optionalHeader[96:]
+	if binary.LittleEndian.Uint16(optionalHeader) == 0x20b { // PE32+
+		dataDirectory = optionalHeader[112:]
+	}
+	exportRVA := binary.LittleEndian.Uint32(dataDirectory)
+	exportSize := binary.LittleEndian.Uint32(dataDirectory[4:])
+	if exportSize < 40 {
+		return nil, errors.New("no export directory")
+	}
+
+	// The tables and names of the exports are part of the export directory
+	exports, err := readMemory(base+uintptr(exportRVA), int(exportSize))
+	if err != nil {
+		return nil, err
+	}
+	read32 := func(rva uint32) uint32 {
+		if rva < exportRVA || exportRVA+exportSize < rva+4 {
+			return 0
+		}
+		return binary.LittleEndian.Uint32(exports[rva-exportRVA:])
+	}
+	read16 := func(rva uint32) uint16 {
+		if rva < exportRVA || exportRVA+exportSize < rva+2 {
+			return 0
+		}
+		return binary.LittleEndian.Uint16(exports[rva-exportRVA:])
+	}
+	numberOfNames := binary.LittleEndian.Uint32(exports[24:])
+	addressOfFunctions := binary.LittleEndian.Uint32(exports[28:])
+	addressOfNames := binary.LittleEndian.Uint32(exports[32:])
+	addressOfOrdinals := binary.LittleEndian.Uint32(exports[36:])
+
+	functions := map[string]uint32{}
+	for index := uint32(0); index < numberOfNames; index++ {
+		nameRVA := read32(addressOfNames + index*4)
+		if nameRVA < exportRVA || exportRVA+exportSize <= nameRVA {
+			continue
+		}
+		name := exports[nameRVA-exportRVA:]
+		if end := bytes.IndexByte(name, 0); 0 <= end {
+			name = name[:end]
+		}
+		rva := read32(addressOfFunctions + uint32(read16(addressOfOrdinals+index*2))*4)
+		if rva == 0 || (exportRVA <= rva && rva < exportRVA+exportSize) {
+			continue // Forwarded to another library
+		}
+		functions[string(name)] = rva
+	}
+	return functions, nil
+}
+
+// readMemory - Read memory of the implant's process, unlike dereferencing a pointer
+// a bad address is an error
+func readMemory(address uintptr, size int) ([]byte, error) {
+	data := make([]byte, size)
+	err := windows.ReadProcessMemory(windows.CurrentProcess(), address, &data[0], uintptr(size), nil)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+type wfpProvider struct {
+	name    string
+	service string
+	filters int
+}
+
+// wfpFilters - Providers of wfp filters other than Windows, security products use
+// them to monitor and block network connections (requires admin)
+func wfpFilters() ([]*sliverpb.EDRComponent, error) {
+	var engine windows.Handle
+	err := syscalls.FwpmEngineOpen0(nil, syscalls.RPC_C_AUTHN_WINNT, 0, 0, &engine)
+	if err != nil {
+		return nil, err
+	}
+	defer syscalls.FwpmEngineClose0(engine)
+
+	providers := map[windows.GUID]*wfpProvider{}
+	var enumHandle windows.Handle
+	err = syscalls.FwpmProviderCreateEnumHandle0(engine, 0, &enumHandle)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var entries **syscalls.FWPM_PROVIDER0
+		var count uint32
+		err = syscalls.FwpmProviderEnum0(engine, enumHandle, wfpEnumSize, &entries, &count)
+		if err != nil {
+			break
+		}
+		for _, provider := range unsafe.Slice(entries, count) {
+			providers[provider.ProviderKey] = &wfpProvider{
+				name:    windows.UTF16PtrToString(provider.DisplayData.Name),
+				service: windows.UTF16PtrToString(provider.ServiceName),
+			}
+		}
+		syscalls.FwpmFreeMemory0((*uintptr)(unsafe.Pointer(&entries)))
+		if count < wfpEnumSize {
+			break
+		}
+	}
+	syscalls.FwpmProviderDestroyEnumHandle0(engine, enumHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscalls.FwpmFilterCreateEnumHandle0(engine, 0, &enumHandle)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var entries **syscalls.FWPM_FILTER0
+		var count uint32
+		err = syscalls.FwpmFilterEnum0(engine, enumHandle, wfpEnumSize, &entries, &count)
+		if err != nil {
+			break
+		}
+		for _, filter := range unsafe.Slice(entries, count) {
+			if filter.ProviderKey == nil {
+				continue
+			}
+			if provider, ok := providers[*filter.ProviderKey]; ok {
+				provider.filters++
+			}
+		}
+		syscalls.FwpmFreeMemory0((*uintptr)(unsafe.Pointer(&entries)))
+		if count < wfpEnumSize {
+			break
+		}
+	}
+	syscalls.FwpmFilterDestroyEnumHandle0(engine, enumHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	components := []*sliverpb.EDRComponent{}
+	for _, provider := range providers {
+		product := Product(provider.service)
+		name := strings.ToLower(provider.name)
+		builtin := strings.HasPrefix(name, "microsoft") || strings.HasPrefix(name, "windows")
+		if provider.filters == 0 || (product == "" && builtin) {
+			continue
+		}
+		detail := fmt.Sprintf("%d filters", provider.filters)
+		if provider.service != "" {
+			detail += fmt.Sprintf(" (service %s)", provider.service)
+		}
+		components = append(components, &sliverpb.EDRComponent{
+			Kind:    sliverpb.EDRKindWFP,
+			Name:    provider.name,
+			Product: product,
+			Detail:  detail,
+		})
+	}
+	return components, nil
+}
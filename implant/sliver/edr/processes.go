@@ -0,0 +1,48 @@
+//go:build windows || linux || darwin
+
+package edr
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+
+	"github.com/bishopfox/sliver/implant/sliver/ps"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// processes - Running processes of security products
+func processes() ([]*sliverpb.EDRComponent, error) {
+	procs, err := ps.Processes()
+	if err != nil {
+		return nil, err
+	}
+	components := []*sliverpb.EDRComponent{}
+	for _, proc := range procs {
+		if product := Product(proc.Executable()); product != "" {
+			components = append(components, &sliverpb.EDRComponent{
+				Kind:    sliverpb.EDRKindProcess,
+				Name:    proc.Executable(),
+				Product: product,
+				Detail:  fmt.Sprintf("pid %d", proc.Pid()),
+			})
+		}
+	}
+	return components, nil
+}
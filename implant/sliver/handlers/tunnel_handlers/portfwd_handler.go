@@ -63,7 +63,11 @@ func PortfwdReqHandler(envelope *sliverpb.Envelope, connection *transports.Conne
 
 	ctx, cancelContext := context.WithCancel(context.Background())
 
-	dst, err := defaultDialer.DialContext(ctx, "tcp", remoteAddress)
+	network := "tcp"
+	if portfwdReq.Protocol == sliverpb.PortFwdProtoUDP {
+		network = "udp"
+	}
+	dst, err := defaultDialer.DialContext(ctx, network, remoteAddress)
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("[portfwd] Failed to dial remote address %s", err)
@@ -88,20 +92,26 @@ func PortfwdReqHandler(envelope *sliverpb.Envelope, connection *transports.Conne
 
 	// Add tunnel
 	// {{if .Config.Debug}}
-	log.Printf("[portfwd] Creating tcp tunnel")
+	log.Printf("[portfwd] Creating %s tunnel", network)
 	// {{end}}
-	tunnel := transports.NewTunnel(
-		portfwdReq.TunnelID,
-		dst,
-		dst,
-	)
+	var tunnel *transports.Tunnel
+	if network == "udp" {
+		udpConn := newUDPTunnelConn(dst)
+		tunnel = transports.NewTunnel(portfwdReq.TunnelID, udpConn, udpConn)
+	} else {
+		tunnel = transports.NewTunnel(portfwdReq.TunnelID, dst, dst)
+	}
 	connection.AddTunnel(tunnel)
 
 	// Send portfwd response
+	protocol := int32(sliverpb.PortFwdProtoTCP)
+	if network == "udp" {
+		protocol = sliverpb.PortFwdProtoUDP
+	}
 	portfwdResp, _ := proto.Marshal(&sliverpb.Portfwd{
 		Port:     portfwdReq.Port,
 		Host:     portfwdReq.Host,
-		Protocol: sliverpb.PortFwdProtoTCP,
+		Protocol: protocol,
 		TunnelID: portfwdReq.TunnelID,
 	})
 	connection.Send <- &sliverpb.Envelope{
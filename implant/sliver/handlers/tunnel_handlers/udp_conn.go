@@ -0,0 +1,97 @@
+package tunnel_handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// udpIdleTimeout - Close a udp tunnel after this long without traffic,
+	// the same as the client
+	udpIdleTimeout = 2 * time.Minute
+
+	maxDatagramSize = 65535
+)
+
+// udpTunnelConn - Carries a udp socket's datagrams over the tunnel's byte
+// stream, each datagram is prefixed with its length (big endian uint16)
+type udpTunnelConn struct {
+	conn net.Conn
+
+	readBuf  []byte
+	leftover []byte
+
+	writeMutex sync.Mutex
+	writeBuf   []byte
+}
+
+func newUDPTunnelConn(conn net.Conn) *udpTunnelConn {
+	return &udpTunnelConn{
+		conn:    conn,
+		readBuf: make([]byte, maxDatagramSize),
+	}
+}
+
+// Read - Read a datagram from the socket and length prefix it, a frame that
+// doesn't fit into p is returned by the following reads
+func (u *udpTunnelConn) Read(p []byte) (int, error) {
+	if len(u.leftover) == 0 {
+		u.conn.SetReadDeadline(time.Now().Add(udpIdleTimeout))
+		n, err := u.conn.Read(u.readBuf)
+		if err != nil {
+			return 0, err
+		}
+		frame := make([]byte, 2+n)
+		binary.BigEndian.PutUint16(frame, uint16(n))
+		copy(frame[2:], u.readBuf[:n])
+		u.leftover = frame
+	}
+	n := copy(p, u.leftover)
+	u.leftover = u.leftover[n:]
+	return n, nil
+}
+
+// Write - Buffer tunnel data and send each complete datagram
+func (u *udpTunnelConn) Write(data []byte) (int, error) {
+	u.writeMutex.Lock()
+	defer u.writeMutex.Unlock()
+	u.writeBuf = append(u.writeBuf, data...)
+	for 2 <= len(u.writeBuf) {
+		size := int(binary.BigEndian.Uint16(u.writeBuf))
+		if len(u.writeBuf) < 2+size {
+			break
+		}
+		_, err := u.conn.Write(u.writeBuf[2 : 2+size])
+		u.writeBuf = u.writeBuf[2+size:]
+		if err != nil {
+			return 0, err
+		}
+		// Outbound traffic also keeps the tunnel open
+		u.conn.SetReadDeadline(time.Now().Add(udpIdleTimeout))
+	}
+	return len(data), nil
+}
+
+func (u *udpTunnelConn) Close() error {
+	return u.conn.Close()
+}
@@ -0,0 +1,129 @@
+package ldap
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"io"
+)
+
+// BER tags of the LDAP messages used by the client
+const (
+	berBoolean     = 0x01
+	berInteger     = 0x02
+	berOctetString = 0x04
+	berEnumerated  = 0x0a
+	berSequence    = 0x30
+	berSet         = 0x31
+
+	// maxMessageSize - Entries of large groups can be hundreds of kilobytes
+	maxMessageSize = 16 * 1024 * 1024
+)
+
+var errInvalidBER = errors.New("invalid ber encoding")
+
+func berEncode(tag byte, contents ...[]byte) []byte {
+	length := 0
+	for _, content := range contents {
+		length += len(content)
+	}
+	encoded := []byte{tag}
+	switch {
+	case length < 0x80:
+		encoded = append(encoded, byte(length))
+	case length < 0x100:
+		encoded = append(encoded, 0x81, byte(length))
+	case length < 0x10000:
+		encoded = append(encoded, 0x82, byte(length>>8), byte(length))
+	default:
+		encoded = append(encoded, 0x84, byte(length>>24), byte(length>>16), byte(length>>8), byte(length))
+	}
+	for _, content := range contents {
+		encoded = append(encoded, content...)
+	}
+	return encoded
+}
+
+// berInt - The shortest two's complement encoding of an integer
+func berInt(tag byte, value int) []byte {
+	contents := []byte{}
+	for {
+		contents = append([]byte{byte(value)}, contents...)
+		if -0x80 <= value && value < 0x80 {
+			break // The rest is the sign extension of the first byte
+		}
+		value >>= 8
+	}
+	return berEncode(tag, contents)
+}
+
+func berString(value string) []byte {
+	return berEncode(berOctetString, []byte(value))
+}
+
+func berBool(value bool) []byte {
+	if value {
+		return berEncode(berBoolean, []byte{0xff})
+	}
+	return berEncode(berBoolean, []byte{0})
+}
+
+// berDecode - The tag and contents of the first element, and what follows it,
+// io.ErrUnexpectedEOF if the element is incomplete
+func berDecode(data []byte) (byte, []byte, []byte, error) {
+	if len(data) < 2 {
+		return 0, nil, nil, io.ErrUnexpectedEOF
+	}
+	tag := data[0]
+	length := int(data[1])
+	offset := 2
+	if length&0x80 != 0 {
+		size := length & 0x7f
+		if size == 0 || 4 < size {
+			return 0, nil, nil, errInvalidBER
+		}
+		if len(data) < offset+size {
+			return 0, nil, nil, io.ErrUnexpectedEOF
+		}
+		length = 0
+		for _, b := range data[offset : offset+size] {
+			length = length<<8 | int(b)
+		}
+		offset += size
+	}
+	if length < 0 || maxMessageSize < length {
+		return 0, nil, nil, errInvalidBER
+	}
+	if len(data) < offset+length {
+		return 0, nil, nil, io.ErrUnexpectedEOF
+	}
+	return tag, data[offset : offset+length], data[offset+length:], nil
+}
+
+// berDecodeInt - The value of an integer or enumerated element's contents
+func berDecodeInt(contents []byte) (int, error) {
+	if len(contents) == 0 || 4 < len(contents) {
+		return 0, errInvalidBER
+	}
+	value := int(int8(contents[0]))
+	for _, b := range contents[1:] {
+		value = value<<8 | int(b)
+	}
+	return value, nil
+}
@@ -0,0 +1,515 @@
+package ldap
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Tags of the LDAP operations used by the client (RFC 4511)
+const (
+	opBindRequest   = 0x60 // [APPLICATION 0]
+	opBindResponse  = 0x61 // [APPLICATION 1]
+	opUnbindRequest = 0x42 // [APPLICATION 2]
+	opSearchRequest = 0x63 // [APPLICATION 3]
+	opSearchEntry   = 0x64 // [APPLICATION 4]
+	opSearchDone    = 0x65 // [APPLICATION 5]
+	opExtendedReq   = 0x77 // [APPLICATION 23]
+	opExtendedResp  = 0x78 // [APPLICATION 24]
+	tagRequestName  = 0x80 // [0] Name of an extended request
+	tagControls     = 0xa0 // [0] Controls of a message
+	tagSimpleAuth   = 0x80 // [0] Simple authentication of a bind
+	scopeBaseObject = 0
+	scopeSubtree    = 2
+	resultSuccess   = 0
+	resultSizeLimit = 4
+	pagedResultsOID = "1.2.840.113556.1.4.319"
+	startTLSOID     = "1.3.6.1.4.1.1466.20037"
+	defaultPageSize = 500
+	readBufferSize  = 32 * 1024
+	protocolVersion = 3
+	defaultPort     = "389"
+	defaultTLSPort  = "636"
+)
+
+var (
+	// ErrNoEntry - A base object search didn't return the object
+	ErrNoEntry = errors.New("no such entry")
+
+	// ErrTruncated - More entries matched than the limit of a search
+	ErrTruncated = errors.New("search results were truncated")
+
+	// ErrInsecureBind - A simple bind would send the password in the clear
+	ErrInsecureBind = errors.New("refusing to send a simple bind without tls")
+)
+
+// ResultError - An LDAP operation that didn't succeed
+type ResultError struct {
+	Code    int
+	Message string
+}
+
+func (e *ResultError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ldap result code %d", e.Code)
+	}
+	return fmt.Sprintf("ldap result code %d: %s", e.Code, e.Message)
+}
+
+// Entry - An object returned by a search, with its raw attribute values
+type Entry struct {
+	DN         string
+	Attributes map[string][][]byte
+}
+
+// Values - Values of an attribute, attribute names are case insensitive and options
+// are ignored (e.g. the first values of a large group's member;range=0-1499)
+func (e *Entry) Values(name string) [][]byte {
+	for key, values := range e.Attributes {
+		key, _, _ = strings.Cut(key, ";")
+		if strings.EqualFold(key, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+// First - The first value of an attribute as a string, blank if it has no values
+func (e *Entry) First(name string) string {
+	values := e.Values(name)
+	if len(values) == 0 {
+		return ""
+	}
+	return string(values[0])
+}
+
+// Conn - An LDAP client connection, every operation has to complete within the timeout
+type Conn struct {
+	conn      net.Conn
+	server    string // host:port the certificate is verified against
+	tls       bool
+	timeout   time.Duration
+	messageID int
+	buffer    []byte
+}
+
+// NewConn - A client of an established connection
+func NewConn(conn net.Conn, timeout time.Duration) *Conn {
+	return &Conn{conn: conn, timeout: timeout}
+}
+
+// Dial - Connect to an LDAP server, with a tls config the connection uses ldaps
+// otherwise it's in the clear until StartTLS
+func Dial(server string, config *tls.Config, timeout time.Duration) (*Conn, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		port := defaultPort
+		if config != nil {
+			port = defaultTLSPort
+		}
+		server = net.JoinHostPort(server, port)
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	if config == nil {
+		conn, err := dialer.Dial("tcp", server)
+		if err != nil {
+			return nil, err
+		}
+		c := NewConn(conn, timeout)
+		c.server = server
+		return c, nil
+	}
+	conn, err := tls.DialWithDialer(dialer, "tcp", server, serverConfig(server, config))
+	if err != nil {
+		return nil, err
+	}
+	c := NewConn(conn, timeout)
+	c.server = server
+	c.tls = true
+	return c, nil
+}
+
+// StartTLS - Upgrade the connection to tls (RFC 4511 section 4.14)
+func (c *Conn) StartTLS(config *tls.Config) error {
+	id, err := c.send(berEncode(opExtendedReq, berEncode(tagRequestName, []byte(startTLSOID))))
+	if err != nil {
+		return err
+	}
+	for {
+		tag, op, _, err := c.receive(id)
+		if err != nil {
+			return err
+		}
+		if tag != opExtendedResp {
+			continue
+		}
+		err = resultError(op)
+		if err != nil {
+			return err
+		}
+		break
+	}
+	if len(c.buffer) != 0 {
+		return errInvalidBER // The server can't have sent anything after its response
+	}
+	server := c.server
+	if server == "" {
+		server = c.conn.RemoteAddr().String()
+	}
+	conn := tls.Client(c.conn, serverConfig(server, config))
+	err = conn.SetDeadline(time.Now().Add(c.timeout))
+	if err != nil {
+		return err
+	}
+	err = conn.Handshake()
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+	c.tls = true
+	return nil
+}
+
+// serverConfig - Verify the certificate of the server's hostname unless the config
+// already has one
+func serverConfig(server string, config *tls.Config) *tls.Config {
+	if config.ServerName != "" {
+		return config
+	}
+	config = config.Clone()
+	config.ServerName, _, _ = net.SplitHostPort(server)
+	return config
+}
+
+// Close - Unbind and close the connection
+func (c *Conn) Close() error {
+	c.conn.SetDeadline(time.Now().Add(c.timeout))
+	c.send(berEncode(opUnbindRequest))
+	return c.conn.Close()
+}
+
+// Bind - Simple bind, the password is sent as is so the connection has to use tls
+func (c *Conn) Bind(username string, password string) error {
+	if !c.tls {
+		return ErrInsecureBind
+	}
+	id, err := c.send(berEncode(opBindRequest,
+		berInt(berInteger, protocolVersion),
+		berString(username),
+		berEncode(tagSimpleAuth, []byte(password)),
+	))
+	if err != nil {
+		return err
+	}
+	for {
+		tag, op, _, err := c.receive(id)
+		if err != nil {
+			return err
+		}
+		if tag == opBindResponse {
+			return resultError(op)
+		}
+	}
+}
+
+// RootDSE - Read the rootDSE, which servers allow without binding
+func (c *Conn) RootDSE(attributes ...string) (*Entry, error) {
+	entries, _, err := c.searchPage("", scopeBaseObject, Present("objectClass"), attributes, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, ErrNoEntry
+	}
+	return entries[0], nil
+}
+
+// Search - Subtree search of a base dn, the results are read in pages so servers
+// don't stop at their size limit. With a limit, at most limit entries are returned
+// along with ErrTruncated if more matched.
+func (c *Conn) Search(baseDN string, filter Filter, attributes []string, limit int) ([]*Entry, error) {
+	entries := []*Entry{}
+	cookie := []byte{}
+	for {
+		page, next, err := c.searchPage(baseDN, scopeSubtree, filter, attributes, cookie)
+		entries = append(entries, page...)
+		if err != nil {
+			return entries, err
+		}
+		if 0 < limit && limit < len(entries) {
+			return entries[:limit], ErrTruncated
+		}
+		if len(next) == 0 {
+			return entries, nil
+		}
+		cookie = next
+	}
+}
+
+// searchPage - One page of a search and the cookie of the next page, paging is
+// only requested if cookie isn't nil
+func (c *Conn) searchPage(baseDN string, scope int, filter Filter, attributes []string, cookie []byte) ([]*Entry, []byte, error) {
+	attributeList := [][]byte{}
+	for _, attribute := range attributes {
+		attributeList = append(attributeList, berString(attribute))
+	}
+	search := berEncode(opSearchRequest,
+		berString(baseDN),
+		berInt(berEnumerated, scope),
+		berInt(berEnumerated, 0), // derefAliases: never
+		berInt(berInteger, 0),    // sizeLimit
+		berInt(berInteger, 0),    // timeLimit
+		berBool(false),           // typesOnly
+		filter.encode(),
+		berEncode(berSequence, attributeList...),
+	)
+	var controls []byte
+	if cookie != nil {
+		controls = berEncode(tagControls, berEncode(berSequence,
+			berString(pagedResultsOID),
+			berEncode(berOctetString, berEncode(berSequence, berInt(berInteger, defaultPageSize), berEncode(berOctetString, cookie))),
+		))
+	}
+	id, err := c.send(search, controls)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := []*Entry{}
+	for {
+		tag, op, controls, err := c.receive(id)
+		if err != nil {
+			return entries, nil, err
+		}
+		switch tag {
+		case opSearchEntry:
+			entry, err := parseEntry(op)
+			if err != nil {
+				return entries, nil, err
+			}
+			entries = append(entries, entry)
+		case opSearchDone:
+			err := resultError(op)
+			if err != nil {
+				if result, ok := err.(*ResultError); ok && result.Code == resultSizeLimit {
+					return entries, nil, ErrTruncated
+				}
+				return entries, nil, err
+			}
+			next, err := pagedCookie(controls)
+			return entries, next, err
+		}
+	}
+}
+
+// send - Send an operation as the next message, returning its message ID
+func (c *Conn) send(op []byte, controls ...[]byte) (int, error) {
+	c.messageID++
+	err := c.conn.SetDeadline(time.Now().Add(c.timeout))
+	if err != nil {
+		return 0, err
+	}
+	_, err = c.conn.Write(berEncode(berSequence, append([][]byte{berInt(berInteger, c.messageID), op}, controls...)...))
+	return c.messageID, err
+}
+
+// receive - The tag, contents, and controls of the next operation sent in reply
+// to a message, replies to other messages are discarded
+func (c *Conn) receive(id int) (byte, []byte, []byte, error) {
+	for {
+		message, err := c.readMessage()
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		_, messageID, rest, err := berDecode(message)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		tag, op, rest, err := berDecode(rest)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		if value, err := berDecodeInt(messageID); err != nil || value != id {
+			continue
+		}
+		var controls []byte
+		if 0 < len(rest) {
+			if tag, contents, _, err := berDecode(rest); err == nil && tag == tagControls {
+				controls = contents
+			}
+		}
+		return tag, op, controls, nil
+	}
+}
+
+// readMessage - The contents of the next complete message
+func (c *Conn) readMessage() ([]byte, error) {
+	buf := make([]byte, readBufferSize)
+	for {
+		tag, message, rest, err := berDecode(c.buffer)
+		if err == nil {
+			if tag != berSequence {
+				return nil, errInvalidBER
+			}
+			c.buffer = rest
+			return message, nil
+		}
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		err = c.conn.SetDeadline(time.Now().Add(c.timeout))
+		if err != nil {
+			return nil, err
+		}
+		n, err := c.conn.Read(buf)
+		c.buffer = append(c.buffer, buf[:n]...)
+		if err != nil && n == 0 {
+			if errors.Is(err, io.EOF) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+}
+
+// resultError - The error of an LDAPResult, nil on success
+func resultError(result []byte) error {
+	_, code, rest, err := berDecode(result)
+	if err != nil {
+		return err
+	}
+	resultCode, err := berDecodeInt(code)
+	if err != nil {
+		return err
+	}
+	if resultCode == resultSuccess {
+		return nil
+	}
+	_, _, rest, err = berDecode(rest) // matchedDN
+	if err != nil {
+		return &ResultError{Code: resultCode}
+	}
+	_, message, _, err := berDecode(rest)
+	if err != nil {
+		return &ResultError{Code: resultCode}
+	}
+	return &ResultError{Code: resultCode, Message: strings.TrimRight(string(message), "\x00\n")}
+}
+
+// pagedCookie - The cookie of the paged results control, blank after the last page
+func pagedCookie(controls []byte) ([]byte, error) {
+	for 0 < len(controls) {
+		var control []byte
+		var err error
+		_, control, controls, err = berDecode(controls)
+		if err != nil {
+			return nil, err
+		}
+		_, oid, rest, err := berDecode(control)
+		if err != nil {
+			return nil, err
+		}
+		if string(oid) != pagedResultsOID {
+			continue
+		}
+		tag, value, rest, err := berDecode(rest)
+		if err == nil && tag == berBoolean {
+			_, value, _, err = berDecode(rest) // skip the criticality
+		}
+		if err != nil {
+			return nil, err
+		}
+		_, pagedResults, _, err := berDecode(value)
+		if err != nil {
+			return nil, err
+		}
+		_, _, rest, err = berDecode(pagedResults) // size
+		if err != nil {
+			return nil, err
+		}
+		_, cookie, _, err := berDecode(rest)
+		return cookie, err
+	}
+	return nil, nil
+}
+
+func parseEntry(op []byte) (*Entry, error) {
+	_, dn, rest, err := berDecode(op)
+	if err != nil {
+		return nil, err
+	}
+	_, list, _, err := berDecode(rest)
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{DN: string(dn), Attributes: map[string][][]byte{}}
+	for 0 < len(list) {
+		var attribute []byte
+		_, attribute, list, err = berDecode(list)
+		if err != nil {
+			return nil, err
+		}
+		_, name, values, err := berDecode(attribute)
+		if err != nil {
+			return nil, err
+		}
+		_, values, _, err = berDecode(values)
+		if err != nil {
+			return nil, err
+		}
+		for 0 < len(values) {
+			var value []byte
+			_, value, values, err = berDecode(values)
+			if err != nil {
+				return nil, err
+			}
+			entry.Attributes[string(name)] = append(entry.Attributes[string(name)], value)
+		}
+	}
+	return entry, nil
+}
+
+// DomainName - The dns domain of a dn, e.g. corp.local of DC=corp,DC=local
+func DomainName(dn string) string {
+	labels := []string{}
+	for _, component := range strings.Split(dn, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(component), "=")
+		if ok && strings.EqualFold(key, "dc") {
+			labels = append(labels, value)
+		}
+	}
+	return strings.Join(labels, ".")
+}
+
+// BaseDN - The dn of a dns domain, e.g. DC=corp,DC=local of corp.local
+func BaseDN(domain string) string {
+	if domain == "" {
+		return ""
+	}
+	components := []string{}
+	for _, label := range strings.Split(strings.Trim(domain, "."), ".") {
+		components = append(components, "DC="+label)
+	}
+	return strings.Join(components, ",")
+}
@@ -0,0 +1,153 @@
+package ldap
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Context specific tags of search filters (RFC 4511 4.5.1)
+const (
+	filterAnd          = 0xa0
+	filterOr           = 0xa1
+	filterNot          = 0xa2
+	filterEqual        = 0xa3
+	filterPresent      = 0x87
+	filterExtensible   = 0xa9
+	matchingRule       = 0x81
+	matchingType       = 0x82
+	matchingValue      = 0x83
+	matchingRuleBitAnd = "1.2.840.113556.1.4.803"
+)
+
+// Filter - A search filter, built once so the same filter can be sent by this
+// client or passed as a string to the system's LDAP library
+type Filter interface {
+	fmt.Stringer
+	encode() []byte
+}
+
+type compositeFilter struct {
+	tag     byte
+	op      string
+	filters []Filter
+}
+
+func (f *compositeFilter) String() string {
+	parts := []string{}
+	for _, filter := range f.filters {
+		parts = append(parts, filter.String())
+	}
+	return "(" + f.op + strings.Join(parts, "") + ")"
+}
+
+func (f *compositeFilter) encode() []byte {
+	encoded := [][]byte{}
+	for _, filter := range f.filters {
+		encoded = append(encoded, filter.encode())
+	}
+	return berEncode(f.tag, encoded...)
+}
+
+// And - Entries matching every filter
+func And(filters ...Filter) Filter {
+	return &compositeFilter{tag: filterAnd, op: "&", filters: filters}
+}
+
+// Or - Entries matching any of the filters
+func Or(filters ...Filter) Filter {
+	return &compositeFilter{tag: filterOr, op: "|", filters: filters}
+}
+
+// Not - Entries not matching the filter
+func Not(filter Filter) Filter {
+	return &compositeFilter{tag: filterNot, op: "!", filters: []Filter{filter}}
+}
+
+type equalFilter struct {
+	attribute string
+	value     string
+}
+
+func (f *equalFilter) String() string {
+	return "(" + f.attribute + "=" + escapeValue(f.value) + ")"
+}
+
+func (f *equalFilter) encode() []byte {
+	return berEncode(filterEqual, berString(f.attribute), berString(f.value))
+}
+
+// Equal - Entries with an attribute value
+func Equal(attribute string, value string) Filter {
+	return &equalFilter{attribute: attribute, value: value}
+}
+
+type presentFilter string
+
+func (f presentFilter) String() string {
+	return "(" + string(f) + "=*)"
+}
+
+func (f presentFilter) encode() []byte {
+	return berEncode(filterPresent, []byte(f))
+}
+
+// Present - Entries that have an attribute
+func Present(attribute string) Filter {
+	return presentFilter(attribute)
+}
+
+type bitAndFilter struct {
+	attribute string
+	bits      uint32
+}
+
+func (f *bitAndFilter) String() string {
+	return fmt.Sprintf("(%s:%s:=%d)", f.attribute, matchingRuleBitAnd, f.bits)
+}
+
+func (f *bitAndFilter) encode() []byte {
+	return berEncode(filterExtensible,
+		berEncode(matchingRule, []byte(matchingRuleBitAnd)),
+		berEncode(matchingType, []byte(f.attribute)),
+		berEncode(matchingValue, []byte(strconv.FormatUint(uint64(f.bits), 10))),
+	)
+}
+
+// BitAnd - Entries with all of the bits set in an integer attribute, such as the
+// flags of userAccountControl
+func BitAnd(attribute string, bits uint32) Filter {
+	return &bitAndFilter{attribute: attribute, bits: bits}
+}
+
+// escapeValue - Escape the characters that are special in a filter string (RFC 4515)
+func escapeValue(value string) string {
+	escaped := strings.Builder{}
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; c {
+		case '*', '(', ')', '\\', 0:
+			fmt.Fprintf(&escaped, "\\%02x", c)
+		default:
+			escaped.WriteByte(c)
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,221 @@
+package ldap
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestBERInt(t *testing.T) {
+	for value, expected := range map[int][]byte{
+		0:    {0x02, 0x01, 0x00},
+		127:  {0x02, 0x01, 0x7f},
+		128:  {0x02, 0x02, 0x00, 0x80},
+		500:  {0x02, 0x02, 0x01, 0xf4},
+		-1:   {0x02, 0x01, 0xff},
+		-129: {0x02, 0x02, 0xff, 0x7f},
+	} {
+		encoded := berInt(berInteger, value)
+		if !bytes.Equal(encoded, expected) {
+			t.Errorf("Expected %x for %d, got %x", expected, value, encoded)
+		}
+		if decoded, err := berDecodeInt(encoded[2:]); err != nil || decoded != value {
+			t.Errorf("Expected %d to round trip, got %d (%v)", value, decoded, err)
+		}
+	}
+
+	long := bytes.Repeat([]byte{'a'}, 70000)
+	if _, decoded, _, err := berDecode(berEncode(berOctetString, long)); err != nil || !bytes.Equal(decoded, long) {
+		t.Errorf("Long form length didn't round trip (%v)", err)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	filter := And(
+		Equal("objectCategory", "person"),
+		Present("servicePrincipalName"),
+		Not(BitAnd("userAccountControl", 2)),
+		Or(Equal("cn", "a*(b)"), Equal("cn", "c")),
+	)
+	expected := `(&(objectCategory=person)(servicePrincipalName=*)(!(userAccountControl:1.2.840.113556.1.4.803:=2))(|(cn=a\2a\28b\29)(cn=c)))`
+	if filter.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, filter.String())
+	}
+	tag, contents, rest, err := berDecode(filter.encode())
+	if err != nil || tag != filterAnd || len(rest) != 0 {
+		t.Fatalf("Unexpected encoding %x (%v)", contents, err)
+	}
+	_, equal, contents, _ := berDecode(contents)
+	if !bytes.Equal(equal, append(berString("objectCategory"), berString("person")...)) {
+		t.Errorf("Unexpected equality filter %x", equal)
+	}
+	if tag, present, _, _ := berDecode(contents); tag != filterPresent || string(present) != "servicePrincipalName" {
+		t.Errorf("Unexpected present filter %x %s", tag, present)
+	}
+}
+
+func TestDomainName(t *testing.T) {
+	if domain := DomainName("CN=Users,DC=corp,DC=local"); domain != "corp.local" {
+		t.Errorf("Unexpected domain %s", domain)
+	}
+	if dn := BaseDN("corp.local."); dn != "DC=corp,DC=local" {
+		t.Errorf("Unexpected dn %s", dn)
+	}
+}
+
+// testCertificate - A self signed certificate for dc01.corp.local
+func testCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "dc01.corp.local"},
+		DNSNames:     []string{"dc01.corp.local"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+// fakeServer - Answers StartTLS, a bind, and a search of two pages of one entry each
+func fakeServer(conn net.Conn, cert tls.Certificate) {
+	server := NewConn(conn, time.Second)
+	defer func() { conn.Close() }()
+	reply := func(id []byte, ops ...[]byte) {
+		for _, op := range ops {
+			conn.Write(berEncode(berSequence, berEncode(berInteger, id), op))
+		}
+	}
+	done := berEncode(opSearchDone, berInt(berEnumerated, resultSuccess), berString(""), berString(""))
+	for {
+		message, err := server.readMessage()
+		if err != nil {
+			return
+		}
+		_, id, rest, _ := berDecode(message)
+		tag, op, rest, _ := berDecode(rest)
+		switch tag {
+		case opExtendedReq:
+			_, name, _, _ := berDecode(op)
+			if string(name) != startTLSOID {
+				return
+			}
+			reply(id, berEncode(opExtendedResp, berInt(berEnumerated, resultSuccess), berString(""), berString("")))
+			conn = tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{cert}})
+			server.conn = conn
+		case opBindRequest:
+			_, _, op, _ = berDecode(op)
+			_, name, _, _ := berDecode(op)
+			code := resultSuccess
+			if string(name) != "alice@corp.local" {
+				code = 49
+			}
+			reply(id, berEncode(opBindResponse, berInt(berEnumerated, code), berString(""), berString("80090308: invalid credentials")))
+		case opSearchRequest:
+			_, baseDN, _, _ := berDecode(op)
+			if len(rest) == 0 {
+				entry := berEncode(opSearchEntry, berString(""), berEncode(berSequence,
+					berEncode(berSequence, berString("defaultNamingContext"), berEncode(berSet, berString("DC=corp,DC=local"))),
+				))
+				reply(id, entry, done)
+				continue
+			}
+			cookie := []byte("page-2")
+			name := "alice"
+			if bytes.Contains(rest, cookie) {
+				cookie, name = []byte{}, "bob"
+			}
+			entry := berEncode(opSearchEntry, berString("CN="+name+","+string(baseDN)), berEncode(berSequence,
+				berEncode(berSequence, berString("sAMAccountName"), berEncode(berSet, berString(name))),
+				berEncode(berSequence, berString("memberOf"), berEncode(berSet, berString("CN=A"), berString("CN=B"))),
+			))
+			paged := berEncode(berSequence, berInt(berInteger, 0), berEncode(berOctetString, cookie))
+			pageDone := berEncode(berSequence, berEncode(berInteger, id), done,
+				berEncode(tagControls, berEncode(berSequence, berString(pagedResultsOID), berEncode(berOctetString, paged))))
+			reply(id, entry)
+			conn.Write(pageDone)
+		}
+	}
+}
+
+func TestConn(t *testing.T) {
+	cert, pool := testCertificate(t)
+	clientConn, serverConn := net.Pipe()
+	go fakeServer(serverConn, cert)
+	client := NewConn(clientConn, time.Second)
+	defer client.Close()
+
+	rootDSE, err := client.RootDSE("defaultNamingContext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootDSE.First("defaultnamingcontext") != "DC=corp,DC=local" {
+		t.Errorf("Unexpected rootDSE %v", rootDSE)
+	}
+
+	if err := client.Bind("alice@corp.local", "password"); !errors.Is(err, ErrInsecureBind) {
+		t.Fatalf("Expected the bind to be refused without tls, got %v", err)
+	}
+	if err := client.StartTLS(&tls.Config{RootCAs: pool, ServerName: "dc01.corp.local"}); err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Bind("mallory@corp.local", "password")
+	if result, ok := err.(*ResultError); !ok || result.Code != 49 || result.Message != "80090308: invalid credentials" {
+		t.Errorf("Expected invalid credentials, got %v", err)
+	}
+	if err := client.Bind("alice@corp.local", "password"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := client.Search("DC=corp,DC=local", Equal("objectClass", "user"), []string{"sAMAccountName"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[1].First("samaccountname") != "bob" || len(entries[0].Values("memberOf")) != 2 {
+		t.Errorf("Unexpected entries %v", entries)
+	}
+	entries, err = client.Search("DC=corp,DC=local", Equal("objectClass", "user"), []string{"sAMAccountName"}, 1)
+	if !errors.Is(err, ErrTruncated) || len(entries) != 1 {
+		t.Errorf("Expected one entry to be returned, got %d (%v)", len(entries), err)
+	}
+}
@@ -1,4 +1,4 @@
-//go:build 386 || amd64 || arm
+//go:build 386 || amd64 || arm || arm64
 
 package limits
 
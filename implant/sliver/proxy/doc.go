@@ -9,6 +9,7 @@
 //		Internet Options: Use automatic configuration script (PAC)
 //		Internet Options: Manual proxy server
 //		WINHTTP: (netsh winhttp)
+//		WPAD, if the Internet Options don't enable it (e.g. services)
 //
 //	Linux:
 //		Configuration File
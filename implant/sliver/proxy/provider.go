@@ -101,6 +101,13 @@ type Provider interface {
 			receive: Time in milliseconds to receive a response to a request. Provider default is 20000.
 	*/
 	SetTimeouts(resolve int, connect int, send int, receive int)
+	/*
+		Enable or disable automatic proxy discovery (i.e. WPAD/PAC), when disabled only statically
+		configured proxies are returned. Provider default is enabled.
+		Params:
+			enabled: Whether proxies may be discovered automatically.
+	*/
+	SetAutoProxy(enabled bool)
 }
 
 const (
@@ -136,6 +143,7 @@ type provider struct {
 	connectTimeout int
 	sendTimeout    int
 	receiveTimeout int
+	autoProxy      bool
 }
 
 func (p *provider) init(configFile string) {
@@ -146,6 +154,7 @@ func (p *provider) init(configFile string) {
 	p.connectTimeout = defaultConnectTimeout
 	p.sendTimeout = defaultSendTimeout
 	p.receiveTimeout = defaultReceiveTimeout
+	p.autoProxy = true
 }
 
 /*
@@ -169,6 +178,16 @@ func (p *provider) SetTimeouts(resolve int, connect int, send int, receive int)
 	p.receiveTimeout = receive
 }
 
+/*
+Enable or disable automatic proxy discovery (i.e. WPAD/PAC), when disabled only statically
+configured proxies are returned. Provider default is enabled.
+Params:
+	enabled: Whether proxies may be discovered automatically.
+*/
+func (p *provider) SetAutoProxy(enabled bool) {
+	p.autoProxy = enabled
+}
+
 /*
 Returns the Proxy configuration for the given traffic protocol and targetUrl.
 If none is found, or an error occurs, nil is returned.
@@ -46,6 +46,8 @@ This function searches the following locations in the following order:
 	* IE Proxy Config: AutoConfig URL
 	* IE Proxy Config: Manual
 	* WinHTTP Default
+	* WPAD, if it wasn't enabled in the IE Proxy Config (e.g. services)
+Automatic discovery (AutoDetect, AutoConfig URL, and WPAD) is skipped if disabled with SetAutoProxy.
 Params:
 	protocol: The protocol of traffic the proxy is to be used for. (i.e. http, https, ftp, socks)
 	targetUrl: The URL the proxy is to be used for. (i.e. https://test.endpoint.rapid7.com)
@@ -128,6 +130,7 @@ type providerWindows struct {
 
 //noinspection SpellCheckingInspection
 func (p *providerWindows) readWinHttpProxy(protocol string, targetUrl *url.URL) Proxy {
+	autoDetected := false
 	// Internet Options
 	ieProxyConfig, err := p.getIeProxyConfigCurrentUser()
 	if err != nil {
@@ -136,7 +139,8 @@ func (p *providerWindows) readWinHttpProxy(protocol string, targetUrl *url.URL)
 		// {{end}}
 	} else {
 		defer p.freeWinHttpResource(ieProxyConfig)
-		if ieProxyConfig.FAutoDetect {
+		if p.autoProxy && ieProxyConfig.FAutoDetect {
+			autoDetected = true
 			proxy, err := p.getProxyAutoDetect(protocol, targetUrl)
 			if err == nil {
 				return proxy
@@ -146,7 +150,7 @@ func (p *providerWindows) readWinHttpProxy(protocol string, targetUrl *url.URL)
 				// {{end}}
 			}
 		}
-		if autoConfigUrl := winhttp.LpwstrToString(ieProxyConfig.LpszAutoConfigUrl); autoConfigUrl != "" {
+		if autoConfigUrl := winhttp.LpwstrToString(ieProxyConfig.LpszAutoConfigUrl); p.autoProxy && autoConfigUrl != "" {
 			proxy, err := p.getProxyAutoConfigUrl(protocol, targetUrl, autoConfigUrl)
 			if err == nil {
 				return proxy
@@ -174,6 +178,17 @@ func (p *providerWindows) readWinHttpProxy(protocol string, targetUrl *url.URL)
 		log.Printf("[proxy.Provider.readWinHttpProxy] Failed to parse WinHttp default proxy info: %s\n", err)
 		// {{end}}
 	}
+	// WPAD, accounts without Internet Options (i.e. services) never enable it
+	if p.autoProxy && !autoDetected {
+		proxy, err := p.getProxyAutoDetect(protocol, targetUrl)
+		if err == nil {
+			return proxy
+		} else if !isNotFound(err) {
+			// {{if .Config.Debug}}
+			log.Printf("[proxy.Provider.readWinHttpProxy] No proxy discovered via WPAD: %s\n", err)
+			// {{end}}
+		}
+	}
 	return nil
 }
 
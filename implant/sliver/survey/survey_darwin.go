@@ -0,0 +1,59 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bishopfox/sliver/implant/sliver/edr"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"golang.org/x/sys/unix"
+)
+
+func collect() *commonpb.HostSurvey {
+	return &commonpb.HostSurvey{
+		SecurityProducts: edr.InstalledProducts(),
+		Domain:           activeDirectoryNode(),
+		Proxy:            proxySettings(),
+		Runtimes:         runtimes(),
+		Virtualization:   virtualization(),
+		LocalAdmin:       localAdmin(),
+	}
+}
+
+// activeDirectoryNode - Macs bound to AD have a config named after the domain's node
+func activeDirectoryNode() string {
+	configs, _ := filepath.Glob("/Library/Preferences/OpenDirectory/Configurations/Active Directory/*.plist")
+	if len(configs) == 0 {
+		return ""
+	}
+	return strings.TrimSuffix(filepath.Base(configs[0]), ".plist")
+}
+
+func virtualization() []string {
+	indicators := []string{}
+	if present, err := unix.SysctlUint32("kern.hv_vmm_present"); err == nil && present == 1 {
+		indicators = append(indicators, "kern.hv_vmm_present")
+	}
+	if model, err := unix.Sysctl("hw.model"); err == nil && (isVirtualized(model) || strings.HasPrefix(model, "VirtualMac")) {
+		indicators = append(indicators, "hw.model: "+model)
+	}
+	return indicators
+}
@@ -0,0 +1,98 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bishopfox/sliver/implant/sliver/edr"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+func collect() *commonpb.HostSurvey {
+	return &commonpb.HostSurvey{
+		SecurityProducts: securityProcesses(),
+		Domain:           kerberosRealm(),
+		Proxy:            proxySettings(),
+		Runtimes:         runtimes(),
+		Virtualization:   virtualization(),
+		LocalAdmin:       localAdmin(),
+	}
+}
+
+// securityProcesses - Products of running processes, from their /proc/<pid>/comm names
+func securityProcesses() []string {
+	comms, _ := filepath.Glob("/proc/[0-9]*/comm")
+	names := []string{}
+	for _, comm := range comms {
+		name, err := os.ReadFile(comm)
+		if err == nil {
+			names = append(names, string(name))
+		}
+	}
+	return edr.MatchProducts(names)
+}
+
+// kerberosRealm - The default realm of hosts joined to an AD domain (e.g. by sssd or winbind)
+func kerberosRealm() string {
+	krb5, err := os.Open("/etc/krb5.conf")
+	if err != nil {
+		return ""
+	}
+	defer krb5.Close()
+	scanner := bufio.NewScanner(krb5)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if found && strings.TrimSpace(key) == "default_realm" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+func virtualization() []string {
+	indicators := []string{}
+	vendor, _ := os.ReadFile("/sys/class/dmi/id/sys_vendor")
+	product, _ := os.ReadFile("/sys/class/dmi/id/product_name")
+	firmware := strings.TrimSpace(string(vendor)) + " " + strings.TrimSpace(string(product))
+	if isVirtualized(firmware) {
+		indicators = append(indicators, "dmi: "+strings.TrimSpace(firmware))
+	}
+	if cpuinfo, err := os.ReadFile("/proc/cpuinfo"); err == nil {
+		for _, line := range strings.Split(string(cpuinfo), "\n") {
+			if strings.HasPrefix(line, "flags") && strings.Contains(line, " hypervisor") {
+				indicators = append(indicators, "cpu: hypervisor")
+				break
+			}
+		}
+	}
+	if _, err := os.Stat("/.dockerenv"); err == nil {
+		indicators = append(indicators, "container: docker")
+	}
+	if _, err := os.Stat("/run/.containerenv"); err == nil {
+		indicators = append(indicators, "container: podman")
+	}
+	if cgroup, err := os.ReadFile("/proc/1/cgroup"); err == nil && strings.Contains(string(cgroup), "kubepods") {
+		indicators = append(indicators, "container: kubernetes")
+	}
+	return indicators
+}
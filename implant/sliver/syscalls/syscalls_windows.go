@@ -46,3 +46,46 @@ package syscalls
 //sys LookupPrivilegeDisplayNameW(systemName string, privilegeName *uint16, buffer *uint16, size *uint32, languageId *uint32) (err error) = advapi32.LookupPrivilegeDisplayNameW
 
 //sys Module32FirstW(hSnapshot windows.Handle, lpme *MODULEENTRY32W) (err error) = kernel32.Module32FirstW
+
+//sys GetLastInputInfo(plii *LASTINPUTINFO) (err error) = User32.GetLastInputInfo
+//sys OpenInputDesktop(dwFlags uint32, fInherit bool, dwDesiredAccess uint32) (hDesktop windows.Handle, err error) = User32.OpenInputDesktop
+//sys CloseDesktop(hDesktop windows.Handle) (err error) = User32.CloseDesktop
+//sys GetSystemPowerStatus(lpSystemPowerStatus *SYSTEM_POWER_STATUS) (err error) = kernel32.GetSystemPowerStatus
+//sys DsGetDcName(computerName *uint16, domainName *uint16, domainGuid *windows.GUID, siteName *uint16, flags uint32, domainControllerInfo **DOMAIN_CONTROLLER_INFO) (neterr error) = netapi32.DsGetDcNameW
+//sys FwpmEngineOpen0(serverName *uint16, authnService uint32, authIdentity uintptr, session uintptr, engineHandle *windows.Handle) (ret error) = fwpuclnt.FwpmEngineOpen0
+//sys FwpmEngineClose0(engineHandle windows.Handle) (ret error) = fwpuclnt.FwpmEngineClose0
+//sys FwpmProviderCreateEnumHandle0(engineHandle windows.Handle, enumTemplate uintptr, enumHandle *windows.Handle) (ret error) = fwpuclnt.FwpmProviderCreateEnumHandle0
+//sys FwpmProviderEnum0(engineHandle windows.Handle, enumHandle windows.Handle, numEntriesRequested uint32, entries ***FWPM_PROVIDER0, numEntriesReturned *uint32) (ret error) = fwpuclnt.FwpmProviderEnum0
+//sys FwpmProviderDestroyEnumHandle0(engineHandle windows.Handle, enumHandle windows.Handle) (ret error) = fwpuclnt.FwpmProviderDestroyEnumHandle0
+//sys FwpmFilterCreateEnumHandle0(engineHandle windows.Handle, enumTemplate uintptr, enumHandle *windows.Handle) (ret error) = fwpuclnt.FwpmFilterCreateEnumHandle0
+//sys FwpmFilterEnum0(engineHandle windows.Handle, enumHandle windows.Handle, numEntriesRequested uint32, entries ***FWPM_FILTER0, numEntriesReturned *uint32) (ret error) = fwpuclnt.FwpmFilterEnum0
+//sys FwpmFilterDestroyEnumHandle0(engineHandle windows.Handle, enumHandle windows.Handle) (ret error) = fwpuclnt.FwpmFilterDestroyEnumHandle0
+//sys FwpmFreeMemory0(p *uintptr) = fwpuclnt.FwpmFreeMemory0
+//sys GetIpNetTable(ipNetTable *byte, size *uint32, order bool) (ret error) = iphlpapi.GetIpNetTable
+//sys SendARP(destIP uint32, srcIP uint32, macAddr *byte, physAddrLen *uint32) (ret error) = iphlpapi.SendARP
+//sys NetShareEnum(serverName *uint16, level uint32, buf **byte, prefMaxLen uint32, entriesRead *uint32, totalEntries *uint32, resumeHandle *uint32) (neterr error) = netapi32.NetShareEnum
+//sys NetSessionEnum(serverName *uint16, clientName *uint16, userName *uint16, level uint32, buf **byte, prefMaxLen uint32, entriesRead *uint32, totalEntries *uint32, resumeHandle *uint32) (neterr error) = netapi32.NetSessionEnum
+//sys LdapInit(hostName *uint16, portNumber uint32) (ld uintptr) = wldap32.ldap_initW
+//sys LdapSslInit(hostName *uint16, portNumber uint32, secure int32) (ld uintptr) = wldap32.ldap_sslinitW
+//sys LdapSetOption(ld uintptr, option int32, value unsafe.Pointer) (code uint32) = wldap32.ldap_set_optionW
+//sys LdapGetOption(ld uintptr, option int32, value unsafe.Pointer) (code uint32) = wldap32.ldap_get_optionW
+//sys LdapConnect(ld uintptr, timeout *LDAP_TIMEVAL) (code uint32) = wldap32.ldap_connect
+//sys LdapBindS(ld uintptr, dn *uint16, cred unsafe.Pointer, method uint32) (code uint32) = wldap32.ldap_bind_sW
+//sys LdapUnbind(ld uintptr) (code uint32) = wldap32.ldap_unbind
+//sys LdapSearchS(ld uintptr, base *uint16, scope uint32, filter *uint16, attrs **uint16, attrsOnly uint32, res *uintptr) (code uint32) = wldap32.ldap_search_sW
+//sys LdapSearchInitPage(ld uintptr, base *uint16, scope uint32, filter *uint16, attrs **uint16, attrsOnly uint32, serverControls uintptr, clientControls uintptr, pageTimeLimit uint32, totalSizeLimit uint32, sortKeys uintptr) (search uintptr) = wldap32.ldap_search_init_pageW
+//sys LdapGetNextPageS(ld uintptr, search uintptr, timeout *LDAP_TIMEVAL, pageSize uint32, totalCount *uint32, res *uintptr) (code uint32) = wldap32.ldap_get_next_page_s
+//sys LdapSearchAbandonPage(ld uintptr, search uintptr) (code uint32) = wldap32.ldap_search_abandon_page
+//sys LdapFirstEntry(ld uintptr, res uintptr) (entry uintptr) = wldap32.ldap_first_entry
+//sys LdapNextEntry(ld uintptr, entry uintptr) (next uintptr) = wldap32.ldap_next_entry
+//sys LdapGetDn(ld uintptr, entry uintptr) (dn uintptr) = wldap32.ldap_get_dnW
+//sys LdapFirstAttribute(ld uintptr, entry uintptr, ber *uintptr) (attribute uintptr) = wldap32.ldap_first_attributeW
+//sys LdapNextAttribute(ld uintptr, entry uintptr, ber uintptr) (attribute uintptr) = wldap32.ldap_next_attributeW
+//sys LdapGetValuesLen(ld uintptr, entry uintptr, attribute *uint16) (values uintptr) = wldap32.ldap_get_values_lenW
+//sys LdapCountValuesLen(values uintptr) (count uint32) = wldap32.ldap_count_values_len
+//sys LdapValueFreeLen(values uintptr) (code uint32) = wldap32.ldap_value_free_len
+//sys LdapMemfree(block uintptr) = wldap32.ldap_memfreeW
+//sys LdapMsgfree(res uintptr) (code uint32) = wldap32.ldap_msgfree
+//sys BerFree(ber uintptr, freeBuf int32) = wldap32.ber_free
+//sys LdapGetLastError() (code uint32) = wldap32.LdapGetLastError
+//sys LdapErr2String(code uint32) (message uintptr) = wldap32.ldap_err2stringW
@@ -183,3 +183,146 @@ type MODULEENTRY32W struct {
 	SzModule      [MAX_MODULE_NAME32 + 1]uint16
 	SzExePath     [MAX_PATH]uint16
 }
+
+type LASTINPUTINFO struct {
+	CbSize uint32
+	DwTime uint32
+}
+
+const (
+	AC_LINE_OFFLINE = 0
+	AC_LINE_ONLINE  = 1
+	AC_LINE_UNKNOWN = 255
+
+	DESKTOP_READOBJECTS = 0x0001
+)
+
+type SYSTEM_POWER_STATUS struct {
+	ACLineStatus        byte
+	BatteryFlag         byte
+	BatteryLifePercent  byte
+	SystemStatusFlag    byte
+	BatteryLifeTime     uint32
+	BatteryFullLifeTime uint32
+}
+
+const (
+	DS_BACKGROUND_ONLY = 0x00000100
+	DS_RETURN_DNS_NAME = 0x40000000
+)
+
+type DOMAIN_CONTROLLER_INFO struct {
+	DomainControllerName        *uint16
+	DomainControllerAddress     *uint16
+	DomainControllerAddressType uint32
+	DomainGuid                  windows.GUID
+	DomainName                  *uint16
+	DnsForestName               *uint16
+	Flags                       uint32
+	DcSiteName                  *uint16
+	ClientSiteName              *uint16
+}
+
+const (
+	RPC_C_AUTHN_WINNT = 10
+)
+
+type FWPM_DISPLAY_DATA0 struct {
+	Name        *uint16
+	Description *uint16
+}
+
+type FWP_BYTE_BLOB struct {
+	Size uint32
+	Data *uint8
+}
+
+type FWPM_PROVIDER0 struct {
+	ProviderKey  windows.GUID
+	DisplayData  FWPM_DISPLAY_DATA0
+	Flags        uint32
+	ProviderData FWP_BYTE_BLOB
+	ServiceName  *uint16
+}
+
+// FWPM_FILTER0 - Only the leading fields, the rest of the struct is laid out
+// differently on 386 and is never read
+type FWPM_FILTER0 struct {
+	FilterKey   windows.GUID
+	DisplayData FWPM_DISPLAY_DATA0
+	Flags       uint32
+	ProviderKey *windows.GUID
+}
+
+const (
+	MAX_PREFERRED_LENGTH = 0xFFFFFFFF
+
+	STYPE_DISKTREE = 0
+	STYPE_PRINTQ   = 1
+	STYPE_DEVICE   = 2
+	STYPE_IPC      = 3
+	STYPE_MASK     = 0x000000FF
+
+	MIB_IPNET_TYPE_INVALID = 2
+)
+
+type SHARE_INFO_1 struct {
+	Netname *uint16
+	Type    uint32
+	Remark  *uint16
+}
+
+type SESSION_INFO_10 struct {
+	Cname    *uint16
+	Username *uint16
+	Time     uint32
+	IdleTime uint32
+}
+
+// MIB_IPNETROW - Rows of a MIB_IPNETTABLE follow its uint32 number of entries
+type MIB_IPNETROW struct {
+	Index       uint32
+	PhysAddrLen uint32
+	PhysAddr    [8]byte
+	Addr        [4]byte
+	Type        uint32
+}
+
+const (
+	LDAP_PORT                       = 389
+	LDAP_SSL_PORT                   = 636
+	LDAP_OPT_REFERRALS              = 0x08
+	LDAP_OPT_PROTOCOL_VERSION       = 0x11
+	LDAP_OPT_HOST_NAME              = 0x30
+	LDAP_OPT_SIGN                   = 0x95
+	LDAP_OPT_ON                     = 1
+	LDAP_OPT_OFF                    = 0
+	LDAP_VERSION3                   = 3
+	LDAP_AUTH_NEGOTIATE             = 0x0486
+	LDAP_SCOPE_BASE                 = 0
+	LDAP_SCOPE_SUBTREE              = 2
+	LDAP_SUCCESS                    = 0
+	LDAP_SIZELIMIT_EXCEEDED         = 0x04
+	LDAP_NO_RESULTS_RETURNED        = 0x5e
+	SEC_WINNT_AUTH_IDENTITY_UNICODE = 2
+)
+
+type LDAP_TIMEVAL struct {
+	Sec  int32
+	Usec int32
+}
+
+type LDAP_BERVAL struct {
+	Len uint32
+	Val *byte
+}
+
+type SEC_WINNT_AUTH_IDENTITY_W struct {
+	User           *uint16
+	UserLength     uint32
+	Domain         *uint16
+	DomainLength   uint32
+	Password       *uint16
+	PasswordLength uint32
+	Flags          uint32
+}
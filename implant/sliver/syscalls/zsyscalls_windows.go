@@ -43,9 +43,13 @@ var (
 	modKernel32 = windows.NewLazySystemDLL("Kernel32.dll")
 	modUser32   = windows.NewLazySystemDLL("User32.dll")
 	modadvapi32 = windows.NewLazySystemDLL("advapi32.dll")
+	modfwpuclnt = windows.NewLazySystemDLL("fwpuclnt.dll")
+	modiphlpapi = windows.NewLazySystemDLL("iphlpapi.dll")
 	modkernel32 = windows.NewLazySystemDLL("kernel32.dll")
+	modnetapi32 = windows.NewLazySystemDLL("netapi32.dll")
 	modntdll    = windows.NewLazySystemDLL("ntdll.dll")
 	modpsapi    = windows.NewLazySystemDLL("psapi.dll")
+	modwldap32  = windows.NewLazySystemDLL("wldap32.dll")
 
 	procMiniDumpWriteDump                 = modDbgHelp.NewProc("MiniDumpWriteDump")
 	procBitBlt                            = modGdi32.NewProc("BitBlt")
@@ -59,20 +63,35 @@ var (
 	procGlobalFree                        = modKernel32.NewProc("GlobalFree")
 	procGlobalLock                        = modKernel32.NewProc("GlobalLock")
 	procGlobalUnlock                      = modKernel32.NewProc("GlobalUnlock")
+	procCloseDesktop                      = modUser32.NewProc("CloseDesktop")
 	procGetDC                             = modUser32.NewProc("GetDC")
 	procGetDesktopWindow                  = modUser32.NewProc("GetDesktopWindow")
+	procGetLastInputInfo                  = modUser32.NewProc("GetLastInputInfo")
+	procOpenInputDesktop                  = modUser32.NewProc("OpenInputDesktop")
 	procReleaseDC                         = modUser32.NewProc("ReleaseDC")
 	procCreateProcessWithLogonW           = modadvapi32.NewProc("CreateProcessWithLogonW")
 	procImpersonateLoggedOnUser           = modadvapi32.NewProc("ImpersonateLoggedOnUser")
 	procLogonUserW                        = modadvapi32.NewProc("LogonUserW")
 	procLookupPrivilegeDisplayNameW       = modadvapi32.NewProc("LookupPrivilegeDisplayNameW")
 	procLookupPrivilegeNameW              = modadvapi32.NewProc("LookupPrivilegeNameW")
+	procFwpmEngineClose0                  = modfwpuclnt.NewProc("FwpmEngineClose0")
+	procFwpmEngineOpen0                   = modfwpuclnt.NewProc("FwpmEngineOpen0")
+	procFwpmFilterCreateEnumHandle0       = modfwpuclnt.NewProc("FwpmFilterCreateEnumHandle0")
+	procFwpmFilterDestroyEnumHandle0      = modfwpuclnt.NewProc("FwpmFilterDestroyEnumHandle0")
+	procFwpmFilterEnum0                   = modfwpuclnt.NewProc("FwpmFilterEnum0")
+	procFwpmFreeMemory0                   = modfwpuclnt.NewProc("FwpmFreeMemory0")
+	procFwpmProviderCreateEnumHandle0     = modfwpuclnt.NewProc("FwpmProviderCreateEnumHandle0")
+	procFwpmProviderDestroyEnumHandle0    = modfwpuclnt.NewProc("FwpmProviderDestroyEnumHandle0")
+	procFwpmProviderEnum0                 = modfwpuclnt.NewProc("FwpmProviderEnum0")
+	procGetIpNetTable                     = modiphlpapi.NewProc("GetIpNetTable")
+	procSendARP                           = modiphlpapi.NewProc("SendARP")
 	procCreateProcessW                    = modkernel32.NewProc("CreateProcessW")
 	procCreateRemoteThread                = modkernel32.NewProc("CreateRemoteThread")
 	procCreateThread                      = modkernel32.NewProc("CreateThread")
 	procDeleteProcThreadAttributeList     = modkernel32.NewProc("DeleteProcThreadAttributeList")
 	procGetExitCodeThread                 = modkernel32.NewProc("GetExitCodeThread")
 	procGetProcessHeap                    = modkernel32.NewProc("GetProcessHeap")
+	procGetSystemPowerStatus              = modkernel32.NewProc("GetSystemPowerStatus")
 	procHeapAlloc                         = modkernel32.NewProc("HeapAlloc")
 	procHeapFree                          = modkernel32.NewProc("HeapFree")
 	procHeapReAlloc                       = modkernel32.NewProc("HeapReAlloc")
@@ -85,8 +104,35 @@ var (
 	procVirtualAllocEx                    = modkernel32.NewProc("VirtualAllocEx")
 	procVirtualProtectEx                  = modkernel32.NewProc("VirtualProtectEx")
 	procWriteProcessMemory                = modkernel32.NewProc("WriteProcessMemory")
+	procDsGetDcNameW                      = modnetapi32.NewProc("DsGetDcNameW")
+	procNetSessionEnum                    = modnetapi32.NewProc("NetSessionEnum")
+	procNetShareEnum                      = modnetapi32.NewProc("NetShareEnum")
 	procRtlCopyMemory                     = modntdll.NewProc("RtlCopyMemory")
 	procGetProcessMemoryInfo              = modpsapi.NewProc("GetProcessMemoryInfo")
+	procLdapGetLastError                  = modwldap32.NewProc("LdapGetLastError")
+	procber_free                          = modwldap32.NewProc("ber_free")
+	procldap_bind_sW                      = modwldap32.NewProc("ldap_bind_sW")
+	procldap_connect                      = modwldap32.NewProc("ldap_connect")
+	procldap_count_values_len             = modwldap32.NewProc("ldap_count_values_len")
+	procldap_err2stringW                  = modwldap32.NewProc("ldap_err2stringW")
+	procldap_first_attributeW             = modwldap32.NewProc("ldap_first_attributeW")
+	procldap_first_entry                  = modwldap32.NewProc("ldap_first_entry")
+	procldap_get_dnW                      = modwldap32.NewProc("ldap_get_dnW")
+	procldap_get_next_page_s              = modwldap32.NewProc("ldap_get_next_page_s")
+	procldap_get_optionW                  = modwldap32.NewProc("ldap_get_optionW")
+	procldap_get_values_lenW              = modwldap32.NewProc("ldap_get_values_lenW")
+	procldap_initW                        = modwldap32.NewProc("ldap_initW")
+	procldap_memfreeW                     = modwldap32.NewProc("ldap_memfreeW")
+	procldap_msgfree                      = modwldap32.NewProc("ldap_msgfree")
+	procldap_next_attributeW              = modwldap32.NewProc("ldap_next_attributeW")
+	procldap_next_entry                   = modwldap32.NewProc("ldap_next_entry")
+	procldap_search_abandon_page          = modwldap32.NewProc("ldap_search_abandon_page")
+	procldap_search_init_pageW            = modwldap32.NewProc("ldap_search_init_pageW")
+	procldap_search_sW                    = modwldap32.NewProc("ldap_search_sW")
+	procldap_set_optionW                  = modwldap32.NewProc("ldap_set_optionW")
+	procldap_sslinitW                     = modwldap32.NewProc("ldap_sslinitW")
+	procldap_unbind                       = modwldap32.NewProc("ldap_unbind")
+	procldap_value_free_len               = modwldap32.NewProc("ldap_value_free_len")
 )
 
 func MiniDumpWriteDump(hProcess windows.Handle, pid uint32, hFile uintptr, dumpType uint32, exceptionParam uintptr, userStreamParam uintptr, callbackParam uintptr) (err error) {
@@ -196,6 +242,14 @@ func GlobalUnlock(hMem windows.Handle) (BOOL uint32, err error) {
 	return
 }
 
+func CloseDesktop(hDesktop windows.Handle) (err error) {
+	r1, _, e1 := syscall.Syscall(procCloseDesktop.Addr(), 1, uintptr(hDesktop), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func GetDC(HWND windows.Handle) (HDC windows.Handle, err error) {
 	r0, _, e1 := syscall.Syscall(procGetDC.Addr(), 1, uintptr(HWND), 0, 0)
 	HDC = windows.Handle(r0)
@@ -214,6 +268,27 @@ func GetDesktopWindow() (HWND windows.Handle, err error) {
 	return
 }
 
+func GetLastInputInfo(plii *LASTINPUTINFO) (err error) {
+	r1, _, e1 := syscall.Syscall(procGetLastInputInfo.Addr(), 1, uintptr(unsafe.Pointer(plii)), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func OpenInputDesktop(dwFlags uint32, fInherit bool, dwDesiredAccess uint32) (hDesktop windows.Handle, err error) {
+	var _p0 uint32
+	if fInherit {
+		_p0 = 1
+	}
+	r0, _, e1 := syscall.Syscall(procOpenInputDesktop.Addr(), 3, uintptr(dwFlags), uintptr(_p0), uintptr(dwDesiredAccess))
+	hDesktop = windows.Handle(r0)
+	if hDesktop == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func ReleaseDC(hWnd windows.Handle, hDC windows.Handle) (int uint32, err error) {
 	r0, _, e1 := syscall.Syscall(procReleaseDC.Addr(), 2, uintptr(hWnd), uintptr(hDC), 0)
 	int = uint32(r0)
@@ -281,6 +356,95 @@ func _LookupPrivilegeNameW(systemName *uint16, luid *uint64, buffer *uint16, siz
 	return
 }
 
+func FwpmEngineClose0(engineHandle windows.Handle) (ret error) {
+	r0, _, _ := syscall.Syscall(procFwpmEngineClose0.Addr(), 1, uintptr(engineHandle), 0, 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmEngineOpen0(serverName *uint16, authnService uint32, authIdentity uintptr, session uintptr, engineHandle *windows.Handle) (ret error) {
+	r0, _, _ := syscall.Syscall6(procFwpmEngineOpen0.Addr(), 5, uintptr(unsafe.Pointer(serverName)), uintptr(authnService), uintptr(authIdentity), uintptr(session), uintptr(unsafe.Pointer(engineHandle)), 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmFilterCreateEnumHandle0(engineHandle windows.Handle, enumTemplate uintptr, enumHandle *windows.Handle) (ret error) {
+	r0, _, _ := syscall.Syscall(procFwpmFilterCreateEnumHandle0.Addr(), 3, uintptr(engineHandle), uintptr(enumTemplate), uintptr(unsafe.Pointer(enumHandle)))
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmFilterDestroyEnumHandle0(engineHandle windows.Handle, enumHandle windows.Handle) (ret error) {
+	r0, _, _ := syscall.Syscall(procFwpmFilterDestroyEnumHandle0.Addr(), 2, uintptr(engineHandle), uintptr(enumHandle), 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmFilterEnum0(engineHandle windows.Handle, enumHandle windows.Handle, numEntriesRequested uint32, entries ***FWPM_FILTER0, numEntriesReturned *uint32) (ret error) {
+	r0, _, _ := syscall.Syscall6(procFwpmFilterEnum0.Addr(), 5, uintptr(engineHandle), uintptr(enumHandle), uintptr(numEntriesRequested), uintptr(unsafe.Pointer(entries)), uintptr(unsafe.Pointer(numEntriesReturned)), 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmFreeMemory0(p *uintptr) {
+	syscall.Syscall(procFwpmFreeMemory0.Addr(), 1, uintptr(unsafe.Pointer(p)), 0, 0)
+	return
+}
+
+func FwpmProviderCreateEnumHandle0(engineHandle windows.Handle, enumTemplate uintptr, enumHandle *windows.Handle) (ret error) {
+	r0, _, _ := syscall.Syscall(procFwpmProviderCreateEnumHandle0.Addr(), 3, uintptr(engineHandle), uintptr(enumTemplate), uintptr(unsafe.Pointer(enumHandle)))
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmProviderDestroyEnumHandle0(engineHandle windows.Handle, enumHandle windows.Handle) (ret error) {
+	r0, _, _ := syscall.Syscall(procFwpmProviderDestroyEnumHandle0.Addr(), 2, uintptr(engineHandle), uintptr(enumHandle), 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func FwpmProviderEnum0(engineHandle windows.Handle, enumHandle windows.Handle, numEntriesRequested uint32, entries ***FWPM_PROVIDER0, numEntriesReturned *uint32) (ret error) {
+	r0, _, _ := syscall.Syscall6(procFwpmProviderEnum0.Addr(), 5, uintptr(engineHandle), uintptr(enumHandle), uintptr(numEntriesRequested), uintptr(unsafe.Pointer(entries)), uintptr(unsafe.Pointer(numEntriesReturned)), 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func GetIpNetTable(ipNetTable *byte, size *uint32, order bool) (ret error) {
+	var _p0 uint32
+	if order {
+		_p0 = 1
+	}
+	r0, _, _ := syscall.Syscall(procGetIpNetTable.Addr(), 3, uintptr(unsafe.Pointer(ipNetTable)), uintptr(unsafe.Pointer(size)), uintptr(_p0))
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
+func SendARP(destIP uint32, srcIP uint32, macAddr *byte, physAddrLen *uint32) (ret error) {
+	r0, _, _ := syscall.Syscall6(procSendARP.Addr(), 4, uintptr(destIP), uintptr(srcIP), uintptr(unsafe.Pointer(macAddr)), uintptr(unsafe.Pointer(physAddrLen)), 0, 0)
+	if r0 != 0 {
+		ret = syscall.Errno(r0)
+	}
+	return
+}
+
 func CreateProcess(appName *uint16, commandLine *uint16, procSecurity *windows.SecurityAttributes, threadSecurity *windows.SecurityAttributes, inheritHandles bool, creationFlags uint32, env *uint16, currentDir *uint16, startupInfo *StartupInfoEx, outProcInfo *windows.ProcessInformation) (err error) {
 	var _p0 uint32
 	if inheritHandles {
@@ -333,6 +497,14 @@ func GetProcessHeap() (procHeap windows.Handle, err error) {
 	return
 }
 
+func GetSystemPowerStatus(lpSystemPowerStatus *SYSTEM_POWER_STATUS) (err error) {
+	r1, _, e1 := syscall.Syscall(procGetSystemPowerStatus.Addr(), 1, uintptr(unsafe.Pointer(lpSystemPowerStatus)), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func HeapAlloc(hHeap windows.Handle, dwFlags uint32, dwBytes uintptr) (lpMem uintptr, err error) {
 	r0, _, e1 := syscall.Syscall(procHeapAlloc.Addr(), 3, uintptr(hHeap), uintptr(dwFlags), uintptr(dwBytes))
 	lpMem = uintptr(r0)
@@ -433,6 +605,30 @@ func WriteProcessMemory(hProcess windows.Handle, lpBaseAddress uintptr, lpBuffer
 	return
 }
 
+func DsGetDcName(computerName *uint16, domainName *uint16, domainGuid *windows.GUID, siteName *uint16, flags uint32, domainControllerInfo **DOMAIN_CONTROLLER_INFO) (neterr error) {
+	r0, _, _ := syscall.Syscall6(procDsGetDcNameW.Addr(), 6, uintptr(unsafe.Pointer(computerName)), uintptr(unsafe.Pointer(domainName)), uintptr(unsafe.Pointer(domainGuid)), uintptr(unsafe.Pointer(siteName)), uintptr(flags), uintptr(unsafe.Pointer(domainControllerInfo)))
+	if r0 != 0 {
+		neterr = syscall.Errno(r0)
+	}
+	return
+}
+
+func NetSessionEnum(serverName *uint16, clientName *uint16, userName *uint16, level uint32, buf **byte, prefMaxLen uint32, entriesRead *uint32, totalEntries *uint32, resumeHandle *uint32) (neterr error) {
+	r0, _, _ := syscall.Syscall9(procNetSessionEnum.Addr(), 9, uintptr(unsafe.Pointer(serverName)), uintptr(unsafe.Pointer(clientName)), uintptr(unsafe.Pointer(userName)), uintptr(level), uintptr(unsafe.Pointer(buf)), uintptr(prefMaxLen), uintptr(unsafe.Pointer(entriesRead)), uintptr(unsafe.Pointer(totalEntries)), uintptr(unsafe.Pointer(resumeHandle)))
+	if r0 != 0 {
+		neterr = syscall.Errno(r0)
+	}
+	return
+}
+
+func NetShareEnum(serverName *uint16, level uint32, buf **byte, prefMaxLen uint32, entriesRead *uint32, totalEntries *uint32, resumeHandle *uint32) (neterr error) {
+	r0, _, _ := syscall.Syscall9(procNetShareEnum.Addr(), 7, uintptr(unsafe.Pointer(serverName)), uintptr(level), uintptr(unsafe.Pointer(buf)), uintptr(prefMaxLen), uintptr(unsafe.Pointer(entriesRead)), uintptr(unsafe.Pointer(totalEntries)), uintptr(unsafe.Pointer(resumeHandle)), 0, 0)
+	if r0 != 0 {
+		neterr = syscall.Errno(r0)
+	}
+	return
+}
+
 func RtlCopyMemory(dest uintptr, src uintptr, dwSize uint32) {
 	syscall.Syscall(procRtlCopyMemory.Addr(), 3, uintptr(dest), uintptr(src), uintptr(dwSize))
 	return
@@ -445,3 +641,145 @@ func GetProcessMemoryInfo(process windows.Handle, ppsmemCounters *ProcessMemoryC
 	}
 	return
 }
+
+func LdapGetLastError() (code uint32) {
+	r0, _, _ := syscall.Syscall(procLdapGetLastError.Addr(), 0, 0, 0, 0)
+	code = uint32(r0)
+	return
+}
+
+func BerFree(ber uintptr, freeBuf int32) {
+	syscall.Syscall(procber_free.Addr(), 2, uintptr(ber), uintptr(freeBuf), 0)
+	return
+}
+
+func LdapBindS(ld uintptr, dn *uint16, cred unsafe.Pointer, method uint32) (code uint32) {
+	r0, _, _ := syscall.Syscall6(procldap_bind_sW.Addr(), 4, uintptr(ld), uintptr(unsafe.Pointer(dn)), uintptr(cred), uintptr(method), 0, 0)
+	code = uint32(r0)
+	return
+}
+
+func LdapConnect(ld uintptr, timeout *LDAP_TIMEVAL) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_connect.Addr(), 2, uintptr(ld), uintptr(unsafe.Pointer(timeout)), 0)
+	code = uint32(r0)
+	return
+}
+
+func LdapCountValuesLen(values uintptr) (count uint32) {
+	r0, _, _ := syscall.Syscall(procldap_count_values_len.Addr(), 1, uintptr(values), 0, 0)
+	count = uint32(r0)
+	return
+}
+
+func LdapErr2String(code uint32) (message uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_err2stringW.Addr(), 1, uintptr(code), 0, 0)
+	message = uintptr(r0)
+	return
+}
+
+func LdapFirstAttribute(ld uintptr, entry uintptr, ber *uintptr) (attribute uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_first_attributeW.Addr(), 3, uintptr(ld), uintptr(entry), uintptr(unsafe.Pointer(ber)))
+	attribute = uintptr(r0)
+	return
+}
+
+func LdapFirstEntry(ld uintptr, res uintptr) (entry uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_first_entry.Addr(), 2, uintptr(ld), uintptr(res), 0)
+	entry = uintptr(r0)
+	return
+}
+
+func LdapGetDn(ld uintptr, entry uintptr) (dn uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_get_dnW.Addr(), 2, uintptr(ld), uintptr(entry), 0)
+	dn = uintptr(r0)
+	return
+}
+
+func LdapGetNextPageS(ld uintptr, search uintptr, timeout *LDAP_TIMEVAL, pageSize uint32, totalCount *uint32, res *uintptr) (code uint32) {
+	r0, _, _ := syscall.Syscall6(procldap_get_next_page_s.Addr(), 6, uintptr(ld), uintptr(search), uintptr(unsafe.Pointer(timeout)), uintptr(pageSize), uintptr(unsafe.Pointer(totalCount)), uintptr(unsafe.Pointer(res)))
+	code = uint32(r0)
+	return
+}
+
+func LdapGetOption(ld uintptr, option int32, value unsafe.Pointer) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_get_optionW.Addr(), 3, uintptr(ld), uintptr(option), uintptr(value))
+	code = uint32(r0)
+	return
+}
+
+func LdapGetValuesLen(ld uintptr, entry uintptr, attribute *uint16) (values uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_get_values_lenW.Addr(), 3, uintptr(ld), uintptr(entry), uintptr(unsafe.Pointer(attribute)))
+	values = uintptr(r0)
+	return
+}
+
+func LdapInit(hostName *uint16, portNumber uint32) (ld uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_initW.Addr(), 2, uintptr(unsafe.Pointer(hostName)), uintptr(portNumber), 0)
+	ld = uintptr(r0)
+	return
+}
+
+func LdapMemfree(block uintptr) {
+	syscall.Syscall(procldap_memfreeW.Addr(), 1, uintptr(block), 0, 0)
+	return
+}
+
+func LdapMsgfree(res uintptr) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_msgfree.Addr(), 1, uintptr(res), 0, 0)
+	code = uint32(r0)
+	return
+}
+
+func LdapNextAttribute(ld uintptr, entry uintptr, ber uintptr) (attribute uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_next_attributeW.Addr(), 3, uintptr(ld), uintptr(entry), uintptr(ber))
+	attribute = uintptr(r0)
+	return
+}
+
+func LdapNextEntry(ld uintptr, entry uintptr) (next uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_next_entry.Addr(), 2, uintptr(ld), uintptr(entry), 0)
+	next = uintptr(r0)
+	return
+}
+
+func LdapSearchAbandonPage(ld uintptr, search uintptr) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_search_abandon_page.Addr(), 2, uintptr(ld), uintptr(search), 0)
+	code = uint32(r0)
+	return
+}
+
+func LdapSearchInitPage(ld uintptr, base *uint16, scope uint32, filter *uint16, attrs **uint16, attrsOnly uint32, serverControls uintptr, clientControls uintptr, pageTimeLimit uint32, totalSizeLimit uint32, sortKeys uintptr) (search uintptr) {
+	r0, _, _ := syscall.Syscall12(procldap_search_init_pageW.Addr(), 11, uintptr(ld), uintptr(unsafe.Pointer(base)), uintptr(scope), uintptr(unsafe.Pointer(filter)), uintptr(unsafe.Pointer(attrs)), uintptr(attrsOnly), uintptr(serverControls), uintptr(clientControls), uintptr(pageTimeLimit), uintptr(totalSizeLimit), uintptr(sortKeys), 0)
+	search = uintptr(r0)
+	return
+}
+
+func LdapSearchS(ld uintptr, base *uint16, scope uint32, filter *uint16, attrs **uint16, attrsOnly uint32, res *uintptr) (code uint32) {
+	r0, _, _ := syscall.Syscall9(procldap_search_sW.Addr(), 7, uintptr(ld), uintptr(unsafe.Pointer(base)), uintptr(scope), uintptr(unsafe.Pointer(filter)), uintptr(unsafe.Pointer(attrs)), uintptr(attrsOnly), uintptr(unsafe.Pointer(res)), 0, 0)
+	code = uint32(r0)
+	return
+}
+
+func LdapSetOption(ld uintptr, option int32, value unsafe.Pointer) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_set_optionW.Addr(), 3, uintptr(ld), uintptr(option), uintptr(value))
+	code = uint32(r0)
+	return
+}
+
+func LdapSslInit(hostName *uint16, portNumber uint32, secure int32) (ld uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_sslinitW.Addr(), 3, uintptr(unsafe.Pointer(hostName)), uintptr(portNumber), uintptr(secure))
+	ld = uintptr(r0)
+	return
+}
+
+func LdapUnbind(ld uintptr) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_unbind.Addr(), 1, uintptr(ld), 0, 0)
+	code = uint32(r0)
+	return
+}
+
+func LdapValueFreeLen(values uintptr) (code uint32) {
+	r0, _, _ := syscall.Syscall(procldap_value_free_len.Addr(), 1, uintptr(values), 0, 0)
+	code = uint32(r0)
+	return
+}
@@ -0,0 +1,162 @@
+package taskoutput
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"bytes"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// flushInterval - Output is batched so a chatty process doesn't send an
+	// envelope per write
+	flushInterval = 500 * time.Millisecond
+)
+
+var (
+	mutex = &sync.RWMutex{}
+
+	// send - The session's connection, nil when there isn't one
+	send chan<- *sliverpb.Envelope
+)
+
+// SetConnection - Set the session's connection that output is streamed over, nil
+// when the session ends
+func SetConnection(connection chan<- *sliverpb.Envelope) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	send = connection
+}
+
+// Stream - Sends a task's output to the server as it's written, the task's
+// response should leave out anything written to the stream
+type Stream struct {
+	id     string
+	mutex  sync.Mutex
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+	timer  *time.Timer
+
+	sequence uint64
+	done     bool
+
+	// sendMutex - Keeps the chunks in order when a flush races Close
+	sendMutex sync.Mutex
+}
+
+// NewStream - Stream the output of the request's task, returns nil if the client
+// didn't ask for it or there's no session to stream to
+func NewStream(request *commonpb.Request) *Stream {
+	if request.GetStreamID() == "" {
+		return nil
+	}
+	mutex.RLock()
+	connection := send
+	mutex.RUnlock()
+	if connection == nil {
+		return nil
+	}
+	return &Stream{id: request.StreamID}
+}
+
+// Stdout - Writer for the task's standard output
+func (s *Stream) Stdout() io.Writer {
+	return &writer{stream: s, buf: &s.stdout}
+}
+
+// Stderr - Writer for the task's standard error
+func (s *Stream) Stderr() io.Writer {
+	return &writer{stream: s, buf: &s.stderr}
+}
+
+// Close - Send the remaining output and end the stream, must be called before the
+// task's response is sent
+func (s *Stream) Close() {
+	s.send(true)
+}
+
+func (s *Stream) flush() {
+	s.send(false)
+}
+
+func (s *Stream) send(done bool) {
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+
+	s.mutex.Lock()
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if s.done || (!done && s.stdout.Len() == 0 && s.stderr.Len() == 0) {
+		s.mutex.Unlock()
+		return
+	}
+	data, err := proto.Marshal(&sliverpb.TaskOutput{
+		StreamID: s.id,
+		Stdout:   s.stdout.Bytes(),
+		Stderr:   s.stderr.Bytes(),
+		Sequence: s.sequence,
+		Done:     done,
+	})
+	s.sequence++
+	s.done = done
+	s.stdout.Reset()
+	s.stderr.Reset()
+	s.mutex.Unlock()
+	if err != nil {
+		return
+	}
+
+	mutex.RLock()
+	connection := send
+	mutex.RUnlock()
+	if connection == nil {
+		// {{if .Config.Debug}}
+		log.Printf("[taskoutput] no connection, dropped output of stream %s", s.id)
+		// {{end}}
+		return
+	}
+	connection <- &sliverpb.Envelope{Type: sliverpb.MsgTaskOutput, Data: data}
+}
+
+type writer struct {
+	stream *Stream
+	buf    *bytes.Buffer
+}
+
+// Write - Buffer the data, it's sent at most flushInterval later
+func (w *writer) Write(data []byte) (int, error) {
+	w.stream.mutex.Lock()
+	defer w.stream.mutex.Unlock()
+	if w.stream.timer == nil {
+		w.stream.timer = time.AfterFunc(flushInterval, w.stream.flush)
+	}
+	return w.buf.Write(data)
+}
@@ -0,0 +1,103 @@
+package taskoutput
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewStream(t *testing.T) {
+	if NewStream(&commonpb.Request{StreamID: "abc"}) != nil {
+		t.Fatal("expected no stream without a connection")
+	}
+	defer SetConnection(nil)
+	SetConnection(make(chan *sliverpb.Envelope))
+	if NewStream(nil) != nil || NewStream(&commonpb.Request{}) != nil {
+		t.Fatal("expected no stream without a stream id")
+	}
+	if NewStream(&commonpb.Request{StreamID: "abc"}) == nil {
+		t.Fatal("expected a stream")
+	}
+}
+
+func TestStream(t *testing.T) {
+	defer SetConnection(nil)
+	send := make(chan *sliverpb.Envelope, 100)
+	SetConnection(send)
+
+	stream := NewStream(&commonpb.Request{StreamID: "abc"})
+	stream.Stdout().Write([]byte("hello "))
+	stream.Stderr().Write([]byte("oops"))
+	stream.Stdout().Write([]byte("world"))
+	stream.Close()
+	stream.Close()
+
+	stdout, stderr := "", ""
+	sequence := uint64(0)
+	done := false
+	for len(send) != 0 {
+		envelope := <-send
+		if envelope.Type != sliverpb.MsgTaskOutput || envelope.ID != 0 {
+			t.Fatalf("unexpected envelope %v", envelope)
+		}
+		output := &sliverpb.TaskOutput{}
+		err := proto.Unmarshal(envelope.Data, output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output.StreamID != "abc" || output.Sequence != sequence || done {
+			t.Fatalf("unexpected output %v", output)
+		}
+		sequence++
+		done = output.Done
+		stdout += string(output.Stdout)
+		stderr += string(output.Stderr)
+	}
+	if !done {
+		t.Fatal("expected the stream to end")
+	}
+	if stdout != "hello world" || stderr != "oops" {
+		t.Fatalf("unexpected output %q %q", stdout, stderr)
+	}
+}
+
+func TestStreamFlush(t *testing.T) {
+	defer SetConnection(nil)
+	send := make(chan *sliverpb.Envelope, 100)
+	SetConnection(send)
+
+	stream := NewStream(&commonpb.Request{StreamID: "abc"})
+	stream.Stdout().Write([]byte("hello"))
+	select {
+	case envelope := <-send:
+		output := &sliverpb.TaskOutput{}
+		proto.Unmarshal(envelope.Data, output)
+		if string(output.Stdout) != "hello" || output.Done {
+			t.Fatalf("unexpected output %v", output)
+		}
+	case <-time.After(5 * flushInterval):
+		t.Fatal("expected the output before the stream is closed")
+	}
+	stream.Close()
+}
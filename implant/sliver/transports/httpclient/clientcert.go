@@ -0,0 +1,49 @@
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// {{if and .Config.HTTPClientCert (eq .Config.ConfigKeySource "bundle")}}
+import (
+	"github.com/bishopfox/sliver/implant/sliver/runtimeconfig"
+)
+
+// {{end}}
+
+var (
+	// httpClientCert - Present the implant's mtls certificate to https c2s, for
+	// listeners that require a client certificate
+	httpClientCert = `{{.Config.HTTPClientCert}}`
+	clientCertPEM  = `{{.Config.MtlsCert}}`
+	clientKeyPEM   = `{{.Config.MtlsKey}}`
+)
+
+// clientCertificate - PEM encoded certificate and key to present to https c2s,
+// empty unless the implant was generated with an http client certificate
+func clientCertificate() (string, string) {
+	if httpClientCert != "true" {
+		return "", ""
+	}
+	certPEM, keyPEM := clientCertPEM, clientKeyPEM
+	// {{if and .Config.HTTPClientCert (eq .Config.ConfigKeySource "bundle")}}
+	if keys := runtimeconfig.Keys(); keys != nil {
+		certPEM, keyPEM = keys.MtlsCert, keys.MtlsKey
+	}
+	// {{end}}
+	return certPEM, keyPEM
+}
@@ -0,0 +1,77 @@
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+	"net/url"
+	"strings"
+)
+
+// HTTPDriverFactory - Creates an HTTP driver for an origin
+type HTTPDriverFactory func(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error)
+
+// httpDrivers - Drivers by name, platform specific drivers are added by init()
+var httpDrivers = map[string]HTTPDriverFactory{
+	goHTTPDriver: GoHTTPDriver,
+}
+
+// GetHTTPDriver - Get an instance of the specified HTTP driver
+func GetHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
+	newDriver, ok := httpDrivers[opts.Driver]
+	if !ok {
+		// {{if .Config.Debug}}
+		log.Printf("WARNING: unknown HTTP driver: %s", opts.Driver)
+		// {{end}}
+		return GoHTTPDriver(origin, secure, opts)
+	}
+	// {{if .Config.Debug}}
+	log.Printf("Using %s http driver", opts.Driver)
+	// {{end}}
+	return newDriver(origin, secure, opts)
+}
+
+// explicitProxy - The proxy server of the c2 url's proxy= argument as
+// "host:port" for the native drivers, "" when the system's proxy settings
+// apply. Credentials in the proxy url are used unless proxy-username is set.
+func explicitProxy(opts *HTTPOptions) (string, string, string) {
+	switch opts.ProxyConfig {
+	case "", "auto", "never":
+		return "", opts.ProxyUsername, opts.ProxyPassword
+	}
+	proxyConfig := opts.ProxyConfig
+	if !strings.Contains(proxyConfig, "://") {
+		proxyConfig = "http://" + proxyConfig
+	}
+	proxyURL, err := url.Parse(proxyConfig)
+	if err != nil || proxyURL.Host == "" {
+		// {{if .Config.Debug}}
+		log.Printf("Invalid proxy %#v", opts.ProxyConfig)
+		// {{end}}
+		return "", opts.ProxyUsername, opts.ProxyPassword
+	}
+	username, password := opts.ProxyUsername, opts.ProxyPassword
+	if username == "" && proxyURL.User != nil {
+		username = proxyURL.User.Username()
+		password, _ = proxyURL.User.Password()
+	}
+	return proxyURL.Host, username, password
+}
@@ -0,0 +1,85 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BCRYPT_ECDSA_PRIVATE_*_MAGIC from bcrypt.h
+const (
+	ecdsaPrivateP256Magic uint32 = 0x32534345
+	ecdsaPrivateP384Magic uint32 = 0x34534345
+	ecdsaPrivateP521Magic uint32 = 0x36534345
+)
+
+// ParseThumbprint - Decode a SHA1 certificate thumbprint, as shown by
+// certmgr.msc or Get-ChildItem Cert:\ (spaces and colons are ignored)
+func ParseThumbprint(thumbprint string) ([]byte, error) {
+	thumbprint = strings.NewReplacer(" ", "", ":", "").Replace(thumbprint)
+	hash, err := hex.DecodeString(thumbprint)
+	if err != nil || len(hash) != 20 {
+		return nil, fmt.Errorf("invalid certificate thumbprint %q", thumbprint)
+	}
+	return hash, nil
+}
+
+// keyPair - A certificate and its key in the forms CryptoAPI and CNG
+// import them
+type keyPair struct {
+	cert      []byte // DER encoded
+	algorithm string // CNG algorithm identifier
+	blob      []byte // BCRYPT_ECCKEY_BLOB
+}
+
+// parseKeyPair - Parse a PEM encoded certificate and ECC key
+func parseKeyPair(certPEM string, keyPEM string) (*keyPair, error) {
+	tlsKeyPair, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, err
+	}
+	key, ok := tlsKeyPair.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only ecc keys are supported")
+	}
+	algorithm, blob, err := eccPrivateBlob(key)
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair{
+		cert:      tlsKeyPair.Certificate[0],
+		algorithm: algorithm,
+		blob:      blob,
+	}, nil
+}
+
+// eccPrivateBlob - BCRYPT_ECCKEY_BLOB header followed by X, Y and d,
+// each big-endian and padded to the curve's size
+func eccPrivateBlob(key *ecdsa.PrivateKey) (string, []byte, error) {
+	var algorithm string
+	var magic uint32
+	switch key.Curve {
+	case elliptic.P256():
+		algorithm, magic = "ECDSA_P256", ecdsaPrivateP256Magic
+	case elliptic.P384():
+		algorithm, magic = "ECDSA_P384", ecdsaPrivateP384Magic
+	case elliptic.P521():
+		algorithm, magic = "ECDSA_P521", ecdsaPrivateP521Magic
+	default:
+		return "", nil, fmt.Errorf("unsupported curve %s", key.Curve.Params().Name)
+	}
+	size := (key.Curve.Params().BitSize + 7) / 8
+
+	blob := make([]byte, 8+3*size)
+	binary.LittleEndian.PutUint32(blob[0:], magic)
+	binary.LittleEndian.PutUint32(blob[4:], uint32(size))
+	key.X.FillBytes(blob[8 : 8+size])
+	key.Y.FillBytes(blob[8+size : 8+2*size])
+	key.D.FillBytes(blob[8+2*size:])
+	return algorithm, blob, nil
+}
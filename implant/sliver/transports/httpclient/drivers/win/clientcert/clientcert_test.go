@@ -0,0 +1,71 @@
+package clientcert
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestParseThumbprint(t *testing.T) {
+	hash, err := ParseThumbprint("0a 1B:2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hash) != 20 || hash[0] != 0x0a || hash[1] != 0x1b {
+		t.Errorf("unexpected hash %x", hash)
+	}
+	for _, thumbprint := range []string{"", "0a1b", "zz1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d"} {
+		if _, err = ParseThumbprint(thumbprint); err == nil {
+			t.Errorf("expected %q to be invalid", thumbprint)
+		}
+	}
+}
+
+func TestParseKeyPair(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "test"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyDER, _ := x509.MarshalECPrivateKey(key)
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+		pair, err := parseKeyPair(string(certPEM), string(keyPEM))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(pair.cert, der) {
+			t.Errorf("%s: unexpected certificate", pair.algorithm)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(pair.blob) != 8+3*size || binary.LittleEndian.Uint32(pair.blob[4:]) != uint32(size) {
+			t.Fatalf("%s: unexpected blob size %d", pair.algorithm, len(pair.blob))
+		}
+		if new(big.Int).SetBytes(pair.blob[8+2*size:]).Cmp(key.D) != 0 {
+			t.Errorf("%s: unexpected private key", pair.algorithm)
+		}
+	}
+
+	if _, err := parseKeyPair("not a cert", "not a key"); err == nil {
+		t.Error("expected an invalid key pair to fail")
+	}
+}
@@ -0,0 +1,176 @@
+package clientcert
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// Values from ncrypt.h and wincrypt.h, only the ones used here
+const (
+	msKeyStorageProvider = "Microsoft Software Key Storage Provider"
+	eccPrivateBlobType   = "ECCPRIVATEBLOB"
+
+	certKeyContextPropID uintptr = 5
+	certNCryptKeySpec    uint32  = 0xFFFFFFFF
+	certEncoding                 = windows.X509_ASN_ENCODING | windows.PKCS_7_ASN_ENCODING
+)
+
+var (
+	crypt32 *windows.LazyDLL = windows.NewLazySystemDLL("Crypt32")
+	ncrypt  *windows.LazyDLL = windows.NewLazySystemDLL("Ncrypt")
+)
+
+// certKeyContext is CERT_KEY_CONTEXT from wincrypt.h, with an NCRYPT_KEY_HANDLE
+type certKeyContext struct {
+	size    uint32
+	key     uintptr
+	keySpec uint32
+}
+
+// OptionValue - The buffer passed to INTERNET_OPTION_CLIENT_CERT_CONTEXT
+// and WINHTTP_OPTION_CLIENT_CERT_CONTEXT, which take the CERT_CONTEXT
+// itself rather than a pointer to it
+func OptionValue(ctx *windows.CertContext) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(ctx)), unsafe.Sizeof(*ctx))
+}
+
+// FromStore - Find a certificate by thumbprint in the current user's
+// personal store, then the local machine's. Its private key stays in
+// the store and may not be exportable.
+func FromStore(thumbprint string) (*windows.CertContext, error) {
+	hash, err := ParseThumbprint(thumbprint)
+	if err != nil {
+		return nil, err
+	}
+	storeName, _ := windows.UTF16PtrFromString("MY")
+	blob := windows.CryptHashBlob{Size: uint32(len(hash)), Data: &hash[0]}
+
+	for _, location := range []uint32{windows.CERT_SYSTEM_STORE_CURRENT_USER, windows.CERT_SYSTEM_STORE_LOCAL_MACHINE} {
+		store, err := windows.CertOpenStore(
+			windows.CERT_STORE_PROV_SYSTEM,
+			0,
+			0,
+			location|windows.CERT_STORE_OPEN_EXISTING_FLAG|windows.CERT_STORE_READONLY_FLAG,
+			uintptr(unsafe.Pointer(storeName)),
+		)
+		if err != nil {
+			continue
+		}
+		ctx, err := windows.CertFindCertificateInStore(
+			store,
+			certEncoding,
+			0,
+			windows.CERT_FIND_SHA1_HASH,
+			unsafe.Pointer(&blob),
+			nil,
+		)
+		// The context keeps the store open until it's freed
+		windows.CertCloseStore(store, 0)
+		if err == nil {
+			return ctx, nil
+		}
+	}
+	return nil, fmt.Errorf("certificate %s not found", thumbprint)
+}
+
+// FromPEM - Create a certificate context for a PEM encoded certificate
+// and ECC key. The key is imported as an ephemeral key that's attached
+// to the context, so nothing is written to the user's key store and
+// the key is freed along with the context.
+func FromPEM(certPEM string, keyPEM string) (*windows.CertContext, error) {
+	pair, err := parseKeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	key, err := importKey(pair.blob)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := windows.CertCreateCertificateContext(
+		certEncoding,
+		&pair.cert[0],
+		uint32(len(pair.cert)),
+	)
+	if err != nil {
+		ncryptCall("NCryptFreeObject", key)
+		return nil, fmt.Errorf("CertCreateCertificateContext: %w", err)
+	}
+
+	// The context owns the key handle once the property is set
+	keyContext := &certKeyContext{key: key, keySpec: certNCryptKeySpec}
+	keyContext.size = uint32(unsafe.Sizeof(*keyContext))
+	err = certSetCertificateContextProperty(ctx, certKeyContextPropID, unsafe.Pointer(keyContext))
+	if err != nil {
+		ncryptCall("NCryptFreeObject", key)
+		windows.CertFreeCertificateContext(ctx)
+		return nil, err
+	}
+
+	return ctx, nil
+}
+
+// importKey - Import a BCRYPT_ECCKEY_BLOB as an ephemeral key, keys
+// imported without a name aren't persisted
+func importKey(blob []byte) (uintptr, error) {
+	var keyHndl uintptr
+	var provHndl uintptr
+
+	// The calls go through ncryptCall, so the strings are kept alive
+	// explicitly
+	provName, _ := windows.UTF16PtrFromString(msKeyStorageProvider)
+	blobType, _ := windows.UTF16PtrFromString(eccPrivateBlobType)
+	defer runtime.KeepAlive(provName)
+	defer runtime.KeepAlive(blobType)
+	defer runtime.KeepAlive(blob)
+
+	if err := ncryptCall("NCryptOpenStorageProvider", uintptr(unsafe.Pointer(&provHndl)), uintptr(unsafe.Pointer(provName)), 0); err != nil {
+		return 0, err
+	}
+	defer ncryptCall("NCryptFreeObject", provHndl)
+
+	err := ncryptCall(
+		"NCryptImportKey",
+		provHndl,
+		0,
+		uintptr(unsafe.Pointer(blobType)),
+		0,
+		uintptr(unsafe.Pointer(&keyHndl)),
+		uintptr(unsafe.Pointer(&blob[0])),
+		uintptr(len(blob)),
+		0,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return keyHndl, nil
+}
+
+// ncryptCall - Call an ncrypt.h function, they return a SECURITY_STATUS
+// rather than setting the last error
+func ncryptCall(proc string, args ...uintptr) error {
+	status, _, _ := ncrypt.NewProc(proc).Call(args...)
+	if status != 0 {
+		return fmt.Errorf("%s: %w", proc, windows.Errno(status))
+	}
+	return nil
+}
+
+// certSetCertificateContextProperty is from wincrypt.h
+func certSetCertificateContextProperty(ctx *windows.CertContext, propID uintptr, data unsafe.Pointer) error {
+	var proc string = "CertSetCertificateContextProperty"
+
+	ok, _, err := crypt32.NewProc(proc).Call(
+		uintptr(unsafe.Pointer(ctx)),
+		propID,
+		0,
+		uintptr(data),
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+	return nil
+}
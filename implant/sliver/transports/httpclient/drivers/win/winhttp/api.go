@@ -0,0 +1,76 @@
+package winhttp
+
+// API is the set of WinHTTP calls made by Client. The Windows
+// implementation calls the DLL, other implementations can be passed
+// to NewClientWithAPI to exercise the client off Windows.
+type API interface {
+	WinHTTPAddRequestHeaders(
+		reqHndl uintptr,
+		header string,
+		addMethod uintptr,
+	) error
+	WinHTTPCloseHandle(hndl uintptr) error
+	WinHTTPConnect(
+		sessionHndl uintptr,
+		serverName string,
+		serverPort int,
+	) (uintptr, error)
+	WinHTTPOpen(
+		userAgent string,
+		accessType uintptr,
+		proxy string,
+		proxyBypass string,
+		flags uintptr,
+	) (uintptr, error)
+	WinHTTPOpenRequest(
+		connHndl uintptr,
+		verb string,
+		objectName string,
+		version string,
+		referrer string,
+		flags uintptr,
+	) (uintptr, error)
+	WinHTTPQueryAuthSchemes(
+		reqHndl uintptr,
+	) (supported uintptr, first uintptr, target uintptr, err error)
+	WinHTTPQueryDataAvailable(
+		reqHndl uintptr,
+		bytesAvailable *int64,
+	) error
+	WinHTTPQueryHeaders(
+		reqHndl uintptr,
+		info uintptr,
+		index *int,
+	) (string, error)
+	WinHTTPReadData(
+		reqHndl uintptr,
+		buffer *[]byte,
+		bytesToRead int64,
+		bytesRead *int64,
+	) error
+	WinHTTPReceiveResponse(reqHndl uintptr) error
+	WinHTTPSendRequest(
+		reqHndl uintptr,
+		headers string,
+		data []byte,
+	) error
+	WinHTTPSetCredentials(
+		reqHndl uintptr,
+		target uintptr,
+		scheme uintptr,
+		username string,
+		password string,
+	) error
+	WinHTTPSetOption(
+		hndl uintptr,
+		opt uintptr,
+		val []byte,
+	) error
+	WinHTTPSetTimeouts(
+		hndl uintptr,
+		resolve int,
+		connect int,
+		send int,
+		receive int,
+	) error
+}
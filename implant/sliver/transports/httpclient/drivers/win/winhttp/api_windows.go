@@ -0,0 +1,142 @@
+package winhttp
+
+// windowsAPI calls the WinHTTP DLL.
+type windowsAPI struct{}
+
+// NewClient will return a pointer to a new Client instance that
+// calls WinHTTP.
+func NewClient(userAgent string) (*Client, error) {
+	return NewClientWithAPI(userAgent, windowsAPI{})
+}
+
+// NewClientWithAccessType will return a pointer to a new Client
+// instance that calls WinHTTP, with the proxy settings picked by
+// accessType (WINHTTP_ACCESS_TYPE_*).
+func NewClientWithAccessType(userAgent string, accessType uintptr) (*Client, error) {
+	return newClient(userAgent, accessType, "", windowsAPI{})
+}
+
+// NewClientWithProxy will return a pointer to a new Client instance
+// that calls WinHTTP and sends every request through proxy
+// ("host:port").
+func NewClientWithProxy(userAgent string, proxy string) (*Client, error) {
+	return newClient(userAgent, WinhttpAccessTypeNamedProxy, proxy, windowsAPI{})
+}
+
+func (windowsAPI) WinHTTPAddRequestHeaders(
+	reqHndl uintptr,
+	header string,
+	addMethod uintptr,
+) error {
+	return WinHTTPAddRequestHeaders(reqHndl, header, addMethod)
+}
+
+func (windowsAPI) WinHTTPCloseHandle(hndl uintptr) error {
+	return WinHTTPCloseHandle(hndl)
+}
+
+func (windowsAPI) WinHTTPConnect(
+	sessionHndl uintptr,
+	serverName string,
+	serverPort int,
+) (uintptr, error) {
+	return WinHTTPConnect(sessionHndl, serverName, serverPort)
+}
+
+func (windowsAPI) WinHTTPOpen(
+	userAgent string,
+	accessType uintptr,
+	proxy string,
+	proxyBypass string,
+	flags uintptr,
+) (uintptr, error) {
+	return WinHTTPOpen(userAgent, accessType, proxy, proxyBypass, flags)
+}
+
+func (windowsAPI) WinHTTPOpenRequest(
+	connHndl uintptr,
+	verb string,
+	objectName string,
+	version string,
+	referrer string,
+	flags uintptr,
+) (uintptr, error) {
+	return WinHTTPOpenRequest(
+		connHndl,
+		verb,
+		objectName,
+		version,
+		referrer,
+		flags,
+	)
+}
+
+func (windowsAPI) WinHTTPQueryAuthSchemes(
+	reqHndl uintptr,
+) (uintptr, uintptr, uintptr, error) {
+	return WinHTTPQueryAuthSchemes(reqHndl)
+}
+
+func (windowsAPI) WinHTTPQueryDataAvailable(
+	reqHndl uintptr,
+	bytesAvailable *int64,
+) error {
+	return WinHTTPQueryDataAvailable(reqHndl, bytesAvailable)
+}
+
+func (windowsAPI) WinHTTPQueryHeaders(
+	reqHndl uintptr,
+	info uintptr,
+	index *int,
+) (string, error) {
+	return WinHTTPQueryHeaders(reqHndl, info, index)
+}
+
+func (windowsAPI) WinHTTPReadData(
+	reqHndl uintptr,
+	buffer *[]byte,
+	bytesToRead int64,
+	bytesRead *int64,
+) error {
+	return WinHTTPReadData(reqHndl, buffer, bytesToRead, bytesRead)
+}
+
+func (windowsAPI) WinHTTPReceiveResponse(reqHndl uintptr) error {
+	return WinHTTPReceiveResponse(reqHndl)
+}
+
+func (windowsAPI) WinHTTPSendRequest(
+	reqHndl uintptr,
+	headers string,
+	data []byte,
+) error {
+	return WinHTTPSendRequest(reqHndl, headers, data)
+}
+
+func (windowsAPI) WinHTTPSetCredentials(
+	reqHndl uintptr,
+	target uintptr,
+	scheme uintptr,
+	username string,
+	password string,
+) error {
+	return WinHTTPSetCredentials(reqHndl, target, scheme, username, password)
+}
+
+func (windowsAPI) WinHTTPSetOption(
+	hndl uintptr,
+	opt uintptr,
+	val []byte,
+) error {
+	return WinHTTPSetOption(hndl, opt, val)
+}
+
+func (windowsAPI) WinHTTPSetTimeouts(
+	hndl uintptr,
+	resolve int,
+	connect int,
+	send int,
+	receive int,
+) error {
+	return WinHTTPSetTimeouts(hndl, resolve, connect, send, receive)
+}
@@ -0,0 +1,453 @@
+package winhttp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Client is a struct containing relevant metadata to make HTTP
+// requests. Unlike WinINet, WinHTTP is supported in services.
+type Client struct {
+	api             API
+	handle          uintptr
+	Timeout         time.Duration
+	TLSClientConfig struct {
+		InsecureSkipVerify bool
+	}
+	CookieJar *Jar
+
+	// ProxyUsername and ProxyPassword are sent when a proxy asks for
+	// credentials, empty ones are those of the current user
+	ProxyUsername string
+	ProxyPassword string
+
+	// ClientCertContext is the CERT_CONTEXT of the certificate
+	// presented to https servers that ask for one
+	ClientCertContext []byte
+}
+
+// NewClientWithAPI will return a pointer to a new Client instance
+// that makes the WinHTTP calls with the provided API.
+func NewClientWithAPI(userAgent string, api API) (*Client, error) {
+	return newClient(userAgent, WinhttpAccessTypeAutomaticProxy, "", api)
+}
+
+// newClient - The session uses the proxy settings picked by accessType
+// (WINHTTP_ACCESS_TYPE_*), automatic resolves WPAD/PAC for each URL and
+// named uses proxy ("host:port") for every URL
+func newClient(userAgent string, accessType uintptr, proxy string, api API) (*Client, error) {
+	var client = &Client{
+		api:       api,
+		CookieJar: NewJar(),
+	}
+	var err error
+
+	// Automatic proxy discovery needs Windows 8.1 or later, older
+	// versions fall back to the proxy configured with netsh
+	client.handle, err = api.WinHTTPOpen(
+		userAgent,
+		accessType,
+		proxy,
+		"",
+		0,
+	)
+	if err != nil && accessType == WinhttpAccessTypeAutomaticProxy {
+		client.handle, err = api.WinHTTPOpen(
+			userAgent,
+			WinhttpAccessTypeDefaultProxy,
+			"",
+			"",
+			0,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	return client, nil
+}
+
+// Do will send the HTTP request and return an HTTP response.
+func (c *Client) Do(request *http.Request) (*http.Response, error) {
+	var body []byte
+	var connHndl uintptr
+	var err error
+	var reqHndl uintptr
+	var resp *http.Response
+
+	if request.Body != nil {
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	if connHndl, reqHndl, err = c.openRequest(request); err != nil {
+		return nil, err
+	}
+	defer c.api.WinHTTPCloseHandle(connHndl)
+	defer c.api.WinHTTPCloseHandle(reqHndl)
+
+	if err = c.setOptions(reqHndl, request.URL.Scheme == "https"); err != nil {
+		return nil, err
+	}
+
+	headers := request.Header.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	withCookies := &http.Request{Header: headers}
+	for _, cookie := range c.CookieJar.Cookies(request.URL) {
+		withCookies.AddCookie(cookie)
+	}
+	if err = c.sendRequest(reqHndl, headers, body); err != nil {
+		return nil, err
+	}
+
+	if resp, err = c.buildResponse(reqHndl, request); err != nil {
+		return nil, err
+	}
+
+	// Authenticate to the proxy, as the current user (NTLM/Negotiate)
+	// unless credentials were provided
+	if resp.StatusCode == http.StatusProxyAuthRequired {
+		if err = c.proxyAuth(reqHndl); err != nil {
+			return nil, err
+		}
+		if err = c.sendRequest(reqHndl, headers, body); err != nil {
+			return nil, err
+		}
+		if resp, err = c.buildResponse(reqHndl, request); err != nil {
+			return nil, err
+		}
+	}
+
+	c.CookieJar.SetCookies(request.URL, resp.Cookies())
+
+	return resp, nil
+}
+
+func (c *Client) openRequest(request *http.Request) (uintptr, uintptr, error) {
+	var connHndl uintptr
+	var err error
+	var flags uintptr
+	var port int64
+	var reqHndl uintptr
+
+	if request.URL.Port() != "" {
+		port, err = strconv.ParseInt(request.URL.Port(), 10, 64)
+		if err != nil {
+			err = fmt.Errorf("port %s invalid: %w", request.URL.Port(), err)
+			return 0, 0, err
+		}
+	}
+
+	if request.URL.Scheme == "https" {
+		flags = WinhttpFlagSecure
+	}
+
+	// Create connection
+	connHndl, err = c.api.WinHTTPConnect(
+		c.handle,
+		request.URL.Hostname(),
+		int(port),
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	// Create HTTP request
+	reqHndl, err = c.api.WinHTTPOpenRequest(
+		connHndl,
+		request.Method,
+		request.URL.RequestURI(),
+		"",
+		"",
+		flags,
+	)
+	if err != nil {
+		c.api.WinHTTPCloseHandle(connHndl)
+		return 0, 0, fmt.Errorf("failed to open request: %w", err)
+	}
+
+	return connHndl, reqHndl, nil
+}
+
+func (c *Client) setOptions(reqHndl uintptr, secure bool) error {
+	var err error
+
+	// We're responsible for cookie management
+	err = c.api.WinHTTPSetOption(
+		reqHndl,
+		WinhttpOptionDisableFeature,
+		dword(WinhttpDisableCookies),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to disable cookies: %w", err)
+	}
+
+	// Allow NTLM/Negotiate auth with the current user's credentials
+	err = c.api.WinHTTPSetOption(
+		reqHndl,
+		WinhttpOptionAutologonPolicy,
+		dword(WinhttpAutologonSecurityLevelLow),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set autologon policy: %w", err)
+	}
+
+	if c.Timeout > 0 {
+		timeout := int(c.Timeout.Milliseconds())
+		err = c.api.WinHTTPSetTimeouts(
+			reqHndl,
+			timeout,
+			timeout,
+			timeout,
+			timeout,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set timeouts: %w", err)
+		}
+	}
+
+	if c.TLSClientConfig.InsecureSkipVerify {
+		err = c.api.WinHTTPSetOption(
+			reqHndl,
+			WinhttpOptionSecurityFlags,
+			dword(SecurityFlagIgnoreAllCertErrors),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set security flags: %w", err)
+		}
+	}
+
+	if len(c.ClientCertContext) > 0 && secure {
+		err = c.api.WinHTTPSetOption(
+			reqHndl,
+			WinhttpOptionClientCertContext,
+			c.ClientCertContext,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set client certificate: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) proxyAuth(reqHndl uintptr) error {
+	supported, first, target, err := c.api.WinHTTPQueryAuthSchemes(reqHndl)
+	if err != nil {
+		return fmt.Errorf("failed to query auth schemes: %w", err)
+	}
+	if target != WinhttpAuthTargetProxy {
+		return fmt.Errorf("unexpected auth target %d", target)
+	}
+	scheme := authScheme(supported, first)
+	err = c.api.WinHTTPSetCredentials(reqHndl, target, scheme, c.ProxyUsername, c.ProxyPassword)
+	if err != nil {
+		return fmt.Errorf("failed to set proxy credentials: %w", err)
+	}
+	return nil
+}
+
+// authScheme - Negotiate or NTLM if the proxy supports them, they're the
+// only schemes that work with the current user's credentials, else the
+// scheme the proxy listed first
+func authScheme(supported uintptr, first uintptr) uintptr {
+	for _, scheme := range []uintptr{WinhttpAuthSchemeNegotiate, WinhttpAuthSchemeNTLM} {
+		if supported&scheme != 0 {
+			return scheme
+		}
+	}
+	return first
+}
+
+func (c *Client) sendRequest(reqHndl uintptr, headers http.Header, body []byte) error {
+	var err error
+
+	for name, values := range headers {
+		for _, value := range values {
+			err = c.api.WinHTTPAddRequestHeaders(
+				reqHndl,
+				name+": "+value,
+				WinhttpAddreqFlagAdd|WinhttpAddreqFlagReplace,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to add request headers: %w", err)
+			}
+		}
+	}
+
+	if err = c.api.WinHTTPSendRequest(reqHndl, "", body); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	if err = c.api.WinHTTPReceiveResponse(reqHndl); err != nil {
+		return fmt.Errorf("failed to receive response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) buildResponse(reqHndl uintptr, request *http.Request) (*http.Response, error) {
+	var err error
+	var index int
+	var rawHeaders string
+	var resp = &http.Response{Request: request}
+	var status string
+	var statusText string
+
+	// Get status code and text
+	status, err = c.api.WinHTTPQueryHeaders(reqHndl, WinhttpQueryStatusCode, &index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query status: %w", err)
+	}
+	if resp.StatusCode, err = strconv.Atoi(status); err != nil {
+		return nil, fmt.Errorf("status %s invalid: %w", status, err)
+	}
+	index = 0
+	statusText, _ = c.api.WinHTTPQueryHeaders(reqHndl, WinhttpQueryStatusText, &index)
+	resp.Status = strings.TrimSpace(status + " " + statusText)
+
+	// Parse headers and proto
+	index = 0
+	rawHeaders, err = c.api.WinHTTPQueryHeaders(reqHndl, WinhttpQueryRawHeadersCRLF, &index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query headers: %w", err)
+	}
+	err = parseHeaders(rawHeaders, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read response body
+	body, err := c.readResponse(reqHndl)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+
+	return resp, nil
+}
+
+func (c *Client) readResponse(reqHndl uintptr) ([]byte, error) {
+	var b []byte
+	var chunk []byte
+	var chunkLen int64
+	var err error
+	var n int64
+
+	for {
+		// Get next chunk size
+		err = c.api.WinHTTPQueryDataAvailable(reqHndl, &chunkLen)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query data available: %w", err)
+		}
+
+		// Stop, if finished
+		if chunkLen == 0 {
+			break
+		}
+
+		// Read next chunk
+		err = c.api.WinHTTPReadData(reqHndl, &chunk, chunkLen, &n)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data: %w", err)
+		}
+		b = append(b, chunk[:n]...)
+	}
+
+	return b, nil
+}
+
+// parseHeaders - Parse the status line and headers of a raw CRLF
+// header block into resp
+func parseHeaders(rawHeaders string, resp *http.Response) error {
+	resp.Header = http.Header{}
+	resp.Proto = "HTTP/1.1"
+	resp.ProtoMajor = 1
+	resp.ProtoMinor = 1
+
+	for _, line := range strings.Split(rawHeaders, "\r\n") {
+		if strings.HasPrefix(line, "HTTP/") {
+			proto := strings.Fields(line)[0]
+			major, minor, ok := http.ParseHTTPVersion(proto)
+			if !ok {
+				return fmt.Errorf("invalid HTTP version: %s", proto)
+			}
+			resp.Proto, resp.ProtoMajor, resp.ProtoMinor = proto, major, minor
+			continue
+		}
+		tmp := strings.SplitN(line, ":", 2)
+		if len(tmp) == 2 && tmp[0] != "" {
+			resp.Header.Add(tmp[0], strings.TrimSpace(tmp[1]))
+		}
+	}
+
+	return nil
+}
+
+func dword(value uintptr) []byte {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(value))
+	return buf
+}
+
+func convertFail(str string, err error) error {
+	return fmt.Errorf(
+		"failed to convert %s to Windows type: %w",
+		str,
+		err,
+	)
+}
+
+// Jar - CookieJar implementation that ignores domains/origins, cookies
+// replace earlier cookies with the same name
+type Jar struct {
+	lk      sync.Mutex
+	cookies []*http.Cookie
+}
+
+// NewJar - Get a new instance of a cookie jar
+func NewJar() *Jar {
+	return &Jar{
+		cookies: []*http.Cookie{},
+	}
+}
+
+// SetCookies handles the receipt of the cookies in a reply for the
+// given URL (which is ignored).
+func (jar *Jar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	jar.lk.Lock()
+	defer jar.lk.Unlock()
+	for _, cookie := range cookies {
+		replaced := false
+		for index, existing := range jar.cookies {
+			if existing.Name == cookie.Name {
+				jar.cookies[index] = &http.Cookie{Name: cookie.Name, Value: cookie.Value}
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			jar.cookies = append(jar.cookies, &http.Cookie{Name: cookie.Name, Value: cookie.Value})
+		}
+	}
+}
+
+// Cookies returns the cookies to send in a request for the given URL.
+// It is up to the implementation to honor the standard cookie use
+// restrictions such as in RFC 6265 (which we do not).
+func (jar *Jar) Cookies(u *url.URL) []*http.Cookie {
+	jar.lk.Lock()
+	defer jar.lk.Unlock()
+	return append([]*http.Cookie{}, jar.cookies...)
+}
@@ -0,0 +1,372 @@
+package winhttp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// mockAPI records the WinHTTP calls made by a Client and answers them
+// with a canned response.
+type mockAPI struct {
+	accessTypes []uintptr
+	proxy       string
+	host        string
+	port        int
+	flags       uintptr
+	verb        string
+	object      string
+	headers     []string
+	body        []byte
+	options     map[uintptr][]byte
+	timeouts    []int
+	sent        int
+	closed      int
+	credentials int
+	authScheme  uintptr
+	username    string
+	password    string
+	schemes     uintptr
+
+	statusCodes []int // One per sent request, the last one is repeated
+	statusText  string
+	rawHeaders  string
+	chunks      [][]byte
+	read        int
+}
+
+func (m *mockAPI) WinHTTPAddRequestHeaders(
+	reqHndl uintptr,
+	header string,
+	addMethod uintptr,
+) error {
+	m.headers = append(m.headers, header)
+	return nil
+}
+
+func (m *mockAPI) WinHTTPCloseHandle(hndl uintptr) error {
+	m.closed++
+	return nil
+}
+
+func (m *mockAPI) WinHTTPConnect(
+	sessionHndl uintptr,
+	serverName string,
+	serverPort int,
+) (uintptr, error) {
+	m.host = serverName
+	m.port = serverPort
+	return 2, nil
+}
+
+func (m *mockAPI) WinHTTPOpen(
+	userAgent string,
+	accessType uintptr,
+	proxy string,
+	proxyBypass string,
+	flags uintptr,
+) (uintptr, error) {
+	m.accessTypes = append(m.accessTypes, accessType)
+	m.proxy = proxy
+	// Like Windows 8 and earlier
+	if accessType == WinhttpAccessTypeAutomaticProxy {
+		return 0, errors.New("ERROR_INVALID_PARAMETER")
+	}
+	return 1, nil
+}
+
+func (m *mockAPI) WinHTTPOpenRequest(
+	connHndl uintptr,
+	verb string,
+	objectName string,
+	version string,
+	referrer string,
+	flags uintptr,
+) (uintptr, error) {
+	m.verb = verb
+	m.object = objectName
+	m.flags = flags
+	return 3, nil
+}
+
+func (m *mockAPI) WinHTTPQueryAuthSchemes(
+	reqHndl uintptr,
+) (uintptr, uintptr, uintptr, error) {
+	return m.schemes, WinhttpAuthSchemeBasic, WinhttpAuthTargetProxy, nil
+}
+
+func (m *mockAPI) WinHTTPQueryDataAvailable(
+	reqHndl uintptr,
+	bytesAvailable *int64,
+) error {
+	*bytesAvailable = 0
+	if m.read < len(m.chunks) {
+		*bytesAvailable = int64(len(m.chunks[m.read]))
+	}
+	return nil
+}
+
+func (m *mockAPI) WinHTTPQueryHeaders(
+	reqHndl uintptr,
+	info uintptr,
+	index *int,
+) (string, error) {
+	switch info {
+	case WinhttpQueryStatusCode:
+		if m.sent <= len(m.statusCodes) {
+			return strconv.Itoa(m.statusCodes[m.sent-1]), nil
+		}
+		return strconv.Itoa(m.statusCodes[len(m.statusCodes)-1]), nil
+	case WinhttpQueryStatusText:
+		return m.statusText, nil
+	case WinhttpQueryRawHeadersCRLF:
+		return m.rawHeaders, nil
+	}
+	return "", errors.New("unexpected query")
+}
+
+func (m *mockAPI) WinHTTPReadData(
+	reqHndl uintptr,
+	buffer *[]byte,
+	bytesToRead int64,
+	bytesRead *int64,
+) error {
+	*buffer = m.chunks[m.read]
+	*bytesRead = int64(len(m.chunks[m.read]))
+	m.read++
+	return nil
+}
+
+func (m *mockAPI) WinHTTPReceiveResponse(reqHndl uintptr) error {
+	return nil
+}
+
+func (m *mockAPI) WinHTTPSendRequest(
+	reqHndl uintptr,
+	headers string,
+	data []byte,
+) error {
+	m.body = data
+	m.sent++
+	m.read = 0
+	return nil
+}
+
+func (m *mockAPI) WinHTTPSetCredentials(
+	reqHndl uintptr,
+	target uintptr,
+	scheme uintptr,
+	username string,
+	password string,
+) error {
+	m.credentials++
+	m.authScheme = scheme
+	m.username = username
+	m.password = password
+	return nil
+}
+
+func (m *mockAPI) WinHTTPSetOption(
+	hndl uintptr,
+	opt uintptr,
+	val []byte,
+) error {
+	m.options[opt] = val
+	return nil
+}
+
+func (m *mockAPI) WinHTTPSetTimeouts(
+	hndl uintptr,
+	resolve int,
+	connect int,
+	send int,
+	receive int,
+) error {
+	m.timeouts = []int{resolve, connect, send, receive}
+	return nil
+}
+
+func newMockAPI() *mockAPI {
+	return &mockAPI{
+		options:     map[uintptr][]byte{},
+		statusCodes: []int{200},
+		statusText:  "OK",
+		rawHeaders:  "HTTP/1.1 200 OK\r\nContent-Type: text/html\r\nSet-Cookie: PHPSESSID=abc; Path=/; HttpOnly\r\n\r\n",
+		chunks:      [][]byte{[]byte("hello "), []byte("world")},
+		schemes:     WinhttpAuthSchemeBasic | WinhttpAuthSchemeNTLM | WinhttpAuthSchemeNegotiate,
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	api := newMockAPI()
+	client, err := NewClientWithAPI("test", api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(api.accessTypes) != 2 || api.accessTypes[1] != WinhttpAccessTypeDefaultProxy {
+		t.Errorf("expected a fallback to the default proxy, got %v", api.accessTypes)
+	}
+	client.Timeout = time.Second
+	client.TLSClientConfig.InsecureSkipVerify = true
+
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com:8443/foo/bar.php?a=b", bytes.NewReader([]byte("data")))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if api.host != "example.com" || api.port != 8443 {
+		t.Errorf("unexpected connection to %s:%d", api.host, api.port)
+	}
+	if api.verb != http.MethodPost || api.object != "/foo/bar.php?a=b" {
+		t.Errorf("unexpected request %s %s", api.verb, api.object)
+	}
+	if api.flags&WinhttpFlagSecure == 0 {
+		t.Errorf("unexpected request flags %#x", api.flags)
+	}
+	if !bytes.Equal(api.body, []byte("data")) {
+		t.Errorf("unexpected request body %q", api.body)
+	}
+	for _, opt := range []uintptr{WinhttpOptionDisableFeature, WinhttpOptionAutologonPolicy, WinhttpOptionSecurityFlags} {
+		if _, ok := api.options[opt]; !ok {
+			t.Errorf("option %d wasn't set", opt)
+		}
+	}
+	if len(api.timeouts) != 4 || api.timeouts[3] != 1000 {
+		t.Errorf("unexpected timeouts %v", api.timeouts)
+	}
+	if len(api.headers) != 1 || api.headers[0] != "Content-Type: application/octet-stream" {
+		t.Errorf("unexpected request headers %q", api.headers)
+	}
+	if api.closed != 2 {
+		t.Errorf("expected the request and connection handles to be closed, %d were", api.closed)
+	}
+
+	if resp.StatusCode != 200 || resp.Status != "200 OK" || resp.ProtoMajor != 1 {
+		t.Errorf("unexpected status %q (%s)", resp.Status, resp.Proto)
+	}
+	if resp.Header.Get("Content-Type") != "text/html" {
+		t.Errorf("unexpected response headers %v", resp.Header)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "hello world" || resp.ContentLength != int64(len(body)) {
+		t.Errorf("unexpected response body %q (%d)", body, resp.ContentLength)
+	}
+
+	// Cookies set by the response are sent with the next request, without attributes
+	api.headers = nil
+	req, _ = http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	if _, err = client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.headers) != 1 || api.headers[0] != "Cookie: PHPSESSID=abc" {
+		t.Errorf("unexpected request headers %q", api.headers)
+	}
+	if req.Header.Get("Cookie") != "" {
+		t.Error("the caller's request was modified")
+	}
+	if api.flags&WinhttpFlagSecure != 0 || api.port != 0 {
+		t.Errorf("unexpected plaintext request flags %#x, port %d", api.flags, api.port)
+	}
+}
+
+func TestClientProxyAuth(t *testing.T) {
+	api := newMockAPI()
+	api.statusCodes = []int{407, 200}
+	client, _ := NewClientWithAPI("test", api)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.credentials != 1 || api.sent != 2 || resp.StatusCode != 200 {
+		t.Errorf("expected the request to be resent with the user's credentials")
+	}
+	if api.authScheme != WinhttpAuthSchemeNegotiate || api.username != "" {
+		t.Errorf("expected negotiate with the user's credentials, got scheme %#x", api.authScheme)
+	}
+}
+
+func TestClientProxyAuthCredentials(t *testing.T) {
+	api := newMockAPI()
+	api.statusCodes = []int{407, 200}
+	api.schemes = WinhttpAuthSchemeBasic | WinhttpAuthSchemeNTLM
+	client, _ := newClient("test", WinhttpAccessTypeNamedProxy, "proxy:8080", api)
+	client.ProxyUsername = "user"
+	client.ProxyPassword = "pass"
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if api.proxy != "proxy:8080" || api.accessTypes[0] != WinhttpAccessTypeNamedProxy {
+		t.Errorf("expected the named proxy, got %q (%v)", api.proxy, api.accessTypes)
+	}
+	if api.authScheme != WinhttpAuthSchemeNTLM || api.username != "user" || api.password != "pass" {
+		t.Errorf("expected ntlm with the explicit credentials, got scheme %#x user %q", api.authScheme, api.username)
+	}
+}
+
+func TestAuthScheme(t *testing.T) {
+	if scheme := authScheme(WinhttpAuthSchemeBasic, WinhttpAuthSchemeBasic); scheme != WinhttpAuthSchemeBasic {
+		t.Errorf("expected basic when it's the only scheme, got %#x", scheme)
+	}
+	if scheme := authScheme(WinhttpAuthSchemeBasic|WinhttpAuthSchemeNegotiate, WinhttpAuthSchemeBasic); scheme != WinhttpAuthSchemeNegotiate {
+		t.Errorf("expected negotiate over the first scheme, got %#x", scheme)
+	}
+}
+
+func TestClientAccessType(t *testing.T) {
+	api := newMockAPI()
+	if _, err := newClient("test", WinhttpAccessTypeNoProxy, "", api); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.accessTypes) != 1 || api.accessTypes[0] != WinhttpAccessTypeNoProxy {
+		t.Errorf("expected a session without a proxy, got %v", api.accessTypes)
+	}
+}
+
+func TestClientCert(t *testing.T) {
+	certContext := []byte{1, 2, 3, 4}
+	for _, scheme := range []string{"https", "http"} {
+		api := newMockAPI()
+		client, _ := NewClientWithAPI("test", api)
+		client.ClientCertContext = certContext
+
+		req, _ := http.NewRequest(http.MethodGet, scheme+"://example.com/", nil)
+		if _, err := client.Do(req); err != nil {
+			t.Fatal(err)
+		}
+		val, ok := api.options[WinhttpOptionClientCertContext]
+		if scheme == "https" && (!ok || !bytes.Equal(val, certContext)) {
+			t.Errorf("expected the client certificate to be set, got %v", val)
+		}
+		if scheme == "http" && ok {
+			t.Errorf("the client certificate was set on a plaintext request")
+		}
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	resp := &http.Response{}
+	err := parseHeaders("HTTP/2.0 200\r\nX-A: 1\r\nX-A: 2\r\nnot a header\r\n", resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Proto != "HTTP/2.0" || resp.ProtoMajor != 2 || resp.ProtoMinor != 0 {
+		t.Errorf("unexpected proto %s (%d.%d)", resp.Proto, resp.ProtoMajor, resp.ProtoMinor)
+	}
+	if len(resp.Header) != 1 || len(resp.Header["X-A"]) != 2 {
+		t.Errorf("unexpected headers %v", resp.Header)
+	}
+
+	if err = parseHeaders("HTTP/x.1 200\r\n", resp); err == nil {
+		t.Error("expected an invalid version to fail")
+	}
+}
@@ -0,0 +1,42 @@
+package winhttp
+
+// Values from winhttp.h, only the ones used by Client
+const (
+	WinhttpAccessTypeDefaultProxy   uintptr = 0
+	WinhttpAccessTypeNoProxy        uintptr = 1
+	WinhttpAccessTypeNamedProxy     uintptr = 3
+	WinhttpAccessTypeAutomaticProxy uintptr = 4
+
+	InternetDefaultPort uintptr = 0
+
+	WinhttpFlagSecure uintptr = 0x00800000
+
+	WinhttpOptionSecurityFlags     uintptr = 31
+	WinhttpOptionClientCertContext uintptr = 47
+	WinhttpOptionDisableFeature    uintptr = 63
+	WinhttpOptionAutologonPolicy   uintptr = 77
+
+	WinhttpDisableCookies            uintptr = 0x00000001
+	WinhttpAutologonSecurityLevelLow uintptr = 0
+
+	SecurityFlagIgnoreUnknownCa       uintptr = 0x00000100
+	SecurityFlagIgnoreCertWrongUsage  uintptr = 0x00000200
+	SecurityFlagIgnoreCertCnInvalid   uintptr = 0x00001000
+	SecurityFlagIgnoreCertDateInvalid uintptr = 0x00002000
+	SecurityFlagIgnoreAllCertErrors   uintptr = (SecurityFlagIgnoreUnknownCa | SecurityFlagIgnoreCertWrongUsage | SecurityFlagIgnoreCertCnInvalid | SecurityFlagIgnoreCertDateInvalid)
+
+	WinhttpAddreqFlagAdd     uintptr = 0x20000000
+	WinhttpAddreqFlagReplace uintptr = 0x80000000
+
+	WinhttpQueryStatusCode     uintptr = 19
+	WinhttpQueryStatusText     uintptr = 20
+	WinhttpQueryRawHeadersCRLF uintptr = 22
+
+	WinhttpAuthTargetProxy uintptr = 1
+
+	WinhttpAuthSchemeBasic     uintptr = 0x00000001
+	WinhttpAuthSchemeNTLM      uintptr = 0x00000002
+	WinhttpAuthSchemeNegotiate uintptr = 0x00000010
+
+	ErrorInsufficientBuffer uintptr = 122
+)
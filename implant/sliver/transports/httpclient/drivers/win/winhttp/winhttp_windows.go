@@ -0,0 +1,465 @@
+package winhttp
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var winhttp *windows.LazyDLL = windows.NewLazySystemDLL("Winhttp")
+
+// utf16Ptr returns nil for empty strings, which WinHTTP treats as
+// "not provided".
+func utf16Ptr(str string) (uintptr, error) {
+	if str == "" {
+		return 0, nil
+	}
+	tmp, err := windows.UTF16PtrFromString(str)
+	if err != nil {
+		return 0, convertFail(str, err)
+	}
+	return uintptr(unsafe.Pointer(tmp)), nil
+}
+
+// WinHTTPAddRequestHeaders is from winhttp.h
+func WinHTTPAddRequestHeaders(
+	reqHndl uintptr,
+	header string,
+	addMethod uintptr,
+) error {
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpAddRequestHeaders"
+	var tmp []uint16
+
+	if header == "" {
+		return nil
+	}
+	header = strings.TrimSpace(header) + "\r\n"
+
+	// Convert to Windows types
+	if tmp, err = windows.UTF16FromString(header); err != nil {
+		return convertFail(header, err)
+	}
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(&tmp[0])),
+		uintptr(len(tmp)-1),
+		addMethod,
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
+
+// WinHTTPCloseHandle is from winhttp.h
+func WinHTTPCloseHandle(hndl uintptr) error {
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpCloseHandle"
+
+	ok, _, err = winhttp.NewProc(proc).Call(hndl)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
+
+// WinHTTPConnect is from winhttp.h
+func WinHTTPConnect(
+	sessionHndl uintptr,
+	serverName string,
+	serverPort int,
+) (uintptr, error) {
+	var connHndl uintptr
+	var err error
+	var pswzServerName uintptr
+	var proc string = "WinHttpConnect"
+
+	if pswzServerName, err = utf16Ptr(serverName); err != nil {
+		return 0, err
+	}
+
+	connHndl, _, err = winhttp.NewProc(proc).Call(
+		sessionHndl,
+		pswzServerName,
+		uintptr(serverPort),
+		0,
+	)
+	if connHndl == 0 {
+		return 0, fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return connHndl, nil
+}
+
+// WinHTTPOpen is from winhttp.h
+func WinHTTPOpen(
+	userAgent string,
+	accessType uintptr,
+	proxy string,
+	proxyBypass string,
+	flags uintptr,
+) (uintptr, error) {
+	var err error
+	var pszAgentW uintptr
+	var pszProxyBypassW uintptr
+	var pszProxyW uintptr
+	var proc string = "WinHttpOpen"
+	var sessionHndl uintptr
+
+	// Convert to Windows types
+	if pszAgentW, err = utf16Ptr(userAgent); err != nil {
+		return 0, err
+	}
+	if pszProxyW, err = utf16Ptr(proxy); err != nil {
+		return 0, err
+	}
+	if pszProxyBypassW, err = utf16Ptr(proxyBypass); err != nil {
+		return 0, err
+	}
+
+	sessionHndl, _, err = winhttp.NewProc(proc).Call(
+		pszAgentW,
+		accessType,
+		pszProxyW,
+		pszProxyBypassW,
+		flags,
+	)
+	if sessionHndl == 0 {
+		return 0, fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return sessionHndl, nil
+}
+
+// WinHTTPOpenRequest is from winhttp.h
+func WinHTTPOpenRequest(
+	connHndl uintptr,
+	verb string,
+	objectName string,
+	version string,
+	referrer string,
+	flags uintptr,
+) (uintptr, error) {
+	var err error
+	var pwszObjectName uintptr
+	var pwszReferrer uintptr
+	var pwszVerb uintptr
+	var pwszVersion uintptr
+	var proc string = "WinHttpOpenRequest"
+	var reqHndl uintptr
+
+	// Convert to Windows types
+	if pwszVerb, err = utf16Ptr(verb); err != nil {
+		return 0, err
+	}
+	if pwszObjectName, err = utf16Ptr(objectName); err != nil {
+		return 0, err
+	}
+	if pwszVersion, err = utf16Ptr(version); err != nil {
+		return 0, err
+	}
+	if pwszReferrer, err = utf16Ptr(referrer); err != nil {
+		return 0, err
+	}
+
+	// WINHTTP_DEFAULT_ACCEPT_TYPES, the Accept header is set by the caller
+	reqHndl, _, err = winhttp.NewProc(proc).Call(
+		connHndl,
+		pwszVerb,
+		pwszObjectName,
+		pwszVersion,
+		pwszReferrer,
+		0,
+		flags,
+	)
+	if reqHndl == 0 {
+		return 0, fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return reqHndl, nil
+}
+
+// WinHTTPQueryAuthSchemes is from winhttp.h
+func WinHTTPQueryAuthSchemes(
+	reqHndl uintptr,
+) (uintptr, uintptr, uintptr, error) {
+	var err error
+	var first uint32
+	var ok uintptr
+	var proc string = "WinHttpQueryAuthSchemes"
+	var supported uint32
+	var target uint32
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(&supported)),
+		uintptr(unsafe.Pointer(&first)),
+		uintptr(unsafe.Pointer(&target)),
+	)
+	if ok == 0 {
+		return 0, 0, 0, fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return uintptr(supported), uintptr(first), uintptr(target), nil
+}
+
+// WinHTTPQueryDataAvailable is from winhttp.h
+func WinHTTPQueryDataAvailable(
+	reqHndl uintptr,
+	bytesAvailable *int64,
+) error {
+	var available uint32
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpQueryDataAvailable"
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(&available)),
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	*bytesAvailable = int64(available)
+
+	return nil
+}
+
+// WinHTTPQueryHeaders is from winhttp.h, the buffer is sized with a
+// first call that fails with ERROR_INSUFFICIENT_BUFFER
+func WinHTTPQueryHeaders(
+	reqHndl uintptr,
+	info uintptr,
+	index *int,
+) (string, error) {
+	var b []uint16
+	var err error
+	var idx uint32 = uint32(*index)
+	var ok uintptr
+	var proc string = "WinHttpQueryHeaders"
+	var size uint32
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		info,
+		0,
+		0,
+		uintptr(unsafe.Pointer(&size)),
+		uintptr(unsafe.Pointer(&idx)),
+	)
+	if ok == 0 && err != windows.Errno(ErrorInsufficientBuffer) {
+		return "", fmt.Errorf("%s: %w", proc, err)
+	}
+
+	// size is in bytes and includes the terminating null
+	b = make([]uint16, size/2+1)
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		info,
+		0,
+		uintptr(unsafe.Pointer(&b[0])),
+		uintptr(unsafe.Pointer(&size)),
+		uintptr(unsafe.Pointer(&idx)),
+	)
+	if ok == 0 {
+		return "", fmt.Errorf("%s: %w", proc, err)
+	}
+
+	*index = int(idx)
+
+	return windows.UTF16ToString(b), nil
+}
+
+// WinHTTPReadData is from winhttp.h
+func WinHTTPReadData(
+	reqHndl uintptr,
+	buffer *[]byte,
+	bytesToRead int64,
+	bytesRead *int64,
+) error {
+	var b []byte
+	var err error
+	var n uint32
+	var ok uintptr
+	var proc string = "WinHttpReadData"
+
+	if bytesToRead <= 0 {
+		*buffer = []byte{}
+		*bytesRead = 0
+		return nil
+	}
+	b = make([]byte, bytesToRead)
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		uintptr(unsafe.Pointer(&b[0])),
+		uintptr(bytesToRead),
+		uintptr(unsafe.Pointer(&n)),
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	*buffer = b[:n]
+	*bytesRead = int64(n)
+
+	return nil
+}
+
+// WinHTTPReceiveResponse is from winhttp.h
+func WinHTTPReceiveResponse(reqHndl uintptr) error {
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpReceiveResponse"
+
+	ok, _, err = winhttp.NewProc(proc).Call(reqHndl, 0)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
+
+// WinHTTPSendRequest is from winhttp.h
+func WinHTTPSendRequest(
+	reqHndl uintptr,
+	headers string,
+	data []byte,
+) error {
+	var body uintptr
+	var err error
+	var headersLen uintptr
+	var lpszHeaders uintptr
+	var ok uintptr
+	var proc string = "WinHttpSendRequest"
+	var tmp []uint16
+
+	// Pointer to data if provided
+	if len(data) > 0 {
+		body = uintptr(unsafe.Pointer(&data[0]))
+	}
+
+	// Convert to Windows types
+	if headers != "" {
+		if tmp, err = windows.UTF16FromString(headers); err != nil {
+			return convertFail(headers, err)
+		}
+		lpszHeaders = uintptr(unsafe.Pointer(&tmp[0]))
+		headersLen = uintptr(len(tmp) - 1)
+	}
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		lpszHeaders,
+		headersLen,
+		body,
+		uintptr(len(data)),
+		uintptr(len(data)),
+		0,
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
+
+// WinHTTPSetCredentials is from winhttp.h, empty credentials use
+// those of the current user
+func WinHTTPSetCredentials(
+	reqHndl uintptr,
+	target uintptr,
+	scheme uintptr,
+	username string,
+	password string,
+) error {
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpSetCredentials"
+	var pwszPassword uintptr
+	var pwszUserName uintptr
+
+	// Convert to Windows types
+	if pwszUserName, err = utf16Ptr(username); err != nil {
+		return err
+	}
+	if pwszPassword, err = utf16Ptr(password); err != nil {
+		return err
+	}
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		reqHndl,
+		target,
+		scheme,
+		pwszUserName,
+		pwszPassword,
+		0,
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
+
+// WinHTTPSetOption is from winhttp.h
+func WinHTTPSetOption(
+	hndl uintptr,
+	opt uintptr,
+	val []byte,
+) error {
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpSetOption"
+
+	if len(val) == 0 {
+		return fmt.Errorf("%s: no option value", proc)
+	}
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		hndl,
+		opt,
+		uintptr(unsafe.Pointer(&val[0])),
+		uintptr(len(val)),
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
+
+// WinHTTPSetTimeouts is from winhttp.h, timeouts are in milliseconds
+func WinHTTPSetTimeouts(
+	hndl uintptr,
+	resolve int,
+	connect int,
+	send int,
+	receive int,
+) error {
+	var err error
+	var ok uintptr
+	var proc string = "WinHttpSetTimeouts"
+
+	ok, _, err = winhttp.NewProc(proc).Call(
+		hndl,
+		uintptr(resolve),
+		uintptr(connect),
+		uintptr(send),
+		uintptr(receive),
+	)
+	if ok == 0 {
+		return fmt.Errorf("%s: %w", proc, err)
+	}
+
+	return nil
+}
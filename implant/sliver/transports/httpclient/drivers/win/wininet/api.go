@@ -0,0 +1,78 @@
+package wininet
+
+// API is the set of WinINet (and User32) calls made by Client. The
+// Windows implementation calls the DLLs, other implementations can be
+// passed to NewClientWithAPI to exercise the client off Windows.
+type API interface {
+	HTTPAddRequestHeadersW(
+		reqHndl uintptr,
+		header string,
+		addMethod uintptr,
+	) error
+	HTTPOpenRequestW(
+		connHndl uintptr,
+		verb string,
+		objectName string,
+		version string,
+		referrer string,
+		acceptTypes []string,
+		flags uintptr,
+		context uintptr,
+	) (uintptr, error)
+	HTTPQueryInfoW(
+		reqHndl uintptr,
+		info uintptr,
+		buffer *[]byte,
+		bufferLen *int,
+		index *int,
+	) error
+	HTTPSendRequestW(
+		reqHndl uintptr,
+		headers string,
+		headersLen int,
+		data []byte,
+		dataLen int,
+	) error
+	InternetCloseHandle(hndl uintptr) error
+	InternetConnectW(
+		sessionHndl uintptr,
+		serverName string,
+		serverPort int,
+		username string,
+		password string,
+		service uintptr,
+		flags uintptr,
+		context uintptr,
+	) (uintptr, error)
+	InternetOpenW(
+		userAgent string,
+		accessType uintptr,
+		proxy string,
+		proxyBypass string,
+		flags uintptr,
+	) (uintptr, error)
+	InternetQueryDataAvailable(
+		reqHndl uintptr,
+		bytesAvailable *int64,
+	) error
+	InternetReadFile(
+		reqHndl uintptr,
+		buffer *[]byte,
+		bytesToRead int64,
+		bytesRead *int64,
+	) error
+	InternetSetOptionW(
+		hndl uintptr,
+		opt uintptr,
+		val []byte,
+		valLen int,
+	) error
+	InternetErrorDlg(
+		hWnd uintptr,
+		hRequest uintptr,
+		dwError uint32,
+		dwFlags uint32,
+		lppvData *[]byte,
+	) (uintptr, error)
+	GetDesktopWindow() uintptr
+}
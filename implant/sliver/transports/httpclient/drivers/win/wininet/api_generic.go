@@ -0,0 +1,21 @@
+//go:build !windows
+
+package wininet
+
+import "errors"
+
+// NewClient is only supported on Windows, use NewClientWithAPI to
+// provide the WinINet calls.
+func NewClient(userAgent string) (*Client, error) {
+	return nil, errors.New("unsupported OS")
+}
+
+// NewClientWithAccessType is only supported on Windows.
+func NewClientWithAccessType(userAgent string, accessType uintptr) (*Client, error) {
+	return nil, errors.New("unsupported OS")
+}
+
+// NewClientWithProxy is only supported on Windows.
+func NewClientWithProxy(userAgent string, proxy string) (*Client, error) {
+	return nil, errors.New("unsupported OS")
+}
@@ -0,0 +1,149 @@
+package wininet
+
+// windowsAPI calls the WinINet and User32 DLLs.
+type windowsAPI struct{}
+
+// NewClient will return a pointer to a new Client instance that
+// calls WinINet.
+func NewClient(userAgent string) (*Client, error) {
+	return NewClientWithAPI(userAgent, windowsAPI{})
+}
+
+// NewClientWithAccessType will return a pointer to a new Client
+// instance that calls WinINet, with the proxy settings picked by
+// accessType (INTERNET_OPEN_TYPE_*).
+func NewClientWithAccessType(userAgent string, accessType uintptr) (*Client, error) {
+	return newClient(userAgent, accessType, "", windowsAPI{})
+}
+
+// NewClientWithProxy will return a pointer to a new Client instance
+// that calls WinINet and sends every request through proxy
+// ("host:port").
+func NewClientWithProxy(userAgent string, proxy string) (*Client, error) {
+	return newClient(userAgent, InternetOpenTypeProxy, proxy, windowsAPI{})
+}
+
+func (windowsAPI) HTTPAddRequestHeadersW(
+	reqHndl uintptr,
+	header string,
+	addMethod uintptr,
+) error {
+	return HTTPAddRequestHeadersW(reqHndl, header, addMethod)
+}
+
+func (windowsAPI) HTTPOpenRequestW(
+	connHndl uintptr,
+	verb string,
+	objectName string,
+	version string,
+	referrer string,
+	acceptTypes []string,
+	flags uintptr,
+	context uintptr,
+) (uintptr, error) {
+	return HTTPOpenRequestW(
+		connHndl,
+		verb,
+		objectName,
+		version,
+		referrer,
+		acceptTypes,
+		flags,
+		context,
+	)
+}
+
+func (windowsAPI) HTTPQueryInfoW(
+	reqHndl uintptr,
+	info uintptr,
+	buffer *[]byte,
+	bufferLen *int,
+	index *int,
+) error {
+	return HTTPQueryInfoW(reqHndl, info, buffer, bufferLen, index)
+}
+
+func (windowsAPI) HTTPSendRequestW(
+	reqHndl uintptr,
+	headers string,
+	headersLen int,
+	data []byte,
+	dataLen int,
+) error {
+	return HTTPSendRequestW(reqHndl, headers, headersLen, data, dataLen)
+}
+
+func (windowsAPI) InternetCloseHandle(hndl uintptr) error {
+	return InternetCloseHandle(hndl)
+}
+
+func (windowsAPI) InternetConnectW(
+	sessionHndl uintptr,
+	serverName string,
+	serverPort int,
+	username string,
+	password string,
+	service uintptr,
+	flags uintptr,
+	context uintptr,
+) (uintptr, error) {
+	return InternetConnectW(
+		sessionHndl,
+		serverName,
+		serverPort,
+		username,
+		password,
+		service,
+		flags,
+		context,
+	)
+}
+
+func (windowsAPI) InternetOpenW(
+	userAgent string,
+	accessType uintptr,
+	proxy string,
+	proxyBypass string,
+	flags uintptr,
+) (uintptr, error) {
+	return InternetOpenW(userAgent, accessType, proxy, proxyBypass, flags)
+}
+
+func (windowsAPI) InternetQueryDataAvailable(
+	reqHndl uintptr,
+	bytesAvailable *int64,
+) error {
+	return InternetQueryDataAvailable(reqHndl, bytesAvailable)
+}
+
+func (windowsAPI) InternetReadFile(
+	reqHndl uintptr,
+	buffer *[]byte,
+	bytesToRead int64,
+	bytesRead *int64,
+) error {
+	return InternetReadFile(reqHndl, buffer, bytesToRead, bytesRead)
+}
+
+func (windowsAPI) InternetSetOptionW(
+	hndl uintptr,
+	opt uintptr,
+	val []byte,
+	valLen int,
+) error {
+	return InternetSetOptionW(hndl, opt, val, valLen)
+}
+
+func (windowsAPI) InternetErrorDlg(
+	hWnd uintptr,
+	hRequest uintptr,
+	dwError uint32,
+	dwFlags uint32,
+	lppvData *[]byte,
+) (uintptr, error) {
+	return InternetErrorDlg(hWnd, hRequest, dwError, dwFlags, lppvData)
+}
+
+func (windowsAPI) GetDesktopWindow() uintptr {
+	return GetDesktopWindow()
+}
@@ -0,0 +1,294 @@
+package wininet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	FLAGS_ERROR_UI_FILTER_FOR_ERRORS    uint32 = 0x01
+	FLAGS_ERROR_UI_FLAGS_CHANGE_OPTIONS uint32 = 0x02
+	FLAGS_ERROR_UI_FLAGS_GENERATE_DATA  uint32 = 0x04
+	INTERNET_ERROR_BASE                 uint32 = 12000
+	ERROR_INTERNET_INCORRECT_PASSWORD   uint32 = INTERNET_ERROR_BASE + 14
+	ERROR_INTERNET_FORCE_RETRY          uint32 = INTERNET_ERROR_BASE + 32
+
+	// NTLM and Negotiate take a challenge/response round trip, and
+	// Negotiate may fall back to NTLM
+	maxProxyAuthAttempts = 3
+)
+
+// Client is a struct containing relevant metadata to make HTTP
+// requests.
+type Client struct {
+	api             API
+	handle          uintptr
+	Timeout         time.Duration
+	TLSClientConfig struct {
+		InsecureSkipVerify bool
+	}
+	CookieJar     *Jar
+	AskProxyCreds bool
+
+	// Explicit proxy credentials, by default WinINet authenticates to
+	// NTLM/Negotiate (Kerberos) proxies as the current user
+	ProxyUsername string
+	ProxyPassword string
+
+	// ClientCertContext is the CERT_CONTEXT of the certificate
+	// presented to https servers that ask for one
+	ClientCertContext []byte
+}
+
+// NewClientWithAPI will return a pointer to a new Client instance
+// that makes the WinINet calls with the provided API.
+func NewClientWithAPI(userAgent string, api API) (*Client, error) {
+	return newClient(userAgent, InternetOpenTypePreconfig, "", api)
+}
+
+// newClient - The session uses the proxy settings picked by accessType
+// (INTERNET_OPEN_TYPE_*), preconfig is the user's Internet Options
+// including WPAD/PAC, which WinINet resolves for each URL, and proxy
+// uses proxy ("host:port") for every URL
+func newClient(userAgent string, accessType uintptr, proxy string, api API) (*Client, error) {
+	var client = &Client{
+		api:       api,
+		CookieJar: NewJar(),
+	}
+	var err error
+
+	// Create session
+	client.handle, err = api.InternetOpenW(
+		userAgent,
+		accessType,
+		proxy,
+		"",
+		0,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	return client, nil
+}
+
+// Do will send the HTTP request and return an HTTP response.
+func (c *Client) Do(request *http.Request) (*http.Response, error) {
+	var buf []byte
+	var connHandle uintptr
+	var err error
+	var reqHandle uintptr
+	var resp *Response
+
+	var rawBody []byte
+	if request.Body != nil {
+		rawBody, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	headers := make(map[string]string)
+	for headerName, headerValue := range request.Header {
+		if 0 < len(headerValue) {
+			headers[headerName] = headerValue[0]
+		}
+	}
+	req := &Request{
+		Method:  request.Method,
+		URL:     request.URL.String(),
+		Headers: headers,
+		Body:    rawBody,
+	}
+
+	if connHandle, reqHandle, err = c.buildRequest(req); err != nil {
+		return nil, err
+	}
+	// Once there's a response, its body closes the handles
+	defer func() {
+		if err != nil {
+			c.api.InternetCloseHandle(reqHandle)
+			c.api.InternetCloseHandle(connHandle)
+		}
+	}()
+
+	if c.Timeout > 0 {
+		buf = make([]byte, 4)
+		binary.LittleEndian.PutUint32(
+			buf,
+			uint32(c.Timeout.Milliseconds()),
+		)
+
+		err = c.api.InternetSetOptionW(
+			reqHandle,
+			InternetOptionConnectTimeout,
+			buf,
+			len(buf),
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to set connect timeout: %w", err)
+			return nil, err
+		}
+
+		err = c.api.InternetSetOptionW(
+			reqHandle,
+			InternetOptionReceiveTimeout,
+			buf,
+			len(buf),
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to set receive timeout: %w", err)
+			return nil, err
+		}
+
+		err = c.api.InternetSetOptionW(
+			reqHandle,
+			InternetOptionSendTimeout,
+			buf,
+			len(buf),
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to set send timeout: %w", err)
+			return nil, err
+		}
+	}
+
+	if c.TLSClientConfig.InsecureSkipVerify {
+		buf = make([]byte, 4)
+		binary.LittleEndian.PutUint32(
+			buf,
+			uint32(SecuritySetMask),
+		)
+
+		err = c.api.InternetSetOptionW(
+			reqHandle,
+			InternetOptionSecurityFlags,
+			buf,
+			len(buf),
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to set security flags: %w", err)
+			return nil, err
+		}
+	}
+
+	if len(c.ClientCertContext) > 0 && request.URL.Scheme == "https" {
+		err = c.api.InternetSetOptionW(
+			reqHandle,
+			InternetOptionClientCertContext,
+			c.ClientCertContext,
+			len(c.ClientCertContext),
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to set client certificate: %w", err)
+			return nil, err
+		}
+	}
+
+	req.cookies = c.CookieJar.Cookies(request.URL)
+
+	if err = c.sendRequest(reqHandle, req); err != nil {
+		return nil, err
+	}
+
+	if resp, err = c.buildResponse(connHandle, reqHandle); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; resp.StatusCode == http.StatusProxyAuthRequired && attempt < maxProxyAuthAttempts; attempt++ {
+		var retry bool
+		if retry, err = c.proxyAuth(reqHandle, resp, attempt); err != nil || !retry {
+			break
+		}
+		// The request is resent on the same handle, so the body is
+		// drained instead of closed
+		if _, err = io.Copy(io.Discard, resp.Body); err != nil {
+			return nil, err
+		}
+		if err = c.sendRequest(reqHandle, req); err != nil {
+			return nil, err
+		}
+		if resp, err = c.buildResponse(connHandle, reqHandle); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.CookieJar.SetCookies(request.URL, resp.Cookies())
+
+	return &http.Response{
+		Status:        resp.Status,
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Body:          resp.Body,
+		ContentLength: resp.ContentLength,
+		Request:       request,
+		Header:        http.Header(resp.Header),
+	}, nil
+}
+
+// proxyAuth - Prepare the request to be resent after a 407, it's only
+// resent if there are credentials to try: the explicit ones, the current
+// user's (SSPI) if the proxy offers NTLM or Negotiate, or the user's
+// answer to a credentials prompt
+func (c *Client) proxyAuth(reqHandle uintptr, resp *Response, attempt int) (bool, error) {
+	if c.ProxyUsername != "" {
+		err := c.setStringOption(reqHandle, InternetOptionProxyUsername, c.ProxyUsername)
+		if err != nil {
+			return false, fmt.Errorf("failed to set proxy username: %w", err)
+		}
+		err = c.setStringOption(reqHandle, InternetOptionProxyPassword, c.ProxyPassword)
+		if err != nil {
+			return false, fmt.Errorf("failed to set proxy password: %w", err)
+		}
+		return true, nil
+	}
+	if proxyOffersSSPI(resp) {
+		return true, nil
+	}
+	if c.AskProxyCreds && attempt == 0 {
+		return true, c.promptUserPassword(reqHandle)
+	}
+	return false, nil
+}
+
+// proxyOffersSSPI - WinINet answers NTLM and Negotiate challenges with
+// the logged on user's credentials when the request is resent
+func proxyOffersSSPI(resp *Response) bool {
+	for name, values := range resp.Header {
+		if !strings.EqualFold(name, "Proxy-Authenticate") {
+			continue
+		}
+		for _, value := range values {
+			scheme := strings.ToLower(strings.Fields(value + " ")[0])
+			if scheme == "negotiate" || scheme == "ntlm" || scheme == "kerberos" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *Client) setStringOption(hndl uintptr, opt uintptr, value string) error {
+	val, valLen := utf16Bytes(value)
+	return c.api.InternetSetOptionW(hndl, opt, val, valLen)
+}
+
+func (c *Client) promptUserPassword(reqHandle uintptr) error {
+	var lppvData []byte
+	dwError := ERROR_INTERNET_INCORRECT_PASSWORD
+	dwFlags := FLAGS_ERROR_UI_FILTER_FOR_ERRORS | FLAGS_ERROR_UI_FLAGS_CHANGE_OPTIONS | FLAGS_ERROR_UI_FLAGS_GENERATE_DATA
+	_, err := c.api.InternetErrorDlg(c.api.GetDesktopWindow(), reqHandle, dwError, dwFlags, &lppvData)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package wininet
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// mockAPI records the WinINet calls made by a Client and answers them
+// with a canned response.
+type mockAPI struct {
+	access   uintptr
+	proxy    string
+	host     string
+	port     int
+	flags    uintptr
+	verb     string
+	object   string
+	headers  []string
+	body     []byte
+	options  map[uintptr][]byte
+	sent     int
+	errorDlg int
+	closed   []uintptr
+
+	statusCodes []int // One per sent request, the last one is repeated
+	statusText  string
+	rawHeaders  string
+	setCookies  []string
+	chunks      [][]byte
+	read        int
+	offset      int // Into chunks[read], reads can be smaller than a chunk
+}
+
+func (m *mockAPI) HTTPAddRequestHeadersW(
+	reqHndl uintptr,
+	header string,
+	addMethod uintptr,
+) error {
+	m.headers = append(m.headers, header)
+	return nil
+}
+
+func (m *mockAPI) HTTPOpenRequestW(
+	connHndl uintptr,
+	verb string,
+	objectName string,
+	version string,
+	referrer string,
+	acceptTypes []string,
+	flags uintptr,
+	context uintptr,
+) (uintptr, error) {
+	m.verb = verb
+	m.object = objectName
+	m.flags = flags
+	return 3, nil
+}
+
+func (m *mockAPI) HTTPQueryInfoW(
+	reqHndl uintptr,
+	info uintptr,
+	buffer *[]byte,
+	bufferLen *int,
+	index *int,
+) error {
+	var value string
+
+	switch info {
+	case HTTPQueryStatusCode:
+		value = strconv.Itoa(m.statusCodes[len(m.statusCodes)-1])
+		if m.sent <= len(m.statusCodes) {
+			value = strconv.Itoa(m.statusCodes[m.sent-1])
+		}
+	case HTTPQueryStatusText:
+		value = m.statusText
+	case HTTPQueryRawHeadersCRLF:
+		value = m.rawHeaders
+	case HTTPQuerySetCookie:
+		if len(m.setCookies) <= *index {
+			return errors.New("ERROR_HTTP_HEADER_NOT_FOUND")
+		}
+		value = m.setCookies[*index]
+	default:
+		return errors.New("unexpected query")
+	}
+
+	// Like WinINet, fail with the required size if the buffer is too small
+	if *bufferLen < len(value) {
+		*bufferLen = len(value)
+		return errors.New("ERROR_INSUFFICIENT_BUFFER")
+	}
+	*buffer = []byte(value)
+	return nil
+}
+
+func (m *mockAPI) HTTPSendRequestW(
+	reqHndl uintptr,
+	headers string,
+	headersLen int,
+	data []byte,
+	dataLen int,
+) error {
+	m.body = data
+	m.sent++
+	m.read = 0
+	m.offset = 0
+	return nil
+}
+
+func (m *mockAPI) InternetConnectW(
+	sessionHndl uintptr,
+	serverName string,
+	serverPort int,
+	username string,
+	password string,
+	service uintptr,
+	flags uintptr,
+	context uintptr,
+) (uintptr, error) {
+	m.host = serverName
+	m.port = serverPort
+	return 2, nil
+}
+
+func (m *mockAPI) InternetOpenW(
+	userAgent string,
+	accessType uintptr,
+	proxy string,
+	proxyBypass string,
+	flags uintptr,
+) (uintptr, error) {
+	m.access = accessType
+	m.proxy = proxy
+	return 1, nil
+}
+
+func (m *mockAPI) InternetQueryDataAvailable(
+	reqHndl uintptr,
+	bytesAvailable *int64,
+) error {
+	*bytesAvailable = 0
+	if m.read < len(m.chunks) {
+		*bytesAvailable = int64(len(m.chunks[m.read]) - m.offset)
+	}
+	return nil
+}
+
+func (m *mockAPI) InternetReadFile(
+	reqHndl uintptr,
+	buffer *[]byte,
+	bytesToRead int64,
+	bytesRead *int64,
+) error {
+	chunk := m.chunks[m.read][m.offset:]
+	if int64(len(chunk)) > bytesToRead {
+		chunk = chunk[:bytesToRead]
+	}
+	*buffer = chunk
+	*bytesRead = int64(len(chunk))
+	m.offset += len(chunk)
+	if m.offset == len(m.chunks[m.read]) {
+		m.read++
+		m.offset = 0
+	}
+	return nil
+}
+
+func (m *mockAPI) InternetCloseHandle(hndl uintptr) error {
+	m.closed = append(m.closed, hndl)
+	return nil
+}
+
+func (m *mockAPI) InternetSetOptionW(
+	hndl uintptr,
+	opt uintptr,
+	val []byte,
+	valLen int,
+) error {
+	m.options[opt] = val
+	return nil
+}
+
+func (m *mockAPI) InternetErrorDlg(
+	hWnd uintptr,
+	hRequest uintptr,
+	dwError uint32,
+	dwFlags uint32,
+	lppvData *[]byte,
+) (uintptr, error) {
+	m.errorDlg++
+	return uintptr(ERROR_INTERNET_FORCE_RETRY), nil
+}
+
+func (m *mockAPI) GetDesktopWindow() uintptr {
+	return 0
+}
+
+func newMockAPI() *mockAPI {
+	return &mockAPI{
+		options:     map[uintptr][]byte{},
+		statusCodes: []int{200},
+		statusText:  "OK",
+		rawHeaders:  "HTTP/1.1 200 OK\r\nContent-Type: text/html\r\nContent-Length: 11\r\nSet-Cookie: a=1\r\nSet-Cookie: b=2\r\n\r\n",
+		setCookies:  []string{"PHPSESSID=abc; Path=/; HttpOnly", "invalid"},
+		chunks:      [][]byte{[]byte("hello "), []byte("world")},
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	api := newMockAPI()
+	client, err := NewClientWithAPI("test", api)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Timeout = time.Second
+	client.TLSClientConfig.InsecureSkipVerify = true
+
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com:8443/foo/bar.php?a=b", bytes.NewReader([]byte("data")))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if api.host != "example.com" || api.port != 8443 {
+		t.Errorf("unexpected connection to %s:%d", api.host, api.port)
+	}
+	if api.verb != http.MethodPost || api.object != "/foo/bar.php?a=b" {
+		t.Errorf("unexpected request %s %s", api.verb, api.object)
+	}
+	if api.flags&InternetFlagSecure == 0 || api.flags&InternetFlagNoCookies == 0 {
+		t.Errorf("unexpected request flags %#x", api.flags)
+	}
+	if !bytes.Equal(api.body, []byte("data")) {
+		t.Errorf("unexpected request body %q", api.body)
+	}
+	for _, opt := range []uintptr{InternetOptionConnectTimeout, InternetOptionReceiveTimeout, InternetOptionSendTimeout, InternetOptionSecurityFlags} {
+		if _, ok := api.options[opt]; !ok {
+			t.Errorf("option %d wasn't set", opt)
+		}
+	}
+	if len(api.headers) != 1 || api.headers[0] != "Content-Type: application/octet-stream" {
+		t.Errorf("unexpected request headers %q", api.headers)
+	}
+
+	if resp.StatusCode != 200 || resp.Status != "200 OK" {
+		t.Errorf("unexpected status %q", resp.Status)
+	}
+	if resp.Header.Get("Content-Type") != "text/html" || len(resp.Header.Values("Set-Cookie")) != 2 {
+		t.Errorf("unexpected response headers %v", resp.Header)
+	}
+	if api.read != 0 {
+		t.Errorf("the body was read before the caller read it")
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "hello world" || resp.ContentLength != int64(len(body)) {
+		t.Errorf("unexpected response body %q (%d)", body, resp.ContentLength)
+	}
+	if len(api.closed) != 0 {
+		t.Errorf("handles were closed before the body was")
+	}
+	resp.Body.Close()
+	if len(api.closed) != 2 || api.closed[0] != 3 || api.closed[1] != 2 {
+		t.Errorf("expected the request and connection handles to be closed, got %v", api.closed)
+	}
+	if _, err = resp.Body.Read(make([]byte, 1)); err == nil {
+		t.Errorf("expected a read of a closed body to fail")
+	}
+
+	// Cookies set by the response are sent with the next request, without attributes
+	cookies := client.CookieJar.Cookies(req.URL)
+	if len(cookies) != 1 || cookies[0].Name != "PHPSESSID" || cookies[0].Value != "abc" {
+		t.Fatalf("unexpected cookies %v", cookies)
+	}
+	api.headers = nil
+	req, _ = http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	if _, err = client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.headers) != 1 || api.headers[0] != "Cookie: PHPSESSID=abc" {
+		t.Errorf("unexpected request headers %q", api.headers)
+	}
+	if api.flags&InternetFlagSecure != 0 || api.port != 0 {
+		t.Errorf("unexpected plaintext request flags %#x, port %d", api.flags, api.port)
+	}
+}
+
+func TestClientAccessType(t *testing.T) {
+	api := newMockAPI()
+	if _, err := NewClientWithAPI("test", api); err != nil || api.access != InternetOpenTypePreconfig {
+		t.Errorf("expected the preconfigured proxy settings by default, got %d (%v)", api.access, err)
+	}
+	if _, err := newClient("test", InternetOpenTypeDirect, "", api); err != nil || api.access != InternetOpenTypeDirect {
+		t.Errorf("unexpected access type %d (%v)", api.access, err)
+	}
+	if _, err := newClient("test", InternetOpenTypeProxy, "proxy:8080", api); err != nil || api.proxy != "proxy:8080" {
+		t.Errorf("expected the named proxy, got %q (%v)", api.proxy, err)
+	}
+}
+
+func TestClientCert(t *testing.T) {
+	certContext := []byte{1, 2, 3, 4}
+	for _, scheme := range []string{"https", "http"} {
+		api := newMockAPI()
+		client, _ := NewClientWithAPI("test", api)
+		client.ClientCertContext = certContext
+
+		req, _ := http.NewRequest(http.MethodGet, scheme+"://example.com/", nil)
+		if _, err := client.Do(req); err != nil {
+			t.Fatal(err)
+		}
+		val, ok := api.options[InternetOptionClientCertContext]
+		if scheme == "https" && (!ok || !bytes.Equal(val, certContext)) {
+			t.Errorf("expected the client certificate to be set, got %v", val)
+		}
+		if scheme == "http" && ok {
+			t.Errorf("the client certificate was set on a plaintext request")
+		}
+	}
+}
+
+func TestResponseBodyStream(t *testing.T) {
+	api := newMockAPI()
+	api.rawHeaders = "HTTP/1.1 200 OK\r\n"
+	client, _ := NewClientWithAPI("test", api)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.ContentLength != -1 {
+		t.Errorf("expected an unknown length without a Content-Length header, got %d", resp.ContentLength)
+	}
+
+	// Small reads split chunks, and only read what they ask for
+	buf := make([]byte, 4)
+	n, err := resp.Body.Read(buf)
+	if err != nil || string(buf[:n]) != "hell" || api.read != 0 || api.offset != 4 {
+		t.Fatalf("unexpected read %q (%v), chunk %d offset %d", buf[:n], err, api.read, api.offset)
+	}
+	rest, err := io.ReadAll(resp.Body)
+	if err != nil || string(rest) != "o world" {
+		t.Fatalf("unexpected rest of the body %q (%v)", rest, err)
+	}
+}
+
+func TestClientProxyCreds(t *testing.T) {
+	api := newMockAPI()
+	api.statusCodes = []int{407, 200}
+	client, _ := NewClientWithAPI("test", api)
+	client.AskProxyCreds = true
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.errorDlg != 1 || api.sent != 2 || resp.StatusCode != 200 {
+		t.Errorf("expected the request to be resent after prompting for credentials")
+	}
+}
+
+func TestClientProxyAuth(t *testing.T) {
+	// Explicit credentials from the c2 url
+	api := newMockAPI()
+	api.statusCodes = []int{407, 200}
+	client, _ := NewClientWithAPI("test", api)
+	client.ProxyUsername = "CORP\\alice"
+	client.ProxyPassword = "hunter2"
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	username, ok := api.options[InternetOptionProxyUsername]
+	if !ok || len(username) != (len(client.ProxyUsername)+1)*2 || username[0] != 'C' || username[1] != 0 {
+		t.Errorf("unexpected proxy username option %v", username)
+	}
+	if _, ok := api.options[InternetOptionProxyPassword]; !ok || api.sent != 2 || resp.StatusCode != 200 {
+		t.Errorf("expected the request to be resent with the explicit credentials")
+	}
+
+	// The current user's credentials, NTLM takes more than one round trip
+	api = newMockAPI()
+	api.statusCodes = []int{407, 407, 200}
+	api.rawHeaders = "HTTP/1.1 407 Proxy Authentication Required\r\nProxy-Authenticate: Negotiate\r\nProxy-Authenticate: NTLM\r\n"
+	client, _ = NewClientWithAPI("test", api)
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.sent != 3 || resp.StatusCode != 200 || api.errorDlg != 0 || len(api.options) != 0 {
+		t.Errorf("expected the request to be resent for SSPI auth, sent %d", api.sent)
+	}
+
+	// Gives up when the proxy keeps rejecting the credentials
+	api.statusCodes = []int{407}
+	api.sent = 0
+	if resp, err = client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if api.sent != maxProxyAuthAttempts+1 || resp.StatusCode != 407 {
+		t.Errorf("expected %d attempts, got %d", maxProxyAuthAttempts+1, api.sent)
+	}
+
+	// Nothing to try for a basic auth proxy without credentials
+	api = newMockAPI()
+	api.statusCodes = []int{407}
+	api.rawHeaders = "HTTP/1.1 407 Proxy Authentication Required\r\nProxy-Authenticate: Basic realm=\"corp\"\r\n"
+	client, _ = NewClientWithAPI("test", api)
+	if resp, err = client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if api.sent != 1 || resp.StatusCode != 407 {
+		t.Errorf("expected the 407 to be returned without resending")
+	}
+}
+
+func TestGetHeaders(t *testing.T) {
+	api := newMockAPI()
+	api.rawHeaders = "HTTP/2.0 200\r\nX-A: 1\r\nX-A: 2\r\nnot a header\r\n"
+	client, _ := NewClientWithAPI("test", api)
+
+	proto, major, minor, headers, err := client.getHeaders(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proto != "HTTP/2.0" || major != 2 || minor != 0 {
+		t.Errorf("unexpected proto %s (%d.%d)", proto, major, minor)
+	}
+	if len(headers) != 1 || len(headers["X-A"]) != 2 {
+		t.Errorf("unexpected headers %v", headers)
+	}
+
+	api.rawHeaders = "HTTP/x.1 200\r\n"
+	if _, _, _, _, err = client.getHeaders(0); err == nil {
+		t.Error("expected an invalid version to fail")
+	}
+}
@@ -1,8 +1,218 @@
 package wininet
 
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
 // Cookie represents an HTTP cookie sent in the Cookie header of an
 // HTTP Request.
 type Cookie struct {
 	Name  string
 	Value string
+
+	// Attributes from the Set-Cookie header, they're never sent back
+	Domain  string
+	Path    string
+	Expires time.Time // Zero for session cookies
+	Secure  bool
+}
+
+// parseSetCookie - Parse a Set-Cookie header value, unknown attributes
+// are ignored. Max-Age takes precedence over Expires.
+func parseSetCookie(line string) *Cookie {
+	parts := strings.Split(line, ";")
+	nameValue := strings.SplitN(parts[0], "=", 2)
+	if len(nameValue) != 2 {
+		return nil
+	}
+	cookie := &Cookie{
+		Name:  strings.TrimSpace(nameValue[0]),
+		Value: strings.TrimSpace(nameValue[1]),
+	}
+	if cookie.Name == "" {
+		return nil
+	}
+
+	var maxAge *int
+	for _, attr := range parts[1:] {
+		key, value, _ := strings.Cut(attr, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "domain":
+			cookie.Domain = strings.ToLower(strings.TrimPrefix(value, "."))
+		case "path":
+			cookie.Path = value
+		case "secure":
+			cookie.Secure = true
+		case "expires":
+			if expires, err := http.ParseTime(value); err == nil {
+				cookie.Expires = expires
+			} else if expires, err := time.Parse("Mon, 02-Jan-2006 15:04:05 MST", value); err == nil {
+				cookie.Expires = expires
+			}
+		case "max-age":
+			if seconds, err := strconv.Atoi(value); err == nil {
+				maxAge = &seconds
+			}
+		}
+	}
+	if maxAge != nil {
+		if *maxAge <= 0 {
+			cookie.Expires = time.Unix(1, 0)
+		} else {
+			cookie.Expires = time.Now().Add(time.Duration(*maxAge) * time.Second)
+		}
+	}
+	return cookie
+}
+
+// jarEntry - A stored cookie, keyed by domain, path and name
+type jarEntry struct {
+	cookie   Cookie
+	hostOnly bool   // No Domain attribute, only sent to the same host
+	created  uint64 // Order the cookie was first set in
+}
+
+func (e *jarEntry) expired(now time.Time) bool {
+	return !e.cookie.Expires.IsZero() && !e.cookie.Expires.After(now)
+}
+
+// Jar - CookieJar implementation following the RFC 6265 domain, path
+// and expiry rules, but without a public suffix list. Each Client has
+// its own Jar, which is safe for concurrent use.
+type Jar struct {
+	lk      sync.Mutex
+	entries map[string]*jarEntry
+	created uint64
+}
+
+// NewJar - Get a new instance of a cookie jar
+func NewJar() *Jar {
+	return &Jar{
+		entries: map[string]*jarEntry{},
+	}
+}
+
+// SetCookies handles the receipt of the cookies in a reply for the
+// given URL. Cookies for another domain are dropped, and expired
+// cookies delete the stored cookie they replace.
+func (jar *Jar) SetCookies(u *url.URL, cookies []*Cookie) {
+	host := canonicalHost(u)
+	now := time.Now()
+
+	jar.lk.Lock()
+	defer jar.lk.Unlock()
+	for _, cookie := range cookies {
+		jar.created++
+		entry := &jarEntry{cookie: *cookie, created: jar.created}
+		if cookie.Domain == "" {
+			entry.hostOnly = true
+			entry.cookie.Domain = host
+		} else if !domainMatch(host, cookie.Domain) {
+			continue
+		}
+		if !strings.HasPrefix(cookie.Path, "/") {
+			entry.cookie.Path = defaultPath(u.Path)
+		}
+
+		key := entry.cookie.Domain + ";" + entry.cookie.Path + ";" + cookie.Name
+		if entry.expired(now) {
+			delete(jar.entries, key)
+			continue
+		}
+		if existing, ok := jar.entries[key]; ok {
+			entry.created = existing.created
+		}
+		jar.entries[key] = entry
+	}
+}
+
+// Cookies returns the cookies to send in a request for the given URL,
+// the ones with longer paths first.
+func (jar *Jar) Cookies(u *url.URL) []*Cookie {
+	host := canonicalHost(u)
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+	now := time.Now()
+
+	jar.lk.Lock()
+	var selected []*jarEntry
+	for key, entry := range jar.entries {
+		if entry.expired(now) {
+			delete(jar.entries, key)
+			continue
+		}
+		if entry.hostOnly && host != entry.cookie.Domain {
+			continue
+		}
+		if !entry.hostOnly && !domainMatch(host, entry.cookie.Domain) {
+			continue
+		}
+		if !pathMatch(path, entry.cookie.Path) || (entry.cookie.Secure && u.Scheme != "https") {
+			continue
+		}
+		selected = append(selected, entry)
+	}
+	jar.lk.Unlock()
+
+	sort.Slice(selected, func(i, j int) bool {
+		if len(selected[i].cookie.Path) != len(selected[j].cookie.Path) {
+			return len(selected[i].cookie.Path) > len(selected[j].cookie.Path)
+		}
+		return selected[i].created < selected[j].created
+	})
+	cookies := make([]*Cookie, 0, len(selected))
+	for _, entry := range selected {
+		cookies = append(cookies, &Cookie{Name: entry.cookie.Name, Value: entry.cookie.Value})
+	}
+	return cookies
+}
+
+func canonicalHost(u *url.URL) string {
+	return strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+}
+
+// domainMatch - The host is the domain or one of its subdomains, IP
+// addresses only match themselves
+func domainMatch(host string, domain string) bool {
+	if host == domain {
+		return true
+	}
+	return net.ParseIP(host) == nil && strings.HasSuffix(host, "."+domain)
+}
+
+// pathMatch - The cookie path is the request path or one of its
+// directories
+func pathMatch(path string, cookiePath string) bool {
+	if path == cookiePath {
+		return true
+	}
+	if !strings.HasPrefix(path, cookiePath) {
+		return false
+	}
+	return strings.HasSuffix(cookiePath, "/") || path[len(cookiePath)] == '/'
+}
+
+// defaultPath - The directory of the request path, for cookies without
+// a Path attribute
+func defaultPath(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		return "/"
+	}
+	i := strings.LastIndex(path, "/")
+	if i == 0 {
+		return "/"
+	}
+	return path[:i]
 }
@@ -0,0 +1,128 @@
+package wininet
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseSetCookie(t *testing.T) {
+	cookie := parseSetCookie("a = 1; Domain=.Example.com; Path=/foo; Secure; HttpOnly; Max-Age=60; Expires=Wed, 21 Oct 2015 07:28:00 GMT")
+	if cookie == nil || cookie.Name != "a" || cookie.Value != "1" {
+		t.Fatalf("unexpected cookie %+v", cookie)
+	}
+	if cookie.Domain != "example.com" || cookie.Path != "/foo" || !cookie.Secure {
+		t.Errorf("unexpected attributes %+v", cookie)
+	}
+	if cookie.Expires.Before(time.Now()) {
+		t.Errorf("expected max-age to take precedence over expires, got %s", cookie.Expires)
+	}
+
+	cookie = parseSetCookie("b=2; expires=Wed, 21-Oct-2015 07:28:00 GMT")
+	if cookie == nil || cookie.Expires.Year() != 2015 {
+		t.Errorf("unexpected expiry %+v", cookie)
+	}
+	for _, line := range []string{"invalid", "=1", ""} {
+		if cookie = parseSetCookie(line); cookie != nil {
+			t.Errorf("expected %q to be invalid, got %+v", line, cookie)
+		}
+	}
+}
+
+func TestJar(t *testing.T) {
+	jar := NewJar()
+	origin, _ := url.Parse("https://www.example.com/api/v1/session")
+	jar.SetCookies(origin, []*Cookie{
+		parseSetCookie("host=1"),
+		parseSetCookie("domain=2; Domain=example.com; Path=/"),
+		parseSetCookie("api=3; Path=/api"),
+		parseSetCookie("secure=4; Path=/; Secure"),
+		parseSetCookie("other=5; Domain=example.org"),
+		parseSetCookie("expired=6; Max-Age=0"),
+	})
+
+	for rawURL, expected := range map[string][]string{
+		"https://www.example.com/api/v1/x": {"host", "api", "domain", "secure"},
+		"https://www.example.com/apis":     {"domain", "secure"},
+		"http://www.example.com/api":       {"api", "domain"},
+		"https://example.com/api/v1/x":     {"domain"},
+		"https://example.org/":             {},
+	} {
+		u, _ := url.Parse(rawURL)
+		cookies := jar.Cookies(u)
+		names := []string{}
+		for _, cookie := range cookies {
+			names = append(names, cookie.Name)
+		}
+		if len(names) != len(expected) {
+			t.Errorf("%s: expected %v, got %v", rawURL, expected, names)
+			continue
+		}
+		// Longer paths first, then in the order they were set
+		for i := range expected {
+			if names[i] != expected[i] {
+				t.Errorf("%s: expected %v, got %v", rawURL, expected, names)
+				break
+			}
+		}
+	}
+
+	// Replacing keeps the original order, an expired cookie deletes it
+	jar.SetCookies(origin, []*Cookie{parseSetCookie("domain=7; Domain=example.com; Path=/")})
+	jar.SetCookies(origin, []*Cookie{parseSetCookie("api=; Path=/api; Expires=Thu, 01 Jan 1970 00:00:00 GMT")})
+	u, _ := url.Parse("https://www.example.com/api")
+	cookies := jar.Cookies(u)
+	if len(cookies) != 2 || cookies[0].Name != "domain" || cookies[0].Value != "7" {
+		t.Errorf("unexpected cookies %v", cookies)
+	}
+}
+
+func TestJarConcurrent(t *testing.T) {
+	jar := NewJar()
+	u, _ := url.Parse("https://example.com/")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				jar.SetCookies(u, []*Cookie{parseSetCookie("a=1")})
+				jar.Cookies(u)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientCookies(t *testing.T) {
+	api := newMockAPI()
+	api.setCookies = []string{"a=1; Path=/", "b=2; Path=/"}
+	api.statusCodes = []int{200, 407, 200}
+	api.rawHeaders = "HTTP/1.1 407 Proxy Authentication Required\r\nProxy-Authenticate: NTLM\r\n"
+	client, _ := NewClientWithAPI("test", api)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	api.headers = nil
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	// Once per send, the retry replaces the header instead of adding to it
+	if len(api.headers) != 2 || api.headers[0] != "Cookie: a=1; b=2" || api.headers[1] != api.headers[0] {
+		t.Errorf("unexpected request headers %q", api.headers)
+	}
+
+	// Cookies aren't sent to other hosts
+	api.headers = nil
+	req, _ = http.NewRequest(http.MethodGet, "http://example.org/", nil)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.headers) != 0 {
+		t.Errorf("unexpected request headers %q", api.headers)
+	}
+}
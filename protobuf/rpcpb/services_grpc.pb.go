@@ -31,6 +31,14 @@ type SliverRPCClient interface {
 	ClientLog(ctx context.Context, opts ...grpc.CallOption) (SliverRPC_ClientLogClient, error)
 	// *** Operator Commands ***
 	GetOperators(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Operators, error)
+	SetPresence(ctx context.Context, in *clientpb.PresenceReq, opts ...grpc.CallOption) (*clientpb.Presence, error)
+	GetPresence(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Presence, error)
+	LockTarget(ctx context.Context, in *clientpb.TargetLockReq, opts ...grpc.CallOption) (*clientpb.TargetLock, error)
+	UnlockTarget(ctx context.Context, in *clientpb.TargetLockReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	UpdateTargetMetadata(ctx context.Context, in *clientpb.TargetMetadataReq, opts ...grpc.CallOption) (*clientpb.TargetMetadata, error)
+	AddTargetHistory(ctx context.Context, in *clientpb.TargetHistoryReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	GetTargetHistory(ctx context.Context, in *clientpb.TargetHistoryReq, opts ...grpc.CallOption) (*clientpb.TargetHistory, error)
+	GetOpsecPolicy(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.OpsecPolicy, error)
 	// *** Generic ***
 	Kill(ctx context.Context, in *sliverpb.KillReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	Reconfigure(ctx context.Context, in *sliverpb.ReconfigureReq, opts ...grpc.CallOption) (*sliverpb.Reconfigure, error)
@@ -44,27 +52,83 @@ type SliverRPCClient interface {
 	GetBeaconTasks(ctx context.Context, in *clientpb.Beacon, opts ...grpc.CallOption) (*clientpb.BeaconTasks, error)
 	GetBeaconTaskContent(ctx context.Context, in *clientpb.BeaconTask, opts ...grpc.CallOption) (*clientpb.BeaconTask, error)
 	CancelBeaconTask(ctx context.Context, in *clientpb.BeaconTask, opts ...grpc.CallOption) (*clientpb.BeaconTask, error)
+	ReorderBeaconTasks(ctx context.Context, in *clientpb.BeaconTasks, opts ...grpc.CallOption) (*clientpb.BeaconTasks, error)
 	// ***Threat monitoring ***
 	MonitorStart(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*commonpb.Response, error)
 	MonitorStop(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Jobs ***
 	GetJobs(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Jobs, error)
 	KillJob(ctx context.Context, in *clientpb.KillJobReq, opts ...grpc.CallOption) (*clientpb.KillJob, error)
+	DrainJob(ctx context.Context, in *clientpb.JobDrainReq, opts ...grpc.CallOption) (*clientpb.Job, error)
 	// *** Listeners ***
 	StartMTLSListener(ctx context.Context, in *clientpb.MTLSListenerReq, opts ...grpc.CallOption) (*clientpb.MTLSListener, error)
+	StartExternalC2Listener(ctx context.Context, in *clientpb.ExternalC2ListenerReq, opts ...grpc.CallOption) (*clientpb.ExternalC2Listener, error)
 	StartWGListener(ctx context.Context, in *clientpb.WGListenerReq, opts ...grpc.CallOption) (*clientpb.WGListener, error)
 	StartDNSListener(ctx context.Context, in *clientpb.DNSListenerReq, opts ...grpc.CallOption) (*clientpb.DNSListener, error)
 	StartHTTPSListener(ctx context.Context, in *clientpb.HTTPListenerReq, opts ...grpc.CallOption) (*clientpb.HTTPListener, error)
 	StartHTTPListener(ctx context.Context, in *clientpb.HTTPListenerReq, opts ...grpc.CallOption) (*clientpb.HTTPListener, error)
+	// *** Listener Profiles ***
+	ListenerProfiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ListenerProfiles, error)
+	SaveListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*clientpb.ListenerProfile, error)
+	RmListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	StartListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*clientpb.ListenerProfile, error)
+	StopListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*clientpb.ListenerProfile, error)
+	// *** HTTP C2 Config ***
+	GetHTTPC2Config(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.HTTPC2Config, error)
+	SaveHTTPC2Config(ctx context.Context, in *clientpb.HTTPC2Config, opts ...grpc.CallOption) (*clientpb.HTTPC2ConfigReload, error)
+	ReloadHTTPC2Config(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.HTTPC2ConfigReload, error)
+	GenerateRedirectorConfig(ctx context.Context, in *clientpb.RedirectorReq, opts ...grpc.CallOption) (*clientpb.RedirectorConfig, error)
+	// *** Server Config ***
+	ReloadServerConfig(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ServerConfigReload, error)
+	// *** Envelope Capture ***
+	StartCapture(ctx context.Context, in *clientpb.CaptureReq, opts ...grpc.CallOption) (*clientpb.CaptureStatus, error)
+	StopCapture(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.CaptureStatus, error)
+	GetCapture(ctx context.Context, in *clientpb.CaptureEntriesReq, opts ...grpc.CallOption) (*clientpb.CapturedEnvelopes, error)
+	// *** Artifact Provenance ***
+	VerifyArtifact(ctx context.Context, in *commonpb.File, opts ...grpc.CallOption) (*clientpb.ArtifactProvenance, error)
+	LookupArtifactMarker(ctx context.Context, in *clientpb.ArtifactMarkerReq, opts ...grpc.CallOption) (*clientpb.ArtifactProvenance, error)
+	// *** Private Armory ***
+	ArmoryPublish(ctx context.Context, in *clientpb.ArmoryPublishReq, opts ...grpc.CallOption) (*clientpb.ArmoryPackageVersion, error)
+	ArmoryPackages(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ArmoryPackages, error)
+	ArmoryUnpublish(ctx context.Context, in *clientpb.ArmoryUnpublishReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	StartArmoryListener(ctx context.Context, in *clientpb.ArmoryListenerReq, opts ...grpc.CallOption) (*clientpb.ArmoryListener, error)
 	// *** Stager Listener ***
 	StartTCPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error)
 	StartHTTPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error)
+	GetStageFetches(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.StageFetches, error)
 	// *** Loot ***
 	LootAdd(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error)
 	LootRm(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	LootUpdate(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error)
 	LootContent(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error)
 	LootAll(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllLoot, error)
+	LootTag(ctx context.Context, in *clientpb.LootTagReq, opts ...grpc.CallOption) (*clientpb.Loot, error)
+	LootSearch(ctx context.Context, in *clientpb.LootSearchReq, opts ...grpc.CallOption) (*clientpb.LootSearchResults, error)
+	LootExport(ctx context.Context, in *clientpb.LootExportReq, opts ...grpc.CallOption) (*commonpb.File, error)
+	BloodHoundIngest(ctx context.Context, in *clientpb.BloodHoundIngestReq, opts ...grpc.CallOption) (*clientpb.BloodHoundIngest, error)
+	// *** Federation ***
+	FederationLinkAdd(ctx context.Context, in *clientpb.FederationLinkReq, opts ...grpc.CallOption) (*clientpb.FederationLink, error)
+	FederationLinkRm(ctx context.Context, in *clientpb.FederationLinkReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	FederationLinks(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.FederationLinks, error)
+	FederationView(ctx context.Context, in *clientpb.FederationReq, opts ...grpc.CallOption) (*clientpb.FederationView, error)
+	FederationLootContent(ctx context.Context, in *clientpb.FederationLootReq, opts ...grpc.CallOption) (*clientpb.Loot, error)
+	// *** High Availability ***
+	HASnapshot(ctx context.Context, in *clientpb.HASnapshotReq, opts ...grpc.CallOption) (SliverRPC_HASnapshotClient, error)
+	// *** Automation ***
+	AutomationRules(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AutomationRules, error)
+	AutomationRuleSave(ctx context.Context, in *clientpb.AutomationRule, opts ...grpc.CallOption) (*clientpb.AutomationRule, error)
+	AutomationRuleRm(ctx context.Context, in *clientpb.AutomationRule, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	ScheduledTasks(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ScheduledTasks, error)
+	ScheduledTaskSave(ctx context.Context, in *clientpb.ScheduledTask, opts ...grpc.CallOption) (*clientpb.ScheduledTask, error)
+	ScheduledTaskRm(ctx context.Context, in *clientpb.ScheduledTask, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	ImplantGroups(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantGroups, error)
+	ImplantGroupSave(ctx context.Context, in *clientpb.ImplantGroup, opts ...grpc.CallOption) (*clientpb.ImplantGroup, error)
+	ImplantGroupRm(ctx context.Context, in *clientpb.ImplantGroup, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	ImplantGroupMembers(ctx context.Context, in *clientpb.ImplantGroup, opts ...grpc.CallOption) (*clientpb.ImplantGroupMembers, error)
+	ImplantGroupTask(ctx context.Context, in *clientpb.GroupTaskReq, opts ...grpc.CallOption) (*clientpb.GroupTaskResults, error)
+	// *** Recordings ***
+	GetRecordings(ctx context.Context, in *clientpb.RecordingReq, opts ...grpc.CallOption) (*clientpb.Recordings, error)
+	GetRecording(ctx context.Context, in *clientpb.RecordingReq, opts ...grpc.CallOption) (*commonpb.File, error)
 	// *** Creds ***
 	Creds(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Credentials, error)
 	CredsAdd(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error)
@@ -77,8 +141,16 @@ type SliverRPCClient interface {
 	// *** Hosts ***
 	Hosts(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllHosts, error)
 	Host(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*clientpb.Host, error)
+	HostDetails(ctx context.Context, in *clientpb.HostDetailsReq, opts ...grpc.CallOption) (*clientpb.HostDetails, error)
+	HostTimeline(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*clientpb.HostTimeline, error)
+	EngagementExport(ctx context.Context, in *clientpb.EngagementExportReq, opts ...grpc.CallOption) (*commonpb.File, error)
+	AttackCoverage(ctx context.Context, in *clientpb.AttackCoverageReq, opts ...grpc.CallOption) (*clientpb.AttackCoverage, error)
+	Preflight(ctx context.Context, in *clientpb.PreflightReq, opts ...grpc.CallOption) (*clientpb.Preflight, error)
 	HostRm(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	HostIOCRm(ctx context.Context, in *clientpb.IOC, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	GetScanResults(ctx context.Context, in *clientpb.ScanResultsReq, opts ...grpc.CallOption) (*clientpb.ScanResults, error)
+	GetDiscoveredHosts(ctx context.Context, in *clientpb.DiscoveredHostsReq, opts ...grpc.CallOption) (*clientpb.DiscoveredHosts, error)
+	GetHostProcesses(ctx context.Context, in *clientpb.HostProcessesReq, opts ...grpc.CallOption) (*clientpb.HostProcesses, error)
 	// *** Implants ***
 	Generate(ctx context.Context, in *clientpb.GenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error)
 	GenerateExternal(ctx context.Context, in *clientpb.ExternalGenerateReq, opts ...grpc.CallOption) (*clientpb.ExternalImplantConfig, error)
@@ -88,6 +160,10 @@ type SliverRPCClient interface {
 	BuilderRegister(ctx context.Context, in *clientpb.Builder, opts ...grpc.CallOption) (SliverRPC_BuilderRegisterClient, error)
 	BuilderTrigger(ctx context.Context, in *clientpb.Event, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	Builders(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Builders, error)
+	// *** Toolchains ***
+	Toolchains(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Toolchains, error)
+	ToolchainInstall(ctx context.Context, in *clientpb.ToolchainReq, opts ...grpc.CallOption) (*clientpb.Toolchain, error)
+	ToolchainRemove(ctx context.Context, in *clientpb.ToolchainReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Crackstation ***
 	CrackstationRegister(ctx context.Context, in *clientpb.Crackstation, opts ...grpc.CallOption) (SliverRPC_CrackstationRegisterClient, error)
 	CrackstationTrigger(ctx context.Context, in *clientpb.Event, opts ...grpc.CallOption) (*commonpb.Empty, error)
@@ -103,14 +179,22 @@ type SliverRPCClient interface {
 	CrackFileDelete(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Payloads ***
 	Regenerate(ctx context.Context, in *clientpb.RegenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error)
+	GenerateProvisioningBundle(ctx context.Context, in *clientpb.ProvisioningBundleReq, opts ...grpc.CallOption) (*clientpb.ProvisioningBundle, error)
+	VerifyImplantBuild(ctx context.Context, in *clientpb.ImplantBuildVerifyReq, opts ...grpc.CallOption) (*clientpb.ImplantBuildVerification, error)
 	ImplantBuilds(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantBuilds, error)
 	DeleteImplantBuild(ctx context.Context, in *clientpb.DeleteReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	BurnImplant(ctx context.Context, in *clientpb.BurnReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	Canaries(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Canaries, error)
+	CanaryTokens(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.CanaryTokens, error)
+	CanaryTokenNew(ctx context.Context, in *clientpb.CanaryTokenReq, opts ...grpc.CallOption) (*clientpb.CanaryToken, error)
+	CanaryTokenRm(ctx context.Context, in *clientpb.CanaryToken, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	GenerateWGClientConfig(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.WGClientConfig, error)
 	GenerateUniqueIP(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.UniqueWGIP, error)
 	ImplantProfiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantProfiles, error)
 	DeleteImplantProfile(ctx context.Context, in *clientpb.DeleteReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	SaveImplantProfile(ctx context.Context, in *clientpb.ImplantProfile, opts ...grpc.CallOption) (*clientpb.ImplantProfile, error)
+	GetImplantProfile(ctx context.Context, in *clientpb.ImplantProfileReq, opts ...grpc.CallOption) (*clientpb.ImplantProfile, error)
+	ImplantProfileHistory(ctx context.Context, in *clientpb.ImplantProfileReq, opts ...grpc.CallOption) (*clientpb.ImplantProfiles, error)
 	MsfStage(ctx context.Context, in *clientpb.MsfStagerReq, opts ...grpc.CallOption) (*clientpb.MsfStager, error)
 	ShellcodeRDI(ctx context.Context, in *clientpb.ShellcodeRDIReq, opts ...grpc.CallOption) (*clientpb.ShellcodeRDI, error)
 	GetCompiler(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Compiler, error)
@@ -132,6 +216,11 @@ type SliverRPCClient interface {
 	Terminate(ctx context.Context, in *sliverpb.TerminateReq, opts ...grpc.CallOption) (*sliverpb.Terminate, error)
 	Ifconfig(ctx context.Context, in *sliverpb.IfconfigReq, opts ...grpc.CallOption) (*sliverpb.Ifconfig, error)
 	Netstat(ctx context.Context, in *sliverpb.NetstatReq, opts ...grpc.CallOption) (*sliverpb.Netstat, error)
+	Scan(ctx context.Context, in *sliverpb.ScanReq, opts ...grpc.CallOption) (*sliverpb.Scan, error)
+	DNSResolvers(ctx context.Context, in *sliverpb.DNSResolversReq, opts ...grpc.CallOption) (*sliverpb.DNSResolvers, error)
+	EDREnum(ctx context.Context, in *sliverpb.EDREnumReq, opts ...grpc.CallOption) (*sliverpb.EDREnum, error)
+	Discover(ctx context.Context, in *sliverpb.DiscoverReq, opts ...grpc.CallOption) (*sliverpb.Discover, error)
+	ADEnum(ctx context.Context, in *sliverpb.ADEnumReq, opts ...grpc.CallOption) (*sliverpb.ADEnum, error)
 	Ls(ctx context.Context, in *sliverpb.LsReq, opts ...grpc.CallOption) (*sliverpb.Ls, error)
 	Cd(ctx context.Context, in *sliverpb.CdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error)
 	Pwd(ctx context.Context, in *sliverpb.PwdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error)
@@ -208,6 +297,8 @@ type SliverRPCClient interface {
 	WGListSocksServers(ctx context.Context, in *sliverpb.WGSocksServersReq, opts ...grpc.CallOption) (*sliverpb.WGSocksServers, error)
 	// *** Realtime Commands ***
 	Shell(ctx context.Context, in *sliverpb.ShellReq, opts ...grpc.CallOption) (*sliverpb.Shell, error)
+	ShellResize(ctx context.Context, in *sliverpb.ShellResizeReq, opts ...grpc.CallOption) (*sliverpb.ShellResize, error)
+	ShellSignal(ctx context.Context, in *sliverpb.ShellSignalReq, opts ...grpc.CallOption) (*sliverpb.ShellSignal, error)
 	Portfwd(ctx context.Context, in *sliverpb.PortfwdReq, opts ...grpc.CallOption) (*sliverpb.Portfwd, error)
 	// *** Socks5 ***
 	CreateSocks(ctx context.Context, in *sliverpb.Socks, opts ...grpc.CallOption) (*sliverpb.Socks, error)
@@ -281,6 +372,78 @@ func (c *sliverRPCClient) GetOperators(ctx context.Context, in *commonpb.Empty,
 	return out, nil
 }
 
+func (c *sliverRPCClient) SetPresence(ctx context.Context, in *clientpb.PresenceReq, opts ...grpc.CallOption) (*clientpb.Presence, error) {
+	out := new(clientpb.Presence)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetPresence(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Presence, error) {
+	out := new(clientpb.Presence)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) LockTarget(ctx context.Context, in *clientpb.TargetLockReq, opts ...grpc.CallOption) (*clientpb.TargetLock, error) {
+	out := new(clientpb.TargetLock)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LockTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) UnlockTarget(ctx context.Context, in *clientpb.TargetLockReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UnlockTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) UpdateTargetMetadata(ctx context.Context, in *clientpb.TargetMetadataReq, opts ...grpc.CallOption) (*clientpb.TargetMetadata, error) {
+	out := new(clientpb.TargetMetadata)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UpdateTargetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) AddTargetHistory(ctx context.Context, in *clientpb.TargetHistoryReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AddTargetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetTargetHistory(ctx context.Context, in *clientpb.TargetHistoryReq, opts ...grpc.CallOption) (*clientpb.TargetHistory, error) {
+	out := new(clientpb.TargetHistory)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetTargetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetOpsecPolicy(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.OpsecPolicy, error) {
+	out := new(clientpb.OpsecPolicy)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetOpsecPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Kill(ctx context.Context, in *sliverpb.KillReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Kill", in, out, opts...)
@@ -371,6 +534,15 @@ func (c *sliverRPCClient) CancelBeaconTask(ctx context.Context, in *clientpb.Bea
 	return out, nil
 }
 
+func (c *sliverRPCClient) ReorderBeaconTasks(ctx context.Context, in *clientpb.BeaconTasks, opts ...grpc.CallOption) (*clientpb.BeaconTasks, error) {
+	out := new(clientpb.BeaconTasks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ReorderBeaconTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) MonitorStart(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*commonpb.Response, error) {
 	out := new(commonpb.Response)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MonitorStart", in, out, opts...)
@@ -407,6 +579,15 @@ func (c *sliverRPCClient) KillJob(ctx context.Context, in *clientpb.KillJobReq,
 	return out, nil
 }
 
+func (c *sliverRPCClient) DrainJob(ctx context.Context, in *clientpb.JobDrainReq, opts ...grpc.CallOption) (*clientpb.Job, error) {
+	out := new(clientpb.Job)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/DrainJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) StartMTLSListener(ctx context.Context, in *clientpb.MTLSListenerReq, opts ...grpc.CallOption) (*clientpb.MTLSListener, error) {
 	out := new(clientpb.MTLSListener)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartMTLSListener", in, out, opts...)
@@ -416,6 +597,15 @@ func (c *sliverRPCClient) StartMTLSListener(ctx context.Context, in *clientpb.MT
 	return out, nil
 }
 
+func (c *sliverRPCClient) StartExternalC2Listener(ctx context.Context, in *clientpb.ExternalC2ListenerReq, opts ...grpc.CallOption) (*clientpb.ExternalC2Listener, error) {
+	out := new(clientpb.ExternalC2Listener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartExternalC2Listener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) StartWGListener(ctx context.Context, in *clientpb.WGListenerReq, opts ...grpc.CallOption) (*clientpb.WGListener, error) {
 	out := new(clientpb.WGListener)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartWGListener", in, out, opts...)
@@ -452,269 +642,336 @@ func (c *sliverRPCClient) StartHTTPListener(ctx context.Context, in *clientpb.HT
 	return out, nil
 }
 
-func (c *sliverRPCClient) StartTCPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
-	out := new(clientpb.StagerListener)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartTCPStagerListener", in, out, opts...)
+func (c *sliverRPCClient) ListenerProfiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ListenerProfiles, error) {
+	out := new(clientpb.ListenerProfiles)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ListenerProfiles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) StartHTTPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
-	out := new(clientpb.StagerListener)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartHTTPStagerListener", in, out, opts...)
+func (c *sliverRPCClient) SaveListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*clientpb.ListenerProfile, error) {
+	out := new(clientpb.ListenerProfile)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SaveListenerProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) LootAdd(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
-	out := new(clientpb.Loot)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootAdd", in, out, opts...)
+func (c *sliverRPCClient) RmListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RmListenerProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) LootRm(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootRm", in, out, opts...)
+func (c *sliverRPCClient) StartListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*clientpb.ListenerProfile, error) {
+	out := new(clientpb.ListenerProfile)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartListenerProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) LootUpdate(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
-	out := new(clientpb.Loot)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootUpdate", in, out, opts...)
+func (c *sliverRPCClient) StopListenerProfile(ctx context.Context, in *clientpb.ListenerProfile, opts ...grpc.CallOption) (*clientpb.ListenerProfile, error) {
+	out := new(clientpb.ListenerProfile)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopListenerProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) LootContent(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
-	out := new(clientpb.Loot)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootContent", in, out, opts...)
+func (c *sliverRPCClient) GetHTTPC2Config(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.HTTPC2Config, error) {
+	out := new(clientpb.HTTPC2Config)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetHTTPC2Config", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) LootAll(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllLoot, error) {
-	out := new(clientpb.AllLoot)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootAll", in, out, opts...)
+func (c *sliverRPCClient) SaveHTTPC2Config(ctx context.Context, in *clientpb.HTTPC2Config, opts ...grpc.CallOption) (*clientpb.HTTPC2ConfigReload, error) {
+	out := new(clientpb.HTTPC2ConfigReload)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SaveHTTPC2Config", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Creds(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Credentials, error) {
-	out := new(clientpb.Credentials)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Creds", in, out, opts...)
+func (c *sliverRPCClient) ReloadHTTPC2Config(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.HTTPC2ConfigReload, error) {
+	out := new(clientpb.HTTPC2ConfigReload)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ReloadHTTPC2Config", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CredsAdd(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsAdd", in, out, opts...)
+func (c *sliverRPCClient) GenerateRedirectorConfig(ctx context.Context, in *clientpb.RedirectorReq, opts ...grpc.CallOption) (*clientpb.RedirectorConfig, error) {
+	out := new(clientpb.RedirectorConfig)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateRedirectorConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CredsRm(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsRm", in, out, opts...)
+func (c *sliverRPCClient) ReloadServerConfig(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ServerConfigReload, error) {
+	out := new(clientpb.ServerConfigReload)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ReloadServerConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CredsUpdate(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsUpdate", in, out, opts...)
+func (c *sliverRPCClient) StartCapture(ctx context.Context, in *clientpb.CaptureReq, opts ...grpc.CallOption) (*clientpb.CaptureStatus, error) {
+	out := new(clientpb.CaptureStatus)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartCapture", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetCredByID(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credential, error) {
-	out := new(clientpb.Credential)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCredByID", in, out, opts...)
+func (c *sliverRPCClient) StopCapture(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.CaptureStatus, error) {
+	out := new(clientpb.CaptureStatus)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopCapture", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetCredsByHashType(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credentials, error) {
-	out := new(clientpb.Credentials)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCredsByHashType", in, out, opts...)
+func (c *sliverRPCClient) GetCapture(ctx context.Context, in *clientpb.CaptureEntriesReq, opts ...grpc.CallOption) (*clientpb.CapturedEnvelopes, error) {
+	out := new(clientpb.CapturedEnvelopes)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCapture", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetPlaintextCredsByHashType(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credentials, error) {
-	out := new(clientpb.Credentials)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPlaintextCredsByHashType", in, out, opts...)
+func (c *sliverRPCClient) VerifyArtifact(ctx context.Context, in *commonpb.File, opts ...grpc.CallOption) (*clientpb.ArtifactProvenance, error) {
+	out := new(clientpb.ArtifactProvenance)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/VerifyArtifact", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CredsSniffHashType(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credential, error) {
-	out := new(clientpb.Credential)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsSniffHashType", in, out, opts...)
+func (c *sliverRPCClient) LookupArtifactMarker(ctx context.Context, in *clientpb.ArtifactMarkerReq, opts ...grpc.CallOption) (*clientpb.ArtifactProvenance, error) {
+	out := new(clientpb.ArtifactProvenance)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LookupArtifactMarker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Hosts(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllHosts, error) {
-	out := new(clientpb.AllHosts)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Hosts", in, out, opts...)
+func (c *sliverRPCClient) ArmoryPublish(ctx context.Context, in *clientpb.ArmoryPublishReq, opts ...grpc.CallOption) (*clientpb.ArmoryPackageVersion, error) {
+	out := new(clientpb.ArmoryPackageVersion)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ArmoryPublish", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Host(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*clientpb.Host, error) {
-	out := new(clientpb.Host)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Host", in, out, opts...)
+func (c *sliverRPCClient) ArmoryPackages(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ArmoryPackages, error) {
+	out := new(clientpb.ArmoryPackages)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ArmoryPackages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) HostRm(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) ArmoryUnpublish(ctx context.Context, in *clientpb.ArmoryUnpublishReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostRm", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ArmoryUnpublish", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) HostIOCRm(ctx context.Context, in *clientpb.IOC, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostIOCRm", in, out, opts...)
+func (c *sliverRPCClient) StartArmoryListener(ctx context.Context, in *clientpb.ArmoryListenerReq, opts ...grpc.CallOption) (*clientpb.ArmoryListener, error) {
+	out := new(clientpb.ArmoryListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartArmoryListener", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Generate(ctx context.Context, in *clientpb.GenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error) {
-	out := new(clientpb.Generate)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Generate", in, out, opts...)
+func (c *sliverRPCClient) StartTCPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
+	out := new(clientpb.StagerListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartTCPStagerListener", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GenerateExternal(ctx context.Context, in *clientpb.ExternalGenerateReq, opts ...grpc.CallOption) (*clientpb.ExternalImplantConfig, error) {
-	out := new(clientpb.ExternalImplantConfig)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateExternal", in, out, opts...)
+func (c *sliverRPCClient) StartHTTPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
+	out := new(clientpb.StagerListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartHTTPStagerListener", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GenerateExternalSaveBuild(ctx context.Context, in *clientpb.ExternalImplantBinary, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) GetStageFetches(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.StageFetches, error) {
+	out := new(clientpb.StageFetches)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetStageFetches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) LootAdd(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
+	out := new(clientpb.Loot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) LootRm(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateExternalSaveBuild", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GenerateExternalGetImplantConfig(ctx context.Context, in *clientpb.ImplantConfig, opts ...grpc.CallOption) (*clientpb.ExternalImplantConfig, error) {
-	out := new(clientpb.ExternalImplantConfig)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateExternalGetImplantConfig", in, out, opts...)
+func (c *sliverRPCClient) LootUpdate(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
+	out := new(clientpb.Loot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootUpdate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) BuilderRegister(ctx context.Context, in *clientpb.Builder, opts ...grpc.CallOption) (SliverRPC_BuilderRegisterClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[1], "/rpcpb.SliverRPC/BuilderRegister", opts...)
+func (c *sliverRPCClient) LootContent(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
+	out := new(clientpb.Loot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &sliverRPCBuilderRegisterClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *sliverRPCClient) LootAll(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllLoot, error) {
+	out := new(clientpb.AllLoot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *sliverRPCClient) LootTag(ctx context.Context, in *clientpb.LootTagReq, opts ...grpc.CallOption) (*clientpb.Loot, error) {
+	out := new(clientpb.Loot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootTag", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type SliverRPC_BuilderRegisterClient interface {
-	Recv() (*clientpb.Event, error)
-	grpc.ClientStream
+func (c *sliverRPCClient) LootSearch(ctx context.Context, in *clientpb.LootSearchReq, opts ...grpc.CallOption) (*clientpb.LootSearchResults, error) {
+	out := new(clientpb.LootSearchResults)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type sliverRPCBuilderRegisterClient struct {
-	grpc.ClientStream
+func (c *sliverRPCClient) LootExport(ctx context.Context, in *clientpb.LootExportReq, opts ...grpc.CallOption) (*commonpb.File, error) {
+	out := new(commonpb.File)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *sliverRPCBuilderRegisterClient) Recv() (*clientpb.Event, error) {
-	m := new(clientpb.Event)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *sliverRPCClient) BloodHoundIngest(ctx context.Context, in *clientpb.BloodHoundIngestReq, opts ...grpc.CallOption) (*clientpb.BloodHoundIngest, error) {
+	out := new(clientpb.BloodHoundIngest)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/BloodHoundIngest", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *sliverRPCClient) BuilderTrigger(ctx context.Context, in *clientpb.Event, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) FederationLinkAdd(ctx context.Context, in *clientpb.FederationLinkReq, opts ...grpc.CallOption) (*clientpb.FederationLink, error) {
+	out := new(clientpb.FederationLink)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/FederationLinkAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) FederationLinkRm(ctx context.Context, in *clientpb.FederationLinkReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/BuilderTrigger", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/FederationLinkRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Builders(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Builders, error) {
-	out := new(clientpb.Builders)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Builders", in, out, opts...)
+func (c *sliverRPCClient) FederationLinks(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.FederationLinks, error) {
+	out := new(clientpb.FederationLinks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/FederationLinks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackstationRegister(ctx context.Context, in *clientpb.Crackstation, opts ...grpc.CallOption) (SliverRPC_CrackstationRegisterClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[2], "/rpcpb.SliverRPC/CrackstationRegister", opts...)
+func (c *sliverRPCClient) FederationView(ctx context.Context, in *clientpb.FederationReq, opts ...grpc.CallOption) (*clientpb.FederationView, error) {
+	out := new(clientpb.FederationView)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/FederationView", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &sliverRPCCrackstationRegisterClient{stream}
+	return out, nil
+}
+
+func (c *sliverRPCClient) FederationLootContent(ctx context.Context, in *clientpb.FederationLootReq, opts ...grpc.CallOption) (*clientpb.Loot, error) {
+	out := new(clientpb.Loot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/FederationLootContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) HASnapshot(ctx context.Context, in *clientpb.HASnapshotReq, opts ...grpc.CallOption) (SliverRPC_HASnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[1], "/rpcpb.SliverRPC/HASnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sliverRPCHASnapshotClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -724,1187 +981,1828 @@ func (c *sliverRPCClient) CrackstationRegister(ctx context.Context, in *clientpb
 	return x, nil
 }
 
-type SliverRPC_CrackstationRegisterClient interface {
-	Recv() (*clientpb.Event, error)
+type SliverRPC_HASnapshotClient interface {
+	Recv() (*clientpb.HASnapshot, error)
 	grpc.ClientStream
 }
 
-type sliverRPCCrackstationRegisterClient struct {
+type sliverRPCHASnapshotClient struct {
 	grpc.ClientStream
 }
 
-func (x *sliverRPCCrackstationRegisterClient) Recv() (*clientpb.Event, error) {
-	m := new(clientpb.Event)
+func (x *sliverRPCHASnapshotClient) Recv() (*clientpb.HASnapshot, error) {
+	m := new(clientpb.HASnapshot)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *sliverRPCClient) CrackstationTrigger(ctx context.Context, in *clientpb.Event, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackstationTrigger", in, out, opts...)
+func (c *sliverRPCClient) AutomationRules(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AutomationRules, error) {
+	out := new(clientpb.AutomationRules)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AutomationRules", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackstationBenchmark(ctx context.Context, in *clientpb.CrackBenchmark, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) AutomationRuleSave(ctx context.Context, in *clientpb.AutomationRule, opts ...grpc.CallOption) (*clientpb.AutomationRule, error) {
+	out := new(clientpb.AutomationRule)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AutomationRuleSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) AutomationRuleRm(ctx context.Context, in *clientpb.AutomationRule, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackstationBenchmark", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AutomationRuleRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Crackstations(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Crackstations, error) {
-	out := new(clientpb.Crackstations)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Crackstations", in, out, opts...)
+func (c *sliverRPCClient) ScheduledTasks(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ScheduledTasks, error) {
+	out := new(clientpb.ScheduledTasks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ScheduledTasks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackTaskByID(ctx context.Context, in *clientpb.CrackTask, opts ...grpc.CallOption) (*clientpb.CrackTask, error) {
-	out := new(clientpb.CrackTask)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackTaskByID", in, out, opts...)
+func (c *sliverRPCClient) ScheduledTaskSave(ctx context.Context, in *clientpb.ScheduledTask, opts ...grpc.CallOption) (*clientpb.ScheduledTask, error) {
+	out := new(clientpb.ScheduledTask)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ScheduledTaskSave", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackTaskUpdate(ctx context.Context, in *clientpb.CrackTask, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) ScheduledTaskRm(ctx context.Context, in *clientpb.ScheduledTask, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackTaskUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ScheduledTaskRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackFilesList(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*clientpb.CrackFiles, error) {
-	out := new(clientpb.CrackFiles)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFilesList", in, out, opts...)
+func (c *sliverRPCClient) ImplantGroups(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantGroups, error) {
+	out := new(clientpb.ImplantGroups)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantGroups", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackFileCreate(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*clientpb.CrackFile, error) {
-	out := new(clientpb.CrackFile)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileCreate", in, out, opts...)
+func (c *sliverRPCClient) ImplantGroupSave(ctx context.Context, in *clientpb.ImplantGroup, opts ...grpc.CallOption) (*clientpb.ImplantGroup, error) {
+	out := new(clientpb.ImplantGroup)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantGroupSave", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackFileChunkUpload(ctx context.Context, in *clientpb.CrackFileChunk, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) ImplantGroupRm(ctx context.Context, in *clientpb.ImplantGroup, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileChunkUpload", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantGroupRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackFileChunkDownload(ctx context.Context, in *clientpb.CrackFileChunk, opts ...grpc.CallOption) (*clientpb.CrackFileChunk, error) {
-	out := new(clientpb.CrackFileChunk)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileChunkDownload", in, out, opts...)
+func (c *sliverRPCClient) ImplantGroupMembers(ctx context.Context, in *clientpb.ImplantGroup, opts ...grpc.CallOption) (*clientpb.ImplantGroupMembers, error) {
+	out := new(clientpb.ImplantGroupMembers)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantGroupMembers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackFileComplete(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileComplete", in, out, opts...)
+func (c *sliverRPCClient) ImplantGroupTask(ctx context.Context, in *clientpb.GroupTaskReq, opts ...grpc.CallOption) (*clientpb.GroupTaskResults, error) {
+	out := new(clientpb.GroupTaskResults)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantGroupTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CrackFileDelete(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileDelete", in, out, opts...)
+func (c *sliverRPCClient) GetRecordings(ctx context.Context, in *clientpb.RecordingReq, opts ...grpc.CallOption) (*clientpb.Recordings, error) {
+	out := new(clientpb.Recordings)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetRecordings", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Regenerate(ctx context.Context, in *clientpb.RegenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error) {
-	out := new(clientpb.Generate)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Regenerate", in, out, opts...)
+func (c *sliverRPCClient) GetRecording(ctx context.Context, in *clientpb.RecordingReq, opts ...grpc.CallOption) (*commonpb.File, error) {
+	out := new(commonpb.File)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetRecording", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ImplantBuilds(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantBuilds, error) {
-	out := new(clientpb.ImplantBuilds)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantBuilds", in, out, opts...)
+func (c *sliverRPCClient) Creds(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Credentials, error) {
+	out := new(clientpb.Credentials)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Creds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) DeleteImplantBuild(ctx context.Context, in *clientpb.DeleteReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) CredsAdd(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/DeleteImplantBuild", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsAdd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Canaries(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Canaries, error) {
-	out := new(clientpb.Canaries)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Canaries", in, out, opts...)
+func (c *sliverRPCClient) CredsRm(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GenerateWGClientConfig(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.WGClientConfig, error) {
-	out := new(clientpb.WGClientConfig)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateWGClientConfig", in, out, opts...)
+func (c *sliverRPCClient) CredsUpdate(ctx context.Context, in *clientpb.Credentials, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsUpdate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GenerateUniqueIP(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.UniqueWGIP, error) {
-	out := new(clientpb.UniqueWGIP)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateUniqueIP", in, out, opts...)
+func (c *sliverRPCClient) GetCredByID(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credential, error) {
+	out := new(clientpb.Credential)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCredByID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ImplantProfiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantProfiles, error) {
-	out := new(clientpb.ImplantProfiles)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantProfiles", in, out, opts...)
+func (c *sliverRPCClient) GetCredsByHashType(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credentials, error) {
+	out := new(clientpb.Credentials)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCredsByHashType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) DeleteImplantProfile(ctx context.Context, in *clientpb.DeleteReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/DeleteImplantProfile", in, out, opts...)
+func (c *sliverRPCClient) GetPlaintextCredsByHashType(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credentials, error) {
+	out := new(clientpb.Credentials)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPlaintextCredsByHashType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) SaveImplantProfile(ctx context.Context, in *clientpb.ImplantProfile, opts ...grpc.CallOption) (*clientpb.ImplantProfile, error) {
-	out := new(clientpb.ImplantProfile)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SaveImplantProfile", in, out, opts...)
+func (c *sliverRPCClient) CredsSniffHashType(ctx context.Context, in *clientpb.Credential, opts ...grpc.CallOption) (*clientpb.Credential, error) {
+	out := new(clientpb.Credential)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CredsSniffHashType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) MsfStage(ctx context.Context, in *clientpb.MsfStagerReq, opts ...grpc.CallOption) (*clientpb.MsfStager, error) {
-	out := new(clientpb.MsfStager)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MsfStage", in, out, opts...)
+func (c *sliverRPCClient) Hosts(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllHosts, error) {
+	out := new(clientpb.AllHosts)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Hosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ShellcodeRDI(ctx context.Context, in *clientpb.ShellcodeRDIReq, opts ...grpc.CallOption) (*clientpb.ShellcodeRDI, error) {
-	out := new(clientpb.ShellcodeRDI)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeRDI", in, out, opts...)
+func (c *sliverRPCClient) Host(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*clientpb.Host, error) {
+	out := new(clientpb.Host)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Host", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetCompiler(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Compiler, error) {
-	out := new(clientpb.Compiler)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCompiler", in, out, opts...)
+func (c *sliverRPCClient) HostDetails(ctx context.Context, in *clientpb.HostDetailsReq, opts ...grpc.CallOption) (*clientpb.HostDetails, error) {
+	out := new(clientpb.HostDetails)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostDetails", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ShellcodeEncoder(ctx context.Context, in *clientpb.ShellcodeEncodeReq, opts ...grpc.CallOption) (*clientpb.ShellcodeEncode, error) {
-	out := new(clientpb.ShellcodeEncode)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeEncoder", in, out, opts...)
+func (c *sliverRPCClient) HostTimeline(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*clientpb.HostTimeline, error) {
+	out := new(clientpb.HostTimeline)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostTimeline", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ShellcodeEncoderMap(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ShellcodeEncoderMap, error) {
-	out := new(clientpb.ShellcodeEncoderMap)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeEncoderMap", in, out, opts...)
+func (c *sliverRPCClient) EngagementExport(ctx context.Context, in *clientpb.EngagementExportReq, opts ...grpc.CallOption) (*commonpb.File, error) {
+	out := new(commonpb.File)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/EngagementExport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) TrafficEncoderMap(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.TrafficEncoderMap, error) {
-	out := new(clientpb.TrafficEncoderMap)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TrafficEncoderMap", in, out, opts...)
+func (c *sliverRPCClient) AttackCoverage(ctx context.Context, in *clientpb.AttackCoverageReq, opts ...grpc.CallOption) (*clientpb.AttackCoverage, error) {
+	out := new(clientpb.AttackCoverage)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AttackCoverage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) TrafficEncoderAdd(ctx context.Context, in *clientpb.TrafficEncoder, opts ...grpc.CallOption) (*clientpb.TrafficEncoderTests, error) {
-	out := new(clientpb.TrafficEncoderTests)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TrafficEncoderAdd", in, out, opts...)
+func (c *sliverRPCClient) Preflight(ctx context.Context, in *clientpb.PreflightReq, opts ...grpc.CallOption) (*clientpb.Preflight, error) {
+	out := new(clientpb.Preflight)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Preflight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) TrafficEncoderRm(ctx context.Context, in *clientpb.TrafficEncoder, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+func (c *sliverRPCClient) HostRm(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TrafficEncoderRm", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Websites(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Websites, error) {
-	out := new(clientpb.Websites)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Websites", in, out, opts...)
+func (c *sliverRPCClient) HostIOCRm(ctx context.Context, in *clientpb.IOC, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostIOCRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Website(ctx context.Context, in *clientpb.Website, opts ...grpc.CallOption) (*clientpb.Website, error) {
-	out := new(clientpb.Website)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Website", in, out, opts...)
+func (c *sliverRPCClient) GetScanResults(ctx context.Context, in *clientpb.ScanResultsReq, opts ...grpc.CallOption) (*clientpb.ScanResults, error) {
+	out := new(clientpb.ScanResults)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetScanResults", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WebsiteRemove(ctx context.Context, in *clientpb.Website, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteRemove", in, out, opts...)
+func (c *sliverRPCClient) GetDiscoveredHosts(ctx context.Context, in *clientpb.DiscoveredHostsReq, opts ...grpc.CallOption) (*clientpb.DiscoveredHosts, error) {
+	out := new(clientpb.DiscoveredHosts)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetDiscoveredHosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WebsiteAddContent(ctx context.Context, in *clientpb.WebsiteAddContent, opts ...grpc.CallOption) (*clientpb.Website, error) {
-	out := new(clientpb.Website)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteAddContent", in, out, opts...)
+func (c *sliverRPCClient) GetHostProcesses(ctx context.Context, in *clientpb.HostProcessesReq, opts ...grpc.CallOption) (*clientpb.HostProcesses, error) {
+	out := new(clientpb.HostProcesses)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetHostProcesses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WebsiteUpdateContent(ctx context.Context, in *clientpb.WebsiteAddContent, opts ...grpc.CallOption) (*clientpb.Website, error) {
-	out := new(clientpb.Website)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteUpdateContent", in, out, opts...)
+func (c *sliverRPCClient) Generate(ctx context.Context, in *clientpb.GenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error) {
+	out := new(clientpb.Generate)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Generate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WebsiteRemoveContent(ctx context.Context, in *clientpb.WebsiteRemoveContent, opts ...grpc.CallOption) (*clientpb.Website, error) {
-	out := new(clientpb.Website)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteRemoveContent", in, out, opts...)
+func (c *sliverRPCClient) GenerateExternal(ctx context.Context, in *clientpb.ExternalGenerateReq, opts ...grpc.CallOption) (*clientpb.ExternalImplantConfig, error) {
+	out := new(clientpb.ExternalImplantConfig)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateExternal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Ping(ctx context.Context, in *sliverpb.Ping, opts ...grpc.CallOption) (*sliverpb.Ping, error) {
-	out := new(sliverpb.Ping)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ping", in, out, opts...)
+func (c *sliverRPCClient) GenerateExternalSaveBuild(ctx context.Context, in *clientpb.ExternalImplantBinary, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateExternalSaveBuild", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Ps(ctx context.Context, in *sliverpb.PsReq, opts ...grpc.CallOption) (*sliverpb.Ps, error) {
-	out := new(sliverpb.Ps)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ps", in, out, opts...)
+func (c *sliverRPCClient) GenerateExternalGetImplantConfig(ctx context.Context, in *clientpb.ImplantConfig, opts ...grpc.CallOption) (*clientpb.ExternalImplantConfig, error) {
+	out := new(clientpb.ExternalImplantConfig)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateExternalGetImplantConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Terminate(ctx context.Context, in *sliverpb.TerminateReq, opts ...grpc.CallOption) (*sliverpb.Terminate, error) {
-	out := new(sliverpb.Terminate)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Terminate", in, out, opts...)
+func (c *sliverRPCClient) BuilderRegister(ctx context.Context, in *clientpb.Builder, opts ...grpc.CallOption) (SliverRPC_BuilderRegisterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[2], "/rpcpb.SliverRPC/BuilderRegister", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &sliverRPCBuilderRegisterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sliverRPCClient) Ifconfig(ctx context.Context, in *sliverpb.IfconfigReq, opts ...grpc.CallOption) (*sliverpb.Ifconfig, error) {
-	out := new(sliverpb.Ifconfig)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ifconfig", in, out, opts...)
-	if err != nil {
+type SliverRPC_BuilderRegisterClient interface {
+	Recv() (*clientpb.Event, error)
+	grpc.ClientStream
+}
+
+type sliverRPCBuilderRegisterClient struct {
+	grpc.ClientStream
+}
+
+func (x *sliverRPCBuilderRegisterClient) Recv() (*clientpb.Event, error) {
+	m := new(clientpb.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *sliverRPCClient) Netstat(ctx context.Context, in *sliverpb.NetstatReq, opts ...grpc.CallOption) (*sliverpb.Netstat, error) {
-	out := new(sliverpb.Netstat)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Netstat", in, out, opts...)
+func (c *sliverRPCClient) BuilderTrigger(ctx context.Context, in *clientpb.Event, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/BuilderTrigger", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Ls(ctx context.Context, in *sliverpb.LsReq, opts ...grpc.CallOption) (*sliverpb.Ls, error) {
-	out := new(sliverpb.Ls)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ls", in, out, opts...)
+func (c *sliverRPCClient) Builders(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Builders, error) {
+	out := new(clientpb.Builders)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Builders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Cd(ctx context.Context, in *sliverpb.CdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error) {
-	out := new(sliverpb.Pwd)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Cd", in, out, opts...)
+func (c *sliverRPCClient) Toolchains(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Toolchains, error) {
+	out := new(clientpb.Toolchains)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Toolchains", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Pwd(ctx context.Context, in *sliverpb.PwdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error) {
-	out := new(sliverpb.Pwd)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Pwd", in, out, opts...)
+func (c *sliverRPCClient) ToolchainInstall(ctx context.Context, in *clientpb.ToolchainReq, opts ...grpc.CallOption) (*clientpb.Toolchain, error) {
+	out := new(clientpb.Toolchain)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ToolchainInstall", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Mv(ctx context.Context, in *sliverpb.MvReq, opts ...grpc.CallOption) (*sliverpb.Mv, error) {
-	out := new(sliverpb.Mv)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Mv", in, out, opts...)
+func (c *sliverRPCClient) ToolchainRemove(ctx context.Context, in *clientpb.ToolchainReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ToolchainRemove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Cp(ctx context.Context, in *sliverpb.CpReq, opts ...grpc.CallOption) (*sliverpb.Cp, error) {
-	out := new(sliverpb.Cp)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Cp", in, out, opts...)
+func (c *sliverRPCClient) CrackstationRegister(ctx context.Context, in *clientpb.Crackstation, opts ...grpc.CallOption) (SliverRPC_CrackstationRegisterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[3], "/rpcpb.SliverRPC/CrackstationRegister", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &sliverRPCCrackstationRegisterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *sliverRPCClient) Rm(ctx context.Context, in *sliverpb.RmReq, opts ...grpc.CallOption) (*sliverpb.Rm, error) {
-	out := new(sliverpb.Rm)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Rm", in, out, opts...)
-	if err != nil {
+type SliverRPC_CrackstationRegisterClient interface {
+	Recv() (*clientpb.Event, error)
+	grpc.ClientStream
+}
+
+type sliverRPCCrackstationRegisterClient struct {
+	grpc.ClientStream
+}
+
+func (x *sliverRPCCrackstationRegisterClient) Recv() (*clientpb.Event, error) {
+	m := new(clientpb.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *sliverRPCClient) Mkdir(ctx context.Context, in *sliverpb.MkdirReq, opts ...grpc.CallOption) (*sliverpb.Mkdir, error) {
-	out := new(sliverpb.Mkdir)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Mkdir", in, out, opts...)
+func (c *sliverRPCClient) CrackstationTrigger(ctx context.Context, in *clientpb.Event, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackstationTrigger", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Download(ctx context.Context, in *sliverpb.DownloadReq, opts ...grpc.CallOption) (*sliverpb.Download, error) {
-	out := new(sliverpb.Download)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Download", in, out, opts...)
+func (c *sliverRPCClient) CrackstationBenchmark(ctx context.Context, in *clientpb.CrackBenchmark, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackstationBenchmark", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Upload(ctx context.Context, in *sliverpb.UploadReq, opts ...grpc.CallOption) (*sliverpb.Upload, error) {
-	out := new(sliverpb.Upload)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Upload", in, out, opts...)
+func (c *sliverRPCClient) Crackstations(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Crackstations, error) {
+	out := new(clientpb.Crackstations)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Crackstations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Chmod(ctx context.Context, in *sliverpb.ChmodReq, opts ...grpc.CallOption) (*sliverpb.Chmod, error) {
-	out := new(sliverpb.Chmod)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chmod", in, out, opts...)
+func (c *sliverRPCClient) CrackTaskByID(ctx context.Context, in *clientpb.CrackTask, opts ...grpc.CallOption) (*clientpb.CrackTask, error) {
+	out := new(clientpb.CrackTask)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackTaskByID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Chown(ctx context.Context, in *sliverpb.ChownReq, opts ...grpc.CallOption) (*sliverpb.Chown, error) {
-	out := new(sliverpb.Chown)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chown", in, out, opts...)
+func (c *sliverRPCClient) CrackTaskUpdate(ctx context.Context, in *clientpb.CrackTask, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackTaskUpdate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Chtimes(ctx context.Context, in *sliverpb.ChtimesReq, opts ...grpc.CallOption) (*sliverpb.Chtimes, error) {
-	out := new(sliverpb.Chtimes)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chtimes", in, out, opts...)
+func (c *sliverRPCClient) CrackFilesList(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*clientpb.CrackFiles, error) {
+	out := new(clientpb.CrackFiles)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFilesList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) MemfilesList(ctx context.Context, in *sliverpb.MemfilesListReq, opts ...grpc.CallOption) (*sliverpb.Ls, error) {
-	out := new(sliverpb.Ls)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MemfilesList", in, out, opts...)
+func (c *sliverRPCClient) CrackFileCreate(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*clientpb.CrackFile, error) {
+	out := new(clientpb.CrackFile)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) MemfilesAdd(ctx context.Context, in *sliverpb.MemfilesAddReq, opts ...grpc.CallOption) (*sliverpb.MemfilesAdd, error) {
-	out := new(sliverpb.MemfilesAdd)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MemfilesAdd", in, out, opts...)
+func (c *sliverRPCClient) CrackFileChunkUpload(ctx context.Context, in *clientpb.CrackFileChunk, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileChunkUpload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) MemfilesRm(ctx context.Context, in *sliverpb.MemfilesRmReq, opts ...grpc.CallOption) (*sliverpb.MemfilesRm, error) {
-	out := new(sliverpb.MemfilesRm)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MemfilesRm", in, out, opts...)
+func (c *sliverRPCClient) CrackFileChunkDownload(ctx context.Context, in *clientpb.CrackFileChunk, opts ...grpc.CallOption) (*clientpb.CrackFileChunk, error) {
+	out := new(clientpb.CrackFileChunk)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileChunkDownload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ProcessDump(ctx context.Context, in *sliverpb.ProcessDumpReq, opts ...grpc.CallOption) (*sliverpb.ProcessDump, error) {
-	out := new(sliverpb.ProcessDump)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ProcessDump", in, out, opts...)
+func (c *sliverRPCClient) CrackFileComplete(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileComplete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RunAs(ctx context.Context, in *sliverpb.RunAsReq, opts ...grpc.CallOption) (*sliverpb.RunAs, error) {
-	out := new(sliverpb.RunAs)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunAs", in, out, opts...)
+func (c *sliverRPCClient) CrackFileDelete(ctx context.Context, in *clientpb.CrackFile, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CrackFileDelete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Impersonate(ctx context.Context, in *sliverpb.ImpersonateReq, opts ...grpc.CallOption) (*sliverpb.Impersonate, error) {
-	out := new(sliverpb.Impersonate)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Impersonate", in, out, opts...)
+func (c *sliverRPCClient) Regenerate(ctx context.Context, in *clientpb.RegenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error) {
+	out := new(clientpb.Generate)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Regenerate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RevToSelf(ctx context.Context, in *sliverpb.RevToSelfReq, opts ...grpc.CallOption) (*sliverpb.RevToSelf, error) {
-	out := new(sliverpb.RevToSelf)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RevToSelf", in, out, opts...)
+func (c *sliverRPCClient) GenerateProvisioningBundle(ctx context.Context, in *clientpb.ProvisioningBundleReq, opts ...grpc.CallOption) (*clientpb.ProvisioningBundle, error) {
+	out := new(clientpb.ProvisioningBundle)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateProvisioningBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetSystem(ctx context.Context, in *clientpb.GetSystemReq, opts ...grpc.CallOption) (*sliverpb.GetSystem, error) {
-	out := new(sliverpb.GetSystem)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetSystem", in, out, opts...)
+func (c *sliverRPCClient) VerifyImplantBuild(ctx context.Context, in *clientpb.ImplantBuildVerifyReq, opts ...grpc.CallOption) (*clientpb.ImplantBuildVerification, error) {
+	out := new(clientpb.ImplantBuildVerification)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/VerifyImplantBuild", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Task(ctx context.Context, in *sliverpb.TaskReq, opts ...grpc.CallOption) (*sliverpb.Task, error) {
-	out := new(sliverpb.Task)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Task", in, out, opts...)
+func (c *sliverRPCClient) ImplantBuilds(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantBuilds, error) {
+	out := new(clientpb.ImplantBuilds)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantBuilds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Msf(ctx context.Context, in *clientpb.MSFReq, opts ...grpc.CallOption) (*sliverpb.Task, error) {
-	out := new(sliverpb.Task)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Msf", in, out, opts...)
+func (c *sliverRPCClient) DeleteImplantBuild(ctx context.Context, in *clientpb.DeleteReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/DeleteImplantBuild", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) MsfRemote(ctx context.Context, in *clientpb.MSFRemoteReq, opts ...grpc.CallOption) (*sliverpb.Task, error) {
-	out := new(sliverpb.Task)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MsfRemote", in, out, opts...)
+func (c *sliverRPCClient) BurnImplant(ctx context.Context, in *clientpb.BurnReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/BurnImplant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ExecuteAssembly(ctx context.Context, in *sliverpb.ExecuteAssemblyReq, opts ...grpc.CallOption) (*sliverpb.ExecuteAssembly, error) {
-	out := new(sliverpb.ExecuteAssembly)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ExecuteAssembly", in, out, opts...)
+func (c *sliverRPCClient) Canaries(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Canaries, error) {
+	out := new(clientpb.Canaries)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Canaries", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Migrate(ctx context.Context, in *clientpb.MigrateReq, opts ...grpc.CallOption) (*sliverpb.Migrate, error) {
-	out := new(sliverpb.Migrate)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Migrate", in, out, opts...)
+func (c *sliverRPCClient) CanaryTokens(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.CanaryTokens, error) {
+	out := new(clientpb.CanaryTokens)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CanaryTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Execute(ctx context.Context, in *sliverpb.ExecuteReq, opts ...grpc.CallOption) (*sliverpb.Execute, error) {
-	out := new(sliverpb.Execute)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Execute", in, out, opts...)
+func (c *sliverRPCClient) CanaryTokenNew(ctx context.Context, in *clientpb.CanaryTokenReq, opts ...grpc.CallOption) (*clientpb.CanaryToken, error) {
+	out := new(clientpb.CanaryToken)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CanaryTokenNew", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ExecuteWindows(ctx context.Context, in *sliverpb.ExecuteWindowsReq, opts ...grpc.CallOption) (*sliverpb.Execute, error) {
-	out := new(sliverpb.Execute)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ExecuteWindows", in, out, opts...)
+func (c *sliverRPCClient) CanaryTokenRm(ctx context.Context, in *clientpb.CanaryToken, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CanaryTokenRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Sideload(ctx context.Context, in *sliverpb.SideloadReq, opts ...grpc.CallOption) (*sliverpb.Sideload, error) {
-	out := new(sliverpb.Sideload)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Sideload", in, out, opts...)
+func (c *sliverRPCClient) GenerateWGClientConfig(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.WGClientConfig, error) {
+	out := new(clientpb.WGClientConfig)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateWGClientConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) SpawnDll(ctx context.Context, in *sliverpb.InvokeSpawnDllReq, opts ...grpc.CallOption) (*sliverpb.SpawnDll, error) {
-	out := new(sliverpb.SpawnDll)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SpawnDll", in, out, opts...)
+func (c *sliverRPCClient) GenerateUniqueIP(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.UniqueWGIP, error) {
+	out := new(clientpb.UniqueWGIP)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GenerateUniqueIP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Screenshot(ctx context.Context, in *sliverpb.ScreenshotReq, opts ...grpc.CallOption) (*sliverpb.Screenshot, error) {
-	out := new(sliverpb.Screenshot)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Screenshot", in, out, opts...)
+func (c *sliverRPCClient) ImplantProfiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ImplantProfiles, error) {
+	out := new(clientpb.ImplantProfiles)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantProfiles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CurrentTokenOwner(ctx context.Context, in *sliverpb.CurrentTokenOwnerReq, opts ...grpc.CallOption) (*sliverpb.CurrentTokenOwner, error) {
-	out := new(sliverpb.CurrentTokenOwner)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CurrentTokenOwner", in, out, opts...)
+func (c *sliverRPCClient) DeleteImplantProfile(ctx context.Context, in *clientpb.DeleteReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/DeleteImplantProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) PivotStartListener(ctx context.Context, in *sliverpb.PivotStartListenerReq, opts ...grpc.CallOption) (*sliverpb.PivotListener, error) {
-	out := new(sliverpb.PivotListener)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotStartListener", in, out, opts...)
+func (c *sliverRPCClient) SaveImplantProfile(ctx context.Context, in *clientpb.ImplantProfile, opts ...grpc.CallOption) (*clientpb.ImplantProfile, error) {
+	out := new(clientpb.ImplantProfile)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SaveImplantProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) PivotStopListener(ctx context.Context, in *sliverpb.PivotStopListenerReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotStopListener", in, out, opts...)
+func (c *sliverRPCClient) GetImplantProfile(ctx context.Context, in *clientpb.ImplantProfileReq, opts ...grpc.CallOption) (*clientpb.ImplantProfile, error) {
+	out := new(clientpb.ImplantProfile)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetImplantProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) PivotSessionListeners(ctx context.Context, in *sliverpb.PivotListenersReq, opts ...grpc.CallOption) (*sliverpb.PivotListeners, error) {
-	out := new(sliverpb.PivotListeners)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotSessionListeners", in, out, opts...)
+func (c *sliverRPCClient) ImplantProfileHistory(ctx context.Context, in *clientpb.ImplantProfileReq, opts ...grpc.CallOption) (*clientpb.ImplantProfiles, error) {
+	out := new(clientpb.ImplantProfiles)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ImplantProfileHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) PivotGraph(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.PivotGraph, error) {
-	out := new(clientpb.PivotGraph)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotGraph", in, out, opts...)
+func (c *sliverRPCClient) MsfStage(ctx context.Context, in *clientpb.MsfStagerReq, opts ...grpc.CallOption) (*clientpb.MsfStager, error) {
+	out := new(clientpb.MsfStager)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MsfStage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) StartService(ctx context.Context, in *sliverpb.StartServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error) {
-	out := new(sliverpb.ServiceInfo)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartService", in, out, opts...)
+func (c *sliverRPCClient) ShellcodeRDI(ctx context.Context, in *clientpb.ShellcodeRDIReq, opts ...grpc.CallOption) (*clientpb.ShellcodeRDI, error) {
+	out := new(clientpb.ShellcodeRDI)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeRDI", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) StopService(ctx context.Context, in *sliverpb.StopServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error) {
-	out := new(sliverpb.ServiceInfo)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopService", in, out, opts...)
+func (c *sliverRPCClient) GetCompiler(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Compiler, error) {
+	out := new(clientpb.Compiler)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetCompiler", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RemoveService(ctx context.Context, in *sliverpb.RemoveServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error) {
-	out := new(sliverpb.ServiceInfo)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RemoveService", in, out, opts...)
+func (c *sliverRPCClient) ShellcodeEncoder(ctx context.Context, in *clientpb.ShellcodeEncodeReq, opts ...grpc.CallOption) (*clientpb.ShellcodeEncode, error) {
+	out := new(clientpb.ShellcodeEncode)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeEncoder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) MakeToken(ctx context.Context, in *sliverpb.MakeTokenReq, opts ...grpc.CallOption) (*sliverpb.MakeToken, error) {
-	out := new(sliverpb.MakeToken)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MakeToken", in, out, opts...)
+func (c *sliverRPCClient) ShellcodeEncoderMap(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ShellcodeEncoderMap, error) {
+	out := new(clientpb.ShellcodeEncoderMap)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeEncoderMap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetEnv(ctx context.Context, in *sliverpb.EnvReq, opts ...grpc.CallOption) (*sliverpb.EnvInfo, error) {
-	out := new(sliverpb.EnvInfo)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetEnv", in, out, opts...)
+func (c *sliverRPCClient) TrafficEncoderMap(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.TrafficEncoderMap, error) {
+	out := new(clientpb.TrafficEncoderMap)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TrafficEncoderMap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) SetEnv(ctx context.Context, in *sliverpb.SetEnvReq, opts ...grpc.CallOption) (*sliverpb.SetEnv, error) {
-	out := new(sliverpb.SetEnv)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SetEnv", in, out, opts...)
+func (c *sliverRPCClient) TrafficEncoderAdd(ctx context.Context, in *clientpb.TrafficEncoder, opts ...grpc.CallOption) (*clientpb.TrafficEncoderTests, error) {
+	out := new(clientpb.TrafficEncoderTests)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TrafficEncoderAdd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) UnsetEnv(ctx context.Context, in *sliverpb.UnsetEnvReq, opts ...grpc.CallOption) (*sliverpb.UnsetEnv, error) {
-	out := new(sliverpb.UnsetEnv)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UnsetEnv", in, out, opts...)
+func (c *sliverRPCClient) TrafficEncoderRm(ctx context.Context, in *clientpb.TrafficEncoder, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TrafficEncoderRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Backdoor(ctx context.Context, in *clientpb.BackdoorReq, opts ...grpc.CallOption) (*clientpb.Backdoor, error) {
-	out := new(clientpb.Backdoor)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Backdoor", in, out, opts...)
+func (c *sliverRPCClient) Websites(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Websites, error) {
+	out := new(clientpb.Websites)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Websites", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryRead(ctx context.Context, in *sliverpb.RegistryReadReq, opts ...grpc.CallOption) (*sliverpb.RegistryRead, error) {
-	out := new(sliverpb.RegistryRead)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryRead", in, out, opts...)
+func (c *sliverRPCClient) Website(ctx context.Context, in *clientpb.Website, opts ...grpc.CallOption) (*clientpb.Website, error) {
+	out := new(clientpb.Website)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Website", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryWrite(ctx context.Context, in *sliverpb.RegistryWriteReq, opts ...grpc.CallOption) (*sliverpb.RegistryWrite, error) {
-	out := new(sliverpb.RegistryWrite)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryWrite", in, out, opts...)
+func (c *sliverRPCClient) WebsiteRemove(ctx context.Context, in *clientpb.Website, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteRemove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryCreateKey(ctx context.Context, in *sliverpb.RegistryCreateKeyReq, opts ...grpc.CallOption) (*sliverpb.RegistryCreateKey, error) {
-	out := new(sliverpb.RegistryCreateKey)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryCreateKey", in, out, opts...)
+func (c *sliverRPCClient) WebsiteAddContent(ctx context.Context, in *clientpb.WebsiteAddContent, opts ...grpc.CallOption) (*clientpb.Website, error) {
+	out := new(clientpb.Website)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteAddContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryDeleteKey(ctx context.Context, in *sliverpb.RegistryDeleteKeyReq, opts ...grpc.CallOption) (*sliverpb.RegistryDeleteKey, error) {
-	out := new(sliverpb.RegistryDeleteKey)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryDeleteKey", in, out, opts...)
+func (c *sliverRPCClient) WebsiteUpdateContent(ctx context.Context, in *clientpb.WebsiteAddContent, opts ...grpc.CallOption) (*clientpb.Website, error) {
+	out := new(clientpb.Website)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteUpdateContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryListSubKeys(ctx context.Context, in *sliverpb.RegistrySubKeyListReq, opts ...grpc.CallOption) (*sliverpb.RegistrySubKeyList, error) {
-	out := new(sliverpb.RegistrySubKeyList)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryListSubKeys", in, out, opts...)
+func (c *sliverRPCClient) WebsiteRemoveContent(ctx context.Context, in *clientpb.WebsiteRemoveContent, opts ...grpc.CallOption) (*clientpb.Website, error) {
+	out := new(clientpb.Website)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WebsiteRemoveContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryListValues(ctx context.Context, in *sliverpb.RegistryListValuesReq, opts ...grpc.CallOption) (*sliverpb.RegistryValuesList, error) {
-	out := new(sliverpb.RegistryValuesList)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryListValues", in, out, opts...)
+func (c *sliverRPCClient) Ping(ctx context.Context, in *sliverpb.Ping, opts ...grpc.CallOption) (*sliverpb.Ping, error) {
+	out := new(sliverpb.Ping)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ping", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RunSSHCommand(ctx context.Context, in *sliverpb.SSHCommandReq, opts ...grpc.CallOption) (*sliverpb.SSHCommand, error) {
-	out := new(sliverpb.SSHCommand)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunSSHCommand", in, out, opts...)
+func (c *sliverRPCClient) Ps(ctx context.Context, in *sliverpb.PsReq, opts ...grpc.CallOption) (*sliverpb.Ps, error) {
+	out := new(sliverpb.Ps)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) HijackDLL(ctx context.Context, in *clientpb.DllHijackReq, opts ...grpc.CallOption) (*clientpb.DllHijack, error) {
-	out := new(clientpb.DllHijack)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HijackDLL", in, out, opts...)
+func (c *sliverRPCClient) Terminate(ctx context.Context, in *sliverpb.TerminateReq, opts ...grpc.CallOption) (*sliverpb.Terminate, error) {
+	out := new(sliverpb.Terminate)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Terminate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetPrivs(ctx context.Context, in *sliverpb.GetPrivsReq, opts ...grpc.CallOption) (*sliverpb.GetPrivs, error) {
-	out := new(sliverpb.GetPrivs)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPrivs", in, out, opts...)
+func (c *sliverRPCClient) Ifconfig(ctx context.Context, in *sliverpb.IfconfigReq, opts ...grpc.CallOption) (*sliverpb.Ifconfig, error) {
+	out := new(sliverpb.Ifconfig)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ifconfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) StartRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStartListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error) {
-	out := new(sliverpb.RportFwdListener)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartRportFwdListener", in, out, opts...)
+func (c *sliverRPCClient) Netstat(ctx context.Context, in *sliverpb.NetstatReq, opts ...grpc.CallOption) (*sliverpb.Netstat, error) {
+	out := new(sliverpb.Netstat)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Netstat", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetRportFwdListeners(ctx context.Context, in *sliverpb.RportFwdListenersReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListeners, error) {
-	out := new(sliverpb.RportFwdListeners)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetRportFwdListeners", in, out, opts...)
+func (c *sliverRPCClient) Scan(ctx context.Context, in *sliverpb.ScanReq, opts ...grpc.CallOption) (*sliverpb.Scan, error) {
+	out := new(sliverpb.Scan)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Scan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) StopRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStopListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error) {
-	out := new(sliverpb.RportFwdListener)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopRportFwdListener", in, out, opts...)
+func (c *sliverRPCClient) DNSResolvers(ctx context.Context, in *sliverpb.DNSResolversReq, opts ...grpc.CallOption) (*sliverpb.DNSResolvers, error) {
+	out := new(sliverpb.DNSResolvers)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/DNSResolvers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) OpenSession(ctx context.Context, in *sliverpb.OpenSession, opts ...grpc.CallOption) (*sliverpb.OpenSession, error) {
-	out := new(sliverpb.OpenSession)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/OpenSession", in, out, opts...)
+func (c *sliverRPCClient) EDREnum(ctx context.Context, in *sliverpb.EDREnumReq, opts ...grpc.CallOption) (*sliverpb.EDREnum, error) {
+	out := new(sliverpb.EDREnum)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/EDREnum", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CloseSession(ctx context.Context, in *sliverpb.CloseSession, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CloseSession", in, out, opts...)
+func (c *sliverRPCClient) Discover(ctx context.Context, in *sliverpb.DiscoverReq, opts ...grpc.CallOption) (*sliverpb.Discover, error) {
+	out := new(sliverpb.Discover)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Discover", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegisterExtension(ctx context.Context, in *sliverpb.RegisterExtensionReq, opts ...grpc.CallOption) (*sliverpb.RegisterExtension, error) {
-	out := new(sliverpb.RegisterExtension)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegisterExtension", in, out, opts...)
+func (c *sliverRPCClient) ADEnum(ctx context.Context, in *sliverpb.ADEnumReq, opts ...grpc.CallOption) (*sliverpb.ADEnum, error) {
+	out := new(sliverpb.ADEnum)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ADEnum", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CallExtension(ctx context.Context, in *sliverpb.CallExtensionReq, opts ...grpc.CallOption) (*sliverpb.CallExtension, error) {
-	out := new(sliverpb.CallExtension)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CallExtension", in, out, opts...)
+func (c *sliverRPCClient) Ls(ctx context.Context, in *sliverpb.LsReq, opts ...grpc.CallOption) (*sliverpb.Ls, error) {
+	out := new(sliverpb.Ls)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Ls", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ListExtensions(ctx context.Context, in *sliverpb.ListExtensionsReq, opts ...grpc.CallOption) (*sliverpb.ListExtensions, error) {
-	out := new(sliverpb.ListExtensions)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ListExtensions", in, out, opts...)
+func (c *sliverRPCClient) Cd(ctx context.Context, in *sliverpb.CdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error) {
+	out := new(sliverpb.Pwd)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Cd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegisterWasmExtension(ctx context.Context, in *sliverpb.RegisterWasmExtensionReq, opts ...grpc.CallOption) (*sliverpb.RegisterWasmExtension, error) {
-	out := new(sliverpb.RegisterWasmExtension)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegisterWasmExtension", in, out, opts...)
+func (c *sliverRPCClient) Pwd(ctx context.Context, in *sliverpb.PwdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error) {
+	out := new(sliverpb.Pwd)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Pwd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ListWasmExtensions(ctx context.Context, in *sliverpb.ListWasmExtensionsReq, opts ...grpc.CallOption) (*sliverpb.ListWasmExtensions, error) {
-	out := new(sliverpb.ListWasmExtensions)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ListWasmExtensions", in, out, opts...)
+func (c *sliverRPCClient) Mv(ctx context.Context, in *sliverpb.MvReq, opts ...grpc.CallOption) (*sliverpb.Mv, error) {
+	out := new(sliverpb.Mv)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Mv", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) ExecWasmExtension(ctx context.Context, in *sliverpb.ExecWasmExtensionReq, opts ...grpc.CallOption) (*sliverpb.ExecWasmExtension, error) {
-	out := new(sliverpb.ExecWasmExtension)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ExecWasmExtension", in, out, opts...)
+func (c *sliverRPCClient) Cp(ctx context.Context, in *sliverpb.CpReq, opts ...grpc.CallOption) (*sliverpb.Cp, error) {
+	out := new(sliverpb.Cp)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Cp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WGStartPortForward(ctx context.Context, in *sliverpb.WGPortForwardStartReq, opts ...grpc.CallOption) (*sliverpb.WGPortForward, error) {
-	out := new(sliverpb.WGPortForward)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStartPortForward", in, out, opts...)
+func (c *sliverRPCClient) Rm(ctx context.Context, in *sliverpb.RmReq, opts ...grpc.CallOption) (*sliverpb.Rm, error) {
+	out := new(sliverpb.Rm)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Rm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WGStopPortForward(ctx context.Context, in *sliverpb.WGPortForwardStopReq, opts ...grpc.CallOption) (*sliverpb.WGPortForward, error) {
-	out := new(sliverpb.WGPortForward)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStopPortForward", in, out, opts...)
+func (c *sliverRPCClient) Mkdir(ctx context.Context, in *sliverpb.MkdirReq, opts ...grpc.CallOption) (*sliverpb.Mkdir, error) {
+	out := new(sliverpb.Mkdir)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Mkdir", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WGStartSocks(ctx context.Context, in *sliverpb.WGSocksStartReq, opts ...grpc.CallOption) (*sliverpb.WGSocks, error) {
-	out := new(sliverpb.WGSocks)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStartSocks", in, out, opts...)
+func (c *sliverRPCClient) Download(ctx context.Context, in *sliverpb.DownloadReq, opts ...grpc.CallOption) (*sliverpb.Download, error) {
+	out := new(sliverpb.Download)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Download", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WGStopSocks(ctx context.Context, in *sliverpb.WGSocksStopReq, opts ...grpc.CallOption) (*sliverpb.WGSocks, error) {
-	out := new(sliverpb.WGSocks)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStopSocks", in, out, opts...)
+func (c *sliverRPCClient) Upload(ctx context.Context, in *sliverpb.UploadReq, opts ...grpc.CallOption) (*sliverpb.Upload, error) {
+	out := new(sliverpb.Upload)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Upload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WGListForwarders(ctx context.Context, in *sliverpb.WGTCPForwardersReq, opts ...grpc.CallOption) (*sliverpb.WGTCPForwarders, error) {
-	out := new(sliverpb.WGTCPForwarders)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGListForwarders", in, out, opts...)
+func (c *sliverRPCClient) Chmod(ctx context.Context, in *sliverpb.ChmodReq, opts ...grpc.CallOption) (*sliverpb.Chmod, error) {
+	out := new(sliverpb.Chmod)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chmod", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) WGListSocksServers(ctx context.Context, in *sliverpb.WGSocksServersReq, opts ...grpc.CallOption) (*sliverpb.WGSocksServers, error) {
-	out := new(sliverpb.WGSocksServers)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGListSocksServers", in, out, opts...)
+func (c *sliverRPCClient) Chown(ctx context.Context, in *sliverpb.ChownReq, opts ...grpc.CallOption) (*sliverpb.Chown, error) {
+	out := new(sliverpb.Chown)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chown", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Shell(ctx context.Context, in *sliverpb.ShellReq, opts ...grpc.CallOption) (*sliverpb.Shell, error) {
-	out := new(sliverpb.Shell)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Shell", in, out, opts...)
+func (c *sliverRPCClient) Chtimes(ctx context.Context, in *sliverpb.ChtimesReq, opts ...grpc.CallOption) (*sliverpb.Chtimes, error) {
+	out := new(sliverpb.Chtimes)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chtimes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Portfwd(ctx context.Context, in *sliverpb.PortfwdReq, opts ...grpc.CallOption) (*sliverpb.Portfwd, error) {
-	out := new(sliverpb.Portfwd)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Portfwd", in, out, opts...)
+func (c *sliverRPCClient) MemfilesList(ctx context.Context, in *sliverpb.MemfilesListReq, opts ...grpc.CallOption) (*sliverpb.Ls, error) {
+	out := new(sliverpb.Ls)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MemfilesList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CreateSocks(ctx context.Context, in *sliverpb.Socks, opts ...grpc.CallOption) (*sliverpb.Socks, error) {
-	out := new(sliverpb.Socks)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CreateSocks", in, out, opts...)
+func (c *sliverRPCClient) MemfilesAdd(ctx context.Context, in *sliverpb.MemfilesAddReq, opts ...grpc.CallOption) (*sliverpb.MemfilesAdd, error) {
+	out := new(sliverpb.MemfilesAdd)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MemfilesAdd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CloseSocks(ctx context.Context, in *sliverpb.Socks, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CloseSocks", in, out, opts...)
+func (c *sliverRPCClient) MemfilesRm(ctx context.Context, in *sliverpb.MemfilesRmReq, opts ...grpc.CallOption) (*sliverpb.MemfilesRm, error) {
+	out := new(sliverpb.MemfilesRm)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MemfilesRm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) SocksProxy(ctx context.Context, opts ...grpc.CallOption) (SliverRPC_SocksProxyClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[3], "/rpcpb.SliverRPC/SocksProxy", opts...)
+func (c *sliverRPCClient) ProcessDump(ctx context.Context, in *sliverpb.ProcessDumpReq, opts ...grpc.CallOption) (*sliverpb.ProcessDump, error) {
+	out := new(sliverpb.ProcessDump)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ProcessDump", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &sliverRPCSocksProxyClient{stream}
-	return x, nil
+	return out, nil
 }
 
-type SliverRPC_SocksProxyClient interface {
-	Send(*sliverpb.SocksData) error
-	Recv() (*sliverpb.SocksData, error)
-	grpc.ClientStream
+func (c *sliverRPCClient) RunAs(ctx context.Context, in *sliverpb.RunAsReq, opts ...grpc.CallOption) (*sliverpb.RunAs, error) {
+	out := new(sliverpb.RunAs)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type sliverRPCSocksProxyClient struct {
-	grpc.ClientStream
+func (c *sliverRPCClient) Impersonate(ctx context.Context, in *sliverpb.ImpersonateReq, opts ...grpc.CallOption) (*sliverpb.Impersonate, error) {
+	out := new(sliverpb.Impersonate)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Impersonate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *sliverRPCSocksProxyClient) Send(m *sliverpb.SocksData) error {
-	return x.ClientStream.SendMsg(m)
+func (c *sliverRPCClient) RevToSelf(ctx context.Context, in *sliverpb.RevToSelfReq, opts ...grpc.CallOption) (*sliverpb.RevToSelf, error) {
+	out := new(sliverpb.RevToSelf)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RevToSelf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *sliverRPCSocksProxyClient) Recv() (*sliverpb.SocksData, error) {
-	m := new(sliverpb.SocksData)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *sliverRPCClient) GetSystem(ctx context.Context, in *clientpb.GetSystemReq, opts ...grpc.CallOption) (*sliverpb.GetSystem, error) {
+	out := new(sliverpb.GetSystem)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetSystem", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *sliverRPCClient) CreateTunnel(ctx context.Context, in *sliverpb.Tunnel, opts ...grpc.CallOption) (*sliverpb.Tunnel, error) {
-	out := new(sliverpb.Tunnel)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CreateTunnel", in, out, opts...)
+func (c *sliverRPCClient) Task(ctx context.Context, in *sliverpb.TaskReq, opts ...grpc.CallOption) (*sliverpb.Task, error) {
+	out := new(sliverpb.Task)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Task", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) CloseTunnel(ctx context.Context, in *sliverpb.Tunnel, opts ...grpc.CallOption) (*commonpb.Empty, error) {
-	out := new(commonpb.Empty)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CloseTunnel", in, out, opts...)
+func (c *sliverRPCClient) Msf(ctx context.Context, in *clientpb.MSFReq, opts ...grpc.CallOption) (*sliverpb.Task, error) {
+	out := new(sliverpb.Task)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Msf", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) TunnelData(ctx context.Context, opts ...grpc.CallOption) (SliverRPC_TunnelDataClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[4], "/rpcpb.SliverRPC/TunnelData", opts...)
+func (c *sliverRPCClient) MsfRemote(ctx context.Context, in *clientpb.MSFRemoteReq, opts ...grpc.CallOption) (*sliverpb.Task, error) {
+	out := new(sliverpb.Task)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MsfRemote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &sliverRPCTunnelDataClient{stream}
-	return x, nil
+	return out, nil
 }
 
-type SliverRPC_TunnelDataClient interface {
-	Send(*sliverpb.TunnelData) error
-	Recv() (*sliverpb.TunnelData, error)
-	grpc.ClientStream
+func (c *sliverRPCClient) ExecuteAssembly(ctx context.Context, in *sliverpb.ExecuteAssemblyReq, opts ...grpc.CallOption) (*sliverpb.ExecuteAssembly, error) {
+	out := new(sliverpb.ExecuteAssembly)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ExecuteAssembly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type sliverRPCTunnelDataClient struct {
-	grpc.ClientStream
+func (c *sliverRPCClient) Migrate(ctx context.Context, in *clientpb.MigrateReq, opts ...grpc.CallOption) (*sliverpb.Migrate, error) {
+	out := new(sliverpb.Migrate)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *sliverRPCTunnelDataClient) Send(m *sliverpb.TunnelData) error {
-	return x.ClientStream.SendMsg(m)
+func (c *sliverRPCClient) Execute(ctx context.Context, in *sliverpb.ExecuteReq, opts ...grpc.CallOption) (*sliverpb.Execute, error) {
+	out := new(sliverpb.Execute)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *sliverRPCTunnelDataClient) Recv() (*sliverpb.TunnelData, error) {
-	m := new(sliverpb.TunnelData)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *sliverRPCClient) ExecuteWindows(ctx context.Context, in *sliverpb.ExecuteWindowsReq, opts ...grpc.CallOption) (*sliverpb.Execute, error) {
+	out := new(sliverpb.Execute)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ExecuteWindows", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *sliverRPCClient) Events(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (SliverRPC_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[5], "/rpcpb.SliverRPC/Events", opts...)
+func (c *sliverRPCClient) Sideload(ctx context.Context, in *sliverpb.SideloadReq, opts ...grpc.CallOption) (*sliverpb.Sideload, error) {
+	out := new(sliverpb.Sideload)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Sideload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &sliverRPCEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *sliverRPCClient) SpawnDll(ctx context.Context, in *sliverpb.InvokeSpawnDllReq, opts ...grpc.CallOption) (*sliverpb.SpawnDll, error) {
+	out := new(sliverpb.SpawnDll)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SpawnDll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *sliverRPCClient) Screenshot(ctx context.Context, in *sliverpb.ScreenshotReq, opts ...grpc.CallOption) (*sliverpb.Screenshot, error) {
+	out := new(sliverpb.Screenshot)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Screenshot", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type SliverRPC_EventsClient interface {
-	Recv() (*clientpb.Event, error)
-	grpc.ClientStream
+func (c *sliverRPCClient) CurrentTokenOwner(ctx context.Context, in *sliverpb.CurrentTokenOwnerReq, opts ...grpc.CallOption) (*sliverpb.CurrentTokenOwner, error) {
+	out := new(sliverpb.CurrentTokenOwner)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CurrentTokenOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type sliverRPCEventsClient struct {
-	grpc.ClientStream
+func (c *sliverRPCClient) PivotStartListener(ctx context.Context, in *sliverpb.PivotStartListenerReq, opts ...grpc.CallOption) (*sliverpb.PivotListener, error) {
+	out := new(sliverpb.PivotListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotStartListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *sliverRPCEventsClient) Recv() (*clientpb.Event, error) {
-	m := new(clientpb.Event)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *sliverRPCClient) PivotStopListener(ctx context.Context, in *sliverpb.PivotStopListenerReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotStopListener", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// SliverRPCServer is the server API for SliverRPC service.
-// All implementations must embed UnimplementedSliverRPCServer
-// for forward compatibility
-type SliverRPCServer interface {
-	// *** Version ***
-	GetVersion(context.Context, *commonpb.Empty) (*clientpb.Version, error)
-	// *** Client Logs ***
-	ClientLog(SliverRPC_ClientLogServer) error
-	// *** Operator Commands ***
-	GetOperators(context.Context, *commonpb.Empty) (*clientpb.Operators, error)
-	// *** Generic ***
-	Kill(context.Context, *sliverpb.KillReq) (*commonpb.Empty, error)
-	Reconfigure(context.Context, *sliverpb.ReconfigureReq) (*sliverpb.Reconfigure, error)
-	Rename(context.Context, *clientpb.RenameReq) (*commonpb.Empty, error)
-	// *** Sessions ***
-	GetSessions(context.Context, *commonpb.Empty) (*clientpb.Sessions, error)
-	// *** Beacons ***
-	GetBeacons(context.Context, *commonpb.Empty) (*clientpb.Beacons, error)
-	GetBeacon(context.Context, *clientpb.Beacon) (*clientpb.Beacon, error)
-	RmBeacon(context.Context, *clientpb.Beacon) (*commonpb.Empty, error)
-	GetBeaconTasks(context.Context, *clientpb.Beacon) (*clientpb.BeaconTasks, error)
-	GetBeaconTaskContent(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error)
-	CancelBeaconTask(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error)
-	// ***Threat monitoring ***
-	MonitorStart(context.Context, *commonpb.Empty) (*commonpb.Response, error)
-	MonitorStop(context.Context, *commonpb.Empty) (*commonpb.Empty, error)
-	// *** Jobs ***
-	GetJobs(context.Context, *commonpb.Empty) (*clientpb.Jobs, error)
-	KillJob(context.Context, *clientpb.KillJobReq) (*clientpb.KillJob, error)
-	// *** Listeners ***
-	StartMTLSListener(context.Context, *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error)
+func (c *sliverRPCClient) PivotSessionListeners(ctx context.Context, in *sliverpb.PivotListenersReq, opts ...grpc.CallOption) (*sliverpb.PivotListeners, error) {
+	out := new(sliverpb.PivotListeners)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotSessionListeners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) PivotGraph(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.PivotGraph, error) {
+	out := new(clientpb.PivotGraph)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StartService(ctx context.Context, in *sliverpb.StartServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error) {
+	out := new(sliverpb.ServiceInfo)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StopService(ctx context.Context, in *sliverpb.StopServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error) {
+	out := new(sliverpb.ServiceInfo)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RemoveService(ctx context.Context, in *sliverpb.RemoveServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error) {
+	out := new(sliverpb.ServiceInfo)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RemoveService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) MakeToken(ctx context.Context, in *sliverpb.MakeTokenReq, opts ...grpc.CallOption) (*sliverpb.MakeToken, error) {
+	out := new(sliverpb.MakeToken)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MakeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetEnv(ctx context.Context, in *sliverpb.EnvReq, opts ...grpc.CallOption) (*sliverpb.EnvInfo, error) {
+	out := new(sliverpb.EnvInfo)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SetEnv(ctx context.Context, in *sliverpb.SetEnvReq, opts ...grpc.CallOption) (*sliverpb.SetEnv, error) {
+	out := new(sliverpb.SetEnv)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SetEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) UnsetEnv(ctx context.Context, in *sliverpb.UnsetEnvReq, opts ...grpc.CallOption) (*sliverpb.UnsetEnv, error) {
+	out := new(sliverpb.UnsetEnv)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UnsetEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Backdoor(ctx context.Context, in *clientpb.BackdoorReq, opts ...grpc.CallOption) (*clientpb.Backdoor, error) {
+	out := new(clientpb.Backdoor)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Backdoor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryRead(ctx context.Context, in *sliverpb.RegistryReadReq, opts ...grpc.CallOption) (*sliverpb.RegistryRead, error) {
+	out := new(sliverpb.RegistryRead)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryWrite(ctx context.Context, in *sliverpb.RegistryWriteReq, opts ...grpc.CallOption) (*sliverpb.RegistryWrite, error) {
+	out := new(sliverpb.RegistryWrite)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryCreateKey(ctx context.Context, in *sliverpb.RegistryCreateKeyReq, opts ...grpc.CallOption) (*sliverpb.RegistryCreateKey, error) {
+	out := new(sliverpb.RegistryCreateKey)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryCreateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryDeleteKey(ctx context.Context, in *sliverpb.RegistryDeleteKeyReq, opts ...grpc.CallOption) (*sliverpb.RegistryDeleteKey, error) {
+	out := new(sliverpb.RegistryDeleteKey)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryDeleteKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryListSubKeys(ctx context.Context, in *sliverpb.RegistrySubKeyListReq, opts ...grpc.CallOption) (*sliverpb.RegistrySubKeyList, error) {
+	out := new(sliverpb.RegistrySubKeyList)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryListSubKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryListValues(ctx context.Context, in *sliverpb.RegistryListValuesReq, opts ...grpc.CallOption) (*sliverpb.RegistryValuesList, error) {
+	out := new(sliverpb.RegistryValuesList)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryListValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RunSSHCommand(ctx context.Context, in *sliverpb.SSHCommandReq, opts ...grpc.CallOption) (*sliverpb.SSHCommand, error) {
+	out := new(sliverpb.SSHCommand)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunSSHCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) HijackDLL(ctx context.Context, in *clientpb.DllHijackReq, opts ...grpc.CallOption) (*clientpb.DllHijack, error) {
+	out := new(clientpb.DllHijack)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HijackDLL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetPrivs(ctx context.Context, in *sliverpb.GetPrivsReq, opts ...grpc.CallOption) (*sliverpb.GetPrivs, error) {
+	out := new(sliverpb.GetPrivs)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPrivs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StartRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStartListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error) {
+	out := new(sliverpb.RportFwdListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartRportFwdListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetRportFwdListeners(ctx context.Context, in *sliverpb.RportFwdListenersReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListeners, error) {
+	out := new(sliverpb.RportFwdListeners)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetRportFwdListeners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StopRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStopListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error) {
+	out := new(sliverpb.RportFwdListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopRportFwdListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) OpenSession(ctx context.Context, in *sliverpb.OpenSession, opts ...grpc.CallOption) (*sliverpb.OpenSession, error) {
+	out := new(sliverpb.OpenSession)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/OpenSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) CloseSession(ctx context.Context, in *sliverpb.CloseSession, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CloseSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegisterExtension(ctx context.Context, in *sliverpb.RegisterExtensionReq, opts ...grpc.CallOption) (*sliverpb.RegisterExtension, error) {
+	out := new(sliverpb.RegisterExtension)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegisterExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) CallExtension(ctx context.Context, in *sliverpb.CallExtensionReq, opts ...grpc.CallOption) (*sliverpb.CallExtension, error) {
+	out := new(sliverpb.CallExtension)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CallExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ListExtensions(ctx context.Context, in *sliverpb.ListExtensionsReq, opts ...grpc.CallOption) (*sliverpb.ListExtensions, error) {
+	out := new(sliverpb.ListExtensions)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ListExtensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegisterWasmExtension(ctx context.Context, in *sliverpb.RegisterWasmExtensionReq, opts ...grpc.CallOption) (*sliverpb.RegisterWasmExtension, error) {
+	out := new(sliverpb.RegisterWasmExtension)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegisterWasmExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ListWasmExtensions(ctx context.Context, in *sliverpb.ListWasmExtensionsReq, opts ...grpc.CallOption) (*sliverpb.ListWasmExtensions, error) {
+	out := new(sliverpb.ListWasmExtensions)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ListWasmExtensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ExecWasmExtension(ctx context.Context, in *sliverpb.ExecWasmExtensionReq, opts ...grpc.CallOption) (*sliverpb.ExecWasmExtension, error) {
+	out := new(sliverpb.ExecWasmExtension)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ExecWasmExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) WGStartPortForward(ctx context.Context, in *sliverpb.WGPortForwardStartReq, opts ...grpc.CallOption) (*sliverpb.WGPortForward, error) {
+	out := new(sliverpb.WGPortForward)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStartPortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) WGStopPortForward(ctx context.Context, in *sliverpb.WGPortForwardStopReq, opts ...grpc.CallOption) (*sliverpb.WGPortForward, error) {
+	out := new(sliverpb.WGPortForward)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStopPortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) WGStartSocks(ctx context.Context, in *sliverpb.WGSocksStartReq, opts ...grpc.CallOption) (*sliverpb.WGSocks, error) {
+	out := new(sliverpb.WGSocks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStartSocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) WGStopSocks(ctx context.Context, in *sliverpb.WGSocksStopReq, opts ...grpc.CallOption) (*sliverpb.WGSocks, error) {
+	out := new(sliverpb.WGSocks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGStopSocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) WGListForwarders(ctx context.Context, in *sliverpb.WGTCPForwardersReq, opts ...grpc.CallOption) (*sliverpb.WGTCPForwarders, error) {
+	out := new(sliverpb.WGTCPForwarders)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGListForwarders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) WGListSocksServers(ctx context.Context, in *sliverpb.WGSocksServersReq, opts ...grpc.CallOption) (*sliverpb.WGSocksServers, error) {
+	out := new(sliverpb.WGSocksServers)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGListSocksServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Shell(ctx context.Context, in *sliverpb.ShellReq, opts ...grpc.CallOption) (*sliverpb.Shell, error) {
+	out := new(sliverpb.Shell)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Shell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ShellResize(ctx context.Context, in *sliverpb.ShellResizeReq, opts ...grpc.CallOption) (*sliverpb.ShellResize, error) {
+	out := new(sliverpb.ShellResize)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellResize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ShellSignal(ctx context.Context, in *sliverpb.ShellSignalReq, opts ...grpc.CallOption) (*sliverpb.ShellSignal, error) {
+	out := new(sliverpb.ShellSignal)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellSignal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Portfwd(ctx context.Context, in *sliverpb.PortfwdReq, opts ...grpc.CallOption) (*sliverpb.Portfwd, error) {
+	out := new(sliverpb.Portfwd)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Portfwd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) CreateSocks(ctx context.Context, in *sliverpb.Socks, opts ...grpc.CallOption) (*sliverpb.Socks, error) {
+	out := new(sliverpb.Socks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CreateSocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) CloseSocks(ctx context.Context, in *sliverpb.Socks, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CloseSocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SocksProxy(ctx context.Context, opts ...grpc.CallOption) (SliverRPC_SocksProxyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[4], "/rpcpb.SliverRPC/SocksProxy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sliverRPCSocksProxyClient{stream}
+	return x, nil
+}
+
+type SliverRPC_SocksProxyClient interface {
+	Send(*sliverpb.SocksData) error
+	Recv() (*sliverpb.SocksData, error)
+	grpc.ClientStream
+}
+
+type sliverRPCSocksProxyClient struct {
+	grpc.ClientStream
+}
+
+func (x *sliverRPCSocksProxyClient) Send(m *sliverpb.SocksData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sliverRPCSocksProxyClient) Recv() (*sliverpb.SocksData, error) {
+	m := new(sliverpb.SocksData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sliverRPCClient) CreateTunnel(ctx context.Context, in *sliverpb.Tunnel, opts ...grpc.CallOption) (*sliverpb.Tunnel, error) {
+	out := new(sliverpb.Tunnel)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CreateTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) CloseTunnel(ctx context.Context, in *sliverpb.Tunnel, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CloseTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) TunnelData(ctx context.Context, opts ...grpc.CallOption) (SliverRPC_TunnelDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[5], "/rpcpb.SliverRPC/TunnelData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sliverRPCTunnelDataClient{stream}
+	return x, nil
+}
+
+type SliverRPC_TunnelDataClient interface {
+	Send(*sliverpb.TunnelData) error
+	Recv() (*sliverpb.TunnelData, error)
+	grpc.ClientStream
+}
+
+type sliverRPCTunnelDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *sliverRPCTunnelDataClient) Send(m *sliverpb.TunnelData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sliverRPCTunnelDataClient) Recv() (*sliverpb.TunnelData, error) {
+	m := new(sliverpb.TunnelData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sliverRPCClient) Events(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (SliverRPC_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SliverRPC_ServiceDesc.Streams[6], "/rpcpb.SliverRPC/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sliverRPCEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SliverRPC_EventsClient interface {
+	Recv() (*clientpb.Event, error)
+	grpc.ClientStream
+}
+
+type sliverRPCEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sliverRPCEventsClient) Recv() (*clientpb.Event, error) {
+	m := new(clientpb.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SliverRPCServer is the server API for SliverRPC service.
+// All implementations must embed UnimplementedSliverRPCServer
+// for forward compatibility
+type SliverRPCServer interface {
+	// *** Version ***
+	GetVersion(context.Context, *commonpb.Empty) (*clientpb.Version, error)
+	// *** Client Logs ***
+	ClientLog(SliverRPC_ClientLogServer) error
+	// *** Operator Commands ***
+	GetOperators(context.Context, *commonpb.Empty) (*clientpb.Operators, error)
+	SetPresence(context.Context, *clientpb.PresenceReq) (*clientpb.Presence, error)
+	GetPresence(context.Context, *commonpb.Empty) (*clientpb.Presence, error)
+	LockTarget(context.Context, *clientpb.TargetLockReq) (*clientpb.TargetLock, error)
+	UnlockTarget(context.Context, *clientpb.TargetLockReq) (*commonpb.Empty, error)
+	UpdateTargetMetadata(context.Context, *clientpb.TargetMetadataReq) (*clientpb.TargetMetadata, error)
+	AddTargetHistory(context.Context, *clientpb.TargetHistoryReq) (*commonpb.Empty, error)
+	GetTargetHistory(context.Context, *clientpb.TargetHistoryReq) (*clientpb.TargetHistory, error)
+	GetOpsecPolicy(context.Context, *commonpb.Empty) (*clientpb.OpsecPolicy, error)
+	// *** Generic ***
+	Kill(context.Context, *sliverpb.KillReq) (*commonpb.Empty, error)
+	Reconfigure(context.Context, *sliverpb.ReconfigureReq) (*sliverpb.Reconfigure, error)
+	Rename(context.Context, *clientpb.RenameReq) (*commonpb.Empty, error)
+	// *** Sessions ***
+	GetSessions(context.Context, *commonpb.Empty) (*clientpb.Sessions, error)
+	// *** Beacons ***
+	GetBeacons(context.Context, *commonpb.Empty) (*clientpb.Beacons, error)
+	GetBeacon(context.Context, *clientpb.Beacon) (*clientpb.Beacon, error)
+	RmBeacon(context.Context, *clientpb.Beacon) (*commonpb.Empty, error)
+	GetBeaconTasks(context.Context, *clientpb.Beacon) (*clientpb.BeaconTasks, error)
+	GetBeaconTaskContent(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error)
+	CancelBeaconTask(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error)
+	ReorderBeaconTasks(context.Context, *clientpb.BeaconTasks) (*clientpb.BeaconTasks, error)
+	// ***Threat monitoring ***
+	MonitorStart(context.Context, *commonpb.Empty) (*commonpb.Response, error)
+	MonitorStop(context.Context, *commonpb.Empty) (*commonpb.Empty, error)
+	// *** Jobs ***
+	GetJobs(context.Context, *commonpb.Empty) (*clientpb.Jobs, error)
+	KillJob(context.Context, *clientpb.KillJobReq) (*clientpb.KillJob, error)
+	DrainJob(context.Context, *clientpb.JobDrainReq) (*clientpb.Job, error)
+	// *** Listeners ***
+	StartMTLSListener(context.Context, *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error)
+	StartExternalC2Listener(context.Context, *clientpb.ExternalC2ListenerReq) (*clientpb.ExternalC2Listener, error)
 	StartWGListener(context.Context, *clientpb.WGListenerReq) (*clientpb.WGListener, error)
 	StartDNSListener(context.Context, *clientpb.DNSListenerReq) (*clientpb.DNSListener, error)
 	StartHTTPSListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error)
 	StartHTTPListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error)
+	// *** Listener Profiles ***
+	ListenerProfiles(context.Context, *commonpb.Empty) (*clientpb.ListenerProfiles, error)
+	SaveListenerProfile(context.Context, *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error)
+	RmListenerProfile(context.Context, *clientpb.ListenerProfile) (*commonpb.Empty, error)
+	StartListenerProfile(context.Context, *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error)
+	StopListenerProfile(context.Context, *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error)
+	// *** HTTP C2 Config ***
+	GetHTTPC2Config(context.Context, *commonpb.Empty) (*clientpb.HTTPC2Config, error)
+	SaveHTTPC2Config(context.Context, *clientpb.HTTPC2Config) (*clientpb.HTTPC2ConfigReload, error)
+	ReloadHTTPC2Config(context.Context, *commonpb.Empty) (*clientpb.HTTPC2ConfigReload, error)
+	GenerateRedirectorConfig(context.Context, *clientpb.RedirectorReq) (*clientpb.RedirectorConfig, error)
+	// *** Server Config ***
+	ReloadServerConfig(context.Context, *commonpb.Empty) (*clientpb.ServerConfigReload, error)
+	// *** Envelope Capture ***
+	StartCapture(context.Context, *clientpb.CaptureReq) (*clientpb.CaptureStatus, error)
+	StopCapture(context.Context, *commonpb.Empty) (*clientpb.CaptureStatus, error)
+	GetCapture(context.Context, *clientpb.CaptureEntriesReq) (*clientpb.CapturedEnvelopes, error)
+	// *** Artifact Provenance ***
+	VerifyArtifact(context.Context, *commonpb.File) (*clientpb.ArtifactProvenance, error)
+	LookupArtifactMarker(context.Context, *clientpb.ArtifactMarkerReq) (*clientpb.ArtifactProvenance, error)
+	// *** Private Armory ***
+	ArmoryPublish(context.Context, *clientpb.ArmoryPublishReq) (*clientpb.ArmoryPackageVersion, error)
+	ArmoryPackages(context.Context, *commonpb.Empty) (*clientpb.ArmoryPackages, error)
+	ArmoryUnpublish(context.Context, *clientpb.ArmoryUnpublishReq) (*commonpb.Empty, error)
+	StartArmoryListener(context.Context, *clientpb.ArmoryListenerReq) (*clientpb.ArmoryListener, error)
 	// *** Stager Listener ***
 	StartTCPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error)
 	StartHTTPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error)
+	GetStageFetches(context.Context, *commonpb.Empty) (*clientpb.StageFetches, error)
 	// *** Loot ***
 	LootAdd(context.Context, *clientpb.Loot) (*clientpb.Loot, error)
 	LootRm(context.Context, *clientpb.Loot) (*commonpb.Empty, error)
 	LootUpdate(context.Context, *clientpb.Loot) (*clientpb.Loot, error)
 	LootContent(context.Context, *clientpb.Loot) (*clientpb.Loot, error)
 	LootAll(context.Context, *commonpb.Empty) (*clientpb.AllLoot, error)
+	LootTag(context.Context, *clientpb.LootTagReq) (*clientpb.Loot, error)
+	LootSearch(context.Context, *clientpb.LootSearchReq) (*clientpb.LootSearchResults, error)
+	LootExport(context.Context, *clientpb.LootExportReq) (*commonpb.File, error)
+	BloodHoundIngest(context.Context, *clientpb.BloodHoundIngestReq) (*clientpb.BloodHoundIngest, error)
+	// *** Federation ***
+	FederationLinkAdd(context.Context, *clientpb.FederationLinkReq) (*clientpb.FederationLink, error)
+	FederationLinkRm(context.Context, *clientpb.FederationLinkReq) (*commonpb.Empty, error)
+	FederationLinks(context.Context, *commonpb.Empty) (*clientpb.FederationLinks, error)
+	FederationView(context.Context, *clientpb.FederationReq) (*clientpb.FederationView, error)
+	FederationLootContent(context.Context, *clientpb.FederationLootReq) (*clientpb.Loot, error)
+	// *** High Availability ***
+	HASnapshot(*clientpb.HASnapshotReq, SliverRPC_HASnapshotServer) error
+	// *** Automation ***
+	AutomationRules(context.Context, *commonpb.Empty) (*clientpb.AutomationRules, error)
+	AutomationRuleSave(context.Context, *clientpb.AutomationRule) (*clientpb.AutomationRule, error)
+	AutomationRuleRm(context.Context, *clientpb.AutomationRule) (*commonpb.Empty, error)
+	ScheduledTasks(context.Context, *commonpb.Empty) (*clientpb.ScheduledTasks, error)
+	ScheduledTaskSave(context.Context, *clientpb.ScheduledTask) (*clientpb.ScheduledTask, error)
+	ScheduledTaskRm(context.Context, *clientpb.ScheduledTask) (*commonpb.Empty, error)
+	ImplantGroups(context.Context, *commonpb.Empty) (*clientpb.ImplantGroups, error)
+	ImplantGroupSave(context.Context, *clientpb.ImplantGroup) (*clientpb.ImplantGroup, error)
+	ImplantGroupRm(context.Context, *clientpb.ImplantGroup) (*commonpb.Empty, error)
+	ImplantGroupMembers(context.Context, *clientpb.ImplantGroup) (*clientpb.ImplantGroupMembers, error)
+	ImplantGroupTask(context.Context, *clientpb.GroupTaskReq) (*clientpb.GroupTaskResults, error)
+	// *** Recordings ***
+	GetRecordings(context.Context, *clientpb.RecordingReq) (*clientpb.Recordings, error)
+	GetRecording(context.Context, *clientpb.RecordingReq) (*commonpb.File, error)
 	// *** Creds ***
 	Creds(context.Context, *commonpb.Empty) (*clientpb.Credentials, error)
 	CredsAdd(context.Context, *clientpb.Credentials) (*commonpb.Empty, error)
@@ -1917,8 +2815,16 @@ type SliverRPCServer interface {
 	// *** Hosts ***
 	Hosts(context.Context, *commonpb.Empty) (*clientpb.AllHosts, error)
 	Host(context.Context, *clientpb.Host) (*clientpb.Host, error)
+	HostDetails(context.Context, *clientpb.HostDetailsReq) (*clientpb.HostDetails, error)
+	HostTimeline(context.Context, *clientpb.Host) (*clientpb.HostTimeline, error)
+	EngagementExport(context.Context, *clientpb.EngagementExportReq) (*commonpb.File, error)
+	AttackCoverage(context.Context, *clientpb.AttackCoverageReq) (*clientpb.AttackCoverage, error)
+	Preflight(context.Context, *clientpb.PreflightReq) (*clientpb.Preflight, error)
 	HostRm(context.Context, *clientpb.Host) (*commonpb.Empty, error)
 	HostIOCRm(context.Context, *clientpb.IOC) (*commonpb.Empty, error)
+	GetScanResults(context.Context, *clientpb.ScanResultsReq) (*clientpb.ScanResults, error)
+	GetDiscoveredHosts(context.Context, *clientpb.DiscoveredHostsReq) (*clientpb.DiscoveredHosts, error)
+	GetHostProcesses(context.Context, *clientpb.HostProcessesReq) (*clientpb.HostProcesses, error)
 	// *** Implants ***
 	Generate(context.Context, *clientpb.GenerateReq) (*clientpb.Generate, error)
 	GenerateExternal(context.Context, *clientpb.ExternalGenerateReq) (*clientpb.ExternalImplantConfig, error)
@@ -1928,6 +2834,10 @@ type SliverRPCServer interface {
 	BuilderRegister(*clientpb.Builder, SliverRPC_BuilderRegisterServer) error
 	BuilderTrigger(context.Context, *clientpb.Event) (*commonpb.Empty, error)
 	Builders(context.Context, *commonpb.Empty) (*clientpb.Builders, error)
+	// *** Toolchains ***
+	Toolchains(context.Context, *commonpb.Empty) (*clientpb.Toolchains, error)
+	ToolchainInstall(context.Context, *clientpb.ToolchainReq) (*clientpb.Toolchain, error)
+	ToolchainRemove(context.Context, *clientpb.ToolchainReq) (*commonpb.Empty, error)
 	// *** Crackstation ***
 	CrackstationRegister(*clientpb.Crackstation, SliverRPC_CrackstationRegisterServer) error
 	CrackstationTrigger(context.Context, *clientpb.Event) (*commonpb.Empty, error)
@@ -1943,14 +2853,22 @@ type SliverRPCServer interface {
 	CrackFileDelete(context.Context, *clientpb.CrackFile) (*commonpb.Empty, error)
 	// *** Payloads ***
 	Regenerate(context.Context, *clientpb.RegenerateReq) (*clientpb.Generate, error)
+	GenerateProvisioningBundle(context.Context, *clientpb.ProvisioningBundleReq) (*clientpb.ProvisioningBundle, error)
+	VerifyImplantBuild(context.Context, *clientpb.ImplantBuildVerifyReq) (*clientpb.ImplantBuildVerification, error)
 	ImplantBuilds(context.Context, *commonpb.Empty) (*clientpb.ImplantBuilds, error)
 	DeleteImplantBuild(context.Context, *clientpb.DeleteReq) (*commonpb.Empty, error)
+	BurnImplant(context.Context, *clientpb.BurnReq) (*commonpb.Empty, error)
 	Canaries(context.Context, *commonpb.Empty) (*clientpb.Canaries, error)
+	CanaryTokens(context.Context, *commonpb.Empty) (*clientpb.CanaryTokens, error)
+	CanaryTokenNew(context.Context, *clientpb.CanaryTokenReq) (*clientpb.CanaryToken, error)
+	CanaryTokenRm(context.Context, *clientpb.CanaryToken) (*commonpb.Empty, error)
 	GenerateWGClientConfig(context.Context, *commonpb.Empty) (*clientpb.WGClientConfig, error)
 	GenerateUniqueIP(context.Context, *commonpb.Empty) (*clientpb.UniqueWGIP, error)
 	ImplantProfiles(context.Context, *commonpb.Empty) (*clientpb.ImplantProfiles, error)
 	DeleteImplantProfile(context.Context, *clientpb.DeleteReq) (*commonpb.Empty, error)
 	SaveImplantProfile(context.Context, *clientpb.ImplantProfile) (*clientpb.ImplantProfile, error)
+	GetImplantProfile(context.Context, *clientpb.ImplantProfileReq) (*clientpb.ImplantProfile, error)
+	ImplantProfileHistory(context.Context, *clientpb.ImplantProfileReq) (*clientpb.ImplantProfiles, error)
 	MsfStage(context.Context, *clientpb.MsfStagerReq) (*clientpb.MsfStager, error)
 	ShellcodeRDI(context.Context, *clientpb.ShellcodeRDIReq) (*clientpb.ShellcodeRDI, error)
 	GetCompiler(context.Context, *commonpb.Empty) (*clientpb.Compiler, error)
@@ -1972,6 +2890,11 @@ type SliverRPCServer interface {
 	Terminate(context.Context, *sliverpb.TerminateReq) (*sliverpb.Terminate, error)
 	Ifconfig(context.Context, *sliverpb.IfconfigReq) (*sliverpb.Ifconfig, error)
 	Netstat(context.Context, *sliverpb.NetstatReq) (*sliverpb.Netstat, error)
+	Scan(context.Context, *sliverpb.ScanReq) (*sliverpb.Scan, error)
+	DNSResolvers(context.Context, *sliverpb.DNSResolversReq) (*sliverpb.DNSResolvers, error)
+	EDREnum(context.Context, *sliverpb.EDREnumReq) (*sliverpb.EDREnum, error)
+	Discover(context.Context, *sliverpb.DiscoverReq) (*sliverpb.Discover, error)
+	ADEnum(context.Context, *sliverpb.ADEnumReq) (*sliverpb.ADEnum, error)
 	Ls(context.Context, *sliverpb.LsReq) (*sliverpb.Ls, error)
 	Cd(context.Context, *sliverpb.CdReq) (*sliverpb.Pwd, error)
 	Pwd(context.Context, *sliverpb.PwdReq) (*sliverpb.Pwd, error)
@@ -2048,6 +2971,8 @@ type SliverRPCServer interface {
 	WGListSocksServers(context.Context, *sliverpb.WGSocksServersReq) (*sliverpb.WGSocksServers, error)
 	// *** Realtime Commands ***
 	Shell(context.Context, *sliverpb.ShellReq) (*sliverpb.Shell, error)
+	ShellResize(context.Context, *sliverpb.ShellResizeReq) (*sliverpb.ShellResize, error)
+	ShellSignal(context.Context, *sliverpb.ShellSignalReq) (*sliverpb.ShellSignal, error)
 	Portfwd(context.Context, *sliverpb.PortfwdReq) (*sliverpb.Portfwd, error)
 	// *** Socks5 ***
 	CreateSocks(context.Context, *sliverpb.Socks) (*sliverpb.Socks, error)
@@ -2075,6 +3000,30 @@ func (UnimplementedSliverRPCServer) ClientLog(SliverRPC_ClientLogServer) error {
 func (UnimplementedSliverRPCServer) GetOperators(context.Context, *commonpb.Empty) (*clientpb.Operators, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOperators not implemented")
 }
+func (UnimplementedSliverRPCServer) SetPresence(context.Context, *clientpb.PresenceReq) (*clientpb.Presence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPresence not implemented")
+}
+func (UnimplementedSliverRPCServer) GetPresence(context.Context, *commonpb.Empty) (*clientpb.Presence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedSliverRPCServer) LockTarget(context.Context, *clientpb.TargetLockReq) (*clientpb.TargetLock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockTarget not implemented")
+}
+func (UnimplementedSliverRPCServer) UnlockTarget(context.Context, *clientpb.TargetLockReq) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockTarget not implemented")
+}
+func (UnimplementedSliverRPCServer) UpdateTargetMetadata(context.Context, *clientpb.TargetMetadataReq) (*clientpb.TargetMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTargetMetadata not implemented")
+}
+func (UnimplementedSliverRPCServer) AddTargetHistory(context.Context, *clientpb.TargetHistoryReq) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTargetHistory not implemented")
+}
+func (UnimplementedSliverRPCServer) GetTargetHistory(context.Context, *clientpb.TargetHistoryReq) (*clientpb.TargetHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetHistory not implemented")
+}
+func (UnimplementedSliverRPCServer) GetOpsecPolicy(context.Context, *commonpb.Empty) (*clientpb.OpsecPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpsecPolicy not implemented")
+}
 func (UnimplementedSliverRPCServer) Kill(context.Context, *sliverpb.KillReq) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Kill not implemented")
 }
@@ -2105,32 +3054,98 @@ func (UnimplementedSliverRPCServer) GetBeaconTaskContent(context.Context, *clien
 func (UnimplementedSliverRPCServer) CancelBeaconTask(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBeaconTask not implemented")
 }
-func (UnimplementedSliverRPCServer) MonitorStart(context.Context, *commonpb.Empty) (*commonpb.Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MonitorStart not implemented")
+func (UnimplementedSliverRPCServer) ReorderBeaconTasks(context.Context, *clientpb.BeaconTasks) (*clientpb.BeaconTasks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBeaconTasks not implemented")
+}
+func (UnimplementedSliverRPCServer) MonitorStart(context.Context, *commonpb.Empty) (*commonpb.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorStart not implemented")
+}
+func (UnimplementedSliverRPCServer) MonitorStop(context.Context, *commonpb.Empty) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorStop not implemented")
+}
+func (UnimplementedSliverRPCServer) GetJobs(context.Context, *commonpb.Empty) (*clientpb.Jobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (UnimplementedSliverRPCServer) KillJob(context.Context, *clientpb.KillJobReq) (*clientpb.KillJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillJob not implemented")
+}
+func (UnimplementedSliverRPCServer) DrainJob(context.Context, *clientpb.JobDrainReq) (*clientpb.Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainJob not implemented")
+}
+func (UnimplementedSliverRPCServer) StartMTLSListener(context.Context, *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMTLSListener not implemented")
+}
+func (UnimplementedSliverRPCServer) StartExternalC2Listener(context.Context, *clientpb.ExternalC2ListenerReq) (*clientpb.ExternalC2Listener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartExternalC2Listener not implemented")
+}
+func (UnimplementedSliverRPCServer) StartWGListener(context.Context, *clientpb.WGListenerReq) (*clientpb.WGListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWGListener not implemented")
+}
+func (UnimplementedSliverRPCServer) StartDNSListener(context.Context, *clientpb.DNSListenerReq) (*clientpb.DNSListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDNSListener not implemented")
+}
+func (UnimplementedSliverRPCServer) StartHTTPSListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPSListener not implemented")
+}
+func (UnimplementedSliverRPCServer) StartHTTPListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPListener not implemented")
+}
+func (UnimplementedSliverRPCServer) ListenerProfiles(context.Context, *commonpb.Empty) (*clientpb.ListenerProfiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenerProfiles not implemented")
+}
+func (UnimplementedSliverRPCServer) SaveListenerProfile(context.Context, *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveListenerProfile not implemented")
+}
+func (UnimplementedSliverRPCServer) RmListenerProfile(context.Context, *clientpb.ListenerProfile) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RmListenerProfile not implemented")
+}
+func (UnimplementedSliverRPCServer) StartListenerProfile(context.Context, *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartListenerProfile not implemented")
+}
+func (UnimplementedSliverRPCServer) StopListenerProfile(context.Context, *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopListenerProfile not implemented")
+}
+func (UnimplementedSliverRPCServer) GetHTTPC2Config(context.Context, *commonpb.Empty) (*clientpb.HTTPC2Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHTTPC2Config not implemented")
+}
+func (UnimplementedSliverRPCServer) SaveHTTPC2Config(context.Context, *clientpb.HTTPC2Config) (*clientpb.HTTPC2ConfigReload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveHTTPC2Config not implemented")
+}
+func (UnimplementedSliverRPCServer) ReloadHTTPC2Config(context.Context, *commonpb.Empty) (*clientpb.HTTPC2ConfigReload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadHTTPC2Config not implemented")
+}
+func (UnimplementedSliverRPCServer) GenerateRedirectorConfig(context.Context, *clientpb.RedirectorReq) (*clientpb.RedirectorConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRedirectorConfig not implemented")
+}
+func (UnimplementedSliverRPCServer) ReloadServerConfig(context.Context, *commonpb.Empty) (*clientpb.ServerConfigReload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadServerConfig not implemented")
+}
+func (UnimplementedSliverRPCServer) StartCapture(context.Context, *clientpb.CaptureReq) (*clientpb.CaptureStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCapture not implemented")
 }
-func (UnimplementedSliverRPCServer) MonitorStop(context.Context, *commonpb.Empty) (*commonpb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MonitorStop not implemented")
+func (UnimplementedSliverRPCServer) StopCapture(context.Context, *commonpb.Empty) (*clientpb.CaptureStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCapture not implemented")
 }
-func (UnimplementedSliverRPCServer) GetJobs(context.Context, *commonpb.Empty) (*clientpb.Jobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+func (UnimplementedSliverRPCServer) GetCapture(context.Context, *clientpb.CaptureEntriesReq) (*clientpb.CapturedEnvelopes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapture not implemented")
 }
-func (UnimplementedSliverRPCServer) KillJob(context.Context, *clientpb.KillJobReq) (*clientpb.KillJob, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KillJob not implemented")
+func (UnimplementedSliverRPCServer) VerifyArtifact(context.Context, *commonpb.File) (*clientpb.ArtifactProvenance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyArtifact not implemented")
 }
-func (UnimplementedSliverRPCServer) StartMTLSListener(context.Context, *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartMTLSListener not implemented")
+func (UnimplementedSliverRPCServer) LookupArtifactMarker(context.Context, *clientpb.ArtifactMarkerReq) (*clientpb.ArtifactProvenance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupArtifactMarker not implemented")
 }
-func (UnimplementedSliverRPCServer) StartWGListener(context.Context, *clientpb.WGListenerReq) (*clientpb.WGListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartWGListener not implemented")
+func (UnimplementedSliverRPCServer) ArmoryPublish(context.Context, *clientpb.ArmoryPublishReq) (*clientpb.ArmoryPackageVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArmoryPublish not implemented")
 }
-func (UnimplementedSliverRPCServer) StartDNSListener(context.Context, *clientpb.DNSListenerReq) (*clientpb.DNSListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartDNSListener not implemented")
+func (UnimplementedSliverRPCServer) ArmoryPackages(context.Context, *commonpb.Empty) (*clientpb.ArmoryPackages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArmoryPackages not implemented")
 }
-func (UnimplementedSliverRPCServer) StartHTTPSListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPSListener not implemented")
+func (UnimplementedSliverRPCServer) ArmoryUnpublish(context.Context, *clientpb.ArmoryUnpublishReq) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArmoryUnpublish not implemented")
 }
-func (UnimplementedSliverRPCServer) StartHTTPListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPListener not implemented")
+func (UnimplementedSliverRPCServer) StartArmoryListener(context.Context, *clientpb.ArmoryListenerReq) (*clientpb.ArmoryListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartArmoryListener not implemented")
 }
 func (UnimplementedSliverRPCServer) StartTCPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartTCPStagerListener not implemented")
@@ -2138,6 +3153,9 @@ func (UnimplementedSliverRPCServer) StartTCPStagerListener(context.Context, *cli
 func (UnimplementedSliverRPCServer) StartHTTPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPStagerListener not implemented")
 }
+func (UnimplementedSliverRPCServer) GetStageFetches(context.Context, *commonpb.Empty) (*clientpb.StageFetches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStageFetches not implemented")
+}
 func (UnimplementedSliverRPCServer) LootAdd(context.Context, *clientpb.Loot) (*clientpb.Loot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LootAdd not implemented")
 }
@@ -2153,6 +3171,75 @@ func (UnimplementedSliverRPCServer) LootContent(context.Context, *clientpb.Loot)
 func (UnimplementedSliverRPCServer) LootAll(context.Context, *commonpb.Empty) (*clientpb.AllLoot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LootAll not implemented")
 }
+func (UnimplementedSliverRPCServer) LootTag(context.Context, *clientpb.LootTagReq) (*clientpb.Loot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LootTag not implemented")
+}
+func (UnimplementedSliverRPCServer) LootSearch(context.Context, *clientpb.LootSearchReq) (*clientpb.LootSearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LootSearch not implemented")
+}
+func (UnimplementedSliverRPCServer) LootExport(context.Context, *clientpb.LootExportReq) (*commonpb.File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LootExport not implemented")
+}
+func (UnimplementedSliverRPCServer) BloodHoundIngest(context.Context, *clientpb.BloodHoundIngestReq) (*clientpb.BloodHoundIngest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BloodHoundIngest not implemented")
+}
+func (UnimplementedSliverRPCServer) FederationLinkAdd(context.Context, *clientpb.FederationLinkReq) (*clientpb.FederationLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederationLinkAdd not implemented")
+}
+func (UnimplementedSliverRPCServer) FederationLinkRm(context.Context, *clientpb.FederationLinkReq) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederationLinkRm not implemented")
+}
+func (UnimplementedSliverRPCServer) FederationLinks(context.Context, *commonpb.Empty) (*clientpb.FederationLinks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederationLinks not implemented")
+}
+func (UnimplementedSliverRPCServer) FederationView(context.Context, *clientpb.FederationReq) (*clientpb.FederationView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederationView not implemented")
+}
+func (UnimplementedSliverRPCServer) FederationLootContent(context.Context, *clientpb.FederationLootReq) (*clientpb.Loot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederationLootContent not implemented")
+}
+func (UnimplementedSliverRPCServer) HASnapshot(*clientpb.HASnapshotReq, SliverRPC_HASnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method HASnapshot not implemented")
+}
+func (UnimplementedSliverRPCServer) AutomationRules(context.Context, *commonpb.Empty) (*clientpb.AutomationRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutomationRules not implemented")
+}
+func (UnimplementedSliverRPCServer) AutomationRuleSave(context.Context, *clientpb.AutomationRule) (*clientpb.AutomationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutomationRuleSave not implemented")
+}
+func (UnimplementedSliverRPCServer) AutomationRuleRm(context.Context, *clientpb.AutomationRule) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutomationRuleRm not implemented")
+}
+func (UnimplementedSliverRPCServer) ScheduledTasks(context.Context, *commonpb.Empty) (*clientpb.ScheduledTasks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTasks not implemented")
+}
+func (UnimplementedSliverRPCServer) ScheduledTaskSave(context.Context, *clientpb.ScheduledTask) (*clientpb.ScheduledTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTaskSave not implemented")
+}
+func (UnimplementedSliverRPCServer) ScheduledTaskRm(context.Context, *clientpb.ScheduledTask) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTaskRm not implemented")
+}
+func (UnimplementedSliverRPCServer) ImplantGroups(context.Context, *commonpb.Empty) (*clientpb.ImplantGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplantGroups not implemented")
+}
+func (UnimplementedSliverRPCServer) ImplantGroupSave(context.Context, *clientpb.ImplantGroup) (*clientpb.ImplantGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplantGroupSave not implemented")
+}
+func (UnimplementedSliverRPCServer) ImplantGroupRm(context.Context, *clientpb.ImplantGroup) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplantGroupRm not implemented")
+}
+func (UnimplementedSliverRPCServer) ImplantGroupMembers(context.Context, *clientpb.ImplantGroup) (*clientpb.ImplantGroupMembers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplantGroupMembers not implemented")
+}
+func (UnimplementedSliverRPCServer) ImplantGroupTask(context.Context, *clientpb.GroupTaskReq) (*clientpb.GroupTaskResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplantGroupTask not implemented")
+}
+func (UnimplementedSliverRPCServer) GetRecordings(context.Context, *clientpb.RecordingReq) (*clientpb.Recordings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordings not implemented")
+}
+func (UnimplementedSliverRPCServer) GetRecording(context.Context, *clientpb.RecordingReq) (*commonpb.File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecording not implemented")
+}
 func (UnimplementedSliverRPCServer) Creds(context.Context, *commonpb.Empty) (*clientpb.Credentials, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Creds not implemented")
 }
@@ -2183,12 +3270,36 @@ func (UnimplementedSliverRPCServer) Hosts(context.Context, *commonpb.Empty) (*cl
 func (UnimplementedSliverRPCServer) Host(context.Context, *clientpb.Host) (*clientpb.Host, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Host not implemented")
 }
+func (UnimplementedSliverRPCServer) HostDetails(context.Context, *clientpb.HostDetailsReq) (*clientpb.HostDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostDetails not implemented")
+}
+func (UnimplementedSliverRPCServer) HostTimeline(context.Context, *clientpb.Host) (*clientpb.HostTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostTimeline not implemented")
+}
+func (UnimplementedSliverRPCServer) EngagementExport(context.Context, *clientpb.EngagementExportReq) (*commonpb.File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngagementExport not implemented")
+}
+func (UnimplementedSliverRPCServer) AttackCoverage(context.Context, *clientpb.AttackCoverageReq) (*clientpb.AttackCoverage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttackCoverage not implemented")
+}
+func (UnimplementedSliverRPCServer) Preflight(context.Context, *clientpb.PreflightReq) (*clientpb.Preflight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preflight not implemented")
+}
 func (UnimplementedSliverRPCServer) HostRm(context.Context, *clientpb.Host) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HostRm not implemented")
 }
 func (UnimplementedSliverRPCServer) HostIOCRm(context.Context, *clientpb.IOC) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HostIOCRm not implemented")
 }
+func (UnimplementedSliverRPCServer) GetScanResults(context.Context, *clientpb.ScanResultsReq) (*clientpb.ScanResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScanResults not implemented")
+}
+func (UnimplementedSliverRPCServer) GetDiscoveredHosts(context.Context, *clientpb.DiscoveredHostsReq) (*clientpb.DiscoveredHosts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscoveredHosts not implemented")
+}
+func (UnimplementedSliverRPCServer) GetHostProcesses(context.Context, *clientpb.HostProcessesReq) (*clientpb.HostProcesses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostProcesses not implemented")
+}
 func (UnimplementedSliverRPCServer) Generate(context.Context, *clientpb.GenerateReq) (*clientpb.Generate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
 }
@@ -2210,6 +3321,15 @@ func (UnimplementedSliverRPCServer) BuilderTrigger(context.Context, *clientpb.Ev
 func (UnimplementedSliverRPCServer) Builders(context.Context, *commonpb.Empty) (*clientpb.Builders, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Builders not implemented")
 }
+func (UnimplementedSliverRPCServer) Toolchains(context.Context, *commonpb.Empty) (*clientpb.Toolchains, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Toolchains not implemented")
+}
+func (UnimplementedSliverRPCServer) ToolchainInstall(context.Context, *clientpb.ToolchainReq) (*clientpb.Toolchain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToolchainInstall not implemented")
+}
+func (UnimplementedSliverRPCServer) ToolchainRemove(context.Context, *clientpb.ToolchainReq) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToolchainRemove not implemented")
+}
 func (UnimplementedSliverRPCServer) CrackstationRegister(*clientpb.Crackstation, SliverRPC_CrackstationRegisterServer) error {
 	return status.Errorf(codes.Unimplemented, "method CrackstationRegister not implemented")
 }
@@ -2249,15 +3369,33 @@ func (UnimplementedSliverRPCServer) CrackFileDelete(context.Context, *clientpb.C
 func (UnimplementedSliverRPCServer) Regenerate(context.Context, *clientpb.RegenerateReq) (*clientpb.Generate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Regenerate not implemented")
 }
+func (UnimplementedSliverRPCServer) GenerateProvisioningBundle(context.Context, *clientpb.ProvisioningBundleReq) (*clientpb.ProvisioningBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateProvisioningBundle not implemented")
+}
+func (UnimplementedSliverRPCServer) VerifyImplantBuild(context.Context, *clientpb.ImplantBuildVerifyReq) (*clientpb.ImplantBuildVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyImplantBuild not implemented")
+}
 func (UnimplementedSliverRPCServer) ImplantBuilds(context.Context, *commonpb.Empty) (*clientpb.ImplantBuilds, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImplantBuilds not implemented")
 }
 func (UnimplementedSliverRPCServer) DeleteImplantBuild(context.Context, *clientpb.DeleteReq) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteImplantBuild not implemented")
 }
+func (UnimplementedSliverRPCServer) BurnImplant(context.Context, *clientpb.BurnReq) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnImplant not implemented")
+}
 func (UnimplementedSliverRPCServer) Canaries(context.Context, *commonpb.Empty) (*clientpb.Canaries, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Canaries not implemented")
 }
+func (UnimplementedSliverRPCServer) CanaryTokens(context.Context, *commonpb.Empty) (*clientpb.CanaryTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanaryTokens not implemented")
+}
+func (UnimplementedSliverRPCServer) CanaryTokenNew(context.Context, *clientpb.CanaryTokenReq) (*clientpb.CanaryToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanaryTokenNew not implemented")
+}
+func (UnimplementedSliverRPCServer) CanaryTokenRm(context.Context, *clientpb.CanaryToken) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanaryTokenRm not implemented")
+}
 func (UnimplementedSliverRPCServer) GenerateWGClientConfig(context.Context, *commonpb.Empty) (*clientpb.WGClientConfig, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateWGClientConfig not implemented")
 }
@@ -2273,6 +3411,12 @@ func (UnimplementedSliverRPCServer) DeleteImplantProfile(context.Context, *clien
 func (UnimplementedSliverRPCServer) SaveImplantProfile(context.Context, *clientpb.ImplantProfile) (*clientpb.ImplantProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveImplantProfile not implemented")
 }
+func (UnimplementedSliverRPCServer) GetImplantProfile(context.Context, *clientpb.ImplantProfileReq) (*clientpb.ImplantProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImplantProfile not implemented")
+}
+func (UnimplementedSliverRPCServer) ImplantProfileHistory(context.Context, *clientpb.ImplantProfileReq) (*clientpb.ImplantProfiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImplantProfileHistory not implemented")
+}
 func (UnimplementedSliverRPCServer) MsfStage(context.Context, *clientpb.MsfStagerReq) (*clientpb.MsfStager, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MsfStage not implemented")
 }
@@ -2330,6 +3474,21 @@ func (UnimplementedSliverRPCServer) Ifconfig(context.Context, *sliverpb.Ifconfig
 func (UnimplementedSliverRPCServer) Netstat(context.Context, *sliverpb.NetstatReq) (*sliverpb.Netstat, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
 }
+func (UnimplementedSliverRPCServer) Scan(context.Context, *sliverpb.ScanReq) (*sliverpb.Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (UnimplementedSliverRPCServer) DNSResolvers(context.Context, *sliverpb.DNSResolversReq) (*sliverpb.DNSResolvers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DNSResolvers not implemented")
+}
+func (UnimplementedSliverRPCServer) EDREnum(context.Context, *sliverpb.EDREnumReq) (*sliverpb.EDREnum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EDREnum not implemented")
+}
+func (UnimplementedSliverRPCServer) Discover(context.Context, *sliverpb.DiscoverReq) (*sliverpb.Discover, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Discover not implemented")
+}
+func (UnimplementedSliverRPCServer) ADEnum(context.Context, *sliverpb.ADEnumReq) (*sliverpb.ADEnum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ADEnum not implemented")
+}
 func (UnimplementedSliverRPCServer) Ls(context.Context, *sliverpb.LsReq) (*sliverpb.Ls, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ls not implemented")
 }
@@ -2429,680 +3588,1661 @@ func (UnimplementedSliverRPCServer) PivotStartListener(context.Context, *sliverp
 func (UnimplementedSliverRPCServer) PivotStopListener(context.Context, *sliverpb.PivotStopListenerReq) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PivotStopListener not implemented")
 }
-func (UnimplementedSliverRPCServer) PivotSessionListeners(context.Context, *sliverpb.PivotListenersReq) (*sliverpb.PivotListeners, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PivotSessionListeners not implemented")
+func (UnimplementedSliverRPCServer) PivotSessionListeners(context.Context, *sliverpb.PivotListenersReq) (*sliverpb.PivotListeners, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PivotSessionListeners not implemented")
+}
+func (UnimplementedSliverRPCServer) PivotGraph(context.Context, *commonpb.Empty) (*clientpb.PivotGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PivotGraph not implemented")
+}
+func (UnimplementedSliverRPCServer) StartService(context.Context, *sliverpb.StartServiceReq) (*sliverpb.ServiceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartService not implemented")
+}
+func (UnimplementedSliverRPCServer) StopService(context.Context, *sliverpb.StopServiceReq) (*sliverpb.ServiceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopService not implemented")
+}
+func (UnimplementedSliverRPCServer) RemoveService(context.Context, *sliverpb.RemoveServiceReq) (*sliverpb.ServiceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+}
+func (UnimplementedSliverRPCServer) MakeToken(context.Context, *sliverpb.MakeTokenReq) (*sliverpb.MakeToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeToken not implemented")
+}
+func (UnimplementedSliverRPCServer) GetEnv(context.Context, *sliverpb.EnvReq) (*sliverpb.EnvInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnv not implemented")
+}
+func (UnimplementedSliverRPCServer) SetEnv(context.Context, *sliverpb.SetEnvReq) (*sliverpb.SetEnv, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnv not implemented")
+}
+func (UnimplementedSliverRPCServer) UnsetEnv(context.Context, *sliverpb.UnsetEnvReq) (*sliverpb.UnsetEnv, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetEnv not implemented")
+}
+func (UnimplementedSliverRPCServer) Backdoor(context.Context, *clientpb.BackdoorReq) (*clientpb.Backdoor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backdoor not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistryRead(context.Context, *sliverpb.RegistryReadReq) (*sliverpb.RegistryRead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryRead not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistryWrite(context.Context, *sliverpb.RegistryWriteReq) (*sliverpb.RegistryWrite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryWrite not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistryCreateKey(context.Context, *sliverpb.RegistryCreateKeyReq) (*sliverpb.RegistryCreateKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryCreateKey not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistryDeleteKey(context.Context, *sliverpb.RegistryDeleteKeyReq) (*sliverpb.RegistryDeleteKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryDeleteKey not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistryListSubKeys(context.Context, *sliverpb.RegistrySubKeyListReq) (*sliverpb.RegistrySubKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryListSubKeys not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistryListValues(context.Context, *sliverpb.RegistryListValuesReq) (*sliverpb.RegistryValuesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryListValues not implemented")
+}
+func (UnimplementedSliverRPCServer) RunSSHCommand(context.Context, *sliverpb.SSHCommandReq) (*sliverpb.SSHCommand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSSHCommand not implemented")
+}
+func (UnimplementedSliverRPCServer) HijackDLL(context.Context, *clientpb.DllHijackReq) (*clientpb.DllHijack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HijackDLL not implemented")
+}
+func (UnimplementedSliverRPCServer) GetPrivs(context.Context, *sliverpb.GetPrivsReq) (*sliverpb.GetPrivs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivs not implemented")
+}
+func (UnimplementedSliverRPCServer) StartRportFwdListener(context.Context, *sliverpb.RportFwdStartListenerReq) (*sliverpb.RportFwdListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRportFwdListener not implemented")
+}
+func (UnimplementedSliverRPCServer) GetRportFwdListeners(context.Context, *sliverpb.RportFwdListenersReq) (*sliverpb.RportFwdListeners, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRportFwdListeners not implemented")
+}
+func (UnimplementedSliverRPCServer) StopRportFwdListener(context.Context, *sliverpb.RportFwdStopListenerReq) (*sliverpb.RportFwdListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRportFwdListener not implemented")
+}
+func (UnimplementedSliverRPCServer) OpenSession(context.Context, *sliverpb.OpenSession) (*sliverpb.OpenSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (UnimplementedSliverRPCServer) CloseSession(context.Context, *sliverpb.CloseSession) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
+}
+func (UnimplementedSliverRPCServer) RegisterExtension(context.Context, *sliverpb.RegisterExtensionReq) (*sliverpb.RegisterExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterExtension not implemented")
+}
+func (UnimplementedSliverRPCServer) CallExtension(context.Context, *sliverpb.CallExtensionReq) (*sliverpb.CallExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallExtension not implemented")
+}
+func (UnimplementedSliverRPCServer) ListExtensions(context.Context, *sliverpb.ListExtensionsReq) (*sliverpb.ListExtensions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExtensions not implemented")
+}
+func (UnimplementedSliverRPCServer) RegisterWasmExtension(context.Context, *sliverpb.RegisterWasmExtensionReq) (*sliverpb.RegisterWasmExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWasmExtension not implemented")
+}
+func (UnimplementedSliverRPCServer) ListWasmExtensions(context.Context, *sliverpb.ListWasmExtensionsReq) (*sliverpb.ListWasmExtensions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWasmExtensions not implemented")
+}
+func (UnimplementedSliverRPCServer) ExecWasmExtension(context.Context, *sliverpb.ExecWasmExtensionReq) (*sliverpb.ExecWasmExtension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecWasmExtension not implemented")
+}
+func (UnimplementedSliverRPCServer) WGStartPortForward(context.Context, *sliverpb.WGPortForwardStartReq) (*sliverpb.WGPortForward, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGStartPortForward not implemented")
+}
+func (UnimplementedSliverRPCServer) WGStopPortForward(context.Context, *sliverpb.WGPortForwardStopReq) (*sliverpb.WGPortForward, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGStopPortForward not implemented")
+}
+func (UnimplementedSliverRPCServer) WGStartSocks(context.Context, *sliverpb.WGSocksStartReq) (*sliverpb.WGSocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGStartSocks not implemented")
+}
+func (UnimplementedSliverRPCServer) WGStopSocks(context.Context, *sliverpb.WGSocksStopReq) (*sliverpb.WGSocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGStopSocks not implemented")
+}
+func (UnimplementedSliverRPCServer) WGListForwarders(context.Context, *sliverpb.WGTCPForwardersReq) (*sliverpb.WGTCPForwarders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGListForwarders not implemented")
+}
+func (UnimplementedSliverRPCServer) WGListSocksServers(context.Context, *sliverpb.WGSocksServersReq) (*sliverpb.WGSocksServers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGListSocksServers not implemented")
+}
+func (UnimplementedSliverRPCServer) Shell(context.Context, *sliverpb.ShellReq) (*sliverpb.Shell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shell not implemented")
+}
+func (UnimplementedSliverRPCServer) ShellResize(context.Context, *sliverpb.ShellResizeReq) (*sliverpb.ShellResize, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellResize not implemented")
+}
+func (UnimplementedSliverRPCServer) ShellSignal(context.Context, *sliverpb.ShellSignalReq) (*sliverpb.ShellSignal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellSignal not implemented")
+}
+func (UnimplementedSliverRPCServer) Portfwd(context.Context, *sliverpb.PortfwdReq) (*sliverpb.Portfwd, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Portfwd not implemented")
+}
+func (UnimplementedSliverRPCServer) CreateSocks(context.Context, *sliverpb.Socks) (*sliverpb.Socks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSocks not implemented")
+}
+func (UnimplementedSliverRPCServer) CloseSocks(context.Context, *sliverpb.Socks) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSocks not implemented")
+}
+func (UnimplementedSliverRPCServer) SocksProxy(SliverRPC_SocksProxyServer) error {
+	return status.Errorf(codes.Unimplemented, "method SocksProxy not implemented")
+}
+func (UnimplementedSliverRPCServer) CreateTunnel(context.Context, *sliverpb.Tunnel) (*sliverpb.Tunnel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTunnel not implemented")
+}
+func (UnimplementedSliverRPCServer) CloseTunnel(context.Context, *sliverpb.Tunnel) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTunnel not implemented")
+}
+func (UnimplementedSliverRPCServer) TunnelData(SliverRPC_TunnelDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method TunnelData not implemented")
+}
+func (UnimplementedSliverRPCServer) Events(*commonpb.Empty, SliverRPC_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedSliverRPCServer) mustEmbedUnimplementedSliverRPCServer() {}
+
+// UnsafeSliverRPCServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SliverRPCServer will
+// result in compilation errors.
+type UnsafeSliverRPCServer interface {
+	mustEmbedUnimplementedSliverRPCServer()
+}
+
+func RegisterSliverRPCServer(s grpc.ServiceRegistrar, srv SliverRPCServer) {
+	s.RegisterService(&SliverRPC_ServiceDesc, srv)
+}
+
+func _SliverRPC_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetVersion(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ClientLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SliverRPCServer).ClientLog(&sliverRPCClientLogServer{stream})
+}
+
+type SliverRPC_ClientLogServer interface {
+	SendAndClose(*commonpb.Empty) error
+	Recv() (*clientpb.ClientLogData, error)
+	grpc.ServerStream
+}
+
+type sliverRPCClientLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *sliverRPCClientLogServer) SendAndClose(m *commonpb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sliverRPCClientLogServer) Recv() (*clientpb.ClientLogData, error) {
+	m := new(clientpb.ClientLogData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SliverRPC_GetOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetOperators(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) PivotGraph(context.Context, *commonpb.Empty) (*clientpb.PivotGraph, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PivotGraph not implemented")
+
+func _SliverRPC_SetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.PresenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).SetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SetPresence(ctx, req.(*clientpb.PresenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) StartService(context.Context, *sliverpb.StartServiceReq) (*sliverpb.ServiceInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartService not implemented")
+
+func _SliverRPC_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetPresence(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) StopService(context.Context, *sliverpb.StopServiceReq) (*sliverpb.ServiceInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopService not implemented")
+
+func _SliverRPC_LockTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.TargetLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).LockTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/LockTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).LockTarget(ctx, req.(*clientpb.TargetLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RemoveService(context.Context, *sliverpb.RemoveServiceReq) (*sliverpb.ServiceInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
+
+func _SliverRPC_UnlockTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.TargetLockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).UnlockTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/UnlockTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).UnlockTarget(ctx, req.(*clientpb.TargetLockReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) MakeToken(context.Context, *sliverpb.MakeTokenReq) (*sliverpb.MakeToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakeToken not implemented")
+
+func _SliverRPC_UpdateTargetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.TargetMetadataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).UpdateTargetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/UpdateTargetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).UpdateTargetMetadata(ctx, req.(*clientpb.TargetMetadataReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) GetEnv(context.Context, *sliverpb.EnvReq) (*sliverpb.EnvInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEnv not implemented")
+
+func _SliverRPC_AddTargetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.TargetHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).AddTargetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/AddTargetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).AddTargetHistory(ctx, req.(*clientpb.TargetHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) SetEnv(context.Context, *sliverpb.SetEnvReq) (*sliverpb.SetEnv, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetEnv not implemented")
+
+func _SliverRPC_GetTargetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.TargetHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetTargetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetTargetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetTargetHistory(ctx, req.(*clientpb.TargetHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) UnsetEnv(context.Context, *sliverpb.UnsetEnvReq) (*sliverpb.UnsetEnv, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsetEnv not implemented")
+
+func _SliverRPC_GetOpsecPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetOpsecPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetOpsecPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetOpsecPolicy(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) Backdoor(context.Context, *clientpb.BackdoorReq) (*clientpb.Backdoor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Backdoor not implemented")
+
+func _SliverRPC_Kill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.KillReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Kill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Kill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Kill(ctx, req.(*sliverpb.KillReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegistryRead(context.Context, *sliverpb.RegistryReadReq) (*sliverpb.RegistryRead, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistryRead not implemented")
+
+func _SliverRPC_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ReconfigureReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Reconfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Reconfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Reconfigure(ctx, req.(*sliverpb.ReconfigureReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegistryWrite(context.Context, *sliverpb.RegistryWriteReq) (*sliverpb.RegistryWrite, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistryWrite not implemented")
+
+func _SliverRPC_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.RenameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Rename(ctx, req.(*clientpb.RenameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegistryCreateKey(context.Context, *sliverpb.RegistryCreateKeyReq) (*sliverpb.RegistryCreateKey, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistryCreateKey not implemented")
+
+func _SliverRPC_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetSessions(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegistryDeleteKey(context.Context, *sliverpb.RegistryDeleteKeyReq) (*sliverpb.RegistryDeleteKey, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistryDeleteKey not implemented")
+
+func _SliverRPC_GetBeacons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetBeacons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetBeacons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetBeacons(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegistryListSubKeys(context.Context, *sliverpb.RegistrySubKeyListReq) (*sliverpb.RegistrySubKeyList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistryListSubKeys not implemented")
+
+func _SliverRPC_GetBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Beacon)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetBeacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetBeacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetBeacon(ctx, req.(*clientpb.Beacon))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegistryListValues(context.Context, *sliverpb.RegistryListValuesReq) (*sliverpb.RegistryValuesList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistryListValues not implemented")
+
+func _SliverRPC_RmBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Beacon)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).RmBeacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/RmBeacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).RmBeacon(ctx, req.(*clientpb.Beacon))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RunSSHCommand(context.Context, *sliverpb.SSHCommandReq) (*sliverpb.SSHCommand, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunSSHCommand not implemented")
+
+func _SliverRPC_GetBeaconTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Beacon)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetBeaconTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetBeaconTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetBeaconTasks(ctx, req.(*clientpb.Beacon))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) HijackDLL(context.Context, *clientpb.DllHijackReq) (*clientpb.DllHijack, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HijackDLL not implemented")
+
+func _SliverRPC_GetBeaconTaskContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.BeaconTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetBeaconTaskContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetBeaconTaskContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetBeaconTaskContent(ctx, req.(*clientpb.BeaconTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) GetPrivs(context.Context, *sliverpb.GetPrivsReq) (*sliverpb.GetPrivs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrivs not implemented")
+
+func _SliverRPC_CancelBeaconTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.BeaconTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).CancelBeaconTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/CancelBeaconTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).CancelBeaconTask(ctx, req.(*clientpb.BeaconTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) StartRportFwdListener(context.Context, *sliverpb.RportFwdStartListenerReq) (*sliverpb.RportFwdListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartRportFwdListener not implemented")
+
+func _SliverRPC_ReorderBeaconTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.BeaconTasks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ReorderBeaconTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ReorderBeaconTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ReorderBeaconTasks(ctx, req.(*clientpb.BeaconTasks))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) GetRportFwdListeners(context.Context, *sliverpb.RportFwdListenersReq) (*sliverpb.RportFwdListeners, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRportFwdListeners not implemented")
+
+func _SliverRPC_MonitorStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).MonitorStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/MonitorStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).MonitorStart(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) StopRportFwdListener(context.Context, *sliverpb.RportFwdStopListenerReq) (*sliverpb.RportFwdListener, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopRportFwdListener not implemented")
+
+func _SliverRPC_MonitorStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).MonitorStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/MonitorStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).MonitorStop(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) OpenSession(context.Context, *sliverpb.OpenSession) (*sliverpb.OpenSession, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+
+func _SliverRPC_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetJobs(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) CloseSession(context.Context, *sliverpb.CloseSession) (*commonpb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
+
+func _SliverRPC_KillJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.KillJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).KillJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/KillJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).KillJob(ctx, req.(*clientpb.KillJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegisterExtension(context.Context, *sliverpb.RegisterExtensionReq) (*sliverpb.RegisterExtension, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterExtension not implemented")
+
+func _SliverRPC_DrainJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.JobDrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).DrainJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/DrainJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).DrainJob(ctx, req.(*clientpb.JobDrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) CallExtension(context.Context, *sliverpb.CallExtensionReq) (*sliverpb.CallExtension, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CallExtension not implemented")
+
+func _SliverRPC_StartMTLSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.MTLSListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartMTLSListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartMTLSListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartMTLSListener(ctx, req.(*clientpb.MTLSListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) ListExtensions(context.Context, *sliverpb.ListExtensionsReq) (*sliverpb.ListExtensions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListExtensions not implemented")
+
+func _SliverRPC_StartExternalC2Listener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ExternalC2ListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartExternalC2Listener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartExternalC2Listener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartExternalC2Listener(ctx, req.(*clientpb.ExternalC2ListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) RegisterWasmExtension(context.Context, *sliverpb.RegisterWasmExtensionReq) (*sliverpb.RegisterWasmExtension, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterWasmExtension not implemented")
+
+func _SliverRPC_StartWGListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.WGListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartWGListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartWGListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartWGListener(ctx, req.(*clientpb.WGListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) ListWasmExtensions(context.Context, *sliverpb.ListWasmExtensionsReq) (*sliverpb.ListWasmExtensions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWasmExtensions not implemented")
+
+func _SliverRPC_StartDNSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.DNSListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartDNSListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartDNSListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartDNSListener(ctx, req.(*clientpb.DNSListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) ExecWasmExtension(context.Context, *sliverpb.ExecWasmExtensionReq) (*sliverpb.ExecWasmExtension, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecWasmExtension not implemented")
+
+func _SliverRPC_StartHTTPSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HTTPListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartHTTPSListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartHTTPSListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartHTTPSListener(ctx, req.(*clientpb.HTTPListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) WGStartPortForward(context.Context, *sliverpb.WGPortForwardStartReq) (*sliverpb.WGPortForward, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WGStartPortForward not implemented")
+
+func _SliverRPC_StartHTTPListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HTTPListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartHTTPListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartHTTPListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartHTTPListener(ctx, req.(*clientpb.HTTPListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) WGStopPortForward(context.Context, *sliverpb.WGPortForwardStopReq) (*sliverpb.WGPortForward, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WGStopPortForward not implemented")
+
+func _SliverRPC_ListenerProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ListenerProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ListenerProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ListenerProfiles(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) WGStartSocks(context.Context, *sliverpb.WGSocksStartReq) (*sliverpb.WGSocks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WGStartSocks not implemented")
+
+func _SliverRPC_SaveListenerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ListenerProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).SaveListenerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SaveListenerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SaveListenerProfile(ctx, req.(*clientpb.ListenerProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) WGStopSocks(context.Context, *sliverpb.WGSocksStopReq) (*sliverpb.WGSocks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WGStopSocks not implemented")
+
+func _SliverRPC_RmListenerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ListenerProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).RmListenerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/RmListenerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).RmListenerProfile(ctx, req.(*clientpb.ListenerProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) WGListForwarders(context.Context, *sliverpb.WGTCPForwardersReq) (*sliverpb.WGTCPForwarders, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WGListForwarders not implemented")
+
+func _SliverRPC_StartListenerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ListenerProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartListenerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartListenerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartListenerProfile(ctx, req.(*clientpb.ListenerProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) WGListSocksServers(context.Context, *sliverpb.WGSocksServersReq) (*sliverpb.WGSocksServers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WGListSocksServers not implemented")
+
+func _SliverRPC_StopListenerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ListenerProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StopListenerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StopListenerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StopListenerProfile(ctx, req.(*clientpb.ListenerProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) Shell(context.Context, *sliverpb.ShellReq) (*sliverpb.Shell, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Shell not implemented")
+
+func _SliverRPC_GetHTTPC2Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetHTTPC2Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetHTTPC2Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetHTTPC2Config(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) Portfwd(context.Context, *sliverpb.PortfwdReq) (*sliverpb.Portfwd, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Portfwd not implemented")
+
+func _SliverRPC_SaveHTTPC2Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HTTPC2Config)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).SaveHTTPC2Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SaveHTTPC2Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SaveHTTPC2Config(ctx, req.(*clientpb.HTTPC2Config))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) CreateSocks(context.Context, *sliverpb.Socks) (*sliverpb.Socks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSocks not implemented")
+
+func _SliverRPC_ReloadHTTPC2Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ReloadHTTPC2Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ReloadHTTPC2Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ReloadHTTPC2Config(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) CloseSocks(context.Context, *sliverpb.Socks) (*commonpb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseSocks not implemented")
+
+func _SliverRPC_GenerateRedirectorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.RedirectorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GenerateRedirectorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GenerateRedirectorConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GenerateRedirectorConfig(ctx, req.(*clientpb.RedirectorReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) SocksProxy(SliverRPC_SocksProxyServer) error {
-	return status.Errorf(codes.Unimplemented, "method SocksProxy not implemented")
+
+func _SliverRPC_ReloadServerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ReloadServerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ReloadServerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ReloadServerConfig(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) CreateTunnel(context.Context, *sliverpb.Tunnel) (*sliverpb.Tunnel, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTunnel not implemented")
+
+func _SliverRPC_StartCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.CaptureReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartCapture(ctx, req.(*clientpb.CaptureReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) CloseTunnel(context.Context, *sliverpb.Tunnel) (*commonpb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseTunnel not implemented")
+
+func _SliverRPC_StopCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StopCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StopCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StopCapture(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) TunnelData(SliverRPC_TunnelDataServer) error {
-	return status.Errorf(codes.Unimplemented, "method TunnelData not implemented")
+
+func _SliverRPC_GetCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.CaptureEntriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetCapture(ctx, req.(*clientpb.CaptureEntriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) Events(*commonpb.Empty, SliverRPC_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+
+func _SliverRPC_VerifyArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.File)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).VerifyArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/VerifyArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).VerifyArtifact(ctx, req.(*commonpb.File))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSliverRPCServer) mustEmbedUnimplementedSliverRPCServer() {}
 
-// UnsafeSliverRPCServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SliverRPCServer will
-// result in compilation errors.
-type UnsafeSliverRPCServer interface {
-	mustEmbedUnimplementedSliverRPCServer()
+func _SliverRPC_LookupArtifactMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ArtifactMarkerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).LookupArtifactMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/LookupArtifactMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).LookupArtifactMarker(ctx, req.(*clientpb.ArtifactMarkerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSliverRPCServer(s grpc.ServiceRegistrar, srv SliverRPCServer) {
-	s.RegisterService(&SliverRPC_ServiceDesc, srv)
+func _SliverRPC_ArmoryPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ArmoryPublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ArmoryPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ArmoryPublish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ArmoryPublish(ctx, req.(*clientpb.ArmoryPublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_ArmoryPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetVersion(ctx, in)
+		return srv.(SliverRPCServer).ArmoryPackages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetVersion",
+		FullMethod: "/rpcpb.SliverRPC/ArmoryPackages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetVersion(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).ArmoryPackages(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ClientLog_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SliverRPCServer).ClientLog(&sliverRPCClientLogServer{stream})
-}
-
-type SliverRPC_ClientLogServer interface {
-	SendAndClose(*commonpb.Empty) error
-	Recv() (*clientpb.ClientLogData, error)
-	grpc.ServerStream
+func _SliverRPC_ArmoryUnpublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ArmoryUnpublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ArmoryUnpublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ArmoryUnpublish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ArmoryUnpublish(ctx, req.(*clientpb.ArmoryUnpublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type sliverRPCClientLogServer struct {
-	grpc.ServerStream
+func _SliverRPC_StartArmoryListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ArmoryListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartArmoryListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartArmoryListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartArmoryListener(ctx, req.(*clientpb.ArmoryListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *sliverRPCClientLogServer) SendAndClose(m *commonpb.Empty) error {
-	return x.ServerStream.SendMsg(m)
+func _SliverRPC_StartTCPStagerListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.StagerListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartTCPStagerListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartTCPStagerListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartTCPStagerListener(ctx, req.(*clientpb.StagerListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *sliverRPCClientLogServer) Recv() (*clientpb.ClientLogData, error) {
-	m := new(clientpb.ClientLogData)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _SliverRPC_StartHTTPStagerListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.StagerListenerReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartHTTPStagerListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartHTTPStagerListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartHTTPStagerListener(ctx, req.(*clientpb.StagerListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_GetStageFetches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetOperators(ctx, in)
+		return srv.(SliverRPCServer).GetStageFetches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetOperators",
+		FullMethod: "/rpcpb.SliverRPC/GetStageFetches",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetOperators(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).GetStageFetches(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Kill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.KillReq)
+func _SliverRPC_LootAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Loot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Kill(ctx, in)
+		return srv.(SliverRPCServer).LootAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Kill",
+		FullMethod: "/rpcpb.SliverRPC/LootAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Kill(ctx, req.(*sliverpb.KillReq))
+		return srv.(SliverRPCServer).LootAdd(ctx, req.(*clientpb.Loot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ReconfigureReq)
+func _SliverRPC_LootRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Loot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Reconfigure(ctx, in)
+		return srv.(SliverRPCServer).LootRm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Reconfigure",
+		FullMethod: "/rpcpb.SliverRPC/LootRm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Reconfigure(ctx, req.(*sliverpb.ReconfigureReq))
+		return srv.(SliverRPCServer).LootRm(ctx, req.(*clientpb.Loot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.RenameReq)
+func _SliverRPC_LootUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Loot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Rename(ctx, in)
+		return srv.(SliverRPCServer).LootUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Rename",
+		FullMethod: "/rpcpb.SliverRPC/LootUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Rename(ctx, req.(*clientpb.RenameReq))
+		return srv.(SliverRPCServer).LootUpdate(ctx, req.(*clientpb.Loot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_LootContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Loot)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetSessions(ctx, in)
+		return srv.(SliverRPCServer).LootContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetSessions",
+		FullMethod: "/rpcpb.SliverRPC/LootContent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetSessions(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).LootContent(ctx, req.(*clientpb.Loot))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetBeacons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_LootAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetBeacons(ctx, in)
+		return srv.(SliverRPCServer).LootAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetBeacons",
+		FullMethod: "/rpcpb.SliverRPC/LootAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetBeacons(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).LootAll(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Beacon)
+func _SliverRPC_LootTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.LootTagReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetBeacon(ctx, in)
+		return srv.(SliverRPCServer).LootTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetBeacon",
+		FullMethod: "/rpcpb.SliverRPC/LootTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetBeacon(ctx, req.(*clientpb.Beacon))
+		return srv.(SliverRPCServer).LootTag(ctx, req.(*clientpb.LootTagReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RmBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Beacon)
+func _SliverRPC_LootSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.LootSearchReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RmBeacon(ctx, in)
+		return srv.(SliverRPCServer).LootSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RmBeacon",
+		FullMethod: "/rpcpb.SliverRPC/LootSearch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RmBeacon(ctx, req.(*clientpb.Beacon))
+		return srv.(SliverRPCServer).LootSearch(ctx, req.(*clientpb.LootSearchReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetBeaconTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Beacon)
+func _SliverRPC_LootExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.LootExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetBeaconTasks(ctx, in)
+		return srv.(SliverRPCServer).LootExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetBeaconTasks",
+		FullMethod: "/rpcpb.SliverRPC/LootExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetBeaconTasks(ctx, req.(*clientpb.Beacon))
+		return srv.(SliverRPCServer).LootExport(ctx, req.(*clientpb.LootExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetBeaconTaskContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.BeaconTask)
+func _SliverRPC_BloodHoundIngest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.BloodHoundIngestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetBeaconTaskContent(ctx, in)
+		return srv.(SliverRPCServer).BloodHoundIngest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetBeaconTaskContent",
+		FullMethod: "/rpcpb.SliverRPC/BloodHoundIngest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetBeaconTaskContent(ctx, req.(*clientpb.BeaconTask))
+		return srv.(SliverRPCServer).BloodHoundIngest(ctx, req.(*clientpb.BloodHoundIngestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_CancelBeaconTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.BeaconTask)
+func _SliverRPC_FederationLinkAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.FederationLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).CancelBeaconTask(ctx, in)
+		return srv.(SliverRPCServer).FederationLinkAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/CancelBeaconTask",
+		FullMethod: "/rpcpb.SliverRPC/FederationLinkAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).CancelBeaconTask(ctx, req.(*clientpb.BeaconTask))
+		return srv.(SliverRPCServer).FederationLinkAdd(ctx, req.(*clientpb.FederationLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MonitorStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_FederationLinkRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.FederationLinkReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MonitorStart(ctx, in)
+		return srv.(SliverRPCServer).FederationLinkRm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MonitorStart",
+		FullMethod: "/rpcpb.SliverRPC/FederationLinkRm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MonitorStart(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).FederationLinkRm(ctx, req.(*clientpb.FederationLinkReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MonitorStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_FederationLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MonitorStop(ctx, in)
+		return srv.(SliverRPCServer).FederationLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MonitorStop",
+		FullMethod: "/rpcpb.SliverRPC/FederationLinks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MonitorStop(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).FederationLinks(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_FederationView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.FederationReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetJobs(ctx, in)
+		return srv.(SliverRPCServer).FederationView(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetJobs",
+		FullMethod: "/rpcpb.SliverRPC/FederationView",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetJobs(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).FederationView(ctx, req.(*clientpb.FederationReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_KillJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.KillJobReq)
+func _SliverRPC_FederationLootContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.FederationLootReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).KillJob(ctx, in)
+		return srv.(SliverRPCServer).FederationLootContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/KillJob",
+		FullMethod: "/rpcpb.SliverRPC/FederationLootContent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).KillJob(ctx, req.(*clientpb.KillJobReq))
+		return srv.(SliverRPCServer).FederationLootContent(ctx, req.(*clientpb.FederationLootReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartMTLSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.MTLSListenerReq)
+func _SliverRPC_HASnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(clientpb.HASnapshotReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SliverRPCServer).HASnapshot(m, &sliverRPCHASnapshotServer{stream})
+}
+
+type SliverRPC_HASnapshotServer interface {
+	Send(*clientpb.HASnapshot) error
+	grpc.ServerStream
+}
+
+type sliverRPCHASnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *sliverRPCHASnapshotServer) Send(m *clientpb.HASnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SliverRPC_AutomationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartMTLSListener(ctx, in)
+		return srv.(SliverRPCServer).AutomationRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartMTLSListener",
+		FullMethod: "/rpcpb.SliverRPC/AutomationRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartMTLSListener(ctx, req.(*clientpb.MTLSListenerReq))
+		return srv.(SliverRPCServer).AutomationRules(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartWGListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.WGListenerReq)
+func _SliverRPC_AutomationRuleSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.AutomationRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartWGListener(ctx, in)
+		return srv.(SliverRPCServer).AutomationRuleSave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartWGListener",
+		FullMethod: "/rpcpb.SliverRPC/AutomationRuleSave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartWGListener(ctx, req.(*clientpb.WGListenerReq))
+		return srv.(SliverRPCServer).AutomationRuleSave(ctx, req.(*clientpb.AutomationRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartDNSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.DNSListenerReq)
+func _SliverRPC_AutomationRuleRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.AutomationRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartDNSListener(ctx, in)
+		return srv.(SliverRPCServer).AutomationRuleRm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartDNSListener",
+		FullMethod: "/rpcpb.SliverRPC/AutomationRuleRm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartDNSListener(ctx, req.(*clientpb.DNSListenerReq))
+		return srv.(SliverRPCServer).AutomationRuleRm(ctx, req.(*clientpb.AutomationRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartHTTPSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.HTTPListenerReq)
+func _SliverRPC_ScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartHTTPSListener(ctx, in)
+		return srv.(SliverRPCServer).ScheduledTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartHTTPSListener",
+		FullMethod: "/rpcpb.SliverRPC/ScheduledTasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartHTTPSListener(ctx, req.(*clientpb.HTTPListenerReq))
+		return srv.(SliverRPCServer).ScheduledTasks(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartHTTPListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.HTTPListenerReq)
+func _SliverRPC_ScheduledTaskSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ScheduledTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartHTTPListener(ctx, in)
+		return srv.(SliverRPCServer).ScheduledTaskSave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartHTTPListener",
+		FullMethod: "/rpcpb.SliverRPC/ScheduledTaskSave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartHTTPListener(ctx, req.(*clientpb.HTTPListenerReq))
+		return srv.(SliverRPCServer).ScheduledTaskSave(ctx, req.(*clientpb.ScheduledTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartTCPStagerListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.StagerListenerReq)
+func _SliverRPC_ScheduledTaskRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ScheduledTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartTCPStagerListener(ctx, in)
+		return srv.(SliverRPCServer).ScheduledTaskRm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartTCPStagerListener",
+		FullMethod: "/rpcpb.SliverRPC/ScheduledTaskRm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartTCPStagerListener(ctx, req.(*clientpb.StagerListenerReq))
+		return srv.(SliverRPCServer).ScheduledTaskRm(ctx, req.(*clientpb.ScheduledTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartHTTPStagerListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.StagerListenerReq)
+func _SliverRPC_ImplantGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartHTTPStagerListener(ctx, in)
+		return srv.(SliverRPCServer).ImplantGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartHTTPStagerListener",
+		FullMethod: "/rpcpb.SliverRPC/ImplantGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartHTTPStagerListener(ctx, req.(*clientpb.StagerListenerReq))
+		return srv.(SliverRPCServer).ImplantGroups(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_LootAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Loot)
+func _SliverRPC_ImplantGroupSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantGroup)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).LootAdd(ctx, in)
+		return srv.(SliverRPCServer).ImplantGroupSave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/LootAdd",
+		FullMethod: "/rpcpb.SliverRPC/ImplantGroupSave",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).LootAdd(ctx, req.(*clientpb.Loot))
+		return srv.(SliverRPCServer).ImplantGroupSave(ctx, req.(*clientpb.ImplantGroup))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_LootRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Loot)
+func _SliverRPC_ImplantGroupRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantGroup)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).LootRm(ctx, in)
+		return srv.(SliverRPCServer).ImplantGroupRm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/LootRm",
+		FullMethod: "/rpcpb.SliverRPC/ImplantGroupRm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).LootRm(ctx, req.(*clientpb.Loot))
+		return srv.(SliverRPCServer).ImplantGroupRm(ctx, req.(*clientpb.ImplantGroup))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_LootUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Loot)
+func _SliverRPC_ImplantGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantGroup)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).LootUpdate(ctx, in)
+		return srv.(SliverRPCServer).ImplantGroupMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/LootUpdate",
+		FullMethod: "/rpcpb.SliverRPC/ImplantGroupMembers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).LootUpdate(ctx, req.(*clientpb.Loot))
+		return srv.(SliverRPCServer).ImplantGroupMembers(ctx, req.(*clientpb.ImplantGroup))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_LootContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Loot)
+func _SliverRPC_ImplantGroupTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.GroupTaskReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).LootContent(ctx, in)
+		return srv.(SliverRPCServer).ImplantGroupTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/LootContent",
+		FullMethod: "/rpcpb.SliverRPC/ImplantGroupTask",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).LootContent(ctx, req.(*clientpb.Loot))
+		return srv.(SliverRPCServer).ImplantGroupTask(ctx, req.(*clientpb.GroupTaskReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_LootAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_GetRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.RecordingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetRecordings(ctx, req.(*clientpb.RecordingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.RecordingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).LootAll(ctx, in)
+		return srv.(SliverRPCServer).GetRecording(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/LootAll",
+		FullMethod: "/rpcpb.SliverRPC/GetRecording",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).LootAll(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).GetRecording(ctx, req.(*clientpb.RecordingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3287,6 +5427,96 @@ func _SliverRPC_Host_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_HostDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HostDetailsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).HostDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/HostDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).HostDetails(ctx, req.(*clientpb.HostDetailsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_HostTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Host)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).HostTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/HostTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).HostTimeline(ctx, req.(*clientpb.Host))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_EngagementExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.EngagementExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).EngagementExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/EngagementExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).EngagementExport(ctx, req.(*clientpb.EngagementExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_AttackCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.AttackCoverageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).AttackCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/AttackCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).AttackCoverage(ctx, req.(*clientpb.AttackCoverageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Preflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.PreflightReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Preflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Preflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Preflight(ctx, req.(*clientpb.PreflightReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_HostRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(clientpb.Host)
 	if err := dec(in); err != nil {
@@ -3323,6 +5553,60 @@ func _SliverRPC_HostIOCRm_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_GetScanResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ScanResultsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetScanResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetScanResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetScanResults(ctx, req.(*clientpb.ScanResultsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetDiscoveredHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.DiscoveredHostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetDiscoveredHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetDiscoveredHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetDiscoveredHosts(ctx, req.(*clientpb.DiscoveredHostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetHostProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HostProcessesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetHostProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetHostProcesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetHostProcesses(ctx, req.(*clientpb.HostProcessesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(clientpb.GenerateReq)
 	if err := dec(in); err != nil {
@@ -3452,6 +5736,60 @@ func _SliverRPC_Builders_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_Toolchains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Toolchains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Toolchains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Toolchains(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ToolchainInstall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ToolchainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ToolchainInstall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ToolchainInstall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ToolchainInstall(ctx, req.(*clientpb.ToolchainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ToolchainRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ToolchainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ToolchainRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ToolchainRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ToolchainRemove(ctx, req.(*clientpb.ToolchainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_CrackstationRegister_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(clientpb.Crackstation)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3689,6 +6027,42 @@ func _SliverRPC_Regenerate_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_GenerateProvisioningBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ProvisioningBundleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GenerateProvisioningBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GenerateProvisioningBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GenerateProvisioningBundle(ctx, req.(*clientpb.ProvisioningBundleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_VerifyImplantBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantBuildVerifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).VerifyImplantBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/VerifyImplantBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).VerifyImplantBuild(ctx, req.(*clientpb.ImplantBuildVerifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_ImplantBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
@@ -3725,6 +6099,24 @@ func _SliverRPC_DeleteImplantBuild_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_BurnImplant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.BurnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).BurnImplant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/BurnImplant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).BurnImplant(ctx, req.(*clientpb.BurnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_Canaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
@@ -3743,6 +6135,60 @@ func _SliverRPC_Canaries_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_CanaryTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).CanaryTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/CanaryTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).CanaryTokens(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_CanaryTokenNew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.CanaryTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).CanaryTokenNew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/CanaryTokenNew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).CanaryTokenNew(ctx, req.(*clientpb.CanaryTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_CanaryTokenRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.CanaryToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).CanaryTokenRm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/CanaryTokenRm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).CanaryTokenRm(ctx, req.(*clientpb.CanaryToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_GenerateWGClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
@@ -3821,14 +6267,50 @@ func _SliverRPC_SaveImplantProfile_Handler(srv interface{}, ctx context.Context,
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).SaveImplantProfile(ctx, in)
+		return srv.(SliverRPCServer).SaveImplantProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SaveImplantProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SaveImplantProfile(ctx, req.(*clientpb.ImplantProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetImplantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetImplantProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetImplantProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetImplantProfile(ctx, req.(*clientpb.ImplantProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ImplantProfileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ImplantProfileHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/SaveImplantProfile",
+		FullMethod: "/rpcpb.SliverRPC/ImplantProfileHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).SaveImplantProfile(ctx, req.(*clientpb.ImplantProfile))
+		return srv.(SliverRPCServer).ImplantProfileHistory(ctx, req.(*clientpb.ImplantProfileReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4175,6 +6657,96 @@ func _SliverRPC_Netstat_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ScanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Scan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Scan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Scan(ctx, req.(*sliverpb.ScanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_DNSResolvers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.DNSResolversReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).DNSResolvers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/DNSResolvers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).DNSResolvers(ctx, req.(*sliverpb.DNSResolversReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_EDREnum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.EDREnumReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).EDREnum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/EDREnum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).EDREnum(ctx, req.(*sliverpb.EDREnumReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Discover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.DiscoverReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Discover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Discover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Discover(ctx, req.(*sliverpb.DiscoverReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ADEnum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ADEnumReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ADEnum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ADEnum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ADEnum(ctx, req.(*sliverpb.ADEnumReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_Ls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(sliverpb.LsReq)
 	if err := dec(in); err != nil {
@@ -5435,6 +8007,42 @@ func _SliverRPC_Shell_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_ShellResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ShellResizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ShellResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ShellResize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ShellResize(ctx, req.(*sliverpb.ShellResizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ShellSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ShellSignalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ShellSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ShellSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ShellSignal(ctx, req.(*sliverpb.ShellSignalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_Portfwd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(sliverpb.PortfwdReq)
 	if err := dec(in); err != nil {
@@ -5613,6 +8221,38 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOperators",
 			Handler:    _SliverRPC_GetOperators_Handler,
 		},
+		{
+			MethodName: "SetPresence",
+			Handler:    _SliverRPC_SetPresence_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _SliverRPC_GetPresence_Handler,
+		},
+		{
+			MethodName: "LockTarget",
+			Handler:    _SliverRPC_LockTarget_Handler,
+		},
+		{
+			MethodName: "UnlockTarget",
+			Handler:    _SliverRPC_UnlockTarget_Handler,
+		},
+		{
+			MethodName: "UpdateTargetMetadata",
+			Handler:    _SliverRPC_UpdateTargetMetadata_Handler,
+		},
+		{
+			MethodName: "AddTargetHistory",
+			Handler:    _SliverRPC_AddTargetHistory_Handler,
+		},
+		{
+			MethodName: "GetTargetHistory",
+			Handler:    _SliverRPC_GetTargetHistory_Handler,
+		},
+		{
+			MethodName: "GetOpsecPolicy",
+			Handler:    _SliverRPC_GetOpsecPolicy_Handler,
+		},
 		{
 			MethodName: "Kill",
 			Handler:    _SliverRPC_Kill_Handler,
@@ -5653,6 +8293,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBeaconTask",
 			Handler:    _SliverRPC_CancelBeaconTask_Handler,
 		},
+		{
+			MethodName: "ReorderBeaconTasks",
+			Handler:    _SliverRPC_ReorderBeaconTasks_Handler,
+		},
 		{
 			MethodName: "MonitorStart",
 			Handler:    _SliverRPC_MonitorStart_Handler,
@@ -5669,10 +8313,18 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KillJob",
 			Handler:    _SliverRPC_KillJob_Handler,
 		},
+		{
+			MethodName: "DrainJob",
+			Handler:    _SliverRPC_DrainJob_Handler,
+		},
 		{
 			MethodName: "StartMTLSListener",
 			Handler:    _SliverRPC_StartMTLSListener_Handler,
 		},
+		{
+			MethodName: "StartExternalC2Listener",
+			Handler:    _SliverRPC_StartExternalC2Listener_Handler,
+		},
 		{
 			MethodName: "StartWGListener",
 			Handler:    _SliverRPC_StartWGListener_Handler,
@@ -5689,6 +8341,82 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartHTTPListener",
 			Handler:    _SliverRPC_StartHTTPListener_Handler,
 		},
+		{
+			MethodName: "ListenerProfiles",
+			Handler:    _SliverRPC_ListenerProfiles_Handler,
+		},
+		{
+			MethodName: "SaveListenerProfile",
+			Handler:    _SliverRPC_SaveListenerProfile_Handler,
+		},
+		{
+			MethodName: "RmListenerProfile",
+			Handler:    _SliverRPC_RmListenerProfile_Handler,
+		},
+		{
+			MethodName: "StartListenerProfile",
+			Handler:    _SliverRPC_StartListenerProfile_Handler,
+		},
+		{
+			MethodName: "StopListenerProfile",
+			Handler:    _SliverRPC_StopListenerProfile_Handler,
+		},
+		{
+			MethodName: "GetHTTPC2Config",
+			Handler:    _SliverRPC_GetHTTPC2Config_Handler,
+		},
+		{
+			MethodName: "SaveHTTPC2Config",
+			Handler:    _SliverRPC_SaveHTTPC2Config_Handler,
+		},
+		{
+			MethodName: "ReloadHTTPC2Config",
+			Handler:    _SliverRPC_ReloadHTTPC2Config_Handler,
+		},
+		{
+			MethodName: "GenerateRedirectorConfig",
+			Handler:    _SliverRPC_GenerateRedirectorConfig_Handler,
+		},
+		{
+			MethodName: "ReloadServerConfig",
+			Handler:    _SliverRPC_ReloadServerConfig_Handler,
+		},
+		{
+			MethodName: "StartCapture",
+			Handler:    _SliverRPC_StartCapture_Handler,
+		},
+		{
+			MethodName: "StopCapture",
+			Handler:    _SliverRPC_StopCapture_Handler,
+		},
+		{
+			MethodName: "GetCapture",
+			Handler:    _SliverRPC_GetCapture_Handler,
+		},
+		{
+			MethodName: "VerifyArtifact",
+			Handler:    _SliverRPC_VerifyArtifact_Handler,
+		},
+		{
+			MethodName: "LookupArtifactMarker",
+			Handler:    _SliverRPC_LookupArtifactMarker_Handler,
+		},
+		{
+			MethodName: "ArmoryPublish",
+			Handler:    _SliverRPC_ArmoryPublish_Handler,
+		},
+		{
+			MethodName: "ArmoryPackages",
+			Handler:    _SliverRPC_ArmoryPackages_Handler,
+		},
+		{
+			MethodName: "ArmoryUnpublish",
+			Handler:    _SliverRPC_ArmoryUnpublish_Handler,
+		},
+		{
+			MethodName: "StartArmoryListener",
+			Handler:    _SliverRPC_StartArmoryListener_Handler,
+		},
 		{
 			MethodName: "StartTCPStagerListener",
 			Handler:    _SliverRPC_StartTCPStagerListener_Handler,
@@ -5697,6 +8425,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartHTTPStagerListener",
 			Handler:    _SliverRPC_StartHTTPStagerListener_Handler,
 		},
+		{
+			MethodName: "GetStageFetches",
+			Handler:    _SliverRPC_GetStageFetches_Handler,
+		},
 		{
 			MethodName: "LootAdd",
 			Handler:    _SliverRPC_LootAdd_Handler,
@@ -5717,6 +8449,94 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LootAll",
 			Handler:    _SliverRPC_LootAll_Handler,
 		},
+		{
+			MethodName: "LootTag",
+			Handler:    _SliverRPC_LootTag_Handler,
+		},
+		{
+			MethodName: "LootSearch",
+			Handler:    _SliverRPC_LootSearch_Handler,
+		},
+		{
+			MethodName: "LootExport",
+			Handler:    _SliverRPC_LootExport_Handler,
+		},
+		{
+			MethodName: "BloodHoundIngest",
+			Handler:    _SliverRPC_BloodHoundIngest_Handler,
+		},
+		{
+			MethodName: "FederationLinkAdd",
+			Handler:    _SliverRPC_FederationLinkAdd_Handler,
+		},
+		{
+			MethodName: "FederationLinkRm",
+			Handler:    _SliverRPC_FederationLinkRm_Handler,
+		},
+		{
+			MethodName: "FederationLinks",
+			Handler:    _SliverRPC_FederationLinks_Handler,
+		},
+		{
+			MethodName: "FederationView",
+			Handler:    _SliverRPC_FederationView_Handler,
+		},
+		{
+			MethodName: "FederationLootContent",
+			Handler:    _SliverRPC_FederationLootContent_Handler,
+		},
+		{
+			MethodName: "AutomationRules",
+			Handler:    _SliverRPC_AutomationRules_Handler,
+		},
+		{
+			MethodName: "AutomationRuleSave",
+			Handler:    _SliverRPC_AutomationRuleSave_Handler,
+		},
+		{
+			MethodName: "AutomationRuleRm",
+			Handler:    _SliverRPC_AutomationRuleRm_Handler,
+		},
+		{
+			MethodName: "ScheduledTasks",
+			Handler:    _SliverRPC_ScheduledTasks_Handler,
+		},
+		{
+			MethodName: "ScheduledTaskSave",
+			Handler:    _SliverRPC_ScheduledTaskSave_Handler,
+		},
+		{
+			MethodName: "ScheduledTaskRm",
+			Handler:    _SliverRPC_ScheduledTaskRm_Handler,
+		},
+		{
+			MethodName: "ImplantGroups",
+			Handler:    _SliverRPC_ImplantGroups_Handler,
+		},
+		{
+			MethodName: "ImplantGroupSave",
+			Handler:    _SliverRPC_ImplantGroupSave_Handler,
+		},
+		{
+			MethodName: "ImplantGroupRm",
+			Handler:    _SliverRPC_ImplantGroupRm_Handler,
+		},
+		{
+			MethodName: "ImplantGroupMembers",
+			Handler:    _SliverRPC_ImplantGroupMembers_Handler,
+		},
+		{
+			MethodName: "ImplantGroupTask",
+			Handler:    _SliverRPC_ImplantGroupTask_Handler,
+		},
+		{
+			MethodName: "GetRecordings",
+			Handler:    _SliverRPC_GetRecordings_Handler,
+		},
+		{
+			MethodName: "GetRecording",
+			Handler:    _SliverRPC_GetRecording_Handler,
+		},
 		{
 			MethodName: "Creds",
 			Handler:    _SliverRPC_Creds_Handler,
@@ -5757,6 +8577,26 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Host",
 			Handler:    _SliverRPC_Host_Handler,
 		},
+		{
+			MethodName: "HostDetails",
+			Handler:    _SliverRPC_HostDetails_Handler,
+		},
+		{
+			MethodName: "HostTimeline",
+			Handler:    _SliverRPC_HostTimeline_Handler,
+		},
+		{
+			MethodName: "EngagementExport",
+			Handler:    _SliverRPC_EngagementExport_Handler,
+		},
+		{
+			MethodName: "AttackCoverage",
+			Handler:    _SliverRPC_AttackCoverage_Handler,
+		},
+		{
+			MethodName: "Preflight",
+			Handler:    _SliverRPC_Preflight_Handler,
+		},
 		{
 			MethodName: "HostRm",
 			Handler:    _SliverRPC_HostRm_Handler,
@@ -5765,6 +8605,18 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HostIOCRm",
 			Handler:    _SliverRPC_HostIOCRm_Handler,
 		},
+		{
+			MethodName: "GetScanResults",
+			Handler:    _SliverRPC_GetScanResults_Handler,
+		},
+		{
+			MethodName: "GetDiscoveredHosts",
+			Handler:    _SliverRPC_GetDiscoveredHosts_Handler,
+		},
+		{
+			MethodName: "GetHostProcesses",
+			Handler:    _SliverRPC_GetHostProcesses_Handler,
+		},
 		{
 			MethodName: "Generate",
 			Handler:    _SliverRPC_Generate_Handler,
@@ -5789,6 +8641,18 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Builders",
 			Handler:    _SliverRPC_Builders_Handler,
 		},
+		{
+			MethodName: "Toolchains",
+			Handler:    _SliverRPC_Toolchains_Handler,
+		},
+		{
+			MethodName: "ToolchainInstall",
+			Handler:    _SliverRPC_ToolchainInstall_Handler,
+		},
+		{
+			MethodName: "ToolchainRemove",
+			Handler:    _SliverRPC_ToolchainRemove_Handler,
+		},
 		{
 			MethodName: "CrackstationTrigger",
 			Handler:    _SliverRPC_CrackstationTrigger_Handler,
@@ -5837,6 +8701,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Regenerate",
 			Handler:    _SliverRPC_Regenerate_Handler,
 		},
+		{
+			MethodName: "GenerateProvisioningBundle",
+			Handler:    _SliverRPC_GenerateProvisioningBundle_Handler,
+		},
+		{
+			MethodName: "VerifyImplantBuild",
+			Handler:    _SliverRPC_VerifyImplantBuild_Handler,
+		},
 		{
 			MethodName: "ImplantBuilds",
 			Handler:    _SliverRPC_ImplantBuilds_Handler,
@@ -5845,10 +8717,26 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteImplantBuild",
 			Handler:    _SliverRPC_DeleteImplantBuild_Handler,
 		},
+		{
+			MethodName: "BurnImplant",
+			Handler:    _SliverRPC_BurnImplant_Handler,
+		},
 		{
 			MethodName: "Canaries",
 			Handler:    _SliverRPC_Canaries_Handler,
 		},
+		{
+			MethodName: "CanaryTokens",
+			Handler:    _SliverRPC_CanaryTokens_Handler,
+		},
+		{
+			MethodName: "CanaryTokenNew",
+			Handler:    _SliverRPC_CanaryTokenNew_Handler,
+		},
+		{
+			MethodName: "CanaryTokenRm",
+			Handler:    _SliverRPC_CanaryTokenRm_Handler,
+		},
 		{
 			MethodName: "GenerateWGClientConfig",
 			Handler:    _SliverRPC_GenerateWGClientConfig_Handler,
@@ -5869,6 +8757,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveImplantProfile",
 			Handler:    _SliverRPC_SaveImplantProfile_Handler,
 		},
+		{
+			MethodName: "GetImplantProfile",
+			Handler:    _SliverRPC_GetImplantProfile_Handler,
+		},
+		{
+			MethodName: "ImplantProfileHistory",
+			Handler:    _SliverRPC_ImplantProfileHistory_Handler,
+		},
 		{
 			MethodName: "MsfStage",
 			Handler:    _SliverRPC_MsfStage_Handler,
@@ -5945,6 +8841,26 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Netstat",
 			Handler:    _SliverRPC_Netstat_Handler,
 		},
+		{
+			MethodName: "Scan",
+			Handler:    _SliverRPC_Scan_Handler,
+		},
+		{
+			MethodName: "DNSResolvers",
+			Handler:    _SliverRPC_DNSResolvers_Handler,
+		},
+		{
+			MethodName: "EDREnum",
+			Handler:    _SliverRPC_EDREnum_Handler,
+		},
+		{
+			MethodName: "Discover",
+			Handler:    _SliverRPC_Discover_Handler,
+		},
+		{
+			MethodName: "ADEnum",
+			Handler:    _SliverRPC_ADEnum_Handler,
+		},
 		{
 			MethodName: "Ls",
 			Handler:    _SliverRPC_Ls_Handler,
@@ -6225,6 +9141,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Shell",
 			Handler:    _SliverRPC_Shell_Handler,
 		},
+		{
+			MethodName: "ShellResize",
+			Handler:    _SliverRPC_ShellResize_Handler,
+		},
+		{
+			MethodName: "ShellSignal",
+			Handler:    _SliverRPC_ShellSignal_Handler,
+		},
 		{
 			MethodName: "Portfwd",
 			Handler:    _SliverRPC_Portfwd_Handler,
@@ -6252,6 +9176,11 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SliverRPC_ClientLog_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "HASnapshot",
+			Handler:       _SliverRPC_HASnapshot_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "BuilderRegister",
 			Handler:       _SliverRPC_BuilderRegister_Handler,
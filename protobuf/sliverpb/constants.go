@@ -339,6 +339,160 @@ const (
 	// MsgCp - Confirms the success/failure, as well as the total number of bytes
 	// written of the cp request (resp to MsgCpReq)
 	MsgCp
+
+	// MsgShellResizeReq - Request to resize a shell's PTY
+	MsgShellResizeReq
+	// MsgShellSignalReq - Request to send a signal to a shell's process
+	MsgShellSignalReq
+
+	// MsgScanReq - Request a TCP connect scan
+	MsgScanReq
+
+	// MsgDNSResolversReq - Request to set the implant's dns resolvers
+	MsgDNSResolversReq
+	// MsgDNSExchange - A dns message the implant resolves through the server,
+	// and the server's reply
+	MsgDNSExchange
+
+	// MsgTaskOutput - Partial output of a streamed task
+	MsgTaskOutput
+
+	// MsgChunk - Part of a large envelope
+	MsgChunk
+	// MsgChunkAck - Acknowledges the chunks of a transfer
+	MsgChunkAck
+
+	// MsgEDREnumReq - Request to enumerate the AV/EDR products
+	MsgEDREnumReq
+
+	// MsgDiscoverReq - Request network discovery
+	MsgDiscoverReq
+
+	// MsgADEnumReq - Request an Active Directory query
+	MsgADEnumReq
+)
+
+// Implant protocol versions, ProtocolVersion is incremented when a message the
+// implant and server exchange changes incompatibly, or the server has to know if
+// the implant supports something before using it. Adding messages and fields
+// doesn't change it, implants reply to messages they don't know as unknown.
+const (
+	// ProtocolVersion - The protocol version of this build
+	ProtocolVersion = 2
+	// MinProtocolVersion - The oldest implant protocol version the server supports,
+	// implants that predate protocol versioning are version 0
+	MinProtocolVersion = 0
+
+	// ChunkedProtocolVersion - The first protocol version with chunked transfers
+	ChunkedProtocolVersion = 2
+)
+
+// Chunked transfers, envelopes with more data than ChunkSize are sent as MsgChunk
+// envelopes and reassembled by the receiver, which acknowledges every chunk
+const (
+	// ChunkSize - Size of every chunk but the last
+	ChunkSize = 1024 * 1024
+	// ChunkWindow - Max chunks of a transfer that are sent but not acknowledged
+	ChunkWindow = 16
+	// MaxChunkedSize - Largest envelope data that can be chunked
+	MaxChunkedSize = (2 * 1024 * 1024 * 1024) - 1
+)
+
+// Envelope compression, the data of envelopes larger than CompressionThreshold is
+// zstd compressed when the receiver accepts compressed envelopes, implants accept
+// them if they were generated with compression and tell the server when they register
+const (
+	// CompressionThreshold - Smallest envelope data that is compressed
+	CompressionThreshold = 1024
+	// MaxDecompressedSize - Largest data a compressed envelope can decompress to
+	MaxDecompressedSize = MaxChunkedSize
+)
+
+// Session key exchange algorithms, implants with a hybrid key exchange prefix the
+// age encrypted session key with the algorithm ID and a KEM ciphertext so a session
+// key is only recoverable by breaking both X25519 and the KEM. Unlike legacy implants
+// (no algorithm) both algorithms timestamp the encrypted plaintext
+const (
+	// KeyExchangeX25519 - Age (X25519) key exchange only
+	KeyExchangeX25519 = "x25519"
+	// KeyExchangeX25519MLKEM768 - Age (X25519) key exchange combined with ML-KEM-768
+	KeyExchangeX25519MLKEM768 = "x25519-mlkem768"
+
+	// KeyExchangeX25519MLKEM768ID - Handshake algorithm ID of KeyExchangeX25519MLKEM768
+	KeyExchangeX25519MLKEM768ID = 1
+
+	// DefaultKeyExchange - Key exchange of newly generated implants
+	DefaultKeyExchange = KeyExchangeX25519MLKEM768
+)
+
+// Where an implant with an encrypted config (RuntimeConfig) gets the key from
+const (
+	// ConfigKeyFromArg - The last command line argument
+	ConfigKeyFromArg = "arg"
+	// ConfigKeyFromEnv - An environment variable
+	ConfigKeyFromEnv = "env"
+	// ConfigKeyFromFile - The contents of a file
+	ConfigKeyFromFile = "file"
+	// ConfigKeyFromURL - The body of an http(s) GET request
+	ConfigKeyFromURL = "url"
+	// ConfigKeyFromBundle - The key is embedded, the encrypted config is a provisioning
+	// bundle appended to the executable
+	ConfigKeyFromBundle = "bundle"
+)
+
+// Prefixes of the persistence an implant removes when its dead man's switch
+// triggers, the remainder of each cleanup entry is the path
+const (
+	// DeadManCleanupFile - Delete a file
+	DeadManCleanupFile = "file:"
+	// DeadManCleanupRegistry - Delete a registry value (HKCU\ or HKLM\ key path + value name)
+	DeadManCleanupRegistry = "reg:"
+	// DeadManCleanupSelf - Delete the implant's own executable
+	DeadManCleanupSelf = "self"
+)
+
+// Kinds of EDRComponent, what the evidence of a security product is
+const (
+	// EDRKindService - An installed service
+	EDRKindService = "service"
+	// EDRKindDriver - An installed kernel or file system driver
+	EDRKindDriver = "driver"
+	// EDRKindProcess - A running process
+	EDRKindProcess = "process"
+	// EDRKindModule - A library loaded into the implant's process (or kernel module on Linux)
+	EDRKindModule = "module"
+	// EDRKindFile - An install path
+	EDRKindFile = "file"
+	// EDRKindWFP - The wfp filters of a provider
+	EDRKindWFP = "wfp-filter"
+	// EDRKindHook - A function of the implant's process that was modified in memory,
+	// or a library that is preloaded into every process
+	EDRKindHook = "hook"
+)
+
+// Queries of ADEnumReq
+const (
+	// ADQueryUsers - User accounts
+	ADQueryUsers = "users"
+	// ADQueryGroups - Groups and their members
+	ADQueryGroups = "groups"
+	// ADQuerySPNs - User accounts with service principal names (kerberoastable)
+	ADQuerySPNs = "spns"
+	// ADQueryTrusts - Trusts of the domain
+	ADQueryTrusts = "trusts"
+	// ADQueryGPOs - Group policy objects
+	ADQueryGPOs = "gpos"
+	// ADQueryDelegation - Accounts trusted for unconstrained delegation, other than
+	// domain controllers
+	ADQueryDelegation = "delegation"
+)
+
+// C cross-compilers of cgo builds, blank uses the first one found
+const (
+	// CCBackendSystem - mingw, osxcross or musl-cross (or the SLIVER_*_CC_* env vars)
+	CCBackendSystem = "system"
+	// CCBackendZig - zig cc, installed by the server if needed
+	CCBackendZig = "zig"
 )
 
 // Constants to replace enums
@@ -602,6 +756,36 @@ func MsgNumber(request proto.Message) uint32 {
 	case *ExecWasmExtensionReq:
 		return MsgExecWasmExtensionReq
 
+	case *ShellResizeReq:
+		return MsgShellResizeReq
+	case *ShellSignalReq:
+		return MsgShellSignalReq
+
+	case *ScanReq:
+		return MsgScanReq
+
+	case *DNSResolversReq:
+		return MsgDNSResolversReq
+	case *DNSExchange:
+		return MsgDNSExchange
+
+	case *TaskOutput:
+		return MsgTaskOutput
+
+	case *Chunk:
+		return MsgChunk
+	case *ChunkAck:
+		return MsgChunkAck
+
+	case *EDREnumReq:
+		return MsgEDREnumReq
+
+	case *DiscoverReq:
+		return MsgDiscoverReq
+
+	case *ADEnumReq:
+		return MsgADEnumReq
+
 	}
 	return uint32(0)
 }
@@ -184,6 +184,7 @@ type Envelope struct {
 	Type               uint32 `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`                             // Message type
 	Data               []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`                              // Actual message data
 	UnknownMessageType bool   `protobuf:"varint,4,opt,name=UnknownMessageType,proto3" json:"UnknownMessageType,omitempty"` // Set if the implant did not understand the message
+	Compressed         bool   `protobuf:"varint,5,opt,name=Compressed,proto3" json:"Compressed,omitempty"`                 // Set if Data is zstd compressed
 }
 
 func (x *Envelope) Reset() {
@@ -246,6 +247,167 @@ func (x *Envelope) GetUnknownMessageType() bool {
 	return false
 }
 
+func (x *Envelope) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+// Chunk - Part of an envelope whose data is larger than ChunkSize, every chunk
+// but the last has exactly ChunkSize bytes
+type Chunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferID   uint64 `protobuf:"varint,1,opt,name=TransferID,proto3" json:"TransferID,omitempty"`
+	Index        uint32 `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	Size         uint64 `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"` // Size of the envelope's data
+	EnvelopeID   int64  `protobuf:"varint,4,opt,name=EnvelopeID,proto3" json:"EnvelopeID,omitempty"`
+	EnvelopeType uint32 `protobuf:"varint,5,opt,name=EnvelopeType,proto3" json:"EnvelopeType,omitempty"`
+	Data         []byte `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *Chunk) Reset() {
+	*x = Chunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chunk) ProtoMessage() {}
+
+func (x *Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Chunk) GetTransferID() uint64 {
+	if x != nil {
+		return x.TransferID
+	}
+	return 0
+}
+
+func (x *Chunk) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Chunk) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Chunk) GetEnvelopeID() int64 {
+	if x != nil {
+		return x.EnvelopeID
+	}
+	return 0
+}
+
+func (x *Chunk) GetEnvelopeType() uint32 {
+	if x != nil {
+		return x.EnvelopeType
+	}
+	return 0
+}
+
+func (x *Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ChunkAck - The number of a transfer's chunks the receiver has, the sender
+// never has more than ChunkWindow chunks in flight. Cancel aborts the transfer.
+type ChunkAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferID uint64 `protobuf:"varint,1,opt,name=TransferID,proto3" json:"TransferID,omitempty"`
+	Received   uint32 `protobuf:"varint,2,opt,name=Received,proto3" json:"Received,omitempty"`
+	Cancel     bool   `protobuf:"varint,3,opt,name=Cancel,proto3" json:"Cancel,omitempty"`
+}
+
+func (x *ChunkAck) Reset() {
+	*x = ChunkAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkAck) ProtoMessage() {}
+
+func (x *ChunkAck) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkAck.ProtoReflect.Descriptor instead.
+func (*ChunkAck) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChunkAck) GetTransferID() uint64 {
+	if x != nil {
+		return x.TransferID
+	}
+	return 0
+}
+
+func (x *ChunkAck) GetReceived() uint32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ChunkAck) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
 // BeaconTasks - DO NOT CONFUSE WITH clientpb.BeaconTasks
 type BeaconTasks struct {
 	state         protoimpl.MessageState
@@ -255,12 +417,15 @@ type BeaconTasks struct {
 	ID          string      `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Tasks       []*Envelope `protobuf:"bytes,2,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
 	NextCheckin int64       `protobuf:"varint,3,opt,name=NextCheckin,proto3" json:"NextCheckin,omitempty"`
+	Compression bool        `protobuf:"varint,4,opt,name=Compression,proto3" json:"Compression,omitempty"` // Set if the beacon accepts compressed envelopes
+	Interval    int64       `protobuf:"varint,5,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	Jitter      int64       `protobuf:"varint,6,opt,name=Jitter,proto3" json:"Jitter,omitempty"`
 }
 
 func (x *BeaconTasks) Reset() {
 	*x = BeaconTasks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[1]
+		mi := &file_sliverpb_sliver_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -273,7 +438,7 @@ func (x *BeaconTasks) String() string {
 func (*BeaconTasks) ProtoMessage() {}
 
 func (x *BeaconTasks) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[1]
+	mi := &file_sliverpb_sliver_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +451,7 @@ func (x *BeaconTasks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconTasks.ProtoReflect.Descriptor instead.
 func (*BeaconTasks) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{1}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BeaconTasks) GetID() string {
@@ -310,35 +475,59 @@ func (x *BeaconTasks) GetNextCheckin() int64 {
 	return 0
 }
 
+func (x *BeaconTasks) GetCompression() bool {
+	if x != nil {
+		return x.Compression
+	}
+	return false
+}
+
+func (x *BeaconTasks) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *BeaconTasks) GetJitter() int64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
 // Register - First message the implant sends to the server
 type Register struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name              string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Hostname          string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Uuid              string `protobuf:"bytes,3,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
-	Username          string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
-	Uid               string `protobuf:"bytes,5,opt,name=Uid,proto3" json:"Uid,omitempty"`
-	Gid               string `protobuf:"bytes,6,opt,name=Gid,proto3" json:"Gid,omitempty"`
-	Os                string `protobuf:"bytes,7,opt,name=Os,proto3" json:"Os,omitempty"`
-	Arch              string `protobuf:"bytes,8,opt,name=Arch,proto3" json:"Arch,omitempty"`
-	Pid               int32  `protobuf:"varint,9,opt,name=Pid,proto3" json:"Pid,omitempty"`
-	Filename          string `protobuf:"bytes,10,opt,name=Filename,proto3" json:"Filename,omitempty"`
-	ActiveC2          string `protobuf:"bytes,11,opt,name=ActiveC2,proto3" json:"ActiveC2,omitempty"`
-	Version           string `protobuf:"bytes,12,opt,name=Version,proto3" json:"Version,omitempty"`
-	ReconnectInterval int64  `protobuf:"varint,13,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
-	ProxyURL          string `protobuf:"bytes,14,opt,name=ProxyURL,proto3" json:"ProxyURL,omitempty"`
-	ConfigID          string `protobuf:"bytes,16,opt,name=ConfigID,proto3" json:"ConfigID,omitempty"`
-	PeerID            int64  `protobuf:"varint,17,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	Locale            string `protobuf:"bytes,18,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	Name              string               `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Hostname          string               `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Uuid              string               `protobuf:"bytes,3,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+	Username          string               `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Uid               string               `protobuf:"bytes,5,opt,name=Uid,proto3" json:"Uid,omitempty"`
+	Gid               string               `protobuf:"bytes,6,opt,name=Gid,proto3" json:"Gid,omitempty"`
+	Os                string               `protobuf:"bytes,7,opt,name=Os,proto3" json:"Os,omitempty"`
+	Arch              string               `protobuf:"bytes,8,opt,name=Arch,proto3" json:"Arch,omitempty"`
+	Pid               int32                `protobuf:"varint,9,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Filename          string               `protobuf:"bytes,10,opt,name=Filename,proto3" json:"Filename,omitempty"`
+	ActiveC2          string               `protobuf:"bytes,11,opt,name=ActiveC2,proto3" json:"ActiveC2,omitempty"`
+	Version           string               `protobuf:"bytes,12,opt,name=Version,proto3" json:"Version,omitempty"`
+	ReconnectInterval int64                `protobuf:"varint,13,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
+	ProxyURL          string               `protobuf:"bytes,14,opt,name=ProxyURL,proto3" json:"ProxyURL,omitempty"`
+	ConfigID          string               `protobuf:"bytes,16,opt,name=ConfigID,proto3" json:"ConfigID,omitempty"`
+	PeerID            int64                `protobuf:"varint,17,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	Locale            string               `protobuf:"bytes,18,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	ProtocolVersion   int32                `protobuf:"varint,19,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"` // 0 if the implant predates protocol versioning
+	Compression       bool                 `protobuf:"varint,20,opt,name=Compression,proto3" json:"Compression,omitempty"`         // Set if the implant accepts compressed envelopes
+	Survey            *commonpb.HostSurvey `protobuf:"bytes,21,opt,name=Survey,proto3" json:"Survey,omitempty"`                    // nil if the implant predates host surveys
 }
 
 func (x *Register) Reset() {
 	*x = Register{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[2]
+		mi := &file_sliverpb_sliver_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +540,7 @@ func (x *Register) String() string {
 func (*Register) ProtoMessage() {}
 
 func (x *Register) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[2]
+	mi := &file_sliverpb_sliver_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +553,7 @@ func (x *Register) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Register.ProtoReflect.Descriptor instead.
 func (*Register) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{2}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Register) GetName() string {
@@ -486,6 +675,27 @@ func (x *Register) GetLocale() string {
 	return ""
 }
 
+func (x *Register) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Register) GetCompression() bool {
+	if x != nil {
+		return x.Compression
+	}
+	return false
+}
+
+func (x *Register) GetSurvey() *commonpb.HostSurvey {
+	if x != nil {
+		return x.Survey
+	}
+	return nil
+}
+
 type BeaconRegister struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -501,7 +711,7 @@ type BeaconRegister struct {
 func (x *BeaconRegister) Reset() {
 	*x = BeaconRegister{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[3]
+		mi := &file_sliverpb_sliver_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -514,7 +724,7 @@ func (x *BeaconRegister) String() string {
 func (*BeaconRegister) ProtoMessage() {}
 
 func (x *BeaconRegister) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[3]
+	mi := &file_sliverpb_sliver_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +737,7 @@ func (x *BeaconRegister) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconRegister.ProtoReflect.Descriptor instead.
 func (*BeaconRegister) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{3}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BeaconRegister) GetID() string {
@@ -577,7 +787,7 @@ type SessionRegister struct {
 func (x *SessionRegister) Reset() {
 	*x = SessionRegister{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[4]
+		mi := &file_sliverpb_sliver_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -590,7 +800,7 @@ func (x *SessionRegister) String() string {
 func (*SessionRegister) ProtoMessage() {}
 
 func (x *SessionRegister) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[4]
+	mi := &file_sliverpb_sliver_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +813,7 @@ func (x *SessionRegister) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionRegister.ProtoReflect.Descriptor instead.
 func (*SessionRegister) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{4}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SessionRegister) GetID() string {
@@ -634,7 +844,7 @@ type OpenSession struct {
 func (x *OpenSession) Reset() {
 	*x = OpenSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[5]
+		mi := &file_sliverpb_sliver_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -647,7 +857,7 @@ func (x *OpenSession) String() string {
 func (*OpenSession) ProtoMessage() {}
 
 func (x *OpenSession) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[5]
+	mi := &file_sliverpb_sliver_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +870,7 @@ func (x *OpenSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenSession.ProtoReflect.Descriptor instead.
 func (*OpenSession) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{5}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OpenSession) GetC2S() []string {
@@ -703,7 +913,7 @@ type CloseSession struct {
 func (x *CloseSession) Reset() {
 	*x = CloseSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[6]
+		mi := &file_sliverpb_sliver_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -716,7 +926,7 @@ func (x *CloseSession) String() string {
 func (*CloseSession) ProtoMessage() {}
 
 func (x *CloseSession) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[6]
+	mi := &file_sliverpb_sliver_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,7 +939,7 @@ func (x *CloseSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseSession.ProtoReflect.Descriptor instead.
 func (*CloseSession) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{6}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CloseSession) GetResponse() *commonpb.Response {
@@ -763,7 +973,7 @@ type Ping struct {
 func (x *Ping) Reset() {
 	*x = Ping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[7]
+		mi := &file_sliverpb_sliver_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +986,7 @@ func (x *Ping) String() string {
 func (*Ping) ProtoMessage() {}
 
 func (x *Ping) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[7]
+	mi := &file_sliverpb_sliver_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +999,7 @@ func (x *Ping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ping.ProtoReflect.Descriptor instead.
 func (*Ping) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{7}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Ping) GetNonce() int32 {
@@ -826,7 +1036,7 @@ type KillReq struct {
 func (x *KillReq) Reset() {
 	*x = KillReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[8]
+		mi := &file_sliverpb_sliver_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -839,7 +1049,7 @@ func (x *KillReq) String() string {
 func (*KillReq) ProtoMessage() {}
 
 func (x *KillReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[8]
+	mi := &file_sliverpb_sliver_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1062,7 @@ func (x *KillReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KillReq.ProtoReflect.Descriptor instead.
 func (*KillReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{8}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *KillReq) GetForce() bool {
@@ -881,7 +1091,7 @@ type PsReq struct {
 func (x *PsReq) Reset() {
 	*x = PsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[9]
+		mi := &file_sliverpb_sliver_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -894,7 +1104,7 @@ func (x *PsReq) String() string {
 func (*PsReq) ProtoMessage() {}
 
 func (x *PsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[9]
+	mi := &file_sliverpb_sliver_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1117,7 @@ func (x *PsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PsReq.ProtoReflect.Descriptor instead.
 func (*PsReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{9}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PsReq) GetRequest() *commonpb.Request {
@@ -929,7 +1139,7 @@ type Ps struct {
 func (x *Ps) Reset() {
 	*x = Ps{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[10]
+		mi := &file_sliverpb_sliver_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1152,7 @@ func (x *Ps) String() string {
 func (*Ps) ProtoMessage() {}
 
 func (x *Ps) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[10]
+	mi := &file_sliverpb_sliver_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1165,7 @@ func (x *Ps) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ps.ProtoReflect.Descriptor instead.
 func (*Ps) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{10}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Ps) GetProcesses() []*commonpb.Process {
@@ -986,7 +1196,7 @@ type TerminateReq struct {
 func (x *TerminateReq) Reset() {
 	*x = TerminateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[11]
+		mi := &file_sliverpb_sliver_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -999,7 +1209,7 @@ func (x *TerminateReq) String() string {
 func (*TerminateReq) ProtoMessage() {}
 
 func (x *TerminateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[11]
+	mi := &file_sliverpb_sliver_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,7 +1222,7 @@ func (x *TerminateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TerminateReq.ProtoReflect.Descriptor instead.
 func (*TerminateReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{11}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TerminateReq) GetPid() int32 {
@@ -1048,7 +1258,7 @@ type Terminate struct {
 func (x *Terminate) Reset() {
 	*x = Terminate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[12]
+		mi := &file_sliverpb_sliver_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1061,7 +1271,7 @@ func (x *Terminate) String() string {
 func (*Terminate) ProtoMessage() {}
 
 func (x *Terminate) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[12]
+	mi := &file_sliverpb_sliver_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,7 +1284,7 @@ func (x *Terminate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Terminate.ProtoReflect.Descriptor instead.
 func (*Terminate) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{12}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Terminate) GetPid() int32 {
@@ -1103,7 +1313,7 @@ type IfconfigReq struct {
 func (x *IfconfigReq) Reset() {
 	*x = IfconfigReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[13]
+		mi := &file_sliverpb_sliver_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1116,7 +1326,7 @@ func (x *IfconfigReq) String() string {
 func (*IfconfigReq) ProtoMessage() {}
 
 func (x *IfconfigReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[13]
+	mi := &file_sliverpb_sliver_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1339,7 @@ func (x *IfconfigReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IfconfigReq.ProtoReflect.Descriptor instead.
 func (*IfconfigReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{13}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *IfconfigReq) GetRequest() *commonpb.Request {
@@ -1151,7 +1361,7 @@ type Ifconfig struct {
 func (x *Ifconfig) Reset() {
 	*x = Ifconfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[14]
+		mi := &file_sliverpb_sliver_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1164,7 +1374,7 @@ func (x *Ifconfig) String() string {
 func (*Ifconfig) ProtoMessage() {}
 
 func (x *Ifconfig) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[14]
+	mi := &file_sliverpb_sliver_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,7 +1387,7 @@ func (x *Ifconfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ifconfig.ProtoReflect.Descriptor instead.
 func (*Ifconfig) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{14}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Ifconfig) GetNetInterfaces() []*NetInterface {
@@ -1208,7 +1418,7 @@ type NetInterface struct {
 func (x *NetInterface) Reset() {
 	*x = NetInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[15]
+		mi := &file_sliverpb_sliver_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1221,7 +1431,7 @@ func (x *NetInterface) String() string {
 func (*NetInterface) ProtoMessage() {}
 
 func (x *NetInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[15]
+	mi := &file_sliverpb_sliver_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,7 +1444,7 @@ func (x *NetInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetInterface.ProtoReflect.Descriptor instead.
 func (*NetInterface) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{15}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *NetInterface) GetIndex() int32 {
@@ -1277,7 +1487,7 @@ type LsReq struct {
 func (x *LsReq) Reset() {
 	*x = LsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[16]
+		mi := &file_sliverpb_sliver_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1290,7 +1500,7 @@ func (x *LsReq) String() string {
 func (*LsReq) ProtoMessage() {}
 
 func (x *LsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[16]
+	mi := &file_sliverpb_sliver_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1513,7 @@ func (x *LsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsReq.ProtoReflect.Descriptor instead.
 func (*LsReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{16}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LsReq) GetPath() string {
@@ -1336,7 +1546,7 @@ type Ls struct {
 func (x *Ls) Reset() {
 	*x = Ls{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[17]
+		mi := &file_sliverpb_sliver_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1349,7 +1559,7 @@ func (x *Ls) String() string {
 func (*Ls) ProtoMessage() {}
 
 func (x *Ls) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[17]
+	mi := &file_sliverpb_sliver_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +1572,7 @@ func (x *Ls) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ls.ProtoReflect.Descriptor instead.
 func (*Ls) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{17}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Ls) GetPath() string {
@@ -1425,7 +1635,7 @@ type FileInfo struct {
 func (x *FileInfo) Reset() {
 	*x = FileInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[18]
+		mi := &file_sliverpb_sliver_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1438,7 +1648,7 @@ func (x *FileInfo) String() string {
 func (*FileInfo) ProtoMessage() {}
 
 func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[18]
+	mi := &file_sliverpb_sliver_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,7 +1661,7 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{18}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *FileInfo) GetName() string {
@@ -1522,7 +1732,7 @@ type CdReq struct {
 func (x *CdReq) Reset() {
 	*x = CdReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[19]
+		mi := &file_sliverpb_sliver_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1535,7 +1745,7 @@ func (x *CdReq) String() string {
 func (*CdReq) ProtoMessage() {}
 
 func (x *CdReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[19]
+	mi := &file_sliverpb_sliver_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +1758,7 @@ func (x *CdReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CdReq.ProtoReflect.Descriptor instead.
 func (*CdReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{19}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CdReq) GetPath() string {
@@ -1576,7 +1786,7 @@ type PwdReq struct {
 func (x *PwdReq) Reset() {
 	*x = PwdReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[20]
+		mi := &file_sliverpb_sliver_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +1799,7 @@ func (x *PwdReq) String() string {
 func (*PwdReq) ProtoMessage() {}
 
 func (x *PwdReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[20]
+	mi := &file_sliverpb_sliver_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +1812,7 @@ func (x *PwdReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PwdReq.ProtoReflect.Descriptor instead.
 func (*PwdReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{20}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PwdReq) GetRequest() *commonpb.Request {
@@ -1624,7 +1834,7 @@ type Pwd struct {
 func (x *Pwd) Reset() {
 	*x = Pwd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[21]
+		mi := &file_sliverpb_sliver_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1637,7 +1847,7 @@ func (x *Pwd) String() string {
 func (*Pwd) ProtoMessage() {}
 
 func (x *Pwd) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[21]
+	mi := &file_sliverpb_sliver_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +1860,7 @@ func (x *Pwd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pwd.ProtoReflect.Descriptor instead.
 func (*Pwd) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{21}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Pwd) GetPath() string {
@@ -1681,7 +1891,7 @@ type RmReq struct {
 func (x *RmReq) Reset() {
 	*x = RmReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[22]
+		mi := &file_sliverpb_sliver_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1694,7 +1904,7 @@ func (x *RmReq) String() string {
 func (*RmReq) ProtoMessage() {}
 
 func (x *RmReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[22]
+	mi := &file_sliverpb_sliver_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +1917,7 @@ func (x *RmReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RmReq.ProtoReflect.Descriptor instead.
 func (*RmReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{22}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RmReq) GetPath() string {
@@ -1750,7 +1960,7 @@ type Rm struct {
 func (x *Rm) Reset() {
 	*x = Rm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[23]
+		mi := &file_sliverpb_sliver_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1763,7 +1973,7 @@ func (x *Rm) String() string {
 func (*Rm) ProtoMessage() {}
 
 func (x *Rm) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[23]
+	mi := &file_sliverpb_sliver_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,7 +1986,7 @@ func (x *Rm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rm.ProtoReflect.Descriptor instead.
 func (*Rm) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{23}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Rm) GetPath() string {
@@ -1806,7 +2016,7 @@ type MvReq struct {
 func (x *MvReq) Reset() {
 	*x = MvReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[24]
+		mi := &file_sliverpb_sliver_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1819,7 +2029,7 @@ func (x *MvReq) String() string {
 func (*MvReq) ProtoMessage() {}
 
 func (x *MvReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[24]
+	mi := &file_sliverpb_sliver_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,7 +2042,7 @@ func (x *MvReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MvReq.ProtoReflect.Descriptor instead.
 func (*MvReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{24}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MvReq) GetSrc() string {
@@ -1869,7 +2079,7 @@ type Mv struct {
 func (x *Mv) Reset() {
 	*x = Mv{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[25]
+		mi := &file_sliverpb_sliver_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +2092,7 @@ func (x *Mv) String() string {
 func (*Mv) ProtoMessage() {}
 
 func (x *Mv) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[25]
+	mi := &file_sliverpb_sliver_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2105,7 @@ func (x *Mv) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mv.ProtoReflect.Descriptor instead.
 func (*Mv) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{25}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Mv) GetSrc() string {
@@ -1932,7 +2142,7 @@ type CpReq struct {
 func (x *CpReq) Reset() {
 	*x = CpReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[26]
+		mi := &file_sliverpb_sliver_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1945,7 +2155,7 @@ func (x *CpReq) String() string {
 func (*CpReq) ProtoMessage() {}
 
 func (x *CpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[26]
+	mi := &file_sliverpb_sliver_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,7 +2168,7 @@ func (x *CpReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CpReq.ProtoReflect.Descriptor instead.
 func (*CpReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{26}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CpReq) GetSrc() string {
@@ -1996,7 +2206,7 @@ type Cp struct {
 func (x *Cp) Reset() {
 	*x = Cp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[27]
+		mi := &file_sliverpb_sliver_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2009,7 +2219,7 @@ func (x *Cp) String() string {
 func (*Cp) ProtoMessage() {}
 
 func (x *Cp) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[27]
+	mi := &file_sliverpb_sliver_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,7 +2232,7 @@ func (x *Cp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cp.ProtoReflect.Descriptor instead.
 func (*Cp) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{27}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Cp) GetSrc() string {
@@ -2065,7 +2275,7 @@ type MkdirReq struct {
 func (x *MkdirReq) Reset() {
 	*x = MkdirReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[28]
+		mi := &file_sliverpb_sliver_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2078,7 +2288,7 @@ func (x *MkdirReq) String() string {
 func (*MkdirReq) ProtoMessage() {}
 
 func (x *MkdirReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[28]
+	mi := &file_sliverpb_sliver_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +2301,7 @@ func (x *MkdirReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MkdirReq.ProtoReflect.Descriptor instead.
 func (*MkdirReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{28}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MkdirReq) GetPath() string {
@@ -2120,7 +2330,7 @@ type Mkdir struct {
 func (x *Mkdir) Reset() {
 	*x = Mkdir{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[29]
+		mi := &file_sliverpb_sliver_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2133,7 +2343,7 @@ func (x *Mkdir) String() string {
 func (*Mkdir) ProtoMessage() {}
 
 func (x *Mkdir) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[29]
+	mi := &file_sliverpb_sliver_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2146,7 +2356,7 @@ func (x *Mkdir) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mkdir.ProtoReflect.Descriptor instead.
 func (*Mkdir) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{29}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Mkdir) GetPath() string {
@@ -2178,7 +2388,7 @@ type DownloadReq struct {
 func (x *DownloadReq) Reset() {
 	*x = DownloadReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[30]
+		mi := &file_sliverpb_sliver_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2191,7 +2401,7 @@ func (x *DownloadReq) String() string {
 func (*DownloadReq) ProtoMessage() {}
 
 func (x *DownloadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[30]
+	mi := &file_sliverpb_sliver_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2204,7 +2414,7 @@ func (x *DownloadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadReq.ProtoReflect.Descriptor instead.
 func (*DownloadReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{30}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DownloadReq) GetPath() string {
@@ -2262,7 +2472,7 @@ type Download struct {
 func (x *Download) Reset() {
 	*x = Download{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[31]
+		mi := &file_sliverpb_sliver_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2275,7 +2485,7 @@ func (x *Download) String() string {
 func (*Download) ProtoMessage() {}
 
 func (x *Download) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[31]
+	mi := &file_sliverpb_sliver_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2288,7 +2498,7 @@ func (x *Download) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Download.ProtoReflect.Descriptor instead.
 func (*Download) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{31}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Download) GetPath() string {
@@ -2377,7 +2587,7 @@ type UploadReq struct {
 func (x *UploadReq) Reset() {
 	*x = UploadReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[32]
+		mi := &file_sliverpb_sliver_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2390,7 +2600,7 @@ func (x *UploadReq) String() string {
 func (*UploadReq) ProtoMessage() {}
 
 func (x *UploadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[32]
+	mi := &file_sliverpb_sliver_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2403,7 +2613,7 @@ func (x *UploadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadReq.ProtoReflect.Descriptor instead.
 func (*UploadReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{32}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UploadReq) GetPath() string {
@@ -2460,7 +2670,7 @@ type Upload struct {
 func (x *Upload) Reset() {
 	*x = Upload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[33]
+		mi := &file_sliverpb_sliver_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2473,7 +2683,7 @@ func (x *Upload) String() string {
 func (*Upload) ProtoMessage() {}
 
 func (x *Upload) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[33]
+	mi := &file_sliverpb_sliver_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +2696,7 @@ func (x *Upload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Upload.ProtoReflect.Descriptor instead.
 func (*Upload) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{33}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Upload) GetPath() string {
@@ -2516,7 +2726,7 @@ type ProcessDumpReq struct {
 func (x *ProcessDumpReq) Reset() {
 	*x = ProcessDumpReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[34]
+		mi := &file_sliverpb_sliver_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2529,7 +2739,7 @@ func (x *ProcessDumpReq) String() string {
 func (*ProcessDumpReq) ProtoMessage() {}
 
 func (x *ProcessDumpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[34]
+	mi := &file_sliverpb_sliver_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +2752,7 @@ func (x *ProcessDumpReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessDumpReq.ProtoReflect.Descriptor instead.
 func (*ProcessDumpReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{34}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProcessDumpReq) GetPid() int32 {
@@ -2578,7 +2788,7 @@ type ProcessDump struct {
 func (x *ProcessDump) Reset() {
 	*x = ProcessDump{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[35]
+		mi := &file_sliverpb_sliver_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2591,7 +2801,7 @@ func (x *ProcessDump) String() string {
 func (*ProcessDump) ProtoMessage() {}
 
 func (x *ProcessDump) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[35]
+	mi := &file_sliverpb_sliver_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2604,7 +2814,7 @@ func (x *ProcessDump) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessDump.ProtoReflect.Descriptor instead.
 func (*ProcessDump) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{35}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProcessDump) GetData() []byte {
@@ -2639,7 +2849,7 @@ type RunAsReq struct {
 func (x *RunAsReq) Reset() {
 	*x = RunAsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[36]
+		mi := &file_sliverpb_sliver_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2652,7 +2862,7 @@ func (x *RunAsReq) String() string {
 func (*RunAsReq) ProtoMessage() {}
 
 func (x *RunAsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[36]
+	mi := &file_sliverpb_sliver_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2665,7 +2875,7 @@ func (x *RunAsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunAsReq.ProtoReflect.Descriptor instead.
 func (*RunAsReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{36}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *RunAsReq) GetUsername() string {
@@ -2736,7 +2946,7 @@ type RunAs struct {
 func (x *RunAs) Reset() {
 	*x = RunAs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[37]
+		mi := &file_sliverpb_sliver_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2749,7 +2959,7 @@ func (x *RunAs) String() string {
 func (*RunAs) ProtoMessage() {}
 
 func (x *RunAs) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[37]
+	mi := &file_sliverpb_sliver_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2762,7 +2972,7 @@ func (x *RunAs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunAs.ProtoReflect.Descriptor instead.
 func (*RunAs) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{37}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *RunAs) GetOutput() string {
@@ -2791,7 +3001,7 @@ type ImpersonateReq struct {
 func (x *ImpersonateReq) Reset() {
 	*x = ImpersonateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[38]
+		mi := &file_sliverpb_sliver_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2804,7 +3014,7 @@ func (x *ImpersonateReq) String() string {
 func (*ImpersonateReq) ProtoMessage() {}
 
 func (x *ImpersonateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[38]
+	mi := &file_sliverpb_sliver_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2817,7 +3027,7 @@ func (x *ImpersonateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImpersonateReq.ProtoReflect.Descriptor instead.
 func (*ImpersonateReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{38}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ImpersonateReq) GetUsername() string {
@@ -2845,7 +3055,7 @@ type Impersonate struct {
 func (x *Impersonate) Reset() {
 	*x = Impersonate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[39]
+		mi := &file_sliverpb_sliver_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2858,7 +3068,7 @@ func (x *Impersonate) String() string {
 func (*Impersonate) ProtoMessage() {}
 
 func (x *Impersonate) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[39]
+	mi := &file_sliverpb_sliver_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,7 +3081,7 @@ func (x *Impersonate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Impersonate.ProtoReflect.Descriptor instead.
 func (*Impersonate) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{39}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Impersonate) GetResponse() *commonpb.Response {
@@ -2892,7 +3102,7 @@ type RevToSelfReq struct {
 func (x *RevToSelfReq) Reset() {
 	*x = RevToSelfReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[40]
+		mi := &file_sliverpb_sliver_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2905,7 +3115,7 @@ func (x *RevToSelfReq) String() string {
 func (*RevToSelfReq) ProtoMessage() {}
 
 func (x *RevToSelfReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[40]
+	mi := &file_sliverpb_sliver_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,7 +3128,7 @@ func (x *RevToSelfReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevToSelfReq.ProtoReflect.Descriptor instead.
 func (*RevToSelfReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{40}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RevToSelfReq) GetRequest() *commonpb.Request {
@@ -2939,7 +3149,7 @@ type RevToSelf struct {
 func (x *RevToSelf) Reset() {
 	*x = RevToSelf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[41]
+		mi := &file_sliverpb_sliver_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2952,7 +3162,7 @@ func (x *RevToSelf) String() string {
 func (*RevToSelf) ProtoMessage() {}
 
 func (x *RevToSelf) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[41]
+	mi := &file_sliverpb_sliver_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2965,7 +3175,7 @@ func (x *RevToSelf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevToSelf.ProtoReflect.Descriptor instead.
 func (*RevToSelf) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{41}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RevToSelf) GetResponse() *commonpb.Response {
@@ -2986,7 +3196,7 @@ type CurrentTokenOwnerReq struct {
 func (x *CurrentTokenOwnerReq) Reset() {
 	*x = CurrentTokenOwnerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[42]
+		mi := &file_sliverpb_sliver_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2999,7 +3209,7 @@ func (x *CurrentTokenOwnerReq) String() string {
 func (*CurrentTokenOwnerReq) ProtoMessage() {}
 
 func (x *CurrentTokenOwnerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[42]
+	mi := &file_sliverpb_sliver_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3012,7 +3222,7 @@ func (x *CurrentTokenOwnerReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrentTokenOwnerReq.ProtoReflect.Descriptor instead.
 func (*CurrentTokenOwnerReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{42}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CurrentTokenOwnerReq) GetRequest() *commonpb.Request {
@@ -3034,7 +3244,7 @@ type CurrentTokenOwner struct {
 func (x *CurrentTokenOwner) Reset() {
 	*x = CurrentTokenOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[43]
+		mi := &file_sliverpb_sliver_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3047,7 +3257,7 @@ func (x *CurrentTokenOwner) String() string {
 func (*CurrentTokenOwner) ProtoMessage() {}
 
 func (x *CurrentTokenOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[43]
+	mi := &file_sliverpb_sliver_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3060,7 +3270,7 @@ func (x *CurrentTokenOwner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrentTokenOwner.ProtoReflect.Descriptor instead.
 func (*CurrentTokenOwner) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{43}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CurrentTokenOwner) GetOutput() string {
@@ -3093,7 +3303,7 @@ type InvokeGetSystemReq struct {
 func (x *InvokeGetSystemReq) Reset() {
 	*x = InvokeGetSystemReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[44]
+		mi := &file_sliverpb_sliver_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3106,7 +3316,7 @@ func (x *InvokeGetSystemReq) String() string {
 func (*InvokeGetSystemReq) ProtoMessage() {}
 
 func (x *InvokeGetSystemReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[44]
+	mi := &file_sliverpb_sliver_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3119,7 +3329,7 @@ func (x *InvokeGetSystemReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeGetSystemReq.ProtoReflect.Descriptor instead.
 func (*InvokeGetSystemReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{44}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *InvokeGetSystemReq) GetData() []byte {
@@ -3155,7 +3365,7 @@ type GetSystem struct {
 func (x *GetSystem) Reset() {
 	*x = GetSystem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[45]
+		mi := &file_sliverpb_sliver_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3168,7 +3378,7 @@ func (x *GetSystem) String() string {
 func (*GetSystem) ProtoMessage() {}
 
 func (x *GetSystem) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[45]
+	mi := &file_sliverpb_sliver_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3181,7 +3391,7 @@ func (x *GetSystem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSystem.ProtoReflect.Descriptor instead.
 func (*GetSystem) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{45}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetSystem) GetResponse() *commonpb.Response {
@@ -3206,7 +3416,7 @@ type MakeTokenReq struct {
 func (x *MakeTokenReq) Reset() {
 	*x = MakeTokenReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[46]
+		mi := &file_sliverpb_sliver_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3219,7 +3429,7 @@ func (x *MakeTokenReq) String() string {
 func (*MakeTokenReq) ProtoMessage() {}
 
 func (x *MakeTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[46]
+	mi := &file_sliverpb_sliver_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3232,7 +3442,7 @@ func (x *MakeTokenReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeTokenReq.ProtoReflect.Descriptor instead.
 func (*MakeTokenReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{46}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *MakeTokenReq) GetUsername() string {
@@ -3281,7 +3491,7 @@ type MakeToken struct {
 func (x *MakeToken) Reset() {
 	*x = MakeToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[47]
+		mi := &file_sliverpb_sliver_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3294,7 +3504,7 @@ func (x *MakeToken) String() string {
 func (*MakeToken) ProtoMessage() {}
 
 func (x *MakeToken) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[47]
+	mi := &file_sliverpb_sliver_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3307,7 +3517,7 @@ func (x *MakeToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeToken.ProtoReflect.Descriptor instead.
 func (*MakeToken) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{47}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *MakeToken) GetResponse() *commonpb.Response {
@@ -3332,7 +3542,7 @@ type TaskReq struct {
 func (x *TaskReq) Reset() {
 	*x = TaskReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[48]
+		mi := &file_sliverpb_sliver_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3345,7 +3555,7 @@ func (x *TaskReq) String() string {
 func (*TaskReq) ProtoMessage() {}
 
 func (x *TaskReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[48]
+	mi := &file_sliverpb_sliver_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3358,7 +3568,7 @@ func (x *TaskReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskReq.ProtoReflect.Descriptor instead.
 func (*TaskReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{48}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *TaskReq) GetEncoder() string {
@@ -3407,7 +3617,7 @@ type Task struct {
 func (x *Task) Reset() {
 	*x = Task{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[49]
+		mi := &file_sliverpb_sliver_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3420,7 +3630,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[49]
+	mi := &file_sliverpb_sliver_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3433,7 +3643,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{49}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Task) GetResponse() *commonpb.Response {
@@ -3469,7 +3679,7 @@ type ExecuteAssemblyReq struct {
 func (x *ExecuteAssemblyReq) Reset() {
 	*x = ExecuteAssemblyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[50]
+		mi := &file_sliverpb_sliver_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3482,7 +3692,7 @@ func (x *ExecuteAssemblyReq) String() string {
 func (*ExecuteAssemblyReq) ProtoMessage() {}
 
 func (x *ExecuteAssemblyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[50]
+	mi := &file_sliverpb_sliver_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3495,7 +3705,7 @@ func (x *ExecuteAssemblyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteAssemblyReq.ProtoReflect.Descriptor instead.
 func (*ExecuteAssemblyReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{50}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ExecuteAssemblyReq) GetAssembly() []byte {
@@ -3618,7 +3828,7 @@ type InvokeExecuteAssemblyReq struct {
 func (x *InvokeExecuteAssemblyReq) Reset() {
 	*x = InvokeExecuteAssemblyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[51]
+		mi := &file_sliverpb_sliver_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3631,7 +3841,7 @@ func (x *InvokeExecuteAssemblyReq) String() string {
 func (*InvokeExecuteAssemblyReq) ProtoMessage() {}
 
 func (x *InvokeExecuteAssemblyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[51]
+	mi := &file_sliverpb_sliver_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3644,7 +3854,7 @@ func (x *InvokeExecuteAssemblyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeExecuteAssemblyReq.ProtoReflect.Descriptor instead.
 func (*InvokeExecuteAssemblyReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{51}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *InvokeExecuteAssemblyReq) GetData() []byte {
@@ -3698,7 +3908,7 @@ type InvokeInProcExecuteAssemblyReq struct {
 func (x *InvokeInProcExecuteAssemblyReq) Reset() {
 	*x = InvokeInProcExecuteAssemblyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[52]
+		mi := &file_sliverpb_sliver_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3711,7 +3921,7 @@ func (x *InvokeInProcExecuteAssemblyReq) String() string {
 func (*InvokeInProcExecuteAssemblyReq) ProtoMessage() {}
 
 func (x *InvokeInProcExecuteAssemblyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[52]
+	mi := &file_sliverpb_sliver_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3724,7 +3934,7 @@ func (x *InvokeInProcExecuteAssemblyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeInProcExecuteAssemblyReq.ProtoReflect.Descriptor instead.
 func (*InvokeInProcExecuteAssemblyReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{52}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *InvokeInProcExecuteAssemblyReq) GetData() []byte {
@@ -3781,7 +3991,7 @@ type ExecuteAssembly struct {
 func (x *ExecuteAssembly) Reset() {
 	*x = ExecuteAssembly{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[53]
+		mi := &file_sliverpb_sliver_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3794,7 +4004,7 @@ func (x *ExecuteAssembly) String() string {
 func (*ExecuteAssembly) ProtoMessage() {}
 
 func (x *ExecuteAssembly) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[53]
+	mi := &file_sliverpb_sliver_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,7 +4017,7 @@ func (x *ExecuteAssembly) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteAssembly.ProtoReflect.Descriptor instead.
 func (*ExecuteAssembly) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{53}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ExecuteAssembly) GetOutput() []byte {
@@ -3837,7 +4047,7 @@ type InvokeMigrateReq struct {
 func (x *InvokeMigrateReq) Reset() {
 	*x = InvokeMigrateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[54]
+		mi := &file_sliverpb_sliver_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3850,7 +4060,7 @@ func (x *InvokeMigrateReq) String() string {
 func (*InvokeMigrateReq) ProtoMessage() {}
 
 func (x *InvokeMigrateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[54]
+	mi := &file_sliverpb_sliver_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3863,7 +4073,7 @@ func (x *InvokeMigrateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeMigrateReq.ProtoReflect.Descriptor instead.
 func (*InvokeMigrateReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{54}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *InvokeMigrateReq) GetPid() uint32 {
@@ -3899,7 +4109,7 @@ type Migrate struct {
 func (x *Migrate) Reset() {
 	*x = Migrate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[55]
+		mi := &file_sliverpb_sliver_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3912,7 +4122,7 @@ func (x *Migrate) String() string {
 func (*Migrate) ProtoMessage() {}
 
 func (x *Migrate) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[55]
+	mi := &file_sliverpb_sliver_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3925,7 +4135,7 @@ func (x *Migrate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Migrate.ProtoReflect.Descriptor instead.
 func (*Migrate) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{55}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *Migrate) GetSuccess() bool {
@@ -3959,7 +4169,7 @@ type ExecuteReq struct {
 func (x *ExecuteReq) Reset() {
 	*x = ExecuteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[56]
+		mi := &file_sliverpb_sliver_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3972,7 +4182,7 @@ func (x *ExecuteReq) String() string {
 func (*ExecuteReq) ProtoMessage() {}
 
 func (x *ExecuteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[56]
+	mi := &file_sliverpb_sliver_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3985,7 +4195,7 @@ func (x *ExecuteReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteReq.ProtoReflect.Descriptor instead.
 func (*ExecuteReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{56}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ExecuteReq) GetPath() string {
@@ -4056,7 +4266,7 @@ type ExecuteWindowsReq struct {
 func (x *ExecuteWindowsReq) Reset() {
 	*x = ExecuteWindowsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[57]
+		mi := &file_sliverpb_sliver_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4069,7 +4279,7 @@ func (x *ExecuteWindowsReq) String() string {
 func (*ExecuteWindowsReq) ProtoMessage() {}
 
 func (x *ExecuteWindowsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[57]
+	mi := &file_sliverpb_sliver_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4082,7 +4292,7 @@ func (x *ExecuteWindowsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteWindowsReq.ProtoReflect.Descriptor instead.
 func (*ExecuteWindowsReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{57}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ExecuteWindowsReq) GetPath() string {
@@ -4163,7 +4373,7 @@ type Execute struct {
 func (x *Execute) Reset() {
 	*x = Execute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[58]
+		mi := &file_sliverpb_sliver_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4176,7 +4386,7 @@ func (x *Execute) String() string {
 func (*Execute) ProtoMessage() {}
 
 func (x *Execute) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[58]
+	mi := &file_sliverpb_sliver_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4189,7 +4399,7 @@ func (x *Execute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Execute.ProtoReflect.Descriptor instead.
 func (*Execute) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{58}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *Execute) GetStatus() uint32 {
@@ -4247,7 +4457,7 @@ type SideloadReq struct {
 func (x *SideloadReq) Reset() {
 	*x = SideloadReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[59]
+		mi := &file_sliverpb_sliver_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4260,7 +4470,7 @@ func (x *SideloadReq) String() string {
 func (*SideloadReq) ProtoMessage() {}
 
 func (x *SideloadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[59]
+	mi := &file_sliverpb_sliver_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4273,7 +4483,7 @@ func (x *SideloadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SideloadReq.ProtoReflect.Descriptor instead.
 func (*SideloadReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{59}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *SideloadReq) GetData() []byte {
@@ -4358,7 +4568,7 @@ type Sideload struct {
 func (x *Sideload) Reset() {
 	*x = Sideload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[60]
+		mi := &file_sliverpb_sliver_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4371,7 +4581,7 @@ func (x *Sideload) String() string {
 func (*Sideload) ProtoMessage() {}
 
 func (x *Sideload) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[60]
+	mi := &file_sliverpb_sliver_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4384,7 +4594,7 @@ func (x *Sideload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sideload.ProtoReflect.Descriptor instead.
 func (*Sideload) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{60}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *Sideload) GetResult() string {
@@ -4419,7 +4629,7 @@ type InvokeSpawnDllReq struct {
 func (x *InvokeSpawnDllReq) Reset() {
 	*x = InvokeSpawnDllReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[61]
+		mi := &file_sliverpb_sliver_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4432,7 +4642,7 @@ func (x *InvokeSpawnDllReq) String() string {
 func (*InvokeSpawnDllReq) ProtoMessage() {}
 
 func (x *InvokeSpawnDllReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[61]
+	mi := &file_sliverpb_sliver_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4445,7 +4655,7 @@ func (x *InvokeSpawnDllReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeSpawnDllReq.ProtoReflect.Descriptor instead.
 func (*InvokeSpawnDllReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{61}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *InvokeSpawnDllReq) GetData() []byte {
@@ -4522,7 +4732,7 @@ type SpawnDllReq struct {
 func (x *SpawnDllReq) Reset() {
 	*x = SpawnDllReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[62]
+		mi := &file_sliverpb_sliver_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4535,7 +4745,7 @@ func (x *SpawnDllReq) String() string {
 func (*SpawnDllReq) ProtoMessage() {}
 
 func (x *SpawnDllReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[62]
+	mi := &file_sliverpb_sliver_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4548,7 +4758,7 @@ func (x *SpawnDllReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawnDllReq.ProtoReflect.Descriptor instead.
 func (*SpawnDllReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{62}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SpawnDllReq) GetData() []byte {
@@ -4619,7 +4829,7 @@ type SpawnDll struct {
 func (x *SpawnDll) Reset() {
 	*x = SpawnDll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[63]
+		mi := &file_sliverpb_sliver_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4632,7 +4842,7 @@ func (x *SpawnDll) String() string {
 func (*SpawnDll) ProtoMessage() {}
 
 func (x *SpawnDll) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[63]
+	mi := &file_sliverpb_sliver_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4645,7 +4855,7 @@ func (x *SpawnDll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpawnDll.ProtoReflect.Descriptor instead.
 func (*SpawnDll) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{63}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SpawnDll) GetResult() string {
@@ -4678,7 +4888,7 @@ type NetstatReq struct {
 func (x *NetstatReq) Reset() {
 	*x = NetstatReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[64]
+		mi := &file_sliverpb_sliver_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4691,7 +4901,7 @@ func (x *NetstatReq) String() string {
 func (*NetstatReq) ProtoMessage() {}
 
 func (x *NetstatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[64]
+	mi := &file_sliverpb_sliver_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4704,7 +4914,7 @@ func (x *NetstatReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatReq.ProtoReflect.Descriptor instead.
 func (*NetstatReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{64}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *NetstatReq) GetTCP() bool {
@@ -4765,7 +4975,7 @@ type SockTabEntry struct {
 func (x *SockTabEntry) Reset() {
 	*x = SockTabEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[65]
+		mi := &file_sliverpb_sliver_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4778,7 +4988,7 @@ func (x *SockTabEntry) String() string {
 func (*SockTabEntry) ProtoMessage() {}
 
 func (x *SockTabEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[65]
+	mi := &file_sliverpb_sliver_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4791,7 +5001,7 @@ func (x *SockTabEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SockTabEntry.ProtoReflect.Descriptor instead.
 func (*SockTabEntry) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{65}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *SockTabEntry) GetLocalAddr() *SockTabEntry_SockAddr {
@@ -4848,7 +5058,7 @@ type Netstat struct {
 func (x *Netstat) Reset() {
 	*x = Netstat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[66]
+		mi := &file_sliverpb_sliver_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4861,7 +5071,7 @@ func (x *Netstat) String() string {
 func (*Netstat) ProtoMessage() {}
 
 func (x *Netstat) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[66]
+	mi := &file_sliverpb_sliver_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4874,7 +5084,7 @@ func (x *Netstat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Netstat.ProtoReflect.Descriptor instead.
 func (*Netstat) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{66}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *Netstat) GetEntries() []*SockTabEntry {
@@ -4903,7 +5113,7 @@ type EnvReq struct {
 func (x *EnvReq) Reset() {
 	*x = EnvReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[67]
+		mi := &file_sliverpb_sliver_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4916,7 +5126,7 @@ func (x *EnvReq) String() string {
 func (*EnvReq) ProtoMessage() {}
 
 func (x *EnvReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[67]
+	mi := &file_sliverpb_sliver_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4929,7 +5139,7 @@ func (x *EnvReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvReq.ProtoReflect.Descriptor instead.
 func (*EnvReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{67}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *EnvReq) GetName() string {
@@ -4958,7 +5168,7 @@ type EnvInfo struct {
 func (x *EnvInfo) Reset() {
 	*x = EnvInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[68]
+		mi := &file_sliverpb_sliver_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4971,7 +5181,7 @@ func (x *EnvInfo) String() string {
 func (*EnvInfo) ProtoMessage() {}
 
 func (x *EnvInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[68]
+	mi := &file_sliverpb_sliver_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4984,7 +5194,7 @@ func (x *EnvInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvInfo.ProtoReflect.Descriptor instead.
 func (*EnvInfo) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{68}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *EnvInfo) GetVariables() []*commonpb.EnvVar {
@@ -5013,7 +5223,7 @@ type SetEnvReq struct {
 func (x *SetEnvReq) Reset() {
 	*x = SetEnvReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[69]
+		mi := &file_sliverpb_sliver_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5026,7 +5236,7 @@ func (x *SetEnvReq) String() string {
 func (*SetEnvReq) ProtoMessage() {}
 
 func (x *SetEnvReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[69]
+	mi := &file_sliverpb_sliver_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5039,7 +5249,7 @@ func (x *SetEnvReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetEnvReq.ProtoReflect.Descriptor instead.
 func (*SetEnvReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{69}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SetEnvReq) GetVariable() *commonpb.EnvVar {
@@ -5067,7 +5277,7 @@ type SetEnv struct {
 func (x *SetEnv) Reset() {
 	*x = SetEnv{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[70]
+		mi := &file_sliverpb_sliver_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5080,7 +5290,7 @@ func (x *SetEnv) String() string {
 func (*SetEnv) ProtoMessage() {}
 
 func (x *SetEnv) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[70]
+	mi := &file_sliverpb_sliver_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5093,7 +5303,7 @@ func (x *SetEnv) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetEnv.ProtoReflect.Descriptor instead.
 func (*SetEnv) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{70}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *SetEnv) GetResponse() *commonpb.Response {
@@ -5115,7 +5325,7 @@ type UnsetEnvReq struct {
 func (x *UnsetEnvReq) Reset() {
 	*x = UnsetEnvReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[71]
+		mi := &file_sliverpb_sliver_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5128,7 +5338,7 @@ func (x *UnsetEnvReq) String() string {
 func (*UnsetEnvReq) ProtoMessage() {}
 
 func (x *UnsetEnvReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[71]
+	mi := &file_sliverpb_sliver_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5141,7 +5351,7 @@ func (x *UnsetEnvReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsetEnvReq.ProtoReflect.Descriptor instead.
 func (*UnsetEnvReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{71}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *UnsetEnvReq) GetName() string {
@@ -5169,7 +5379,7 @@ type UnsetEnv struct {
 func (x *UnsetEnv) Reset() {
 	*x = UnsetEnv{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[72]
+		mi := &file_sliverpb_sliver_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5182,7 +5392,7 @@ func (x *UnsetEnv) String() string {
 func (*UnsetEnv) ProtoMessage() {}
 
 func (x *UnsetEnv) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[72]
+	mi := &file_sliverpb_sliver_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5195,7 +5405,7 @@ func (x *UnsetEnv) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsetEnv.ProtoReflect.Descriptor instead.
 func (*UnsetEnv) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{72}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *UnsetEnv) GetResponse() *commonpb.Response {
@@ -5217,7 +5427,7 @@ type DNSSessionInit struct {
 func (x *DNSSessionInit) Reset() {
 	*x = DNSSessionInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[73]
+		mi := &file_sliverpb_sliver_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5230,7 +5440,7 @@ func (x *DNSSessionInit) String() string {
 func (*DNSSessionInit) ProtoMessage() {}
 
 func (x *DNSSessionInit) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[73]
+	mi := &file_sliverpb_sliver_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5243,7 +5453,7 @@ func (x *DNSSessionInit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSSessionInit.ProtoReflect.Descriptor instead.
 func (*DNSSessionInit) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{73}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *DNSSessionInit) GetKey() []byte {
@@ -5264,7 +5474,7 @@ type DNSPoll struct {
 func (x *DNSPoll) Reset() {
 	*x = DNSPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[74]
+		mi := &file_sliverpb_sliver_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5277,7 +5487,7 @@ func (x *DNSPoll) String() string {
 func (*DNSPoll) ProtoMessage() {}
 
 func (x *DNSPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[74]
+	mi := &file_sliverpb_sliver_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5290,7 +5500,7 @@ func (x *DNSPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSPoll.ProtoReflect.Descriptor instead.
 func (*DNSPoll) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{74}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *DNSPoll) GetBlocks() []*DNSBlockHeader {
@@ -5312,7 +5522,7 @@ type DNSBlockHeader struct {
 func (x *DNSBlockHeader) Reset() {
 	*x = DNSBlockHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[75]
+		mi := &file_sliverpb_sliver_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5325,7 +5535,7 @@ func (x *DNSBlockHeader) String() string {
 func (*DNSBlockHeader) ProtoMessage() {}
 
 func (x *DNSBlockHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[75]
+	mi := &file_sliverpb_sliver_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5338,7 +5548,7 @@ func (x *DNSBlockHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSBlockHeader.ProtoReflect.Descriptor instead.
 func (*DNSBlockHeader) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{75}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *DNSBlockHeader) GetID() string {
@@ -5367,7 +5577,7 @@ type HTTPSessionInit struct {
 func (x *HTTPSessionInit) Reset() {
 	*x = HTTPSessionInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[76]
+		mi := &file_sliverpb_sliver_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5380,7 +5590,7 @@ func (x *HTTPSessionInit) String() string {
 func (*HTTPSessionInit) ProtoMessage() {}
 
 func (x *HTTPSessionInit) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[76]
+	mi := &file_sliverpb_sliver_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,7 +5603,7 @@ func (x *HTTPSessionInit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPSessionInit.ProtoReflect.Descriptor instead.
 func (*HTTPSessionInit) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{76}
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *HTTPSessionInit) GetKey() []byte {
@@ -5403,32 +5613,38 @@ func (x *HTTPSessionInit) GetKey() []byte {
 	return nil
 }
 
-// ScreenshotReq - Request the implant take a screenshot
-type ScreenshotReq struct {
+// RuntimeConfig - Implant config that is encrypted at compile-time and only
+// decrypted using a key the implant gets at runtime
+type RuntimeConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	C2   []string       `protobuf:"bytes,1,rep,name=C2,proto3" json:"C2,omitempty"`
+	HTTP *HTTPC2Profile `protobuf:"bytes,2,opt,name=HTTP,proto3" json:"HTTP,omitempty"`
+	// Only set in provisioning bundles, the provisionable implant takes on the
+	// name and keys of the implant the bundle was generated for
+	Name string       `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Keys *ImplantKeys `protobuf:"bytes,4,opt,name=Keys,proto3" json:"Keys,omitempty"`
 }
 
-func (x *ScreenshotReq) Reset() {
-	*x = ScreenshotReq{}
+func (x *RuntimeConfig) Reset() {
+	*x = RuntimeConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[77]
+		mi := &file_sliverpb_sliver_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScreenshotReq) String() string {
+func (x *RuntimeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScreenshotReq) ProtoMessage() {}
+func (*RuntimeConfig) ProtoMessage() {}
 
-func (x *ScreenshotReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[77]
+func (x *RuntimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5439,103 +5655,73 @@ func (x *ScreenshotReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScreenshotReq.ProtoReflect.Descriptor instead.
-func (*ScreenshotReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use RuntimeConfig.ProtoReflect.Descriptor instead.
+func (*RuntimeConfig) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ScreenshotReq) GetRequest() *commonpb.Request {
+func (x *RuntimeConfig) GetC2() []string {
 	if x != nil {
-		return x.Request
+		return x.C2
 	}
 	return nil
 }
 
-type Screenshot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data     []byte             `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
-}
-
-func (x *Screenshot) Reset() {
-	*x = Screenshot{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[78]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Screenshot) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Screenshot) ProtoMessage() {}
-
-func (x *Screenshot) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[78]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RuntimeConfig) GetHTTP() *HTTPC2Profile {
+	if x != nil {
+		return x.HTTP
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Screenshot.ProtoReflect.Descriptor instead.
-func (*Screenshot) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{78}
+	return nil
 }
 
-func (x *Screenshot) GetData() []byte {
+func (x *RuntimeConfig) GetName() string {
 	if x != nil {
-		return x.Data
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Screenshot) GetResponse() *commonpb.Response {
+func (x *RuntimeConfig) GetKeys() *ImplantKeys {
 	if x != nil {
-		return x.Response
+		return x.Keys
 	}
 	return nil
 }
 
-type StartServiceReq struct {
+// ImplantKeys - The keys of a provisioned implant
+type ImplantKeys struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceName        string            `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
-	ServiceDescription string            `protobuf:"bytes,2,opt,name=ServiceDescription,proto3" json:"ServiceDescription,omitempty"`
-	BinPath            string            `protobuf:"bytes,3,opt,name=BinPath,proto3" json:"BinPath,omitempty"`
-	Hostname           string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Arguments          string            `protobuf:"bytes,5,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
-	Request            *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	PeerPublicKey           string `protobuf:"bytes,1,opt,name=PeerPublicKey,proto3" json:"PeerPublicKey,omitempty"`
+	PeerPrivateKey          string `protobuf:"bytes,2,opt,name=PeerPrivateKey,proto3" json:"PeerPrivateKey,omitempty"`
+	PeerPublicKeySignature  string `protobuf:"bytes,3,opt,name=PeerPublicKeySignature,proto3" json:"PeerPublicKeySignature,omitempty"`
+	AgeServerPublicKey      string `protobuf:"bytes,4,opt,name=AgeServerPublicKey,proto3" json:"AgeServerPublicKey,omitempty"`
+	MinisignServerPublicKey string `protobuf:"bytes,5,opt,name=MinisignServerPublicKey,proto3" json:"MinisignServerPublicKey,omitempty"`
+	MLKEMServerPublicKey    string `protobuf:"bytes,6,opt,name=MLKEMServerPublicKey,proto3" json:"MLKEMServerPublicKey,omitempty"`
+	MtlsCACert              string `protobuf:"bytes,7,opt,name=MtlsCACert,proto3" json:"MtlsCACert,omitempty"`
+	MtlsCert                string `protobuf:"bytes,8,opt,name=MtlsCert,proto3" json:"MtlsCert,omitempty"`
+	MtlsKey                 string `protobuf:"bytes,9,opt,name=MtlsKey,proto3" json:"MtlsKey,omitempty"`
 }
 
-func (x *StartServiceReq) Reset() {
-	*x = StartServiceReq{}
+func (x *ImplantKeys) Reset() {
+	*x = ImplantKeys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[79]
+		mi := &file_sliverpb_sliver_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartServiceReq) String() string {
+func (x *ImplantKeys) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartServiceReq) ProtoMessage() {}
+func (*ImplantKeys) ProtoMessage() {}
 
-func (x *StartServiceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[79]
+func (x *ImplantKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5546,111 +5732,98 @@ func (x *StartServiceReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartServiceReq.ProtoReflect.Descriptor instead.
-func (*StartServiceReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ImplantKeys.ProtoReflect.Descriptor instead.
+func (*ImplantKeys) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *StartServiceReq) GetServiceName() string {
+func (x *ImplantKeys) GetPeerPublicKey() string {
 	if x != nil {
-		return x.ServiceName
+		return x.PeerPublicKey
 	}
 	return ""
 }
 
-func (x *StartServiceReq) GetServiceDescription() string {
+func (x *ImplantKeys) GetPeerPrivateKey() string {
 	if x != nil {
-		return x.ServiceDescription
+		return x.PeerPrivateKey
 	}
 	return ""
 }
 
-func (x *StartServiceReq) GetBinPath() string {
+func (x *ImplantKeys) GetPeerPublicKeySignature() string {
 	if x != nil {
-		return x.BinPath
+		return x.PeerPublicKeySignature
 	}
 	return ""
 }
 
-func (x *StartServiceReq) GetHostname() string {
+func (x *ImplantKeys) GetAgeServerPublicKey() string {
 	if x != nil {
-		return x.Hostname
+		return x.AgeServerPublicKey
 	}
 	return ""
 }
 
-func (x *StartServiceReq) GetArguments() string {
+func (x *ImplantKeys) GetMinisignServerPublicKey() string {
 	if x != nil {
-		return x.Arguments
+		return x.MinisignServerPublicKey
 	}
 	return ""
 }
 
-func (x *StartServiceReq) GetRequest() *commonpb.Request {
+func (x *ImplantKeys) GetMLKEMServerPublicKey() string {
 	if x != nil {
-		return x.Request
+		return x.MLKEMServerPublicKey
 	}
-	return nil
-}
-
-type ServiceInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	return ""
 }
 
-func (x *ServiceInfo) Reset() {
-	*x = ServiceInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[80]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ImplantKeys) GetMtlsCACert() string {
+	if x != nil {
+		return x.MtlsCACert
 	}
+	return ""
 }
 
-func (x *ServiceInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ServiceInfo) ProtoMessage() {}
-
-func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[80]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ImplantKeys) GetMtlsCert() string {
+	if x != nil {
+		return x.MtlsCert
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
-func (*ServiceInfo) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{80}
+	return ""
 }
 
-func (x *ServiceInfo) GetResponse() *commonpb.Response {
+func (x *ImplantKeys) GetMtlsKey() string {
 	if x != nil {
-		return x.Response
+		return x.MtlsKey
 	}
-	return nil
+	return ""
 }
 
-type ServiceInfoReq struct {
+// HTTPC2Profile - The http c2 profile of an implant
+type HTTPC2Profile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceName string `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
-	Hostname    string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-}
-
-func (x *ServiceInfoReq) Reset() {
-	*x = ServiceInfoReq{}
+	UserAgent           string             `protobuf:"bytes,1,opt,name=UserAgent,proto3" json:"UserAgent,omitempty"`
+	NonceQueryArgs      string             `protobuf:"bytes,2,opt,name=NonceQueryArgs,proto3" json:"NonceQueryArgs,omitempty"`
+	Headers             []*HTTPC2NameValue `protobuf:"bytes,3,rep,name=Headers,proto3" json:"Headers,omitempty"`
+	URLParameters       []*HTTPC2NameValue `protobuf:"bytes,4,rep,name=URLParameters,proto3" json:"URLParameters,omitempty"`
+	PollPaths           []string           `protobuf:"bytes,5,rep,name=PollPaths,proto3" json:"PollPaths,omitempty"`
+	PollFiles           []string           `protobuf:"bytes,6,rep,name=PollFiles,proto3" json:"PollFiles,omitempty"`
+	PollFileExt         string             `protobuf:"bytes,7,opt,name=PollFileExt,proto3" json:"PollFileExt,omitempty"`
+	SessionPaths        []string           `protobuf:"bytes,8,rep,name=SessionPaths,proto3" json:"SessionPaths,omitempty"`
+	SessionFiles        []string           `protobuf:"bytes,9,rep,name=SessionFiles,proto3" json:"SessionFiles,omitempty"`
+	SessionFileExt      string             `protobuf:"bytes,10,opt,name=SessionFileExt,proto3" json:"SessionFileExt,omitempty"`
+	StartSessionFileExt string             `protobuf:"bytes,11,opt,name=StartSessionFileExt,proto3" json:"StartSessionFileExt,omitempty"`
+	ClosePaths          []string           `protobuf:"bytes,12,rep,name=ClosePaths,proto3" json:"ClosePaths,omitempty"`
+	CloseFiles          []string           `protobuf:"bytes,13,rep,name=CloseFiles,proto3" json:"CloseFiles,omitempty"`
+	CloseFileExt        string             `protobuf:"bytes,14,opt,name=CloseFileExt,proto3" json:"CloseFileExt,omitempty"`
+}
+
+func (x *HTTPC2Profile) Reset() {
+	*x = HTTPC2Profile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5658,13 +5831,13 @@ func (x *ServiceInfoReq) Reset() {
 	}
 }
 
-func (x *ServiceInfoReq) String() string {
+func (x *HTTPC2Profile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceInfoReq) ProtoMessage() {}
+func (*HTTPC2Profile) ProtoMessage() {}
 
-func (x *ServiceInfoReq) ProtoReflect() protoreflect.Message {
+func (x *HTTPC2Profile) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5676,106 +5849,137 @@ func (x *ServiceInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceInfoReq.ProtoReflect.Descriptor instead.
-func (*ServiceInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use HTTPC2Profile.ProtoReflect.Descriptor instead.
+func (*HTTPC2Profile) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ServiceInfoReq) GetServiceName() string {
+func (x *HTTPC2Profile) GetUserAgent() string {
 	if x != nil {
-		return x.ServiceName
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *ServiceInfoReq) GetHostname() string {
+func (x *HTTPC2Profile) GetNonceQueryArgs() string {
 	if x != nil {
-		return x.Hostname
+		return x.NonceQueryArgs
 	}
 	return ""
 }
 
-type StopServiceReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *HTTPC2Profile) GetHeaders() []*HTTPC2NameValue {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
 
-	ServiceInfo *ServiceInfoReq   `protobuf:"bytes,1,opt,name=ServiceInfo,proto3" json:"ServiceInfo,omitempty"`
-	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+func (x *HTTPC2Profile) GetURLParameters() []*HTTPC2NameValue {
+	if x != nil {
+		return x.URLParameters
+	}
+	return nil
 }
 
-func (x *StopServiceReq) Reset() {
-	*x = StopServiceReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[82]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *HTTPC2Profile) GetPollPaths() []string {
+	if x != nil {
+		return x.PollPaths
 	}
+	return nil
 }
 
-func (x *StopServiceReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HTTPC2Profile) GetPollFiles() []string {
+	if x != nil {
+		return x.PollFiles
+	}
+	return nil
 }
 
-func (*StopServiceReq) ProtoMessage() {}
+func (x *HTTPC2Profile) GetPollFileExt() string {
+	if x != nil {
+		return x.PollFileExt
+	}
+	return ""
+}
 
-func (x *StopServiceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[82]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *HTTPC2Profile) GetSessionPaths() []string {
+	if x != nil {
+		return x.SessionPaths
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use StopServiceReq.ProtoReflect.Descriptor instead.
-func (*StopServiceReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{82}
+func (x *HTTPC2Profile) GetSessionFiles() []string {
+	if x != nil {
+		return x.SessionFiles
+	}
+	return nil
 }
 
-func (x *StopServiceReq) GetServiceInfo() *ServiceInfoReq {
+func (x *HTTPC2Profile) GetSessionFileExt() string {
 	if x != nil {
-		return x.ServiceInfo
+		return x.SessionFileExt
+	}
+	return ""
+}
+
+func (x *HTTPC2Profile) GetStartSessionFileExt() string {
+	if x != nil {
+		return x.StartSessionFileExt
+	}
+	return ""
+}
+
+func (x *HTTPC2Profile) GetClosePaths() []string {
+	if x != nil {
+		return x.ClosePaths
 	}
 	return nil
 }
 
-func (x *StopServiceReq) GetRequest() *commonpb.Request {
+func (x *HTTPC2Profile) GetCloseFiles() []string {
 	if x != nil {
-		return x.Request
+		return x.CloseFiles
 	}
 	return nil
 }
 
-type RemoveServiceReq struct {
+func (x *HTTPC2Profile) GetCloseFileExt() string {
+	if x != nil {
+		return x.CloseFileExt
+	}
+	return ""
+}
+
+type HTTPC2NameValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceInfo *ServiceInfoReq   `protobuf:"bytes,1,opt,name=ServiceInfo,proto3" json:"ServiceInfo,omitempty"`
-	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Value       string   `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Probability int32    `protobuf:"varint,3,opt,name=Probability,proto3" json:"Probability,omitempty"`
+	Methods     []string `protobuf:"bytes,4,rep,name=Methods,proto3" json:"Methods,omitempty"`
 }
 
-func (x *RemoveServiceReq) Reset() {
-	*x = RemoveServiceReq{}
+func (x *HTTPC2NameValue) Reset() {
+	*x = HTTPC2NameValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[83]
+		mi := &file_sliverpb_sliver_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveServiceReq) String() string {
+func (x *HTTPC2NameValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveServiceReq) ProtoMessage() {}
+func (*HTTPC2NameValue) ProtoMessage() {}
 
-func (x *RemoveServiceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[83]
+func (x *HTTPC2NameValue) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5786,54 +5990,65 @@ func (x *RemoveServiceReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveServiceReq.ProtoReflect.Descriptor instead.
-func (*RemoveServiceReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use HTTPC2NameValue.ProtoReflect.Descriptor instead.
+func (*HTTPC2NameValue) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *RemoveServiceReq) GetServiceInfo() *ServiceInfoReq {
+func (x *HTTPC2NameValue) GetName() string {
 	if x != nil {
-		return x.ServiceInfo
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *RemoveServiceReq) GetRequest() *commonpb.Request {
+func (x *HTTPC2NameValue) GetValue() string {
 	if x != nil {
-		return x.Request
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HTTPC2NameValue) GetProbability() int32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *HTTPC2NameValue) GetMethods() []string {
+	if x != nil {
+		return x.Methods
 	}
 	return nil
 }
 
-type RegistryReadReq struct {
+// ScreenshotReq - Request the implant take a screenshot
+type ScreenshotReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hive     string            `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
-	Path     string            `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
-	Key      string            `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
-	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistryReadReq) Reset() {
-	*x = RegistryReadReq{}
+func (x *ScreenshotReq) Reset() {
+	*x = ScreenshotReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[84]
+		mi := &file_sliverpb_sliver_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryReadReq) String() string {
+func (x *ScreenshotReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryReadReq) ProtoMessage() {}
+func (*ScreenshotReq) ProtoMessage() {}
 
-func (x *RegistryReadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[84]
+func (x *ScreenshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5844,72 +6059,44 @@ func (x *RegistryReadReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryReadReq.ProtoReflect.Descriptor instead.
-func (*RegistryReadReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *RegistryReadReq) GetHive() string {
-	if x != nil {
-		return x.Hive
-	}
-	return ""
-}
-
-func (x *RegistryReadReq) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RegistryReadReq) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *RegistryReadReq) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
+// Deprecated: Use ScreenshotReq.ProtoReflect.Descriptor instead.
+func (*ScreenshotReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *RegistryReadReq) GetRequest() *commonpb.Request {
+func (x *ScreenshotReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type RegistryRead struct {
+type Screenshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value    string             `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Data     []byte             `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
 	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RegistryRead) Reset() {
-	*x = RegistryRead{}
+func (x *Screenshot) Reset() {
+	*x = Screenshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[85]
+		mi := &file_sliverpb_sliver_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryRead) String() string {
+func (x *Screenshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryRead) ProtoMessage() {}
+func (*Screenshot) ProtoMessage() {}
 
-func (x *RegistryRead) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[85]
+func (x *Screenshot) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5920,59 +6107,55 @@ func (x *RegistryRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryRead.ProtoReflect.Descriptor instead.
-func (*RegistryRead) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use Screenshot.ProtoReflect.Descriptor instead.
+func (*Screenshot) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *RegistryRead) GetValue() string {
+func (x *Screenshot) GetData() []byte {
 	if x != nil {
-		return x.Value
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *RegistryRead) GetResponse() *commonpb.Response {
+func (x *Screenshot) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type RegistryWriteReq struct {
+type StartServiceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hive        string            `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
-	Path        string            `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
-	Key         string            `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
-	Hostname    string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	StringValue string            `protobuf:"bytes,5,opt,name=StringValue,proto3" json:"StringValue,omitempty"`
-	ByteValue   []byte            `protobuf:"bytes,6,opt,name=ByteValue,proto3" json:"ByteValue,omitempty"`
-	DWordValue  uint32            `protobuf:"varint,7,opt,name=DWordValue,proto3" json:"DWordValue,omitempty"`
-	QWordValue  uint64            `protobuf:"varint,8,opt,name=QWordValue,proto3" json:"QWordValue,omitempty"`
-	Type        uint32            `protobuf:"varint,10,opt,name=Type,proto3" json:"Type,omitempty"`
-	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	ServiceName        string            `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	ServiceDescription string            `protobuf:"bytes,2,opt,name=ServiceDescription,proto3" json:"ServiceDescription,omitempty"`
+	BinPath            string            `protobuf:"bytes,3,opt,name=BinPath,proto3" json:"BinPath,omitempty"`
+	Hostname           string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Arguments          string            `protobuf:"bytes,5,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
+	Request            *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistryWriteReq) Reset() {
-	*x = RegistryWriteReq{}
+func (x *StartServiceReq) Reset() {
+	*x = StartServiceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[86]
+		mi := &file_sliverpb_sliver_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistryWriteReq) String() string {
+func (x *StartServiceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryWriteReq) ProtoMessage() {}
+func (*StartServiceReq) ProtoMessage() {}
 
-func (x *RegistryWriteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[86]
+func (x *StartServiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5983,91 +6166,111 @@ func (x *RegistryWriteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryWriteReq.ProtoReflect.Descriptor instead.
-func (*RegistryWriteReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use StartServiceReq.ProtoReflect.Descriptor instead.
+func (*StartServiceReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *RegistryWriteReq) GetHive() string {
+func (x *StartServiceReq) GetServiceName() string {
 	if x != nil {
-		return x.Hive
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *RegistryWriteReq) GetPath() string {
+func (x *StartServiceReq) GetServiceDescription() string {
 	if x != nil {
-		return x.Path
+		return x.ServiceDescription
 	}
 	return ""
 }
 
-func (x *RegistryWriteReq) GetKey() string {
+func (x *StartServiceReq) GetBinPath() string {
 	if x != nil {
-		return x.Key
+		return x.BinPath
 	}
 	return ""
 }
 
-func (x *RegistryWriteReq) GetHostname() string {
+func (x *StartServiceReq) GetHostname() string {
 	if x != nil {
 		return x.Hostname
 	}
 	return ""
 }
 
-func (x *RegistryWriteReq) GetStringValue() string {
+func (x *StartServiceReq) GetArguments() string {
 	if x != nil {
-		return x.StringValue
+		return x.Arguments
 	}
 	return ""
 }
 
-func (x *RegistryWriteReq) GetByteValue() []byte {
+func (x *StartServiceReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.ByteValue
+		return x.Request
 	}
 	return nil
 }
 
-func (x *RegistryWriteReq) GetDWordValue() uint32 {
-	if x != nil {
-		return x.DWordValue
-	}
-	return 0
+type ServiceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RegistryWriteReq) GetQWordValue() uint64 {
-	if x != nil {
-		return x.QWordValue
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *RegistryWriteReq) GetType() uint32 {
-	if x != nil {
-		return x.Type
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RegistryWriteReq) GetRequest() *commonpb.Request {
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ServiceInfo) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Request
+		return x.Response
 	}
 	return nil
 }
 
-type RegistryWrite struct {
+type ServiceInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ServiceName string `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Hostname    string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
 }
 
-func (x *RegistryWrite) Reset() {
-	*x = RegistryWrite{}
+func (x *ServiceInfoReq) Reset() {
+	*x = ServiceInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6075,13 +6278,13 @@ func (x *RegistryWrite) Reset() {
 	}
 }
 
-func (x *RegistryWrite) String() string {
+func (x *ServiceInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryWrite) ProtoMessage() {}
+func (*ServiceInfoReq) ProtoMessage() {}
 
-func (x *RegistryWrite) ProtoReflect() protoreflect.Message {
+func (x *ServiceInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6093,32 +6296,36 @@ func (x *RegistryWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryWrite.ProtoReflect.Descriptor instead.
-func (*RegistryWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceInfoReq.ProtoReflect.Descriptor instead.
+func (*ServiceInfoReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *RegistryWrite) GetResponse() *commonpb.Response {
+func (x *ServiceInfoReq) GetServiceName() string {
 	if x != nil {
-		return x.Response
+		return x.ServiceName
 	}
-	return nil
+	return ""
 }
 
-type RegistryCreateKeyReq struct {
+func (x *ServiceInfoReq) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type StopServiceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hive     string            `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
-	Path     string            `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
-	Key      string            `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
-	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	ServiceInfo *ServiceInfoReq   `protobuf:"bytes,1,opt,name=ServiceInfo,proto3" json:"ServiceInfo,omitempty"`
+	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistryCreateKeyReq) Reset() {
-	*x = RegistryCreateKeyReq{}
+func (x *StopServiceReq) Reset() {
+	*x = StopServiceReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6126,13 +6333,13 @@ func (x *RegistryCreateKeyReq) Reset() {
 	}
 }
 
-func (x *RegistryCreateKeyReq) String() string {
+func (x *StopServiceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryCreateKeyReq) ProtoMessage() {}
+func (*StopServiceReq) ProtoMessage() {}
 
-func (x *RegistryCreateKeyReq) ProtoReflect() protoreflect.Message {
+func (x *StopServiceReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6144,56 +6351,36 @@ func (x *RegistryCreateKeyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryCreateKeyReq.ProtoReflect.Descriptor instead.
-func (*RegistryCreateKeyReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopServiceReq.ProtoReflect.Descriptor instead.
+func (*StopServiceReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *RegistryCreateKeyReq) GetHive() string {
-	if x != nil {
-		return x.Hive
-	}
-	return ""
-}
-
-func (x *RegistryCreateKeyReq) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RegistryCreateKeyReq) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *RegistryCreateKeyReq) GetHostname() string {
+func (x *StopServiceReq) GetServiceInfo() *ServiceInfoReq {
 	if x != nil {
-		return x.Hostname
+		return x.ServiceInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *RegistryCreateKeyReq) GetRequest() *commonpb.Request {
+func (x *StopServiceReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type RegistryCreateKey struct {
+type RemoveServiceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ServiceInfo *ServiceInfoReq   `protobuf:"bytes,1,opt,name=ServiceInfo,proto3" json:"ServiceInfo,omitempty"`
+	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistryCreateKey) Reset() {
-	*x = RegistryCreateKey{}
+func (x *RemoveServiceReq) Reset() {
+	*x = RemoveServiceReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6201,13 +6388,13 @@ func (x *RegistryCreateKey) Reset() {
 	}
 }
 
-func (x *RegistryCreateKey) String() string {
+func (x *RemoveServiceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryCreateKey) ProtoMessage() {}
+func (*RemoveServiceReq) ProtoMessage() {}
 
-func (x *RegistryCreateKey) ProtoReflect() protoreflect.Message {
+func (x *RemoveServiceReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6219,19 +6406,26 @@ func (x *RegistryCreateKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryCreateKey.ProtoReflect.Descriptor instead.
-func (*RegistryCreateKey) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServiceReq.ProtoReflect.Descriptor instead.
+func (*RemoveServiceReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *RegistryCreateKey) GetResponse() *commonpb.Response {
+func (x *RemoveServiceReq) GetServiceInfo() *ServiceInfoReq {
 	if x != nil {
-		return x.Response
+		return x.ServiceInfo
 	}
 	return nil
 }
 
-type RegistryDeleteKeyReq struct {
+func (x *RemoveServiceReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RegistryReadReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -6243,8 +6437,8 @@ type RegistryDeleteKeyReq struct {
 	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistryDeleteKeyReq) Reset() {
-	*x = RegistryDeleteKeyReq{}
+func (x *RegistryReadReq) Reset() {
+	*x = RegistryReadReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6252,13 +6446,13 @@ func (x *RegistryDeleteKeyReq) Reset() {
 	}
 }
 
-func (x *RegistryDeleteKeyReq) String() string {
+func (x *RegistryReadReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryDeleteKeyReq) ProtoMessage() {}
+func (*RegistryReadReq) ProtoMessage() {}
 
-func (x *RegistryDeleteKeyReq) ProtoReflect() protoreflect.Message {
+func (x *RegistryReadReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6270,56 +6464,57 @@ func (x *RegistryDeleteKeyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryDeleteKeyReq.ProtoReflect.Descriptor instead.
-func (*RegistryDeleteKeyReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryReadReq.ProtoReflect.Descriptor instead.
+func (*RegistryReadReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RegistryDeleteKeyReq) GetHive() string {
+func (x *RegistryReadReq) GetHive() string {
 	if x != nil {
 		return x.Hive
 	}
 	return ""
 }
 
-func (x *RegistryDeleteKeyReq) GetPath() string {
+func (x *RegistryReadReq) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-func (x *RegistryDeleteKeyReq) GetKey() string {
+func (x *RegistryReadReq) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-func (x *RegistryDeleteKeyReq) GetHostname() string {
+func (x *RegistryReadReq) GetHostname() string {
 	if x != nil {
 		return x.Hostname
 	}
 	return ""
 }
 
-func (x *RegistryDeleteKeyReq) GetRequest() *commonpb.Request {
+func (x *RegistryReadReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type RegistryDeleteKey struct {
+type RegistryRead struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	Value    string             `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
 	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RegistryDeleteKey) Reset() {
-	*x = RegistryDeleteKey{}
+func (x *RegistryRead) Reset() {
+	*x = RegistryRead{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6327,13 +6522,13 @@ func (x *RegistryDeleteKey) Reset() {
 	}
 }
 
-func (x *RegistryDeleteKey) String() string {
+func (x *RegistryRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryDeleteKey) ProtoMessage() {}
+func (*RegistryRead) ProtoMessage() {}
 
-func (x *RegistryDeleteKey) ProtoReflect() protoreflect.Message {
+func (x *RegistryRead) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6345,32 +6540,44 @@ func (x *RegistryDeleteKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryDeleteKey.ProtoReflect.Descriptor instead.
-func (*RegistryDeleteKey) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryRead.ProtoReflect.Descriptor instead.
+func (*RegistryRead) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RegistryDeleteKey) GetResponse() *commonpb.Response {
+func (x *RegistryRead) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RegistryRead) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type RegistrySubKeyListReq struct {
+type RegistryWriteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hive string `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
-	// Keep the same ID as the other registry operations
-	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Hive        string            `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
+	Path        string            `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	Key         string            `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+	Hostname    string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	StringValue string            `protobuf:"bytes,5,opt,name=StringValue,proto3" json:"StringValue,omitempty"`
+	ByteValue   []byte            `protobuf:"bytes,6,opt,name=ByteValue,proto3" json:"ByteValue,omitempty"`
+	DWordValue  uint32            `protobuf:"varint,7,opt,name=DWordValue,proto3" json:"DWordValue,omitempty"`
+	QWordValue  uint64            `protobuf:"varint,8,opt,name=QWordValue,proto3" json:"QWordValue,omitempty"`
+	Type        uint32            `protobuf:"varint,10,opt,name=Type,proto3" json:"Type,omitempty"`
+	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistrySubKeyListReq) Reset() {
-	*x = RegistrySubKeyListReq{}
+func (x *RegistryWriteReq) Reset() {
+	*x = RegistryWriteReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6378,13 +6585,13 @@ func (x *RegistrySubKeyListReq) Reset() {
 	}
 }
 
-func (x *RegistrySubKeyListReq) String() string {
+func (x *RegistryWriteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistrySubKeyListReq) ProtoMessage() {}
+func (*RegistryWriteReq) ProtoMessage() {}
 
-func (x *RegistrySubKeyListReq) ProtoReflect() protoreflect.Message {
+func (x *RegistryWriteReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6396,50 +6603,91 @@ func (x *RegistrySubKeyListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistrySubKeyListReq.ProtoReflect.Descriptor instead.
-func (*RegistrySubKeyListReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryWriteReq.ProtoReflect.Descriptor instead.
+func (*RegistryWriteReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *RegistrySubKeyListReq) GetHive() string {
+func (x *RegistryWriteReq) GetHive() string {
 	if x != nil {
 		return x.Hive
 	}
 	return ""
 }
 
-func (x *RegistrySubKeyListReq) GetPath() string {
+func (x *RegistryWriteReq) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-func (x *RegistrySubKeyListReq) GetHostname() string {
+func (x *RegistryWriteReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegistryWriteReq) GetHostname() string {
 	if x != nil {
 		return x.Hostname
 	}
 	return ""
 }
 
-func (x *RegistrySubKeyListReq) GetRequest() *commonpb.Request {
+func (x *RegistryWriteReq) GetStringValue() string {
 	if x != nil {
-		return x.Request
+		return x.StringValue
 	}
-	return nil
+	return ""
 }
 
-type RegistrySubKeyList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *RegistryWriteReq) GetByteValue() []byte {
+	if x != nil {
+		return x.ByteValue
+	}
+	return nil
+}
+
+func (x *RegistryWriteReq) GetDWordValue() uint32 {
+	if x != nil {
+		return x.DWordValue
+	}
+	return 0
+}
+
+func (x *RegistryWriteReq) GetQWordValue() uint64 {
+	if x != nil {
+		return x.QWordValue
+	}
+	return 0
+}
+
+func (x *RegistryWriteReq) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *RegistryWriteReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RegistryWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subkeys  []string           `protobuf:"bytes,1,rep,name=Subkeys,proto3" json:"Subkeys,omitempty"`
 	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RegistrySubKeyList) Reset() {
-	*x = RegistrySubKeyList{}
+func (x *RegistryWrite) Reset() {
+	*x = RegistryWrite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6447,13 +6695,13 @@ func (x *RegistrySubKeyList) Reset() {
 	}
 }
 
-func (x *RegistrySubKeyList) String() string {
+func (x *RegistryWrite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistrySubKeyList) ProtoMessage() {}
+func (*RegistryWrite) ProtoMessage() {}
 
-func (x *RegistrySubKeyList) ProtoReflect() protoreflect.Message {
+func (x *RegistryWrite) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6465,39 +6713,32 @@ func (x *RegistrySubKeyList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistrySubKeyList.ProtoReflect.Descriptor instead.
-func (*RegistrySubKeyList) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryWrite.ProtoReflect.Descriptor instead.
+func (*RegistryWrite) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *RegistrySubKeyList) GetSubkeys() []string {
-	if x != nil {
-		return x.Subkeys
-	}
-	return nil
-}
-
-func (x *RegistrySubKeyList) GetResponse() *commonpb.Response {
+func (x *RegistryWrite) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type RegistryListValuesReq struct {
+type RegistryCreateKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hive string `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
-	// Keep the same ID as the other registry operations
+	Hive     string            `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
+	Path     string            `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	Key      string            `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
 	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
 	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegistryListValuesReq) Reset() {
-	*x = RegistryListValuesReq{}
+func (x *RegistryCreateKeyReq) Reset() {
+	*x = RegistryCreateKeyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6505,13 +6746,13 @@ func (x *RegistryListValuesReq) Reset() {
 	}
 }
 
-func (x *RegistryListValuesReq) String() string {
+func (x *RegistryCreateKeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryListValuesReq) ProtoMessage() {}
+func (*RegistryCreateKeyReq) ProtoMessage() {}
 
-func (x *RegistryListValuesReq) ProtoReflect() protoreflect.Message {
+func (x *RegistryCreateKeyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6523,50 +6764,56 @@ func (x *RegistryListValuesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryListValuesReq.ProtoReflect.Descriptor instead.
-func (*RegistryListValuesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryCreateKeyReq.ProtoReflect.Descriptor instead.
+func (*RegistryCreateKeyReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RegistryListValuesReq) GetHive() string {
+func (x *RegistryCreateKeyReq) GetHive() string {
 	if x != nil {
 		return x.Hive
 	}
 	return ""
 }
 
-func (x *RegistryListValuesReq) GetPath() string {
+func (x *RegistryCreateKeyReq) GetPath() string {
 	if x != nil {
 		return x.Path
 	}
 	return ""
 }
 
-func (x *RegistryListValuesReq) GetHostname() string {
+func (x *RegistryCreateKeyReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegistryCreateKeyReq) GetHostname() string {
 	if x != nil {
 		return x.Hostname
 	}
 	return ""
 }
 
-func (x *RegistryListValuesReq) GetRequest() *commonpb.Request {
+func (x *RegistryCreateKeyReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type RegistryValuesList struct {
+type RegistryCreateKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValueNames []string           `protobuf:"bytes,1,rep,name=ValueNames,proto3" json:"ValueNames,omitempty"`
-	Response   *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RegistryValuesList) Reset() {
-	*x = RegistryValuesList{}
+func (x *RegistryCreateKey) Reset() {
+	*x = RegistryCreateKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6574,13 +6821,13 @@ func (x *RegistryValuesList) Reset() {
 	}
 }
 
-func (x *RegistryValuesList) String() string {
+func (x *RegistryCreateKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistryValuesList) ProtoMessage() {}
+func (*RegistryCreateKey) ProtoMessage() {}
 
-func (x *RegistryValuesList) ProtoReflect() protoreflect.Message {
+func (x *RegistryCreateKey) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6592,37 +6839,32 @@ func (x *RegistryValuesList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistryValuesList.ProtoReflect.Descriptor instead.
-func (*RegistryValuesList) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryCreateKey.ProtoReflect.Descriptor instead.
+func (*RegistryCreateKey) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RegistryValuesList) GetValueNames() []string {
-	if x != nil {
-		return x.ValueNames
-	}
-	return nil
-}
-
-func (x *RegistryValuesList) GetResponse() *commonpb.Response {
+func (x *RegistryCreateKey) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-// Tunnel - Tunnel related messages
-type Tunnel struct {
+type RegistryDeleteKeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TunnelID  uint64 `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
-	SessionID string `protobuf:"bytes,9,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Hive     string            `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
+	Path     string            `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	Key      string            `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *Tunnel) Reset() {
-	*x = Tunnel{}
+func (x *RegistryDeleteKeyReq) Reset() {
+	*x = RegistryDeleteKeyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6630,13 +6872,13 @@ func (x *Tunnel) Reset() {
 	}
 }
 
-func (x *Tunnel) String() string {
+func (x *RegistryDeleteKeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tunnel) ProtoMessage() {}
+func (*RegistryDeleteKeyReq) ProtoMessage() {}
 
-func (x *Tunnel) ProtoReflect() protoreflect.Message {
+func (x *RegistryDeleteKeyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6648,43 +6890,56 @@ func (x *Tunnel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
-func (*Tunnel) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryDeleteKeyReq.ProtoReflect.Descriptor instead.
+func (*RegistryDeleteKeyReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *Tunnel) GetTunnelID() uint64 {
+func (x *RegistryDeleteKeyReq) GetHive() string {
 	if x != nil {
-		return x.TunnelID
+		return x.Hive
 	}
-	return 0
+	return ""
 }
 
-func (x *Tunnel) GetSessionID() string {
+func (x *RegistryDeleteKeyReq) GetPath() string {
 	if x != nil {
-		return x.SessionID
+		return x.Path
 	}
 	return ""
 }
 
-type TunnelData struct {
+func (x *RegistryDeleteKeyReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RegistryDeleteKeyReq) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RegistryDeleteKeyReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RegistryDeleteKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data          []byte    `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
-	Closed        bool      `protobuf:"varint,2,opt,name=Closed,proto3" json:"Closed,omitempty"`
-	Sequence      uint64    `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
-	Ack           uint64    `protobuf:"varint,4,opt,name=Ack,proto3" json:"Ack,omitempty"`
-	Resend        bool      `protobuf:"varint,5,opt,name=Resend,proto3" json:"Resend,omitempty"`
-	CreateReverse bool      `protobuf:"varint,6,opt,name=CreateReverse,proto3" json:"CreateReverse,omitempty"`
-	Rportfwd      *RPortfwd `protobuf:"bytes,7,opt,name=rportfwd,proto3" json:"rportfwd,omitempty"`
-	TunnelID      uint64    `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
-	SessionID     string    `protobuf:"bytes,9,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *TunnelData) Reset() {
-	*x = TunnelData{}
+func (x *RegistryDeleteKey) Reset() {
+	*x = RegistryDeleteKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6692,13 +6947,13 @@ func (x *TunnelData) Reset() {
 	}
 }
 
-func (x *TunnelData) String() string {
+func (x *RegistryDeleteKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelData) ProtoMessage() {}
+func (*RegistryDeleteKey) ProtoMessage() {}
 
-func (x *TunnelData) ProtoReflect() protoreflect.Message {
+func (x *RegistryDeleteKey) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6710,103 +6965,46 @@ func (x *TunnelData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelData.ProtoReflect.Descriptor instead.
-func (*TunnelData) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryDeleteKey.ProtoReflect.Descriptor instead.
+func (*RegistryDeleteKey) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *TunnelData) GetData() []byte {
+func (x *RegistryDeleteKey) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Data
+		return x.Response
 	}
 	return nil
 }
 
-func (x *TunnelData) GetClosed() bool {
-	if x != nil {
-		return x.Closed
-	}
-	return false
-}
+type RegistrySubKeyListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *TunnelData) GetSequence() uint64 {
-	if x != nil {
-		return x.Sequence
-	}
-	return 0
+	Hive string `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	// Keep the same ID as the other registry operations
+	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *TunnelData) GetAck() uint64 {
-	if x != nil {
-		return x.Ack
+func (x *RegistrySubKeyListReq) Reset() {
+	*x = RegistrySubKeyListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TunnelData) GetResend() bool {
-	if x != nil {
-		return x.Resend
-	}
-	return false
+func (x *RegistrySubKeyListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TunnelData) GetCreateReverse() bool {
-	if x != nil {
-		return x.CreateReverse
-	}
-	return false
-}
+func (*RegistrySubKeyListReq) ProtoMessage() {}
 
-func (x *TunnelData) GetRportfwd() *RPortfwd {
-	if x != nil {
-		return x.Rportfwd
-	}
-	return nil
-}
-
-func (x *TunnelData) GetTunnelID() uint64 {
-	if x != nil {
-		return x.TunnelID
-	}
-	return 0
-}
-
-func (x *TunnelData) GetSessionID() string {
-	if x != nil {
-		return x.SessionID
-	}
-	return ""
-}
-
-// ShellReq - Request the implant open a realtime shell tunnel
-type ShellReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Path      string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	EnablePTY bool              `protobuf:"varint,2,opt,name=EnablePTY,proto3" json:"EnablePTY,omitempty"`
-	Pid       uint32            `protobuf:"varint,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
-	TunnelID  uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
-	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
-}
-
-func (x *ShellReq) Reset() {
-	*x = ShellReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[98]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ShellReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ShellReq) ProtoMessage() {}
-
-func (x *ShellReq) ProtoReflect() protoreflect.Message {
+func (x *RegistrySubKeyListReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6818,61 +7016,50 @@ func (x *ShellReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShellReq.ProtoReflect.Descriptor instead.
-func (*ShellReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrySubKeyListReq.ProtoReflect.Descriptor instead.
+func (*RegistrySubKeyListReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ShellReq) GetPath() string {
+func (x *RegistrySubKeyListReq) GetHive() string {
 	if x != nil {
-		return x.Path
+		return x.Hive
 	}
 	return ""
 }
 
-func (x *ShellReq) GetEnablePTY() bool {
-	if x != nil {
-		return x.EnablePTY
-	}
-	return false
-}
-
-func (x *ShellReq) GetPid() uint32 {
+func (x *RegistrySubKeyListReq) GetPath() string {
 	if x != nil {
-		return x.Pid
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *ShellReq) GetTunnelID() uint64 {
+func (x *RegistrySubKeyListReq) GetHostname() string {
 	if x != nil {
-		return x.TunnelID
+		return x.Hostname
 	}
-	return 0
+	return ""
 }
 
-func (x *ShellReq) GetRequest() *commonpb.Request {
+func (x *RegistrySubKeyListReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-// Shell - Request the implant open a realtime shell tunnel
-type Shell struct {
+type RegistrySubKeyList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path      string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	EnablePTY bool               `protobuf:"varint,2,opt,name=EnablePTY,proto3" json:"EnablePTY,omitempty"`
-	Pid       uint32             `protobuf:"varint,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
-	TunnelID  uint64             `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
-	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Subkeys  []string           `protobuf:"bytes,1,rep,name=Subkeys,proto3" json:"Subkeys,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *Shell) Reset() {
-	*x = Shell{}
+func (x *RegistrySubKeyList) Reset() {
+	*x = RegistrySubKeyList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6880,13 +7067,13 @@ func (x *Shell) Reset() {
 	}
 }
 
-func (x *Shell) String() string {
+func (x *RegistrySubKeyList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shell) ProtoMessage() {}
+func (*RegistrySubKeyList) ProtoMessage() {}
 
-func (x *Shell) ProtoReflect() protoreflect.Message {
+func (x *RegistrySubKeyList) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6898,60 +7085,39 @@ func (x *Shell) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shell.ProtoReflect.Descriptor instead.
-func (*Shell) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrySubKeyList.ProtoReflect.Descriptor instead.
+func (*RegistrySubKeyList) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *Shell) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *Shell) GetEnablePTY() bool {
-	if x != nil {
-		return x.EnablePTY
-	}
-	return false
-}
-
-func (x *Shell) GetPid() uint32 {
-	if x != nil {
-		return x.Pid
-	}
-	return 0
-}
-
-func (x *Shell) GetTunnelID() uint64 {
+func (x *RegistrySubKeyList) GetSubkeys() []string {
 	if x != nil {
-		return x.TunnelID
+		return x.Subkeys
 	}
-	return 0
+	return nil
 }
 
-func (x *Shell) GetResponse() *commonpb.Response {
+func (x *RegistrySubKeyList) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type PortfwdReq struct {
+type RegistryListValuesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port     uint32            `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
-	Protocol int32             `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	Host     string            `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
-	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Hive string `protobuf:"bytes,1,opt,name=Hive,proto3" json:"Hive,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	// Keep the same ID as the other registry operations
+	Hostname string            `protobuf:"bytes,4,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
 	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PortfwdReq) Reset() {
-	*x = PortfwdReq{}
+func (x *RegistryListValuesReq) Reset() {
+	*x = RegistryListValuesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6959,13 +7125,13 @@ func (x *PortfwdReq) Reset() {
 	}
 }
 
-func (x *PortfwdReq) String() string {
+func (x *RegistryListValuesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortfwdReq) ProtoMessage() {}
+func (*RegistryListValuesReq) ProtoMessage() {}
 
-func (x *PortfwdReq) ProtoReflect() protoreflect.Message {
+func (x *RegistryListValuesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6977,60 +7143,50 @@ func (x *PortfwdReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortfwdReq.ProtoReflect.Descriptor instead.
-func (*PortfwdReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryListValuesReq.ProtoReflect.Descriptor instead.
+func (*RegistryListValuesReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *PortfwdReq) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *PortfwdReq) GetProtocol() int32 {
+func (x *RegistryListValuesReq) GetHive() string {
 	if x != nil {
-		return x.Protocol
+		return x.Hive
 	}
-	return 0
+	return ""
 }
 
-func (x *PortfwdReq) GetHost() string {
+func (x *RegistryListValuesReq) GetPath() string {
 	if x != nil {
-		return x.Host
+		return x.Path
 	}
 	return ""
 }
 
-func (x *PortfwdReq) GetTunnelID() uint64 {
+func (x *RegistryListValuesReq) GetHostname() string {
 	if x != nil {
-		return x.TunnelID
+		return x.Hostname
 	}
-	return 0
+	return ""
 }
 
-func (x *PortfwdReq) GetRequest() *commonpb.Request {
+func (x *RegistryListValuesReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type Portfwd struct {
+type RegistryValuesList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port     uint32             `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
-	Protocol int32              `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	Host     string             `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
-	TunnelID uint64             `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ValueNames []string           `protobuf:"bytes,1,rep,name=ValueNames,proto3" json:"ValueNames,omitempty"`
+	Response   *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *Portfwd) Reset() {
-	*x = Portfwd{}
+func (x *RegistryValuesList) Reset() {
+	*x = RegistryValuesList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7038,13 +7194,13 @@ func (x *Portfwd) Reset() {
 	}
 }
 
-func (x *Portfwd) String() string {
+func (x *RegistryValuesList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Portfwd) ProtoMessage() {}
+func (*RegistryValuesList) ProtoMessage() {}
 
-func (x *Portfwd) ProtoReflect() protoreflect.Message {
+func (x *RegistryValuesList) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7056,47 +7212,27 @@ func (x *Portfwd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Portfwd.ProtoReflect.Descriptor instead.
-func (*Portfwd) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistryValuesList.ProtoReflect.Descriptor instead.
+func (*RegistryValuesList) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *Portfwd) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *Portfwd) GetProtocol() int32 {
-	if x != nil {
-		return x.Protocol
-	}
-	return 0
-}
-
-func (x *Portfwd) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *Portfwd) GetTunnelID() uint64 {
+func (x *RegistryValuesList) GetValueNames() []string {
 	if x != nil {
-		return x.TunnelID
+		return x.ValueNames
 	}
-	return 0
+	return nil
 }
 
-func (x *Portfwd) GetResponse() *commonpb.Response {
+func (x *RegistryValuesList) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type Socks struct {
+// Tunnel - Tunnel related messages
+type Tunnel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -7105,8 +7241,8 @@ type Socks struct {
 	SessionID string `protobuf:"bytes,9,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
 }
 
-func (x *Socks) Reset() {
-	*x = Socks{}
+func (x *Tunnel) Reset() {
+	*x = Tunnel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7114,13 +7250,13 @@ func (x *Socks) Reset() {
 	}
 }
 
-func (x *Socks) String() string {
+func (x *Tunnel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Socks) ProtoMessage() {}
+func (*Tunnel) ProtoMessage() {}
 
-func (x *Socks) ProtoReflect() protoreflect.Message {
+func (x *Tunnel) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7132,41 +7268,43 @@ func (x *Socks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Socks.ProtoReflect.Descriptor instead.
-func (*Socks) Descriptor() ([]byte, []int) {
+// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
+func (*Tunnel) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *Socks) GetTunnelID() uint64 {
+func (x *Tunnel) GetTunnelID() uint64 {
 	if x != nil {
 		return x.TunnelID
 	}
 	return 0
 }
 
-func (x *Socks) GetSessionID() string {
+func (x *Tunnel) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-type SocksData struct {
+type TunnelData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data      []byte            `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
-	CloseConn bool              `protobuf:"varint,2,opt,name=CloseConn,proto3" json:"CloseConn,omitempty"`
-	Username  string            `protobuf:"bytes,3,opt,name=Username,proto3" json:"Username,omitempty"`
-	Password  string            `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
-	Sequence  uint64            `protobuf:"varint,5,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
-	TunnelID  uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
-	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Data          []byte    `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Closed        bool      `protobuf:"varint,2,opt,name=Closed,proto3" json:"Closed,omitempty"`
+	Sequence      uint64    `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Ack           uint64    `protobuf:"varint,4,opt,name=Ack,proto3" json:"Ack,omitempty"`
+	Resend        bool      `protobuf:"varint,5,opt,name=Resend,proto3" json:"Resend,omitempty"`
+	CreateReverse bool      `protobuf:"varint,6,opt,name=CreateReverse,proto3" json:"CreateReverse,omitempty"`
+	Rportfwd      *RPortfwd `protobuf:"bytes,7,opt,name=rportfwd,proto3" json:"rportfwd,omitempty"`
+	TunnelID      uint64    `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	SessionID     string    `protobuf:"bytes,9,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
 }
 
-func (x *SocksData) Reset() {
-	*x = SocksData{}
+func (x *TunnelData) Reset() {
+	*x = TunnelData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7174,13 +7312,13 @@ func (x *SocksData) Reset() {
 	}
 }
 
-func (x *SocksData) String() string {
+func (x *TunnelData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SocksData) ProtoMessage() {}
+func (*TunnelData) ProtoMessage() {}
 
-func (x *SocksData) ProtoReflect() protoreflect.Message {
+func (x *TunnelData) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7192,73 +7330,91 @@ func (x *SocksData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SocksData.ProtoReflect.Descriptor instead.
-func (*SocksData) Descriptor() ([]byte, []int) {
+// Deprecated: Use TunnelData.ProtoReflect.Descriptor instead.
+func (*TunnelData) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *SocksData) GetData() []byte {
+func (x *TunnelData) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *SocksData) GetCloseConn() bool {
+func (x *TunnelData) GetClosed() bool {
 	if x != nil {
-		return x.CloseConn
+		return x.Closed
 	}
 	return false
 }
 
-func (x *SocksData) GetUsername() string {
+func (x *TunnelData) GetSequence() uint64 {
 	if x != nil {
-		return x.Username
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (x *SocksData) GetPassword() string {
+func (x *TunnelData) GetAck() uint64 {
 	if x != nil {
-		return x.Password
+		return x.Ack
 	}
-	return ""
+	return 0
 }
 
-func (x *SocksData) GetSequence() uint64 {
+func (x *TunnelData) GetResend() bool {
 	if x != nil {
-		return x.Sequence
+		return x.Resend
 	}
-	return 0
+	return false
 }
 
-func (x *SocksData) GetTunnelID() uint64 {
+func (x *TunnelData) GetCreateReverse() bool {
+	if x != nil {
+		return x.CreateReverse
+	}
+	return false
+}
+
+func (x *TunnelData) GetRportfwd() *RPortfwd {
+	if x != nil {
+		return x.Rportfwd
+	}
+	return nil
+}
+
+func (x *TunnelData) GetTunnelID() uint64 {
 	if x != nil {
 		return x.TunnelID
 	}
 	return 0
 }
 
-func (x *SocksData) GetRequest() *commonpb.Request {
+func (x *TunnelData) GetSessionID() string {
 	if x != nil {
-		return x.Request
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type PivotStartListenerReq struct {
+// ShellReq - Request the implant open a realtime shell tunnel
+type ShellReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type        PivotType         `protobuf:"varint,1,opt,name=Type,proto3,enum=sliverpb.PivotType" json:"Type,omitempty"`
-	BindAddress string            `protobuf:"bytes,2,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
-	Options     []bool            `protobuf:"varint,3,rep,packed,name=Options,proto3" json:"Options,omitempty"`
-	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Path      string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	EnablePTY bool              `protobuf:"varint,2,opt,name=EnablePTY,proto3" json:"EnablePTY,omitempty"`
+	Pid       uint32            `protobuf:"varint,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Rows      uint32            `protobuf:"varint,4,opt,name=Rows,proto3" json:"Rows,omitempty"` // Initial size of the PTY
+	Cols      uint32            `protobuf:"varint,5,opt,name=Cols,proto3" json:"Cols,omitempty"`
+	TunnelID  uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PivotStartListenerReq) Reset() {
-	*x = PivotStartListenerReq{}
+func (x *ShellReq) Reset() {
+	*x = ShellReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7266,13 +7422,13 @@ func (x *PivotStartListenerReq) Reset() {
 	}
 }
 
-func (x *PivotStartListenerReq) String() string {
+func (x *ShellReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotStartListenerReq) ProtoMessage() {}
+func (*ShellReq) ProtoMessage() {}
 
-func (x *PivotStartListenerReq) ProtoReflect() protoreflect.Message {
+func (x *ShellReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7284,50 +7440,75 @@ func (x *PivotStartListenerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotStartListenerReq.ProtoReflect.Descriptor instead.
-func (*PivotStartListenerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShellReq.ProtoReflect.Descriptor instead.
+func (*ShellReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *PivotStartListenerReq) GetType() PivotType {
+func (x *ShellReq) GetPath() string {
 	if x != nil {
-		return x.Type
+		return x.Path
 	}
-	return PivotType_TCP
+	return ""
 }
 
-func (x *PivotStartListenerReq) GetBindAddress() string {
+func (x *ShellReq) GetEnablePTY() bool {
 	if x != nil {
-		return x.BindAddress
+		return x.EnablePTY
 	}
-	return ""
+	return false
 }
 
-func (x *PivotStartListenerReq) GetOptions() []bool {
+func (x *ShellReq) GetPid() uint32 {
 	if x != nil {
-		return x.Options
+		return x.Pid
 	}
-	return nil
+	return 0
 }
 
-func (x *PivotStartListenerReq) GetRequest() *commonpb.Request {
+func (x *ShellReq) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ShellReq) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *ShellReq) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
+	}
+	return 0
+}
+
+func (x *ShellReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type PivotStopListenerReq struct {
+// Shell - Request the implant open a realtime shell tunnel
+type Shell struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      uint32            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Path      string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	EnablePTY bool               `protobuf:"varint,2,opt,name=EnablePTY,proto3" json:"EnablePTY,omitempty"`
+	Pid       uint32             `protobuf:"varint,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	TunnelID  uint64             `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *PivotStopListenerReq) Reset() {
-	*x = PivotStopListenerReq{}
+func (x *Shell) Reset() {
+	*x = Shell{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7335,13 +7516,13 @@ func (x *PivotStopListenerReq) Reset() {
 	}
 }
 
-func (x *PivotStopListenerReq) String() string {
+func (x *Shell) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotStopListenerReq) ProtoMessage() {}
+func (*Shell) ProtoMessage() {}
 
-func (x *PivotStopListenerReq) ProtoReflect() protoreflect.Message {
+func (x *Shell) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7353,39 +7534,60 @@ func (x *PivotStopListenerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotStopListenerReq.ProtoReflect.Descriptor instead.
-func (*PivotStopListenerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Shell.ProtoReflect.Descriptor instead.
+func (*Shell) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *PivotStopListenerReq) GetID() uint32 {
+func (x *Shell) GetPath() string {
 	if x != nil {
-		return x.ID
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Shell) GetEnablePTY() bool {
+	if x != nil {
+		return x.EnablePTY
+	}
+	return false
+}
+
+func (x *Shell) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
 	}
 	return 0
 }
 
-func (x *PivotStopListenerReq) GetRequest() *commonpb.Request {
+func (x *Shell) GetTunnelID() uint64 {
 	if x != nil {
-		return x.Request
+		return x.TunnelID
+	}
+	return 0
+}
+
+func (x *Shell) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
 	}
 	return nil
 }
 
-type PivotListener struct {
+// ShellResizeReq - Resize the PTY of a shell, e.g. the operator's terminal was resized
+type ShellResizeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Type        PivotType          `protobuf:"varint,2,opt,name=Type,proto3,enum=sliverpb.PivotType" json:"Type,omitempty"`
-	BindAddress string             `protobuf:"bytes,3,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
-	Pivots      []*NetConnPivot    `protobuf:"bytes,4,rep,name=Pivots,proto3" json:"Pivots,omitempty"`
-	Response    *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Rows     uint32            `protobuf:"varint,1,opt,name=Rows,proto3" json:"Rows,omitempty"`
+	Cols     uint32            `protobuf:"varint,2,opt,name=Cols,proto3" json:"Cols,omitempty"`
+	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PivotListener) Reset() {
-	*x = PivotListener{}
+func (x *ShellResizeReq) Reset() {
+	*x = ShellResizeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7393,13 +7595,13 @@ func (x *PivotListener) Reset() {
 	}
 }
 
-func (x *PivotListener) String() string {
+func (x *ShellResizeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotListener) ProtoMessage() {}
+func (*ShellResizeReq) ProtoMessage() {}
 
-func (x *PivotListener) ProtoReflect() protoreflect.Message {
+func (x *ShellResizeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7411,59 +7613,49 @@ func (x *PivotListener) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotListener.ProtoReflect.Descriptor instead.
-func (*PivotListener) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShellResizeReq.ProtoReflect.Descriptor instead.
+func (*ShellResizeReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *PivotListener) GetID() uint32 {
+func (x *ShellResizeReq) GetRows() uint32 {
 	if x != nil {
-		return x.ID
+		return x.Rows
 	}
 	return 0
 }
 
-func (x *PivotListener) GetType() PivotType {
-	if x != nil {
-		return x.Type
-	}
-	return PivotType_TCP
-}
-
-func (x *PivotListener) GetBindAddress() string {
+func (x *ShellResizeReq) GetCols() uint32 {
 	if x != nil {
-		return x.BindAddress
+		return x.Cols
 	}
-	return ""
+	return 0
 }
 
-func (x *PivotListener) GetPivots() []*NetConnPivot {
+func (x *ShellResizeReq) GetTunnelID() uint64 {
 	if x != nil {
-		return x.Pivots
+		return x.TunnelID
 	}
-	return nil
+	return 0
 }
 
-func (x *PivotListener) GetResponse() *commonpb.Response {
+func (x *ShellResizeReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type PivotHello struct {
+type ShellResize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey          []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
-	PeerID             int64  `protobuf:"varint,2,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	PublicKeySignature string `protobuf:"bytes,3,opt,name=PublicKeySignature,proto3" json:"PublicKeySignature,omitempty"`
-	SessionKey         []byte `protobuf:"bytes,4,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *PivotHello) Reset() {
-	*x = PivotHello{}
+func (x *ShellResize) Reset() {
+	*x = ShellResize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7471,13 +7663,13 @@ func (x *PivotHello) Reset() {
 	}
 }
 
-func (x *PivotHello) String() string {
+func (x *ShellResize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotHello) ProtoMessage() {}
+func (*ShellResize) ProtoMessage() {}
 
-func (x *PivotHello) ProtoReflect() protoreflect.Message {
+func (x *ShellResize) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7489,64 +7681,45 @@ func (x *PivotHello) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotHello.ProtoReflect.Descriptor instead.
-func (*PivotHello) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShellResize.ProtoReflect.Descriptor instead.
+func (*ShellResize) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *PivotHello) GetPublicKey() []byte {
+func (x *ShellResize) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.PublicKey
+		return x.Response
 	}
 	return nil
 }
 
-func (x *PivotHello) GetPeerID() int64 {
-	if x != nil {
-		return x.PeerID
-	}
-	return 0
+// ShellSignalReq - Send a signal (e.g. SIGINT) to the process running in a shell
+type ShellSignalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signal   string            `protobuf:"bytes,1,opt,name=Signal,proto3" json:"Signal,omitempty"`
+	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PivotHello) GetPublicKeySignature() string {
-	if x != nil {
-		return x.PublicKeySignature
+func (x *ShellSignalReq) Reset() {
+	*x = ShellSignalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PivotHello) GetSessionKey() []byte {
-	if x != nil {
-		return x.SessionKey
-	}
-	return nil
-}
-
-type PivotServerKeyExchange struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OriginID   int64  `protobuf:"varint,1,opt,name=OriginID,proto3" json:"OriginID,omitempty"`
-	SessionKey []byte `protobuf:"bytes,2,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`
-}
-
-func (x *PivotServerKeyExchange) Reset() {
-	*x = PivotServerKeyExchange{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[108]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PivotServerKeyExchange) String() string {
+func (x *ShellSignalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotServerKeyExchange) ProtoMessage() {}
+func (*ShellSignalReq) ProtoMessage() {}
 
-func (x *PivotServerKeyExchange) ProtoReflect() protoreflect.Message {
+func (x *ShellSignalReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7558,36 +7731,42 @@ func (x *PivotServerKeyExchange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotServerKeyExchange.ProtoReflect.Descriptor instead.
-func (*PivotServerKeyExchange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShellSignalReq.ProtoReflect.Descriptor instead.
+func (*ShellSignalReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *PivotServerKeyExchange) GetOriginID() int64 {
+func (x *ShellSignalReq) GetSignal() string {
 	if x != nil {
-		return x.OriginID
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *ShellSignalReq) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
 	}
 	return 0
 }
 
-func (x *PivotServerKeyExchange) GetSessionKey() []byte {
+func (x *ShellSignalReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.SessionKey
+		return x.Request
 	}
 	return nil
 }
 
-type PivotPeer struct {
+type ShellSignal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerID int64  `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *PivotPeer) Reset() {
-	*x = PivotPeer{}
+func (x *ShellSignal) Reset() {
+	*x = ShellSignal{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7595,13 +7774,13 @@ func (x *PivotPeer) Reset() {
 	}
 }
 
-func (x *PivotPeer) String() string {
+func (x *ShellSignal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotPeer) ProtoMessage() {}
+func (*ShellSignal) ProtoMessage() {}
 
-func (x *PivotPeer) ProtoReflect() protoreflect.Message {
+func (x *ShellSignal) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7613,39 +7792,36 @@ func (x *PivotPeer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotPeer.ProtoReflect.Descriptor instead.
-func (*PivotPeer) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShellSignal.ProtoReflect.Descriptor instead.
+func (*ShellSignal) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *PivotPeer) GetPeerID() int64 {
-	if x != nil {
-		return x.PeerID
-	}
-	return 0
-}
-
-func (x *PivotPeer) GetName() string {
+func (x *ShellSignal) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Name
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type PivotPeerEnvelope struct {
+// ScanReq - TCP connect scan of Targets (addresses, CIDRs, ranges like 10.0.0.1-50, or
+// hostnames) on Ports, with Ping only hosts that respond to a TCP ping are port scanned
+type ScanReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers          []*PivotPeer `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
-	Type           uint32       `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
-	PivotSessionID []byte       `protobuf:"bytes,3,opt,name=PivotSessionID,proto3" json:"PivotSessionID,omitempty"`
-	Data           []byte       `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
-	PeerFailureAt  int64        `protobuf:"varint,5,opt,name=PeerFailureAt,proto3" json:"PeerFailureAt,omitempty"`
+	Targets        []string          `protobuf:"bytes,1,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Ports          []uint32          `protobuf:"varint,2,rep,packed,name=Ports,proto3" json:"Ports,omitempty"`
+	Ping           bool              `protobuf:"varint,3,opt,name=Ping,proto3" json:"Ping,omitempty"`
+	Banners        bool              `protobuf:"varint,4,opt,name=Banners,proto3" json:"Banners,omitempty"`
+	ConnectTimeout int32             `protobuf:"varint,5,opt,name=ConnectTimeout,proto3" json:"ConnectTimeout,omitempty"` // Milliseconds
+	Concurrency    int32             `protobuf:"varint,6,opt,name=Concurrency,proto3" json:"Concurrency,omitempty"`
+	Request        *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PivotPeerEnvelope) Reset() {
-	*x = PivotPeerEnvelope{}
+func (x *ScanReq) Reset() {
+	*x = ScanReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7653,13 +7829,13 @@ func (x *PivotPeerEnvelope) Reset() {
 	}
 }
 
-func (x *PivotPeerEnvelope) String() string {
+func (x *ScanReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotPeerEnvelope) ProtoMessage() {}
+func (*ScanReq) ProtoMessage() {}
 
-func (x *PivotPeerEnvelope) ProtoReflect() protoreflect.Message {
+func (x *ScanReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7671,56 +7847,71 @@ func (x *PivotPeerEnvelope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotPeerEnvelope.ProtoReflect.Descriptor instead.
-func (*PivotPeerEnvelope) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScanReq.ProtoReflect.Descriptor instead.
+func (*ScanReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *PivotPeerEnvelope) GetPeers() []*PivotPeer {
+func (x *ScanReq) GetTargets() []string {
 	if x != nil {
-		return x.Peers
+		return x.Targets
 	}
 	return nil
 }
 
-func (x *PivotPeerEnvelope) GetType() uint32 {
+func (x *ScanReq) GetPorts() []uint32 {
 	if x != nil {
-		return x.Type
+		return x.Ports
 	}
-	return 0
+	return nil
 }
 
-func (x *PivotPeerEnvelope) GetPivotSessionID() []byte {
+func (x *ScanReq) GetPing() bool {
 	if x != nil {
-		return x.PivotSessionID
+		return x.Ping
 	}
-	return nil
+	return false
 }
 
-func (x *PivotPeerEnvelope) GetData() []byte {
+func (x *ScanReq) GetBanners() bool {
 	if x != nil {
-		return x.Data
+		return x.Banners
 	}
-	return nil
+	return false
 }
 
-func (x *PivotPeerEnvelope) GetPeerFailureAt() int64 {
+func (x *ScanReq) GetConnectTimeout() int32 {
 	if x != nil {
-		return x.PeerFailureAt
+		return x.ConnectTimeout
 	}
 	return 0
 }
 
-type PivotPing struct {
+func (x *ScanReq) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *ScanReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ScanPort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce uint32 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Port   uint32 `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Banner string `protobuf:"bytes,2,opt,name=Banner,proto3" json:"Banner,omitempty"`
 }
 
-func (x *PivotPing) Reset() {
-	*x = PivotPing{}
+func (x *ScanPort) Reset() {
+	*x = ScanPort{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7728,13 +7919,13 @@ func (x *PivotPing) Reset() {
 	}
 }
 
-func (x *PivotPing) String() string {
+func (x *ScanPort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotPing) ProtoMessage() {}
+func (*ScanPort) ProtoMessage() {}
 
-func (x *PivotPing) ProtoReflect() protoreflect.Message {
+func (x *ScanPort) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7746,29 +7937,37 @@ func (x *PivotPing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotPing.ProtoReflect.Descriptor instead.
-func (*PivotPing) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScanPort.ProtoReflect.Descriptor instead.
+func (*ScanPort) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *PivotPing) GetNonce() uint32 {
+func (x *ScanPort) GetPort() uint32 {
 	if x != nil {
-		return x.Nonce
+		return x.Port
 	}
 	return 0
 }
 
-type NetConnPivot struct {
+func (x *ScanPort) GetBanner() string {
+	if x != nil {
+		return x.Banner
+	}
+	return ""
+}
+
+type ScanHost struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerID        int64  `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	RemoteAddress string `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	Address  string      `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Hostname string      `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Ports    []*ScanPort `protobuf:"bytes,3,rep,name=Ports,proto3" json:"Ports,omitempty"`
 }
 
-func (x *NetConnPivot) Reset() {
-	*x = NetConnPivot{}
+func (x *ScanHost) Reset() {
+	*x = ScanHost{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7776,13 +7975,13 @@ func (x *NetConnPivot) Reset() {
 	}
 }
 
-func (x *NetConnPivot) String() string {
+func (x *ScanHost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetConnPivot) ProtoMessage() {}
+func (*ScanHost) ProtoMessage() {}
 
-func (x *NetConnPivot) ProtoReflect() protoreflect.Message {
+func (x *ScanHost) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7794,37 +7993,45 @@ func (x *NetConnPivot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetConnPivot.ProtoReflect.Descriptor instead.
-func (*NetConnPivot) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScanHost.ProtoReflect.Descriptor instead.
+func (*ScanHost) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *NetConnPivot) GetPeerID() int64 {
+func (x *ScanHost) GetAddress() string {
 	if x != nil {
-		return x.PeerID
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *NetConnPivot) GetRemoteAddress() string {
+func (x *ScanHost) GetHostname() string {
 	if x != nil {
-		return x.RemoteAddress
+		return x.Hostname
 	}
 	return ""
 }
 
-type PivotPeerFailure struct {
+func (x *ScanHost) GetPorts() []*ScanPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// Scan - Hosts that are up or have open ports
+type Scan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerID int64           `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	Type   PeerFailureType `protobuf:"varint,2,opt,name=Type,proto3,enum=sliverpb.PeerFailureType" json:"Type,omitempty"`
-	Err    string          `protobuf:"bytes,3,opt,name=Err,proto3" json:"Err,omitempty"`
+	Hosts    []*ScanHost        `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+	Scanned  uint32             `protobuf:"varint,2,opt,name=Scanned,proto3" json:"Scanned,omitempty"` // Number of addresses scanned
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *PivotPeerFailure) Reset() {
-	*x = PivotPeerFailure{}
+func (x *Scan) Reset() {
+	*x = Scan{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7832,13 +8039,13 @@ func (x *PivotPeerFailure) Reset() {
 	}
 }
 
-func (x *PivotPeerFailure) String() string {
+func (x *Scan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotPeerFailure) ProtoMessage() {}
+func (*Scan) ProtoMessage() {}
 
-func (x *PivotPeerFailure) ProtoReflect() protoreflect.Message {
+func (x *Scan) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7850,42 +8057,47 @@ func (x *PivotPeerFailure) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotPeerFailure.ProtoReflect.Descriptor instead.
-func (*PivotPeerFailure) Descriptor() ([]byte, []int) {
+// Deprecated: Use Scan.ProtoReflect.Descriptor instead.
+func (*Scan) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *PivotPeerFailure) GetPeerID() int64 {
+func (x *Scan) GetHosts() []*ScanHost {
 	if x != nil {
-		return x.PeerID
+		return x.Hosts
 	}
-	return 0
+	return nil
 }
 
-func (x *PivotPeerFailure) GetType() PeerFailureType {
+func (x *Scan) GetScanned() uint32 {
 	if x != nil {
-		return x.Type
+		return x.Scanned
 	}
-	return PeerFailureType_SEND_FAILURE
+	return 0
 }
 
-func (x *PivotPeerFailure) GetErr() string {
+func (x *Scan) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Err
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type PivotListenersReq struct {
+// DNSResolversReq - Set the resolvers of the implant's name resolution, each server
+// is host:port or "c2" to resolve through the server. With Set and no Servers the
+// system resolver is used again, without Set the current resolvers are returned.
+type DNSResolversReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	Servers []string          `protobuf:"bytes,1,rep,name=Servers,proto3" json:"Servers,omitempty"`
+	Set     bool              `protobuf:"varint,2,opt,name=Set,proto3" json:"Set,omitempty"`
 	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PivotListenersReq) Reset() {
-	*x = PivotListenersReq{}
+func (x *DNSResolversReq) Reset() {
+	*x = DNSResolversReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7893,13 +8105,13 @@ func (x *PivotListenersReq) Reset() {
 	}
 }
 
-func (x *PivotListenersReq) String() string {
+func (x *DNSResolversReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotListenersReq) ProtoMessage() {}
+func (*DNSResolversReq) ProtoMessage() {}
 
-func (x *PivotListenersReq) ProtoReflect() protoreflect.Message {
+func (x *DNSResolversReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7911,29 +8123,43 @@ func (x *PivotListenersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotListenersReq.ProtoReflect.Descriptor instead.
-func (*PivotListenersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSResolversReq.ProtoReflect.Descriptor instead.
+func (*DNSResolversReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *PivotListenersReq) GetRequest() *commonpb.Request {
+func (x *DNSResolversReq) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *DNSResolversReq) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+func (x *DNSResolversReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type PivotListeners struct {
+type DNSResolvers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listeners []*PivotListener   `protobuf:"bytes,1,rep,name=Listeners,proto3" json:"Listeners,omitempty"`
-	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Servers  []string           `protobuf:"bytes,1,rep,name=Servers,proto3" json:"Servers,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *PivotListeners) Reset() {
-	*x = PivotListeners{}
+func (x *DNSResolvers) Reset() {
+	*x = DNSResolvers{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7941,13 +8167,13 @@ func (x *PivotListeners) Reset() {
 	}
 }
 
-func (x *PivotListeners) String() string {
+func (x *DNSResolvers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotListeners) ProtoMessage() {}
+func (*DNSResolvers) ProtoMessage() {}
 
-func (x *PivotListeners) ProtoReflect() protoreflect.Message {
+func (x *DNSResolvers) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7959,37 +8185,39 @@ func (x *PivotListeners) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotListeners.ProtoReflect.Descriptor instead.
-func (*PivotListeners) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSResolvers.ProtoReflect.Descriptor instead.
+func (*DNSResolvers) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *PivotListeners) GetListeners() []*PivotListener {
+func (x *DNSResolvers) GetServers() []string {
 	if x != nil {
-		return x.Listeners
+		return x.Servers
 	}
 	return nil
 }
 
-func (x *PivotListeners) GetResponse() *commonpb.Response {
+func (x *DNSResolvers) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type WGPortForwardStartReq struct {
+// DNSExchange - A raw dns message the implant resolves through the server, the
+// server replies with the same ID
+type DNSExchange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocalPort     int32             `protobuf:"varint,1,opt,name=LocalPort,proto3" json:"LocalPort,omitempty"`
-	RemoteAddress string            `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
-	Request       *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	ID      uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Message []byte `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	Err     string `protobuf:"bytes,3,opt,name=Err,proto3" json:"Err,omitempty"`
 }
 
-func (x *WGPortForwardStartReq) Reset() {
-	*x = WGPortForwardStartReq{}
+func (x *DNSExchange) Reset() {
+	*x = DNSExchange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7997,13 +8225,13 @@ func (x *WGPortForwardStartReq) Reset() {
 	}
 }
 
-func (x *WGPortForwardStartReq) String() string {
+func (x *DNSExchange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGPortForwardStartReq) ProtoMessage() {}
+func (*DNSExchange) ProtoMessage() {}
 
-func (x *WGPortForwardStartReq) ProtoReflect() protoreflect.Message {
+func (x *DNSExchange) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8015,43 +8243,43 @@ func (x *WGPortForwardStartReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGPortForwardStartReq.ProtoReflect.Descriptor instead.
-func (*WGPortForwardStartReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSExchange.ProtoReflect.Descriptor instead.
+func (*DNSExchange) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *WGPortForwardStartReq) GetLocalPort() int32 {
+func (x *DNSExchange) GetID() uint64 {
 	if x != nil {
-		return x.LocalPort
+		return x.ID
 	}
 	return 0
 }
 
-func (x *WGPortForwardStartReq) GetRemoteAddress() string {
+func (x *DNSExchange) GetMessage() []byte {
 	if x != nil {
-		return x.RemoteAddress
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *WGPortForwardStartReq) GetRequest() *commonpb.Request {
+func (x *DNSExchange) GetErr() string {
 	if x != nil {
-		return x.Request
+		return x.Err
 	}
-	return nil
+	return ""
 }
 
-type WGPortForward struct {
+// EDREnumReq - Enumerate the AV/EDR products on the remote system
+type EDREnumReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forwarder *WGTCPForwarder    `protobuf:"bytes,1,opt,name=Forwarder,proto3" json:"Forwarder,omitempty"`
-	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *WGPortForward) Reset() {
-	*x = WGPortForward{}
+func (x *EDREnumReq) Reset() {
+	*x = EDREnumReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8059,13 +8287,13 @@ func (x *WGPortForward) Reset() {
 	}
 }
 
-func (x *WGPortForward) String() string {
+func (x *EDREnumReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGPortForward) ProtoMessage() {}
+func (*EDREnumReq) ProtoMessage() {}
 
-func (x *WGPortForward) ProtoReflect() protoreflect.Message {
+func (x *EDREnumReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8077,36 +8305,34 @@ func (x *WGPortForward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGPortForward.ProtoReflect.Descriptor instead.
-func (*WGPortForward) Descriptor() ([]byte, []int) {
+// Deprecated: Use EDREnumReq.ProtoReflect.Descriptor instead.
+func (*EDREnumReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *WGPortForward) GetForwarder() *WGTCPForwarder {
-	if x != nil {
-		return x.Forwarder
-	}
-	return nil
-}
-
-func (x *WGPortForward) GetResponse() *commonpb.Response {
+func (x *EDREnumReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type WGPortForwardStopReq struct {
+// EDRComponent - Evidence of a security product, Kind is one of the EDRKind
+// constants. Product is blank for components that don't match a known product
+// (e.g. the hooks and wfp filters of an unknown vendor).
+type EDRComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      int32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Kind    string `protobuf:"bytes,1,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Product string `protobuf:"bytes,3,opt,name=Product,proto3" json:"Product,omitempty"`
+	Detail  string `protobuf:"bytes,4,opt,name=Detail,proto3" json:"Detail,omitempty"`
 }
 
-func (x *WGPortForwardStopReq) Reset() {
-	*x = WGPortForwardStopReq{}
+func (x *EDRComponent) Reset() {
+	*x = EDRComponent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8114,13 +8340,13 @@ func (x *WGPortForwardStopReq) Reset() {
 	}
 }
 
-func (x *WGPortForwardStopReq) String() string {
+func (x *EDRComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGPortForwardStopReq) ProtoMessage() {}
+func (*EDRComponent) ProtoMessage() {}
 
-func (x *WGPortForwardStopReq) ProtoReflect() protoreflect.Message {
+func (x *EDRComponent) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8132,36 +8358,54 @@ func (x *WGPortForwardStopReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGPortForwardStopReq.ProtoReflect.Descriptor instead.
-func (*WGPortForwardStopReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EDRComponent.ProtoReflect.Descriptor instead.
+func (*EDRComponent) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *WGPortForwardStopReq) GetID() int32 {
+func (x *EDRComponent) GetKind() string {
 	if x != nil {
-		return x.ID
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *WGPortForwardStopReq) GetRequest() *commonpb.Request {
+func (x *EDRComponent) GetName() string {
 	if x != nil {
-		return x.Request
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type WGSocksStartReq struct {
+func (x *EDRComponent) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *EDRComponent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// EDREnum - The products found and the components of each, Warnings are the
+// checks that couldn't run (e.g. no access to the wfp filters)
+type EDREnum struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port    int32             `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Products   []string           `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	Components []*EDRComponent    `protobuf:"bytes,2,rep,name=Components,proto3" json:"Components,omitempty"`
+	Warnings   []string           `protobuf:"bytes,3,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	Response   *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *WGSocksStartReq) Reset() {
-	*x = WGSocksStartReq{}
+func (x *EDREnum) Reset() {
+	*x = EDREnum{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8169,13 +8413,13 @@ func (x *WGSocksStartReq) Reset() {
 	}
 }
 
-func (x *WGSocksStartReq) String() string {
+func (x *EDREnum) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGSocksStartReq) ProtoMessage() {}
+func (*EDREnum) ProtoMessage() {}
 
-func (x *WGSocksStartReq) ProtoReflect() protoreflect.Message {
+func (x *EDREnum) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8187,36 +8431,59 @@ func (x *WGSocksStartReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGSocksStartReq.ProtoReflect.Descriptor instead.
-func (*WGSocksStartReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EDREnum.ProtoReflect.Descriptor instead.
+func (*EDREnum) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *WGSocksStartReq) GetPort() int32 {
+func (x *EDREnum) GetProducts() []string {
 	if x != nil {
-		return x.Port
+		return x.Products
 	}
-	return 0
+	return nil
 }
 
-func (x *WGSocksStartReq) GetRequest() *commonpb.Request {
+func (x *EDREnum) GetComponents() []*EDRComponent {
 	if x != nil {
-		return x.Request
+		return x.Components
 	}
 	return nil
 }
 
-type WGSocks struct {
+func (x *EDREnum) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *EDREnum) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// DiscoverReq - Network discovery from the remote system, Targets are like a
+// ScanReq's. Without Targets the implant's local IPv4 networks are used.
+type DiscoverReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Server   *WGSocksServer     `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Targets []string          `protobuf:"bytes,1,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	ARP     bool              `protobuf:"varint,2,opt,name=ARP,proto3" json:"ARP,omitempty"`         // Read the ARP cache
+	Sweep   bool              `protobuf:"varint,3,opt,name=Sweep,proto3" json:"Sweep,omitempty"`     // Resolve the MAC of every target to fill the ARP cache
+	NetBIOS bool              `protobuf:"varint,4,opt,name=NetBIOS,proto3" json:"NetBIOS,omitempty"` // NetBIOS node status (names, workgroup, roles)
+	MDNS    bool              `protobuf:"varint,5,opt,name=MDNS,proto3" json:"MDNS,omitempty"`       // Reverse lookups sent to each target's mDNS responder
+	SMB     bool              `protobuf:"varint,6,opt,name=SMB,proto3" json:"SMB,omitempty"`         // Shares and sessions
+	LDAP    bool              `protobuf:"varint,7,opt,name=LDAP,proto3" json:"LDAP,omitempty"`       // RootDSE of domain controllers
+	Timeout int32             `protobuf:"varint,8,opt,name=Timeout,proto3" json:"Timeout,omitempty"` // Milliseconds per query
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *WGSocks) Reset() {
-	*x = WGSocks{}
+func (x *DiscoverReq) Reset() {
+	*x = DiscoverReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8224,13 +8491,13 @@ func (x *WGSocks) Reset() {
 	}
 }
 
-func (x *WGSocks) String() string {
+func (x *DiscoverReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGSocks) ProtoMessage() {}
+func (*DiscoverReq) ProtoMessage() {}
 
-func (x *WGSocks) ProtoReflect() protoreflect.Message {
+func (x *DiscoverReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8242,36 +8509,86 @@ func (x *WGSocks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGSocks.ProtoReflect.Descriptor instead.
-func (*WGSocks) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoverReq.ProtoReflect.Descriptor instead.
+func (*DiscoverReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *WGSocks) GetServer() *WGSocksServer {
+func (x *DiscoverReq) GetTargets() []string {
 	if x != nil {
-		return x.Server
+		return x.Targets
 	}
 	return nil
 }
 
-func (x *WGSocks) GetResponse() *commonpb.Response {
+func (x *DiscoverReq) GetARP() bool {
 	if x != nil {
-		return x.Response
+		return x.ARP
+	}
+	return false
+}
+
+func (x *DiscoverReq) GetSweep() bool {
+	if x != nil {
+		return x.Sweep
+	}
+	return false
+}
+
+func (x *DiscoverReq) GetNetBIOS() bool {
+	if x != nil {
+		return x.NetBIOS
+	}
+	return false
+}
+
+func (x *DiscoverReq) GetMDNS() bool {
+	if x != nil {
+		return x.MDNS
+	}
+	return false
+}
+
+func (x *DiscoverReq) GetSMB() bool {
+	if x != nil {
+		return x.SMB
+	}
+	return false
+}
+
+func (x *DiscoverReq) GetLDAP() bool {
+	if x != nil {
+		return x.LDAP
+	}
+	return false
+}
+
+func (x *DiscoverReq) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *DiscoverReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
 	}
 	return nil
 }
 
-type WGSocksStopReq struct {
+type Discover struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      int32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Hosts    []*commonpb.DiscoveredHost `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+	Warnings []string                   `protobuf:"bytes,2,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	Response *commonpb.Response         `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *WGSocksStopReq) Reset() {
-	*x = WGSocksStopReq{}
+func (x *Discover) Reset() {
+	*x = Discover{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8279,13 +8596,13 @@ func (x *WGSocksStopReq) Reset() {
 	}
 }
 
-func (x *WGSocksStopReq) String() string {
+func (x *Discover) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGSocksStopReq) ProtoMessage() {}
+func (*Discover) ProtoMessage() {}
 
-func (x *WGSocksStopReq) ProtoReflect() protoreflect.Message {
+func (x *Discover) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8297,35 +8614,54 @@ func (x *WGSocksStopReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGSocksStopReq.ProtoReflect.Descriptor instead.
-func (*WGSocksStopReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Discover.ProtoReflect.Descriptor instead.
+func (*Discover) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *WGSocksStopReq) GetID() int32 {
+func (x *Discover) GetHosts() []*commonpb.DiscoveredHost {
 	if x != nil {
-		return x.ID
+		return x.Hosts
 	}
-	return 0
+	return nil
 }
 
-func (x *WGSocksStopReq) GetRequest() *commonpb.Request {
+func (x *Discover) GetWarnings() []string {
 	if x != nil {
-		return x.Request
+		return x.Warnings
 	}
 	return nil
 }
 
-type WGTCPForwardersReq struct {
+func (x *Discover) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ADEnumReq - Query the Active Directory domain over LDAP, Query is one of the
+// ADQuery constants. Without a Username the implant binds as its own user (Windows
+// only), without a Server the domain's controller is used.
+type ADEnumReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Query              string            `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Domain             string            `protobuf:"bytes,2,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	Server             string            `protobuf:"bytes,3,opt,name=Server,proto3" json:"Server,omitempty"`
+	Username           string            `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"` // user@domain or DOMAIN\user
+	Password           string            `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
+	LDAPS              bool              `protobuf:"varint,6,opt,name=LDAPS,proto3" json:"LDAPS,omitempty"`                            // Otherwise StartTLS, simple binds are never sent in the clear
+	Limit              uint32            `protobuf:"varint,7,opt,name=Limit,proto3" json:"Limit,omitempty"`                            // Maximum number of objects, 0 for all
+	Timeout            int32             `protobuf:"varint,8,opt,name=Timeout,proto3" json:"Timeout,omitempty"`                        // Seconds per LDAP operation
+	InsecureSkipVerify bool              `protobuf:"varint,10,opt,name=InsecureSkipVerify,proto3" json:"InsecureSkipVerify,omitempty"` // Don't verify the domain controller's certificate
+	Request            *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *WGTCPForwardersReq) Reset() {
-	*x = WGTCPForwardersReq{}
+func (x *ADEnumReq) Reset() {
+	*x = ADEnumReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8333,13 +8669,13 @@ func (x *WGTCPForwardersReq) Reset() {
 	}
 }
 
-func (x *WGTCPForwardersReq) String() string {
+func (x *ADEnumReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGTCPForwardersReq) ProtoMessage() {}
+func (*ADEnumReq) ProtoMessage() {}
 
-func (x *WGTCPForwardersReq) ProtoReflect() protoreflect.Message {
+func (x *ADEnumReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8351,28 +8687,93 @@ func (x *WGTCPForwardersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGTCPForwardersReq.ProtoReflect.Descriptor instead.
-func (*WGTCPForwardersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ADEnumReq.ProtoReflect.Descriptor instead.
+func (*ADEnumReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *WGTCPForwardersReq) GetRequest() *commonpb.Request {
+func (x *ADEnumReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ADEnumReq) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ADEnumReq) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ADEnumReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ADEnumReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ADEnumReq) GetLDAPS() bool {
+	if x != nil {
+		return x.LDAPS
+	}
+	return false
+}
+
+func (x *ADEnumReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ADEnumReq) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *ADEnumReq) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *ADEnumReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type WGSocksServersReq struct {
+// ADAttribute - Values formatted as text, e.g. SIDs, times, and flags are decoded
+type ADAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Name   string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=Values,proto3" json:"Values,omitempty"`
 }
 
-func (x *WGSocksServersReq) Reset() {
-	*x = WGSocksServersReq{}
+func (x *ADAttribute) Reset() {
+	*x = ADAttribute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8380,13 +8781,13 @@ func (x *WGSocksServersReq) Reset() {
 	}
 }
 
-func (x *WGSocksServersReq) String() string {
+func (x *ADAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGSocksServersReq) ProtoMessage() {}
+func (*ADAttribute) ProtoMessage() {}
 
-func (x *WGSocksServersReq) ProtoReflect() protoreflect.Message {
+func (x *ADAttribute) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8398,30 +8799,37 @@ func (x *WGSocksServersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGSocksServersReq.ProtoReflect.Descriptor instead.
-func (*WGSocksServersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ADAttribute.ProtoReflect.Descriptor instead.
+func (*ADAttribute) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *WGSocksServersReq) GetRequest() *commonpb.Request {
+func (x *ADAttribute) GetName() string {
 	if x != nil {
-		return x.Request
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ADAttribute) GetValues() []string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-type WGTCPForwarder struct {
+type ADObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID         int32  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	LocalAddr  string `protobuf:"bytes,2,opt,name=LocalAddr,proto3" json:"LocalAddr,omitempty"`
-	RemoteAddr string `protobuf:"bytes,3,opt,name=RemoteAddr,proto3" json:"RemoteAddr,omitempty"`
+	DN         string         `protobuf:"bytes,1,opt,name=DN,proto3" json:"DN,omitempty"`
+	Name       string         `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Attributes []*ADAttribute `protobuf:"bytes,3,rep,name=Attributes,proto3" json:"Attributes,omitempty"`
 }
 
-func (x *WGTCPForwarder) Reset() {
-	*x = WGTCPForwarder{}
+func (x *ADObject) Reset() {
+	*x = ADObject{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8429,13 +8837,13 @@ func (x *WGTCPForwarder) Reset() {
 	}
 }
 
-func (x *WGTCPForwarder) String() string {
+func (x *ADObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGTCPForwarder) ProtoMessage() {}
+func (*ADObject) ProtoMessage() {}
 
-func (x *WGTCPForwarder) ProtoReflect() protoreflect.Message {
+func (x *ADObject) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8447,43 +8855,48 @@ func (x *WGTCPForwarder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGTCPForwarder.ProtoReflect.Descriptor instead.
-func (*WGTCPForwarder) Descriptor() ([]byte, []int) {
+// Deprecated: Use ADObject.ProtoReflect.Descriptor instead.
+func (*ADObject) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *WGTCPForwarder) GetID() int32 {
+func (x *ADObject) GetDN() string {
 	if x != nil {
-		return x.ID
+		return x.DN
 	}
-	return 0
+	return ""
 }
 
-func (x *WGTCPForwarder) GetLocalAddr() string {
+func (x *ADObject) GetName() string {
 	if x != nil {
-		return x.LocalAddr
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WGTCPForwarder) GetRemoteAddr() string {
+func (x *ADObject) GetAttributes() []*ADAttribute {
 	if x != nil {
-		return x.RemoteAddr
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-type WGSocksServer struct {
+// ADEnum - The objects matching a query, Truncated if more matched than the limit
+type ADEnum struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        int32  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	LocalAddr string `protobuf:"bytes,2,opt,name=LocalAddr,proto3" json:"LocalAddr,omitempty"`
+	Query     string             `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Domain    string             `protobuf:"bytes,2,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	Server    string             `protobuf:"bytes,3,opt,name=Server,proto3" json:"Server,omitempty"`
+	Objects   []*ADObject        `protobuf:"bytes,4,rep,name=Objects,proto3" json:"Objects,omitempty"`
+	Truncated bool               `protobuf:"varint,5,opt,name=Truncated,proto3" json:"Truncated,omitempty"`
+	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *WGSocksServer) Reset() {
-	*x = WGSocksServer{}
+func (x *ADEnum) Reset() {
+	*x = ADEnum{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8491,13 +8904,13 @@ func (x *WGSocksServer) Reset() {
 	}
 }
 
-func (x *WGSocksServer) String() string {
+func (x *ADEnum) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGSocksServer) ProtoMessage() {}
+func (*ADEnum) ProtoMessage() {}
 
-func (x *WGSocksServer) ProtoReflect() protoreflect.Message {
+func (x *ADEnum) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8509,36 +8922,71 @@ func (x *WGSocksServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGSocksServer.ProtoReflect.Descriptor instead.
-func (*WGSocksServer) Descriptor() ([]byte, []int) {
+// Deprecated: Use ADEnum.ProtoReflect.Descriptor instead.
+func (*ADEnum) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *WGSocksServer) GetID() int32 {
+func (x *ADEnum) GetQuery() string {
 	if x != nil {
-		return x.ID
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *WGSocksServer) GetLocalAddr() string {
+func (x *ADEnum) GetDomain() string {
 	if x != nil {
-		return x.LocalAddr
+		return x.Domain
 	}
 	return ""
 }
 
-type WGSocksServers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ADEnum) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
 
-	Servers  []*WGSocksServer   `protobuf:"bytes,1,rep,name=Servers,proto3" json:"Servers,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+func (x *ADEnum) GetObjects() []*ADObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
 }
 
-func (x *WGSocksServers) Reset() {
-	*x = WGSocksServers{}
+func (x *ADEnum) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ADEnum) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// TaskOutput - Partial output of a task with a Request.StreamID, sent by the
+// implant as it's produced. The task's response leaves out what was streamed.
+// Messages are numbered from 0, the last one is Done so the client knows when
+// it has all of the output.
+type TaskOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID string `protobuf:"bytes,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Stdout   []byte `protobuf:"bytes,2,opt,name=Stdout,proto3" json:"Stdout,omitempty"`
+	Stderr   []byte `protobuf:"bytes,3,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
+	Sequence uint64 `protobuf:"varint,4,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Done     bool   `protobuf:"varint,5,opt,name=Done,proto3" json:"Done,omitempty"`
+}
+
+func (x *TaskOutput) Reset() {
+	*x = TaskOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8546,13 +8994,13 @@ func (x *WGSocksServers) Reset() {
 	}
 }
 
-func (x *WGSocksServers) String() string {
+func (x *TaskOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGSocksServers) ProtoMessage() {}
+func (*TaskOutput) ProtoMessage() {}
 
-func (x *WGSocksServers) ProtoReflect() protoreflect.Message {
+func (x *TaskOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8564,36 +9012,60 @@ func (x *WGSocksServers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGSocksServers.ProtoReflect.Descriptor instead.
-func (*WGSocksServers) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskOutput.ProtoReflect.Descriptor instead.
+func (*TaskOutput) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *WGSocksServers) GetServers() []*WGSocksServer {
+func (x *TaskOutput) GetStreamID() string {
 	if x != nil {
-		return x.Servers
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *TaskOutput) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
 	}
 	return nil
 }
 
-func (x *WGSocksServers) GetResponse() *commonpb.Response {
+func (x *TaskOutput) GetStderr() []byte {
 	if x != nil {
-		return x.Response
+		return x.Stderr
 	}
 	return nil
 }
 
-type WGTCPForwarders struct {
+func (x *TaskOutput) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TaskOutput) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type PortfwdReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forwarders []*WGTCPForwarder  `protobuf:"bytes,1,rep,name=Forwarders,proto3" json:"Forwarders,omitempty"`
-	Response   *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Port     uint32            `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Protocol int32             `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Host     string            `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *WGTCPForwarders) Reset() {
-	*x = WGTCPForwarders{}
+func (x *PortfwdReq) Reset() {
+	*x = PortfwdReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8601,13 +9073,13 @@ func (x *WGTCPForwarders) Reset() {
 	}
 }
 
-func (x *WGTCPForwarders) String() string {
+func (x *PortfwdReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WGTCPForwarders) ProtoMessage() {}
+func (*PortfwdReq) ProtoMessage() {}
 
-func (x *WGTCPForwarders) ProtoReflect() protoreflect.Message {
+func (x *PortfwdReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8619,39 +9091,60 @@ func (x *WGTCPForwarders) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WGTCPForwarders.ProtoReflect.Descriptor instead.
-func (*WGTCPForwarders) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortfwdReq.ProtoReflect.Descriptor instead.
+func (*PortfwdReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *WGTCPForwarders) GetForwarders() []*WGTCPForwarder {
+func (x *PortfwdReq) GetPort() uint32 {
 	if x != nil {
-		return x.Forwarders
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-func (x *WGTCPForwarders) GetResponse() *commonpb.Response {
+func (x *PortfwdReq) GetProtocol() int32 {
 	if x != nil {
-		return x.Response
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *PortfwdReq) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PortfwdReq) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
+	}
+	return 0
+}
+
+func (x *PortfwdReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
 	}
 	return nil
 }
 
-// ReconfigureReq - Request the implant to reconfigure itself
-type ReconfigureReq struct {
+type Portfwd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReconnectInterval int64             `protobuf:"varint,1,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
-	BeaconInterval    int64             `protobuf:"varint,2,opt,name=BeaconInterval,proto3" json:"BeaconInterval,omitempty"`
-	BeaconJitter      int64             `protobuf:"varint,3,opt,name=BeaconJitter,proto3" json:"BeaconJitter,omitempty"`
-	Request           *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Port     uint32             `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Protocol int32              `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Host     string             `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	TunnelID uint64             `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *ReconfigureReq) Reset() {
-	*x = ReconfigureReq{}
+func (x *Portfwd) Reset() {
+	*x = Portfwd{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8659,13 +9152,13 @@ func (x *ReconfigureReq) Reset() {
 	}
 }
 
-func (x *ReconfigureReq) String() string {
+func (x *Portfwd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconfigureReq) ProtoMessage() {}
+func (*Portfwd) ProtoMessage() {}
 
-func (x *ReconfigureReq) ProtoReflect() protoreflect.Message {
+func (x *Portfwd) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8677,49 +9170,57 @@ func (x *ReconfigureReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconfigureReq.ProtoReflect.Descriptor instead.
-func (*ReconfigureReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Portfwd.ProtoReflect.Descriptor instead.
+func (*Portfwd) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *ReconfigureReq) GetReconnectInterval() int64 {
+func (x *Portfwd) GetPort() uint32 {
 	if x != nil {
-		return x.ReconnectInterval
+		return x.Port
 	}
 	return 0
 }
 
-func (x *ReconfigureReq) GetBeaconInterval() int64 {
+func (x *Portfwd) GetProtocol() int32 {
 	if x != nil {
-		return x.BeaconInterval
+		return x.Protocol
 	}
 	return 0
 }
 
-func (x *ReconfigureReq) GetBeaconJitter() int64 {
+func (x *Portfwd) GetHost() string {
 	if x != nil {
-		return x.BeaconJitter
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Portfwd) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
 	}
 	return 0
 }
 
-func (x *ReconfigureReq) GetRequest() *commonpb.Request {
+func (x *Portfwd) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Request
+		return x.Response
 	}
 	return nil
 }
 
-type Reconfigure struct {
+type Socks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	TunnelID  uint64 `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	SessionID string `protobuf:"bytes,9,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
 }
 
-func (x *Reconfigure) Reset() {
-	*x = Reconfigure{}
+func (x *Socks) Reset() {
+	*x = Socks{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8727,13 +9228,13 @@ func (x *Reconfigure) Reset() {
 	}
 }
 
-func (x *Reconfigure) String() string {
+func (x *Socks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reconfigure) ProtoMessage() {}
+func (*Socks) ProtoMessage() {}
 
-func (x *Reconfigure) ProtoReflect() protoreflect.Message {
+func (x *Socks) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8745,30 +9246,44 @@ func (x *Reconfigure) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reconfigure.ProtoReflect.Descriptor instead.
-func (*Reconfigure) Descriptor() ([]byte, []int) {
+// Deprecated: Use Socks.ProtoReflect.Descriptor instead.
+func (*Socks) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *Reconfigure) GetResponse() *commonpb.Response {
+func (x *Socks) GetTunnelID() uint64 {
 	if x != nil {
-		return x.Response
+		return x.TunnelID
 	}
-	return nil
+	return 0
 }
 
-// PollIntervalReq - Request the implant to update it's poll intervbal
-type PollIntervalReq struct {
+func (x *Socks) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type SocksData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PollInterval int64             `protobuf:"varint,1,opt,name=PollInterval,proto3" json:"PollInterval,omitempty"`
-	Request      *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Data      []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	CloseConn bool   `protobuf:"varint,2,opt,name=CloseConn,proto3" json:"CloseConn,omitempty"`
+	Username  string `protobuf:"bytes,3,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password  string `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
+	Sequence  uint64 `protobuf:"varint,5,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	// Data is a socks5 udp datagram (RSV FRAG ATYP DST.ADDR DST.PORT DATA)
+	// of the tunnel's udp associate
+	Datagram bool              `protobuf:"varint,6,opt,name=Datagram,proto3" json:"Datagram,omitempty"`
+	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *PollIntervalReq) Reset() {
-	*x = PollIntervalReq{}
+func (x *SocksData) Reset() {
+	*x = SocksData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8776,13 +9291,13 @@ func (x *PollIntervalReq) Reset() {
 	}
 }
 
-func (x *PollIntervalReq) String() string {
+func (x *SocksData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PollIntervalReq) ProtoMessage() {}
+func (*SocksData) ProtoMessage() {}
 
-func (x *PollIntervalReq) ProtoReflect() protoreflect.Message {
+func (x *SocksData) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8794,106 +9309,95 @@ func (x *PollIntervalReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PollIntervalReq.ProtoReflect.Descriptor instead.
-func (*PollIntervalReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SocksData.ProtoReflect.Descriptor instead.
+func (*SocksData) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *PollIntervalReq) GetPollInterval() int64 {
+func (x *SocksData) GetData() []byte {
 	if x != nil {
-		return x.PollInterval
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *PollIntervalReq) GetRequest() *commonpb.Request {
+func (x *SocksData) GetCloseConn() bool {
 	if x != nil {
-		return x.Request
+		return x.CloseConn
 	}
-	return nil
+	return false
 }
 
-type PollInterval struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+func (x *SocksData) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *PollInterval) Reset() {
-	*x = PollInterval{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[131]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SocksData) GetPassword() string {
+	if x != nil {
+		return x.Password
 	}
+	return ""
 }
 
-func (x *PollInterval) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SocksData) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
 }
 
-func (*PollInterval) ProtoMessage() {}
-
-func (x *PollInterval) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[131]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SocksData) GetDatagram() bool {
+	if x != nil {
+		return x.Datagram
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use PollInterval.ProtoReflect.Descriptor instead.
-func (*PollInterval) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{131}
+func (x *SocksData) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
+	}
+	return 0
 }
 
-func (x *PollInterval) GetResponse() *commonpb.Response {
+func (x *SocksData) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type SSHCommandReq struct {
+type PivotStartListenerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string            `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
-	Hostname string            `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Port     uint32            `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
-	Command  string            `protobuf:"bytes,4,opt,name=Command,proto3" json:"Command,omitempty"`
-	Password string            `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
-	PrivKey  []byte            `protobuf:"bytes,6,opt,name=PrivKey,proto3" json:"PrivKey,omitempty"`
-	Krb5Conf string            `protobuf:"bytes,7,opt,name=Krb5Conf,proto3" json:"Krb5Conf,omitempty"`
-	Keytab   []byte            `protobuf:"bytes,8,opt,name=Keytab,proto3" json:"Keytab,omitempty"`
-	Realm    string            `protobuf:"bytes,10,opt,name=Realm,proto3" json:"Realm,omitempty"`
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Type        PivotType         `protobuf:"varint,1,opt,name=Type,proto3,enum=sliverpb.PivotType" json:"Type,omitempty"`
+	BindAddress string            `protobuf:"bytes,2,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
+	Options     []bool            `protobuf:"varint,3,rep,packed,name=Options,proto3" json:"Options,omitempty"`
+	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *SSHCommandReq) Reset() {
-	*x = SSHCommandReq{}
+func (x *PivotStartListenerReq) Reset() {
+	*x = PivotStartListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[132]
+		mi := &file_sliverpb_sliver_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SSHCommandReq) String() string {
+func (x *PivotStartListenerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSHCommandReq) ProtoMessage() {}
+func (*PivotStartListenerReq) ProtoMessage() {}
 
-func (x *SSHCommandReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[132]
+func (x *PivotStartListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8904,93 +9408,108 @@ func (x *SSHCommandReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSHCommandReq.ProtoReflect.Descriptor instead.
-func (*SSHCommandReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use PivotStartListenerReq.ProtoReflect.Descriptor instead.
+func (*PivotStartListenerReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *SSHCommandReq) GetUsername() string {
+func (x *PivotStartListenerReq) GetType() PivotType {
 	if x != nil {
-		return x.Username
+		return x.Type
 	}
-	return ""
+	return PivotType_TCP
 }
 
-func (x *SSHCommandReq) GetHostname() string {
+func (x *PivotStartListenerReq) GetBindAddress() string {
 	if x != nil {
-		return x.Hostname
+		return x.BindAddress
 	}
 	return ""
 }
 
-func (x *SSHCommandReq) GetPort() uint32 {
+func (x *PivotStartListenerReq) GetOptions() []bool {
 	if x != nil {
-		return x.Port
+		return x.Options
 	}
-	return 0
+	return nil
 }
 
-func (x *SSHCommandReq) GetCommand() string {
+func (x *PivotStartListenerReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Command
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *SSHCommandReq) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+type PivotStopListenerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      uint32            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *SSHCommandReq) GetPrivKey() []byte {
-	if x != nil {
-		return x.PrivKey
+func (x *PivotStopListenerReq) Reset() {
+	*x = PivotStopListenerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SSHCommandReq) GetKrb5Conf() string {
-	if x != nil {
-		return x.Krb5Conf
-	}
-	return ""
+func (x *PivotStopListenerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SSHCommandReq) GetKeytab() []byte {
-	if x != nil {
-		return x.Keytab
+func (*PivotStopListenerReq) ProtoMessage() {}
+
+func (x *PivotStopListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SSHCommandReq) GetRealm() string {
+// Deprecated: Use PivotStopListenerReq.ProtoReflect.Descriptor instead.
+func (*PivotStopListenerReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *PivotStopListenerReq) GetID() uint32 {
 	if x != nil {
-		return x.Realm
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *SSHCommandReq) GetRequest() *commonpb.Request {
+func (x *PivotStopListenerReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type SSHCommand struct {
+type PivotListener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StdOut   string             `protobuf:"bytes,1,opt,name=StdOut,proto3" json:"StdOut,omitempty"`
-	StdErr   string             `protobuf:"bytes,2,opt,name=StdErr,proto3" json:"StdErr,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ID          uint32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type        PivotType          `protobuf:"varint,2,opt,name=Type,proto3,enum=sliverpb.PivotType" json:"Type,omitempty"`
+	BindAddress string             `protobuf:"bytes,3,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
+	Pivots      []*NetConnPivot    `protobuf:"bytes,4,rep,name=Pivots,proto3" json:"Pivots,omitempty"`
+	Response    *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *SSHCommand) Reset() {
-	*x = SSHCommand{}
+func (x *PivotListener) Reset() {
+	*x = PivotListener{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8998,13 +9517,13 @@ func (x *SSHCommand) Reset() {
 	}
 }
 
-func (x *SSHCommand) String() string {
+func (x *PivotListener) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SSHCommand) ProtoMessage() {}
+func (*PivotListener) ProtoMessage() {}
 
-func (x *SSHCommand) ProtoReflect() protoreflect.Message {
+func (x *PivotListener) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9016,42 +9535,59 @@ func (x *SSHCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SSHCommand.ProtoReflect.Descriptor instead.
-func (*SSHCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotListener.ProtoReflect.Descriptor instead.
+func (*PivotListener) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *SSHCommand) GetStdOut() string {
+func (x *PivotListener) GetID() uint32 {
 	if x != nil {
-		return x.StdOut
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *SSHCommand) GetStdErr() string {
+func (x *PivotListener) GetType() PivotType {
 	if x != nil {
-		return x.StdErr
+		return x.Type
+	}
+	return PivotType_TCP
+}
+
+func (x *PivotListener) GetBindAddress() string {
+	if x != nil {
+		return x.BindAddress
 	}
 	return ""
 }
 
-func (x *SSHCommand) GetResponse() *commonpb.Response {
+func (x *PivotListener) GetPivots() []*NetConnPivot {
+	if x != nil {
+		return x.Pivots
+	}
+	return nil
+}
+
+func (x *PivotListener) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type GetPrivsReq struct {
+type PivotHello struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	PublicKey          []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	PeerID             int64  `protobuf:"varint,2,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	PublicKeySignature string `protobuf:"bytes,3,opt,name=PublicKeySignature,proto3" json:"PublicKeySignature,omitempty"`
+	SessionKey         []byte `protobuf:"bytes,4,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`
 }
 
-func (x *GetPrivsReq) Reset() {
-	*x = GetPrivsReq{}
+func (x *PivotHello) Reset() {
+	*x = PivotHello{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9059,13 +9595,13 @@ func (x *GetPrivsReq) Reset() {
 	}
 }
 
-func (x *GetPrivsReq) String() string {
+func (x *PivotHello) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrivsReq) ProtoMessage() {}
+func (*PivotHello) ProtoMessage() {}
 
-func (x *GetPrivsReq) ProtoReflect() protoreflect.Message {
+func (x *PivotHello) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9077,33 +9613,50 @@ func (x *GetPrivsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrivsReq.ProtoReflect.Descriptor instead.
-func (*GetPrivsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotHello.ProtoReflect.Descriptor instead.
+func (*PivotHello) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *GetPrivsReq) GetRequest() *commonpb.Request {
+func (x *PivotHello) GetPublicKey() []byte {
 	if x != nil {
-		return x.Request
+		return x.PublicKey
 	}
 	return nil
 }
 
-type WindowsPrivilegeEntry struct {
+func (x *PivotHello) GetPeerID() int64 {
+	if x != nil {
+		return x.PeerID
+	}
+	return 0
+}
+
+func (x *PivotHello) GetPublicKeySignature() string {
+	if x != nil {
+		return x.PublicKeySignature
+	}
+	return ""
+}
+
+func (x *PivotHello) GetSessionKey() []byte {
+	if x != nil {
+		return x.SessionKey
+	}
+	return nil
+}
+
+type PivotServerKeyExchange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description      string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	Enabled          bool   `protobuf:"varint,3,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
-	EnabledByDefault bool   `protobuf:"varint,4,opt,name=EnabledByDefault,proto3" json:"EnabledByDefault,omitempty"`
-	Removed          bool   `protobuf:"varint,5,opt,name=Removed,proto3" json:"Removed,omitempty"`
-	UsedForAccess    bool   `protobuf:"varint,6,opt,name=UsedForAccess,proto3" json:"UsedForAccess,omitempty"`
+	OriginID   int64  `protobuf:"varint,1,opt,name=OriginID,proto3" json:"OriginID,omitempty"`
+	SessionKey []byte `protobuf:"bytes,2,opt,name=SessionKey,proto3" json:"SessionKey,omitempty"`
 }
 
-func (x *WindowsPrivilegeEntry) Reset() {
-	*x = WindowsPrivilegeEntry{}
+func (x *PivotServerKeyExchange) Reset() {
+	*x = PivotServerKeyExchange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9111,13 +9664,13 @@ func (x *WindowsPrivilegeEntry) Reset() {
 	}
 }
 
-func (x *WindowsPrivilegeEntry) String() string {
+func (x *PivotServerKeyExchange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowsPrivilegeEntry) ProtoMessage() {}
+func (*PivotServerKeyExchange) ProtoMessage() {}
 
-func (x *WindowsPrivilegeEntry) ProtoReflect() protoreflect.Message {
+func (x *PivotServerKeyExchange) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9129,66 +9682,36 @@ func (x *WindowsPrivilegeEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowsPrivilegeEntry.ProtoReflect.Descriptor instead.
-func (*WindowsPrivilegeEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotServerKeyExchange.ProtoReflect.Descriptor instead.
+func (*PivotServerKeyExchange) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *WindowsPrivilegeEntry) GetName() string {
+func (x *PivotServerKeyExchange) GetOriginID() int64 {
 	if x != nil {
-		return x.Name
+		return x.OriginID
 	}
-	return ""
+	return 0
 }
 
-func (x *WindowsPrivilegeEntry) GetDescription() string {
+func (x *PivotServerKeyExchange) GetSessionKey() []byte {
 	if x != nil {
-		return x.Description
+		return x.SessionKey
 	}
-	return ""
+	return nil
 }
 
-func (x *WindowsPrivilegeEntry) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *WindowsPrivilegeEntry) GetEnabledByDefault() bool {
-	if x != nil {
-		return x.EnabledByDefault
-	}
-	return false
-}
-
-func (x *WindowsPrivilegeEntry) GetRemoved() bool {
-	if x != nil {
-		return x.Removed
-	}
-	return false
-}
-
-func (x *WindowsPrivilegeEntry) GetUsedForAccess() bool {
-	if x != nil {
-		return x.UsedForAccess
-	}
-	return false
-}
-
-type GetPrivs struct {
+type PivotPeer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrivInfo         []*WindowsPrivilegeEntry `protobuf:"bytes,1,rep,name=PrivInfo,proto3" json:"PrivInfo,omitempty"`
-	ProcessIntegrity string                   `protobuf:"bytes,2,opt,name=ProcessIntegrity,proto3" json:"ProcessIntegrity,omitempty"`
-	ProcessName      string                   `protobuf:"bytes,3,opt,name=ProcessName,proto3" json:"ProcessName,omitempty"`
-	Response         *commonpb.Response       `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	PeerID int64  `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 }
 
-func (x *GetPrivs) Reset() {
-	*x = GetPrivs{}
+func (x *PivotPeer) Reset() {
+	*x = PivotPeer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9196,13 +9719,13 @@ func (x *GetPrivs) Reset() {
 	}
 }
 
-func (x *GetPrivs) String() string {
+func (x *PivotPeer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrivs) ProtoMessage() {}
+func (*PivotPeer) ProtoMessage() {}
 
-func (x *GetPrivs) ProtoReflect() protoreflect.Message {
+func (x *PivotPeer) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9214,53 +9737,39 @@ func (x *GetPrivs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrivs.ProtoReflect.Descriptor instead.
-func (*GetPrivs) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotPeer.ProtoReflect.Descriptor instead.
+func (*PivotPeer) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *GetPrivs) GetPrivInfo() []*WindowsPrivilegeEntry {
-	if x != nil {
-		return x.PrivInfo
-	}
-	return nil
-}
-
-func (x *GetPrivs) GetProcessIntegrity() string {
+func (x *PivotPeer) GetPeerID() int64 {
 	if x != nil {
-		return x.ProcessIntegrity
+		return x.PeerID
 	}
-	return ""
+	return 0
 }
 
-func (x *GetPrivs) GetProcessName() string {
+func (x *PivotPeer) GetName() string {
 	if x != nil {
-		return x.ProcessName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetPrivs) GetResponse() *commonpb.Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-type RegisterExtensionReq struct {
+type PivotPeerEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Data    []byte            `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
-	OS      string            `protobuf:"bytes,3,opt,name=OS,proto3" json:"OS,omitempty"`
-	Init    string            `protobuf:"bytes,4,opt,name=Init,proto3" json:"Init,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Peers          []*PivotPeer `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
+	Type           uint32       `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	PivotSessionID []byte       `protobuf:"bytes,3,opt,name=PivotSessionID,proto3" json:"PivotSessionID,omitempty"`
+	Data           []byte       `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+	PeerFailureAt  int64        `protobuf:"varint,5,opt,name=PeerFailureAt,proto3" json:"PeerFailureAt,omitempty"`
 }
 
-func (x *RegisterExtensionReq) Reset() {
-	*x = RegisterExtensionReq{}
+func (x *PivotPeerEnvelope) Reset() {
+	*x = PivotPeerEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9268,13 +9777,13 @@ func (x *RegisterExtensionReq) Reset() {
 	}
 }
 
-func (x *RegisterExtensionReq) String() string {
+func (x *PivotPeerEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterExtensionReq) ProtoMessage() {}
+func (*PivotPeerEnvelope) ProtoMessage() {}
 
-func (x *RegisterExtensionReq) ProtoReflect() protoreflect.Message {
+func (x *PivotPeerEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9286,56 +9795,56 @@ func (x *RegisterExtensionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterExtensionReq.ProtoReflect.Descriptor instead.
-func (*RegisterExtensionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotPeerEnvelope.ProtoReflect.Descriptor instead.
+func (*PivotPeerEnvelope) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *RegisterExtensionReq) GetName() string {
+func (x *PivotPeerEnvelope) GetPeers() []*PivotPeer {
 	if x != nil {
-		return x.Name
+		return x.Peers
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterExtensionReq) GetData() []byte {
+func (x *PivotPeerEnvelope) GetType() uint32 {
 	if x != nil {
-		return x.Data
+		return x.Type
 	}
-	return nil
+	return 0
 }
 
-func (x *RegisterExtensionReq) GetOS() string {
+func (x *PivotPeerEnvelope) GetPivotSessionID() []byte {
 	if x != nil {
-		return x.OS
+		return x.PivotSessionID
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterExtensionReq) GetInit() string {
+func (x *PivotPeerEnvelope) GetData() []byte {
 	if x != nil {
-		return x.Init
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterExtensionReq) GetRequest() *commonpb.Request {
+func (x *PivotPeerEnvelope) GetPeerFailureAt() int64 {
 	if x != nil {
-		return x.Request
+		return x.PeerFailureAt
 	}
-	return nil
+	return 0
 }
 
-type RegisterExtension struct {
+type PivotPing struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Nonce uint32 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 }
 
-func (x *RegisterExtension) Reset() {
-	*x = RegisterExtension{}
+func (x *PivotPing) Reset() {
+	*x = PivotPing{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9343,13 +9852,13 @@ func (x *RegisterExtension) Reset() {
 	}
 }
 
-func (x *RegisterExtension) String() string {
+func (x *PivotPing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterExtension) ProtoMessage() {}
+func (*PivotPing) ProtoMessage() {}
 
-func (x *RegisterExtension) ProtoReflect() protoreflect.Message {
+func (x *PivotPing) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9361,32 +9870,29 @@ func (x *RegisterExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterExtension.ProtoReflect.Descriptor instead.
-func (*RegisterExtension) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotPing.ProtoReflect.Descriptor instead.
+func (*PivotPing) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *RegisterExtension) GetResponse() *commonpb.Response {
+func (x *PivotPing) GetNonce() uint32 {
 	if x != nil {
-		return x.Response
+		return x.Nonce
 	}
-	return nil
+	return 0
 }
 
-type CallExtensionReq struct {
+type NetConnPivot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	ServerStore bool              `protobuf:"varint,2,opt,name=ServerStore,proto3" json:"ServerStore,omitempty"`
-	Args        []byte            `protobuf:"bytes,3,opt,name=Args,proto3" json:"Args,omitempty"`
-	Export      string            `protobuf:"bytes,4,opt,name=Export,proto3" json:"Export,omitempty"`
-	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	PeerID        int64  `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	RemoteAddress string `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
 }
 
-func (x *CallExtensionReq) Reset() {
-	*x = CallExtensionReq{}
+func (x *NetConnPivot) Reset() {
+	*x = NetConnPivot{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9394,13 +9900,13 @@ func (x *CallExtensionReq) Reset() {
 	}
 }
 
-func (x *CallExtensionReq) String() string {
+func (x *NetConnPivot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallExtensionReq) ProtoMessage() {}
+func (*NetConnPivot) ProtoMessage() {}
 
-func (x *CallExtensionReq) ProtoReflect() protoreflect.Message {
+func (x *NetConnPivot) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9412,58 +9918,37 @@ func (x *CallExtensionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallExtensionReq.ProtoReflect.Descriptor instead.
-func (*CallExtensionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetConnPivot.ProtoReflect.Descriptor instead.
+func (*NetConnPivot) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *CallExtensionReq) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CallExtensionReq) GetServerStore() bool {
-	if x != nil {
-		return x.ServerStore
-	}
-	return false
-}
-
-func (x *CallExtensionReq) GetArgs() []byte {
+func (x *NetConnPivot) GetPeerID() int64 {
 	if x != nil {
-		return x.Args
+		return x.PeerID
 	}
-	return nil
+	return 0
 }
 
-func (x *CallExtensionReq) GetExport() string {
+func (x *NetConnPivot) GetRemoteAddress() string {
 	if x != nil {
-		return x.Export
+		return x.RemoteAddress
 	}
 	return ""
 }
 
-func (x *CallExtensionReq) GetRequest() *commonpb.Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type CallExtension struct {
+type PivotPeerFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Output      []byte             `protobuf:"bytes,1,opt,name=Output,proto3" json:"Output,omitempty"`
-	ServerStore bool               `protobuf:"varint,2,opt,name=ServerStore,proto3" json:"ServerStore,omitempty"`
-	Response    *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	PeerID int64           `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	Type   PeerFailureType `protobuf:"varint,2,opt,name=Type,proto3,enum=sliverpb.PeerFailureType" json:"Type,omitempty"`
+	Err    string          `protobuf:"bytes,3,opt,name=Err,proto3" json:"Err,omitempty"`
 }
 
-func (x *CallExtension) Reset() {
-	*x = CallExtension{}
+func (x *PivotPeerFailure) Reset() {
+	*x = PivotPeerFailure{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9471,13 +9956,13 @@ func (x *CallExtension) Reset() {
 	}
 }
 
-func (x *CallExtension) String() string {
+func (x *PivotPeerFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallExtension) ProtoMessage() {}
+func (*PivotPeerFailure) ProtoMessage() {}
 
-func (x *CallExtension) ProtoReflect() protoreflect.Message {
+func (x *PivotPeerFailure) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9489,33 +9974,33 @@ func (x *CallExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallExtension.ProtoReflect.Descriptor instead.
-func (*CallExtension) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotPeerFailure.ProtoReflect.Descriptor instead.
+func (*PivotPeerFailure) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *CallExtension) GetOutput() []byte {
+func (x *PivotPeerFailure) GetPeerID() int64 {
 	if x != nil {
-		return x.Output
+		return x.PeerID
 	}
-	return nil
+	return 0
 }
 
-func (x *CallExtension) GetServerStore() bool {
+func (x *PivotPeerFailure) GetType() PeerFailureType {
 	if x != nil {
-		return x.ServerStore
+		return x.Type
 	}
-	return false
+	return PeerFailureType_SEND_FAILURE
 }
 
-func (x *CallExtension) GetResponse() *commonpb.Response {
+func (x *PivotPeerFailure) GetErr() string {
 	if x != nil {
-		return x.Response
+		return x.Err
 	}
-	return nil
+	return ""
 }
 
-type ListExtensionsReq struct {
+type PivotListenersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -9523,8 +10008,8 @@ type ListExtensionsReq struct {
 	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *ListExtensionsReq) Reset() {
-	*x = ListExtensionsReq{}
+func (x *PivotListenersReq) Reset() {
+	*x = PivotListenersReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9532,13 +10017,13 @@ func (x *ListExtensionsReq) Reset() {
 	}
 }
 
-func (x *ListExtensionsReq) String() string {
+func (x *PivotListenersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExtensionsReq) ProtoMessage() {}
+func (*PivotListenersReq) ProtoMessage() {}
 
-func (x *ListExtensionsReq) ProtoReflect() protoreflect.Message {
+func (x *PivotListenersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9550,29 +10035,29 @@ func (x *ListExtensionsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExtensionsReq.ProtoReflect.Descriptor instead.
-func (*ListExtensionsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotListenersReq.ProtoReflect.Descriptor instead.
+func (*PivotListenersReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *ListExtensionsReq) GetRequest() *commonpb.Request {
+func (x *PivotListenersReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type ListExtensions struct {
+type PivotListeners struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names    []string           `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Listeners []*PivotListener   `protobuf:"bytes,1,rep,name=Listeners,proto3" json:"Listeners,omitempty"`
+	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *ListExtensions) Reset() {
-	*x = ListExtensions{}
+func (x *PivotListeners) Reset() {
+	*x = PivotListeners{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9580,13 +10065,13 @@ func (x *ListExtensions) Reset() {
 	}
 }
 
-func (x *ListExtensions) String() string {
+func (x *PivotListeners) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExtensions) ProtoMessage() {}
+func (*PivotListeners) ProtoMessage() {}
 
-func (x *ListExtensions) ProtoReflect() protoreflect.Message {
+func (x *PivotListeners) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9598,36 +10083,37 @@ func (x *ListExtensions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExtensions.ProtoReflect.Descriptor instead.
-func (*ListExtensions) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotListeners.ProtoReflect.Descriptor instead.
+func (*PivotListeners) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *ListExtensions) GetNames() []string {
+func (x *PivotListeners) GetListeners() []*PivotListener {
 	if x != nil {
-		return x.Names
+		return x.Listeners
 	}
 	return nil
 }
 
-func (x *ListExtensions) GetResponse() *commonpb.Response {
+func (x *PivotListeners) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type RportFwdStopListenerReq struct {
+type WGPortForwardStartReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      uint32            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	LocalPort     int32             `protobuf:"varint,1,opt,name=LocalPort,proto3" json:"LocalPort,omitempty"`
+	RemoteAddress string            `protobuf:"bytes,2,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	Request       *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RportFwdStopListenerReq) Reset() {
-	*x = RportFwdStopListenerReq{}
+func (x *WGPortForwardStartReq) Reset() {
+	*x = WGPortForwardStartReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9635,13 +10121,13 @@ func (x *RportFwdStopListenerReq) Reset() {
 	}
 }
 
-func (x *RportFwdStopListenerReq) String() string {
+func (x *WGPortForwardStartReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RportFwdStopListenerReq) ProtoMessage() {}
+func (*WGPortForwardStartReq) ProtoMessage() {}
 
-func (x *RportFwdStopListenerReq) ProtoReflect() protoreflect.Message {
+func (x *WGPortForwardStartReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9653,39 +10139,43 @@ func (x *RportFwdStopListenerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RportFwdStopListenerReq.ProtoReflect.Descriptor instead.
-func (*RportFwdStopListenerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGPortForwardStartReq.ProtoReflect.Descriptor instead.
+func (*WGPortForwardStartReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *RportFwdStopListenerReq) GetID() uint32 {
+func (x *WGPortForwardStartReq) GetLocalPort() int32 {
 	if x != nil {
-		return x.ID
+		return x.LocalPort
 	}
 	return 0
 }
 
-func (x *RportFwdStopListenerReq) GetRequest() *commonpb.Request {
+func (x *WGPortForwardStartReq) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *WGPortForwardStartReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type RportFwdStartListenerReq struct {
+type WGPortForward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BindAddress    string            `protobuf:"bytes,1,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
-	BindPort       uint32            `protobuf:"varint,2,opt,name=BindPort,proto3" json:"BindPort,omitempty"`
-	ForwardPort    uint32            `protobuf:"varint,3,opt,name=ForwardPort,proto3" json:"ForwardPort,omitempty"`
-	ForwardAddress string            `protobuf:"bytes,4,opt,name=ForwardAddress,proto3" json:"ForwardAddress,omitempty"`
-	Request        *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Forwarder *WGTCPForwarder    `protobuf:"bytes,1,opt,name=Forwarder,proto3" json:"Forwarder,omitempty"`
+	Response  *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RportFwdStartListenerReq) Reset() {
-	*x = RportFwdStartListenerReq{}
+func (x *WGPortForward) Reset() {
+	*x = WGPortForward{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9693,13 +10183,13 @@ func (x *RportFwdStartListenerReq) Reset() {
 	}
 }
 
-func (x *RportFwdStartListenerReq) String() string {
+func (x *WGPortForward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RportFwdStartListenerReq) ProtoMessage() {}
+func (*WGPortForward) ProtoMessage() {}
 
-func (x *RportFwdStartListenerReq) ProtoReflect() protoreflect.Message {
+func (x *WGPortForward) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9711,61 +10201,36 @@ func (x *RportFwdStartListenerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RportFwdStartListenerReq.ProtoReflect.Descriptor instead.
-func (*RportFwdStartListenerReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGPortForward.ProtoReflect.Descriptor instead.
+func (*WGPortForward) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *RportFwdStartListenerReq) GetBindAddress() string {
-	if x != nil {
-		return x.BindAddress
-	}
-	return ""
-}
-
-func (x *RportFwdStartListenerReq) GetBindPort() uint32 {
-	if x != nil {
-		return x.BindPort
-	}
-	return 0
-}
-
-func (x *RportFwdStartListenerReq) GetForwardPort() uint32 {
-	if x != nil {
-		return x.ForwardPort
-	}
-	return 0
-}
-
-func (x *RportFwdStartListenerReq) GetForwardAddress() string {
+func (x *WGPortForward) GetForwarder() *WGTCPForwarder {
 	if x != nil {
-		return x.ForwardAddress
+		return x.Forwarder
 	}
-	return ""
+	return nil
 }
 
-func (x *RportFwdStartListenerReq) GetRequest() *commonpb.Request {
+func (x *WGPortForward) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Request
+		return x.Response
 	}
 	return nil
 }
 
-type RportFwdListener struct {
+type WGPortForwardStopReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             uint32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	BindAddress    string             `protobuf:"bytes,2,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
-	BindPort       uint32             `protobuf:"varint,3,opt,name=BindPort,proto3" json:"BindPort,omitempty"`
-	ForwardAddress string             `protobuf:"bytes,4,opt,name=ForwardAddress,proto3" json:"ForwardAddress,omitempty"`
-	ForwardPort    uint32             `protobuf:"varint,5,opt,name=ForwardPort,proto3" json:"ForwardPort,omitempty"`
-	Response       *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ID      int32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RportFwdListener) Reset() {
-	*x = RportFwdListener{}
+func (x *WGPortForwardStopReq) Reset() {
+	*x = WGPortForwardStopReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9773,13 +10238,13 @@ func (x *RportFwdListener) Reset() {
 	}
 }
 
-func (x *RportFwdListener) String() string {
+func (x *WGPortForwardStopReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RportFwdListener) ProtoMessage() {}
+func (*WGPortForwardStopReq) ProtoMessage() {}
 
-func (x *RportFwdListener) ProtoReflect() protoreflect.Message {
+func (x *WGPortForwardStopReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9791,64 +10256,36 @@ func (x *RportFwdListener) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RportFwdListener.ProtoReflect.Descriptor instead.
-func (*RportFwdListener) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGPortForwardStopReq.ProtoReflect.Descriptor instead.
+func (*WGPortForwardStopReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *RportFwdListener) GetID() uint32 {
+func (x *WGPortForwardStopReq) GetID() int32 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *RportFwdListener) GetBindAddress() string {
-	if x != nil {
-		return x.BindAddress
-	}
-	return ""
-}
-
-func (x *RportFwdListener) GetBindPort() uint32 {
-	if x != nil {
-		return x.BindPort
-	}
-	return 0
-}
-
-func (x *RportFwdListener) GetForwardAddress() string {
-	if x != nil {
-		return x.ForwardAddress
-	}
-	return ""
-}
-
-func (x *RportFwdListener) GetForwardPort() uint32 {
-	if x != nil {
-		return x.ForwardPort
-	}
-	return 0
-}
-
-func (x *RportFwdListener) GetResponse() *commonpb.Response {
+func (x *WGPortForwardStopReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type RportFwdListeners struct {
+type WGSocksStartReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listeners []*RportFwdListener `protobuf:"bytes,1,rep,name=Listeners,proto3" json:"Listeners,omitempty"`
-	Response  *commonpb.Response  `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Port    int32             `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RportFwdListeners) Reset() {
-	*x = RportFwdListeners{}
+func (x *WGSocksStartReq) Reset() {
+	*x = WGSocksStartReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9856,13 +10293,13 @@ func (x *RportFwdListeners) Reset() {
 	}
 }
 
-func (x *RportFwdListeners) String() string {
+func (x *WGSocksStartReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RportFwdListeners) ProtoMessage() {}
+func (*WGSocksStartReq) ProtoMessage() {}
 
-func (x *RportFwdListeners) ProtoReflect() protoreflect.Message {
+func (x *WGSocksStartReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9874,35 +10311,36 @@ func (x *RportFwdListeners) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RportFwdListeners.ProtoReflect.Descriptor instead.
-func (*RportFwdListeners) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGSocksStartReq.ProtoReflect.Descriptor instead.
+func (*WGSocksStartReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *RportFwdListeners) GetListeners() []*RportFwdListener {
+func (x *WGSocksStartReq) GetPort() int32 {
 	if x != nil {
-		return x.Listeners
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-func (x *RportFwdListeners) GetResponse() *commonpb.Response {
+func (x *WGSocksStartReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type RportFwdListenersReq struct {
+type WGSocks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Server   *WGSocksServer     `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RportFwdListenersReq) Reset() {
-	*x = RportFwdListenersReq{}
+func (x *WGSocks) Reset() {
+	*x = WGSocks{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9910,13 +10348,13 @@ func (x *RportFwdListenersReq) Reset() {
 	}
 }
 
-func (x *RportFwdListenersReq) String() string {
+func (x *WGSocks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RportFwdListenersReq) ProtoMessage() {}
+func (*WGSocks) ProtoMessage() {}
 
-func (x *RportFwdListenersReq) ProtoReflect() protoreflect.Message {
+func (x *WGSocks) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9928,32 +10366,36 @@ func (x *RportFwdListenersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RportFwdListenersReq.ProtoReflect.Descriptor instead.
-func (*RportFwdListenersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGSocks.ProtoReflect.Descriptor instead.
+func (*WGSocks) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *RportFwdListenersReq) GetRequest() *commonpb.Request {
+func (x *WGSocks) GetServer() *WGSocksServer {
 	if x != nil {
-		return x.Request
+		return x.Server
 	}
 	return nil
 }
 
-type RPortfwd struct {
+func (x *WGSocks) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type WGSocksStopReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port     uint32             `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
-	Protocol int32              `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	Host     string             `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
-	TunnelID uint64             `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ID      int32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RPortfwd) Reset() {
-	*x = RPortfwd{}
+func (x *WGSocksStopReq) Reset() {
+	*x = WGSocksStopReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9961,13 +10403,13 @@ func (x *RPortfwd) Reset() {
 	}
 }
 
-func (x *RPortfwd) String() string {
+func (x *WGSocksStopReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RPortfwd) ProtoMessage() {}
+func (*WGSocksStopReq) ProtoMessage() {}
 
-func (x *RPortfwd) ProtoReflect() protoreflect.Message {
+func (x *WGSocksStopReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9979,60 +10421,35 @@ func (x *RPortfwd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RPortfwd.ProtoReflect.Descriptor instead.
-func (*RPortfwd) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGSocksStopReq.ProtoReflect.Descriptor instead.
+func (*WGSocksStopReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *RPortfwd) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *RPortfwd) GetProtocol() int32 {
-	if x != nil {
-		return x.Protocol
-	}
-	return 0
-}
-
-func (x *RPortfwd) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *RPortfwd) GetTunnelID() uint64 {
+func (x *WGSocksStopReq) GetID() int32 {
 	if x != nil {
-		return x.TunnelID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *RPortfwd) GetResponse() *commonpb.Response {
+func (x *WGSocksStopReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type RPortfwdReq struct {
+type WGTCPForwardersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port     uint32            `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
-	Protocol int32             `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	Host     string            `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
-	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RPortfwdReq) Reset() {
-	*x = RPortfwdReq{}
+func (x *WGTCPForwardersReq) Reset() {
+	*x = WGTCPForwardersReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10040,13 +10457,13 @@ func (x *RPortfwdReq) Reset() {
 	}
 }
 
-func (x *RPortfwdReq) String() string {
+func (x *WGTCPForwardersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RPortfwdReq) ProtoMessage() {}
+func (*WGTCPForwardersReq) ProtoMessage() {}
 
-func (x *RPortfwdReq) ProtoReflect() protoreflect.Message {
+func (x *WGTCPForwardersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10058,59 +10475,28 @@ func (x *RPortfwdReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RPortfwdReq.ProtoReflect.Descriptor instead.
-func (*RPortfwdReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGTCPForwardersReq.ProtoReflect.Descriptor instead.
+func (*WGTCPForwardersReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *RPortfwdReq) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *RPortfwdReq) GetProtocol() int32 {
-	if x != nil {
-		return x.Protocol
-	}
-	return 0
-}
-
-func (x *RPortfwdReq) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *RPortfwdReq) GetTunnelID() uint64 {
-	if x != nil {
-		return x.TunnelID
-	}
-	return 0
-}
-
-func (x *RPortfwdReq) GetRequest() *commonpb.Request {
+func (x *WGTCPForwardersReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type ChmodReq struct {
+type WGSocksServersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path      string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	FileMode  string            `protobuf:"bytes,2,opt,name=FileMode,proto3" json:"FileMode,omitempty"`
-	Recursive bool              `protobuf:"varint,3,opt,name=Recursive,proto3" json:"Recursive,omitempty"`
-	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *ChmodReq) Reset() {
-	*x = ChmodReq{}
+func (x *WGSocksServersReq) Reset() {
+	*x = WGSocksServersReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10118,13 +10504,13 @@ func (x *ChmodReq) Reset() {
 	}
 }
 
-func (x *ChmodReq) String() string {
+func (x *WGSocksServersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChmodReq) ProtoMessage() {}
+func (*WGSocksServersReq) ProtoMessage() {}
 
-func (x *ChmodReq) ProtoReflect() protoreflect.Message {
+func (x *WGSocksServersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10136,50 +10522,30 @@ func (x *ChmodReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChmodReq.ProtoReflect.Descriptor instead.
-func (*ChmodReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGSocksServersReq.ProtoReflect.Descriptor instead.
+func (*WGSocksServersReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *ChmodReq) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ChmodReq) GetFileMode() string {
-	if x != nil {
-		return x.FileMode
-	}
-	return ""
-}
-
-func (x *ChmodReq) GetRecursive() bool {
-	if x != nil {
-		return x.Recursive
-	}
-	return false
-}
-
-func (x *ChmodReq) GetRequest() *commonpb.Request {
+func (x *WGSocksServersReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type Chmod struct {
+type WGTCPForwarder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ID         int32  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	LocalAddr  string `protobuf:"bytes,2,opt,name=LocalAddr,proto3" json:"LocalAddr,omitempty"`
+	RemoteAddr string `protobuf:"bytes,3,opt,name=RemoteAddr,proto3" json:"RemoteAddr,omitempty"`
 }
 
-func (x *Chmod) Reset() {
-	*x = Chmod{}
+func (x *WGTCPForwarder) Reset() {
+	*x = WGTCPForwarder{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10187,13 +10553,13 @@ func (x *Chmod) Reset() {
 	}
 }
 
-func (x *Chmod) String() string {
+func (x *WGTCPForwarder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Chmod) ProtoMessage() {}
+func (*WGTCPForwarder) ProtoMessage() {}
 
-func (x *Chmod) ProtoReflect() protoreflect.Message {
+func (x *WGTCPForwarder) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10205,39 +10571,43 @@ func (x *Chmod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Chmod.ProtoReflect.Descriptor instead.
-func (*Chmod) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGTCPForwarder.ProtoReflect.Descriptor instead.
+func (*WGTCPForwarder) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *Chmod) GetPath() string {
+func (x *WGTCPForwarder) GetID() int32 {
 	if x != nil {
-		return x.Path
+		return x.ID
+	}
+	return 0
+}
+
+func (x *WGTCPForwarder) GetLocalAddr() string {
+	if x != nil {
+		return x.LocalAddr
 	}
 	return ""
 }
 
-func (x *Chmod) GetResponse() *commonpb.Response {
+func (x *WGTCPForwarder) GetRemoteAddr() string {
 	if x != nil {
-		return x.Response
+		return x.RemoteAddr
 	}
-	return nil
+	return ""
 }
 
-type ChownReq struct {
+type WGSocksServer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path      string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	Uid       string            `protobuf:"bytes,2,opt,name=Uid,proto3" json:"Uid,omitempty"`
-	Gid       string            `protobuf:"bytes,3,opt,name=Gid,proto3" json:"Gid,omitempty"`
-	Recursive bool              `protobuf:"varint,4,opt,name=Recursive,proto3" json:"Recursive,omitempty"`
-	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	ID        int32  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	LocalAddr string `protobuf:"bytes,2,opt,name=LocalAddr,proto3" json:"LocalAddr,omitempty"`
 }
 
-func (x *ChownReq) Reset() {
-	*x = ChownReq{}
+func (x *WGSocksServer) Reset() {
+	*x = WGSocksServer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10245,13 +10615,13 @@ func (x *ChownReq) Reset() {
 	}
 }
 
-func (x *ChownReq) String() string {
+func (x *WGSocksServer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChownReq) ProtoMessage() {}
+func (*WGSocksServer) ProtoMessage() {}
 
-func (x *ChownReq) ProtoReflect() protoreflect.Message {
+func (x *WGSocksServer) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10263,57 +10633,36 @@ func (x *ChownReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChownReq.ProtoReflect.Descriptor instead.
-func (*ChownReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGSocksServer.ProtoReflect.Descriptor instead.
+func (*WGSocksServer) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *ChownReq) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ChownReq) GetUid() string {
+func (x *WGSocksServer) GetID() int32 {
 	if x != nil {
-		return x.Uid
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *ChownReq) GetGid() string {
+func (x *WGSocksServer) GetLocalAddr() string {
 	if x != nil {
-		return x.Gid
+		return x.LocalAddr
 	}
 	return ""
 }
 
-func (x *ChownReq) GetRecursive() bool {
-	if x != nil {
-		return x.Recursive
-	}
-	return false
-}
-
-func (x *ChownReq) GetRequest() *commonpb.Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type Chown struct {
+type WGSocksServers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Servers  []*WGSocksServer   `protobuf:"bytes,1,rep,name=Servers,proto3" json:"Servers,omitempty"`
 	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *Chown) Reset() {
-	*x = Chown{}
+func (x *WGSocksServers) Reset() {
+	*x = WGSocksServers{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[153]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10321,13 +10670,13 @@ func (x *Chown) Reset() {
 	}
 }
 
-func (x *Chown) String() string {
+func (x *WGSocksServers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Chown) ProtoMessage() {}
+func (*WGSocksServers) ProtoMessage() {}
 
-func (x *Chown) ProtoReflect() protoreflect.Message {
+func (x *WGSocksServers) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[153]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10339,38 +10688,36 @@ func (x *Chown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Chown.ProtoReflect.Descriptor instead.
-func (*Chown) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGSocksServers.ProtoReflect.Descriptor instead.
+func (*WGSocksServers) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *Chown) GetPath() string {
+func (x *WGSocksServers) GetServers() []*WGSocksServer {
 	if x != nil {
-		return x.Path
+		return x.Servers
 	}
-	return ""
+	return nil
 }
 
-func (x *Chown) GetResponse() *commonpb.Response {
+func (x *WGSocksServers) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type ChtimesReq struct {
+type WGTCPForwarders struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path    string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	ATime   int64             `protobuf:"varint,2,opt,name=ATime,proto3" json:"ATime,omitempty"`
-	MTime   int64             `protobuf:"varint,3,opt,name=MTime,proto3" json:"MTime,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Forwarders []*WGTCPForwarder  `protobuf:"bytes,1,rep,name=Forwarders,proto3" json:"Forwarders,omitempty"`
+	Response   *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *ChtimesReq) Reset() {
-	*x = ChtimesReq{}
+func (x *WGTCPForwarders) Reset() {
+	*x = WGTCPForwarders{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10378,13 +10725,13 @@ func (x *ChtimesReq) Reset() {
 	}
 }
 
-func (x *ChtimesReq) String() string {
+func (x *WGTCPForwarders) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChtimesReq) ProtoMessage() {}
+func (*WGTCPForwarders) ProtoMessage() {}
 
-func (x *ChtimesReq) ProtoReflect() protoreflect.Message {
+func (x *WGTCPForwarders) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10396,50 +10743,39 @@ func (x *ChtimesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChtimesReq.ProtoReflect.Descriptor instead.
-func (*ChtimesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WGTCPForwarders.ProtoReflect.Descriptor instead.
+func (*WGTCPForwarders) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *ChtimesReq) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ChtimesReq) GetATime() int64 {
-	if x != nil {
-		return x.ATime
-	}
-	return 0
-}
-
-func (x *ChtimesReq) GetMTime() int64 {
+func (x *WGTCPForwarders) GetForwarders() []*WGTCPForwarder {
 	if x != nil {
-		return x.MTime
+		return x.Forwarders
 	}
-	return 0
+	return nil
 }
 
-func (x *ChtimesReq) GetRequest() *commonpb.Request {
+func (x *WGTCPForwarders) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Request
+		return x.Response
 	}
 	return nil
 }
 
-type Chtimes struct {
+// ReconfigureReq - Request the implant to reconfigure itself
+type ReconfigureReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	ReconnectInterval int64             `protobuf:"varint,1,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
+	BeaconInterval    int64             `protobuf:"varint,2,opt,name=BeaconInterval,proto3" json:"BeaconInterval,omitempty"`
+	BeaconJitter      int64             `protobuf:"varint,3,opt,name=BeaconJitter,proto3" json:"BeaconJitter,omitempty"`
+	Request           *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *Chtimes) Reset() {
-	*x = Chtimes{}
+func (x *ReconfigureReq) Reset() {
+	*x = ReconfigureReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10447,13 +10783,13 @@ func (x *Chtimes) Reset() {
 	}
 }
 
-func (x *Chtimes) String() string {
+func (x *ReconfigureReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Chtimes) ProtoMessage() {}
+func (*ReconfigureReq) ProtoMessage() {}
 
-func (x *Chtimes) ProtoReflect() protoreflect.Message {
+func (x *ReconfigureReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10465,35 +10801,49 @@ func (x *Chtimes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Chtimes.ProtoReflect.Descriptor instead.
-func (*Chtimes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconfigureReq.ProtoReflect.Descriptor instead.
+func (*ReconfigureReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *Chtimes) GetPath() string {
+func (x *ReconfigureReq) GetReconnectInterval() int64 {
 	if x != nil {
-		return x.Path
+		return x.ReconnectInterval
 	}
-	return ""
+	return 0
 }
 
-func (x *Chtimes) GetResponse() *commonpb.Response {
+func (x *ReconfigureReq) GetBeaconInterval() int64 {
 	if x != nil {
-		return x.Response
+		return x.BeaconInterval
+	}
+	return 0
+}
+
+func (x *ReconfigureReq) GetBeaconJitter() int64 {
+	if x != nil {
+		return x.BeaconJitter
+	}
+	return 0
+}
+
+func (x *ReconfigureReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
 	}
 	return nil
 }
 
-type MemfilesListReq struct {
+type Reconfigure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *MemfilesListReq) Reset() {
-	*x = MemfilesListReq{}
+func (x *Reconfigure) Reset() {
+	*x = Reconfigure{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[156]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10501,13 +10851,13 @@ func (x *MemfilesListReq) Reset() {
 	}
 }
 
-func (x *MemfilesListReq) String() string {
+func (x *Reconfigure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemfilesListReq) ProtoMessage() {}
+func (*Reconfigure) ProtoMessage() {}
 
-func (x *MemfilesListReq) ProtoReflect() protoreflect.Message {
+func (x *Reconfigure) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[156]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10519,28 +10869,30 @@ func (x *MemfilesListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemfilesListReq.ProtoReflect.Descriptor instead.
-func (*MemfilesListReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reconfigure.ProtoReflect.Descriptor instead.
+func (*Reconfigure) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *MemfilesListReq) GetRequest() *commonpb.Request {
+func (x *Reconfigure) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Request
+		return x.Response
 	}
 	return nil
 }
 
-type MemfilesAddReq struct {
+// PollIntervalReq - Request the implant to update it's poll intervbal
+type PollIntervalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	PollInterval int64             `protobuf:"varint,1,opt,name=PollInterval,proto3" json:"PollInterval,omitempty"`
+	Request      *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *MemfilesAddReq) Reset() {
-	*x = MemfilesAddReq{}
+func (x *PollIntervalReq) Reset() {
+	*x = PollIntervalReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10548,13 +10900,13 @@ func (x *MemfilesAddReq) Reset() {
 	}
 }
 
-func (x *MemfilesAddReq) String() string {
+func (x *PollIntervalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemfilesAddReq) ProtoMessage() {}
+func (*PollIntervalReq) ProtoMessage() {}
 
-func (x *MemfilesAddReq) ProtoReflect() protoreflect.Message {
+func (x *PollIntervalReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10566,29 +10918,35 @@ func (x *MemfilesAddReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemfilesAddReq.ProtoReflect.Descriptor instead.
-func (*MemfilesAddReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PollIntervalReq.ProtoReflect.Descriptor instead.
+func (*PollIntervalReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *MemfilesAddReq) GetRequest() *commonpb.Request {
+func (x *PollIntervalReq) GetPollInterval() int64 {
+	if x != nil {
+		return x.PollInterval
+	}
+	return 0
+}
+
+func (x *PollIntervalReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type MemfilesAdd struct {
+type PollInterval struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fd       int64              `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
 	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *MemfilesAdd) Reset() {
-	*x = MemfilesAdd{}
+func (x *PollInterval) Reset() {
+	*x = PollInterval{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[158]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10596,13 +10954,13 @@ func (x *MemfilesAdd) Reset() {
 	}
 }
 
-func (x *MemfilesAdd) String() string {
+func (x *PollInterval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemfilesAdd) ProtoMessage() {}
+func (*PollInterval) ProtoMessage() {}
 
-func (x *MemfilesAdd) ProtoReflect() protoreflect.Message {
+func (x *PollInterval) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[158]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10614,36 +10972,37 @@ func (x *MemfilesAdd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemfilesAdd.ProtoReflect.Descriptor instead.
-func (*MemfilesAdd) Descriptor() ([]byte, []int) {
+// Deprecated: Use PollInterval.ProtoReflect.Descriptor instead.
+func (*PollInterval) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *MemfilesAdd) GetFd() int64 {
-	if x != nil {
-		return x.Fd
-	}
-	return 0
-}
-
-func (x *MemfilesAdd) GetResponse() *commonpb.Response {
+func (x *PollInterval) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type MemfilesRmReq struct {
+type SSHCommandReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fd      int64             `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Username string            `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
+	Hostname string            `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Port     uint32            `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	Command  string            `protobuf:"bytes,4,opt,name=Command,proto3" json:"Command,omitempty"`
+	Password string            `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
+	PrivKey  []byte            `protobuf:"bytes,6,opt,name=PrivKey,proto3" json:"PrivKey,omitempty"`
+	Krb5Conf string            `protobuf:"bytes,7,opt,name=Krb5Conf,proto3" json:"Krb5Conf,omitempty"`
+	Keytab   []byte            `protobuf:"bytes,8,opt,name=Keytab,proto3" json:"Keytab,omitempty"`
+	Realm    string            `protobuf:"bytes,10,opt,name=Realm,proto3" json:"Realm,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *MemfilesRmReq) Reset() {
-	*x = MemfilesRmReq{}
+func (x *SSHCommandReq) Reset() {
+	*x = SSHCommandReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[159]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10651,13 +11010,13 @@ func (x *MemfilesRmReq) Reset() {
 	}
 }
 
-func (x *MemfilesRmReq) String() string {
+func (x *SSHCommandReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemfilesRmReq) ProtoMessage() {}
+func (*SSHCommandReq) ProtoMessage() {}
 
-func (x *MemfilesRmReq) ProtoReflect() protoreflect.Message {
+func (x *SSHCommandReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[159]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10669,107 +11028,108 @@ func (x *MemfilesRmReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemfilesRmReq.ProtoReflect.Descriptor instead.
-func (*MemfilesRmReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSHCommandReq.ProtoReflect.Descriptor instead.
+func (*SSHCommandReq) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *MemfilesRmReq) GetFd() int64 {
+func (x *SSHCommandReq) GetUsername() string {
 	if x != nil {
-		return x.Fd
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *MemfilesRmReq) GetRequest() *commonpb.Request {
+func (x *SSHCommandReq) GetHostname() string {
 	if x != nil {
-		return x.Request
+		return x.Hostname
 	}
-	return nil
+	return ""
 }
 
-type MemfilesRm struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Fd       int64              `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+func (x *SSHCommandReq) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
 }
 
-func (x *MemfilesRm) Reset() {
-	*x = MemfilesRm{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[160]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SSHCommandReq) GetCommand() string {
+	if x != nil {
+		return x.Command
 	}
+	return ""
 }
 
-func (x *MemfilesRm) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SSHCommandReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (*MemfilesRm) ProtoMessage() {}
+func (x *SSHCommandReq) GetPrivKey() []byte {
+	if x != nil {
+		return x.PrivKey
+	}
+	return nil
+}
 
-func (x *MemfilesRm) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[160]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SSHCommandReq) GetKrb5Conf() string {
+	if x != nil {
+		return x.Krb5Conf
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MemfilesRm.ProtoReflect.Descriptor instead.
-func (*MemfilesRm) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{160}
+func (x *SSHCommandReq) GetKeytab() []byte {
+	if x != nil {
+		return x.Keytab
+	}
+	return nil
 }
 
-func (x *MemfilesRm) GetFd() int64 {
+func (x *SSHCommandReq) GetRealm() string {
 	if x != nil {
-		return x.Fd
+		return x.Realm
 	}
-	return 0
+	return ""
 }
 
-func (x *MemfilesRm) GetResponse() *commonpb.Response {
+func (x *SSHCommandReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type RegisterWasmExtensionReq struct {
+type SSHCommand struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	WasmGz  []byte            `protobuf:"bytes,2,opt,name=WasmGz,proto3" json:"WasmGz,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	StdOut   string             `protobuf:"bytes,1,opt,name=StdOut,proto3" json:"StdOut,omitempty"`
+	StdErr   string             `protobuf:"bytes,2,opt,name=StdErr,proto3" json:"StdErr,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *RegisterWasmExtensionReq) Reset() {
-	*x = RegisterWasmExtensionReq{}
+func (x *SSHCommand) Reset() {
+	*x = SSHCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[161]
+		mi := &file_sliverpb_sliver_proto_msgTypes[160]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterWasmExtensionReq) String() string {
+func (x *SSHCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterWasmExtensionReq) ProtoMessage() {}
+func (*SSHCommand) ProtoMessage() {}
 
-func (x *RegisterWasmExtensionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[161]
+func (x *SSHCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[160]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10780,57 +11140,57 @@ func (x *RegisterWasmExtensionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterWasmExtensionReq.ProtoReflect.Descriptor instead.
-func (*RegisterWasmExtensionReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{161}
+// Deprecated: Use SSHCommand.ProtoReflect.Descriptor instead.
+func (*SSHCommand) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *RegisterWasmExtensionReq) GetName() string {
+func (x *SSHCommand) GetStdOut() string {
 	if x != nil {
-		return x.Name
+		return x.StdOut
 	}
 	return ""
 }
 
-func (x *RegisterWasmExtensionReq) GetWasmGz() []byte {
+func (x *SSHCommand) GetStdErr() string {
 	if x != nil {
-		return x.WasmGz
+		return x.StdErr
 	}
-	return nil
+	return ""
 }
 
-func (x *RegisterWasmExtensionReq) GetRequest() *commonpb.Request {
+func (x *SSHCommand) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Request
+		return x.Response
 	}
 	return nil
 }
 
-type RegisterWasmExtension struct {
+type GetPrivsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *RegisterWasmExtension) Reset() {
-	*x = RegisterWasmExtension{}
+func (x *GetPrivsReq) Reset() {
+	*x = GetPrivsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[162]
+		mi := &file_sliverpb_sliver_proto_msgTypes[161]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterWasmExtension) String() string {
+func (x *GetPrivsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterWasmExtension) ProtoMessage() {}
+func (*GetPrivsReq) ProtoMessage() {}
 
-func (x *RegisterWasmExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[162]
+func (x *GetPrivsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[161]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10841,44 +11201,48 @@ func (x *RegisterWasmExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterWasmExtension.ProtoReflect.Descriptor instead.
-func (*RegisterWasmExtension) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use GetPrivsReq.ProtoReflect.Descriptor instead.
+func (*GetPrivsReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *RegisterWasmExtension) GetResponse() *commonpb.Response {
+func (x *GetPrivsReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type DeregisterWasmExtensionReq struct {
+type WindowsPrivilegeEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Name             string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description      string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	EnabledByDefault bool   `protobuf:"varint,4,opt,name=EnabledByDefault,proto3" json:"EnabledByDefault,omitempty"`
+	Removed          bool   `protobuf:"varint,5,opt,name=Removed,proto3" json:"Removed,omitempty"`
+	UsedForAccess    bool   `protobuf:"varint,6,opt,name=UsedForAccess,proto3" json:"UsedForAccess,omitempty"`
 }
 
-func (x *DeregisterWasmExtensionReq) Reset() {
-	*x = DeregisterWasmExtensionReq{}
+func (x *WindowsPrivilegeEntry) Reset() {
+	*x = WindowsPrivilegeEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[163]
+		mi := &file_sliverpb_sliver_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeregisterWasmExtensionReq) String() string {
+func (x *WindowsPrivilegeEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeregisterWasmExtensionReq) ProtoMessage() {}
+func (*WindowsPrivilegeEntry) ProtoMessage() {}
 
-func (x *DeregisterWasmExtensionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[163]
+func (x *WindowsPrivilegeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10889,98 +11253,81 @@ func (x *DeregisterWasmExtensionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeregisterWasmExtensionReq.ProtoReflect.Descriptor instead.
-func (*DeregisterWasmExtensionReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{163}
+// Deprecated: Use WindowsPrivilegeEntry.ProtoReflect.Descriptor instead.
+func (*WindowsPrivilegeEntry) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *DeregisterWasmExtensionReq) GetName() string {
+func (x *WindowsPrivilegeEntry) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeregisterWasmExtensionReq) GetRequest() *commonpb.Request {
+func (x *WindowsPrivilegeEntry) GetDescription() string {
 	if x != nil {
-		return x.Request
+		return x.Description
 	}
-	return nil
-}
-
-type ListWasmExtensionsReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	return ""
 }
 
-func (x *ListWasmExtensionsReq) Reset() {
-	*x = ListWasmExtensionsReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[164]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WindowsPrivilegeEntry) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
+	return false
 }
 
-func (x *ListWasmExtensionsReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListWasmExtensionsReq) ProtoMessage() {}
-
-func (x *ListWasmExtensionsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[164]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WindowsPrivilegeEntry) GetEnabledByDefault() bool {
+	if x != nil {
+		return x.EnabledByDefault
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListWasmExtensionsReq.ProtoReflect.Descriptor instead.
-func (*ListWasmExtensionsReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{164}
+func (x *WindowsPrivilegeEntry) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
 }
 
-func (x *ListWasmExtensionsReq) GetRequest() *commonpb.Request {
+func (x *WindowsPrivilegeEntry) GetUsedForAccess() bool {
 	if x != nil {
-		return x.Request
+		return x.UsedForAccess
 	}
-	return nil
+	return false
 }
 
-type ListWasmExtensions struct {
+type GetPrivs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names    []string           `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	PrivInfo         []*WindowsPrivilegeEntry `protobuf:"bytes,1,rep,name=PrivInfo,proto3" json:"PrivInfo,omitempty"`
+	ProcessIntegrity string                   `protobuf:"bytes,2,opt,name=ProcessIntegrity,proto3" json:"ProcessIntegrity,omitempty"`
+	ProcessName      string                   `protobuf:"bytes,3,opt,name=ProcessName,proto3" json:"ProcessName,omitempty"`
+	Response         *commonpb.Response       `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *ListWasmExtensions) Reset() {
-	*x = ListWasmExtensions{}
+func (x *GetPrivs) Reset() {
+	*x = GetPrivs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[165]
+		mi := &file_sliverpb_sliver_proto_msgTypes[163]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListWasmExtensions) String() string {
+func (x *GetPrivs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWasmExtensions) ProtoMessage() {}
+func (*GetPrivs) ProtoMessage() {}
 
-func (x *ListWasmExtensions) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[165]
+func (x *GetPrivs) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[163]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10991,55 +11338,68 @@ func (x *ListWasmExtensions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWasmExtensions.ProtoReflect.Descriptor instead.
-func (*ListWasmExtensions) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use GetPrivs.ProtoReflect.Descriptor instead.
+func (*GetPrivs) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *ListWasmExtensions) GetNames() []string {
+func (x *GetPrivs) GetPrivInfo() []*WindowsPrivilegeEntry {
 	if x != nil {
-		return x.Names
+		return x.PrivInfo
 	}
 	return nil
 }
 
-func (x *ListWasmExtensions) GetResponse() *commonpb.Response {
+func (x *GetPrivs) GetProcessIntegrity() string {
+	if x != nil {
+		return x.ProcessIntegrity
+	}
+	return ""
+}
+
+func (x *GetPrivs) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *GetPrivs) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type ExecWasmExtensionReq struct {
+type RegisterExtensionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Args        []string          `protobuf:"bytes,2,rep,name=Args,proto3" json:"Args,omitempty"`
-	Interactive bool              `protobuf:"varint,3,opt,name=Interactive,proto3" json:"Interactive,omitempty"`
-	MemFS       map[string][]byte `protobuf:"bytes,4,rep,name=MemFS,proto3" json:"MemFS,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TunnelID    uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
-	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Name    string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Data    []byte            `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	OS      string            `protobuf:"bytes,3,opt,name=OS,proto3" json:"OS,omitempty"`
+	Init    string            `protobuf:"bytes,4,opt,name=Init,proto3" json:"Init,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *ExecWasmExtensionReq) Reset() {
-	*x = ExecWasmExtensionReq{}
+func (x *RegisterExtensionReq) Reset() {
+	*x = RegisterExtensionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sliverpb_sliver_proto_msgTypes[166]
+		mi := &file_sliverpb_sliver_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecWasmExtensionReq) String() string {
+func (x *RegisterExtensionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecWasmExtensionReq) ProtoMessage() {}
+func (*RegisterExtensionReq) ProtoMessage() {}
 
-func (x *ExecWasmExtensionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sliverpb_sliver_proto_msgTypes[166]
+func (x *RegisterExtensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11050,66 +11410,184 @@ func (x *ExecWasmExtensionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecWasmExtensionReq.ProtoReflect.Descriptor instead.
-func (*ExecWasmExtensionReq) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{166}
-}
-
-func (x *ExecWasmExtensionReq) GetName() string {
+// Deprecated: Use RegisterExtensionReq.ProtoReflect.Descriptor instead.
+func (*RegisterExtensionReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *RegisterExtensionReq) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ExecWasmExtensionReq) GetArgs() []string {
+func (x *RegisterExtensionReq) GetData() []byte {
 	if x != nil {
-		return x.Args
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ExecWasmExtensionReq) GetInteractive() bool {
+func (x *RegisterExtensionReq) GetOS() string {
 	if x != nil {
-		return x.Interactive
+		return x.OS
+	}
+	return ""
+}
+
+func (x *RegisterExtensionReq) GetInit() string {
+	if x != nil {
+		return x.Init
+	}
+	return ""
+}
+
+func (x *RegisterExtensionReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RegisterExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *RegisterExtension) Reset() {
+	*x = RegisterExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterExtension) ProtoMessage() {}
+
+func (x *RegisterExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterExtension.ProtoReflect.Descriptor instead.
+func (*RegisterExtension) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *RegisterExtension) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type CallExtensionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	ServerStore bool              `protobuf:"varint,2,opt,name=ServerStore,proto3" json:"ServerStore,omitempty"`
+	Args        []byte            `protobuf:"bytes,3,opt,name=Args,proto3" json:"Args,omitempty"`
+	Export      string            `protobuf:"bytes,4,opt,name=Export,proto3" json:"Export,omitempty"`
+	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *CallExtensionReq) Reset() {
+	*x = CallExtensionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallExtensionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExtensionReq) ProtoMessage() {}
+
+func (x *CallExtensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExtensionReq.ProtoReflect.Descriptor instead.
+func (*CallExtensionReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *CallExtensionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CallExtensionReq) GetServerStore() bool {
+	if x != nil {
+		return x.ServerStore
 	}
 	return false
 }
 
-func (x *ExecWasmExtensionReq) GetMemFS() map[string][]byte {
+func (x *CallExtensionReq) GetArgs() []byte {
 	if x != nil {
-		return x.MemFS
+		return x.Args
 	}
 	return nil
 }
 
-func (x *ExecWasmExtensionReq) GetTunnelID() uint64 {
+func (x *CallExtensionReq) GetExport() string {
 	if x != nil {
-		return x.TunnelID
+		return x.Export
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecWasmExtensionReq) GetRequest() *commonpb.Request {
+func (x *CallExtensionReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type ExecWasmExtension struct {
+type CallExtension struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stdout   []byte             `protobuf:"bytes,1,opt,name=Stdout,proto3" json:"Stdout,omitempty"`
-	Stderr   []byte             `protobuf:"bytes,2,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
-	ExitCode uint32             `protobuf:"varint,3,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Output      []byte             `protobuf:"bytes,1,opt,name=Output,proto3" json:"Output,omitempty"`
+	ServerStore bool               `protobuf:"varint,2,opt,name=ServerStore,proto3" json:"ServerStore,omitempty"`
+	Response    *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *ExecWasmExtension) Reset() {
-	*x = ExecWasmExtension{}
+func (x *CallExtension) Reset() {
+	*x = CallExtension{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[167]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11117,13 +11595,13 @@ func (x *ExecWasmExtension) Reset() {
 	}
 }
 
-func (x *ExecWasmExtension) String() string {
+func (x *CallExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecWasmExtension) ProtoMessage() {}
+func (*CallExtension) ProtoMessage() {}
 
-func (x *ExecWasmExtension) ProtoReflect() protoreflect.Message {
+func (x *CallExtension) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[167]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11135,50 +11613,42 @@ func (x *ExecWasmExtension) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecWasmExtension.ProtoReflect.Descriptor instead.
-func (*ExecWasmExtension) Descriptor() ([]byte, []int) {
+// Deprecated: Use CallExtension.ProtoReflect.Descriptor instead.
+func (*CallExtension) Descriptor() ([]byte, []int) {
 	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *ExecWasmExtension) GetStdout() []byte {
-	if x != nil {
-		return x.Stdout
-	}
-	return nil
-}
-
-func (x *ExecWasmExtension) GetStderr() []byte {
+func (x *CallExtension) GetOutput() []byte {
 	if x != nil {
-		return x.Stderr
+		return x.Output
 	}
 	return nil
 }
 
-func (x *ExecWasmExtension) GetExitCode() uint32 {
+func (x *CallExtension) GetServerStore() bool {
 	if x != nil {
-		return x.ExitCode
+		return x.ServerStore
 	}
-	return 0
+	return false
 }
 
-func (x *ExecWasmExtension) GetResponse() *commonpb.Response {
+func (x *CallExtension) GetResponse() *commonpb.Response {
 	if x != nil {
 		return x.Response
 	}
 	return nil
 }
 
-type SockTabEntry_SockAddr struct {
+type ListExtensionsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip   string `protobuf:"bytes,1,opt,name=Ip,proto3" json:"Ip,omitempty"`
-	Port uint32 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *SockTabEntry_SockAddr) Reset() {
-	*x = SockTabEntry_SockAddr{}
+func (x *ListExtensionsReq) Reset() {
+	*x = ListExtensionsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sliverpb_sliver_proto_msgTypes[168]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11186,13 +11656,13 @@ func (x *SockTabEntry_SockAddr) Reset() {
 	}
 }
 
-func (x *SockTabEntry_SockAddr) String() string {
+func (x *ListExtensionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SockTabEntry_SockAddr) ProtoMessage() {}
+func (*ListExtensionsReq) ProtoMessage() {}
 
-func (x *SockTabEntry_SockAddr) ProtoReflect() protoreflect.Message {
+func (x *ListExtensionsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sliverpb_sliver_proto_msgTypes[168]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11204,732 +11674,2480 @@ func (x *SockTabEntry_SockAddr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SockTabEntry_SockAddr.ProtoReflect.Descriptor instead.
-func (*SockTabEntry_SockAddr) Descriptor() ([]byte, []int) {
-	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{65, 0}
+// Deprecated: Use ListExtensionsReq.ProtoReflect.Descriptor instead.
+func (*ListExtensionsReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *SockTabEntry_SockAddr) GetIp() string {
+func (x *ListExtensionsReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Ip
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *SockTabEntry_SockAddr) GetPort() uint32 {
-	if x != nil {
-		return x.Port
+type ListExtensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names    []string           `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *ListExtensions) Reset() {
+	*x = ListExtensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_sliverpb_sliver_proto protoreflect.FileDescriptor
+func (x *ListExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_sliverpb_sliver_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x72, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x12,
-	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x69, 0x0a, 0x0b,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x05, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x05,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x22, 0xac, 0x03, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63, 0x68,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03,
-	0x50, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
-	0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x22,
-	0x51, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x43, 0x32, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4c, 0x0a, 0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f,
-	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a,
-	0x05, 0x50, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x02, 0x50, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x0c, 0x54, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x46, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72,
-	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4d, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x2e,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a,
-	0x0a, 0x0b, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x08, 0x49, 0x66,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0d, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x41, 0x43,
-	0x12, 0x20, 0x0a, 0x0b, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x22, 0x48, 0x0a, 0x05, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce, 0x01, 0x0a,
-	0x02, 0x4c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
-	0x28, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
-	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
-	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
-	0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74,
-	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01,
-	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49,
-	0x73, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x47, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x69, 0x64, 0x22, 0x48,
-	0x0a, 0x05, 0x43, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x06, 0x50, 0x77, 0x64, 0x52,
-	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x49, 0x0a, 0x03, 0x50, 0x77, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x05, 0x52, 0x6d,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75,
-	0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x02, 0x52, 0x6d, 0x12, 0x12,
-	0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x58, 0x0a, 0x05, 0x4d, 0x76, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x53,
-	0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x72, 0x63, 0x12, 0x10, 0x0a,
-	0x03, 0x44, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x73, 0x74, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x02,
-	0x4d, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x53, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x44, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x05, 0x43, 0x70, 0x52, 0x65, 0x71, 0x12,
-	0x10, 0x0a, 0x03, 0x53, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x72,
-	0x63, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x44, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x7c, 0x0a, 0x02, 0x43, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x72, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x2e,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b,
-	0x0a, 0x08, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b,
+func (*ListExtensions) ProtoMessage() {}
+
+func (x *ListExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExtensions.ProtoReflect.Descriptor instead.
+func (*ListExtensions) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ListExtensions) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListExtensions) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type RportFwdStopListenerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      uint32            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *RportFwdStopListenerReq) Reset() {
+	*x = RportFwdStopListenerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RportFwdStopListenerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RportFwdStopListenerReq) ProtoMessage() {}
+
+func (x *RportFwdStopListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RportFwdStopListenerReq.ProtoReflect.Descriptor instead.
+func (*RportFwdStopListenerReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *RportFwdStopListenerReq) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RportFwdStopListenerReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RportFwdStartListenerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BindAddress    string            `protobuf:"bytes,1,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
+	BindPort       uint32            `protobuf:"varint,2,opt,name=BindPort,proto3" json:"BindPort,omitempty"`
+	ForwardPort    uint32            `protobuf:"varint,3,opt,name=ForwardPort,proto3" json:"ForwardPort,omitempty"`
+	ForwardAddress string            `protobuf:"bytes,4,opt,name=ForwardAddress,proto3" json:"ForwardAddress,omitempty"`
+	Request        *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *RportFwdStartListenerReq) Reset() {
+	*x = RportFwdStartListenerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RportFwdStartListenerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RportFwdStartListenerReq) ProtoMessage() {}
+
+func (x *RportFwdStartListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RportFwdStartListenerReq.ProtoReflect.Descriptor instead.
+func (*RportFwdStartListenerReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *RportFwdStartListenerReq) GetBindAddress() string {
+	if x != nil {
+		return x.BindAddress
+	}
+	return ""
+}
+
+func (x *RportFwdStartListenerReq) GetBindPort() uint32 {
+	if x != nil {
+		return x.BindPort
+	}
+	return 0
+}
+
+func (x *RportFwdStartListenerReq) GetForwardPort() uint32 {
+	if x != nil {
+		return x.ForwardPort
+	}
+	return 0
+}
+
+func (x *RportFwdStartListenerReq) GetForwardAddress() string {
+	if x != nil {
+		return x.ForwardAddress
+	}
+	return ""
+}
+
+func (x *RportFwdStartListenerReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RportFwdListener struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint32             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BindAddress    string             `protobuf:"bytes,2,opt,name=BindAddress,proto3" json:"BindAddress,omitempty"`
+	BindPort       uint32             `protobuf:"varint,3,opt,name=BindPort,proto3" json:"BindPort,omitempty"`
+	ForwardAddress string             `protobuf:"bytes,4,opt,name=ForwardAddress,proto3" json:"ForwardAddress,omitempty"`
+	ForwardPort    uint32             `protobuf:"varint,5,opt,name=ForwardPort,proto3" json:"ForwardPort,omitempty"`
+	Response       *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *RportFwdListener) Reset() {
+	*x = RportFwdListener{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RportFwdListener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RportFwdListener) ProtoMessage() {}
+
+func (x *RportFwdListener) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RportFwdListener.ProtoReflect.Descriptor instead.
+func (*RportFwdListener) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *RportFwdListener) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RportFwdListener) GetBindAddress() string {
+	if x != nil {
+		return x.BindAddress
+	}
+	return ""
+}
+
+func (x *RportFwdListener) GetBindPort() uint32 {
+	if x != nil {
+		return x.BindPort
+	}
+	return 0
+}
+
+func (x *RportFwdListener) GetForwardAddress() string {
+	if x != nil {
+		return x.ForwardAddress
+	}
+	return ""
+}
+
+func (x *RportFwdListener) GetForwardPort() uint32 {
+	if x != nil {
+		return x.ForwardPort
+	}
+	return 0
+}
+
+func (x *RportFwdListener) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type RportFwdListeners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listeners []*RportFwdListener `protobuf:"bytes,1,rep,name=Listeners,proto3" json:"Listeners,omitempty"`
+	Response  *commonpb.Response  `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *RportFwdListeners) Reset() {
+	*x = RportFwdListeners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RportFwdListeners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RportFwdListeners) ProtoMessage() {}
+
+func (x *RportFwdListeners) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RportFwdListeners.ProtoReflect.Descriptor instead.
+func (*RportFwdListeners) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *RportFwdListeners) GetListeners() []*RportFwdListener {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *RportFwdListeners) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type RportFwdListenersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *RportFwdListenersReq) Reset() {
+	*x = RportFwdListenersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RportFwdListenersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RportFwdListenersReq) ProtoMessage() {}
+
+func (x *RportFwdListenersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RportFwdListenersReq.ProtoReflect.Descriptor instead.
+func (*RportFwdListenersReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *RportFwdListenersReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RPortfwd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     uint32             `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Protocol int32              `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Host     string             `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	TunnelID uint64             `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *RPortfwd) Reset() {
+	*x = RPortfwd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RPortfwd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPortfwd) ProtoMessage() {}
+
+func (x *RPortfwd) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPortfwd.ProtoReflect.Descriptor instead.
+func (*RPortfwd) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *RPortfwd) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RPortfwd) GetProtocol() int32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *RPortfwd) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RPortfwd) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
+	}
+	return 0
+}
+
+func (x *RPortfwd) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type RPortfwdReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     uint32            `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Protocol int32             `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Host     string            `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *RPortfwdReq) Reset() {
+	*x = RPortfwdReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RPortfwdReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPortfwdReq) ProtoMessage() {}
+
+func (x *RPortfwdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPortfwdReq.ProtoReflect.Descriptor instead.
+func (*RPortfwdReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *RPortfwdReq) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RPortfwdReq) GetProtocol() int32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *RPortfwdReq) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RPortfwdReq) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
+	}
+	return 0
+}
+
+func (x *RPortfwdReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ChmodReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	FileMode  string            `protobuf:"bytes,2,opt,name=FileMode,proto3" json:"FileMode,omitempty"`
+	Recursive bool              `protobuf:"varint,3,opt,name=Recursive,proto3" json:"Recursive,omitempty"`
+	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *ChmodReq) Reset() {
+	*x = ChmodReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChmodReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChmodReq) ProtoMessage() {}
+
+func (x *ChmodReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChmodReq.ProtoReflect.Descriptor instead.
+func (*ChmodReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ChmodReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChmodReq) GetFileMode() string {
+	if x != nil {
+		return x.FileMode
+	}
+	return ""
+}
+
+func (x *ChmodReq) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *ChmodReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type Chmod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *Chmod) Reset() {
+	*x = Chmod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chmod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chmod) ProtoMessage() {}
+
+func (x *Chmod) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chmod.ProtoReflect.Descriptor instead.
+func (*Chmod) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *Chmod) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Chmod) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ChownReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Uid       string            `protobuf:"bytes,2,opt,name=Uid,proto3" json:"Uid,omitempty"`
+	Gid       string            `protobuf:"bytes,3,opt,name=Gid,proto3" json:"Gid,omitempty"`
+	Recursive bool              `protobuf:"varint,4,opt,name=Recursive,proto3" json:"Recursive,omitempty"`
+	Request   *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *ChownReq) Reset() {
+	*x = ChownReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChownReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChownReq) ProtoMessage() {}
+
+func (x *ChownReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChownReq.ProtoReflect.Descriptor instead.
+func (*ChownReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ChownReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChownReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ChownReq) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *ChownReq) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *ChownReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type Chown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *Chown) Reset() {
+	*x = Chown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chown) ProtoMessage() {}
+
+func (x *Chown) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chown.ProtoReflect.Descriptor instead.
+func (*Chown) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *Chown) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Chown) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ChtimesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string            `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	ATime   int64             `protobuf:"varint,2,opt,name=ATime,proto3" json:"ATime,omitempty"`
+	MTime   int64             `protobuf:"varint,3,opt,name=MTime,proto3" json:"MTime,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *ChtimesReq) Reset() {
+	*x = ChtimesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChtimesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChtimesReq) ProtoMessage() {}
+
+func (x *ChtimesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChtimesReq.ProtoReflect.Descriptor instead.
+func (*ChtimesReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ChtimesReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChtimesReq) GetATime() int64 {
+	if x != nil {
+		return x.ATime
+	}
+	return 0
+}
+
+func (x *ChtimesReq) GetMTime() int64 {
+	if x != nil {
+		return x.MTime
+	}
+	return 0
+}
+
+func (x *ChtimesReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type Chtimes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string             `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *Chtimes) Reset() {
+	*x = Chtimes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chtimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chtimes) ProtoMessage() {}
+
+func (x *Chtimes) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chtimes.ProtoReflect.Descriptor instead.
+func (*Chtimes) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *Chtimes) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Chtimes) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type MemfilesListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *MemfilesListReq) Reset() {
+	*x = MemfilesListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemfilesListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemfilesListReq) ProtoMessage() {}
+
+func (x *MemfilesListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemfilesListReq.ProtoReflect.Descriptor instead.
+func (*MemfilesListReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *MemfilesListReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type MemfilesAddReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *MemfilesAddReq) Reset() {
+	*x = MemfilesAddReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemfilesAddReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemfilesAddReq) ProtoMessage() {}
+
+func (x *MemfilesAddReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemfilesAddReq.ProtoReflect.Descriptor instead.
+func (*MemfilesAddReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *MemfilesAddReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type MemfilesAdd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fd       int64              `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *MemfilesAdd) Reset() {
+	*x = MemfilesAdd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemfilesAdd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemfilesAdd) ProtoMessage() {}
+
+func (x *MemfilesAdd) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemfilesAdd.ProtoReflect.Descriptor instead.
+func (*MemfilesAdd) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *MemfilesAdd) GetFd() int64 {
+	if x != nil {
+		return x.Fd
+	}
+	return 0
+}
+
+func (x *MemfilesAdd) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type MemfilesRmReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fd      int64             `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *MemfilesRmReq) Reset() {
+	*x = MemfilesRmReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemfilesRmReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemfilesRmReq) ProtoMessage() {}
+
+func (x *MemfilesRmReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemfilesRmReq.ProtoReflect.Descriptor instead.
+func (*MemfilesRmReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *MemfilesRmReq) GetFd() int64 {
+	if x != nil {
+		return x.Fd
+	}
+	return 0
+}
+
+func (x *MemfilesRmReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type MemfilesRm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fd       int64              `protobuf:"varint,1,opt,name=Fd,proto3" json:"Fd,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *MemfilesRm) Reset() {
+	*x = MemfilesRm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemfilesRm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemfilesRm) ProtoMessage() {}
+
+func (x *MemfilesRm) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemfilesRm.ProtoReflect.Descriptor instead.
+func (*MemfilesRm) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *MemfilesRm) GetFd() int64 {
+	if x != nil {
+		return x.Fd
+	}
+	return 0
+}
+
+func (x *MemfilesRm) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type RegisterWasmExtensionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	WasmGz  []byte            `protobuf:"bytes,2,opt,name=WasmGz,proto3" json:"WasmGz,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *RegisterWasmExtensionReq) Reset() {
+	*x = RegisterWasmExtensionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWasmExtensionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWasmExtensionReq) ProtoMessage() {}
+
+func (x *RegisterWasmExtensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWasmExtensionReq.ProtoReflect.Descriptor instead.
+func (*RegisterWasmExtensionReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *RegisterWasmExtensionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterWasmExtensionReq) GetWasmGz() []byte {
+	if x != nil {
+		return x.WasmGz
+	}
+	return nil
+}
+
+func (x *RegisterWasmExtensionReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RegisterWasmExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *RegisterWasmExtension) Reset() {
+	*x = RegisterWasmExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWasmExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWasmExtension) ProtoMessage() {}
+
+func (x *RegisterWasmExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWasmExtension.ProtoReflect.Descriptor instead.
+func (*RegisterWasmExtension) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *RegisterWasmExtension) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type DeregisterWasmExtensionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *DeregisterWasmExtensionReq) Reset() {
+	*x = DeregisterWasmExtensionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterWasmExtensionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterWasmExtensionReq) ProtoMessage() {}
+
+func (x *DeregisterWasmExtensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterWasmExtensionReq.ProtoReflect.Descriptor instead.
+func (*DeregisterWasmExtensionReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *DeregisterWasmExtensionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeregisterWasmExtensionReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListWasmExtensionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *ListWasmExtensionsReq) Reset() {
+	*x = ListWasmExtensionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWasmExtensionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWasmExtensionsReq) ProtoMessage() {}
+
+func (x *ListWasmExtensionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWasmExtensionsReq.ProtoReflect.Descriptor instead.
+func (*ListWasmExtensionsReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListWasmExtensionsReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListWasmExtensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names    []string           `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *ListWasmExtensions) Reset() {
+	*x = ListWasmExtensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWasmExtensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWasmExtensions) ProtoMessage() {}
+
+func (x *ListWasmExtensions) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWasmExtensions.ProtoReflect.Descriptor instead.
+func (*ListWasmExtensions) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ListWasmExtensions) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListWasmExtensions) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ExecWasmExtensionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Args        []string          `protobuf:"bytes,2,rep,name=Args,proto3" json:"Args,omitempty"`
+	Interactive bool              `protobuf:"varint,3,opt,name=Interactive,proto3" json:"Interactive,omitempty"`
+	MemFS       map[string][]byte `protobuf:"bytes,4,rep,name=MemFS,proto3" json:"MemFS,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TunnelID    uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"` // Bind to this tunnel
+	Request     *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *ExecWasmExtensionReq) Reset() {
+	*x = ExecWasmExtensionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecWasmExtensionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecWasmExtensionReq) ProtoMessage() {}
+
+func (x *ExecWasmExtensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecWasmExtensionReq.ProtoReflect.Descriptor instead.
+func (*ExecWasmExtensionReq) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ExecWasmExtensionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecWasmExtensionReq) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExecWasmExtensionReq) GetInteractive() bool {
+	if x != nil {
+		return x.Interactive
+	}
+	return false
+}
+
+func (x *ExecWasmExtensionReq) GetMemFS() map[string][]byte {
+	if x != nil {
+		return x.MemFS
+	}
+	return nil
+}
+
+func (x *ExecWasmExtensionReq) GetTunnelID() uint64 {
+	if x != nil {
+		return x.TunnelID
+	}
+	return 0
+}
+
+func (x *ExecWasmExtensionReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ExecWasmExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stdout   []byte             `protobuf:"bytes,1,opt,name=Stdout,proto3" json:"Stdout,omitempty"`
+	Stderr   []byte             `protobuf:"bytes,2,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
+	ExitCode uint32             `protobuf:"varint,3,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *ExecWasmExtension) Reset() {
+	*x = ExecWasmExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecWasmExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecWasmExtension) ProtoMessage() {}
+
+func (x *ExecWasmExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecWasmExtension.ProtoReflect.Descriptor instead.
+func (*ExecWasmExtension) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *ExecWasmExtension) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *ExecWasmExtension) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecWasmExtension) GetExitCode() uint32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecWasmExtension) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type SockTabEntry_SockAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip   string `protobuf:"bytes,1,opt,name=Ip,proto3" json:"Ip,omitempty"`
+	Port uint32 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *SockTabEntry_SockAddr) Reset() {
+	*x = SockTabEntry_SockAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sliverpb_sliver_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SockTabEntry_SockAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SockTabEntry_SockAddr) ProtoMessage() {}
+
+func (x *SockTabEntry_SockAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_sliverpb_sliver_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SockTabEntry_SockAddr.ProtoReflect.Descriptor instead.
+func (*SockTabEntry_SockAddr) Descriptor() ([]byte, []int) {
+	return file_sliverpb_sliver_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *SockTabEntry_SockAddr) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SockTabEntry_SockAddr) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+var File_sliverpb_sliver_proto protoreflect.FileDescriptor
+
+var file_sliverpb_sliver_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a,
+	0x12, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0xa9, 0x01,
+	0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x5e, 0x0a, 0x08, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x05, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0xa6, 0x04, 0x0a, 0x08,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x4f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x41, 0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x1a,
+	0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x52, 0x06, 0x53, 0x75,
+	0x72, 0x76, 0x65, 0x79, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x4e,
+	0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x22, 0x51, 0x0a,
+	0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x22, 0x92, 0x01, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x43, 0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x43,
+	0x32, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x79, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
+	0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2b,
 	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x05, 0x4d,
-	0x6b, 0x64, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x05, 0x50,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x65, 0x0a, 0x02, 0x50, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65,
 	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9c, 0x02,
-	0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18,
-	0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49,
-	0x73, 0x44, 0x69, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x55, 0x6e, 0x72,
-	0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a,
+	0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x01, 0x0a,
-	0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18,
-	0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
-	0x49, 0x73, 0x49, 0x4f, 0x43, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x49,
-	0x4f, 0x43, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x06, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44,
-	0x75, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x41,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x69,
-	0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x48, 0x69, 0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65,
-	0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x4e, 0x65, 0x74,
-	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x4f, 0x0a, 0x05, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0b,
+	0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x08, 0x49, 0x66, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x52, 0x0d, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x59, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x41, 0x43, 0x12, 0x20,
+	0x0a, 0x0b, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x48, 0x0a, 0x05, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x02, 0x4c,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44,
+	0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x05,
+	0x43, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x06, 0x50, 0x77, 0x64, 0x52, 0x65, 0x71,
 	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a,
-	0x0b, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a,
+	0x03, 0x50, 0x77, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x05, 0x52, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x02, 0x52, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x58, 0x0a, 0x05, 0x4d, 0x76, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x72, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x02, 0x4d, 0x76,
+	0x12, 0x10, 0x0a, 0x03, 0x53, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53,
+	0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x44, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x05, 0x43, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x53, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x72, 0x63, 0x12,
+	0x10, 0x0a, 0x03, 0x44, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c,
+	0x0a, 0x02, 0x43, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x53, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x08,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x0c,
-	0x52, 0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x08,
+	0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x07,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x65, 0x76,
-	0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x05, 0x4d, 0x6b, 0x64,
+	0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2b,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x53, 0x74, 0x6f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x65, 0x12, 0x2b,
 	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x6f,
-	0x6b, 0x65, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x12,
-	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x48, 0x6f, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9c, 0x02, 0x0a, 0x08,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44,
+	0x69, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x09, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73,
+	0x49, 0x4f, 0x43, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x49, 0x4f, 0x43,
+	0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x06, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x51, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x75, 0x6d,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x69, 0x64, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x48, 0x69,
+	0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4f, 0x0a, 0x05, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x59, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0b, 0x49,
+	0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x0c, 0x52, 0x65,
+	0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x54, 0x6f,
+	0x53, 0x65, 0x6c, 0x66, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
 	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x11, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b,
+	0x0a, 0x09, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x07,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x57, 0x58, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x57, 0x58, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x50, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3b, 0x0a, 0x09, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01,
-	0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x57, 0x58, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x57, 0x58, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x36, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf, 0x03, 0x0a, 0x12, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65,
-	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x1c, 0x0a,
-	0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x4c, 0x4c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x4c, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x41,
-	0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12,
-	0x1c, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x70, 0x70, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x6e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xab, 0x01, 0x0a,
-	0x18, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x73,
-	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a,
+	0x22, 0x36, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf, 0x03, 0x0a, 0x12, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x41,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x4c, 0x4c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x4c, 0x4c, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x70, 0x70, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x6e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x2b, 0x0a,
 	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x1e, 0x49,
-	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x6d, 0x73,
-	0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x41,
-	0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x74, 0x77,
-	0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x45, 0x74,
-	0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x18, 0x49,
+	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x1e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x6d, 0x73,
+	0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79,
+	0x70, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x45, 0x74, 0x77, 0x42,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x59, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a,
+	0x10, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x50, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41,
-	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x65, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x07, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x07, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0a, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x11, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x55, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x48,
+	0x69, 0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x48, 0x69, 0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x93, 0x01, 0x0a,
+	0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50,
+	0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0b, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x4b, 0x69, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x69, 0x6c, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x44, 0x4c, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x69, 0x73, 0x44, 0x4c, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x55, 0x6e, 0x69, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x55, 0x6e, 0x69,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x08, 0x53, 0x69, 0x64, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0a,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72,
-	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
-	0x64, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50,
-	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x2b,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x11,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b,
+	0x69, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50,
+	0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72,
+	0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b,
 	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x11,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64,
-	0x65, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x55, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
-	0x0a, 0x48, 0x69, 0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x48, 0x69, 0x64, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a,
-	0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69,
-	0x64, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x93,
-	0x01, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
-	0x64, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65,
-	0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x50, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0b, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x69,
-	0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x44, 0x4c, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x4c, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x55, 0x6e,
-	0x69, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x55,
-	0x6e, 0x69, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x08, 0x53, 0x69, 0x64,
-	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf4, 0x01,
-	0x0a, 0x11, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x69, 0x6c,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x50,
-	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x50, 0x69, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a,
-	0x08, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x10, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x54,
-	0x43, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x03, 0x55, 0x44, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x03, 0x49, 0x50, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x03, 0x49, 0x50, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xb3, 0x02, 0x0a, 0x0c, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
-	0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
-	0x53, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x55, 0x49, 0x44,
-	0x12, 0x2b, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x2e, 0x0a, 0x08, 0x53, 0x6f, 0x63,
-	0x6b, 0x41, 0x64, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x49, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x6b, 0x0a, 0x07, 0x4e, 0x65, 0x74,
-	0x73, 0x74, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x06, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x08, 0x53,
+	0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9f, 0x01, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x54, 0x43, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x54, 0x43, 0x50,
+	0x12, 0x10, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x55,
+	0x44, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x49, 0x50, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x49, 0x50, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
 	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
 	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x69, 0x0a, 0x07, 0x45, 0x6e, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x09,
-	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61,
-	0x72, 0x52, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x09,
-	0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x08, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52, 0x08, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x2e,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e,
-	0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a,
-	0x0a, 0x08, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x74, 0x22, 0xb3, 0x02, 0x0a, 0x0c, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x6f,
+	0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x6f,
+	0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x2b,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x2e, 0x0a, 0x08, 0x53, 0x6f, 0x63, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x6b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74,
+	0x61, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x06, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x69, 0x0a, 0x07, 0x45, 0x6e, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x09, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52,
+	0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x0e, 0x44, 0x4e,
-	0x53, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x3b,
-	0x0a, 0x07, 0x44, 0x4e, 0x53, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x34, 0x0a, 0x0e, 0x44,
-	0x4e, 0x53, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a,
-	0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x23, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x3c, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x42, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42,
-	0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a,
-	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x08,
+	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x09, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x52, 0x08, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x38, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x2e, 0x0a, 0x08,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x0e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x20,
-	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x0e,
-	0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3a,
-	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x52, 0x0b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x0b, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b,
-	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x0c, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xa9, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10,
-	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x42, 0x79, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x44, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x44, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x51, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x51, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a,
-	0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x2e,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99,
-	0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65, 0x18,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x0b,
+	0x55, 0x6e, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x08,
+	0x55, 0x6e, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x07,
+	0x44, 0x4e, 0x53, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x34, 0x0a, 0x0e, 0x44, 0x4e, 0x53,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x23, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x4b, 0x65, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x43, 0x32, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x02, 0x43, 0x32, 0x12, 0x2b, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x48,
+	0x54, 0x54, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x04, 0x4b, 0x65,
+	0x79, 0x73, 0x22, 0x87, 0x03, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x36, 0x0a, 0x16, 0x50, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x50, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x41, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x4d, 0x69, 0x6e, 0x69,
+	0x73, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x4d, 0x69, 0x6e, 0x69, 0x73,
+	0x69, 0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x4d, 0x4c, 0x4b, 0x45, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x4d, 0x4c, 0x4b, 0x45, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x41,
+	0x43, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x74, 0x6c, 0x73,
+	0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x22, 0xaf, 0x04, 0x0a,
+	0x0d, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x55, 0x52, 0x4c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x43, 0x32, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x55, 0x52, 0x4c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f,
+	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x50,
+	0x6f, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x50, 0x6f, 0x6c,
+	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x46, 0x69,
+	0x6c, 0x65, 0x45, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x6f, 0x6c,
+	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x45,
+	0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x22, 0x77,
+	0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x12, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x42, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x42, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d,
+	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a,
+	0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x79, 0x0a,
+	0x0e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x52, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x0b,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x52, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x0c,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50,
 	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
 	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
 	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x42, 0x79, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x44, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x44, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x51, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x51, 0x57, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f,
+	0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12,
 	0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x99, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65,
+	0x99, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04,
 	0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
@@ -11939,596 +14157,783 @@ var file_sliverpb_sliver_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
 	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x88, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69,
-	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x12, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x15,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a,
-	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x22, 0x99, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x12,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x69, 0x76, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a,
+	0x06, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x92, 0x02, 0x0a, 0x0a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x41, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x72, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x77, 0x64, 0x52, 0x08, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x1e, 0x0a, 0x08,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xc3, 0x01, 0x0a, 0x08, 0x53,
+	0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x54, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x54, 0x59, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x52,
+	0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x43,
+	0x6f, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x9b, 0x01, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x54, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x54, 0x59, 0x12, 0x10, 0x0a, 0x03,
+	0x50, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x1e,
+	0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2e,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x0e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x43, 0x6f, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0b, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x0e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12,
+	0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0b,
+	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x07,
+	0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x42,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x08,
+	0x53, 0x63, 0x61, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x42, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x08, 0x53, 0x63, 0x61, 0x6e, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x63, 0x61, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0x7a, 0x0a, 0x04, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x48, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x08,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x06,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x0f,
+	0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x53, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x0c, 0x44, 0x4e, 0x53, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45,
+	0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x45, 0x72, 0x72, 0x22, 0x39, 0x0a,
+	0x0a, 0x45, 0x44, 0x52, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x0c, 0x45, 0x44, 0x52, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x22, 0xa9, 0x01, 0x0a, 0x07, 0x45, 0x44, 0x52, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x44, 0x52, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xea,
+	0x01, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x52, 0x50, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x41, 0x52, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x42, 0x49, 0x4f, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x42, 0x49, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x44,
+	0x4e, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4d, 0x44, 0x4e, 0x53, 0x12, 0x10,
+	0x0a, 0x03, 0x53, 0x4d, 0x42, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x53, 0x4d, 0x42,
+	0x12, 0x12, 0x0a, 0x04, 0x4c, 0x44, 0x41, 0x50, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x4c, 0x44, 0x41, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x08,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x09, 0x41, 0x44, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x44, 0x41, 0x50, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x4c, 0x44, 0x41, 0x50, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x0b, 0x41, 0x44, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x65,
+	0x0a, 0x08, 0x41, 0x44, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x4e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x44, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x41, 0x44,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x06, 0x41, 0x44, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x41, 0x44, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x6f, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0x9d, 0x01,
+	0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9d, 0x01,
+	0x0a, 0x07, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a,
+	0x05, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
 	0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75,
 	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
 	0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x92, 0x02, 0x0a, 0x0a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x41,
-	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x16, 0x0a,
-	0x06, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52,
-	0x65, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72,
-	0x70, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77,
-	0x64, 0x52, 0x08, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
-	0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x9b, 0x01, 0x0a, 0x08, 0x53, 0x68,
-	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x54, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x54, 0x59, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
-	0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x54, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x54, 0x59, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x07, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x05, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e,
-	0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x1c,
-	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xde, 0x01, 0x0a,
-	0x09, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0xfa, 0x01, 0x0a, 0x09, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x44, 0x61, 0x74, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa9, 0x01,
-	0x0a, 0x15, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x08, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x14, 0x50, 0x69, 0x76,
-	0x6f, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xca,
-	0x01, 0x0a, 0x0d, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x69, 0x6e,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x50,
-	0x69, 0x76, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x50, 0x69,
-	0x76, 0x6f, 0x74, 0x52, 0x06, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0a,
-	0x50, 0x69, 0x76, 0x6f, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x50, 0x65,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b,
-	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x4b, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x16, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x09, 0x50, 0x69,
-	0x76, 0x6f, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x50, 0x65, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x50, 0x69, 0x76, 0x6f,
-	0x74, 0x50, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x29, 0x0a,
-	0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x50, 0x65, 0x65,
-	0x72, 0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x74, 0x22, 0x21,
-	0x0a, 0x09, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63,
-	0x65, 0x22, 0x50, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x50, 0x69, 0x76, 0x6f,
-	0x74, 0x12, 0x1a, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a,
-	0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x10, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x50, 0x65, 0x65, 0x72,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x50, 0x65, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65,
-	0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x45, 0x72, 0x72, 0x22, 0x40, 0x0a, 0x11, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x0e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a,
+	0x14, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x0d, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69,
+	0x76, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2e, 0x0a, 0x06, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x52, 0x06, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x73, 0x12,
 	0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x88, 0x01, 0x0a, 0x15, 0x57, 0x47, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a,
+	0x96, 0x01, 0x0a, 0x0a, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x06,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x16, 0x50, 0x69, 0x76, 0x6f,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x3b,
+	0x0a, 0x09, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x06, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x11,
+	0x50, 0x69, 0x76, 0x6f, 0x74, 0x50, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d,
+	0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x41, 0x74, 0x22, 0x21, 0x0a, 0x09, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x50, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x50, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x10, 0x50, 0x69, 0x76, 0x6f, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x45, 0x72, 0x72, 0x22, 0x40, 0x0a, 0x11, 0x50, 0x69, 0x76, 0x6f,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a,
 	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x0d, 0x57, 0x47,
-	0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x54, 0x43, 0x50, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x0e, 0x50, 0x69,
+	0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
 	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
 	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x14, 0x57, 0x47, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
-	0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x0f, 0x57, 0x47, 0x53, 0x6f,
-	0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6a, 0x0a, 0x07,
-	0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0e, 0x57, 0x47, 0x53, 0x6f,
-	0x63, 0x6b, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x12, 0x57, 0x47, 0x54, 0x43, 0x50,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x11, 0x57, 0x47,
-	0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x57, 0x47, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77,
+	0x0a, 0x0d, 0x57, 0x47, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x36, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47,
+	0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x14, 0x57, 0x47, 0x50, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12,
 	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x0e,
-	0x57, 0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c,
-	0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3d, 0x0a, 0x0d,
-	0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a,
-	0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x22, 0x73, 0x0a, 0x0e, 0x57,
-	0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a,
-	0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x7b, 0x0a, 0x0f, 0x57, 0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x57, 0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x0a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01,
-	0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26,
-	0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x6f, 0x6c,
-	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2b, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x6f,
-	0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x0f,
+	0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x6a, 0x0a, 0x07, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0e,
+	0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x12, 0x57,
+	0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40,
+	0x0a, 0x11, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5e, 0x0a, 0x0e, 0x57, 0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x22, 0x3d, 0x0a, 0x0d, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x22,
+	0x73, 0x0a, 0x0e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47,
+	0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x0f, 0x57, 0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x54, 0x43, 0x50, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0d, 0x53,
-	0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4b, 0x72, 0x62, 0x35,
-	0x43, 0x6f, 0x6e, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4b, 0x72, 0x62, 0x35,
-	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x4b, 0x65, 0x79, 0x74, 0x61, 0x62, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4b, 0x65, 0x79, 0x74, 0x61, 0x62, 0x12, 0x14, 0x0a, 0x05,
-	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52, 0x65, 0x61,
-	0x6c, 0x6d, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x6c, 0x0a, 0x0a, 0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x53, 0x74, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
-	0x74, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x45, 0x72, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x45, 0x72, 0x72, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x79,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x55, 0x73, 0x65,
-	0x64, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x55, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0xc5, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x12, 0x3b, 0x0a, 0x08,
-	0x50, 0x72, 0x69, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x50, 0x72, 0x69, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x0f, 0x50, 0x6f,
+	0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a,
+	0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e,
+	0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e,
 	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1,
-	0x01, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x79, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2,
+	0x02, 0x0a, 0x0d, 0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x4b, 0x72, 0x62, 0x35, 0x43, 0x6f, 0x6e, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4b, 0x72, 0x62, 0x35, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x4b, 0x65, 0x79, 0x74,
+	0x61, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4b, 0x65, 0x79, 0x74, 0x61, 0x62,
+	0x12, 0x14, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x0a, 0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64,
+	0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x64, 0x45, 0x72,
+	0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd3, 0x01,
+	0x0a, 0x15, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65,
+	0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x42, 0x79, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x55, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x55, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73,
+	0x12, 0x3b, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x50, 0x72, 0x69, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x4f, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04,
+	0x49, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x6e, 0x69, 0x74,
+	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a,
+	0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x56, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x17, 0x52, 0x70, 0x6f, 0x72, 0x74,
-	0x46, 0x77, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xcf, 0x01, 0x0a, 0x18, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b,
-	0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0xda, 0x01, 0x0a, 0x10, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x69, 0x6e,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x69, 0x6e, 0x64,
-	0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x42, 0x69, 0x6e, 0x64,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2e,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d,
-	0x0a, 0x11, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x52, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a,
-	0x14, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x52, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50,
-	0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48,
-	0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x52, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x40, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x17, 0x52,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x18, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x20, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x10, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x69,
+	0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x42, 0x69, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x7d, 0x0a, 0x11, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x43, 0x0a, 0x14, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x52, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x77, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65,
 	0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x52, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x77, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x43, 0x68, 0x6d,
+	0x6f, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x4b, 0x0a, 0x05, 0x43, 0x68, 0x6d, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01,
+	0x0a, 0x08, 0x43, 0x68, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x47, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a,
+	0x05, 0x43, 0x68, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x0a, 0x43, 0x68,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x41, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x41, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x4d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x07, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x0f, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x43, 0x68, 0x6d, 0x6f, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x05,
-	0x43, 0x68, 0x6d, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x08, 0x43, 0x68,
-	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x47, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x69, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x2b, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x05, 0x43, 0x68, 0x6f,
-	0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x0a, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x4d, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4d,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x4d, 0x0a, 0x07, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3e, 0x0a, 0x0f, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41,
+	0x64, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x46, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x46, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3d, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4d, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x64, 0x64, 0x12, 0x0e,
+	0x22, 0x4c, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x12, 0x0e,
 	0x0a, 0x02, 0x46, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x46, 0x64, 0x12, 0x2e,
 	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c,
-	0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x46, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x46, 0x64, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x0a,
-	0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x46, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x46, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73,
+	0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x57, 0x61, 0x73, 0x6d, 0x47, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x57, 0x61, 0x73, 0x6d, 0x47, 0x7a, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57,
+	0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x1a,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x02,
+	0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x3f, 0x0a, 0x05, 0x4d, 0x65, 0x6d, 0x46, 0x53, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x57,
+	0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x2e,
+	0x4d, 0x65, 0x6d, 0x46, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x4d, 0x65, 0x6d, 0x46,
+	0x53, 0x12, 0x1e, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38,
+	0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x46, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x65,
+	0x63, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x18, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x61,
-	0x73, 0x6d, 0x47, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x57, 0x61, 0x73, 0x6d,
-	0x47, 0x7a, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x47, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x61, 0x73, 0x6d, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x1a, 0x44, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57,
-	0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x14, 0x45, 0x78,
-	0x65, 0x63, 0x57, 0x61, 0x73, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3f, 0x0a, 0x05,
-	0x4d, 0x65, 0x6d, 0x46, 0x53, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x57, 0x61, 0x73, 0x6d, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x65, 0x6d, 0x46,
-	0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x4d, 0x65, 0x6d, 0x46, 0x53, 0x12, 0x1e, 0x0a,
-	0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x4d, 0x65,
-	0x6d, 0x46, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x57, 0x61, 0x73,
-	0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
-	0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78,
-	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x45, 0x78,
-	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x49, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x44,
-	0x57, 0x4f, 0x52, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x57, 0x4f, 0x52, 0x44, 0x10,
-	0x04, 0x2a, 0x2c, 0x0a, 0x09, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07,
-	0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01,
-	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65, 0x10, 0x02, 0x2a,
-	0x33, 0x0a, 0x0f, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
-	0x52, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
-	0x43, 0x54, 0x10, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78, 0x2f, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x49, 0x0a, 0x0c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x44, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x57,
+	0x4f, 0x52, 0x44, 0x10, 0x04, 0x2a, 0x2c, 0x0a, 0x09, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55,
+	0x44, 0x50, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x69, 0x70,
+	0x65, 0x10, 0x02, 0x2a, 0x33, 0x0a, 0x0f, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78,
+	0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -12544,366 +14949,420 @@ func file_sliverpb_sliver_proto_rawDescGZIP() []byte {
 }
 
 var file_sliverpb_sliver_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_sliverpb_sliver_proto_msgTypes = make([]protoimpl.MessageInfo, 170)
+var file_sliverpb_sliver_proto_msgTypes = make([]protoimpl.MessageInfo, 197)
 var file_sliverpb_sliver_proto_goTypes = []interface{}{
 	(RegistryType)(0),                      // 0: sliverpb.RegistryType
 	(PivotType)(0),                         // 1: sliverpb.PivotType
 	(PeerFailureType)(0),                   // 2: sliverpb.PeerFailureType
 	(*Envelope)(nil),                       // 3: sliverpb.Envelope
-	(*BeaconTasks)(nil),                    // 4: sliverpb.BeaconTasks
-	(*Register)(nil),                       // 5: sliverpb.Register
-	(*BeaconRegister)(nil),                 // 6: sliverpb.BeaconRegister
-	(*SessionRegister)(nil),                // 7: sliverpb.SessionRegister
-	(*OpenSession)(nil),                    // 8: sliverpb.OpenSession
-	(*CloseSession)(nil),                   // 9: sliverpb.CloseSession
-	(*Ping)(nil),                           // 10: sliverpb.Ping
-	(*KillReq)(nil),                        // 11: sliverpb.KillReq
-	(*PsReq)(nil),                          // 12: sliverpb.PsReq
-	(*Ps)(nil),                             // 13: sliverpb.Ps
-	(*TerminateReq)(nil),                   // 14: sliverpb.TerminateReq
-	(*Terminate)(nil),                      // 15: sliverpb.Terminate
-	(*IfconfigReq)(nil),                    // 16: sliverpb.IfconfigReq
-	(*Ifconfig)(nil),                       // 17: sliverpb.Ifconfig
-	(*NetInterface)(nil),                   // 18: sliverpb.NetInterface
-	(*LsReq)(nil),                          // 19: sliverpb.LsReq
-	(*Ls)(nil),                             // 20: sliverpb.Ls
-	(*FileInfo)(nil),                       // 21: sliverpb.FileInfo
-	(*CdReq)(nil),                          // 22: sliverpb.CdReq
-	(*PwdReq)(nil),                         // 23: sliverpb.PwdReq
-	(*Pwd)(nil),                            // 24: sliverpb.Pwd
-	(*RmReq)(nil),                          // 25: sliverpb.RmReq
-	(*Rm)(nil),                             // 26: sliverpb.Rm
-	(*MvReq)(nil),                          // 27: sliverpb.MvReq
-	(*Mv)(nil),                             // 28: sliverpb.Mv
-	(*CpReq)(nil),                          // 29: sliverpb.CpReq
-	(*Cp)(nil),                             // 30: sliverpb.Cp
-	(*MkdirReq)(nil),                       // 31: sliverpb.MkdirReq
-	(*Mkdir)(nil),                          // 32: sliverpb.Mkdir
-	(*DownloadReq)(nil),                    // 33: sliverpb.DownloadReq
-	(*Download)(nil),                       // 34: sliverpb.Download
-	(*UploadReq)(nil),                      // 35: sliverpb.UploadReq
-	(*Upload)(nil),                         // 36: sliverpb.Upload
-	(*ProcessDumpReq)(nil),                 // 37: sliverpb.ProcessDumpReq
-	(*ProcessDump)(nil),                    // 38: sliverpb.ProcessDump
-	(*RunAsReq)(nil),                       // 39: sliverpb.RunAsReq
-	(*RunAs)(nil),                          // 40: sliverpb.RunAs
-	(*ImpersonateReq)(nil),                 // 41: sliverpb.ImpersonateReq
-	(*Impersonate)(nil),                    // 42: sliverpb.Impersonate
-	(*RevToSelfReq)(nil),                   // 43: sliverpb.RevToSelfReq
-	(*RevToSelf)(nil),                      // 44: sliverpb.RevToSelf
-	(*CurrentTokenOwnerReq)(nil),           // 45: sliverpb.CurrentTokenOwnerReq
-	(*CurrentTokenOwner)(nil),              // 46: sliverpb.CurrentTokenOwner
-	(*InvokeGetSystemReq)(nil),             // 47: sliverpb.InvokeGetSystemReq
-	(*GetSystem)(nil),                      // 48: sliverpb.GetSystem
-	(*MakeTokenReq)(nil),                   // 49: sliverpb.MakeTokenReq
-	(*MakeToken)(nil),                      // 50: sliverpb.MakeToken
-	(*TaskReq)(nil),                        // 51: sliverpb.TaskReq
-	(*Task)(nil),                           // 52: sliverpb.Task
-	(*ExecuteAssemblyReq)(nil),             // 53: sliverpb.ExecuteAssemblyReq
-	(*InvokeExecuteAssemblyReq)(nil),       // 54: sliverpb.InvokeExecuteAssemblyReq
-	(*InvokeInProcExecuteAssemblyReq)(nil), // 55: sliverpb.InvokeInProcExecuteAssemblyReq
-	(*ExecuteAssembly)(nil),                // 56: sliverpb.ExecuteAssembly
-	(*InvokeMigrateReq)(nil),               // 57: sliverpb.InvokeMigrateReq
-	(*Migrate)(nil),                        // 58: sliverpb.Migrate
-	(*ExecuteReq)(nil),                     // 59: sliverpb.ExecuteReq
-	(*ExecuteWindowsReq)(nil),              // 60: sliverpb.ExecuteWindowsReq
-	(*Execute)(nil),                        // 61: sliverpb.Execute
-	(*SideloadReq)(nil),                    // 62: sliverpb.SideloadReq
-	(*Sideload)(nil),                       // 63: sliverpb.Sideload
-	(*InvokeSpawnDllReq)(nil),              // 64: sliverpb.InvokeSpawnDllReq
-	(*SpawnDllReq)(nil),                    // 65: sliverpb.SpawnDllReq
-	(*SpawnDll)(nil),                       // 66: sliverpb.SpawnDll
-	(*NetstatReq)(nil),                     // 67: sliverpb.NetstatReq
-	(*SockTabEntry)(nil),                   // 68: sliverpb.SockTabEntry
-	(*Netstat)(nil),                        // 69: sliverpb.Netstat
-	(*EnvReq)(nil),                         // 70: sliverpb.EnvReq
-	(*EnvInfo)(nil),                        // 71: sliverpb.EnvInfo
-	(*SetEnvReq)(nil),                      // 72: sliverpb.SetEnvReq
-	(*SetEnv)(nil),                         // 73: sliverpb.SetEnv
-	(*UnsetEnvReq)(nil),                    // 74: sliverpb.UnsetEnvReq
-	(*UnsetEnv)(nil),                       // 75: sliverpb.UnsetEnv
-	(*DNSSessionInit)(nil),                 // 76: sliverpb.DNSSessionInit
-	(*DNSPoll)(nil),                        // 77: sliverpb.DNSPoll
-	(*DNSBlockHeader)(nil),                 // 78: sliverpb.DNSBlockHeader
-	(*HTTPSessionInit)(nil),                // 79: sliverpb.HTTPSessionInit
-	(*ScreenshotReq)(nil),                  // 80: sliverpb.ScreenshotReq
-	(*Screenshot)(nil),                     // 81: sliverpb.Screenshot
-	(*StartServiceReq)(nil),                // 82: sliverpb.StartServiceReq
-	(*ServiceInfo)(nil),                    // 83: sliverpb.ServiceInfo
-	(*ServiceInfoReq)(nil),                 // 84: sliverpb.ServiceInfoReq
-	(*StopServiceReq)(nil),                 // 85: sliverpb.StopServiceReq
-	(*RemoveServiceReq)(nil),               // 86: sliverpb.RemoveServiceReq
-	(*RegistryReadReq)(nil),                // 87: sliverpb.RegistryReadReq
-	(*RegistryRead)(nil),                   // 88: sliverpb.RegistryRead
-	(*RegistryWriteReq)(nil),               // 89: sliverpb.RegistryWriteReq
-	(*RegistryWrite)(nil),                  // 90: sliverpb.RegistryWrite
-	(*RegistryCreateKeyReq)(nil),           // 91: sliverpb.RegistryCreateKeyReq
-	(*RegistryCreateKey)(nil),              // 92: sliverpb.RegistryCreateKey
-	(*RegistryDeleteKeyReq)(nil),           // 93: sliverpb.RegistryDeleteKeyReq
-	(*RegistryDeleteKey)(nil),              // 94: sliverpb.RegistryDeleteKey
-	(*RegistrySubKeyListReq)(nil),          // 95: sliverpb.RegistrySubKeyListReq
-	(*RegistrySubKeyList)(nil),             // 96: sliverpb.RegistrySubKeyList
-	(*RegistryListValuesReq)(nil),          // 97: sliverpb.RegistryListValuesReq
-	(*RegistryValuesList)(nil),             // 98: sliverpb.RegistryValuesList
-	(*Tunnel)(nil),                         // 99: sliverpb.Tunnel
-	(*TunnelData)(nil),                     // 100: sliverpb.TunnelData
-	(*ShellReq)(nil),                       // 101: sliverpb.ShellReq
-	(*Shell)(nil),                          // 102: sliverpb.Shell
-	(*PortfwdReq)(nil),                     // 103: sliverpb.PortfwdReq
-	(*Portfwd)(nil),                        // 104: sliverpb.Portfwd
-	(*Socks)(nil),                          // 105: sliverpb.Socks
-	(*SocksData)(nil),                      // 106: sliverpb.SocksData
-	(*PivotStartListenerReq)(nil),          // 107: sliverpb.PivotStartListenerReq
-	(*PivotStopListenerReq)(nil),           // 108: sliverpb.PivotStopListenerReq
-	(*PivotListener)(nil),                  // 109: sliverpb.PivotListener
-	(*PivotHello)(nil),                     // 110: sliverpb.PivotHello
-	(*PivotServerKeyExchange)(nil),         // 111: sliverpb.PivotServerKeyExchange
-	(*PivotPeer)(nil),                      // 112: sliverpb.PivotPeer
-	(*PivotPeerEnvelope)(nil),              // 113: sliverpb.PivotPeerEnvelope
-	(*PivotPing)(nil),                      // 114: sliverpb.PivotPing
-	(*NetConnPivot)(nil),                   // 115: sliverpb.NetConnPivot
-	(*PivotPeerFailure)(nil),               // 116: sliverpb.PivotPeerFailure
-	(*PivotListenersReq)(nil),              // 117: sliverpb.PivotListenersReq
-	(*PivotListeners)(nil),                 // 118: sliverpb.PivotListeners
-	(*WGPortForwardStartReq)(nil),          // 119: sliverpb.WGPortForwardStartReq
-	(*WGPortForward)(nil),                  // 120: sliverpb.WGPortForward
-	(*WGPortForwardStopReq)(nil),           // 121: sliverpb.WGPortForwardStopReq
-	(*WGSocksStartReq)(nil),                // 122: sliverpb.WGSocksStartReq
-	(*WGSocks)(nil),                        // 123: sliverpb.WGSocks
-	(*WGSocksStopReq)(nil),                 // 124: sliverpb.WGSocksStopReq
-	(*WGTCPForwardersReq)(nil),             // 125: sliverpb.WGTCPForwardersReq
-	(*WGSocksServersReq)(nil),              // 126: sliverpb.WGSocksServersReq
-	(*WGTCPForwarder)(nil),                 // 127: sliverpb.WGTCPForwarder
-	(*WGSocksServer)(nil),                  // 128: sliverpb.WGSocksServer
-	(*WGSocksServers)(nil),                 // 129: sliverpb.WGSocksServers
-	(*WGTCPForwarders)(nil),                // 130: sliverpb.WGTCPForwarders
-	(*ReconfigureReq)(nil),                 // 131: sliverpb.ReconfigureReq
-	(*Reconfigure)(nil),                    // 132: sliverpb.Reconfigure
-	(*PollIntervalReq)(nil),                // 133: sliverpb.PollIntervalReq
-	(*PollInterval)(nil),                   // 134: sliverpb.PollInterval
-	(*SSHCommandReq)(nil),                  // 135: sliverpb.SSHCommandReq
-	(*SSHCommand)(nil),                     // 136: sliverpb.SSHCommand
-	(*GetPrivsReq)(nil),                    // 137: sliverpb.GetPrivsReq
-	(*WindowsPrivilegeEntry)(nil),          // 138: sliverpb.WindowsPrivilegeEntry
-	(*GetPrivs)(nil),                       // 139: sliverpb.GetPrivs
-	(*RegisterExtensionReq)(nil),           // 140: sliverpb.RegisterExtensionReq
-	(*RegisterExtension)(nil),              // 141: sliverpb.RegisterExtension
-	(*CallExtensionReq)(nil),               // 142: sliverpb.CallExtensionReq
-	(*CallExtension)(nil),                  // 143: sliverpb.CallExtension
-	(*ListExtensionsReq)(nil),              // 144: sliverpb.ListExtensionsReq
-	(*ListExtensions)(nil),                 // 145: sliverpb.ListExtensions
-	(*RportFwdStopListenerReq)(nil),        // 146: sliverpb.RportFwdStopListenerReq
-	(*RportFwdStartListenerReq)(nil),       // 147: sliverpb.RportFwdStartListenerReq
-	(*RportFwdListener)(nil),               // 148: sliverpb.RportFwdListener
-	(*RportFwdListeners)(nil),              // 149: sliverpb.RportFwdListeners
-	(*RportFwdListenersReq)(nil),           // 150: sliverpb.RportFwdListenersReq
-	(*RPortfwd)(nil),                       // 151: sliverpb.RPortfwd
-	(*RPortfwdReq)(nil),                    // 152: sliverpb.RPortfwdReq
-	(*ChmodReq)(nil),                       // 153: sliverpb.ChmodReq
-	(*Chmod)(nil),                          // 154: sliverpb.Chmod
-	(*ChownReq)(nil),                       // 155: sliverpb.ChownReq
-	(*Chown)(nil),                          // 156: sliverpb.Chown
-	(*ChtimesReq)(nil),                     // 157: sliverpb.ChtimesReq
-	(*Chtimes)(nil),                        // 158: sliverpb.Chtimes
-	(*MemfilesListReq)(nil),                // 159: sliverpb.MemfilesListReq
-	(*MemfilesAddReq)(nil),                 // 160: sliverpb.MemfilesAddReq
-	(*MemfilesAdd)(nil),                    // 161: sliverpb.MemfilesAdd
-	(*MemfilesRmReq)(nil),                  // 162: sliverpb.MemfilesRmReq
-	(*MemfilesRm)(nil),                     // 163: sliverpb.MemfilesRm
-	(*RegisterWasmExtensionReq)(nil),       // 164: sliverpb.RegisterWasmExtensionReq
-	(*RegisterWasmExtension)(nil),          // 165: sliverpb.RegisterWasmExtension
-	(*DeregisterWasmExtensionReq)(nil),     // 166: sliverpb.DeregisterWasmExtensionReq
-	(*ListWasmExtensionsReq)(nil),          // 167: sliverpb.ListWasmExtensionsReq
-	(*ListWasmExtensions)(nil),             // 168: sliverpb.ListWasmExtensions
-	(*ExecWasmExtensionReq)(nil),           // 169: sliverpb.ExecWasmExtensionReq
-	(*ExecWasmExtension)(nil),              // 170: sliverpb.ExecWasmExtension
-	(*SockTabEntry_SockAddr)(nil),          // 171: sliverpb.SockTabEntry.SockAddr
-	nil,                                    // 172: sliverpb.ExecWasmExtensionReq.MemFSEntry
-	(*commonpb.Response)(nil),              // 173: commonpb.Response
-	(*commonpb.Request)(nil),               // 174: commonpb.Request
-	(*commonpb.Process)(nil),               // 175: commonpb.Process
-	(*commonpb.EnvVar)(nil),                // 176: commonpb.EnvVar
+	(*Chunk)(nil),                          // 4: sliverpb.Chunk
+	(*ChunkAck)(nil),                       // 5: sliverpb.ChunkAck
+	(*BeaconTasks)(nil),                    // 6: sliverpb.BeaconTasks
+	(*Register)(nil),                       // 7: sliverpb.Register
+	(*BeaconRegister)(nil),                 // 8: sliverpb.BeaconRegister
+	(*SessionRegister)(nil),                // 9: sliverpb.SessionRegister
+	(*OpenSession)(nil),                    // 10: sliverpb.OpenSession
+	(*CloseSession)(nil),                   // 11: sliverpb.CloseSession
+	(*Ping)(nil),                           // 12: sliverpb.Ping
+	(*KillReq)(nil),                        // 13: sliverpb.KillReq
+	(*PsReq)(nil),                          // 14: sliverpb.PsReq
+	(*Ps)(nil),                             // 15: sliverpb.Ps
+	(*TerminateReq)(nil),                   // 16: sliverpb.TerminateReq
+	(*Terminate)(nil),                      // 17: sliverpb.Terminate
+	(*IfconfigReq)(nil),                    // 18: sliverpb.IfconfigReq
+	(*Ifconfig)(nil),                       // 19: sliverpb.Ifconfig
+	(*NetInterface)(nil),                   // 20: sliverpb.NetInterface
+	(*LsReq)(nil),                          // 21: sliverpb.LsReq
+	(*Ls)(nil),                             // 22: sliverpb.Ls
+	(*FileInfo)(nil),                       // 23: sliverpb.FileInfo
+	(*CdReq)(nil),                          // 24: sliverpb.CdReq
+	(*PwdReq)(nil),                         // 25: sliverpb.PwdReq
+	(*Pwd)(nil),                            // 26: sliverpb.Pwd
+	(*RmReq)(nil),                          // 27: sliverpb.RmReq
+	(*Rm)(nil),                             // 28: sliverpb.Rm
+	(*MvReq)(nil),                          // 29: sliverpb.MvReq
+	(*Mv)(nil),                             // 30: sliverpb.Mv
+	(*CpReq)(nil),                          // 31: sliverpb.CpReq
+	(*Cp)(nil),                             // 32: sliverpb.Cp
+	(*MkdirReq)(nil),                       // 33: sliverpb.MkdirReq
+	(*Mkdir)(nil),                          // 34: sliverpb.Mkdir
+	(*DownloadReq)(nil),                    // 35: sliverpb.DownloadReq
+	(*Download)(nil),                       // 36: sliverpb.Download
+	(*UploadReq)(nil),                      // 37: sliverpb.UploadReq
+	(*Upload)(nil),                         // 38: sliverpb.Upload
+	(*ProcessDumpReq)(nil),                 // 39: sliverpb.ProcessDumpReq
+	(*ProcessDump)(nil),                    // 40: sliverpb.ProcessDump
+	(*RunAsReq)(nil),                       // 41: sliverpb.RunAsReq
+	(*RunAs)(nil),                          // 42: sliverpb.RunAs
+	(*ImpersonateReq)(nil),                 // 43: sliverpb.ImpersonateReq
+	(*Impersonate)(nil),                    // 44: sliverpb.Impersonate
+	(*RevToSelfReq)(nil),                   // 45: sliverpb.RevToSelfReq
+	(*RevToSelf)(nil),                      // 46: sliverpb.RevToSelf
+	(*CurrentTokenOwnerReq)(nil),           // 47: sliverpb.CurrentTokenOwnerReq
+	(*CurrentTokenOwner)(nil),              // 48: sliverpb.CurrentTokenOwner
+	(*InvokeGetSystemReq)(nil),             // 49: sliverpb.InvokeGetSystemReq
+	(*GetSystem)(nil),                      // 50: sliverpb.GetSystem
+	(*MakeTokenReq)(nil),                   // 51: sliverpb.MakeTokenReq
+	(*MakeToken)(nil),                      // 52: sliverpb.MakeToken
+	(*TaskReq)(nil),                        // 53: sliverpb.TaskReq
+	(*Task)(nil),                           // 54: sliverpb.Task
+	(*ExecuteAssemblyReq)(nil),             // 55: sliverpb.ExecuteAssemblyReq
+	(*InvokeExecuteAssemblyReq)(nil),       // 56: sliverpb.InvokeExecuteAssemblyReq
+	(*InvokeInProcExecuteAssemblyReq)(nil), // 57: sliverpb.InvokeInProcExecuteAssemblyReq
+	(*ExecuteAssembly)(nil),                // 58: sliverpb.ExecuteAssembly
+	(*InvokeMigrateReq)(nil),               // 59: sliverpb.InvokeMigrateReq
+	(*Migrate)(nil),                        // 60: sliverpb.Migrate
+	(*ExecuteReq)(nil),                     // 61: sliverpb.ExecuteReq
+	(*ExecuteWindowsReq)(nil),              // 62: sliverpb.ExecuteWindowsReq
+	(*Execute)(nil),                        // 63: sliverpb.Execute
+	(*SideloadReq)(nil),                    // 64: sliverpb.SideloadReq
+	(*Sideload)(nil),                       // 65: sliverpb.Sideload
+	(*InvokeSpawnDllReq)(nil),              // 66: sliverpb.InvokeSpawnDllReq
+	(*SpawnDllReq)(nil),                    // 67: sliverpb.SpawnDllReq
+	(*SpawnDll)(nil),                       // 68: sliverpb.SpawnDll
+	(*NetstatReq)(nil),                     // 69: sliverpb.NetstatReq
+	(*SockTabEntry)(nil),                   // 70: sliverpb.SockTabEntry
+	(*Netstat)(nil),                        // 71: sliverpb.Netstat
+	(*EnvReq)(nil),                         // 72: sliverpb.EnvReq
+	(*EnvInfo)(nil),                        // 73: sliverpb.EnvInfo
+	(*SetEnvReq)(nil),                      // 74: sliverpb.SetEnvReq
+	(*SetEnv)(nil),                         // 75: sliverpb.SetEnv
+	(*UnsetEnvReq)(nil),                    // 76: sliverpb.UnsetEnvReq
+	(*UnsetEnv)(nil),                       // 77: sliverpb.UnsetEnv
+	(*DNSSessionInit)(nil),                 // 78: sliverpb.DNSSessionInit
+	(*DNSPoll)(nil),                        // 79: sliverpb.DNSPoll
+	(*DNSBlockHeader)(nil),                 // 80: sliverpb.DNSBlockHeader
+	(*HTTPSessionInit)(nil),                // 81: sliverpb.HTTPSessionInit
+	(*RuntimeConfig)(nil),                  // 82: sliverpb.RuntimeConfig
+	(*ImplantKeys)(nil),                    // 83: sliverpb.ImplantKeys
+	(*HTTPC2Profile)(nil),                  // 84: sliverpb.HTTPC2Profile
+	(*HTTPC2NameValue)(nil),                // 85: sliverpb.HTTPC2NameValue
+	(*ScreenshotReq)(nil),                  // 86: sliverpb.ScreenshotReq
+	(*Screenshot)(nil),                     // 87: sliverpb.Screenshot
+	(*StartServiceReq)(nil),                // 88: sliverpb.StartServiceReq
+	(*ServiceInfo)(nil),                    // 89: sliverpb.ServiceInfo
+	(*ServiceInfoReq)(nil),                 // 90: sliverpb.ServiceInfoReq
+	(*StopServiceReq)(nil),                 // 91: sliverpb.StopServiceReq
+	(*RemoveServiceReq)(nil),               // 92: sliverpb.RemoveServiceReq
+	(*RegistryReadReq)(nil),                // 93: sliverpb.RegistryReadReq
+	(*RegistryRead)(nil),                   // 94: sliverpb.RegistryRead
+	(*RegistryWriteReq)(nil),               // 95: sliverpb.RegistryWriteReq
+	(*RegistryWrite)(nil),                  // 96: sliverpb.RegistryWrite
+	(*RegistryCreateKeyReq)(nil),           // 97: sliverpb.RegistryCreateKeyReq
+	(*RegistryCreateKey)(nil),              // 98: sliverpb.RegistryCreateKey
+	(*RegistryDeleteKeyReq)(nil),           // 99: sliverpb.RegistryDeleteKeyReq
+	(*RegistryDeleteKey)(nil),              // 100: sliverpb.RegistryDeleteKey
+	(*RegistrySubKeyListReq)(nil),          // 101: sliverpb.RegistrySubKeyListReq
+	(*RegistrySubKeyList)(nil),             // 102: sliverpb.RegistrySubKeyList
+	(*RegistryListValuesReq)(nil),          // 103: sliverpb.RegistryListValuesReq
+	(*RegistryValuesList)(nil),             // 104: sliverpb.RegistryValuesList
+	(*Tunnel)(nil),                         // 105: sliverpb.Tunnel
+	(*TunnelData)(nil),                     // 106: sliverpb.TunnelData
+	(*ShellReq)(nil),                       // 107: sliverpb.ShellReq
+	(*Shell)(nil),                          // 108: sliverpb.Shell
+	(*ShellResizeReq)(nil),                 // 109: sliverpb.ShellResizeReq
+	(*ShellResize)(nil),                    // 110: sliverpb.ShellResize
+	(*ShellSignalReq)(nil),                 // 111: sliverpb.ShellSignalReq
+	(*ShellSignal)(nil),                    // 112: sliverpb.ShellSignal
+	(*ScanReq)(nil),                        // 113: sliverpb.ScanReq
+	(*ScanPort)(nil),                       // 114: sliverpb.ScanPort
+	(*ScanHost)(nil),                       // 115: sliverpb.ScanHost
+	(*Scan)(nil),                           // 116: sliverpb.Scan
+	(*DNSResolversReq)(nil),                // 117: sliverpb.DNSResolversReq
+	(*DNSResolvers)(nil),                   // 118: sliverpb.DNSResolvers
+	(*DNSExchange)(nil),                    // 119: sliverpb.DNSExchange
+	(*EDREnumReq)(nil),                     // 120: sliverpb.EDREnumReq
+	(*EDRComponent)(nil),                   // 121: sliverpb.EDRComponent
+	(*EDREnum)(nil),                        // 122: sliverpb.EDREnum
+	(*DiscoverReq)(nil),                    // 123: sliverpb.DiscoverReq
+	(*Discover)(nil),                       // 124: sliverpb.Discover
+	(*ADEnumReq)(nil),                      // 125: sliverpb.ADEnumReq
+	(*ADAttribute)(nil),                    // 126: sliverpb.ADAttribute
+	(*ADObject)(nil),                       // 127: sliverpb.ADObject
+	(*ADEnum)(nil),                         // 128: sliverpb.ADEnum
+	(*TaskOutput)(nil),                     // 129: sliverpb.TaskOutput
+	(*PortfwdReq)(nil),                     // 130: sliverpb.PortfwdReq
+	(*Portfwd)(nil),                        // 131: sliverpb.Portfwd
+	(*Socks)(nil),                          // 132: sliverpb.Socks
+	(*SocksData)(nil),                      // 133: sliverpb.SocksData
+	(*PivotStartListenerReq)(nil),          // 134: sliverpb.PivotStartListenerReq
+	(*PivotStopListenerReq)(nil),           // 135: sliverpb.PivotStopListenerReq
+	(*PivotListener)(nil),                  // 136: sliverpb.PivotListener
+	(*PivotHello)(nil),                     // 137: sliverpb.PivotHello
+	(*PivotServerKeyExchange)(nil),         // 138: sliverpb.PivotServerKeyExchange
+	(*PivotPeer)(nil),                      // 139: sliverpb.PivotPeer
+	(*PivotPeerEnvelope)(nil),              // 140: sliverpb.PivotPeerEnvelope
+	(*PivotPing)(nil),                      // 141: sliverpb.PivotPing
+	(*NetConnPivot)(nil),                   // 142: sliverpb.NetConnPivot
+	(*PivotPeerFailure)(nil),               // 143: sliverpb.PivotPeerFailure
+	(*PivotListenersReq)(nil),              // 144: sliverpb.PivotListenersReq
+	(*PivotListeners)(nil),                 // 145: sliverpb.PivotListeners
+	(*WGPortForwardStartReq)(nil),          // 146: sliverpb.WGPortForwardStartReq
+	(*WGPortForward)(nil),                  // 147: sliverpb.WGPortForward
+	(*WGPortForwardStopReq)(nil),           // 148: sliverpb.WGPortForwardStopReq
+	(*WGSocksStartReq)(nil),                // 149: sliverpb.WGSocksStartReq
+	(*WGSocks)(nil),                        // 150: sliverpb.WGSocks
+	(*WGSocksStopReq)(nil),                 // 151: sliverpb.WGSocksStopReq
+	(*WGTCPForwardersReq)(nil),             // 152: sliverpb.WGTCPForwardersReq
+	(*WGSocksServersReq)(nil),              // 153: sliverpb.WGSocksServersReq
+	(*WGTCPForwarder)(nil),                 // 154: sliverpb.WGTCPForwarder
+	(*WGSocksServer)(nil),                  // 155: sliverpb.WGSocksServer
+	(*WGSocksServers)(nil),                 // 156: sliverpb.WGSocksServers
+	(*WGTCPForwarders)(nil),                // 157: sliverpb.WGTCPForwarders
+	(*ReconfigureReq)(nil),                 // 158: sliverpb.ReconfigureReq
+	(*Reconfigure)(nil),                    // 159: sliverpb.Reconfigure
+	(*PollIntervalReq)(nil),                // 160: sliverpb.PollIntervalReq
+	(*PollInterval)(nil),                   // 161: sliverpb.PollInterval
+	(*SSHCommandReq)(nil),                  // 162: sliverpb.SSHCommandReq
+	(*SSHCommand)(nil),                     // 163: sliverpb.SSHCommand
+	(*GetPrivsReq)(nil),                    // 164: sliverpb.GetPrivsReq
+	(*WindowsPrivilegeEntry)(nil),          // 165: sliverpb.WindowsPrivilegeEntry
+	(*GetPrivs)(nil),                       // 166: sliverpb.GetPrivs
+	(*RegisterExtensionReq)(nil),           // 167: sliverpb.RegisterExtensionReq
+	(*RegisterExtension)(nil),              // 168: sliverpb.RegisterExtension
+	(*CallExtensionReq)(nil),               // 169: sliverpb.CallExtensionReq
+	(*CallExtension)(nil),                  // 170: sliverpb.CallExtension
+	(*ListExtensionsReq)(nil),              // 171: sliverpb.ListExtensionsReq
+	(*ListExtensions)(nil),                 // 172: sliverpb.ListExtensions
+	(*RportFwdStopListenerReq)(nil),        // 173: sliverpb.RportFwdStopListenerReq
+	(*RportFwdStartListenerReq)(nil),       // 174: sliverpb.RportFwdStartListenerReq
+	(*RportFwdListener)(nil),               // 175: sliverpb.RportFwdListener
+	(*RportFwdListeners)(nil),              // 176: sliverpb.RportFwdListeners
+	(*RportFwdListenersReq)(nil),           // 177: sliverpb.RportFwdListenersReq
+	(*RPortfwd)(nil),                       // 178: sliverpb.RPortfwd
+	(*RPortfwdReq)(nil),                    // 179: sliverpb.RPortfwdReq
+	(*ChmodReq)(nil),                       // 180: sliverpb.ChmodReq
+	(*Chmod)(nil),                          // 181: sliverpb.Chmod
+	(*ChownReq)(nil),                       // 182: sliverpb.ChownReq
+	(*Chown)(nil),                          // 183: sliverpb.Chown
+	(*ChtimesReq)(nil),                     // 184: sliverpb.ChtimesReq
+	(*Chtimes)(nil),                        // 185: sliverpb.Chtimes
+	(*MemfilesListReq)(nil),                // 186: sliverpb.MemfilesListReq
+	(*MemfilesAddReq)(nil),                 // 187: sliverpb.MemfilesAddReq
+	(*MemfilesAdd)(nil),                    // 188: sliverpb.MemfilesAdd
+	(*MemfilesRmReq)(nil),                  // 189: sliverpb.MemfilesRmReq
+	(*MemfilesRm)(nil),                     // 190: sliverpb.MemfilesRm
+	(*RegisterWasmExtensionReq)(nil),       // 191: sliverpb.RegisterWasmExtensionReq
+	(*RegisterWasmExtension)(nil),          // 192: sliverpb.RegisterWasmExtension
+	(*DeregisterWasmExtensionReq)(nil),     // 193: sliverpb.DeregisterWasmExtensionReq
+	(*ListWasmExtensionsReq)(nil),          // 194: sliverpb.ListWasmExtensionsReq
+	(*ListWasmExtensions)(nil),             // 195: sliverpb.ListWasmExtensions
+	(*ExecWasmExtensionReq)(nil),           // 196: sliverpb.ExecWasmExtensionReq
+	(*ExecWasmExtension)(nil),              // 197: sliverpb.ExecWasmExtension
+	(*SockTabEntry_SockAddr)(nil),          // 198: sliverpb.SockTabEntry.SockAddr
+	nil,                                    // 199: sliverpb.ExecWasmExtensionReq.MemFSEntry
+	(*commonpb.HostSurvey)(nil),            // 200: commonpb.HostSurvey
+	(*commonpb.Response)(nil),              // 201: commonpb.Response
+	(*commonpb.Request)(nil),               // 202: commonpb.Request
+	(*commonpb.Process)(nil),               // 203: commonpb.Process
+	(*commonpb.EnvVar)(nil),                // 204: commonpb.EnvVar
+	(*commonpb.DiscoveredHost)(nil),        // 205: commonpb.DiscoveredHost
 }
 var file_sliverpb_sliver_proto_depIdxs = []int32{
 	3,   // 0: sliverpb.BeaconTasks.Tasks:type_name -> sliverpb.Envelope
-	5,   // 1: sliverpb.BeaconRegister.Register:type_name -> sliverpb.Register
-	5,   // 2: sliverpb.SessionRegister.Register:type_name -> sliverpb.Register
-	173, // 3: sliverpb.OpenSession.Response:type_name -> commonpb.Response
-	174, // 4: sliverpb.OpenSession.Request:type_name -> commonpb.Request
-	173, // 5: sliverpb.CloseSession.Response:type_name -> commonpb.Response
-	174, // 6: sliverpb.CloseSession.Request:type_name -> commonpb.Request
-	173, // 7: sliverpb.Ping.Response:type_name -> commonpb.Response
-	174, // 8: sliverpb.Ping.Request:type_name -> commonpb.Request
-	174, // 9: sliverpb.KillReq.Request:type_name -> commonpb.Request
-	174, // 10: sliverpb.PsReq.Request:type_name -> commonpb.Request
-	175, // 11: sliverpb.Ps.Processes:type_name -> commonpb.Process
-	173, // 12: sliverpb.Ps.Response:type_name -> commonpb.Response
-	174, // 13: sliverpb.TerminateReq.Request:type_name -> commonpb.Request
-	173, // 14: sliverpb.Terminate.Response:type_name -> commonpb.Response
-	174, // 15: sliverpb.IfconfigReq.Request:type_name -> commonpb.Request
-	18,  // 16: sliverpb.Ifconfig.NetInterfaces:type_name -> sliverpb.NetInterface
-	173, // 17: sliverpb.Ifconfig.Response:type_name -> commonpb.Response
-	174, // 18: sliverpb.LsReq.Request:type_name -> commonpb.Request
-	21,  // 19: sliverpb.Ls.Files:type_name -> sliverpb.FileInfo
-	173, // 20: sliverpb.Ls.Response:type_name -> commonpb.Response
-	174, // 21: sliverpb.CdReq.Request:type_name -> commonpb.Request
-	174, // 22: sliverpb.PwdReq.Request:type_name -> commonpb.Request
-	173, // 23: sliverpb.Pwd.Response:type_name -> commonpb.Response
-	174, // 24: sliverpb.RmReq.Request:type_name -> commonpb.Request
-	173, // 25: sliverpb.Rm.Response:type_name -> commonpb.Response
-	174, // 26: sliverpb.MvReq.Request:type_name -> commonpb.Request
-	173, // 27: sliverpb.Mv.Response:type_name -> commonpb.Response
-	174, // 28: sliverpb.CpReq.Request:type_name -> commonpb.Request
-	173, // 29: sliverpb.Cp.Response:type_name -> commonpb.Response
-	174, // 30: sliverpb.MkdirReq.Request:type_name -> commonpb.Request
-	173, // 31: sliverpb.Mkdir.Response:type_name -> commonpb.Response
-	174, // 32: sliverpb.DownloadReq.Request:type_name -> commonpb.Request
-	173, // 33: sliverpb.Download.Response:type_name -> commonpb.Response
-	174, // 34: sliverpb.UploadReq.Request:type_name -> commonpb.Request
-	173, // 35: sliverpb.Upload.Response:type_name -> commonpb.Response
-	174, // 36: sliverpb.ProcessDumpReq.Request:type_name -> commonpb.Request
-	173, // 37: sliverpb.ProcessDump.Response:type_name -> commonpb.Response
-	174, // 38: sliverpb.RunAsReq.Request:type_name -> commonpb.Request
-	173, // 39: sliverpb.RunAs.Response:type_name -> commonpb.Response
-	174, // 40: sliverpb.ImpersonateReq.Request:type_name -> commonpb.Request
-	173, // 41: sliverpb.Impersonate.Response:type_name -> commonpb.Response
-	174, // 42: sliverpb.RevToSelfReq.Request:type_name -> commonpb.Request
-	173, // 43: sliverpb.RevToSelf.Response:type_name -> commonpb.Response
-	174, // 44: sliverpb.CurrentTokenOwnerReq.Request:type_name -> commonpb.Request
-	173, // 45: sliverpb.CurrentTokenOwner.Response:type_name -> commonpb.Response
-	174, // 46: sliverpb.InvokeGetSystemReq.Request:type_name -> commonpb.Request
-	173, // 47: sliverpb.GetSystem.Response:type_name -> commonpb.Response
-	174, // 48: sliverpb.MakeTokenReq.Request:type_name -> commonpb.Request
-	173, // 49: sliverpb.MakeToken.Response:type_name -> commonpb.Response
-	174, // 50: sliverpb.TaskReq.Request:type_name -> commonpb.Request
-	173, // 51: sliverpb.Task.Response:type_name -> commonpb.Response
-	174, // 52: sliverpb.ExecuteAssemblyReq.Request:type_name -> commonpb.Request
-	174, // 53: sliverpb.InvokeExecuteAssemblyReq.Request:type_name -> commonpb.Request
-	174, // 54: sliverpb.InvokeInProcExecuteAssemblyReq.Request:type_name -> commonpb.Request
-	173, // 55: sliverpb.ExecuteAssembly.Response:type_name -> commonpb.Response
-	174, // 56: sliverpb.InvokeMigrateReq.Request:type_name -> commonpb.Request
-	173, // 57: sliverpb.Migrate.Response:type_name -> commonpb.Response
-	174, // 58: sliverpb.ExecuteReq.Request:type_name -> commonpb.Request
-	174, // 59: sliverpb.ExecuteWindowsReq.Request:type_name -> commonpb.Request
-	173, // 60: sliverpb.Execute.Response:type_name -> commonpb.Response
-	174, // 61: sliverpb.SideloadReq.Request:type_name -> commonpb.Request
-	173, // 62: sliverpb.Sideload.Response:type_name -> commonpb.Response
-	174, // 63: sliverpb.InvokeSpawnDllReq.Request:type_name -> commonpb.Request
-	174, // 64: sliverpb.SpawnDllReq.Request:type_name -> commonpb.Request
-	173, // 65: sliverpb.SpawnDll.Response:type_name -> commonpb.Response
-	174, // 66: sliverpb.NetstatReq.Request:type_name -> commonpb.Request
-	171, // 67: sliverpb.SockTabEntry.LocalAddr:type_name -> sliverpb.SockTabEntry.SockAddr
-	171, // 68: sliverpb.SockTabEntry.RemoteAddr:type_name -> sliverpb.SockTabEntry.SockAddr
-	175, // 69: sliverpb.SockTabEntry.Process:type_name -> commonpb.Process
-	68,  // 70: sliverpb.Netstat.Entries:type_name -> sliverpb.SockTabEntry
-	173, // 71: sliverpb.Netstat.Response:type_name -> commonpb.Response
-	174, // 72: sliverpb.EnvReq.Request:type_name -> commonpb.Request
-	176, // 73: sliverpb.EnvInfo.Variables:type_name -> commonpb.EnvVar
-	173, // 74: sliverpb.EnvInfo.Response:type_name -> commonpb.Response
-	176, // 75: sliverpb.SetEnvReq.Variable:type_name -> commonpb.EnvVar
-	174, // 76: sliverpb.SetEnvReq.Request:type_name -> commonpb.Request
-	173, // 77: sliverpb.SetEnv.Response:type_name -> commonpb.Response
-	174, // 78: sliverpb.UnsetEnvReq.Request:type_name -> commonpb.Request
-	173, // 79: sliverpb.UnsetEnv.Response:type_name -> commonpb.Response
-	78,  // 80: sliverpb.DNSPoll.blocks:type_name -> sliverpb.DNSBlockHeader
-	174, // 81: sliverpb.ScreenshotReq.Request:type_name -> commonpb.Request
-	173, // 82: sliverpb.Screenshot.Response:type_name -> commonpb.Response
-	174, // 83: sliverpb.StartServiceReq.Request:type_name -> commonpb.Request
-	173, // 84: sliverpb.ServiceInfo.Response:type_name -> commonpb.Response
-	84,  // 85: sliverpb.StopServiceReq.ServiceInfo:type_name -> sliverpb.ServiceInfoReq
-	174, // 86: sliverpb.StopServiceReq.Request:type_name -> commonpb.Request
-	84,  // 87: sliverpb.RemoveServiceReq.ServiceInfo:type_name -> sliverpb.ServiceInfoReq
-	174, // 88: sliverpb.RemoveServiceReq.Request:type_name -> commonpb.Request
-	174, // 89: sliverpb.RegistryReadReq.Request:type_name -> commonpb.Request
-	173, // 90: sliverpb.RegistryRead.Response:type_name -> commonpb.Response
-	174, // 91: sliverpb.RegistryWriteReq.Request:type_name -> commonpb.Request
-	173, // 92: sliverpb.RegistryWrite.Response:type_name -> commonpb.Response
-	174, // 93: sliverpb.RegistryCreateKeyReq.Request:type_name -> commonpb.Request
-	173, // 94: sliverpb.RegistryCreateKey.Response:type_name -> commonpb.Response
-	174, // 95: sliverpb.RegistryDeleteKeyReq.Request:type_name -> commonpb.Request
-	173, // 96: sliverpb.RegistryDeleteKey.Response:type_name -> commonpb.Response
-	174, // 97: sliverpb.RegistrySubKeyListReq.Request:type_name -> commonpb.Request
-	173, // 98: sliverpb.RegistrySubKeyList.Response:type_name -> commonpb.Response
-	174, // 99: sliverpb.RegistryListValuesReq.Request:type_name -> commonpb.Request
-	173, // 100: sliverpb.RegistryValuesList.Response:type_name -> commonpb.Response
-	151, // 101: sliverpb.TunnelData.rportfwd:type_name -> sliverpb.RPortfwd
-	174, // 102: sliverpb.ShellReq.Request:type_name -> commonpb.Request
-	173, // 103: sliverpb.Shell.Response:type_name -> commonpb.Response
-	174, // 104: sliverpb.PortfwdReq.Request:type_name -> commonpb.Request
-	173, // 105: sliverpb.Portfwd.Response:type_name -> commonpb.Response
-	174, // 106: sliverpb.SocksData.Request:type_name -> commonpb.Request
-	1,   // 107: sliverpb.PivotStartListenerReq.Type:type_name -> sliverpb.PivotType
-	174, // 108: sliverpb.PivotStartListenerReq.Request:type_name -> commonpb.Request
-	174, // 109: sliverpb.PivotStopListenerReq.Request:type_name -> commonpb.Request
-	1,   // 110: sliverpb.PivotListener.Type:type_name -> sliverpb.PivotType
-	115, // 111: sliverpb.PivotListener.Pivots:type_name -> sliverpb.NetConnPivot
-	173, // 112: sliverpb.PivotListener.Response:type_name -> commonpb.Response
-	112, // 113: sliverpb.PivotPeerEnvelope.Peers:type_name -> sliverpb.PivotPeer
-	2,   // 114: sliverpb.PivotPeerFailure.Type:type_name -> sliverpb.PeerFailureType
-	174, // 115: sliverpb.PivotListenersReq.Request:type_name -> commonpb.Request
-	109, // 116: sliverpb.PivotListeners.Listeners:type_name -> sliverpb.PivotListener
-	173, // 117: sliverpb.PivotListeners.Response:type_name -> commonpb.Response
-	174, // 118: sliverpb.WGPortForwardStartReq.Request:type_name -> commonpb.Request
-	127, // 119: sliverpb.WGPortForward.Forwarder:type_name -> sliverpb.WGTCPForwarder
-	173, // 120: sliverpb.WGPortForward.Response:type_name -> commonpb.Response
-	174, // 121: sliverpb.WGPortForwardStopReq.Request:type_name -> commonpb.Request
-	174, // 122: sliverpb.WGSocksStartReq.Request:type_name -> commonpb.Request
-	128, // 123: sliverpb.WGSocks.Server:type_name -> sliverpb.WGSocksServer
-	173, // 124: sliverpb.WGSocks.Response:type_name -> commonpb.Response
-	174, // 125: sliverpb.WGSocksStopReq.Request:type_name -> commonpb.Request
-	174, // 126: sliverpb.WGTCPForwardersReq.Request:type_name -> commonpb.Request
-	174, // 127: sliverpb.WGSocksServersReq.Request:type_name -> commonpb.Request
-	128, // 128: sliverpb.WGSocksServers.Servers:type_name -> sliverpb.WGSocksServer
-	173, // 129: sliverpb.WGSocksServers.Response:type_name -> commonpb.Response
-	127, // 130: sliverpb.WGTCPForwarders.Forwarders:type_name -> sliverpb.WGTCPForwarder
-	173, // 131: sliverpb.WGTCPForwarders.Response:type_name -> commonpb.Response
-	174, // 132: sliverpb.ReconfigureReq.Request:type_name -> commonpb.Request
-	173, // 133: sliverpb.Reconfigure.Response:type_name -> commonpb.Response
-	174, // 134: sliverpb.PollIntervalReq.Request:type_name -> commonpb.Request
-	173, // 135: sliverpb.PollInterval.Response:type_name -> commonpb.Response
-	174, // 136: sliverpb.SSHCommandReq.Request:type_name -> commonpb.Request
-	173, // 137: sliverpb.SSHCommand.Response:type_name -> commonpb.Response
-	174, // 138: sliverpb.GetPrivsReq.Request:type_name -> commonpb.Request
-	138, // 139: sliverpb.GetPrivs.PrivInfo:type_name -> sliverpb.WindowsPrivilegeEntry
-	173, // 140: sliverpb.GetPrivs.Response:type_name -> commonpb.Response
-	174, // 141: sliverpb.RegisterExtensionReq.Request:type_name -> commonpb.Request
-	173, // 142: sliverpb.RegisterExtension.Response:type_name -> commonpb.Response
-	174, // 143: sliverpb.CallExtensionReq.Request:type_name -> commonpb.Request
-	173, // 144: sliverpb.CallExtension.Response:type_name -> commonpb.Response
-	174, // 145: sliverpb.ListExtensionsReq.Request:type_name -> commonpb.Request
-	173, // 146: sliverpb.ListExtensions.Response:type_name -> commonpb.Response
-	174, // 147: sliverpb.RportFwdStopListenerReq.Request:type_name -> commonpb.Request
-	174, // 148: sliverpb.RportFwdStartListenerReq.Request:type_name -> commonpb.Request
-	173, // 149: sliverpb.RportFwdListener.Response:type_name -> commonpb.Response
-	148, // 150: sliverpb.RportFwdListeners.Listeners:type_name -> sliverpb.RportFwdListener
-	173, // 151: sliverpb.RportFwdListeners.Response:type_name -> commonpb.Response
-	174, // 152: sliverpb.RportFwdListenersReq.Request:type_name -> commonpb.Request
-	173, // 153: sliverpb.RPortfwd.Response:type_name -> commonpb.Response
-	174, // 154: sliverpb.RPortfwdReq.Request:type_name -> commonpb.Request
-	174, // 155: sliverpb.ChmodReq.Request:type_name -> commonpb.Request
-	173, // 156: sliverpb.Chmod.Response:type_name -> commonpb.Response
-	174, // 157: sliverpb.ChownReq.Request:type_name -> commonpb.Request
-	173, // 158: sliverpb.Chown.Response:type_name -> commonpb.Response
-	174, // 159: sliverpb.ChtimesReq.Request:type_name -> commonpb.Request
-	173, // 160: sliverpb.Chtimes.Response:type_name -> commonpb.Response
-	174, // 161: sliverpb.MemfilesListReq.Request:type_name -> commonpb.Request
-	174, // 162: sliverpb.MemfilesAddReq.Request:type_name -> commonpb.Request
-	173, // 163: sliverpb.MemfilesAdd.Response:type_name -> commonpb.Response
-	174, // 164: sliverpb.MemfilesRmReq.Request:type_name -> commonpb.Request
-	173, // 165: sliverpb.MemfilesRm.Response:type_name -> commonpb.Response
-	174, // 166: sliverpb.RegisterWasmExtensionReq.Request:type_name -> commonpb.Request
-	173, // 167: sliverpb.RegisterWasmExtension.Response:type_name -> commonpb.Response
-	174, // 168: sliverpb.DeregisterWasmExtensionReq.Request:type_name -> commonpb.Request
-	174, // 169: sliverpb.ListWasmExtensionsReq.Request:type_name -> commonpb.Request
-	173, // 170: sliverpb.ListWasmExtensions.Response:type_name -> commonpb.Response
-	172, // 171: sliverpb.ExecWasmExtensionReq.MemFS:type_name -> sliverpb.ExecWasmExtensionReq.MemFSEntry
-	174, // 172: sliverpb.ExecWasmExtensionReq.Request:type_name -> commonpb.Request
-	173, // 173: sliverpb.ExecWasmExtension.Response:type_name -> commonpb.Response
-	174, // [174:174] is the sub-list for method output_type
-	174, // [174:174] is the sub-list for method input_type
-	174, // [174:174] is the sub-list for extension type_name
-	174, // [174:174] is the sub-list for extension extendee
-	0,   // [0:174] is the sub-list for field type_name
+	200, // 1: sliverpb.Register.Survey:type_name -> commonpb.HostSurvey
+	7,   // 2: sliverpb.BeaconRegister.Register:type_name -> sliverpb.Register
+	7,   // 3: sliverpb.SessionRegister.Register:type_name -> sliverpb.Register
+	201, // 4: sliverpb.OpenSession.Response:type_name -> commonpb.Response
+	202, // 5: sliverpb.OpenSession.Request:type_name -> commonpb.Request
+	201, // 6: sliverpb.CloseSession.Response:type_name -> commonpb.Response
+	202, // 7: sliverpb.CloseSession.Request:type_name -> commonpb.Request
+	201, // 8: sliverpb.Ping.Response:type_name -> commonpb.Response
+	202, // 9: sliverpb.Ping.Request:type_name -> commonpb.Request
+	202, // 10: sliverpb.KillReq.Request:type_name -> commonpb.Request
+	202, // 11: sliverpb.PsReq.Request:type_name -> commonpb.Request
+	203, // 12: sliverpb.Ps.Processes:type_name -> commonpb.Process
+	201, // 13: sliverpb.Ps.Response:type_name -> commonpb.Response
+	202, // 14: sliverpb.TerminateReq.Request:type_name -> commonpb.Request
+	201, // 15: sliverpb.Terminate.Response:type_name -> commonpb.Response
+	202, // 16: sliverpb.IfconfigReq.Request:type_name -> commonpb.Request
+	20,  // 17: sliverpb.Ifconfig.NetInterfaces:type_name -> sliverpb.NetInterface
+	201, // 18: sliverpb.Ifconfig.Response:type_name -> commonpb.Response
+	202, // 19: sliverpb.LsReq.Request:type_name -> commonpb.Request
+	23,  // 20: sliverpb.Ls.Files:type_name -> sliverpb.FileInfo
+	201, // 21: sliverpb.Ls.Response:type_name -> commonpb.Response
+	202, // 22: sliverpb.CdReq.Request:type_name -> commonpb.Request
+	202, // 23: sliverpb.PwdReq.Request:type_name -> commonpb.Request
+	201, // 24: sliverpb.Pwd.Response:type_name -> commonpb.Response
+	202, // 25: sliverpb.RmReq.Request:type_name -> commonpb.Request
+	201, // 26: sliverpb.Rm.Response:type_name -> commonpb.Response
+	202, // 27: sliverpb.MvReq.Request:type_name -> commonpb.Request
+	201, // 28: sliverpb.Mv.Response:type_name -> commonpb.Response
+	202, // 29: sliverpb.CpReq.Request:type_name -> commonpb.Request
+	201, // 30: sliverpb.Cp.Response:type_name -> commonpb.Response
+	202, // 31: sliverpb.MkdirReq.Request:type_name -> commonpb.Request
+	201, // 32: sliverpb.Mkdir.Response:type_name -> commonpb.Response
+	202, // 33: sliverpb.DownloadReq.Request:type_name -> commonpb.Request
+	201, // 34: sliverpb.Download.Response:type_name -> commonpb.Response
+	202, // 35: sliverpb.UploadReq.Request:type_name -> commonpb.Request
+	201, // 36: sliverpb.Upload.Response:type_name -> commonpb.Response
+	202, // 37: sliverpb.ProcessDumpReq.Request:type_name -> commonpb.Request
+	201, // 38: sliverpb.ProcessDump.Response:type_name -> commonpb.Response
+	202, // 39: sliverpb.RunAsReq.Request:type_name -> commonpb.Request
+	201, // 40: sliverpb.RunAs.Response:type_name -> commonpb.Response
+	202, // 41: sliverpb.ImpersonateReq.Request:type_name -> commonpb.Request
+	201, // 42: sliverpb.Impersonate.Response:type_name -> commonpb.Response
+	202, // 43: sliverpb.RevToSelfReq.Request:type_name -> commonpb.Request
+	201, // 44: sliverpb.RevToSelf.Response:type_name -> commonpb.Response
+	202, // 45: sliverpb.CurrentTokenOwnerReq.Request:type_name -> commonpb.Request
+	201, // 46: sliverpb.CurrentTokenOwner.Response:type_name -> commonpb.Response
+	202, // 47: sliverpb.InvokeGetSystemReq.Request:type_name -> commonpb.Request
+	201, // 48: sliverpb.GetSystem.Response:type_name -> commonpb.Response
+	202, // 49: sliverpb.MakeTokenReq.Request:type_name -> commonpb.Request
+	201, // 50: sliverpb.MakeToken.Response:type_name -> commonpb.Response
+	202, // 51: sliverpb.TaskReq.Request:type_name -> commonpb.Request
+	201, // 52: sliverpb.Task.Response:type_name -> commonpb.Response
+	202, // 53: sliverpb.ExecuteAssemblyReq.Request:type_name -> commonpb.Request
+	202, // 54: sliverpb.InvokeExecuteAssemblyReq.Request:type_name -> commonpb.Request
+	202, // 55: sliverpb.InvokeInProcExecuteAssemblyReq.Request:type_name -> commonpb.Request
+	201, // 56: sliverpb.ExecuteAssembly.Response:type_name -> commonpb.Response
+	202, // 57: sliverpb.InvokeMigrateReq.Request:type_name -> commonpb.Request
+	201, // 58: sliverpb.Migrate.Response:type_name -> commonpb.Response
+	202, // 59: sliverpb.ExecuteReq.Request:type_name -> commonpb.Request
+	202, // 60: sliverpb.ExecuteWindowsReq.Request:type_name -> commonpb.Request
+	201, // 61: sliverpb.Execute.Response:type_name -> commonpb.Response
+	202, // 62: sliverpb.SideloadReq.Request:type_name -> commonpb.Request
+	201, // 63: sliverpb.Sideload.Response:type_name -> commonpb.Response
+	202, // 64: sliverpb.InvokeSpawnDllReq.Request:type_name -> commonpb.Request
+	202, // 65: sliverpb.SpawnDllReq.Request:type_name -> commonpb.Request
+	201, // 66: sliverpb.SpawnDll.Response:type_name -> commonpb.Response
+	202, // 67: sliverpb.NetstatReq.Request:type_name -> commonpb.Request
+	198, // 68: sliverpb.SockTabEntry.LocalAddr:type_name -> sliverpb.SockTabEntry.SockAddr
+	198, // 69: sliverpb.SockTabEntry.RemoteAddr:type_name -> sliverpb.SockTabEntry.SockAddr
+	203, // 70: sliverpb.SockTabEntry.Process:type_name -> commonpb.Process
+	70,  // 71: sliverpb.Netstat.Entries:type_name -> sliverpb.SockTabEntry
+	201, // 72: sliverpb.Netstat.Response:type_name -> commonpb.Response
+	202, // 73: sliverpb.EnvReq.Request:type_name -> commonpb.Request
+	204, // 74: sliverpb.EnvInfo.Variables:type_name -> commonpb.EnvVar
+	201, // 75: sliverpb.EnvInfo.Response:type_name -> commonpb.Response
+	204, // 76: sliverpb.SetEnvReq.Variable:type_name -> commonpb.EnvVar
+	202, // 77: sliverpb.SetEnvReq.Request:type_name -> commonpb.Request
+	201, // 78: sliverpb.SetEnv.Response:type_name -> commonpb.Response
+	202, // 79: sliverpb.UnsetEnvReq.Request:type_name -> commonpb.Request
+	201, // 80: sliverpb.UnsetEnv.Response:type_name -> commonpb.Response
+	80,  // 81: sliverpb.DNSPoll.blocks:type_name -> sliverpb.DNSBlockHeader
+	84,  // 82: sliverpb.RuntimeConfig.HTTP:type_name -> sliverpb.HTTPC2Profile
+	83,  // 83: sliverpb.RuntimeConfig.Keys:type_name -> sliverpb.ImplantKeys
+	85,  // 84: sliverpb.HTTPC2Profile.Headers:type_name -> sliverpb.HTTPC2NameValue
+	85,  // 85: sliverpb.HTTPC2Profile.URLParameters:type_name -> sliverpb.HTTPC2NameValue
+	202, // 86: sliverpb.ScreenshotReq.Request:type_name -> commonpb.Request
+	201, // 87: sliverpb.Screenshot.Response:type_name -> commonpb.Response
+	202, // 88: sliverpb.StartServiceReq.Request:type_name -> commonpb.Request
+	201, // 89: sliverpb.ServiceInfo.Response:type_name -> commonpb.Response
+	90,  // 90: sliverpb.StopServiceReq.ServiceInfo:type_name -> sliverpb.ServiceInfoReq
+	202, // 91: sliverpb.StopServiceReq.Request:type_name -> commonpb.Request
+	90,  // 92: sliverpb.RemoveServiceReq.ServiceInfo:type_name -> sliverpb.ServiceInfoReq
+	202, // 93: sliverpb.RemoveServiceReq.Request:type_name -> commonpb.Request
+	202, // 94: sliverpb.RegistryReadReq.Request:type_name -> commonpb.Request
+	201, // 95: sliverpb.RegistryRead.Response:type_name -> commonpb.Response
+	202, // 96: sliverpb.RegistryWriteReq.Request:type_name -> commonpb.Request
+	201, // 97: sliverpb.RegistryWrite.Response:type_name -> commonpb.Response
+	202, // 98: sliverpb.RegistryCreateKeyReq.Request:type_name -> commonpb.Request
+	201, // 99: sliverpb.RegistryCreateKey.Response:type_name -> commonpb.Response
+	202, // 100: sliverpb.RegistryDeleteKeyReq.Request:type_name -> commonpb.Request
+	201, // 101: sliverpb.RegistryDeleteKey.Response:type_name -> commonpb.Response
+	202, // 102: sliverpb.RegistrySubKeyListReq.Request:type_name -> commonpb.Request
+	201, // 103: sliverpb.RegistrySubKeyList.Response:type_name -> commonpb.Response
+	202, // 104: sliverpb.RegistryListValuesReq.Request:type_name -> commonpb.Request
+	201, // 105: sliverpb.RegistryValuesList.Response:type_name -> commonpb.Response
+	178, // 106: sliverpb.TunnelData.rportfwd:type_name -> sliverpb.RPortfwd
+	202, // 107: sliverpb.ShellReq.Request:type_name -> commonpb.Request
+	201, // 108: sliverpb.Shell.Response:type_name -> commonpb.Response
+	202, // 109: sliverpb.ShellResizeReq.Request:type_name -> commonpb.Request
+	201, // 110: sliverpb.ShellResize.Response:type_name -> commonpb.Response
+	202, // 111: sliverpb.ShellSignalReq.Request:type_name -> commonpb.Request
+	201, // 112: sliverpb.ShellSignal.Response:type_name -> commonpb.Response
+	202, // 113: sliverpb.ScanReq.Request:type_name -> commonpb.Request
+	114, // 114: sliverpb.ScanHost.Ports:type_name -> sliverpb.ScanPort
+	115, // 115: sliverpb.Scan.Hosts:type_name -> sliverpb.ScanHost
+	201, // 116: sliverpb.Scan.Response:type_name -> commonpb.Response
+	202, // 117: sliverpb.DNSResolversReq.Request:type_name -> commonpb.Request
+	201, // 118: sliverpb.DNSResolvers.Response:type_name -> commonpb.Response
+	202, // 119: sliverpb.EDREnumReq.Request:type_name -> commonpb.Request
+	121, // 120: sliverpb.EDREnum.Components:type_name -> sliverpb.EDRComponent
+	201, // 121: sliverpb.EDREnum.Response:type_name -> commonpb.Response
+	202, // 122: sliverpb.DiscoverReq.Request:type_name -> commonpb.Request
+	205, // 123: sliverpb.Discover.Hosts:type_name -> commonpb.DiscoveredHost
+	201, // 124: sliverpb.Discover.Response:type_name -> commonpb.Response
+	202, // 125: sliverpb.ADEnumReq.Request:type_name -> commonpb.Request
+	126, // 126: sliverpb.ADObject.Attributes:type_name -> sliverpb.ADAttribute
+	127, // 127: sliverpb.ADEnum.Objects:type_name -> sliverpb.ADObject
+	201, // 128: sliverpb.ADEnum.Response:type_name -> commonpb.Response
+	202, // 129: sliverpb.PortfwdReq.Request:type_name -> commonpb.Request
+	201, // 130: sliverpb.Portfwd.Response:type_name -> commonpb.Response
+	202, // 131: sliverpb.SocksData.Request:type_name -> commonpb.Request
+	1,   // 132: sliverpb.PivotStartListenerReq.Type:type_name -> sliverpb.PivotType
+	202, // 133: sliverpb.PivotStartListenerReq.Request:type_name -> commonpb.Request
+	202, // 134: sliverpb.PivotStopListenerReq.Request:type_name -> commonpb.Request
+	1,   // 135: sliverpb.PivotListener.Type:type_name -> sliverpb.PivotType
+	142, // 136: sliverpb.PivotListener.Pivots:type_name -> sliverpb.NetConnPivot
+	201, // 137: sliverpb.PivotListener.Response:type_name -> commonpb.Response
+	139, // 138: sliverpb.PivotPeerEnvelope.Peers:type_name -> sliverpb.PivotPeer
+	2,   // 139: sliverpb.PivotPeerFailure.Type:type_name -> sliverpb.PeerFailureType
+	202, // 140: sliverpb.PivotListenersReq.Request:type_name -> commonpb.Request
+	136, // 141: sliverpb.PivotListeners.Listeners:type_name -> sliverpb.PivotListener
+	201, // 142: sliverpb.PivotListeners.Response:type_name -> commonpb.Response
+	202, // 143: sliverpb.WGPortForwardStartReq.Request:type_name -> commonpb.Request
+	154, // 144: sliverpb.WGPortForward.Forwarder:type_name -> sliverpb.WGTCPForwarder
+	201, // 145: sliverpb.WGPortForward.Response:type_name -> commonpb.Response
+	202, // 146: sliverpb.WGPortForwardStopReq.Request:type_name -> commonpb.Request
+	202, // 147: sliverpb.WGSocksStartReq.Request:type_name -> commonpb.Request
+	155, // 148: sliverpb.WGSocks.Server:type_name -> sliverpb.WGSocksServer
+	201, // 149: sliverpb.WGSocks.Response:type_name -> commonpb.Response
+	202, // 150: sliverpb.WGSocksStopReq.Request:type_name -> commonpb.Request
+	202, // 151: sliverpb.WGTCPForwardersReq.Request:type_name -> commonpb.Request
+	202, // 152: sliverpb.WGSocksServersReq.Request:type_name -> commonpb.Request
+	155, // 153: sliverpb.WGSocksServers.Servers:type_name -> sliverpb.WGSocksServer
+	201, // 154: sliverpb.WGSocksServers.Response:type_name -> commonpb.Response
+	154, // 155: sliverpb.WGTCPForwarders.Forwarders:type_name -> sliverpb.WGTCPForwarder
+	201, // 156: sliverpb.WGTCPForwarders.Response:type_name -> commonpb.Response
+	202, // 157: sliverpb.ReconfigureReq.Request:type_name -> commonpb.Request
+	201, // 158: sliverpb.Reconfigure.Response:type_name -> commonpb.Response
+	202, // 159: sliverpb.PollIntervalReq.Request:type_name -> commonpb.Request
+	201, // 160: sliverpb.PollInterval.Response:type_name -> commonpb.Response
+	202, // 161: sliverpb.SSHCommandReq.Request:type_name -> commonpb.Request
+	201, // 162: sliverpb.SSHCommand.Response:type_name -> commonpb.Response
+	202, // 163: sliverpb.GetPrivsReq.Request:type_name -> commonpb.Request
+	165, // 164: sliverpb.GetPrivs.PrivInfo:type_name -> sliverpb.WindowsPrivilegeEntry
+	201, // 165: sliverpb.GetPrivs.Response:type_name -> commonpb.Response
+	202, // 166: sliverpb.RegisterExtensionReq.Request:type_name -> commonpb.Request
+	201, // 167: sliverpb.RegisterExtension.Response:type_name -> commonpb.Response
+	202, // 168: sliverpb.CallExtensionReq.Request:type_name -> commonpb.Request
+	201, // 169: sliverpb.CallExtension.Response:type_name -> commonpb.Response
+	202, // 170: sliverpb.ListExtensionsReq.Request:type_name -> commonpb.Request
+	201, // 171: sliverpb.ListExtensions.Response:type_name -> commonpb.Response
+	202, // 172: sliverpb.RportFwdStopListenerReq.Request:type_name -> commonpb.Request
+	202, // 173: sliverpb.RportFwdStartListenerReq.Request:type_name -> commonpb.Request
+	201, // 174: sliverpb.RportFwdListener.Response:type_name -> commonpb.Response
+	175, // 175: sliverpb.RportFwdListeners.Listeners:type_name -> sliverpb.RportFwdListener
+	201, // 176: sliverpb.RportFwdListeners.Response:type_name -> commonpb.Response
+	202, // 177: sliverpb.RportFwdListenersReq.Request:type_name -> commonpb.Request
+	201, // 178: sliverpb.RPortfwd.Response:type_name -> commonpb.Response
+	202, // 179: sliverpb.RPortfwdReq.Request:type_name -> commonpb.Request
+	202, // 180: sliverpb.ChmodReq.Request:type_name -> commonpb.Request
+	201, // 181: sliverpb.Chmod.Response:type_name -> commonpb.Response
+	202, // 182: sliverpb.ChownReq.Request:type_name -> commonpb.Request
+	201, // 183: sliverpb.Chown.Response:type_name -> commonpb.Response
+	202, // 184: sliverpb.ChtimesReq.Request:type_name -> commonpb.Request
+	201, // 185: sliverpb.Chtimes.Response:type_name -> commonpb.Response
+	202, // 186: sliverpb.MemfilesListReq.Request:type_name -> commonpb.Request
+	202, // 187: sliverpb.MemfilesAddReq.Request:type_name -> commonpb.Request
+	201, // 188: sliverpb.MemfilesAdd.Response:type_name -> commonpb.Response
+	202, // 189: sliverpb.MemfilesRmReq.Request:type_name -> commonpb.Request
+	201, // 190: sliverpb.MemfilesRm.Response:type_name -> commonpb.Response
+	202, // 191: sliverpb.RegisterWasmExtensionReq.Request:type_name -> commonpb.Request
+	201, // 192: sliverpb.RegisterWasmExtension.Response:type_name -> commonpb.Response
+	202, // 193: sliverpb.DeregisterWasmExtensionReq.Request:type_name -> commonpb.Request
+	202, // 194: sliverpb.ListWasmExtensionsReq.Request:type_name -> commonpb.Request
+	201, // 195: sliverpb.ListWasmExtensions.Response:type_name -> commonpb.Response
+	199, // 196: sliverpb.ExecWasmExtensionReq.MemFS:type_name -> sliverpb.ExecWasmExtensionReq.MemFSEntry
+	202, // 197: sliverpb.ExecWasmExtensionReq.Request:type_name -> commonpb.Request
+	201, // 198: sliverpb.ExecWasmExtension.Response:type_name -> commonpb.Response
+	199, // [199:199] is the sub-list for method output_type
+	199, // [199:199] is the sub-list for method input_type
+	199, // [199:199] is the sub-list for extension type_name
+	199, // [199:199] is the sub-list for extension extendee
+	0,   // [0:199] is the sub-list for field type_name
 }
 
 func init() { file_sliverpb_sliver_proto_init() }
@@ -12924,8 +15383,308 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconTasks); i {
+		file_sliverpb_sliver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconTasks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Register); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconRegister); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRegister); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Terminate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IfconfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ifconfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PwdReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pwd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RmReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MvReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12936,8 +15695,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Register); i {
+		file_sliverpb_sliver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mv); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12948,8 +15707,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconRegister); i {
+		file_sliverpb_sliver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12960,8 +15719,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SessionRegister); i {
+		file_sliverpb_sliver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12972,8 +15731,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSession); i {
+		file_sliverpb_sliver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MkdirReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12984,8 +15743,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSession); i {
+		file_sliverpb_sliver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mkdir); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12996,8 +15755,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ping); i {
+		file_sliverpb_sliver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13008,8 +15767,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillReq); i {
+		file_sliverpb_sliver_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Download); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13020,8 +15779,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PsReq); i {
+		file_sliverpb_sliver_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13032,8 +15791,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ps); i {
+		file_sliverpb_sliver_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13044,8 +15803,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TerminateReq); i {
+		file_sliverpb_sliver_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessDumpReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13056,8 +15815,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Terminate); i {
+		file_sliverpb_sliver_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessDump); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13068,8 +15827,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IfconfigReq); i {
+		file_sliverpb_sliver_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunAsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13080,8 +15839,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ifconfig); i {
+		file_sliverpb_sliver_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunAs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13092,8 +15851,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetInterface); i {
+		file_sliverpb_sliver_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpersonateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13104,8 +15863,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsReq); i {
+		file_sliverpb_sliver_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Impersonate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13116,8 +15875,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ls); i {
+		file_sliverpb_sliver_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevToSelfReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13128,8 +15887,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
+		file_sliverpb_sliver_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevToSelf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13140,8 +15899,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CdReq); i {
+		file_sliverpb_sliver_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentTokenOwnerReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13152,8 +15911,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PwdReq); i {
+		file_sliverpb_sliver_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentTokenOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13164,8 +15923,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pwd); i {
+		file_sliverpb_sliver_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvokeGetSystemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13176,8 +15935,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmReq); i {
+		file_sliverpb_sliver_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13188,8 +15947,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rm); i {
+		file_sliverpb_sliver_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13200,8 +15959,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MvReq); i {
+		file_sliverpb_sliver_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13212,8 +15971,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mv); i {
+		file_sliverpb_sliver_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13224,8 +15983,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CpReq); i {
+		file_sliverpb_sliver_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13236,8 +15995,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cp); i {
+		file_sliverpb_sliver_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteAssemblyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13248,8 +16007,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MkdirReq); i {
+		file_sliverpb_sliver_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvokeExecuteAssemblyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13260,8 +16019,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mkdir); i {
+		file_sliverpb_sliver_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvokeInProcExecuteAssemblyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13272,8 +16031,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadReq); i {
+		file_sliverpb_sliver_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteAssembly); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13284,8 +16043,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Download); i {
+		file_sliverpb_sliver_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvokeMigrateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13296,8 +16055,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadReq); i {
+		file_sliverpb_sliver_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Migrate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13308,8 +16067,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Upload); i {
+		file_sliverpb_sliver_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13320,8 +16079,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessDumpReq); i {
+		file_sliverpb_sliver_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteWindowsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13332,8 +16091,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessDump); i {
+		file_sliverpb_sliver_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Execute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13344,8 +16103,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunAsReq); i {
+		file_sliverpb_sliver_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SideloadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13356,8 +16115,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunAs); i {
+		file_sliverpb_sliver_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sideload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13368,8 +16127,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImpersonateReq); i {
+		file_sliverpb_sliver_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvokeSpawnDllReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13380,8 +16139,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Impersonate); i {
+		file_sliverpb_sliver_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpawnDllReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13392,8 +16151,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevToSelfReq); i {
+		file_sliverpb_sliver_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpawnDll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13404,8 +16163,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevToSelf); i {
+		file_sliverpb_sliver_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetstatReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13416,8 +16175,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrentTokenOwnerReq); i {
+		file_sliverpb_sliver_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SockTabEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13428,8 +16187,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrentTokenOwner); i {
+		file_sliverpb_sliver_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Netstat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13440,8 +16199,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvokeGetSystemReq); i {
+		file_sliverpb_sliver_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13452,8 +16211,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystem); i {
+		file_sliverpb_sliver_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13464,8 +16223,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeTokenReq); i {
+		file_sliverpb_sliver_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEnvReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13476,8 +16235,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeToken); i {
+		file_sliverpb_sliver_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEnv); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13488,8 +16247,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskReq); i {
+		file_sliverpb_sliver_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsetEnvReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13500,8 +16259,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Task); i {
+		file_sliverpb_sliver_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsetEnv); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13512,8 +16271,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteAssemblyReq); i {
+		file_sliverpb_sliver_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSSessionInit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13524,8 +16283,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvokeExecuteAssemblyReq); i {
+		file_sliverpb_sliver_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13536,8 +16295,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvokeInProcExecuteAssemblyReq); i {
+		file_sliverpb_sliver_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSBlockHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13548,8 +16307,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteAssembly); i {
+		file_sliverpb_sliver_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPSessionInit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13560,8 +16319,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvokeMigrateReq); i {
+		file_sliverpb_sliver_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13572,8 +16331,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Migrate); i {
+		file_sliverpb_sliver_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplantKeys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13584,8 +16343,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteReq); i {
+		file_sliverpb_sliver_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPC2Profile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13596,8 +16355,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteWindowsReq); i {
+		file_sliverpb_sliver_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPC2NameValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13608,8 +16367,32 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Execute); i {
+		file_sliverpb_sliver_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenshotReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Screenshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sliverpb_sliver_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartServiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13620,8 +16403,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SideloadReq); i {
+		file_sliverpb_sliver_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13632,8 +16415,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sideload); i {
+		file_sliverpb_sliver_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13644,8 +16427,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvokeSpawnDllReq); i {
+		file_sliverpb_sliver_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopServiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13656,8 +16439,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpawnDllReq); i {
+		file_sliverpb_sliver_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServiceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13668,8 +16451,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpawnDll); i {
+		file_sliverpb_sliver_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryReadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13680,8 +16463,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetstatReq); i {
+		file_sliverpb_sliver_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryRead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13692,8 +16475,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SockTabEntry); i {
+		file_sliverpb_sliver_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryWriteReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13704,8 +16487,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Netstat); i {
+		file_sliverpb_sliver_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13716,8 +16499,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvReq); i {
+		file_sliverpb_sliver_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryCreateKeyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13728,8 +16511,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvInfo); i {
+		file_sliverpb_sliver_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryCreateKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13740,8 +16523,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetEnvReq); i {
+		file_sliverpb_sliver_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryDeleteKeyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13752,8 +16535,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetEnv); i {
+		file_sliverpb_sliver_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryDeleteKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13764,8 +16547,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsetEnvReq); i {
+		file_sliverpb_sliver_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrySubKeyListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13776,8 +16559,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsetEnv); i {
+		file_sliverpb_sliver_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrySubKeyList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13788,8 +16571,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSSessionInit); i {
+		file_sliverpb_sliver_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryListValuesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13800,8 +16583,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSPoll); i {
+		file_sliverpb_sliver_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryValuesList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13812,8 +16595,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSBlockHeader); i {
+		file_sliverpb_sliver_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tunnel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13824,8 +16607,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HTTPSessionInit); i {
+		file_sliverpb_sliver_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13836,8 +16619,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScreenshotReq); i {
+		file_sliverpb_sliver_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13848,8 +16631,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Screenshot); i {
+		file_sliverpb_sliver_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shell); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13860,8 +16643,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartServiceReq); i {
+		file_sliverpb_sliver_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellResizeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13872,8 +16655,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceInfo); i {
+		file_sliverpb_sliver_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellResize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13884,8 +16667,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceInfoReq); i {
+		file_sliverpb_sliver_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellSignalReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13896,8 +16679,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopServiceReq); i {
+		file_sliverpb_sliver_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellSignal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13908,8 +16691,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveServiceReq); i {
+		file_sliverpb_sliver_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13920,8 +16703,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryReadReq); i {
+		file_sliverpb_sliver_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13932,8 +16715,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryRead); i {
+		file_sliverpb_sliver_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanHost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13944,8 +16727,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryWriteReq); i {
+		file_sliverpb_sliver_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13956,8 +16739,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryWrite); i {
+		file_sliverpb_sliver_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSResolversReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13968,8 +16751,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryCreateKeyReq); i {
+		file_sliverpb_sliver_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSResolvers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13980,8 +16763,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryCreateKey); i {
+		file_sliverpb_sliver_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSExchange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13992,8 +16775,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryDeleteKeyReq); i {
+		file_sliverpb_sliver_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EDREnumReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14004,8 +16787,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryDeleteKey); i {
+		file_sliverpb_sliver_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EDRComponent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14016,8 +16799,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrySubKeyListReq); i {
+		file_sliverpb_sliver_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EDREnum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14028,8 +16811,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrySubKeyList); i {
+		file_sliverpb_sliver_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14040,8 +16823,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryListValuesReq); i {
+		file_sliverpb_sliver_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discover); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14052,8 +16835,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistryValuesList); i {
+		file_sliverpb_sliver_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADEnumReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14064,8 +16847,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tunnel); i {
+		file_sliverpb_sliver_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14076,8 +16859,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelData); i {
+		file_sliverpb_sliver_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADObject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14088,8 +16871,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShellReq); i {
+		file_sliverpb_sliver_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADEnum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14100,8 +16883,8 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shell); i {
+		file_sliverpb_sliver_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14112,7 +16895,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PortfwdReq); i {
 			case 0:
 				return &v.state
@@ -14124,7 +16907,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Portfwd); i {
 			case 0:
 				return &v.state
@@ -14136,7 +16919,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Socks); i {
 			case 0:
 				return &v.state
@@ -14148,7 +16931,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SocksData); i {
 			case 0:
 				return &v.state
@@ -14160,7 +16943,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotStartListenerReq); i {
 			case 0:
 				return &v.state
@@ -14172,7 +16955,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotStopListenerReq); i {
 			case 0:
 				return &v.state
@@ -14184,7 +16967,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotListener); i {
 			case 0:
 				return &v.state
@@ -14196,7 +16979,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotHello); i {
 			case 0:
 				return &v.state
@@ -14208,7 +16991,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotServerKeyExchange); i {
 			case 0:
 				return &v.state
@@ -14220,7 +17003,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotPeer); i {
 			case 0:
 				return &v.state
@@ -14232,7 +17015,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotPeerEnvelope); i {
 			case 0:
 				return &v.state
@@ -14244,7 +17027,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotPing); i {
 			case 0:
 				return &v.state
@@ -14256,7 +17039,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NetConnPivot); i {
 			case 0:
 				return &v.state
@@ -14268,7 +17051,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotPeerFailure); i {
 			case 0:
 				return &v.state
@@ -14280,7 +17063,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotListenersReq); i {
 			case 0:
 				return &v.state
@@ -14292,7 +17075,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PivotListeners); i {
 			case 0:
 				return &v.state
@@ -14304,7 +17087,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGPortForwardStartReq); i {
 			case 0:
 				return &v.state
@@ -14316,7 +17099,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGPortForward); i {
 			case 0:
 				return &v.state
@@ -14328,7 +17111,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGPortForwardStopReq); i {
 			case 0:
 				return &v.state
@@ -14340,7 +17123,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGSocksStartReq); i {
 			case 0:
 				return &v.state
@@ -14352,7 +17135,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGSocks); i {
 			case 0:
 				return &v.state
@@ -14364,7 +17147,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGSocksStopReq); i {
 			case 0:
 				return &v.state
@@ -14376,7 +17159,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGTCPForwardersReq); i {
 			case 0:
 				return &v.state
@@ -14388,7 +17171,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGSocksServersReq); i {
 			case 0:
 				return &v.state
@@ -14400,7 +17183,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGTCPForwarder); i {
 			case 0:
 				return &v.state
@@ -14412,7 +17195,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGSocksServer); i {
 			case 0:
 				return &v.state
@@ -14424,7 +17207,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGSocksServers); i {
 			case 0:
 				return &v.state
@@ -14436,7 +17219,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WGTCPForwarders); i {
 			case 0:
 				return &v.state
@@ -14448,7 +17231,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconfigureReq); i {
 			case 0:
 				return &v.state
@@ -14460,7 +17243,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Reconfigure); i {
 			case 0:
 				return &v.state
@@ -14472,7 +17255,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PollIntervalReq); i {
 			case 0:
 				return &v.state
@@ -14484,7 +17267,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PollInterval); i {
 			case 0:
 				return &v.state
@@ -14496,7 +17279,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SSHCommandReq); i {
 			case 0:
 				return &v.state
@@ -14508,7 +17291,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SSHCommand); i {
 			case 0:
 				return &v.state
@@ -14520,7 +17303,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrivsReq); i {
 			case 0:
 				return &v.state
@@ -14532,7 +17315,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WindowsPrivilegeEntry); i {
 			case 0:
 				return &v.state
@@ -14544,7 +17327,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrivs); i {
 			case 0:
 				return &v.state
@@ -14556,7 +17339,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterExtensionReq); i {
 			case 0:
 				return &v.state
@@ -14568,7 +17351,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterExtension); i {
 			case 0:
 				return &v.state
@@ -14580,7 +17363,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CallExtensionReq); i {
 			case 0:
 				return &v.state
@@ -14592,7 +17375,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CallExtension); i {
 			case 0:
 				return &v.state
@@ -14604,7 +17387,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExtensionsReq); i {
 			case 0:
 				return &v.state
@@ -14616,7 +17399,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListExtensions); i {
 			case 0:
 				return &v.state
@@ -14628,7 +17411,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RportFwdStopListenerReq); i {
 			case 0:
 				return &v.state
@@ -14640,7 +17423,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RportFwdStartListenerReq); i {
 			case 0:
 				return &v.state
@@ -14652,7 +17435,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RportFwdListener); i {
 			case 0:
 				return &v.state
@@ -14664,7 +17447,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RportFwdListeners); i {
 			case 0:
 				return &v.state
@@ -14676,7 +17459,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RportFwdListenersReq); i {
 			case 0:
 				return &v.state
@@ -14688,7 +17471,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RPortfwd); i {
 			case 0:
 				return &v.state
@@ -14700,7 +17483,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RPortfwdReq); i {
 			case 0:
 				return &v.state
@@ -14712,7 +17495,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChmodReq); i {
 			case 0:
 				return &v.state
@@ -14724,7 +17507,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Chmod); i {
 			case 0:
 				return &v.state
@@ -14736,7 +17519,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChownReq); i {
 			case 0:
 				return &v.state
@@ -14748,7 +17531,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Chown); i {
 			case 0:
 				return &v.state
@@ -14760,7 +17543,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChtimesReq); i {
 			case 0:
 				return &v.state
@@ -14772,7 +17555,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Chtimes); i {
 			case 0:
 				return &v.state
@@ -14784,7 +17567,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MemfilesListReq); i {
 			case 0:
 				return &v.state
@@ -14796,7 +17579,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MemfilesAddReq); i {
 			case 0:
 				return &v.state
@@ -14808,7 +17591,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MemfilesAdd); i {
 			case 0:
 				return &v.state
@@ -14820,7 +17603,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MemfilesRmReq); i {
 			case 0:
 				return &v.state
@@ -14832,7 +17615,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MemfilesRm); i {
 			case 0:
 				return &v.state
@@ -14844,7 +17627,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterWasmExtensionReq); i {
 			case 0:
 				return &v.state
@@ -14856,7 +17639,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterWasmExtension); i {
 			case 0:
 				return &v.state
@@ -14868,7 +17651,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeregisterWasmExtensionReq); i {
 			case 0:
 				return &v.state
@@ -14880,7 +17663,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListWasmExtensionsReq); i {
 			case 0:
 				return &v.state
@@ -14892,7 +17675,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListWasmExtensions); i {
 			case 0:
 				return &v.state
@@ -14904,7 +17687,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecWasmExtensionReq); i {
 			case 0:
 				return &v.state
@@ -14916,7 +17699,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecWasmExtension); i {
 			case 0:
 				return &v.state
@@ -14928,7 +17711,7 @@ func file_sliverpb_sliver_proto_init() {
 				return nil
 			}
 		}
-		file_sliverpb_sliver_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+		file_sliverpb_sliver_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SockTabEntry_SockAddr); i {
 			case 0:
 				return &v.state
@@ -14947,7 +17730,7 @@ func file_sliverpb_sliver_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sliverpb_sliver_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   170,
+			NumMessages:   197,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
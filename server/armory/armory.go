@@ -0,0 +1,326 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// DirName - Packages are stored in this directory of the app dir
+	DirName = "armory"
+
+	aliasManifestFileName     = "alias.json"
+	extensionManifestFileName = "extension.json"
+
+	// maxManifestSize - Manifests are embedded in signatures and the index
+	maxManifestSize = 64 * 1024
+)
+
+var (
+	armoryLog = log.NamedLogger("armory", "packages")
+
+	// ErrInvalidPackage - The package isn't a tar.gz with an alias or extension manifest
+	ErrInvalidPackage = errors.New("invalid armory package")
+	// ErrVersionExists - Published versions cannot be replaced
+	ErrVersionExists = errors.New("version already published")
+	// ErrPackageNotFound - No published package matched
+	ErrPackageNotFound = errors.New("package not found")
+
+	validName    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	validVersion = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.+-]*$`)
+)
+
+// Manifest - The fields of an alias/extension manifest the armory needs
+type Manifest struct {
+	Name        string `json:"name"`
+	CommandName string `json:"command_name"`
+	Version     string `json:"version"`
+}
+
+// Index - The signed index returned to clients, matches the client's self-hosted
+// armory format
+type Index struct {
+	Minisig     string `json:"minisig"`      // Minisig (Base64)
+	ArmoryIndex string `json:"armory_index"` // Index JSON (Base64)
+}
+
+// PackageSignature - The signature and download url of a package
+type PackageSignature struct {
+	Minisig  string `json:"minisig"`
+	TarGzURL string `json:"tar_gz_url"`
+}
+
+type indexPackage struct {
+	Name        string `json:"name"`
+	CommandName string `json:"command_name"`
+	RepoURL     string `json:"repo_url"`
+	PublicKey   string `json:"public_key"`
+}
+
+type armoryIndex struct {
+	Aliases    []*indexPackage `json:"aliases"`
+	Extensions []*indexPackage `json:"extensions"`
+	Bundles    []any           `json:"bundles"`
+}
+
+// GetArmoryDir - The directory packages are stored in
+func GetArmoryDir() string {
+	dir := filepath.Join(assets.GetRootAppDir(), DirName)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		err = os.MkdirAll(dir, 0700)
+		if err != nil {
+			armoryLog.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func packagePath(pkg *models.ArmoryPackage) string {
+	return filepath.Join(GetArmoryDir(), pkg.ID.String()+".tar.gz")
+}
+
+// Publish - Sign and store a new version of a package, the manifest is read
+// from the package so the signature always matches its contents
+func Publish(tarGz []byte, operator string) (*models.ArmoryPackage, error) {
+	manifestData, isAlias, err := ReadManifest(tarGz)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := ParseManifest(manifestData)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := Versions(manifest.CommandName)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range previous {
+		if pkg.Version == manifest.Version {
+			return nil, fmt.Errorf("%w: %s %s", ErrVersionExists, manifest.CommandName, manifest.Version)
+		}
+		if pkg.IsAlias != isAlias {
+			return nil, fmt.Errorf("%w: %s is published with a different type", ErrInvalidPackage, manifest.CommandName)
+		}
+	}
+
+	digest := sha256.Sum256(tarGz)
+	pkg := &models.ArmoryPackage{
+		CommandName: manifest.CommandName,
+		Name:        manifest.Name,
+		Version:     manifest.Version,
+		IsAlias:     isAlias,
+		Manifest:    string(manifestData),
+		SHA256:      hex.EncodeToString(digest[:]),
+		Size:        int64(len(tarGz)),
+		PublishedBy: operator,
+		Minisig:     cryptography.ArmorySign(tarGz, base64.StdEncoding.EncodeToString(manifestData)),
+	}
+	err = db.Session().Create(pkg).Error
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(packagePath(pkg), tarGz, 0600)
+	if err != nil {
+		db.Session().Delete(pkg)
+		return nil, err
+	}
+	armoryLog.Infof("%s published %s %s", operator, pkg.CommandName, pkg.Version)
+	return pkg, nil
+}
+
+// ReadManifest - Read the alias or extension manifest from the root of a package
+func ReadManifest(tarGz []byte) ([]byte, bool, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(tarGz))
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %s", ErrInvalidPackage, err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %s", ErrInvalidPackage, err)
+		}
+		name := filepath.ToSlash(filepath.Clean(header.Name))
+		if name != aliasManifestFileName && name != extensionManifestFileName {
+			continue
+		}
+		if maxManifestSize < header.Size {
+			return nil, false, fmt.Errorf("%w: manifest is too large", ErrInvalidPackage)
+		}
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %s", ErrInvalidPackage, err)
+		}
+		return data, name == aliasManifestFileName, nil
+	}
+	return nil, false, fmt.Errorf("%w: no %s or %s", ErrInvalidPackage, aliasManifestFileName, extensionManifestFileName)
+}
+
+// ParseManifest - Parse and validate the fields of a manifest the armory uses
+func ParseManifest(data []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+	err := json.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPackage, err)
+	}
+	if manifest.Name == "" {
+		return nil, fmt.Errorf("%w: missing name", ErrInvalidPackage)
+	}
+	if !validName.MatchString(manifest.CommandName) {
+		return nil, fmt.Errorf("%w: invalid command name '%s'", ErrInvalidPackage, manifest.CommandName)
+	}
+	if !validVersion.MatchString(manifest.Version) {
+		return nil, fmt.Errorf("%w: invalid version '%s'", ErrInvalidPackage, manifest.Version)
+	}
+	return manifest, nil
+}
+
+// Packages - All published versions of all packages, newest first
+func Packages() ([]*models.ArmoryPackage, error) {
+	packages := []*models.ArmoryPackage{}
+	err := db.Session().Order("command_name, created_at desc").Find(&packages).Error
+	return packages, err
+}
+
+// Versions - The published versions of a package, newest first
+func Versions(commandName string) ([]*models.ArmoryPackage, error) {
+	packages := []*models.ArmoryPackage{}
+	err := db.Session().Where(&models.ArmoryPackage{
+		CommandName: commandName,
+	}).Order("created_at desc").Find(&packages).Error
+	return packages, err
+}
+
+// Latest - The most recently published version of a package
+func Latest(commandName string) (*models.ArmoryPackage, error) {
+	packages, err := Versions(commandName)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) == 0 {
+		return nil, ErrPackageNotFound
+	}
+	return packages[0], nil
+}
+
+// LatestVersions - The latest version of each package
+func LatestVersions(packages []*models.ArmoryPackage) []*models.ArmoryPackage {
+	latest := []*models.ArmoryPackage{}
+	seen := map[string]bool{}
+	for _, pkg := range packages {
+		if seen[pkg.CommandName] {
+			continue
+		}
+		seen[pkg.CommandName] = true
+		latest = append(latest, pkg)
+	}
+	return latest
+}
+
+// Unpublish - Remove a version of a package, or all versions if version is blank.
+// The previous version (if any) is served once the latest is removed.
+func Unpublish(commandName string, version string) (int, error) {
+	packages, err := Versions(commandName)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, pkg := range packages {
+		if version != "" && pkg.Version != version {
+			continue
+		}
+		err = db.Session().Delete(pkg).Error
+		if err != nil {
+			return removed, err
+		}
+		os.Remove(packagePath(pkg))
+		removed++
+	}
+	if removed == 0 {
+		return 0, ErrPackageNotFound
+	}
+	return removed, nil
+}
+
+// ReadPackage - The contents of a published package
+func ReadPackage(pkg *models.ArmoryPackage) ([]byte, error) {
+	return os.ReadFile(packagePath(pkg))
+}
+
+// SignedIndex - Build and sign the index of the latest packages, package urls are
+// relative to baseURL (the url the armory is being accessed at)
+func SignedIndex(baseURL string) (*Index, error) {
+	packages, err := Packages()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(buildIndex(LatestVersions(packages), baseURL, cryptography.ArmorySigningPublicKey()))
+	if err != nil {
+		return nil, err
+	}
+	return &Index{
+		Minisig:     base64.StdEncoding.EncodeToString([]byte(cryptography.ArmorySign(data, "sliver private armory"))),
+		ArmoryIndex: base64.StdEncoding.EncodeToString(data),
+	}, nil
+}
+
+func buildIndex(packages []*models.ArmoryPackage, baseURL string, publicKey string) *armoryIndex {
+	index := &armoryIndex{
+		Aliases:    []*indexPackage{},
+		Extensions: []*indexPackage{},
+		Bundles:    []any{},
+	}
+	for _, pkg := range packages {
+		entry := &indexPackage{
+			Name:        pkg.Name,
+			CommandName: pkg.CommandName,
+			RepoURL:     fmt.Sprintf("%s/packages/%s", baseURL, pkg.CommandName),
+			PublicKey:   publicKey,
+		}
+		if pkg.IsAlias {
+			index.Aliases = append(index.Aliases, entry)
+		} else {
+			index.Extensions = append(index.Extensions, entry)
+		}
+	}
+	return index
+}
@@ -0,0 +1,128 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+func testPackage(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, data := range files {
+		err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tarWriter.Write([]byte(data))
+	}
+	tarWriter.Close()
+	gzipWriter.Close()
+	return buf.Bytes()
+}
+
+func TestReadManifest(t *testing.T) {
+	manifest := `{"name": "rubeus", "command_name": "rubeus", "version": "1.2.0"}`
+	data, isAlias, err := ReadManifest(testPackage(t, map[string]string{
+		"./rubeus.exe": "MZ",
+		"./alias.json": manifest,
+	}))
+	if err != nil || !isAlias || string(data) != manifest {
+		t.Fatalf("Unexpected manifest %q %v %v", data, isAlias, err)
+	}
+	_, isAlias, err = ReadManifest(testPackage(t, map[string]string{"./extension.json": manifest}))
+	if err != nil || isAlias {
+		t.Fatalf("Expected an extension manifest (%v)", err)
+	}
+
+	_, _, err = ReadManifest(testPackage(t, map[string]string{"./sub/alias.json": manifest}))
+	if !errors.Is(err, ErrInvalidPackage) {
+		t.Errorf("Expected a missing manifest error, got %v", err)
+	}
+	_, _, err = ReadManifest([]byte("not a package"))
+	if !errors.Is(err, ErrInvalidPackage) {
+		t.Errorf("Expected an invalid package error, got %v", err)
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	manifest, err := ParseManifest([]byte(`{"name": "Rubeus", "command_name": "rubeus", "version": "v1.2.0+build.1"}`))
+	if err != nil || manifest.CommandName != "rubeus" || manifest.Version != "v1.2.0+build.1" {
+		t.Fatalf("Unexpected manifest %+v %v", manifest, err)
+	}
+	invalid := []string{
+		`{"command_name": "rubeus", "version": "1.0.0"}`,
+		`{"name": "x", "command_name": "../rubeus", "version": "1.0.0"}`,
+		`{"name": "x", "command_name": "rubeus"}`,
+		`{"name": "x", "command_name": "rubeus", "version": "1.0/../0"}`,
+	}
+	for _, data := range invalid {
+		if _, err := ParseManifest([]byte(data)); !errors.Is(err, ErrInvalidPackage) {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
+
+func TestLatestVersions(t *testing.T) {
+	packages := []*models.ArmoryPackage{
+		{CommandName: "a", Version: "2.0.0"},
+		{CommandName: "a", Version: "1.0.0"},
+		{CommandName: "b", Version: "0.1.0", IsAlias: true},
+	}
+	latest := LatestVersions(packages)
+	if len(latest) != 2 || latest[0].Version != "2.0.0" || latest[1].CommandName != "b" {
+		t.Fatalf("Unexpected latest versions %+v", latest)
+	}
+
+	index := buildIndex(latest, "https://armory.local:8443", "key")
+	if len(index.Extensions) != 1 || len(index.Aliases) != 1 {
+		t.Fatalf("Unexpected index %+v", index)
+	}
+	if index.Aliases[0].RepoURL != "https://armory.local:8443/packages/b" || index.Aliases[0].PublicKey != "key" {
+		t.Errorf("Unexpected index entry %+v", index.Aliases[0])
+	}
+}
+
+func TestParsePackagePath(t *testing.T) {
+	tests := []struct {
+		path       string
+		command    string
+		version    string
+		isDownload bool
+	}{
+		{"/packages/rubeus", "rubeus", "", false},
+		{"/packages/rubeus/1.2.0.tar.gz", "rubeus", "1.2.0", true},
+		{"/packages/rubeus/1.2.0", "", "", false},
+		{"/packages/../rubeus", "", "", false},
+		{"/packages/rubeus/a/b.tar.gz", "", "", false},
+	}
+	for _, test := range tests {
+		command, version, isDownload := parsePackagePath(test.path)
+		if command != test.command || version != test.version || isDownload != test.isDownload {
+			t.Errorf("%s: got %q %q %v", test.path, command, version, isDownload)
+		}
+	}
+}
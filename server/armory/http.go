@@ -0,0 +1,239 @@
+package armory
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// DefaultPort - Port of armory listeners if none is given
+	DefaultPort = 8443
+)
+
+var (
+	httpLog = log.NamedLogger("armory", "http")
+)
+
+// ListenerConfig - Configuration of an armory listener
+type ListenerConfig struct {
+	Host          string
+	Port          uint16
+	Secure        bool
+	Cert          []byte
+	Key           []byte
+	Authorization string
+}
+
+// Handler - Serves the signed index at /index, package signatures at
+// /packages/<command> and the packages at /packages/<command>/<version>.tar.gz
+func Handler(authorization string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/index", serveIndex)
+	mux.HandleFunc("/packages/", servePackage)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		given := req.Header.Get("Authorization")
+		if authorization != "" && subtle.ConstantTimeCompare([]byte(given), []byte(authorization)) != 1 {
+			httpLog.Warnf("Unauthorized request from %s for %s", req.RemoteAddr, req.URL.Path)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, req)
+	})
+}
+
+// baseURL - The url the client used to reach the armory
+func baseURL(req *http.Request) string {
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, req.Host)
+}
+
+func serveIndex(w http.ResponseWriter, req *http.Request) {
+	index, err := SignedIndex(baseURL(req))
+	if err != nil {
+		httpLog.Errorf("Failed to build index: %s", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, index)
+}
+
+func servePackage(w http.ResponseWriter, req *http.Request) {
+	commandName, version, isDownload := parsePackagePath(req.URL.Path)
+	if commandName == "" {
+		http.NotFound(w, req)
+		return
+	}
+	if !isDownload {
+		// Clients pinned to a version request it with ?version=
+		pkg, err := Latest(commandName)
+		if pinned := req.URL.Query().Get("version"); pinned != "" && err == nil {
+			pkg, err = findVersion(commandName, pinned)
+		}
+		if err != nil {
+			http.NotFound(w, req)
+			return
+		}
+		writeJSON(w, &PackageSignature{
+			Minisig:  pkg.Minisig,
+			TarGzURL: fmt.Sprintf("%s/packages/%s/%s.tar.gz", baseURL(req), pkg.CommandName, pkg.Version),
+		})
+		return
+	}
+
+	pkg, err := findVersion(commandName, version)
+	if err != nil {
+		http.NotFound(w, req)
+		return
+	}
+	data, err := ReadPackage(pkg)
+	if err != nil {
+		httpLog.Errorf("Failed to read package %s %s: %s", pkg.CommandName, pkg.Version, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	httpLog.Infof("%s downloaded %s %s", req.RemoteAddr, pkg.CommandName, pkg.Version)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(data)
+}
+
+func findVersion(commandName string, version string) (*models.ArmoryPackage, error) {
+	versions, err := Versions(commandName)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range versions {
+		if pkg.Version == version {
+			return pkg, nil
+		}
+	}
+	return nil, ErrPackageNotFound
+}
+
+// parsePackagePath - The command name, version, and if it is a download of
+// /packages/<command> or /packages/<command>/<version>.tar.gz
+func parsePackagePath(urlPath string) (string, string, bool) {
+	parts := strings.Split(strings.TrimPrefix(urlPath, "/packages/"), "/")
+	if !validName.MatchString(parts[0]) {
+		return "", "", false
+	}
+	switch len(parts) {
+	case 1:
+		return parts[0], "", false
+	case 2:
+		version := strings.TrimSuffix(parts[1], ".tar.gz")
+		if version == parts[1] || !validVersion.MatchString(version) {
+			return "", "", false
+		}
+		return parts[0], version, true
+	}
+	return "", "", false
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// StartListenerJob - Serve the private armory over http(s), a self-signed
+// certificate is generated if none is given
+func StartListenerJob(conf *ListenerConfig) (*core.Job, error) {
+	ln, err := net.Listen("tcp", net.JoinHostPort(conf.Host, fmt.Sprintf("%d", conf.Port)))
+	if err != nil {
+		return nil, err
+	}
+	name := "armory"
+	if conf.Secure {
+		if len(conf.Cert) == 0 || len(conf.Key) == 0 {
+			host := conf.Host
+			if host == "" {
+				host = "localhost"
+			}
+			conf.Cert, conf.Key, err = certs.HTTPSGenerateRSACertificate(host)
+			if err != nil {
+				ln.Close()
+				return nil, err
+			}
+		}
+		cert, err := tls.X509KeyPair(conf.Cert, conf.Key)
+		if err != nil {
+			ln.Close()
+			return nil, err
+		}
+		ln = tls.NewListener(ln, &tls.Config{Certificates: []tls.Certificate{cert}})
+		name = "armory (https)"
+	}
+
+	server := &http.Server{
+		Handler:           Handler(conf.Authorization),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        name,
+		Description: "private armory",
+		Protocol:    "tcp",
+		Port:        conf.Port,
+		JobCtrl:     make(chan bool),
+	}
+	go func() {
+		err := server.Serve(ln)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			httpLog.Errorf("Armory listener error %s", err)
+		}
+	}()
+	go func() {
+		<-job.JobCtrl
+		httpLog.Infof("Stopping armory listener (%d) ...", job.ID)
+		server.Close()
+		core.Jobs.Remove(job)
+		core.EventBroker.Publish(core.Event{
+			Job:       job,
+			EventType: consts.JobStoppedEvent,
+		})
+	}()
+	core.Jobs.Add(job)
+	return job, nil
+}
@@ -0,0 +1,177 @@
+package attack
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sort"
+	"strings"
+)
+
+// Technique - A MITRE ATT&CK technique, the tactic is the kill chain phase name
+type Technique struct {
+	ID     string
+	Name   string
+	Tactic string
+}
+
+var (
+	// Tactics - The enterprise tactics (kill chain phase names) in kill chain order
+	Tactics = []string{
+		"reconnaissance",
+		"resource-development",
+		"initial-access",
+		"execution",
+		"persistence",
+		"privilege-escalation",
+		"defense-evasion",
+		"credential-access",
+		"discovery",
+		"lateral-movement",
+		"collection",
+		"command-and-control",
+		"exfiltration",
+		"impact",
+	}
+
+	// methodTechniques - The technique of each implant command (rpc method), commands
+	// that don't map to a technique (e.g. Ping) are not tagged
+	methodTechniques = map[string]Technique{
+		"Ps":                    {"T1057", "Process Discovery", "discovery"},
+		"Ifconfig":              {"T1016", "System Network Configuration Discovery", "discovery"},
+		"Netstat":               {"T1049", "System Network Connections Discovery", "discovery"},
+		"EDREnum":               {"T1518.001", "Software Discovery: Security Software Discovery", "discovery"},
+		"Scan":                  {"T1046", "Network Service Discovery", "discovery"},
+		"Discover":              {"T1018", "Remote System Discovery", "discovery"},
+		"ADEnum":                {"T1087.002", "Account Discovery: Domain Account", "discovery"},
+		"Ls":                    {"T1083", "File and Directory Discovery", "discovery"},
+		"Cd":                    {"T1083", "File and Directory Discovery", "discovery"},
+		"Pwd":                   {"T1083", "File and Directory Discovery", "discovery"},
+		"GetEnv":                {"T1082", "System Information Discovery", "discovery"},
+		"CurrentTokenOwner":     {"T1033", "System Owner/User Discovery", "discovery"},
+		"GetPrivs":              {"T1033", "System Owner/User Discovery", "discovery"},
+		"RegistryRead":          {"T1012", "Query Registry", "discovery"},
+		"RegistryListSubKeys":   {"T1012", "Query Registry", "discovery"},
+		"RegistryListValues":    {"T1012", "Query Registry", "discovery"},
+		"Screenshot":            {"T1113", "Screen Capture", "collection"},
+		"Download":              {"T1005", "Data from Local System", "collection"},
+		"Upload":                {"T1105", "Ingress Tool Transfer", "command-and-control"},
+		"Rm":                    {"T1070.004", "Indicator Removal: File Deletion", "defense-evasion"},
+		"Chmod":                 {"T1222", "File and Directory Permissions Modification", "defense-evasion"},
+		"Chown":                 {"T1222", "File and Directory Permissions Modification", "defense-evasion"},
+		"MemfilesAdd":           {"T1027.011", "Obfuscated Files or Information: Fileless Storage", "defense-evasion"},
+		"Chtimes":               {"T1070.006", "Indicator Removal: Timestomp", "defense-evasion"},
+		"Execute":               {"T1059", "Command and Scripting Interpreter", "execution"},
+		"ExecuteWindows":        {"T1059", "Command and Scripting Interpreter", "execution"},
+		"Shell":                 {"T1059", "Command and Scripting Interpreter", "execution"},
+		"RunSSHCommand":         {"T1021.004", "Remote Services: SSH", "lateral-movement"},
+		"ExecuteAssembly":       {"T1620", "Reflective Code Loading", "defense-evasion"},
+		"Sideload":              {"T1055.001", "Process Injection: Dynamic-link Library Injection", "defense-evasion"},
+		"SpawnDll":              {"T1055.001", "Process Injection: Dynamic-link Library Injection", "defense-evasion"},
+		"Task":                  {"T1055", "Process Injection", "defense-evasion"},
+		"Msf":                   {"T1055", "Process Injection", "defense-evasion"},
+		"MsfRemote":             {"T1055", "Process Injection", "defense-evasion"},
+		"Migrate":               {"T1055", "Process Injection", "defense-evasion"},
+		"ProcessDump":           {"T1003.001", "OS Credential Dumping: LSASS Memory", "credential-access"},
+		"Impersonate":           {"T1134.001", "Access Token Manipulation: Token Impersonation/Theft", "privilege-escalation"},
+		"RunAs":                 {"T1134.002", "Access Token Manipulation: Create Process with Token", "privilege-escalation"},
+		"MakeToken":             {"T1134.003", "Access Token Manipulation: Make and Impersonate Token", "privilege-escalation"},
+		"GetSystem":             {"T1134", "Access Token Manipulation", "privilege-escalation"},
+		"StartService":          {"T1543.003", "Create or Modify System Process: Windows Service", "persistence"},
+		"StopService":           {"T1489", "Service Stop", "impact"},
+		"RemoveService":         {"T1489", "Service Stop", "impact"},
+		"CallExtension":         {"T1129", "Shared Modules", "execution"},
+		"RegistryWrite":         {"T1112", "Modify Registry", "defense-evasion"},
+		"RegistryCreateKey":     {"T1112", "Modify Registry", "defense-evasion"},
+		"RegistryDeleteKey":     {"T1112", "Modify Registry", "defense-evasion"},
+		"Backdoor":              {"T1554", "Compromise Client Software Binary", "persistence"},
+		"HijackDLL":             {"T1574.001", "Hijack Execution Flow: DLL Search Order Hijacking", "persistence"},
+		"Portfwd":               {"T1090", "Proxy", "command-and-control"},
+		"CreateSocks":           {"T1090", "Proxy", "command-and-control"},
+		"StartRportFwdListener": {"T1090", "Proxy", "command-and-control"},
+		"WGStartPortForward":    {"T1090", "Proxy", "command-and-control"},
+		"WGStartSocks":          {"T1090", "Proxy", "command-and-control"},
+		"PivotStartListener":    {"T1090.001", "Proxy: Internal Proxy", "command-and-control"},
+	}
+
+	// transportTechniques - The C2 channel's technique, by transport prefix
+	transportTechniques = []struct {
+		prefix    string
+		technique Technique
+	}{
+		{"mtls", Technique{"T1573.002", "Encrypted Channel: Asymmetric Cryptography", "command-and-control"}},
+		{"wg", Technique{"T1573.001", "Encrypted Channel: Symmetric Cryptography", "command-and-control"}},
+		{"http", Technique{"T1071.001", "Application Layer Protocol: Web Protocols", "command-and-control"}},
+		{"dns", Technique{"T1071.004", "Application Layer Protocol: DNS", "command-and-control"}},
+		{"pivot", Technique{"T1090.001", "Proxy: Internal Proxy", "command-and-control"}},
+	}
+)
+
+// MethodTechnique - The technique a command maps to
+func MethodTechnique(method string) (Technique, bool) {
+	technique, ok := methodTechniques[method]
+	return technique, ok
+}
+
+// TransportTechnique - The technique of an implant's C2 transport
+func TransportTechnique(transport string) (Technique, bool) {
+	transport = strings.ToLower(transport)
+	for _, entry := range transportTechniques {
+		if strings.HasPrefix(transport, entry.prefix) {
+			return entry.technique, true
+		}
+	}
+	return Technique{}, false
+}
+
+// ByID - Look up a technique by its ATT&CK id (e.g. T1057)
+func ByID(id string) (Technique, bool) {
+	for _, technique := range Techniques() {
+		if technique.ID == id {
+			return technique, true
+		}
+	}
+	return Technique{}, false
+}
+
+// Techniques - Every technique a command or transport is tagged with, sorted by id
+func Techniques() []Technique {
+	seen := map[string]bool{}
+	techniques := []Technique{}
+	add := func(technique Technique) {
+		if !seen[technique.ID] {
+			seen[technique.ID] = true
+			techniques = append(techniques, technique)
+		}
+	}
+	for _, technique := range methodTechniques {
+		add(technique)
+	}
+	for _, entry := range transportTechniques {
+		add(entry.technique)
+	}
+	sort.Slice(techniques, func(i, j int) bool {
+		return techniques[i].ID < techniques[j].ID
+	})
+	return techniques
+}
+
+// URL - The technique's page on attack.mitre.org
+func (t Technique) URL() string {
+	return "https://attack.mitre.org/techniques/" + strings.ReplaceAll(t.ID, ".", "/") + "/"
+}
@@ -0,0 +1,55 @@
+package attack
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import "testing"
+
+func TestTechniques(t *testing.T) {
+	tactics := map[string]bool{}
+	for _, tactic := range Tactics {
+		tactics[tactic] = true
+	}
+	techniques := Techniques()
+	for index, technique := range techniques {
+		if !tactics[technique.Tactic] {
+			t.Fatalf("%s has an unknown tactic %s", technique.ID, technique.Tactic)
+		}
+		if 0 < index && technique.ID <= techniques[index-1].ID {
+			t.Fatalf("Expected techniques sorted by unique id, got %s after %s", technique.ID, techniques[index-1].ID)
+		}
+	}
+
+	technique, ok := ByID("T1057")
+	if !ok || technique.Name != "Process Discovery" {
+		t.Fatalf("Unexpected technique %v", technique)
+	}
+	if _, ok := ByID("T0000"); ok {
+		t.Fatal("Expected unknown technique id to not be found")
+	}
+	if _, ok := MethodTechnique("Ping"); ok {
+		t.Fatal("Expected Ping to not be tagged")
+	}
+	technique, ok = TransportTechnique("HTTPS")
+	if !ok || technique.ID != "T1071.001" {
+		t.Fatalf("Unexpected transport technique %v", technique)
+	}
+	if url := technique.URL(); url != "https://attack.mitre.org/techniques/T1071/001/" {
+		t.Fatalf("Unexpected url %s", url)
+	}
+}
@@ -0,0 +1,194 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	automationLog = log.NamedLogger("automation", "rules")
+
+	// ErrRuleNotFound - No automation rule with the given name
+	ErrRuleNotFound = errors.New("automation rule not found")
+	// ErrInvalidEvent - Rules can only be triggered by new sessions and beacons
+	ErrInvalidEvent = fmt.Errorf("invalid event, must be one of: %s, %s", consts.SessionOpenedEvent, consts.BeaconRegisteredEvent)
+
+	startOnce     sync.Once
+	defaultRunner *runner
+)
+
+// Start - Run matching automation rules when a session connects or a beacon registers,
+// and scheduled tasks when they are due. Rules and schedules run on the server so they
+// work when no operator is connected. Tasks are sent through the tasker, an rpc client
+// of the server, just like tasks from an operator.
+func Start(tasker Tasker) {
+	startOnce.Do(func() {
+		runner := newRunner(tasker)
+		defaultRunner = runner
+		go runner.schedules()
+		go func() {
+			for event := range core.EventBroker.Subscribe() {
+				switch event.EventType {
+				case consts.SessionOpenedEvent:
+					if event.Session != nil {
+						runner.trigger(event.EventType, sessionTarget(event.Session))
+					}
+				case consts.BeaconRegisteredEvent:
+					if event.Beacon != nil {
+						runner.trigger(event.EventType, beaconTarget(event.Beacon))
+					}
+				case consts.BeaconTaskResultEvent:
+					task := &clientpb.BeaconTask{}
+					if proto.Unmarshal(event.Data, task) == nil {
+						runner.beaconResult(task.ID)
+					}
+				}
+			}
+		}()
+	})
+}
+
+// Rules - All automation rules
+func Rules() ([]*clientpb.AutomationRule, error) {
+	dbRules, err := db.AutomationRules()
+	if err != nil {
+		return nil, err
+	}
+	rules := []*clientpb.AutomationRule{}
+	for _, rule := range dbRules {
+		rules = append(rules, rule.ToProtobuf())
+	}
+	return rules, nil
+}
+
+// Save - Validate and save a rule, an existing rule with the same name is replaced
+func Save(rule *clientpb.AutomationRule) (*clientpb.AutomationRule, error) {
+	err := Validate(rule)
+	if err != nil {
+		return nil, err
+	}
+	dbRule, err := db.AutomationRuleByName(rule.Name)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, err
+	}
+	if dbRule == nil {
+		dbRule = &models.AutomationRule{Name: rule.Name}
+	}
+	dbRule.Event = rule.Event
+	dbRule.OS = strings.ToLower(rule.OS)
+	dbRule.Hostname = rule.Hostname
+	dbRule.Username = rule.Username
+	dbRule.Tasks = strings.Join(rule.Tasks, "\n")
+	dbRule.Enabled = rule.Enabled
+	dbRule.Operator = rule.Operator
+	err = db.Session().Save(dbRule).Error
+	if err != nil {
+		return nil, err
+	}
+	automationLog.Infof("Saved automation rule %s (%s)", dbRule.Name, dbRule.Event)
+	return dbRule.ToProtobuf(), nil
+}
+
+// Remove - Remove a rule by name
+func Remove(name string) error {
+	dbRule, err := db.AutomationRuleByName(name)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return ErrRuleNotFound
+		}
+		return err
+	}
+	return db.Session().Delete(dbRule).Error
+}
+
+// Validate - Check a rule's event, filters, and tasks
+func Validate(rule *clientpb.AutomationRule) error {
+	if rule.Name == "" {
+		return errors.New("rule name required")
+	}
+	if rule.Event != consts.SessionOpenedEvent && rule.Event != consts.BeaconRegisteredEvent {
+		return ErrInvalidEvent
+	}
+	for _, expr := range []string{rule.Hostname, rule.Username} {
+		if _, err := regexp.Compile(expr); err != nil {
+			return err
+		}
+	}
+	for _, line := range rule.Tasks {
+		if strings.Contains(line, "\n") {
+			return errors.New("tasks cannot contain line breaks")
+		}
+	}
+	tasks, err := ParseTasks(rule.Tasks)
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return errors.New("rule has no tasks")
+	}
+	return nil
+}
+
+// matches - Check if an enabled rule applies to an event and implant, the hostname
+// and username filters are unanchored case insensitive regular expressions
+func matches(rule *models.AutomationRule, eventType string, target *target) bool {
+	if !rule.Enabled || rule.Event != eventType {
+		return false
+	}
+	if rule.OS != "" && !strings.EqualFold(rule.OS, target.OS) {
+		return false
+	}
+	filters := [][2]string{
+		{rule.Hostname, target.Hostname},
+		{rule.Username, target.Username},
+	}
+	for _, filter := range filters {
+		if filter[0] == "" {
+			continue
+		}
+		expr, err := regexp.Compile("(?i)" + filter[0])
+		if err != nil || !expr.MatchString(filter[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// publish - Notify operators of what a rule did
+func publish(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	automationLog.Info(message)
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.AutomationEvent,
+		Data:      []byte(message),
+	})
+}
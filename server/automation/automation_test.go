@@ -0,0 +1,102 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+func TestParseTask(t *testing.T) {
+	task, err := ParseTask(`execute "C:\Windows\System32\whoami.exe" /all`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Name != TaskExecute || len(task.Args) != 2 || task.Args[1] != "/all" {
+		t.Fatalf("Unexpected task %v", task)
+	}
+	task, err = ParseTask("interval 1h 10m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Interval != time.Hour || task.Jitter != 10*time.Minute {
+		t.Fatalf("Unexpected interval %s / %s", task.Interval, task.Jitter)
+	}
+	task, err = ParseTask("sleep 30")
+	if err != nil || task.Duration != 30*time.Second {
+		t.Fatalf("Expected sleep in seconds, got %v (%v)", task, err)
+	}
+	for _, line := range []string{"", "rm -rf /", "ps aux", "execute", "interval 10ms", "tag"} {
+		if _, err := ParseTask(line); err == nil {
+			t.Fatalf("Expected '%s' to be rejected", line)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	rule := &clientpb.AutomationRule{Name: "recon", Event: consts.SessionOpenedEvent, Tasks: []string{"ps", "tag new"}}
+	if err := Validate(rule); err != nil {
+		t.Fatal(err)
+	}
+	rule.Event = consts.BuildEvent
+	if err := Validate(rule); err != ErrInvalidEvent {
+		t.Fatalf("Expected invalid event, got %v", err)
+	}
+	rule.Event = consts.BeaconRegisteredEvent
+	rule.Hostname = "("
+	if err := Validate(rule); err == nil {
+		t.Fatal("Expected invalid regular expression to be rejected")
+	}
+	rule.Hostname = ""
+	rule.Tasks = []string{" "}
+	if err := Validate(rule); err == nil {
+		t.Fatal("Expected rule without tasks to be rejected")
+	}
+}
+
+func TestMatches(t *testing.T) {
+	rule := &models.AutomationRule{
+		Name:     "dc",
+		Event:    consts.SessionOpenedEvent,
+		OS:       "windows",
+		Hostname: "^dc[0-9]+",
+		Enabled:  true,
+	}
+	dc := &target{Hostname: "DC01", Username: "CORP\\alice", OS: "windows"}
+	if !matches(rule, consts.SessionOpenedEvent, dc) {
+		t.Fatal("Expected rule to match")
+	}
+	if matches(rule, consts.BeaconRegisteredEvent, dc) {
+		t.Fatal("Expected rule to only match its event")
+	}
+	if matches(rule, consts.SessionOpenedEvent, &target{Hostname: "WS01", OS: "windows"}) {
+		t.Fatal("Expected hostname filter to apply")
+	}
+	if matches(rule, consts.SessionOpenedEvent, &target{Hostname: "dc02", OS: "linux"}) {
+		t.Fatal("Expected os filter to apply")
+	}
+	rule.Enabled = false
+	if matches(rule, consts.SessionOpenedEvent, dc) {
+		t.Fatal("Expected disabled rule to not match")
+	}
+}
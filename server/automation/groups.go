@@ -0,0 +1,333 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+const (
+	// groupConcurrency - Number of group members tasked at the same time
+	groupConcurrency = 10
+)
+
+var (
+	// ErrGroupNotFound - No implant group with the given name
+	ErrGroupNotFound = errors.New("implant group not found")
+	// ErrNotStarted - Groups can only be tasked once the server has started automation
+	ErrNotStarted = errors.New("automation has not been started")
+)
+
+// Groups - All implant groups
+func Groups() ([]*clientpb.ImplantGroup, error) {
+	dbGroups, err := db.ImplantGroups()
+	if err != nil {
+		return nil, err
+	}
+	groups := []*clientpb.ImplantGroup{}
+	for _, group := range dbGroups {
+		groups = append(groups, group.ToProtobuf())
+	}
+	return groups, nil
+}
+
+// ValidateGroup - A group needs a name and at least one filter or member
+func ValidateGroup(group *clientpb.ImplantGroup) error {
+	if group.Name == "" {
+		return errors.New("group name required")
+	}
+	if len(group.Tags) == 0 && len(group.Subnets) == 0 && group.OS == "" && len(group.ImplantIDs) == 0 {
+		return errors.New("group needs a tag, subnet, os, or implant")
+	}
+	for _, values := range [][]string{group.Tags, group.Subnets, group.ImplantIDs} {
+		for _, value := range values {
+			if value == "" || strings.Contains(value, "\n") {
+				return fmt.Errorf("invalid value '%s'", value)
+			}
+		}
+	}
+	for _, subnet := range group.Subnets {
+		if _, err := parseSubnet(subnet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSubnet - A CIDR, or a single address
+func parseSubnet(subnet string) (*net.IPNet, error) {
+	if !strings.Contains(subnet, "/") {
+		ip := net.ParseIP(subnet)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid subnet '%s'", subnet)
+		}
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 8 * net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subnet '%s'", subnet)
+	}
+	return ipNet, nil
+}
+
+// SaveGroup - Validate and save a group, an existing group with the same name is replaced
+func SaveGroup(group *clientpb.ImplantGroup) (*clientpb.ImplantGroup, error) {
+	err := ValidateGroup(group)
+	if err != nil {
+		return nil, err
+	}
+	dbGroup, err := db.ImplantGroupByName(group.Name)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, err
+	}
+	if dbGroup == nil {
+		dbGroup = &models.ImplantGroup{Name: group.Name}
+	}
+	dbGroup.Tags = strings.Join(group.Tags, "\n")
+	dbGroup.Subnets = strings.Join(group.Subnets, "\n")
+	dbGroup.OS = strings.ToLower(group.OS)
+	dbGroup.ImplantIDs = strings.Join(group.ImplantIDs, "\n")
+	dbGroup.Operator = group.Operator
+	err = db.Session().Save(dbGroup).Error
+	if err != nil {
+		return nil, err
+	}
+	automationLog.Infof("Saved implant group %s", dbGroup.Name)
+	return dbGroup.ToProtobuf(), nil
+}
+
+// RemoveGroup - Remove a group by name
+func RemoveGroup(name string) error {
+	dbGroup, err := db.ImplantGroupByName(name)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return ErrGroupNotFound
+		}
+		return err
+	}
+	return db.Session().Delete(dbGroup).Error
+}
+
+// GroupMembers - The live sessions and the beacons that are members of a group
+func GroupMembers(name string) (*clientpb.ImplantGroupMembers, error) {
+	targets, err := groupTargets(name)
+	if err != nil {
+		return nil, err
+	}
+	members := &clientpb.ImplantGroupMembers{
+		Sessions: []*clientpb.Session{},
+		Beacons:  []*clientpb.Beacon{},
+	}
+	for _, target := range targets {
+		if target.IsBeacon {
+			beacon, err := db.BeaconByID(target.ID)
+			if err == nil && beacon != nil {
+				members.Beacons = append(members.Beacons, beacon.ToProtobuf())
+			}
+		} else if session := core.Sessions.Get(target.ID); session != nil {
+			members.Sessions = append(members.Sessions, session.ToProtobuf())
+		}
+	}
+	return members, nil
+}
+
+func groupTargets(name string) ([]*target, error) {
+	dbGroup, err := db.ImplantGroupByName(name)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, ErrGroupNotFound
+		}
+		return nil, err
+	}
+	group := dbGroup.ToProtobuf()
+	candidates := []*target{}
+	for _, session := range core.Sessions.All() {
+		candidates = append(candidates, sessionTarget(session))
+	}
+	beacons, err := db.ListBeacons()
+	if err != nil {
+		return nil, err
+	}
+	for _, beacon := range beacons {
+		candidates = append(candidates, beaconTarget(beacon))
+	}
+
+	hostTags := map[string][]string{}
+	targets := []*target{}
+	for _, candidate := range candidates {
+		if _, ok := hostTags[candidate.HostUUID]; !ok && 0 < len(group.Tags) {
+			hostTags[candidate.HostUUID] = []string{}
+			if host, err := db.HostByHostUUID(candidate.HostUUID); err == nil {
+				for _, tag := range host.Tags {
+					hostTags[candidate.HostUUID] = append(hostTags[candidate.HostUUID], tag.Name)
+				}
+			}
+		}
+		if isGroupMember(group, candidate, hostTags[candidate.HostUUID]) {
+			targets = append(targets, candidate)
+		}
+	}
+	return targets, nil
+}
+
+// isGroupMember - Explicit members always match, otherwise an implant must match every
+// filter the group has: its OS, any of its tags, and any of its subnets
+func isGroupMember(group *clientpb.ImplantGroup, target *target, tags []string) bool {
+	for _, id := range group.ImplantIDs {
+		if id == target.ID {
+			return true
+		}
+	}
+	if group.OS == "" && len(group.Tags) == 0 && len(group.Subnets) == 0 {
+		return false
+	}
+	if group.OS != "" && !strings.EqualFold(group.OS, target.OS) {
+		return false
+	}
+	if 0 < len(group.Tags) && !containsAny(tags, group.Tags) {
+		return false
+	}
+	if 0 < len(group.Subnets) && !inAnySubnet(target.RemoteAddress, group.Subnets) {
+		return false
+	}
+	return true
+}
+
+func containsAny(values []string, wanted []string) bool {
+	for _, value := range values {
+		for _, want := range wanted {
+			if value == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inAnySubnet - The remote address is usually host:port
+func inAnySubnet(address string, subnets []string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, subnet := range subnets {
+		ipNet, err := parseSubnet(subnet)
+		if err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// RunGroup - Run tasks against every member of a group and return the result of each
+// task on each member, members run in parallel and the tasks of a member run in order.
+// Output is saved as loot tagged with the group, beacon output once the task completes.
+func RunGroup(req *clientpb.GroupTaskReq) ([]*clientpb.GroupTaskResult, error) {
+	if defaultRunner == nil {
+		return nil, ErrNotStarted
+	}
+	tasks, err := ParseTasks(req.Tasks)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, errors.New("no tasks")
+	}
+	targets, err := groupTargets(req.Group)
+	if err != nil {
+		return nil, err
+	}
+	origin := &origin{kind: "group", name: req.Group}
+	publish("Automation %s tasked %d implant(s) with %s", origin, len(targets), strings.Join(req.Tasks, ", "))
+
+	results := make([][]*clientpb.GroupTaskResult, len(targets))
+	limit := make(chan struct{}, groupConcurrency)
+	wg := &sync.WaitGroup{}
+	for index, member := range targets {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(index int, member *target) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			results[index] = defaultRunner.runMember(origin, tasks, member)
+		}(index, member)
+	}
+	wg.Wait()
+
+	all := []*clientpb.GroupTaskResult{}
+	for _, memberResults := range results {
+		all = append(all, memberResults...)
+	}
+	return all, nil
+}
+
+// runMember - Run every task on one group member
+func (r *runner) runMember(origin *origin, tasks []*Task, target *target) []*clientpb.GroupTaskResult {
+	results := []*clientpb.GroupTaskResult{}
+	for _, task := range tasks {
+		result := &clientpb.GroupTaskResult{
+			ImplantID:   target.ID,
+			ImplantName: target.Name,
+			Hostname:    target.Hostname,
+			Beacon:      target.IsBeacon,
+			Task:        task.String(),
+		}
+		results = append(results, result)
+		resp, err := r.runTask(task, target)
+		if err != nil {
+			result.Err = err.Error()
+			continue
+		}
+		if resp == nil {
+			continue
+		}
+		if target.IsBeacon {
+			r.queued(&pendingResult{origin: origin, task: task, target: target, resp: resp})
+			result.TaskID = resp.GetResponse().GetTaskID()
+			continue
+		}
+		if execute, ok := resp.(*sliverpb.Execute); ok {
+			result.Output = string(append(append(execute.Stdout, '\n'), execute.Stderr...))
+		}
+		result.LootID, err = saveOutput(origin, task, target, resp)
+		if err != nil {
+			result.Err = err.Error()
+		}
+	}
+	return results
+}
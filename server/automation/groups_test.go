@@ -0,0 +1,68 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestValidateGroup(t *testing.T) {
+	group := &clientpb.ImplantGroup{Name: "corp", Subnets: []string{"10.0.0.0/8", "192.168.1.10"}}
+	if err := ValidateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	group.Subnets = []string{"10.0.0.0/33"}
+	if err := ValidateGroup(group); err == nil {
+		t.Fatal("Expected invalid subnet to be rejected")
+	}
+	if err := ValidateGroup(&clientpb.ImplantGroup{Name: "empty"}); err == nil {
+		t.Fatal("Expected group without filters to be rejected")
+	}
+}
+
+func TestIsGroupMember(t *testing.T) {
+	group := &clientpb.ImplantGroup{
+		Name:    "corp-windows",
+		OS:      "windows",
+		Tags:    []string{"dc", "sql"},
+		Subnets: []string{"10.10.0.0/16"},
+	}
+	dc := &target{ID: "a", OS: "windows", RemoteAddress: "10.10.1.5:49152"}
+	if !isGroupMember(group, dc, []string{"new", "dc"}) {
+		t.Fatal("Expected implant to be a member")
+	}
+	if isGroupMember(group, dc, []string{"new"}) {
+		t.Fatal("Expected tag filter to apply")
+	}
+	if isGroupMember(group, &target{ID: "b", OS: "windows", RemoteAddress: "10.11.1.5:49152"}, []string{"dc"}) {
+		t.Fatal("Expected subnet filter to apply")
+	}
+	if isGroupMember(group, &target{ID: "c", OS: "linux", RemoteAddress: "10.10.1.6:22"}, []string{"sql"}) {
+		t.Fatal("Expected os filter to apply")
+	}
+	group.ImplantIDs = []string{"c"}
+	if !isGroupMember(group, &target{ID: "c", OS: "linux"}, nil) {
+		t.Fatal("Expected explicit members to always match")
+	}
+	if isGroupMember(&clientpb.ImplantGroup{ImplantIDs: []string{"c"}}, dc, nil) {
+		t.Fatal("Expected a group of explicit members to only match its members")
+	}
+}
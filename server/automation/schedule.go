@@ -0,0 +1,219 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ScheduleEvery - Prefix of a recurring schedule with a fixed interval, e.g. "@every 1h"
+	ScheduleEvery = "@every"
+	// ScheduleAt - Prefix of a one time schedule, e.g. "@at 2023-09-20T14:00:00Z"
+	ScheduleAt = "@at"
+
+	// minInterval - Schedules are checked at most this often
+	minInterval = time.Minute
+	// maxCronSearch - A cron expression that never matches (e.g. Feb 30th) gives up after this long
+	maxCronSearch = 5 * 366 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidSchedule - The schedule is not a cron expression, @every, or @at
+	ErrInvalidSchedule = errors.New("invalid schedule, must be a cron expression, @every <duration>, or @at <time>")
+
+	cronDescriptors = map[string]string{
+		"@hourly":  "0 * * * *",
+		"@daily":   "0 0 * * *",
+		"@weekly":  "0 0 * * 0",
+		"@monthly": "0 0 1 * *",
+		"@yearly":  "0 0 1 1 *",
+	}
+)
+
+// Schedule - When a scheduled task runs
+type Schedule interface {
+	// Next - The first run after a time, or the zero time if the schedule will not run again
+	Next(after time.Time) time.Time
+}
+
+// ParseSchedule - Parse a five field cron expression (evaluated in UTC), a descriptor
+// such as @daily, "@every <duration>", or "@at <RFC 3339 time>"
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expr, ok := cronDescriptors[spec]; ok {
+		spec = expr
+	}
+	switch {
+	case strings.HasPrefix(spec, ScheduleEvery+" "):
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, ScheduleEvery)))
+		if err != nil {
+			return nil, err
+		}
+		if interval < minInterval {
+			return nil, fmt.Errorf("interval must be at least %s", minInterval)
+		}
+		return everySchedule(interval), nil
+	case strings.HasPrefix(spec, ScheduleAt+" "):
+		at, err := time.Parse(time.RFC3339, strings.TrimSpace(strings.TrimPrefix(spec, ScheduleAt)))
+		if err != nil {
+			return nil, err
+		}
+		return atSchedule(at), nil
+	}
+	return parseCron(spec)
+}
+
+// everySchedule - Runs at a fixed interval from the previous run
+type everySchedule time.Duration
+
+func (e everySchedule) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(e))
+}
+
+// atSchedule - Runs once
+type atSchedule time.Time
+
+func (a atSchedule) Next(after time.Time) time.Time {
+	if time.Time(a).After(after) {
+		return time.Time(a)
+	}
+	return time.Time{}
+}
+
+// cronSchedule - The minutes, hours, days, months, and weekdays a task runs on
+type cronSchedule struct {
+	minute map[int]bool
+	hour   map[int]bool
+	dom    map[int]bool
+	month  map[int]bool
+	dow    map[int]bool
+	anyDom bool
+	anyDow bool
+}
+
+func parseCron(spec string) (*cronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, ErrInvalidSchedule
+	}
+	bounds := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	values := []map[int]bool{}
+	for index, field := range fields {
+		value, err := parseCronField(field, bounds[index][0], bounds[index][1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", ErrInvalidSchedule, err)
+		}
+		values = append(values, value)
+	}
+	// Sunday is 0 or 7
+	if values[4][7] {
+		values[4][0] = true
+	}
+	return &cronSchedule{
+		minute: values[0],
+		hour:   values[1],
+		dom:    values[2],
+		month:  values[3],
+		dow:    values[4],
+		anyDom: fields[2] == "*",
+		anyDow: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField - Parse a comma separated list of values, ranges (a-b), and steps (*/n, a-b/n)
+func parseCronField(field string, min int, max int) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if rangePart, stepPart, ok := strings.Cut(part, "/"); ok {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step '%s'", part)
+			}
+			part = rangePart
+		}
+		start, end := min, max
+		if part != "*" {
+			startPart, endPart, isRange := strings.Cut(part, "-")
+			var err error
+			start, err = strconv.Atoi(startPart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value '%s'", part)
+			}
+			end = start
+			if isRange {
+				end, err = strconv.Atoi(endPart)
+				if err != nil {
+					return nil, fmt.Errorf("invalid range '%s'", part)
+				}
+			} else if step != 1 {
+				end = max
+			}
+		}
+		if start < min || max < end || end < start {
+			return nil, fmt.Errorf("'%s' is out of range %d-%d", part, min, max)
+		}
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+// matchesDay - Like cron, a day matches the day of the month or the day of the week
+// when both are restricted
+func (c *cronSchedule) matchesDay(t time.Time) bool {
+	dom := c.dom[t.Day()]
+	dow := c.dow[int(t.Weekday())]
+	if c.anyDom || c.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (c *cronSchedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	deadline := t.Add(maxCronSearch)
+	for t.Before(deadline) {
+		if !c.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.hour[t.Hour()] {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !c.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
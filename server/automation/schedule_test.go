@@ -0,0 +1,62 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	now := time.Date(2023, 9, 15, 10, 30, 0, 0, time.UTC) // Friday
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"*/15 * * * *", time.Date(2023, 9, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2023, 9, 18, 9, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2023, 9, 16, 0, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2023, 9, 16, 10, 30, 0, 0, time.UTC)},
+		{"0 0 1 1,6 *", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 13 * 7", time.Date(2023, 9, 17, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@every 1h", now.Add(time.Hour)},
+		{"@at 2023-09-15T12:00:00Z", time.Date(2023, 9, 15, 12, 0, 0, 0, time.UTC)},
+		{"@at 2023-09-15T09:00:00Z", time.Time{}},
+	}
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.spec)
+		if err != nil {
+			t.Fatalf("Failed to parse '%s': %s", test.spec, err)
+		}
+		if next := schedule.Next(now); !next.Equal(test.next) {
+			t.Fatalf("Expected '%s' to run next at %s, got %s", test.spec, test.next, next)
+		}
+	}
+
+	schedule, _ := ParseSchedule("0 0 30 2 *")
+	if !schedule.Next(now).IsZero() {
+		t.Fatal("Expected impossible cron expression to never run")
+	}
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "@every 10s", "@at tomorrow", "@sometimes"} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Fatalf("Expected '%s' to be rejected", spec)
+		}
+	}
+}
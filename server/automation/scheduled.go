@@ -0,0 +1,228 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+var (
+	// ErrScheduleNotFound - No scheduled task with the given name
+	ErrScheduleNotFound = errors.New("scheduled task not found")
+	// ErrImplantNotFound - No session or beacon with the given ID
+	ErrImplantNotFound = errors.New("session or beacon not found")
+	// ErrScheduleNeverRuns - A one time schedule in the past
+	ErrScheduleNeverRuns = errors.New("schedule will never run, is the time in the past?")
+
+	// scheduleTick - How often the server checks for scheduled tasks that are due
+	scheduleTick = 15 * time.Second
+)
+
+// ScheduledTasks - All scheduled tasks
+func ScheduledTasks() ([]*clientpb.ScheduledTask, error) {
+	dbScheduled, err := db.ScheduledTasks()
+	if err != nil {
+		return nil, err
+	}
+	scheduled := []*clientpb.ScheduledTask{}
+	for _, task := range dbScheduled {
+		scheduled = append(scheduled, task.ToProtobuf())
+	}
+	return scheduled, nil
+}
+
+// ValidateScheduledTask - Check a scheduled task's schedule and tasks
+func ValidateScheduledTask(scheduled *clientpb.ScheduledTask) error {
+	if scheduled.Name == "" {
+		return errors.New("scheduled task name required")
+	}
+	if scheduled.ImplantID == "" {
+		return errors.New("session or beacon required")
+	}
+	_, err := ParseSchedule(scheduled.Schedule)
+	if err != nil {
+		return err
+	}
+	for _, line := range scheduled.Tasks {
+		if strings.Contains(line, "\n") {
+			return errors.New("tasks cannot contain line breaks")
+		}
+	}
+	tasks, err := ParseTasks(scheduled.Tasks)
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return errors.New("scheduled task has no tasks")
+	}
+	return nil
+}
+
+// SaveScheduledTask - Validate and save a scheduled task, an existing scheduled task with
+// the same name is replaced but keeps its run history
+func SaveScheduledTask(scheduled *clientpb.ScheduledTask) (*clientpb.ScheduledTask, error) {
+	err := ValidateScheduledTask(scheduled)
+	if err != nil {
+		return nil, err
+	}
+	schedule, _ := ParseSchedule(scheduled.Schedule)
+	dbScheduled, err := db.ScheduledTaskByName(scheduled.Name)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, err
+	}
+	if dbScheduled == nil {
+		dbScheduled = &models.ScheduledTask{Name: scheduled.Name}
+	}
+	if dbScheduled.ImplantID != scheduled.ImplantID {
+		err = setImplant(dbScheduled, scheduled.ImplantID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	dbScheduled.Schedule = strings.TrimSpace(scheduled.Schedule)
+	dbScheduled.Tasks = strings.Join(scheduled.Tasks, "\n")
+	dbScheduled.Enabled = scheduled.Enabled
+	dbScheduled.Operator = scheduled.Operator
+	dbScheduled.NextRun = time.Time{}
+	if dbScheduled.Enabled {
+		dbScheduled.NextRun = schedule.Next(time.Now())
+		if dbScheduled.NextRun.IsZero() {
+			return nil, ErrScheduleNeverRuns
+		}
+	}
+	err = db.Session().Save(dbScheduled).Error
+	if err != nil {
+		return nil, err
+	}
+	automationLog.Infof("Saved scheduled task %s (%s)", dbScheduled.Name, dbScheduled.Schedule)
+	return dbScheduled.ToProtobuf(), nil
+}
+
+// setImplant - Point a scheduled task at a session or beacon
+func setImplant(scheduled *models.ScheduledTask, implantID string) error {
+	var target *target
+	if session := core.Sessions.Get(implantID); session != nil {
+		target = sessionTarget(session)
+	} else if beacon, err := db.BeaconByID(implantID); err == nil && beacon != nil {
+		target = beaconTarget(beacon)
+	} else {
+		return ErrImplantNotFound
+	}
+	scheduled.ImplantID = target.ID
+	scheduled.Beacon = target.IsBeacon
+	scheduled.ImplantName = target.Name
+	scheduled.Hostname = target.Hostname
+	scheduled.HostUUID = target.HostUUID
+	return nil
+}
+
+// RemoveScheduledTask - Remove a scheduled task by name
+func RemoveScheduledTask(name string) error {
+	dbScheduled, err := db.ScheduledTaskByName(name)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return ErrScheduleNotFound
+		}
+		return err
+	}
+	return db.Session().Delete(dbScheduled).Error
+}
+
+// schedules - Run scheduled tasks as they become due
+func (r *runner) schedules() {
+	ticker := time.NewTicker(scheduleTick)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		r.runDue(now)
+	}
+}
+
+// runDue - Start every scheduled task that is due and work out when it runs next, a
+// schedule that was missed while the server was down runs once and is not caught up
+func (r *runner) runDue(now time.Time) {
+	due, err := db.DueScheduledTasks(now)
+	if err != nil {
+		automationLog.Errorf("Failed to load scheduled tasks: %s", err)
+		return
+	}
+	for _, scheduled := range due {
+		schedule, err := ParseSchedule(scheduled.Schedule)
+		if err != nil {
+			automationLog.Errorf("Scheduled task %s is invalid: %s", scheduled.Name, err)
+			continue
+		}
+		tasks, err := ParseTasks(strings.Split(scheduled.Tasks, "\n"))
+		if err != nil {
+			automationLog.Errorf("Scheduled task %s is invalid: %s", scheduled.Name, err)
+			continue
+		}
+		target := scheduledTarget(scheduled)
+
+		scheduled.NextRun = schedule.Next(now)
+		if scheduled.NextRun.IsZero() {
+			scheduled.Enabled = false
+		}
+		if target != nil {
+			scheduled.LastRun = now
+			scheduled.Runs++
+		}
+		err = db.Session().Save(scheduled).Error
+		if err != nil {
+			automationLog.Errorf("Failed to update scheduled task %s: %s", scheduled.Name, err)
+			continue
+		}
+
+		origin := &origin{kind: "schedule", name: scheduled.Name}
+		if target == nil {
+			publish("Automation %s skipped, %s (%s) is not connected", origin, scheduled.Hostname, scheduled.ImplantName)
+			continue
+		}
+		go r.run(origin, tasks, target)
+	}
+}
+
+// scheduledTarget - The session or beacon of a scheduled task, sessions get a new ID when
+// an implant reconnects so the schedule moves to a live session of the same implant on
+// the same host
+func scheduledTarget(scheduled *models.ScheduledTask) *target {
+	if scheduled.Beacon {
+		beacon, err := db.BeaconByID(scheduled.ImplantID)
+		if err != nil || beacon == nil {
+			return nil
+		}
+		return beaconTarget(beacon)
+	}
+	if session := core.Sessions.Get(scheduled.ImplantID); session != nil {
+		return sessionTarget(session)
+	}
+	for _, session := range core.Sessions.All() {
+		if session.UUID == scheduled.HostUUID && session.Name == scheduled.ImplantName {
+			scheduled.ImplantID = session.ID
+			return sessionTarget(session)
+		}
+	}
+	return nil
+}
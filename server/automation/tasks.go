@@ -0,0 +1,162 @@
+package automation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+)
+
+const (
+	// TaskPs - List processes
+	TaskPs = "ps"
+	// TaskIfconfig - List network interfaces
+	TaskIfconfig = "ifconfig"
+	// TaskNetstat - List TCP/UDP connections and listeners
+	TaskNetstat = "netstat"
+	// TaskPwd - Print the working directory
+	TaskPwd = "pwd"
+	// TaskLs - List a directory, defaults to the working directory
+	TaskLs = "ls"
+	// TaskGetEnv - Get one or all environment variables
+	TaskGetEnv = "getenv"
+	// TaskScreenshot - Take a screenshot
+	TaskScreenshot = "screenshot"
+	// TaskExecute - Execute a program and capture its output
+	TaskExecute = "execute"
+	// TaskInterval - Change the reconnect interval of a session, or the interval and jitter of a beacon
+	TaskInterval = "interval"
+	// TaskTag - Tag the implant's host
+	TaskTag = "tag"
+	// TaskSleep - Wait before running the next task
+	TaskSleep = "sleep"
+)
+
+var (
+	// ErrEmptyTask - A task line without a command
+	ErrEmptyTask = errors.New("empty task")
+
+	// taskArgs - The minimum and maximum number of arguments of each task, -1 is unlimited
+	taskArgs = map[string][2]int{
+		TaskPs:         {0, 0},
+		TaskIfconfig:   {0, 0},
+		TaskNetstat:    {0, 0},
+		TaskPwd:        {0, 0},
+		TaskLs:         {0, 1},
+		TaskGetEnv:     {0, 1},
+		TaskScreenshot: {0, 0},
+		TaskExecute:    {1, -1},
+		TaskInterval:   {1, 2},
+		TaskTag:        {1, 1},
+		TaskSleep:      {1, 1},
+	}
+)
+
+// Task - A single parsed step of an automation rule
+type Task struct {
+	Name string
+	Args []string
+
+	// Interval and Jitter are set for interval tasks, Duration for sleep tasks
+	Interval time.Duration
+	Jitter   time.Duration
+	Duration time.Duration
+}
+
+// String - The task as it would be written in a rule
+func (t *Task) String() string {
+	return strings.TrimSpace(t.Name + " " + shellquote.Join(t.Args...))
+}
+
+// ParseTask - Parse and validate a task line, arguments may be quoted
+func ParseTask(line string) (*Task, error) {
+	words, err := shellquote.Split(line)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, ErrEmptyTask
+	}
+	task := &Task{Name: strings.ToLower(words[0]), Args: words[1:]}
+	limits, ok := taskArgs[task.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown task '%s'", words[0])
+	}
+	if len(task.Args) < limits[0] || (0 <= limits[1] && limits[1] < len(task.Args)) {
+		return nil, fmt.Errorf("wrong number of arguments for '%s'", task.Name)
+	}
+
+	switch task.Name {
+	case TaskInterval:
+		task.Interval, err = time.ParseDuration(task.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		if task.Interval < time.Second {
+			return nil, fmt.Errorf("interval must be at least 1s")
+		}
+		if len(task.Args) == 2 {
+			task.Jitter, err = time.ParseDuration(task.Args[1])
+			if err != nil {
+				return nil, err
+			}
+			if task.Jitter < 0 {
+				return nil, fmt.Errorf("jitter cannot be negative")
+			}
+		}
+	case TaskSleep:
+		task.Duration, err = time.ParseDuration(task.Args[0])
+		if err != nil {
+			seconds, convErr := strconv.Atoi(task.Args[0])
+			if convErr != nil {
+				return nil, err
+			}
+			task.Duration = time.Duration(seconds) * time.Second
+		}
+		if task.Duration < 0 {
+			return nil, fmt.Errorf("sleep cannot be negative")
+		}
+	case TaskTag:
+		if strings.TrimSpace(task.Args[0]) == "" {
+			return nil, fmt.Errorf("tag cannot be blank")
+		}
+	}
+	return task, nil
+}
+
+// ParseTasks - Parse every task line of a rule, blank lines are ignored
+func ParseTasks(lines []string) ([]*Task, error) {
+	tasks := []*Task{}
+	for index, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		task, err := ParseTask(line)
+		if err != nil {
+			return nil, fmt.Errorf("task %d: %s", index+1, err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
@@ -0,0 +1,350 @@
+package backup
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+)
+
+const (
+	// FormatVersion - Incremented whenever the archive layout changes
+	FormatVersion = 1
+
+	manifestName = "manifest.json"
+
+	// stagingPattern - Archives are extracted into a temporary dir in the app dir
+	stagingPattern = ".restore-*"
+
+	// DatabaseName - The sqlite database in the app dir
+	DatabaseName = "sliver.db"
+)
+
+var (
+	// Directories - App dir contents that make up the server's state, everything else
+	// (the go toolchain, logs, etc.) is re-created when the server is started
+	Directories = []string{
+		"configs",
+		"certs",
+		"loot",
+		"builds",
+		"web",
+		"armory",
+		"traffic-encoders",
+		"recordings",
+	}
+
+	// Files - Individual files in the app dir, the at-rest encryption salt and check
+	// value are needed to decrypt anything that was encrypted at rest
+	Files = []string{
+		"at-rest.salt",
+		"at-rest.check",
+	}
+
+	// ErrInvalidArchive - The archive is corrupt, wasn't created by this package,
+	// or the passphrase is wrong
+	ErrInvalidArchive = errors.New("invalid backup archive")
+	// ErrUnsupportedVersion - The archive was created by a newer server
+	ErrUnsupportedVersion = errors.New("unsupported backup format version")
+)
+
+// Manifest - Describes the contents of an archive, Files and Size are the totals
+// written (or extracted) and aren't stored in the archive
+type Manifest struct {
+	FormatVersion int       `json:"format_version"`
+	ServerVersion string    `json:"server_version"`
+	Hostname      string    `json:"hostname"`
+	CreatedAt     time.Time `json:"created_at"`
+	Dialect       string    `json:"dialect"`
+	Database      bool      `json:"database"`
+
+	Files int   `json:"-"`
+	Size  int64 `json:"-"`
+}
+
+// Create - Write an archive of the app dir encrypted with the passphrase, the database
+// is copied from databasePath (a consistent snapshot of the live database) unless blank
+func Create(w io.Writer, passphrase string, appDir string, databasePath string, manifest *Manifest) error {
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return err
+	}
+	return CreateFor(w, recipient, appDir, databasePath, manifest)
+}
+
+// CreateFor - Write an archive of the app dir encrypted to an age recipient
+func CreateFor(w io.Writer, recipient age.Recipient, appDir string, databasePath string, manifest *Manifest) error {
+	encrypted, err := age.Encrypt(w, recipient)
+	if err != nil {
+		return err
+	}
+	gzipWriter := gzip.NewWriter(encrypted)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	// The manifest is always the first entry so restore can check the
+	// version before extracting anything
+	manifest.FormatVersion = FormatVersion
+	manifest.Database = databasePath != ""
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name:    manifestName,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: manifest.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = tarWriter.Write(data); err != nil {
+		return err
+	}
+
+	archive := &archiveWriter{tar: tarWriter}
+	if databasePath != "" {
+		err = archive.addFile(databasePath, DatabaseName)
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range Files {
+		err = archive.addFile(filepath.Join(appDir, name), name)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	for _, name := range Directories {
+		err = archive.addDir(filepath.Join(appDir, name), name)
+		if err != nil {
+			return err
+		}
+	}
+	manifest.Files = archive.files
+	manifest.Size = archive.size
+
+	if err = tarWriter.Close(); err != nil {
+		return err
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return err
+	}
+	return encrypted.Close()
+}
+
+type archiveWriter struct {
+	tar   *tar.Writer
+	files int
+	size  int64
+}
+
+func (a *archiveWriter) addFile(filePath string, name string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	err = a.tar.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	})
+	if err != nil {
+		return err
+	}
+	written, err := io.Copy(a.tar, file)
+	if err != nil {
+		return err
+	}
+	a.files++
+	a.size += written
+	return nil
+}
+
+// addDir - Add the regular files in a directory, symlinks and other special
+// files are skipped, a missing directory is not an error
+func (a *archiveWriter) addDir(dirPath string, name string) error {
+	err := filepath.WalkDir(dirPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dirPath, filePath)
+		if err != nil {
+			return err
+		}
+		return a.addFile(filePath, path.Join(name, filepath.ToSlash(relPath)))
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Restore - Decrypt an archive and extract it into the app dir, existing files are
+// overwritten. Returns the archive's manifest. The app dir is only changed once the
+// whole archive has been read (and authenticated), a truncated or tampered archive
+// leaves it as is.
+func Restore(r io.Reader, passphrase string, appDir string) (*Manifest, error) {
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return RestoreWith(r, identity, appDir)
+}
+
+// RestoreWith - Restore an archive encrypted to the identity's recipient
+func RestoreWith(r io.Reader, identity age.Identity, appDir string) (*Manifest, error) {
+	decrypted, err := age.Decrypt(r, identity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+	}
+	gzipReader, err := gzip.NewReader(decrypted)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if err != nil || header.Name != manifestName {
+		return nil, fmt.Errorf("%w: missing manifest", ErrInvalidArchive)
+	}
+	manifest := &Manifest{}
+	err = json.NewDecoder(tarReader).Decode(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+	}
+	if FormatVersion < manifest.FormatVersion {
+		return manifest, ErrUnsupportedVersion
+	}
+
+	// age authenticates the archive a chunk at a time as it's read, so everything is
+	// extracted into a staging dir (on the same file system, so files can be renamed
+	// into place) and only swapped in after the end of the archive
+	staging, err := os.MkdirTemp(appDir, stagingPattern)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	names := []string{}
+	extracted := map[string]bool{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if !isAllowed(header.Name) {
+			return nil, fmt.Errorf("%w: unexpected file '%s'", ErrInvalidArchive, header.Name)
+		}
+		written, err := extractFile(tarReader, filepath.Join(staging, filepath.FromSlash(header.Name)))
+		if err != nil {
+			return nil, err
+		}
+		if !extracted[header.Name] {
+			extracted[header.Name] = true
+			names = append(names, header.Name)
+		}
+		manifest.Files++
+		manifest.Size += written
+	}
+
+	for _, name := range names {
+		filePath := filepath.Join(appDir, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(filePath), 0700)
+		if err != nil {
+			return nil, err
+		}
+		err = os.Rename(filepath.Join(staging, filepath.FromSlash(name)), filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		// The write-ahead log of the old database would corrupt the restored one
+		if name == DatabaseName {
+			os.Remove(filepath.Join(appDir, DatabaseName+"-wal"))
+			os.Remove(filepath.Join(appDir, DatabaseName+"-shm"))
+		}
+	}
+	return manifest, nil
+}
+
+// isAllowed - Only the files and directories that are backed up can be restored, which
+// also rejects absolute paths and path traversal
+func isAllowed(name string) bool {
+	if name != path.Clean(name) || path.IsAbs(name) || strings.HasPrefix(name, "..") {
+		return false
+	}
+	if name == DatabaseName {
+		return true
+	}
+	for _, file := range Files {
+		if name == file {
+			return true
+		}
+	}
+	for _, dir := range Directories {
+		if strings.HasPrefix(name, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func extractFile(r io.Reader, filePath string) (int64, error) {
+	err := os.MkdirAll(filepath.Dir(filePath), 0700)
+	if err != nil {
+		return 0, err
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+	written, err := io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return written, err
+}
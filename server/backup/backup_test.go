@@ -0,0 +1,151 @@
+package backup
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestFile(t *testing.T, filePath string, data string) {
+	err := os.MkdirAll(filepath.Dir(filePath), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filePath, []byte(data), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	appDir := t.TempDir()
+	writeTestFile(t, filepath.Join(appDir, "configs", "server.json"), `{"daemon_mode": false}`)
+	writeTestFile(t, filepath.Join(appDir, "loot", "3c1e2b", "hashes.txt"), "hashes")
+	writeTestFile(t, filepath.Join(appDir, "at-rest.salt"), "salt")
+	writeTestFile(t, filepath.Join(appDir, "logs", "console.log"), "not backed up")
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	writeTestFile(t, snapshot, "database")
+
+	archive := &bytes.Buffer{}
+	manifest := &Manifest{Hostname: "test", CreatedAt: time.Now(), Dialect: "sqlite3"}
+	err := Create(archive, "correct horse", appDir, snapshot, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Files != 4 || !manifest.Database {
+		t.Errorf("unexpected manifest %+v", manifest)
+	}
+
+	_, err = Restore(bytes.NewReader(archive.Bytes()), "battery staple", t.TempDir())
+	if !errors.Is(err, ErrInvalidArchive) {
+		t.Fatalf("expected %v with the wrong passphrase, got %v", ErrInvalidArchive, err)
+	}
+
+	restoreDir := t.TempDir()
+	writeTestFile(t, filepath.Join(restoreDir, DatabaseName+"-wal"), "stale")
+	restored, err := Restore(bytes.NewReader(archive.Bytes()), "correct horse", restoreDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Hostname != "test" || restored.Files != 4 {
+		t.Errorf("unexpected manifest %+v", restored)
+	}
+	for name, expected := range map[string]string{
+		DatabaseName:             "database",
+		"configs/server.json":    `{"daemon_mode": false}`,
+		"loot/3c1e2b/hashes.txt": "hashes",
+		"at-rest.salt":           "salt",
+	} {
+		data, err := os.ReadFile(filepath.Join(restoreDir, filepath.FromSlash(name)))
+		if err != nil || string(data) != expected {
+			t.Errorf("%s: expected %q, got %q (%v)", name, expected, data, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(restoreDir, "logs")); !os.IsNotExist(err) {
+		t.Error("logs should not be backed up")
+	}
+	if _, err := os.Stat(filepath.Join(restoreDir, DatabaseName+"-wal")); !os.IsNotExist(err) {
+		t.Error("stale write-ahead log should be removed")
+	}
+}
+
+func TestIsAllowed(t *testing.T) {
+	for name, allowed := range map[string]bool{
+		"sliver.db":                true,
+		"configs/server.json":      true,
+		"loot/a/b.txt":             true,
+		"configs/../../etc/passwd": false,
+		"/etc/passwd":              false,
+		"../sliver.db":             false,
+		"go/bin/go":                false,
+		"configs":                  false,
+	} {
+		if isAllowed(name) != allowed {
+			t.Errorf("%s: expected %v", name, allowed)
+		}
+	}
+}
+
+func TestRestoreTruncated(t *testing.T) {
+	appDir := t.TempDir()
+	writeTestFile(t, filepath.Join(appDir, "configs", "server.json"), "new config")
+	// Random data doesn't compress, so the archive is many age chunks long
+	random := make([]byte, 256*1024)
+	rand.Read(random)
+	writeTestFile(t, filepath.Join(appDir, "loot", "3c1e2b", "random.bin"), string(random))
+	snapshot := filepath.Join(t.TempDir(), "snapshot.db")
+	writeTestFile(t, snapshot, "new database")
+
+	archive := &bytes.Buffer{}
+	err := Create(archive, "correct horse", appDir, snapshot, &Manifest{Hostname: "test", CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restoreDir := t.TempDir()
+	writeTestFile(t, filepath.Join(restoreDir, DatabaseName), "old database")
+	writeTestFile(t, filepath.Join(restoreDir, "configs", "server.json"), "old config")
+	truncated := archive.Bytes()[:archive.Len()-1024]
+	_, err = Restore(bytes.NewReader(truncated), "correct horse", restoreDir)
+	if err == nil {
+		t.Fatal("expected truncated archive to fail")
+	}
+	for name, expected := range map[string]string{
+		DatabaseName:          "old database",
+		"configs/server.json": "old config",
+	} {
+		data, err := os.ReadFile(filepath.Join(restoreDir, filepath.FromSlash(name)))
+		if err != nil || string(data) != expected {
+			t.Errorf("%s: expected %q, got %q (%v)", name, expected, data, err)
+		}
+	}
+	entries, err := os.ReadDir(restoreDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("expected the app dir to be unchanged, got %v", entries)
+	}
+}
@@ -0,0 +1,144 @@
+package bloodhound
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func testZip(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for name, content := range files {
+		entry, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry.Write([]byte(content))
+	}
+	writer.Close()
+	return buf.Bytes()
+}
+
+func TestParse(t *testing.T) {
+	data := testZip(t, map[string]string{
+		"20230101_computers.json": "\xef\xbb\xbf" + `{"data":[{},{}],"meta":{"type":"computers","count":2,"version":5}}`,
+		"20230101_users.json":     `{"data":[{}],"meta":{"type":"Users","version":5}}`,
+		"readme.txt":              "not bloodhound data",
+		"other.json":              `{"foo":"bar"}`,
+	})
+	files, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(files))
+	}
+	for _, file := range files {
+		switch file.Type {
+		case "computers":
+			if file.Count != 2 || file.Version != 5 {
+				t.Errorf("unexpected computers file %+v", file)
+			}
+		case "users":
+			if file.Count != 1 {
+				t.Errorf("expected the count of the data, got %d", file.Count)
+			}
+		default:
+			t.Errorf("unexpected type %s", file.Type)
+		}
+	}
+
+	_, err = Parse(testZip(t, map[string]string{"readme.txt": "foo"}))
+	if err != ErrNoData {
+		t.Errorf("expected %s, got %v", ErrNoData, err)
+	}
+	_, err = Parse([]byte("not a zip"))
+	if err == nil {
+		t.Error("expected an error for invalid zip data")
+	}
+
+	defer func(size uint64) { maxTotalSize = size }(maxTotalSize)
+	maxTotalSize = uint64(len(`{"data":[{}],"meta":{"type":"Users","version":5}}`))
+	if _, err = Parse(data); err != ErrTooLarge {
+		t.Errorf("expected %s, got %v", ErrTooLarge, err)
+	}
+}
+
+func TestSign(t *testing.T) {
+	sig1 := Sign("key", "POST", "/api/v2/file-upload/start", "2023-01-01T10:00:00Z", nil)
+	sig2 := Sign("key", "POST", "/api/v2/file-upload/start", "2023-01-01T10:59:59Z", nil)
+	if sig1 != sig2 {
+		t.Error("expected the same signature within the hour")
+	}
+	sig3 := Sign("key", "POST", "/api/v2/file-upload/start", "2023-01-01T11:00:00Z", nil)
+	if sig1 == sig3 {
+		t.Error("expected a different signature in the next hour")
+	}
+	if sig1 == Sign("key", "POST", "/api/v2/file-upload/start", "2023-01-01T10:00:00Z", []byte("{}")) {
+		t.Error("expected the body to be signed")
+	}
+}
+
+func TestUpload(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		if r.Header.Get("Authorization") != "bhesignature id" || r.Header.Get("Signature") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"data":{"id":42}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&configs.BloodHoundConfig{URL: server.URL + "/", TokenID: "id", TokenKey: "key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.now = func() time.Time { return time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC) }
+	jobID, err := client.Upload([]*File{{Name: "computers.json", Data: []byte("{}")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobID != 42 {
+		t.Errorf("expected job 42, got %d", jobID)
+	}
+	expected := []string{"/api/v2/file-upload/start", "/api/v2/file-upload/42", "/api/v2/file-upload/42/end"}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, requests)
+	}
+	for index := range expected {
+		if requests[index] != expected[index] {
+			t.Errorf("expected %s, got %s", expected[index], requests[index])
+		}
+	}
+
+	_, err = NewClient(&configs.BloodHoundConfig{URL: server.URL})
+	if err == nil {
+		t.Error("expected an error without a token")
+	}
+}
@@ -0,0 +1,137 @@
+package bloodhound
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	uploadTimeout = 10 * time.Minute
+)
+
+// Client - A BloodHound Community Edition API client, requests are signed
+// with an API token (id and key) created in the BloodHound UI
+type Client struct {
+	config *configs.BloodHoundConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewClient - Create a client for the configured BloodHound instance
+func NewClient(config *configs.BloodHoundConfig) (*Client, error) {
+	if config == nil || config.URL == "" {
+		return nil, fmt.Errorf("bloodhound is not configured")
+	}
+	if config.TokenID == "" || config.TokenKey == "" {
+		return nil, fmt.Errorf("bloodhound token_id and token_key are required")
+	}
+	return &Client{
+		config: config,
+		client: &http.Client{
+			Timeout: uploadTimeout,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.InsecureSkipVerify,
+				},
+			},
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Upload - Upload data files as a single file upload job, returns the job's id
+func (c *Client) Upload(files []*File) (int64, error) {
+	resp, err := c.request(http.MethodPost, "/api/v2/file-upload/start", nil)
+	if err != nil {
+		return 0, err
+	}
+	job := struct {
+		Data struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+	}{}
+	err = json.Unmarshal(resp, &job)
+	if err != nil {
+		return 0, err
+	}
+	for _, file := range files {
+		_, err = c.request(http.MethodPost, fmt.Sprintf("/api/v2/file-upload/%d", job.Data.ID), file.Data)
+		if err != nil {
+			return job.Data.ID, fmt.Errorf("%s: %s", file.Name, err)
+		}
+	}
+	_, err = c.request(http.MethodPost, fmt.Sprintf("/api/v2/file-upload/%d/end", job.Data.ID), nil)
+	return job.Data.ID, err
+}
+
+func (c *Client) request(method string, uri string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, strings.TrimSuffix(c.config.URL, "/")+uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	requestDate := c.now().Format(time.RFC3339)
+	req.Header.Set("Authorization", "bhesignature "+c.config.TokenID)
+	req.Header.Set("RequestDate", requestDate)
+	req.Header.Set("Signature", Sign(c.config.TokenKey, method, uri, requestDate, body))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		return nil, fmt.Errorf("server returned %s", resp.Status)
+	}
+	return data, nil
+}
+
+// Sign - The request signature, an HMAC chain of the method and uri, the request date
+// truncated to the hour, and the body keyed with the token key
+func Sign(tokenKey string, method string, uri string, requestDate string, body []byte) string {
+	digester := hmac.New(sha256.New, []byte(tokenKey))
+	digester.Write([]byte(method + uri))
+	digester = hmac.New(sha256.New, digester.Sum(nil))
+	if 13 < len(requestDate) {
+		requestDate = requestDate[:13]
+	}
+	digester.Write([]byte(requestDate))
+	digester = hmac.New(sha256.New, digester.Sum(nil))
+	digester.Write(body)
+	return base64.StdEncoding.EncodeToString(digester.Sum(nil))
+}
@@ -0,0 +1,141 @@
+package bloodhound
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+const (
+	// maxFileSize - Largest file read from a collection zip, domains with a few hundred
+	// thousand objects produce files of a few hundred megabytes
+	maxFileSize = 1024 * 1024 * 1024
+)
+
+var (
+	// maxTotalSize - Largest total size of the files read from a collection zip, they're
+	// all held in memory until they're uploaded
+	maxTotalSize uint64 = 2 * 1024 * 1024 * 1024
+
+	// ErrNoData - The zip doesn't contain any BloodHound data files
+	ErrNoData = errors.New("no bloodhound data files found")
+	// ErrTooLarge - The zip's data files are too large once decompressed
+	ErrTooLarge = errors.New("bloodhound data files are too large")
+
+	utf8BOM = []byte{0xef, 0xbb, 0xbf}
+)
+
+// File - A BloodHound data file (e.g. 20230101_computers.json) from a collection zip
+type File struct {
+	Name    string
+	Type    string // computers, users, groups, domains, gpos, ous, containers, etc.
+	Count   int
+	Version int
+	Data    []byte
+}
+
+type dataFile struct {
+	Data []json.RawMessage `json:"data"`
+	Meta struct {
+		Type    string `json:"type"`
+		Count   int    `json:"count"`
+		Version int    `json:"version"`
+	} `json:"meta"`
+}
+
+// Parse - Read and validate the data files of a SharpHound (or compatible collector)
+// zip, files that aren't BloodHound data are skipped
+func Parse(zipData []byte) ([]*File, error) {
+	reader, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
+	if err != nil {
+		return nil, err
+	}
+	entries := []*zip.File{}
+	total := uint64(0)
+	for _, entry := range reader.File {
+		if entry.FileInfo().IsDir() || !strings.EqualFold(path.Ext(entry.Name), ".json") {
+			continue
+		}
+		if maxFileSize < entry.UncompressedSize64 {
+			return nil, fmt.Errorf("%s is too large", entry.Name)
+		}
+		// The zip reader refuses to read more than an entry's declared size
+		total += entry.UncompressedSize64
+		if maxTotalSize < total {
+			return nil, ErrTooLarge
+		}
+		entries = append(entries, entry)
+	}
+	files := []*File{}
+	for _, entry := range entries {
+		data, err := readEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", entry.Name, err)
+		}
+		file, err := ParseFile(path.Base(entry.Name), data)
+		if err != nil {
+			continue
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, ErrNoData
+	}
+	return files, nil
+}
+
+// ParseFile - Validate a single BloodHound data file
+func ParseFile(name string, data []byte) (*File, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	parsed := &dataFile{}
+	err := json.Unmarshal(data, parsed)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Meta.Type == "" {
+		return nil, fmt.Errorf("%s is not a bloodhound data file", name)
+	}
+	count := parsed.Meta.Count
+	if count == 0 {
+		count = len(parsed.Data)
+	}
+	return &File{
+		Name:    name,
+		Type:    strings.ToLower(parsed.Meta.Type),
+		Count:   count,
+		Version: parsed.Meta.Version,
+		Data:    data,
+	}, nil
+}
+
+func readEntry(entry *zip.File) ([]byte, error) {
+	file, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(io.LimitReader(file, maxFileSize))
+}
@@ -220,6 +220,8 @@ func handleBuildEvent(externalBuilder *clientpb.Builder, event *clientpb.Event,
 	_, err = rpc.GenerateExternalSaveBuild(context.Background(), &clientpb.ExternalImplantBinary{
 		Name:            extConfig.Config.Name,
 		ImplantConfigID: extConfig.Config.ID,
+		BuilderName:     externalBuilder.Name,
+		Toolchain:       generate.ServerToolchain(),
 		File: &commonpb.File{
 			Name: fileName,
 			Data: data,
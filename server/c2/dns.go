@@ -57,6 +57,7 @@ import (
 	sliverHandlers "github.com/bishopfox/sliver/server/handlers"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/bishopfox/sliver/util/encoders"
+	"github.com/gofrs/uuid"
 	"github.com/miekg/dns"
 	"google.golang.org/protobuf/proto"
 )
@@ -79,8 +80,11 @@ var (
 )
 
 // StartDNSListener - Start a DNS listener
-func StartDNSListener(bindIface string, lport uint16, domains []string, canaries bool, enforceOTP bool) *SliverDNSServer {
+func StartDNSListener(bindIface string, lport uint16, domains []string, canaries bool, enforceOTP bool, replayWindow time.Duration) *SliverDNSServer {
 	// StartPivotListener()
+	if replayWindow == 0 {
+		replayWindow = cryptography.DefaultReplayWindow
+	}
 	server := &SliverDNSServer{
 		server:       &dns.Server{Addr: fmt.Sprintf("%s:%d", bindIface, lport), Net: "udp"},
 		sessions:     &sync.Map{}, // DNS Session ID -> DNSSession
@@ -88,6 +92,7 @@ func StartDNSListener(bindIface string, lport uint16, domains []string, canaries
 		TTL:          0,
 		MaxTXTLength: defaultMaxTXTLength,
 		EnforceOTP:   enforceOTP,
+		ReplayWindow: replayWindow,
 	}
 	dnsLog.Infof("Starting DNS listener for %v (canaries: %v) ...", domains, canaries)
 	dns.HandleFunc(".", func(writer dns.ResponseWriter, req *dns.Msg) {
@@ -100,9 +105,10 @@ func StartDNSListener(bindIface string, lport uint16, domains []string, canaries
 
 // DNSSession - Holds DNS session information
 type DNSSession struct {
-	ID          uint32
-	ImplantConn *core.ImplantConnection
-	CipherCtx   *cryptography.CipherContext
+	ID             uint32
+	ImplantConn    *core.ImplantConnection
+	CipherCtx      *cryptography.CipherContext
+	ImplantBuildID uuid.UUID
 
 	dnsIdMsgIdMap   map[uint32]uint32
 	outgoingMsgIDs  []uint32
@@ -126,7 +132,7 @@ func (s *DNSSession) nextMsgID() uint32 {
 // StageOutgoingEnvelope - Stage an outgoing envelope
 func (s *DNSSession) StageOutgoingEnvelope(envelope *sliverpb.Envelope) error {
 	dnsLog.Debugf("Staging outgoing envelope %v", envelope)
-	plaintext, err := proto.Marshal(envelope)
+	plaintext, err := proto.Marshal(s.ImplantConn.CompressEnvelope(envelope))
 	if err != nil {
 		dnsLog.Errorf("[dns] failed to marshal outgoing message %s", err)
 		return err
@@ -231,8 +237,14 @@ func (s *DNSSession) ForwardCompletedEnvelope(msgID uint32, pending *PendingEnve
 		dnsLog.Errorf("Failed to unmarshal message %d: %s", msgID, err)
 		return
 	}
+	err = core.DecompressEnvelope(envelope)
+	if err != nil {
+		dnsLog.Errorf("Failed to decompress message %d: %s", msgID, err)
+		return
+	}
 
 	s.ImplantConn.UpdateLastMessage()
+	core.Capture.Record(s.ImplantConn, core.CaptureRecv, envelope)
 	handlers := sliverHandlers.GetHandlers()
 	if envelope.ID != 0 {
 		s.ImplantConn.RespMutex.RLock()
@@ -318,6 +330,8 @@ type SliverDNSServer struct {
 	TTL          uint32
 	MaxTXTLength int
 	EnforceOTP   bool
+	ReplayWindow time.Duration // Negative disables key exchange timestamp checks
+	Drain        *core.Drain
 }
 
 // Shutdown - Shutdown the DNS server
@@ -391,11 +405,15 @@ func (s *SliverDNSServer) handleC2(domain string, req *dns.Msg) *dns.Msg {
 	}
 
 	// All other handlers require a valid dns session ID
-	_, ok := s.sessions.Load(msg.ID & sessionIDBitMask)
+	loadSession, ok := s.sessions.Load(msg.ID & sessionIDBitMask)
 	if !ok {
 		dnsLog.Warnf("[dns] session not found for id %v (%v)", msg.ID, msg.ID&sessionIDBitMask)
 		return s.nameErrorResp(req)
 	}
+	if name, burned := core.BurnList.BuildName(loadSession.(*DNSSession).ImplantBuildID); burned {
+		core.BurnedContact(name, "dns", "n/a")
+		return s.refusedErrorResp(req)
+	}
 
 	// Msg Type -> Handler
 	switch msg.Type {
@@ -518,9 +536,13 @@ func (s *SliverDNSServer) handleDNSSessionInit(domain string, msg *dnspb.DNSMess
 		dnsLog.Errorf("[session init] error implant public key not found")
 		return s.refusedErrorResp(req)
 	}
+	if name, burned := core.BurnList.BuildName(implantConfig.ImplantBuildID); burned {
+		core.BurnedContact(name, "dns", "n/a")
+		return s.refusedErrorResp(req)
+	}
 
 	serverKeyPair := cryptography.AgeServerKeyPair()
-	sessionInit, err := cryptography.AgeKeyExFromImplant(serverKeyPair.Private, implantConfig.PeerPrivateKey, msg.Data[32:])
+	sessionInit, kemSharedKey, err := cryptography.KeyExFromImplant(implantConfig.KeyExchange, serverKeyPair.Private, implantConfig.PeerPrivateKey, msg.Data[32:], s.ReplayWindow)
 	if err != nil {
 		dnsLog.Errorf("[session init] error decrypting session init data: %s", err)
 		return s.refusedErrorResp(req)
@@ -532,14 +554,18 @@ func (s *SliverDNSServer) handleDNSSessionInit(domain string, msg *dnspb.DNSMess
 	}
 
 	dnsSession.ImplantConn = core.NewImplantConnection("dns", "n/a")
+	dnsSession.ImplantConn.Drain = s.Drain
+	dnsSession.ImplantConn.ReplayWindow = s.ReplayWindow
+	dnsSession.ImplantBuildID = implantConfig.ImplantBuildID
 	go func() {
 		dnsLog.Debugf("[dns] starting implant conn send loop")
 		for envelope := range dnsSession.ImplantConn.Send {
+			core.Capture.Record(dnsSession.ImplantConn, core.CaptureSend, envelope)
 			dnsSession.StageOutgoingEnvelope(envelope)
 		}
 		dnsLog.Debugf("[dns] closing implant conn send loop")
 	}()
-	dnsSession.CipherCtx = cryptography.NewCipherContext(sessionKey)
+	dnsSession.CipherCtx = cryptography.NewCipherContext(cryptography.SessionKey(sessionKey, kemSharedKey))
 	buf := make([]byte, 4)
 	binary.LittleEndian.PutUint32(buf, dnsSession.ID)
 	respData, err := dnsSession.CipherCtx.Encrypt(buf)
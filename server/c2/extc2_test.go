@@ -0,0 +1,78 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestExternalC2Options(t *testing.T) {
+	buf := &bytes.Buffer{}
+	for _, option := range []string{"arch=x64", "pipename=foo", "block=100", "go"} {
+		WriteFrame(buf, []byte(option))
+	}
+	options, err := ReadExternalC2Options(buf)
+	if err != nil {
+		t.Fatalf("failed to read options: %s", err)
+	}
+	if options["arch"] != "x64" || options["pipename"] != "foo" || options["block"] != "100" {
+		t.Errorf("unexpected options %v", options)
+	}
+}
+
+func TestExternalC2FrameTooLarge(t *testing.T) {
+	buf := bytes.NewBuffer([]byte{0xff, 0xff, 0xff, 0xff})
+	_, err := ReadFrame(buf)
+	if err != ErrFrameTooLarge {
+		t.Errorf("expected %s, got %v", ErrFrameTooLarge, err)
+	}
+}
+
+func TestExternalC2Conn(t *testing.T) {
+	controller, listener := net.Pipe()
+	frames := newExternalC2Conn(listener)
+	defer frames.Close()
+
+	// Every frame is answered with one frame, empty if there's nothing to send
+	err := WriteFrame(controller, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 4)
+	if _, err = io.ReadFull(frames, data); err != nil || string(data) != "ping" {
+		t.Fatalf("expected ping, got %q (%v)", data, err)
+	}
+	controller.SetReadDeadline(time.Now().Add(time.Second))
+	reply, err := ReadFrame(controller)
+	if err != nil || len(reply) != 0 {
+		t.Fatalf("expected empty frame, got %q (%v)", reply, err)
+	}
+
+	frames.Write([]byte("pong"))
+	WriteFrame(controller, []byte{})
+	controller.SetReadDeadline(time.Now().Add(time.Second))
+	reply, err = ReadFrame(controller)
+	if err != nil || string(reply) != "pong" {
+		t.Fatalf("expected pong, got %q (%v)", reply, err)
+	}
+}
@@ -0,0 +1,122 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/dnspb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/encoders"
+	utilEncoders "github.com/bishopfox/sliver/util/encoders"
+	"google.golang.org/protobuf/proto"
+)
+
+// lengthPrefixed - Frame data like the mtls and wireguard transports
+func lengthPrefixed(data []byte) []byte {
+	frame := binary.LittleEndian.AppendUint32(nil, uint32(len(data)))
+	return append(frame, data...)
+}
+
+// fuzzReadEnvelope - Feed the fuzzer's data to an envelope reader over a connection
+func fuzzReadEnvelope(f *testing.F, readEnvelope func(net.Conn) (*sliverpb.Envelope, error)) {
+	envelope, _ := proto.Marshal(&sliverpb.Envelope{ID: 1, Type: sliverpb.MsgPing, Data: []byte("ping")})
+	compressed, _ := proto.Marshal(&sliverpb.Envelope{ID: 2, Type: sliverpb.MsgPing, Data: []byte("ping"), Compressed: true})
+	f.Add(lengthPrefixed(envelope))
+	f.Add(lengthPrefixed(compressed))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		server, client := net.Pipe()
+		go func() {
+			client.Write(data)
+			client.Close()
+		}()
+		readEnvelope(server)
+		server.Close() // Unblocks the write if the reader gave up early
+	})
+}
+
+func FuzzMTLSReadEnvelope(f *testing.F) {
+	fuzzReadEnvelope(f, func(conn net.Conn) (*sliverpb.Envelope, error) {
+		return socketReadEnvelope(conn, nil)
+	})
+}
+
+func FuzzWGReadEnvelope(f *testing.F) {
+	fuzzReadEnvelope(f, socketWGReadEnvelope)
+}
+
+func FuzzDNSDecodeSubdata(f *testing.F) {
+	msg, _ := proto.Marshal(&dnspb.DNSMessage{ID: 1, Type: dnspb.DNSMessageType_NOP, Size: 4, Data: []byte("test")})
+	for _, encoder := range []utilEncoders.Encoder{utilEncoders.Base32{}, utilEncoders.Base58{}} {
+		subdata, _ := encoder.Encode(msg)
+		f.Add(string(subdata))
+	}
+	f.Add("a.b.c")
+	server := &SliverDNSServer{}
+	f.Fuzz(func(t *testing.T, subdomain string) {
+		server.decodeSubdata(subdomain)
+	})
+}
+
+func FuzzDNSPendingEnvelope(f *testing.F) {
+	f.Add(uint32(8), uint32(0), []byte("abcd"), uint32(4), []byte("efgh"))
+	f.Add(uint32(4), uint32(2), []byte("abcd"), uint32(0), []byte("ab"))
+	f.Fuzz(func(t *testing.T, size uint32, start1 uint32, data1 []byte, start2 uint32, data2 []byte) {
+		pending := &PendingEnvelope{
+			Size:     size,
+			messages: map[uint32][]byte{},
+			mutex:    &sync.Mutex{},
+		}
+		pending.Insert(&dnspb.DNSMessage{Start: start1, Data: data1})
+		pending.Insert(&dnspb.DNSMessage{Start: start2, Data: data2})
+		data, err := pending.Reassemble()
+		if err == nil && len(data) != int(size) {
+			t.Fatalf("reassembled %d bytes, expected %d", len(data), size)
+		}
+	})
+}
+
+func FuzzHTTPDecode(f *testing.F) {
+	for _, encoderID := range []uint64{utilEncoders.Base64EncoderID, utilEncoders.GzipEncoderID, utilEncoders.PNGEncoderID} {
+		f.Add(fmt.Sprintf("/foo.php?x=%d", 7*utilEncoders.EncoderModulus+encoderID), []byte("data"))
+	}
+	f.Add("/foo.php?a=65537&b=1", []byte{})
+	f.Fuzz(func(t *testing.T, rawURL string, body []byte) {
+		reqURL, err := url.Parse(rawURL)
+		if err != nil {
+			return
+		}
+		nonce, err := getNonceFromURL(reqURL)
+		if err != nil {
+			return
+		}
+		_, encoder, err := encoders.EncoderFromNonce(nonce)
+		if err != nil {
+			t.Fatalf("accepted nonce %d without an encoder", nonce)
+		}
+		encoder.Decode(body)
+	})
+}
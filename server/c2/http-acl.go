@@ -0,0 +1,238 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/geoip"
+	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultACLBanDuration - How long a client that exceeds the rate limit is banned
+	DefaultACLBanDuration = 10 * time.Minute
+
+	aclSweepInterval = time.Minute
+	aclIdleTimeout   = 10 * time.Minute
+)
+
+// listenerACL - Per-listener allow/deny lists and rate limiting, denied and banned
+// clients never reach the C2 handlers and only ever see decoy content
+type listenerACL struct {
+	allowNets      []*net.IPNet
+	denyNets       []*net.IPNet
+	allowCountries map[string]bool
+	denyCountries  map[string]bool
+	geoip          *geoip.Database
+	trustProxy     bool
+
+	limit       rate.Limit
+	burst       int
+	banDuration time.Duration
+	clients     map[string]*aclClient
+	lastSweep   time.Time
+	mutex       *sync.Mutex
+}
+
+type aclClient struct {
+	limiter     *rate.Limiter
+	lastSeen    time.Time
+	bannedUntil time.Time
+}
+
+// newListenerACL - Returns nil if the config has no rules
+func newListenerACL(conf *clientpb.HTTPListenerACL) (*listenerACL, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	acl := &listenerACL{
+		allowCountries: countrySet(conf.AllowCountries),
+		denyCountries:  countrySet(conf.DenyCountries),
+		trustProxy:     conf.TrustProxyHeaders,
+		banDuration:    time.Duration(conf.BanDuration),
+		clients:        map[string]*aclClient{},
+		lastSweep:      time.Now(),
+		mutex:          &sync.Mutex{},
+	}
+	var err error
+	if acl.allowNets, err = parseCIDRs(conf.AllowCIDRs); err != nil {
+		return nil, err
+	}
+	if acl.denyNets, err = parseCIDRs(conf.DenyCIDRs); err != nil {
+		return nil, err
+	}
+	if 0 < len(acl.allowCountries) || 0 < len(acl.denyCountries) {
+		acl.geoip, err = geoip.Default()
+		if err != nil {
+			return nil, fmt.Errorf("country rules require a geoip database: %s", err)
+		}
+	}
+	if 0 < conf.RateLimit {
+		acl.limit = rate.Limit(float64(conf.RateLimit) / time.Minute.Seconds())
+		acl.burst = int(conf.RateLimit)
+		if acl.banDuration <= 0 {
+			acl.banDuration = DefaultACLBanDuration
+		}
+	}
+	if len(acl.allowNets) == 0 && len(acl.denyNets) == 0 && acl.geoip == nil && acl.limit == 0 {
+		return nil, nil
+	}
+	return acl, nil
+}
+
+func parseCIDRs(values []string) ([]*net.IPNet, error) {
+	nets := []*net.IPNet{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr '%s'", value)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+func countrySet(values []string) map[string]bool {
+	set := map[string]bool{}
+	for _, value := range values {
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if value != "" {
+			set[value] = true
+		}
+	}
+	return set
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP - The TCP peer address, or the address from the proxy headers if we trust them
+func (a *listenerACL) clientIP(req *http.Request) net.IP {
+	if a.trustProxy {
+		if ip := net.ParseIP(strings.TrimSpace(req.Header.Get("X-Real-Ip"))); ip != nil {
+			return ip
+		}
+		// The first address is the original client, any others are proxies
+		forwardedFor := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+		if ip := net.ParseIP(strings.TrimSpace(forwardedFor[0])); ip != nil {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+// permitted - Deny rules take precedence, if there are any allow rules the client
+// must match at least one of them (cidr or country)
+func (a *listenerACL) permitted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	if containsIP(a.denyNets, ip) {
+		return false
+	}
+	country := ""
+	if a.geoip != nil {
+		country = a.geoip.Country(ip)
+	}
+	if country != "" && a.denyCountries[country] {
+		return false
+	}
+	if len(a.allowNets) == 0 && len(a.allowCountries) == 0 {
+		return true
+	}
+	return containsIP(a.allowNets, ip) || (country != "" && a.allowCountries[country])
+}
+
+// allowRequest - Rate limit a client, clients that exceed the limit are banned
+func (a *listenerACL) allowRequest(ip net.IP, now time.Time) bool {
+	if a.limit == 0 {
+		return true
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.sweep(now)
+	key := ip.String()
+	client, ok := a.clients[key]
+	if !ok {
+		client = &aclClient{limiter: rate.NewLimiter(a.limit, a.burst)}
+		a.clients[key] = client
+	}
+	client.lastSeen = now
+	if now.Before(client.bannedUntil) {
+		return false
+	}
+	if !client.limiter.AllowN(now, 1) {
+		client.bannedUntil = now.Add(a.banDuration)
+		httpLog.Warnf("Banned %s for %s, exceeded %v request(s) per minute", key, a.banDuration, a.burst)
+		return false
+	}
+	return true
+}
+
+// sweep - Forget idle clients that are not banned, must hold the lock
+func (a *listenerACL) sweep(now time.Time) {
+	if now.Sub(a.lastSweep) < aclSweepInterval {
+		return
+	}
+	a.lastSweep = now
+	for key, client := range a.clients {
+		if aclIdleTimeout < now.Sub(client.lastSeen) && now.After(client.bannedUntil) {
+			delete(a.clients, key)
+		}
+	}
+}
+
+// denied - Mux matcher, matches requests from clients that should only see decoy content
+func (a *listenerACL) denied(req *http.Request, rm *mux.RouteMatch) bool {
+	ip := a.clientIP(req)
+	if !a.permitted(ip) {
+		httpLog.Debugf("[acl] Denied %s -> %s", ip, req.RequestURI)
+		return true
+	}
+	return !a.allowRequest(ip, time.Now())
+}
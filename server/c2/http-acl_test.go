@@ -0,0 +1,152 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	implantEncoders "github.com/bishopfox/sliver/implant/sliver/encoders"
+	implantTransports "github.com/bishopfox/sliver/implant/sliver/transports/httpclient"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/geoip"
+	"github.com/gorilla/mux"
+)
+
+func TestListenerACLRules(t *testing.T) {
+	acl, err := newListenerACL(&clientpb.HTTPListenerACL{
+		AllowCIDRs: []string{"10.0.0.0/8", "192.0.2.1"},
+		DenyCIDRs:  []string{"10.1.0.0/16"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbPath := filepath.Join(t.TempDir(), "geoip.csv")
+	os.WriteFile(dbPath, []byte("first,last,country\n203.0.113.0,203.0.113.255,us\n198.51.100.0,198.51.100.255,RU\n"), 0600)
+	acl.geoip, err = geoip.Load(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acl.allowCountries = countrySet([]string{"US"})
+	acl.denyCountries = countrySet([]string{"RU"})
+
+	for addr, expected := range map[string]bool{
+		"10.2.3.4":      true,
+		"10.1.2.3":      false, // deny cidr takes precedence
+		"192.0.2.1":     true,
+		"192.0.2.2":     false,
+		"203.0.113.9":   true, // allowed country
+		"198.51.100.10": false,
+		"8.8.8.8":       false, // unknown country, no allow rule matches
+	} {
+		if acl.permitted(net.ParseIP(addr)) != expected {
+			t.Errorf("Expected permitted(%s) = %v", addr, expected)
+		}
+	}
+
+	_, err = newListenerACL(&clientpb.HTTPListenerACL{DenyCIDRs: []string{"10.0.0.0/33"}})
+	if err == nil {
+		t.Fatal("Expected invalid cidr error")
+	}
+	acl, _ = newListenerACL(&clientpb.HTTPListenerACL{})
+	if acl != nil {
+		t.Fatal("Expected nil acl without any rules")
+	}
+}
+
+func TestListenerACLRateLimit(t *testing.T) {
+	acl, err := newListenerACL(&clientpb.HTTPListenerACL{RateLimit: 3, BanDuration: int64(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ip := net.ParseIP("192.0.2.1")
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if !acl.allowRequest(ip, now) {
+			t.Fatalf("Request %d should be allowed", i)
+		}
+	}
+	if acl.allowRequest(ip, now) {
+		t.Fatal("Expected client to be banned")
+	}
+	// The limiter has refilled, but the client is still banned
+	if acl.allowRequest(ip, now.Add(30*time.Minute)) {
+		t.Fatal("Expected client to still be banned")
+	}
+	if !acl.allowRequest(ip, now.Add(61*time.Minute)) {
+		t.Fatal("Expected ban to expire")
+	}
+	if !acl.allowRequest(net.ParseIP("192.0.2.2"), now) {
+		t.Fatal("Other clients should not be affected by a ban")
+	}
+}
+
+func TestListenerACLDecoyRoute(t *testing.T) {
+
+	implantTransports.SetNonceQueryArgs("abcdedfghijklmnopqrstuvwxyz")
+
+	acl, err := newListenerACL(&clientpb.HTTPListenerACL{
+		DenyCIDRs:         []string{"198.51.100.0/24"},
+		TrustProxyHeaders: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &SliverHTTPC2{
+		ServerConf:  &HTTPServerConfig{},
+		handlerLock: &sync.RWMutex{},
+		acl:         acl,
+	}
+	c2Config := configs.GetHTTPC2Config()
+	router := server.router(c2Config, nil)
+
+	client := implantTransports.SliverHTTPClient{}
+	baseURL := &url.URL{
+		Scheme: "http",
+		Host:   "127.0.0.1:8888",
+		Path:   fmt.Sprintf("/test/foo.%s", c2Config.ImplantConfig.PollFileExt),
+	}
+	nonce, _ := implantEncoders.RandomEncoder(0)
+	testURL := client.NonceQueryArgument(baseURL, nonce)
+
+	for forwardedFor, decoy := range map[string]bool{
+		"198.51.100.7, 10.0.0.1": true,
+		"203.0.113.1":            false,
+	} {
+		req := httptest.NewRequest(http.MethodGet, testURL.String(), nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		match := &mux.RouteMatch{}
+		if !router.Match(req, match) {
+			t.Fatalf("No route matched %s", testURL)
+		}
+		template, _ := match.Route.GetPathTemplate()
+		if (template == "/{rpath:.*}") != decoy {
+			t.Fatalf("Request from %s matched route %s", forwardedFor, template)
+		}
+	}
+}
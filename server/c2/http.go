@@ -23,6 +23,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -37,7 +38,9 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/canaries"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
@@ -49,6 +52,7 @@ import (
 	"github.com/bishopfox/sliver/server/website"
 	"github.com/bishopfox/sliver/util"
 
+	"github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
 	"google.golang.org/protobuf/proto"
 )
@@ -77,10 +81,53 @@ var (
 
 // HTTPSession - Holds data related to a sliver c2 session
 type HTTPSession struct {
-	ID          string
-	ImplantConn *core.ImplantConnection
-	CipherCtx   *cryptography.CipherContext
-	Started     time.Time
+	ID             string
+	ImplantConn    *core.ImplantConnection
+	CipherCtx      *cryptography.CipherContext
+	Started        time.Time
+	ImplantBuildID uuid.UUID
+
+	nonces *nonceWindow // Nil if nonces are not checked for replays
+}
+
+// nonceWindow - Nonces used by an http session within the replay window
+type nonceWindow struct {
+	window time.Duration
+	used   map[uint64]time.Time
+	pruned time.Time
+	mutex  *sync.Mutex
+}
+
+func newNonceWindow(window time.Duration) *nonceWindow {
+	return &nonceWindow{
+		window: window,
+		used:   map[uint64]time.Time{},
+		pruned: time.Now(),
+		mutex:  &sync.Mutex{},
+	}
+}
+
+// Use - Record the use of a nonce, returns false if it was already used within the window
+func (n *nonceWindow) Use(nonce uint64) bool {
+	if n == nil {
+		return true
+	}
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	now := time.Now()
+	if n.window < now.Sub(n.pruned) {
+		for usedNonce, used := range n.used {
+			if n.window < now.Sub(used) {
+				delete(n.used, usedNonce)
+			}
+		}
+		n.pruned = now
+	}
+	if used, ok := n.used[nonce]; ok && now.Sub(used) <= n.window {
+		return false
+	}
+	n.used[nonce] = now
+	return true
 }
 
 // HTTPSessions - All currently open HTTP sessions
@@ -124,11 +171,24 @@ type HTTPServerConfig struct {
 	Key     []byte
 	ACME    bool
 
+	// DNS-01 challenges, ACMEDomains defaults to Domain and may contain wildcards
+	ACMEDNSProvider string
+	ACMEDomains     []string
+
 	MaxRequestLength int
 
 	LongPollTimeout time.Duration
 	LongPollJitter  time.Duration
 	RandomizeJARM   bool
+
+	// Key exchanges and nonces are checked for replays within this window,
+	// negative disables the checks
+	ReplayWindow time.Duration
+
+	// Implants must present a certificate signed by the implant CA
+	RequireClientCert bool
+
+	ACL *clientpb.HTTPListenerACL
 }
 
 // SliverHTTPC2 - Holds refs to all the C2 objects
@@ -136,10 +196,38 @@ type SliverHTTPC2 struct {
 	HTTPServer   *http.Server
 	ServerConf   *HTTPServerConfig // Server config (user args)
 	HTTPSessions *HTTPSessions
-	SliverStage  []byte // Sliver shellcode to serve during staging process
+	SliverStage  []byte       // Sliver shellcode to serve during staging process
+	StagePolicy  *StagePolicy // Constraints on who can fetch the stage
 	Cleanup      func()
+	Drain        *core.Drain
+
+	c2Config    *configs.HTTPC2Config // C2 config (from config file)
+	handler     http.Handler
+	handlerLock *sync.RWMutex
+	acl         *listenerACL
+}
+
+// ServeHTTP - Dispatch to the current router, the router is swapped out
+// when the C2 config is reloaded
+func (s *SliverHTTPC2) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	s.handlerLock.RLock()
+	handler := s.handler
+	s.handlerLock.RUnlock()
+	handler.ServeHTTP(resp, req)
+}
 
-	c2Config *configs.HTTPC2Config // C2 config (from config file)
+// ReloadC2Config - Re-read the C2 config and apply it to the running listener. Existing
+// http sessions are not affected, and routes for previous config versions are kept so
+// implants generated with an older version can still connect.
+func (s *SliverHTTPC2) ReloadC2Config() int {
+	c2Config := configs.GetHTTPC2Config()
+	router := s.router(c2Config, configs.GetPreviousHTTPC2Configs())
+	s.handlerLock.Lock()
+	s.c2Config = c2Config
+	s.handler = router
+	s.handlerLock.Unlock()
+	httpLog.Infof("Reloaded http c2 config version %d on %s", c2Config.Version, s.ServerConf.Addr)
+	return c2Config.Version
 }
 
 func (s *SliverHTTPC2) getServerHeader() string {
@@ -161,6 +249,8 @@ func (s *SliverHTTPC2) getCookieName() string {
 }
 
 func (s *SliverHTTPC2) LoadC2Config() *configs.HTTPC2Config {
+	s.handlerLock.Lock()
+	defer s.handlerLock.Unlock()
 	if s.c2Config != nil {
 		return s.c2Config
 	}
@@ -175,22 +265,51 @@ func (s *SliverHTTPC2) LoadC2Config() *configs.HTTPC2Config {
 // TODO: Better error handling, configurable ACME host/port
 func StartHTTPListener(conf *HTTPServerConfig) (*SliverHTTPC2, error) {
 	httpLog.Infof("Starting https listener on '%s'", conf.Addr)
+	acl, err := newListenerACL(conf.ACL)
+	if err != nil {
+		return nil, err
+	}
 	server := &SliverHTTPC2{
 		ServerConf: conf,
 		HTTPSessions: &HTTPSessions{
 			active: map[string]*HTTPSession{},
 			mutex:  &sync.RWMutex{},
 		},
+		handlerLock: &sync.RWMutex{},
+		acl:         acl,
 	}
+	server.handler = server.router(server.LoadC2Config(), configs.GetPreviousHTTPC2Configs())
 	server.HTTPServer = &http.Server{
 		Addr:         conf.Addr,
-		Handler:      server.router(),
+		Handler:      server,
 		WriteTimeout: DefaultHTTPTimeout,
 		ReadTimeout:  DefaultHTTPTimeout,
 		IdleTimeout:  DefaultHTTPTimeout,
 		TLSNextProto: make(map[string]func(*http.Server, *tls.Conn, http.Handler)),
 	}
-	if conf.ACME {
+	if conf.ACME && conf.ACMEDNSProvider != "" {
+		domains := conf.ACMEDomains
+		if len(domains) == 0 {
+			domains = []string{conf.Domain}
+		}
+		httpLog.Infof("Attempting to fetch let's encrypt certificate for %v using dns-01 (%s) ...", domains, conf.ACMEDNSProvider)
+		acmeManager, err := certs.GetACMEDNSManager(domains, conf.ACMEDNSProvider)
+		if err != nil {
+			httpLog.Errorf("Failed to get dns-01 certificate: %s", err)
+			return nil, err
+		}
+		server.HTTPServer.TLSConfig = &tls.Config{
+			GetCertificate: acmeManager.GetCertificate,
+		}
+		server.Cleanup = func() {
+			acmeManager.Release()
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := server.HTTPServer.Shutdown(ctx); err != nil {
+				httpLog.Warn("Failed to shutdown https server")
+			}
+		}
+	} else if conf.ACME {
 		conf.Domain = filepath.Base(conf.Domain) // I don't think we need this, but we do it anyways
 		httpLog.Infof("Attempting to fetch let's encrypt certificate for '%s' ...", conf.Domain)
 		acmeManager := certs.GetACMEManager(conf.Domain)
@@ -223,10 +342,30 @@ func StartHTTPListener(conf *HTTPServerConfig) (*SliverHTTPC2, error) {
 			}
 		}
 	}
+	if conf.RequireClientCert && server.HTTPServer.TLSConfig != nil {
+		if err := requireClientCert(server.HTTPServer.TLSConfig); err != nil {
+			server.Cleanup()
+			return nil, err
+		}
+	}
 
 	return server, nil
 }
 
+// requireClientCert - Only complete handshakes with clients that present a
+// certificate from the implant CA, like the mtls listener
+func requireClientCert(tlsConfig *tls.Config) error {
+	implantCACert, _, err := certs.GetCertificateAuthority(certs.MtlsImplantCA)
+	if err != nil {
+		return fmt.Errorf("failed to find ca type (%s): %w", certs.MtlsImplantCA, err)
+	}
+	implantCACertPool := x509.NewCertPool()
+	implantCACertPool.AddCert(implantCACert)
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	tlsConfig.ClientCAs = implantCACertPool
+	return nil
+}
+
 func getHTTPSConfig(conf *HTTPServerConfig) *tls.Config {
 	if conf.Cert == nil || conf.Key == nil {
 		var err error
@@ -332,9 +471,8 @@ func getHTTPSConfig(conf *HTTPServerConfig) *tls.Config {
 	return tlsConfig
 }
 
-func (s *SliverHTTPC2) router() *mux.Router {
+func (s *SliverHTTPC2) router(c2Config *configs.HTTPC2Config, previous []*configs.HTTPC2Config) *mux.Router {
 	router := mux.NewRouter()
-	c2Config := s.LoadC2Config()
 	if s.ServerConf.MaxRequestLength < 1024 {
 		s.ServerConf.MaxRequestLength = DefaultMaxBodyLength
 	}
@@ -342,31 +480,60 @@ func (s *SliverHTTPC2) router() *mux.Router {
 		s.ServerConf.LongPollTimeout = DefaultLongPollTimeout
 		s.ServerConf.LongPollJitter = DefaultLongPollJitter
 	}
+	if s.ServerConf.ReplayWindow == 0 {
+		s.ServerConf.ReplayWindow = cryptography.DefaultReplayWindow
+	}
 
 	httpLog.Debugf("HTTP C2 Implant Config = %v", c2Config.ImplantConfig)
 	httpLog.Debugf("HTTP C2 Server Config = %v", c2Config.ServerConfig)
 
+	// Clients denied by the listener ACL, or banned for exceeding the rate limit,
+	// only ever see decoy content
+	if s.acl != nil {
+		router.HandleFunc("/{rpath:.*}", s.defaultHandler).MatcherFunc(s.acl.denied).Methods(http.MethodGet, http.MethodPost)
+	}
+
+	// Routes for the current config are registered first so they take precedence
+	// over any previous version that re-used a file extension
+	s.c2Routes(router, c2Config.ImplantConfig)
+	for _, prevConfig := range previous {
+		httpLog.Debugf("HTTP C2 adding routes for config version %d", prevConfig.Version)
+		s.c2Routes(router, prevConfig.ImplantConfig)
+	}
+
+	// Default handler returns static content or 404s
+	httpLog.Debugf("No pattern matches for request uri")
+	router.HandleFunc("/{rpath:.*}", s.defaultHandler).Methods(http.MethodGet, http.MethodPost)
+
+	router.Use(loggingMiddleware)
+	router.Use(s.DefaultRespHeaders(c2Config.ServerConfig))
+
+	return router
+}
+
+// c2Routes - Register the C2 handlers for the file extensions in an implant config
+func (s *SliverHTTPC2) c2Routes(router *mux.Router, implantConfig *configs.HTTPC2ImplantConfig) {
 	// Start Session Handler
 	router.HandleFunc(
-		fmt.Sprintf("/{rpath:.*\\.%s$}", c2Config.ImplantConfig.StartSessionFileExt),
+		fmt.Sprintf("/{rpath:.*\\.%s$}", implantConfig.StartSessionFileExt),
 		s.startSessionHandler,
 	).MatcherFunc(s.filterNonce).Methods(http.MethodGet, http.MethodPost)
 
 	// Session Handler
 	router.HandleFunc(
-		fmt.Sprintf("/{rpath:.*\\.%s$}", c2Config.ImplantConfig.SessionFileExt),
+		fmt.Sprintf("/{rpath:.*\\.%s$}", implantConfig.SessionFileExt),
 		s.sessionHandler,
 	).MatcherFunc(s.filterNonce).Methods(http.MethodGet, http.MethodPost)
 
 	// Poll Handler
 	router.HandleFunc(
-		fmt.Sprintf("/{rpath:.*\\.%s$}", c2Config.ImplantConfig.PollFileExt),
+		fmt.Sprintf("/{rpath:.*\\.%s$}", implantConfig.PollFileExt),
 		s.pollHandler,
 	).MatcherFunc(s.filterNonce).Methods(http.MethodGet)
 
 	// Close Handler
 	router.HandleFunc(
-		fmt.Sprintf("/{rpath:.*\\.%s$}", c2Config.ImplantConfig.CloseFileExt),
+		fmt.Sprintf("/{rpath:.*\\.%s$}", implantConfig.CloseFileExt),
 		s.closeHandler,
 	).MatcherFunc(s.filterNonce).Methods(http.MethodGet)
 
@@ -374,18 +541,9 @@ func (s *SliverHTTPC2) router() *mux.Router {
 	// Request from msf stager payload will look like:
 	// GET /fonts/Inter-Medium.woff/B64_ENCODED_PAYLOAD_UUID
 	router.HandleFunc(
-		fmt.Sprintf("/{rpath:.*\\.%s[/]{0,1}.*$}", c2Config.ImplantConfig.StagerFileExt),
+		fmt.Sprintf("/{rpath:.*\\.%s[/]{0,1}.*$}", implantConfig.StagerFileExt),
 		s.stagerHandler,
 	).Methods(http.MethodGet)
-
-	// Default handler returns static content or 404s
-	httpLog.Debugf("No pattern matches for request uri")
-	router.HandleFunc("/{rpath:.*}", s.defaultHandler).Methods(http.MethodGet, http.MethodPost)
-
-	router.Use(loggingMiddleware)
-	router.Use(s.DefaultRespHeaders)
-
-	return router
 }
 
 func (s *SliverHTTPC2) noCacheHeader(resp http.ResponseWriter) {
@@ -445,22 +603,24 @@ func loggingMiddleware(next http.Handler) http.Handler {
 }
 
 // DefaultRespHeaders - Configures default response headers
-func (s *SliverHTTPC2) DefaultRespHeaders(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
-		if s.c2Config.ServerConfig.RandomVersionHeaders {
-			resp.Header().Set("Server", s.getServerHeader())
-		}
-		for _, header := range s.c2Config.ServerConfig.Headers {
-			if 0 < header.Probability && header.Probability < 100 {
-				roll := insecureRand.Intn(99) + 1
-				if header.Probability < roll {
-					continue
+func (s *SliverHTTPC2) DefaultRespHeaders(serverConfig *configs.HTTPC2ServerConfig) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			if serverConfig.RandomVersionHeaders {
+				resp.Header().Set("Server", s.getServerHeader())
+			}
+			for _, header := range serverConfig.Headers {
+				if 0 < header.Probability && header.Probability < 100 {
+					roll := insecureRand.Intn(99) + 1
+					if header.Probability < roll {
+						continue
+					}
 				}
+				resp.Header().Set(header.Name, header.Value)
 			}
-			resp.Header().Set(header.Name, header.Value)
-		}
-		next.ServeHTTP(resp, req)
-	})
+			next.ServeHTTP(resp, req)
+		})
+	}
 }
 
 func (s *SliverHTTPC2) websiteContentHandler(resp http.ResponseWriter, req *http.Request) error {
@@ -477,7 +637,10 @@ func (s *SliverHTTPC2) websiteContentHandler(resp http.ResponseWriter, req *http
 }
 
 func (s *SliverHTTPC2) defaultHandler(resp http.ResponseWriter, req *http.Request) {
-	// Request does not match the C2 profile so we pass it to the static content or 404 handler
+	// Request does not match the C2 profile so we pass it to the canary, static content, or 404 handler
+	if canaries.HandleHTTP(resp, req) {
+		return
+	}
 	if s.ServerConf.Website != "" {
 		httpLog.Infof("Serving static content from website %v", s.ServerConf.Website)
 		err := s.websiteContentHandler(resp, req)
@@ -526,11 +689,16 @@ func (s *SliverHTTPC2) startSessionHandler(resp http.ResponseWriter, req *http.R
 		s.defaultHandler(resp, req)
 		return
 	}
+	if name, burned := core.BurnList.BuildName(implantConfig.ImplantBuildID); burned {
+		core.BurnedContact(name, "http(s)", getRemoteAddr(req))
+		s.defaultHandler(resp, req)
+		return
+	}
 
 	serverKeyPair := cryptography.AgeServerKeyPair()
-	sessionInitData, err := cryptography.AgeKeyExFromImplant(serverKeyPair.Private, implantConfig.PeerPrivateKey, data[32:])
+	sessionInitData, kemSharedKey, err := cryptography.KeyExFromImplant(implantConfig.KeyExchange, serverKeyPair.Private, implantConfig.PeerPrivateKey, data[32:], s.ServerConf.ReplayWindow)
 	if err != nil {
-		httpLog.Error("age key exchange decryption failed")
+		httpLog.Error("key exchange decryption failed")
 		s.defaultHandler(resp, req)
 		return
 	}
@@ -547,8 +715,15 @@ func (s *SliverHTTPC2) startSessionHandler(resp http.ResponseWriter, req *http.R
 		httpLog.Error("Failed to convert bytes to session key")
 		return
 	}
-	httpSession.CipherCtx = cryptography.NewCipherContext(sKey)
+	httpSession.CipherCtx = cryptography.NewCipherContext(cryptography.SessionKey(sKey, kemSharedKey))
 	httpSession.ImplantConn = core.NewImplantConnection("http(s)", getRemoteAddr(req))
+	httpSession.ImplantConn.Drain = s.Drain
+	httpSession.ImplantConn.ReplayWindow = s.ServerConf.ReplayWindow
+	httpSession.ImplantBuildID = implantConfig.ImplantBuildID
+	if implantConfig.KeyExchange != "" && 0 < s.ServerConf.ReplayWindow {
+		// Implants with a key exchange algorithm don't reuse nonces within a session
+		httpSession.nonces = newNonceWindow(s.ServerConf.ReplayWindow)
+	}
 	s.HTTPSessions.Add(httpSession)
 	httpLog.Infof("Started new session with http session id: %s", httpSession.ID)
 
@@ -587,12 +762,16 @@ func (s *SliverHTTPC2) sessionHandler(resp http.ResponseWriter, req *http.Reques
 	}
 	envelope := &sliverpb.Envelope{}
 	err = proto.Unmarshal(plaintext, envelope)
+	if err == nil {
+		err = core.DecompressEnvelope(envelope)
+	}
 	if err != nil {
 		httpLog.Warnf("Failed to decode request body: %s", err)
 		s.defaultHandler(resp, req)
 		return
 	}
 
+	core.Capture.Record(httpSession.ImplantConn, core.CaptureRecv, envelope)
 	resp.WriteHeader(http.StatusAccepted)
 	handlers := sliverHandlers.GetHandlers()
 	if envelope.ID != 0 {
@@ -625,8 +804,9 @@ func (s *SliverHTTPC2) pollHandler(resp http.ResponseWriter, req *http.Request)
 	_, encoder, _ := encoders.EncoderFromNonce(nonce)
 	select {
 	case envelope := <-httpSession.ImplantConn.Send:
+		core.Capture.Record(httpSession.ImplantConn, core.CaptureSend, envelope)
 		resp.WriteHeader(http.StatusOK)
-		envelopeData, _ := proto.Marshal(envelope)
+		envelopeData, _ := proto.Marshal(httpSession.ImplantConn.CompressEnvelope(envelope))
 		ciphertext, err := httpSession.CipherCtx.Encrypt(envelopeData)
 		if err != nil {
 			httpLog.Errorf("Failed to encrypt message %s", err)
@@ -696,7 +876,7 @@ func (s *SliverHTTPC2) closeHandler(resp http.ResponseWriter, req *http.Request)
 	httpLog.Debug("Close request")
 	httpSession := s.getHTTPSession(req)
 	if httpSession == nil {
-		httpLog.Infof("No session with id %#v", httpSession.ID)
+		httpLog.Info("No session for close request")
 		s.defaultHandler(resp, req)
 		return
 	}
@@ -713,6 +893,10 @@ func (s *SliverHTTPC2) stagerHandler(resp http.ResponseWriter, req *http.Request
 	httpLog.Debug("Stager request")
 	if len(s.SliverStage) != 0 {
 		httpLog.Infof("Received staging request from %s", getRemoteAddr(req))
+		if !s.StagePolicy.Fetch("http(s)", req.RemoteAddr, getRemoteAddr(req), req.UserAgent(), req.URL.Path) {
+			s.defaultHandler(resp, req)
+			return
+		}
 		s.noCacheHeader(resp)
 		resp.Write(s.SliverStage)
 		httpLog.Infof("Serving sliver shellcode (size %d) to %s", len(s.SliverStage), getRemoteAddr(req))
@@ -726,6 +910,15 @@ func (s *SliverHTTPC2) getHTTPSession(req *http.Request) *HTTPSession {
 	for _, cookie := range req.Cookies() {
 		httpSession := s.HTTPSessions.Get(cookie.Value)
 		if httpSession != nil {
+			if name, burned := core.BurnList.BuildName(httpSession.ImplantBuildID); burned {
+				core.BurnedContact(name, "http(s)", getRemoteAddr(req))
+				return nil // Burned after the session was established
+			}
+			nonce, _ := getNonceFromURL(req.URL)
+			if !httpSession.nonces.Use(nonce) {
+				httpLog.Warnf("Replayed nonce in session %s", httpSession.ID)
+				return nil
+			}
 			httpSession.ImplantConn.UpdateLastMessage()
 			return httpSession
 		}
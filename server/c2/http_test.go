@@ -25,7 +25,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	implantCrypto "github.com/bishopfox/sliver/implant/sliver/cryptography"
 	implantEncoders "github.com/bishopfox/sliver/implant/sliver/encoders"
@@ -33,6 +36,7 @@ import (
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/gorilla/mux"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -79,6 +83,43 @@ func TestStartSessionHandler(t *testing.T) {
 	}
 }
 
+func TestRouterPreviousConfigVersions(t *testing.T) {
+
+	implantTransports.SetNonceQueryArgs("abcdedfghijklmnopqrstuvwxyz")
+
+	previous := configs.GetHTTPC2Config()
+	current := *previous
+	currentImplantConfig := *previous.ImplantConfig
+	currentImplantConfig.PollFileExt = "jsx"
+	current.ImplantConfig = &currentImplantConfig
+	current.Version = previous.Version + 1
+
+	server := &SliverHTTPC2{
+		ServerConf:  &HTTPServerConfig{},
+		handlerLock: &sync.RWMutex{},
+	}
+	router := server.router(&current, []*configs.HTTPC2Config{previous})
+
+	client := implantTransports.SliverHTTPClient{}
+	for _, ext := range []string{currentImplantConfig.PollFileExt, previous.ImplantConfig.PollFileExt} {
+		baseURL := &url.URL{
+			Scheme: "http",
+			Host:   "127.0.0.1:8888",
+			Path:   fmt.Sprintf("/test/foo.%s", ext),
+		}
+		nonce, _ := implantEncoders.RandomEncoder(0)
+		testURL := client.NonceQueryArgument(baseURL, nonce)
+		match := &mux.RouteMatch{}
+		if !router.Match(httptest.NewRequest(http.MethodGet, testURL.String(), nil), match) {
+			t.Fatalf("No route matched %s", testURL)
+		}
+		template, _ := match.Route.GetPathTemplate()
+		if !strings.HasSuffix(template, fmt.Sprintf("\\.%s$}", ext)) {
+			t.Fatalf("Request for %s matched route %s", testURL, template)
+		}
+	}
+}
+
 func TestGetNonceFromURL(t *testing.T) {
 
 	implantTransports.SetNonceQueryArgs("abcdedfghijklmnopqrstuvwxyz")
@@ -146,3 +187,28 @@ func randomArgs(min int) string {
 	}
 	return keys
 }
+
+func TestNonceWindow(t *testing.T) {
+	nonces := newNonceWindow(time.Minute)
+	if !nonces.Use(1) || !nonces.Use(2) {
+		t.Fatal("expected unused nonces to be accepted")
+	}
+	if nonces.Use(1) {
+		t.Fatal("expected reused nonce to be rejected")
+	}
+
+	// Nonces can be reused once they're outside of the window
+	nonces.used[1] = time.Now().Add(-2 * time.Minute)
+	nonces.pruned = time.Now().Add(-2 * time.Minute)
+	if !nonces.Use(1) {
+		t.Fatal("expected nonce outside of the window to be accepted")
+	}
+	if _, ok := nonces.used[2]; !ok {
+		t.Fatal("expected nonce within the window to be kept")
+	}
+
+	var disabled *nonceWindow
+	if !disabled.Use(1) || !disabled.Use(1) {
+		t.Fatal("expected nil window to accept all nonces")
+	}
+}
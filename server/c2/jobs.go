@@ -22,6 +22,7 @@ import (
 	"bufio"
 	"bytes"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -33,29 +34,73 @@ import (
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/log"
 	"golang.zx2c4.com/wireguard/device"
 )
 
+const (
+	keyExHistoryPruneInterval = time.Hour
+)
+
 var (
 	jobLog = log.NamedLogger("c2", "jobs")
+
+	httpListeners = &sync.Map{} // Job ID -> *SliverHTTPC2
+	stagePolicies = &sync.Map{} // Job ID -> *StagePolicy
 )
 
+// HTTPListener - The server of a running HTTP(S) listener (C2 or stager) job
+func HTTPListener(jobID int) (*SliverHTTPC2, bool) {
+	server, ok := httpListeners.Load(jobID)
+	if !ok {
+		return nil, false
+	}
+	return server.(*SliverHTTPC2), true
+}
+
+// JobStagePolicy - The stage policy of a running stager job
+func JobStagePolicy(jobID int) (*StagePolicy, bool) {
+	policy, ok := stagePolicies.Load(jobID)
+	if !ok {
+		return nil, false
+	}
+	return policy.(*StagePolicy), true
+}
+
+// ReloadHTTPC2Config - Apply the current HTTP C2 config to all running HTTP(S)
+// listeners, returns the IDs of the jobs that were reloaded
+func ReloadHTTPC2Config() []int {
+	jobIDs := []int{}
+	httpListeners.Range(func(key, value interface{}) bool {
+		value.(*SliverHTTPC2).ReloadC2Config()
+		jobIDs = append(jobIDs, key.(int))
+		return true
+	})
+	return jobIDs
+}
+
 // StartMTLSListenerJob - Start an mTLS listener as a job
-func StartMTLSListenerJob(host string, listenPort uint16) (*core.Job, error) {
+func StartMTLSListenerJob(host string, listenPort uint16, spa bool, knockPorts []uint16) (*core.Job, error) {
 	bind := fmt.Sprintf("%s:%d", host, listenPort)
-	ln, err := StartMutualTLSListener(host, listenPort)
+	drain := &core.Drain{}
+	ln, err := StartMutualTLSListener(host, listenPort, spa, knockPorts, drain)
 	if err != nil {
 		return nil, err // If we fail to bind don't setup the Job
 	}
 
+	description := fmt.Sprintf("mutual tls listener %s", bind)
+	if spa {
+		description += " (spa)"
+	}
 	job := &core.Job{
 		ID:          core.NextJobID(),
 		Name:        "mtls",
-		Description: fmt.Sprintf("mutual tls listener %s", bind),
+		Description: description,
 		Protocol:    "tcp",
 		Port:        listenPort,
 		JobCtrl:     make(chan bool),
+		Drain:       drain,
 	}
 
 	go func() {
@@ -69,9 +114,40 @@ func StartMTLSListenerJob(host string, listenPort uint16) (*core.Job, error) {
 	return job, nil
 }
 
+// StartExternalC2ListenerJob - Start an External C2 listener as a job
+func StartExternalC2ListenerJob(host string, listenPort uint16, stage []byte) (*core.Job, error) {
+	bind := fmt.Sprintf("%s:%d", host, listenPort)
+	drain := &core.Drain{}
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        consts.ExternalC2Str,
+		Description: fmt.Sprintf("external c2 listener %s", bind),
+		Protocol:    "tcp",
+		Port:        listenPort,
+		JobCtrl:     make(chan bool),
+		Drain:       drain,
+	}
+	policy := &StagePolicy{JobID: job.ID, mutex: &sync.Mutex{}}
+	ln, err := StartExternalC2Listener(host, listenPort, stage, policy, drain)
+	if err != nil {
+		return nil, err // If we fail to bind don't setup the Job
+	}
+
+	go func() {
+		<-job.JobCtrl
+		jobLog.Infof("Stopping External C2 listener (%d) ...", job.ID)
+		ln.Close() // Kills the listener goroutine but NOT connections
+		core.Jobs.Remove(job)
+	}()
+	core.Jobs.Add(job)
+
+	return job, nil
+}
+
 // StartWGListenerJob - Start a WireGuard listener as a job
 func StartWGListenerJob(listenPort uint16, nListenPort uint16, keyExchangeListenPort uint16) (*core.Job, error) {
-	ln, dev, _, err := StartWGListener(listenPort, nListenPort, keyExchangeListenPort)
+	drain := &core.Drain{}
+	ln, dev, _, err := StartWGListener(listenPort, nListenPort, keyExchangeListenPort, drain)
 	if err != nil {
 		return nil, err // If we fail to bind don't setup the Job
 	}
@@ -83,6 +159,7 @@ func StartWGListenerJob(listenPort uint16, nListenPort uint16, keyExchangeListen
 		Protocol:    "udp",
 		Port:        listenPort,
 		JobCtrl:     make(chan bool),
+		Drain:       drain,
 	}
 
 	ticker := time.NewTicker(5 * time.Second)
@@ -126,8 +203,9 @@ func StartWGListenerJob(listenPort uint16, nListenPort uint16, keyExchangeListen
 }
 
 // StartDNSListenerJob - Start a DNS listener as a job
-func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canaries bool, enforceOTP bool) (*core.Job, error) {
-	server := StartDNSListener(bindIface, lport, domains, canaries, enforceOTP)
+func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canaries bool, enforceOTP bool, replayWindow time.Duration) (*core.Job, error) {
+	server := StartDNSListener(bindIface, lport, domains, canaries, enforceOTP, replayWindow)
+	server.Drain = &core.Drain{}
 	description := fmt.Sprintf("%s (canaries %v)", strings.Join(domains, " "), canaries)
 	job := &core.Job{
 		ID:          core.NextJobID(),
@@ -137,6 +215,7 @@ func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canar
 		Port:        lport,
 		JobCtrl:     make(chan bool),
 		Domains:     domains,
+		Drain:       server.Drain,
 	}
 
 	go func() {
@@ -178,6 +257,7 @@ func StartHTTPListenerJob(conf *HTTPServerConfig) (*core.Job, error) {
 	if conf.Secure {
 		name = "https"
 	}
+	server.Drain = &core.Drain{}
 
 	job := &core.Job{
 		ID:          core.NextJobID(),
@@ -187,11 +267,14 @@ func StartHTTPListenerJob(conf *HTTPServerConfig) (*core.Job, error) {
 		Port:        uint16(conf.LPort),
 		JobCtrl:     make(chan bool),
 		Domains:     []string{conf.Domain},
+		Drain:       server.Drain,
 	}
 	core.Jobs.Add(job)
+	httpListeners.Store(job.ID, server)
 
 	cleanup := func(err error) {
 		server.Cleanup()
+		httpListeners.Delete(job.ID)
 		core.Jobs.Remove(job)
 		core.EventBroker.Publish(core.Event{
 			Job:       job,
@@ -228,27 +311,30 @@ func StartHTTPListenerJob(conf *HTTPServerConfig) (*core.Job, error) {
 }
 
 // StartTCPStagerListenerJob - Start a TCP staging payload listener
-func StartTCPStagerListenerJob(host string, port uint16, profileName string, shellcode []byte) (*core.Job, error) {
-	ln, err := StartTCPListener(host, port, shellcode)
-	if err != nil {
-		return nil, err // If we fail to bind don't setup the Job
-	}
-
+func StartTCPStagerListenerJob(host string, port uint16, shellcode []byte, policy *StagePolicy) (*core.Job, error) {
 	job := &core.Job{
 		ID:          core.NextJobID(),
 		Name:        "TCP",
 		Description: "Raw TCP listener (stager only)",
 		Protocol:    "tcp",
 		Port:        port,
-		ProfileName: profileName,
+		ProfileName: policy.ProfileName,
 		JobCtrl:     make(chan bool),
 	}
+	policy.JobID = job.ID
+
+	ln, err := StartTCPListener(host, port, shellcode, policy)
+	if err != nil {
+		return nil, err // If we fail to bind don't setup the Job
+	}
+	stagePolicies.Store(job.ID, policy)
 
 	go func() {
 		<-job.JobCtrl
 		jobLog.Infof("Stopping TCP listener (%d) ...", job.ID)
 		ln.Close() // Kills listener GoRoutines in startMutualTLSListener() but NOT connections
 
+		stagePolicies.Delete(job.ID)
 		core.Jobs.Remove(job)
 
 		core.EventBroker.Publish(core.Event{
@@ -263,7 +349,7 @@ func StartTCPStagerListenerJob(host string, port uint16, profileName string, she
 }
 
 // StartHTTPStagerListenerJob - Start an HTTP(S) stager payload listener
-func StartHTTPStagerListenerJob(conf *HTTPServerConfig, profileName string, data []byte) (*core.Job, error) {
+func StartHTTPStagerListenerJob(conf *HTTPServerConfig, data []byte, policy *StagePolicy) (*core.Job, error) {
 	server, err := StartHTTPListener(conf)
 	if err != nil {
 		return nil, err
@@ -272,20 +358,26 @@ func StartHTTPStagerListenerJob(conf *HTTPServerConfig, profileName string, data
 	if conf.Secure {
 		name = "https"
 	}
-	server.SliverStage = data
 	job := &core.Job{
 		ID:          core.NextJobID(),
 		Name:        name,
 		Description: fmt.Sprintf("Stager handler %s for domain %s", name, conf.Domain),
 		Protocol:    "tcp",
 		Port:        uint16(conf.LPort),
-		ProfileName: profileName,
+		ProfileName: policy.ProfileName,
 		JobCtrl:     make(chan bool),
 	}
+	policy.JobID = job.ID
+	server.StagePolicy = policy
+	server.SliverStage = data
 	core.Jobs.Add(job)
+	httpListeners.Store(job.ID, server)
+	stagePolicies.Store(job.ID, policy)
 
 	cleanup := func(err error) {
 		server.Cleanup()
+		httpListeners.Delete(job.ID)
+		stagePolicies.Delete(job.ID)
 		core.Jobs.Remove(job)
 		core.EventBroker.Publish(core.Event{
 			Job:       job,
@@ -321,14 +413,37 @@ func StartHTTPStagerListenerJob(conf *HTTPServerConfig, profileName string, data
 	return job, nil
 }
 
-// StartPersistentJobs - Start persistent jobs
+// StartKeyExHistoryPruner - Periodically remove the hashes of key exchanges that
+// are outside of the replay window, and so can no longer be replayed
+func StartKeyExHistoryPruner() {
+	go func() {
+		for {
+			pruned, err := db.PruneKeyExHistory()
+			if err != nil {
+				jobLog.Errorf("Failed to prune key exchange history: %s", err)
+			} else if 0 < pruned {
+				jobLog.Debugf("Pruned %d expired key exchange(s)", pruned)
+			}
+			time.Sleep(keyExHistoryPruneInterval)
+		}
+	}()
+}
+
+// StartPersistentJobs - Start persistent jobs and the autostart listener profiles, the
+// profiles are started even if some of the persistent jobs failed to start
 func StartPersistentJobs(cfg *configs.ServerConfig) error {
+	err := startConfigJobs(cfg)
+	return errors.Join(err, StartAutoStartListenerProfiles())
+}
+
+// startConfigJobs - Start the persistent jobs of the server config
+func startConfigJobs(cfg *configs.ServerConfig) error {
 	if cfg.Jobs == nil {
 		return nil
 	}
 
 	for _, j := range cfg.Jobs.MTLS {
-		job, err := StartMTLSListenerJob(j.Host, j.Port)
+		job, err := StartMTLSListenerJob(j.Host, j.Port, j.SPA, j.KnockPorts)
 		if err != nil {
 			return err
 		}
@@ -344,7 +459,7 @@ func StartPersistentJobs(cfg *configs.ServerConfig) error {
 	}
 
 	for _, j := range cfg.Jobs.DNS {
-		job, err := StartDNSListenerJob(j.Host, j.Port, j.Domains, j.Canaries, j.EnforceOTP)
+		job, err := StartDNSListenerJob(j.Host, j.Port, j.Domains, j.Canaries, j.EnforceOTP, time.Duration(j.ReplayWindow))
 		if err != nil {
 			return err
 		}
@@ -361,9 +476,15 @@ func StartPersistentJobs(cfg *configs.ServerConfig) error {
 			Cert:            j.Cert,
 			Key:             j.Key,
 			ACME:            j.ACME,
+			ACMEDNSProvider: j.ACMEDNSProvider,
+			ACMEDomains:     j.ACMEDomains,
 			LongPollTimeout: time.Duration(j.LongPollTimeout),
 			LongPollJitter:  time.Duration(j.LongPollJitter),
 			RandomizeJARM:   j.RandomizeJARM,
+			ReplayWindow:    time.Duration(j.ReplayWindow),
+			ACL:             j.ACL,
+
+			RequireClientCert: j.RequireClientCert,
 		}
 		job, err := StartHTTPListenerJob(cfg)
 		if err != nil {
@@ -0,0 +1,293 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+)
+
+var (
+	// ErrListenerProfileNotFound - No listener profile with the given name
+	ErrListenerProfileNotFound = errors.New("listener profile not found")
+	// ErrListenerProfileCycle - Profile dependencies form a cycle
+	ErrListenerProfileCycle = errors.New("listener profile dependency cycle")
+)
+
+// ListenerProfileJobs - Running jobs that were started from a listener profile
+func ListenerProfileJobs(name string) []*core.Job {
+	jobs := []*core.Job{}
+	for _, job := range core.Jobs.All() {
+		if job.ListenerProfile == name {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// StartListenerProfile - Start all listeners in a profile, any profiles it depends on
+// that are not already running are started first.
+func StartListenerProfile(name string) ([]*core.Job, error) {
+	profiles, err := allListenerProfiles()
+	if err != nil {
+		return nil, err
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, ErrListenerProfileNotFound
+	}
+	ordered, err := OrderListenerProfiles(dependenciesOf(profile, profiles))
+	if err != nil {
+		return nil, err
+	}
+	for _, dependency := range ordered {
+		if dependency.Name == name || 0 < len(ListenerProfileJobs(dependency.Name)) {
+			continue
+		}
+		jobLog.Infof("Starting listener profile '%s' (dependency of '%s')", dependency.Name, name)
+		if _, err := startListenerProfileJobs(dependency); err != nil {
+			return nil, fmt.Errorf("dependency '%s': %s", dependency.Name, err)
+		}
+	}
+	if running := ListenerProfileJobs(name); 0 < len(running) {
+		return running, nil
+	}
+	return startListenerProfileJobs(profile)
+}
+
+// StopListenerProfile - Stop all running jobs started from a listener profile
+func StopListenerProfile(name string) []*core.Job {
+	jobs := ListenerProfileJobs(name)
+	for _, job := range jobs {
+		job.JobCtrl <- true
+	}
+	return jobs
+}
+
+// StartAutoStartListenerProfiles - Start all autostart profiles in dependency order, a
+// profile that fails to start only keeps the profiles that depend on it from starting
+func StartAutoStartListenerProfiles() error {
+	profiles, err := allListenerProfiles()
+	if err != nil {
+		return err
+	}
+	autoStart := []*clientpb.ListenerProfile{}
+	for _, profile := range profiles {
+		if profile.AutoStart {
+			autoStart = append(autoStart, dependenciesOf(profile, profiles)...)
+		}
+	}
+	ordered, err := OrderListenerProfiles(autoStart)
+	if err != nil {
+		return err
+	}
+	return startListenerProfiles(ordered, startListenerProfileJobs)
+}
+
+// startListenerProfiles - Start the profiles (in dependency order) that are not running,
+// failures are logged and returned once every other profile has been started
+func startListenerProfiles(ordered []*clientpb.ListenerProfile, start func(*clientpb.ListenerProfile) ([]*core.Job, error)) error {
+	failed := map[string]bool{}
+	errs := []error{}
+	for _, profile := range ordered {
+		if 0 < len(ListenerProfileJobs(profile.Name)) {
+			continue
+		}
+		if dependency := failedDependency(profile, failed); dependency != "" {
+			failed[profile.Name] = true
+			jobLog.Errorf("Not starting listener profile '%s', its dependency '%s' failed to start", profile.Name, dependency)
+			errs = append(errs, fmt.Errorf("listener profile '%s': dependency '%s' failed to start", profile.Name, dependency))
+			continue
+		}
+		jobLog.Infof("Auto-starting listener profile '%s'", profile.Name)
+		if _, err := start(profile); err != nil {
+			failed[profile.Name] = true
+			jobLog.Errorf("Failed to start listener profile '%s': %s", profile.Name, err)
+			errs = append(errs, fmt.Errorf("listener profile '%s': %s", profile.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// failedDependency - The name of a dependency of the profile that failed to start
+func failedDependency(profile *clientpb.ListenerProfile, failed map[string]bool) string {
+	for _, name := range profile.DependsOn {
+		if failed[name] {
+			return name
+		}
+	}
+	return ""
+}
+
+// OrderListenerProfiles - Sort profiles such that dependencies always come before
+// the profiles that depend on them, ties are broken by StartOrder and then name.
+// Dependencies that are not included in the list are ignored.
+func OrderListenerProfiles(profiles []*clientpb.ListenerProfile) ([]*clientpb.ListenerProfile, error) {
+	byName := map[string]*clientpb.ListenerProfile{}
+	for _, profile := range profiles {
+		byName[profile.Name] = profile
+	}
+	sorted := []*clientpb.ListenerProfile{}
+	for _, profile := range byName {
+		sorted = append(sorted, profile)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].StartOrder != sorted[j].StartOrder {
+			return sorted[i].StartOrder < sorted[j].StartOrder
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	ordered := []*clientpb.ListenerProfile{}
+	var visit func(profile *clientpb.ListenerProfile) error
+	visit = func(profile *clientpb.ListenerProfile) error {
+		switch state[profile.Name] {
+		case visiting:
+			return fmt.Errorf("%w: %s", ErrListenerProfileCycle, profile.Name)
+		case visited:
+			return nil
+		}
+		state[profile.Name] = visiting
+		for _, name := range profile.DependsOn {
+			if dependency, ok := byName[name]; ok {
+				if err := visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+		state[profile.Name] = visited
+		ordered = append(ordered, profile)
+		return nil
+	}
+	for _, profile := range sorted {
+		if err := visit(profile); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// dependenciesOf - The profile and all of its transitive dependencies
+func dependenciesOf(profile *clientpb.ListenerProfile, profiles map[string]*clientpb.ListenerProfile) []*clientpb.ListenerProfile {
+	seen := map[string]bool{}
+	result := []*clientpb.ListenerProfile{}
+	var walk func(p *clientpb.ListenerProfile)
+	walk = func(p *clientpb.ListenerProfile) {
+		if seen[p.Name] {
+			return
+		}
+		seen[p.Name] = true
+		result = append(result, p)
+		for _, name := range p.DependsOn {
+			if dependency, ok := profiles[name]; ok {
+				walk(dependency)
+			} else {
+				jobLog.Warnf("Listener profile '%s' depends on unknown profile '%s'", p.Name, name)
+			}
+		}
+	}
+	walk(profile)
+	return result
+}
+
+func allListenerProfiles() (map[string]*clientpb.ListenerProfile, error) {
+	dbProfiles, err := db.ListenerProfiles()
+	if err != nil {
+		return nil, err
+	}
+	profiles := map[string]*clientpb.ListenerProfile{}
+	for _, dbProfile := range dbProfiles {
+		profiles[dbProfile.Name] = dbProfile.ToProtobuf()
+	}
+	return profiles, nil
+}
+
+// startListenerProfileJobs - Start every listener in the profile, if any listener
+// fails to start the listeners already started are stopped so the unit is atomic.
+func startListenerProfileJobs(profile *clientpb.ListenerProfile) ([]*core.Job, error) {
+	jobs := []*core.Job{}
+	start := func(job *core.Job, err error) error {
+		if err != nil {
+			for _, started := range jobs {
+				started.JobCtrl <- true
+			}
+			return err
+		}
+		job.ListenerProfile = profile.Name
+		jobs = append(jobs, job)
+		return nil
+	}
+
+	for _, mtls := range profile.MTLS {
+		knockPorts, err := SPAKnockPorts(mtls.KnockPorts)
+		if err != nil {
+			return nil, start(nil, err)
+		}
+		if err := start(StartMTLSListenerJob(mtls.Host, uint16(mtls.Port), mtls.SPA, knockPorts)); err != nil {
+			return nil, err
+		}
+	}
+	for _, wg := range profile.WG {
+		if err := start(StartWGListenerJob(uint16(wg.Port), uint16(wg.NPort), uint16(wg.KeyPort))); err != nil {
+			return nil, err
+		}
+	}
+	for _, dns := range profile.DNS {
+		if err := start(StartDNSListenerJob(dns.Host, uint16(dns.Port), dns.Domains, dns.Canaries, dns.EnforceOTP, time.Duration(dns.ReplayWindow))); err != nil {
+			return nil, err
+		}
+	}
+	for _, http := range profile.HTTP {
+		conf := &HTTPServerConfig{
+			Addr:            fmt.Sprintf("%s:%d", http.Host, http.Port),
+			LPort:           uint16(http.Port),
+			Secure:          http.Secure,
+			Domain:          http.Domain,
+			Website:         http.Website,
+			Cert:            http.Cert,
+			Key:             http.Key,
+			ACME:            http.ACME,
+			ACMEDNSProvider: http.ACMEDNSProvider,
+			ACMEDomains:     http.ACMEDomains,
+			LongPollTimeout: time.Duration(http.LongPollTimeout),
+			LongPollJitter:  time.Duration(http.LongPollJitter),
+			RandomizeJARM:   http.RandomizeJARM,
+			ReplayWindow:    time.Duration(http.ReplayWindow),
+			ACL:             http.ACL,
+
+			RequireClientCert: http.RequireClientCert,
+		}
+		if err := start(StartHTTPListenerJob(conf)); err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
@@ -0,0 +1,104 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+)
+
+func profileNames(profiles []*clientpb.ListenerProfile) []string {
+	names := []string{}
+	for _, profile := range profiles {
+		names = append(names, profile.Name)
+	}
+	return names
+}
+
+func TestOrderListenerProfiles(t *testing.T) {
+	profiles := []*clientpb.ListenerProfile{
+		{Name: "https", StartOrder: 0, DependsOn: []string{"dns"}},
+		{Name: "mtls", StartOrder: 10},
+		{Name: "dns", StartOrder: 20},
+		{Name: "wg", StartOrder: 0, DependsOn: []string{"missing"}},
+	}
+	ordered, err := OrderListenerProfiles(profiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"dns", "https", "wg", "mtls"}
+	names := profileNames(ordered)
+	if len(names) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+	for index := range expected {
+		if names[index] != expected[index] {
+			t.Fatalf("expected %v, got %v", expected, names)
+		}
+	}
+}
+
+func TestOrderListenerProfilesCycle(t *testing.T) {
+	profiles := []*clientpb.ListenerProfile{
+		{Name: "a", DependsOn: []string{"b"}},
+		{Name: "b", DependsOn: []string{"c"}},
+		{Name: "c", DependsOn: []string{"a"}},
+	}
+	_, err := OrderListenerProfiles(profiles)
+	if !errors.Is(err, ErrListenerProfileCycle) {
+		t.Fatalf("expected cycle error, got %v", err)
+	}
+}
+
+func TestStartListenerProfilesFailure(t *testing.T) {
+	ordered, err := OrderListenerProfiles([]*clientpb.ListenerProfile{
+		{Name: "broken"},
+		{Name: "dependent", DependsOn: []string{"broken"}},
+		{Name: "transitive", DependsOn: []string{"dependent"}},
+		{Name: "unrelated", StartOrder: 1},
+		{Name: "other"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := []string{}
+	err = startListenerProfiles(ordered, func(profile *clientpb.ListenerProfile) ([]*core.Job, error) {
+		if profile.Name == "broken" {
+			return nil, errors.New("address already in use")
+		}
+		started = append(started, profile.Name)
+		return []*core.Job{}, nil
+	})
+	if err == nil {
+		t.Fatal("Expected the failed profiles to be returned")
+	}
+	if got := fmt.Sprint(started); got != "[other unrelated]" {
+		t.Fatalf("Expected only the unrelated profiles to start, got %s", got)
+	}
+	for _, name := range []string{"broken", "dependent", "transitive"} {
+		if !strings.Contains(err.Error(), "'"+name+"'") {
+			t.Fatalf("Expected %s in the error, got %s", name, err)
+		}
+	}
+}
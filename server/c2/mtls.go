@@ -29,9 +29,12 @@ import (
 	"net"
 
 	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/implant/sliver/cryptography/mlkem"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
 	serverHandlers "github.com/bishopfox/sliver/server/handlers"
 	"github.com/bishopfox/sliver/server/log"
 	"google.golang.org/protobuf/proto"
@@ -43,14 +46,18 @@ const (
 
 	// ServerMaxMessageSize - Server-side max GRPC message size
 	ServerMaxMessageSize = (2 * 1024 * 1024 * 1024) - 1
+
+	// mtlsKEMKeyLabel - TLS exporter label of the keying material the hybrid key is derived from
+	mtlsKEMKeyLabel = "EXPORTER-sliver-mtls-kem"
 )
 
 var (
 	mtlsLog = log.NamedLogger("c2", consts.MtlsStr)
 )
 
-// StartMutualTLSListener - Start a mutual TLS listener
-func StartMutualTLSListener(bindIface string, port uint16) (net.Listener, error) {
+// StartMutualTLSListener - Start a mutual TLS listener, with spa only addresses that sent
+// a valid knock can connect (see spa.go)
+func StartMutualTLSListener(bindIface string, port uint16, spa bool, knockPorts []uint16, drain *core.Drain) (net.Listener, error) {
 	mtlsLog.Infof("Starting raw TCP/mTLS listener on %s:%d", bindIface, port)
 	host := bindIface
 	if host == "" {
@@ -61,16 +68,26 @@ func StartMutualTLSListener(bindIface string, port uint16) (net.Listener, error)
 		certs.MtlsC2ServerGenerateECCCertificate(host)
 	}
 	tlsConfig := getServerTLSConfig(host)
-	ln, err := tls.Listen("tcp", fmt.Sprintf("%s:%d", bindIface, port), tlsConfig)
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", bindIface, port))
 	if err != nil {
 		mtlsLog.Error(err)
 		return nil, err
 	}
-	go acceptSliverConnections(ln)
+	if spa {
+		spaLn, err := newSPAListener(ln, bindIface, port, knockPorts)
+		if err != nil {
+			mtlsLog.Error(err)
+			ln.Close()
+			return nil, err
+		}
+		ln = spaLn
+	}
+	ln = tls.NewListener(ln, tlsConfig)
+	go acceptSliverConnections(ln, drain)
 	return ln, nil
 }
 
-func acceptSliverConnections(ln net.Listener) {
+func acceptSliverConnections(ln net.Listener, drain *core.Drain) {
 	for {
 		conn, err := ln.Accept()
 		if err != nil {
@@ -80,13 +97,26 @@ func acceptSliverConnections(ln net.Listener) {
 			mtlsLog.Errorf("Accept failed: %v", err)
 			continue
 		}
-		go handleSliverConnection(conn)
+		go handleSliverConnection(conn, drain)
 	}
 }
 
-func handleSliverConnection(conn net.Conn) {
+func handleSliverConnection(conn net.Conn, drain *core.Drain) {
 	mtlsLog.Infof("Accepted incoming connection: %s", conn.RemoteAddr())
 	implantConn := core.NewImplantConnection(consts.MtlsStr, conn.RemoteAddr().String())
+	implantConn.Drain = drain
+	implantName := mtlsImplantName(conn)
+	if core.BurnList.IsBurned(implantName) {
+		core.BurnedContact(implantName, consts.MtlsStr, conn.RemoteAddr().String())
+		conn.Close()
+		return
+	}
+	sessionKey, err := mtlsSessionKey(conn, implantName)
+	if err != nil {
+		mtlsLog.Errorf("Key exchange failed %v", err)
+		conn.Close()
+		return
+	}
 
 	defer func() {
 		mtlsLog.Debugf("mtls connection closing")
@@ -101,12 +131,17 @@ func handleSliverConnection(conn net.Conn) {
 		}()
 		handlers := serverHandlers.GetHandlers()
 		for {
-			envelope, err := socketReadEnvelope(conn)
+			envelope, err := socketReadEnvelope(conn, sessionKey)
 			if err != nil {
 				mtlsLog.Errorf("Socket read error %v", err)
 				return
 			}
+			if core.BurnList.IsBurned(implantName) {
+				core.BurnedContact(implantName, consts.MtlsStr, conn.RemoteAddr().String())
+				return // Burned after the connection was established
+			}
 			implantConn.UpdateLastMessage()
+			core.Capture.Record(implantConn, core.CaptureRecv, envelope)
 			if envelope.ID != 0 {
 				implantConn.RespMutex.RLock()
 				if resp, ok := implantConn.Resp[envelope.ID]; ok {
@@ -129,7 +164,8 @@ Loop:
 	for {
 		select {
 		case envelope := <-implantConn.Send:
-			err := socketWriteEnvelope(conn, envelope)
+			core.Capture.Record(implantConn, core.CaptureSend, envelope)
+			err := socketWriteEnvelope(conn, sessionKey, implantConn.CompressEnvelope(envelope))
 			if err != nil {
 				mtlsLog.Errorf("Socket write failed %v", err)
 				break Loop
@@ -141,15 +177,85 @@ Loop:
 	mtlsLog.Debugf("Closing implant connection %s", implantConn.ID)
 }
 
+// mtlsImplantName - The implant name is the common name of the client certificate,
+// we complete the handshake early so the burn list can be checked before any messages
+func mtlsImplantName(conn net.Conn) string {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return ""
+	}
+	err := tlsConn.Handshake()
+	if err != nil {
+		mtlsLog.Errorf("TLS handshake failed %v", err)
+		return ""
+	}
+	peerCerts := tlsConn.ConnectionState().PeerCertificates
+	if len(peerCerts) == 0 {
+		return ""
+	}
+	return peerCerts[0].Subject.CommonName
+}
+
+// mtlsSessionKey - Implants built with a hybrid key exchange send a zero length frame
+// followed by the algorithm ID and an ML-KEM ciphertext after the handshake, and then
+// encrypt their envelopes with a key derived from the TLS session and the KEM shared
+// key. The build's key exchange decides if it's required, so it can't be downgraded
+func mtlsSessionKey(conn net.Conn, implantName string) (*[32]byte, error) {
+	build, err := db.ImplantBuildByName(implantName)
+	if err == db.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keyExchange := build.ImplantConfig.KeyExchange
+	if keyExchange != sliverpb.KeyExchangeX25519MLKEM768 {
+		return nil, nil
+	}
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return nil, errors.New("hybrid key exchange requires a tls connection")
+	}
+
+	frame := make([]byte, 4+1+mlkem.CiphertextSize)
+	_, err = io.ReadFull(conn, frame)
+	if err != nil {
+		return nil, err
+	}
+	if binary.LittleEndian.Uint32(frame[:4]) != 0 {
+		return nil, errors.New("missing hybrid key exchange")
+	}
+	kemSharedKey, err := cryptography.KEMFromImplant(keyExchange, frame[4:])
+	if err != nil {
+		return nil, err
+	}
+	state := tlsConn.ConnectionState()
+	tlsKey, err := state.ExportKeyingMaterial(mtlsKEMKeyLabel, nil, 32)
+	if err != nil {
+		return nil, err
+	}
+	var key [32]byte
+	copy(key[:], tlsKey)
+	sessionKey := cryptography.SessionKey(key, kemSharedKey)
+	return &sessionKey, nil
+}
+
 // socketWriteEnvelope - Writes a message to the TLS socket using length prefix framing
 // which is a fancy way of saying we write the length of the message then the message
 // e.g. [uint32 length|message] so the receiver can delimit messages properly
-func socketWriteEnvelope(connection net.Conn, envelope *sliverpb.Envelope) error {
+func socketWriteEnvelope(connection net.Conn, sessionKey *[32]byte, envelope *sliverpb.Envelope) error {
 	data, err := proto.Marshal(envelope)
 	if err != nil {
 		mtlsLog.Errorf("Envelope marshaling error: %v", err)
 		return err
 	}
+	if sessionKey != nil {
+		data, err = cryptography.Encrypt(*sessionKey, data)
+		if err != nil {
+			mtlsLog.Errorf("Envelope encryption error: %v", err)
+			return err
+		}
+	}
 	dataLengthBuf := new(bytes.Buffer)
 	binary.Write(dataLengthBuf, binary.LittleEndian, uint32(len(data)))
 	connection.Write(dataLengthBuf.Bytes())
@@ -159,7 +265,7 @@ func socketWriteEnvelope(connection net.Conn, envelope *sliverpb.Envelope) error
 
 // socketReadEnvelope - Reads a message from the TLS connection using length prefix framing
 // returns messageType, message, and error
-func socketReadEnvelope(connection net.Conn) (*sliverpb.Envelope, error) {
+func socketReadEnvelope(connection net.Conn, sessionKey *[32]byte) (*sliverpb.Envelope, error) {
 	// Read the first four bytes to determine data length
 	dataLengthBuf := make([]byte, 4) // Size of uint32
 	n, err := io.ReadFull(connection, dataLengthBuf)
@@ -183,6 +289,13 @@ func socketReadEnvelope(connection net.Conn) (*sliverpb.Envelope, error) {
 		mtlsLog.Errorf("Socket error (read data): %v", err)
 		return nil, err
 	}
+	if sessionKey != nil {
+		dataBuf, err = cryptography.Decrypt(*sessionKey, dataBuf)
+		if err != nil {
+			mtlsLog.Errorf("Envelope decryption error: %v", err)
+			return nil, err
+		}
+	}
 
 	// Unmarshal the protobuf envelope
 	envelope := &sliverpb.Envelope{}
@@ -191,6 +304,11 @@ func socketReadEnvelope(connection net.Conn) (*sliverpb.Envelope, error) {
 		mtlsLog.Errorf("Un-marshaling envelope error: %v", err)
 		return nil, err
 	}
+	err = core.DecompressEnvelope(envelope)
+	if err != nil {
+		mtlsLog.Errorf("Decompressing envelope error: %v", err)
+		return nil, err
+	}
 	return envelope, nil
 }
 
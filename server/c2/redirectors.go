@@ -0,0 +1,433 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	// RedirectorNginx - nginx server block
+	RedirectorNginx = "nginx"
+	// RedirectorApache - Apache virtual host using mod_rewrite and mod_proxy
+	RedirectorApache = "apache"
+	// RedirectorCaddy - Caddyfile site block
+	RedirectorCaddy = "caddy"
+	// RedirectorHAProxy - HAProxy frontend and backends
+	RedirectorHAProxy = "haproxy"
+
+	defaultRedirectorPort = 80
+
+	// generatedUserAgentPattern - Matches the Chrome user agents generated for implants
+	// when the profile does not specify a fixed user agent
+	generatedUserAgentPattern = `^Mozilla/5\.0 \([^)]+\) AppleWebKit/537\.36 \(KHTML, like Gecko\) Chrome/[0-9.]+ Safari/537\.36$`
+)
+
+var (
+	// RedirectorTypes - Supported redirector config formats
+	RedirectorTypes = []string{RedirectorNginx, RedirectorApache, RedirectorCaddy, RedirectorHAProxy}
+
+	// ErrUnknownRedirectorType - The requested redirector format is not supported
+	ErrUnknownRedirectorType = fmt.Errorf("unknown redirector type, must be one of: %s", strings.Join(RedirectorTypes, ", "))
+	// ErrInvalidRedirectorUpstream - Upstreams must be a scheme and host without a path
+	ErrInvalidRedirectorUpstream = errors.New("upstream must be an http(s) url without a path, e.g. https://10.0.0.1:443")
+)
+
+// RedirectorOptions - Deployment specific settings that are not part of the C2 profile
+type RedirectorOptions struct {
+	Upstream   string // Sliver HTTP(S) listener
+	Decoy      string // Requests that do not match the profile are proxied here, or 404 if blank
+	ServerName string
+	PathPrefix string // Must match the path of the implant's C2 URL, if any
+	Port       uint32
+}
+
+type redirectorRoute struct {
+	Name    string
+	Pattern string
+	Methods []string
+	Checked bool // Nonce and header checks apply to the route
+}
+
+type redirectorHeader struct {
+	Name    string
+	Pattern string
+}
+
+type redirectorUpstream struct {
+	URL      string
+	Host     string // host:port
+	Hostname string
+	Secure   bool
+}
+
+type redirectorProfile struct {
+	Version      int
+	Port         uint32
+	ServerName   string
+	Upstream     *redirectorUpstream
+	Decoy        *redirectorUpstream
+	Routes       []redirectorRoute
+	Headers      []redirectorHeader
+	NoncePattern string
+}
+
+// RenderRedirectorConfig - Render a redirector config that only forwards requests
+// matching the traffic shape of an HTTP C2 config to the upstream listener
+func RenderRedirectorConfig(redirectorType string, c2Config *configs.HTTPC2Config, opts *RedirectorOptions) ([]byte, error) {
+	redirectorTemplate, ok := redirectorTemplates[redirectorType]
+	if !ok {
+		return nil, ErrUnknownRedirectorType
+	}
+	profile, err := newRedirectorProfile(c2Config, opts)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(redirectorType).Funcs(template.FuncMap{
+		"join":   strings.Join,
+		"header": nginxHeaderVar,
+	}).Parse(redirectorTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, profile)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func newRedirectorProfile(c2Config *configs.HTTPC2Config, opts *RedirectorOptions) (*redirectorProfile, error) {
+	upstream, err := parseRedirectorUpstream(opts.Upstream)
+	if err != nil {
+		return nil, err
+	}
+	var decoy *redirectorUpstream
+	if opts.Decoy != "" {
+		decoy, err = parseRedirectorUpstream(opts.Decoy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decoy: %s", err)
+		}
+	}
+	profile := &redirectorProfile{
+		Version:      c2Config.Version,
+		Port:         opts.Port,
+		ServerName:   opts.ServerName,
+		Upstream:     upstream,
+		Decoy:        decoy,
+		Routes:       redirectorRoutes(c2Config.ImplantConfig, opts.PathPrefix),
+		Headers:      redirectorHeaders(c2Config.ImplantConfig),
+		NoncePattern: fmt.Sprintf("(^|&)[%s]=", regexpCharClass(c2Config.ImplantConfig.NonceQueryArgs)),
+	}
+	if profile.Port == 0 {
+		profile.Port = defaultRedirectorPort
+	}
+	return profile, nil
+}
+
+// redirectorRoutes - Mirrors the routes registered by c2Routes, paths are built the same
+// way as the implant: zero or more path segments followed by a file name and extension
+func redirectorRoutes(implantConfig *configs.HTTPC2ImplantConfig, pathPrefix string) []redirectorRoute {
+	getPost := []string{http.MethodGet, http.MethodPost}
+	get := []string{http.MethodGet}
+	routes := []redirectorRoute{
+		{
+			Name:    "start session",
+			Pattern: redirectorPathPattern(pathPrefix, implantConfig.SessionPaths, implantConfig.SessionFiles, implantConfig.StartSessionFileExt, false),
+			Methods: getPost,
+			Checked: true,
+		},
+		{
+			Name:    "session",
+			Pattern: redirectorPathPattern(pathPrefix, implantConfig.SessionPaths, implantConfig.SessionFiles, implantConfig.SessionFileExt, false),
+			Methods: getPost,
+			Checked: true,
+		},
+		{
+			Name:    "poll",
+			Pattern: redirectorPathPattern(pathPrefix, implantConfig.PollPaths, implantConfig.PollFiles, implantConfig.PollFileExt, false),
+			Methods: get,
+			Checked: true,
+		},
+		{
+			Name:    "close",
+			Pattern: redirectorPathPattern(pathPrefix, implantConfig.ClosePaths, implantConfig.CloseFiles, implantConfig.CloseFileExt, false),
+			Methods: get,
+			Checked: true,
+		},
+	}
+	if implantConfig.StagerFileExt != "" && 0 < len(implantConfig.StagerFiles) {
+		// Stager requests can't carry a nonce or the profile headers
+		routes = append(routes, redirectorRoute{
+			Name:    "stager",
+			Pattern: redirectorPathPattern(pathPrefix, implantConfig.StagerPaths, implantConfig.StagerFiles, implantConfig.StagerFileExt, true),
+			Methods: get,
+		})
+	}
+	return routes
+}
+
+// redirectorHeaders - Only headers the implant sends on every request can be required,
+// headers with a probability or restricted to specific methods are optional
+func redirectorHeaders(implantConfig *configs.HTTPC2ImplantConfig) []redirectorHeader {
+	userAgent := generatedUserAgentPattern
+	if implantConfig.UserAgent != "" {
+		userAgent = "^" + redirectorQuoteMeta(implantConfig.UserAgent) + "$"
+	}
+	headers := []redirectorHeader{{Name: "User-Agent", Pattern: userAgent}}
+	for _, header := range implantConfig.Headers {
+		if 0 < len(header.Methods) || (0 < header.Probability && header.Probability < 100) {
+			continue
+		}
+		headers = append(headers, redirectorHeader{
+			Name:    header.Name,
+			Pattern: "^" + redirectorQuoteMeta(header.Value) + "$",
+		})
+	}
+	return headers
+}
+
+func redirectorPathPattern(pathPrefix string, paths []string, files []string, ext string, trailing bool) string {
+	pattern := "^"
+	if prefix := strings.Trim(pathPrefix, "/"); prefix != "" {
+		pattern += "/" + redirectorQuoteMeta(prefix)
+	}
+	pattern += "/"
+	if 0 < len(paths) {
+		pattern += "(?:(?:" + regexpAlternation(paths) + ")/)*"
+	}
+	pattern += "(?:" + regexpAlternation(files) + `)\.` + redirectorQuoteMeta(ext)
+	if trailing {
+		pattern += "(?:/.*)?"
+	}
+	return pattern + "$"
+}
+
+func regexpAlternation(values []string) string {
+	quoted := []string{}
+	for _, value := range values {
+		quoted = append(quoted, redirectorQuoteMeta(value))
+	}
+	return strings.Join(quoted, "|")
+}
+
+func regexpCharClass(chars string) string {
+	var buf strings.Builder
+	for _, char := range chars {
+		if strings.ContainsRune(`\]^-[`, char) {
+			buf.WriteRune('\\')
+		}
+		buf.WriteRune(char)
+	}
+	return buf.String()
+}
+
+func parseRedirectorUpstream(rawURL string) (*redirectorUpstream, error) {
+	upstreamURL, err := url.Parse(rawURL)
+	if err != nil || upstreamURL.Host == "" {
+		return nil, ErrInvalidRedirectorUpstream
+	}
+	if upstreamURL.Scheme != "http" && upstreamURL.Scheme != "https" {
+		return nil, ErrInvalidRedirectorUpstream
+	}
+	if upstreamURL.Path != "" && upstreamURL.Path != "/" {
+		return nil, ErrInvalidRedirectorUpstream
+	}
+	host := upstreamURL.Host
+	if upstreamURL.Port() == "" {
+		if upstreamURL.Scheme == "https" {
+			host += ":443"
+		} else {
+			host += ":80"
+		}
+	}
+	return &redirectorUpstream{
+		URL:      fmt.Sprintf("%s://%s", upstreamURL.Scheme, upstreamURL.Host),
+		Host:     host,
+		Hostname: upstreamURL.Hostname(),
+		Secure:   upstreamURL.Scheme == "https",
+	}, nil
+}
+
+// redirectorQuoteMeta - Escape a literal for use in a pattern, quote characters are hex
+// escaped so patterns can be embedded in any of the config formats without further quoting
+func redirectorQuoteMeta(value string) string {
+	return strings.NewReplacer(`"`, `\x22`, "'", `\x27`, "`", `\x60`).Replace(regexp.QuoteMeta(value))
+}
+
+// nginxHeaderVar - The nginx variable name for a request header
+func nginxHeaderVar(name string) string {
+	return "$http_" + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+}
+
+var redirectorTemplates = map[string]string{
+	RedirectorNginx: `# Sliver redirector for HTTP C2 config version {{.Version}}
+# Requests that do not match the C2 profile are handled by @sliver_decoy
+server {
+    listen {{.Port}};
+    server_name {{if .ServerName}}{{.ServerName}}{{else}}_{{end}};
+    error_page 418 = @sliver_decoy;
+{{range .Routes}}
+    # {{.Name}}
+    location ~ "{{.Pattern}}" {
+        set $sliver_c2 1;
+        if ($request_method !~ "^({{join .Methods "|"}})$") { set $sliver_c2 0; }
+{{- if .Checked}}
+        if ($args !~ "{{$.NoncePattern}}") { set $sliver_c2 0; }
+{{- range $.Headers}}
+        if ({{header .Name}} !~ "{{.Pattern}}") { set $sliver_c2 0; }
+{{- end}}
+{{- end}}
+        if ($sliver_c2 = 0) { return 418; }
+        proxy_pass {{$.Upstream.URL}};
+        proxy_set_header Host $host;
+        proxy_set_header X-Real-IP $remote_addr;
+        proxy_set_header X-Forwarded-For $proxy_add_x_forwarded_for;
+    }
+{{end}}
+    location / {
+        return 418;
+    }
+
+    location @sliver_decoy {
+{{- if .Decoy}}
+        proxy_pass {{.Decoy.URL}};
+        proxy_set_header Host $proxy_host;
+{{- else}}
+        return 404;
+{{- end}}
+    }
+}
+`,
+
+	RedirectorApache: `# Sliver redirector for HTTP C2 config version {{.Version}}
+# Requires mod_rewrite, mod_proxy, and mod_proxy_http (and mod_ssl for https upstreams)
+<VirtualHost *:{{.Port}}>
+{{- if .ServerName}}
+    ServerName {{.ServerName}}
+{{- end}}
+    RewriteEngine On
+    ProxyPreserveHost On
+{{- if or .Upstream.Secure (and .Decoy .Decoy.Secure)}}
+    SSLProxyEngine On
+    SSLProxyVerify none
+    SSLProxyCheckPeerCN off
+    SSLProxyCheckPeerName off
+{{- end}}
+{{range .Routes}}
+    # {{.Name}}
+    RewriteCond %{REQUEST_URI} "{{.Pattern}}"
+    RewriteCond %{REQUEST_METHOD} "^({{join .Methods "|"}})$"
+{{- if .Checked}}
+    RewriteCond %{QUERY_STRING} "{{$.NoncePattern}}"
+{{- range $.Headers}}
+    RewriteCond %{HTTP:{{.Name}}} "{{.Pattern}}"
+{{- end}}
+{{- end}}
+    RewriteRule ^ "{{$.Upstream.URL}}%{REQUEST_URI}" [P,L]
+{{end}}
+    # Decoy
+{{- if .Decoy}}
+    RewriteRule ^ "{{.Decoy.URL}}%{REQUEST_URI}" [P,L]
+{{- else}}
+    RewriteRule ^ - [R=404,L]
+{{- end}}
+</VirtualHost>
+`,
+
+	RedirectorCaddy: `# Sliver redirector for HTTP C2 config version {{.Version}}
+{{if .ServerName}}{{.ServerName}}:{{.Port}}{{else}}:{{.Port}}{{end}} {
+{{- range $index, $route := .Routes}}
+	# {{$route.Name}}
+	@sliver_c2_{{$index}} {
+		path_regexp ` + "`{{$route.Pattern}}`" + `
+		method {{join $route.Methods " "}}
+{{- if $route.Checked}}
+		expression ` + "`{query}.matches(\"{{$.NoncePattern}}\")`" + `
+{{- range $.Headers}}
+		header_regexp {{.Name}} ` + "`{{.Pattern}}`" + `
+{{- end}}
+{{- end}}
+	}
+	handle @sliver_c2_{{$index}} {
+		reverse_proxy {{$.Upstream.URL}}{{if $.Upstream.Secure}} {
+			transport http {
+				tls_insecure_skip_verify
+			}
+		}{{end}}
+	}
+{{end}}
+	# Decoy
+	handle {
+{{- if .Decoy}}
+		reverse_proxy {{.Decoy.URL}} {
+			header_up Host {upstream_hostport}
+		}
+{{- else}}
+		respond 404
+{{- end}}
+	}
+}
+`,
+
+	RedirectorHAProxy: `# Sliver redirector for HTTP C2 config version {{.Version}}
+frontend sliver_redirector
+    bind *:{{.Port}}
+    mode http
+    option forwardfor
+{{- if .ServerName}}
+    acl sliver_host hdr(host) -i {{.ServerName}}
+{{- end}}
+    acl sliver_nonce query -m reg '{{.NoncePattern}}'
+{{- range $index, $header := .Headers}}
+    acl sliver_header_{{$index}} req.hdr({{$header.Name}}) -m reg '{{$header.Pattern}}'
+{{- end}}
+{{range $index, $route := .Routes}}
+    # {{$route.Name}}
+    acl sliver_path_{{$index}} path -m reg '{{$route.Pattern}}'
+    acl sliver_method_{{$index}} method {{join $route.Methods " "}}
+    use_backend sliver_c2 if {{if $.ServerName}}sliver_host {{end}}sliver_path_{{$index}} sliver_method_{{$index}}
+{{- if $route.Checked}} sliver_nonce{{range $hIndex, $header := $.Headers}} sliver_header_{{$hIndex}}{{end}}{{end}}
+{{end}}
+    default_backend sliver_decoy
+
+backend sliver_c2
+    mode http
+    server sliver {{.Upstream.Host}}{{if .Upstream.Secure}} ssl verify none{{end}}
+
+backend sliver_decoy
+    mode http
+{{- if .Decoy}}
+    http-request set-header Host {{.Decoy.Hostname}}
+    server decoy {{.Decoy.Host}}{{if .Decoy.Secure}} ssl verify none sni str({{.Decoy.Hostname}}){{end}}
+{{- else}}
+    http-request deny deny_status 404
+{{- end}}
+`,
+}
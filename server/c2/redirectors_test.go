@@ -0,0 +1,111 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func redirectorTestConfig() *configs.HTTPC2Config {
+	return &configs.HTTPC2Config{
+		Version: 3,
+		ImplantConfig: &configs.HTTPC2ImplantConfig{
+			NonceQueryArgs: "abcdefghijklmnopqrstuvwxyz",
+			Headers: []configs.NameValueProbability{
+				{Name: "Accept-Language", Value: "en-US", Probability: 100},
+				{Name: "X-Optional", Value: "1", Probability: 50},
+			},
+			StagerFileExt:       "woff",
+			StagerFiles:         []string{"attribute_text_w01_regular"},
+			PollFileExt:         "js",
+			PollFiles:           []string{"jquery.min", "main"},
+			PollPaths:           []string{"js", "scripts"},
+			StartSessionFileExt: "html",
+			SessionFileExt:      "php",
+			SessionFiles:        []string{"login", "admin"},
+			SessionPaths:        []string{"php", "api"},
+			CloseFileExt:        "png",
+			CloseFiles:          []string{"favicon"},
+			ClosePaths:          []string{"static"},
+		},
+	}
+}
+
+func TestRedirectorRoutes(t *testing.T) {
+	routes := redirectorRoutes(redirectorTestConfig().ImplantConfig, "/foo/")
+	matches := map[string][]string{
+		"start session": {"/foo/login.html", "/foo/api/php/admin.html"},
+		"session":       {"/foo/login.php", "/foo/php/php/admin.php"},
+		"poll":          {"/foo/jquery.min.js", "/foo/scripts/main.js"},
+		"close":         {"/foo/static/favicon.png"},
+		"stager":        {"/foo/attribute_text_w01_regular.woff", "/foo/attribute_text_w01_regular.woff/dGVzdA"},
+	}
+	misses := []string{"/login.php", "/foo/jqueryXmin.js", "/foo/other/main.js", "/foo/login.php.bak", "/foo/admin"}
+	for _, route := range routes {
+		pattern, err := regexp.Compile(route.Pattern)
+		if err != nil {
+			t.Fatalf("route %s has an invalid pattern %s: %s", route.Name, route.Pattern, err)
+		}
+		for _, uri := range matches[route.Name] {
+			if !pattern.MatchString(uri) {
+				t.Errorf("route %s (%s) should match %s", route.Name, route.Pattern, uri)
+			}
+		}
+		for _, uri := range misses {
+			if pattern.MatchString(uri) {
+				t.Errorf("route %s (%s) should not match %s", route.Name, route.Pattern, uri)
+			}
+		}
+	}
+
+	headers := redirectorHeaders(redirectorTestConfig().ImplantConfig)
+	if len(headers) != 2 || headers[1].Name != "Accept-Language" {
+		t.Fatalf("expected the user agent and required headers only, got %v", headers)
+	}
+	userAgent := regexp.MustCompile(headers[0].Pattern)
+	sample := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/106.0.5249.119 Safari/537.36"
+	if !userAgent.MatchString(sample) {
+		t.Errorf("generated user agent pattern should match %s", sample)
+	}
+}
+
+func TestRenderRedirectorConfig(t *testing.T) {
+	opts := &RedirectorOptions{Upstream: "https://10.0.0.1:8443", Decoy: "https://example.com"}
+	for _, redirectorType := range RedirectorTypes {
+		data, err := RenderRedirectorConfig(redirectorType, redirectorTestConfig(), opts)
+		if err != nil {
+			t.Fatalf("failed to render %s config: %s", redirectorType, err)
+		}
+		if !bytes.Contains(data, []byte("10.0.0.1:8443")) || !bytes.Contains(data, []byte("example.com")) {
+			t.Errorf("%s config is missing the upstream or decoy:\n%s", redirectorType, data)
+		}
+	}
+	_, err := RenderRedirectorConfig("iis", redirectorTestConfig(), opts)
+	if err != ErrUnknownRedirectorType {
+		t.Errorf("expected unknown redirector type error, got %v", err)
+	}
+	_, err = RenderRedirectorConfig(RedirectorNginx, redirectorTestConfig(), &RedirectorOptions{Upstream: "https://10.0.0.1/c2"})
+	if err != ErrInvalidRedirectorUpstream {
+		t.Errorf("expected invalid upstream error, got %v", err)
+	}
+}
@@ -0,0 +1,382 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// DefaultSPAWindow - How long a valid knock lets an address connect for
+	DefaultSPAWindow = 30 * time.Second
+
+	// DefaultSPAClockSkew - Knocks timestamped further than this from the server's clock
+	// are dropped, the nonces of the others are remembered until they'd be dropped anyway
+	DefaultSPAClockSkew = time.Hour
+
+	// spaSequenceTimeout - How long an address has to send the next knock of a sequence
+	spaSequenceTimeout = 10 * time.Second
+	spaSweepInterval   = 10 * time.Second
+
+	// spaMinReadDelay, spaMaxReadDelay - Backoff after failing to read a knock
+	spaMinReadDelay = 100 * time.Millisecond
+	spaMaxReadDelay = 5 * time.Second
+
+	// A knock is [nonce|unix timestamp|hmac-sha256(nonce|timestamp|port)], the port is the
+	// udp port the knock is sent to, so a knock captured on the way to one port of a
+	// sequence (or one listener) can't be sent to another
+	spaNonceSize     = 16
+	spaTimestampSize = 8
+	spaPacketSize    = spaNonceSize + spaTimestampSize + sha256.Size
+)
+
+var (
+	spaLog = log.NamedLogger("c2", "spa")
+)
+
+// SPAGate - The addresses that sent a valid single packet authorization knock sequence
+// to a listener's knock ports, and when they stop being allowed to connect
+type SPAGate struct {
+	key        []byte
+	port       uint16
+	knockPorts []uint16
+	window     time.Duration
+	clockSkew  time.Duration
+	config     *configs.SPAConfig
+
+	allowed  map[string]time.Time
+	sequence map[string]spaProgress
+	nonces   map[string]time.Time
+	mutex    *sync.Mutex
+}
+
+// spaProgress - The knocks of a sequence an address sent so far, and when it sent the last
+type spaProgress struct {
+	knocks int
+	last   time.Time
+}
+
+// NewSPAGate - A gate for the listener on port, addresses have to knock on each of the
+// knock ports in order (the listener's port if there are none). The config's commands
+// (if any) open and close the port in the host's firewall as addresses are allowed and
+// expire.
+func NewSPAGate(key []byte, port uint16, knockPorts []uint16, config *configs.SPAConfig) *SPAGate {
+	window := DefaultSPAWindow
+	if config != nil && 0 < config.Window {
+		window = time.Duration(config.Window) * time.Second
+	}
+	clockSkew := DefaultSPAClockSkew
+	if config != nil && 0 < config.ClockSkew {
+		clockSkew = time.Duration(config.ClockSkew) * time.Second
+	}
+	if len(knockPorts) == 0 {
+		knockPorts = []uint16{port}
+	}
+	return &SPAGate{
+		key:        key,
+		port:       port,
+		knockPorts: knockPorts,
+		window:     window,
+		clockSkew:  clockSkew,
+		config:     config,
+		allowed:    map[string]time.Time{},
+		sequence:   map[string]spaProgress{},
+		nonces:     map[string]time.Time{},
+		mutex:      &sync.Mutex{},
+	}
+}
+
+// Knock - Record a knock sent to knockPort, ip is allowed to connect until the window
+// ends once its knocks complete the sequence. Invalid and replayed knocks are ignored,
+// a valid knock out of order restarts the sequence.
+func (g *SPAGate) Knock(ip net.IP, knockPort uint16, packet []byte, now time.Time) bool {
+	if !verifySPAPacket(g.key, knockPort, packet, now, g.clockSkew) {
+		return false
+	}
+	addr := ip.String()
+	g.mutex.Lock()
+	nonce := string(packet[:spaNonceSize])
+	if _, replayed := g.nonces[nonce]; replayed {
+		g.mutex.Unlock()
+		return false
+	}
+	g.nonces[nonce] = now
+	progress := g.sequence[addr]
+	if spaSequenceTimeout < now.Sub(progress.last) || g.knockPorts[progress.knocks] != knockPort {
+		progress = spaProgress{}
+	}
+	if g.knockPorts[progress.knocks] != knockPort {
+		delete(g.sequence, addr)
+		g.mutex.Unlock()
+		return false
+	}
+	progress.knocks++
+	progress.last = now
+	if progress.knocks < len(g.knockPorts) {
+		g.sequence[addr] = progress
+		g.mutex.Unlock()
+		return true
+	}
+	delete(g.sequence, addr)
+	_, open := g.allowed[addr]
+	g.allowed[addr] = now.Add(g.window)
+	g.mutex.Unlock()
+
+	if !open {
+		spaLog.Infof("Allowing %s to connect to port %d", addr, g.port)
+		g.runCommand(g.allowCommand(), addr)
+	}
+	return true
+}
+
+// Allowed - Has ip knocked recently enough to connect
+func (g *SPAGate) Allowed(ip net.IP, now time.Time) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	until, ok := g.allowed[ip.String()]
+	return ok && now.Before(until)
+}
+
+// Sweep - Forget expired addresses and nonces, the expired addresses are removed from the
+// firewall
+func (g *SPAGate) Sweep(now time.Time) []string {
+	expired := []string{}
+	g.mutex.Lock()
+	for addr, until := range g.allowed {
+		if !now.Before(until) {
+			delete(g.allowed, addr)
+			expired = append(expired, addr)
+		}
+	}
+	for addr, progress := range g.sequence {
+		if spaSequenceTimeout < now.Sub(progress.last) {
+			delete(g.sequence, addr)
+		}
+	}
+	for nonce, seen := range g.nonces {
+		if g.clockSkew*2 < now.Sub(seen) {
+			delete(g.nonces, nonce)
+		}
+	}
+	g.mutex.Unlock()
+
+	for _, addr := range expired {
+		spaLog.Debugf("Knock from %s to port %d expired", addr, g.port)
+		g.runCommand(g.revokeCommand(), addr)
+	}
+	return expired
+}
+
+func (g *SPAGate) allowCommand() string {
+	if g.config == nil {
+		return ""
+	}
+	return g.config.AllowCommand
+}
+
+func (g *SPAGate) revokeCommand() string {
+	if g.config == nil {
+		return ""
+	}
+	return g.config.RevokeCommand
+}
+
+// runCommand - Run a firewall command for addr, it's not a shell so the address can't
+// inject anything (and it was authenticated by the knock anyway)
+func (g *SPAGate) runCommand(command string, addr string) {
+	args := spaCommandArgs(command, addr, g.port)
+	if len(args) == 0 {
+		return
+	}
+	output, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		spaLog.Errorf("%s failed: %s %s", args[0], err, strings.TrimSpace(string(output)))
+	}
+}
+
+func spaCommandArgs(command string, addr string, port uint16) []string {
+	replacer := strings.NewReplacer("{ip}", addr, "{port}", strconv.Itoa(int(port)))
+	args := strings.Fields(command)
+	for index, arg := range args {
+		args[index] = replacer.Replace(arg)
+	}
+	return args
+}
+
+func verifySPAPacket(key []byte, port uint16, packet []byte, now time.Time, clockSkew time.Duration) bool {
+	if len(packet) != spaPacketSize {
+		return false
+	}
+	signed := packet[:spaNonceSize+spaTimestampSize]
+	timestamp := time.Unix(int64(binary.BigEndian.Uint64(signed[spaNonceSize:])), 0)
+	if timestamp.Before(now.Add(-clockSkew)) || timestamp.After(now.Add(clockSkew)) {
+		return false
+	}
+	return hmac.Equal(packet[len(signed):], spaMAC(key, port, signed))
+}
+
+func spaMAC(key []byte, port uint16, signed []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(signed)
+	mac.Write(binary.BigEndian.AppendUint16(nil, port))
+	return mac.Sum(nil)
+}
+
+// spaListener - Knocks are read from the listener's knock ports (by default the udp port
+// with the same number as the tcp listener), tcp connections from addresses that haven't
+// knocked are closed before the tls handshake so scanners can't fingerprint the listener
+type spaListener struct {
+	net.Listener
+	gate   *SPAGate
+	knocks []net.PacketConn
+	done   chan struct{}
+	once   *sync.Once
+}
+
+func newSPAListener(ln net.Listener, bindIface string, port uint16, knockPorts []uint16) (*spaListener, error) {
+	key, err := cryptography.SPAServerKey()
+	if err != nil {
+		return nil, err
+	}
+	gate := NewSPAGate(key, port, knockPorts, configs.GetServerConfig().SPA)
+	spaListener := &spaListener{
+		Listener: ln,
+		gate:     gate,
+		done:     make(chan struct{}),
+		once:     &sync.Once{},
+	}
+	for _, knockPort := range gate.knockPorts {
+		knock, err := net.ListenPacket("udp", fmt.Sprintf("%s:%d", bindIface, knockPort))
+		if err != nil {
+			for _, knock := range spaListener.knocks {
+				knock.Close()
+			}
+			return nil, err
+		}
+		spaListener.knocks = append(spaListener.knocks, knock)
+	}
+	for index, knock := range spaListener.knocks {
+		go spaListener.readKnocks(knock, gate.knockPorts[index])
+	}
+	go spaListener.sweep()
+	return spaListener, nil
+}
+
+// SPAKnockPorts - Validate the knock ports of a listener request, a port can only be
+// knocked once per sequence
+func SPAKnockPorts(knockPorts []uint32) ([]uint16, error) {
+	ports := []uint16{}
+	seen := map[uint32]bool{}
+	for _, port := range knockPorts {
+		if port == 0 || 65535 < port {
+			return nil, fmt.Errorf("invalid knock port %d", port)
+		}
+		if seen[port] {
+			return nil, fmt.Errorf("knock port %d is repeated", port)
+		}
+		seen[port] = true
+		ports = append(ports, uint16(port))
+	}
+	return ports, nil
+}
+
+// Accept - Only returns connections from addresses that have knocked
+func (l *spaListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok && l.gate.Allowed(addr.IP, time.Now()) {
+			return conn, nil
+		}
+		spaLog.Debugf("Dropping connection from %s, it hasn't knocked", conn.RemoteAddr())
+		conn.Close()
+	}
+}
+
+// Close - Stops reading knocks, the addresses that are still allowed are removed from the
+// firewall
+func (l *spaListener) Close() error {
+	l.once.Do(func() {
+		close(l.done)
+		for _, knock := range l.knocks {
+			knock.Close()
+		}
+	})
+	return l.Listener.Close()
+}
+
+// readKnocks - Read the knocks sent to a knock port, read errors back off so one that
+// persists doesn't spin
+func (l *spaListener) readKnocks(knock net.PacketConn, knockPort uint16) {
+	buf := make([]byte, 512)
+	delay := spaMinReadDelay
+	for {
+		n, addr, err := knock.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			spaLog.Warnf("Failed to read knock on port %d: %s", knockPort, err)
+			select {
+			case <-l.done:
+				return
+			case <-time.After(delay):
+			}
+			if delay *= 2; spaMaxReadDelay < delay {
+				delay = spaMaxReadDelay
+			}
+			continue
+		}
+		delay = spaMinReadDelay
+		udpAddr, ok := addr.(*net.UDPAddr)
+		if !ok || !l.gate.Knock(udpAddr.IP, knockPort, buf[:n], time.Now()) {
+			spaLog.Debugf("Ignoring invalid knock from %s on port %d", addr, knockPort)
+		}
+	}
+}
+
+func (l *spaListener) sweep() {
+	ticker := time.NewTicker(spaSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.gate.Sweep(time.Now())
+		case <-l.done:
+			l.gate.Sweep(time.Now().Add(l.gate.window))
+			return
+		}
+	}
+}
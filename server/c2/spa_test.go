@@ -0,0 +1,195 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	implantMTLS "github.com/bishopfox/sliver/implant/sliver/transports/mtls"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func TestSPAGateKnock(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	now := time.Unix(1700000000, 0)
+	gate := NewSPAGate(key, 8888, nil, nil)
+	ip := net.ParseIP("192.0.2.1")
+
+	packet, err := implantMTLS.SPAKnockPacket(key, 8888, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gate.Allowed(ip, now) {
+		t.Fatal("Address allowed before knocking")
+	}
+	if !gate.Knock(ip, 8888, packet, now) {
+		t.Fatal("Valid knock was rejected")
+	}
+	if !gate.Allowed(ip, now.Add(DefaultSPAWindow-time.Second)) || gate.Allowed(net.ParseIP("192.0.2.2"), now) {
+		t.Fatal("Knock allowed the wrong addresses")
+	}
+	if gate.Knock(ip, 8888, packet, now.Add(time.Second)) {
+		t.Fatal("Replayed knock was accepted")
+	}
+
+	otherPort, _ := implantMTLS.SPAKnockPacket(key, 8889, now)
+	otherKey, _ := implantMTLS.SPAKnockPacket([]byte("wrong"), 8888, now)
+	stale, _ := implantMTLS.SPAKnockPacket(key, 8888, now.Add(-DefaultSPAClockSkew-time.Second))
+	for name, packet := range map[string][]byte{
+		"other port": otherPort,
+		"other key":  otherKey,
+		"stale":      stale,
+		"truncated":  packet[:spaPacketSize-1],
+	} {
+		if gate.Knock(net.ParseIP("192.0.2.3"), 8888, packet, now) {
+			t.Errorf("Invalid knock (%s) was accepted", name)
+		}
+	}
+
+	expired := gate.Sweep(now.Add(DefaultSPAWindow))
+	if !reflect.DeepEqual(expired, []string{"192.0.2.1"}) || gate.Allowed(ip, now) {
+		t.Fatalf("Unexpected expired addresses %v", expired)
+	}
+	gate.Sweep(now.Add(DefaultSPAClockSkew * 3))
+	if len(gate.nonces) != 0 {
+		t.Fatalf("Sweep kept %d nonces", len(gate.nonces))
+	}
+}
+
+func TestSPAGateSequence(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	now := time.Unix(1700000000, 0)
+	gate := NewSPAGate(key, 8888, []uint16{7001, 7002, 7003}, nil)
+	ip := net.ParseIP("192.0.2.1")
+	knock := func(port uint16, at time.Time) bool {
+		packet, _ := implantMTLS.SPAKnockPacket(key, port, at)
+		return gate.Knock(ip, port, packet, at)
+	}
+
+	if knock(8888, now) || knock(7002, now) {
+		t.Fatal("Knock on a port that doesn't start the sequence was accepted")
+	}
+	if !knock(7001, now) || !knock(7002, now) || gate.Allowed(ip, now) {
+		t.Fatal("Address allowed before completing the sequence")
+	}
+	if !knock(7003, now) || !gate.Allowed(ip, now) {
+		t.Fatal("Address not allowed after completing the sequence")
+	}
+
+	gate = NewSPAGate(key, 8888, []uint16{7001, 7002}, nil)
+	if !knock(7001, now) || knock(7002, now.Add(spaSequenceTimeout+time.Second)) || gate.Allowed(ip, now) {
+		t.Fatal("Sequence completed after the timeout")
+	}
+	if !knock(7001, now) || knock(7003, now) || knock(7002, now) || gate.Allowed(ip, now) {
+		t.Fatal("Sequence completed after a knock out of order")
+	}
+	wrongPort, _ := implantMTLS.SPAKnockPacket(key, 7001, now)
+	if !knock(7001, now) || gate.Knock(ip, 7002, wrongPort, now) {
+		t.Fatal("Knock for another port was accepted")
+	}
+}
+
+func TestSPAGateConfig(t *testing.T) {
+	gate := NewSPAGate(nil, 8888, nil, &configs.SPAConfig{Window: 5, ClockSkew: 60})
+	if gate.window != 5*time.Second || gate.clockSkew != time.Minute {
+		t.Fatalf("Unexpected window %s and clock skew %s", gate.window, gate.clockSkew)
+	}
+	if !reflect.DeepEqual(gate.knockPorts, []uint16{8888}) {
+		t.Fatalf("Unexpected default knock ports %v", gate.knockPorts)
+	}
+	key := []byte("key")
+	skewed, _ := implantMTLS.SPAKnockPacket(key, 8888, time.Now().Add(-90*time.Second))
+	if verifySPAPacket(key, 8888, skewed, time.Now(), gate.clockSkew) || !verifySPAPacket(key, 8888, skewed, time.Now(), DefaultSPAClockSkew) {
+		t.Fatal("Clock skew not applied")
+	}
+}
+
+func TestSPAKnockPorts(t *testing.T) {
+	ports, err := SPAKnockPorts([]uint32{7001, 7002})
+	if err != nil || !reflect.DeepEqual(ports, []uint16{7001, 7002}) {
+		t.Fatalf("Unexpected knock ports %v (%v)", ports, err)
+	}
+	for _, invalid := range [][]uint32{{0}, {65536}, {7001, 7001}} {
+		if _, err := SPAKnockPorts(invalid); err == nil {
+			t.Errorf("Expected knock ports %v to be invalid", invalid)
+		}
+	}
+	if ports := implantMTLS.SPAKnockPorts("7001:7002", 8888); !reflect.DeepEqual(ports, []uint16{7001, 7002}) {
+		t.Fatalf("Unexpected implant knock ports %v", ports)
+	}
+	if ports := implantMTLS.SPAKnockPorts("", 8888); !reflect.DeepEqual(ports, []uint16{8888}) {
+		t.Fatalf("Unexpected default implant knock ports %v", ports)
+	}
+}
+
+func TestSPACommandArgs(t *testing.T) {
+	args := spaCommandArgs("iptables -I INPUT -s {ip} --dport {port} -j ACCEPT", "192.0.2.1; rm -rf /", 8888)
+	expected := []string{"iptables", "-I", "INPUT", "-s", "192.0.2.1; rm -rf /", "--dport", "8888", "-j", "ACCEPT"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("Unexpected args %q", args)
+	}
+	if len(spaCommandArgs("", "192.0.2.1", 8888)) != 0 {
+		t.Fatal("Blank command has args")
+	}
+}
+
+func TestSPAListener(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gate := NewSPAGate([]byte("key"), 8888, nil, nil)
+	spaLn := tls.NewListener(&spaListener{Listener: ln, gate: gate}, &tls.Config{})
+	defer ln.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := spaLn.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+
+	dropped, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dropped.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := dropped.Read(make([]byte, 1)); err == nil {
+		t.Fatal("Connection without a knock wasn't closed")
+	}
+
+	packet, _ := implantMTLS.SPAKnockPacket([]byte("key"), 8888, time.Now())
+	gate.Knock(net.ParseIP("127.0.0.1"), 8888, packet, time.Now())
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	select {
+	case conn := <-accepted:
+		conn.Close()
+	case <-time.After(5 * time.Second):
+		t.Fatal("Connection after a knock wasn't accepted")
+	}
+}
@@ -0,0 +1,145 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+var (
+	stageLog = log.NamedLogger("c2", "stage")
+)
+
+// StagePolicy - Constraints on who can fetch a stage and how often, every request
+// is recorded whether or not the stage is served
+type StagePolicy struct {
+	JobID       int
+	ProfileName string
+
+	maxFetches uint32
+	expiresAt  time.Time
+	allowNets  []*net.IPNet
+	fetches    uint32
+	mutex      *sync.Mutex
+}
+
+// NewStagePolicy - A policy from the stage listener request, a blank request is unconstrained
+func NewStagePolicy(req *clientpb.StagerListenerReq) (*StagePolicy, error) {
+	policy := &StagePolicy{
+		ProfileName: req.ProfileName,
+		maxFetches:  req.MaxFetches,
+		mutex:       &sync.Mutex{},
+	}
+	if req.ExpiresAt != 0 {
+		policy.expiresAt = time.Unix(req.ExpiresAt, 0)
+	}
+	var err error
+	if policy.allowNets, err = parseCIDRs(req.AllowedIPs); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Constrained - True if the stage is limited to an allow list, a number of fetches,
+// or until it expires, otherwise it is served to anyone who asks
+func (p *StagePolicy) Constrained() bool {
+	return p != nil && (0 < p.maxFetches || !p.expiresAt.IsZero() || 0 < len(p.allowNets))
+}
+
+// allow - Check the constraints for a request from an address, a served request
+// counts towards the maximum number of fetches. Returns the reason if denied.
+func (p *StagePolicy) allow(ip net.IP, now time.Time) (bool, string) {
+	if p == nil {
+		return true, ""
+	}
+	if !p.expiresAt.IsZero() && now.After(p.expiresAt) {
+		return false, "expired"
+	}
+	if 0 < len(p.allowNets) && (ip == nil || !containsIP(p.allowNets, ip)) {
+		return false, "address not allowed"
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if 0 < p.maxFetches && p.maxFetches <= p.fetches {
+		return false, "fetch limit reached"
+	}
+	p.fetches++
+	return true, ""
+}
+
+// Fetch - Check the policy for a request and record the result, the peer address is
+// used for IP pinning and the remote address (which may include proxy headers) is logged
+func (p *StagePolicy) Fetch(protocol string, peerAddr string, remoteAddr string, userAgent string, path string) bool {
+	served, reason := p.allow(peerIP(peerAddr), time.Now())
+	fetch := &models.StageFetch{
+		Protocol:      protocol,
+		RemoteAddress: remoteAddr,
+		UserAgent:     userAgent,
+		Path:          path,
+		Served:        served,
+		Reason:        reason,
+	}
+	if p != nil {
+		fetch.JobID = uint32(p.JobID)
+		fetch.ProfileName = p.ProfileName
+	}
+	recordStageFetch(fetch)
+	return served
+}
+
+func peerIP(addr string) net.IP {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return net.ParseIP(host)
+}
+
+func recordStageFetch(fetch *models.StageFetch) {
+	msg := fmt.Sprintf("Stage (job #%d) fetched via %s by %s", fetch.JobID, fetch.Protocol, fetch.RemoteAddress)
+	if !fetch.Served {
+		msg = fmt.Sprintf("Refused stage (job #%d) request via %s from %s (%s)", fetch.JobID, fetch.Protocol, fetch.RemoteAddress, fetch.Reason)
+	}
+	if fetch.UserAgent != "" {
+		msg += fmt.Sprintf(" [%s]", fetch.UserAgent)
+	}
+	if fetch.Served {
+		stageLog.Info(msg)
+	} else {
+		stageLog.Warn(msg)
+	}
+	err := db.Session().Create(fetch).Error
+	if err != nil {
+		stageLog.Errorf("Failed to save stage fetch: %s", err)
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.StageFetchEvent,
+		Data:      []byte(msg),
+	})
+}
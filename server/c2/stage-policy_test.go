@@ -0,0 +1,62 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestStagePolicy(t *testing.T) {
+	var unconstrained *StagePolicy
+	if served, _ := unconstrained.allow(nil, time.Now()); !served {
+		t.Fatal("expected a nil policy to always serve the stage")
+	}
+
+	now := time.Now()
+	policy, err := NewStagePolicy(&clientpb.StagerListenerReq{
+		MaxFetches: 1,
+		ExpiresAt:  now.Add(time.Hour).Unix(),
+		AllowedIPs: []string{"10.0.0.0/24", "192.168.1.10"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if served, reason := policy.allow(net.ParseIP("172.16.0.1"), now); served || reason != "address not allowed" {
+		t.Fatalf("expected address to be refused, got %v (%s)", served, reason)
+	}
+	if served, _ := policy.allow(net.ParseIP("192.168.1.10"), now); !served {
+		t.Fatal("expected pinned address to be served")
+	}
+	if served, reason := policy.allow(net.ParseIP("10.0.0.5"), now); served || reason != "fetch limit reached" {
+		t.Fatalf("expected single-use stage to be refused, got %v (%s)", served, reason)
+	}
+
+	policy, _ = NewStagePolicy(&clientpb.StagerListenerReq{ExpiresAt: now.Unix()})
+	if served, reason := policy.allow(net.ParseIP("10.0.0.5"), now.Add(time.Second)); served || reason != "expired" {
+		t.Fatalf("expected expired stage to be refused, got %v (%s)", served, reason)
+	}
+
+	if _, err := NewStagePolicy(&clientpb.StagerListenerReq{AllowedIPs: []string{"not-an-ip"}}); err == nil {
+		t.Fatal("expected invalid address to be rejected")
+	}
+}
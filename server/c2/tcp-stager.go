@@ -30,18 +30,18 @@ var (
 )
 
 // StartTCPListener - Start a TCP listener
-func StartTCPListener(bindIface string, port uint16, data []byte) (net.Listener, error) {
+func StartTCPListener(bindIface string, port uint16, data []byte, policy *StagePolicy) (net.Listener, error) {
 	tcpLog.Infof("Starting Raw TCP listener on %s:%d", bindIface, port)
 	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", bindIface, port))
 	if err != nil {
 		mtlsLog.Error(err)
 		return nil, err
 	}
-	go acceptConnections(ln, data)
+	go acceptConnections(ln, data, policy)
 	return ln, nil
 }
 
-func acceptConnections(ln net.Listener, data []byte) {
+func acceptConnections(ln net.Listener, data []byte, policy *StagePolicy) {
 	for {
 		conn, err := ln.Accept()
 		if err != nil {
@@ -51,12 +51,16 @@ func acceptConnections(ln net.Listener, data []byte) {
 			tcpLog.Errorf("Accept failed: %v", err)
 			continue
 		}
-		go handleConnection(conn, data)
+		go handleConnection(conn, data, policy)
 	}
 }
 
-func handleConnection(conn net.Conn, data []byte) {
+func handleConnection(conn net.Conn, data []byte, policy *StagePolicy) {
 	mtlsLog.Infof("Accepted incoming connection: %s", conn.RemoteAddr())
+	if !policy.Fetch("tcp", conn.RemoteAddr().String(), conn.RemoteAddr().String(), "", "") {
+		conn.Close()
+		return
+	}
 	tcpLog.Infof("Sending shellcode (%d)\n", len(data))
 	// Send shellcode
 	conn.Write(data)
@@ -49,7 +49,7 @@ var (
 // then creates a Wireguard device/interface and applies configuration.
 // Go routines are spun up to handle key exchange connections, as well
 // as c2 comms connections.
-func StartWGListener(port uint16, netstackPort uint16, keyExchangeListenPort uint16) (net.Listener, *device.Device, *bytes.Buffer, error) {
+func StartWGListener(port uint16, netstackPort uint16, keyExchangeListenPort uint16, drain *core.Drain) (net.Listener, *device.Device, *bytes.Buffer, error) {
 	wgLog.Infof("Starting Wireguard listener on port: %d", port)
 
 	tun, tNet, err := netstack.CreateNetTUN(
@@ -88,9 +88,8 @@ func StartWGListener(port uint16, netstackPort uint16, keyExchangeListenPort uin
 		return nil, nil, nil, err
 	}
 
-	for k, v := range peers {
-		fmt.Fprintf(wgConf, "public_key=%s\n", k)
-		fmt.Fprintf(wgConf, "allowed_ip=%s/32\n", v)
+	for _, peer := range peers {
+		fmt.Fprint(wgConf, certs.WGPeerIPC(peer.PubKey, peer.TunIP, peer.PresharedKey))
 	}
 
 	// Set wg device config
@@ -120,7 +119,7 @@ func StartWGListener(port uint16, netstackPort uint16, keyExchangeListenPort uin
 		return nil, nil, nil, err
 	}
 	wgLog.Printf("Successfully setup up wg sliver listener")
-	go acceptWGSliverConnections(listener)
+	go acceptWGSliverConnections(listener, drain)
 	return listener, dev, wgConf, nil
 }
 
@@ -144,7 +143,9 @@ func acceptKeyExchangeConnection(ln net.Listener) {
 
 // handleKeyExchangeConnection - Retrieve current wg server pub key.
 // Generate new implant wg keys. Generate new unique IP for implant.
-// Write all retrieved data to socket connection.
+// Write all retrieved data to socket connection. If the implant's
+// initial peer has a preshared key, so does the new peer and it's
+// appended to the message.
 func handleKeyExchangeConnection(conn net.Conn) {
 	wgLog.Infof("Handling connection to key exchange listener")
 
@@ -154,7 +155,17 @@ func handleKeyExchangeConnection(conn net.Conn) {
 		wgLog.Errorf("Failed to generate unique IP: %s", err)
 	}
 
-	implantPrivKey, _, err := certs.ImplantGenerateWGKeys(ip.String())
+	presharedKey := ""
+	peerIP, _, _ := net.SplitHostPort(conn.RemoteAddr().String())
+	if peer, err := certs.GetWGPeerByTunIP(peerIP); err == nil && peer.PresharedKey != "" {
+		presharedKey, err = certs.GenerateWGPresharedKey()
+		if err != nil {
+			wgLog.Errorf("Failed to generate wg preshared key: %s", err)
+			return
+		}
+	}
+
+	implantPrivKey, _, err := certs.ImplantGenerateWGKeys(ip.String(), presharedKey)
 	if err != nil {
 		wgLog.Errorf("Failed to generate new wg keys: %s", err)
 	}
@@ -165,12 +176,15 @@ func handleKeyExchangeConnection(conn net.Conn) {
 	} else {
 		wgLog.Infof("Successfully generated new wg keys")
 		message := implantPrivKey + "|" + serverPubKey + "|" + string(ip)
+		if presharedKey != "" {
+			message += "|" + presharedKey
+		}
 		wgLog.Debugf("Sending new wg keys and IP: %s", message)
 		conn.Write([]byte(message))
 	}
 }
 
-func acceptWGSliverConnections(ln net.Listener) {
+func acceptWGSliverConnections(ln net.Listener, drain *core.Drain) {
 	for {
 		conn, err := ln.Accept()
 		if err != nil {
@@ -180,14 +194,15 @@ func acceptWGSliverConnections(ln net.Listener) {
 			wgLog.Errorf("Accept failed: %v", err)
 			continue
 		}
-		go handleWGSliverConnection(conn)
+		go handleWGSliverConnection(conn, drain)
 	}
 }
 
-func handleWGSliverConnection(conn net.Conn) {
+func handleWGSliverConnection(conn net.Conn, drain *core.Drain) {
 	wgLog.Infof("Accepted incoming connection: %s", conn.RemoteAddr())
 
 	implantConn := core.NewImplantConnection("wg", conn.RemoteAddr().String())
+	implantConn.Drain = drain
 	implantConn.UpdateLastMessage()
 	defer func() {
 		implantConn.Cleanup()
@@ -207,6 +222,7 @@ func handleWGSliverConnection(conn net.Conn) {
 				return
 			}
 			implantConn.UpdateLastMessage()
+			core.Capture.Record(implantConn, core.CaptureRecv, envelope)
 			if envelope.ID != 0 {
 				implantConn.RespMutex.RLock()
 				if resp, ok := implantConn.Resp[envelope.ID]; ok {
@@ -228,7 +244,8 @@ Loop:
 	for {
 		select {
 		case envelope := <-implantConn.Send:
-			err := socketWGWriteEnvelope(conn, envelope)
+			core.Capture.Record(implantConn, core.CaptureSend, envelope)
+			err := socketWGWriteEnvelope(conn, implantConn.CompressEnvelope(envelope))
 			if err != nil {
 				wgLog.Errorf("Socket write failed %s", err)
 				break Loop
@@ -271,11 +288,11 @@ func socketWGReadEnvelope(connection net.Conn) (*sliverpb.Envelope, error) {
 	}
 	dataLength := int(binary.LittleEndian.Uint32(dataLengthBuf))
 
-	if dataLength <= 0 {
+	if dataLength <= 0 || ServerMaxMessageSize < dataLength {
 		// {{if .Config.Debug}}
 		wgLog.Errorf("[wireguard] read error: %s\n", err)
 		// {{end}}
-		return nil, errors.New("[wireguard] zero data length")
+		return nil, errors.New("[wireguard] invalid data length")
 	}
 
 	dataBuf := make([]byte, dataLength)
@@ -293,5 +310,10 @@ func socketWGReadEnvelope(connection net.Conn) (*sliverpb.Envelope, error) {
 		wgLog.Errorf("Un-marshaling envelope error: %v", err)
 		return nil, err
 	}
+	err = core.DecompressEnvelope(envelope)
+	if err != nil {
+		wgLog.Errorf("Decompressing envelope error: %v", err)
+		return nil, err
+	}
 	return envelope, nil
 }
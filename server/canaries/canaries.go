@@ -0,0 +1,304 @@
+package canaries
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	insecureRand "math/rand"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// TypeHTTP - A URL that triggers when it is requested
+	TypeHTTP = "http"
+	// TypeAWS - AWS credentials that trigger when they are used
+	TypeAWS = "aws"
+	// TypeDocument - A Word document that triggers when it is opened
+	TypeDocument = "document"
+
+	// alertInterval - Minimum time between alerts for the same canary
+	alertInterval = time.Minute
+)
+
+var (
+	canaryLog = log.NamedLogger("canaries", "tokens")
+
+	// ErrInvalidType - Unsupported canary type
+	ErrInvalidType = fmt.Errorf("invalid canary type, must be one of: %s, %s, %s", TypeHTTP, TypeAWS, TypeDocument)
+	// ErrInvalidURL - Canaries must point at an http(s) listener
+	ErrInvalidURL = errors.New("invalid url, must be the http(s) url of a listener")
+	// ErrCanaryNotFound - No canary with the given ID
+	ErrCanaryNotFound = errors.New("canary not found")
+
+	// urlDirs - Plausible directories for canary URLs
+	urlDirs = []string{"assets", "static", "images", "media", "content", "files"}
+
+	awsCredentialPattern = regexp.MustCompile(`Credential=(AKIA[A-Z2-7]{16})/`)
+
+	tokens = &tokenCache{
+		tokens:     map[string]bool{},
+		lastAlerts: map[string]time.Time{},
+		mutex:      &sync.RWMutex{},
+	}
+)
+
+// tokenCache - Listeners consult the cache for every request that does not match the
+// C2 profile, so the database is only queried for requests that trigger a canary
+type tokenCache struct {
+	tokens     map[string]bool
+	lastAlerts map[string]time.Time
+	mutex      *sync.RWMutex
+	loadOnce   sync.Once
+}
+
+func (t *tokenCache) load() {
+	t.loadOnce.Do(func() {
+		canaries, err := db.ListCanaryTokens()
+		if err != nil {
+			canaryLog.Errorf("Failed to load canary tokens: %s", err)
+			return
+		}
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		for _, canary := range canaries {
+			t.tokens[canary.Token] = true
+		}
+	})
+}
+
+func (t *tokenCache) contains(token string) bool {
+	t.load()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.tokens[token]
+}
+
+func (t *tokenCache) add(token string) {
+	t.load()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.tokens[token] = true
+}
+
+func (t *tokenCache) remove(token string) {
+	t.load()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.tokens, token)
+	delete(t.lastAlerts, token)
+}
+
+// shouldAlert - Rate limit alerts so a canary that is requested repeatedly
+// (e.g. by a sandbox) does not flood every operator's console
+func (t *tokenCache) shouldAlert(token string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if last, ok := t.lastAlerts[token]; ok && time.Since(last) < alertInterval {
+		return false
+	}
+	t.lastAlerts[token] = time.Now()
+	return true
+}
+
+// New - Generate a canary, documents and AWS credentials are returned in the File field
+func New(req *clientpb.CanaryTokenReq) (*clientpb.CanaryToken, error) {
+	baseURL, err := url.Parse(req.URL)
+	if err != nil || (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return nil, ErrInvalidURL
+	}
+	baseURL.Path = strings.TrimSuffix(baseURL.Path, "/")
+	baseURL.RawQuery = ""
+	baseURL.Fragment = ""
+
+	canary := &models.CanaryToken{Type: req.Type, Name: req.Name}
+	var file *commonpb.File
+	switch req.Type {
+	case TypeHTTP:
+		canary.Token = randomToken()
+		canary.URL = canaryURL(baseURL, canary.Token, ".pdf")
+	case TypeDocument:
+		canary.Token = randomToken()
+		canary.URL = canaryURL(baseURL, canary.Token, ".gif")
+		file, err = document(req.Name, canary.URL)
+		if err != nil {
+			return nil, err
+		}
+	case TypeAWS:
+		var secret string
+		canary.Token, secret = awsKeyPair()
+		canary.URL = baseURL.String()
+		file = awsCredentials(canary.Token, secret, canary.URL)
+	default:
+		return nil, ErrInvalidType
+	}
+	err = db.Session().Create(canary).Error
+	if err != nil {
+		return nil, err
+	}
+	tokens.add(canary.Token)
+	canaryLog.Infof("Generated %s canary %s (%s)", canary.Type, canary.Name, canary.URL)
+	pbCanary := canary.ToProtobuf()
+	pbCanary.File = file
+	return pbCanary, nil
+}
+
+// List - All canary tokens
+func List() ([]*clientpb.CanaryToken, error) {
+	canaries, err := db.ListCanaryTokens()
+	if err != nil {
+		return nil, err
+	}
+	pbCanaries := []*clientpb.CanaryToken{}
+	for _, canary := range canaries {
+		pbCanaries = append(pbCanaries, canary.ToProtobuf())
+	}
+	return pbCanaries, nil
+}
+
+// Remove - Remove a canary token, requests for it are no longer alerted
+func Remove(id string) error {
+	canaryID := uuid.FromStringOrNil(id)
+	if canaryID == uuid.Nil {
+		return ErrCanaryNotFound
+	}
+	canary := &models.CanaryToken{}
+	err := db.Session().Where(&models.CanaryToken{ID: canaryID}).First(canary).Error
+	if err != nil {
+		return ErrCanaryNotFound
+	}
+	err = db.Session().Delete(canary).Error
+	if err != nil {
+		return err
+	}
+	tokens.remove(canary.Token)
+	return nil
+}
+
+// HandleHTTP - Called by http(s) listeners for requests that do not match the C2 profile,
+// returns true if the request triggered a canary and a decoy response was written
+func HandleHTTP(resp http.ResponseWriter, req *http.Request) bool {
+	if match := awsCredentialPattern.FindStringSubmatch(req.Header.Get("Authorization")); match != nil && tokens.contains(match[1]) {
+		trigger(match[1], req)
+		resp.Header().Set("Content-Type", "text/xml")
+		resp.WriteHeader(http.StatusForbidden)
+		requestID, _ := uuid.NewV4()
+		fmt.Fprintf(resp, awsErrorResponse, requestID)
+		return true
+	}
+	token := path.Base(req.URL.Path)
+	token = strings.TrimSuffix(token, path.Ext(token))
+	if token == "" || !tokens.contains(token) {
+		return false
+	}
+	canary := trigger(token, req)
+	if canary != nil && canary.Type == TypeDocument {
+		resp.Header().Set("Content-Type", "image/gif")
+		resp.Write(transparentGIF)
+		return true
+	}
+	resp.WriteHeader(http.StatusNotFound)
+	return true
+}
+
+// trigger - Update a canary and alert operators through the canary event
+func trigger(token string, req *http.Request) *models.CanaryToken {
+	canary, err := db.CanaryTokenByToken(token)
+	if err != nil {
+		canaryLog.Errorf("Failed to find canary token: %s", err)
+		return nil
+	}
+	source := requestSource(req)
+	canaryLog.Warnf("%s canary %s (%s) triggered by %s", canary.Type, canary.Name, canary.ID, source)
+	if !canary.Triggered {
+		canary.Triggered = true
+		canary.FirstTrigger = time.Now()
+	}
+	canary.LatestTrigger = time.Now()
+	canary.Count++
+	canary.LastSource = source
+	err = db.Session().Save(canary).Error
+	if err != nil {
+		canaryLog.Errorf("Failed to update canary token: %s", err)
+	}
+	if tokens.shouldAlert(token) {
+		name := canary.Name
+		if name == "" {
+			name = strings.Split(canary.ID.String(), "-")[0]
+		}
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.CanaryEvent,
+			Data:      []byte(fmt.Sprintf("%s canary %s triggered by %s", canary.Type, name, source)),
+		})
+	}
+	return canary
+}
+
+// requestSource - The remote address (and forwarded address when behind a redirector)
+// and user agent of a request
+func requestSource(req *http.Request) string {
+	source := req.RemoteAddr
+	if forwarded := req.Header.Get("X-Forwarded-For"); forwarded != "" {
+		source = fmt.Sprintf("%s (via %s)", strings.TrimSpace(strings.Split(forwarded, ",")[0]), req.RemoteAddr)
+	}
+	if userAgent := req.UserAgent(); userAgent != "" {
+		source = fmt.Sprintf("%s, %s", source, userAgent)
+	}
+	return source
+}
+
+func canaryURL(baseURL *url.URL, token string, ext string) string {
+	canaryURL := *baseURL
+	canaryURL.Path = path.Join("/", baseURL.Path, urlDirs[insecureRand.Intn(len(urlDirs))], token+ext)
+	return canaryURL.String()
+}
+
+// randomToken - 16 random lower case alphanumeric characters
+func randomToken() string {
+	buf := make([]byte, 10)
+	rand.Read(buf)
+	return strings.ToLower(base32.StdEncoding.EncodeToString(buf))
+}
+
+// awsKeyPair - An access key ID and secret access key in the format AWS uses
+func awsKeyPair() (string, string) {
+	keyID := make([]byte, 10)
+	rand.Read(keyID)
+	secret := make([]byte, 30)
+	rand.Read(secret)
+	return "AKIA" + base32.StdEncoding.EncodeToString(keyID), base64.StdEncoding.EncodeToString(secret)
+}
@@ -0,0 +1,127 @@
+package canaries
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+)
+
+func TestHTTPCanary(t *testing.T) {
+	canary, err := New(&clientpb.CanaryTokenReq{Type: TypeHTTP, Name: "wiki", URL: "https://cdn.example.com/base/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(canary.URL, "https://cdn.example.com/base/") || !strings.HasSuffix(canary.URL, ".pdf") {
+		t.Fatalf("Unexpected canary url %s", canary.URL)
+	}
+	defer Remove(canary.ID)
+
+	resp := httptest.NewRecorder()
+	if HandleHTTP(resp, httptest.NewRequest(http.MethodGet, "/base/static/index.html", nil)) {
+		t.Fatal("Expected other requests to not trigger the canary")
+	}
+	canaryURL, _ := url.Parse(canary.URL)
+	req := httptest.NewRequest(http.MethodGet, canaryURL.Path, nil)
+	req.Header.Set("User-Agent", "curl/8.0")
+	if !HandleHTTP(resp, req) || resp.Code != http.StatusNotFound {
+		t.Fatalf("Expected canary to be triggered with a 404, got %d", resp.Code)
+	}
+	dbCanary, err := db.CanaryTokenByToken(strings.TrimSuffix(canaryURL.Path[strings.LastIndex(canaryURL.Path, "/")+1:], ".pdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dbCanary.Triggered || dbCanary.Count != 1 || !strings.Contains(dbCanary.LastSource, "curl/8.0") {
+		t.Fatalf("Unexpected canary state %v", dbCanary)
+	}
+
+	err = Remove(canary.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HandleHTTP(httptest.NewRecorder(), req) {
+		t.Fatal("Expected removed canary to not trigger")
+	}
+}
+
+func TestAWSCanary(t *testing.T) {
+	canary, err := New(&clientpb.CanaryTokenReq{Type: TypeAWS, URL: "https://api.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Remove(canary.ID)
+	if len(canary.AccessKeyID) != 20 || !strings.Contains(string(canary.File.Data), "endpoint_url = https://api.example.com") {
+		t.Fatalf("Unexpected aws canary %v", canary)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+canary.AccessKeyID+"/20230915/us-east-1/sts/aws4_request, SignedHeaders=host, Signature=00")
+	resp := httptest.NewRecorder()
+	if !HandleHTTP(resp, req) || resp.Code != http.StatusForbidden || !strings.Contains(resp.Body.String(), "InvalidClientTokenId") {
+		t.Fatalf("Expected aws canary to be triggered, got %d", resp.Code)
+	}
+}
+
+func TestDocumentCanary(t *testing.T) {
+	canary, err := New(&clientpb.CanaryTokenReq{Type: TypeDocument, Name: "Q3 Salaries", URL: "http://cdn.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Remove(canary.ID)
+	if canary.File.Name != "Q3 Salaries.docx" {
+		t.Fatalf("Unexpected document name %s", canary.File.Name)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(canary.File.Data), int64(len(canary.File.Data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := map[string]string{}
+	for _, file := range archive.File {
+		reader, _ := file.Open()
+		content, _ := io.ReadAll(reader)
+		parts[file.Name] = string(content)
+	}
+	if !strings.Contains(parts["word/document.xml"], `r:link="rId1"`) {
+		t.Fatal("Expected document to contain a linked picture")
+	}
+	rels := parts["word/_rels/document.xml.rels"]
+	if !strings.Contains(rels, `Target="`+canary.URL+`"`) || !strings.Contains(rels, `TargetMode="External"`) {
+		t.Fatal("Expected document to link the canary url")
+	}
+
+	canaryURL, _ := url.Parse(canary.URL)
+	resp := httptest.NewRecorder()
+	if !HandleHTTP(resp, httptest.NewRequest(http.MethodGet, canaryURL.Path, nil)) || resp.Header().Get("Content-Type") != "image/gif" {
+		t.Fatal("Expected document canary to be served a picture")
+	}
+
+	_, err = New(&clientpb.CanaryTokenReq{Type: TypeDocument, URL: "ftp://cdn.example.com"})
+	if err != ErrInvalidURL {
+		t.Fatalf("Expected invalid url, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package certs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	cloudflareAPIURL = "https://api.cloudflare.com/client/v4"
+	cloudflareTXTTTL = 120
+)
+
+// CloudflareProvider - DNS-01 provider using the Cloudflare v4 API
+type CloudflareProvider struct {
+	APIToken string
+	ZoneID   string
+
+	baseURL string
+	client  *http.Client
+}
+
+type cloudflareResponse struct {
+	Success bool              `json:"success"`
+	Errors  []cloudflareError `json:"errors"`
+	Result  json.RawMessage   `json:"result"`
+}
+
+type cloudflareError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type cloudflareRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+}
+
+// NewCloudflareProvider - Create a Cloudflare provider from the server config
+func NewCloudflareProvider(config *configs.CloudflareConfig) *CloudflareProvider {
+	return &CloudflareProvider{
+		APIToken: config.APIToken,
+		ZoneID:   config.ZoneID,
+		baseURL:  cloudflareAPIURL,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Present - Create one TXT record per value
+func (c *CloudflareProvider) Present(ctx context.Context, fqdn string, values []string) error {
+	zoneID, err := c.zoneID(ctx, fqdn)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		record := &cloudflareRecord{Type: "TXT", Name: fqdn, Content: value, TTL: cloudflareTXTTTL}
+		err := c.do(ctx, http.MethodPost, "/zones/"+zoneID+"/dns_records", record, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanUp - Delete the TXT records created by Present
+func (c *CloudflareProvider) CleanUp(ctx context.Context, fqdn string, values []string) error {
+	zoneID, err := c.zoneID(ctx, fqdn)
+	if err != nil {
+		return err
+	}
+	records := []*cloudflareRecord{}
+	query := url.Values{"type": {"TXT"}, "name": {fqdn}}
+	err = c.do(ctx, http.MethodGet, "/zones/"+zoneID+"/dns_records?"+query.Encode(), nil, &records)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		for _, value := range values {
+			if strings.Trim(record.Content, "\"") == value {
+				err := c.do(ctx, http.MethodDelete, "/zones/"+zoneID+"/dns_records/"+record.ID, nil, nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// zoneID - Use the configured zone, or find the closest zone that contains the name
+func (c *CloudflareProvider) zoneID(ctx context.Context, fqdn string) (string, error) {
+	if c.ZoneID != "" {
+		return c.ZoneID, nil
+	}
+	labels := strings.Split(strings.TrimSuffix(fqdn, "."), ".")
+	for index := 0; index < len(labels)-1; index++ {
+		zones := []struct {
+			ID string `json:"id"`
+		}{}
+		query := url.Values{"name": {strings.Join(labels[index:], ".")}}
+		err := c.do(ctx, http.MethodGet, "/zones?"+query.Encode(), nil, &zones)
+		if err != nil {
+			return "", err
+		}
+		if 0 < len(zones) {
+			return zones[0].ID, nil
+		}
+	}
+	return "", fmt.Errorf("no cloudflare zone found for %s", fqdn)
+}
+
+func (c *CloudflareProvider) do(ctx context.Context, method string, path string, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.APIToken)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	cfResp := &cloudflareResponse{}
+	err = json.NewDecoder(resp.Body).Decode(cfResp)
+	if err != nil {
+		return fmt.Errorf("cloudflare api returned %s", resp.Status)
+	}
+	if !cfResp.Success {
+		messages := []string{}
+		for _, cfErr := range cfResp.Errors {
+			messages = append(messages, fmt.Sprintf("%d: %s", cfErr.Code, cfErr.Message))
+		}
+		return fmt.Errorf("cloudflare api error (%s)", strings.Join(messages, ", "))
+	}
+	if result != nil {
+		return json.Unmarshal(cfResp.Result, result)
+	}
+	return nil
+}
@@ -0,0 +1,469 @@
+package certs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// ACMEDNSProviderRoute53 - AWS Route53 DNS-01 provider
+	ACMEDNSProviderRoute53 = "route53"
+	// ACMEDNSProviderCloudflare - Cloudflare DNS-01 provider
+	ACMEDNSProviderCloudflare = "cloudflare"
+
+	acmeDNSDirName          = "dns-01"
+	acmeDNSAccountKeyName   = "account.key"
+	acmeDNSRenewBefore      = 30 * 24 * time.Hour
+	acmeDNSOrderTimeout     = 10 * time.Minute
+	defaultDNSPropagation   = 30 * time.Second
+	acmeChallengeLabel      = "_acme-challenge."
+	acmeDNSRenewCheckPeriod = 12 * time.Hour
+)
+
+var (
+	// ErrUnknownDNSProvider - No DNS-01 provider with the given name
+	ErrUnknownDNSProvider = errors.New("unknown acme dns provider")
+	// ErrDNSProviderNotConfigured - The provider is missing from the server config
+	ErrDNSProviderNotConfigured = errors.New("acme dns provider is not configured, see the 'acme' section of the server config")
+	// ErrNoACMEDomains - At least one certificate name is required
+	ErrNoACMEDomains = errors.New("no domains to request a certificate for")
+
+	acmeDNSManagers     = map[string]*ACMEDNSManager{}
+	acmeDNSManagersLock = &sync.Mutex{}
+)
+
+// DNSProvider - Creates and removes the TXT records used to answer DNS-01 challenges,
+// a record may need multiple values (e.g. example.com and *.example.com)
+type DNSProvider interface {
+	Present(ctx context.Context, fqdn string, values []string) error
+	CleanUp(ctx context.Context, fqdn string, values []string) error
+}
+
+// ACMEDNSProviders - Names of the supported DNS-01 providers
+func ACMEDNSProviders() []string {
+	return []string{ACMEDNSProviderRoute53, ACMEDNSProviderCloudflare}
+}
+
+// GetDNSProvider - Get a DNS-01 provider using the credentials from the server config
+func GetDNSProvider(name string, acmeConfig *configs.ACMEConfig) (DNSProvider, error) {
+	if acmeConfig == nil {
+		return nil, ErrDNSProviderNotConfigured
+	}
+	switch strings.ToLower(name) {
+	case ACMEDNSProviderRoute53:
+		if acmeConfig.Route53 == nil {
+			return nil, ErrDNSProviderNotConfigured
+		}
+		return NewRoute53Provider(acmeConfig.Route53)
+	case ACMEDNSProviderCloudflare:
+		if acmeConfig.Cloudflare == nil || acmeConfig.Cloudflare.APIToken == "" {
+			return nil, ErrDNSProviderNotConfigured
+		}
+		return NewCloudflareProvider(acmeConfig.Cloudflare), nil
+	}
+	return nil, fmt.Errorf("%w '%s'", ErrUnknownDNSProvider, name)
+}
+
+// ACMEDNSManager - Obtains and renews a certificate (which may include wildcard names)
+// using DNS-01 challenges, so no inbound port 80 is required.
+type ACMEDNSManager struct {
+	Domains []string
+
+	provider    DNSProvider
+	config      *configs.ACMEConfig
+	cert        *tls.Certificate
+	certLock    *sync.RWMutex
+	renewLock   *sync.Mutex
+	propagation time.Duration
+
+	// ready is closed once the first certificate is loaded or requested, err is
+	// then the result. refs is guarded by acmeDNSManagersLock.
+	ready  chan struct{}
+	err    error
+	refs   int
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newACMEDNSManager(domains []string, provider DNSProvider, acmeConfig *configs.ACMEConfig) *ACMEDNSManager {
+	ctx, cancel := context.WithCancel(context.Background())
+	manager := &ACMEDNSManager{
+		Domains:     domains,
+		provider:    provider,
+		config:      acmeConfig,
+		certLock:    &sync.RWMutex{},
+		renewLock:   &sync.Mutex{},
+		propagation: defaultDNSPropagation,
+		ready:       make(chan struct{}),
+		refs:        1,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	if 0 < acmeConfig.PropagationSeconds {
+		manager.propagation = time.Duration(acmeConfig.PropagationSeconds) * time.Second
+	}
+	return manager
+}
+
+// GetACMEDNSManager - Get the certificate manager for a set of names, the certificate is
+// loaded from the cache or requested before this function returns. Managers are shared
+// between listeners with the same names, each listener must Release its manager when
+// it's stopped.
+func GetACMEDNSManager(domains []string, providerName string) (*ACMEDNSManager, error) {
+	domains = normalizeACMEDomains(domains)
+	if len(domains) == 0 {
+		return nil, ErrNoACMEDomains
+	}
+	key := strings.Join(domains, ",")
+	acmeDNSManagersLock.Lock()
+	if manager, ok := acmeDNSManagers[key]; ok {
+		manager.refs++
+		acmeDNSManagersLock.Unlock()
+		<-manager.ready
+		if manager.err != nil {
+			manager.Release()
+			return nil, manager.err
+		}
+		return manager, nil
+	}
+	acmeConfig := configs.GetServerConfig().ACME
+	provider, err := GetDNSProvider(providerName, acmeConfig)
+	if err != nil {
+		acmeDNSManagersLock.Unlock()
+		return nil, err
+	}
+	manager := newACMEDNSManager(domains, provider, acmeConfig)
+	acmeDNSManagers[key] = manager
+	acmeDNSManagersLock.Unlock()
+
+	// The order can take minutes, listeners for other names aren't blocked meanwhile
+	cert, err := manager.loadCachedCertificate()
+	if err != nil || needsRenewal(cert) {
+		acmeLog.Infof("Requesting certificate for %s via dns-01 (%s)", key, providerName)
+		cert, err = manager.obtain()
+	}
+	if err != nil {
+		manager.err = err
+		close(manager.ready)
+		manager.Release()
+		return nil, err
+	}
+	manager.certLock.Lock()
+	manager.cert = cert
+	manager.certLock.Unlock()
+	close(manager.ready)
+	go manager.renewLoop()
+	return manager, nil
+}
+
+// Release - The listener using the manager was stopped, the certificate is no longer
+// renewed once every listener sharing the manager released it
+func (m *ACMEDNSManager) Release() {
+	acmeDNSManagersLock.Lock()
+	defer acmeDNSManagersLock.Unlock()
+	m.refs--
+	if 0 < m.refs {
+		return
+	}
+	key := strings.Join(m.Domains, ",")
+	if acmeDNSManagers[key] == m {
+		delete(acmeDNSManagers, key)
+	}
+	m.cancel()
+}
+
+// GetCertificate - tls.Config callback
+func (m *ACMEDNSManager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	m.certLock.RLock()
+	defer m.certLock.RUnlock()
+	return m.cert, nil
+}
+
+func (m *ACMEDNSManager) renewLoop() {
+	ticker := time.NewTicker(acmeDNSRenewCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		m.certLock.RLock()
+		renew := needsRenewal(m.cert)
+		m.certLock.RUnlock()
+		if !renew {
+			continue
+		}
+		acmeLog.Infof("Renewing certificate for %s", strings.Join(m.Domains, ","))
+		cert, err := m.obtain()
+		if err != nil {
+			if m.ctx.Err() != nil {
+				return
+			}
+			acmeLog.Errorf("Failed to renew certificate for %s: %s", strings.Join(m.Domains, ","), err)
+			continue
+		}
+		m.certLock.Lock()
+		m.cert = cert
+		m.certLock.Unlock()
+	}
+}
+
+// obtain - Run an ACME order for all the manager's names and cache the result
+func (m *ACMEDNSManager) obtain() (*tls.Certificate, error) {
+	m.renewLock.Lock()
+	defer m.renewLock.Unlock()
+
+	ctx, cancel := context.WithTimeout(m.ctx, acmeDNSOrderTimeout)
+	defer cancel()
+	client, err := m.acmeClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(m.Domains...))
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect every pending dns-01 challenge first, a wildcard and its base domain
+	// share a single TXT record name so all values must be published together.
+	records := map[string][]string{}
+	pending := []*acme.Challenge{}
+	authzURLs := []string{}
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return nil, err
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == "dns-01" {
+				challenge = c
+				break
+			}
+		}
+		if challenge == nil {
+			return nil, fmt.Errorf("no dns-01 challenge offered for %s", authz.Identifier.Value)
+		}
+		value, err := client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		fqdn := ChallengeFQDN(authz.Identifier.Value)
+		records[fqdn] = append(records[fqdn], value)
+		pending = append(pending, challenge)
+		authzURLs = append(authzURLs, authz.URI)
+	}
+
+	for fqdn, values := range records {
+		acmeLog.Infof("Publishing dns-01 TXT record %s", fqdn)
+		if err := m.provider.Present(ctx, fqdn, values); err != nil {
+			return nil, fmt.Errorf("failed to publish %s: %s", fqdn, err)
+		}
+	}
+	defer func() {
+		for fqdn, values := range records {
+			if err := m.provider.CleanUp(context.Background(), fqdn, values); err != nil {
+				acmeLog.Warnf("Failed to remove TXT record %s: %s", fqdn, err)
+			}
+		}
+	}()
+	if 0 < len(records) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(m.propagation):
+		}
+	}
+
+	for _, challenge := range pending {
+		if _, err := client.Accept(ctx, challenge); err != nil {
+			return nil, err
+		}
+	}
+	for _, authzURL := range authzURLs {
+		if _, err := client.WaitAuthorization(ctx, authzURL); err != nil {
+			return nil, err
+		}
+	}
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: m.Domains[0]},
+		DNSNames: m.Domains,
+	}, certKey)
+	if err != nil {
+		return nil, err
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, err
+	}
+	return m.saveCertificate(chain, certKey)
+}
+
+func (m *ACMEDNSManager) acmeClient(ctx context.Context) (*acme.Client, error) {
+	accountKey, err := getACMEDNSAccountKey()
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{Key: accountKey, DirectoryURL: m.config.DirectoryURL}
+	if client.DirectoryURL == "" {
+		client.DirectoryURL = acme.LetsEncryptURL
+	}
+	account := &acme.Account{}
+	if m.config.Email != "" {
+		account.Contact = []string{"mailto:" + m.config.Email}
+	}
+	_, err = client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (m *ACMEDNSManager) certPath() string {
+	name := strings.ReplaceAll(strings.Join(m.Domains, ","), "*", "_")
+	return filepath.Join(getACMEDNSDir(), filepath.Base(name)+".pem")
+}
+
+func (m *ACMEDNSManager) saveCertificate(chain [][]byte, key *ecdsa.PrivateKey) (*tls.Certificate, error) {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	for _, der := range chain {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	err = os.WriteFile(m.certPath(), data, 0600)
+	if err != nil {
+		acmeLog.Warnf("Failed to cache certificate: %s", err)
+	}
+	return parseACMEDNSCertificate(data)
+}
+
+func (m *ACMEDNSManager) loadCachedCertificate() (*tls.Certificate, error) {
+	data, err := os.ReadFile(m.certPath())
+	if err != nil {
+		return nil, err
+	}
+	return parseACMEDNSCertificate(data)
+}
+
+func parseACMEDNSCertificate(data []byte) (*tls.Certificate, error) {
+	cert, err := tls.X509KeyPair(data, data)
+	if err != nil {
+		return nil, err
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+func needsRenewal(cert *tls.Certificate) bool {
+	return cert == nil || cert.Leaf == nil || time.Until(cert.Leaf.NotAfter) < acmeDNSRenewBefore
+}
+
+// ChallengeFQDN - Name of the TXT record for an identifier, wildcards are validated
+// against the base domain's record
+func ChallengeFQDN(domain string) string {
+	return acmeChallengeLabel + strings.TrimPrefix(strings.TrimSuffix(domain, "."), "*.")
+}
+
+func normalizeACMEDomains(domains []string) []string {
+	seen := map[string]bool{}
+	normalized := []string{}
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+		if domain == "" || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		normalized = append(normalized, domain)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+func getACMEDNSDir() string {
+	dnsDir := filepath.Join(GetACMEDir(), acmeDNSDirName)
+	if _, err := os.Stat(dnsDir); os.IsNotExist(err) {
+		acmeLog.Infof("[mkdir] %s", dnsDir)
+		os.MkdirAll(dnsDir, 0700)
+	}
+	return dnsDir
+}
+
+func getACMEDNSAccountKey() (crypto.Signer, error) {
+	keyPath := filepath.Join(getACMEDNSDir(), acmeDNSAccountKeyName)
+	data, err := os.ReadFile(keyPath)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("invalid acme account key %s", keyPath)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,137 @@
+package certs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func TestChallengeFQDN(t *testing.T) {
+	if fqdn := ChallengeFQDN("*.example.com"); fqdn != "_acme-challenge.example.com" {
+		t.Fatalf("unexpected wildcard challenge name %s", fqdn)
+	}
+	if fqdn := ChallengeFQDN("cdn.example.com."); fqdn != "_acme-challenge.cdn.example.com" {
+		t.Fatalf("unexpected challenge name %s", fqdn)
+	}
+	domains := normalizeACMEDomains([]string{"Example.com", "*.example.com", " example.com. ", ""})
+	if len(domains) != 2 || domains[0] != "*.example.com" || domains[1] != "example.com" {
+		t.Fatalf("unexpected normalized domains %v", domains)
+	}
+}
+
+func TestCloudflareProvider(t *testing.T) {
+	records := map[string]*cloudflareRecord{}
+	mutex := &sync.Mutex{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if req.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": false})
+			return
+		}
+		var result interface{}
+		switch {
+		case req.URL.Path == "/zones":
+			zones := []map[string]string{}
+			if req.URL.Query().Get("name") == "example.com" {
+				zones = append(zones, map[string]string{"id": "zone1"})
+			}
+			result = zones
+		case req.Method == http.MethodPost:
+			record := &cloudflareRecord{}
+			json.NewDecoder(req.Body).Decode(record)
+			record.ID = record.Content
+			records[record.ID] = record
+			result = record
+		case req.Method == http.MethodGet:
+			found := []*cloudflareRecord{}
+			for _, record := range records {
+				if record.Name == req.URL.Query().Get("name") {
+					found = append(found, record)
+				}
+			}
+			result = found
+		case req.Method == http.MethodDelete:
+			delete(records, req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "result": result})
+	}))
+	defer server.Close()
+
+	provider := &CloudflareProvider{APIToken: "token", baseURL: server.URL, client: server.Client()}
+	fqdn := ChallengeFQDN("*.example.com")
+	err := provider.Present(context.Background(), fqdn, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("present failed %s", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	err = provider.CleanUp(context.Background(), fqdn, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("cleanup failed %s", err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("expected records to be removed, %d remain", len(records))
+	}
+
+	provider.APIToken = "wrong"
+	if provider.Present(context.Background(), fqdn, []string{"a"}) == nil {
+		t.Fatalf("expected error with invalid token")
+	}
+}
+
+func TestACMEDNSManagerRelease(t *testing.T) {
+	manager := newACMEDNSManager([]string{"example.com"}, nil, &configs.ACMEConfig{})
+	manager.refs = 2
+	acmeDNSManagersLock.Lock()
+	acmeDNSManagers["example.com"] = manager
+	acmeDNSManagersLock.Unlock()
+	stopped := make(chan struct{})
+	go func() {
+		manager.renewLoop()
+		close(stopped)
+	}()
+
+	manager.Release()
+	if manager.ctx.Err() != nil {
+		t.Fatalf("manager stopped while still in use")
+	}
+	manager.Release()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("renew loop still running after the last release")
+	}
+	acmeDNSManagersLock.Lock()
+	defer acmeDNSManagersLock.Unlock()
+	if _, ok := acmeDNSManagers["example.com"]; ok {
+		t.Fatalf("released manager is still shared")
+	}
+}
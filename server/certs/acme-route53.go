@@ -0,0 +1,249 @@
+package certs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	route53APIURL        = "https://route53.amazonaws.com/2013-04-01"
+	route53XMLNS         = "https://route53.amazonaws.com/doc/2013-04-01/"
+	route53SigningName   = "route53"
+	route53DefaultRegion = "us-east-1"
+	route53TXTTTL        = 60
+	route53SyncTimeout   = 2 * time.Minute
+)
+
+// Route53Provider - DNS-01 provider using the AWS Route53 API
+type Route53Provider struct {
+	HostedZoneID string
+
+	region      string
+	credentials aws.CredentialsProvider
+	baseURL     string
+	client      *http.Client
+}
+
+type route53ChangeRequest struct {
+	XMLName     xml.Name           `xml:"ChangeResourceRecordSetsRequest"`
+	XMLNS       string             `xml:"xmlns,attr"`
+	ChangeBatch route53ChangeBatch `xml:"ChangeBatch"`
+}
+
+type route53ChangeBatch struct {
+	Changes []route53Change `xml:"Changes>Change"`
+}
+
+type route53Change struct {
+	Action            string                   `xml:"Action"`
+	ResourceRecordSet route53ResourceRecordSet `xml:"ResourceRecordSet"`
+}
+
+type route53ResourceRecordSet struct {
+	Name            string   `xml:"Name"`
+	Type            string   `xml:"Type"`
+	TTL             int      `xml:"TTL"`
+	ResourceRecords []string `xml:"ResourceRecords>ResourceRecord>Value"`
+}
+
+type route53ChangeInfo struct {
+	ID     string `xml:"ChangeInfo>Id"`
+	Status string `xml:"ChangeInfo>Status"`
+}
+
+type route53HostedZones struct {
+	HostedZones []struct {
+		ID   string `xml:"Id"`
+		Name string `xml:"Name"`
+	} `xml:"HostedZones>HostedZone"`
+}
+
+type route53Error struct {
+	Code    string `xml:"Error>Code"`
+	Message string `xml:"Error>Message"`
+}
+
+// NewRoute53Provider - Create a Route53 provider from the server config, if no static
+// keys are configured the default AWS credential chain is used.
+func NewRoute53Provider(config *configs.Route53Config) (*Route53Provider, error) {
+	provider := &Route53Provider{
+		HostedZoneID: config.HostedZoneID,
+		region:       config.Region,
+		baseURL:      route53APIURL,
+		client:       &http.Client{Timeout: 30 * time.Second},
+	}
+	if provider.region == "" {
+		provider.region = route53DefaultRegion
+	}
+	if config.AccessKeyID != "" {
+		provider.credentials = credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	} else {
+		awsCfg, err := awsConfig.LoadDefaultConfig(context.Background(), awsConfig.WithRegion(provider.region))
+		if err != nil {
+			return nil, err
+		}
+		provider.credentials = awsCfg.Credentials
+	}
+	return provider, nil
+}
+
+// Present - Upsert a TXT record set containing all the values
+func (r *Route53Provider) Present(ctx context.Context, fqdn string, values []string) error {
+	return r.change(ctx, "UPSERT", fqdn, values)
+}
+
+// CleanUp - Delete the TXT record set created by Present
+func (r *Route53Provider) CleanUp(ctx context.Context, fqdn string, values []string) error {
+	return r.change(ctx, "DELETE", fqdn, values)
+}
+
+func (r *Route53Provider) change(ctx context.Context, action string, fqdn string, values []string) error {
+	zoneID, err := r.zoneID(ctx, fqdn)
+	if err != nil {
+		return err
+	}
+	quoted := []string{}
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	body, err := xml.Marshal(&route53ChangeRequest{
+		XMLNS: route53XMLNS,
+		ChangeBatch: route53ChangeBatch{
+			Changes: []route53Change{{
+				Action: action,
+				ResourceRecordSet: route53ResourceRecordSet{
+					Name:            fqdn,
+					Type:            "TXT",
+					TTL:             route53TXTTTL,
+					ResourceRecords: quoted,
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	changeInfo := &route53ChangeInfo{}
+	err = r.do(ctx, http.MethodPost, "/hostedzone/"+zoneID+"/rrset/", append([]byte(xml.Header), body...), changeInfo)
+	if err != nil {
+		return err
+	}
+	if action == "DELETE" {
+		return nil
+	}
+	return r.waitInSync(ctx, changeInfo)
+}
+
+// waitInSync - Wait for a change to propagate to all of the Route53 name servers
+func (r *Route53Provider) waitInSync(ctx context.Context, changeInfo *route53ChangeInfo) error {
+	deadline := time.Now().Add(route53SyncTimeout)
+	for changeInfo.Status != "INSYNC" {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for route53 change %s", changeInfo.ID)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+		err := r.do(ctx, http.MethodGet, "/change/"+strings.TrimPrefix(changeInfo.ID, "/change/"), nil, changeInfo)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// zoneID - Use the configured hosted zone, or find the closest zone that contains the name
+func (r *Route53Provider) zoneID(ctx context.Context, fqdn string) (string, error) {
+	if r.HostedZoneID != "" {
+		return strings.TrimPrefix(r.HostedZoneID, "/hostedzone/"), nil
+	}
+	labels := strings.Split(strings.TrimSuffix(fqdn, "."), ".")
+	for index := 0; index < len(labels)-1; index++ {
+		name := strings.Join(labels[index:], ".")
+		query := url.Values{"dnsname": {name}, "maxitems": {"1"}}
+		zones := &route53HostedZones{}
+		err := r.do(ctx, http.MethodGet, "/hostedzonesbyname?"+query.Encode(), nil, zones)
+		if err != nil {
+			return "", err
+		}
+		if 0 < len(zones.HostedZones) && strings.TrimSuffix(zones.HostedZones[0].Name, ".") == name {
+			return strings.TrimPrefix(zones.HostedZones[0].ID, "/hostedzone/"), nil
+		}
+	}
+	return "", fmt.Errorf("no route53 hosted zone found for %s", fqdn)
+}
+
+func (r *Route53Provider) do(ctx context.Context, method string, path string, body []byte, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, r.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/xml")
+	}
+	creds, err := r.credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	payloadHash := sha256.Sum256(body)
+	err = v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(payloadHash[:]), route53SigningName, route53DefaultRegion, time.Now())
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		awsErr := &route53Error{}
+		if xml.Unmarshal(data, awsErr) == nil && awsErr.Code != "" {
+			return fmt.Errorf("route53 api error (%s: %s)", awsErr.Code, awsErr.Message)
+		}
+		return fmt.Errorf("route53 api returned %s", resp.Status)
+	}
+	if result != nil {
+		return xml.Unmarshal(data, result)
+	}
+	return nil
+}
@@ -71,6 +71,10 @@ func saveCertificate(caType string, keyType string, commonName string, cert []by
 		CertificatePEM: string(cert),
 		PrivateKeyPEM:  string(key),
 	}
+	if x509Cert, err := parseCertificatePEM(cert); err == nil {
+		certModel.SerialNumber = SerialNumberString(x509Cert.SerialNumber)
+		certModel.NotAfter = x509Cert.NotAfter
+	}
 
 	dbSession := db.Session()
 	result := dbSession.Create(&certModel)
@@ -136,6 +140,12 @@ func RemoveCertificate(caType string, keyType string, commonName string) error {
 // We choose some reasonable defaults like Curve, Key Size, ValidFor, etc.
 // Returns two strings `cert` and `key` (PEM Encoded).
 func GenerateECCCertificate(caType string, commonName string, isCA bool, isClient bool) ([]byte, []byte) {
+	return GenerateECCCertificateValidFor(caType, commonName, isCA, isClient, 0)
+}
+
+// GenerateECCCertificateValidFor - Generate a TLS certificate that is valid from now until
+// now + validFor, a zero validFor uses the default randomized validity period.
+func GenerateECCCertificateValidFor(caType string, commonName string, isCA bool, isClient bool, validFor time.Duration) ([]byte, []byte) {
 
 	certsLog.Infof("Generating TLS certificate (ECC) for '%s' ...", commonName)
 
@@ -152,6 +162,10 @@ func GenerateECCCertificate(caType string, commonName string, isCA bool, isClien
 	subject := pkix.Name{
 		CommonName: commonName,
 	}
+	if 0 < validFor {
+		notBefore := time.Now()
+		return generateCertificateValidFor(caType, subject, isCA, isClient, privateKey, notBefore, notBefore.Add(validFor))
+	}
 	return generateCertificate(caType, subject, isCA, isClient, privateKey)
 }
 
@@ -181,6 +195,10 @@ func generateCertificate(caType string, subject pkix.Name, isCA bool, isClient b
 	days := randomInt(365) * -1 // Within -1 year
 	notBefore = notBefore.AddDate(0, 0, days)
 	notAfter := notBefore.Add(randomValidFor())
+	return generateCertificateValidFor(caType, subject, isCA, isClient, privateKey, notBefore, notAfter)
+}
+
+func generateCertificateValidFor(caType string, subject pkix.Name, isCA bool, isClient bool, privateKey interface{}, notBefore time.Time, notAfter time.Time) ([]byte, []byte) {
 	certsLog.Debugf("Valid from %v to %v", notBefore, notAfter)
 
 	// Serial number
@@ -193,7 +211,7 @@ func generateCertificate(caType string, subject pkix.Name, isCA bool, isClient b
 
 	if isCA {
 		certsLog.Debugf("Authority certificate")
-		keyUsage = x509.KeyUsageCertSign | x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+		keyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
 		extKeyUsage = []x509.ExtKeyUsage{
 			x509.ExtKeyUsageServerAuth,
 			x509.ExtKeyUsageClientAuth,
@@ -229,6 +247,9 @@ func generateCertificate(caType string, subject pkix.Name, isCA bool, isClient b
 		}
 	} else {
 		certsLog.Debugf("Client certificate authenticates CN: %v", subject.CommonName)
+		if caType == OperatorCA {
+			template.OCSPServer = operatorOCSPServers()
+		}
 	}
 
 	// Sign certificate or self-sign if CA
@@ -261,6 +282,19 @@ func generateCertificate(caType string, subject pkix.Name, isCA bool, isClient b
 	return certOut.Bytes(), keyOut.Bytes()
 }
 
+// SerialNumberString - Text encoding of a certificate serial number used in the database
+func SerialNumberString(serial *big.Int) string {
+	return fmt.Sprintf("%x", serial)
+}
+
+func parseCertificatePEM(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("failed to parse certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
 func publicKey(priv interface{}) interface{} {
 	switch k := priv.(type) {
 	case *rsa.PrivateKey:
@@ -20,7 +20,15 @@ package certs
 
 import (
 	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
 )
 
 func TestOperatorGenerateCertificate(t *testing.T) {
@@ -42,3 +50,82 @@ func TestOperatorGenerateCertificate(t *testing.T) {
 		return
 	}
 }
+
+func TestOperatorRevokeCertificate(t *testing.T) {
+	_, err := RotateOperatorCA()
+	if err != nil {
+		t.Fatalf("Failed to rotate operator ca %v", err)
+	}
+	certPEM, _, err := OperatorClientGenerateCertificateValidFor("test4", 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to generate operator certificate %v", err)
+	}
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		t.Fatalf("Failed to parse operator certificate %v", err)
+	}
+	if cert.NotAfter.Sub(cert.NotBefore) != 24*time.Hour {
+		t.Fatalf("Unexpected certificate lifetime %v", cert.NotAfter.Sub(cert.NotBefore))
+	}
+
+	chains := [][]*x509.Certificate{{cert}}
+	if err := VerifyOperatorCertificate(nil, chains); err != nil {
+		t.Fatalf("Valid operator certificate rejected %v", err)
+	}
+	if status := operatorOCSPStatus(t, cert); status != ocsp.Good {
+		t.Fatalf("Unexpected ocsp status %d for a valid certificate", status)
+	}
+	_, err = OperatorRevokeCertificate(SerialNumberString(cert.SerialNumber))
+	if err != nil {
+		t.Fatalf("Failed to revoke operator certificate %v", err)
+	}
+	if err := VerifyOperatorCertificate(nil, chains); err != ErrOperatorCertificateRevoked {
+		t.Fatalf("Revoked operator certificate was not rejected (%v)", err)
+	}
+	if status := operatorOCSPStatus(t, cert); status != ocsp.Revoked {
+		t.Fatalf("Unexpected ocsp status %d for a revoked certificate", status)
+	}
+
+	crlPEM, err := OperatorCRL()
+	if err != nil {
+		t.Fatalf("Failed to generate crl %v", err)
+	}
+	block, _ := pem.Decode(crlPEM)
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed to parse crl %v", err)
+	}
+	found := false
+	for _, entry := range crl.RevokedCertificates {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Revoked certificate missing from crl")
+	}
+}
+
+func operatorOCSPStatus(t *testing.T, cert *x509.Certificate) int {
+	caCert, _, err := GetCertificateAuthority(OperatorCA)
+	if err != nil {
+		t.Fatalf("Failed to get operator ca %v", err)
+	}
+	req, err := ocsp.CreateRequest(cert, caCert, nil)
+	if err != nil {
+		t.Fatalf("Failed to create ocsp request %v", err)
+	}
+	server := httptest.NewServer(OperatorOCSPHandler())
+	defer server.Close()
+	resp, err := http.Post(server.URL, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		t.Fatalf("Failed to send ocsp request %v", err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	ocspResp, err := ocsp.ParseResponseForCert(data, cert, caCert)
+	if err != nil {
+		t.Fatalf("Failed to parse ocsp response %v", err)
+	}
+	return ocspResp.Status
+}
@@ -0,0 +1,171 @@
+package certs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	operatorOCSPValidFor = 24 * time.Hour
+	maxOCSPRequestSize   = 64 * 1024
+)
+
+var (
+	// ErrOCSPMalformedRequest - The OCSP request could not be parsed
+	ErrOCSPMalformedRequest = errors.New("malformed ocsp request")
+	// ErrOCSPUnknownIssuer - The OCSP request is for a certificate of another CA
+	ErrOCSPUnknownIssuer = errors.New("ocsp request for an unknown issuer")
+)
+
+// OperatorOCSPResponse - Signed OCSP response with the status of an operator certificate,
+// for TLS proxies that check client certificates with OCSP instead of the CRL
+func OperatorOCSPResponse(rawReq []byte) ([]byte, error) {
+	req, err := ocsp.ParseRequest(rawReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOCSPMalformedRequest, err)
+	}
+	caCert, caKey, err := GetCertificateAuthority(OperatorCA)
+	if err != nil {
+		return nil, err
+	}
+	if !ocspIssuedBy(req, caCert) {
+		return nil, ErrOCSPUnknownIssuer
+	}
+	backfillSerialsOnce.Do(backfillCertificateSerials)
+
+	now := time.Now()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: req.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(operatorOCSPValidFor),
+	}
+	certModel := &models.Certificate{}
+	err = db.Session().Where(&models.Certificate{
+		CAType:       OperatorCA,
+		SerialNumber: SerialNumberString(req.SerialNumber),
+	}).First(certModel).Error
+	if err == nil {
+		if certModel.Revoked {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = certModel.RevokedAt
+			template.RevocationReason = ocsp.Unspecified
+		} else {
+			template.Status = ocsp.Good
+		}
+	}
+	return ocsp.CreateResponse(caCert, caCert, template, caKey)
+}
+
+// ocspIssuedBy - The request's issuer name and key hashes match the CA
+func ocspIssuedBy(req *ocsp.Request, caCert *x509.Certificate) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCert.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false
+	}
+	nameHash := req.HashAlgorithm.New()
+	nameHash.Write(caCert.RawSubject)
+	keyHash := req.HashAlgorithm.New()
+	keyHash.Write(publicKeyInfo.PublicKey.RightAlign())
+	return bytes.Equal(nameHash.Sum(nil), req.IssuerNameHash) && bytes.Equal(keyHash.Sum(nil), req.IssuerKeyHash)
+}
+
+// OperatorOCSPHandler - OCSP responder (RFC 6960), requests are POSTed or base64
+// encoded in the path of a GET
+func OperatorOCSPHandler() http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		var rawReq []byte
+		var err error
+		switch req.Method {
+		case http.MethodGet:
+			rawReq, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(req.URL.Path, "/"))
+		case http.MethodPost:
+			rawReq, err = io.ReadAll(io.LimitReader(req.Body, maxOCSPRequestSize))
+		default:
+			resp.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		ocspResp := ocsp.MalformedRequestErrorResponse
+		if err == nil {
+			ocspResp, err = OperatorOCSPResponse(rawReq)
+			switch {
+			case errors.Is(err, ErrOCSPMalformedRequest):
+				ocspResp = ocsp.MalformedRequestErrorResponse
+			case errors.Is(err, ErrOCSPUnknownIssuer):
+				ocspResp = ocsp.UnauthorizedErrorResponse
+			case err != nil:
+				certsLog.Warnf("Failed to answer ocsp request: %s", err)
+				ocspResp = ocsp.InternalErrorErrorResponse
+			}
+		}
+		resp.Header().Set("Content-Type", "application/ocsp-response")
+		resp.Write(ocspResp)
+	})
+}
+
+// StartOperatorOCSPResponder - Start the OCSP responder for operator certificates if it's
+// enabled in the server config
+func StartOperatorOCSPResponder(config *configs.ServerConfig) {
+	if config.Operators == nil || config.Operators.OCSPListen == "" {
+		return
+	}
+	server := &http.Server{
+		Addr:         config.Operators.OCSPListen,
+		Handler:      OperatorOCSPHandler(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+	go func() {
+		certsLog.Infof("Starting operator ocsp responder on %s", server.Addr)
+		if err := server.ListenAndServe(); err != nil {
+			certsLog.Errorf("Operator ocsp responder stopped: %s", err)
+		}
+	}()
+}
+
+// operatorOCSPServers - The responder URL embedded in new operator certificates
+func operatorOCSPServers() []string {
+	config := configs.GetServerConfig()
+	if config.Operators == nil || config.Operators.OCSPURL == "" {
+		return nil
+	}
+	return []string{config.Operators.OCSPURL}
+}
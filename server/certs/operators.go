@@ -19,10 +19,20 @@ package certs
 */
 
 import (
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 )
@@ -33,11 +43,39 @@ const (
 
 	clientNamespace = "client" // Operator clients
 	serverNamespace = "server" // Operator servers
+
+	operatorCRLFileName = "operator-crl.pem"
+	operatorCRLValidFor = 7 * 24 * time.Hour
+)
+
+var (
+	// ErrOperatorCertificateRevoked - The operator certificate has been revoked
+	ErrOperatorCertificateRevoked = errors.New("operator certificate has been revoked")
+	// ErrUnknownOperatorCertificate - The operator certificate was not issued by this server
+	ErrUnknownOperatorCertificate = errors.New("unknown operator certificate")
+
+	backfillSerialsOnce = &sync.Once{}
 )
 
+// OperatorCertLifetime - Lifetime of new operator certificates from the server config,
+// zero means the default randomized validity period
+func OperatorCertLifetime() time.Duration {
+	serverConfig := configs.GetServerConfig()
+	if serverConfig.Operators == nil || serverConfig.Operators.CertLifetimeDays < 1 {
+		return 0
+	}
+	return time.Duration(serverConfig.Operators.CertLifetimeDays) * 24 * time.Hour
+}
+
 // OperatorClientGenerateCertificate - Generate a certificate signed with a given CA
 func OperatorClientGenerateCertificate(operator string) ([]byte, []byte, error) {
-	cert, key := GenerateECCCertificate(OperatorCA, operator, false, true)
+	return OperatorClientGenerateCertificateValidFor(operator, OperatorCertLifetime())
+}
+
+// OperatorClientGenerateCertificateValidFor - Generate an operator certificate that expires
+// after validFor, zero uses the default randomized validity period
+func OperatorClientGenerateCertificateValidFor(operator string, validFor time.Duration) ([]byte, []byte, error) {
+	cert, key := GenerateECCCertificateValidFor(OperatorCA, operator, false, true, validFor)
 	err := saveCertificate(OperatorCA, ECCKey, fmt.Sprintf("%s.%s", clientNamespace, operator), cert, key)
 	return cert, key, err
 }
@@ -92,3 +130,193 @@ func OperatorClientListCertificates() []*x509.Certificate {
 	}
 	return certs
 }
+
+// OperatorClientCertificates - All operator client certificate records, including revoked ones
+func OperatorClientCertificates() ([]*models.Certificate, error) {
+	backfillSerialsOnce.Do(backfillCertificateSerials)
+	operatorCerts := []*models.Certificate{}
+	err := db.Session().Where(&models.Certificate{
+		CAType: OperatorCA,
+	}).Where("common_name LIKE ?", clientNamespace+".%").Order("created_at").Find(&operatorCerts).Error
+	return operatorCerts, err
+}
+
+// OperatorNameFromCommonName - Operator name of a client certificate record
+func OperatorNameFromCommonName(commonName string) string {
+	return strings.TrimPrefix(commonName, clientNamespace+".")
+}
+
+// OperatorRevokeCertificate - Revoke a single operator client certificate by serial number
+func OperatorRevokeCertificate(serial string) (*models.Certificate, error) {
+	backfillSerialsOnce.Do(backfillCertificateSerials)
+	certModel := &models.Certificate{}
+	err := db.Session().Where(&models.Certificate{
+		CAType:       OperatorCA,
+		SerialNumber: strings.ToLower(serial),
+	}).Where("common_name LIKE ?", clientNamespace+".%").First(certModel).Error
+	if err != nil {
+		return nil, err
+	}
+	err = revokeCertificates([]*models.Certificate{certModel})
+	return certModel, err
+}
+
+// OperatorRevokeCertificates - Revoke every client certificate issued to an operator
+func OperatorRevokeCertificates(operator string) ([]*models.Certificate, error) {
+	backfillSerialsOnce.Do(backfillCertificateSerials)
+	operatorCerts := []*models.Certificate{}
+	err := db.Session().Where(&models.Certificate{
+		CAType:     OperatorCA,
+		CommonName: fmt.Sprintf("%s.%s", clientNamespace, operator),
+	}).Find(&operatorCerts).Error
+	if err != nil {
+		return nil, err
+	}
+	err = revokeCertificates(operatorCerts)
+	return operatorCerts, err
+}
+
+func revokeCertificates(certModels []*models.Certificate) error {
+	now := time.Now()
+	for _, certModel := range certModels {
+		if certModel.Revoked {
+			continue
+		}
+		certModel.Revoked = true
+		certModel.RevokedAt = now
+		err := db.Session().Save(certModel).Error
+		if err != nil {
+			return err
+		}
+		certsLog.Infof("Revoked operator certificate %s (%s)", certModel.SerialNumber, certModel.CommonName)
+	}
+	// CAs generated by older versions cannot sign CRLs, revocation is still enforced
+	// by VerifyOperatorCertificate so this is not fatal
+	if err := SaveOperatorCRL(); err != nil {
+		certsLog.Warnf("Failed to update operator CRL (rotate the operator CA to fix): %s", err)
+	}
+	return nil
+}
+
+// VerifyOperatorCertificate - tls.Config VerifyPeerCertificate callback, the chain has already
+// been verified against the operator CA so we only need to reject revoked or unknown certificates.
+func VerifyOperatorCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return ErrUnknownOperatorCertificate
+	}
+	backfillSerialsOnce.Do(backfillCertificateSerials)
+	leaf := verifiedChains[0][0]
+	certModel := &models.Certificate{}
+	err := db.Session().Where(&models.Certificate{
+		CAType:       OperatorCA,
+		SerialNumber: SerialNumberString(leaf.SerialNumber),
+	}).First(certModel).Error
+	if err != nil {
+		certsLog.Warnf("Rejected unknown operator certificate %s (%s)", SerialNumberString(leaf.SerialNumber), leaf.Subject.CommonName)
+		return ErrUnknownOperatorCertificate
+	}
+	if certModel.Revoked {
+		certsLog.Warnf("Rejected revoked operator certificate %s (%s)", certModel.SerialNumber, leaf.Subject.CommonName)
+		return ErrOperatorCertificateRevoked
+	}
+	return nil
+}
+
+// OperatorCRL - PEM encoded certificate revocation list for the operator CA
+func OperatorCRL() ([]byte, error) {
+	caCert, caKey, err := GetCertificateAuthority(OperatorCA)
+	if err != nil {
+		return nil, err
+	}
+	revoked := []*models.Certificate{}
+	err = db.Session().Where(&models.Certificate{CAType: OperatorCA, Revoked: true}).Find(&revoked).Error
+	if err != nil {
+		return nil, err
+	}
+	entries := []pkix.RevokedCertificate{}
+	for _, certModel := range revoked {
+		cert, err := parseCertificatePEM([]byte(certModel.CertificatePEM))
+		if err != nil || cert.CheckSignatureFrom(caCert) != nil {
+			continue // Issued by a previous CA, which is no longer trusted at all
+		}
+		entries = append(entries, pkix.RevokedCertificate{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: certModel.RevokedAt,
+		})
+	}
+	now := time.Now()
+	crlDER, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              big.NewInt(now.Unix()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(operatorCRLValidFor),
+		RevokedCertificates: entries,
+	}, caCert, caKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlDER}), nil
+}
+
+// GetOperatorCRLPath - Path to the operator CRL, regenerated on every revocation
+func GetOperatorCRLPath() string {
+	return filepath.Join(getCertDir(), operatorCRLFileName)
+}
+
+// SaveOperatorCRL - Write the current operator CRL to disk
+func SaveOperatorCRL() error {
+	crlPEM, err := OperatorCRL()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(GetOperatorCRLPath(), crlPEM, 0600)
+}
+
+// RotateOperatorCA - Replace the operator CA, the previous CA is backed up and every
+// certificate it issued is revoked. Operator configs must be regenerated afterwards.
+func RotateOperatorCA() ([]*models.Certificate, error) {
+	certDir := getCertDir()
+	suffix := time.Now().Format("20060102150405")
+	for _, name := range []string{"cert", "key"} {
+		current := filepath.Join(certDir, fmt.Sprintf("%s-ca-%s.pem", OperatorCA, name))
+		backup := filepath.Join(certDir, fmt.Sprintf("%s-ca-%s.%s.pem", OperatorCA, name, suffix))
+		if err := os.Rename(current, backup); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	certsLog.Infof("Rotating operator certificate authority")
+	GenerateCertificateAuthority(OperatorCA, "operators")
+
+	// Server certificates are regenerated on demand under the new CA
+	err := db.Session().Where(&models.Certificate{
+		CAType: OperatorCA,
+	}).Where("common_name LIKE ?", serverNamespace+".%").Delete(&models.Certificate{}).Error
+	if err != nil {
+		return nil, err
+	}
+	operatorCerts, err := OperatorClientCertificates()
+	if err != nil {
+		return nil, err
+	}
+	err = revokeCertificates(operatorCerts)
+	return operatorCerts, err
+}
+
+// backfillCertificateSerials - Certificates created by older versions of the server
+// do not have their serial number / expiry stored in the database
+func backfillCertificateSerials() {
+	certModels := []*models.Certificate{}
+	err := db.Session().Where("serial_number IS NULL OR serial_number = ''").Find(&certModels).Error
+	if err != nil {
+		certsLog.Errorf("Failed to backfill certificate serials: %s", err)
+		return
+	}
+	for _, certModel := range certModels {
+		cert, err := parseCertificatePEM([]byte(certModel.CertificatePEM))
+		if err != nil {
+			continue
+		}
+		certModel.SerialNumber = SerialNumberString(cert.SerialNumber)
+		certModel.NotAfter = cert.NotAfter
+		db.Session().Save(certModel)
+	}
+}
@@ -47,10 +47,10 @@ func SetupWGKeys() {
 	}
 }
 
-// ImplantGenerateWGKeys - Generate WG keys for implant
-func ImplantGenerateWGKeys(wgPeerTunIP string) (string, string, error) {
+// ImplantGenerateWGKeys - Generate WG keys for implant, the preshared key is optional
+func ImplantGenerateWGKeys(wgPeerTunIP string, presharedKey string) (string, string, error) {
 	isPeer := true
-	privKey, pubKey, err := GenerateWGKeys(isPeer, wgPeerTunIP)
+	privKey, pubKey, err := generateWGKeys(isPeer, wgPeerTunIP, presharedKey)
 
 	if err != nil {
 		wgKeysLog.Errorf("Error generating wg keys for peer: %s", err)
@@ -62,12 +62,19 @@ func ImplantGenerateWGKeys(wgPeerTunIP string) (string, string, error) {
 	return privKey, pubKey, nil
 }
 
-// GetWGSPeers - Get a map of Pubkey:TunIP for existing wg peers
-func GetWGPeers() (map[string]string, error) {
-
-	peers := make(map[string]string)
+// GenerateWGPresharedKey - Generate a wg preshared key, it's mixed into the handshake
+// of a peer so the tunnel stays confidential even if curve25519 is broken
+func GenerateWGPresharedKey() (string, error) {
+	presharedKey, err := wgtypes.GenerateKey()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(presharedKey[:]), nil
+}
 
-	wgPeerModels := []models.WGPeer{}
+// GetWGSPeers - Get the existing wg peers
+func GetWGPeers() ([]*models.WGPeer, error) {
+	wgPeerModels := []*models.WGPeer{}
 	dbSession := db.Session()
 	err := dbSession.Where(&models.WGPeer{}).Find(&wgPeerModels).Error
 	if errors.Is(err, db.ErrRecordNotFound) {
@@ -75,11 +82,26 @@ func GetWGPeers() (map[string]string, error) {
 	} else if err != nil {
 		return nil, err
 	}
+	return wgPeerModels, nil
+}
 
-	for _, v := range wgPeerModels {
-		peers[v.PubKey] = v.TunIP
+// GetWGPeerByTunIP - Get the wg peer with the tunnel ip
+func GetWGPeerByTunIP(tunIP string) (*models.WGPeer, error) {
+	wgPeerModel := &models.WGPeer{}
+	err := db.Session().Where(&models.WGPeer{TunIP: tunIP}).First(wgPeerModel).Error
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return nil, ErrWGPeerDoesNotExist
 	}
-	return peers, nil
+	return wgPeerModel, err
+}
+
+// WGPeerIPC - The wg device (IPC) config of a peer
+func WGPeerIPC(pubKey string, tunIP string, presharedKey string) string {
+	ipc := fmt.Sprintf("public_key=%s\n", pubKey)
+	if presharedKey != "" {
+		ipc += fmt.Sprintf("preshared_key=%s\n", presharedKey)
+	}
+	return ipc + fmt.Sprintf("allowed_ip=%s/32\n", tunIP)
 }
 
 // GetWGServerKeys - Get existing wg server keys
@@ -102,13 +124,17 @@ func GetWGServerKeys() (string, string, error) {
 
 // GenerateWGKeys - Generates and saves new wg keys
 func GenerateWGKeys(isPeer bool, wgPeerTunIP string) (string, string, error) {
+	return generateWGKeys(isPeer, wgPeerTunIP, "")
+}
+
+func generateWGKeys(isPeer bool, wgPeerTunIP string, presharedKey string) (string, string, error) {
 	privKey, pubKey, err := genWGKeys()
 
 	if err != nil {
 		return "", "", err
 	}
 
-	if err := saveWGKeys(isPeer, wgPeerTunIP, privKey, pubKey); err != nil {
+	if err := saveWGKeys(isPeer, wgPeerTunIP, privKey, pubKey, presharedKey); err != nil {
 		wgKeysLog.Error("Error Saving wg keys: ", err)
 		return "", "", err
 	}
@@ -128,7 +154,7 @@ func genWGKeys() (string, string, error) {
 }
 
 // saveWGKeys - Saves wg keys to the database
-func saveWGKeys(isPeer bool, wgPeerTunIP string, privKey string, pubKey string) error {
+func saveWGKeys(isPeer bool, wgPeerTunIP string, privKey string, pubKey string, presharedKey string) error {
 
 	wgKeysLog.Info("Saving wg keys")
 	dbSession := db.Session()
@@ -137,9 +163,10 @@ func saveWGKeys(isPeer bool, wgPeerTunIP string, privKey string, pubKey string)
 
 	if isPeer {
 		wgPeerModels := &models.WGPeer{
-			PrivKey: privKey,
-			PubKey:  pubKey,
-			TunIP:   wgPeerTunIP,
+			PrivKey:      privKey,
+			PubKey:       pubKey,
+			TunIP:        wgPeerTunIP,
+			PresharedKey: presharedKey,
 		}
 		result = dbSession.Create(&wgPeerModels)
 
@@ -152,9 +179,8 @@ func saveWGKeys(isPeer bool, wgPeerTunIP string, privKey string, pubKey string)
 	}
 	core.EventBroker.Publish(core.Event{
 		EventType: consts.WireGuardNewPeer,
-		Data: []byte(fmt.Sprintf("public_key=%s\nallowed_ip=%s/32\n", pubKey, wgPeerTunIP)),
+		Data:      []byte(WGPeerIPC(pubKey, wgPeerTunIP, presharedKey)),
 	})
 
-
 	return result.Error
 }
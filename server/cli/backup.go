@@ -0,0 +1,208 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/bishopfox/sliver/client/version"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/backup"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/encryption"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	// backupPassphraseEnvVar - Passphrase for non-interactive backups and restores
+	backupPassphraseEnvVar = "SLIVER_BACKUP_PASSPHRASE"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Create an encrypted backup of the server's state",
+	Long: `Create a single passphrase encrypted archive of the database, certificates, configs (including
+persistent listeners), loot, builds, websites, and traffic encoders. Set $` + backupPassphraseEnvVar + `
+to skip the passphrase prompt. The server can be running, the database is snapshotted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		save, err := cmd.Flags().GetString(saveFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", saveFlagStr, err)
+			os.Exit(1)
+		}
+		hostname, _ := os.Hostname()
+		now := time.Now()
+		if save == "" {
+			save, _ = os.Getwd()
+		}
+		saveTo, _ := filepath.Abs(save)
+		fi, err := os.Stat(saveTo)
+		if err == nil && fi.IsDir() {
+			saveTo = filepath.Join(saveTo, fmt.Sprintf("sliver_%s_%s.backup", hostname, now.Format("20060102150405")))
+		} else if err == nil {
+			fmt.Printf("File already exists: %s\n", saveTo)
+			os.Exit(1)
+		}
+
+		passphrase, err := getBackupPassphrase(true)
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+
+		appDir := assets.GetRootAppDir()
+		dbConfig := configs.GetDatabaseConfig()
+		snapshot := ""
+		if dbConfig.Dialect == configs.Sqlite {
+			snapshot, err = db.Snapshot()
+			if err != nil {
+				fmt.Printf("Failed to snapshot database: %s\n", err)
+				os.Exit(1)
+			}
+			defer os.Remove(snapshot)
+		} else {
+			fmt.Printf("The %s database is not included, back it up with the database's own tools\n", dbConfig.Dialect)
+		}
+
+		file, err := os.OpenFile(saveTo, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			fmt.Printf("Failed to create %s: %s\n", saveTo, err)
+			os.Exit(1)
+		}
+		manifest := &backup.Manifest{
+			ServerVersion: version.FullVersion(),
+			Hostname:      hostname,
+			CreatedAt:     now.UTC(),
+			Dialect:       dbConfig.Dialect,
+		}
+		err = backup.Create(file, passphrase, appDir, snapshot, manifest)
+		file.Close()
+		if err != nil {
+			os.Remove(saveTo)
+			fmt.Printf("Backup failed: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Backed up %d files (%d bytes) to %s\n", manifest.Files, manifest.Size, saveTo)
+		if config := configs.GetServerConfig().Encryption; config != nil && config.KeySource == encryption.KeyringKeySource {
+			fmt.Printf("The at-rest encryption key is stored in the OS keyring and is not included\n")
+		}
+	},
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the server's state from a backup",
+	Long: `Restore a backup created with the backup command, the server must not be running (restores
+are refused while it is). Existing files are overwritten, use --force to restore over an existing server.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		load, err := cmd.Flags().GetString(loadFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", loadFlagStr, err)
+			os.Exit(1)
+		}
+		if load == "" {
+			fmt.Printf("Missing --%s flag\n", loadFlagStr)
+			os.Exit(1)
+		}
+		force, err := cmd.Flags().GetBool(forceFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", forceFlagStr, err)
+			os.Exit(1)
+		}
+
+		appDir := assets.GetRootAppDir()
+		serverLock, err := lockServer(appDir)
+		if errors.Is(err, errServerRunning) {
+			fmt.Printf("The server is running, stop it before restoring\n")
+			os.Exit(1)
+		}
+		if err != nil {
+			fmt.Printf("Failed to lock %s: %s\n", appDir, err)
+			os.Exit(1)
+		}
+		defer serverLock.Close()
+		if _, err := os.Stat(filepath.Join(appDir, backup.DatabaseName)); err == nil && !force {
+			fmt.Printf("%s already contains a server, use --%s to overwrite it\n", appDir, forceFlagStr)
+			os.Exit(1)
+		}
+
+		file, err := os.Open(load)
+		if err != nil {
+			fmt.Printf("Cannot read file %s\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		passphrase, err := getBackupPassphrase(false)
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+
+		manifest, err := backup.Restore(file, passphrase, appDir)
+		if errors.Is(err, backup.ErrInvalidArchive) {
+			fmt.Printf("%s (wrong passphrase?)\n", err)
+			os.Exit(1)
+		}
+		if err != nil {
+			fmt.Printf("Restore failed: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Restored %d files (%d bytes) from %s backup of %s (%s)\n",
+			manifest.Files, manifest.Size, manifest.CreatedAt.Local().Format(time.RFC1123), manifest.Hostname, manifest.ServerVersion)
+		if !manifest.Database {
+			fmt.Printf("The backup does not include the %s database, restore it separately\n", manifest.Dialect)
+		}
+	},
+}
+
+func getBackupPassphrase(confirm bool) (string, error) {
+	if passphrase := os.Getenv(backupPassphraseEnvVar); passphrase != "" {
+		return passphrase, nil
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("no terminal, set $%s", backupPassphraseEnvVar)
+	}
+	fmt.Printf("Backup passphrase: ")
+	passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	if len(passphrase) == 0 {
+		return "", errors.New("passphrase cannot be blank")
+	}
+	if confirm {
+		fmt.Printf("Confirm passphrase: ")
+		confirmation, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Println()
+		if err != nil {
+			return "", err
+		}
+		if string(confirmation) != string(passphrase) {
+			return "", errors.New("passphrases do not match")
+		}
+	}
+	return string(passphrase), nil
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/bishopfox/sliver/server/builder"
 	"github.com/bishopfox/sliver/server/generate"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/toolchains"
 	"github.com/spf13/cobra"
 )
 
@@ -46,6 +47,9 @@ const (
 	operatorConfigFlagStr = "config"
 	quietFlagStr          = "quiet"
 	logLevelFlagStr       = "log-level"
+
+	maxConcurrentBuildsFlagStr = "max-concurrent-builds"
+	zigFlagStr                 = "zig"
 )
 
 func initBuilderCmd() *cobra.Command {
@@ -57,6 +61,8 @@ func initBuilderCmd() *cobra.Command {
 	// Artifact configuration options
 	builderCmd.Flags().StringSlice(enableTargetFlagStr, []string{}, "force enable a target: format:goos/goarch")
 	builderCmd.Flags().StringSlice(disableTargetFlagStr, []string{}, "force disable target arch: format:goos/goarch")
+	builderCmd.Flags().Uint32(maxConcurrentBuildsFlagStr, 0, "maximum number of builds to run at the same time (0 = no limit)")
+	builderCmd.Flags().Bool(zigFlagStr, false, "install zig before registering, so every shared library target can be built")
 
 	return builderCmd
 }
@@ -134,6 +140,21 @@ var builderCmd = &cobra.Command{
 func parseBuilderConfigFlags(cmd *cobra.Command) *clientpb.Builder {
 	externalBuilder := &clientpb.Builder{GOOS: runtime.GOOS, GOARCH: runtime.GOARCH}
 
+	installZig, err := cmd.Flags().GetBool(zigFlagStr)
+	if err != nil {
+		builderLog.Errorf("Failed to parse --%s flag %s\n", zigFlagStr, err)
+	}
+	if installZig {
+		builderLog.Infof("Installing zig ...")
+		toolchain, err := toolchains.Install("")
+		if err != nil {
+			builderLog.Errorf("Failed to install zig: %s", err)
+		} else {
+			builderLog.Infof("Installed zig %s to %s", toolchain.Version, toolchain.Path)
+		}
+	}
+	externalBuilder.Toolchains = toolchains.Installed()
+
 	externalBuilder.CrossCompilers = generate.GetCrossCompilers()
 	builderLog.Infof("Found %d cross-compilers", len(externalBuilder.CrossCompilers))
 	for _, crossCompiler := range externalBuilder.CrossCompilers {
@@ -157,6 +178,11 @@ func parseBuilderConfigFlags(cmd *cobra.Command) *clientpb.Builder {
 		externalBuilder.Name = name
 	}
 
+	externalBuilder.MaxConcurrentBuilds, err = cmd.Flags().GetUint32(maxConcurrentBuildsFlagStr)
+	if err != nil {
+		builderLog.Errorf("Failed to parse --%s flag %s\n", maxConcurrentBuildsFlagStr, err)
+	}
+
 	return externalBuilder
 }
 
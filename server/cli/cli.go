@@ -33,8 +33,14 @@ import (
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/console"
+	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/cryptography"
 	"github.com/bishopfox/sliver/server/daemon"
+	"github.com/bishopfox/sliver/server/exporter"
+	"github.com/bishopfox/sliver/server/federation"
+	"github.com/bishopfox/sliver/server/ha"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/bishopfox/sliver/server/metrics"
 )
 
 const (
@@ -48,6 +54,9 @@ const (
 	lportFlagStr = "lport"
 	saveFlagStr  = "save"
 
+	lifetimeFlagStr = "lifetime"
+	standbyFlagStr  = "standby"
+
 	// Cert flags
 	caTypeFlagStr = "type"
 	loadFlagStr   = "load"
@@ -77,6 +86,8 @@ func init() {
 	operatorCmd.Flags().StringP(lhostFlagStr, "l", "", "multiplayer listener host")
 	operatorCmd.Flags().Uint16P(lportFlagStr, "p", uint16(31337), "multiplayer listener port")
 	operatorCmd.Flags().StringP(saveFlagStr, "s", "", "save file to ...")
+	operatorCmd.Flags().IntP(lifetimeFlagStr, "t", 0, "client certificate lifetime in days (default: server config)")
+	operatorCmd.Flags().Bool(standbyFlagStr, false, "identity of a standby server, it can only copy this server's state")
 	rootCmd.AddCommand(operatorCmd)
 
 	// Certs
@@ -94,6 +105,22 @@ func init() {
 	daemonCmd.Flags().BoolP(forceFlagStr, "f", false, "force unpack and overwrite static assets")
 	rootCmd.AddCommand(daemonCmd)
 
+	// Backup
+	backupCmd.Flags().StringP(saveFlagStr, "s", "", "save backup to file ...")
+	rootCmd.AddCommand(backupCmd)
+
+	restoreCmd.Flags().StringP(loadFlagStr, "l", "", "load backup from file ...")
+	restoreCmd.Flags().BoolP(forceFlagStr, "f", false, "overwrite an existing server")
+	rootCmd.AddCommand(restoreCmd)
+
+	// Standby
+	standbyCmd.Flags().StringP(primaryFlagStr, "P", "", "standby config of the primary server")
+	standbyCmd.Flags().Duration(intervalFlagStr, ha.DefaultSyncInterval, "how often to copy the primary's state")
+	standbyCmd.Flags().Duration(failoverFlagStr, ha.DefaultFailoverAfter, "take over once the primary has been unreachable for this long")
+	standbyCmd.Flags().StringP(lhostFlagStr, "l", daemon.BlankHost, "multiplayer listener host after failover")
+	standbyCmd.Flags().Uint16P(lportFlagStr, "p", daemon.BlankPort, "multiplayer listener port after failover")
+	rootCmd.AddCommand(standbyCmd)
+
 	// Builder
 	rootCmd.AddCommand(initBuilderCmd())
 
@@ -111,6 +138,12 @@ var rootCmd = &cobra.Command{
 		appDir := assets.GetRootAppDir()
 		logFile := initConsoleLogging(appDir)
 		defer logFile.Close()
+		serverLock, err := lockServer(appDir)
+		if err != nil {
+			fmt.Printf("Failed to lock %s: %s\n", appDir, err)
+			os.Exit(1)
+		}
+		defer serverLock.Close()
 
 		defer func() {
 			if r := recover(); r != nil {
@@ -127,7 +160,18 @@ var rootCmd = &cobra.Command{
 		cryptography.MinisignServerPrivateKey()
 
 		serverConfig := configs.GetServerConfig()
-		c2.StartPersistentJobs(serverConfig)
+		exporter.Start(serverConfig)
+		metrics.Start(serverConfig)
+		certs.StartOperatorOCSPResponder(serverConfig)
+		registerConfigReloaders()
+		federation.Start()
+		c2.StartKeyExHistoryPruner()
+		core.BeaconWatchdog.Start(serverConfig)
+		loot.ReloadResultLimits(serverConfig)
+		if err := c2.StartPersistentJobs(serverConfig); err != nil {
+			fmt.Printf("Failed to start persistent jobs: %s\n", err)
+			log.Printf("Failed to start persistent jobs: %s", err)
+		}
 		console.StartPersistentJobs(serverConfig)
 		if serverConfig.DaemonMode {
 			daemon.Start(daemon.BlankHost, daemon.BlankPort)
@@ -10,8 +10,13 @@ import (
 	"github.com/bishopfox/sliver/server/c2"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/cryptography"
 	"github.com/bishopfox/sliver/server/daemon"
+	"github.com/bishopfox/sliver/server/exporter"
+	"github.com/bishopfox/sliver/server/federation"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/bishopfox/sliver/server/metrics"
 	"github.com/spf13/cobra"
 )
 
@@ -39,6 +44,12 @@ var daemonCmd = &cobra.Command{
 		appDir := assets.GetRootAppDir()
 		logFile := initConsoleLogging(appDir)
 		defer logFile.Close()
+		serverLock, err := lockServer(appDir)
+		if err != nil {
+			fmt.Printf("Failed to lock %s: %s\n", appDir, err)
+			os.Exit(1)
+		}
+		defer serverLock.Close()
 
 		defer func() {
 			if r := recover(); r != nil {
@@ -55,7 +66,18 @@ var daemonCmd = &cobra.Command{
 		cryptography.MinisignServerPrivateKey()
 
 		serverConfig := configs.GetServerConfig()
-		c2.StartPersistentJobs(serverConfig)
+		exporter.Start(serverConfig)
+		metrics.Start(serverConfig)
+		certs.StartOperatorOCSPResponder(serverConfig)
+		registerConfigReloaders()
+		federation.Start()
+		c2.StartKeyExHistoryPruner()
+		core.BeaconWatchdog.Start(serverConfig)
+		loot.ReloadResultLimits(serverConfig)
+		if err := c2.StartPersistentJobs(serverConfig); err != nil {
+			fmt.Printf("Failed to start persistent jobs: %s\n", err)
+			log.Printf("Failed to start persistent jobs: %s", err)
+		}
 
 		daemon.Start(lhost, uint16(lport))
 	},
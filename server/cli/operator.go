@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/console"
@@ -69,8 +70,29 @@ var operatorCmd = &cobra.Command{
 			save, _ = os.Getwd()
 		}
 
+		lifetime, err := cmd.Flags().GetInt(lifetimeFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s", lifetimeFlagStr, err)
+			os.Exit(1)
+		}
+
+		standby, err := cmd.Flags().GetBool(standbyFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s", standbyFlagStr, err)
+			os.Exit(1)
+		}
+
 		certs.SetupCAs()
-		configJSON, err := console.NewOperatorConfig(name, lhost, lport)
+		validFor := certs.OperatorCertLifetime()
+		if 0 < lifetime {
+			validFor = time.Duration(lifetime) * 24 * time.Hour
+		}
+		var configJSON []byte
+		if standby {
+			configJSON, err = console.NewStandbyConfig(name, lhost, lport, validFor)
+		} else {
+			configJSON, err = console.NewOperatorConfigValidFor(name, lhost, lport, validFor)
+		}
 		if err != nil {
 			fmt.Printf("Failed: %s\n", err)
 			os.Exit(1)
@@ -0,0 +1,63 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/exporter"
+	"github.com/bishopfox/sliver/server/geoip"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/bishopfox/sliver/server/metrics"
+	"github.com/bishopfox/sliver/server/recordings"
+	"github.com/bishopfox/sliver/server/transport"
+)
+
+// registerConfigReloaders - Components that can be re-configured without restarting
+// the server, everything else (e.g. persistent jobs, daemon host/port) needs a restart
+func registerConfigReloaders() {
+	configs.OnServerConfigReload("grpc-logs", func(config *configs.ServerConfig) error {
+		transport.ReloadConfig(config)
+		return nil
+	})
+	configs.OnServerConfigReload("exporters", func(config *configs.ServerConfig) error {
+		exporter.Reload(config)
+		return nil
+	})
+	configs.OnServerConfigReload("metrics", metrics.Reload)
+	configs.OnServerConfigReload("recordings", func(config *configs.ServerConfig) error {
+		recordings.Reload(config)
+		return nil
+	})
+	configs.OnServerConfigReload("geoip", func(_ *configs.ServerConfig) error {
+		geoip.Reload()
+		return nil
+	})
+	configs.OnServerConfigReload("opsec", func(_ *configs.ServerConfig) error {
+		return configs.ReloadOpsecPolicy()
+	})
+	configs.OnServerConfigReload("beacons", func(config *configs.ServerConfig) error {
+		core.BeaconWatchdog.Reload(config)
+		return nil
+	})
+	configs.OnServerConfigReload("results", func(config *configs.ServerConfig) error {
+		loot.ReloadResultLimits(config)
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// serverLockFileName - Locked for as long as a server runs from the app dir
+	serverLockFileName = "server.lock"
+)
+
+var (
+	errServerRunning = errors.New("a server is already running")
+)
+
+// lockServer - Lock the app dir for as long as the process runs, the lock is released
+// when the returned file is closed or the process exits (even if it crashes)
+func lockServer(appDir string) (*os.File, error) {
+	lockFile, err := os.OpenFile(filepath.Join(appDir, serverLockFileName), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err = lockExclusive(lockFile); err != nil {
+		lockFile.Close()
+		return nil, errServerRunning
+	}
+	return lockFile, nil
+}
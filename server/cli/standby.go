@@ -0,0 +1,134 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	clientAssets "github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/ha"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Standby flags
+	primaryFlagStr  = "primary"
+	intervalFlagStr = "interval"
+	failoverFlagStr = "failover-after"
+)
+
+var standbyCmd = &cobra.Command{
+	Use:   "standby",
+	Short: "Replicate a primary server and take over its listeners if it fails",
+	Long: `Copy the state of a primary server (the same state a backup contains) every --interval using a
+standby config of the primary, generated on the primary with "operator --standby" (or "new-operator --standby"
+in its console). Standby configs can't be used as operator configs, and operator configs can't copy the
+primary's state. The state is streamed in chunks, so large app dirs aren't limited by the message size. Once the primary has been unreachable for --failover-after the last copy is restored
+and the server is started in daemon mode, with the primary's persistent listeners, certificates, and keys.
+The copies are encrypted to a key that is kept encrypted with the backup passphrase, set $` + backupPassphraseEnvVar + `
+to skip the prompt.
+
+Implants only reach the standby if their c2 domains (or redirectors) are pointed at it, or if they were
+generated with the standby as one of their c2s. Make sure the primary stays down, otherwise both servers
+run the same listeners.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		primary, _ := cmd.Flags().GetString(primaryFlagStr)
+		if primary == "" {
+			fmt.Printf("Missing --%s flag\n", primaryFlagStr)
+			os.Exit(1)
+		}
+		interval, _ := cmd.Flags().GetDuration(intervalFlagStr)
+		failoverAfter, _ := cmd.Flags().GetDuration(failoverFlagStr)
+		lhost, _ := cmd.Flags().GetString(lhostFlagStr)
+		lport, _ := cmd.Flags().GetUint16(lportFlagStr)
+
+		appDir := assets.GetRootAppDir()
+		logFile := initConsoleLogging(appDir)
+		defer logFile.Close()
+		serverLock, err := lockServer(appDir)
+		if err != nil {
+			fmt.Printf("Failed to lock %s: %s\n", appDir, err)
+			os.Exit(1)
+		}
+		defer serverLock.Close()
+
+		config, err := clientAssets.ReadConfig(primary)
+		if err != nil {
+			fmt.Printf("Failed to read %s: %s\n", primary, err)
+			os.Exit(1)
+		}
+		keyPath := filepath.Join(appDir, ha.KeyName)
+		_, err = os.Stat(keyPath)
+		passphrase, err := getBackupPassphrase(os.IsNotExist(err))
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+		identity, err := ha.LoadIdentity(keyPath, passphrase)
+		if err != nil {
+			fmt.Printf("Failed to load standby key: %s (wrong passphrase?)\n", err)
+			os.Exit(1)
+		}
+
+		archivePath := filepath.Join(appDir, ha.ArchiveName)
+		standby := &ha.Standby{
+			Primary:       config,
+			Identity:      identity,
+			ArchivePath:   archivePath,
+			SyncInterval:  interval,
+			FailoverAfter: failoverAfter,
+		}
+		fmt.Printf("Standing by for %s:%d, syncing every %s\n", config.LHost, config.LPort, interval)
+		standby.Run()
+
+		fmt.Printf("%s:%d is unreachable, restoring its state from %s\n",
+			config.LHost, config.LPort, standby.LastSync().Local().Format(time.RFC1123))
+		manifest, err := ha.Failover(archivePath, identity, appDir)
+		if err != nil {
+			fmt.Printf("Failover failed: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Restored %d files (%d bytes) of %s (%s)\n", manifest.Files, manifest.Size, manifest.Hostname, manifest.ServerVersion)
+		if !manifest.Database {
+			fmt.Printf("The primary's %s database is not included, the standby uses it as is\n", manifest.Dialect)
+		}
+
+		// The database was opened before it was restored, so the server runs in a new process
+		executable, err := os.Executable()
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+		daemon := exec.Command(executable, "daemon", "--"+lhostFlagStr, lhost, "--"+lportFlagStr, strconv.Itoa(int(lport)))
+		daemon.Stdin, daemon.Stdout, daemon.Stderr = os.Stdin, os.Stdout, os.Stderr
+		err = daemon.Run()
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		} else if err != nil {
+			fmt.Printf("Failed to start daemon: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
@@ -25,7 +25,9 @@ import (
 	insecureRand "math/rand"
 	"os"
 	"path"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/bishopfox/sliver/server/assets"
@@ -34,19 +36,22 @@ import (
 
 const (
 	httpC2ConfigFileName = "http-c2.json"
+	httpC2VersionsDir    = "http-c2-versions"
 	DefaultChromeBaseVer = 106
 	DefaultMacOSVer      = "10_15_7"
 )
 
 // HTTPC2Config - Parent config file struct for implant/server
 type HTTPC2Config struct {
+	Version       int                  `json:"version"`
 	ImplantConfig *HTTPC2ImplantConfig `json:"implant_config"`
 	ServerConfig  *HTTPC2ServerConfig  `json:"server_config"`
 }
 
 // RandomImplantConfig - Randomly generate a new implant config from the parent config,
-// this is the primary configuration used by the implant generation.
-func (h *HTTPC2Config) RandomImplantConfig() *HTTPC2ImplantConfig {
+// this is the primary configuration used by the implant generation. Reproducible builds
+// pass a seeded rng.
+func (h *HTTPC2Config) RandomImplantConfig(rng *insecureRand.Rand) *HTTPC2ImplantConfig {
 	return &HTTPC2ImplantConfig{
 
 		NonceQueryArgs: h.ImplantConfig.NonceQueryArgs,
@@ -54,38 +59,38 @@ func (h *HTTPC2Config) RandomImplantConfig() *HTTPC2ImplantConfig {
 		Headers:        h.ImplantConfig.Headers,
 
 		PollFileExt: h.ImplantConfig.PollFileExt,
-		PollFiles:   h.ImplantConfig.RandomPollFiles(),
-		PollPaths:   h.ImplantConfig.RandomPollPaths(),
+		PollFiles:   h.ImplantConfig.RandomPollFiles(rng),
+		PollPaths:   h.ImplantConfig.RandomPollPaths(rng),
 
 		StartSessionFileExt: h.ImplantConfig.StartSessionFileExt,
 		SessionFileExt:      h.ImplantConfig.SessionFileExt,
-		SessionFiles:        h.ImplantConfig.RandomSessionFiles(),
-		SessionPaths:        h.ImplantConfig.RandomSessionPaths(),
+		SessionFiles:        h.ImplantConfig.RandomSessionFiles(rng),
+		SessionPaths:        h.ImplantConfig.RandomSessionPaths(rng),
 
 		CloseFileExt: h.ImplantConfig.CloseFileExt,
-		CloseFiles:   h.ImplantConfig.RandomCloseFiles(),
-		ClosePaths:   h.ImplantConfig.RandomClosePaths(),
+		CloseFiles:   h.ImplantConfig.RandomCloseFiles(rng),
+		ClosePaths:   h.ImplantConfig.RandomClosePaths(rng),
 	}
 }
 
 // GenerateUserAgent - Generate a user-agent depending on OS/Arch
-func (h *HTTPC2Config) GenerateUserAgent(goos string, goarch string) string {
-	return h.generateChromeUserAgent(goos, goarch)
+func (h *HTTPC2Config) GenerateUserAgent(goos string, goarch string, rng *insecureRand.Rand) string {
+	return h.generateChromeUserAgent(goos, goarch, rng)
 }
 
-func (h *HTTPC2Config) generateChromeUserAgent(goos string, goarch string) string {
+func (h *HTTPC2Config) generateChromeUserAgent(goos string, goarch string, rng *insecureRand.Rand) string {
 	if h.ImplantConfig.UserAgent == "" {
 		switch goos {
 		case "windows":
 			switch goarch {
 			case "amd64":
-				return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.ChromeVer())
+				return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.ChromeVer(rng))
 			}
 
 		case "linux":
 			switch goarch {
 			case "amd64":
-				return fmt.Sprintf("Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.ChromeVer())
+				return fmt.Sprintf("Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.ChromeVer(rng))
 			}
 
 		case "darwin":
@@ -93,7 +98,7 @@ func (h *HTTPC2Config) generateChromeUserAgent(goos string, goarch string) strin
 			case "arm64":
 				fallthrough // https://source.chromium.org/chromium/chromium/src/+/master:third_party/blink/renderer/core/frame/navigator_id.cc;l=76
 			case "amd64":
-				return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X %s) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.MacOSVer(), h.ChromeVer())
+				return fmt.Sprintf("Mozilla/5.0 (Macintosh; Intel Mac OS X %s) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.MacOSVer(), h.ChromeVer(rng))
 			}
 
 		}
@@ -102,16 +107,16 @@ func (h *HTTPC2Config) generateChromeUserAgent(goos string, goarch string) strin
 	}
 
 	// Default is a generic Windows/Chrome
-	return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.ChromeVer())
+	return fmt.Sprintf("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s Safari/537.36", h.ChromeVer(rng))
 }
 
 // ChromeVer - Generate a random Chrome user-agent
-func (h *HTTPC2Config) ChromeVer() string {
+func (h *HTTPC2Config) ChromeVer(rng *insecureRand.Rand) string {
 	chromeVer := h.ImplantConfig.ChromeBaseVersion
 	if chromeVer == 0 {
 		chromeVer = DefaultChromeBaseVer
 	}
-	return fmt.Sprintf("%d.0.%d.%d", chromeVer+insecureRand.Intn(3), 1000+insecureRand.Intn(8999), insecureRand.Intn(999))
+	return fmt.Sprintf("%d.0.%d.%d", chromeVer+rng.Intn(3), 1000+rng.Intn(8999), rng.Intn(999))
 }
 
 func (h *HTTPC2Config) MacOSVer() string {
@@ -183,44 +188,44 @@ type HTTPC2ImplantConfig struct {
 	ClosePaths   []string `json:"close_paths"`
 }
 
-func (h *HTTPC2ImplantConfig) RandomPollFiles() []string {
+func (h *HTTPC2ImplantConfig) RandomPollFiles(rng *insecureRand.Rand) []string {
 	min := h.MinFiles
 	if min < 1 {
 		min = 1
 	}
-	return h.randomSample(h.PollFiles, h.PollFileExt, min, h.MaxFiles)
+	return h.randomSample(rng, h.PollFiles, h.PollFileExt, min, h.MaxFiles)
 }
 
-func (h *HTTPC2ImplantConfig) RandomPollPaths() []string {
-	return h.randomSample(h.PollPaths, "", h.MinPaths, h.MaxPaths)
+func (h *HTTPC2ImplantConfig) RandomPollPaths(rng *insecureRand.Rand) []string {
+	return h.randomSample(rng, h.PollPaths, "", h.MinPaths, h.MaxPaths)
 }
 
-func (h *HTTPC2ImplantConfig) RandomCloseFiles() []string {
+func (h *HTTPC2ImplantConfig) RandomCloseFiles(rng *insecureRand.Rand) []string {
 	min := h.MinFiles
 	if min < 1 {
 		min = 1
 	}
-	return h.randomSample(h.CloseFiles, h.CloseFileExt, min, h.MaxFiles)
+	return h.randomSample(rng, h.CloseFiles, h.CloseFileExt, min, h.MaxFiles)
 }
 
-func (h *HTTPC2ImplantConfig) RandomClosePaths() []string {
-	return h.randomSample(h.ClosePaths, "", h.MinPaths, h.MaxPaths)
+func (h *HTTPC2ImplantConfig) RandomClosePaths(rng *insecureRand.Rand) []string {
+	return h.randomSample(rng, h.ClosePaths, "", h.MinPaths, h.MaxPaths)
 }
 
-func (h *HTTPC2ImplantConfig) RandomSessionFiles() []string {
+func (h *HTTPC2ImplantConfig) RandomSessionFiles(rng *insecureRand.Rand) []string {
 	min := h.MinFiles
 	if min < 1 {
 		min = 1
 	}
-	return h.randomSample(h.SessionFiles, h.SessionFileExt, min, h.MaxFiles)
+	return h.randomSample(rng, h.SessionFiles, h.SessionFileExt, min, h.MaxFiles)
 }
 
-func (h *HTTPC2ImplantConfig) RandomSessionPaths() []string {
-	return h.randomSample(h.SessionPaths, "", h.MinPaths, h.MaxPaths)
+func (h *HTTPC2ImplantConfig) RandomSessionPaths(rng *insecureRand.Rand) []string {
+	return h.randomSample(rng, h.SessionPaths, "", h.MinPaths, h.MaxPaths)
 }
 
-func (h *HTTPC2ImplantConfig) randomSample(values []string, ext string, min int, max int) []string {
-	count := insecureRand.Intn(len(values))
+func (h *HTTPC2ImplantConfig) randomSample(rng *insecureRand.Rand, values []string, ext string, min int, max int) []string {
+	count := rng.Intn(len(values))
 	if count < min {
 		count = min
 	}
@@ -371,6 +376,170 @@ func CheckHTTPC2ConfigErrors() error {
 	return nil
 }
 
+// SaveHTTPC2Config - Validate and save a new version of the HTTP C2 config, the
+// current config is kept as a previous version so that implants generated with it
+// can still be served by listeners. Returns the new version number.
+func SaveHTTPC2Config(config *HTTPC2Config) (int, error) {
+	err := checkHTTPC2Config(config)
+	if err != nil {
+		return 0, err
+	}
+	current := GetHTTPC2Config()
+	versionsDir := GetHTTPC2VersionsDir()
+	if _, err := os.Stat(versionsDir); os.IsNotExist(err) {
+		err = os.MkdirAll(versionsDir, 0700)
+		if err != nil {
+			return 0, err
+		}
+	}
+	data, err := json.MarshalIndent(current, "", "    ")
+	if err != nil {
+		return 0, err
+	}
+	err = os.WriteFile(filepath.Join(versionsDir, fmt.Sprintf("%d.json", current.Version)), data, 0600)
+	if err != nil {
+		return 0, err
+	}
+
+	config.Version = current.Version + 1
+	data, err = json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return 0, err
+	}
+	err = os.WriteFile(GetHTTPC2ConfigPath(), data, 0600)
+	if err != nil {
+		return 0, err
+	}
+	httpC2ConfigLog.Infof("Saved http c2 config version %d", config.Version)
+	return config.Version, nil
+}
+
+// GetHTTPC2VersionsDir - Directory containing previous versions of http-c2.json
+func GetHTTPC2VersionsDir() string {
+	appDir := assets.GetRootAppDir()
+	return path.Join(appDir, "configs", httpC2VersionsDir)
+}
+
+// GetPreviousHTTPC2Configs - Get all valid previous versions of the HTTP C2 config,
+// newest first
+func GetPreviousHTTPC2Configs() []*HTTPC2Config {
+	previous := []*HTTPC2Config{}
+	versionFiles, err := os.ReadDir(GetHTTPC2VersionsDir())
+	if err != nil {
+		return previous
+	}
+	for _, versionFile := range versionFiles {
+		if versionFile.IsDir() || filepath.Ext(versionFile.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(GetHTTPC2VersionsDir(), versionFile.Name()))
+		if err != nil {
+			httpC2ConfigLog.Errorf("Failed to read http c2 config %s", err)
+			continue
+		}
+		config := &HTTPC2Config{}
+		err = json.Unmarshal(data, config)
+		if err != nil {
+			httpC2ConfigLog.Errorf("Failed to parse http c2 config %s: %s", versionFile.Name(), err)
+			continue
+		}
+		if err = checkHTTPC2Config(config); err != nil {
+			httpC2ConfigLog.Errorf("Invalid http c2 config %s: %s", versionFile.Name(), err)
+			continue
+		}
+		previous = append(previous, config)
+	}
+	sort.Slice(previous, func(i, j int) bool {
+		return previous[j].Version < previous[i].Version
+	})
+	return previous
+}
+
+// GetHTTPC2ConfigVersion - Get a specific version of the HTTP C2 config, 0 is the current version
+func GetHTTPC2ConfigVersion(version int) (*HTTPC2Config, error) {
+	current := GetHTTPC2Config()
+	if version == 0 || version == current.Version {
+		return current, nil
+	}
+	for _, config := range GetPreviousHTTPC2Configs() {
+		if config.Version == version {
+			return config, nil
+		}
+	}
+	return nil, fmt.Errorf("http c2 config version %d not found", version)
+}
+
+// DefaultValues - The json names of the settings that still have their default value,
+// the defaults are public so they are easy to write signatures for
+func (h *HTTPC2Config) DefaultValues() []string {
+	defaults := defaultHTTPC2Config.ImplantConfig
+	implant := h.ImplantConfig
+	values := []string{}
+	if sameValues(h.ServerConfig.Cookies, defaultHTTPC2Config.ServerConfig.Cookies) {
+		values = append(values, "cookies")
+	}
+	if implant.UserAgent == "" && (implant.ChromeBaseVersion == 0 || implant.ChromeBaseVersion == DefaultChromeBaseVer) {
+		values = append(values, "chrome_base_version")
+	}
+	if implant.NonceQueryArgs == defaults.NonceQueryArgs {
+		values = append(values, "nonce_query_args")
+	}
+	fileExts := []struct {
+		name         string
+		value        string
+		defaultValue string
+	}{
+		{"stager_file_ext", implant.StagerFileExt, defaults.StagerFileExt},
+		{"poll_file_ext", implant.PollFileExt, defaults.PollFileExt},
+		{"start_session_file_ext", implant.StartSessionFileExt, defaults.StartSessionFileExt},
+		{"session_file_ext", implant.SessionFileExt, defaults.SessionFileExt},
+		{"close_file_ext", implant.CloseFileExt, defaults.CloseFileExt},
+	}
+	for _, fileExt := range fileExts {
+		if coerceFileExt(fileExt.value) == coerceFileExt(fileExt.defaultValue) {
+			values = append(values, fileExt.name)
+		}
+	}
+	names := []struct {
+		name          string
+		value         []string
+		defaultValues []string
+	}{
+		{"stager_files", implant.StagerFiles, defaults.StagerFiles},
+		{"stager_paths", implant.StagerPaths, defaults.StagerPaths},
+		{"poll_files", implant.PollFiles, defaults.PollFiles},
+		{"poll_paths", implant.PollPaths, defaults.PollPaths},
+		{"session_files", implant.SessionFiles, defaults.SessionFiles},
+		{"session_paths", implant.SessionPaths, defaults.SessionPaths},
+		{"close_files", implant.CloseFiles, defaults.CloseFiles},
+		{"close_paths", implant.ClosePaths, defaults.ClosePaths},
+	}
+	for _, name := range names {
+		if sameValues(name.value, name.defaultValues) {
+			values = append(values, name.name)
+		}
+	}
+	return values
+}
+
+// sameValues - True if both contain the same values, in any order
+func sameValues(values []string, other []string) bool {
+	if len(values) != len(other) {
+		return false
+	}
+	counts := map[string]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+	for _, value := range other {
+		counts[value]--
+		if counts[value] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func generateDefaultConfig(saveTo string) error {
 	data, err := json.MarshalIndent(defaultHTTPC2Config, "", "    ")
 	if err != nil {
@@ -20,6 +20,8 @@ package configs
 
 import (
 	"encoding/json"
+	insecureRand "math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -90,3 +92,40 @@ func TestPollConfig(t *testing.T) {
 	config.ImplantConfig.PollFiles = origPollFiles
 
 }
+
+func TestDefaultValues(t *testing.T) {
+	data, _ := json.Marshal(defaultHTTPC2Config)
+	var config *HTTPC2Config
+	json.Unmarshal(data, &config)
+	if values := config.DefaultValues(); len(values) != 16 {
+		t.Fatalf("Expected every setting to be a default value, got %v", values)
+	}
+
+	config.ServerConfig.Cookies = []string{"__cf_bm"}
+	config.ImplantConfig.StagerFileExt = "mp4"
+	config.ImplantConfig.PollPaths = append(config.ImplantConfig.PollPaths, "cdn")
+	config.ImplantConfig.SessionFiles = []string{"index", "api", "login", "signin", "samples", "rpc", "admin", "register", "sign-up"}
+	for _, value := range config.DefaultValues() {
+		switch value {
+		case "cookies", "stager_file_ext", "poll_paths":
+			t.Fatalf("Expected %s to not be a default value", value)
+		}
+	}
+	if !sameValues(config.ImplantConfig.SessionFiles, defaultHTTPC2Config.ImplantConfig.SessionFiles) {
+		t.Fatal("Expected reordered values to be the same")
+	}
+}
+
+func TestSeededImplantConfig(t *testing.T) {
+	config := defaultHTTPC2Config
+	implantConfig1 := config.RandomImplantConfig(insecureRand.New(insecureRand.NewSource(1)))
+	userAgent1 := config.GenerateUserAgent("windows", "amd64", insecureRand.New(insecureRand.NewSource(1)))
+	implantConfig2 := config.RandomImplantConfig(insecureRand.New(insecureRand.NewSource(1)))
+	userAgent2 := config.GenerateUserAgent("windows", "amd64", insecureRand.New(insecureRand.NewSource(1)))
+	if !reflect.DeepEqual(implantConfig1, implantConfig2) {
+		t.Fatal("Expected the same seed to render the same implant config")
+	}
+	if userAgent1 != userAgent2 {
+		t.Fatalf("Expected the same seed to render the same user agent, got '%s' and '%s'", userAgent1, userAgent2)
+	}
+}
@@ -0,0 +1,165 @@
+package configs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/util/opsec"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	opsecPolicyFileName = "opsec.json"
+)
+
+var (
+	opsecPolicyLog = log.NamedLogger("config", "opsec")
+
+	opsecPolicy      *clientpb.OpsecPolicy
+	opsecPolicyMutex = &sync.RWMutex{}
+)
+
+// getOpsecPolicyPath - File path to opsec.json
+func getOpsecPolicyPath() string {
+	appDir := assets.GetRootAppDir()
+	return filepath.Join(appDir, "configs", opsecPolicyFileName)
+}
+
+// defaultOpsecPolicy - Classifies the usual risky commands but only warns, the
+// engagement rules decide what is prompted for or blocked
+func defaultOpsecPolicy() *clientpb.OpsecPolicy {
+	return &clientpb.OpsecPolicy{
+		Rules: []*clientpb.OpsecRule{
+			{
+				Category:    "process-injection",
+				Description: "injects code into another process",
+				Commands: []string{
+					consts.MigrateStr, consts.ExecuteAssemblyStr, consts.ExecuteShellcodeStr,
+					consts.SideloadStr, consts.SpawnDllStr, consts.MsfInjectStr,
+				},
+				Methods: []string{"Migrate", "ExecuteAssembly", "Task", "Sideload", "SpawnDll", "MsfRemote"},
+				Action:  opsec.ActionWarn,
+			},
+			{
+				Category:    "lsass-access",
+				Description: "opens a handle to lsass",
+				Commands:    []string{consts.ProcdumpStr},
+				Methods:     []string{"ProcessDump"},
+				Match:       "(?i)lsass",
+				Action:      opsec.ActionWarn,
+			},
+			{
+				Category:    "disk-write",
+				Description: "writes to the target's disk",
+				Commands: []string{
+					consts.UploadStr, consts.CpStr, consts.MvStr, consts.MkdirStr, consts.BackdoorStr, consts.PsExecStr,
+				},
+				Methods: []string{"Upload", "Cp", "Mv", "Mkdir", "Backdoor"},
+				Action:  opsec.ActionWarn,
+			},
+		},
+	}
+}
+
+// SaveOpsecPolicy - Save the opsec policy to disk
+func SaveOpsecPolicy(policy *clientpb.OpsecPolicy) error {
+	configPath := getOpsecPolicyPath()
+	configDir := filepath.Dir(configPath)
+	if _, err := os.Stat(configDir); os.IsNotExist(err) {
+		err := os.MkdirAll(configDir, 0700)
+		if err != nil {
+			return err
+		}
+	}
+	data, err := json.MarshalIndent(policy, "", "    ")
+	if err != nil {
+		return err
+	}
+	opsecPolicyLog.Infof("Saving opsec policy to %s", configPath)
+	return os.WriteFile(configPath, data, 0600)
+}
+
+// LoadOpsecPolicy - Read the opsec policy from disk, the default policy is written
+// if the file does not exist
+func LoadOpsecPolicy() (*clientpb.OpsecPolicy, error) {
+	configPath := getOpsecPolicyPath()
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		opsecPolicyLog.Debugf("Opsec policy file does not exist, using defaults")
+		policy := defaultOpsecPolicy()
+		err = SaveOpsecPolicy(policy)
+		if err != nil {
+			opsecPolicyLog.Errorf("Failed to save default opsec policy %s", err)
+		}
+		return policy, nil
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	policy := &clientpb.OpsecPolicy{}
+	err = json.Unmarshal(data, policy)
+	if err != nil {
+		return nil, err
+	}
+	err = opsec.Validate(policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetOpsecPolicy - A copy of the opsec policy the server enforces, read from disk on first use
+func GetOpsecPolicy() *clientpb.OpsecPolicy {
+	opsecPolicyMutex.RLock()
+	loaded := opsecPolicy != nil
+	opsecPolicyMutex.RUnlock()
+	if !loaded && ReloadOpsecPolicy() != nil {
+		// Fall back to the defaults rather than enforcing nothing
+		opsecPolicyMutex.Lock()
+		if opsecPolicy == nil {
+			opsecPolicy = defaultOpsecPolicy()
+		}
+		opsecPolicyMutex.Unlock()
+	}
+	opsecPolicyMutex.RLock()
+	defer opsecPolicyMutex.RUnlock()
+	return proto.Clone(opsecPolicy).(*clientpb.OpsecPolicy)
+}
+
+// ReloadOpsecPolicy - Re-read the opsec policy from disk, the current policy is kept
+// if the file is invalid
+func ReloadOpsecPolicy() error {
+	policy, err := LoadOpsecPolicy()
+	if err != nil {
+		opsecPolicyLog.Errorf("Failed to load opsec policy: %s", err)
+		return err
+	}
+	opsecPolicyMutex.Lock()
+	defer opsecPolicyMutex.Unlock()
+	opsecPolicy = policy
+	return nil
+}
@@ -0,0 +1,45 @@
+package configs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/util/opsec"
+)
+
+func TestDefaultOpsecPolicy(t *testing.T) {
+	policy := defaultOpsecPolicy()
+	err := opsec.Validate(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := opsec.CommandRules(policy.Rules, "procdump", "procdump --name lsass.exe")
+	if len(rules) != 1 || rules[0].Category != "lsass-access" {
+		t.Fatalf("Expected procdump of lsass to match, got %v", rules)
+	}
+	if len(opsec.CommandRules(policy.Rules, "procdump", "procdump --name explorer.exe")) != 0 {
+		t.Fatal("Expected procdump of other processes not to match")
+	}
+	for _, rule := range policy.Rules {
+		if opsec.Rank(opsec.ActionWarn) < opsec.Rank(rule.Action) {
+			t.Fatalf("Expected the default rules to only warn, %s is %s", rule.Category, rule.Action)
+		}
+	}
+}
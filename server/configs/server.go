@@ -24,8 +24,10 @@ import (
 	insecureRand "math/rand"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/assets"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/sirupsen/logrus"
@@ -68,6 +70,8 @@ type JobConfig struct {
 	WG          []*WGJobConfig          `json:"wg,omitempty"`
 	DNS         []*DNSJobConfig         `json:"dns,omitempty"`
 	HTTP        []*HTTPJobConfig        `json:"http,omitempty"`
+	Dashboard   []*DashboardJobConfig   `json:"dashboard,omitempty"`
+	Mythic      []*MythicJobConfig      `json:"mythic,omitempty"`
 }
 
 type MultiplayerJobConfig struct {
@@ -77,11 +81,30 @@ type MultiplayerJobConfig struct {
 	Tailscale bool   `json:"tailscale"`
 }
 
+// DashboardJobConfig - Persistent web dashboard config
+type DashboardJobConfig struct {
+	Host  string `json:"host"`
+	Port  uint16 `json:"port"`
+	JobID string `json:"job_id"`
+}
+
+// MythicJobConfig - Persistent Mythic bridge config
+type MythicJobConfig struct {
+	URL         string `json:"url"`
+	PayloadUUID string `json:"payload_uuid"`
+	Interval    int64  `json:"interval"`
+	SkipVerify  bool   `json:"skip_verify"`
+	JobID       string `json:"job_id"`
+}
+
 // MTLSJobConfig - Per-type job configs
 type MTLSJobConfig struct {
 	Host  string `json:"host"`
 	Port  uint16 `json:"port"`
 	JobID string `json:"job_id"`
+	SPA   bool   `json:"spa,omitempty"`
+
+	KnockPorts []uint16 `json:"knock_ports,omitempty"` // Udp ports knocked in order, blank is the port
 }
 
 // WGJobConfig - Per-type job configs
@@ -100,30 +123,168 @@ type DNSJobConfig struct {
 	Port       uint16   `json:"port"`
 	JobID      string   `json:"job_id"`
 	EnforceOTP bool     `json:"enforce_otp"`
+
+	ReplayWindow int64 `json:"replay_window,omitempty"`
 }
 
 // HTTPJobConfig - Persistent HTTP job config
 type HTTPJobConfig struct {
-	Domain          string `json:"domain"`
-	Host            string `json:"host"`
-	Port            uint16 `json:"port"`
-	Secure          bool   `json:"secure"`
-	Website         string `json:"website"`
-	Cert            []byte `json:"cert"`
-	Key             []byte `json:"key"`
-	ACME            bool   `json:"acme"`
-	JobID           string `json:"job_id"`
-	EnforceOTP      bool   `json:"enforce_otp"`
-	LongPollTimeout int64  `json:"long_poll_timeout"`
-	LongPollJitter  int64  `json:"long_poll_jitter"`
-	RandomizeJARM   bool   `json:"randomize_jarm"`
-}
-
-// WatchTowerConfig - Watch Tower job config
+	Domain          string   `json:"domain"`
+	Host            string   `json:"host"`
+	Port            uint16   `json:"port"`
+	Secure          bool     `json:"secure"`
+	Website         string   `json:"website"`
+	Cert            []byte   `json:"cert"`
+	Key             []byte   `json:"key"`
+	ACME            bool     `json:"acme"`
+	ACMEDNSProvider string   `json:"acme_dns_provider,omitempty"`
+	ACMEDomains     []string `json:"acme_domains,omitempty"`
+	JobID           string   `json:"job_id"`
+	EnforceOTP      bool     `json:"enforce_otp"`
+	LongPollTimeout int64    `json:"long_poll_timeout"`
+	LongPollJitter  int64    `json:"long_poll_jitter"`
+	RandomizeJARM   bool     `json:"randomize_jarm"`
+	ReplayWindow    int64    `json:"replay_window,omitempty"`
+
+	RequireClientCert bool `json:"require_client_cert,omitempty"`
+
+	ACL *clientpb.HTTPListenerACL `json:"acl,omitempty"`
+}
+
+// WatchTowerConfig - Watch Tower job config, see server/watchtower
 type WatchTowerConfig struct {
 	VTApiKey          string `json:"vt_api_key"`
 	XForceApiKey      string `json:"xforce_api_key"`
 	XForceApiPassword string `json:"xforce_api_password"`
+	ShodanApiKey      string `json:"shodan_api_key,omitempty"`
+	CensysApiID       string `json:"censys_api_id,omitempty"`
+	CensysApiSecret   string `json:"censys_api_secret,omitempty"`
+	CrtSh             bool   `json:"crtsh,omitempty"`
+
+	ScanInterval string         `json:"scan_interval,omitempty"` // e.g. 6h
+	RateLimits   map[string]int `json:"rate_limits,omitempty"`   // requests per minute, by provider
+	Domains      []string       `json:"domains,omitempty"`       // checked in addition to implant and listener domains
+	Addresses    []string       `json:"addresses,omitempty"`     // checked in addition to implant and listener addresses
+}
+
+// ACMEConfig - Let's Encrypt settings, the DNS provider configs are only
+// required for DNS-01 challenges (e.g. wildcard certificates)
+type ACMEConfig struct {
+	Email              string            `json:"email"`
+	DirectoryURL       string            `json:"directory_url"`
+	PropagationSeconds int               `json:"propagation_seconds"`
+	Route53            *Route53Config    `json:"route53,omitempty"`
+	Cloudflare         *CloudflareConfig `json:"cloudflare,omitempty"`
+}
+
+// Route53Config - AWS Route53 DNS provider, if the keys are blank the default
+// AWS credential chain (environment, shared config, instance role) is used
+type Route53Config struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SessionToken    string `json:"session_token"`
+	Region          string `json:"region"`
+	HostedZoneID    string `json:"hosted_zone_id"`
+}
+
+// CloudflareConfig - Cloudflare DNS provider, the API token needs Zone:DNS:Edit
+type CloudflareConfig struct {
+	APIToken string `json:"api_token"`
+	ZoneID   string `json:"zone_id"`
+}
+
+// OperatorsConfig - Operator client certificate policy
+type OperatorsConfig struct {
+	// Lifetime of new operator client certificates, 0 uses the legacy randomized validity
+	CertLifetimeDays int `json:"cert_lifetime_days"`
+	// Address of the OCSP responder for operator certificates e.g. "127.0.0.1:8889",
+	// disabled if blank
+	OCSPListen string `json:"ocsp_listen"`
+	// Responder URL embedded in new operator certificates, as reached by TLS proxies
+	OCSPURL string `json:"ocsp_url"`
+}
+
+// EncryptionConfig - At-rest encryption of loot files and sensitive database columns,
+// the key is derived from a passphrase (environment variable or key file) or a random
+// key stored in the OS keyring. Losing the key means losing the encrypted data.
+type EncryptionConfig struct {
+	Enabled   bool   `json:"enabled"`
+	KeySource string `json:"key_source"` // env, file, or keyring
+	KeyFile   string `json:"key_file,omitempty"`
+}
+
+// ExporterConfig - Stream server events and task logs to a SIEM, see server/exporter
+type ExporterConfig struct {
+	Type               string   `json:"type"` // elasticsearch, splunk, syslog, or webhook
+	URL                string   `json:"url"`  // base URL, or udp://, tcp://, tls:// address for syslog
+	Index              string   `json:"index,omitempty"`
+	Token              string   `json:"token,omitempty"` // Splunk HEC token or Elasticsearch API key
+	Username           string   `json:"username,omitempty"`
+	Password           string   `json:"password,omitempty"`
+	InsecureSkipVerify bool     `json:"insecure_skip_verify,omitempty"`
+	Kinds              []string `json:"kinds,omitempty"` // event, task, result; blank exports everything
+	Types              []string `json:"types,omitempty"` // event types or task methods; blank exports everything
+}
+
+// RecordingsConfig - Interactive shell and console recordings, see server/recordings
+type RecordingsConfig struct {
+	Disabled bool `json:"disabled"`
+}
+
+// MetricsConfig - Prometheus metrics endpoint, see server/metrics
+type MetricsConfig struct {
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host"`
+	Port    uint16 `json:"port"`
+	Token   string `json:"token"` // Bearer token, generated if blank
+	TLS     bool   `json:"tls"`   // Serve HTTPS with a certificate signed by the operator CA
+}
+
+// GeoIPConfig - Offline geoip databases, see server/geoip. Databases are MaxMind DB files
+// (.mmdb), or CSVs of "first ip,last ip,country code" or "first ip,last ip,asn,organization"
+// ranges (e.g. db-ip.com's lite databases)
+type GeoIPConfig struct {
+	CountryDatabase string `json:"country_database"`
+	ASNDatabase     string `json:"asn_database"`
+	ReverseDNS      bool   `json:"reverse_dns"` // PTR lookups are sent to the address owner's name servers
+}
+
+// BeaconsConfig - Beacon check-in tracking, see server/core/beacons.go
+type BeaconsConfig struct {
+	OverdueCheckins int `json:"overdue_checkins"` // Missed check-ins before alerting, 0 is the default, negative disables
+}
+
+// ResultsConfig - Task result size limits, see server/loot/results.go
+type ResultsConfig struct {
+	MaxSize int64 `json:"max_size"` // Bytes, larger beacon task results are saved as loot, 0 is the default, negative disables
+}
+
+// ToolchainsConfig - Cross-compilers installed by the server, see server/toolchains
+type ToolchainsConfig struct {
+	DisableAutoInstall bool   `json:"disable_auto_install"` // Fail builds that need a missing cross-compiler
+	ZigVersion         string `json:"zig_version,omitempty"`
+	IndexURL           string `json:"index_url,omitempty"` // zig release index (or a mirror of it)
+}
+
+// BloodHoundConfig - BloodHound Community Edition instance that ingested collections
+// are uploaded to, see server/bloodhound
+type BloodHoundConfig struct {
+	URL                string `json:"url"`
+	TokenID            string `json:"token_id"`
+	TokenKey           string `json:"token_key"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify,omitempty"`
+	AutoUpload         bool   `json:"auto_upload,omitempty"` // upload every ingested collection
+}
+
+// SPAConfig - Single packet authorization listeners, see server/c2/spa.go. The commands
+// aren't run by a shell, they're split on whitespace and {ip} and {port} are replaced with
+// the knocking address and the listener's port. Without them the port stays open and
+// connections from addresses that haven't knocked are dropped once they're accepted.
+type SPAConfig struct {
+	AllowCommand  string `json:"allow_command,omitempty"`  // e.g. iptables -I INPUT -p tcp -s {ip} --dport {port} -j ACCEPT
+	RevokeCommand string `json:"revoke_command,omitempty"` // e.g. iptables -D INPUT -p tcp -s {ip} --dport {port} -j ACCEPT
+	Window        int64  `json:"window,omitempty"`         // Seconds a knock lets an address connect for, 0 is the default
+	ClockSkew     int64  `json:"clock_skew,omitempty"`     // Seconds a knock's timestamp may be off from the server's clock, 0 is the default
 }
 
 // ServerConfig - Server config
@@ -134,6 +295,18 @@ type ServerConfig struct {
 	Jobs         *JobConfig        `json:"jobs,omitempty"`
 	Watchtower   *WatchTowerConfig `json:"watch_tower"`
 	GoProxy      string            `json:"go_proxy"`
+	ACME         *ACMEConfig       `json:"acme,omitempty"`
+	Operators    *OperatorsConfig  `json:"operators,omitempty"`
+	Encryption   *EncryptionConfig `json:"encryption,omitempty"`
+	Exporters    []*ExporterConfig `json:"exporters,omitempty"`
+	Recordings   *RecordingsConfig `json:"recordings,omitempty"`
+	Metrics      *MetricsConfig    `json:"metrics,omitempty"`
+	GeoIP        *GeoIPConfig      `json:"geoip,omitempty"`
+	BloodHound   *BloodHoundConfig `json:"bloodhound,omitempty"`
+	Beacons      *BeaconsConfig    `json:"beacons,omitempty"`
+	Results      *ResultsConfig    `json:"results,omitempty"`
+	Toolchains   *ToolchainsConfig `json:"toolchains,omitempty"`
+	SPA          *SPAConfig        `json:"spa,omitempty"`
 }
 
 // Save - Save config file to disk
@@ -209,6 +382,26 @@ func (c *ServerConfig) AddHTTPJob(config *HTTPJobConfig) error {
 	return c.Save()
 }
 
+// AddDashboardJob - Add a persistent web dashboard
+func (c *ServerConfig) AddDashboardJob(config *DashboardJobConfig) error {
+	if c.Jobs == nil {
+		c.Jobs = &JobConfig{}
+	}
+	config.JobID = getRandomID()
+	c.Jobs.Dashboard = append(c.Jobs.Dashboard, config)
+	return c.Save()
+}
+
+// AddMythicJob - Add a persistent Mythic bridge
+func (c *ServerConfig) AddMythicJob(config *MythicJobConfig) error {
+	if c.Jobs == nil {
+		c.Jobs = &JobConfig{}
+	}
+	config.JobID = getRandomID()
+	c.Jobs.Mythic = append(c.Jobs.Mythic, config)
+	return c.Save()
+}
+
 // RemoveJob - Remove Job by ID
 func (c *ServerConfig) RemoveJob(jobID string) {
 	if c.Jobs == nil {
@@ -233,6 +426,18 @@ func (c *ServerConfig) RemoveJob(jobID string) {
 			return
 		}
 	}
+	for i, j := range c.Jobs.Dashboard {
+		if j.JobID == jobID {
+			c.Jobs.Dashboard = append(c.Jobs.Dashboard[:i], c.Jobs.Dashboard[i+1:]...)
+			return
+		}
+	}
+	for i, j := range c.Jobs.Mythic {
+		if j.JobID == jobID {
+			c.Jobs.Mythic = append(c.Jobs.Mythic[:i], c.Jobs.Mythic[i+1:]...)
+			return
+		}
+	}
 }
 
 // GetServerConfig - Get config value
@@ -254,13 +459,7 @@ func GetServerConfig() *ServerConfig {
 		serverConfigLog.Warnf("Config file does not exist, using defaults")
 	}
 
-	if config.Logs.Level < 0 {
-		config.Logs.Level = 0
-	}
-	if 6 < config.Logs.Level {
-		config.Logs.Level = 6
-	}
-	log.RootLogger.SetLevel(log.LevelFrom(config.Logs.Level))
+	config.applyLogLevel()
 
 	err := config.Save() // This updates the config with any missing fields
 	if err != nil {
@@ -269,6 +468,80 @@ func GetServerConfig() *ServerConfig {
 	return config
 }
 
+func (c *ServerConfig) applyLogLevel() {
+	if c.Logs.Level < 0 {
+		c.Logs.Level = 0
+	}
+	if 6 < c.Logs.Level {
+		c.Logs.Level = 6
+	}
+	log.RootLogger.SetLevel(log.LevelFrom(c.Logs.Level))
+}
+
+// LoadServerConfig - Like GetServerConfig but returns an error instead of falling back to
+// the defaults if the config file cannot be parsed, used to reload the config at runtime
+func LoadServerConfig() (*ServerConfig, error) {
+	config := getDefaultServerConfig()
+	data, err := os.ReadFile(GetServerConfigPath())
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	if config.Logs == nil {
+		config.Logs = getDefaultServerConfig().Logs
+	}
+	config.applyLogLevel()
+	return config, nil
+}
+
+// ServerConfigReloader - Re-applies a reloaded server config to a running component
+type ServerConfigReloader func(*ServerConfig) error
+
+var (
+	reloaders      = map[string]ServerConfigReloader{}
+	reloadersOrder = []string{}
+	reloadersMutex = &sync.Mutex{}
+)
+
+// OnServerConfigReload - Register a component that is re-configured when the server
+// config is reloaded, components that are not registered need a server restart
+func OnServerConfigReload(component string, reloader ServerConfigReloader) {
+	reloadersMutex.Lock()
+	defer reloadersMutex.Unlock()
+	if _, ok := reloaders[component]; !ok {
+		reloadersOrder = append(reloadersOrder, component)
+	}
+	reloaders[component] = reloader
+}
+
+// ReloadServerConfig - Read the server config from disk and apply it to every registered
+// component, returns the components that were reloaded and the errors of those that failed
+func ReloadServerConfig() ([]string, map[string]error, error) {
+	config, err := LoadServerConfig()
+	if err != nil {
+		serverConfigLog.Errorf("Failed to reload server config: %s", err)
+		return nil, nil, err
+	}
+	serverConfigLog.Infof("Reloading server config")
+	reloadersMutex.Lock()
+	defer reloadersMutex.Unlock()
+	reloaded := []string{"logs"}
+	errs := map[string]error{}
+	for _, component := range reloadersOrder {
+		err := reloaders[component](config)
+		if err != nil {
+			serverConfigLog.Errorf("Failed to reload %s: %s", component, err)
+			errs[component] = err
+			continue
+		}
+		reloaded = append(reloaded, component)
+	}
+	return reloaded, errs, nil
+}
+
 func getDefaultServerConfig() *ServerConfig {
 	return &ServerConfig{
 		DaemonMode: false,
@@ -20,8 +20,10 @@ package console
 
 import (
 	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -29,6 +31,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -36,8 +40,11 @@ import (
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/dashboard"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/mythic"
+	"github.com/bishopfox/sliver/server/rpc"
 	"github.com/bishopfox/sliver/server/transport"
 )
 
@@ -86,13 +93,25 @@ func newOperatorCmd(cmd *cobra.Command, _ []string) {
 	lhost, _ := cmd.Flags().GetString("lhost")
 	lport, _ := cmd.Flags().GetUint16("lport")
 	save, _ := cmd.Flags().GetString("save")
+	lifetime, _ := cmd.Flags().GetInt("lifetime")
+	standby, _ := cmd.Flags().GetBool("standby")
 
 	if save == "" {
 		save, _ = os.Getwd()
 	}
 
+	validFor := certs.OperatorCertLifetime()
+	if 0 < lifetime {
+		validFor = time.Duration(lifetime) * 24 * time.Hour
+	}
 	fmt.Printf(Info + "Generating new client certificate, please wait ... \n")
-	configJSON, err := NewOperatorConfig(name, lhost, lport)
+	var configJSON []byte
+	var err error
+	if standby {
+		configJSON, err = NewStandbyConfig(name, lhost, lport, validFor)
+	} else {
+		configJSON, err = NewOperatorConfigValidFor(name, lhost, lport, validFor)
+	}
 	if err != nil {
 		fmt.Printf(Warn+"%s\n", err)
 		return
@@ -118,6 +137,22 @@ func newOperatorCmd(cmd *cobra.Command, _ []string) {
 
 // NewOperatorConfig - Generate a new player/client/operator configuration
 func NewOperatorConfig(operatorName string, lhost string, lport uint16) ([]byte, error) {
+	return NewOperatorConfigValidFor(operatorName, lhost, lport, certs.OperatorCertLifetime())
+}
+
+// NewOperatorConfigValidFor - Generate an operator configuration whose client certificate
+// expires after validFor, zero uses the default randomized validity period
+func NewOperatorConfigValidFor(operatorName string, lhost string, lport uint16, validFor time.Duration) ([]byte, error) {
+	return newOperatorConfig(operatorName, lhost, lport, validFor, false)
+}
+
+// NewStandbyConfig - Generate the configuration of a standby server's identity, which
+// can only copy this server's state (see server/ha)
+func NewStandbyConfig(operatorName string, lhost string, lport uint16, validFor time.Duration) ([]byte, error) {
+	return newOperatorConfig(operatorName, lhost, lport, validFor, true)
+}
+
+func newOperatorConfig(operatorName string, lhost string, lport uint16, validFor time.Duration, standby bool) ([]byte, error) {
 	if !namePattern.MatchString(operatorName) {
 		return nil, errors.New("invalid operator name (alphanumerics only)")
 	}
@@ -128,20 +163,30 @@ func NewOperatorConfig(operatorName string, lhost string, lport uint16) ([]byte,
 		return nil, errors.New("invalid lhost")
 	}
 
-	rawToken := models.GenerateOperatorToken()
-	digest := sha256.Sum256([]byte(rawToken))
-	dbOperator := &models.Operator{
-		Name:  operatorName,
-		Token: hex.EncodeToString(digest[:]),
+	publicKey, privateKey, err := certs.OperatorClientGenerateCertificateValidFor(operatorName, validFor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate %s", err)
 	}
-	err := db.Session().Save(dbOperator).Error
+	block, _ := pem.Decode(publicKey)
+	if block == nil {
+		return nil, errors.New("failed to parse generated certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
 	if err != nil {
 		return nil, err
 	}
 
-	publicKey, privateKey, err := certs.OperatorClientGenerateCertificate(operatorName)
+	rawToken := models.GenerateOperatorToken()
+	digest := sha256.Sum256([]byte(rawToken))
+	dbOperator := &models.Operator{
+		Name:              operatorName,
+		Token:             hex.EncodeToString(digest[:]),
+		CertificateSerial: certs.SerialNumberString(cert.SerialNumber),
+		Standby:           standby,
+	}
+	err = db.Session().Save(dbOperator).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate certificate %s", err)
+		return nil, err
 	}
 	caCertPEM, _, _ := certs.GetCertificateAuthorityPEM(certs.OperatorCA)
 	config := ClientConfig{
@@ -167,15 +212,89 @@ func kickOperatorCmd(cmd *cobra.Command, _ []string) {
 		return
 	}
 	transport.ClearTokenCache()
-	fmt.Printf(Info+"Removing client certificate(s) for %s, please wait ... \n", operator)
-	err = certs.OperatorClientRemoveCertificate(operator)
+	fmt.Printf(Info+"Revoking client certificate(s) for %s, please wait ... \n", operator)
+	_, err = certs.OperatorRevokeCertificates(operator)
 	if err != nil {
-		fmt.Printf(Warn+"Failed to remove the operator certificate: %v \n", err)
+		fmt.Printf(Warn+"Failed to revoke the operator certificate: %v \n", err)
 		return
 	}
 	fmt.Printf(Info+"Operator %s has been kicked out.\n", operator)
 }
 
+func operatorCertsCmd(cmd *cobra.Command, _ []string) {
+	operatorCerts, err := certs.OperatorClientCertificates()
+	if err != nil {
+		fmt.Printf(Warn+"%s\n", err)
+		return
+	}
+	if len(operatorCerts) == 0 {
+		fmt.Printf(Info + "No operator certificates\n")
+		return
+	}
+	table := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintf(table, "Operator\tSerial\tCreated\tExpires\tStatus\t\n")
+	for _, certModel := range operatorCerts {
+		status := "valid"
+		if certModel.Revoked {
+			status = fmt.Sprintf("revoked %s", certModel.RevokedAt.Format(time.RFC1123))
+		} else if certModel.NotAfter.Before(time.Now()) {
+			status = "expired"
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t\n",
+			certs.OperatorNameFromCommonName(certModel.CommonName),
+			certModel.SerialNumber,
+			certModel.CreatedAt.Format(time.RFC1123),
+			certModel.NotAfter.Format(time.RFC1123),
+			status,
+		)
+	}
+	table.Flush()
+}
+
+func revokeOperatorCmd(cmd *cobra.Command, _ []string) {
+	serial, _ := cmd.Flags().GetString("serial")
+	if serial == "" {
+		fmt.Printf(Warn + "Must specify --serial, see operator-certs\n")
+		return
+	}
+	certModel, err := certs.OperatorRevokeCertificate(serial)
+	if err != nil {
+		fmt.Printf(Warn+"Failed to revoke certificate %s: %s\n", serial, err)
+		return
+	}
+	err = db.Session().Where(&models.Operator{
+		CertificateSerial: certModel.SerialNumber,
+	}).Delete(&models.Operator{}).Error
+	if err != nil {
+		fmt.Printf(Warn+"Failed to remove auth token: %s\n", err)
+		return
+	}
+	transport.ClearTokenCache()
+	fmt.Printf(Info+"Revoked certificate %s of operator %s\n", certModel.SerialNumber, certs.OperatorNameFromCommonName(certModel.CommonName))
+	fmt.Printf(Info+"Updated CRL %s\n", certs.GetOperatorCRLPath())
+}
+
+func rotateOperatorCACmd(cmd *cobra.Command, _ []string) {
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		fmt.Printf(Warn + "Rotating the operator CA invalidates ALL operator configs, re-run with --force to continue\n")
+		return
+	}
+	revoked, err := certs.RotateOperatorCA()
+	if err != nil {
+		fmt.Printf(Warn+"Failed to rotate operator CA: %s\n", err)
+		return
+	}
+	err = db.Session().Where("1 = 1").Delete(&models.Operator{}).Error
+	if err != nil {
+		fmt.Printf(Warn+"Failed to remove auth tokens: %s\n", err)
+		return
+	}
+	transport.ClearTokenCache()
+	fmt.Printf(Info+"Operator CA rotated, revoked %d operator certificate(s)\n", len(revoked))
+	fmt.Printf(Info + "Generate new operator configs with new-operator\n")
+}
+
 func StartPersistentJobs(cfg *configs.ServerConfig) error {
 	if cfg.Jobs == nil {
 		return nil
@@ -187,6 +306,27 @@ func StartPersistentJobs(cfg *configs.ServerConfig) error {
 			jobStartMtlsClientListener(j.Host, j.Port)
 		}
 	}
+	for _, j := range cfg.Jobs.Dashboard {
+		jobID, err := jobStartDashboard(j.Host, j.Port)
+		if err != nil {
+			log.Printf("Failed to start persistent dashboard: %s", err)
+			continue
+		}
+		core.Jobs.Get(jobID).PersistentID = j.JobID
+	}
+	for _, j := range cfg.Jobs.Mythic {
+		jobID, err := jobStartMythicBridge(&mythic.Config{
+			URL:         j.URL,
+			PayloadUUID: j.PayloadUUID,
+			Interval:    time.Duration(j.Interval),
+			SkipVerify:  j.SkipVerify,
+		})
+		if err != nil {
+			log.Printf("Failed to start persistent mythic bridge: %s", err)
+			continue
+		}
+		core.Jobs.Get(jobID).PersistentID = j.JobID
+	}
 	return nil
 }
 
@@ -279,3 +419,103 @@ func jobStartTsNetClientListener(host string, port uint16) (int, error) {
 	core.Jobs.Add(job)
 	return job.ID, nil
 }
+
+func startDashboardCmd(cmd *cobra.Command, _ []string) {
+	lhost, _ := cmd.Flags().GetString("lhost")
+	lport, _ := cmd.Flags().GetUint16("lport")
+	persistent, _ := cmd.Flags().GetBool("persistent")
+
+	jobID, err := jobStartDashboard(lhost, lport)
+	if err != nil {
+		fmt.Printf(Warn+"Failed to start job %v\n", err)
+		return
+	}
+	fmt.Printf(Info+"Web dashboard listening on https://%s:%d (requires an operator certificate)\n", lhost, lport)
+	if persistent {
+		serverConfig := configs.GetServerConfig()
+		dashboardConfig := &configs.DashboardJobConfig{
+			Host: lhost,
+			Port: lport,
+		}
+		serverConfig.AddDashboardJob(dashboardConfig)
+		core.Jobs.Get(jobID).PersistentID = dashboardConfig.JobID
+	}
+}
+
+func jobStartDashboard(host string, port uint16) (int, error) {
+	webDashboard, err := dashboard.Start(host, port)
+	if err != nil {
+		return -1, err // If we fail to bind don't setup the Job
+	}
+
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        "dashboard",
+		Description: "web dashboard",
+		Protocol:    "tcp",
+		Port:        port,
+		JobCtrl:     make(chan bool),
+	}
+
+	go func() {
+		<-job.JobCtrl
+		log.Printf("Stopping web dashboard (%d) ...\n", job.ID)
+		webDashboard.Stop()
+		core.Jobs.Remove(job)
+	}()
+
+	core.Jobs.Add(job)
+	return job.ID, nil
+}
+
+func startMythicBridgeCmd(cmd *cobra.Command, _ []string) {
+	config := &mythic.Config{}
+	config.URL, _ = cmd.Flags().GetString("url")
+	config.PayloadUUID, _ = cmd.Flags().GetString("payload-uuid")
+	config.Interval, _ = cmd.Flags().GetDuration("interval")
+	config.SkipVerify, _ = cmd.Flags().GetBool("skip-verify")
+	persistent, _ := cmd.Flags().GetBool("persistent")
+
+	jobID, err := jobStartMythicBridge(config)
+	if err != nil {
+		fmt.Printf(Warn+"Failed to start job %v\n", err)
+		return
+	}
+	fmt.Printf(Info+"Bridging sessions to %s (job %d)\n", config.URL, jobID)
+	if persistent {
+		serverConfig := configs.GetServerConfig()
+		mythicConfig := &configs.MythicJobConfig{
+			URL:         config.URL,
+			PayloadUUID: config.PayloadUUID,
+			Interval:    int64(config.Interval),
+			SkipVerify:  config.SkipVerify,
+		}
+		serverConfig.AddMythicJob(mythicConfig)
+		core.Jobs.Get(jobID).PersistentID = mythicConfig.JobID
+	}
+}
+
+func jobStartMythicBridge(config *mythic.Config) (int, error) {
+	bridge, err := mythic.Start(config, transport.InProcessClient(&rpc.Server{}, mythic.Operator))
+	if err != nil {
+		return -1, err
+	}
+
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        "mythic",
+		Description: fmt.Sprintf("mythic bridge to %s", config.URL),
+		Protocol:    "http",
+		JobCtrl:     make(chan bool),
+	}
+
+	go func() {
+		<-job.JobCtrl
+		log.Printf("Stopping mythic bridge (%d) ...\n", job.ID)
+		bridge.Stop()
+		core.Jobs.Remove(job)
+	}()
+
+	core.Jobs.Add(job)
+	return job.ID, nil
+}
@@ -34,6 +34,8 @@ import (
 	clienttransport "github.com/bishopfox/sliver/client/transport"
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/dashboard"
+	"github.com/bishopfox/sliver/server/mythic"
 	"github.com/bishopfox/sliver/server/transport"
 	"google.golang.org/grpc"
 )
@@ -62,7 +64,7 @@ func Start() {
 	}
 
 	con := console.NewConsole(false)
-	console.StartClient(con, localRPC, command.ServerCommands(con, serverOnlyCmds), command.SliverCommands(con), true)
+	console.StartClient(con, localRPC, command.ServerCommandsFactory(serverOnlyCmds), command.SliverCommands, true)
 
 	con.App.Start()
 }
@@ -87,6 +89,36 @@ func serverOnlyCmds() (commands []*cobra.Command) {
 
 	commands = append(commands, startMultiplayer)
 
+	startDashboard := &cobra.Command{
+		Use:     consts.DashboardStr,
+		Short:   "Start the web dashboard",
+		Long:    help.GetHelpFor([]string{consts.DashboardStr}),
+		Run:     startDashboardCmd,
+		GroupID: consts.MultiplayerHelpGroup,
+	}
+	command.Flags("dashboard", false, startDashboard, func(f *pflag.FlagSet) {
+		f.StringP("lhost", "L", "", "hostname to bind the dashboard to")
+		f.Uint16P("lport", "l", dashboard.DefaultPort, "tcp listen port")
+		f.BoolP("persistent", "p", false, "make persistent across restarts")
+	})
+	commands = append(commands, startDashboard)
+
+	startMythicBridge := &cobra.Command{
+		Use:     consts.MythicBridgeStr,
+		Short:   "Bridge sessions to a Mythic instance",
+		Long:    help.GetHelpFor([]string{consts.MythicBridgeStr}),
+		Run:     startMythicBridgeCmd,
+		GroupID: consts.MultiplayerHelpGroup,
+	}
+	command.Flags("mythic", false, startMythicBridge, func(f *pflag.FlagSet) {
+		f.StringP("url", "u", "", "agent message url of the mythic http c2 profile")
+		f.StringP("payload-uuid", "U", "", "uuid of the mythic payload sessions check in as")
+		f.DurationP("interval", "i", mythic.DefaultInterval, "how often to check mythic for tasks")
+		f.BoolP("skip-verify", "k", false, "skip tls certificate verification")
+		f.BoolP("persistent", "p", false, "make persistent across restarts")
+	})
+	commands = append(commands, startMythicBridge)
+
 	newOperator := &cobra.Command{
 		Use:     consts.NewOperatorStr,
 		Short:   "Create a new operator config file",
@@ -99,6 +131,8 @@ func serverOnlyCmds() (commands []*cobra.Command) {
 		f.Uint16P("lport", "p", 31337, "listen port")
 		f.StringP("save", "s", "", "directory/file in which to save config")
 		f.StringP("name", "n", "", "operator name")
+		f.IntP("lifetime", "t", 0, "client certificate lifetime in days (default: server config)")
+		f.Bool("standby", false, "identity of a standby server, it can only copy this server's state")
 	})
 	command.FlagComps(newOperator, func(comp *carapace.ActionMap) {
 		(*comp)["save"] = carapace.ActionDirectories()
@@ -118,5 +152,38 @@ func serverOnlyCmds() (commands []*cobra.Command) {
 	})
 	commands = append(commands, kickOperator)
 
+	operatorCerts := &cobra.Command{
+		Use:     consts.OperatorCertsStr,
+		Short:   "List operator client certificates",
+		Long:    help.GetHelpFor([]string{consts.OperatorCertsStr}),
+		Run:     operatorCertsCmd,
+		GroupID: consts.MultiplayerHelpGroup,
+	}
+	commands = append(commands, operatorCerts)
+
+	revokeOperator := &cobra.Command{
+		Use:     consts.RevokeOperatorStr,
+		Short:   "Revoke a single operator config",
+		Long:    help.GetHelpFor([]string{consts.RevokeOperatorStr}),
+		Run:     revokeOperatorCmd,
+		GroupID: consts.MultiplayerHelpGroup,
+	}
+	command.Flags("operator", false, revokeOperator, func(f *pflag.FlagSet) {
+		f.StringP("serial", "s", "", "serial number of the certificate to revoke")
+	})
+	commands = append(commands, revokeOperator)
+
+	rotateOperatorCA := &cobra.Command{
+		Use:     consts.RotateOperatorCAStr,
+		Short:   "Rotate the operator certificate authority",
+		Long:    help.GetHelpFor([]string{consts.RotateOperatorCAStr}),
+		Run:     rotateOperatorCACmd,
+		GroupID: consts.MultiplayerHelpGroup,
+	}
+	command.Flags("operator", false, rotateOperatorCA, func(f *pflag.FlagSet) {
+		f.BoolP("force", "f", false, "confirm that all operator configs will be invalidated")
+	})
+	commands = append(commands, rotateOperatorCA)
+
 	return
 }
@@ -0,0 +1,127 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// DefaultOverdueCheckins - Missed check-ins before a beacon is reported as overdue
+	DefaultOverdueCheckins = 3
+
+	beaconWatchdogInterval = 30 * time.Second
+)
+
+var (
+	beaconsLog = log.NamedLogger("core", "beacons")
+
+	// BeaconWatchdog - Raises an event when a beacon misses too many expected check-ins
+	BeaconWatchdog = &beaconWatchdog{
+		overdueCheckins: DefaultOverdueCheckins,
+		alerted:         map[string]bool{},
+		mutex:           &sync.Mutex{},
+	}
+)
+
+type beaconWatchdog struct {
+	overdueCheckins int64 // Negative disables alerts
+	alerted         map[string]bool
+	mutex           *sync.Mutex
+	startOnce       sync.Once
+}
+
+// Start - Start checking beacons in the background
+func (w *beaconWatchdog) Start(config *configs.ServerConfig) {
+	w.Reload(config)
+	w.startOnce.Do(func() {
+		go func() {
+			for {
+				time.Sleep(beaconWatchdogInterval)
+				beacons, err := db.ListBeacons()
+				if err != nil {
+					beaconsLog.Errorf("Failed to list beacons: %s", err)
+					continue
+				}
+				for _, beacon := range w.overdue(beacons, time.Now()) {
+					BeaconOverdue(beacon)
+				}
+			}
+		}()
+	})
+}
+
+// Reload - Apply the beacons section of the server config
+func (w *beaconWatchdog) Reload(config *configs.ServerConfig) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.overdueCheckins = DefaultOverdueCheckins
+	if config.Beacons != nil && config.Beacons.OverdueCheckins != 0 {
+		w.overdueCheckins = int64(config.Beacons.OverdueCheckins)
+	}
+}
+
+// overdue - Beacons that just missed too many check-ins, a beacon is only reported
+// once until it checks in again
+func (w *beaconWatchdog) overdue(beacons []*models.Beacon, now time.Time) []*models.Beacon {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	overdue := []*models.Beacon{}
+	seen := map[string]bool{}
+	for _, beacon := range beacons {
+		id := beacon.ID.String()
+		seen[id] = true
+		missed := beacon.MissedCheckins(now)
+		if missed == 0 {
+			delete(w.alerted, id)
+			continue
+		}
+		if w.overdueCheckins < 0 || missed < w.overdueCheckins || w.alerted[id] || BurnList.IsBurned(beacon.Name) {
+			continue
+		}
+		w.alerted[id] = true
+		overdue = append(overdue, beacon)
+	}
+	for id := range w.alerted {
+		if !seen[id] {
+			delete(w.alerted, id) // Removed beacon
+		}
+	}
+	return overdue
+}
+
+// BeaconOverdue - Alert operators that a beacon has missed too many check-ins
+func BeaconOverdue(beacon *models.Beacon) {
+	missed := beacon.MissedCheckins(time.Now())
+	beaconsLog.Warnf("Beacon %s (%s) missed %d check-in(s)", beacon.Name, beacon.ID, missed)
+	EventBroker.Publish(Event{
+		EventType: consts.BeaconOverdueEvent,
+		Beacon:    beacon,
+		Data: []byte(fmt.Sprintf("Beacon %s %s (%s) missed %d check-ins, last seen %s ago",
+			shortID(beacon.ID.String()), beacon.Name, beacon.Hostname, missed, time.Since(beacon.LastCheckin).Round(time.Second))),
+	})
+}
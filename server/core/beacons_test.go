@@ -0,0 +1,93 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+func TestBeaconMissedCheckins(t *testing.T) {
+	now := time.Now()
+	beacon := &models.Beacon{
+		Interval:    int64(time.Minute),
+		Jitter:      int64(30 * time.Second),
+		NextCheckin: now.Unix(),
+	}
+	for _, test := range []struct {
+		at     time.Time
+		missed int64
+	}{
+		{now.Add(-time.Minute), 0},
+		{now.Add(models.BeaconCheckinGrace), 0},
+		{now.Add(models.BeaconCheckinGrace + time.Second), 1},
+		{now.Add(models.BeaconCheckinGrace + 90*time.Second), 2},
+		{now.Add(models.BeaconCheckinGrace + 5*90*time.Second), 6},
+	} {
+		if missed := beacon.MissedCheckins(test.at); missed != test.missed {
+			t.Fatalf("expected %d missed check-ins %s after the next check-in, got %d", test.missed, test.at.Sub(now), missed)
+		}
+	}
+	if (&models.Beacon{}).MissedCheckins(now) != 0 {
+		t.Fatal("expected a beacon without a next check-in to never miss one")
+	}
+}
+
+func TestBeaconWatchdog(t *testing.T) {
+	watchdog := &beaconWatchdog{
+		overdueCheckins: 3,
+		alerted:         map[string]bool{},
+		mutex:           &sync.Mutex{},
+	}
+	now := time.Now()
+	beacon := &models.Beacon{
+		ID:          uuid.Must(uuid.NewV4()),
+		Name:        "LATE_BLOOMER",
+		Interval:    int64(time.Minute),
+		NextCheckin: now.Add(-2 * time.Minute).Unix(),
+	}
+	if overdue := watchdog.overdue([]*models.Beacon{beacon}, now); len(overdue) != 0 {
+		t.Fatal("expected a beacon with 2 missed check-ins not to be overdue")
+	}
+	beacon.NextCheckin = now.Add(-3 * time.Minute).Unix()
+	if overdue := watchdog.overdue([]*models.Beacon{beacon}, now); len(overdue) != 1 {
+		t.Fatal("expected a beacon with 3 missed check-ins to be overdue")
+	}
+	if overdue := watchdog.overdue([]*models.Beacon{beacon}, now); len(overdue) != 0 {
+		t.Fatal("expected an overdue beacon to only be reported once")
+	}
+
+	// Once the beacon checks in again it's reported the next time it's overdue
+	beacon.NextCheckin = now.Add(time.Minute).Unix()
+	watchdog.overdue([]*models.Beacon{beacon}, now)
+	beacon.NextCheckin = now.Add(-3 * time.Minute).Unix()
+	if overdue := watchdog.overdue([]*models.Beacon{beacon}, now); len(overdue) != 1 {
+		t.Fatal("expected a beacon to be reported again after checking in")
+	}
+
+	watchdog.overdueCheckins = -1
+	watchdog.alerted = map[string]bool{}
+	if overdue := watchdog.overdue([]*models.Beacon{beacon}, now); len(overdue) != 0 {
+		t.Fatal("expected no overdue beacons when alerts are disabled")
+	}
+}
@@ -0,0 +1,181 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+var (
+	// BuildQueue - Queue of builds waiting for, or assigned to, external builders
+	BuildQueue = &externalBuildQueue{
+		builds: []*ExternalBuild{},
+		mutex:  &sync.Mutex{},
+	}
+)
+
+// ExternalBuild - A build request for an external builder
+type ExternalBuild struct {
+	Config      *clientpb.ExternalImplantConfig
+	ConfigHash  string
+	BuilderName string
+	Priority    int32
+	Queued      time.Time
+
+	dispatched bool
+}
+
+type externalBuildQueue struct {
+	builds []*ExternalBuild
+	mutex  *sync.Mutex
+}
+
+// Enqueue - Queue a build for a builder, if an identical build (same config hash) is
+// already queued or in progress for that builder it is returned instead and create is
+// never called. Otherwise create is called to generate the implant config.
+func (q *externalBuildQueue) Enqueue(builderName string, configHash string, priority int32, create func() (*clientpb.ExternalImplantConfig, error)) (*ExternalBuild, bool, error) {
+	q.mutex.Lock()
+	if configHash != "" {
+		for _, build := range q.builds {
+			if build.BuilderName == builderName && build.ConfigHash == configHash {
+				q.mutex.Unlock()
+				return build, true, nil
+			}
+		}
+	}
+	config, err := create()
+	if err != nil {
+		q.mutex.Unlock()
+		return nil, false, err
+	}
+	build := &ExternalBuild{
+		Config:      config,
+		ConfigHash:  configHash,
+		BuilderName: builderName,
+		Priority:    priority,
+		Queued:      time.Now(),
+	}
+	q.builds = append(q.builds, build)
+	q.mutex.Unlock()
+
+	q.Dispatch()
+	return build, false, nil
+}
+
+// Dispatch - Send queued builds to any builders that have capacity, higher priority
+// builds are dispatched first and builds of equal priority are dispatched in order.
+func (q *externalBuildQueue) Dispatch() {
+	q.mutex.Lock()
+	active := map[string]uint32{}
+	pending := []*ExternalBuild{}
+	for _, build := range q.builds {
+		if build.dispatched {
+			active[build.BuilderName]++
+		} else {
+			pending = append(pending, build)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].Priority != pending[j].Priority {
+			return pending[j].Priority < pending[i].Priority
+		}
+		return pending[i].Queued.Before(pending[j].Queued)
+	})
+	dispatch := []*ExternalBuild{}
+	for _, build := range pending {
+		builder := GetBuilder(build.BuilderName)
+		if builder == nil {
+			continue // Builder disconnected, see FailBuilder()
+		}
+		if 0 < builder.MaxConcurrentBuilds && builder.MaxConcurrentBuilds <= active[build.BuilderName] {
+			continue
+		}
+		build.dispatched = true
+		active[build.BuilderName]++
+		dispatch = append(dispatch, build)
+	}
+	q.mutex.Unlock()
+
+	for _, build := range dispatch {
+		EventBroker.Publish(Event{
+			EventType: consts.ExternalBuildEvent,
+			Data:      []byte(fmt.Sprintf("%s:%s", build.BuilderName, build.Config.Config.ID)),
+		})
+	}
+}
+
+// Done - Remove a completed (or failed) build from the queue and dispatch the next
+func (q *externalBuildQueue) Done(configID string) {
+	q.mutex.Lock()
+	for index, build := range q.builds {
+		if build.Config.Config.ID == configID {
+			q.builds = append(q.builds[:index], q.builds[index+1:]...)
+			break
+		}
+	}
+	q.mutex.Unlock()
+	q.Dispatch()
+}
+
+// FailBuilder - Fail all builds queued for, or assigned to, a builder
+func (q *externalBuildQueue) FailBuilder(builderName string, reason string) {
+	q.mutex.Lock()
+	failed := []*ExternalBuild{}
+	remaining := []*ExternalBuild{}
+	for _, build := range q.builds {
+		if build.BuilderName == builderName {
+			failed = append(failed, build)
+		} else {
+			remaining = append(remaining, build)
+		}
+	}
+	q.builds = remaining
+	q.mutex.Unlock()
+
+	for _, build := range failed {
+		EventBroker.Publish(Event{
+			EventType: consts.ExternalBuildFailedEvent,
+			Data:      []byte(fmt.Sprintf("%s:%s", build.Config.Config.ID, reason)),
+		})
+	}
+}
+
+// Stats - The number of active and queued builds for a builder
+func (q *externalBuildQueue) Stats(builderName string) (uint32, uint32) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	var active, queued uint32
+	for _, build := range q.builds {
+		if build.BuilderName != builderName {
+			continue
+		}
+		if build.dispatched {
+			active++
+		} else {
+			queued++
+		}
+	}
+	return active, queued
+}
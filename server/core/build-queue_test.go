@@ -0,0 +1,79 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestBuildQueuePriority(t *testing.T) {
+	builder := &clientpb.Builder{Name: "test-builder", MaxConcurrentBuilds: 1}
+	if err := AddBuilder(builder); err != nil {
+		t.Fatal(err)
+	}
+	defer RemoveBuilder(builder.Name)
+
+	events := EventBroker.Subscribe()
+	defer EventBroker.Unsubscribe(events)
+	nextDispatch := func() string {
+		for {
+			select {
+			case event := <-events:
+				if event.EventType == consts.ExternalBuildEvent {
+					return string(event.Data)
+				}
+			case <-time.After(time.Second):
+				return ""
+			}
+		}
+	}
+
+	for index, priority := range []int32{0, 1, 5, 1} {
+		configID := fmt.Sprintf("config-%d", index)
+		_, duplicate, err := BuildQueue.Enqueue(builder.Name, configID, priority, func() (*clientpb.ExternalImplantConfig, error) {
+			return &clientpb.ExternalImplantConfig{Config: &clientpb.ImplantConfig{ID: configID}}, nil
+		})
+		if err != nil || duplicate {
+			t.Fatalf("Failed to enqueue build %s (%v)", configID, err)
+		}
+	}
+	if _, duplicate, _ := BuildQueue.Enqueue(builder.Name, "config-1", 0, nil); !duplicate {
+		t.Fatalf("Identical build was not deduplicated")
+	}
+
+	// config-0 is dispatched immediately, the rest are dispatched by priority then in order
+	for _, expected := range []string{"config-0", "config-2", "config-1", "config-3"} {
+		dispatched := nextDispatch()
+		if dispatched != fmt.Sprintf("%s:%s", builder.Name, expected) {
+			t.Fatalf("Expected %s to be dispatched, got '%s'", expected, dispatched)
+		}
+		if active, _ := BuildQueue.Stats(builder.Name); active != 1 {
+			t.Fatalf("Expected 1 active build, got %d", active)
+		}
+		BuildQueue.Done(expected)
+	}
+	if active, queued := BuildQueue.Stats(builder.Name); active != 0 || queued != 0 {
+		t.Fatalf("Expected empty queue, got %d active %d queued", active, queued)
+	}
+}
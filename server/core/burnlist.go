@@ -0,0 +1,140 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// burnedContactAlertInterval - Minimum time between alerts for the same implant and transport
+	burnedContactAlertInterval = time.Minute
+)
+
+var (
+	burnLog = log.NamedLogger("core", "burnlist")
+
+	// BurnList - Implant builds marked as compromised, every listener consults
+	// this list and only serves decoy content to a burned implant
+	BurnList = &burnList{
+		names:      map[string]uuid.UUID{},
+		builds:     map[uuid.UUID]string{},
+		lastAlerts: map[string]time.Time{},
+		mutex:      &sync.RWMutex{},
+	}
+)
+
+type burnList struct {
+	names      map[string]uuid.UUID
+	builds     map[uuid.UUID]string
+	lastAlerts map[string]time.Time
+	mutex      *sync.RWMutex
+	loadOnce   sync.Once
+}
+
+// load - Populate the list from the database the first time it is used
+func (b *burnList) load() {
+	b.loadOnce.Do(func() {
+		builds, err := db.CompromisedImplantBuilds()
+		if err != nil {
+			burnLog.Errorf("Failed to load burned implant builds: %s", err)
+			return
+		}
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		for _, build := range builds {
+			b.names[build.Name] = build.ID
+			b.builds[build.ID] = build.Name
+		}
+		burnLog.Infof("Loaded %d burned implant build(s)", len(builds))
+	})
+}
+
+// Add - Add an implant build to the burn list
+func (b *burnList) Add(build *models.ImplantBuild) {
+	b.load()
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.names[build.Name] = build.ID
+	b.builds[build.ID] = build.Name
+}
+
+// Remove - Remove an implant build from the burn list
+func (b *burnList) Remove(build *models.ImplantBuild) {
+	b.load()
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.names, build.Name)
+	delete(b.builds, build.ID)
+}
+
+// IsBurned - Check if an implant build name is on the burn list
+func (b *burnList) IsBurned(name string) bool {
+	b.load()
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	_, ok := b.names[name]
+	return ok
+}
+
+// BuildName - Returns the name of a burned implant build, and false if the build is not burned
+func (b *burnList) BuildName(buildID uuid.UUID) (string, bool) {
+	if buildID == uuid.Nil {
+		return "", false
+	}
+	b.load()
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	name, ok := b.builds[buildID]
+	return name, ok
+}
+
+// shouldAlert - Rate limit alerts so a burned implant that is still calling
+// home does not flood every operator's console
+func (b *burnList) shouldAlert(name string, transport string) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	key := name + "/" + transport
+	if last, ok := b.lastAlerts[key]; ok && time.Since(last) < burnedContactAlertInterval {
+		return false
+	}
+	b.lastAlerts[key] = time.Now()
+	return true
+}
+
+// BurnedContact - Called by a listener when a burned implant makes contact, the
+// contact is always logged and operators are alerted
+func BurnedContact(name string, transport string, remoteAddress string) {
+	burnLog.Warnf("Burned implant %s made contact via %s from %s", name, transport, remoteAddress)
+	if !BurnList.shouldAlert(name, transport) {
+		return
+	}
+	EventBroker.Publish(Event{
+		EventType: consts.BurnedContactEvent,
+		Data:      []byte(fmt.Sprintf("%s contacted a %s listener from %s", name, transport, remoteAddress)),
+	})
+}
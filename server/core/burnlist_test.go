@@ -0,0 +1,55 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+func TestBurnList(t *testing.T) {
+	build := &models.ImplantBuild{ID: uuid.Must(uuid.NewV4()), Name: "BURNT_TOAST"}
+	BurnList.Add(build)
+	if !BurnList.IsBurned(build.Name) {
+		t.Fatalf("expected %s to be burned", build.Name)
+	}
+	if name, burned := BurnList.BuildName(build.ID); !burned || name != build.Name {
+		t.Fatalf("expected build id lookup to return %s, got %q", build.Name, name)
+	}
+	if _, burned := BurnList.BuildName(uuid.Nil); burned {
+		t.Fatal("nil build id should never be burned")
+	}
+
+	if !BurnList.shouldAlert(build.Name, "mtls") {
+		t.Fatal("expected first contact to alert")
+	}
+	if BurnList.shouldAlert(build.Name, "mtls") {
+		t.Fatal("expected repeat contact to be rate limited")
+	}
+	if !BurnList.shouldAlert(build.Name, "dns") {
+		t.Fatal("expected contact on another transport to alert")
+	}
+
+	BurnList.Remove(build)
+	if BurnList.IsBurned(build.Name) {
+		t.Fatalf("expected %s to be removed from the burn list", build.Name)
+	}
+}
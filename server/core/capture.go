@@ -0,0 +1,276 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CaptureSend - Envelopes sent from the server to an implant
+	CaptureSend = "send"
+	// CaptureRecv - Envelopes received by the server from an implant
+	CaptureRecv = "recv"
+
+	defaultCaptureEntries = 1000
+	maxCaptureEntries     = 100000
+
+	// maxPendingRequests - Requests that never get a response are dropped
+	// from the latency tracking once there are too many of them
+	maxPendingRequests = 10000
+)
+
+var (
+	captureLog = log.NamedLogger("core", "capture")
+
+	// Capture - Debug recording of the decrypted envelopes passing through the
+	// c2 transports, disabled unless started by an operator
+	Capture = &envelopeCapture{
+		mutex:   &sync.Mutex{},
+		pending: map[string]time.Time{},
+		beacons: map[string]string{},
+	}
+
+	// ErrCaptureRunning - Only one capture can be running at a time
+	ErrCaptureRunning = errors.New("envelope capture already running")
+)
+
+type envelopeCapture struct {
+	enabled atomic.Bool
+	mutex   *sync.Mutex
+
+	payloads   bool
+	sessionID  string
+	maxEntries int
+	startedAt  time.Time
+	captured   uint64
+
+	entries []*clientpb.CapturedEnvelope
+	next    int
+
+	file     *os.File
+	filePath string
+
+	pending map[string]time.Time // ConnectionID/EnvelopeID -> sent
+	beacons map[string]string    // ConnectionID -> Beacon ID
+}
+
+// Start - Start capturing envelopes, if filePath is not blank every entry is also
+// appended to the file as a line of json
+func (c *envelopeCapture) Start(req *clientpb.CaptureReq, filePath string) (*clientpb.CaptureStatus, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.enabled.Load() {
+		return nil, ErrCaptureRunning
+	}
+	maxEntries := int(req.MaxEntries)
+	if maxEntries == 0 {
+		maxEntries = defaultCaptureEntries
+	}
+	if maxCaptureEntries < maxEntries {
+		maxEntries = maxCaptureEntries
+	}
+
+	c.file = nil
+	c.filePath = ""
+	if filePath != "" {
+		file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, err
+		}
+		c.file = file
+		c.filePath = filePath
+	}
+
+	c.payloads = req.Payloads
+	c.sessionID = req.SessionID
+	c.maxEntries = maxEntries
+	c.startedAt = time.Now()
+	c.captured = 0
+	c.entries = make([]*clientpb.CapturedEnvelope, 0, maxEntries)
+	c.next = 0
+	c.pending = map[string]time.Time{}
+	c.beacons = map[string]string{}
+	c.enabled.Store(true)
+	captureLog.Warnf("Envelope capture started (payloads: %v, session: %q)", c.payloads, c.sessionID)
+	return c.status(), nil
+}
+
+// Stop - Stop capturing, entries that were already captured are kept until the next start
+func (c *envelopeCapture) Stop() *clientpb.CaptureStatus {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.enabled.Load() {
+		captureLog.Infof("Envelope capture stopped (%d envelopes)", c.captured)
+	}
+	c.enabled.Store(false)
+	if c.file != nil {
+		c.file.Close()
+		c.file = nil
+	}
+	c.pending = map[string]time.Time{}
+	c.beacons = map[string]string{}
+	return c.status()
+}
+
+// Status - The current capture settings
+func (c *envelopeCapture) Status() *clientpb.CaptureStatus {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.status()
+}
+
+func (c *envelopeCapture) status() *clientpb.CaptureStatus {
+	status := &clientpb.CaptureStatus{
+		Enabled:    c.enabled.Load(),
+		Payloads:   c.payloads,
+		MaxEntries: uint32(c.maxEntries),
+		SessionID:  c.sessionID,
+		FilePath:   c.filePath,
+		Captured:   c.captured,
+	}
+	if !c.startedAt.IsZero() {
+		status.StartedAt = c.startedAt.Unix()
+	}
+	return status
+}
+
+// Entries - Captured envelopes oldest first, optionally only those of a session (or
+// beacon) and/or the most recent limit entries
+func (c *envelopeCapture) Entries(sessionID string, limit int) []*clientpb.CapturedEnvelope {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	ordered := append([]*clientpb.CapturedEnvelope{}, c.entries[c.next:]...)
+	ordered = append(ordered, c.entries[:c.next]...)
+	entries := []*clientpb.CapturedEnvelope{}
+	for _, entry := range ordered {
+		if sessionID == "" || entry.SessionID == sessionID || entry.BeaconID == sessionID {
+			entries = append(entries, entry)
+		}
+	}
+	if 0 < limit && limit < len(entries) {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries
+}
+
+// Record - Capture an envelope sent to or received from an implant connection,
+// this is a no-op unless a capture is running
+func (c *envelopeCapture) Record(conn *ImplantConnection, direction string, envelope *sliverpb.Envelope) {
+	if !c.enabled.Load() || conn == nil || envelope == nil {
+		return
+	}
+	now := time.Now()
+	entry := &clientpb.CapturedEnvelope{
+		Timestamp:     now.UnixNano(),
+		Direction:     direction,
+		Transport:     conn.Transport,
+		ConnectionID:  conn.ID,
+		RemoteAddress: conn.RemoteAddress,
+		EnvelopeID:    envelope.ID,
+		Type:          envelope.Type,
+		Size:          uint32(proto.Size(envelope)),
+	}
+	if session := Sessions.FromImplantConnection(conn); session != nil {
+		entry.SessionID = session.ID
+	}
+
+	beaconID := ""
+	if entry.SessionID == "" {
+		beaconID = envelopeBeaconID(envelope)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if entry.SessionID == "" {
+		// Beacons only send their ID when they register and check in, the
+		// tasks and results that follow use the same connection
+		if beaconID != "" {
+			if maxPendingRequests <= len(c.beacons) {
+				c.beacons = map[string]string{}
+			}
+			c.beacons[conn.ID] = beaconID
+		}
+		entry.BeaconID = c.beacons[conn.ID]
+	}
+	if !c.enabled.Load() || (c.sessionID != "" && entry.SessionID != c.sessionID && entry.BeaconID != c.sessionID) {
+		return
+	}
+	if c.payloads {
+		entry.Data = append([]byte{}, envelope.Data...)
+	}
+	if envelope.ID != 0 {
+		key := fmt.Sprintf("%s/%d", conn.ID, envelope.ID)
+		if direction == CaptureSend {
+			if maxPendingRequests <= len(c.pending) {
+				c.pending = map[string]time.Time{}
+			}
+			c.pending[key] = now
+		} else if sent, ok := c.pending[key]; ok {
+			entry.Latency = int64(now.Sub(sent))
+			delete(c.pending, key)
+		}
+	}
+
+	if len(c.entries) < c.maxEntries {
+		c.entries = append(c.entries, entry)
+	} else {
+		c.entries[c.next] = entry
+		c.next = (c.next + 1) % c.maxEntries
+	}
+	c.captured++
+
+	if c.file != nil {
+		data, err := protojson.Marshal(entry)
+		if err == nil {
+			_, err = c.file.Write(append(data, '\n'))
+		}
+		if err != nil {
+			captureLog.Errorf("Failed to write envelope capture: %s", err)
+		}
+	}
+}
+
+// envelopeBeaconID - The beacon ID of a registration or check in envelope
+func envelopeBeaconID(envelope *sliverpb.Envelope) string {
+	switch envelope.Type {
+	case sliverpb.MsgBeaconRegister:
+		register := &sliverpb.BeaconRegister{}
+		if proto.Unmarshal(envelope.Data, register) == nil {
+			return register.ID
+		}
+	case sliverpb.MsgBeaconTasks:
+		tasks := &sliverpb.BeaconTasks{}
+		if proto.Unmarshal(envelope.Data, tasks) == nil {
+			return tasks.ID
+		}
+	}
+	return ""
+}
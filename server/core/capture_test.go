@@ -0,0 +1,120 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCaptureRingBuffer(t *testing.T) {
+	conn := NewImplantConnection("mtls", "127.0.0.1:1234")
+	Capture.Record(conn, CaptureSend, &sliverpb.Envelope{Type: 1})
+	if len(Capture.Entries("", 0)) != 0 {
+		t.Fatal("envelopes should not be captured unless a capture is running")
+	}
+
+	_, err := Capture.Start(&clientpb.CaptureReq{MaxEntries: 3}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Capture.Stop()
+	if _, err := Capture.Start(&clientpb.CaptureReq{}, ""); err != ErrCaptureRunning {
+		t.Fatalf("expected %v, got %v", ErrCaptureRunning, err)
+	}
+
+	for index := 1; index <= 5; index++ {
+		Capture.Record(conn, CaptureRecv, &sliverpb.Envelope{Type: uint32(index), Data: []byte("data")})
+	}
+	entries := Capture.Entries("", 0)
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+	for index, entry := range entries {
+		if entry.Type != uint32(index+3) {
+			t.Errorf("expected oldest first, got type %d at %d", entry.Type, index)
+		}
+		if entry.Data != nil {
+			t.Errorf("payloads should not be captured")
+		}
+	}
+	if last := Capture.Entries("", 1); len(last) != 1 || last[0].Type != 5 {
+		t.Errorf("expected only the most recent entry, got %v", last)
+	}
+	if status := Capture.Status(); !status.Enabled || status.Captured != 5 {
+		t.Errorf("unexpected status %v", status)
+	}
+}
+
+func TestCaptureLatency(t *testing.T) {
+	conn := NewImplantConnection("http(s)", "127.0.0.1:1234")
+	_, err := Capture.Start(&clientpb.CaptureReq{Payloads: true}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Capture.Record(conn, CaptureSend, &sliverpb.Envelope{ID: 42, Type: sliverpb.MsgPing})
+	Capture.Record(conn, CaptureRecv, &sliverpb.Envelope{ID: 42, Type: sliverpb.MsgPing, Data: []byte("pong")})
+	status := Capture.Stop()
+	if status.Enabled {
+		t.Fatal("expected capture to be stopped")
+	}
+
+	entries := Capture.Entries("", 0)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Latency != 0 || entries[1].Latency <= 0 {
+		t.Errorf("expected the response to have a latency, got %d and %d", entries[0].Latency, entries[1].Latency)
+	}
+	if string(entries[1].Data) != "pong" {
+		t.Errorf("expected payload to be captured, got %q", entries[1].Data)
+	}
+	if entries[1].Transport != "http(s)" || entries[1].ConnectionID != conn.ID {
+		t.Errorf("unexpected connection details %v", entries[1])
+	}
+}
+
+func TestCaptureBeacon(t *testing.T) {
+	const beaconID = "3f1c2b4a-6d5e-4f70-8a9b-0c1d2e3f4a5b"
+	conn := NewImplantConnection("http(s)", "127.0.0.1:1234")
+	other := NewImplantConnection("http(s)", "127.0.0.1:5678")
+	_, err := Capture.Start(&clientpb.CaptureReq{SessionID: beaconID}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Capture.Stop()
+
+	checkin, _ := proto.Marshal(&sliverpb.BeaconTasks{ID: beaconID})
+	Capture.Record(conn, CaptureRecv, &sliverpb.Envelope{Type: sliverpb.MsgBeaconTasks, Data: checkin})
+	Capture.Record(conn, CaptureSend, &sliverpb.Envelope{Type: sliverpb.MsgBeaconTasks})
+	Capture.Record(other, CaptureSend, &sliverpb.Envelope{Type: sliverpb.MsgBeaconTasks})
+
+	entries := Capture.Entries(beaconID, 0)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	for _, entry := range entries {
+		if entry.BeaconID != beaconID || entry.SessionID != "" {
+			t.Errorf("expected the beacon id to be resolved, got %v", entry)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxIncomingTransfers - Chunked transfers an implant can have in progress at once
+	maxIncomingTransfers = 4
+	// maxIncomingSize - Total size of the envelopes of a connection's chunked transfers
+	// in progress, the data is allocated as chunks arrive rather than up front
+	maxIncomingSize = sliverpb.MaxChunkedSize
+	// maxPendingChunks - Chunks received ahead of the next chunk of the data, an implant
+	// doesn't send more than ChunkWindow chunks that aren't acknowledged
+	maxPendingChunks = 2 * sliverpb.ChunkWindow
+	// transferStallTimeout - Incomplete transfers are dropped if no chunk arrives in time
+	transferStallTimeout = 5 * time.Minute
+)
+
+var (
+	transferID = uint64(0)
+
+	// ErrTransferCanceled - The implant canceled a chunked transfer
+	ErrTransferCanceled = errors.New("implant canceled the transfer")
+	// ErrTransferTooLarge - The envelope is larger than MaxChunkedSize
+	ErrTransferTooLarge = errors.New("message is too large")
+)
+
+// chunkTransfers - A connection's chunked transfers in progress
+type chunkTransfers struct {
+	// outgoing - Transfer ID -> chan *sliverpb.ChunkAck of the envelopes being sent
+	outgoing *sync.Map
+
+	mutex    *sync.Mutex
+	incoming map[uint64]*incomingTransfer
+}
+
+type incomingTransfer struct {
+	envelope *sliverpb.Envelope // Data is the chunks received in order so far
+	size     uint64
+	count    uint32
+	next     uint32            // Index of the chunk that's appended to the data next
+	pending  map[uint32][]byte // Chunks received ahead of the next chunk
+	stalled  *time.Timer
+}
+
+func newChunkTransfers() *chunkTransfers {
+	return &chunkTransfers{
+		outgoing: &sync.Map{},
+		mutex:    &sync.Mutex{},
+		incoming: map[uint64]*incomingTransfer{},
+	}
+}
+
+// chunkCount - Number of chunks data of the size is split into
+func chunkCount(size uint64) uint32 {
+	return uint32((size + sliverpb.ChunkSize - 1) / sliverpb.ChunkSize)
+}
+
+// chunkBounds - The part of the data of the size that the chunk holds
+func chunkBounds(index uint32, size uint64) (uint64, uint64) {
+	start := uint64(index) * sliverpb.ChunkSize
+	end := start + sliverpb.ChunkSize
+	if size < end {
+		end = size
+	}
+	if size < start {
+		start = size
+	}
+	return start, end
+}
+
+// SendChunked - Send the envelope as chunks, blocks while ChunkWindow chunks are waiting
+// to be acknowledged. The timeout applies to every chunk, not the whole transfer.
+func (c *ImplantConnection) SendChunked(envelope *sliverpb.Envelope, timeout time.Duration) error {
+	size := uint64(len(envelope.Data))
+	if sliverpb.MaxChunkedSize < size {
+		return ErrTransferTooLarge
+	}
+	id := atomic.AddUint64(&transferID, 1)
+	acks := make(chan *sliverpb.ChunkAck, sliverpb.ChunkWindow)
+	c.transfers.outgoing.Store(id, acks)
+	defer c.transfers.outgoing.Delete(id)
+
+	count := chunkCount(size)
+	acknowledged := uint32(0)
+	for index := uint32(0); index < count; index++ {
+		for sliverpb.ChunkWindow <= index-acknowledged {
+			select {
+			case ack := <-acks:
+				if ack.Cancel {
+					return ErrTransferCanceled
+				}
+				if acknowledged < ack.Received {
+					acknowledged = ack.Received
+				}
+			case <-time.After(timeout):
+				return ErrImplantTimeout
+			}
+		}
+		start, end := chunkBounds(index, size)
+		data, err := proto.Marshal(&sliverpb.Chunk{
+			TransferID:   id,
+			Index:        index,
+			Size:         size,
+			EnvelopeID:   envelope.ID,
+			EnvelopeType: envelope.Type,
+			Data:         envelope.Data[start:end],
+		})
+		if err != nil {
+			return err
+		}
+		select {
+		case c.Send <- &sliverpb.Envelope{Type: sliverpb.MsgChunk, Data: data}:
+		case <-time.After(timeout):
+			return ErrImplantTimeout
+		}
+	}
+	return nil
+}
+
+// AckChunks - Deliver the implant's acknowledgement to the transfer it's for
+func (c *ImplantConnection) AckChunks(ack *sliverpb.ChunkAck) {
+	if acks, ok := c.transfers.outgoing.Load(ack.TransferID); ok {
+		select {
+		case acks.(chan *sliverpb.ChunkAck) <- ack:
+		default:
+		}
+	}
+}
+
+// ReceiveChunk - Add a chunk from the implant to its transfer, returns the envelope once
+// every chunk was received and the acknowledgement to send back. Chunks may be received
+// in any order.
+func (c *ImplantConnection) ReceiveChunk(chunk *sliverpb.Chunk) (*sliverpb.Envelope, *sliverpb.ChunkAck) {
+	c.transfers.mutex.Lock()
+	defer c.transfers.mutex.Unlock()
+
+	cancel := &sliverpb.ChunkAck{TransferID: chunk.TransferID, Cancel: true}
+	transfer, ok := c.transfers.incoming[chunk.TransferID]
+	if !ok {
+		tooLarge := maxIncomingSize < chunk.Size || maxIncomingSize-chunk.Size < c.transfers.incomingSize()
+		if tooLarge || maxIncomingTransfers <= len(c.transfers.incoming) {
+			return nil, cancel
+		}
+		transfer = &incomingTransfer{
+			envelope: &sliverpb.Envelope{
+				ID:   chunk.EnvelopeID,
+				Type: chunk.EnvelopeType,
+			},
+			size:    chunk.Size,
+			count:   chunkCount(chunk.Size),
+			pending: map[uint32][]byte{},
+		}
+		c.transfers.incoming[chunk.TransferID] = transfer
+		transfer.stalled = time.AfterFunc(transferStallTimeout, func() {
+			c.transfers.remove(chunk.TransferID, transfer)
+		})
+	}
+
+	// Every chunk has exactly the size of its part of the data, or the transfer is dropped
+	start, end := chunkBounds(chunk.Index, chunk.Size)
+	_, duplicate := transfer.pending[chunk.Index]
+	valid := chunk.Index < transfer.count && transfer.next <= chunk.Index && !duplicate &&
+		transfer.size == chunk.Size && uint64(len(chunk.Data)) == end-start
+	if !valid || maxPendingChunks <= len(transfer.pending) {
+		transfer.stalled.Stop()
+		delete(c.transfers.incoming, chunk.TransferID)
+		return nil, cancel
+	}
+	transfer.stalled.Reset(transferStallTimeout)
+	transfer.pending[chunk.Index] = chunk.Data
+	for data, ok := transfer.pending[transfer.next]; ok; data, ok = transfer.pending[transfer.next] {
+		transfer.envelope.Data = append(transfer.envelope.Data, data...)
+		delete(transfer.pending, transfer.next)
+		transfer.next++
+	}
+
+	received := transfer.next + uint32(len(transfer.pending))
+	ack := &sliverpb.ChunkAck{TransferID: chunk.TransferID, Received: received}
+	if received < transfer.count {
+		return nil, ack
+	}
+	transfer.stalled.Stop()
+	delete(c.transfers.incoming, chunk.TransferID)
+	return transfer.envelope, ack
+}
+
+// incomingSize - Total size of the envelopes of the transfers in progress
+func (t *chunkTransfers) incomingSize() uint64 {
+	size := uint64(0)
+	for _, transfer := range t.incoming {
+		size += transfer.size
+	}
+	return size
+}
+
+// remove - Drop a stalled transfer, unless it was already completed or canceled
+func (t *chunkTransfers) remove(id uint64, transfer *incomingTransfer) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.incoming[id] == transfer {
+		delete(t.incoming, id)
+	}
+}
@@ -0,0 +1,124 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChunkedTransfer(t *testing.T) {
+	sender := NewImplantConnection("test", "sender")
+	receiver := NewImplantConnection("test", "receiver")
+	data := make([]byte, (sliverpb.ChunkWindow+3)*sliverpb.ChunkSize+123)
+	rand.Read(data)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- sender.SendChunked(&sliverpb.Envelope{ID: 7, Type: sliverpb.MsgDownload, Data: data}, 5*time.Second)
+	}()
+
+	// Acknowledge every chunk that was sent before the window filled up in reverse
+	// order, like a receiver that handles envelopes concurrently
+	var envelope *sliverpb.Envelope
+	for envelope == nil {
+		window := []*sliverpb.Chunk{}
+		for done := false; !done; {
+			select {
+			case chunkEnvelope := <-sender.Send:
+				chunk := &sliverpb.Chunk{}
+				proto.Unmarshal(chunkEnvelope.Data, chunk)
+				window = append(window, chunk)
+			case <-time.After(100 * time.Millisecond):
+				done = true
+			}
+		}
+		if len(window) == 0 || sliverpb.ChunkWindow < len(window) {
+			t.Fatalf("unexpected window of %d chunks", len(window))
+		}
+		for i := len(window) - 1; 0 <= i; i-- {
+			var ack *sliverpb.ChunkAck
+			envelope, ack = receiver.ReceiveChunk(window[i])
+			if ack.Cancel {
+				t.Fatalf("unexpected cancel of chunk %d", window[i].Index)
+			}
+			sender.AckChunks(ack)
+		}
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if envelope.ID != 7 || envelope.Type != sliverpb.MsgDownload || !bytes.Equal(envelope.Data, data) {
+		t.Fatal("reassembled envelope doesn't match")
+	}
+}
+
+func TestReceiveInvalidChunk(t *testing.T) {
+	conn := NewImplantConnection("test", "test")
+	size := uint64(2*sliverpb.ChunkSize + 1)
+	invalid := []*sliverpb.Chunk{
+		{TransferID: 1, Index: 0, Size: size, Data: make([]byte, 10)},
+		{TransferID: 2, Index: 3, Size: size, Data: make([]byte, 1)},
+		{TransferID: 3, Index: 0, Size: sliverpb.MaxChunkedSize + 1, Data: make([]byte, sliverpb.ChunkSize)},
+	}
+	for _, chunk := range invalid {
+		envelope, ack := conn.ReceiveChunk(chunk)
+		if envelope != nil || !ack.Cancel {
+			t.Fatalf("expected transfer %d to be canceled", chunk.TransferID)
+		}
+	}
+	if len(conn.transfers.incoming) != 0 {
+		t.Fatal("expected canceled transfers to be removed")
+	}
+
+	conn.ReceiveChunk(&sliverpb.Chunk{TransferID: 4, Index: 2, Size: size, Data: make([]byte, 1)})
+	_, ack := conn.ReceiveChunk(&sliverpb.Chunk{TransferID: 4, Index: 2, Size: size, Data: make([]byte, 1)})
+	if !ack.Cancel {
+		t.Fatal("expected a duplicate chunk to cancel the transfer")
+	}
+
+	// The data isn't allocated up front, but the sizes of the transfers in progress are capped
+	half := uint64(maxIncomingSize/2 + 1)
+	_, ack = conn.ReceiveChunk(&sliverpb.Chunk{TransferID: 5, Index: 0, Size: half, Data: make([]byte, sliverpb.ChunkSize)})
+	if ack.Cancel || uint64(cap(conn.transfers.incoming[5].envelope.Data)) != sliverpb.ChunkSize {
+		t.Fatal("expected only the first chunk of the transfer to be allocated")
+	}
+	_, ack = conn.ReceiveChunk(&sliverpb.Chunk{TransferID: 6, Index: 0, Size: half, Data: make([]byte, sliverpb.ChunkSize)})
+	if !ack.Cancel {
+		t.Fatal("expected a transfer over the total size to be canceled")
+	}
+}
+
+func TestStalledTransfer(t *testing.T) {
+	conn := NewImplantConnection("test", "test")
+	conn.ReceiveChunk(&sliverpb.Chunk{TransferID: 1, Index: 0, Size: 2 * sliverpb.ChunkSize, Data: make([]byte, sliverpb.ChunkSize)})
+	transfer := conn.transfers.incoming[1]
+	if transfer == nil || !transfer.stalled.Stop() {
+		t.Fatal("expected the transfer to have a stall timer")
+	}
+	conn.transfers.remove(1, transfer)
+	if len(conn.transfers.incoming) != 0 {
+		t.Fatal("expected the stalled transfer to be removed")
+	}
+}
@@ -77,6 +77,18 @@ func (cc *clients) ActiveOperators() []string {
 	return operators
 }
 
+// IsActive - Check if an operator has any active clients
+func (cc *clients) IsActive(operator string) bool {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+	for _, client := range cc.active {
+		if client.Operator.Name == operator {
+			return true
+		}
+	}
+	return false
+}
+
 // RemoveClient - Remove a client struct atomically
 func (cc *clients) Remove(clientID int) {
 	cc.mutex.Lock()
@@ -0,0 +1,71 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	// Encoding and decoding with EncodeAll/DecodeAll is safe for concurrent use
+	envelopeEncoder, _ = zstd.NewWriter(nil)
+	envelopeDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(sliverpb.MaxDecompressedSize))
+)
+
+// SetCompression - Set if the implant accepts compressed envelopes, it tells us
+// when it registers or checks in
+func (c *ImplantConnection) SetCompression(enabled bool) {
+	c.compression.Store(enabled)
+}
+
+// CompressEnvelope - Returns a copy of the envelope with zstd compressed data if the
+// implant accepts compressed envelopes and the data is at least CompressionThreshold
+// bytes, otherwise (or if compressing doesn't make it smaller) the envelope itself
+func (c *ImplantConnection) CompressEnvelope(envelope *sliverpb.Envelope) *sliverpb.Envelope {
+	if !c.compression.Load() || envelope.Compressed || len(envelope.Data) < sliverpb.CompressionThreshold {
+		return envelope
+	}
+	data := envelopeEncoder.EncodeAll(envelope.Data, make([]byte, 0, len(envelope.Data)))
+	if len(envelope.Data) <= len(data) {
+		return envelope
+	}
+	return &sliverpb.Envelope{
+		ID:                 envelope.ID,
+		Type:               envelope.Type,
+		Data:               data,
+		UnknownMessageType: envelope.UnknownMessageType,
+		Compressed:         true,
+	}
+}
+
+// DecompressEnvelope - Decompress the data of a compressed envelope in place, every
+// transport calls this on the envelopes it reads before handling them
+func DecompressEnvelope(envelope *sliverpb.Envelope) error {
+	if !envelope.Compressed {
+		return nil
+	}
+	data, err := envelopeDecoder.DecodeAll(envelope.Data, nil)
+	if err != nil {
+		return err
+	}
+	envelope.Data = data
+	envelope.Compressed = false
+	return nil
+}
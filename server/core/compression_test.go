@@ -0,0 +1,60 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestCompressEnvelope(t *testing.T) {
+	conn := NewImplantConnection("test", "test")
+	data := bytes.Repeat([]byte("Microsoft Windows [Version 10.0.19045.3803]\r\n"), 100)
+	envelope := &sliverpb.Envelope{ID: 1, Type: sliverpb.MsgExecuteReq, Data: data}
+	if conn.CompressEnvelope(envelope) != envelope {
+		t.Fatal("expected no compression before the implant accepts it")
+	}
+
+	conn.SetCompression(true)
+	compressed := conn.CompressEnvelope(envelope)
+	if !compressed.Compressed || len(data) <= len(compressed.Data) {
+		t.Fatal("expected the envelope to be compressed")
+	}
+	if envelope.Compressed || !bytes.Equal(envelope.Data, data) {
+		t.Fatal("compress modified the original envelope")
+	}
+	small := &sliverpb.Envelope{Data: []byte("hello")}
+	if conn.CompressEnvelope(small) != small {
+		t.Fatal("expected small envelopes not to be compressed")
+	}
+
+	err := DecompressEnvelope(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compressed.Compressed || compressed.ID != 1 || !bytes.Equal(compressed.Data, data) {
+		t.Fatal("decompressed envelope doesn't match")
+	}
+	err = DecompressEnvelope(&sliverpb.Envelope{Data: data, Compressed: true})
+	if err == nil {
+		t.Fatal("expected invalid data to fail")
+	}
+}
@@ -20,6 +20,7 @@ package core
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -37,6 +38,11 @@ type ImplantConnection struct {
 	RemoteAddress    string
 	LastMessage      time.Time
 	Cleanup          func()
+	Drain            *Drain        // Listener the connection was accepted by, if any
+	ReplayWindow     time.Duration // Key exchange replay window of the listener, zero for the default
+
+	transfers   *chunkTransfers
+	compression atomic.Bool // Set if the implant accepts compressed envelopes
 }
 
 // GetLastMessage - Retrieves the last message time
@@ -66,6 +72,7 @@ func NewImplantConnection(transport string, remoteAddress string) *ImplantConnec
 		Transport:        transport,
 		RemoteAddress:    remoteAddress,
 		Cleanup:          func() {},
+		transfers:        newChunkTransfers(),
 	}
 }
 
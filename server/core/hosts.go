@@ -20,12 +20,15 @@ package core
 
 import (
 	"errors"
+	"time"
 
 	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
@@ -47,6 +50,18 @@ func StartEventAutomation() {
 				if event.Session != nil {
 					hostsSessionCallback(event.Session)
 				}
+			case consts.SessionClosedEvent:
+				if event.Session != nil {
+					Presence.RemoveTarget(event.Session.ID)
+					err := db.DeleteTargetHistory(event.Session.ID)
+					if err != nil {
+						coreLog.Errorf("Failed to delete session history: %s", err)
+					}
+				}
+			case consts.LeftEvent:
+				if event.Client != nil && !Clients.IsActive(event.Client.Operator.Name) {
+					Presence.Leave(event.Client.Operator.Name)
+				}
 			}
 
 		}
@@ -108,3 +123,40 @@ func hostsBeaconCallback(beacon *models.Beacon) {
 		}
 	}
 }
+
+// SaveHostSurvey - Save the host survey an implant sent with its registration. It's called
+// before the session/beacon event is published, so new hosts are created here instead of
+// by the event callbacks.
+func SaveHostSurvey(hostUUID string, register *sliverpb.Register) {
+	if register.Survey == nil || uuid.FromStringOrNil(hostUUID) == uuid.Nil {
+		return // Implant predates host surveys
+	}
+	survey, err := proto.Marshal(register.Survey)
+	if err != nil {
+		coreLog.Error(err)
+		return
+	}
+	dbSession := db.Session()
+	host, err := db.HostByHostUUID(hostUUID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		coreLog.Infof("Survey is from a new host %s", hostUUID)
+		err = dbSession.Create(&models.Host{
+			HostUUID:      uuid.FromStringOrNil(hostUUID),
+			Hostname:      register.Hostname,
+			OSVersion:     register.Os,
+			Locale:        register.Locale,
+			Survey:        survey,
+			SurveyedAt:    time.Now(),
+			IOCs:          []models.IOC{},
+			ExtensionData: []models.ExtensionData{},
+		}).Error
+	} else if err == nil {
+		err = dbSession.Model(host).Updates(models.Host{
+			Survey:     survey,
+			SurveyedAt: time.Now(),
+		}).Error
+	}
+	if err != nil {
+		coreLog.Errorf("Failed to save host survey: %s", err)
+	}
+}
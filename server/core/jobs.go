@@ -20,6 +20,7 @@ package core
 
 import (
 	"sync"
+	"sync/atomic"
 
 	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
@@ -45,18 +46,61 @@ type Job struct {
 	JobCtrl      chan bool
 	PersistentID string
 	ProfileName  string
+
+	// ListenerProfile - Name of the listener profile that started the job (if any)
+	ListenerProfile string
+
+	// Drain - Set for C2 listeners, shared with the implant connections the listener accepts
+	Drain *Drain
+}
+
+// Drain - A draining listener does not accept new implant registrations, existing sessions
+// and beacons keep working so they can finish their tasks before the listener is stopped
+type Drain struct {
+	draining int32
+}
+
+// IsDraining - True if the listener is draining, safe to call on a nil drain
+func (d *Drain) IsDraining() bool {
+	return d != nil && atomic.LoadInt32(&d.draining) == 1
+}
+
+// SetDraining - Start or stop draining the listener
+func (d *Drain) SetDraining(draining bool) {
+	value := int32(0)
+	if draining {
+		value = 1
+	}
+	atomic.StoreInt32(&d.draining, value)
+}
+
+// ActiveSessions - Number of live sessions connected through a listener job
+func (j *Job) ActiveSessions() int {
+	if j.Drain == nil {
+		return 0
+	}
+	count := 0
+	for _, session := range Sessions.All() {
+		if session.Connection != nil && session.Connection.Drain == j.Drain {
+			count++
+		}
+	}
+	return count
 }
 
 // ToProtobuf - Get the protobuf version of the object
 func (j *Job) ToProtobuf() *clientpb.Job {
 	return &clientpb.Job{
-		ID:          uint32(j.ID),
-		Name:        j.Name,
-		Description: j.Description,
-		Protocol:    j.Protocol,
-		Port:        uint32(j.Port),
-		Domains:     j.Domains,
-		ProfileName: j.ProfileName,
+		ID:              uint32(j.ID),
+		Name:            j.Name,
+		Description:     j.Description,
+		Protocol:        j.Protocol,
+		Port:            uint32(j.Port),
+		Domains:         j.Domains,
+		ProfileName:     j.ProfileName,
+		ListenerProfile: j.ListenerProfile,
+		Draining:        j.Drain.IsDraining(),
+		Sessions:        uint32(j.ActiveSessions()),
 	}
 }
 
@@ -107,6 +151,22 @@ func (j *jobs) Get(jobID int) *Job {
 	return nil
 }
 
+// ForConnection - The listener job an implant connection was accepted by, if any
+func (j *jobs) ForConnection(connection *ImplantConnection) *Job {
+	if connection == nil || connection.Drain == nil {
+		return nil
+	}
+	var job *Job
+	j.active.Range(func(key, value interface{}) bool {
+		if value.(*Job).Drain == connection.Drain {
+			job = value.(*Job)
+			return false
+		}
+		return true
+	})
+	return job
+}
+
 // NextJobID - Returns an incremental nonce as an id
 func NextJobID() int {
 	newID := jobID + 1
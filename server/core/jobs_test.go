@@ -0,0 +1,47 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestJobDrain(t *testing.T) {
+	var noDrain *Drain
+	if noDrain.IsDraining() {
+		t.Fatal("connections without a listener should never be draining")
+	}
+
+	drain := &Drain{}
+	job := &Job{ID: NextJobID(), Name: "mtls", Drain: drain}
+	if job.ToProtobuf().Draining {
+		t.Fatal("new listeners should not be draining")
+	}
+	drain.SetDraining(true)
+	if !job.ToProtobuf().Draining {
+		t.Fatal("expected listener to be draining")
+	}
+	if job.ActiveSessions() != 0 {
+		t.Fatalf("expected no sessions, got %d", job.ActiveSessions())
+	}
+	drain.SetDraining(false)
+	if drain.IsDraining() {
+		t.Fatal("expected listener to accept new implants")
+	}
+}
@@ -54,7 +54,8 @@ func (p *Pivot) Start() {
 			coreLog.Debugf("pivot session %s send loop closing (origin id: %d)", p.ID, p.OriginID)
 		}()
 		for envelope := range p.ImplantConn.Send {
-			envelopeData, err := proto.Marshal(envelope)
+			Capture.Record(p.ImplantConn, CaptureSend, envelope)
+			envelopeData, err := proto.Marshal(p.ImplantConn.CompressEnvelope(envelope))
 			if err != nil {
 				coreLog.Errorf("failed to marshal envelope: %v", err)
 				continue
@@ -95,6 +96,7 @@ type PivotGraphEntry struct {
 	PeerID    int64
 	SessionID string
 	Name      string
+	JobID     int // Listener job of a top-level session, zero if unknown
 
 	// PeerID -> Child
 	Children map[int64]*PivotGraphEntry
@@ -111,6 +113,7 @@ func (e *PivotGraphEntry) ToProtobuf() *clientpb.PivotGraphEntry {
 		Session:  Sessions.Get(e.SessionID).ToProtobuf(),
 		Name:     e.Name,
 		Children: children,
+		JobID:    uint32(e.JobID),
 	}
 }
 
@@ -169,12 +172,16 @@ func PivotGraph() []*PivotGraphEntry {
 		if session.Connection.Transport == PivotTransportName {
 			continue
 		}
-		graph = append(graph, &PivotGraphEntry{
+		entry := &PivotGraphEntry{
 			PeerID:    session.PeerID,
 			SessionID: session.ID,
 			Name:      session.Name,
 			Children:  make(map[int64]*PivotGraphEntry),
-		})
+		}
+		if job := Jobs.ForConnection(session.Connection); job != nil {
+			entry.JobID = job.ID
+		}
+		graph = append(graph, entry)
 	}
 	for _, topLevel := range graph {
 		coreLog.Debugf("[graph] top level: %v", topLevel)
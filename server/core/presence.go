@@ -0,0 +1,234 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// presenceTimeout - Operators that haven't used a target for this long are
+	// no longer considered to be interacting with it
+	presenceTimeout = 15 * time.Minute
+)
+
+var (
+	// Presence - Which operators are interacting with which sessions/beacons, and
+	// the soft-locks operators have placed on them
+	Presence = &operatorPresence{
+		mutex:     &sync.Mutex{},
+		operators: map[string]map[string]*clientpb.OperatorPresence{},
+		locks:     map[string]*clientpb.TargetLock{},
+	}
+
+	// ErrNotLocked - The target has no lock to remove
+	ErrNotLocked = errors.New("target is not locked")
+)
+
+// TargetLockedError - The target is locked by another operator
+type TargetLockedError struct {
+	Lock *clientpb.TargetLock
+}
+
+func (e *TargetLockedError) Error() string {
+	msg := fmt.Sprintf("%s is working this target (locked %s)",
+		e.Lock.Operator, time.Unix(e.Lock.LockedAt, 0).Format(time.RFC1123))
+	if e.Lock.Reason != "" {
+		msg += fmt.Sprintf(": %s", e.Lock.Reason)
+	}
+	return msg
+}
+
+type operatorPresence struct {
+	mutex     *sync.Mutex
+	operators map[string]map[string]*clientpb.OperatorPresence // Operator -> TargetID -> Presence
+	locks     map[string]*clientpb.TargetLock                  // TargetID -> Lock
+}
+
+// Touch - Record that an operator is interacting with a target
+func (p *operatorPresence) Touch(operator string, targetID string) {
+	if operator == "" || targetID == "" {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	now := time.Now().Unix()
+	targets, ok := p.operators[operator]
+	if !ok {
+		targets = map[string]*clientpb.OperatorPresence{}
+		p.operators[operator] = targets
+	}
+	if presence, ok := targets[targetID]; ok {
+		presence.LastSeen = now
+		return
+	}
+	targets[targetID] = &clientpb.OperatorPresence{
+		Operator: operator,
+		TargetID: targetID,
+		Since:    now,
+		LastSeen: now,
+	}
+}
+
+// Leave - The operator is no longer interacting with any target
+func (p *operatorPresence) Leave(operator string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.operators, operator)
+}
+
+// Operators - Operators interacting with targets, optionally only a single target.
+// Touch updates the presences, so copies are returned.
+func (p *operatorPresence) Operators(targetID string) []*clientpb.OperatorPresence {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.expire(time.Now())
+	presences := []*clientpb.OperatorPresence{}
+	for _, targets := range p.operators {
+		for _, presence := range targets {
+			if targetID == "" || presence.TargetID == targetID {
+				presences = append(presences, proto.Clone(presence).(*clientpb.OperatorPresence))
+			}
+		}
+	}
+	sort.Slice(presences, func(i, j int) bool {
+		if presences[i].TargetID != presences[j].TargetID {
+			return presences[i].TargetID < presences[j].TargetID
+		}
+		return presences[i].Since < presences[j].Since
+	})
+	return presences
+}
+
+func (p *operatorPresence) expire(now time.Time) {
+	for operator, targets := range p.operators {
+		for targetID, presence := range targets {
+			if presenceTimeout < now.Sub(time.Unix(presence.LastSeen, 0)) {
+				delete(targets, targetID)
+			}
+		}
+		if len(targets) == 0 {
+			delete(p.operators, operator)
+		}
+	}
+}
+
+// Locks - All of the current target locks
+func (p *operatorPresence) Locks() []*clientpb.TargetLock {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	locks := []*clientpb.TargetLock{}
+	for _, lock := range p.locks {
+		locks = append(locks, lock)
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].LockedAt < locks[j].LockedAt
+	})
+	return locks
+}
+
+// Lock - Lock a target for an operator, taking over another operator's lock requires force
+func (p *operatorPresence) Lock(operator string, targetID string, reason string, force bool) (*clientpb.TargetLock, error) {
+	p.mutex.Lock()
+	previous, ok := p.locks[targetID]
+	if ok && previous.Operator != operator && !force {
+		p.mutex.Unlock()
+		return nil, &TargetLockedError{Lock: previous}
+	}
+	lock := &clientpb.TargetLock{
+		TargetID: targetID,
+		Operator: operator,
+		Reason:   reason,
+		LockedAt: time.Now().Unix(),
+	}
+	p.locks[targetID] = lock
+	p.mutex.Unlock()
+
+	if ok && previous.Operator != operator {
+		publishLockEvent(fmt.Sprintf("%s took over the lock on %s from %s", operator, shortID(targetID), previous.Operator))
+	} else {
+		publishLockEvent(fmt.Sprintf("%s locked %s", operator, shortID(targetID)))
+	}
+	return lock, nil
+}
+
+// Unlock - Remove an operator's lock, removing another operator's lock requires force
+func (p *operatorPresence) Unlock(operator string, targetID string, force bool) error {
+	p.mutex.Lock()
+	lock, ok := p.locks[targetID]
+	if !ok {
+		p.mutex.Unlock()
+		return ErrNotLocked
+	}
+	if lock.Operator != operator && !force {
+		p.mutex.Unlock()
+		return &TargetLockedError{Lock: lock}
+	}
+	delete(p.locks, targetID)
+	p.mutex.Unlock()
+
+	if lock.Operator != operator {
+		publishLockEvent(fmt.Sprintf("%s broke %s's lock on %s", operator, lock.Operator, shortID(targetID)))
+	} else {
+		publishLockEvent(fmt.Sprintf("%s unlocked %s", operator, shortID(targetID)))
+	}
+	return nil
+}
+
+// Check - Returns an error if the target is locked by another operator
+func (p *operatorPresence) Check(operator string, targetID string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if lock, ok := p.locks[targetID]; ok && lock.Operator != operator {
+		return &TargetLockedError{Lock: lock}
+	}
+	return nil
+}
+
+// RemoveTarget - Forget about a target that's gone e.g. a closed session
+func (p *operatorPresence) RemoveTarget(targetID string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.locks, targetID)
+	for _, targets := range p.operators {
+		delete(targets, targetID)
+	}
+}
+
+func publishLockEvent(msg string) {
+	EventBroker.Publish(Event{
+		EventType: consts.TargetLockEvent,
+		Data:      []byte(msg),
+	})
+}
+
+func shortID(id string) string {
+	if len(id) < 8 {
+		return id
+	}
+	return id[:8]
+}
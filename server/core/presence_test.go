@@ -0,0 +1,90 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTargetLocks(t *testing.T) {
+	const target = "0b9b2e4e-5a0c-4f1e-9a47-2d7f8c2f1a10"
+	defer Presence.RemoveTarget(target)
+
+	if _, err := Presence.Lock("alice", target, "privesc", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := Presence.Check("alice", target); err != nil {
+		t.Errorf("lock owner should not be refused: %s", err)
+	}
+	var lockedErr *TargetLockedError
+	if err := Presence.Check("bob", target); !errors.As(err, &lockedErr) || lockedErr.Lock.Operator != "alice" {
+		t.Errorf("expected target to be locked by alice, got %v", err)
+	}
+	if _, err := Presence.Lock("bob", target, "", false); err == nil {
+		t.Error("expected lock to require force")
+	}
+	if err := Presence.Unlock("bob", target, false); err == nil {
+		t.Error("expected unlock to require force")
+	}
+
+	lock, err := Presence.Lock("bob", target, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lock.Operator != "bob" || Presence.Check("alice", target) == nil {
+		t.Errorf("expected bob to take over the lock, got %v", lock)
+	}
+	if err := Presence.Unlock("bob", target, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := Presence.Unlock("bob", target, false); err != ErrNotLocked {
+		t.Errorf("expected %v, got %v", ErrNotLocked, err)
+	}
+}
+
+func TestOperatorPresence(t *testing.T) {
+	const target = "5c0a7a0e-3a1f-4b43-8d9c-6a2f1e0b7d21"
+	defer Presence.RemoveTarget(target)
+
+	Presence.Touch("alice", target)
+	Presence.Touch("bob", target)
+	if presences := Presence.Operators(target); len(presences) != 2 {
+		t.Fatalf("expected 2 operators, got %d", len(presences))
+	}
+
+	Presence.Leave("bob")
+	presences := Presence.Operators(target)
+	if len(presences) != 1 || presences[0].Operator != "alice" {
+		t.Fatalf("expected only alice, got %v", presences)
+	}
+
+	presences[0].LastSeen = 0
+	if presences := Presence.Operators(target); len(presences) != 1 || presences[0].LastSeen == 0 {
+		t.Fatalf("expected a copy of the presence, got %v", presences)
+	}
+
+	Presence.mutex.Lock()
+	Presence.operators["alice"][target].LastSeen = time.Now().Add(-2 * presenceTimeout).Unix()
+	Presence.mutex.Unlock()
+	if presences := Presence.Operators(target); len(presences) != 0 {
+		t.Errorf("expected idle presence to expire, got %v", presences)
+	}
+}
@@ -0,0 +1,75 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const redacted = "[REDACTED]"
+
+// RedactRequest - A copy of a request without its secrets (e.g. credentials) for
+// the audit log and anything else that records requests, requests that don't have
+// any secrets are returned as is
+func RedactRequest(req proto.Message) proto.Message {
+	switch req := req.(type) {
+	case *sliverpb.ADEnumReq:
+		if req.Password == "" {
+			return req
+		}
+		copy := proto.Clone(req).(*sliverpb.ADEnumReq)
+		copy.Password = redacted
+		return copy
+	}
+	return req
+}
+
+// RedactTaskRequest - Redact the request of a beacon task (a *sliverpb.Envelope), once
+// the task has been sent to the beacon its secrets don't need to be stored any longer
+func RedactTaskRequest(data []byte) []byte {
+	envelope := &sliverpb.Envelope{}
+	if proto.Unmarshal(data, envelope) != nil {
+		return data
+	}
+	var req proto.Message
+	switch envelope.Type {
+	case sliverpb.MsgADEnumReq:
+		req = &sliverpb.ADEnumReq{}
+	default:
+		return data
+	}
+	if proto.Unmarshal(envelope.Data, req) != nil {
+		return data
+	}
+	redactedReq := RedactRequest(req)
+	if redactedReq == req {
+		return data
+	}
+	var err error
+	envelope.Data, err = proto.Marshal(redactedReq)
+	if err != nil {
+		return data
+	}
+	redactedData, err := proto.Marshal(envelope)
+	if err != nil {
+		return data
+	}
+	return redactedData
+}
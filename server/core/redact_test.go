@@ -0,0 +1,49 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedactTaskRequest(t *testing.T) {
+	req := &sliverpb.ADEnumReq{Query: "users", Username: "alice@corp.local", Password: "Password1"}
+	reqData, _ := proto.Marshal(req)
+	data, _ := proto.Marshal(&sliverpb.Envelope{Type: sliverpb.MsgADEnumReq, Data: reqData})
+
+	envelope := &sliverpb.Envelope{}
+	err := proto.Unmarshal(RedactTaskRequest(data), envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redactedReq := &sliverpb.ADEnumReq{}
+	err = proto.Unmarshal(envelope.Data, redactedReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redactedReq.Password != redacted || redactedReq.Username != req.Username {
+		t.Errorf("Unexpected redacted request %v", redactedReq)
+	}
+	if req.Password != "Password1" {
+		t.Errorf("The original request was modified")
+	}
+}
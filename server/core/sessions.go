@@ -43,7 +43,7 @@ var (
 
 	// ErrUnknownMessageType - Returned if the implant did not understand the message for
 	//                         example when the command is not supported on the platform
-	ErrUnknownMessageType = errors.New("unknown message type")
+	ErrUnknownMessageType = errors.New("unknown message type, the implant doesn't support this command on its platform or is older than the server")
 
 	// ErrImplantTimeout - The implant did not respond prior to timeout deadline
 	ErrImplantTimeout = errors.New("implant timeout")
@@ -74,6 +74,8 @@ type Session struct {
 	PeerID            int64
 	Locale            string
 	FirstContact      int64
+	AddressInfo       *clientpb.AddressInfo
+	ProtocolVersion   int32
 }
 
 // LastCheckin - Get the last time a session message was received
@@ -140,6 +142,7 @@ func (s *Session) ToProtobuf() *clientpb.Session {
 		PeerID:            s.PeerID,
 		Locale:            s.Locale,
 		FirstContact:      s.FirstContact,
+		AddressInfo:       s.AddressInfo,
 	}
 }
 
@@ -159,14 +162,22 @@ func (s *Session) Request(msgType uint32, timeout time.Duration, data []byte) ([
 	if timeout == 0 {
 		timeout = 60 * time.Second
 	}
-	select {
-	case s.Connection.Send <- &sliverpb.Envelope{
+	envelope := &sliverpb.Envelope{
 		ID:   reqID,
 		Type: msgType,
 		Data: data,
-	}:
-	case <-time.After(timeout):
-		return nil, ErrImplantTimeout
+	}
+	if sliverpb.ChunkSize < len(data) && sliverpb.ChunkedProtocolVersion <= s.ProtocolVersion {
+		err := s.Connection.SendChunked(envelope, timeout)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		select {
+		case s.Connection.Send <- envelope:
+		case <-time.After(timeout):
+			return nil, ErrImplantTimeout
+		}
 	}
 
 	var respEnvelope *sliverpb.Envelope
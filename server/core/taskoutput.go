@@ -0,0 +1,105 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// taskStreamLinger - How long a stream accepts output after the task's response,
+	// output is handled concurrently with the response so some of it can arrive later
+	taskStreamLinger = time.Minute
+)
+
+var (
+	// TaskStreams - Session tasks whose output is streamed to the client
+	TaskStreams = taskStreams{
+		streams: map[string]*taskStream{},
+		mutex:   &sync.RWMutex{},
+	}
+)
+
+type taskStreams struct {
+	streams map[string]*taskStream
+	mutex   *sync.RWMutex
+}
+
+type taskStream struct {
+	session  *Session
+	received uint64
+	total    uint64 // Known once the Done output arrived
+}
+
+// Add - Accept TaskOutput for the stream from the session, returns false if
+// the stream ID is already in use
+func (t *taskStreams) Add(streamID string, session *Session) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, ok := t.streams[streamID]; ok {
+		return false
+	}
+	t.streams[streamID] = &taskStream{session: session}
+	return true
+}
+
+// Finish - The task's response arrived, the stream is removed once all of its
+// output has arrived, or after taskStreamLinger if some of it never does
+func (t *taskStreams) Finish(streamID string) {
+	t.mutex.RLock()
+	stream := t.streams[streamID]
+	t.mutex.RUnlock()
+	if stream == nil {
+		return
+	}
+	time.AfterFunc(taskStreamLinger, func() {
+		t.remove(streamID, stream)
+	})
+}
+
+// Received - Count output of the stream from the connection, returns the session
+// the stream belongs to or nil if the output isn't for a stream of the connection.
+// Outputs are numbered from 0 and the last one is Done, so once as many as the Done
+// output's sequence number have arrived the stream is complete and it's removed
+func (t *taskStreams) Received(streamID string, conn *ImplantConnection, sequence uint64, done bool) *Session {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	stream := t.streams[streamID]
+	if stream == nil || stream.session.Connection != conn {
+		return nil
+	}
+	stream.received++
+	if done {
+		stream.total = sequence + 1
+	}
+	if 0 < stream.total && stream.total <= stream.received {
+		delete(t.streams, streamID)
+	}
+	return stream.session
+}
+
+// remove - Remove the stream, unless its ID has been reused since
+func (t *taskStreams) remove(streamID string, stream *taskStream) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.streams[streamID] == stream {
+		delete(t.streams, streamID)
+	}
+}
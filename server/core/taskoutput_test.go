@@ -0,0 +1,49 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestTaskStreamsOutOfOrder(t *testing.T) {
+	conn := &ImplantConnection{}
+	session := &Session{Connection: conn}
+	if !TaskStreams.Add("stream-1", session) {
+		t.Fatal("expected the stream to be added")
+	}
+	if TaskStreams.Add("stream-1", session) {
+		t.Fatal("expected a stream ID in use to be refused")
+	}
+	if TaskStreams.Received("stream-1", &ImplantConnection{}, 0, false) != nil {
+		t.Fatal("expected output from another connection to be refused")
+	}
+
+	// The response arrived, and Done was handled before the output before it
+	TaskStreams.Finish("stream-1")
+	if TaskStreams.Received("stream-1", conn, 1, true) != session {
+		t.Fatal("expected the Done output to be accepted")
+	}
+	if TaskStreams.Received("stream-1", conn, 0, false) != session {
+		t.Fatal("expected output that arrived after Done to be accepted")
+	}
+	if TaskStreams.Received("stream-1", conn, 0, false) != nil {
+		t.Fatal("expected the stream to be removed once all of its output arrived")
+	}
+}
@@ -30,23 +30,44 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"io"
 	"sync"
+	"time"
 
 	"filippo.io/age"
+	"github.com/bishopfox/sliver/implant/sliver/cryptography/mlkem"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/cryptography/minisign"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/util/encoders"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/chacha20poly1305"
 )
 
 const (
 	serverAgeKeyPairKey      = "server.age"
+	serverMLKEMKey           = "server.mlkem"
+	serverSPAKey             = "server.spa"
 	serverMinisignPrivateKey = "server.minisign"
-
-	sha256Size = 32 // size in bytes of a sha256 hash
+	artifactMinisignKey      = "server.artifact.minisign"
+	armoryMinisignKey        = "server.armory.minisign"
+
+	sha256Size    = 32 // size in bytes of a sha256 hash
+	timestampSize = 8  // size in bytes of a key exchange timestamp
+
+	// DefaultReplayWindow - Key exchanges timestamped further than this from the
+	// server's clock are rejected, unless the listener is configured otherwise
+	DefaultReplayWindow = 10 * time.Minute
+
+	// ConfigKeySaltSize - Size in bytes of the salt of an encrypted implant config, the
+	// argon2id parameters must match implant/sliver/runtimeconfig
+	ConfigKeySaltSize = 16
+	configKeyTime     = 3
+	configKeyMemory   = 64 * 1024 // KiB
+	configKeyThreads  = 4
 )
 
 var (
@@ -59,6 +80,12 @@ var (
 	// ErrDecryptFailed
 	ErrDecryptFailed = errors.New("decryption failed")
 
+	// ErrUnknownKeyExchange - The implant config has an unsupported key exchange
+	ErrUnknownKeyExchange = errors.New("unknown key exchange")
+
+	// ErrReplayWindow - The key exchange timestamp is outside of the replay window
+	ErrReplayWindow = errors.New("key exchange outside of replay window")
+
 	// This will be prepended to any age encrypted message, however
 	// since we already know what it is, and who the recipient is,
 	// and we can ensure there will only ever be a single recipient,
@@ -195,20 +222,142 @@ func AgeKeyExFromImplant(serverPrivateKey string, implantPrivateKey string, ciph
 	return plaintext[sha256Size:], nil
 }
 
+// KeyExFromImplant - Decrypt the session key exchange from an implant using the key
+// exchange of its config, the data must not include the public key digest. Hybrid
+// key exchanges also return the KEM shared key which is combined using SessionKey.
+// Key exchanges of implants with a key exchange algorithm in their config are
+// timestamped, and are rejected if they're further than the replay window from
+// the server's clock (a window <= 0 disables this check)
+func KeyExFromImplant(keyExchange string, serverPrivateKey string, implantPrivateKey string, data []byte, replayWindow time.Duration) ([]byte, []byte, error) {
+	switch keyExchange {
+
+	case "":
+		plaintext, err := AgeKeyExFromImplant(serverPrivateKey, implantPrivateKey, data)
+		return plaintext, nil, err
+
+	case sliverpb.KeyExchangeX25519:
+		plaintext, err := timestampedKeyExFromImplant(serverPrivateKey, implantPrivateKey, data, replayWindow)
+		return plaintext, nil, err
+
+	case sliverpb.KeyExchangeX25519MLKEM768:
+		// The algorithm ID must match the implant's config, so a hybrid implant's
+		// key exchange can't be downgraded to X25519 only
+		if len(data) <= 1+mlkem.CiphertextSize || data[0] != sliverpb.KeyExchangeX25519MLKEM768ID {
+			return nil, nil, ErrDecryptFailed
+		}
+		plaintext, err := timestampedKeyExFromImplant(serverPrivateKey, implantPrivateKey, data[1+mlkem.CiphertextSize:], replayWindow)
+		if err != nil {
+			return nil, nil, err
+		}
+		kemServerKey, err := MLKEMServerKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		kemSharedKey, err := kemServerKey.Decapsulate(data[1 : 1+mlkem.CiphertextSize])
+		if err != nil {
+			return nil, nil, ErrDecryptFailed
+		}
+		return plaintext, kemSharedKey, nil
+	}
+	return nil, nil, ErrUnknownKeyExchange
+}
+
+// KEMFromImplant - Decapsulate the KEM shared key of a transport that only adds the
+// hybrid part to its own key exchange (see the implant's KEMToServer), the algorithm
+// ID must match the implant's config
+func KEMFromImplant(keyExchange string, msg []byte) ([]byte, error) {
+	if keyExchange != sliverpb.KeyExchangeX25519MLKEM768 {
+		return nil, ErrUnknownKeyExchange
+	}
+	if len(msg) != 1+mlkem.CiphertextSize || msg[0] != sliverpb.KeyExchangeX25519MLKEM768ID {
+		return nil, ErrDecryptFailed
+	}
+	kemServerKey, err := MLKEMServerKey()
+	if err != nil {
+		return nil, err
+	}
+	kemSharedKey, err := kemServerKey.Decapsulate(msg[1:])
+	if err != nil {
+		return nil, ErrDecryptFailed
+	}
+	return kemSharedKey, nil
+}
+
+// timestampedKeyExFromImplant - Decrypt a key exchange prefixed with the implant's
+// timestamp, once a timestamped key exchange is outside of the replay window it can
+// no longer be replayed, so there's no need to remember it after that
+func timestampedKeyExFromImplant(serverPrivateKey string, implantPrivateKey string, ciphertext []byte, replayWindow time.Duration) ([]byte, error) {
+	plaintext, err := AgeKeyExFromImplant(serverPrivateKey, implantPrivateKey, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if len(plaintext) < timestampSize {
+		return nil, ErrDecryptFailed
+	}
+	if replayWindow <= 0 {
+		return plaintext[timestampSize:], nil
+	}
+	timestamp := time.Unix(int64(binary.BigEndian.Uint64(plaintext[:timestampSize])), 0)
+	if delta := time.Since(timestamp); delta < -replayWindow || replayWindow < delta {
+		return nil, ErrReplayWindow
+	}
+	if err := db.ExpireKeyExReplay(ciphertext, timestamp.Add(replayWindow)); err != nil {
+		return nil, err
+	}
+	return plaintext[timestampSize:], nil
+}
+
+// SessionKey - Derive a session key from the key exchanged using age and the KEM shared
+// key, without a KEM shared key the exchanged key is used as is
+func SessionKey(key [chacha20poly1305.KeySize]byte, kemSharedKey []byte) [chacha20poly1305.KeySize]byte {
+	if kemSharedKey == nil {
+		return key
+	}
+	digest := sha256.New()
+	digest.Write([]byte(sliverpb.KeyExchangeX25519MLKEM768))
+	digest.Write(key[:])
+	digest.Write(kemSharedKey)
+	var sessionKey [chacha20poly1305.KeySize]byte
+	copy(sessionKey[:], digest.Sum(nil))
+	return sessionKey
+}
+
 // Encrypt - Encrypt using chacha20poly1305
 // https://pkg.go.dev/golang.org/x/crypto/chacha20poly1305
 func Encrypt(key [chacha20poly1305.KeySize]byte, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return encrypt(key, nonce, plaintext)
+}
+
+// DeriveConfigKey - Derive the key of an encrypted implant config from the operator's
+// config key, which may be a passphrase, with argon2id
+func DeriveConfigKey(configKey string, salt []byte) [chacha20poly1305.KeySize]byte {
+	var key [chacha20poly1305.KeySize]byte
+	copy(key[:], argon2.IDKey([]byte(configKey), salt, configKeyTime, configKeyMemory, configKeyThreads, chacha20poly1305.KeySize))
+	return key
+}
+
+// EncryptReproducible - Encrypt with a nonce derived from the seed and plaintext, the
+// same key, seed and plaintext always encrypt to the same ciphertext (reproducible builds)
+func EncryptReproducible(key [chacha20poly1305.KeySize]byte, seed string, plaintext []byte) ([]byte, error) {
+	mac := hmac.New(sha256.New, []byte(seed))
+	mac.Write(plaintext)
+	return encrypt(key, mac.Sum(nil)[:chacha20poly1305.NonceSize], plaintext)
+}
+
+func encrypt(key [chacha20poly1305.KeySize]byte, nonce []byte, plaintext []byte) ([]byte, error) {
 	aead, err := chacha20poly1305.New(key[:])
 	if err != nil {
 		return nil, err
 	}
 	compressed, _ := encoders.GzipBuf(plaintext)
 	plaintext = bytes.NewBuffer(compressed).Bytes()
-	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
-	if _, err := rand.Read(nonce); err != nil {
-		return nil, err
-	}
-	return aead.Seal(nonce, nonce, plaintext, nil), nil
+	ciphertext := make([]byte, 0, len(nonce)+len(plaintext)+aead.Overhead())
+	ciphertext = append(ciphertext, nonce...)
+	return aead.Seal(ciphertext, nonce, plaintext, nil), nil
 }
 
 // Decrypt - Decrypt using chacha20poly1305
@@ -317,6 +466,83 @@ func generateServerKeyPair() (*AgeKeyPair, error) {
 	return keyPair, err
 }
 
+var (
+	mlkemServerKey     *mlkem.DecapsulationKey
+	mlkemServerKeyErr  error
+	mlkemServerKeyOnce sync.Once
+)
+
+// MLKEMServerKey - Get the server's ML-KEM-768 decapsulation key, the key is
+// loaded (or generated) once and then cached for the life of the process
+func MLKEMServerKey() (*mlkem.DecapsulationKey, error) {
+	mlkemServerKeyOnce.Do(func() {
+		mlkemServerKey, mlkemServerKeyErr = loadMLKEMServerKey()
+	})
+	return mlkemServerKey, mlkemServerKeyErr
+}
+
+// MLKEMServerPublicKey - Get the server's base64 encoded ML-KEM-768 encapsulation key
+func MLKEMServerPublicKey() (string, error) {
+	key, err := MLKEMServerKey()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(key.EncapsulationKey()), nil
+}
+
+func loadMLKEMServerKey() (*mlkem.DecapsulationKey, error) {
+	data, err := db.GetKeyValue(serverMLKEMKey)
+	if err == db.ErrRecordNotFound {
+		return generateMLKEMServerKey()
+	}
+	if err != nil {
+		return nil, err
+	}
+	seed, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	return mlkem.NewDecapsulationKey(seed)
+}
+
+func generateMLKEMServerKey() (*mlkem.DecapsulationKey, error) {
+	key, err := mlkem.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	err = db.SetKeyValue(serverMLKEMKey, base64.RawStdEncoding.EncodeToString(key.Bytes()))
+	return key, err
+}
+
+var (
+	spaServerKey     []byte
+	spaServerKeyErr  error
+	spaServerKeyOnce sync.Once
+)
+
+// SPAServerKey - Get the server's single packet authorization key, implants built with
+// SPA enabled authenticate their knocks with it. The key is loaded (or generated) once
+// and then cached for the life of the process.
+func SPAServerKey() ([]byte, error) {
+	spaServerKeyOnce.Do(func() {
+		spaServerKey, spaServerKeyErr = loadSPAServerKey()
+	})
+	return spaServerKey, spaServerKeyErr
+}
+
+func loadSPAServerKey() ([]byte, error) {
+	data, err := db.GetKeyValue(serverSPAKey)
+	if err == db.ErrRecordNotFound {
+		key := RandomSymmetricKey()
+		err = db.SetKeyValue(serverSPAKey, base64.RawStdEncoding.EncodeToString(key[:]))
+		return key[:], err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return base64.RawStdEncoding.DecodeString(data)
+}
+
 // minisignPrivateKey - This is here so we can marshal to/from JSON
 type minisignPrivateKey struct {
 	ID         uint64 `json:"id"`
@@ -341,9 +567,54 @@ func MinisignServerSign(message []byte) string {
 
 // MinisignServerPrivateKey - Get the server's minisign key pair
 func MinisignServerPrivateKey() *minisign.PrivateKey {
-	data, err := db.GetKeyValue(serverMinisignPrivateKey)
+	return getMinisignPrivateKey(serverMinisignPrivateKey)
+}
+
+// ArtifactSigningPublicKey - Get the public key used to verify artifact provenance records,
+// this key is separate from the server's implant minisign key
+func ArtifactSigningPublicKey() string {
+	publicKey := getMinisignPrivateKey(artifactMinisignKey).Public().(minisign.PublicKey)
+	publicKeyText, err := publicKey.MarshalText()
+	if err != nil {
+		panic(err)
+	}
+	return string(publicKeyText)
+}
+
+// ArtifactSign - Sign an artifact provenance record
+func ArtifactSign(message []byte) string {
+	privateKey := getMinisignPrivateKey(artifactMinisignKey)
+	return string(minisign.Sign(*privateKey, message))
+}
+
+// ArtifactVerify - Verify the signature of an artifact provenance record
+func ArtifactVerify(message []byte, signature string) bool {
+	publicKey := getMinisignPrivateKey(artifactMinisignKey).Public().(minisign.PublicKey)
+	return minisign.Verify(publicKey, message, []byte(signature))
+}
+
+// ArmorySigningPublicKey - Get the public key operators configure to trust the
+// server's private armory, separate from the other minisign keys
+func ArmorySigningPublicKey() string {
+	publicKey := getMinisignPrivateKey(armoryMinisignKey).Public().(minisign.PublicKey)
+	publicKeyText, err := publicKey.MarshalText()
+	if err != nil {
+		panic(err)
+	}
+	return string(publicKeyText)
+}
+
+// ArmorySign - Sign a private armory index or package, the trusted comment is covered
+// by the signature
+func ArmorySign(message []byte, trustedComment string) string {
+	privateKey := getMinisignPrivateKey(armoryMinisignKey)
+	return string(minisign.SignWithComments(*privateKey, message, trustedComment, ""))
+}
+
+func getMinisignPrivateKey(kvKey string) *minisign.PrivateKey {
+	data, err := db.GetKeyValue(kvKey)
 	if err == db.ErrRecordNotFound {
-		privateKey, err := generateServerMinisignPrivateKey()
+		privateKey, err := generateMinisignPrivateKey(kvKey)
 		if err != nil {
 			panic(err)
 		}
@@ -362,7 +633,7 @@ func MinisignServerPrivateKey() *minisign.PrivateKey {
 	}
 }
 
-func generateServerMinisignPrivateKey() (*minisign.PrivateKey, error) {
+func generateMinisignPrivateKey(kvKey string) (*minisign.PrivateKey, error) {
 	_, privateKey, err := minisign.GenerateKey(rand.Reader)
 	if err != nil {
 		return nil, err
@@ -371,7 +642,7 @@ func generateServerMinisignPrivateKey() (*minisign.PrivateKey, error) {
 		ID:         privateKey.ID(),
 		PrivateKey: privateKey.Bytes(),
 	})
-	err = db.SetKeyValue(serverMinisignPrivateKey, string(data))
+	err = db.SetKeyValue(kvKey, string(data))
 	if err != nil {
 		return nil, err
 	}
@@ -25,9 +25,12 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	implantCrypto "github.com/bishopfox/sliver/implant/sliver/cryptography"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/cryptography/minisign"
+	"golang.org/x/crypto/chacha20poly1305"
 )
 
 var (
@@ -184,6 +187,171 @@ func TestAgeKeyExReplay(t *testing.T) {
 	}
 }
 
+func TestHybridKeyEx(t *testing.T) {
+	kemPublicKey, err := MLKEMServerPublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	implantCrypto.SetKeyExchange(sliverpb.KeyExchangeX25519MLKEM768, kemPublicKey)
+	defer implantCrypto.SetKeyExchange("", "")
+
+	sessionKey := RandomSymmetricKey()
+	msg, implantKEMSharedKey, err := implantCrypto.KeyExToServer(sessionKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, kemSharedKey, err := KeyExFromImplant(
+		sliverpb.KeyExchangeX25519MLKEM768,
+		serverAgeKeyPair.Private,
+		implantPeerAgeKeyPair.Private,
+		msg[32:],
+		DefaultReplayWindow,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sessionKey[:], decrypted) {
+		t.Fatalf("Session key does not match")
+	}
+	if SessionKey(sessionKey, kemSharedKey) != implantCrypto.SessionKey(sessionKey, implantKEMSharedKey) {
+		t.Fatalf("Derived session keys do not match")
+	}
+	if SessionKey(sessionKey, kemSharedKey) == sessionKey {
+		t.Fatalf("Derived session key does not include the KEM shared key")
+	}
+
+	// A hybrid implant's key exchange must not be accepted as X25519 only
+	_, _, err = KeyExFromImplant(
+		sliverpb.KeyExchangeX25519,
+		serverAgeKeyPair.Private,
+		implantPeerAgeKeyPair.Private,
+		msg[32:],
+		DefaultReplayWindow,
+	)
+	if err == nil {
+		t.Fatal("Expected downgraded key exchange to fail")
+	}
+
+	// A tampered KEM ciphertext results in a different shared key
+	msg, implantKEMSharedKey, err = implantCrypto.KeyExToServer(sessionKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg[33] ^= 0xFF
+	_, kemSharedKey, err = KeyExFromImplant(
+		sliverpb.KeyExchangeX25519MLKEM768,
+		serverAgeKeyPair.Private,
+		implantPeerAgeKeyPair.Private,
+		msg[32:],
+		DefaultReplayWindow,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(kemSharedKey, implantKEMSharedKey) {
+		t.Fatal("Expected tampered KEM ciphertext to change the shared key")
+	}
+}
+
+func TestKEMFromImplant(t *testing.T) {
+	msg, implantKEMSharedKey, err := implantCrypto.KEMToServer()
+	if err != nil || msg != nil || implantKEMSharedKey != nil {
+		t.Fatalf("Expected no KEM message without a hybrid key exchange")
+	}
+
+	kemPublicKey, err := MLKEMServerPublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	implantCrypto.SetKeyExchange(sliverpb.KeyExchangeX25519MLKEM768, kemPublicKey)
+	defer implantCrypto.SetKeyExchange("", "")
+
+	msg, implantKEMSharedKey, err = implantCrypto.KEMToServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kemSharedKey, err := KEMFromImplant(sliverpb.KeyExchangeX25519MLKEM768, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(kemSharedKey, implantKEMSharedKey) {
+		t.Fatalf("KEM shared keys do not match")
+	}
+
+	_, err = KEMFromImplant(sliverpb.KeyExchangeX25519, msg)
+	if err == nil {
+		t.Fatal("Expected KEM with a classical key exchange to fail")
+	}
+	msg[0] ^= 0xFF
+	_, err = KEMFromImplant(sliverpb.KeyExchangeX25519MLKEM768, msg)
+	if err == nil {
+		t.Fatal("Expected KEM with the wrong algorithm ID to fail")
+	}
+}
+
+func TestKeyExReplayWindow(t *testing.T) {
+	implantCrypto.SetKeyExchange(sliverpb.KeyExchangeX25519, "")
+	defer implantCrypto.SetKeyExchange("", "")
+	defer func() { implantCrypto.ServerTimeDelta = 0 }()
+
+	sessionKey := RandomSymmetricKey()
+	msg, _, err := implantCrypto.KeyExToServer(sessionKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, _, err := KeyExFromImplant(
+		sliverpb.KeyExchangeX25519,
+		serverAgeKeyPair.Private,
+		implantPeerAgeKeyPair.Private,
+		msg[32:],
+		DefaultReplayWindow,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sessionKey[:], decrypted) {
+		t.Fatalf("Session key does not match")
+	}
+
+	// Key exchanges timestamped outside of the window are rejected, in either direction
+	for _, delta := range []time.Duration{-time.Hour, time.Hour} {
+		implantCrypto.ServerTimeDelta = delta
+		msg, _, err = implantCrypto.KeyExToServer(sessionKey[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = KeyExFromImplant(
+			sliverpb.KeyExchangeX25519,
+			serverAgeKeyPair.Private,
+			implantPeerAgeKeyPair.Private,
+			msg[32:],
+			DefaultReplayWindow,
+		)
+		if err != ErrReplayWindow {
+			t.Fatalf("Expected key exchange %s from now to be rejected, got %v", delta, err)
+		}
+	}
+
+	// Unless the window is disabled
+	msg, _, err = implantCrypto.KeyExToServer(sessionKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, _, err = KeyExFromImplant(
+		sliverpb.KeyExchangeX25519,
+		serverAgeKeyPair.Private,
+		implantPeerAgeKeyPair.Private,
+		msg[32:],
+		-1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sessionKey[:], decrypted) {
+		t.Fatalf("Session key does not match")
+	}
+}
+
 // TestEncryptDecrypt - Test AEAD functions
 func TestEncryptDecrypt(t *testing.T) {
 	key := RandomSymmetricKey()
@@ -213,6 +381,41 @@ func TestEncryptDecrypt(t *testing.T) {
 	}
 }
 
+// TestEncryptReproducible - The same seed and plaintext always encrypt to the same ciphertext
+func TestEncryptReproducible(t *testing.T) {
+	key := RandomSymmetricKey()
+	cipher1, err := EncryptReproducible(key, "seed", sample1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipher2, err := EncryptReproducible(key, "seed", sample1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(cipher1, cipher2) {
+		t.Fatal("Reproducible ciphertexts do not match")
+	}
+	data1, err := Decrypt(key, cipher1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sample1, data1) {
+		t.Fatalf("Sample does not match decrypted data")
+	}
+
+	cipher3, err := EncryptReproducible(key, "other seed", sample1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipher4, err := EncryptReproducible(key, "seed", sample2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(cipher1[:chacha20poly1305.NonceSize], cipher3[:chacha20poly1305.NonceSize]) || bytes.Equal(cipher1[:chacha20poly1305.NonceSize], cipher4[:chacha20poly1305.NonceSize]) {
+		t.Fatal("Reproducible nonce was reused")
+	}
+}
+
 // TestTamperData - Detect tampered ciphertext
 func TestTamperData(t *testing.T) {
 	key := RandomSymmetricKey()
@@ -323,6 +526,45 @@ func TestServerMinisign(t *testing.T) {
 	}
 }
 
+func TestArtifactSign(t *testing.T) {
+	message := randomData()
+	signature := ArtifactSign(message)
+	if !ArtifactVerify(message, signature) {
+		t.Fatalf("Failed to verify artifact signature")
+	}
+	message[0]++
+	if ArtifactVerify(message, signature) {
+		t.Fatalf("Verified tampered artifact record")
+	}
+	if ArtifactSigningPublicKey() == MinisignServerPublicKey() {
+		t.Fatalf("Artifact signing key should not be the implant update signing key")
+	}
+}
+
+func TestArmorySign(t *testing.T) {
+	message := randomData()
+	var publicKey minisign.PublicKey
+	err := publicKey.UnmarshalText([]byte(ArmorySigningPublicKey()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := ArmorySign(message, "trusted")
+	if !minisign.Verify(publicKey, message, []byte(signature)) {
+		t.Fatalf("Failed to verify armory signature")
+	}
+	var sig minisign.Signature
+	if err := sig.UnmarshalText([]byte(signature)); err != nil || sig.TrustedComment != "trusted" {
+		t.Fatalf("Expected trusted comment in armory signature (%v)", err)
+	}
+	message[0]++
+	if minisign.Verify(publicKey, message, []byte(signature)) {
+		t.Fatalf("Verified tampered armory package")
+	}
+	if ArmorySigningPublicKey() == ArtifactSigningPublicKey() {
+		t.Fatalf("Armory signing key should not be the artifact signing key")
+	}
+}
+
 func TestImplantMinisign(t *testing.T) {
 	message := randomData()
 	privateKey := MinisignServerPrivateKey()
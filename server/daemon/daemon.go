@@ -62,12 +62,19 @@ func Start(host string, port uint16) {
 
 	done := make(chan bool)
 	signals := make(chan os.Signal, 1)
-	signal.Notify(signals, syscall.SIGTERM)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGHUP)
 	go func() {
-		<-signals
-		daemonLog.Infof("Received SIGTERM, exiting ...")
-		ln.Close()
-		done <- true
+		for sig := range signals {
+			if sig == syscall.SIGHUP {
+				daemonLog.Infof("Received SIGHUP, reloading server config ...")
+				configs.ReloadServerConfig()
+				continue
+			}
+			daemonLog.Infof("Received SIGTERM, exiting ...")
+			ln.Close()
+			done <- true
+			return
+		}
 	}()
 	<-done
 }
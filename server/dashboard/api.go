@@ -0,0 +1,225 @@
+package dashboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultExecuteTimeout = 60 * time.Second
+	maxExecuteBodySize    = 1024 * 1024
+)
+
+// executeReq - Console command sent from the dashboard
+type executeReq struct {
+	Path   string   `json:"path"`
+	Args   []string `json:"args"`
+	Output bool     `json:"output"`
+}
+
+var jsonMarshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+func writeProto(w http.ResponseWriter, msg proto.Message) {
+	data, err := jsonMarshaler.Marshal(msg)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// httpStatus - The http status of an rpc refused by the interceptors, or the given status
+func httpStatus(err error, otherwise int) int {
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return otherwise
+}
+
+func writeError(w http.ResponseWriter, err error, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+func (d *Dashboard) operatorHandler(w http.ResponseWriter, req *http.Request) {
+	name, _ := req.Context().Value(operatorKey).(string)
+	writeProto(w, &clientpb.Operator{Name: name, Online: true})
+}
+
+func (d *Dashboard) sessionsHandler(w http.ResponseWriter, req *http.Request) {
+	sessions, err := d.rpc(req).GetSessions(req.Context(), &commonpb.Empty{})
+	if err != nil {
+		writeError(w, err, httpStatus(err, http.StatusInternalServerError))
+		return
+	}
+	writeProto(w, sessions)
+}
+
+func (d *Dashboard) beaconsHandler(w http.ResponseWriter, req *http.Request) {
+	beacons, err := d.rpc(req).GetBeacons(req.Context(), &commonpb.Empty{})
+	if err != nil {
+		writeError(w, err, httpStatus(err, http.StatusInternalServerError))
+		return
+	}
+	writeProto(w, beacons)
+}
+
+func (d *Dashboard) beaconTasksHandler(w http.ResponseWriter, req *http.Request) {
+	beaconID := mux.Vars(req)["id"]
+	tasks, err := d.rpc(req).GetBeaconTasks(req.Context(), &clientpb.Beacon{ID: beaconID})
+	if err != nil {
+		writeError(w, err, httpStatus(err, http.StatusNotFound))
+		return
+	}
+	writeProto(w, tasks)
+}
+
+func (d *Dashboard) jobsHandler(w http.ResponseWriter, req *http.Request) {
+	jobs, err := d.rpc(req).GetJobs(req.Context(), &commonpb.Empty{})
+	if err != nil {
+		writeError(w, err, httpStatus(err, http.StatusInternalServerError))
+		return
+	}
+	writeProto(w, jobs)
+}
+
+func (d *Dashboard) lootHandler(w http.ResponseWriter, req *http.Request) {
+	allLoot, err := d.rpc(req).LootAll(req.Context(), &commonpb.Empty{})
+	if err != nil {
+		writeError(w, err, httpStatus(err, http.StatusInternalServerError))
+		return
+	}
+	writeProto(w, allLoot)
+}
+
+func (d *Dashboard) sessionExecuteHandler(w http.ResponseWriter, req *http.Request) {
+	execReq, err := parseExecuteReq(w, req)
+	if err != nil {
+		writeError(w, err, http.StatusBadRequest)
+		return
+	}
+	execReq.Request = &commonpb.Request{
+		SessionID: mux.Vars(req)["id"],
+		Timeout:   int64(defaultExecuteTimeout),
+	}
+	d.execute(w, req, execReq)
+}
+
+func (d *Dashboard) beaconExecuteHandler(w http.ResponseWriter, req *http.Request) {
+	execReq, err := parseExecuteReq(w, req)
+	if err != nil {
+		writeError(w, err, http.StatusBadRequest)
+		return
+	}
+	execReq.Request = &commonpb.Request{
+		BeaconID: mux.Vars(req)["id"],
+		Async:    true,
+		Timeout:  int64(defaultExecuteTimeout),
+	}
+	d.execute(w, req, execReq)
+}
+
+func (d *Dashboard) execute(w http.ResponseWriter, req *http.Request, execReq *sliverpb.ExecuteReq) {
+	operator, _ := req.Context().Value(operatorKey).(string)
+	dashboardLog.Infof("%s executing '%s' via dashboard", operator, execReq.Path)
+	execute, err := d.rpc(req).Execute(req.Context(), execReq)
+	if err != nil {
+		writeError(w, err, httpStatus(err, http.StatusBadGateway))
+		return
+	}
+	writeProto(w, execute)
+}
+
+func parseExecuteReq(w http.ResponseWriter, req *http.Request) (*sliverpb.ExecuteReq, error) {
+	cmd := &executeReq{}
+	err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxExecuteBodySize)).Decode(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Path == "" {
+		return nil, fmt.Errorf("missing command path")
+	}
+	return &sliverpb.ExecuteReq{
+		Path:   cmd.Path,
+		Args:   cmd.Args,
+		Output: cmd.Output,
+	}, nil
+}
+
+// eventsHandler - Stream server events to the browser (server-sent events)
+func (d *Dashboard) eventsHandler(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	events := core.EventBroker.Subscribe()
+	defer core.EventBroker.Unsubscribe(events)
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case event := <-events:
+			pbEvent := &clientpb.Event{EventType: event.EventType}
+			if event.Job != nil {
+				pbEvent.Job = event.Job.ToProtobuf()
+			}
+			if event.Session != nil {
+				pbEvent.Session = event.Session.ToProtobuf()
+			}
+			if event.Client != nil {
+				pbEvent.Client = event.Client.ToProtobuf()
+			}
+			if event.Err != nil {
+				pbEvent.Err = event.Err.Error()
+			}
+			data, err := jsonMarshaler.Marshal(pbEvent)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package dashboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/rpc"
+	"github.com/bishopfox/sliver/server/transport"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// DefaultPort - Default dashboard listen port
+	DefaultPort = 31338
+
+	certHostname = "dashboard"
+)
+
+type contextKey int
+
+const (
+	operatorKey contextKey = iota
+)
+
+var (
+	dashboardLog = log.NamedLogger("dashboard", "server")
+
+	// ErrUnauthorized - Client certificate does not belong to a current operator
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrCrossOrigin - State changing request that did not come from the dashboard itself
+	ErrCrossOrigin = errors.New("cross-origin request")
+
+	//go:embed static/*
+	staticFs embed.FS
+)
+
+// Dashboard - An instance of the web dashboard
+type Dashboard struct {
+	server    *http.Server
+	listener  net.Listener
+	rpcServer rpcpb.SliverRPCServer
+}
+
+// Start - Start the web dashboard on the given host/port, the dashboard
+// requires operator mTLS certificates, the same as the multiplayer listener.
+func Start(host string, port uint16) (*Dashboard, error) {
+	tlsConfig, err := getDashboardTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	dashboardLog.Infof("Starting web dashboard on %s:%d", host, port)
+	ln, err := tls.Listen("tcp", fmt.Sprintf("%s:%d", host, port), tlsConfig)
+	if err != nil {
+		dashboardLog.Error(err)
+		return nil, err
+	}
+	dashboard := &Dashboard{
+		listener:  ln,
+		rpcServer: &rpc.Server{},
+	}
+	dashboard.server = &http.Server{
+		Handler:      dashboard.router(),
+		ReadTimeout:  time.Minute,
+		WriteTimeout: 0, // Event stream is long lived
+	}
+	go func() {
+		err := dashboard.server.Serve(ln)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			dashboardLog.Warnf("Dashboard exited with error: %s", err)
+		}
+	}()
+	return dashboard, nil
+}
+
+// Stop - Stop the web dashboard
+func (d *Dashboard) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return d.server.Shutdown(ctx)
+}
+
+// rpc - An in process rpc client of the request's operator, so dashboard requests go
+// through the same audit log, opsec policy, target lock, and standby checks as the
+// operator's console
+func (d *Dashboard) rpc(req *http.Request) rpcpb.SliverRPCClient {
+	operator, _ := req.Context().Value(operatorKey).(string)
+	return transport.InProcessClient(d.rpcServer, operator)
+}
+
+func (d *Dashboard) router() *mux.Router {
+	router := mux.NewRouter()
+	router.Use(operatorAuthMiddleware)
+
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(sameOriginMiddleware)
+	api.HandleFunc("/operator", d.operatorHandler).Methods(http.MethodGet)
+	api.HandleFunc("/sessions", d.sessionsHandler).Methods(http.MethodGet)
+	api.HandleFunc("/sessions/{id}/execute", d.sessionExecuteHandler).Methods(http.MethodPost)
+	api.HandleFunc("/beacons", d.beaconsHandler).Methods(http.MethodGet)
+	api.HandleFunc("/beacons/{id}/execute", d.beaconExecuteHandler).Methods(http.MethodPost)
+	api.HandleFunc("/beacons/{id}/tasks", d.beaconTasksHandler).Methods(http.MethodGet)
+	api.HandleFunc("/jobs", d.jobsHandler).Methods(http.MethodGet)
+	api.HandleFunc("/loot", d.lootHandler).Methods(http.MethodGet)
+	api.HandleFunc("/events", d.eventsHandler).Methods(http.MethodGet)
+
+	static, _ := fs.Sub(staticFs, "static")
+	router.PathPrefix("/").Handler(http.FileServer(http.FS(static))).Methods(http.MethodGet)
+	return router
+}
+
+// operatorAuthMiddleware - Verify the client certificate belongs to an operator
+// that has not been kicked, the TLS layer has already verified the chain.
+func operatorAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		name, err := operatorName(req)
+		if err != nil {
+			dashboardLog.Warnf("Rejected dashboard request from %s: %s", req.RemoteAddr, err)
+			http.Error(w, ErrUnauthorized.Error(), http.StatusUnauthorized)
+			return
+		}
+		ctx := context.WithValue(req.Context(), operatorKey, name)
+		next.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
+
+// sameOriginMiddleware - Browsers will attach the operator's client certificate to
+// requests made by any page, so state changing requests must be JSON (which can't be
+// sent cross-origin without a CORS preflight) and must come from the dashboard origin.
+func sameOriginMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			err := checkSameOrigin(req)
+			if err != nil {
+				dashboardLog.Warnf("Rejected dashboard request from %s: %s", req.RemoteAddr, err)
+				http.Error(w, ErrCrossOrigin.Error(), http.StatusForbidden)
+				return
+			}
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func checkSameOrigin(req *http.Request) error {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return fmt.Errorf("invalid content type '%s'", req.Header.Get("Content-Type"))
+	}
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return errors.New("missing origin")
+	}
+	if origin != "https://"+req.Host {
+		return fmt.Errorf("origin '%s' does not match host '%s'", origin, req.Host)
+	}
+	return nil
+}
+
+func operatorName(req *http.Request) (string, error) {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return "", ErrUnauthorized
+	}
+	name := req.TLS.VerifiedChains[0][0].Subject.CommonName
+	if name == "" {
+		return "", ErrUnauthorized
+	}
+	operator := &models.Operator{}
+	err := db.Session().Where(&models.Operator{Name: name}).First(operator).Error
+	if err != nil {
+		return "", ErrUnauthorized
+	}
+	if operator.Standby {
+		return "", ErrUnauthorized // Standby identities can only copy the server's state
+	}
+	return operator.Name, nil
+}
+
+// getDashboardTLSConfig - The CA and server certificate are reloaded for each handshake
+// (the same as the multiplayer listener) so that a rotated operator CA takes effect
+// without restarting the dashboard
+func getDashboardTLSConfig() (*tls.Config, error) {
+	tlsConfig, err := loadDashboardTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		config, err := loadDashboardTLSConfig()
+		if err != nil {
+			dashboardLog.Errorf("Error loading dashboard tls config: %v", err)
+			return nil, err
+		}
+		return config, nil
+	}
+	return tlsConfig, nil
+}
+
+func loadDashboardTLSConfig() (*tls.Config, error) {
+	caCert, _, err := certs.GetCertificateAuthority(certs.OperatorCA)
+	if err != nil {
+		return nil, err
+	}
+	caCertPool := x509.NewCertPool()
+	caCertPool.AddCert(caCert)
+
+	_, _, err = certs.OperatorServerGetCertificate(certHostname)
+	if err == certs.ErrCertDoesNotExist {
+		certs.OperatorServerGenerateCertificate(certHostname)
+	}
+	certPEM, keyPEM, err := certs.OperatorServerGetCertificate(certHostname)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		ClientAuth:            tls.RequireAndVerifyClientCert,
+		ClientCAs:             caCertPool,
+		Certificates:          []tls.Certificate{cert},
+		VerifyPeerCertificate: certs.VerifyOperatorCertificate,
+		MinVersion:            tls.VersionTLS12,
+	}, nil
+}
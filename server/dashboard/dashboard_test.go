@@ -0,0 +1,154 @@
+package dashboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/rpc"
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// auditHook - Collects the audit log entries
+type auditHook struct {
+	mutex   sync.Mutex
+	entries []map[string]string
+}
+
+func (h *auditHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *auditHook) Fire(entry *logrus.Entry) error {
+	msg := map[string]string{}
+	json.Unmarshal([]byte(entry.Message), &msg)
+	h.mutex.Lock()
+	h.entries = append(h.entries, msg)
+	h.mutex.Unlock()
+	return nil
+}
+
+func (h *auditHook) find(method string, user string) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for _, entry := range h.entries {
+		if entry["method"] == method && entry["user"] == user {
+			return true
+		}
+	}
+	return false
+}
+
+func TestExecuteLockedTarget(t *testing.T) {
+	operator := &models.Operator{Name: "dashboard-test"}
+	err := db.Session().Create(operator).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Session().Delete(operator)
+
+	session := core.Sessions.Add(&core.Session{
+		ID:         uuid.Must(uuid.NewV4()).String(),
+		Name:       "TEST",
+		Connection: core.NewImplantConnection("mtls", "192.0.2.1:1234"),
+	})
+	defer core.Sessions.Remove(session.ID)
+	_, err = core.Presence.Lock("someone-else", session.ID, "testing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer core.Presence.Unlock("someone-else", session.ID, false)
+
+	hook := &auditHook{}
+	log.AuditLogger.AddHook(hook)
+
+	body := strings.NewReader(`{"path": "whoami"}`)
+	req := httptest.NewRequest(http.MethodPost, "https://dashboard.test/api/sessions/"+session.ID+"/execute", body)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Origin", "https://dashboard.test")
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: operator.Name}}
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}
+	resp := httptest.NewRecorder()
+	dashboard := &Dashboard{rpcServer: &rpc.Server{}}
+	dashboard.router().ServeHTTP(resp, req)
+
+	if resp.Code != http.StatusConflict {
+		t.Fatalf("Expected execute on a locked session to be refused, got %d %s", resp.Code, resp.Body)
+	}
+	if !hook.find("/rpcpb.SliverRPC/Execute", operator.Name) {
+		t.Fatalf("Expected an audit log entry of the execute by %s, got %v", operator.Name, hook.entries)
+	}
+}
+
+func TestDashboardCARotation(t *testing.T) {
+	certs.SetupCAs()
+	tlsConfig, err := getDashboardTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = certs.RotateOperatorCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, _, err := certs.OperatorClientGenerateCertificate("dashboard-rotation-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verify := func(config *tls.Config) error {
+		_, err := cert.Verify(x509.VerifyOptions{
+			Roots:     config.ClientCAs,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		return err
+	}
+	if verify(tlsConfig) == nil {
+		t.Fatal("Expected the dashboard's initial config to trust only the old operator ca")
+	}
+	handshakeConfig, err := tlsConfig.GetConfigForClient(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(handshakeConfig); err != nil {
+		t.Fatalf("Expected certificates of the rotated operator ca to be trusted, got %s", err)
+	}
+}
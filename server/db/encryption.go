@@ -0,0 +1,87 @@
+package db
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/encryption"
+	"gorm.io/gorm"
+)
+
+const (
+	// atRestEncryptedKey - Set once existing rows have been encrypted
+	atRestEncryptedKey = "at-rest-encrypted"
+)
+
+// encryptExistingRows - Re-write the encrypted columns of rows that were written
+// before at-rest encryption was enabled, values are encrypted by the serializer
+func encryptExistingRows(dbClient *gorm.DB) error {
+	if !encryption.Enabled() {
+		return nil
+	}
+	err := dbClient.Where(&models.KeyValue{Key: atRestEncryptedKey}).First(&models.KeyValue{}).Error
+	if err == nil {
+		return nil
+	}
+	clientLog.Infof("Encrypting existing database values at rest ...")
+	err = encryptRows[models.Credential](dbClient, "Plaintext", "Hash")
+	if err != nil {
+		return err
+	}
+	err = encryptRows[models.Certificate](dbClient, "PrivateKeyPEM")
+	if err != nil {
+		return err
+	}
+	err = encryptRows[models.ImplantConfig](dbClient, "PeerPrivateKey", "MtlsKey", "WGImplantPrivKey")
+	if err != nil {
+		return err
+	}
+	err = encryptRows[models.KeyValue](dbClient, "Value")
+	if err != nil {
+		return err
+	}
+	err = encryptRows[models.FederationLink](dbClient, "Config")
+	if err != nil {
+		return err
+	}
+	err = encryptRows[models.WGKeys](dbClient, "PrivKey")
+	if err != nil {
+		return err
+	}
+	err = encryptRows[models.WGPeer](dbClient, "PrivKey")
+	if err != nil {
+		return err
+	}
+	return dbClient.Create(&models.KeyValue{Key: atRestEncryptedKey, Value: "true"}).Error
+}
+
+func encryptRows[T any](dbClient *gorm.DB, columns ...string) error {
+	rows := []*T{}
+	err := dbClient.Find(&rows).Error
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		err = dbClient.Model(row).Select(columns).Updates(row).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
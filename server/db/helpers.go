@@ -134,6 +134,102 @@ func ImplantBuildByName(name string) (*models.ImplantBuild, error) {
 	return &build, err
 }
 
+// ImplantBuildByMarker - Fetch the implant build an artifact marker was embedded in
+func ImplantBuildByMarker(marker string) (*models.ImplantBuild, error) {
+	if len(marker) < 1 {
+		return nil, ErrRecordNotFound
+	}
+	configs := []*models.ImplantConfig{}
+	err := Session().Where(&models.ImplantConfig{
+		Marker: marker,
+	}).Find(&configs).Error
+	if err != nil {
+		return nil, err
+	}
+	// The config saved before the build has the same marker, but no build
+	for _, config := range configs {
+		if config.ImplantBuildID == uuid.Nil {
+			continue
+		}
+		build := models.ImplantBuild{}
+		err = Session().Where(&models.ImplantBuild{
+			ID: config.ImplantBuildID,
+		}).Preload("ImplantConfig").First(&build).Error
+		return &build, err
+	}
+	return nil, ErrRecordNotFound
+}
+
+// ImplantBuildMarkers - Fetch the markers of all implant builds that have one
+func ImplantBuildMarkers() ([]string, error) {
+	configs := []*models.ImplantConfig{}
+	err := Session().Where("marker != ''").Find(&configs).Error
+	if err != nil {
+		return nil, err
+	}
+	markers := []string{}
+	for _, config := range configs {
+		if config.ImplantBuildID != uuid.Nil {
+			markers = append(markers, config.Marker)
+		}
+	}
+	return markers, nil
+}
+
+// CompromisedImplantBuilds - Fetch all implant builds that have been burned by an operator
+func CompromisedImplantBuilds() ([]*models.ImplantBuild, error) {
+	builds := []*models.ImplantBuild{}
+	err := Session().Where(&models.ImplantBuild{
+		Compromised: true,
+	}).Find(&builds).Error
+	return builds, err
+}
+
+// ArtifactProvenanceBySHA256 - Fetch the most recent provenance record for an artifact hash
+func ArtifactProvenanceBySHA256(sha256 string) (*models.ArtifactProvenance, error) {
+	provenance := models.ArtifactProvenance{}
+	err := Session().Where(&models.ArtifactProvenance{
+		SHA256: sha256,
+	}).Order("created_at desc").First(&provenance).Error
+	if err != nil {
+		return nil, err
+	}
+	return &provenance, nil
+}
+
+// ImplantBuildByConfigHash - Fetch the most recent unburned implant build with a
+// matching external build config hash
+func ImplantBuildByConfigHash(configHash string) (*models.ImplantBuild, error) {
+	if len(configHash) < 1 {
+		return nil, ErrRecordNotFound
+	}
+	configs := []*models.ImplantConfig{}
+	err := Session().Where(&models.ImplantConfig{
+		ConfigHash: configHash,
+	}).Order("created_at desc").Find(&configs).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range configs {
+		if config.ImplantBuildID == uuid.Nil {
+			continue // Build has not completed
+		}
+		build := models.ImplantBuild{}
+		err = Session().Where(&models.ImplantBuild{
+			ID: config.ImplantBuildID,
+		}).Preload("ImplantConfig").First(&build).Error
+		if err != nil || build.Burned {
+			continue
+		}
+		err = loadC2s(&build.ImplantConfig)
+		if err != nil {
+			return nil, err
+		}
+		return &build, nil
+	}
+	return nil, ErrRecordNotFound
+}
+
 // ImplantBuildNames - Fetch a list of all build names
 func ImplantBuildNames() ([]string, error) {
 	builds := []*models.ImplantBuild{}
@@ -221,6 +317,31 @@ func ProfileByName(name string) (*models.ImplantProfile, error) {
 	return dbProfile, err
 }
 
+// ImplantProfileVersions - Fetch the saved versions of a profile, newest first
+func ImplantProfileVersions(name string) ([]*models.ImplantProfileVersion, error) {
+	versions := []*models.ImplantProfileVersion{}
+	err := Session().Where(&models.ImplantProfileVersion{
+		ProfileName: name,
+	}).Order("version desc").Find(&versions).Error
+	return versions, err
+}
+
+// ImplantProfileVersionByName - Fetch a specific version of a profile
+func ImplantProfileVersionByName(name string, version uint32) (*models.ImplantProfileVersion, error) {
+	if len(name) < 1 {
+		return nil, ErrRecordNotFound
+	}
+	profileVersion := models.ImplantProfileVersion{}
+	err := Session().Where(&models.ImplantProfileVersion{
+		ProfileName: name,
+		Version:     version,
+	}).First(&profileVersion).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profileVersion, nil
+}
+
 // ListCanaries - List of all embedded canaries
 func ListCanaries() ([]*models.DNSCanary, error) {
 	canaries := []*models.DNSCanary{}
@@ -228,6 +349,30 @@ func ListCanaries() ([]*models.DNSCanary, error) {
 	return canaries, err
 }
 
+// ListStageFetches - List all requests made to stage listeners
+func ListStageFetches() ([]*models.StageFetch, error) {
+	fetches := []*models.StageFetch{}
+	err := Session().Order("created_at").Find(&fetches).Error
+	return fetches, err
+}
+
+// ListCanaryTokens - List all canary tokens
+func ListCanaryTokens() ([]*models.CanaryToken, error) {
+	tokens := []*models.CanaryToken{}
+	err := Session().Order("created_at").Find(&tokens).Error
+	return tokens, err
+}
+
+// CanaryTokenByToken - Get a canary token by its URL token or access key ID
+func CanaryTokenByToken(token string) (*models.CanaryToken, error) {
+	canary := models.CanaryToken{}
+	err := Session().Where(&models.CanaryToken{Token: token}).First(&canary).Error
+	if err != nil {
+		return nil, err
+	}
+	return &canary, nil
+}
+
 // CanaryByDomain - Check if a canary exists
 func CanaryByDomain(domain string) (*models.DNSCanary, error) {
 	if len(domain) < 1 {
@@ -252,6 +397,26 @@ func WebsiteByName(name string) (*models.Website, error) {
 	return &website, nil
 }
 
+// ListenerProfiles - List all listener profiles
+func ListenerProfiles() ([]*models.ListenerProfile, error) {
+	profiles := []*models.ListenerProfile{}
+	err := Session().Where(&models.ListenerProfile{}).Find(&profiles).Error
+	return profiles, err
+}
+
+// ListenerProfileByName - Get a listener profile by name
+func ListenerProfileByName(name string) (*models.ListenerProfile, error) {
+	if len(name) < 1 {
+		return nil, ErrRecordNotFound
+	}
+	profile := models.ListenerProfile{}
+	err := Session().Where(&models.ListenerProfile{Name: name}).First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
 // WGPeerIPs - Fetch a list of ips for all wireguard peers
 func WGPeerIPs() ([]string, error) {
 	wgPeers := []*models.WGPeer{}
@@ -271,7 +436,7 @@ func ListHosts() ([]*models.Host, error) {
 	hosts := []*models.Host{}
 	err := Session().Where(
 		&models.Host{},
-	).Preload("IOCs").Preload("ExtensionData").Find(&hosts).Error
+	).Preload("IOCs").Preload("ExtensionData").Preload("Tags").Find(&hosts).Error
 	return hosts, err
 }
 
@@ -297,7 +462,21 @@ func HostByHostUUID(id string) (*models.Host, error) {
 	host := models.Host{}
 	err := Session().Where(
 		&models.Host{HostUUID: hostID},
-	).Preload("IOCs").Preload("ExtensionData").First(&host).Error
+	).Preload("IOCs").Preload("ExtensionData").Preload("Tags").First(&host).Error
+	if err != nil {
+		return nil, err
+	}
+	return &host, nil
+}
+
+// HostByHostname - Get a host by one of its names, ignoring case
+func HostByHostname(names ...string) (*models.Host, error) {
+	lowerNames := []string{}
+	for _, name := range names {
+		lowerNames = append(lowerNames, strings.ToLower(name))
+	}
+	host := models.Host{}
+	err := Session().Where("LOWER(hostname) IN ?", lowerNames).Preload("Tags").First(&host).Error
 	if err != nil {
 		return nil, err
 	}
@@ -397,7 +576,7 @@ func RenameBeacon(id string, name string) error {
 	return nil
 }
 
-// PendingBeaconTasksByBeaconID - Select a Beacon by ID, ordered by creation time
+// PendingBeaconTasksByBeaconID - Select a Beacon's pending tasks, in the order they are sent
 func PendingBeaconTasksByBeaconID(id string) ([]*models.BeaconTask, error) {
 	if len(id) < 1 {
 		return nil, ErrRecordNotFound
@@ -412,12 +591,13 @@ func PendingBeaconTasksByBeaconID(id string) ([]*models.BeaconTask, error) {
 			BeaconID: beaconID,
 			State:    models.PENDING,
 		},
-	).Order("created_at").Find(&tasks).Error
+	).Order("position, created_at").Find(&tasks).Error
 	return tasks, err
 }
 
-// UpdateBeaconCheckinByID - Update the beacon's last / next checkin
-func UpdateBeaconCheckinByID(id string, next int64) error {
+// UpdateBeaconCheckinByID - Update the beacon's last / next checkin, interval, and jitter,
+// zero values are not updated (older implants don't report their interval and jitter)
+func UpdateBeaconCheckinByID(id string, next int64, interval int64, jitter int64) error {
 	if len(id) < 1 {
 		return ErrRecordNotFound
 	}
@@ -430,6 +610,8 @@ func UpdateBeaconCheckinByID(id string, next int64) error {
 	}).Updates(models.Beacon{
 		LastCheckin: time.Now(),
 		NextCheckin: time.Now().Unix() + next,
+		Interval:    interval,
+		Jitter:      jitter,
 	}).Error
 	return err
 }
@@ -686,3 +868,251 @@ func CheckKeyExReplay(ciphertext []byte) error {
 		},
 	).Error
 }
+
+// ExpireKeyExReplay - Set when the hash of a key exchange can be forgotten, e.g. because
+// it's timestamped and will be rejected after this time anyways
+func ExpireKeyExReplay(ciphertext []byte, expires time.Time) error {
+	keyExSha256Hash := sha256.Sum256(ciphertext)
+	return Session().Model(&models.KeyExHistory{}).Where(&models.KeyExHistory{
+		Sha256: hex.EncodeToString(keyExSha256Hash[:]),
+	}).Update("Expires", expires).Error
+}
+
+// PruneKeyExHistory - Remove the hashes of expired key exchanges, hashes without an
+// expiration are kept forever
+func PruneKeyExHistory() (int64, error) {
+	result := Session().Where("expires > ? AND expires < ?", time.Time{}, time.Now()).Delete(&models.KeyExHistory{})
+	return result.RowsAffected, result.Error
+}
+
+// FederationLinks - All linked servers
+func FederationLinks() ([]*models.FederationLink, error) {
+	links := []*models.FederationLink{}
+	err := Session().Find(&links).Error
+	return links, err
+}
+
+// FederationLinkByName - Get a linked server by name
+func FederationLinkByName(name string) (*models.FederationLink, error) {
+	link := models.FederationLink{}
+	err := Session().Where(&models.FederationLink{
+		Name: name,
+	}).First(&link).Error
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// AddHostTag - Tag a host, tagging a host with a tag it already has is a no-op
+func AddHostTag(host *models.Host, name string) error {
+	for _, tag := range host.Tags {
+		if tag.Name == name {
+			return nil
+		}
+	}
+	tag := models.HostTag{HostID: host.ID, Name: name}
+	err := Session().Create(&tag).Error
+	if err != nil {
+		return err
+	}
+	host.Tags = append(host.Tags, tag)
+	return nil
+}
+
+// ScheduledTasks - All scheduled tasks, ordered by their next run
+func ScheduledTasks() ([]*models.ScheduledTask, error) {
+	scheduled := []*models.ScheduledTask{}
+	err := Session().Order("next_run").Find(&scheduled).Error
+	return scheduled, err
+}
+
+// DueScheduledTasks - Enabled scheduled tasks that should have run by now
+func DueScheduledTasks(now time.Time) ([]*models.ScheduledTask, error) {
+	scheduled := []*models.ScheduledTask{}
+	err := Session().Where("enabled = ? AND next_run <= ?", true, now).Find(&scheduled).Error
+	return scheduled, err
+}
+
+// ScheduledTaskByName - Get a scheduled task by name
+func ScheduledTaskByName(name string) (*models.ScheduledTask, error) {
+	scheduled := models.ScheduledTask{}
+	err := Session().Where(&models.ScheduledTask{
+		Name: name,
+	}).First(&scheduled).Error
+	if err != nil {
+		return nil, err
+	}
+	return &scheduled, nil
+}
+
+// ImplantGroups - All implant groups
+func ImplantGroups() ([]*models.ImplantGroup, error) {
+	groups := []*models.ImplantGroup{}
+	err := Session().Order("name").Find(&groups).Error
+	return groups, err
+}
+
+// ImplantGroupByName - Get an implant group by name
+func ImplantGroupByName(name string) (*models.ImplantGroup, error) {
+	group := models.ImplantGroup{}
+	err := Session().Where(&models.ImplantGroup{
+		Name: name,
+	}).First(&group).Error
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// HostCommands - The most recent commands run on a host, newest first
+func HostCommands(hostUUID uuid.UUID, limit int) ([]*models.HostCommand, error) {
+	commands := []*models.HostCommand{}
+	err := Session().Where(&models.HostCommand{
+		HostUUID: hostUUID,
+	}).Order("created_at desc").Limit(limit).Find(&commands).Error
+	return commands, err
+}
+
+// BeaconsByHostUUID - All beacons running on a host
+func BeaconsByHostUUID(hostUUID uuid.UUID) ([]*models.Beacon, error) {
+	beacons := []*models.Beacon{}
+	err := Session().Where(&models.Beacon{UUID: hostUUID}).Find(&beacons).Error
+	return beacons, err
+}
+
+// HostProcessesByHostUUID - The most recent process list of a host
+func HostProcessesByHostUUID(hostUUID uuid.UUID) (*models.HostProcesses, error) {
+	processes := models.HostProcesses{}
+	err := Session().Where(&models.HostProcesses{
+		HostUUID: hostUUID,
+	}).First(&processes).Error
+	if err != nil {
+		return nil, err
+	}
+	return &processes, nil
+}
+
+// HostScanResults - Scan results ordered by address and port, filtered by the host the
+// scans were run from and/or the scanned address if not blank
+func HostScanResults(hostUUID string, address string) ([]*models.HostScanResult, error) {
+	results := []*models.HostScanResult{}
+	query := Session().Model(&models.HostScanResult{})
+	if hostUUID != "" {
+		id, err := uuid.FromString(hostUUID)
+		if err != nil {
+			return nil, ErrRecordNotFound
+		}
+		query = query.Where(&models.HostScanResult{HostUUID: id})
+	}
+	if address != "" {
+		query = query.Where(&models.HostScanResult{Address: address})
+	}
+	err := query.Order("address, port").Find(&results).Error
+	return results, err
+}
+
+// DiscoveredHosts - Discovered hosts ordered by address, filtered by the host the
+// discovery was run from and/or the discovered address if not blank
+func DiscoveredHosts(hostUUID string, address string) ([]*models.DiscoveredHost, error) {
+	hosts := []*models.DiscoveredHost{}
+	query := Session().Model(&models.DiscoveredHost{})
+	if hostUUID != "" {
+		id, err := uuid.FromString(hostUUID)
+		if err != nil {
+			return nil, ErrRecordNotFound
+		}
+		query = query.Where(&models.DiscoveredHost{HostUUID: id})
+	}
+	if address != "" {
+		query = query.Where(&models.DiscoveredHost{Address: address})
+	}
+	err := query.Order("address").Find(&hosts).Error
+	return hosts, err
+}
+
+// AutomationRules - All automation rules
+func AutomationRules() ([]*models.AutomationRule, error) {
+	rules := []*models.AutomationRule{}
+	err := Session().Order("created_at").Find(&rules).Error
+	return rules, err
+}
+
+// AutomationRuleByName - Get an automation rule by name
+func AutomationRuleByName(name string) (*models.AutomationRule, error) {
+	rule := models.AutomationRule{}
+	err := Session().Where(&models.AutomationRule{
+		Name: name,
+	}).First(&rule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// TargetMetadataByTargetID - The operator assigned metadata of a session or beacon
+func TargetMetadataByTargetID(targetID string) (*models.TargetMetadata, error) {
+	metadata := models.TargetMetadata{}
+	err := Session().Where(&models.TargetMetadata{
+		TargetID: targetID,
+	}).Preload("Tags").First(&metadata).Error
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// AllTargetMetadata - The metadata of every session and beacon, keyed by target id
+func AllTargetMetadata() (map[string]*models.TargetMetadata, error) {
+	all := []*models.TargetMetadata{}
+	err := Session().Preload("Tags").Find(&all).Error
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]*models.TargetMetadata{}
+	for _, targetMetadata := range all {
+		metadata[targetMetadata.TargetID] = targetMetadata
+	}
+	return metadata, nil
+}
+
+// DeleteTargetMetadata - Delete the metadata of a session or beacon that no longer exists
+func DeleteTargetMetadata(targetID string) error {
+	metadata, err := TargetMetadataByTargetID(targetID)
+	if err == ErrRecordNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = Session().Where(&models.TargetTag{
+		TargetMetadataID: metadata.ID,
+	}).Delete(&models.TargetTag{}).Error
+	if err != nil {
+		return err
+	}
+	return Session().Delete(metadata).Error
+}
+
+// TargetHistoryByTargetID - The most recent console command lines run on a session
+// or beacon, oldest first
+func TargetHistoryByTargetID(targetID string, limit int) ([]*models.TargetHistory, error) {
+	history := []*models.TargetHistory{}
+	err := Session().Where(&models.TargetHistory{
+		TargetID: targetID,
+	}).Order("created_at desc").Limit(limit).Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+	return history, nil
+}
+
+// DeleteTargetHistory - Delete the command history of a session or beacon that no longer exists
+func DeleteTargetHistory(targetID string) error {
+	return Session().Where(&models.TargetHistory{
+		TargetID: targetID,
+	}).Delete(&models.TargetHistory{}).Error
+}
@@ -0,0 +1,70 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// ArmoryPackage - A version of an alias or extension published to the server's
+// private armory, the package itself is stored in the armory directory
+type ArmoryPackage struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	CommandName string `gorm:"index"`
+	Name        string
+	Version     string
+	IsAlias     bool
+	Manifest    string
+	SHA256      string
+	Size        int64
+	PublishedBy string
+
+	Minisig string // Signature of the package, the trusted comment is the manifest
+}
+
+// BeforeCreate - GORM hook
+func (a *ArmoryPackage) BeforeCreate(tx *gorm.DB) (err error) {
+	a.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	a.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf object
+func (a *ArmoryPackage) ToProtobuf() *clientpb.ArmoryPackageVersion {
+	return &clientpb.ArmoryPackageVersion{
+		ID:          a.ID.String(),
+		CommandName: a.CommandName,
+		Name:        a.Name,
+		Version:     a.Version,
+		IsAlias:     a.IsAlias,
+		SHA256:      a.SHA256,
+		Size:        a.Size,
+		PublishedBy: a.PublishedBy,
+		PublishedAt: a.CreatedAt.Unix(),
+	}
+}
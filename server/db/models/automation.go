@@ -0,0 +1,186 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// AutomationRule - A task sequence the server runs when an event matches,
+// Tasks is newline separated
+type AutomationRule struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name     string `gorm:"uniqueIndex"`
+	Event    string
+	OS       string
+	Hostname string
+	Username string
+	Tasks    string
+	Enabled  bool
+	Operator string
+}
+
+// BeforeCreate - GORM hook
+func (a *AutomationRule) BeforeCreate(tx *gorm.DB) (err error) {
+	a.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	a.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf
+func (a *AutomationRule) ToProtobuf() *clientpb.AutomationRule {
+	tasks := []string{}
+	for _, task := range strings.Split(a.Tasks, "\n") {
+		if task != "" {
+			tasks = append(tasks, task)
+		}
+	}
+	return &clientpb.AutomationRule{
+		ID:        a.ID.String(),
+		Name:      a.Name,
+		Event:     a.Event,
+		OS:        a.OS,
+		Hostname:  a.Hostname,
+		Username:  a.Username,
+		Tasks:     tasks,
+		Enabled:   a.Enabled,
+		Operator:  a.Operator,
+		CreatedAt: a.CreatedAt.Unix(),
+	}
+}
+
+// ScheduledTask - A task sequence the server runs against one session or beacon
+// at a time or on a recurring schedule, Tasks is newline separated
+type ScheduledTask struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name        string `gorm:"uniqueIndex"`
+	ImplantID   string
+	Beacon      bool
+	ImplantName string
+	Hostname    string
+	HostUUID    string
+	Schedule    string
+	Tasks       string
+	NextRun     time.Time `gorm:"index"`
+	LastRun     time.Time
+	Runs        int32
+	Enabled     bool
+	Operator    string
+}
+
+// BeforeCreate - GORM hook
+func (s *ScheduledTask) BeforeCreate(tx *gorm.DB) (err error) {
+	s.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	s.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf
+func (s *ScheduledTask) ToProtobuf() *clientpb.ScheduledTask {
+	tasks := []string{}
+	for _, task := range strings.Split(s.Tasks, "\n") {
+		if task != "" {
+			tasks = append(tasks, task)
+		}
+	}
+	scheduled := &clientpb.ScheduledTask{
+		ID:          s.ID.String(),
+		Name:        s.Name,
+		ImplantID:   s.ImplantID,
+		Beacon:      s.Beacon,
+		ImplantName: s.ImplantName,
+		Hostname:    s.Hostname,
+		Schedule:    s.Schedule,
+		Tasks:       tasks,
+		Runs:        s.Runs,
+		Enabled:     s.Enabled,
+		Operator:    s.Operator,
+		CreatedAt:   s.CreatedAt.Unix(),
+	}
+	if !s.NextRun.IsZero() {
+		scheduled.NextRun = s.NextRun.Unix()
+	}
+	if !s.LastRun.IsZero() {
+		scheduled.LastRun = s.LastRun.Unix()
+	}
+	return scheduled
+}
+
+// ImplantGroup - A named set of sessions and beacons, matched by host tag, subnet,
+// and operating system, Tags, Subnets, and ImplantIDs are newline separated
+type ImplantGroup struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name       string `gorm:"uniqueIndex"`
+	Tags       string
+	Subnets    string
+	OS         string
+	ImplantIDs string
+	Operator   string
+}
+
+// BeforeCreate - GORM hook
+func (g *ImplantGroup) BeforeCreate(tx *gorm.DB) (err error) {
+	g.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	g.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf
+func (g *ImplantGroup) ToProtobuf() *clientpb.ImplantGroup {
+	return &clientpb.ImplantGroup{
+		ID:         g.ID.String(),
+		Name:       g.Name,
+		Tags:       splitLines(g.Tags),
+		Subnets:    splitLines(g.Subnets),
+		OS:         g.OS,
+		ImplantIDs: splitLines(g.ImplantIDs),
+		Operator:   g.Operator,
+		CreatedAt:  g.CreatedAt.Unix(),
+	}
+}
+
+func splitLines(value string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(value, "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -30,6 +30,12 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	// BeaconCheckinGrace - How late a beacon can be before a check-in is considered missed,
+	// for network latency and the time it takes to run tasks
+	BeaconCheckinGrace = 30 * time.Second
+)
+
 // Beacon - Represents a host machine
 type Beacon struct {
 	CreatedAt time.Time `gorm:"->;<-:create;"`
@@ -54,6 +60,12 @@ type Beacon struct {
 	ProxyURL          string
 	Locale            string
 
+	// GeoIP enrichment of the remote address, see server/geoip
+	Country    string
+	ASN        uint32
+	ASNOrg     string
+	ReverseDNS string
+
 	ImplantBuildID uuid.UUID `gorm:"type:uuid;"`
 
 	Interval    int64
@@ -94,6 +106,38 @@ func (b *Beacon) ToProtobuf() *clientpb.Beacon {
 		NextCheckin:       b.NextCheckin,
 		Locale:            b.Locale,
 		FirstContact:      b.CreatedAt.Unix(),
+		AddressInfo:       b.addressInfo(),
+		MissedCheckins:    b.MissedCheckins(time.Now()),
+	}
+}
+
+// MissedCheckins - Number of expected check-ins the beacon has missed by now, after the
+// next check-in every interval plus jitter without one is another missed check-in
+func (b *Beacon) MissedCheckins(now time.Time) int64 {
+	if b.NextCheckin == 0 {
+		return 0
+	}
+	late := now.Sub(time.Unix(b.NextCheckin, 0).Add(BeaconCheckinGrace))
+	if late <= 0 {
+		return 0
+	}
+	period := time.Duration(b.Interval + b.Jitter)
+	if period <= 0 {
+		return 1
+	}
+	return 1 + int64(late/period)
+}
+
+// addressInfo - Nil if the remote address was not enriched
+func (b *Beacon) addressInfo() *clientpb.AddressInfo {
+	if b.Country == "" && b.ASN == 0 && b.ReverseDNS == "" {
+		return nil
+	}
+	return &clientpb.AddressInfo{
+		Country:    b.Country,
+		ASN:        b.ASN,
+		ASNOrg:     b.ASNOrg,
+		ReverseDNS: b.ReverseDNS,
 	}
 }
 
@@ -127,8 +171,12 @@ type BeaconTask struct {
 	SentAt      time.Time
 	CompletedAt time.Time
 	Description string
+	Position    int64  // Pending tasks are sent in ascending order
 	Request     []byte // *sliverpb.Envelope
 	Response    []byte // *sliverpb.Envelope
+
+	ResponseSize   int64
+	ResponseLootID string // Oversized responses are saved as loot, see server/loot/results.go
 }
 
 // BeforeCreate - GORM hook
@@ -138,6 +186,7 @@ func (b *BeaconTask) BeforeCreate(tx *gorm.DB) (err error) {
 		return err
 	}
 	b.CreatedAt = time.Now()
+	b.Position = b.CreatedAt.UnixNano()
 	b.State = PENDING
 	buf := make([]byte, 8)
 	_, err = rand.Read(buf)
@@ -157,6 +206,10 @@ func (b *BeaconTask) ToProtobuf(content bool) *clientpb.BeaconTask {
 		SentAt:      int64(b.SentAt.UTC().Unix()),
 		CompletedAt: int64(b.CompletedAt.UTC().Unix()),
 		Description: b.Description,
+		Position:    b.Position,
+
+		ResponseSize:   b.ResponseSize,
+		ResponseLootID: b.ResponseLootID,
 	}
 	if content {
 		task.Request = b.Request
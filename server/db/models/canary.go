@@ -60,3 +60,52 @@ func (c *DNSCanary) ToProtobuf() *clientpb.DNSCanary {
 		Count:          c.Count,
 	}
 }
+
+// CanaryToken - A canary that is triggered through an HTTP(S) listener, Token is
+// the random path segment of the canary URL (or the access key ID of AWS canaries)
+type CanaryToken struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Type          string
+	Name          string
+	URL           string
+	Token         string `gorm:"uniqueIndex"`
+	Triggered     bool
+	FirstTrigger  time.Time
+	LatestTrigger time.Time
+	Count         uint32
+	LastSource    string
+}
+
+// BeforeCreate - GORM hook
+func (c *CanaryToken) BeforeCreate(tx *gorm.DB) (err error) {
+	c.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	c.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf object
+func (c *CanaryToken) ToProtobuf() *clientpb.CanaryToken {
+	token := &clientpb.CanaryToken{
+		ID:         c.ID.String(),
+		Type:       c.Type,
+		Name:       c.Name,
+		URL:        c.URL,
+		Triggered:  c.Triggered,
+		Count:      c.Count,
+		LastSource: c.LastSource,
+		CreatedAt:  c.CreatedAt.Unix(),
+	}
+	if c.Type == "aws" {
+		token.AccessKeyID = c.Token
+	}
+	if c.Triggered {
+		token.FirstTriggered = c.FirstTrigger.Format(time.RFC1123)
+		token.LatestTrigger = c.LatestTrigger.Format(time.RFC1123)
+	}
+	return token
+}
@@ -33,7 +33,12 @@ type Certificate struct {
 	CAType         string
 	KeyType        string
 	CertificatePEM string
-	PrivateKeyPEM  string
+	PrivateKeyPEM  string `gorm:"serializer:encrypted"`
+
+	SerialNumber string `gorm:"index"`
+	NotAfter     time.Time
+	Revoked      bool
+	RevokedAt    time.Time
 }
 
 // BeforeCreate - GORM hook to automatically set values
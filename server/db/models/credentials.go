@@ -32,10 +32,13 @@ type Credential struct {
 	CreatedAt      time.Time `gorm:"->;<-:create;"`
 	OriginHostUUID uuid.UUID `gorm:"type:uuid;"`
 
+	// OriginSessionID - The session or beacon the credential was collected from
+	OriginSessionID string
+
 	Collection string
 	Username   string
-	Plaintext  string
-	Hash       string // https://hashcat.net/wiki/doku.php?id=example_hashes
+	Plaintext  string `gorm:"serializer:encrypted"`
+	Hash       string `gorm:"serializer:encrypted"` // https://hashcat.net/wiki/doku.php?id=example_hashes
 	HashType   int32
 	IsCracked  bool
 }
@@ -50,6 +53,8 @@ func (c *Credential) ToProtobuf() *clientpb.Credential {
 		IsCracked:      c.IsCracked,
 		OriginHostUUID: c.OriginHostUUID.String(),
 		Collection:     c.Collection,
+
+		OriginSessionID: c.OriginSessionID,
 	}
 }
 
@@ -0,0 +1,62 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// FederationLink - Another Sliver server linked to this one, Config is the
+// operator config (json) used to connect to the linked server
+type FederationLink struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name        string `gorm:"uniqueIndex"`
+	Host        string
+	Operator    string
+	Config      string `gorm:"serializer:encrypted"`
+	RelayEvents bool
+}
+
+// BeforeCreate - GORM hook
+func (f *FederationLink) BeforeCreate(tx *gorm.DB) (err error) {
+	f.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	f.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf, the operator config is never returned
+func (f *FederationLink) ToProtobuf() *clientpb.FederationLink {
+	return &clientpb.FederationLink{
+		ID:          f.ID.String(),
+		Name:        f.Name,
+		Host:        f.Host,
+		Operator:    f.Operator,
+		RelayEvents: f.RelayEvents,
+		CreatedAt:   f.CreatedAt.Unix(),
+	}
+}
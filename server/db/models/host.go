@@ -22,7 +22,9 @@ import (
 	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
@@ -36,8 +38,14 @@ type Host struct {
 	OSVersion string // Verbose OS version
 	Locale    string // Detected language code
 
+	// Survey - Serialized commonpb.HostSurvey from the most recent implant
+	// registration that included one
+	Survey     []byte
+	SurveyedAt time.Time
+
 	IOCs          []IOC
 	ExtensionData []ExtensionData
+	Tags          []HostTag
 }
 
 // BeforeCreate - GORM hook
@@ -51,6 +59,20 @@ func (h *Host) BeforeCreate(tx *gorm.DB) (err error) {
 }
 
 func (h *Host) ToProtobuf() *clientpb.Host {
+	tags := []string{}
+	for _, tag := range h.Tags {
+		tags = append(tags, tag.Name)
+	}
+	var survey *commonpb.HostSurvey
+	var surveyedAt int64
+	if 0 < len(h.Survey) {
+		survey = &commonpb.HostSurvey{}
+		if proto.Unmarshal(h.Survey, survey) == nil {
+			surveyedAt = h.SurveyedAt.Unix()
+		} else {
+			survey = nil
+		}
+	}
 	return &clientpb.Host{
 		HostUUID:      h.HostUUID.String(),
 		Hostname:      h.Hostname,
@@ -59,6 +81,9 @@ func (h *Host) ToProtobuf() *clientpb.Host {
 		IOCs:          h.iocsToProtobuf(),
 		ExtensionData: h.extensionDataToProtobuf(),
 		FirstContact:  h.CreatedAt.Unix(),
+		Tags:          tags,
+		Survey:        survey,
+		SurveyedAt:    surveyedAt,
 	}
 }
 
@@ -132,3 +157,140 @@ func (e *ExtensionData) BeforeCreate(tx *gorm.DB) (err error) {
 	e.CreatedAt = time.Now()
 	return nil
 }
+
+// HostTag - A free-form tag on a host
+type HostTag struct {
+	ID     uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostID uuid.UUID `gorm:"type:uuid;index;"`
+	Name   string    `gorm:"index;"`
+}
+
+// BeforeCreate - GORM hook
+func (t *HostTag) BeforeCreate(tx *gorm.DB) (err error) {
+	t.ID, err = uuid.NewV4()
+	return err
+}
+
+// HostCommand - A command an operator ran on a host, recorded for every session
+// and beacon so a host's history survives the implant reconnecting
+type HostCommand struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostUUID  uuid.UUID `gorm:"type:uuid;index;"`
+	CreatedAt time.Time `gorm:"->;<-:create;index;"`
+
+	ImplantID   string
+	ImplantName string
+	Beacon      bool
+	Operator    string
+	Method      string
+	Request     string
+	Error       string
+	TechniqueID string
+}
+
+// BeforeCreate - GORM hook
+func (c *HostCommand) BeforeCreate(tx *gorm.DB) (err error) {
+	c.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	c.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf object
+func (c *HostCommand) ToProtobuf() *clientpb.HostCommand {
+	return &clientpb.HostCommand{
+		ID:          c.ID.String(),
+		ImplantID:   c.ImplantID,
+		ImplantName: c.ImplantName,
+		Beacon:      c.Beacon,
+		Operator:    c.Operator,
+		Method:      c.Method,
+		Request:     c.Request,
+		Error:       c.Error,
+		CreatedAt:   c.CreatedAt.Unix(),
+		TechniqueID: c.TechniqueID,
+	}
+}
+
+// HostProcesses - The most recent process list of a host, Processes is a
+// serialized sliverpb.Ps
+type HostProcesses struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostUUID  uuid.UUID `gorm:"type:uuid;uniqueIndex;"`
+	UpdatedAt time.Time
+
+	ImplantName string
+	Processes   []byte
+}
+
+// BeforeCreate - GORM hook
+func (p *HostProcesses) BeforeCreate(tx *gorm.DB) (err error) {
+	p.ID, err = uuid.NewV4()
+	return err
+}
+
+// DiscoveredHost - A host found by network discovery run from a host, Data is the
+// serialized commonpb.DiscoveredHost merged from every discovery of the address
+type DiscoveredHost struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostUUID  uuid.UUID `gorm:"type:uuid;index;"`
+	UpdatedAt time.Time
+
+	ImplantName string
+	Address     string `gorm:"index;"`
+	Data        []byte
+}
+
+// BeforeCreate - GORM hook
+func (d *DiscoveredHost) BeforeCreate(tx *gorm.DB) (err error) {
+	d.ID, err = uuid.NewV4()
+	return err
+}
+
+// ToProtobuf - Converts to protobuf object
+func (d *DiscoveredHost) ToProtobuf() *clientpb.DiscoveredHostRecord {
+	host := &commonpb.DiscoveredHost{}
+	if proto.Unmarshal(d.Data, host) != nil {
+		host = &commonpb.DiscoveredHost{}
+	}
+	host.Address = d.Address
+	return &clientpb.DiscoveredHostRecord{
+		HostUUID:    d.HostUUID.String(),
+		ImplantName: d.ImplantName,
+		Host:        host,
+		UpdatedAt:   d.UpdatedAt.Unix(),
+	}
+}
+
+// HostScanResult - An open port found by a scan run from a host, Port is zero
+// for addresses that only responded to a ping
+type HostScanResult struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostUUID  uuid.UUID `gorm:"type:uuid;index;"`
+	UpdatedAt time.Time
+
+	ImplantName string
+	Address     string `gorm:"index;"`
+	Port        uint32
+	Banner      string
+}
+
+// BeforeCreate - GORM hook
+func (r *HostScanResult) BeforeCreate(tx *gorm.DB) (err error) {
+	r.ID, err = uuid.NewV4()
+	return err
+}
+
+// ToProtobuf - Converts to protobuf object
+func (r *HostScanResult) ToProtobuf() *clientpb.ScanResult {
+	return &clientpb.ScanResult{
+		HostUUID:    r.HostUUID.String(),
+		ImplantName: r.ImplantName,
+		Address:     r.Address,
+		Port:        r.Port,
+		Banner:      r.Banner,
+		UpdatedAt:   r.UpdatedAt.Unix(),
+	}
+}
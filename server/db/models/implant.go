@@ -43,6 +43,10 @@ type ImplantBuild struct {
 	// has been seen on threat intel platforms
 	Burned bool
 
+	// Compromised indicates an operator has burned the implant,
+	// listeners will only serve decoy content to it
+	Compromised bool
+
 	ImplantConfig ImplantConfig
 }
 
@@ -77,15 +81,17 @@ type ImplantConfig struct {
 	// ECC
 	PeerPublicKey           string
 	PeerPublicKeyDigest     string
-	PeerPrivateKey          string
+	PeerPrivateKey          string `gorm:"serializer:encrypted"`
 	PeerPublicKeySignature  string
 	AgeServerPublicKey      string
 	MinisignServerPublicKey string
+	MLKEMServerPublicKey    string
+	KeyExchange             string
 
 	// MTLS
 	MtlsCACert string
 	MtlsCert   string
-	MtlsKey    string
+	MtlsKey    string `gorm:"serializer:encrypted"`
 
 	Debug               bool
 	DebugFile           string
@@ -98,8 +104,9 @@ type ImplantConfig struct {
 	SGNEnabled          bool
 
 	// WireGuard
-	WGImplantPrivKey  string
+	WGImplantPrivKey  string `gorm:"serializer:encrypted"`
 	WGServerPubKey    string
+	WGPresharedKey    string `gorm:"serializer:encrypted"`
 	WGPeerTunIP       string
 	WGKeyExchangePort uint32
 	WGTcpCommsPort    uint32
@@ -135,9 +142,62 @@ type ImplantConfig struct {
 
 	FileName string
 
+	// ConfigHash identifies identical external build requests, see generate.ExternalConfigHash
+	ConfigHash string
+
 	NetGoEnabled           bool
 	TrafficEncodersEnabled bool
 	Assets                 []EncoderAsset
+
+	// HTTPC2ConfigVersion - Version of the HTTP C2 config to generate with, 0 is the current version
+	HTTPC2ConfigVersion int32
+
+	// DNSResolvers - Comma separated resolvers of the implant's name resolution
+	DNSResolvers string
+
+	// CompressionEnabled - Compress c2 envelopes larger than sliverpb.CompressionThreshold
+	CompressionEnabled bool
+
+	// ConfigKeySource - Where the implant gets the key of its encrypted c2 config from,
+	// the config isn't encrypted if empty
+	ConfigKeySource   string
+	ConfigKeyLocation string
+	ConfigKey         string `gorm:"serializer:encrypted"`
+
+	// DeadManSwitchDays - Days without reaching any c2 after which the implant removes
+	// the DeadManCleanup persistence and exits, 0 is disabled
+	DeadManSwitchDays uint32
+	DeadManStateFile  string
+	DeadManCleanup    string
+
+	// Reproducible builds derive everything that's randomized at build time (e.g. the
+	// obfuscation and the http c2 profile sample) from the BuildSeed
+	Reproducible bool
+	BuildSeed    string
+
+	// CCBackend - The C cross-compiler of cgo builds, blank picks the first one found
+	CCBackend    string
+	GlibcVersion string
+
+	// Marker - Unique to each build and embedded in the artifact if ArtifactMarker is
+	// set, so a leaked sample can be traced back to the build it came from
+	Marker         string
+	ArtifactMarker bool
+
+	// AdaptiveBeacon - Beacons sleep AdaptiveIdleFactor times longer once the user's been
+	// idle for AdaptiveIdleThreshold or the screen is locked, and twice as long on battery
+	AdaptiveBeacon        bool
+	AdaptiveIdleThreshold int64
+	AdaptiveIdleFactor    uint32
+
+	// SPAEnabled - Knock on mtls listeners with an HMAC of the SPAKey (the server's, hex
+	// encoded) before connecting, see server/c2/spa.go
+	SPAEnabled bool
+	SPAKey     string
+
+	// HTTPClientCert - Present the implant's mtls certificate to https c2s, for listeners
+	// that require client certificates
+	HTTPClientCert bool
 }
 
 // BeforeCreate - GORM hook
@@ -159,14 +219,16 @@ func (ic *ImplantConfig) ToProtobuf() *clientpb.ImplantConfig {
 		BeaconInterval: ic.BeaconInterval,
 		BeaconJitter:   ic.BeaconJitter,
 
-		GOOS:               ic.GOOS,
-		GOARCH:             ic.GOARCH,
-		AgeServerPublicKey: ic.AgeServerPublicKey,
-		PeerPublicKey:      ic.PeerPublicKey,
-		PeerPrivateKey:     ic.PeerPrivateKey,
-		MtlsCACert:         ic.MtlsCACert,
-		MtlsCert:           ic.MtlsCert,
-		MtlsKey:            ic.MtlsKey,
+		GOOS:                 ic.GOOS,
+		GOARCH:               ic.GOARCH,
+		AgeServerPublicKey:   ic.AgeServerPublicKey,
+		MLKEMServerPublicKey: ic.MLKEMServerPublicKey,
+		KeyExchange:          ic.KeyExchange,
+		PeerPublicKey:        ic.PeerPublicKey,
+		PeerPrivateKey:       ic.PeerPrivateKey,
+		MtlsCACert:           ic.MtlsCACert,
+		MtlsCert:             ic.MtlsCert,
+		MtlsKey:              ic.MtlsKey,
 
 		Debug:            ic.Debug,
 		DebugFile:        ic.DebugFile,
@@ -200,6 +262,26 @@ func (ic *ImplantConfig) ToProtobuf() *clientpb.ImplantConfig {
 		FileName:               ic.FileName,
 		TrafficEncodersEnabled: ic.TrafficEncodersEnabled,
 		NetGoEnabled:           ic.NetGoEnabled,
+		HTTPC2ConfigVersion:    ic.HTTPC2ConfigVersion,
+		DNSResolvers:           ic.DNSResolvers,
+		CompressionEnabled:     ic.CompressionEnabled,
+		ConfigKeySource:        ic.ConfigKeySource,
+		ConfigKeyLocation:      ic.ConfigKeyLocation,
+		ConfigKey:              ic.ConfigKey,
+		DeadManSwitchDays:      ic.DeadManSwitchDays,
+		DeadManStateFile:       ic.DeadManStateFile,
+		DeadManCleanup:         ic.DeadManCleanup,
+		Reproducible:           ic.Reproducible,
+		BuildSeed:              ic.BuildSeed,
+		CCBackend:              ic.CCBackend,
+		GlibcVersion:           ic.GlibcVersion,
+		Marker:                 ic.Marker,
+		AdaptiveBeacon:         ic.AdaptiveBeacon,
+		AdaptiveIdleThreshold:  ic.AdaptiveIdleThreshold,
+		AdaptiveIdleFactor:     ic.AdaptiveIdleFactor,
+		SPAEnabled:             ic.SPAEnabled,
+		HTTPClientCert:         ic.HTTPClientCert,
+		ArtifactMarker:         ic.ArtifactMarker,
 	}
 	// Copy Canary Domains
 	config.CanaryDomains = []string{}
@@ -293,6 +375,7 @@ type ImplantProfile struct {
 	CreatedAt time.Time `gorm:"->;<-:create;"`
 
 	Name          string `gorm:"unique;"`
+	Version       uint32
 	ImplantConfig *ImplantConfig
 }
 
@@ -306,6 +389,27 @@ func (ip *ImplantProfile) BeforeCreate(tx *gorm.DB) (err error) {
 	return nil
 }
 
+// ImplantProfileVersion - A snapshot of a profile each time it's saved, Config is the
+// protojson encoded clientpb.ImplantConfig
+type ImplantProfileVersion struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	ProfileName string `gorm:"index"`
+	Version     uint32
+	Config      string
+}
+
+// BeforeCreate - GORM hook
+func (v *ImplantProfileVersion) BeforeCreate(tx *gorm.DB) (err error) {
+	v.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	v.CreatedAt = time.Now()
+	return nil
+}
+
 // EncoderAsset - Tracks which assets were embedded into the implant
 // but we currently don't keep a copy of the actual data
 type EncoderAsset struct {
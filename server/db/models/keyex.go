@@ -28,6 +28,7 @@ import (
 type KeyExHistory struct {
 	Sha256    string    `gorm:"primaryKey;"`
 	CreatedAt time.Time `gorm:"->;<-:create;"`
+	Expires   time.Time `gorm:"index"` // Zero if the key exchange can be replayed forever
 }
 
 // BeforeCreate - GORM hook
@@ -31,7 +31,7 @@ type KeyValue struct {
 	CreatedAt time.Time `gorm:"->;<-:create;"`
 
 	Key   string `gorm:"unique;"`
-	Value string
+	Value string `gorm:"serializer:encrypted"`
 }
 
 // BeforeCreate - GORM hook
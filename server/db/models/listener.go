@@ -0,0 +1,97 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// ListenerProfile - A named set of listeners that are started/stopped as a unit
+type ListenerProfile struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name       string `gorm:"unique;"`
+	AutoStart  bool
+	StartOrder int32
+	DependsOn  string // Comma separated list of profile names
+
+	// Listeners - Serialized clientpb.ListenerProfile containing just the listener configs
+	Listeners []byte
+}
+
+// BeforeCreate - GORM hook
+func (l *ListenerProfile) BeforeCreate(tx *gorm.DB) (err error) {
+	l.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	l.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf object
+func (l *ListenerProfile) ToProtobuf() *clientpb.ListenerProfile {
+	profile := &clientpb.ListenerProfile{}
+	proto.Unmarshal(l.Listeners, profile)
+	profile.ID = l.ID.String()
+	profile.Name = l.Name
+	profile.AutoStart = l.AutoStart
+	profile.StartOrder = l.StartOrder
+	profile.DependsOn = l.DependsOnList()
+	return profile
+}
+
+// DependsOnList - Names of the profiles this profile depends on
+func (l *ListenerProfile) DependsOnList() []string {
+	names := []string{}
+	for _, name := range strings.Split(l.DependsOn, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ListenerProfileFromProtobuf - Create a db model from a protobuf object
+func ListenerProfileFromProtobuf(pbProfile *clientpb.ListenerProfile) (*ListenerProfile, error) {
+	listeners, err := proto.Marshal(&clientpb.ListenerProfile{
+		MTLS: pbProfile.MTLS,
+		WG:   pbProfile.WG,
+		DNS:  pbProfile.DNS,
+		HTTP: pbProfile.HTTP,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ListenerProfile{
+		ID:         uuid.FromStringOrNil(pbProfile.ID),
+		Name:       pbProfile.Name,
+		AutoStart:  pbProfile.AutoStart,
+		StartOrder: pbProfile.StartOrder,
+		DependsOn:  strings.Join(pbProfile.DependsOn, ","),
+		Listeners:  listeners,
+	}, nil
+}
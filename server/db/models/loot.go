@@ -34,17 +34,27 @@ type Loot struct {
 	FileType int
 	Name     string
 	Size     int64
+	SHA256   string `gorm:"index;"`
 
 	OriginHostID uuid.UUID `gorm:"type:uuid;"`
+
+	Tags []LootTag
 }
 
 func (l *Loot) ToProtobuf() *clientpb.Loot {
+	tags := []string{}
+	for _, tag := range l.Tags {
+		tags = append(tags, tag.Name)
+	}
 	return &clientpb.Loot{
 		ID:             l.ID.String(),
 		FileType:       clientpb.FileType(l.FileType),
 		Name:           l.Name,
 		Size:           l.Size,
 		OriginHostUUID: l.OriginHostID.String(),
+		Tags:           tags,
+		SHA256:         l.SHA256,
+		CreatedAt:      l.CreatedAt.Unix(),
 	}
 }
 
@@ -57,3 +67,16 @@ func (l *Loot) BeforeCreate(tx *gorm.DB) (err error) {
 	l.CreatedAt = time.Now()
 	return nil
 }
+
+// LootTag - A free-form tag on a piece of loot
+type LootTag struct {
+	ID     uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	LootID uuid.UUID `gorm:"type:uuid;index;"`
+	Name   string    `gorm:"index;"`
+}
+
+// BeforeCreate - GORM hook
+func (t *LootTag) BeforeCreate(tx *gorm.DB) (err error) {
+	t.ID, err = uuid.NewV4()
+	return err
+}
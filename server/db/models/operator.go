@@ -34,6 +34,13 @@ type Operator struct {
 	CreatedAt time.Time `gorm:"->;<-:create;"`
 	Name      string
 	Token     string `gorm:"uniqueIndex"`
+
+	// Serial number of the client certificate issued with this token
+	CertificateSerial string
+
+	// Standby - A standby server's identity, it can only copy the server's state
+	// (see server/ha) and is refused everything else
+	Standby bool
 }
 
 // BeforeCreate - GORM hook
@@ -0,0 +1,84 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// ArtifactProvenance - Signed record of how and where an artifact was generated
+type ArtifactProvenance struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name        string
+	Kind        string
+	SHA256      string `gorm:"index"`
+	ProfileName string
+	Builder     string
+	Toolchain   string
+	Config      string
+
+	Signature string // Signature of SignedRecord()
+}
+
+// BeforeCreate - GORM hook
+func (a *ArtifactProvenance) BeforeCreate(tx *gorm.DB) (err error) {
+	a.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	a.CreatedAt = time.Now()
+	return nil
+}
+
+// SignedRecord - The canonical encoding of the fields covered by the signature
+func (a *ArtifactProvenance) SignedRecord() []byte {
+	record, _ := json.Marshal(map[string]string{
+		"name":      a.Name,
+		"kind":      a.Kind,
+		"sha256":    a.SHA256,
+		"profile":   a.ProfileName,
+		"builder":   a.Builder,
+		"toolchain": a.Toolchain,
+		"config":    a.Config,
+	})
+	return record
+}
+
+// ToProtobuf - Converts to protobuf object
+func (a *ArtifactProvenance) ToProtobuf() *clientpb.ArtifactProvenance {
+	return &clientpb.ArtifactProvenance{
+		ID:          a.ID.String(),
+		CreatedAt:   a.CreatedAt.Unix(),
+		Name:        a.Name,
+		Kind:        a.Kind,
+		SHA256:      a.SHA256,
+		ProfileName: a.ProfileName,
+		Builder:     a.Builder,
+		Toolchain:   a.Toolchain,
+		Config:      a.Config,
+		Signature:   a.Signature,
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// StageFetch - A request to a stage listener, requests that were refused by the
+// listener's constraints are recorded too
+type StageFetch struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	JobID         uint32
+	ProfileName   string
+	Protocol      string
+	RemoteAddress string
+	UserAgent     string
+	Path          string
+	Served        bool
+	Reason        string
+}
+
+// BeforeCreate - GORM hook
+func (f *StageFetch) BeforeCreate(tx *gorm.DB) (err error) {
+	f.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	f.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf object
+func (f *StageFetch) ToProtobuf() *clientpb.StageFetch {
+	return &clientpb.StageFetch{
+		JobID:         f.JobID,
+		ProfileName:   f.ProfileName,
+		Protocol:      f.Protocol,
+		RemoteAddress: f.RemoteAddress,
+		UserAgent:     f.UserAgent,
+		Path:          f.Path,
+		Served:        f.Served,
+		Reason:        f.Reason,
+		CreatedAt:     f.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,114 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// TargetMetadata - Operator assigned tags, note, and color of a session or beacon
+type TargetMetadata struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+	UpdatedAt time.Time
+
+	TargetID  string `gorm:"uniqueIndex;"`
+	Note      string
+	Color     string
+	UpdatedBy string
+
+	Tags []TargetTag
+}
+
+// ToProtobuf - Converts to protobuf
+func (t *TargetMetadata) ToProtobuf() *clientpb.TargetMetadata {
+	return &clientpb.TargetMetadata{
+		TargetID:  t.TargetID,
+		Tags:      t.TagNames(),
+		Note:      t.Note,
+		Color:     t.Color,
+		UpdatedBy: t.UpdatedBy,
+		UpdatedAt: t.UpdatedAt.Unix(),
+	}
+}
+
+// TagNames - The names of the target's tags
+func (t *TargetMetadata) TagNames() []string {
+	tags := []string{}
+	for _, tag := range t.Tags {
+		tags = append(tags, tag.Name)
+	}
+	return tags
+}
+
+// BeforeCreate - GORM hook
+func (t *TargetMetadata) BeforeCreate(tx *gorm.DB) (err error) {
+	t.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	t.CreatedAt = time.Now()
+	return nil
+}
+
+// TargetTag - A free-form tag on a session or beacon
+type TargetTag struct {
+	ID               uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	TargetMetadataID uuid.UUID `gorm:"type:uuid;index;"`
+	Name             string    `gorm:"index;"`
+}
+
+// BeforeCreate - GORM hook
+func (t *TargetTag) BeforeCreate(tx *gorm.DB) (err error) {
+	t.ID, err = uuid.NewV4()
+	return err
+}
+
+// TargetHistory - A console command line an operator ran on a session or beacon
+type TargetHistory struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	TargetID  string    `gorm:"index;"`
+	CreatedAt time.Time `gorm:"->;<-:create;index;"`
+
+	Operator string
+	Line     string
+}
+
+// BeforeCreate - GORM hook
+func (h *TargetHistory) BeforeCreate(tx *gorm.DB) (err error) {
+	h.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	h.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf
+func (h *TargetHistory) ToProtobuf() *clientpb.TargetHistoryEntry {
+	return &clientpb.TargetHistoryEntry{
+		Line:      h.Line,
+		Operator:  h.Operator,
+		CreatedAt: h.CreatedAt.Unix(),
+	}
+}
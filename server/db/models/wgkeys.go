@@ -11,7 +11,7 @@ import (
 type WGKeys struct {
 	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
 	CreatedAt time.Time `gorm:"->;<-:create;"`
-	PrivKey   string
+	PrivKey   string    `gorm:"serializer:encrypted"`
 	PubKey    string
 }
 
@@ -30,9 +30,12 @@ type WGPeer struct {
 	// gorm.Model
 	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
 	CreatedAt time.Time `gorm:"->;<-:create;"`
-	PrivKey   string
+	PrivKey   string    `gorm:"serializer:encrypted"`
 	PubKey    string
 	TunIP     string
+
+	// PresharedKey - Optional, set for implants with a hybrid key exchange
+	PresharedKey string `gorm:"serializer:encrypted"`
 }
 
 // BeforeCreate - GORM hook to automatically set values
@@ -20,10 +20,12 @@ package db
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/encryption"
 	"github.com/bishopfox/sliver/server/log"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
@@ -38,6 +40,12 @@ var (
 func newDBClient() *gorm.DB {
 	dbConfig := configs.GetDatabaseConfig()
 
+	// Fail early if encrypted values cannot be read
+	err := encryption.Init()
+	if err != nil {
+		panic(err)
+	}
+
 	var dbClient *gorm.DB
 	switch dbConfig.Dialect {
 	case configs.Sqlite:
@@ -50,11 +58,13 @@ func newDBClient() *gorm.DB {
 		panic(fmt.Sprintf("Unknown DB Dialect: '%s'", dbConfig.Dialect))
 	}
 
-	err := dbClient.AutoMigrate(
+	err = dbClient.AutoMigrate(
 		&models.Beacon{},
 		&models.BeaconTask{},
 		&models.DNSCanary{},
+		&models.CanaryToken{},
 		&models.Crackstation{},
+		&models.FederationLink{},
 		&models.Benchmark{},
 		&models.CrackTask{},
 		&models.CrackCommand{},
@@ -64,15 +74,32 @@ func newDBClient() *gorm.DB {
 		&models.Host{},
 		&models.IOC{},
 		&models.ExtensionData{},
+		&models.HostTag{},
+		&models.HostCommand{},
+		&models.HostProcesses{},
+		&models.HostScanResult{},
+		&models.DiscoveredHost{},
+		&models.AutomationRule{},
+		&models.ScheduledTask{},
+		&models.ImplantGroup{},
 		&models.ImplantBuild{},
 		&models.ImplantProfile{},
+		&models.ImplantProfileVersion{},
 		&models.ImplantConfig{},
 		&models.ImplantC2{},
 		&models.EncoderAsset{},
 		&models.KeyExHistory{},
 		&models.KeyValue{},
+		&models.ListenerProfile{},
+		&models.ArtifactProvenance{},
+		&models.ArmoryPackage{},
 		&models.CanaryDomain{},
+		&models.StageFetch{},
 		&models.Loot{},
+		&models.LootTag{},
+		&models.TargetMetadata{},
+		&models.TargetTag{},
+		&models.TargetHistory{},
 		&models.Credential{},
 		&models.Operator{},
 		&models.Website{},
@@ -83,6 +110,10 @@ func newDBClient() *gorm.DB {
 	if err != nil {
 		clientLog.Error(err)
 	}
+	err = encryptExistingRows(dbClient)
+	if err != nil {
+		clientLog.Errorf("Failed to encrypt existing values: %s", err)
+	}
 
 	// Get generic database object sql.DB to use its functions
 	sqlDB, err := dbClient.DB()
@@ -131,3 +162,19 @@ func mySQLClient(dbConfig *configs.DatabaseConfig) *gorm.DB {
 	}
 	return dbClient
 }
+
+// Snapshot - A consistent copy of the sqlite database in a temporary file, even while
+// the server is using it, the caller removes the file
+func Snapshot() (string, error) {
+	snapshot, err := os.CreateTemp("", "sliver-snapshot-*.db")
+	if err != nil {
+		return "", err
+	}
+	snapshot.Close()
+	os.Remove(snapshot.Name()) // VACUUM INTO requires the file to not exist
+	err = Session().Exec("VACUUM INTO ?", snapshot.Name()).Error
+	if err != nil {
+		return "", err
+	}
+	return snapshot.Name(), nil
+}
@@ -0,0 +1,310 @@
+package encryption
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// KeyEnvVar - Passphrase used to derive the at-rest key when the key source is "env"
+	KeyEnvVar = "SLIVER_AT_REST_KEY"
+
+	// EnvKeySource - Derive the key from the passphrase in $SLIVER_AT_REST_KEY
+	EnvKeySource = "env"
+	// FileKeySource - Derive the key from the passphrase in the configured key file
+	FileKeySource = "file"
+	// KeyringKeySource - A random key stored in the OS keyring
+	KeyringKeySource = "keyring"
+
+	keyringService = "sliver"
+	keyringAccount = "at-rest-key"
+
+	saltFileName  = "at-rest.salt"
+	checkFileName = "at-rest.check"
+	checkValue    = "sliver at-rest encryption"
+
+	// Argon2id parameters, per the RFC 9106 second recommended option
+	argonTime    = 3
+	argonMemory  = 64 * 1024
+	argonThreads = 4
+)
+
+var (
+	encryptionLog = log.NamedLogger("encryption", "at-rest")
+
+	// fileMagic - Prefix of encrypted files
+	fileMagic = []byte("SLVRENC1")
+	// columnPrefix - Prefix of encrypted database values
+	columnPrefix = "enc:v1:"
+
+	// ErrKeyUnavailable - Encrypted data was found but no key is configured
+	ErrKeyUnavailable = errors.New("at-rest encryption key is not available")
+	// ErrWrongKey - The configured key does not decrypt existing data
+	ErrWrongKey = errors.New("at-rest encryption key does not match the existing data")
+	// ErrKeyringNotFound - No key stored in the OS keyring
+	ErrKeyringNotFound = errors.New("key not found in keyring")
+
+	enabledOnce sync.Once
+	enabled     bool
+
+	keyOnce sync.Once
+	key     []byte
+	keyErr  error
+)
+
+// Enabled - Returns true if new data should be encrypted at rest, changes to
+// the server config take effect the next time the server is started
+func Enabled() bool {
+	enabledOnce.Do(func() {
+		config := configs.GetServerConfig()
+		enabled = config.Encryption != nil && config.Encryption.Enabled
+	})
+	return enabled
+}
+
+// Init - Load the key and verify it decrypts existing data, this should be called
+// before the database is opened so a missing or wrong key fails loudly at startup
+func Init() error {
+	config := configs.GetServerConfig().Encryption
+	if config == nil || (!config.Enabled && config.KeySource == "") {
+		return nil
+	}
+	_, err := getKey()
+	return err
+}
+
+// Seal - Encrypt a file's contents, returns the plaintext unmodified if
+// at-rest encryption is disabled
+func Seal(plaintext []byte) ([]byte, error) {
+	if !Enabled() {
+		return plaintext, nil
+	}
+	ciphertext, err := encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, fileMagic...), ciphertext...), nil
+}
+
+// Open - Decrypt a file's contents, data that was written before at-rest
+// encryption was enabled is returned unmodified
+func Open(data []byte) ([]byte, error) {
+	if !IsSealed(data) {
+		return data, nil
+	}
+	return decrypt(data[len(fileMagic):])
+}
+
+// IsSealed - Check if file contents are encrypted
+func IsSealed(data []byte) bool {
+	return bytes.HasPrefix(data, fileMagic)
+}
+
+// SealString - Encrypt a database value, empty values are not encrypted so
+// existing "is empty" queries continue to work
+func SealString(value string) (string, error) {
+	if value == "" || !Enabled() {
+		return value, nil
+	}
+	ciphertext, err := encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return columnPrefix + base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// OpenString - Decrypt a database value, plaintext values are returned unmodified
+func OpenString(value string) (string, error) {
+	if !strings.HasPrefix(value, columnPrefix) {
+		return value, nil
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(value[len(columnPrefix):])
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := decrypt(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func encrypt(plaintext []byte) ([]byte, error) {
+	key, err := getKey()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(ciphertext []byte) ([]byte, error) {
+	key, err := getKey()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// getKey - Load the key once, subsequent calls return the same key (or error)
+func getKey() ([]byte, error) {
+	keyOnce.Do(func() {
+		key, keyErr = loadKey(configs.GetServerConfig().Encryption)
+		if keyErr != nil {
+			encryptionLog.Errorf("Failed to load at-rest encryption key: %s", keyErr)
+		}
+	})
+	return key, keyErr
+}
+
+func loadKey(config *configs.EncryptionConfig) ([]byte, error) {
+	if config == nil {
+		return nil, ErrKeyUnavailable
+	}
+	var newKey []byte
+	var err error
+	switch config.KeySource {
+	case EnvKeySource, "":
+		passphrase := os.Getenv(KeyEnvVar)
+		if passphrase == "" {
+			return nil, fmt.Errorf("%w: %s is not set", ErrKeyUnavailable, KeyEnvVar)
+		}
+		newKey, err = deriveKey([]byte(passphrase))
+	case FileKeySource:
+		var passphrase []byte
+		passphrase, err = os.ReadFile(config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		newKey, err = deriveKey(bytes.TrimSpace(passphrase))
+	case KeyringKeySource:
+		newKey, err = keyringKey()
+	default:
+		return nil, fmt.Errorf("unknown at-rest key source '%s'", config.KeySource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = checkKey(newKey)
+	if err != nil {
+		return nil, err
+	}
+	return newKey, nil
+}
+
+// deriveKey - Argon2id with a random per-server salt
+func deriveKey(passphrase []byte) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("%w: empty passphrase", ErrKeyUnavailable)
+	}
+	saltPath := filepath.Join(assets.GetRootAppDir(), saltFileName)
+	salt, err := os.ReadFile(saltPath)
+	if os.IsNotExist(err) {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		err = os.WriteFile(saltPath, salt, 0600)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return argon2.IDKey(passphrase, salt, argonTime, argonMemory, argonThreads, chacha20poly1305.KeySize), nil
+}
+
+// keyringKey - Fetch the key from the OS keyring, a random key is generated
+// and stored the first time
+func keyringKey() ([]byte, error) {
+	encoded, err := keyringGet(keyringService, keyringAccount)
+	if err == nil {
+		return base64.RawStdEncoding.DecodeString(strings.TrimSpace(encoded))
+	}
+	if !errors.Is(err, ErrKeyringNotFound) {
+		return nil, err
+	}
+	newKey := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(newKey); err != nil {
+		return nil, err
+	}
+	encryptionLog.Infof("Storing new at-rest encryption key in the OS keyring")
+	err = keyringSet(keyringService, keyringAccount, base64.RawStdEncoding.EncodeToString(newKey))
+	if err != nil {
+		return nil, err
+	}
+	return newKey, nil
+}
+
+// checkKey - The first key used is recorded by encrypting a known value, any
+// other key is rejected so data is never written with a mix of keys
+func checkKey(candidate []byte) error {
+	aead, err := chacha20poly1305.NewX(candidate)
+	if err != nil {
+		return err
+	}
+	checkPath := filepath.Join(assets.GetRootAppDir(), checkFileName)
+	data, err := os.ReadFile(checkPath)
+	if os.IsNotExist(err) {
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return err
+		}
+		return os.WriteFile(checkPath, aead.Seal(nonce, nonce, []byte(checkValue), nil), 0600)
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) < aead.NonceSize() {
+		return ErrWrongKey
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil || string(plaintext) != checkValue {
+		return ErrWrongKey
+	}
+	return nil
+}
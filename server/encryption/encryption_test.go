@@ -0,0 +1,96 @@
+package encryption
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+)
+
+func enableTestKey(t *testing.T) {
+	enabledOnce.Do(func() { enabled = true })
+	keyOnce.Do(func() {
+		key = make([]byte, 32)
+		rand.Read(key)
+	})
+	if !Enabled() {
+		t.Fatal("Expected at-rest encryption to be enabled")
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	legacy := []byte("written before encryption was enabled")
+	enableTestKey(t)
+
+	data, err := Open(legacy)
+	if err != nil || !bytes.Equal(data, legacy) {
+		t.Fatalf("Expected plaintext data to pass through %v", err)
+	}
+
+	plaintext := []byte("ntlm hashes")
+	sealed, err := Seal(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSealed(sealed) || bytes.Contains(sealed, plaintext) {
+		t.Fatal("Expected sealed data to be encrypted")
+	}
+	opened, err := Open(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(opened, plaintext) {
+		t.Fatalf("Decrypted data mismatch %v != %v", opened, plaintext)
+	}
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = Open(sealed)
+	if err == nil {
+		t.Fatal("Expected tampered data to fail to decrypt")
+	}
+}
+
+func TestSealOpenString(t *testing.T) {
+	enableTestKey(t)
+
+	value, err := SealString("")
+	if err != nil || value != "" {
+		t.Fatalf("Expected empty values to not be encrypted")
+	}
+	value, err = SealString("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(value, columnPrefix) || strings.Contains(value, "hunter2") {
+		t.Fatalf("Expected an encrypted value, got %s", value)
+	}
+	value, err = OpenString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "hunter2" {
+		t.Fatalf("Decrypted value mismatch %s", value)
+	}
+	value, err = OpenString("legacy")
+	if err != nil || value != "legacy" {
+		t.Fatal("Expected plaintext values to pass through")
+	}
+}
@@ -0,0 +1,74 @@
+package encryption
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// errSecItemNotFound - Exit code of security(1) when there is no matching item
+	errSecItemNotFound = 44
+)
+
+// keyringGet - Lookup a generic password in the login keychain
+func keyringGet(service string, account string) (string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w")
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == errSecItemNotFound {
+			return "", ErrKeyringNotFound
+		}
+		return "", fmt.Errorf("security find-generic-password failed: %w %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// keyringSet - Store a generic password in the login keychain, the command is
+// passed to security(1)'s interactive mode on stdin so the secret never appears
+// in the process arguments.
+func keyringSet(service string, account string, secret string) error {
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+		securityQuote(service), securityQuote(account), securityQuote(secret)))
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("security add-generic-password failed: %w %s", err, strings.TrimSpace(stderr.String()))
+	}
+	// Interactive mode exits zero even if the command fails, it only reports the error
+	if stderr.Len() != 0 {
+		return fmt.Errorf("security add-generic-password failed: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// securityQuote - Quote an argument for security(1)'s interactive mode
+func securityQuote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
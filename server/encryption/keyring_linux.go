@@ -0,0 +1,57 @@
+package encryption
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// keyringGet - Lookup a secret using the freedesktop Secret Service (secret-tool)
+func keyringGet(service string, account string) (string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("secret-tool", "lookup", "service", service, "account", account)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok && stderr.Len() == 0 {
+			return "", ErrKeyringNotFound // secret-tool exits 1 with no output if there is no match
+		}
+		return "", fmt.Errorf("secret-tool lookup failed: %w %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// keyringSet - Store a secret using the freedesktop Secret Service (secret-tool),
+// the secret is passed via stdin so it never appears in the process list
+func keyringSet(service string, account string, secret string) error {
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("secret-tool", "store", "--label=Sliver at-rest encryption key", "service", service, "account", account)
+	cmd.Stdin = strings.NewReader(secret)
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("secret-tool store failed: %w %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
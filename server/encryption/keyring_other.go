@@ -0,0 +1,33 @@
+//go:build !linux && !darwin && !windows
+
+package encryption
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import "errors"
+
+// keyringGet - No OS keyring support on this platform
+func keyringGet(service string, account string) (string, error) {
+	return "", errors.New("os keyring is not supported on this platform")
+}
+
+// keyringSet - No OS keyring support on this platform
+func keyringSet(service string, account string, secret string) error {
+	return errors.New("os keyring is not supported on this platform")
+}
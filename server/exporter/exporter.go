@@ -0,0 +1,212 @@
+package exporter
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// queueSize - Records are dropped rather than blocking RPCs or the event broker
+	queueSize     = 4096
+	batchSize     = 100
+	flushInterval = 2 * time.Second
+	sinkTimeout   = 30 * time.Second
+)
+
+var (
+	exporterLog = log.NamedLogger("exporter", "exporter")
+
+	serverHostname, _ = os.Hostname()
+
+	startOnce sync.Once
+	runOnce   sync.Once
+	queue     = make(chan *Record, queueSize)
+	enabled   int32
+
+	sinks      []*sink
+	sinksMutex = &sync.RWMutex{}
+)
+
+// Sink - A SIEM that records are exported to
+type Sink interface {
+	Name() string
+	Send([]*Record) error
+}
+
+type sink struct {
+	Sink
+	kinds map[string]bool
+	types map[string]bool
+}
+
+func (s *sink) wants(record *Record) bool {
+	return (len(s.kinds) == 0 || s.kinds[record.Kind]) && (len(s.types) == 0 || s.types[record.Type])
+}
+
+// Start - Start every exporter in the server config, subsequent calls are ignored
+func Start(config *configs.ServerConfig) {
+	startOnce.Do(func() {
+		Reload(config)
+	})
+}
+
+// Reload - Replace the running exporters with the ones in a reloaded server config,
+// records that are already queued are sent to the new exporters
+func Reload(config *configs.ServerConfig) {
+	newSinks := []*sink{}
+	for _, exporterConfig := range config.Exporters {
+		exporterSink, err := NewSink(exporterConfig)
+		if err != nil {
+			exporterLog.Errorf("Failed to start %s exporter: %s", exporterConfig.Type, err)
+			continue
+		}
+		kinds := map[string]bool{}
+		for _, kind := range exporterConfig.Kinds {
+			kinds[strings.ToLower(kind)] = true
+		}
+		types := map[string]bool{}
+		for _, recordType := range exporterConfig.Types {
+			types[recordType] = true
+		}
+		newSinks = append(newSinks, &sink{Sink: exporterSink, kinds: kinds, types: types})
+		exporterLog.Infof("Exporting server events to %s", exporterSink.Name())
+	}
+	sinksMutex.Lock()
+	sinks = newSinks
+	sinksMutex.Unlock()
+	if len(newSinks) == 0 {
+		atomic.StoreInt32(&enabled, 0)
+		return
+	}
+	atomic.StoreInt32(&enabled, 1)
+	runOnce.Do(func() {
+		go run()
+		go subscribe()
+	})
+}
+
+// NewSink - Create a sink from an exporter config
+func NewSink(config *configs.ExporterConfig) (Sink, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("no url configured")
+	}
+	switch strings.ToLower(config.Type) {
+	case "elasticsearch", "elastic":
+		return newElasticsearch(config), nil
+	case "splunk":
+		return newSplunk(config), nil
+	case "syslog":
+		return newSyslog(config)
+	case "webhook":
+		return newWebhook(config), nil
+	}
+	return nil, fmt.Errorf("unknown exporter type '%s'", config.Type)
+}
+
+// Enabled - True if at least one exporter is running
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// Export - Queue a record for export, records are dropped if the queue is full
+func Export(record *Record) {
+	if !Enabled() {
+		return
+	}
+	select {
+	case queue <- record:
+	default:
+		exporterLog.Warnf("Export queue is full, dropped %s record %s", record.Kind, record.Type)
+	}
+}
+
+func subscribe() {
+	for event := range core.EventBroker.Subscribe() {
+		if Enabled() {
+			Export(eventRecord(event))
+		}
+	}
+}
+
+// run - Batch queued records and send each batch to every sink
+func run() {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	batch := []*Record{}
+	for {
+		select {
+		case record := <-queue:
+			batch = append(batch, record)
+			if len(batch) < batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		flush(batch)
+		batch = []*Record{}
+	}
+}
+
+func flush(batch []*Record) {
+	sinksMutex.RLock()
+	current := sinks
+	sinksMutex.RUnlock()
+	for _, s := range current {
+		records := []*Record{}
+		for _, record := range batch {
+			if s.wants(record) {
+				records = append(records, record)
+			}
+		}
+		if len(records) == 0 {
+			continue
+		}
+		err := s.Send(records)
+		if err != nil {
+			exporterLog.Errorf("Failed to export %d record(s) to %s: %s", len(records), s.Name(), err)
+		}
+	}
+}
+
+func httpClient(config *configs.ExporterConfig) *http.Client {
+	return &http.Client{
+		Timeout: sinkTimeout,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: config.InsecureSkipVerify,
+			},
+		},
+	}
+}
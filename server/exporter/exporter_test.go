@@ -0,0 +1,193 @@
+package exporter
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func testRecords() []*Record {
+	record := NewRecord(KindTask, "/rpcpb.SliverRPC/Ls", time.Now())
+	record.Operator = "alice"
+	record.SessionID = "e1f3e3b0"
+	burned := NewRecord(KindEvent, consts.BurnedContactEvent, time.Now())
+	burned.Message = "TEST contacted a mtls listener"
+	return []*Record{record, burned}
+}
+
+func TestElasticsearchSink(t *testing.T) {
+	var lines []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/_bulk" || req.Header.Get("Authorization") != "ApiKey secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		lines = strings.Split(strings.TrimSpace(string(body)), "\n")
+		w.Write([]byte(`{"errors":false,"items":[]}`))
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(&configs.ExporterConfig{Type: "elasticsearch", URL: server.URL, Token: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(testRecords())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 4 {
+		t.Fatalf("Expected 4 bulk lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], defaultElasticsearchIndex) {
+		t.Fatalf("Expected default index in bulk action %s", lines[0])
+	}
+	record := &Record{}
+	err = json.Unmarshal([]byte(lines[1]), record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Operator != "alice" || record.SchemaVersion != SchemaVersion {
+		t.Fatalf("Unexpected record %v", record)
+	}
+}
+
+func TestSplunkSink(t *testing.T) {
+	events := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/services/collector/event" || req.Header.Get("Authorization") != "Splunk token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		decoder := json.NewDecoder(req.Body)
+		for decoder.More() {
+			event := &splunkEvent{}
+			if decoder.Decode(event) != nil || event.Event == nil || event.Time == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			events++
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(&configs.ExporterConfig{Type: "splunk", URL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(testRecords())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events != 2 {
+		t.Fatalf("Expected 2 events, got %d", events)
+	}
+}
+
+func TestSyslogSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	received := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		for {
+			prefix, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			length, err := strconv.Atoi(strings.TrimSpace(prefix))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, length)
+			_, err = io.ReadFull(reader, msg)
+			if err != nil {
+				return
+			}
+			received <- string(msg)
+		}
+	}()
+
+	sink, err := NewSink(&configs.ExporterConfig{Type: "syslog", URL: "tcp://" + listener.Addr().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(testRecords())
+	if err != nil {
+		t.Fatal(err)
+	}
+	task := <-received
+	if !strings.HasPrefix(task, "<134>1 ") || !strings.Contains(task, " sliver - task - {") {
+		t.Fatalf("Unexpected syslog message %s", task)
+	}
+	burned := <-received
+	if !strings.HasPrefix(burned, "<132>1 ") {
+		t.Fatalf("Expected warning severity for burned contact %s", burned)
+	}
+
+	_, err = NewSink(&configs.ExporterConfig{Type: "syslog", URL: "http://127.0.0.1"})
+	if err == nil {
+		t.Fatal("Expected invalid syslog scheme to fail")
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	records := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		record := &Record{}
+		if req.Header.Get("Authorization") != "Bearer token" || json.NewDecoder(req.Body).Decode(record) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		records++
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(&configs.ExporterConfig{Type: "webhook", URL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sink.Send(testRecords())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records != 2 {
+		t.Fatalf("Expected 2 records, got %d", records)
+	}
+}
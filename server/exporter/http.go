@@ -0,0 +1,241 @@
+package exporter
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	defaultElasticsearchIndex = "sliver-events"
+	defaultSplunkSourceType   = "sliver:server"
+)
+
+// elasticsearch - Index records with the bulk API
+type elasticsearch struct {
+	url      string
+	index    string
+	apiKey   string
+	username string
+	password string
+	client   *http.Client
+}
+
+func newElasticsearch(config *configs.ExporterConfig) *elasticsearch {
+	index := config.Index
+	if index == "" {
+		index = defaultElasticsearchIndex
+	}
+	return &elasticsearch{
+		url:      strings.TrimSuffix(config.URL, "/"),
+		index:    index,
+		apiKey:   config.Token,
+		username: config.Username,
+		password: config.Password,
+		client:   httpClient(config),
+	}
+}
+
+func (e *elasticsearch) Name() string {
+	return fmt.Sprintf("elasticsearch (%s/%s)", e.url, e.index)
+}
+
+type elasticsearchBulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Error *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+func (e *elasticsearch) Send(records []*Record) error {
+	var body bytes.Buffer
+	action, _ := json.Marshal(map[string]interface{}{"create": map[string]string{"_index": e.index}})
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(data)
+		body.WriteByte('\n')
+	}
+	req, err := http.NewRequest(http.MethodPost, e.url+"/_bulk", &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+e.apiKey)
+	} else if e.username != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+	respBody, err := doRequest(e.client, req)
+	if err != nil {
+		return err
+	}
+	bulkResp := &elasticsearchBulkResponse{}
+	if json.Unmarshal(respBody, bulkResp) == nil && bulkResp.Errors {
+		for _, item := range bulkResp.Items {
+			for _, result := range item {
+				if result.Error != nil {
+					return fmt.Errorf("bulk index error (%s: %s)", result.Error.Type, result.Error.Reason)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// splunk - Send records to a Splunk HTTP Event Collector
+type splunk struct {
+	url    string
+	index  string
+	token  string
+	client *http.Client
+}
+
+func newSplunk(config *configs.ExporterConfig) *splunk {
+	url := strings.TrimSuffix(config.URL, "/")
+	if !strings.HasSuffix(url, "/services/collector/event") {
+		url += "/services/collector/event"
+	}
+	return &splunk{
+		url:    url,
+		index:  config.Index,
+		token:  config.Token,
+		client: httpClient(config),
+	}
+}
+
+func (s *splunk) Name() string {
+	return fmt.Sprintf("splunk (%s)", s.url)
+}
+
+type splunkEvent struct {
+	Time       float64 `json:"time"`
+	Host       string  `json:"host,omitempty"`
+	Source     string  `json:"source"`
+	SourceType string  `json:"sourcetype"`
+	Index      string  `json:"index,omitempty"`
+	Event      *Record `json:"event"`
+}
+
+func (s *splunk) Send(records []*Record) error {
+	var body bytes.Buffer
+	for _, record := range records {
+		data, err := json.Marshal(&splunkEvent{
+			Time:       splunkTime(record.Timestamp),
+			Host:       record.Server,
+			Source:     "sliver",
+			SourceType: defaultSplunkSourceType,
+			Index:      s.index,
+			Event:      record,
+		})
+		if err != nil {
+			return err
+		}
+		body.Write(data)
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Splunk "+s.token)
+	_, err = doRequest(s.client, req)
+	return err
+}
+
+// splunkTime - HEC expects epoch seconds with optional milliseconds
+func splunkTime(timestamp string) float64 {
+	parsed, err := time.Parse(TimestampFormat, timestamp)
+	if err != nil {
+		parsed = time.Now()
+	}
+	return float64(parsed.UnixMilli()) / 1000
+}
+
+// webhook - POST each record as a JSON object, for chat and alerting integrations
+type webhook struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func newWebhook(config *configs.ExporterConfig) *webhook {
+	return &webhook{
+		url:    config.URL,
+		token:  config.Token,
+		client: httpClient(config),
+	}
+}
+
+func (w *webhook) Name() string {
+	return fmt.Sprintf("webhook (%s)", w.url)
+}
+
+func (w *webhook) Send(records []*Record) error {
+	for _, record := range records {
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if w.token != "" {
+			req.Header.Set("Authorization", "Bearer "+w.token)
+		}
+		_, err = doRequest(w.client, req)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func doRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		return nil, fmt.Errorf("server returned %s", resp.Status)
+	}
+	return data, nil
+}
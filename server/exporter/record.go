@@ -0,0 +1,208 @@
+package exporter
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+	"unicode/utf8"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// SchemaVersion - Incremented whenever a field in Record changes meaning or is removed
+	SchemaVersion = 1
+
+	// TimestampFormat - RFC 3339 with millisecond precision, valid in every supported SIEM
+	TimestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+	// KindEvent - A server event (session/beacon/job/operator state change)
+	KindEvent = "event"
+	// KindTask - An operator submitted a task to a session or beacon
+	KindTask = "task"
+	// KindResult - A beacon returned the result of a task
+	KindResult = "result"
+)
+
+// Record - A single exported record, see README.md for the schema. Task content and
+// results are never exported, only metadata about them.
+type Record struct {
+	SchemaVersion int    `json:"schema_version"`
+	Timestamp     string `json:"@timestamp"`
+	Server        string `json:"server"`
+	Kind          string `json:"kind"`
+	Type          string `json:"type"`
+
+	Operator      string `json:"operator,omitempty"`
+	SessionID     string `json:"session_id,omitempty"`
+	BeaconID      string `json:"beacon_id,omitempty"`
+	ImplantName   string `json:"implant_name,omitempty"`
+	Hostname      string `json:"hostname,omitempty"`
+	Username      string `json:"username,omitempty"`
+	OS            string `json:"os,omitempty"`
+	Transport     string `json:"transport,omitempty"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+	Country       string `json:"country,omitempty"`
+	ASN           uint32 `json:"asn,omitempty"`
+	ASNOrg        string `json:"asn_org,omitempty"`
+	ReverseDNS    string `json:"reverse_dns,omitempty"`
+
+	JobID   int    `json:"job_id,omitempty"`
+	JobName string `json:"job_name,omitempty"`
+
+	TaskID       string `json:"task_id,omitempty"`
+	Description  string `json:"description,omitempty"`
+	State        string `json:"state,omitempty"`
+	Status       string `json:"status,omitempty"`
+	DurationMs   int64  `json:"duration_ms,omitempty"`
+	RequestSize  int    `json:"request_size,omitempty"`
+	ResponseSize int    `json:"response_size,omitempty"`
+
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// textEvents - Events that carry a human readable message in their data
+var textEvents = map[string]bool{
+	consts.WatchtowerEvent:     true,
+	consts.BurnedContactEvent:  true,
+	consts.FederationEvent:     true,
+	consts.AutomationEvent:     true,
+	consts.CanaryEvent:         true,
+	consts.StageFetchEvent:     true,
+	consts.TargetLockEvent:     true,
+	consts.TargetMetadataEvent: true,
+	consts.OpsecEvent:          true,
+	consts.BeaconOverdueEvent:  true,
+}
+
+// NewRecord - A record stamped with the current schema version and server name
+func NewRecord(kind string, recordType string, timestamp time.Time) *Record {
+	return &Record{
+		SchemaVersion: SchemaVersion,
+		Timestamp:     timestamp.UTC().Format(TimestampFormat),
+		Server:        serverHostname,
+		Kind:          kind,
+		Type:          recordType,
+	}
+}
+
+// eventRecord - Convert a server event to a record
+func eventRecord(event core.Event) *Record {
+	if event.EventType == consts.BeaconTaskResultEvent {
+		return resultRecord(event)
+	}
+	record := NewRecord(KindEvent, event.EventType, time.Now())
+	if event.Session != nil {
+		record.SessionID = event.Session.ID
+		record.ImplantName = event.Session.Name
+		record.Hostname = event.Session.Hostname
+		record.Username = event.Session.Username
+		record.OS = event.Session.OS
+		if event.Session.Connection != nil {
+			record.Transport = event.Session.Connection.Transport
+			record.RemoteAddress = event.Session.Connection.RemoteAddress
+		}
+		record.addressInfo(event.Session.AddressInfo)
+	}
+	if event.Beacon != nil {
+		record.BeaconID = event.Beacon.ID.String()
+		record.ImplantName = event.Beacon.Name
+		record.Hostname = event.Beacon.Hostname
+		record.Username = event.Beacon.Username
+		record.OS = event.Beacon.OS
+		record.Transport = event.Beacon.Transport
+		record.RemoteAddress = event.Beacon.RemoteAddress
+		record.Country = event.Beacon.Country
+		record.ASN = event.Beacon.ASN
+		record.ASNOrg = event.Beacon.ASNOrg
+		record.ReverseDNS = event.Beacon.ReverseDNS
+	}
+	if event.Job != nil {
+		record.JobID = event.Job.ID
+		record.JobName = event.Job.Name
+		record.Description = event.Job.Description
+	}
+	if event.Client != nil && event.Client.Operator != nil {
+		record.Operator = event.Client.Operator.Name
+	}
+	if textEvents[event.EventType] && utf8.Valid(event.Data) {
+		record.Message = string(event.Data)
+	}
+	if event.Err != nil {
+		record.Error = event.Err.Error()
+	}
+	return record
+}
+
+// resultRecord - Beacon task results are published after the result is saved
+func resultRecord(event core.Event) *Record {
+	record := NewRecord(KindResult, event.EventType, time.Now())
+	task := &clientpb.BeaconTask{}
+	err := proto.Unmarshal(event.Data, task)
+	if err != nil {
+		record.Error = err.Error()
+		return record
+	}
+	record.BeaconID = task.BeaconID
+	record.TaskID = task.ID
+	record.Description = task.Description
+	record.State = task.State
+	if 0 < task.SentAt && 0 < task.CompletedAt {
+		record.DurationMs = (task.CompletedAt - task.SentAt) * 1000
+	}
+	return record
+}
+
+// TaskRecord - A task submitted to a session or beacon by an operator
+func TaskRecord(method string, operator string, session *clientpb.Session, beacon *clientpb.Beacon) *Record {
+	record := NewRecord(KindTask, method, time.Now())
+	record.Operator = operator
+	if session != nil {
+		record.SessionID = session.ID
+		record.ImplantName = session.Name
+		record.Hostname = session.Hostname
+		record.Username = session.Username
+		record.OS = session.OS
+		record.Transport = session.Transport
+		record.RemoteAddress = session.RemoteAddress
+		record.addressInfo(session.AddressInfo)
+	}
+	if beacon != nil {
+		record.BeaconID = beacon.ID
+		record.ImplantName = beacon.Name
+		record.Hostname = beacon.Hostname
+		record.Username = beacon.Username
+		record.OS = beacon.OS
+		record.Transport = beacon.Transport
+		record.RemoteAddress = beacon.RemoteAddress
+		record.addressInfo(beacon.AddressInfo)
+	}
+	return record
+}
+
+func (r *Record) addressInfo(info *clientpb.AddressInfo) {
+	r.Country = info.GetCountry()
+	r.ASN = info.GetASN()
+	r.ASNOrg = info.GetASNOrg()
+	r.ReverseDNS = info.GetReverseDNS()
+}
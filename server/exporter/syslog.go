@@ -0,0 +1,138 @@
+package exporter
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	syslogFacility = 16 // local0
+	syslogInfo     = 6
+	syslogWarning  = 4
+	syslogAppName  = "sliver"
+)
+
+// syslog - RFC 5424 messages with the JSON record as the message body, TCP and TLS
+// use octet counting framing (RFC 6587)
+type syslog struct {
+	network string
+	address string
+	tls     *tls.Config
+	conn    net.Conn
+}
+
+func newSyslog(config *configs.ExporterConfig) (*syslog, error) {
+	syslogURL, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, err
+	}
+	s := &syslog{network: syslogURL.Scheme, address: syslogURL.Host}
+	defaultPort := "514"
+	switch s.network {
+	case "udp", "tcp":
+	case "tls":
+		defaultPort = "6514"
+		s.tls = &tls.Config{
+			ServerName:         syslogURL.Hostname(),
+			InsecureSkipVerify: config.InsecureSkipVerify,
+		}
+	default:
+		return nil, fmt.Errorf("syslog url scheme must be one of: udp, tcp, tls")
+	}
+	if syslogURL.Port() == "" {
+		s.address = net.JoinHostPort(syslogURL.Hostname(), defaultPort)
+	}
+	return s, nil
+}
+
+func (s *syslog) Name() string {
+	return fmt.Sprintf("syslog (%s://%s)", s.network, s.address)
+}
+
+func (s *syslog) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: sinkTimeout}
+	if s.tls != nil {
+		return tls.DialWithDialer(dialer, "tcp", s.address, s.tls)
+	}
+	return dialer.Dial(s.network, s.address)
+}
+
+func (s *syslog) Send(records []*Record) error {
+	for _, record := range records {
+		msg, err := syslogMessage(record)
+		if err != nil {
+			return err
+		}
+		if s.network != "udp" {
+			msg = append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+		}
+		err = s.write(msg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write - Reconnect once if the connection was closed since the last batch
+func (s *syslog) write(msg []byte) error {
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			s.conn, err = s.dial()
+			if err != nil {
+				return err
+			}
+		}
+		s.conn.SetWriteDeadline(time.Now().Add(sinkTimeout))
+		_, err = s.conn.Write(msg)
+		if err == nil {
+			return nil
+		}
+		s.conn.Close()
+		s.conn = nil
+	}
+	return err
+}
+
+func syslogMessage(record *Record) ([]byte, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	severity := syslogInfo
+	if record.Error != "" || record.Type == consts.BurnedContactEvent || record.Type == consts.WatchtowerEvent {
+		severity = syslogWarning
+	}
+	hostname := record.Server
+	if hostname == "" {
+		hostname = "-"
+	}
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ", syslogFacility*8+severity, record.Timestamp, hostname, syslogAppName, record.Kind)
+	return append([]byte(header), data...), nil
+}
@@ -0,0 +1,415 @@
+package federation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	clientAssets "github.com/bishopfox/sliver/client/assets"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/client/transport"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	reconnectInterval = 30 * time.Second
+	requestTimeout    = 30 * time.Second
+)
+
+var (
+	federationLog = log.NamedLogger("federation", "links")
+
+	// ErrLinkNotFound - No linked server with the requested name
+	ErrLinkNotFound = errors.New("linked server not found")
+	// ErrLinkExists - Linked server names must be unique
+	ErrLinkExists = errors.New("a linked server with that name already exists")
+	// ErrNotConnected - The linked server is not currently reachable
+	ErrNotConnected = errors.New("linked server is not connected")
+
+	peers      = map[string]*peer{}
+	peersMutex = &sync.RWMutex{}
+	startOnce  sync.Once
+)
+
+// peer - A connection to a linked server, the connection is retried until the link is removed
+type peer struct {
+	link   *models.FederationLink
+	config *clientAssets.ClientConfig
+
+	mutex sync.RWMutex
+	rpc   rpcpb.SliverRPCClient
+	conn  *grpc.ClientConn
+	err   error
+	stop  chan struct{}
+}
+
+// Start - Connect to every linked server, subsequent calls are ignored
+func Start() {
+	startOnce.Do(func() {
+		links, err := db.FederationLinks()
+		if err != nil {
+			federationLog.Errorf("Failed to load linked servers: %s", err)
+			return
+		}
+		for _, link := range links {
+			config, err := parseConfig([]byte(link.Config))
+			if err != nil {
+				federationLog.Errorf("Invalid operator config for linked server %s: %s", link.Name, err)
+				continue
+			}
+			startPeer(link, config)
+		}
+	})
+}
+
+func parseConfig(data []byte) (*clientAssets.ClientConfig, error) {
+	config := &clientAssets.ClientConfig{}
+	err := json.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	if config.LHost == "" || config.LPort == 0 || config.Certificate == "" || config.PrivateKey == "" || config.CACertificate == "" {
+		return nil, errors.New("missing required fields")
+	}
+	return config, nil
+}
+
+func startPeer(link *models.FederationLink, config *clientAssets.ClientConfig) *peer {
+	p := &peer{link: link, config: config, stop: make(chan struct{})}
+	peersMutex.Lock()
+	peers[link.Name] = p
+	peersMutex.Unlock()
+	go p.run()
+	return p
+}
+
+// AddLink - Link a server using one of its operator configs, the connection is
+// tested before the link is saved
+func AddLink(name string, configData []byte, relayEvents bool) (*clientpb.FederationLink, error) {
+	config, err := parseConfig(configData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator config: %w", err)
+	}
+	if name == "" {
+		name = config.LHost
+	}
+	if _, err := db.FederationLinkByName(name); err == nil {
+		return nil, ErrLinkExists
+	}
+	rpc, conn, err := transport.MTLSConnect(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s:%d: %w", config.LHost, config.LPort, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	_, err = rpc.GetVersion(ctx, &commonpb.Empty{})
+	cancel()
+	conn.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	link := &models.FederationLink{
+		Name:        name,
+		Host:        fmt.Sprintf("%s:%d", config.LHost, config.LPort),
+		Operator:    config.Operator,
+		Config:      string(configData),
+		RelayEvents: relayEvents,
+	}
+	err = db.Session().Create(link).Error
+	if err != nil {
+		return nil, err
+	}
+	federationLog.Infof("Linked server %s (%s)", link.Name, link.Host)
+	return startPeer(link, config).ToProtobuf(), nil
+}
+
+// RemoveLink - Disconnect and forget a linked server
+func RemoveLink(name string) error {
+	link, err := db.FederationLinkByName(name)
+	if err != nil {
+		return ErrLinkNotFound
+	}
+	peersMutex.Lock()
+	if p, ok := peers[name]; ok {
+		close(p.stop)
+		delete(peers, name)
+	}
+	peersMutex.Unlock()
+	federationLog.Infof("Unlinked server %s (%s)", link.Name, link.Host)
+	return db.Session().Delete(link).Error
+}
+
+// Links - All linked servers and their connection status
+func Links() *clientpb.FederationLinks {
+	links := &clientpb.FederationLinks{Links: []*clientpb.FederationLink{}}
+	for _, p := range getPeers("") {
+		links.Links = append(links.Links, p.ToProtobuf())
+	}
+	return links
+}
+
+func getPeers(name string) []*peer {
+	peersMutex.RLock()
+	defer peersMutex.RUnlock()
+	all := []*peer{}
+	for peerName, p := range peers {
+		if name == "" || peerName == name {
+			all = append(all, p)
+		}
+	}
+	return all
+}
+
+// ToProtobuf - Link details and connection status
+func (p *peer) ToProtobuf() *clientpb.FederationLink {
+	link := p.link.ToProtobuf()
+	rpc, err := p.client()
+	link.Connected = rpc != nil
+	if err != nil {
+		link.Err = err.Error()
+	}
+	return link
+}
+
+// client - The rpc client of a connected peer
+func (p *peer) client() (rpcpb.SliverRPCClient, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	if p.conn == nil {
+		if p.err != nil {
+			return nil, p.err
+		}
+		return nil, ErrNotConnected
+	}
+	state := p.conn.GetState()
+	if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+		return nil, ErrNotConnected
+	}
+	return p.rpc, nil
+}
+
+// run - (Re)connect until the link is removed, events are relayed while connected
+func (p *peer) run() {
+	for {
+		rpc, conn, err := transport.MTLSConnect(p.config)
+		p.mutex.Lock()
+		p.rpc, p.conn, p.err = rpc, conn, err
+		p.mutex.Unlock()
+		if err != nil {
+			federationLog.Warnf("Failed to connect to linked server %s: %s", p.link.Name, err)
+		} else {
+			federationLog.Infof("Connected to linked server %s (%s)", p.link.Name, p.link.Host)
+			if p.link.RelayEvents {
+				err = p.relayEvents(rpc)
+			} else {
+				<-p.stop
+			}
+			conn.Close()
+			p.mutex.Lock()
+			p.rpc, p.conn, p.err = nil, nil, err
+			p.mutex.Unlock()
+		}
+		select {
+		case <-p.stop:
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// relayEvents - Publish the linked server's events to our operators
+func (p *peer) relayEvents(rpc rpcpb.SliverRPCClient) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-p.stop
+		cancel()
+	}()
+	events, err := rpc.Events(ctx, &commonpb.Empty{})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := events.Recv()
+		if err != nil {
+			select {
+			case <-p.stop:
+				return nil
+			default:
+			}
+			federationLog.Warnf("Lost event stream from linked server %s: %s", p.link.Name, err)
+			return err
+		}
+		msg := eventMessage(event)
+		if msg == "" {
+			continue
+		}
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.FederationEvent,
+			Data:      []byte(fmt.Sprintf("[%s] %s", p.link.Name, msg)),
+		})
+	}
+}
+
+// View - Browse the sessions, beacons, and loot of linked servers
+func View(req *clientpb.FederationReq) (*clientpb.FederationView, error) {
+	selected := getPeers(req.Name)
+	if req.Name != "" && len(selected) == 0 {
+		return nil, ErrLinkNotFound
+	}
+	view := &clientpb.FederationView{Servers: []*clientpb.FederatedServer{}}
+	for _, p := range selected {
+		server := &clientpb.FederatedServer{Name: p.link.Name}
+		err := p.fetch(req, server)
+		if err != nil {
+			server.Err = err.Error()
+		}
+		view.Servers = append(view.Servers, server)
+	}
+	return view, nil
+}
+
+func (p *peer) fetch(req *clientpb.FederationReq, server *clientpb.FederatedServer) error {
+	rpc, err := p.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	if req.Sessions {
+		sessions, err := rpc.GetSessions(ctx, &commonpb.Empty{})
+		if err != nil {
+			return err
+		}
+		server.Sessions = sessions.Sessions
+	}
+	if req.Beacons {
+		beacons, err := rpc.GetBeacons(ctx, &commonpb.Empty{})
+		if err != nil {
+			return err
+		}
+		server.Beacons = beacons.Beacons
+	}
+	if req.Loot {
+		allLoot, err := rpc.LootAll(ctx, &commonpb.Empty{})
+		if err != nil {
+			return err
+		}
+		server.Loot = allLoot.Loot
+	}
+	return nil
+}
+
+// LootContent - Fetch a piece of loot from a linked server, and optionally
+// replicate it into the local loot store
+func LootContent(req *clientpb.FederationLootReq) (*clientpb.Loot, error) {
+	selected := getPeers(req.Name)
+	if len(selected) == 0 {
+		return nil, ErrLinkNotFound
+	}
+	rpc, err := selected[0].client()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	remoteLoot, err := rpc.LootContent(ctx, &clientpb.Loot{ID: req.LootID})
+	if err != nil {
+		return nil, err
+	}
+	if !req.SaveLocal {
+		return remoteLoot, nil
+	}
+
+	// The origin host only exists on the linked server
+	localLoot, err := loot.GetLootStore().Add(&clientpb.Loot{
+		Name:     remoteLoot.Name,
+		FileType: remoteLoot.FileType,
+		File:     remoteLoot.File,
+		Tags:     append(remoteLoot.Tags, "federation:"+req.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(localLoot.ID),
+	})
+	return loot.GetLootStore().GetContent(localLoot.ID, true)
+}
+
+// eventMessage - A one line description of the linked server events we relay
+func eventMessage(event *clientpb.Event) string {
+	switch event.EventType {
+	case consts.SessionOpenedEvent:
+		if session := event.Session; session != nil {
+			return fmt.Sprintf("Session %s %s - %s (%s) - %s/%s", shortID(session.ID), session.Name,
+				session.RemoteAddress, session.Hostname, session.OS, session.Arch)
+		}
+	case consts.SessionClosedEvent:
+		if session := event.Session; session != nil {
+			return fmt.Sprintf("Lost session %s %s - %s (%s)", shortID(session.ID), session.Name,
+				session.RemoteAddress, session.Hostname)
+		}
+	case consts.BeaconRegisteredEvent:
+		beacon := &clientpb.Beacon{}
+		if proto.Unmarshal(event.Data, beacon) == nil {
+			return fmt.Sprintf("Beacon %s %s - %s (%s) - %s/%s", shortID(beacon.ID), beacon.Name,
+				beacon.RemoteAddress, beacon.Hostname, beacon.OS, beacon.Arch)
+		}
+	case consts.JobStoppedEvent:
+		if job := event.Job; job != nil {
+			return fmt.Sprintf("Job #%d stopped (%s/%s)", job.ID, job.Protocol, job.Name)
+		}
+	case consts.LootAddedEvent:
+		return fmt.Sprintf("Loot %s added", shortID(string(event.Data)))
+	case consts.CanaryEvent:
+		if event.Session != nil {
+			return fmt.Sprintf("WARNING: %s has been burned (DNS Canary)", event.Session.Name)
+		}
+		return fmt.Sprintf("WARNING: %s", event.Data)
+	case consts.WatchtowerEvent:
+		return fmt.Sprintf("WARNING: implant has been burned (seen on %s)", event.Data)
+	case consts.BurnedContactEvent:
+		return fmt.Sprintf("WARNING: Burned implant %s", event.Data)
+	case consts.BeaconOverdueEvent:
+		return fmt.Sprintf("WARNING: %s", event.Data)
+	}
+	return ""
+}
+
+func shortID(id string) string {
+	return strings.Split(id, "-")[0]
+}
@@ -0,0 +1,59 @@
+package federation
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseConfig(t *testing.T) {
+	_, err := parseConfig([]byte(`{"operator":"alice","lhost":"10.0.0.1","lport":31337}`))
+	if err == nil {
+		t.Fatal("Expected config without certificates to be rejected")
+	}
+	config, err := parseConfig([]byte(`{"operator":"alice","lhost":"10.0.0.1","lport":31337,"token":"t",
+		"ca_certificate":"ca","certificate":"cert","private_key":"key"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.LHost != "10.0.0.1" || config.LPort != 31337 {
+		t.Fatalf("Unexpected config %v", config)
+	}
+}
+
+func TestEventMessage(t *testing.T) {
+	beacon, _ := proto.Marshal(&clientpb.Beacon{ID: "abcd-1234", Name: "FOO", Hostname: "WS01"})
+	msg := eventMessage(&clientpb.Event{EventType: consts.BeaconRegisteredEvent, Data: beacon})
+	if !strings.HasPrefix(msg, "Beacon abcd FOO") || !strings.Contains(msg, "WS01") {
+		t.Fatalf("Unexpected beacon message %s", msg)
+	}
+	msg = eventMessage(&clientpb.Event{EventType: consts.BuildEvent})
+	if msg != "" {
+		t.Fatalf("Expected build events to not be relayed")
+	}
+	msg = eventMessage(&clientpb.Event{EventType: consts.FederationEvent, Data: []byte("[other] loop")})
+	if msg != "" {
+		t.Fatalf("Expected relayed events to not be relayed again")
+	}
+}
@@ -20,8 +20,12 @@ package generate
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/fs"
 	insecureRand "math/rand"
@@ -32,9 +36,11 @@ import (
 	"runtime"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/bishopfox/sliver/implant"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/assets"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
@@ -43,10 +49,12 @@ import (
 	"github.com/bishopfox/sliver/server/encoders"
 	"github.com/bishopfox/sliver/server/gogo"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/toolchains"
 	"github.com/bishopfox/sliver/util"
 	utilEncoders "github.com/bishopfox/sliver/util/encoders"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -83,8 +91,10 @@ var (
 		"darwin/arm64":  true,
 		"linux/386":     true,
 		"linux/amd64":   true,
+		"linux/arm64":   true,
 		"windows/386":   true,
 		"windows/amd64": true,
+		"windows/arm64": true,
 	}
 )
 
@@ -148,6 +158,8 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 	cfg.BeaconJitter = pbConfig.BeaconJitter
 
 	cfg.AgeServerPublicKey = pbConfig.AgeServerPublicKey
+	cfg.MLKEMServerPublicKey = pbConfig.MLKEMServerPublicKey
+	cfg.KeyExchange = pbConfig.KeyExchange
 	cfg.PeerPrivateKey = pbConfig.PeerPrivateKey
 	cfg.PeerPublicKey = pbConfig.PeerPublicKey
 
@@ -191,6 +203,26 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 	cfg.RunAtLoad = pbConfig.RunAtLoad
 	cfg.TrafficEncodersEnabled = pbConfig.TrafficEncodersEnabled
 	cfg.NetGoEnabled = pbConfig.NetGoEnabled
+	cfg.HTTPC2ConfigVersion = pbConfig.HTTPC2ConfigVersion
+	cfg.DNSResolvers = pbConfig.DNSResolvers
+	cfg.CompressionEnabled = pbConfig.CompressionEnabled
+	cfg.ConfigKeySource = pbConfig.ConfigKeySource
+	cfg.ConfigKeyLocation = pbConfig.ConfigKeyLocation
+	cfg.ConfigKey = pbConfig.ConfigKey
+	cfg.DeadManSwitchDays = pbConfig.DeadManSwitchDays
+	cfg.DeadManStateFile = pbConfig.DeadManStateFile
+	cfg.DeadManCleanup = pbConfig.DeadManCleanup
+	cfg.Reproducible = pbConfig.Reproducible
+	cfg.BuildSeed = pbConfig.BuildSeed
+	cfg.CCBackend = pbConfig.CCBackend
+	cfg.GlibcVersion = pbConfig.GlibcVersion
+	cfg.Marker = pbConfig.Marker
+	cfg.AdaptiveBeacon = pbConfig.AdaptiveBeacon
+	cfg.AdaptiveIdleThreshold = pbConfig.AdaptiveIdleThreshold
+	cfg.AdaptiveIdleFactor = pbConfig.AdaptiveIdleFactor
+	cfg.SPAEnabled = pbConfig.SPAEnabled
+	cfg.HTTPClientCert = pbConfig.HTTPClientCert
+	cfg.ArtifactMarker = pbConfig.ArtifactMarker
 
 	cfg.Assets = []models.EncoderAsset{}
 	for _, pbAsset := range pbConfig.Assets {
@@ -208,7 +240,7 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 
 	// Copy C2
 	cfg.C2 = copyC2List(pbConfig.C2)
-	cfg.MTLSc2Enabled = isC2Enabled([]string{"mtls"}, cfg.C2)
+	cfg.MTLSc2Enabled = isC2Enabled([]string{"mtls", "extc2"}, cfg.C2)
 	cfg.WGc2Enabled = isC2Enabled([]string{"wg"}, cfg.C2)
 	cfg.HTTPc2Enabled = isC2Enabled([]string{"http", "https"}, cfg.C2)
 	cfg.DNSc2Enabled = isC2Enabled([]string{"dns"}, cfg.C2)
@@ -285,6 +317,9 @@ func SliverShellcode(name string, config *models.ImplantConfig, save bool) (stri
 	if config.GOOS != "windows" {
 		return "", fmt.Errorf("shellcode format is currently only supported on Windows")
 	}
+	if config.GOARCH == "arm64" {
+		return "", fmt.Errorf("shellcode format is not supported on arm64")
+	}
 	appDir := assets.GetRootAppDir()
 	goConfig := &gogo.GoConfig{
 		CGO: "0",
@@ -300,6 +335,7 @@ func SliverShellcode(name string, config *models.ImplantConfig, save bool) (stri
 
 		Obfuscation: config.ObfuscateSymbols,
 		GOGARBLE:    goGarble(config),
+		GarbleSeed:  garbleSeed(config),
 	}
 	pkgPath, err := renderSliverGoCode(name, config, goConfig)
 	if err != nil {
@@ -353,13 +389,19 @@ func SliverSharedLibrary(name string, config *models.ImplantConfig, save bool) (
 
 	appDir := assets.GetRootAppDir()
 	// Don't use a cross-compiler if the target bin is built on the same platform
-	// as the sliver-server.
-	if runtime.GOOS != config.GOOS {
+	// as the sliver-server, unless the build uses zig (e.g. to link an older glibc)
+	if IsCrossCompiled(config.GOOS, config.GOARCH) || config.CCBackend == sliverpb.CCBackendZig {
 		buildLog.Debugf("Cross-compiling from %s/%s to %s/%s", runtime.GOOS, runtime.GOARCH, config.GOOS, config.GOARCH)
-		cc, cxx = findCrossCompilers(config.GOOS, config.GOARCH)
-		if cc == "" {
-			return "", fmt.Errorf("CC '%s/%s' not found", config.GOOS, config.GOARCH)
+		var err error
+		cc, cxx, err = requireCrossCompilers(config)
+		if err != nil {
+			return "", err
 		}
+		release, err := toolchains.Acquire(cc)
+		if err != nil {
+			return "", err
+		}
+		defer release()
 	}
 	goConfig := &gogo.GoConfig{
 		CGO: "1",
@@ -377,6 +419,7 @@ func SliverSharedLibrary(name string, config *models.ImplantConfig, save bool) (
 
 		Obfuscation: config.ObfuscateSymbols,
 		GOGARBLE:    goGarble(config),
+		GarbleSeed:  garbleSeed(config),
 	}
 	pkgPath, err := renderSliverGoCode(name, config, goConfig)
 	if err != nil {
@@ -402,10 +445,17 @@ func SliverSharedLibrary(name string, config *models.ImplantConfig, save bool) (
 	if !config.Debug && goConfig.GOOS == WINDOWS {
 		ldflags[0] += " -H=windowsgui"
 	}
-	// Statically link Linux .so files to avoid glibc hell
-	if goConfig.GOOS == LINUX && goConfig.CC != "" && goConfig.CGO == "1" {
+	// Statically link Linux .so files to avoid glibc hell, unless zig links them against an old glibc
+	if goConfig.GOOS == LINUX && goConfig.CC != "" && goConfig.CGO == "1" && config.GlibcVersion == "" {
 		ldflags[0] += " -linkmode external -extldflags \"-static\""
 	}
+	// The mingw linker stamps the link time into the PE header, zig's linker doesn't
+	if config.Reproducible && goConfig.GOOS == WINDOWS && !toolchains.IsZigCrossCompiler(goConfig.CC) {
+		ldflags[0] += " -extldflags=-Wl,--no-insert-timestamp"
+	}
+	if config.Reproducible && !config.ObfuscateSymbols {
+		ldflags[0] += " -buildid=" // Garble already strips the build id
+	}
 	// Keep those for potential later use
 	gcFlags := ""
 	asmFlags := ""
@@ -446,6 +496,7 @@ func SliverExecutable(name string, config *models.ImplantConfig, save bool) (str
 
 		Obfuscation: config.ObfuscateSymbols,
 		GOGARBLE:    goGarble(config),
+		GarbleSeed:  garbleSeed(config),
 	}
 
 	pkgPath, err := renderSliverGoCode(name, config, goConfig)
@@ -471,6 +522,13 @@ func SliverExecutable(name string, config *models.ImplantConfig, save bool) (str
 		gcFlags = "all=-N -l"
 		ldflags = []string{}
 	}
+	if config.Reproducible && !config.ObfuscateSymbols {
+		// Garble already strips the build id
+		if len(ldflags) == 0 {
+			ldflags = []string{""}
+		}
+		ldflags[0] += " -buildid="
+	}
 	_, err = gogo.GoBuild(*goConfig, pkgPath, dest, "", tags, ldflags, gcFlags, asmFlags)
 	if err != nil {
 		return "", err
@@ -523,6 +581,31 @@ func renderSliverGoCode(name string, config *models.ImplantConfig, goConfig *gog
 		return "", nil
 	}
 
+	// Listeners keep the routes of previous config versions, so implants can be
+	// generated with the version a profile was bound to
+	httpC2Config, err := configs.GetHTTPC2ConfigVersion(int(config.HTTPC2ConfigVersion))
+	if err != nil {
+		return "", err
+	}
+	rng := buildRand(config)
+	httpC2ImplantConfig := httpC2Config.RandomImplantConfig(rng)
+	userAgent := httpC2Config.GenerateUserAgent(config.GOOS, config.GOARCH, rng)
+
+	// The c2 urls and http c2 profile of implants with an encrypted config are only
+	// in the encrypted runtime config, so they're not rendered into the code
+	encryptedConfig := ""
+	if config.ConfigKeySource != "" {
+		// Provisionable implants get their encrypted config from a bundle instead
+		if config.ConfigKeySource != sliverpb.ConfigKeyFromBundle {
+			encryptedConfig, err = encryptRuntimeConfig(config, httpC2ImplantConfig, userAgent)
+			if err != nil {
+				return "", err
+			}
+		}
+		httpC2ImplantConfig = &configs.HTTPC2ImplantConfig{}
+		userAgent = ""
+	}
+
 	err = fs.WalkDir(implant.FS, ".", func(fsPath string, f fs.DirEntry, err error) error {
 		if f.IsDir() {
 			return nil
@@ -576,7 +659,10 @@ func renderSliverGoCode(name string, config *models.ImplantConfig, goConfig *gog
 		sliverCode := template.New("sliver")
 		sliverCode, err = sliverCode.Funcs(template.FuncMap{
 			"GenerateUserAgent": func() string {
-				return configs.GetHTTPC2Config().GenerateUserAgent(config.GOOS, config.GOARCH)
+				return userAgent
+			},
+			"ArtifactMarkerTag": func() string {
+				return artifactMarkerTag(config.Marker)
 			},
 		}).Parse(sliverGoCode)
 		if err != nil {
@@ -587,10 +673,12 @@ func renderSliverGoCode(name string, config *models.ImplantConfig, goConfig *gog
 			Name                string
 			Config              *models.ImplantConfig
 			HTTPC2ImplantConfig *configs.HTTPC2ImplantConfig
+			EncryptedConfig     string
 		}{
 			name,
 			config,
-			configs.GetHTTPC2Config().RandomImplantConfig(),
+			httpC2ImplantConfig,
+			encryptedConfig,
 		})
 		if err != nil {
 			buildLog.Errorf("Template execution error %s", err)
@@ -624,7 +712,7 @@ func renderSliverGoCode(name string, config *models.ImplantConfig, goConfig *gog
 	}
 
 	// Render encoder assets
-	renderNativeEncoderAssets(config, sliverPkgDir)
+	renderNativeEncoderAssets(config, sliverPkgDir, rng)
 	if config.TrafficEncodersEnabled {
 		renderTrafficEncoderAssets(config, sliverPkgDir)
 	}
@@ -667,6 +755,88 @@ func renderSliverGoCode(name string, config *models.ImplantConfig, goConfig *gog
 	return sliverPkgDir, nil
 }
 
+// encryptRuntimeConfig - Encrypt the c2 urls and http c2 profile of an implant with the
+// config key, the implant decrypts them using the key it gets at runtime
+func encryptRuntimeConfig(config *models.ImplantConfig, httpC2ImplantConfig *configs.HTTPC2ImplantConfig, userAgent string) (string, error) {
+	ciphertext, err := encryptConfig(config, newRuntimeConfig(config, httpC2ImplantConfig, userAgent))
+	if err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+func encryptConfig(config *models.ImplantConfig, runtimeConfig *sliverpb.RuntimeConfig) ([]byte, error) {
+	if config.ConfigKey == "" {
+		return nil, fmt.Errorf("encrypted config requires a config key")
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(runtimeConfig)
+	if err != nil {
+		return nil, err
+	}
+	// The salt is stored in front of the encrypted config, reproducible builds derive
+	// it from the build seed
+	salt := make([]byte, cryptography.ConfigKeySaltSize)
+	if config.Reproducible {
+		mac := hmac.New(sha256.New, []byte(config.BuildSeed))
+		mac.Write([]byte("config key salt"))
+		copy(salt, mac.Sum(nil))
+	} else if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key := cryptography.DeriveConfigKey(config.ConfigKey, salt)
+	var ciphertext []byte
+	if config.Reproducible {
+		ciphertext, err = cryptography.EncryptReproducible(key, config.BuildSeed, data)
+	} else {
+		ciphertext, err = cryptography.Encrypt(key, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(salt, ciphertext...), nil
+}
+
+func newRuntimeConfig(config *models.ImplantConfig, httpC2ImplantConfig *configs.HTTPC2ImplantConfig, userAgent string) *sliverpb.RuntimeConfig {
+	runtimeConfig := &sliverpb.RuntimeConfig{
+		HTTP: &sliverpb.HTTPC2Profile{
+			UserAgent:      userAgent,
+			NonceQueryArgs: httpC2ImplantConfig.NonceQueryArgs,
+
+			PollPaths:   httpC2ImplantConfig.PollPaths,
+			PollFiles:   httpC2ImplantConfig.PollFiles,
+			PollFileExt: httpC2ImplantConfig.PollFileExt,
+
+			SessionPaths:        httpC2ImplantConfig.SessionPaths,
+			SessionFiles:        httpC2ImplantConfig.SessionFiles,
+			SessionFileExt:      httpC2ImplantConfig.SessionFileExt,
+			StartSessionFileExt: httpC2ImplantConfig.StartSessionFileExt,
+
+			ClosePaths:   httpC2ImplantConfig.ClosePaths,
+			CloseFiles:   httpC2ImplantConfig.CloseFiles,
+			CloseFileExt: httpC2ImplantConfig.CloseFileExt,
+		},
+	}
+	for _, c2 := range config.C2 {
+		runtimeConfig.C2 = append(runtimeConfig.C2, c2.URL)
+	}
+	for _, header := range httpC2ImplantConfig.Headers {
+		runtimeConfig.HTTP.Headers = append(runtimeConfig.HTTP.Headers, &sliverpb.HTTPC2NameValue{
+			Name:        header.Name,
+			Value:       header.Value,
+			Probability: int32(header.Probability),
+			Methods:     header.Methods,
+		})
+	}
+	for _, param := range httpC2ImplantConfig.URLParameters {
+		runtimeConfig.HTTP.URLParameters = append(runtimeConfig.HTTP.URLParameters, &sliverpb.HTTPC2NameValue{
+			Name:        param.Name,
+			Value:       param.Value,
+			Probability: int32(param.Probability),
+		})
+	}
+	return runtimeConfig
+}
+
 // renderTrafficEncoderAssets - Copies and compresses any enabled WASM traffic encoders
 func renderTrafficEncoderAssets(config *models.ImplantConfig, sliverPkgDir string) {
 	buildLog.Infof("Rendering traffic encoder assets ...")
@@ -693,12 +863,12 @@ func renderTrafficEncoderAssets(config *models.ImplantConfig, sliverPkgDir strin
 }
 
 // renderNativeEncoderAssets - Render native encoder assets such as the english dictionary file
-func renderNativeEncoderAssets(config *models.ImplantConfig, sliverPkgDir string) {
+func renderNativeEncoderAssets(config *models.ImplantConfig, sliverPkgDir string, rng *insecureRand.Rand) {
 	buildLog.Infof("Rendering native encoder assets ...")
 	encoderAssetsPath := filepath.Join(sliverPkgDir, "implant", "sliver", "encoders", "assets")
 
 	// English assets
-	dictionary := renderImplantEnglish()
+	dictionary := renderImplantEnglish(rng)
 	dictionaryPath := filepath.Join(encoderAssetsPath, "english.gz")
 	data := []byte(strings.Join(dictionary, "\n"))
 	compressedData, _ := encoders.Gzip.Encode(data)
@@ -713,11 +883,11 @@ func renderNativeEncoderAssets(config *models.ImplantConfig, sliverPkgDir string
 // renderImplantEnglish - Render the english dictionary file, ensures that the returned dictionary
 // contains at least one word that will encode to a given byte value (0-255). There is also a default
 // dictionary that we'll try to overwrite (the default one is in the git repo).
-func renderImplantEnglish() []string {
+func renderImplantEnglish(rng *insecureRand.Rand) []string {
 	allWords := assets.English() // 178,543 words -> server/assets/fs/english.txt
 	meRiCaN := cases.Title(language.AmericanEnglish)
 	for i := 0; i < len(allWords); i++ {
-		switch insecureRand.Intn(3) {
+		switch rng.Intn(3) {
 		case 0:
 			allWords[i] = strings.ToUpper(allWords[i])
 		case 1:
@@ -737,7 +907,7 @@ func renderImplantEnglish() []string {
 
 	// Shuffle the words for each byte value
 	for byteValue := 0; byteValue < 256; byteValue++ {
-		insecureRand.Shuffle(len(allWordsDictionary[byteValue]), func(i, j int) {
+		rng.Shuffle(len(allWordsDictionary[byteValue]), func(i, j int) {
 			allWordsDictionary[byteValue][i], allWordsDictionary[byteValue][j] = allWordsDictionary[byteValue][j], allWordsDictionary[byteValue][i]
 		})
 	}
@@ -758,7 +928,7 @@ func renderImplantEnglish() []string {
 func GenerateConfig(name string, config *models.ImplantConfig, save bool) error {
 	var err error
 
-	config.MTLSc2Enabled = isC2Enabled([]string{"mtls"}, config.C2)
+	config.MTLSc2Enabled = isC2Enabled([]string{"mtls", "extc2"}, config.C2)
 	config.WGc2Enabled = isC2Enabled([]string{"wg"}, config.C2)
 	config.HTTPc2Enabled = isC2Enabled([]string{"http", "https"}, config.C2)
 	config.DNSc2Enabled = isC2Enabled([]string{"dns"}, config.C2)
@@ -785,17 +955,88 @@ func GenerateConfig(name string, config *models.ImplantConfig, save bool) error
 	config.PeerPublicKeySignature = cryptography.MinisignServerSign([]byte(implantKeyPair.Public))
 	config.AgeServerPublicKey = serverKeyPair.Public
 	config.MinisignServerPublicKey = cryptography.MinisignServerPublicKey()
+	if config.KeyExchange == "" {
+		config.KeyExchange = sliverpb.DefaultKeyExchange
+	}
+	switch config.KeyExchange {
+	case sliverpb.KeyExchangeX25519:
+	case sliverpb.KeyExchangeX25519MLKEM768:
+		config.MLKEMServerPublicKey, err = cryptography.MLKEMServerPublicKey()
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid key exchange: %s", config.KeyExchange)
+	}
+	switch config.ConfigKeySource {
+	case "", sliverpb.ConfigKeyFromArg:
+	case sliverpb.ConfigKeyFromBundle:
+		err = validateProvisionable(config)
+		if err != nil {
+			return err
+		}
+	case sliverpb.ConfigKeyFromEnv, sliverpb.ConfigKeyFromFile, sliverpb.ConfigKeyFromURL:
+		if config.ConfigKeyLocation == "" || strings.Contains(config.ConfigKeyLocation, "`") {
+			return fmt.Errorf("invalid config key location: %q", config.ConfigKeyLocation)
+		}
+	default:
+		return fmt.Errorf("invalid config key source: %s", config.ConfigKeySource)
+	}
+	err = validateDeadManSwitch(config)
+	if err != nil {
+		return err
+	}
+	err = validateReproducible(config)
+	if err != nil {
+		return err
+	}
+	err = validateCCBackend(config)
+	if err != nil {
+		return err
+	}
+	err = validateAdaptiveBeacon(config)
+	if err != nil {
+		return err
+	}
+	config.Marker = ""
+	if config.ArtifactMarker {
+		config.Marker, err = newArtifactMarker()
+		if err != nil {
+			return err
+		}
+	}
 
-	// MTLS keys
-	if config.MTLSc2Enabled {
+	// MTLS keys, also the client certificate of https c2s
+	if config.HTTPClientCert && !config.HTTPc2Enabled {
+		return errors.New("an http client certificate requires an http(s) c2")
+	}
+	if config.MTLSc2Enabled || config.HTTPClientCert {
 		config.MtlsCACert = string(serverCACert)
 		config.MtlsCert = string(sliverCert)
 		config.MtlsKey = string(sliverKey)
 	}
+	if config.SPAEnabled {
+		if !config.MTLSc2Enabled {
+			return errors.New("single packet authorization requires an mtls c2")
+		}
+		spaKey, err := cryptography.SPAServerKey()
+		if err != nil {
+			return err
+		}
+		config.SPAKey = hex.EncodeToString(spaKey)
+	}
 
 	// Generate wg Keys as needed
 	if config.WGc2Enabled {
-		implantPrivKey, _, err := certs.ImplantGenerateWGKeys(config.WGPeerTunIP)
+		// Hybrid implants also get a preshared key, which WireGuard mixes into its handshake
+		presharedKey := ""
+		if config.KeyExchange == sliverpb.KeyExchangeX25519MLKEM768 {
+			presharedKey, err = certs.GenerateWGPresharedKey()
+			if err != nil {
+				return err
+			}
+		}
+		implantPrivKey, _, err := certs.ImplantGenerateWGKeys(config.WGPeerTunIP, presharedKey)
 		if err != nil {
 			return err
 		}
@@ -805,6 +1046,7 @@ func GenerateConfig(name string, config *models.ImplantConfig, save bool) error
 		}
 		config.WGImplantPrivKey = implantPrivKey
 		config.WGServerPubKey = serverPubKey
+		config.WGPresharedKey = presharedKey
 	}
 
 	if save {
@@ -816,6 +1058,60 @@ func GenerateConfig(name string, config *models.ImplantConfig, save bool) error
 	return nil
 }
 
+// validateDeadManSwitch - The state file and cleanup entries are rendered into raw
+// strings, and registry values can only be removed on windows
+func validateDeadManSwitch(config *models.ImplantConfig) error {
+	if config.DeadManSwitchDays == 0 {
+		return nil
+	}
+	if strings.Contains(config.DeadManStateFile, "`") {
+		return fmt.Errorf("invalid dead man's switch state file: %q", config.DeadManStateFile)
+	}
+	for _, entry := range strings.Split(config.DeadManCleanup, "\n") {
+		if entry == "" {
+			continue
+		}
+		valid := !strings.Contains(entry, "`")
+		switch {
+		case entry == sliverpb.DeadManCleanupSelf:
+			// The executable of a shared library or shellcode is its host process
+			valid = !config.IsSharedLib && !config.IsShellcode
+		case strings.HasPrefix(entry, sliverpb.DeadManCleanupFile):
+			valid = valid && len(sliverpb.DeadManCleanupFile) < len(entry)
+		case strings.HasPrefix(entry, sliverpb.DeadManCleanupRegistry):
+			valid = valid && config.GOOS == "windows" && strings.Contains(entry, "\\")
+		default:
+			valid = false
+		}
+		if !valid {
+			return fmt.Errorf("invalid dead man's switch cleanup: %q", entry)
+		}
+	}
+	return nil
+}
+
+// validateAdaptiveBeacon - Only beacons have an interval to adapt, the defaults sleep four
+// times longer after five minutes without user input
+func validateAdaptiveBeacon(config *models.ImplantConfig) error {
+	if !config.AdaptiveBeacon {
+		return nil
+	}
+	if !config.IsBeacon {
+		return errors.New("adaptive beaconing requires a beacon")
+	}
+	if config.AdaptiveIdleThreshold == 0 {
+		config.AdaptiveIdleThreshold = int64(5 * time.Minute)
+	}
+	if config.AdaptiveIdleFactor == 0 {
+		config.AdaptiveIdleFactor = 4
+	}
+	if config.AdaptiveIdleThreshold < int64(time.Minute) || 100 < config.AdaptiveIdleFactor {
+		return fmt.Errorf("invalid adaptive beacon idle threshold %s or factor %d",
+			time.Duration(config.AdaptiveIdleThreshold), config.AdaptiveIdleFactor)
+	}
+	return nil
+}
+
 // Platform specific ENV VARS take precedence over generic
 func getCrossCompilersFromEnv(targetGoos string, targetGoarch string) (string, string) {
 	var cc string
@@ -851,7 +1147,18 @@ func getCrossCompilersFromEnv(targetGoos string, targetGoarch string) (string, s
 	return cc, cxx
 }
 
+// findCrossCompilers - The system's cross-compilers of a target, or those of an
+// installed zig if the system has none
 func findCrossCompilers(targetGoos string, targetGoarch string) (string, string) {
+	cc, cxx := findSystemCrossCompilers(targetGoos, targetGoarch)
+	if cc == "" {
+		cc, cxx = toolchains.CrossCompiler(targetGoos, targetGoarch)
+		buildLog.Debugf("zig CC = '%s'", cc)
+	}
+	return cc, cxx
+}
+
+func findSystemCrossCompilers(targetGoos string, targetGoarch string) (string, string) {
 	var found bool
 
 	// Get CC and CXX from ENV
@@ -868,6 +1175,12 @@ func findCrossCompilers(targetGoos string, targetGoarch string) (string, string)
 		} else {
 			buildLog.Debugf("No default paths for %s runtime", runtime.GOOS)
 		}
+		if cc != "" {
+			if _, err := os.Stat(cc); os.IsNotExist(err) {
+				buildLog.Debugf("Default CC path '%s' does not exist", cc)
+				cc = ""
+			}
+		}
 	}
 
 	// Check to see if CC and CXX exist
@@ -886,6 +1199,70 @@ func findCrossCompilers(targetGoos string, targetGoarch string) (string, string)
 	return cc, cxx
 }
 
+// requireCrossCompilers - Find the cross-compilers of a build's cc backend, installing
+// zig if there are none, so builds don't fail with cgo errors from a missing compiler
+func requireCrossCompilers(config *models.ImplantConfig) (string, string, error) {
+	var cc, cxx string
+	switch config.CCBackend {
+	case sliverpb.CCBackendZig:
+		var err error
+		cc, cxx, err = toolchains.ZigCrossCompiler(config.GOOS, config.GOARCH, config.GlibcVersion)
+		if errors.Is(err, toolchains.ErrNotInstalled) && toolchains.AutoInstall() {
+			installToolchain(config)
+			cc, cxx, err = toolchains.ZigCrossCompiler(config.GOOS, config.GOARCH, config.GlibcVersion)
+		}
+		if err != nil {
+			return "", "", err
+		}
+		return cc, cxx, nil
+	case sliverpb.CCBackendSystem:
+		cc, cxx = findSystemCrossCompilers(config.GOOS, config.GOARCH)
+	default:
+		cc, cxx = findCrossCompilers(config.GOOS, config.GOARCH)
+		if cc == "" && toolchains.AutoInstall() {
+			installToolchain(config)
+			cc, cxx = findCrossCompilers(config.GOOS, config.GOARCH)
+		}
+	}
+	if cc == "" {
+		envVar := SliverPlatformCC64EnvVar
+		if config.GOARCH == "386" {
+			envVar = SliverPlatformCC32EnvVar
+		}
+		return "", "", fmt.Errorf("no cross-compiler for %s/%s, install one with 'toolchains install' or set %s",
+			config.GOOS, config.GOARCH, fmt.Sprintf(envVar, strings.ToUpper(config.GOOS)))
+	}
+	return cc, cxx, nil
+}
+
+func installToolchain(config *models.ImplantConfig) {
+	buildLog.Infof("No CC for %s/%s, installing a toolchain", config.GOOS, config.GOARCH)
+	_, err := toolchains.Install("")
+	if err != nil {
+		buildLog.Errorf("Failed to install toolchain: %s", err)
+	}
+}
+
+// validateCCBackend - Only zig can link against an older glibc than the builder's
+func validateCCBackend(config *models.ImplantConfig) error {
+	switch config.CCBackend {
+	case "", sliverpb.CCBackendSystem, sliverpb.CCBackendZig:
+	default:
+		return fmt.Errorf("invalid cc backend: %s", config.CCBackend)
+	}
+	if config.GlibcVersion != "" && (config.CCBackend != sliverpb.CCBackendZig || config.GOOS != LINUX) {
+		return errors.New("a glibc version requires the zig cc backend and a linux target")
+	}
+	return nil
+}
+
+// IsCrossCompiled - Shared libraries of the target need a cross-compiler, the system's
+// compilers only target the server's os, and can't target arm64 from another arch or
+// the other way around
+func IsCrossCompiled(targetGoos string, targetGoarch string) bool {
+	return runtime.GOOS != targetGoos || (runtime.GOARCH == "arm64") != (targetGoarch == "arm64")
+}
+
 // GetCompilerTargets - This function attempts to determine what we can reasonably target
 func GetCompilerTargets() []*clientpb.CompilerTarget {
 	targets := []*clientpb.CompilerTarget{}
@@ -905,7 +1282,7 @@ func GetCompilerTargets() []*clientpb.CompilerTarget {
 		platform := strings.SplitN(longPlatform, "/", 2)
 
 		// We can always build our own platform
-		if runtime.GOOS == platform[0] {
+		if !IsCrossCompiled(platform[0], platform[1]) {
 			targets = append(targets, &clientpb.CompilerTarget{
 				GOOS:   platform[0],
 				GOARCH: platform[1],
@@ -945,10 +1322,10 @@ func GetCompilerTargets() []*clientpb.CompilerTarget {
 		})
 	}
 
-	// SHELLCODE - Can generate shellcode for Windows targets only
+	// SHELLCODE - Can generate shellcode for Windows targets only, donut has no arm64 loader
 	for longPlatform := range SupportedCompilerTargets {
 		platform := strings.SplitN(longPlatform, "/", 2)
-		if platform[0] != WINDOWS {
+		if platform[0] != WINDOWS || platform[1] == "arm64" {
 			continue
 		}
 
@@ -967,7 +1344,7 @@ func GetCrossCompilers() []*clientpb.CrossCompiler {
 	compilers := []*clientpb.CrossCompiler{}
 	for longPlatform := range SupportedCompilerTargets {
 		platform := strings.SplitN(longPlatform, "/", 2)
-		if runtime.GOOS == platform[0] {
+		if !IsCrossCompiled(platform[0], platform[1]) {
 			continue
 		}
 		cc, cxx := findCrossCompilers(platform[0], platform[1])
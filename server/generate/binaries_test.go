@@ -20,7 +20,9 @@ package generate
 
 import (
 	"fmt"
+	"reflect"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
@@ -464,3 +466,22 @@ func symbolObfuscation(t *testing.T, goos string, goarch string) {
 		t.Fatalf("%v", err)
 	}
 }
+
+func TestArtifactMarker(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 64; i++ {
+		marker, err := newArtifactMarker()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(marker) < ArtifactMarkerMinSize || seen[marker] {
+			t.Fatalf("unexpected marker %q", marker)
+		}
+		seen[marker] = true
+		tag := reflect.StructTag(artifactMarkerTag(marker))
+		key := strings.SplitN(string(tag), ":", 2)[0]
+		if tag.Get(key) != marker || artifactMarkerTag(marker) != string(tag) {
+			t.Fatalf("marker %q not embedded in tag %s", marker, tag)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+var (
+	// ErrNotProvisionable - The implant build doesn't take a provisioning bundle
+	ErrNotProvisionable = errors.New("implant build is not provisionable")
+
+	// c2Transports - Schemes of the c2s that are compiled in together
+	c2Transports = [][]string{{"mtls", "extc2"}, {"wg"}, {"http", "https"}, {"dns"}, {"namedpipe"}, {"tcppivot"}}
+)
+
+// validateProvisionable - The bundle is appended to the executable and decrypted with
+// the embedded config key, wireguard peers need a tunnel ip assigned by the client
+func validateProvisionable(config *models.ImplantConfig) error {
+	if config.ConfigKey == "" || config.ConfigKeyLocation != "" {
+		return errors.New("provisionable implants need an embedded config key")
+	}
+	if config.IsSharedLib || config.IsShellcode {
+		return errors.New("provisionable implants must be executables")
+	}
+	if config.WGc2Enabled {
+		return errors.New("provisionable implants don't support wireguard c2")
+	}
+	return nil
+}
+
+// ProvisioningBundle - Generate the c2 config and keys of a new implant for a provisionable
+// build, encrypted with the build's config key. Appending the bundle to the build's
+// executable results in the new implant, which is saved as a build of its own so the
+// server knows its keys. The http c2 profile is only in the bundle, so a version other
+// than the build's can be used (0 keeps the build's).
+//
+// The build's config key is in plaintext in the generic executable, so the encryption
+// only keeps the bundle from being read on its own: anyone with the generic executable
+// can decrypt every bundle generated for it, bundles are not confidential
+func ProvisioningBundle(build *models.ImplantBuild, name string, c2s []*clientpb.ImplantC2, httpC2Version int32) ([]byte, error) {
+	if build.ImplantConfig.ConfigKeySource != sliverpb.ConfigKeyFromBundle {
+		return nil, ErrNotProvisionable
+	}
+	if len(c2s) == 0 {
+		return nil, errors.New("provisioning bundle requires at least one c2")
+	}
+	executable, err := ImplantFileFromBuild(build)
+	if err != nil {
+		return nil, err
+	}
+
+	_, config := ImplantConfigFromProtobuf(build.ImplantConfig.ToProtobuf())
+	config.C2 = copyC2List(c2s)
+	for _, schemes := range c2Transports {
+		if isC2Enabled(schemes, config.C2) && !isC2Enabled(schemes, build.ImplantConfig.C2) {
+			return nil, fmt.Errorf("%s c2 is not compiled into %s", schemes[0], build.Name)
+		}
+	}
+	if httpC2Version != 0 {
+		config.HTTPC2ConfigVersion = httpC2Version
+	}
+	httpC2Config, err := configs.GetHTTPC2ConfigVersion(int(config.HTTPC2ConfigVersion))
+	if err != nil {
+		return nil, err
+	}
+	err = GenerateConfig(name, config, true)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := buildRand(config)
+	userAgent := httpC2Config.GenerateUserAgent(config.GOOS, config.GOARCH, rng)
+	runtimeConfig := newRuntimeConfig(config, httpC2Config.RandomImplantConfig(rng), userAgent)
+	runtimeConfig.Name = name
+	runtimeConfig.Keys = &sliverpb.ImplantKeys{
+		PeerPublicKey:           config.PeerPublicKey,
+		PeerPrivateKey:          config.PeerPrivateKey,
+		PeerPublicKeySignature:  config.PeerPublicKeySignature,
+		AgeServerPublicKey:      config.AgeServerPublicKey,
+		MinisignServerPublicKey: config.MinisignServerPublicKey,
+		MLKEMServerPublicKey:    config.MLKEMServerPublicKey,
+
+		MtlsCACert: config.MtlsCACert,
+		MtlsCert:   config.MtlsCert,
+		MtlsKey:    config.MtlsKey,
+	}
+	ciphertext, err := encryptConfig(config, runtimeConfig)
+	if err != nil {
+		return nil, err
+	}
+	// The implant finds the start of the bundle using the length at the end of the file
+	bundle := binary.BigEndian.AppendUint32(ciphertext, uint32(len(ciphertext)))
+
+	buildDir, err := os.MkdirTemp(GetSliversDir(), "bundle")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(buildDir)
+	config.FileName = filepath.Base(name) + filepath.Ext(build.ImplantConfig.FileName)
+	fPath := filepath.Join(buildDir, config.FileName)
+	err = os.WriteFile(fPath, append(executable, bundle...), 0600)
+	if err != nil {
+		return nil, err
+	}
+	err = ImplantBuildSave(name, config, fPath)
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
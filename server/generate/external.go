@@ -19,8 +19,12 @@ package generate
 */
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/db/models"
+	"google.golang.org/protobuf/proto"
 )
 
 // SliverExternal - Generates the cryptographic keys for the implant but compiles no code
@@ -33,3 +37,18 @@ func SliverExternal(name string, config *models.ImplantConfig) (*clientpb.Extern
 		Config: config.ToProtobuf(),
 	}, nil
 }
+
+// ExternalConfigHash - Hash of the operator supplied implant config, requests with the
+// same hash produce functionally identical implants so the hash is used to deduplicate
+// and cache external builds. Server generated values (ID, keys, etc.) are not included.
+func ExternalConfigHash(config *clientpb.ImplantConfig) (string, error) {
+	hashConfig := proto.Clone(config).(*clientpb.ImplantConfig)
+	hashConfig.ID = ""
+	hashConfig.FileName = ""
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(hashConfig)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:]), nil
+}
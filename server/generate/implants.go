@@ -71,6 +71,12 @@ func ImplantConfigSave(config *models.ImplantConfig) error {
 
 // ImplantBuildSave - Saves a binary file into the database
 func ImplantBuildSave(name string, config *models.ImplantConfig, fPath string) error {
+	return ImplantBuildSaveFrom(name, config, fPath, ServerBuilder, ServerToolchain())
+}
+
+// ImplantBuildSaveFrom - Saves a binary file into the database, along with a provenance
+// record of the builder and toolchain that produced it
+func ImplantBuildSaveFrom(name string, config *models.ImplantConfig, fPath string, builder string, toolchain string) error {
 	rootAppDir, _ := filepath.Abs(assets.GetRootAppDir())
 	fPath, _ = filepath.Abs(fPath)
 	if !strings.HasPrefix(fPath, rootAppDir) {
@@ -100,7 +106,18 @@ func ImplantBuildSave(name string, config *models.ImplantConfig, fPath string) e
 		return result.Error
 	}
 	storageLog.Infof("%s -> %s", implantBuild.ID, implantBuild.Name)
-	return os.WriteFile(filepath.Join(buildsDir, implantBuild.ID.String()), data, 0600)
+	err = os.WriteFile(filepath.Join(buildsDir, implantBuild.ID.String()), data, 0600)
+	if err != nil {
+		return err
+	}
+	return RecordProvenance(&models.ArtifactProvenance{
+		Name:        name,
+		Kind:        ArtifactImplant,
+		ProfileName: ImplantProfileName(config),
+		Builder:     builder,
+		Toolchain:   toolchain,
+		Config:      ImplantProvenanceConfig(config),
+	}, data)
 }
 
 func computeHashes(data []byte) (string, string, string) {
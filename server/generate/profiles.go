@@ -21,30 +21,86 @@ package generate
 import (
 	"errors"
 
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
-// SaveImplantProfile - Save a sliver profile to disk
-func SaveImplantProfile(name string, config *models.ImplantConfig) error {
+// SaveImplantProfile - Save a sliver profile to disk, every change to the profile is
+// kept as a new version and the current version number is returned
+func SaveImplantProfile(name string, config *models.ImplantConfig) (uint32, error) {
 
 	profile, err := db.ImplantProfileByName(name)
 	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
-		return err
+		return 0, err
 	}
 
+	// The name of the last build is saved back to the profile, which on its own
+	// doesn't make a new version
+	pbConfig := config.ToProtobuf()
+	pbConfig.ID = ""
+	pbConfig.Name = ""
+	snapshot, err := protojson.Marshal(pbConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	version := uint32(1)
 	dbSession := db.Session()
 	if errors.Is(err, db.ErrRecordNotFound) {
 		err = dbSession.Create(&models.ImplantProfile{
 			Name:          name,
+			Version:       version,
 			ImplantConfig: config,
 		}).Error
 	} else {
+		version = profile.Version + 1
+		latest, lookupErr := db.ImplantProfileVersionByName(name, profile.Version)
+		if lookupErr == nil && sameProfileConfig(latest, snapshot) {
+			version = profile.Version
+		}
 		err = dbSession.Save(&models.ImplantProfile{
 			ID:            profile.ID,
 			Name:          name,
+			Version:       version,
 			ImplantConfig: config,
 		}).Error
 	}
-	return err
+	if err != nil {
+		return 0, err
+	}
+	if profile != nil && version == profile.Version {
+		return version, nil
+	}
+	err = dbSession.Create(&models.ImplantProfileVersion{
+		ProfileName: name,
+		Version:     version,
+		Config:      string(snapshot),
+	}).Error
+	return version, err
+}
+
+// sameProfileConfig - protojson output isn't stable so compare the decoded messages
+func sameProfileConfig(profileVersion *models.ImplantProfileVersion, snapshot []byte) bool {
+	saved, err := ImplantProfileVersionConfig(profileVersion)
+	if err != nil {
+		return false
+	}
+	config := &clientpb.ImplantConfig{}
+	if protojson.Unmarshal(snapshot, config) != nil {
+		return false
+	}
+	return proto.Equal(saved, config)
+}
+
+// ImplantProfileVersionConfig - Decode the config saved with a profile version
+func ImplantProfileVersionConfig(profileVersion *models.ImplantProfileVersion) (*clientpb.ImplantConfig, error) {
+	config := &clientpb.ImplantConfig{}
+	err := protojson.Unmarshal([]byte(profileVersion.Config), config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
 }
@@ -0,0 +1,152 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/client/version"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/gogo"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ArtifactImplant - Provenance kind for implant builds
+	ArtifactImplant = "implant"
+	// ArtifactStager - Provenance kind for stagers
+	ArtifactStager = "stager"
+
+	// ServerBuilder - Builder name for artifacts built by the server itself
+	ServerBuilder = "server"
+
+	// ArtifactMarkerMinSize - Fewest random bytes in an artifact marker, the rest of the
+	// marker's size and its encoding are random too so markers don't share a pattern
+	ArtifactMarkerMinSize = 8
+	// ArtifactMarkerMaxSize - Most random bytes in an artifact marker
+	ArtifactMarkerMaxSize = 20
+)
+
+var (
+	// artifactMarkerKeys - Struct tag keys a marker can be embedded with
+	artifactMarkerKeys = []string{"etag", "rev", "ref", "hash", "digest", "sum", "id", "key", "build", "version"}
+
+	// artifactMarkerEncodings - Encodings a marker can use
+	artifactMarkerEncodings = []func([]byte) string{
+		hex.EncodeToString,
+		base64.RawURLEncoding.EncodeToString,
+		func(data []byte) string {
+			return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data))
+		},
+	}
+
+	serverToolchain     string
+	serverToolchainOnce = &sync.Once{}
+)
+
+// ServerToolchain - Versions of the tools used by this process to build implants
+func ServerToolchain() string {
+	serverToolchainOnce.Do(func() {
+		appDir := assets.GetRootAppDir()
+		goVersion, err := gogo.GoVersion(gogo.GoConfig{
+			GOOS:       runtime.GOOS,
+			GOARCH:     runtime.GOARCH,
+			GOCACHE:    gogo.GetGoCache(appDir),
+			GOMODCACHE: gogo.GetGoModCache(appDir),
+			GOROOT:     gogo.GetGoRootDir(appDir),
+		})
+		toolchain := fmt.Sprintf("sliver %s (%s %s/%s)", version.FullVersion(), runtime.Version(), runtime.GOOS, runtime.GOARCH)
+		if err == nil {
+			toolchain += ", " + strings.TrimSpace(string(goVersion))
+		}
+		serverToolchain = toolchain
+	})
+	return serverToolchain
+}
+
+// ImplantProvenanceConfig - JSON encoding of an implant config suitable for a provenance
+// record, private keys and embedded assets are omitted.
+func ImplantProvenanceConfig(config *models.ImplantConfig) string {
+	pbConfig := proto.Clone(config.ToProtobuf()).(*clientpb.ImplantConfig)
+	pbConfig.MtlsKey = ""
+	pbConfig.PeerPrivateKey = ""
+	pbConfig.WGImplantPrivKey = ""
+	pbConfig.Assets = nil
+	data, _ := protojson.Marshal(pbConfig)
+	return string(data)
+}
+
+// ImplantProfileName - Name of the profile an implant config was created from, if any
+func ImplantProfileName(config *models.ImplantConfig) string {
+	profile := &models.ImplantProfile{}
+	err := db.Session().Where(&models.ImplantProfile{ID: config.ImplantProfileID}).First(profile).Error
+	if err != nil {
+		return ""
+	}
+	return profile.Name
+}
+
+// RecordProvenance - Sign and save a provenance record for a generated artifact
+func RecordProvenance(provenance *models.ArtifactProvenance, data []byte) error {
+	digest := sha256.Sum256(data)
+	provenance.SHA256 = hex.EncodeToString(digest[:])
+	provenance.Signature = cryptography.ArtifactSign(provenance.SignedRecord())
+	err := db.Session().Create(provenance).Error
+	if err != nil {
+		return err
+	}
+	storageLog.Infof("Recorded provenance for %s %s (sha256 %s)", provenance.Kind, provenance.Name, provenance.SHA256)
+	return nil
+}
+
+// newArtifactMarker - A marker that's unique to a single build, implants embed it in the
+// struct tags of a type garble never obfuscates so it can be found in obfuscated samples
+func newArtifactMarker() (string, error) {
+	choice := make([]byte, 2)
+	if _, err := rand.Read(choice); err != nil {
+		return "", err
+	}
+	size := ArtifactMarkerMinSize + int(choice[0])%(ArtifactMarkerMaxSize-ArtifactMarkerMinSize+1)
+	marker := make([]byte, size)
+	if _, err := rand.Read(marker); err != nil {
+		return "", err
+	}
+	return artifactMarkerEncodings[int(choice[1])%len(artifactMarkerEncodings)](marker), nil
+}
+
+// artifactMarkerTag - The struct tag a marker is embedded with, the key depends on the
+// marker so it can be rendered again from the implant config
+func artifactMarkerTag(marker string) string {
+	digest := sha256.Sum256([]byte(marker))
+	key := artifactMarkerKeys[int(digest[0])%len(artifactMarkerKeys)]
+	return fmt.Sprintf("%s:%q", key, marker)
+}
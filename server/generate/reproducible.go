@@ -0,0 +1,111 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	insecureRand "math/rand"
+	"os"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+var (
+	// ErrNotReproducible - The implant build wasn't generated as a reproducible build
+	ErrNotReproducible = errors.New("implant build is not reproducible")
+)
+
+// validateReproducible - Shellcode is encrypted by donut with random keys, and canary
+// domains are generated (and saved) for every build, so neither can be reproduced
+func validateReproducible(config *models.ImplantConfig) error {
+	if !config.Reproducible {
+		return nil
+	}
+	if config.Format == clientpb.OutputFormat_SHELLCODE {
+		return fmt.Errorf("%w: shellcode builds cannot be reproduced", ErrNotReproducible)
+	}
+	if 0 < len(config.CanaryDomains) {
+		return fmt.Errorf("%w: canary domains cannot be reproduced", ErrNotReproducible)
+	}
+	if config.BuildSeed == "" {
+		seed := make([]byte, 32)
+		if _, err := rand.Read(seed); err != nil {
+			return err
+		}
+		config.BuildSeed = hex.EncodeToString(seed)
+	}
+	return nil
+}
+
+// buildRand - The source of everything that's randomized while rendering an implant,
+// seeded by the build seed of reproducible builds
+func buildRand(config *models.ImplantConfig) *insecureRand.Rand {
+	var seed int64
+	if config.Reproducible {
+		digest := sha256.Sum256([]byte("render:" + config.BuildSeed))
+		seed = int64(binary.LittleEndian.Uint64(digest[:8]))
+	} else {
+		binary.Read(rand.Reader, binary.LittleEndian, &seed)
+	}
+	return insecureRand.New(insecureRand.NewSource(seed))
+}
+
+// garbleSeed - Garble's -seed flag, garble picks a random seed unless the build is reproducible
+func garbleSeed(config *models.ImplantConfig) string {
+	if !config.Reproducible {
+		return "random"
+	}
+	digest := sha256.Sum256([]byte("garble:" + config.BuildSeed))
+	return base64.RawStdEncoding.EncodeToString(digest[:])
+}
+
+// RebuildSHA256 - Rebuild a reproducible implant from its saved config, and return
+// the sha256 of the rebuilt file. The build is not saved.
+func RebuildSHA256(build *models.ImplantBuild) (string, error) {
+	config := build.ImplantConfig
+	if !config.Reproducible {
+		return "", ErrNotReproducible
+	}
+	var fPath string
+	var err error
+	switch config.Format {
+	case clientpb.OutputFormat_SERVICE, clientpb.OutputFormat_EXECUTABLE:
+		fPath, err = SliverExecutable(build.Name, &config, false)
+	case clientpb.OutputFormat_SHARED_LIB:
+		fPath, err = SliverSharedLibrary(build.Name, &config, false)
+	default:
+		return "", fmt.Errorf("%w: %s", ErrNotReproducible, config.Format)
+	}
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(fPath)
+	if err != nil {
+		return "", err
+	}
+	_, _, sha256Hash := computeHashes(data)
+	return sha256Hash, nil
+}
@@ -0,0 +1,140 @@
+package geoip
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	reverseDNSTimeout = 2 * time.Second
+	enrichCacheTTL    = time.Hour
+	enrichCacheSize   = 4096
+)
+
+var (
+	enrichCache = &addressCache{
+		entries: map[string]*addressCacheEntry{},
+		mutex:   &sync.Mutex{},
+	}
+)
+
+type addressCacheEntry struct {
+	info    *clientpb.AddressInfo
+	expires time.Time
+}
+
+type addressCache struct {
+	entries map[string]*addressCacheEntry
+	mutex   *sync.Mutex
+}
+
+func (c *addressCache) get(key string) (*clientpb.AddressInfo, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.info, true
+}
+
+func (c *addressCache) reset() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries = map[string]*addressCacheEntry{}
+}
+
+func (c *addressCache) put(key string, info *clientpb.AddressInfo) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if enrichCacheSize <= len(c.entries) {
+		c.entries = map[string]*addressCacheEntry{}
+	}
+	c.entries[key] = &addressCacheEntry{info: info, expires: time.Now().Add(enrichCacheTTL)}
+}
+
+// RemoteIP - Parse the ip address out of an implant connection's remote address, e.g.
+// "1.2.3.4:443", "tcp(10.0.0.1:8080)->1.2.3.4" (http behind a proxy), and pivots
+// "1.2.3.4:443->peer->" where the first address is the one on the internet
+func RemoteIP(remoteAddress string) net.IP {
+	if strings.HasPrefix(remoteAddress, "tcp(") {
+		if index := strings.Index(remoteAddress, ")->"); index != -1 {
+			remoteAddress = remoteAddress[index+len(")->"):]
+		}
+	}
+	remoteAddress = strings.SplitN(remoteAddress, "->", 2)[0]
+	host, _, err := net.SplitHostPort(remoteAddress)
+	if err != nil {
+		host = remoteAddress
+	}
+	return net.ParseIP(strings.Trim(host, "[]"))
+}
+
+// Enrich - GeoIP country, ASN, and (if enabled) reverse DNS of an implant's remote
+// address, returns nil if nothing is known about the address
+func Enrich(remoteAddress string) *clientpb.AddressInfo {
+	ip := RemoteIP(remoteAddress)
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() {
+		return nil
+	}
+	key := ip.String()
+	if info, ok := enrichCache.get(key); ok {
+		return info
+	}
+	info := &clientpb.AddressInfo{}
+	if db, err := Default(); err == nil {
+		found := db.Lookup(ip)
+		info.Country, info.ASN, info.ASNOrg = found.Country, found.ASN, found.ASNOrg
+	}
+	if db, err := ASN(); err == nil {
+		found := db.Lookup(ip)
+		if found.ASN != 0 {
+			info.ASN, info.ASNOrg = found.ASN, found.ASNOrg
+		}
+		if info.Country == "" {
+			info.Country = found.Country
+		}
+	}
+	if conf := configs.GetServerConfig().GeoIP; conf != nil && conf.ReverseDNS {
+		info.ReverseDNS = reverseDNS(key)
+	}
+	if info.Country == "" && info.ASN == 0 && info.ReverseDNS == "" {
+		info = nil
+	}
+	enrichCache.put(key, info)
+	return info
+}
+
+func reverseDNS(addr string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), reverseDNSTimeout)
+	defer cancel()
+	names, err := net.DefaultResolver.LookupAddr(ctx, addr)
+	if err != nil || len(names) == 0 {
+		return ""
+	}
+	return strings.TrimSuffix(names[0], ".")
+}
@@ -0,0 +1,259 @@
+package geoip
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+var (
+	geoipLog = log.NamedLogger("geoip", "database")
+
+	// ErrNoDatabase - No geoip database is configured
+	ErrNoDatabase = errors.New("no geoip database, see the geoip section of the server config")
+
+	country       = &lazyDatabase{kind: "country"}
+	asn           = &lazyDatabase{kind: "asn"}
+	databaseMutex = &sync.Mutex{}
+)
+
+// lazyDatabase - A database from the server config, loaded the first time it's used
+type lazyDatabase struct {
+	kind string
+	once sync.Once
+	db   *Database
+	err  error
+}
+
+func (l *lazyDatabase) get(path func(*configs.GeoIPConfig) string) (*Database, error) {
+	l.once.Do(func() {
+		conf := configs.GetServerConfig().GeoIP
+		if conf == nil {
+			l.err = ErrNoDatabase
+			return
+		}
+		l.db, l.err = loadDatabase(path(conf), l.kind)
+	})
+	return l.db, l.err
+}
+
+// Info - What a database knows about an address, blank fields are unknown
+type Info struct {
+	Country string // ISO 3166-1 alpha-2
+	ASN     uint32
+	ASNOrg  string
+}
+
+type ipRange struct {
+	first []byte
+	last  []byte
+	info  Info
+}
+
+// Database - A MaxMind DB, or CSV ranges sorted by first address
+type Database struct {
+	ranges []ipRange
+	mmdb   *mmdbReader
+}
+
+// Load - Load a MaxMind DB (.mmdb), or a CSV of "first ip,last ip,country code" or
+// "first ip,last ip,asn,organization" ranges, CSVs may be gzip compressed. Lines that
+// do not parse (e.g. a header) are skipped.
+func Load(path string) (*Database, error) {
+	if strings.HasSuffix(strings.ToLower(path), ".mmdb") {
+		mmdb, err := openMMDB(path)
+		if err != nil {
+			return nil, err
+		}
+		return &Database{mmdb: mmdb}, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gzReader.Close()
+		reader = gzReader
+	}
+	return parse(reader)
+}
+
+func parse(reader io.Reader) (*Database, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.ReuseRecord = true
+	db := &Database{ranges: []ipRange{}}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 {
+			continue
+		}
+		first := net.ParseIP(strings.TrimSpace(record[0])).To16()
+		last := net.ParseIP(strings.TrimSpace(record[1])).To16()
+		if first == nil || last == nil || bytes.Compare(first, last) > 0 {
+			continue
+		}
+		info := Info{}
+		value := strings.TrimSpace(record[2])
+		if asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(value), "AS"), 10, 32); err == nil {
+			info.ASN = uint32(asn)
+			if 3 < len(record) {
+				info.ASNOrg = strings.TrimSpace(record[3])
+			}
+		} else {
+			info.Country = strings.ToUpper(value)
+		}
+		if info == (Info{}) {
+			continue
+		}
+		db.ranges = append(db.ranges, ipRange{first: first, last: last, info: info})
+	}
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].first, db.ranges[j].first) < 0
+	})
+	return db, nil
+}
+
+// Len - Number of ranges in a CSV database
+func (d *Database) Len() int {
+	return len(d.ranges)
+}
+
+// Lookup - Everything the database knows about an address
+func (d *Database) Lookup(ip net.IP) Info {
+	if d.mmdb != nil {
+		record, err := d.mmdb.Lookup(ip)
+		if err != nil {
+			geoipLog.Warnf("Failed to lookup %s: %s", ip, err)
+		}
+		return mmdbInfo(record)
+	}
+	addr := ip.To16()
+	if addr == nil {
+		return Info{}
+	}
+	index := sort.Search(len(d.ranges), func(i int) bool {
+		return bytes.Compare(d.ranges[i].first, addr) > 0
+	})
+	if index == 0 {
+		return Info{}
+	}
+	if bytes.Compare(addr, d.ranges[index-1].last) <= 0 {
+		return d.ranges[index-1].info
+	}
+	return Info{}
+}
+
+// Country - The ISO 3166-1 alpha-2 country code of an address, or blank if unknown
+func (d *Database) Country(ip net.IP) string {
+	return d.Lookup(ip).Country
+}
+
+// mmdbInfo - Supports the MaxMind GeoLite2 schemas, and the flat schema used by some
+// other providers (e.g. {"country": "US", "asn": "AS13335", "as_name": "..."})
+func mmdbInfo(record map[string]interface{}) Info {
+	info := Info{}
+	for _, key := range []string{"country", "registered_country"} {
+		switch country := record[key].(type) {
+		case map[string]interface{}:
+			info.Country, _ = country["iso_code"].(string)
+		case string:
+			info.Country = country
+		}
+		if info.Country != "" {
+			break
+		}
+	}
+	info.Country = strings.ToUpper(info.Country)
+	if asn, ok := record["autonomous_system_number"].(uint64); ok {
+		info.ASN = uint32(asn)
+	} else if asn, ok := record["asn"].(string); ok {
+		number, _ := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(asn), "AS"), 10, 32)
+		info.ASN = uint32(number)
+	}
+	info.ASNOrg, _ = record["autonomous_system_organization"].(string)
+	if info.ASNOrg == "" {
+		info.ASNOrg, _ = record["as_name"].(string)
+	}
+	return info
+}
+
+func loadDatabase(path string, kind string) (*Database, error) {
+	if path == "" {
+		return nil, ErrNoDatabase
+	}
+	db, err := Load(path)
+	if err != nil {
+		geoipLog.Errorf("Failed to load %s database %s: %s", kind, path, err)
+		return nil, err
+	}
+	geoipLog.Infof("Loaded %s database %s", kind, path)
+	return db, nil
+}
+
+// Default - The country database configured in the server config
+func Default() (*Database, error) {
+	databaseMutex.Lock()
+	current := country
+	databaseMutex.Unlock()
+	return current.get(func(conf *configs.GeoIPConfig) string { return conf.CountryDatabase })
+}
+
+// ASN - The asn database configured in the server config
+func ASN() (*Database, error) {
+	databaseMutex.Lock()
+	current := asn
+	databaseMutex.Unlock()
+	return current.get(func(conf *configs.GeoIPConfig) string { return conf.ASNDatabase })
+}
+
+// Reload - The databases are loaded again the next time they're used, and cached
+// lookups are discarded. Listeners keep using the database they were started with.
+func Reload() {
+	databaseMutex.Lock()
+	defer databaseMutex.Unlock()
+	country = &lazyDatabase{kind: "country"}
+	asn = &lazyDatabase{kind: "asn"}
+	enrichCache.reset()
+}
@@ -0,0 +1,169 @@
+package geoip
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCSVDatabase(t *testing.T) {
+	db, err := parse(strings.NewReader(strings.Join([]string{
+		"first,last,country",
+		"203.0.113.0,203.0.113.255,us",
+		"2001:db8::,2001:db8::ffff,DE",
+		"198.51.100.0,198.51.100.127,13335,\"Example, Inc.\"",
+		"198.51.100.128,198.51.100.255,AS64496,Other",
+	}, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Len() != 4 {
+		t.Fatalf("Expected 4 ranges, got %d", db.Len())
+	}
+	for addr, expected := range map[string]Info{
+		"203.0.113.77":   {Country: "US"},
+		"2001:db8::1":    {Country: "DE"},
+		"198.51.100.1":   {ASN: 13335, ASNOrg: "Example, Inc."},
+		"198.51.100.200": {ASN: 64496, ASNOrg: "Other"},
+		"192.0.2.1":      {},
+		"2001:db9::1":    {},
+	} {
+		if info := db.Lookup(net.ParseIP(addr)); info != expected {
+			t.Errorf("Lookup(%s) = %v, expected %v", addr, info, expected)
+		}
+	}
+}
+
+func TestRemoteIP(t *testing.T) {
+	for remoteAddress, expected := range map[string]string{
+		"203.0.113.1:443":                    "203.0.113.1",
+		"[2001:db8::1]:443":                  "2001:db8::1",
+		"tcp(10.0.0.1:8080)->203.0.113.1":    "203.0.113.1",
+		"203.0.113.1:443->PIVOT_PEER->":      "203.0.113.1",
+		"tcp(10.0.0.1:8080)->203.0.113.1->a": "203.0.113.1",
+		"n/a":                                "<nil>",
+	} {
+		if ip := RemoteIP(remoteAddress).String(); ip != expected {
+			t.Errorf("RemoteIP(%s) = %s, expected %s", remoteAddress, ip, expected)
+		}
+	}
+}
+
+// mmdbEncode - Enough of a MaxMind DB writer to test the reader
+func mmdbEncode(value interface{}) []byte {
+	header := func(kind int, size int) []byte {
+		extra := []byte{}
+		if 29 <= size {
+			extra = []byte{byte(size - 29)} // Sizes up to 284
+			size = 29
+		}
+		if 7 < kind {
+			return append([]byte{byte(size), byte(kind - 7)}, extra...)
+		}
+		return append([]byte{byte(kind<<5 | size)}, extra...)
+	}
+	switch v := value.(type) {
+	case string:
+		return append(header(mmdbString, len(v)), v...)
+	case uint16:
+		return append(header(mmdbUint16, 2), byte(v>>8), byte(v))
+	case uint32:
+		return append(header(mmdbUint32, 4), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	case map[string]interface{}:
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		buf := header(mmdbMap, len(v))
+		for _, key := range keys {
+			buf = append(buf, mmdbEncode(key)...)
+			buf = append(buf, mmdbEncode(v[key])...)
+		}
+		return buf
+	}
+	panic("unsupported type")
+}
+
+// testMMDB - An IPv6 tree with a single record for 203.0.113.0/24 (i.e. ::203.0.113.0/120)
+func testMMDB(recordSize uint16) []byte {
+	prefix := append(make([]byte, 12), 203, 0, 113)
+	bits := len(prefix) * 8
+	nodeCount := uint32(bits)
+	records := func(left uint32, right uint32) []byte {
+		switch recordSize {
+		case 24:
+			return []byte{byte(left >> 16), byte(left >> 8), byte(left), byte(right >> 16), byte(right >> 8), byte(right)}
+		case 28:
+			return []byte{byte(left >> 16), byte(left >> 8), byte(left), byte(left>>20)&0xF0 | byte(right>>24)&0x0F, byte(right >> 16), byte(right >> 8), byte(right)}
+		}
+		return []byte{byte(left >> 24), byte(left >> 16), byte(left >> 8), byte(left), byte(right >> 24), byte(right >> 16), byte(right >> 8), byte(right)}
+	}
+	tree := []byte{}
+	for node := 0; node < bits; node++ {
+		next := uint32(node + 1)
+		if node == bits-1 {
+			next = nodeCount + mmdbDataSeparator // Data at offset 0
+		}
+		if prefix[node/8]>>(7-uint(node%8))&1 == 0 {
+			tree = append(tree, records(next, nodeCount)...)
+		} else {
+			tree = append(tree, records(nodeCount, next)...)
+		}
+	}
+	buf := append(tree, make([]byte, mmdbDataSeparator)...)
+	buf = append(buf, mmdbEncode(map[string]interface{}{
+		"country":                        map[string]interface{}{"iso_code": "US"},
+		"autonomous_system_number":       uint32(64496),
+		"autonomous_system_organization": "Example",
+	})...)
+	buf = append(buf, mmdbMetadataStart...)
+	return append(buf, mmdbEncode(map[string]interface{}{
+		"node_count":  nodeCount,
+		"record_size": recordSize,
+		"ip_version":  uint16(6),
+	})...)
+}
+
+func TestMMDB(t *testing.T) {
+	for _, recordSize := range []uint16{24, 28, 32} {
+		reader, err := parseMMDB(testMMDB(recordSize))
+		if err != nil {
+			t.Fatalf("record size %d: %s", recordSize, err)
+		}
+		db := &Database{mmdb: reader}
+		expected := Info{Country: "US", ASN: 64496, ASNOrg: "Example"}
+		if info := db.Lookup(net.ParseIP("203.0.113.9")); info != expected {
+			t.Errorf("record size %d: Lookup = %v, expected %v", recordSize, info, expected)
+		}
+		if info := db.Lookup(net.ParseIP("203.0.114.9")); info != (Info{}) {
+			t.Errorf("record size %d: expected no match, got %v", recordSize, info)
+		}
+		if info := db.Lookup(net.ParseIP("2001:db8::1")); info != (Info{}) {
+			t.Errorf("record size %d: expected no match, got %v", recordSize, info)
+		}
+	}
+	if _, err := parseMMDB(bytes.Repeat([]byte{0}, 64)); err == nil {
+		t.Fatal("Expected an error parsing an invalid database")
+	}
+}
@@ -0,0 +1,326 @@
+package geoip
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+)
+
+// A minimal reader for MaxMind DB files (GeoLite2-Country, GeoLite2-City, GeoLite2-ASN, etc.)
+// https://maxmind.github.io/MaxMind-DB/
+
+const (
+	mmdbDataSeparator = 16
+
+	mmdbExtended  = 0
+	mmdbPointer   = 1
+	mmdbString    = 2
+	mmdbDouble    = 3
+	mmdbBytes     = 4
+	mmdbUint16    = 5
+	mmdbUint32    = 6
+	mmdbMap       = 7
+	mmdbInt32     = 8
+	mmdbUint64    = 9
+	mmdbUint128   = 10
+	mmdbArray     = 11
+	mmdbContainer = 12
+	mmdbEnd       = 13
+	mmdbBool      = 14
+	mmdbFloat     = 15
+
+	mmdbMaxDepth = 32
+)
+
+var (
+	mmdbMetadataStart = []byte("\xAB\xCD\xEFMaxMind.com")
+
+	// ErrInvalidMMDB - The file is not a valid MaxMind DB
+	ErrInvalidMMDB = errors.New("invalid maxmind database")
+)
+
+type mmdbReader struct {
+	buf        []byte
+	data       []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint
+}
+
+func openMMDB(path string) (*mmdbReader, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseMMDB(buf)
+}
+
+func parseMMDB(buf []byte) (*mmdbReader, error) {
+	index := bytes.LastIndex(buf, mmdbMetadataStart)
+	if index == -1 {
+		return nil, ErrInvalidMMDB
+	}
+	metadataDecoder := &mmdbDecoder{data: buf[index+len(mmdbMetadataStart):]}
+	value, _, err := metadataDecoder.decode(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	metadata, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidMMDB
+	}
+	reader := &mmdbReader{
+		buf:        buf,
+		nodeCount:  uint(mmdbUint(metadata["node_count"])),
+		recordSize: uint(mmdbUint(metadata["record_size"])),
+		ipVersion:  uint(mmdbUint(metadata["ip_version"])),
+	}
+	if reader.recordSize != 24 && reader.recordSize != 28 && reader.recordSize != 32 {
+		return nil, fmt.Errorf("%w: unsupported record size %d", ErrInvalidMMDB, reader.recordSize)
+	}
+	treeSize := reader.nodeCount * reader.recordSize / 4
+	if uint(index) < treeSize+mmdbDataSeparator {
+		return nil, ErrInvalidMMDB
+	}
+	reader.data = buf[treeSize+mmdbDataSeparator : index]
+
+	// IPv4 addresses are stored as ::a.b.c.d in an IPv6 tree
+	if reader.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < reader.nodeCount; i++ {
+			node = reader.record(node, 0)
+		}
+		reader.ipv4Start = node
+	}
+	return reader, nil
+}
+
+// record - Read the left (0) or right (1) record of a node
+func (r *mmdbReader) record(node uint, bit uint) uint {
+	switch r.recordSize {
+	case 24:
+		offset := node*6 + bit*3
+		b := r.buf[offset : offset+3]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+	case 28:
+		b := r.buf[node*7 : node*7+7]
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		offset := node*8 + bit*4
+		return uint(binary.BigEndian.Uint32(r.buf[offset : offset+4]))
+	}
+}
+
+// Lookup - Returns the data record for an address, or nil if there isn't one
+func (r *mmdbReader) Lookup(ip net.IP) (map[string]interface{}, error) {
+	addr := ip.To4()
+	node := uint(0)
+	if addr != nil && r.ipVersion == 6 {
+		node = r.ipv4Start
+	} else if addr == nil {
+		if r.ipVersion == 4 {
+			return nil, nil
+		}
+		addr = ip.To16()
+	}
+	if addr == nil {
+		return nil, nil
+	}
+	for i := 0; i < len(addr)*8 && node < r.nodeCount; i++ {
+		bit := uint(addr[i/8]>>(7-uint(i%8))) & 1
+		node = r.record(node, bit)
+	}
+	if node <= r.nodeCount {
+		return nil, nil // Empty or not found
+	}
+	offset := node - r.nodeCount - mmdbDataSeparator
+	decoder := &mmdbDecoder{data: r.data}
+	value, _, err := decoder.decode(offset, 0)
+	if err != nil {
+		return nil, err
+	}
+	record, _ := value.(map[string]interface{})
+	return record, nil
+}
+
+type mmdbDecoder struct {
+	data []byte
+}
+
+func (d *mmdbDecoder) bytes(offset uint, size uint) ([]byte, error) {
+	if uint(len(d.data)) < offset+size {
+		return nil, ErrInvalidMMDB
+	}
+	return d.data[offset : offset+size], nil
+}
+
+// decode - Decode the value at offset, returns the value and the offset of the next value
+func (d *mmdbDecoder) decode(offset uint, depth int) (interface{}, uint, error) {
+	if mmdbMaxDepth < depth {
+		return nil, 0, ErrInvalidMMDB
+	}
+	ctrl, err := d.bytes(offset, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset++
+	kind := uint(ctrl[0] >> 5)
+	if kind == mmdbPointer {
+		pointer, next, err := d.pointer(ctrl[0], offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		value, _, err := d.decode(pointer, depth+1)
+		return value, next, err
+	}
+	if kind == mmdbExtended {
+		extended, err := d.bytes(offset, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		offset++
+		kind = 7 + uint(extended[0])
+	}
+	size := uint(ctrl[0] & 0x1F)
+	if 29 <= size {
+		count := size - 28
+		extra, err := d.bytes(offset, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += count
+		switch count {
+		case 1:
+			size = 29 + uint(extra[0])
+		case 2:
+			size = 285 + (uint(extra[0])<<8 | uint(extra[1]))
+		default:
+			size = 65821 + (uint(extra[0])<<16 | uint(extra[1])<<8 | uint(extra[2]))
+		}
+	}
+
+	switch kind {
+	case mmdbMap:
+		values := map[string]interface{}{}
+		for i := uint(0); i < size; i++ {
+			key, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return nil, 0, ErrInvalidMMDB
+			}
+			value, next, err := d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			values[name] = value
+			offset = next
+		}
+		return values, offset, nil
+	case mmdbArray:
+		values := []interface{}{}
+		for i := uint(0); i < size; i++ {
+			value, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			values = append(values, value)
+			offset = next
+		}
+		return values, offset, nil
+	case mmdbBool:
+		return size != 0, offset, nil
+	case mmdbContainer, mmdbEnd:
+		return nil, offset, nil
+	}
+
+	raw, err := d.bytes(offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset += size
+	switch kind {
+	case mmdbString:
+		return string(raw), offset, nil
+	case mmdbBytes:
+		return raw, offset, nil
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, ErrInvalidMMDB
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(raw)), offset, nil
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, ErrInvalidMMDB
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(raw)), offset, nil
+	case mmdbUint16, mmdbUint32, mmdbUint64, mmdbInt32:
+		value := uint64(0)
+		for _, b := range raw {
+			value = value<<8 | uint64(b)
+		}
+		if kind == mmdbInt32 {
+			return int64(int32(uint32(value))), offset, nil
+		}
+		return value, offset, nil
+	case mmdbUint128:
+		return raw, offset, nil // We have no use for these, keep the bytes
+	}
+	return nil, 0, fmt.Errorf("%w: unknown type %d", ErrInvalidMMDB, kind)
+}
+
+func (d *mmdbDecoder) pointer(ctrl byte, offset uint) (uint, uint, error) {
+	size := uint((ctrl>>3)&0x3) + 1
+	raw, err := d.bytes(offset, size)
+	if err != nil {
+		return 0, 0, err
+	}
+	value := uint(0)
+	if size < 4 {
+		value = uint(ctrl & 0x7)
+	}
+	for _, b := range raw {
+		value = value<<8 | uint(b)
+	}
+	switch size {
+	case 2:
+		value += 2048
+	case 3:
+		value += 526336
+	}
+	return value, offset + size, nil
+}
+
+// mmdbUint - Numbers are decoded as uint64
+func mmdbUint(value interface{}) uint64 {
+	number, _ := value.(uint64)
+	return number
+}
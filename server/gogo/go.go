@@ -59,6 +59,7 @@ type GoConfig struct {
 
 	Obfuscation bool
 	GOGARBLE    string
+	GarbleSeed  string // Base64 seed, or "random"
 }
 
 // GetGoRootDir - Get the path to GOROOT
@@ -96,7 +97,11 @@ func GarbleCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 		return nil, fmt.Errorf(fmt.Sprintf("Invalid compiler target: %s", target))
 	}
 	garbleBinPath := filepath.Join(config.GOROOT, "bin", "garble")
-	garbleFlags := []string{"-seed=random", "-literals", "-tiny"}
+	seed := config.GarbleSeed
+	if seed == "" {
+		seed = "random"
+	}
+	garbleFlags := []string{"-seed=" + seed, "-literals", "-tiny"}
 	command = append(garbleFlags, command...)
 	cmd := exec.Command(garbleBinPath, command...)
 	cmd.Dir = cwd
@@ -0,0 +1,341 @@
+package ha
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	clientAssets "github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/transport"
+	"github.com/bishopfox/sliver/client/version"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/server/backup"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/grpc"
+)
+
+const (
+	// DefaultSyncInterval - How often a standby copies the primary's state
+	DefaultSyncInterval = 5 * time.Minute
+	// DefaultFailoverAfter - How long the primary has to be unreachable before a standby
+	// takes over
+	DefaultFailoverAfter = 2 * time.Minute
+
+	// ArchiveName - The primary's last snapshot in the standby's app dir, it's a backup
+	// archive encrypted to the standby's key
+	ArchiveName = "standby.backup"
+	// KeyName - The standby's age key, encrypted with the standby's passphrase
+	KeyName = "standby.key"
+
+	healthInterval = 10 * time.Second
+	requestTimeout = 30 * time.Second
+	syncTimeout    = 30 * time.Minute
+
+	// snapshotChunkSize - Snapshots are streamed to the standby in chunks of this size
+	snapshotChunkSize = 2 * 1024 * 1024
+)
+
+var (
+	haLog = log.NamedLogger("ha", "standby")
+
+	// ErrInvalidRecipient - The standby's public key can't be parsed
+	ErrInvalidRecipient = errors.New("invalid standby recipient")
+)
+
+// Snapshot - Archive the state of this (the primary) server for a standby, the same
+// state a backup contains, encrypted to the standby's age public key
+func Snapshot(w io.Writer, appDir string, recipient string) (*backup.Manifest, error) {
+	ageRecipient, err := age.ParseX25519Recipient(recipient)
+	if err != nil {
+		return nil, ErrInvalidRecipient
+	}
+	hostname, _ := os.Hostname()
+	dbConfig := configs.GetDatabaseConfig()
+	manifest := &backup.Manifest{
+		ServerVersion: version.FullVersion(),
+		Hostname:      hostname,
+		CreatedAt:     time.Now().UTC(),
+		Dialect:       dbConfig.Dialect,
+	}
+	snapshot := ""
+	if dbConfig.Dialect == configs.Sqlite {
+		snapshot, err = db.Snapshot()
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(snapshot)
+	}
+	err = backup.CreateFor(w, ageRecipient, appDir, snapshot, manifest)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// SendSnapshot - Stream a snapshot in chunks, the archive is written to a temporary
+// file first so it's never held in memory
+func SendSnapshot(appDir string, recipient string, send func(*clientpb.HASnapshot) error) error {
+	archive, err := os.CreateTemp("", "sliver-standby-*.backup")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+	manifest, err := Snapshot(archive, appDir, recipient)
+	if err != nil {
+		return err
+	}
+	_, err = archive.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	chunk := &clientpb.HASnapshot{
+		ServerVersion: manifest.ServerVersion,
+		Database:      manifest.Database,
+		CreatedAt:     manifest.CreatedAt.Unix(),
+	}
+	buf := make([]byte, snapshotChunkSize)
+	for {
+		n, err := io.ReadFull(archive, buf)
+		if n == 0 && err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		chunk.Data = buf[:n]
+		if err := send(chunk); err != nil {
+			return err
+		}
+		chunk = &clientpb.HASnapshot{}
+		if err == io.ErrUnexpectedEOF {
+			return nil // Last chunk
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Standby - Copies the state of a primary server, using an operator config of the
+// primary, until the primary has been unreachable for FailoverAfter
+type Standby struct {
+	Primary       *clientAssets.ClientConfig
+	Identity      *age.X25519Identity
+	ArchivePath   string
+	SyncInterval  time.Duration
+	FailoverAfter time.Duration
+
+	rpc         rpcpb.SliverRPCClient
+	conn        *grpc.ClientConn
+	lastContact time.Time
+	lastSync    time.Time
+}
+
+// Run - Blocks until the primary fails, a snapshot left by a previous run counts as
+// the last sync. The standby never takes over before it has a snapshot.
+func (s *Standby) Run() {
+	s.lastContact = time.Now()
+	if info, err := os.Stat(s.ArchivePath); err == nil {
+		s.lastSync = info.ModTime()
+	}
+	for {
+		now := time.Now()
+		if s.check() {
+			s.lastContact = now
+			if s.SyncInterval <= now.Sub(s.lastSync) {
+				err := s.sync()
+				if err != nil {
+					haLog.Errorf("Failed to sync with %s:%d: %s", s.Primary.LHost, s.Primary.LPort, err)
+				}
+			}
+		} else if s.failed(now) {
+			haLog.Warnf("%s:%d has been unreachable since %s", s.Primary.LHost, s.Primary.LPort, s.lastContact)
+			return
+		}
+		time.Sleep(healthInterval)
+	}
+}
+
+// LastSync - When the snapshot that will be restored on failover was taken
+func (s *Standby) LastSync() time.Time {
+	return s.lastSync
+}
+
+// failed - The primary's been unreachable for too long and there's a snapshot to restore
+func (s *Standby) failed(now time.Time) bool {
+	return !s.lastSync.IsZero() && s.FailoverAfter <= now.Sub(s.lastContact)
+}
+
+// check - Is the primary reachable, the connection is re-established as needed
+func (s *Standby) check() bool {
+	if s.conn == nil {
+		rpc, conn, err := transport.MTLSConnect(s.Primary)
+		if err != nil {
+			haLog.Warnf("Failed to connect to %s:%d: %s", s.Primary.LHost, s.Primary.LPort, err)
+			return false
+		}
+		s.rpc, s.conn = rpc, conn
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := s.rpc.GetVersion(ctx, &commonpb.Empty{})
+	if err != nil {
+		haLog.Warnf("%s:%d is unreachable: %s", s.Primary.LHost, s.Primary.LPort, err)
+		s.conn.Close()
+		s.rpc, s.conn = nil, nil
+		return false
+	}
+	return true
+}
+
+// sync - Replace the archive with a new snapshot of the primary, the old one is kept
+// if anything goes wrong
+func (s *Standby) sync() error {
+	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+	defer cancel()
+	stream, err := s.rpc.HASnapshot(ctx, &clientpb.HASnapshotReq{Recipient: s.Identity.Recipient().String()})
+	if err != nil {
+		return err
+	}
+	tmpPath := s.ArchivePath + ".tmp"
+	snapshot, size, err := receiveSnapshot(stream, tmpPath)
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	err = os.Rename(tmpPath, s.ArchivePath)
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	s.lastSync = time.Unix(snapshot.CreatedAt, 0)
+	haLog.Infof("Synced %d bytes from %s:%d (%s)", size, s.Primary.LHost, s.Primary.LPort, snapshot.ServerVersion)
+	if !snapshot.Database {
+		haLog.Warnf("The primary's database is not sqlite and isn't replicated")
+	}
+	return nil
+}
+
+// receiveSnapshot - Write the streamed chunks to the file, returns the first chunk
+// (without its data), which has the snapshot's metadata
+func receiveSnapshot(stream rpcpb.SliverRPC_HASnapshotClient, path string) (*clientpb.HASnapshot, int64, error) {
+	archive, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer archive.Close()
+	var first *clientpb.HASnapshot
+	size := int64(0)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		n, err := archive.Write(chunk.Data)
+		if err != nil {
+			return nil, 0, err
+		}
+		size += int64(n)
+		if first == nil {
+			chunk.Data = nil
+			first = chunk
+		}
+	}
+	if first == nil {
+		return nil, 0, errors.New("empty snapshot")
+	}
+	return first, size, archive.Close()
+}
+
+// LoadIdentity - The standby's age key, it's generated on first use and saved encrypted
+// with the passphrase so the archive isn't readable without it
+func LoadIdentity(keyPath string, passphrase string) (*age.X25519Identity, error) {
+	data, err := os.ReadFile(keyPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return newIdentity(keyPath, passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+	scryptIdentity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	decrypted, err := age.Decrypt(bytes.NewReader(data), scryptIdentity)
+	if err != nil {
+		return nil, err
+	}
+	key, err := io.ReadAll(decrypted)
+	if err != nil {
+		return nil, err
+	}
+	return age.ParseX25519Identity(strings.TrimSpace(string(key)))
+}
+
+func newIdentity(keyPath string, passphrase string) (*age.X25519Identity, error) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	encrypted, err := age.Encrypt(buf, recipient)
+	if err != nil {
+		return nil, err
+	}
+	_, err = encrypted.Write([]byte(identity.String()))
+	if err != nil {
+		return nil, err
+	}
+	err = encrypted.Close()
+	if err != nil {
+		return nil, err
+	}
+	return identity, os.WriteFile(keyPath, buf.Bytes(), 0600)
+}
+
+// Failover - Restore the last snapshot of the primary into the app dir, the server
+// started afterwards has the primary's listeners, certificates, and keys
+func Failover(archivePath string, identity *age.X25519Identity, appDir string) (*backup.Manifest, error) {
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+	return backup.RestoreWith(archive, identity, appDir)
+}
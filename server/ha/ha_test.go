@@ -0,0 +1,99 @@
+package ha
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/server/backup"
+)
+
+func TestLoadIdentity(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), KeyName)
+	identity, err := LoadIdentity(keyPath, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadIdentity(keyPath, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.String() != identity.String() {
+		t.Fatal("Loaded a different identity than the one that was generated")
+	}
+	if _, err := LoadIdentity(keyPath, "battery staple"); err == nil {
+		t.Fatal("Loaded the identity with the wrong passphrase")
+	}
+}
+
+func TestFailover(t *testing.T) {
+	identity, err := LoadIdentity(filepath.Join(t.TempDir(), KeyName), "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	primaryDir := t.TempDir()
+	os.MkdirAll(filepath.Join(primaryDir, "configs"), 0700)
+	os.WriteFile(filepath.Join(primaryDir, "configs", "server.json"), []byte("{}"), 0600)
+
+	archive := &bytes.Buffer{}
+	err = backup.CreateFor(archive, identity.Recipient(), primaryDir, "", &backup.Manifest{Hostname: "primary"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	archivePath := filepath.Join(t.TempDir(), ArchiveName)
+	os.WriteFile(archivePath, archive.Bytes(), 0600)
+
+	standbyDir := t.TempDir()
+	manifest, err := Failover(archivePath, identity, standbyDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Hostname != "primary" || manifest.Files != 1 {
+		t.Fatalf("Unexpected manifest %+v", manifest)
+	}
+	if data, err := os.ReadFile(filepath.Join(standbyDir, "configs", "server.json")); err != nil || string(data) != "{}" {
+		t.Fatalf("Config wasn't restored: %q %v", data, err)
+	}
+}
+
+func TestStandbyFailed(t *testing.T) {
+	now := time.Now()
+	standby := &Standby{FailoverAfter: time.Minute, lastContact: now.Add(-2 * time.Minute)}
+	if standby.failed(now) {
+		t.Fatal("Failed over without a snapshot")
+	}
+	standby.lastSync = now.Add(-time.Hour)
+	if !standby.failed(now) {
+		t.Fatal("Didn't fail over after the primary was unreachable")
+	}
+	standby.lastContact = now.Add(-30 * time.Second)
+	if standby.failed(now) {
+		t.Fatal("Failed over while the primary was reachable")
+	}
+}
+
+func TestSnapshotRecipient(t *testing.T) {
+	if _, err := Snapshot(&bytes.Buffer{}, t.TempDir(), "not a key"); err != ErrInvalidRecipient {
+		t.Fatalf("Expected %v, got %v", ErrInvalidRecipient, err)
+	}
+}
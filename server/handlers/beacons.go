@@ -25,6 +25,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	consts "github.com/bishopfox/sliver/client/constants"
@@ -33,7 +34,10 @@ import (
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/geoip"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/bishopfox/sliver/server/metrics"
 	"github.com/gofrs/uuid"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
@@ -50,6 +54,14 @@ func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sl
 		beaconHandlerLog.Errorf("Error decoding beacon registration message: %s", err)
 		return nil
 	}
+	if core.BurnList.IsBurned(beaconReg.Register.Name) {
+		core.BurnedContact(beaconReg.Register.Name, implantConn.Transport, implantConn.RemoteAddress)
+		return nil
+	}
+	if !supportedProtocol(implantConn, beaconReg.Register) {
+		return nil
+	}
+	implantConn.SetCompression(beaconReg.Register.Compression)
 	beaconHandlerLog.Infof("Beacon registration from %s", beaconReg.ID)
 	beacon, err := db.BeaconByID(beaconReg.ID)
 	beaconHandlerLog.Debugf("Found %v err = %s", beacon, err)
@@ -58,6 +70,11 @@ func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sl
 		return nil
 	}
 	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Known beacons can re-register, e.g. after a connection error
+		if implantConn.Drain.IsDraining() {
+			beaconHandlerLog.Warnf("Listener is draining, ignoring beacon registration from %s (%s)", beaconReg.Register.Name, implantConn.RemoteAddress)
+			return nil
+		}
 		beacon = &models.Beacon{
 			ID: uuid.FromStringOrNil(beaconReg.ID),
 		}
@@ -82,6 +99,12 @@ func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sl
 	// beacon.ConfigID = uuid.FromStringOrNil(beaconReg.Register.ConfigID)
 	beacon.Locale = beaconReg.Register.Locale
 
+	addressInfo := geoip.Enrich(implantConn.RemoteAddress)
+	beacon.Country = addressInfo.GetCountry()
+	beacon.ASN = addressInfo.GetASN()
+	beacon.ASNOrg = addressInfo.GetASNOrg()
+	beacon.ReverseDNS = addressInfo.GetReverseDNS()
+
 	beacon.Interval = beaconReg.Interval
 	beacon.Jitter = beaconReg.Jitter
 	beacon.NextCheckin = time.Now().Unix() + beaconReg.NextCheckin
@@ -90,6 +113,7 @@ func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sl
 	if err != nil {
 		beaconHandlerLog.Errorf("Database write %s", err)
 	}
+	core.SaveHostSurvey(beacon.UUID.String(), beaconReg.Register)
 
 	eventData, _ := proto.Marshal(beacon.ToProtobuf())
 	core.EventBroker.Publish(core.Event{
@@ -126,8 +150,16 @@ func beaconTasksHandler(implantConn *core.ImplantConnection, data []byte) *slive
 		beaconHandlerLog.Errorf("Error decoding beacon tasks message: %s", err)
 		return nil
 	}
+	beacon, err := db.BeaconByID(beaconTasks.ID)
+	if err == nil && core.BurnList.IsBurned(beacon.Name) {
+		core.BurnedContact(beacon.Name, implantConn.Transport, implantConn.RemoteAddress)
+		return nil
+	}
+	// Beacons check in over a new connection, so they tell us every time
+	implantConn.SetCompression(beaconTasks.Compression)
+	metrics.BeaconCheckin(implantConn.Transport)
 	go func() {
-		err := db.UpdateBeaconCheckinByID(beaconTasks.ID, beaconTasks.NextCheckin)
+		err := db.UpdateBeaconCheckinByID(beaconTasks.ID, beaconTasks.NextCheckin, beaconTasks.Interval, beaconTasks.Jitter)
 		if err != nil {
 			beaconHandlerLog.Errorf("failed to update checkin: %s", err)
 		}
@@ -146,7 +178,8 @@ func beaconTasksHandler(implantConn *core.ImplantConnection, data []byte) *slive
 
 	beaconHandlerLog.Infof("Beacon %s requested pending task(s)", beaconTasks.ID)
 
-	// Pending tasks are ordered by their creation time.
+	// Pending tasks are ordered by their position, which is their creation
+	// time unless an operator has reordered them.
 	pendingTasks, err := db.PendingBeaconTasksByBeaconID(beaconTasks.ID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		beaconHandlerLog.Errorf("Beacon task database error: %s", err)
@@ -164,6 +197,7 @@ func beaconTasksHandler(implantConn *core.ImplantConnection, data []byte) *slive
 		tasks = append(tasks, envelope)
 		pendingTask.State = models.SENT
 		pendingTask.SentAt = time.Now()
+		pendingTask.Request = core.RedactTaskRequest(pendingTask.Request)
 		err = db.Session().Model(&models.BeaconTask{}).Where(&models.BeaconTask{
 			ID: pendingTask.ID,
 		}).Updates(pendingTask).Error
@@ -196,7 +230,13 @@ func beaconTaskResults(beaconID string, taskEnvelopes []*sliverpb.Envelope) *sli
 		}
 		dbTask.State = models.COMPLETED
 		dbTask.CompletedAt = time.Now()
-		dbTask.Response = envelope.Data
+		dbTask.ResponseSize = int64(len(envelope.Data))
+		if loot.IsOversizedResult(envelope.Data) {
+			dbTask.ResponseLootID = divertBeaconTaskResult(beaconID, dbTask, envelope.Data)
+		}
+		if dbTask.ResponseLootID == "" {
+			dbTask.Response = envelope.Data
+		}
 		err = db.Session().Model(&models.BeaconTask{}).Where(&models.BeaconTask{
 			ID: dbTask.ID,
 		}).Updates(dbTask).Error
@@ -212,3 +252,28 @@ func beaconTaskResults(beaconID string, taskEnvelopes []*sliverpb.Envelope) *sli
 	}
 	return nil
 }
+
+// divertBeaconTaskResult - Save an oversized task result as loot, returns the loot ID
+// or an empty string if the result should be kept in the database after all
+func divertBeaconTaskResult(beaconID string, dbTask *models.BeaconTask, data []byte) string {
+	beacon, err := db.BeaconByID(beaconID)
+	if err != nil {
+		beaconHandlerLog.Errorf("Error finding beacon %s: %s", beaconID, err)
+		return ""
+	}
+	request := &sliverpb.Envelope{}
+	proto.Unmarshal(dbTask.Request, request)
+	name := fmt.Sprintf("%s %s", beacon.Name, dbTask.Description)
+	lootResult, err := loot.GetLootStore().DivertResult(name, beacon.UUID.String(), request.Type, data)
+	if err != nil {
+		beaconHandlerLog.Errorf("Error saving task result as loot: %s", err)
+		return ""
+	}
+	beaconHandlerLog.Warnf("Task %s result (%d bytes) exceeds the result size limit, saved as loot %s",
+		dbTask.ID, len(data), lootResult.ID)
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(lootResult.ID),
+	})
+	return lootResult.ID
+}
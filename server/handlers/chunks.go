@@ -0,0 +1,86 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	WARNING: These functions can be invoked by remote implants without user interaction
+*/
+
+import (
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	chunkHandlerLog = log.NamedLogger("handlers", "chunks")
+)
+
+// chunkHandler - Reassemble an envelope the implant sent as chunks, once complete it's
+// delivered as if it was received in one piece
+func chunkHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	chunk := &sliverpb.Chunk{}
+	err := proto.Unmarshal(data, chunk)
+	if err != nil {
+		chunkHandlerLog.Errorf("Error decoding chunk: %s", err)
+		return nil
+	}
+	envelope, ack := implantConn.ReceiveChunk(chunk)
+	if ack.Cancel {
+		chunkHandlerLog.Warnf("Canceled chunked transfer %d from %s", chunk.TransferID, implantConn.RemoteAddress)
+	}
+	if envelope != nil {
+		go deliverEnvelope(implantConn, envelope)
+	}
+	data, err = proto.Marshal(ack)
+	if err != nil {
+		return nil
+	}
+	return &sliverpb.Envelope{Type: sliverpb.MsgChunkAck, Data: data}
+}
+
+// chunkAckHandler - The implant acknowledged chunks of an envelope the server is sending
+func chunkAckHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	ack := &sliverpb.ChunkAck{}
+	err := proto.Unmarshal(data, ack)
+	if err != nil {
+		chunkHandlerLog.Errorf("Error decoding chunk ack: %s", err)
+		return nil
+	}
+	implantConn.AckChunks(ack)
+	return nil
+}
+
+// deliverEnvelope - Deliver a reassembled envelope like the c2 listeners do, pivot
+// handlers are excluded so a chunked envelope can't recurse into them
+func deliverEnvelope(implantConn *core.ImplantConnection, envelope *sliverpb.Envelope) {
+	if envelope.ID != 0 {
+		implantConn.RespMutex.RLock()
+		defer implantConn.RespMutex.RUnlock()
+		if resp, ok := implantConn.Resp[envelope.ID]; ok {
+			resp <- envelope
+		}
+	} else if handler, ok := GetNonPivotHandlers()[envelope.Type]; ok {
+		respEnvelope := handler(implantConn, envelope.Data)
+		if respEnvelope != nil {
+			implantConn.Send <- respEnvelope
+		}
+	}
+}
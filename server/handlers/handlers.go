@@ -44,6 +44,10 @@ func GetHandlers() map[uint32]ServerHandler {
 		sliverpb.MsgTunnelClose: tunnelCloseHandler,
 		sliverpb.MsgPing:        pingHandler,
 		sliverpb.MsgSocksData:   socksDataHandler,
+		sliverpb.MsgDNSExchange: dnsExchangeHandler,
+		sliverpb.MsgTaskOutput:  taskOutputHandler,
+		sliverpb.MsgChunk:       chunkHandler,
+		sliverpb.MsgChunkAck:    chunkAckHandler,
 
 		// Beacons
 		sliverpb.MsgBeaconRegister: beaconRegisterHandler,
@@ -66,6 +70,10 @@ func GetNonPivotHandlers() map[uint32]ServerHandler {
 		sliverpb.MsgTunnelClose: tunnelCloseHandler,
 		sliverpb.MsgPing:        pingHandler,
 		sliverpb.MsgSocksData:   socksDataHandler,
+		sliverpb.MsgDNSExchange: dnsExchangeHandler,
+		sliverpb.MsgTaskOutput:  taskOutputHandler,
+		sliverpb.MsgChunk:       chunkHandler,
+		sliverpb.MsgChunkAck:    chunkAckHandler,
 
 		// Beacons - Not currently supported in pivots
 	}
@@ -104,6 +104,11 @@ func sessionEnvelopeHandler(implantConn *core.ImplantConnection, peerEnvelope *s
 		pivotLog.Errorf("failed to unmarshal pivot session data: %v", err)
 		return nil
 	}
+	err = core.DecompressEnvelope(envelope)
+	if err != nil {
+		pivotLog.Errorf("failed to decompress pivot session data: %v", err)
+		return nil
+	}
 
 	go handlePivotEnvelope(pivot, envelope)
 
@@ -114,6 +119,7 @@ func handlePivotEnvelope(pivot *core.Pivot, envelope *sliverpb.Envelope) {
 	pivotLog.Debugf("pivot session %s received envelope: %v", pivot.ID, envelope.Type)
 	handlers := GetNonPivotHandlers()
 	pivot.ImplantConn.UpdateLastMessage()
+	core.Capture.Record(pivot.ImplantConn, core.CaptureRecv, envelope)
 	if envelope.ID != 0 {
 		pivot.ImplantConn.RespMutex.RLock()
 		defer pivot.ImplantConn.RespMutex.RUnlock()
@@ -209,12 +215,24 @@ func serverKeyExchange(implantConn *core.ImplantConnection, peerEnvelope *sliver
 		pivotLog.Warn("Unknown public key digest")
 		return nil
 	}
+	if name, burned := core.BurnList.BuildName(implantConfig.ImplantBuildID); burned {
+		core.BurnedContact(name, "pivot", implantConn.RemoteAddress)
+		return nil
+	}
 
+	// Pivots are checked with the replay window of the listener the pivot's traffic
+	// arrives on, implants behind a pivot don't correct for clock skew though
+	replayWindow := implantConn.ReplayWindow
+	if replayWindow == 0 {
+		replayWindow = cryptography.DefaultReplayWindow
+	}
 	serverKeyPair := cryptography.AgeServerKeyPair()
-	rawSessionKey, err := cryptography.AgeKeyExFromImplant(
+	rawSessionKey, kemSharedKey, err := cryptography.KeyExFromImplant(
+		implantConfig.KeyExchange,
 		serverKeyPair.Private,
 		implantConfig.PeerPrivateKey,
 		serverKeyEx.SessionKey[32:],
+		replayWindow,
 	)
 	if err != nil {
 		pivotLog.Warn("Failed to decrypt session key from origin")
@@ -227,7 +245,7 @@ func serverKeyExchange(implantConn *core.ImplantConnection, peerEnvelope *sliver
 	}
 	pivotSession := core.NewPivotSession(peerEnvelope.Peers)
 	pivotSession.OriginID = peerEnvelope.Peers[0].PeerID
-	pivotSession.CipherCtx = cryptography.NewCipherContext(sessionKey)
+	pivotSession.CipherCtx = cryptography.NewCipherContext(cryptography.SessionKey(sessionKey, kemSharedKey))
 
 	pivotLog.Infof("Pivot session %s created with origin %s and OriginID: %v", pivotSession.ID, peerEnvelope.Peers[0].Name, pivotSession.OriginID)
 	pivotLog.Infof("Peers: %v", peerEnvelope.Peers)
@@ -235,6 +253,8 @@ func serverKeyExchange(implantConn *core.ImplantConnection, peerEnvelope *sliver
 	pivotRemoteAddr := peersToString(implantConn.RemoteAddress, peerEnvelope)
 
 	pivotSession.ImplantConn = core.NewImplantConnection(core.PivotTransportName, pivotRemoteAddr)
+	pivotSession.ImplantConn.Drain = implantConn.Drain
+	pivotSession.ImplantConn.ReplayWindow = implantConn.ReplayWindow
 	pivotSession.ImmediateImplantConn = implantConn
 	core.PivotSessions.Store(pivotSession.ID, pivotSession)
 	keyExRespEnvelope := MustMarshal(&sliverpb.Envelope{
@@ -0,0 +1,105 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	WARNING: These functions can be invoked by remote implants without user interaction
+
+*/
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/miekg/dns"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	resolvConf      = "/etc/resolv.conf"
+	exchangeTimeout = 5 * time.Second
+)
+
+var (
+	resolverHandlerLog = log.NamedLogger("handlers", "resolver")
+
+	errNoResolvers = errors.New("server has no dns resolvers")
+)
+
+// dnsExchangeHandler - Resolve a session's dns message with the server's resolvers, so
+// the implant's lookups never reach the target network's resolver
+func dnsExchangeHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	session := core.Sessions.FromImplantConnection(implantConn)
+	if session == nil {
+		resolverHandlerLog.Warnf("Received dns message from unknown session: %v", implantConn)
+		return nil
+	}
+	exchange := &sliverpb.DNSExchange{}
+	err := proto.Unmarshal(data, exchange)
+	if err != nil {
+		resolverHandlerLog.Errorf("Error decoding dns message: %s", err)
+		return nil
+	}
+	reply := &sliverpb.DNSExchange{ID: exchange.ID}
+	reply.Message, err = resolve(exchange.Message)
+	if err != nil {
+		resolverHandlerLog.Warnf("Session %s dns exchange failed: %s", session.ID, err)
+		reply.Err = err.Error()
+	}
+	data, err = proto.Marshal(reply)
+	if err != nil {
+		return nil
+	}
+	return &sliverpb.Envelope{Type: sliverpb.MsgDNSExchange, Data: data}
+}
+
+// resolve - Exchange a message with the first resolver that answers, over tcp if the
+// udp reply is truncated
+func resolve(message []byte) ([]byte, error) {
+	msg := &dns.Msg{}
+	err := msg.Unpack(message)
+	if err != nil {
+		return nil, err
+	}
+	config, err := dns.ClientConfigFromFile(resolvConf)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Servers) == 0 {
+		return nil, errNoResolvers
+	}
+	for _, server := range config.Servers {
+		address := net.JoinHostPort(server, config.Port)
+		client := &dns.Client{Net: "udp", Timeout: exchangeTimeout}
+		var reply *dns.Msg
+		reply, _, err = client.Exchange(msg, address)
+		if err == nil && reply.Truncated {
+			client.Net = "tcp"
+			reply, _, err = client.Exchange(msg, address)
+		}
+		if err == nil {
+			return reply.Pack()
+		}
+	}
+	return nil, err
+}
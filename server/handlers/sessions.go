@@ -34,6 +34,7 @@ import (
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/core/rtunnels"
+	"github.com/bishopfox/sliver/server/geoip"
 	"github.com/bishopfox/sliver/server/log"
 
 	"google.golang.org/protobuf/proto"
@@ -55,6 +56,17 @@ func registerSessionHandler(implantConn *core.ImplantConnection, data []byte) *s
 		sessionHandlerLog.Errorf("Error decoding session registration message: %s", err)
 		return nil
 	}
+	if core.BurnList.IsBurned(register.Name) {
+		core.BurnedContact(register.Name, implantConn.Transport, implantConn.RemoteAddress)
+		return nil
+	}
+	if !supportedProtocol(implantConn, register) {
+		return nil
+	}
+	if implantConn.Drain.IsDraining() {
+		sessionHandlerLog.Warnf("Listener is draining, ignoring session registration from %s (%s)", register.Name, implantConn.RemoteAddress)
+		return nil
+	}
 
 	session := core.NewSession(implantConn)
 
@@ -80,6 +92,10 @@ func registerSessionHandler(implantConn *core.ImplantConnection, data []byte) *s
 	session.Locale = register.Locale
 	session.ConfigID = register.ConfigID
 	session.PeerID = register.PeerID
+	session.AddressInfo = geoip.Enrich(implantConn.RemoteAddress)
+	session.ProtocolVersion = register.ProtocolVersion
+	implantConn.SetCompression(register.Compression)
+	core.SaveHostSurvey(session.UUID, register)
 	core.Sessions.Add(session)
 	implantConn.Cleanup = func() {
 		core.Sessions.Remove(session.ID)
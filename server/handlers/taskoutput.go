@@ -0,0 +1,57 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	WARNING: These functions can be invoked by remote implants without user interaction
+*/
+
+import (
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	taskOutputHandlerLog = log.NamedLogger("handlers", "taskoutput")
+)
+
+// taskOutputHandler - Publish a streamed task's partial output, only the session the
+// stream was created for can write to it
+func taskOutputHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	output := &sliverpb.TaskOutput{}
+	err := proto.Unmarshal(data, output)
+	if err != nil {
+		taskOutputHandlerLog.Errorf("Error decoding task output: %s", err)
+		return nil
+	}
+	session := core.TaskStreams.Received(output.StreamID, implantConn, output.Sequence, output.Done)
+	if session == nil {
+		taskOutputHandlerLog.Warnf("Received task output for unknown stream: %v", implantConn)
+		return nil
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.TaskOutputEvent,
+		Session:   session,
+		Data:      data,
+	})
+	return nil
+}
@@ -0,0 +1,48 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	WARNING: These functions can be invoked by remote implants without user interaction
+
+*/
+
+import (
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+var (
+	versionHandlerLog = log.NamedLogger("handlers", "version")
+)
+
+// supportedProtocol - Implants older than the oldest supported protocol version can't
+// register, newer implants are accepted but may not understand every command
+func supportedProtocol(implantConn *core.ImplantConnection, register *sliverpb.Register) bool {
+	if register.ProtocolVersion < sliverpb.MinProtocolVersion {
+		versionHandlerLog.Warnf("Ignoring registration from %s (%s), implant protocol v%d is older than v%d, generate a new implant",
+			register.Name, implantConn.RemoteAddress, register.ProtocolVersion, sliverpb.MinProtocolVersion)
+		return false
+	}
+	if sliverpb.ProtocolVersion < register.ProtocolVersion {
+		versionHandlerLog.Warnf("Implant %s (%s) uses protocol v%d, which is newer than the server's v%d",
+			register.Name, implantConn.RemoteAddress, register.ProtocolVersion, sliverpb.ProtocolVersion)
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/attack"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+// Coverage - The MITRE ATT&CK techniques of the commands run on the hosts (all if
+// blank), per host and for the whole engagement. Commands before since (if not zero)
+// are left out.
+func Coverage(hostUUIDs []string, since time.Time) (*clientpb.AttackCoverage, error) {
+	selected := map[string]bool{}
+	for _, hostUUID := range hostUUIDs {
+		selected[hostUUID] = true
+	}
+	dbHosts, err := db.ListHosts()
+	if err != nil {
+		return nil, err
+	}
+	coverage := &clientpb.AttackCoverage{
+		Tagged: int32(len(attack.Techniques())),
+	}
+	engagement := techniqueCounts{}
+	for _, dbHost := range dbHosts {
+		if 0 < len(selected) && !selected[dbHost.HostUUID.String()] {
+			continue
+		}
+		commands, err := db.HostCommands(dbHost.HostUUID, -1)
+		if err != nil {
+			return nil, err
+		}
+		host := techniqueCounts{}
+		for _, command := range commands {
+			if command.CreatedAt.Before(since) {
+				continue
+			}
+			host.add(command)
+			engagement.add(command)
+		}
+		coverage.Hosts = append(coverage.Hosts, &clientpb.HostAttackCoverage{
+			Host:       dbHost.ToProtobuf(),
+			Techniques: host.sorted(),
+		})
+	}
+	coverage.Techniques = engagement.sorted()
+	return coverage, nil
+}
+
+type techniqueCounts map[string]*clientpb.AttackTechnique
+
+// add - Count a command, commands recorded before they were tagged are
+// tagged by their method
+func (t techniqueCounts) add(command *models.HostCommand) {
+	technique, ok := attack.ByID(command.TechniqueID)
+	if !ok {
+		technique, ok = attack.MethodTechnique(command.Method)
+	}
+	if !ok {
+		return
+	}
+	counts, ok := t[technique.ID]
+	if !ok {
+		counts = &clientpb.AttackTechnique{
+			ID:     technique.ID,
+			Name:   technique.Name,
+			Tactic: technique.Tactic,
+		}
+		t[technique.ID] = counts
+	}
+	if command.Error != "" {
+		counts.Failed++
+		return
+	}
+	counts.Count++
+	when := command.CreatedAt.Unix()
+	if counts.FirstSeen == 0 || when < counts.FirstSeen {
+		counts.FirstSeen = when
+	}
+	if counts.LastSeen < when {
+		counts.LastSeen = when
+	}
+	for _, operator := range counts.Operators {
+		if operator == command.Operator {
+			return
+		}
+	}
+	counts.Operators = append(counts.Operators, command.Operator)
+}
+
+// sorted - Techniques in kill chain order, then by id
+func (t techniqueCounts) sorted() []*clientpb.AttackTechnique {
+	techniques := []*clientpb.AttackTechnique{}
+	for _, technique := range t {
+		techniques = append(techniques, technique)
+	}
+	sort.Slice(techniques, func(i, j int) bool {
+		if techniques[i].Tactic != techniques[j].Tactic {
+			return tacticOrder(techniques[i].Tactic) < tacticOrder(techniques[j].Tactic)
+		}
+		return techniques[i].ID < techniques[j].ID
+	})
+	return techniques
+}
+
+func tacticOrder(tactic string) int {
+	for index, name := range attack.Tactics {
+		if name == tactic {
+			return index
+		}
+	}
+	return len(attack.Tactics)
+}
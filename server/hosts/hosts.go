@@ -0,0 +1,545 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/attack"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultHistoryLimit - Number of commands returned with a host's details by default
+	DefaultHistoryLimit = 50
+
+	// maxRequestSize - Recorded requests are truncated, uploads can be very large
+	maxRequestSize      = 1024
+	rpcPrefix           = "/rpcpb.SliverRPC/"
+	psDescription       = "PsReq"
+	scanDescription     = "ScanReq"
+	discoverDescription = "DiscoverReq"
+	adEnumDescription   = "ADEnumReq"
+
+	// discoverySourceSMB - Source of discovered hosts whose shares and sessions were enumerated
+	discoverySourceSMB = "smb"
+	// DiscoveredHostTag - Tag of the hosts added to the host inventory by network discovery
+	DiscoveredHostTag = "discovered"
+)
+
+var (
+	hostsLog = log.NamedLogger("hosts", "history")
+
+	// ErrHostNotFound - No host with the given UUID
+	ErrHostNotFound = errors.New("host not found")
+	// ErrNoProcesses - No process list has been saved for the host
+	ErrNoProcesses = errors.New("no process list for host")
+
+	startOnce = &sync.Once{}
+)
+
+// StartEventAutomation - Save the process lists, scan results, discovered hosts, and
+// AD queries returned to beacons, session results are saved as they pass through the
+// rpc interceptor
+func StartEventAutomation() {
+	startOnce.Do(func() {
+		go func() {
+			events := core.EventBroker.Subscribe()
+			defer core.EventBroker.Unsubscribe(events)
+			for event := range events {
+				if event.EventType != consts.BeaconTaskResultEvent {
+					continue
+				}
+				task := &clientpb.BeaconTask{}
+				if proto.Unmarshal(event.Data, task) != nil {
+					continue
+				}
+				switch task.Description {
+				case psDescription:
+					beaconProcesses(task.ID)
+				case scanDescription:
+					beaconScan(task.ID)
+				case discoverDescription:
+					beaconDiscover(task.ID)
+				case adEnumDescription:
+					beaconADEnum(task.ID)
+				}
+			}
+		}()
+	})
+}
+
+// RecordCommand - Record a command run on a session or beacon in its host's history
+func RecordCommand(method string, operator string, session *clientpb.Session, beacon *clientpb.Beacon, rawRequest []byte, resp interface{}, respErr error) {
+	command := &models.HostCommand{
+		Operator: operator,
+		Method:   strings.TrimPrefix(method, rpcPrefix),
+		Request:  requestSummary(rawRequest),
+	}
+	if technique, ok := attack.MethodTechnique(command.Method); ok {
+		command.TechniqueID = technique.ID
+	}
+	var hostUUID string
+	if session != nil {
+		hostUUID = session.UUID
+		command.ImplantID = session.ID
+		command.ImplantName = session.Name
+	} else if beacon != nil {
+		hostUUID = beacon.UUID
+		command.ImplantID = beacon.ID
+		command.ImplantName = beacon.Name
+		command.Beacon = true
+	} else {
+		return
+	}
+	command.HostUUID = uuid.FromStringOrNil(hostUUID)
+	if command.HostUUID == uuid.Nil {
+		return
+	}
+	if respErr != nil {
+		command.Error = respErr.Error()
+	} else if common, ok := resp.(interface{ GetResponse() *commonpb.Response }); ok && common.GetResponse().GetErr() != "" {
+		command.Error = common.GetResponse().GetErr()
+	}
+	err := db.Session().Create(command).Error
+	if err != nil {
+		hostsLog.Errorf("Failed to record command on %s: %s", hostUUID, err)
+	}
+
+	if ps, ok := resp.(*sliverpb.Ps); ok && session != nil && command.Error == "" {
+		saveProcesses(command.HostUUID, session.Name, ps)
+	}
+	if scan, ok := resp.(*sliverpb.Scan); ok && session != nil && command.Error == "" {
+		saveScan(command.HostUUID, session.Name, scan)
+	}
+	if discover, ok := resp.(*sliverpb.Discover); ok && session != nil && command.Error == "" {
+		saveDiscovery(command.HostUUID, session.Name, discover)
+	}
+	if adEnum, ok := resp.(*sliverpb.ADEnum); ok && session != nil && command.Error == "" {
+		saveADEnum(command.HostUUID, adEnum)
+	}
+}
+
+// requestSummary - The request as json without the implant routing fields
+func requestSummary(rawRequest []byte) string {
+	request := map[string]interface{}{}
+	if json.Unmarshal(rawRequest, &request) != nil {
+		return ""
+	}
+	delete(request, "Request")
+	delete(request, "Password") // Credentials passed to implants (e.g. AD queries) aren't recorded
+	if len(request) == 0 {
+		return ""
+	}
+	summary, _ := json.Marshal(request)
+	if maxRequestSize < len(summary) {
+		return string(summary[:maxRequestSize]) + "..."
+	}
+	return string(summary)
+}
+
+func beaconProcesses(taskID string) {
+	task, err := db.BeaconTaskByID(taskID)
+	if err != nil || task.State != models.COMPLETED {
+		return
+	}
+	beacon, err := db.BeaconByID(task.BeaconID.String())
+	if err != nil {
+		return
+	}
+	ps := &sliverpb.Ps{}
+	err = proto.Unmarshal(task.Response, ps)
+	if err != nil || ps.Response.GetErr() != "" {
+		return
+	}
+	saveProcesses(beacon.UUID, beacon.Name, ps)
+}
+
+// saveProcesses - Replace a host's process list
+func saveProcesses(hostUUID uuid.UUID, implantName string, ps *sliverpb.Ps) {
+	data, err := proto.Marshal(&sliverpb.Ps{Processes: ps.Processes})
+	if err != nil {
+		return
+	}
+	processes, err := db.HostProcessesByHostUUID(hostUUID)
+	if err != nil {
+		processes = &models.HostProcesses{HostUUID: hostUUID}
+	}
+	processes.ImplantName = implantName
+	processes.Processes = data
+	err = db.Session().Save(processes).Error
+	if err != nil {
+		hostsLog.Errorf("Failed to save process list of %s: %s", hostUUID, err)
+	}
+}
+
+func beaconScan(taskID string) {
+	task, err := db.BeaconTaskByID(taskID)
+	if err != nil || task.State != models.COMPLETED {
+		return
+	}
+	beacon, err := db.BeaconByID(task.BeaconID.String())
+	if err != nil {
+		return
+	}
+	scan := &sliverpb.Scan{}
+	err = proto.Unmarshal(task.Response, scan)
+	if err != nil || scan.Response.GetErr() != "" {
+		return
+	}
+	saveScan(beacon.UUID, beacon.Name, scan)
+}
+
+// saveScan - Add or update the open ports found by a scan, an address that only
+// responded to a ping is recorded as port zero until open ports are found
+func saveScan(hostUUID uuid.UUID, implantName string, scan *sliverpb.Scan) {
+	for _, host := range scan.Hosts {
+		ports := host.Ports
+		if len(ports) == 0 {
+			ports = []*sliverpb.ScanPort{{Port: 0}}
+		}
+		existing, err := db.HostScanResults(hostUUID.String(), host.Address)
+		if err != nil {
+			hostsLog.Errorf("Failed to save scan results of %s: %s", hostUUID, err)
+			return
+		}
+		for _, port := range ports {
+			if port.Port == 0 && 0 < len(existing) {
+				continue
+			}
+			result := &models.HostScanResult{HostUUID: hostUUID, Address: host.Address, Port: port.Port}
+			for _, previous := range existing {
+				if previous.Port == port.Port {
+					result = previous
+				}
+			}
+			result.ImplantName = implantName
+			if port.Banner != "" || result.Banner == "" {
+				result.Banner = port.Banner
+			}
+			err = db.Session().Save(result).Error
+			if err != nil {
+				hostsLog.Errorf("Failed to save scan results of %s: %s", hostUUID, err)
+				return
+			}
+		}
+		if 0 < len(host.Ports) {
+			db.Session().Where("host_uuid = ? AND address = ? AND port = 0", hostUUID, host.Address).Delete(&models.HostScanResult{})
+		}
+	}
+}
+
+func beaconDiscover(taskID string) {
+	task, err := db.BeaconTaskByID(taskID)
+	if err != nil || task.State != models.COMPLETED {
+		return
+	}
+	beacon, err := db.BeaconByID(task.BeaconID.String())
+	if err != nil {
+		return
+	}
+	discover := &sliverpb.Discover{}
+	err = proto.Unmarshal(task.Response, discover)
+	if err != nil || discover.Response.GetErr() != "" {
+		return
+	}
+	saveDiscovery(beacon.UUID, beacon.Name, discover)
+}
+
+// saveDiscovery - Add the discovered hosts, or merge them into the hosts already
+// discovered from the host
+func saveDiscovery(hostUUID uuid.UUID, implantName string, discover *sliverpb.Discover) {
+	for _, found := range discover.Hosts {
+		existing, err := db.DiscoveredHosts(hostUUID.String(), found.Address)
+		if err != nil {
+			hostsLog.Errorf("Failed to save discovered hosts of %s: %s", hostUUID, err)
+			return
+		}
+		record := &models.DiscoveredHost{HostUUID: hostUUID, Address: found.Address}
+		host := found
+		if 0 < len(existing) {
+			record = existing[0]
+			previous := &commonpb.DiscoveredHost{}
+			if proto.Unmarshal(record.Data, previous) == nil {
+				host = mergeDiscoveredHost(previous, found)
+			}
+		}
+		record.ImplantName = implantName
+		record.Data, err = proto.Marshal(host)
+		if err == nil {
+			err = db.Session().Save(record).Error
+		}
+		if err == nil {
+			err = inventoryHost(hostUUID, host)
+		}
+		if err != nil {
+			hostsLog.Errorf("Failed to save discovered hosts of %s: %s", hostUUID, err)
+			return
+		}
+	}
+}
+
+// inventoryHost - Add a discovered host to the host inventory, unless a host with one of
+// its names is already there (e.g. it has an implant). The host UUID is derived from the
+// host the discovery was run from and the address, so rediscovering the address updates
+// the same host.
+func inventoryHost(hostUUID uuid.UUID, found *commonpb.DiscoveredHost) error {
+	names := []string{}
+	for _, name := range found.Hostnames {
+		names = append(names, name, strings.Split(name, ".")[0])
+	}
+	if 0 < len(names) {
+		_, err := db.HostByHostname(names...)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, db.ErrRecordNotFound) {
+			return err
+		}
+	}
+	hostname := found.Address
+	if 0 < len(found.Hostnames) {
+		hostname = found.Hostnames[0]
+	}
+	inventoryUUID := uuid.NewV5(hostUUID, found.Address)
+	host, err := db.HostByHostUUID(inventoryUUID.String())
+	if errors.Is(err, db.ErrRecordNotFound) {
+		host = &models.Host{
+			HostUUID:      inventoryUUID,
+			Hostname:      hostname,
+			IOCs:          []models.IOC{},
+			ExtensionData: []models.ExtensionData{},
+		}
+		err = db.Session().Create(host).Error
+		if err != nil {
+			return err
+		}
+		return db.AddHostTag(host, DiscoveredHostTag)
+	}
+	if err != nil || host.Hostname == hostname {
+		return err
+	}
+	return db.Session().Model(host).Update("hostname", hostname).Error
+}
+
+// mergeDiscoveredHost - What was found about a host added to what was already known,
+// the shares and sessions are replaced by a newer smb enumeration
+func mergeDiscoveredHost(previous *commonpb.DiscoveredHost, found *commonpb.DiscoveredHost) *commonpb.DiscoveredHost {
+	merged := proto.Clone(previous).(*commonpb.DiscoveredHost)
+	if found.MAC != "" {
+		merged.MAC = found.MAC
+	}
+	if found.Workgroup != "" {
+		merged.Workgroup = found.Workgroup
+	}
+	merged.Hostnames = appendUnique(merged.Hostnames, found.Hostnames...)
+	merged.Roles = appendUnique(merged.Roles, found.Roles...)
+	merged.Sources = appendUnique(merged.Sources, found.Sources...)
+	for _, source := range found.Sources {
+		if source == discoverySourceSMB {
+			merged.Shares = found.Shares
+			merged.Sessions = found.Sessions
+		}
+	}
+	return merged
+}
+
+// appendUnique - Append the values that aren't in the list yet (ignoring case)
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if strings.EqualFold(existing, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func beaconADEnum(taskID string) {
+	task, err := db.BeaconTaskByID(taskID)
+	if err != nil || task.State != models.COMPLETED {
+		return
+	}
+	beacon, err := db.BeaconByID(task.BeaconID.String())
+	if err != nil {
+		return
+	}
+	adEnum := &sliverpb.ADEnum{}
+	err = proto.Unmarshal(task.Response, adEnum)
+	if err != nil || adEnum.Response.GetErr() != "" {
+		return
+	}
+	saveADEnum(beacon.UUID, adEnum)
+}
+
+// saveADEnum - Save the objects returned by an AD query as json loot of the host
+// the query was run from, tagged "ad" and with the query
+func saveADEnum(hostUUID uuid.UUID, adEnum *sliverpb.ADEnum) {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(&sliverpb.ADEnum{
+		Query:     adEnum.Query,
+		Domain:    adEnum.Domain,
+		Server:    adEnum.Server,
+		Objects:   adEnum.Objects,
+		Truncated: adEnum.Truncated,
+	})
+	if err != nil {
+		return
+	}
+	timestamp := time.Now().Format("20060102150405")
+	saved, err := loot.GetLootStore().Add(&clientpb.Loot{
+		Name:     fmt.Sprintf("AD %s of %s", adEnum.Query, adEnum.Domain),
+		FileType: clientpb.FileType_TEXT,
+		File: &commonpb.File{
+			Name: fmt.Sprintf("ad_%s_%s_%s.json", adEnum.Query, adEnum.Domain, timestamp),
+			Data: data,
+		},
+		OriginHostUUID: hostUUID.String(),
+		Tags:           []string{"ad", adEnum.Query},
+	})
+	if err != nil {
+		hostsLog.Errorf("Failed to save AD %s of %s: %s", adEnum.Query, hostUUID, err)
+		return
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(saved.ID),
+	})
+}
+
+// Details - Everything known about a host, aggregated across all of its sessions and beacons
+func Details(req *clientpb.HostDetailsReq) (*clientpb.HostDetails, error) {
+	dbHost, err := db.HostByHostUUID(req.HostUUID)
+	if err != nil {
+		return nil, ErrHostNotFound
+	}
+	details := &clientpb.HostDetails{
+		Host:     dbHost.ToProtobuf(),
+		Sessions: []*clientpb.Session{},
+		Beacons:  []*clientpb.Beacon{},
+		History:  []*clientpb.HostCommand{},
+	}
+	for _, session := range core.Sessions.All() {
+		if session.UUID == dbHost.HostUUID.String() {
+			details.Sessions = append(details.Sessions, session.ToProtobuf())
+		}
+	}
+	beacons, err := db.BeaconsByHostUUID(dbHost.HostUUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, beacon := range beacons {
+		details.Beacons = append(details.Beacons, beacon.ToProtobuf())
+	}
+
+	results, err := loot.GetLootStore().Search(&clientpb.LootSearchReq{Host: dbHost.HostUUID.String()})
+	if err != nil {
+		return nil, err
+	}
+	details.Loot = results.Loot
+
+	limit := int(req.HistoryLimit)
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	commands, err := db.HostCommands(dbHost.HostUUID, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, command := range commands {
+		details.History = append(details.History, command.ToProtobuf())
+	}
+
+	processes, err := db.HostProcessesByHostUUID(dbHost.HostUUID)
+	if err == nil {
+		ps := &sliverpb.Ps{}
+		if proto.Unmarshal(processes.Processes, ps) == nil {
+			details.Processes = ps.Processes
+			details.ProcessesImplantName = processes.ImplantName
+			details.ProcessesAt = processes.UpdatedAt.Unix()
+		}
+	}
+	return details, nil
+}
+
+// Processes - The most recent process list of a host
+func Processes(hostUUID string) (*clientpb.HostProcesses, error) {
+	id, err := uuid.FromString(hostUUID)
+	if err != nil {
+		return nil, ErrHostNotFound
+	}
+	processes, err := db.HostProcessesByHostUUID(id)
+	if err != nil {
+		return nil, ErrNoProcesses
+	}
+	ps := &sliverpb.Ps{}
+	err = proto.Unmarshal(processes.Processes, ps)
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.HostProcesses{
+		HostUUID:    hostUUID,
+		ImplantName: processes.ImplantName,
+		CreatedAt:   processes.UpdatedAt.Unix(),
+		Processes:   ps.Processes,
+	}, nil
+}
+
+// Remove - Remove a host along with its command history, process list, scan results,
+// and discovered hosts
+func Remove(host *models.Host) error {
+	err := db.Session().Where(&models.DiscoveredHost{HostUUID: host.HostUUID}).Delete(&models.DiscoveredHost{}).Error
+	if err != nil {
+		return err
+	}
+	err = db.Session().Where(&models.HostScanResult{HostUUID: host.HostUUID}).Delete(&models.HostScanResult{}).Error
+	if err != nil {
+		return err
+	}
+	err = db.Session().Where(&models.HostCommand{HostUUID: host.HostUUID}).Delete(&models.HostCommand{}).Error
+	if err != nil {
+		return err
+	}
+	err = db.Session().Where(&models.HostProcesses{HostUUID: host.HostUUID}).Delete(&models.HostProcesses{}).Error
+	if err != nil {
+		return err
+	}
+	return db.Session().Delete(host).Error
+}
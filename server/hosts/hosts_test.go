@@ -0,0 +1,256 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+func TestRequestSummary(t *testing.T) {
+	summary := requestSummary([]byte(`{"Path":"/tmp","Request":{"SessionID":"abc","Timeout":60}}`))
+	if summary != `{"Path":"/tmp"}` {
+		t.Fatalf("Unexpected summary %s", summary)
+	}
+	if requestSummary([]byte(`{"Request":{"SessionID":"abc"}}`)) != "" {
+		t.Fatal("Expected requests without arguments to have no summary")
+	}
+	summary = requestSummary([]byte(`{"Query":"users","Username":"alice@corp.local","Password":"hunter2"}`))
+	if strings.Contains(summary, "hunter2") {
+		t.Fatalf("Expected the password to be left out, got %s", summary)
+	}
+	summary = requestSummary([]byte(`{"Data":"` + strings.Repeat("A", 4096) + `"}`))
+	if len(summary) != maxRequestSize+3 {
+		t.Fatalf("Expected summary to be truncated, got %d bytes", len(summary))
+	}
+}
+
+func TestHostDetails(t *testing.T) {
+	hostUUID, _ := uuid.NewV4()
+	host := &models.Host{HostUUID: hostUUID, Hostname: "WS01"}
+	err := db.Session().Create(host).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two sessions from the same host, e.g. the implant reconnected
+	for _, id := range []string{"session-1", "session-2"} {
+		session := &clientpb.Session{ID: id, Name: "IMPLANT", UUID: hostUUID.String()}
+		RecordCommand("/rpcpb.SliverRPC/Ls", "alice", session, nil, []byte(`{"Path":"/"}`), &sliverpb.Ls{}, nil)
+	}
+	RecordCommand("/rpcpb.SliverRPC/Ps", "bob", &clientpb.Session{ID: "session-2", Name: "IMPLANT", UUID: hostUUID.String()}, nil,
+		[]byte(`{}`), &sliverpb.Ps{Processes: []*commonpb.Process{{Pid: 4, Executable: "System"}}}, nil)
+	RecordCommand("/rpcpb.SliverRPC/Cd", "bob", &clientpb.Session{ID: "session-2", Name: "IMPLANT", UUID: hostUUID.String()}, nil,
+		[]byte(`{"Path":"/nope"}`), &sliverpb.Pwd{Response: &commonpb.Response{Err: "no such directory"}}, nil)
+	RecordCommand("/rpcpb.SliverRPC/Pwd", "bob", &clientpb.Session{ID: "session-2", Name: "IMPLANT", UUID: hostUUID.String()}, nil,
+		[]byte(`{}`), nil, errors.New("timeout"))
+
+	details, err := Details(&clientpb.HostDetailsReq{HostUUID: hostUUID.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(details.History) != 5 {
+		t.Fatalf("Expected 5 commands, got %d", len(details.History))
+	}
+	if details.History[0].Method != "Pwd" || details.History[0].Error != "timeout" || details.History[1].Error != "no such directory" {
+		t.Fatalf("Unexpected history %v", details.History[:2])
+	}
+	if len(details.Processes) != 1 || details.Processes[0].Executable != "System" || details.ProcessesImplantName != "IMPLANT" {
+		t.Fatalf("Unexpected processes %v", details.Processes)
+	}
+
+	details, err = Details(&clientpb.HostDetailsReq{HostUUID: hostUUID.String(), HistoryLimit: 2})
+	if err != nil || len(details.History) != 2 {
+		t.Fatalf("Expected history limit to be applied, got %v", err)
+	}
+
+	err = Remove(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commands, _ := db.HostCommands(hostUUID, 10)
+	if len(commands) != 0 {
+		t.Fatal("Expected host history to be removed with the host")
+	}
+	_, err = Details(&clientpb.HostDetailsReq{HostUUID: hostUUID.String()})
+	if err != ErrHostNotFound {
+		t.Fatalf("Expected host not found, got %v", err)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	hostUUID, _ := uuid.NewV4()
+	host := &models.Host{
+		HostUUID: hostUUID,
+		Hostname: "WS02",
+		IOCs:     []models.IOC{{Path: "C:\\Windows\\Temp\\svc.exe", FileHash: "abcd"}},
+	}
+	err := db.Session().Create(host).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Remove(host)
+
+	beacon := &clientpb.Beacon{ID: "beacon-1", Name: "IMPLANT", UUID: hostUUID.String()}
+	RecordCommand("/rpcpb.SliverRPC/Ls", "alice", nil, beacon, []byte(`{"Path":"/"}`), &sliverpb.Ls{}, nil)
+	RecordCommand("/rpcpb.SliverRPC/Upload", "alice", nil, beacon, []byte(`{"Path":"C:\\Windows\\Temp\\svc.exe"}`), &sliverpb.Upload{}, nil)
+	RecordCommand("/rpcpb.SliverRPC/StartService", "bob", nil, beacon, []byte(`{"ServiceName":"svc"}`), &sliverpb.ServiceInfo{}, nil)
+
+	timeline, err := Timeline(hostUUID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := map[string]int{}
+	for index, event := range timeline.Events {
+		if 0 < index && event.Timestamp < timeline.Events[index-1].Timestamp {
+			t.Fatalf("Expected events in chronological order, got %v", timeline.Events)
+		}
+		kinds[event.Kind]++
+		if event.Kind == TimelinePersistence && (event.Operator != "bob" || !strings.HasPrefix(event.Summary, "StartService")) {
+			t.Fatalf("Unexpected persistence event %v", event)
+		}
+	}
+	if timeline.Events[0].Kind != TimelineContact {
+		t.Fatalf("Expected first contact to be the first event, got %v", timeline.Events[0])
+	}
+	// The upload command and the IOC it left behind
+	if kinds[TimelineTask] != 1 || kinds[TimelineFile] != 2 || kinds[TimelinePersistence] != 1 {
+		t.Fatalf("Unexpected event kinds %v", kinds)
+	}
+
+	_, err = Timeline(uuid.Must(uuid.NewV4()).String())
+	if err != ErrHostNotFound {
+		t.Fatalf("Expected host not found, got %v", err)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	hostUUID, _ := uuid.NewV4()
+	host := &models.Host{HostUUID: hostUUID, Hostname: "WS03"}
+	err := db.Session().Create(host).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Remove(host)
+
+	session := &clientpb.Session{ID: "session-3", Name: "IMPLANT", UUID: hostUUID.String()}
+	RecordCommand("/rpcpb.SliverRPC/Ps", "alice", session, nil, []byte(`{}`), &sliverpb.Ps{}, nil)
+	RecordCommand("/rpcpb.SliverRPC/Ps", "bob", session, nil, []byte(`{}`), &sliverpb.Ps{}, nil)
+	RecordCommand("/rpcpb.SliverRPC/ProcessDump", "alice", session, nil, []byte(`{"Pid":612}`), nil, errors.New("access denied"))
+	RecordCommand("/rpcpb.SliverRPC/Ping", "alice", session, nil, []byte(`{}`), &sliverpb.Ping{}, nil)
+
+	coverage, err := Coverage([]string{hostUUID.String()}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(coverage.Hosts) != 1 || len(coverage.Techniques) != 2 {
+		t.Fatalf("Unexpected coverage %v", coverage)
+	}
+	// Credential access comes before discovery in the kill chain
+	dump, ps := coverage.Techniques[0], coverage.Techniques[1]
+	if dump.ID != "T1003.001" || dump.Count != 0 || dump.Failed != 1 {
+		t.Fatalf("Unexpected technique %v", dump)
+	}
+	if ps.ID != "T1057" || ps.Count != 2 || len(ps.Operators) != 2 {
+		t.Fatalf("Unexpected technique %v", ps)
+	}
+}
+
+func TestMergeDiscoveredHost(t *testing.T) {
+	previous := &commonpb.DiscoveredHost{
+		Address:   "10.0.0.5",
+		MAC:       "00:0c:29:aa:bb:cc",
+		Hostnames: []string{"FS01"},
+		Shares:    []*commonpb.DiscoveredShare{{Name: "OLD"}},
+		Sources:   []string{"arp", "smb"},
+	}
+	found := &commonpb.DiscoveredHost{
+		Address:   "10.0.0.5",
+		Hostnames: []string{"fs01", "fs01.corp.local"},
+		Workgroup: "CORP",
+		Roles:     []string{"file-server"},
+		Shares:    []*commonpb.DiscoveredShare{{Name: "C$"}, {Name: "DATA"}},
+		Sources:   []string{"netbios", "smb"},
+	}
+	merged := mergeDiscoveredHost(previous, found)
+	if merged.MAC != previous.MAC || merged.Workgroup != "CORP" {
+		t.Fatalf("Unexpected merged host %v", merged)
+	}
+	if len(merged.Hostnames) != 2 || len(merged.Sources) != 3 || len(merged.Roles) != 1 {
+		t.Fatalf("Unexpected merged host %v", merged)
+	}
+	if len(merged.Shares) != 2 || merged.Shares[0].Name != "C$" {
+		t.Fatalf("Shares were not replaced %v", merged.Shares)
+	}
+	if len(previous.Shares) != 1 || len(previous.Sources) != 2 {
+		t.Fatalf("Previous host was modified %v", previous)
+	}
+}
+
+func TestDiscoveredHostsInventory(t *testing.T) {
+	hostUUID, _ := uuid.NewV4()
+	host := &models.Host{HostUUID: hostUUID, Hostname: "WS04"}
+	err := db.Session().Create(host).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Remove(host)
+	implantHost := &models.Host{HostUUID: uuid.Must(uuid.NewV4()), Hostname: "dc01"}
+	err = db.Session().Create(implantHost).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Remove(implantHost)
+
+	session := &clientpb.Session{ID: "session-4", Name: "IMPLANT", UUID: hostUUID.String()}
+	discover := &sliverpb.Discover{Hosts: []*commonpb.DiscoveredHost{
+		{Address: "10.0.0.10", Sources: []string{"arp"}},
+		{Address: "10.0.0.11", Hostnames: []string{"DC01.corp.local"}, Sources: []string{"netbios"}},
+	}}
+	RecordCommand("/rpcpb.SliverRPC/Discover", "alice", session, nil, []byte(`{}`), discover, nil)
+
+	// Hosts that are already in the inventory aren't added again
+	discovered, err := db.HostByHostUUID(uuid.NewV5(hostUUID, "10.0.0.10").String())
+	if err != nil || discovered.Hostname != "10.0.0.10" || len(discovered.Tags) != 1 || discovered.Tags[0].Name != DiscoveredHostTag {
+		t.Fatalf("Expected the address to be added to the inventory, got %v (%v)", discovered, err)
+	}
+	defer Remove(discovered)
+	if _, err := db.HostByHostUUID(uuid.NewV5(hostUUID, "10.0.0.11").String()); err == nil {
+		t.Fatal("Expected the host with an implant to not be added again")
+	}
+
+	// Rediscovering the address updates the same host
+	discover = &sliverpb.Discover{Hosts: []*commonpb.DiscoveredHost{
+		{Address: "10.0.0.10", Hostnames: []string{"printer01"}, Sources: []string{"mdns"}},
+	}}
+	RecordCommand("/rpcpb.SliverRPC/Discover", "alice", session, nil, []byte(`{}`), discover, nil)
+	discovered, err = db.HostByHostUUID(uuid.NewV5(hostUUID, "10.0.0.10").String())
+	if err != nil || discovered.Hostname != "printer01" {
+		t.Fatalf("Expected the discovered host to be renamed, got %v (%v)", discovered, err)
+	}
+}
@@ -0,0 +1,164 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/loot"
+)
+
+// Kinds of timeline events
+const (
+	TimelineContact     = "contact"
+	TimelineSession     = "session"
+	TimelineBeacon      = "beacon"
+	TimelineTask        = "task"
+	TimelineFile        = "file"
+	TimelinePersistence = "persistence"
+	TimelineLoot        = "loot"
+)
+
+var (
+	// fileMethods - Commands that touch the host's file system
+	fileMethods = map[string]bool{
+		"Upload":   true,
+		"Download": true,
+		"Mv":       true,
+		"Cp":       true,
+		"Rm":       true,
+		"Mkdir":    true,
+		"Chmod":    true,
+		"Chown":    true,
+		"Chtimes":  true,
+	}
+
+	// persistenceMethods - Commands that leave something behind on the host
+	persistenceMethods = map[string]bool{
+		"Backdoor":          true,
+		"StartService":      true,
+		"RegistryWrite":     true,
+		"RegistryCreateKey": true,
+	}
+)
+
+// Timeline - Everything that happened on a host in chronological order: first contact,
+// sessions and beacons opened, commands run, files touched, and loot collected
+func Timeline(hostUUID string) (*clientpb.HostTimeline, error) {
+	dbHost, err := db.HostByHostUUID(hostUUID)
+	if err != nil {
+		return nil, ErrHostNotFound
+	}
+	timeline := &clientpb.HostTimeline{
+		Host: dbHost.ToProtobuf(),
+		Events: []*clientpb.TimelineEvent{{
+			Timestamp: dbHost.CreatedAt.Unix(),
+			Kind:      TimelineContact,
+			Summary:   fmt.Sprintf("first contact with %s", dbHost.Hostname),
+		}},
+	}
+
+	for _, session := range core.Sessions.All() {
+		if session.UUID != dbHost.HostUUID.String() {
+			continue
+		}
+		timeline.Events = append(timeline.Events, &clientpb.TimelineEvent{
+			Timestamp:   session.FirstContact,
+			Kind:        TimelineSession,
+			ImplantName: session.Name,
+			Summary:     fmt.Sprintf("session opened as %s (%s, %s)", session.Username, session.Connection.Transport, session.Connection.RemoteAddress),
+		})
+	}
+	beacons, err := db.BeaconsByHostUUID(dbHost.HostUUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, beacon := range beacons {
+		timeline.Events = append(timeline.Events, &clientpb.TimelineEvent{
+			Timestamp:   beacon.CreatedAt.Unix(),
+			Kind:        TimelineBeacon,
+			ImplantName: beacon.Name,
+			Summary:     fmt.Sprintf("beacon opened as %s (%s, %s)", beacon.Username, beacon.Transport, beacon.RemoteAddress),
+		})
+	}
+
+	commands, err := db.HostCommands(dbHost.HostUUID, -1)
+	if err != nil {
+		return nil, err
+	}
+	for index := len(commands) - 1; 0 <= index; index-- {
+		timeline.Events = append(timeline.Events, commandEvent(commands[index]))
+	}
+
+	for _, ioc := range dbHost.IOCs {
+		summary := fmt.Sprintf("wrote %s", ioc.Path)
+		if ioc.FileHash != "" {
+			summary += fmt.Sprintf(" (%s)", ioc.FileHash)
+		}
+		timeline.Events = append(timeline.Events, &clientpb.TimelineEvent{
+			Timestamp: ioc.CreatedAt.Unix(),
+			Kind:      TimelineFile,
+			Summary:   summary,
+		})
+	}
+
+	results, err := loot.GetLootStore().Search(&clientpb.LootSearchReq{Host: dbHost.HostUUID.String()})
+	if err != nil {
+		return nil, err
+	}
+	for _, lootItem := range results.Loot {
+		timeline.Events = append(timeline.Events, &clientpb.TimelineEvent{
+			Timestamp: lootItem.CreatedAt,
+			Kind:      TimelineLoot,
+			Summary:   fmt.Sprintf("looted %s", lootItem.Name),
+		})
+	}
+
+	// Stable so events with the same timestamp keep the order they were added in
+	sort.SliceStable(timeline.Events, func(i, j int) bool {
+		return timeline.Events[i].Timestamp < timeline.Events[j].Timestamp
+	})
+	return timeline, nil
+}
+
+func commandEvent(command *models.HostCommand) *clientpb.TimelineEvent {
+	kind := TimelineTask
+	if fileMethods[command.Method] {
+		kind = TimelineFile
+	} else if persistenceMethods[command.Method] {
+		kind = TimelinePersistence
+	}
+	summary := command.Method
+	if command.Request != "" {
+		summary += " " + command.Request
+	}
+	return &clientpb.TimelineEvent{
+		Timestamp:   command.CreatedAt.Unix(),
+		Kind:        kind,
+		ImplantName: command.ImplantName,
+		Operator:    command.Operator,
+		Summary:     summary,
+		Error:       command.Error,
+	}
+}
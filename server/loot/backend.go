@@ -19,14 +19,18 @@ package loot
 */
 
 import (
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/encryption"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/gofrs/uuid"
 	"google.golang.org/protobuf/proto"
@@ -56,13 +60,26 @@ func (l *LocalBackend) Add(loot *clientpb.Loot) (*clientpb.Loot, error) {
 	} else {
 		hostID = host.ID
 	}
+	dbSession := db.Session()
+	digest := ""
+	if loot.File != nil && 0 < len(loot.File.Data) {
+		digest = fmt.Sprintf("%x", sha256.Sum256(loot.File.Data))
+		existing := &models.Loot{}
+		err = dbSession.Where(&models.Loot{SHA256: digest}).First(existing).Error
+		if err == nil {
+			// Duplicate content, keep the existing loot and merge any new tags
+			lootLog.Infof("Loot %s is a duplicate of %s", loot.GetName(), existing.ID)
+			return l.Tag(existing.ID.String(), loot.Tags, nil)
+		}
+	}
 	dbLoot := &models.Loot{
 		Name:         loot.GetName(),
 		FileType:     int(loot.GetFileType()),
 		Size:         int64(len(loot.File.Data)),
+		SHA256:       digest,
 		OriginHostID: hostID,
+		Tags:         lootTags(loot.Tags),
 	}
-	dbSession := db.Session()
 	err = dbSession.Create(dbLoot).Error
 	if err != nil {
 		return nil, err
@@ -74,6 +91,10 @@ func (l *LocalBackend) Add(loot *clientpb.Loot) (*clientpb.Loot, error) {
 		if err != nil {
 			return nil, err
 		}
+		data, err = encryption.Seal(data)
+		if err != nil {
+			return nil, err
+		}
 		err = os.WriteFile(lootLocalFile, data, 0600)
 		if err != nil {
 			return nil, err
@@ -111,6 +132,47 @@ func (l *LocalBackend) Update(lootReq *clientpb.Loot) (*clientpb.Loot, error) {
 	return l.GetContent(lootReq.ID, false)
 }
 
+// Tag - Add and remove tags on a piece of loot
+func (l *LocalBackend) Tag(lootID string, add []string, remove []string) (*clientpb.Loot, error) {
+	dbSession := db.Session()
+	lootUUID, err := uuid.FromString(lootID)
+	if err != nil {
+		return nil, ErrInvalidLootID
+	}
+	dbLoot := &models.Loot{}
+	result := dbSession.Where(&models.Loot{ID: lootUUID}).Preload("Tags").First(dbLoot)
+	if errors.Is(result.Error, db.ErrRecordNotFound) {
+		return nil, ErrLootNotFound
+	}
+	existing := map[string]bool{}
+	for _, tag := range dbLoot.Tags {
+		existing[tag.Name] = true
+	}
+	for _, tag := range lootTags(remove) {
+		if existing[tag.Name] {
+			err = dbSession.Where(&models.LootTag{LootID: dbLoot.ID, Name: tag.Name}).Delete(&models.LootTag{}).Error
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, tag := range lootTags(add) {
+		if !existing[tag.Name] {
+			tag.LootID = dbLoot.ID
+			err = dbSession.Create(&tag).Error
+			if err != nil {
+				return nil, err
+			}
+			existing[tag.Name] = true
+		}
+	}
+	loot, err := l.GetContent(lootID, false)
+	if err == nil && loot.File != nil {
+		loot.File.Data = nil
+	}
+	return loot, err
+}
+
 // Rm - Remove a piece of loot
 func (l *LocalBackend) Rm(lootID string) error {
 	dbSession := db.Session()
@@ -133,6 +195,10 @@ func (l *LocalBackend) Rm(lootID string) error {
 		}
 	}
 
+	err = dbSession.Where(&models.LootTag{LootID: dbLoot.ID}).Delete(&models.LootTag{}).Error
+	if err != nil {
+		return err
+	}
 	result = dbSession.Delete(&dbLoot)
 	return result.Error
 }
@@ -145,7 +211,7 @@ func (l *LocalBackend) GetContent(lootID string, eager bool) (*clientpb.Loot, er
 		return nil, ErrInvalidLootID
 	}
 	dbLoot := &models.Loot{}
-	result := dbSession.Where(&models.Loot{ID: lootUUID}).First(dbLoot)
+	result := dbSession.Where(&models.Loot{ID: lootUUID}).Preload("Tags").First(dbLoot)
 	if errors.Is(result.Error, db.ErrRecordNotFound) {
 		return nil, ErrLootNotFound
 	}
@@ -160,6 +226,10 @@ func (l *LocalBackend) GetContent(lootID string, eager bool) (*clientpb.Loot, er
 		if err != nil {
 			return nil, err
 		}
+		data, err = encryption.Open(data)
+		if err != nil {
+			return nil, err
+		}
 		loot.File = &commonpb.File{}
 		err = proto.Unmarshal(data, loot.File)
 		if err != nil {
@@ -193,3 +263,76 @@ func (l *LocalBackend) All() *clientpb.AllLoot {
 	}
 	return all
 }
+
+// lootTags - Normalize free-form tags, tags are trimmed, lower case, and unique
+func lootTags(names []string) []models.LootTag {
+	tags := []models.LootTag{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, models.LootTag{Name: name})
+	}
+	return tags
+}
+
+// backfillLootHashes - Loot added before content hashes were recorded is hashed
+// the first time the loot store is used so it can be de-duplicated
+func (l *LocalBackend) backfillLootHashes() {
+	dbSession := db.Session()
+	allDBLoot := []*models.Loot{}
+	err := dbSession.Where("sha256 = ? OR sha256 IS NULL", "").Find(&allDBLoot).Error
+	if err != nil {
+		lootLog.Errorf("Failed to query loot hashes: %s", err)
+		return
+	}
+	for _, dbLoot := range allDBLoot {
+		loot, err := l.GetContent(dbLoot.ID.String(), true)
+		if err != nil || loot.File == nil || len(loot.File.Data) == 0 {
+			continue
+		}
+		digest := fmt.Sprintf("%x", sha256.Sum256(loot.File.Data))
+		err = dbSession.Model(dbLoot).Update("SHA256", digest).Error
+		if err != nil {
+			lootLog.Errorf("Failed to update loot hash: %s", err)
+		}
+	}
+}
+
+// encryptLootFiles - Encrypt loot files written before at-rest encryption was enabled
+func (l *LocalBackend) encryptLootFiles() {
+	if !encryption.Enabled() {
+		return
+	}
+	entries, err := os.ReadDir(l.LocalFileDir)
+	if err != nil {
+		lootLog.Errorf("Failed to read loot dir: %s", err)
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		lootLocalFile := filepath.Join(l.LocalFileDir, entry.Name())
+		data, err := os.ReadFile(lootLocalFile)
+		if err != nil || encryption.IsSealed(data) {
+			continue
+		}
+		sealed, err := encryption.Seal(data)
+		if err != nil {
+			lootLog.Errorf("Failed to encrypt loot file %s: %s", entry.Name(), err)
+			return
+		}
+		// Write then rename so a crash never leaves a partially written file
+		err = os.WriteFile(lootLocalFile+".tmp", sealed, 0600)
+		if err == nil {
+			err = os.Rename(lootLocalFile+".tmp", lootLocalFile)
+		}
+		if err != nil {
+			lootLog.Errorf("Failed to encrypt loot file %s: %s", entry.Name(), err)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+)
+
+const (
+	// ExportCSV - Loot and credential metadata as CSV
+	ExportCSV = "csv"
+	// ExportJSON - Loot and credential metadata as JSON
+	ExportJSON = "json"
+	// ExportZip - Loot files grouped by host or tag, with a JSON manifest
+	ExportZip = "zip"
+
+	// GroupByHost - Group zip exports by origin host
+	GroupByHost = "host"
+	// GroupByTag - Group zip exports by tag, loot with multiple tags appears in each group
+	GroupByTag = "tag"
+
+	unknownHost = "unknown-host"
+	untagged    = "untagged"
+)
+
+var (
+	// ErrInvalidExportFormat - Unsupported export format
+	ErrInvalidExportFormat = errors.New("invalid export format, must be one of: csv, json, zip")
+	// ErrInvalidExportGroup - Unsupported zip grouping
+	ErrInvalidExportGroup = errors.New("invalid export grouping, must be one of: host, tag")
+)
+
+// exportRecord - A flattened loot or credential for CSV and JSON exports
+type exportRecord struct {
+	Kind      string   `json:"kind"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	FileName  string   `json:"file_name,omitempty"`
+	FileType  string   `json:"file_type,omitempty"`
+	Size      int64    `json:"size,omitempty"`
+	SHA256    string   `json:"sha256,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	Host      string   `json:"host,omitempty"`
+	HostID    string   `json:"host_id,omitempty"`
+	CreatedAt string   `json:"created_at,omitempty"`
+
+	Username   string `json:"username,omitempty"`
+	Plaintext  string `json:"plaintext,omitempty"`
+	Hash       string `json:"hash,omitempty"`
+	HashType   string `json:"hash_type,omitempty"`
+	Collection string `json:"collection,omitempty"`
+}
+
+// Export - Export the loot and credentials matching a search filter
+func (l *LootStore) Export(req *clientpb.LootExportReq) (*commonpb.File, error) {
+	filter := req.Filter
+	if filter == nil {
+		filter = &clientpb.LootSearchReq{}
+	}
+	results, err := l.Search(filter)
+	if err != nil {
+		return nil, err
+	}
+	hosts := newHostCache()
+	records := exportRecords(results, hosts)
+	name := fmt.Sprintf("loot-%s.%s", time.Now().Format("20060102150405"), req.Format)
+
+	var data []byte
+	switch req.Format {
+	case ExportCSV:
+		data, err = exportCSV(records)
+	case ExportJSON:
+		data, err = json.MarshalIndent(records, "", "    ")
+	case ExportZip:
+		data, err = l.exportZip(results.Loot, records, req.GroupBy, hosts)
+	default:
+		return nil, ErrInvalidExportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.File{Name: name, Data: data}, nil
+}
+
+func exportRecords(results *clientpb.LootSearchResults, hosts *hostCache) []*exportRecord {
+	records := []*exportRecord{}
+	for _, loot := range results.Loot {
+		record := &exportRecord{
+			Kind:      "loot",
+			ID:        loot.ID,
+			Name:      loot.Name,
+			FileType:  strings.ToLower(loot.FileType.String()),
+			Size:      loot.Size,
+			SHA256:    loot.SHA256,
+			Tags:      loot.Tags,
+			Host:      hosts.Hostname(loot.OriginHostUUID),
+			HostID:    loot.OriginHostUUID,
+			CreatedAt: time.Unix(loot.CreatedAt, 0).UTC().Format(time.RFC3339),
+		}
+		if loot.File != nil {
+			record.FileName = loot.File.Name
+		}
+		records = append(records, record)
+	}
+	for _, credential := range results.Credentials {
+		records = append(records, &exportRecord{
+			Kind:       "credential",
+			ID:         credential.ID,
+			Host:       hosts.Hostname(credential.OriginHostUUID),
+			HostID:     credential.OriginHostUUID,
+			Username:   credential.Username,
+			Plaintext:  credential.Plaintext,
+			Hash:       credential.Hash,
+			HashType:   credential.HashType.String(),
+			Collection: credential.Collection,
+		})
+	}
+	return records
+}
+
+func exportCSV(records []*exportRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write([]string{
+		"kind", "id", "name", "file_name", "file_type", "size", "sha256", "tags", "host", "host_id", "created_at",
+		"username", "plaintext", "hash", "hash_type", "collection",
+	})
+	for _, record := range records {
+		size := ""
+		if record.Kind == "loot" {
+			size = fmt.Sprintf("%d", record.Size)
+		}
+		writer.Write([]string{
+			record.Kind, record.ID, record.Name, record.FileName, record.FileType, size, record.SHA256,
+			strings.Join(record.Tags, ";"), record.Host, record.HostID, record.CreatedAt,
+			record.Username, record.Plaintext, record.Hash, record.HashType, record.Collection,
+		})
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// exportZip - Loot files are stored as <group>/<short id>_<file name> so files with the
+// same name never collide, manifest.json describes every exported loot and credential
+func (l *LootStore) exportZip(allLoot []*clientpb.Loot, records []*exportRecord, groupBy string, hosts *hostCache) ([]byte, error) {
+	if groupBy == "" {
+		groupBy = GroupByHost
+	}
+	if groupBy != GroupByHost && groupBy != GroupByTag {
+		return nil, ErrInvalidExportGroup
+	}
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, loot := range allLoot {
+		content, err := l.GetContent(loot.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		if content.File == nil {
+			continue
+		}
+		fileName := path.Base(strings.ReplaceAll(content.File.Name, "\\", "/"))
+		if fileName == "." || fileName == "/" || fileName == "" {
+			fileName = loot.Name
+		}
+		fileName = fmt.Sprintf("%s_%s", strings.Split(loot.ID, "-")[0], fileName)
+		for _, group := range exportGroups(loot, groupBy, hosts) {
+			writer, err := archive.Create(path.Join(exportSafeName(group), exportSafeName(fileName)))
+			if err != nil {
+				return nil, err
+			}
+			_, err = writer.Write(content.File.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	manifest, err := json.MarshalIndent(records, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	writer, err := archive.Create("manifest.json")
+	if err != nil {
+		return nil, err
+	}
+	_, err = writer.Write(manifest)
+	if err != nil {
+		return nil, err
+	}
+	err = archive.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func exportGroups(loot *clientpb.Loot, groupBy string, hosts *hostCache) []string {
+	if groupBy == GroupByTag {
+		if len(loot.Tags) == 0 {
+			return []string{untagged}
+		}
+		return loot.Tags
+	}
+	hostname := hosts.Hostname(loot.OriginHostUUID)
+	if hostname == "" {
+		return []string{unknownHost}
+	}
+	return []string{hostname}
+}
+
+// exportSafeName - Zip entry names must not be able to escape the export directory
+func exportSafeName(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(name)
+	if name == "" {
+		return "_"
+	}
+	return name
+}
@@ -22,11 +22,16 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/assets"
 )
 
+var (
+	backfillOnce = &sync.Once{}
+)
+
 const (
 	// MaxLootSize - The maximum size of a loot file in bytes
 	MaxLootSize = 2 * 1024 * 1024 * 1024 // 2Gb, shouldn't matter the gRPC message size limit is 2Gb
@@ -39,6 +44,7 @@ type LootBackend interface {
 	Update(*clientpb.Loot) (*clientpb.Loot, error)
 	GetContent(string, bool) (*clientpb.Loot, error)
 	All() *clientpb.AllLoot
+	Tag(string, []string, []string) (*clientpb.Loot, error)
 }
 
 // LootStore - The struct that represents the loot store
@@ -76,6 +82,11 @@ func (l *LootStore) Rm(lootID string) error {
 	return nil
 }
 
+// Tag - Add and remove tags on a piece of loot
+func (l *LootStore) Tag(lootID string, add []string, remove []string) (*clientpb.Loot, error) {
+	return l.backend.Tag(lootID, add, remove)
+}
+
 // GetContent - Get the content of a piece of loot from the loot store
 func (l *LootStore) GetContent(lootID string, eager bool) (*clientpb.Loot, error) {
 	return l.backend.GetContent(lootID, eager)
@@ -88,10 +99,15 @@ func (l *LootStore) All() *clientpb.AllLoot {
 
 // GetLootStore - Get an instances of the core LootStore
 func GetLootStore() *LootStore {
+	backend := &LocalBackend{
+		LocalFileDir: GetLootDir(),
+	}
+	backfillOnce.Do(func() {
+		backend.backfillLootHashes()
+		backend.encryptLootFiles()
+	})
 	return &LootStore{
-		backend: &LocalBackend{
-			LocalFileDir: GetLootDir(),
-		},
+		backend: backend,
 	}
 }
 
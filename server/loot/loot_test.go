@@ -187,3 +187,77 @@ func TestLootErrors(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestLootTagSearchDedup(t *testing.T) {
+	lootStore := GetLootStore()
+	loot, err := lootStore.Add(&clientpb.Loot{
+		Name:     name2,
+		FileType: clientpb.FileType_TEXT,
+		File: &commonpb.File{
+			Name: "passwords.txt",
+			Data: []byte("administrator:hunter2"),
+		},
+		Tags: []string{"DC01", "lsass"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loot.Tags) != 2 || loot.Tags[0] != "dc01" {
+		t.Fatalf("Expected normalized tags, got %v", loot.Tags)
+	}
+
+	// Adding the same content again should merge tags instead of storing a copy
+	dup, err := lootStore.Add(&clientpb.Loot{
+		Name:     name1,
+		FileType: clientpb.FileType_TEXT,
+		File: &commonpb.File{
+			Name: "copy.txt",
+			Data: []byte("administrator:hunter2"),
+		},
+		Tags: []string{"domain-admin"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dup.ID != loot.ID {
+		t.Fatalf("Expected duplicate loot to be de-duplicated %s != %s", dup.ID, loot.ID)
+	}
+	if len(dup.Tags) != 3 {
+		t.Fatalf("Expected merged tags, got %v", dup.Tags)
+	}
+
+	loot, err = lootStore.Tag(loot.ID, nil, []string{"LSASS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loot.Tags) != 2 {
+		t.Fatalf("Expected tag to be removed, got %v", loot.Tags)
+	}
+
+	results, err := lootStore.Search(&clientpb.LootSearchReq{Query: "hunter2", Content: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Loot) != 1 {
+		t.Fatalf("Expected content search to match, got %d result(s)", len(results.Loot))
+	}
+	results, err = lootStore.Search(&clientpb.LootSearchReq{Query: "hunter2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Loot) != 0 {
+		t.Fatalf("Expected no match without content search, got %d result(s)", len(results.Loot))
+	}
+	results, err = lootStore.Search(&clientpb.LootSearchReq{Tags: []string{"lsass"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Loot) != 0 {
+		t.Fatalf("Expected no match for removed tag, got %d result(s)", len(results.Loot))
+	}
+
+	err = lootStore.Rm(loot.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync/atomic"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/util/encoders"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultMaxResultSize - Beacon task results larger than this are saved as loot
+	// instead of being held in the database
+	DefaultMaxResultSize = 64 * 1024 * 1024
+
+	// MaxSessionResultSize - Session results aren't held in the database, they are only
+	// saved as loot if they wouldn't fit in the grpc message to the client (2Gb - 1 byte,
+	// see client/transport), leaving room for the rest of the response
+	MaxSessionResultSize = 2*1024*1024*1024 - 1024*1024
+
+	// ResultTag - Tag of the loot of oversized task results
+	ResultTag = "task-result"
+)
+
+var maxResultSize atomic.Int64
+
+func init() {
+	maxResultSize.Store(DefaultMaxResultSize)
+}
+
+// ReloadResultLimits - Apply the result size limit of the server config
+func ReloadResultLimits(config *configs.ServerConfig) {
+	size := int64(DefaultMaxResultSize)
+	if config.Results != nil && config.Results.MaxSize != 0 {
+		size = config.Results.MaxSize
+	}
+	maxResultSize.Store(size)
+}
+
+// MaxResultSize - The largest task result that isn't diverted to loot, 0 if unlimited
+func MaxResultSize() int64 {
+	size := maxResultSize.Load()
+	if size < 0 {
+		return 0
+	}
+	return size
+}
+
+// IsOversizedResult - The beacon task result has to be diverted to loot
+func IsOversizedResult(data []byte) bool {
+	size := MaxResultSize()
+	return 0 < size && size < int64(len(data))
+}
+
+// IsOversizedSessionResult - The session result has to be diverted to loot
+func IsOversizedSessionResult(data []byte) bool {
+	return MaxSessionResultSize < int64(len(data))
+}
+
+// DivertResult - Save an oversized task result as loot, results with file content
+// (downloads, screenshots, etc.) are saved as that file, anything else as the result's
+// protobuf message
+func (l *LootStore) DivertResult(name string, hostUUID string, reqType uint32, data []byte) (*clientpb.Loot, error) {
+	file, fileType, err := resultFile(reqType, data)
+	if err != nil {
+		return nil, err
+	}
+	return l.Add(&clientpb.Loot{
+		Name:           name,
+		FileType:       fileType,
+		File:           file,
+		OriginHostUUID: hostUUID,
+		Tags:           []string{ResultTag},
+	})
+}
+
+func resultFile(reqType uint32, data []byte) (*commonpb.File, clientpb.FileType, error) {
+	switch reqType {
+	case sliverpb.MsgDownloadReq:
+		download := &sliverpb.Download{}
+		if proto.Unmarshal(data, download) == nil {
+			name := path.Base(strings.ReplaceAll(download.Path, "\\", "/"))
+			if download.IsDir {
+				name += ".tar.gz"
+			}
+			content := download.Data
+			if download.Encoder == "gzip" {
+				var err error
+				content, err = encoders.Gzip{}.Decode(download.Data)
+				if err != nil {
+					return nil, clientpb.FileType_BINARY, fmt.Errorf("failed to decode download of %s: %w", download.Path, err)
+				}
+			}
+			return &commonpb.File{Name: name, Data: content}, clientpb.FileType_BINARY, nil
+		}
+	case sliverpb.MsgExecuteReq, sliverpb.MsgExecuteWindowsReq:
+		execute := &sliverpb.Execute{}
+		if proto.Unmarshal(data, execute) == nil {
+			output := append(append(execute.Stdout, '\n'), execute.Stderr...)
+			return &commonpb.File{Name: "output.txt", Data: output}, clientpb.FileType_TEXT, nil
+		}
+	case sliverpb.MsgScreenshotReq:
+		screenshot := &sliverpb.Screenshot{}
+		if proto.Unmarshal(data, screenshot) == nil {
+			return &commonpb.File{Name: "screenshot.png", Data: screenshot.Data}, clientpb.FileType_BINARY, nil
+		}
+	case sliverpb.MsgProcessDumpReq:
+		dump := &sliverpb.ProcessDump{}
+		if proto.Unmarshal(data, dump) == nil {
+			return &commonpb.File{Name: "process.dmp", Data: dump.Data}, clientpb.FileType_BINARY, nil
+		}
+	}
+	return &commonpb.File{Name: fmt.Sprintf("result-%d.pb", reqType), Data: data}, clientpb.FileType_BINARY, nil
+}
@@ -0,0 +1,79 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/util/encoders"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResultLimits(t *testing.T) {
+	defer ReloadResultLimits(&configs.ServerConfig{})
+
+	ReloadResultLimits(&configs.ServerConfig{Results: &configs.ResultsConfig{MaxSize: 16}})
+	if IsOversizedResult(make([]byte, 16)) || !IsOversizedResult(make([]byte, 17)) {
+		t.Fatal("expected results over 16 bytes to be oversized")
+	}
+	ReloadResultLimits(&configs.ServerConfig{Results: &configs.ResultsConfig{MaxSize: -1}})
+	if MaxResultSize() != 0 || IsOversizedResult(make([]byte, 2*DefaultMaxResultSize)) {
+		t.Fatal("expected a negative max size to disable the limit")
+	}
+	ReloadResultLimits(&configs.ServerConfig{})
+	if MaxResultSize() != DefaultMaxResultSize {
+		t.Fatalf("expected the default limit, got %d", MaxResultSize())
+	}
+	if IsOversizedSessionResult(make([]byte, 2*DefaultMaxResultSize)) {
+		t.Fatal("expected session results to only be limited by the grpc message size")
+	}
+}
+
+func TestResultFile(t *testing.T) {
+	content := randomData()
+	compressed, _ := encoders.Gzip{}.Encode(content)
+	download, _ := proto.Marshal(&sliverpb.Download{Path: "C:\\Users\\foo\\bar.txt", Encoder: "gzip", Data: compressed})
+	file, fileType, err := resultFile(sliverpb.MsgDownloadReq, download)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Name != "bar.txt" || fileType != clientpb.FileType_BINARY || !bytes.Equal(file.Data, content) {
+		t.Fatalf("unexpected download loot %s", file.Name)
+	}
+
+	corrupt, _ := proto.Marshal(&sliverpb.Download{Path: "bar.txt", Encoder: "gzip", Data: content})
+	if _, _, err = resultFile(sliverpb.MsgDownloadReq, corrupt); err == nil {
+		t.Fatal("expected an error for a download that isn't gzip encoded")
+	}
+
+	execute, _ := proto.Marshal(&sliverpb.Execute{Stdout: []byte("out"), Stderr: []byte("err")})
+	file, fileType, _ = resultFile(sliverpb.MsgExecuteReq, execute)
+	if fileType != clientpb.FileType_TEXT || string(file.Data) != "out\nerr" {
+		t.Fatalf("unexpected execute loot %q", file.Data)
+	}
+
+	file, _, _ = resultFile(sliverpb.MsgLsReq, content)
+	if !bytes.Equal(file.Data, content) {
+		t.Fatal("expected other results to be saved as is")
+	}
+}
@@ -0,0 +1,168 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+// Search - Find loot and credentials matching every term in the query, loot is matched
+// on name, file name, tags, and hash (and content if requested), credentials are matched
+// on username, plaintext, hash, and collection. Tag and host filters are applied first.
+func (l *LootStore) Search(req *clientpb.LootSearchReq) (*clientpb.LootSearchResults, error) {
+	results := &clientpb.LootSearchResults{
+		Loot:        []*clientpb.Loot{},
+		Credentials: []*clientpb.Credential{},
+	}
+	terms := strings.Fields(strings.ToLower(req.Query))
+	hosts := newHostCache()
+
+	all := l.All()
+	if all == nil {
+		return results, nil
+	}
+	for _, loot := range all.Loot {
+		if !hasAllTags(loot.Tags, req.Tags) || !hosts.matches(loot.OriginHostUUID, req.Host) {
+			continue
+		}
+		fields := append([]string{loot.Name, loot.SHA256}, loot.Tags...)
+		if loot.File != nil {
+			fields = append(fields, loot.File.Name)
+		}
+		if req.Content && loot.FileType == clientpb.FileType_TEXT && 0 < len(terms) {
+			content, err := l.GetContent(loot.ID, true)
+			if err == nil && content.File != nil {
+				fields = append(fields, string(content.File.Data))
+			}
+		}
+		if matchesAllTerms(fields, terms) {
+			results.Loot = append(results.Loot, loot)
+		}
+	}
+
+	// Credentials are not tagged
+	if 0 < len(req.Tags) {
+		return results, nil
+	}
+	credentials := []*models.Credential{}
+	err := db.Session().Where(&models.Credential{}).Find(&credentials).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, credential := range credentials {
+		if !hosts.matches(credential.OriginHostUUID.String(), req.Host) {
+			continue
+		}
+		fields := []string{credential.Username, credential.Plaintext, credential.Hash, credential.Collection}
+		if matchesAllTerms(fields, terms) {
+			results.Credentials = append(results.Credentials, credential.ToProtobuf())
+		}
+	}
+	return results, nil
+}
+
+func matchesAllTerms(fields []string, terms []string) bool {
+	for _, term := range terms {
+		found := false
+		for _, field := range fields {
+			if strings.Contains(strings.ToLower(field), term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func hasAllTags(tags []string, required []string) bool {
+	for _, name := range lootTags(required) {
+		found := false
+		for _, tag := range tags {
+			if tag == name.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hostCache - Resolves loot and credential origin hosts, loot references the host's
+// database ID while credentials reference the host's UUID so we match on either
+type hostCache struct {
+	hosts map[string]*models.Host
+}
+
+func newHostCache() *hostCache {
+	return &hostCache{hosts: map[string]*models.Host{}}
+}
+
+// Get - Lookup a host by database ID or host UUID, returns nil if the host is unknown
+func (h *hostCache) Get(id string) *models.Host {
+	if host, ok := h.hosts[id]; ok {
+		return host
+	}
+	hostID, err := uuid.FromString(id)
+	if err != nil || hostID == uuid.Nil {
+		return nil
+	}
+	host, err := db.HostByHostID(hostID)
+	if err != nil {
+		host, err = db.HostByHostUUID(id)
+	}
+	if err != nil {
+		host = nil
+	}
+	h.hosts[id] = host
+	return host
+}
+
+func (h *hostCache) matches(id string, filter string) bool {
+	if filter == "" {
+		return true
+	}
+	if id == filter {
+		return true
+	}
+	host := h.Get(id)
+	if host == nil {
+		return false
+	}
+	return strings.EqualFold(host.Hostname, filter) || host.HostUUID.String() == filter
+}
+
+// Hostname - The hostname of a loot or credential origin host, or blank if unknown
+func (h *hostCache) Hostname(id string) string {
+	host := h.Get(id)
+	if host == nil {
+		return ""
+	}
+	return host.Hostname
+}
@@ -0,0 +1,165 @@
+package metrics
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/bishopfox/sliver/client/version"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+var (
+	startTime = time.Now()
+)
+
+// taskStateCount - Beacon tasks grouped by state
+type taskStateCount struct {
+	State string
+	Count int64
+}
+
+// Write - Write every metric in the Prometheus text exposition format, gauges are
+// read from the server's state when scraped
+func Write(w io.Writer) {
+	writeMetric(w, "sliver_build_info", "gauge", "Server version", []sample{
+		{labels: labels{"version", version.Version, "commit", version.GitCommit}, value: 1},
+	})
+	writeMetric(w, "sliver_start_time_seconds", "gauge", "Server start time since the unix epoch", []sample{
+		{value: float64(startTime.Unix())},
+	})
+	writeMetric(w, "sliver_operators_online", "gauge", "Connected operators", []sample{
+		{value: float64(len(core.Clients.ActiveOperators()))},
+	})
+
+	writeSessions(w)
+	writeBeacons(w)
+	writeMetric(w, "sliver_beacon_checkins_total", "counter", "Beacon check-ins", beaconCheckin.samples())
+	writeJobs(w)
+	writeBuilders(w)
+
+	writeMetric(w, "sliver_rpc_requests_total", "counter", "Completed rpc calls by method and status code", rpcRequests.samples())
+	rpcDurations.write(w, "sliver_rpc_duration_seconds", "Latency of rpc calls by method")
+}
+
+func writeSessions(w io.Writer) {
+	sessions := map[string]*sample{}
+	dead := 0
+	for _, session := range core.Sessions.All() {
+		transport := session.Connection.Transport
+		if _, ok := sessions[transport]; !ok {
+			sessions[transport] = &sample{labels: labels{"transport", transport}}
+		}
+		sessions[transport].value++
+		if session.IsDead() {
+			dead++
+		}
+	}
+	writeMetric(w, "sliver_sessions", "gauge", "Active sessions by transport", sortedSamples(sessions))
+	writeMetric(w, "sliver_sessions_dead", "gauge", "Sessions that missed their expected check-in", []sample{
+		{value: float64(dead)},
+	})
+}
+
+func writeBeacons(w io.Writer) {
+	beacons, err := db.ListBeacons()
+	if err != nil {
+		metricsLog.Errorf("Failed to list beacons: %s", err)
+		beacons = []*models.Beacon{}
+	}
+	byTransport := map[string]*sample{}
+	late := 0
+	now := time.Now()
+	for _, beacon := range beacons {
+		if _, ok := byTransport[beacon.Transport]; !ok {
+			byTransport[beacon.Transport] = &sample{labels: labels{"transport", beacon.Transport}}
+		}
+		byTransport[beacon.Transport].value++
+		if 0 < beacon.MissedCheckins(now) {
+			late++
+		}
+	}
+	writeMetric(w, "sliver_beacons", "gauge", "Beacons by transport", sortedSamples(byTransport))
+	writeMetric(w, "sliver_beacons_late", "gauge", "Beacons that missed at least one expected check-in", []sample{
+		{value: float64(late)},
+	})
+
+	counts := []taskStateCount{}
+	err = db.Session().Model(&models.BeaconTask{}).Select("state, count(*) as count").Group("state").Scan(&counts).Error
+	if err != nil {
+		metricsLog.Errorf("Failed to count beacon tasks: %s", err)
+	}
+	states := map[string]*sample{
+		models.PENDING:   {labels: labels{"state", models.PENDING}},
+		models.SENT:      {labels: labels{"state", models.SENT}},
+		models.COMPLETED: {labels: labels{"state", models.COMPLETED}},
+		models.CANCELED:  {labels: labels{"state", models.CANCELED}},
+	}
+	for _, count := range counts {
+		if _, ok := states[count.State]; !ok {
+			states[count.State] = &sample{labels: labels{"state", count.State}}
+		}
+		states[count.State].value = float64(count.Count)
+	}
+	writeMetric(w, "sliver_beacon_tasks", "gauge", "Beacon tasks by state, pending tasks are queued for the next check-in", sortedSamples(states))
+}
+
+func writeJobs(w io.Writer) {
+	jobs := []sample{}
+	for _, job := range core.Jobs.All() {
+		jobs = append(jobs, sample{
+			labels: labels{
+				"id", fmt.Sprintf("%d", job.ID),
+				"name", job.Name,
+				"protocol", job.Protocol,
+				"port", fmt.Sprintf("%d", job.Port),
+			},
+			value: 1,
+		})
+	}
+	writeMetric(w, "sliver_job_up", "gauge", "Running jobs (listeners)", jobs)
+}
+
+func writeBuilders(w io.Writer) {
+	builders := []sample{}
+	active := []sample{}
+	queued := []sample{}
+	for _, builder := range core.AllBuilders() {
+		builderLabels := labels{"builder", builder.Name}
+		builders = append(builders, sample{labels: builderLabels, value: 1})
+		activeBuilds, queuedBuilds := core.BuildQueue.Stats(builder.Name)
+		active = append(active, sample{labels: builderLabels, value: float64(activeBuilds)})
+		queued = append(queued, sample{labels: builderLabels, value: float64(queuedBuilds)})
+	}
+	writeMetric(w, "sliver_builder_up", "gauge", "Connected external builders", builders)
+	writeMetric(w, "sliver_builds_active", "gauge", "Builds in progress on external builders", active)
+	writeMetric(w, "sliver_builds_queued", "gauge", "Builds waiting for an external builder", queued)
+}
+
+func sortedSamples(samples map[string]*sample) []sample {
+	sorted := []sample{}
+	for _, key := range sortedKeys(samples) {
+		sorted = append(sorted, *samples[key])
+	}
+	return sorted
+}
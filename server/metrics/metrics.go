@@ -0,0 +1,173 @@
+package metrics
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// DefaultPort - Default metrics listen port
+	DefaultPort = 31339
+
+	certHostname = "metrics"
+	contentType  = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+var (
+	metricsLog = log.NamedLogger("metrics", "server")
+
+	startOnce   = &sync.Once{}
+	server      *http.Server
+	serverMutex = &sync.Mutex{}
+)
+
+// Start - Serve /metrics if enabled in the server config, scrapes must send the
+// configured bearer token. A token is generated and saved if none is configured.
+func Start(config *configs.ServerConfig) {
+	startOnce.Do(func() {
+		err := Reload(config)
+		if err != nil {
+			metricsLog.Errorf("Failed to start metrics server: %s", err)
+		}
+	})
+}
+
+// Reload - Restart the metrics endpoint with a reloaded server config
+func Reload(config *configs.ServerConfig) error {
+	serverMutex.Lock()
+	defer serverMutex.Unlock()
+	if server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			metricsLog.Warnf("Failed to shutdown metrics server: %s", err)
+		}
+		server = nil
+	}
+	if config.Metrics == nil || !config.Metrics.Enabled {
+		return nil
+	}
+	if config.Metrics.Token == "" {
+		buf := make([]byte, 32)
+		rand.Read(buf)
+		config.Metrics.Token = hex.EncodeToString(buf)
+		err := config.Save()
+		if err != nil {
+			return fmt.Errorf("failed to save generated metrics token: %s", err)
+		}
+		metricsLog.Infof("Generated a metrics bearer token, see the server config")
+	}
+	port := config.Metrics.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	metricsServer := &http.Server{
+		Addr:         fmt.Sprintf("%s:%d", config.Metrics.Host, port),
+		Handler:      Handler(config.Metrics.Token),
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+	if config.Metrics.TLS {
+		tlsConfig, err := getMetricsTLSConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get metrics certificate: %s", err)
+		}
+		metricsServer.TLSConfig = tlsConfig
+	}
+	server = metricsServer
+	go func() {
+		metricsLog.Infof("Serving metrics on %s", metricsServer.Addr)
+		var err error
+		if metricsServer.TLSConfig != nil {
+			err = metricsServer.ListenAndServeTLS("", "")
+		} else {
+			err = metricsServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			metricsLog.Errorf("Metrics server exited with error: %s", err)
+		}
+	}()
+	return nil
+}
+
+// Handler - Serves the metrics to requests with the bearer token
+func Handler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !authorized(req, token) {
+			metricsLog.Warnf("Rejected metrics request from %s", req.RemoteAddr)
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		Write(w)
+	})
+	return mux
+}
+
+func authorized(req *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+	given := strings.TrimPrefix(header, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+func getMetricsTLSConfig() (*tls.Config, error) {
+	_, _, err := certs.OperatorServerGetCertificate(certHostname)
+	if err == certs.ErrCertDoesNotExist {
+		certs.OperatorServerGenerateCertificate(certHostname)
+	}
+	certPEM, keyPEM, err := certs.OperatorServerGetCertificate(certHostname)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
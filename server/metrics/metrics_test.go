@@ -0,0 +1,78 @@
+package metrics
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	handler := Handler("secret")
+	for _, auth := range []string{"", "Bearer wrong", "secret", "Basic secret"} {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		if resp.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected '%s' to be unauthorized, got %d", auth, resp.Code)
+		}
+	}
+
+	ObserveRPC("/rpcpb.SliverRPC/Ps", "OK", 30*time.Millisecond)
+	ObserveRPC("/rpcpb.SliverRPC/Ps", "OK", 2*time.Second)
+	BeaconCheckin("mtls")
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("Expected metrics, got %d", resp.Code)
+	}
+	body := resp.Body.String()
+	expected := []string{
+		`sliver_rpc_requests_total{method="/rpcpb.SliverRPC/Ps",code="OK"} 2`,
+		`sliver_rpc_duration_seconds_bucket{method="/rpcpb.SliverRPC/Ps",le="0.05"} 1`,
+		`sliver_rpc_duration_seconds_bucket{method="/rpcpb.SliverRPC/Ps",le="2.5"} 2`,
+		`sliver_rpc_duration_seconds_bucket{method="/rpcpb.SliverRPC/Ps",le="+Inf"} 2`,
+		`sliver_rpc_duration_seconds_count{method="/rpcpb.SliverRPC/Ps"} 2`,
+		`sliver_beacon_checkins_total{transport="mtls"} 1`,
+		`sliver_beacon_tasks{state="pending"} `,
+		"# TYPE sliver_sessions gauge",
+	}
+	for _, line := range expected {
+		if !strings.Contains(body, line) {
+			t.Fatalf("Expected metrics to contain '%s':\n%s", line, body)
+		}
+	}
+}
+
+func TestLabels(t *testing.T) {
+	if value := (labels{"name", "a \"b\"\n\\"}).String(); value != `{name="a \"b\"\n\\"}` {
+		t.Fatalf("Unexpected labels %s", value)
+	}
+	if formatValue(0.25) != "0.25" || formatValue(3) != "3" {
+		t.Fatal("Unexpected value formatting")
+	}
+}
@@ -0,0 +1,190 @@
+package metrics
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// rpcBuckets - Upper bounds (seconds) of the rpc latency histogram, beacon tasks
+	// return once queued while session tasks wait for the implant
+	rpcBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+	rpcRequests   = newCounterVec()
+	rpcDurations  = newHistogramVec(rpcBuckets)
+	beaconCheckin = newCounterVec()
+)
+
+// ObserveRPC - Record the outcome and latency of an rpc call
+func ObserveRPC(method string, code string, duration time.Duration) {
+	rpcRequests.Inc(labels{"method", method, "code", code})
+	rpcDurations.Observe(labels{"method", method}, duration.Seconds())
+}
+
+// BeaconCheckin - Record a beacon checking in for tasks
+func BeaconCheckin(transport string) {
+	beaconCheckin.Inc(labels{"transport", transport})
+}
+
+// labels - Label names and values, in pairs
+type labels []string
+
+func (l labels) String() string {
+	if len(l) == 0 {
+		return ""
+	}
+	pairs := []string{}
+	for index := 0; index+1 < len(l); index += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, l[index], escapeLabel(l[index+1])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// with - Copy the labels with an extra label
+func (l labels) with(name string, value string) labels {
+	return append(append(labels{}, l...), name, value)
+}
+
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case value == math.Trunc(value) && math.Abs(value) < 1e15:
+		return fmt.Sprintf("%d", int64(value))
+	}
+	return fmt.Sprintf("%g", value)
+}
+
+// sample - One line of the text exposition format
+type sample struct {
+	labels labels
+	value  float64
+}
+
+// writeMetric - Write a metric family in the Prometheus text exposition format
+func writeMetric(w io.Writer, name string, kind string, help string, samples []sample) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, kind)
+	for _, sample := range samples {
+		fmt.Fprintf(w, "%s%s %s\n", name, sample.labels, formatValue(sample.value))
+	}
+}
+
+// counterVec - Counters keyed by their labels
+type counterVec struct {
+	values map[string]*sample
+	mutex  *sync.Mutex
+}
+
+func newCounterVec() *counterVec {
+	return &counterVec{values: map[string]*sample{}, mutex: &sync.Mutex{}}
+}
+
+func (c *counterVec) Inc(l labels) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	key := l.String()
+	if _, ok := c.values[key]; !ok {
+		c.values[key] = &sample{labels: l}
+	}
+	c.values[key].value++
+}
+
+func (c *counterVec) samples() []sample {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	samples := []sample{}
+	for _, key := range sortedKeys(c.values) {
+		samples = append(samples, *c.values[key])
+	}
+	return samples
+}
+
+// histogram - Cumulative bucket counts, sum, and count of observations
+type histogram struct {
+	labels  labels
+	buckets []uint64
+	sum     float64
+	count   uint64
+}
+
+// histogramVec - Histograms keyed by their labels
+type histogramVec struct {
+	bounds []float64
+	values map[string]*histogram
+	mutex  *sync.Mutex
+}
+
+func newHistogramVec(bounds []float64) *histogramVec {
+	return &histogramVec{bounds: bounds, values: map[string]*histogram{}, mutex: &sync.Mutex{}}
+}
+
+func (h *histogramVec) Observe(l labels, value float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	key := l.String()
+	if _, ok := h.values[key]; !ok {
+		h.values[key] = &histogram{labels: l, buckets: make([]uint64, len(h.bounds))}
+	}
+	hist := h.values[key]
+	for index, bound := range h.bounds {
+		if value <= bound {
+			hist.buckets[index]++
+		}
+	}
+	hist.sum += value
+	hist.count++
+}
+
+// write - Histograms are written as _bucket, _sum, and _count series
+func (h *histogramVec) write(w io.Writer, name string, help string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s histogram\n", name)
+	for _, key := range sortedKeys(h.values) {
+		hist := h.values[key]
+		for index, bound := range h.bounds {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", name, hist.labels.with("le", formatValue(bound)), hist.buckets[index])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", name, hist.labels.with("le", "+Inf"), hist.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", name, hist.labels, formatValue(hist.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", name, hist.labels, hist.count)
+	}
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := []string{}
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
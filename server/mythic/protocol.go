@@ -0,0 +1,149 @@
+package mythic
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Mythic agent message actions
+const (
+	actionCheckin      = "checkin"
+	actionGetTasking   = "get_tasking"
+	actionPostResponse = "post_response"
+
+	statusSuccess = "success"
+	statusError   = "error"
+
+	// uuidLength - Messages are prefixed with the payload or callback UUID in its string form
+	uuidLength = 36
+)
+
+var (
+	// ErrInvalidMessage - Mythic's response is not a valid agent message
+	ErrInvalidMessage = errors.New("invalid mythic message")
+)
+
+// checkinMsg - Registers a new callback, sent with the payload UUID
+type checkinMsg struct {
+	Action         string   `json:"action"`
+	UUID           string   `json:"uuid"`
+	IPs            []string `json:"ips,omitempty"`
+	OS             string   `json:"os"`
+	User           string   `json:"user"`
+	Host           string   `json:"host"`
+	PID            int32    `json:"pid"`
+	Architecture   string   `json:"architecture"`
+	Domain         string   `json:"domain,omitempty"`
+	IntegrityLevel int      `json:"integrity_level"`
+	ExternalIP     string   `json:"external_ip,omitempty"`
+	ProcessName    string   `json:"process_name"`
+}
+
+type checkinResp struct {
+	Action string `json:"action"`
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type getTaskingMsg struct {
+	Action      string `json:"action"`
+	TaskingSize int    `json:"tasking_size"`
+}
+
+type getTaskingResp struct {
+	Action string  `json:"action"`
+	Tasks  []*Task `json:"tasks"`
+}
+
+// Task - A task issued by a Mythic operator
+type Task struct {
+	ID         string  `json:"id"`
+	Command    string  `json:"command"`
+	Parameters string  `json:"parameters"`
+	Timestamp  float64 `json:"timestamp"`
+}
+
+type postResponseMsg struct {
+	Action    string      `json:"action"`
+	Responses []*response `json:"responses"`
+}
+
+type response struct {
+	TaskID     string `json:"task_id"`
+	UserOutput string `json:"user_output"`
+	Completed  bool   `json:"completed"`
+	Status     string `json:"status,omitempty"`
+}
+
+// encodeMessage - base64(UUID + JSON), the format of unencrypted agent messages
+func encodeMessage(uuid string, msg interface{}) ([]byte, error) {
+	if len(uuid) != uuidLength {
+		return nil, fmt.Errorf("invalid uuid '%s'", uuid)
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(base64.StdEncoding.EncodeToString(append([]byte(uuid), data...))), nil
+}
+
+// decodeMessage - Returns the UUID the message is prefixed with and unmarshals the rest
+func decodeMessage(data []byte, msg interface{}) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidMessage, err)
+	}
+	if len(raw) < uuidLength {
+		return "", ErrInvalidMessage
+	}
+	err = json.Unmarshal(raw[uuidLength:], msg)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidMessage, err)
+	}
+	return string(raw[:uuidLength]), nil
+}
+
+// send - Post an agent message to Mythic's C2 profile and decode the reply
+func (b *Bridge) send(uuid string, msg interface{}, reply interface{}) error {
+	data, err := encodeMessage(uuid, msg)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Post(b.config.URL, "text/plain", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mythic returned %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+	if err != nil {
+		return err
+	}
+	_, err = decodeMessage(body, reply)
+	return err
+}
@@ -0,0 +1,176 @@
+package preflight
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	// Default ports of the generate command, they are the first ports scanners try
+	defaultMTLSPort = "8888"
+	defaultWGPort   = "53"
+
+	minBeaconInterval = 30 * time.Second
+)
+
+// CheckBuild - Check an implant config and the HTTP C2 config it is built with
+func CheckBuild(config *clientpb.ImplantConfig, httpC2 *configs.HTTPC2Config) []*clientpb.PreflightFinding {
+	target := config.Name
+	if target == "" {
+		target = "build"
+	}
+	findings := []*clientpb.PreflightFinding{}
+	if config.Debug {
+		findings = append(findings, newFinding(High, CheckDebug, target,
+			"debug builds log every action to stderr and embed the log messages",
+			"generate without --debug"))
+	}
+	if !config.ObfuscateSymbols {
+		findings = append(findings, newFinding(High, CheckSymbols, target,
+			"symbols are not obfuscated, the binary contains Sliver's package and function names",
+			"generate without --skip-symbols"))
+	}
+	if config.GOOS == "windows" && !config.Evasion {
+		findings = append(findings, newFinding(Low, CheckEvasion, target,
+			"evasion features are disabled, user space hooks are left in place",
+			"generate with --evasion"))
+	}
+	if config.IsBeacon {
+		if config.BeaconJitter == 0 {
+			findings = append(findings, newFinding(Medium, CheckBeacon, target,
+				"beacon checks in at a fixed interval, periodic callbacks are easy to spot in network logs",
+				"generate with --jitter"))
+		}
+		if time.Duration(config.BeaconInterval) < minBeaconInterval {
+			findings = append(findings, newFinding(Low, CheckBeacon, target,
+				fmt.Sprintf("beacon interval of %s generates a lot of traffic", time.Duration(config.BeaconInterval)),
+				fmt.Sprintf("use an interval of %s or more", minBeaconInterval)))
+		}
+	}
+	if !hasLimits(config) {
+		findings = append(findings, newFinding(Low, CheckLimits, target,
+			"no execution limits, the implant also runs in sandboxes and on hosts that are out of scope",
+			"generate with --limit-domainjoined, --limit-hostname, --limit-username, --limit-datetime, --limit-fileexists, or --limit-locale"))
+	}
+
+	tlsC2s := []*url.URL{}
+	for _, c2 := range config.C2 {
+		uri, err := url.Parse(c2.URL)
+		if err != nil {
+			continue
+		}
+		findings = append(findings, checkC2(target, uri)...)
+		switch uri.Scheme {
+		case "https":
+			if !usesNativeDriver(config, uri) {
+				tlsC2s = append(tlsC2s, uri)
+			}
+		case "mtls":
+			tlsC2s = append(tlsC2s, uri)
+		}
+	}
+	if httpC2 != nil && usesHTTP(config) {
+		if values := httpC2.DefaultValues(); 0 < len(values) {
+			findings = append(findings, newFinding(Medium, CheckDefaultProfile, target,
+				fmt.Sprintf("the HTTP C2 profile has default values that public signatures match (%s)", strings.Join(values, ", ")),
+				"change these values in http-c2.json and run 'reload'"))
+		}
+	}
+	for _, uri := range tlsC2s {
+		findings = append(findings, checkJA3(target, uri))
+	}
+	return findings
+}
+
+func checkC2(target string, uri *url.URL) []*clientpb.PreflightFinding {
+	findings := []*clientpb.PreflightFinding{}
+	if uri.Scheme == "http" {
+		findings = append(findings, newFinding(Medium, CheckC2, target,
+			fmt.Sprintf("%s is plain text HTTP, the requests can be inspected by any proxy", uri),
+			"use https"))
+	}
+	if ip := net.ParseIP(uri.Hostname()); ip != nil && !ip.IsLoopback() {
+		findings = append(findings, newFinding(Low, CheckC2, target,
+			fmt.Sprintf("%s connects to an IP address, traffic to a bare address stands out and burns the server", uri),
+			"use a domain, ideally pointing to a redirector"))
+	}
+	port := uri.Port()
+	if (uri.Scheme == "mtls" && (port == "" || port == defaultMTLSPort)) || (uri.Scheme == "wg" && (port == "" || port == defaultWGPort)) {
+		findings = append(findings, newFinding(Low, CheckC2, target,
+			fmt.Sprintf("%s uses the default %s port", uri, uri.Scheme),
+			"use another port"))
+	}
+	return findings
+}
+
+// checkJA3 - Implants use Go's crypto/tls, so their client hello is the same as every
+// other Go program's and the JA3 is in public blocklists for Go based C2s
+func checkJA3(target string, uri *url.URL) *clientpb.PreflightFinding {
+	_, digest, err := ClientHelloJA3(implantTLSConfig(uri))
+	if err != nil {
+		return newFinding(Info, CheckJA3, target, fmt.Sprintf("failed to compute the JA3 of the %s client hello: %s", uri, err), "")
+	}
+	return newFinding(Medium, CheckJA3, target,
+		fmt.Sprintf("the %s client hello is Go's crypto/tls default (JA3 %s), a known fingerprint of Go based implants", uri, digest),
+		"put the C2 behind a redirector or CDN that terminates TLS, use the wininet or winhttp driver on windows, or use dns or wg")
+}
+
+// implantTLSConfig - The implant's client side TLS config (implant/sliver/transports/mtls and
+// httpclient/gohttp.go) as far as the client hello goes, the certificates and verification
+// callbacks are only used later in the handshake. tls.Dial and http.Transport both use the
+// c2's hostname as the server name, which is left out of the hello for ip addresses.
+func implantTLSConfig(uri *url.URL) *tls.Config {
+	return &tls.Config{
+		ServerName:         uri.Hostname(),
+		InsecureSkipVerify: true,
+	}
+}
+
+// usesNativeDriver - Windows implants can use the os' http stack, which sends the
+// same client hello as other Windows programs
+func usesNativeDriver(config *clientpb.ImplantConfig, uri *url.URL) bool {
+	switch strings.ToLower(strings.TrimSpace(uri.Query().Get("driver"))) {
+	case "wininet", "winhttp":
+		return config.GOOS == "windows"
+	}
+	return false
+}
+
+func hasLimits(config *clientpb.ImplantConfig) bool {
+	return config.LimitDomainJoined || config.LimitHostname != "" || config.LimitUsername != "" ||
+		config.LimitDatetime != "" || config.LimitFileExists != "" || config.LimitLocale != ""
+}
+
+func usesHTTP(config *clientpb.ImplantConfig) bool {
+	for _, c2 := range config.C2 {
+		if strings.HasPrefix(c2.URL, "http://") || strings.HasPrefix(c2.URL, "https://") {
+			return true
+		}
+	}
+	return false
+}
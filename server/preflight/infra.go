@@ -0,0 +1,219 @@
+package preflight
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/c2"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+)
+
+const (
+	probeTimeout = 5 * time.Second
+)
+
+// probe - What a scanner sees when it connects to a listener
+type probe struct {
+	status     int
+	server     string
+	bodyLength int
+	commonName string
+}
+
+// CheckInfrastructure - Probe the running listeners over loopback the way a scanner
+// would, and check the staging listeners' policies
+func CheckInfrastructure() []*clientpb.PreflightFinding {
+	findings := []*clientpb.PreflightFinding{}
+	commonNames := map[string][]string{} // CN -> Jobs
+	for _, job := range core.Jobs.All() {
+		target := fmt.Sprintf("job %d (%s %d)", job.ID, job.Name, job.Port)
+		if server, ok := c2.HTTPListener(job.ID); ok {
+			result, err := probeHTTP(server.ServerConf)
+			if err != nil {
+				findings = append(findings, newFinding(Info, CheckProbe, target, fmt.Sprintf("failed to probe listener: %s", err), ""))
+			} else {
+				findings = append(findings, bannerFindings(target, result)...)
+				if result.commonName != "" {
+					commonNames[result.commonName] = append(commonNames[result.commonName], target)
+				}
+			}
+			if 0 < len(server.SliverStage) {
+				findings = append(findings, stagingFindings(target, server.StagePolicy, configs.GetHTTPC2Config())...)
+			} else if server.ServerConf.Secure && !server.ServerConf.RandomizeJARM {
+				findings = append(findings, newFinding(Low, CheckJARM, target,
+					"the TLS server has Go's default JARM fingerprint, which is in public lists of C2 servers",
+					"restart the listener without --disable-randomized-jarm"))
+			}
+			continue
+		}
+		if policy, ok := c2.JobStagePolicy(job.ID); ok && !policy.Constrained() {
+			findings = append(findings, newFinding(High, CheckStaging, target,
+				"the stage is sent to every connection, scanners can download and analyze the implant",
+				"restart the stager with --allow-ip, --max-fetches, or --expires"))
+			continue
+		}
+		if job.Name == "mtls" {
+			commonName, err := probeTLS(fmt.Sprintf("127.0.0.1:%d", job.Port), "")
+			if err != nil {
+				findings = append(findings, newFinding(Info, CheckProbe, target, fmt.Sprintf("failed to probe listener: %s", err), ""))
+			} else if commonName != "" {
+				commonNames[commonName] = append(commonNames[commonName], target)
+			}
+		}
+	}
+	return append(findings, certReuseFindings(commonNames)...)
+}
+
+// bannerFindings - Responses that give away a Sliver listener
+func bannerFindings(target string, result *probe) []*clientpb.PreflightFinding {
+	findings := []*clientpb.PreflightFinding{}
+	if result.status == http.StatusNotFound && result.bodyLength == 0 {
+		findings = append(findings, newFinding(Medium, CheckBanner, target,
+			"unknown paths get an empty 404 response, a known fingerprint of Sliver's HTTP listeners",
+			"restart the listener with --website to serve decoy content"))
+	}
+	if result.server == "" {
+		findings = append(findings, newFinding(Low, CheckBanner, target,
+			"responses have no Server header, unlike the web servers the listener blends in with",
+			"enable random_version_headers or add a Server header in http-c2.json"))
+	}
+	return findings
+}
+
+// stagingFindings - Stages served on a predictable URL, to anyone who asks
+func stagingFindings(target string, policy *c2.StagePolicy, httpC2 *configs.HTTPC2Config) []*clientpb.PreflightFinding {
+	findings := []*clientpb.PreflightFinding{}
+	for _, value := range httpC2.DefaultValues() {
+		if value == "stager_file_ext" {
+			findings = append(findings, newFinding(High, CheckStaging, target,
+				fmt.Sprintf("the stage is served on any path ending in .%s, the default stager file extension",
+					strings.TrimPrefix(httpC2.ImplantConfig.StagerFileExt, ".")),
+				"change stager_file_ext in http-c2.json and run 'reload'"))
+		}
+	}
+	if !policy.Constrained() {
+		findings = append(findings, newFinding(Medium, CheckStaging, target,
+			"the stage is served to anyone who requests it, scanners can download and analyze the implant",
+			"restart the stager with --allow-ip, --max-fetches, or --expires"))
+	}
+	return findings
+}
+
+// certReuseFindings - Listeners with the same certificate common name can be linked
+// together, burning one of them burns them all
+func certReuseFindings(commonNames map[string][]string) []*clientpb.PreflightFinding {
+	findings := []*clientpb.PreflightFinding{}
+	names := []string{}
+	for commonName := range commonNames {
+		names = append(names, commonName)
+	}
+	sort.Strings(names)
+	for _, commonName := range names {
+		targets := commonNames[commonName]
+		if len(targets) < 2 {
+			continue
+		}
+		findings = append(findings, newFinding(Medium, CheckCertReuse, strings.Join(targets, ", "),
+			fmt.Sprintf("%d listeners present a certificate for '%s'", len(targets), commonName),
+			"use a different domain and certificate for each listener"))
+	}
+	return findings
+}
+
+func probeHTTP(conf *c2.HTTPServerConfig) (*probe, error) {
+	host, _, err := net.SplitHostPort(conf.Addr)
+	if err != nil || host == "" || net.ParseIP(host).IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	scheme := "http"
+	if conf.Secure {
+		scheme = "https"
+	}
+	result := &probe{}
+	client := &http.Client{
+		Timeout: probeTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				ServerName:         conf.Domain,
+				InsecureSkipVerify: true,
+				VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+					result.commonName = leafCommonName(rawCerts)
+					return nil
+				},
+			},
+		},
+	}
+	path := make([]byte, 8)
+	rand.Read(path)
+	resp, err := client.Get(fmt.Sprintf("%s://%s/%s", scheme, net.JoinHostPort(host, fmt.Sprint(conf.LPort)), hex.EncodeToString(path)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	result.status = resp.StatusCode
+	result.server = resp.Header.Get("Server")
+	result.bodyLength = len(body)
+	return result, nil
+}
+
+// probeTLS - The common name of a TLS server's certificate, the handshake can fail
+// afterwards (e.g. the server requires a client certificate)
+func probeTLS(addr string, serverName string) (string, error) {
+	commonName := ""
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: probeTimeout}, "tcp", addr, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			commonName = leafCommonName(rawCerts)
+			return nil
+		},
+	})
+	if err == nil {
+		conn.Close()
+	}
+	if commonName != "" {
+		return commonName, nil
+	}
+	return "", err
+}
+
+func leafCommonName(rawCerts [][]byte) string {
+	if len(rawCerts) == 0 {
+		return ""
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return ""
+	}
+	return cert.Subject.CommonName
+}
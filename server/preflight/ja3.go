@@ -0,0 +1,184 @@
+package preflight
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/md5"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	recordTypeHandshake      = 0x16
+	handshakeTypeClientHello = 0x01
+
+	extensionSupportedGroups = 0x000a
+	extensionPointFormats    = 0x000b
+)
+
+var (
+	// ErrInvalidClientHello - The data is not a TLS client hello
+	ErrInvalidClientHello = errors.New("invalid client hello")
+)
+
+// ClientHelloJA3 - The JA3 string and digest of the client hello Go's crypto/tls sends
+// with a config, the hello is captured over a pipe so nothing goes over the network
+func ClientHelloJA3(config *tls.Config) (string, string, error) {
+	client, server := net.Pipe()
+	defer server.Close()
+	go func() {
+		tls.Client(client, config).Handshake()
+		client.Close()
+	}()
+	server.SetReadDeadline(time.Now().Add(5 * time.Second))
+	header := make([]byte, 5)
+	_, err := io.ReadFull(server, header)
+	if err != nil {
+		return "", "", err
+	}
+	if header[0] != recordTypeHandshake {
+		return "", "", ErrInvalidClientHello
+	}
+	record := make([]byte, binary.BigEndian.Uint16(header[3:5]))
+	_, err = io.ReadFull(server, record)
+	if err != nil {
+		return "", "", err
+	}
+	ja3, err := JA3(record)
+	if err != nil {
+		return "", "", err
+	}
+	digest := md5.Sum([]byte(ja3))
+	return ja3, hex.EncodeToString(digest[:]), nil
+}
+
+// JA3 - The JA3 string of a client hello handshake message: the version, cipher suites,
+// extensions, supported groups, and point formats, GREASE values are left out
+func JA3(hello []byte) (string, error) {
+	msg := &reader{data: hello}
+	if msg.uint8() != handshakeTypeClientHello {
+		return "", ErrInvalidClientHello
+	}
+	body := &reader{data: msg.bytes(int(msg.uint24()))}
+	version := body.uint16()
+	body.bytes(32)                // random
+	body.bytes(int(body.uint8())) // session id
+
+	ciphers := []string{}
+	cipherData := &reader{data: body.bytes(int(body.uint16()))}
+	for !cipherData.empty() {
+		if cipher := cipherData.uint16(); !isGREASE(cipher) {
+			ciphers = append(ciphers, fmt.Sprint(cipher))
+		}
+	}
+	body.bytes(int(body.uint8())) // compression methods
+
+	extensions := []string{}
+	groups := []string{}
+	pointFormats := []string{}
+	extensionData := &reader{data: body.bytes(int(body.uint16()))}
+	for !extensionData.empty() {
+		extension := extensionData.uint16()
+		data := &reader{data: extensionData.bytes(int(extensionData.uint16()))}
+		if isGREASE(extension) {
+			continue
+		}
+		extensions = append(extensions, fmt.Sprint(extension))
+		switch extension {
+		case extensionSupportedGroups:
+			groupData := &reader{data: data.bytes(int(data.uint16()))}
+			for !groupData.empty() {
+				if group := groupData.uint16(); !isGREASE(group) {
+					groups = append(groups, fmt.Sprint(group))
+				}
+			}
+		case extensionPointFormats:
+			for _, format := range data.bytes(int(data.uint8())) {
+				pointFormats = append(pointFormats, fmt.Sprint(format))
+			}
+		}
+	}
+	if msg.err || body.err || cipherData.err || extensionData.err {
+		return "", ErrInvalidClientHello
+	}
+	return strings.Join([]string{
+		fmt.Sprint(version),
+		strings.Join(ciphers, "-"),
+		strings.Join(extensions, "-"),
+		strings.Join(groups, "-"),
+		strings.Join(pointFormats, "-"),
+	}, ","), nil
+}
+
+// isGREASE - GREASE values (RFC 8701) are random so they are not part of the fingerprint
+func isGREASE(value uint16) bool {
+	return value&0x0f0f == 0x0a0a && value>>8 == value&0xff
+}
+
+// reader - Reads big endian values, reading past the end sets err and returns zeros
+type reader struct {
+	data []byte
+	err  bool
+}
+
+func (r *reader) bytes(n int) []byte {
+	if len(r.data) < n {
+		r.err = true
+		r.data = nil
+		return nil
+	}
+	value := r.data[:n]
+	r.data = r.data[n:]
+	return value
+}
+
+func (r *reader) uint8() uint8 {
+	value := r.bytes(1)
+	if value == nil {
+		return 0
+	}
+	return value[0]
+}
+
+func (r *reader) uint16() uint16 {
+	value := r.bytes(2)
+	if value == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(value)
+}
+
+func (r *reader) uint24() uint32 {
+	value := r.bytes(3)
+	if value == nil {
+		return 0
+	}
+	return uint32(value[0])<<16 | uint32(value[1])<<8 | uint32(value[2])
+}
+
+func (r *reader) empty() bool {
+	return len(r.data) == 0
+}
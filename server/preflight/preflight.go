@@ -0,0 +1,135 @@
+package preflight
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sort"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+// Finding severities, in order
+const (
+	High   = "high"
+	Medium = "medium"
+	Low    = "low"
+	Info   = "info"
+)
+
+// Checks
+const (
+	CheckDebug          = "debug"
+	CheckSymbols        = "symbols"
+	CheckEvasion        = "evasion"
+	CheckBeacon         = "beacon"
+	CheckC2             = "c2"
+	CheckLimits         = "limits"
+	CheckDefaultProfile = "default-profile"
+	CheckJA3            = "ja3"
+	CheckBanner         = "banner"
+	CheckJARM           = "jarm"
+	CheckCertReuse      = "cert-reuse"
+	CheckStaging        = "staging"
+	CheckProbe          = "probe"
+)
+
+var (
+	// penalties - Points a finding of each severity costs, out of 100
+	penalties = map[string]int32{
+		High:   25,
+		Medium: 10,
+		Low:    3,
+	}
+
+	// grades - The minimum score of each grade
+	grades = []struct {
+		grade string
+		score int32
+	}{
+		{"A", 90},
+		{"B", 80},
+		{"C", 70},
+		{"D", 60},
+	}
+)
+
+// Run - Check a build config (if not nil) and the running listeners, findings are
+// sorted by severity
+func Run(config *clientpb.ImplantConfig, infrastructure bool) *clientpb.Preflight {
+	findings := []*clientpb.PreflightFinding{}
+	if config != nil {
+		httpC2 := configs.GetHTTPC2Config()
+		if config.HTTPC2ConfigVersion != 0 {
+			if previous, err := configs.GetHTTPC2ConfigVersion(int(config.HTTPC2ConfigVersion)); err == nil {
+				httpC2 = previous
+			}
+		}
+		findings = append(findings, CheckBuild(config, httpC2)...)
+	}
+	if infrastructure {
+		findings = append(findings, CheckInfrastructure()...)
+	}
+	return Grade(findings)
+}
+
+// Grade - Score the findings, every finding costs points depending on its severity
+func Grade(findings []*clientpb.PreflightFinding) *clientpb.Preflight {
+	sort.SliceStable(findings, func(i, j int) bool {
+		return severityOrder(findings[i].Severity) < severityOrder(findings[j].Severity)
+	})
+	score := int32(100)
+	for _, finding := range findings {
+		score -= penalties[finding.Severity]
+	}
+	if score < 0 {
+		score = 0
+	}
+	preflight := &clientpb.Preflight{Grade: "F", Score: score, Findings: findings}
+	for _, grade := range grades {
+		if grade.score <= score {
+			preflight.Grade = grade.grade
+			break
+		}
+	}
+	return preflight
+}
+
+func severityOrder(severity string) int {
+	switch severity {
+	case High:
+		return 0
+	case Medium:
+		return 1
+	case Low:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func newFinding(severity string, check string, target string, message string, remediation string) *clientpb.PreflightFinding {
+	return &clientpb.PreflightFinding{
+		Severity:    severity,
+		Check:       check,
+		Target:      target,
+		Message:     message,
+		Remediation: remediation,
+	}
+}
@@ -0,0 +1,178 @@
+package preflight
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/c2"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func TestGrade(t *testing.T) {
+	preflight := Grade([]*clientpb.PreflightFinding{})
+	if preflight.Grade != "A" || preflight.Score != 100 {
+		t.Fatalf("Expected no findings to be an A, got %s (%d)", preflight.Grade, preflight.Score)
+	}
+	preflight = Grade([]*clientpb.PreflightFinding{
+		newFinding(Low, CheckLimits, "build", "", ""),
+		newFinding(Info, CheckProbe, "job", "", ""),
+		newFinding(High, CheckDebug, "build", "", ""),
+		newFinding(Medium, CheckBanner, "job", "", ""),
+	})
+	if preflight.Score != 62 || preflight.Grade != "D" {
+		t.Fatalf("Unexpected grade %s (%d)", preflight.Grade, preflight.Score)
+	}
+	if preflight.Findings[0].Severity != High || preflight.Findings[3].Severity != Info {
+		t.Fatalf("Expected findings sorted by severity, got %v", preflight.Findings)
+	}
+	findings := []*clientpb.PreflightFinding{}
+	for i := 0; i < 5; i++ {
+		findings = append(findings, newFinding(High, CheckDebug, "build", "", ""))
+	}
+	if preflight = Grade(findings); preflight.Score != 0 || preflight.Grade != "F" {
+		t.Fatalf("Unexpected grade %s (%d)", preflight.Grade, preflight.Score)
+	}
+}
+
+func TestCheckBuild(t *testing.T) {
+	config := &clientpb.ImplantConfig{
+		Name:             "TEST",
+		GOOS:             "windows",
+		Debug:            true,
+		ObfuscateSymbols: false,
+		IsBeacon:         true,
+		BeaconInterval:   int64(10 * time.Second),
+		C2: []*clientpb.ImplantC2{
+			{URL: "mtls://192.0.2.1"},
+			{URL: "http://example.com"},
+			{URL: "https://example.com?driver=wininet"},
+		},
+	}
+	checks := map[string]int{}
+	for _, finding := range CheckBuild(config, nil) {
+		checks[finding.Check]++
+	}
+	expected := map[string]int{
+		CheckDebug:   1,
+		CheckSymbols: 1,
+		CheckEvasion: 1,
+		CheckBeacon:  2, // no jitter, short interval
+		CheckLimits:  1,
+		CheckC2:      3, // bare ip, default mtls port, plain text http
+		CheckJA3:     1, // mtls, the https c2 uses the os' tls stack
+	}
+	for check, count := range expected {
+		if checks[check] != count {
+			t.Fatalf("Expected %d %s finding(s), got %v", count, check, checks)
+		}
+	}
+
+	config = &clientpb.ImplantConfig{
+		GOOS:              "linux",
+		ObfuscateSymbols:  true,
+		LimitDomainJoined: true,
+		C2:                []*clientpb.ImplantC2{{URL: "dns://c2.example.com"}},
+	}
+	if findings := CheckBuild(config, nil); len(findings) != 0 {
+		t.Fatalf("Expected no findings, got %v", findings)
+	}
+}
+
+func TestClientHelloJA3(t *testing.T) {
+	ja3, digest, err := ClientHelloJA3(&tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := strings.Split(ja3, ",")
+	if len(fields) != 5 || fields[0] != "771" || fields[1] == "" || fields[2] == "" {
+		t.Fatalf("Unexpected JA3 %s", ja3)
+	}
+	if len(digest) != 32 {
+		t.Fatalf("Unexpected JA3 digest %s", digest)
+	}
+	if _, err := JA3([]byte{handshakeTypeClientHello, 0, 0, 10, 3, 3}); err != ErrInvalidClientHello {
+		t.Fatalf("Expected truncated hello to be invalid, got %v", err)
+	}
+	withSNI, _, err := ClientHelloJA3(implantTLSConfig(&url.URL{Scheme: "https", Host: "example.com"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hasExtension(withSNI, "0") || hasExtension(ja3, "0") {
+		t.Fatalf("Expected only the hello to a hostname to have the server name extension, got %s and %s", withSNI, ja3)
+	}
+	if !isGREASE(0x1a1a) || isGREASE(0x1a2a) || isGREASE(0x0017) {
+		t.Fatal("Unexpected GREASE values")
+	}
+}
+
+func hasExtension(ja3 string, extension string) bool {
+	for _, value := range strings.Split(strings.Split(ja3, ",")[2], "-") {
+		if value == extension {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBannerFindings(t *testing.T) {
+	findings := bannerFindings("job", &probe{status: http.StatusNotFound})
+	if len(findings) != 2 {
+		t.Fatalf("Expected empty 404 and missing server header findings, got %v", findings)
+	}
+	findings = bannerFindings("job", &probe{status: http.StatusOK, server: "nginx/1.18.0 (Ubuntu)", bodyLength: 612})
+	if len(findings) != 0 {
+		t.Fatalf("Expected no findings, got %v", findings)
+	}
+}
+
+func TestStagingFindings(t *testing.T) {
+	httpC2 := &configs.HTTPC2Config{
+		ServerConfig:  &configs.HTTPC2ServerConfig{},
+		ImplantConfig: &configs.HTTPC2ImplantConfig{StagerFileExt: "woff"},
+	}
+	findings := stagingFindings("job", nil, httpC2)
+	if len(findings) != 2 || findings[0].Severity != High {
+		t.Fatalf("Expected default extension and unconstrained findings, got %v", findings)
+	}
+	policy, err := c2.NewStagePolicy(&clientpb.StagerListenerReq{MaxFetches: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpC2.ImplantConfig.StagerFileExt = "mp4"
+	if findings := stagingFindings("job", policy, httpC2); len(findings) != 0 {
+		t.Fatalf("Expected no findings, got %v", findings)
+	}
+}
+
+func TestCertReuseFindings(t *testing.T) {
+	findings := certReuseFindings(map[string][]string{
+		"example.com": {"job 1 (https 443)", "job 2 (mtls 8443)"},
+		"example.org": {"job 3 (https 8443)"},
+	})
+	if len(findings) != 1 || !strings.Contains(findings[0].Target, "job 2") {
+		t.Fatalf("Unexpected findings %v", findings)
+	}
+}
@@ -0,0 +1,150 @@
+package recordings
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// Output - Data written to the operator's terminal
+	Output = "o"
+	// Input - Data typed by the operator
+	Input = "i"
+
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// Header - An asciicast v2 header, players ignore the sliver metadata
+// https://docs.asciinema.org/manual/asciicast/v2/
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	Sliver    *Metadata         `json:"sliver,omitempty"`
+}
+
+// Metadata - The session a recording belongs to
+type Metadata struct {
+	SessionID   string `json:"session_id"`
+	SessionName string `json:"session_name"`
+	Hostname    string `json:"hostname"`
+	Kind        string `json:"kind"`
+	Operator    string `json:"operator"`
+}
+
+// Recorder - Writes timestamped terminal I/O as an asciicast v2 file
+type Recorder struct {
+	file    *os.File
+	started time.Time
+	mutex   sync.Mutex
+
+	// Trailing bytes of an incomplete utf-8 character, per stream
+	partial map[string][]byte
+}
+
+func newRecorder(path string, header *Header) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	recorder := &Recorder{file: file, started: time.Now(), partial: map[string][]byte{}}
+	header.Version = 2
+	header.Timestamp = recorder.started.Unix()
+	if header.Width == 0 {
+		header.Width = defaultWidth
+	}
+	if header.Height == 0 {
+		header.Height = defaultHeight
+	}
+	data, err := json.Marshal(header)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	_, err = file.Write(append(data, '\n'))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return recorder, nil
+}
+
+// Write - Record data on the output or input stream
+func (r *Recorder) Write(stream string, data []byte) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.file == nil {
+		return os.ErrClosed
+	}
+	data = append(r.partial[stream], data...)
+	data, r.partial[stream] = splitUTF8(data)
+	if len(data) == 0 {
+		return nil
+	}
+	elapsed := float64(time.Since(r.started).Microseconds()) / 1e6
+	event, err := json.Marshal([]interface{}{elapsed, stream, string(data)})
+	if err != nil {
+		return err
+	}
+	_, err = r.file.Write(append(event, '\n'))
+	return err
+}
+
+// Close - Flush any incomplete characters and close the file
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	elapsed := float64(time.Since(r.started).Microseconds()) / 1e6
+	for stream, partial := range r.partial {
+		if 0 < len(partial) {
+			event, _ := json.Marshal([]interface{}{elapsed, stream, string(partial)})
+			r.file.Write(append(event, '\n'))
+		}
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// splitUTF8 - Terminal output is chunked without regard for character boundaries,
+// hold back a trailing incomplete character so it is not replaced in the JSON string
+func splitUTF8(data []byte) ([]byte, []byte) {
+	for index := len(data) - 1; 0 <= index && len(data)-utf8.UTFMax < index; index-- {
+		if !utf8.RuneStart(data[index]) {
+			continue
+		}
+		if utf8.FullRune(data[index:]) {
+			return data, nil
+		}
+		return data[:index], append([]byte{}, data[index:]...)
+	}
+	return data, nil
+}
@@ -0,0 +1,295 @@
+package recordings
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// KindShell - An interactive shell tunnel
+	KindShell = "shell"
+	// KindConsole - Commands and their responses for a session
+	KindConsole = "console"
+
+	castExt = ".cast"
+
+	// maxConsoleOutput - Console responses (e.g. downloads) can be very large, only
+	// the start of each response is kept
+	maxConsoleOutput = 64 * 1024
+	// maxConsoleRequest - Same for requests (e.g. uploads), they're on a single line
+	maxConsoleRequest = 4 * 1024
+)
+
+var (
+	recordingsLog = log.NamedLogger("recordings", "recorder")
+
+	// ErrRecordingNotFound - No recording with the requested ID
+	ErrRecordingNotFound = errors.New("recording not found")
+
+	recordingsConfig      = configs.GetServerConfig().Recordings
+	recordingsConfigMutex = &sync.RWMutex{}
+
+	shells   = &sync.Map{} // TunnelID -> *Recorder
+	consoles = &sync.Map{} // SessionID -> *Recorder
+)
+
+// Enabled - Recordings are on unless disabled in the server config
+func Enabled() bool {
+	recordingsConfigMutex.RLock()
+	defer recordingsConfigMutex.RUnlock()
+	return recordingsConfig == nil || !recordingsConfig.Disabled
+}
+
+// Reload - Apply a reloaded server config, recordings that are in progress are not affected
+func Reload(config *configs.ServerConfig) {
+	recordingsConfigMutex.Lock()
+	defer recordingsConfigMutex.Unlock()
+	recordingsConfig = config.Recordings
+}
+
+// StartEventAutomation - Close console recordings when their session closes
+func StartEventAutomation() {
+	go func() {
+		for event := range core.EventBroker.Subscribe() {
+			if event.EventType == consts.SessionClosedEvent && event.Session != nil {
+				StopConsole(event.Session.ID)
+			}
+		}
+	}()
+}
+
+// RecordingsDir - Directory containing all recordings
+func RecordingsDir() string {
+	recordingsDir := filepath.Join(assets.GetRootAppDir(), "recordings")
+	if _, err := os.Stat(recordingsDir); os.IsNotExist(err) {
+		err = os.MkdirAll(recordingsDir, 0700)
+		if err != nil {
+			recordingsLog.Errorf("Failed to create recordings dir %s", err)
+		}
+	}
+	return recordingsDir
+}
+
+// start - Recording IDs sort by start time and are safe to use as file names
+func start(kind string, session *core.Session, operator string, header *Header) (*Recorder, error) {
+	id := fmt.Sprintf("%s_%s_%s", time.Now().UTC().Format("20060102T150405.000000"), kind, session.ID)
+	header.Sliver = &Metadata{
+		SessionID:   session.ID,
+		SessionName: session.Name,
+		Hostname:    session.Hostname,
+		Kind:        kind,
+		Operator:    operator,
+	}
+	return newRecorder(filepath.Join(RecordingsDir(), id+castExt), header)
+}
+
+// StartShell - Record an interactive shell tunnel
+func StartShell(tunnelID uint64, session *core.Session, operator string, shellPath string) {
+	if !Enabled() {
+		return
+	}
+	recorder, err := start(KindShell, session, operator, &Header{
+		Title: fmt.Sprintf("%s@%s %s", session.Username, session.Hostname, shellPath),
+		Env:   map[string]string{"SHELL": shellPath, "TERM": "xterm-256color"},
+	})
+	if err != nil {
+		recordingsLog.Errorf("Failed to start shell recording: %s", err)
+		return
+	}
+	shells.Store(tunnelID, recorder)
+}
+
+// ShellInput - Data sent from the operator to the shell
+func ShellInput(tunnelID uint64, data []byte) {
+	if recorder, ok := shells.Load(tunnelID); ok {
+		recorder.(*Recorder).Write(Input, data)
+	}
+}
+
+// ShellOutput - Data sent from the shell to the operator
+func ShellOutput(tunnelID uint64, data []byte) {
+	if recorder, ok := shells.Load(tunnelID); ok {
+		recorder.(*Recorder).Write(Output, data)
+	}
+}
+
+// StopShell - Stop recording a shell tunnel, safe to call more than once
+func StopShell(tunnelID uint64) {
+	if recorder, ok := shells.LoadAndDelete(tunnelID); ok {
+		recorder.(*Recorder).Close()
+	}
+}
+
+// Console - Record a session RPC and its response, the console recording for
+// a session is created by the first RPC
+func Console(sessionID string, operator string, method string, req []byte, resp interface{}, respErr error) {
+	if !Enabled() {
+		return
+	}
+	session := core.Sessions.Get(sessionID)
+	if session == nil {
+		return
+	}
+	value, ok := consoles.Load(sessionID)
+	if !ok {
+		recorder, err := start(KindConsole, session, operator, &Header{
+			Title: fmt.Sprintf("%s (%s)", session.Name, session.Hostname),
+		})
+		if err != nil {
+			recordingsLog.Errorf("Failed to start console recording: %s", err)
+			return
+		}
+		var loaded bool
+		value, loaded = consoles.LoadOrStore(sessionID, recorder)
+		if loaded {
+			recorder.Close()
+		}
+	}
+	recorder := value.(*Recorder)
+
+	method = method[strings.LastIndex(method, "/")+1:]
+	prompt := fmt.Sprintf("[%s] sliver (%s) > %s %s\n", operator, session.Name, method, truncate(req, maxConsoleRequest))
+	recorder.Write(Input, terminalText([]byte(prompt)))
+
+	var output []byte
+	if respErr != nil {
+		output = []byte(fmt.Sprintf("[!] %s\n", respErr))
+	} else if msg, ok := resp.(proto.Message); ok {
+		output, _ = protojson.MarshalOptions{Multiline: true}.Marshal(msg)
+		output = append(truncate(output, maxConsoleOutput), '\n')
+	}
+	recorder.Write(Output, terminalText(output))
+}
+
+// StopConsole - Close the console recording of a session
+func StopConsole(sessionID string) {
+	if recorder, ok := consoles.LoadAndDelete(sessionID); ok {
+		recorder.(*Recorder).Close()
+	}
+}
+
+// truncate - Keep the first max bytes of the data, followed by how much was dropped
+func truncate(data []byte, max int) []byte {
+	if len(data) <= max {
+		return data
+	}
+	truncated := make([]byte, max, max+32)
+	copy(truncated, data)
+	return append(truncated, []byte(fmt.Sprintf("\n... truncated %d byte(s)", len(data)-max))...)
+}
+
+// terminalText - Console text uses bare newlines, terminals need a carriage return
+func terminalText(data []byte) []byte {
+	return []byte(strings.ReplaceAll(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n", "\r\n"))
+}
+
+// List - List recordings, optionally only those of one session
+func List(sessionID string) ([]*clientpb.Recording, error) {
+	entries, err := os.ReadDir(RecordingsDir())
+	if err != nil {
+		return nil, err
+	}
+	recordings := []*clientpb.Recording{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), castExt) {
+			continue
+		}
+		recording, err := readRecordingInfo(entry.Name())
+		if err != nil {
+			recordingsLog.Warnf("Skipping invalid recording %s: %s", entry.Name(), err)
+			continue
+		}
+		if sessionID != "" && !strings.HasPrefix(recording.SessionID, sessionID) && recording.SessionName != sessionID {
+			continue
+		}
+		recordings = append(recordings, recording)
+	}
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].ID < recordings[j].ID
+	})
+	return recordings, nil
+}
+
+func readRecordingInfo(fileName string) (*clientpb.Recording, error) {
+	filePath := filepath.Join(RecordingsDir(), fileName)
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(file)
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	header := &Header{}
+	err = json.Unmarshal(line, header)
+	if err != nil {
+		return nil, err
+	}
+	if header.Sliver == nil {
+		header.Sliver = &Metadata{}
+	}
+	return &clientpb.Recording{
+		ID:          strings.TrimSuffix(fileName, castExt),
+		SessionID:   header.Sliver.SessionID,
+		SessionName: header.Sliver.SessionName,
+		Hostname:    header.Sliver.Hostname,
+		Kind:        header.Sliver.Kind,
+		Title:       header.Title,
+		Operator:    header.Sliver.Operator,
+		StartedAt:   header.Timestamp,
+		Size:        info.Size(),
+	}, nil
+}
+
+// Read - Read an asciicast file by recording ID
+func Read(id string) ([]byte, error) {
+	if id == "" || filepath.Base(id) != id {
+		return nil, ErrRecordingNotFound
+	}
+	data, err := os.ReadFile(filepath.Join(RecordingsDir(), id+castExt))
+	if os.IsNotExist(err) {
+		return nil, ErrRecordingNotFound
+	}
+	return data, err
+}
@@ -0,0 +1,94 @@
+package recordings
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.cast")
+	recorder, err := newRecorder(path, &Header{Title: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.Write(Input, []byte("ls\r"))
+	// "é" split across two writes
+	recorder.Write(Output, []byte("caf\xc3"))
+	recorder.Write(Output, []byte("\xa9\r\n"))
+	recorder.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Scan()
+	header := &Header{}
+	err = json.Unmarshal(scanner.Bytes(), header)
+	if err != nil || header.Version != 2 || header.Width != defaultWidth || header.Title != "test" {
+		t.Fatalf("Invalid asciicast header %s", scanner.Text())
+	}
+	output := ""
+	for scanner.Scan() {
+		event := []interface{}{}
+		err = json.Unmarshal(scanner.Bytes(), &event)
+		if err != nil || len(event) != 3 {
+			t.Fatalf("Invalid asciicast event %s", scanner.Text())
+		}
+		if event[1] == Output {
+			output += event[2].(string)
+		}
+	}
+	if output != "café\r\n" {
+		t.Fatalf("Unexpected output %q", output)
+	}
+
+	_, err = newRecorder(path, &Header{})
+	if err == nil {
+		t.Fatal("Expected existing recordings to not be overwritten")
+	}
+}
+
+func TestTerminalText(t *testing.T) {
+	text := string(terminalText([]byte("a\nb\r\nc")))
+	if text != "a\r\nb\r\nc" || strings.Count(text, "\r") != 2 {
+		t.Fatalf("Unexpected terminal text %q", text)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if data := string(truncate([]byte("abc"), 3)); data != "abc" {
+		t.Fatalf("Unexpected data %q", data)
+	}
+	req := []byte(strings.Repeat("a", maxConsoleRequest+10))
+	data := string(truncate(req, maxConsoleRequest))
+	if !strings.HasPrefix(data, strings.Repeat("a", maxConsoleRequest)+"\n") || !strings.HasSuffix(data, "truncated 10 byte(s)") {
+		t.Fatalf("Unexpected truncated data %q", data[maxConsoleRequest:])
+	}
+	if len(req) != maxConsoleRequest+10 || req[maxConsoleRequest] != 'a' {
+		t.Fatalf("Truncate modified its input")
+	}
+}
@@ -35,6 +35,9 @@ var (
 	// ErrInvalidSessionID - Invalid Session ID in request
 	ErrInvalidSessionID = status.Error(codes.InvalidArgument, "Invalid session ID")
 
+	// ErrInvalidStreamID - The request's stream ID is already in use
+	ErrInvalidStreamID = status.Error(codes.InvalidArgument, "Invalid stream ID")
+
 	// ErrMissingRequestField - Returned when a request does not contain a commonpb.Request
 	ErrMissingRequestField = status.Error(codes.InvalidArgument, "Missing session request field")
 	// ErrAsyncNotSupported - Unsupported mode / command type
@@ -48,4 +51,6 @@ var (
 	ErrBuildExists = status.Error(codes.AlreadyExists, "Build already exists")
 
 	ErrInvalidBeaconTaskCancelState = status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid task state, must be '%s' to cancel", models.PENDING))
+	// ErrInvalidBeaconTaskReorder - A reordered task is not a pending task of the beacon
+	ErrInvalidBeaconTaskReorder = status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid task, must be a '%s' task of the beacon to reorder", models.PENDING))
 )
@@ -0,0 +1,108 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/armory"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ArmoryPublish - Sign and publish a package to the private armory
+func (rpc *Server) ArmoryPublish(ctx context.Context, req *clientpb.ArmoryPublishReq) (*clientpb.ArmoryPackageVersion, error) {
+	pkg, err := armory.Publish(req.TarGz, rpc.getClientCommonName(ctx))
+	if errors.Is(err, armory.ErrInvalidPackage) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, armory.ErrVersionExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	pbPkg := pkg.ToProtobuf()
+	pbPkg.Latest = true
+	return pbPkg, nil
+}
+
+// ArmoryPackages - List all published versions of private armory packages
+func (rpc *Server) ArmoryPackages(ctx context.Context, _ *commonpb.Empty) (*clientpb.ArmoryPackages, error) {
+	packages, err := armory.Packages()
+	if err != nil {
+		return nil, err
+	}
+	latest := map[string]bool{}
+	for _, pkg := range armory.LatestVersions(packages) {
+		latest[pkg.ID.String()] = true
+	}
+	pbPackages := &clientpb.ArmoryPackages{
+		Packages:  []*clientpb.ArmoryPackageVersion{},
+		PublicKey: cryptography.ArmorySigningPublicKey(),
+	}
+	for _, pkg := range packages {
+		pbPkg := pkg.ToProtobuf()
+		pbPkg.Latest = latest[pbPkg.ID]
+		pbPackages.Packages = append(pbPackages.Packages, pbPkg)
+	}
+	return pbPackages, nil
+}
+
+// ArmoryUnpublish - Remove one or all versions of a private armory package
+func (rpc *Server) ArmoryUnpublish(ctx context.Context, req *clientpb.ArmoryUnpublishReq) (*commonpb.Empty, error) {
+	_, err := armory.Unpublish(req.CommandName, req.Version)
+	if errors.Is(err, armory.ErrPackageNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// StartArmoryListener - Serve the private armory over http(s)
+func (rpc *Server) StartArmoryListener(ctx context.Context, req *clientpb.ArmoryListenerReq) (*clientpb.ArmoryListener, error) {
+	if 65535 <= req.Port {
+		return nil, ErrInvalidPort
+	}
+	listenPort := uint16(armory.DefaultPort)
+	if req.Port != 0 {
+		listenPort = uint16(req.Port)
+	}
+	job, err := armory.StartListenerJob(&armory.ListenerConfig{
+		Host:          req.Host,
+		Port:          listenPort,
+		Secure:        req.Secure,
+		Cert:          req.Cert,
+		Key:           req.Key,
+		Authorization: req.Authorization,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.ArmoryListener{
+		JobID:     uint32(job.ID),
+		PublicKey: cryptography.ArmorySigningPublicKey(),
+	}, nil
+}
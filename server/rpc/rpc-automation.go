@@ -0,0 +1,172 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/automation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AutomationRules - List automation rules
+func (rpc *Server) AutomationRules(ctx context.Context, _ *commonpb.Empty) (*clientpb.AutomationRules, error) {
+	rules, err := automation.Rules()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clientpb.AutomationRules{Rules: rules}, nil
+}
+
+// AutomationRuleSave - Create or replace an automation rule
+func (rpc *Server) AutomationRuleSave(ctx context.Context, req *clientpb.AutomationRule) (*clientpb.AutomationRule, error) {
+	err := automation.Validate(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	req.Operator = rpc.getClientCommonName(ctx)
+	rule, err := automation.Save(req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return rule, nil
+}
+
+// AutomationRuleRm - Remove an automation rule
+func (rpc *Server) AutomationRuleRm(ctx context.Context, req *clientpb.AutomationRule) (*commonpb.Empty, error) {
+	err := automation.Remove(req.Name)
+	if err == automation.ErrRuleNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// ScheduledTasks - List scheduled tasks
+func (rpc *Server) ScheduledTasks(ctx context.Context, _ *commonpb.Empty) (*clientpb.ScheduledTasks, error) {
+	scheduled, err := automation.ScheduledTasks()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clientpb.ScheduledTasks{Tasks: scheduled}, nil
+}
+
+// ScheduledTaskSave - Create or replace a scheduled task
+func (rpc *Server) ScheduledTaskSave(ctx context.Context, req *clientpb.ScheduledTask) (*clientpb.ScheduledTask, error) {
+	err := automation.ValidateScheduledTask(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	req.Operator = rpc.getClientCommonName(ctx)
+	scheduled, err := automation.SaveScheduledTask(req)
+	switch err {
+	case nil:
+		return scheduled, nil
+	case automation.ErrImplantNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case automation.ErrScheduleNeverRuns:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// ScheduledTaskRm - Remove a scheduled task
+func (rpc *Server) ScheduledTaskRm(ctx context.Context, req *clientpb.ScheduledTask) (*commonpb.Empty, error) {
+	err := automation.RemoveScheduledTask(req.Name)
+	if err == automation.ErrScheduleNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// ImplantGroups - List implant groups
+func (rpc *Server) ImplantGroups(ctx context.Context, _ *commonpb.Empty) (*clientpb.ImplantGroups, error) {
+	groups, err := automation.Groups()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clientpb.ImplantGroups{Groups: groups}, nil
+}
+
+// ImplantGroupSave - Create or replace an implant group
+func (rpc *Server) ImplantGroupSave(ctx context.Context, req *clientpb.ImplantGroup) (*clientpb.ImplantGroup, error) {
+	err := automation.ValidateGroup(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	req.Operator = rpc.getClientCommonName(ctx)
+	group, err := automation.SaveGroup(req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return group, nil
+}
+
+// ImplantGroupRm - Remove an implant group
+func (rpc *Server) ImplantGroupRm(ctx context.Context, req *clientpb.ImplantGroup) (*commonpb.Empty, error) {
+	err := automation.RemoveGroup(req.Name)
+	if err == automation.ErrGroupNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// ImplantGroupMembers - The sessions and beacons in an implant group
+func (rpc *Server) ImplantGroupMembers(ctx context.Context, req *clientpb.ImplantGroup) (*clientpb.ImplantGroupMembers, error) {
+	members, err := automation.GroupMembers(req.Name)
+	if err == automation.ErrGroupNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return members, nil
+}
+
+// ImplantGroupTask - Run tasks against every member of an implant group
+func (rpc *Server) ImplantGroupTask(ctx context.Context, req *clientpb.GroupTaskReq) (*clientpb.GroupTaskResults, error) {
+	tasks, err := automation.ParseTasks(req.Tasks)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(tasks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no tasks")
+	}
+	results, err := automation.RunGroup(req)
+	switch err {
+	case nil:
+		return &clientpb.GroupTaskResults{Results: results}, nil
+	case automation.ErrGroupNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case automation.ErrNotStarted:
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
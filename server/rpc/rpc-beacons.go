@@ -23,6 +23,7 @@ import (
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/log"
@@ -50,6 +51,7 @@ func (rpc *Server) GetBeacons(ctx context.Context, req *commonpb.Empty) (*client
 		pbBeacon.TasksCountCompleted = completed
 		beacons = append(beacons, pbBeacon)
 	}
+	applyBeaconMetadata(beacons)
 	return &clientpb.Beacons{Beacons: beacons}, nil
 }
 
@@ -60,7 +62,9 @@ func (rpc *Server) GetBeacon(ctx context.Context, req *clientpb.Beacon) (*client
 		beaconRpcLog.Error(err)
 		return nil, ErrDatabaseFailure
 	}
-	return beacon.ToProtobuf(), nil
+	pbBeacon := beacon.ToProtobuf()
+	applyBeaconMetadata([]*clientpb.Beacon{pbBeacon})
+	return pbBeacon, nil
 }
 
 // RmBeacon - Delete a beacon and any related tasks
@@ -82,6 +86,14 @@ func (rpc *Server) RmBeacon(ctx context.Context, req *clientpb.Beacon) (*commonp
 		beaconRpcLog.Errorf("Database error: %s", err)
 		return nil, ErrDatabaseFailure
 	}
+	err = db.DeleteTargetMetadata(beacon.ID.String())
+	if err != nil {
+		beaconRpcLog.Errorf("Database error: %s", err)
+	}
+	err = db.DeleteTargetHistory(beacon.ID.String())
+	if err != nil {
+		beaconRpcLog.Errorf("Database error: %s", err)
+	}
 	return &commonpb.Empty{}, nil
 }
 
@@ -99,12 +111,79 @@ func (rpc *Server) GetBeaconTasks(ctx context.Context, req *clientpb.Beacon) (*c
 	return &clientpb.BeaconTasks{Tasks: tasks}, err
 }
 
+// ReorderBeaconTasks - Change the order pending tasks are sent to a beacon in, the tasks
+// in the request are sent first in the order given followed by any other pending tasks
+func (rpc *Server) ReorderBeaconTasks(ctx context.Context, req *clientpb.BeaconTasks) (*clientpb.BeaconTasks, error) {
+	beacon, err := db.BeaconByID(req.BeaconID)
+	if err != nil {
+		return nil, ErrInvalidBeaconID
+	}
+	pending, err := db.PendingBeaconTasksByBeaconID(beacon.ID.String())
+	if err != nil {
+		beaconRpcLog.Errorf("Database error: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+	ordered, err := reorderTasks(pending, req.Tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reuse the current positions so tasks queued later are still sent last, the
+	// positions of tasks queued before positions existed are all zero
+	positions := []int64{}
+	for index, task := range pending {
+		position := task.Position
+		if 0 < index && position <= positions[index-1] {
+			position = positions[index-1] + 1
+		}
+		positions = append(positions, position)
+	}
+	tasks := []*clientpb.BeaconTask{}
+	for index, task := range ordered {
+		err = db.Session().Model(&models.BeaconTask{}).Where(&models.BeaconTask{
+			ID:    task.ID,
+			State: models.PENDING,
+		}).Update("position", positions[index]).Error
+		if err != nil {
+			beaconRpcLog.Errorf("Database error: %s", err)
+			return nil, ErrDatabaseFailure
+		}
+		task.Position = positions[index]
+		tasks = append(tasks, task.ToProtobuf(false))
+	}
+	return &clientpb.BeaconTasks{BeaconID: beacon.ID.String(), Tasks: tasks}, nil
+}
+
+// reorderTasks - The pending tasks with the requested tasks first
+func reorderTasks(pending []*models.BeaconTask, requested []*clientpb.BeaconTask) ([]*models.BeaconTask, error) {
+	byID := map[string]*models.BeaconTask{}
+	for _, task := range pending {
+		byID[task.ID.String()] = task
+	}
+	ordered := []*models.BeaconTask{}
+	for _, task := range requested {
+		dbTask, ok := byID[task.ID]
+		if !ok {
+			return nil, ErrInvalidBeaconTaskReorder
+		}
+		delete(byID, task.ID)
+		ordered = append(ordered, dbTask)
+	}
+	for _, task := range pending {
+		if _, ok := byID[task.ID.String()]; ok {
+			ordered = append(ordered, task)
+		}
+	}
+	return ordered, nil
+}
+
 // GetBeaconTaskContent - Get the content of a specific task
 func (rpc *Server) GetBeaconTaskContent(ctx context.Context, req *clientpb.BeaconTask) (*clientpb.BeaconTask, error) {
 	task, err := db.BeaconTaskByID(req.ID)
 	if err != nil {
 		return nil, ErrInvalidBeaconTaskID
 	}
+	task.Request = core.RedactTaskRequest(task.Request)
 	return task.ToProtobuf(true), nil
 }
 
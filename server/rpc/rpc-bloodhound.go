@@ -0,0 +1,109 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/bloodhound"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// bloodhoundTag - Tag of all ingested collections and data files
+	bloodhoundTag = "bloodhound"
+)
+
+var (
+	bloodhoundLog = log.NamedLogger("rpc", "bloodhound")
+)
+
+// BloodHoundIngest - Save a BloodHound collection zip and its data files as loot, and
+// upload it to the configured BloodHound instance
+func (rpc *Server) BloodHoundIngest(ctx context.Context, req *clientpb.BloodHoundIngestReq) (*clientpb.BloodHoundIngest, error) {
+	files, err := bloodhound.Parse(req.Data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	name := req.Name
+	if name == "" {
+		name = "bloodhound.zip"
+	}
+	lootStore := loot.GetLootStore()
+	collection, err := lootStore.Add(&clientpb.Loot{
+		Name:           name,
+		FileType:       clientpb.FileType_BINARY,
+		OriginHostUUID: req.HostUUID,
+		Tags:           []string{bloodhoundTag},
+		File:           &commonpb.File{Name: name, Data: req.Data},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ingest := &clientpb.BloodHoundIngest{LootID: collection.ID}
+
+	// The data files are saved separately so they can be searched with "loot search"
+	for _, file := range files {
+		fileLoot, err := lootStore.Add(&clientpb.Loot{
+			Name:           fmt.Sprintf("%s/%s", strings.TrimSuffix(name, ".zip"), file.Name),
+			FileType:       clientpb.FileType_TEXT,
+			OriginHostUUID: req.HostUUID,
+			Tags:           []string{bloodhoundTag, bloodhoundTag + ":" + file.Type},
+			File:           &commonpb.File{Name: file.Name, Data: file.Data},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ingest.Files = append(ingest.Files, &clientpb.BloodHoundFile{
+			Name:    file.Name,
+			Type:    file.Type,
+			Count:   int32(file.Count),
+			Version: int32(file.Version),
+			LootID:  fileLoot.ID,
+		})
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(collection.ID),
+	})
+
+	config := configs.GetServerConfig().BloodHound
+	if req.Upload || (config != nil && config.AutoUpload) {
+		ingest.Uploaded = true
+		client, err := bloodhound.NewClient(config)
+		if err == nil {
+			ingest.UploadJobID, err = client.Upload(files)
+		}
+		if err != nil {
+			bloodhoundLog.Errorf("Failed to upload %s: %s", name, err)
+			ingest.UploadErr = err.Error()
+		}
+	}
+	return ingest, nil
+}
@@ -0,0 +1,69 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartCapture - Start recording decrypted envelopes for debugging transports
+func (rpc *Server) StartCapture(ctx context.Context, req *clientpb.CaptureReq) (*clientpb.CaptureStatus, error) {
+	filePath := ""
+	if req.SaveToFile {
+		capturesDir := filepath.Join(assets.GetRootAppDir(), "captures")
+		err := os.MkdirAll(capturesDir, 0700)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		filePath = filepath.Join(capturesDir, fmt.Sprintf("%s.jsonl", time.Now().UTC().Format("20060102T150405")))
+	}
+	captureStatus, err := core.Capture.Start(req, filePath)
+	if err == core.ErrCaptureRunning {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rpcLog.Warnf("%s started an envelope capture (payloads: %v)", rpc.getClientCommonName(ctx), req.Payloads)
+	return captureStatus, nil
+}
+
+// StopCapture - Stop recording envelopes, the captured envelopes are kept
+func (rpc *Server) StopCapture(ctx context.Context, _ *commonpb.Empty) (*clientpb.CaptureStatus, error) {
+	return core.Capture.Stop(), nil
+}
+
+// GetCapture - Get the captured envelopes
+func (rpc *Server) GetCapture(ctx context.Context, req *clientpb.CaptureEntriesReq) (*clientpb.CapturedEnvelopes, error) {
+	return &clientpb.CapturedEnvelopes{
+		Status:    core.Capture.Status(),
+		Envelopes: core.Capture.Entries(req.SessionID, int(req.Limit)),
+	}, nil
+}
@@ -55,13 +55,19 @@ func (rpc *Server) Creds(ctx context.Context, req *commonpb.Empty) (*clientpb.Cr
 
 func (rpc *Server) CredsAdd(ctx context.Context, req *clientpb.Credentials) (*commonpb.Empty, error) {
 	for _, cred := range req.Credentials {
+		hashType := cred.HashType
+		if cred.Hash != "" && hashType == clientpb.HashType_INVALID {
+			hashType = credentials.SniffHashType(cred.Hash)
+		}
 		err := db.Session().Create(&models.Credential{
-			Collection: cred.Collection,
-			Username:   cred.Username,
-			Plaintext:  cred.Plaintext,
-			Hash:       cred.Hash,
-			HashType:   int32(cred.HashType),
-			IsCracked:  (cred.Plaintext != "" && cred.Hash != ""),
+			Collection:      cred.Collection,
+			Username:        cred.Username,
+			Plaintext:       cred.Plaintext,
+			Hash:            cred.Hash,
+			HashType:        int32(hashType),
+			IsCracked:       (cred.Plaintext != "" && cred.Hash != ""),
+			OriginHostUUID:  uuid.FromStringOrNil(cred.OriginHostUUID),
+			OriginSessionID: cred.OriginSessionID,
 		}).Error
 		if err != nil {
 			credsRpcLog.Errorf("Failed to add credential: %s", err)
@@ -0,0 +1,79 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/federation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FederationLinkAdd - Link another server using one of its operator configs
+func (rpc *Server) FederationLinkAdd(ctx context.Context, req *clientpb.FederationLinkReq) (*clientpb.FederationLink, error) {
+	link, err := federation.AddLink(req.Name, req.Config, req.RelayEvents)
+	if err == federation.ErrLinkExists {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return link, nil
+}
+
+// FederationLinkRm - Remove a linked server
+func (rpc *Server) FederationLinkRm(ctx context.Context, req *clientpb.FederationLinkReq) (*commonpb.Empty, error) {
+	err := federation.RemoveLink(req.Name)
+	if err == federation.ErrLinkNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// FederationLinks - List linked servers
+func (rpc *Server) FederationLinks(ctx context.Context, _ *commonpb.Empty) (*clientpb.FederationLinks, error) {
+	return federation.Links(), nil
+}
+
+// FederationView - Browse the sessions, beacons, and loot of linked servers
+func (rpc *Server) FederationView(ctx context.Context, req *clientpb.FederationReq) (*clientpb.FederationView, error) {
+	view, err := federation.View(req)
+	if err == federation.ErrLinkNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return view, err
+}
+
+// FederationLootContent - Fetch (and optionally replicate) loot from a linked server
+func (rpc *Server) FederationLootContent(ctx context.Context, req *clientpb.FederationLootReq) (*clientpb.Loot, error) {
+	lootContent, err := federation.LootContent(req)
+	if err == federation.ErrLinkNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err == federation.ErrNotConnected {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return lootContent, err
+}
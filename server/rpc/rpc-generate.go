@@ -33,9 +33,11 @@ import (
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/canaries"
 	"github.com/bishopfox/sliver/server/codenames"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/encoders"
 	"github.com/bishopfox/sliver/server/generate"
 	"github.com/bishopfox/sliver/server/log"
@@ -131,6 +133,79 @@ func (rpc *Server) Regenerate(ctx context.Context, req *clientpb.RegenerateReq)
 	}, nil
 }
 
+// GenerateProvisioningBundle - Generate the config and keys of a new implant for a
+// provisionable implant build
+func (rpc *Server) GenerateProvisioningBundle(ctx context.Context, req *clientpb.ProvisioningBundleReq) (*clientpb.ProvisioningBundle, error) {
+	build, err := db.ImplantBuildByName(req.ImplantName)
+	if err != nil {
+		rpcLog.Errorf("Failed to find implant %s: %s", req.ImplantName, err)
+		return nil, status.Error(codes.InvalidArgument, "invalid implant name")
+	}
+	name := req.Name
+	if name == "" {
+		name, err = codenames.GetCodename()
+		if err != nil {
+			return nil, err
+		}
+	} else if _, err := db.ImplantBuildByName(name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "implant %s already exists", name)
+	}
+	bundle, err := generate.ProvisioningBundle(build, name, req.C2, req.HTTPC2ConfigVersion)
+	if err == generate.ErrNotProvisionable {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	file := &commonpb.File{
+		Name: name + ".bundle",
+		Data: bundle,
+	}
+	if req.Patch {
+		executable, err := generate.ImplantFileFromBuild(build)
+		if err != nil {
+			return nil, err
+		}
+		file = &commonpb.File{
+			Name: name + filepath.Ext(build.ImplantConfig.FileName),
+			Data: append(executable, bundle...),
+		}
+	}
+
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.BuildCompletedEvent,
+		Data:      []byte(name),
+	})
+
+	return &clientpb.ProvisioningBundle{
+		Name: name,
+		File: file,
+	}, nil
+}
+
+// VerifyImplantBuild - Rebuild a reproducible implant and compare it to the saved build
+func (rpc *Server) VerifyImplantBuild(ctx context.Context, req *clientpb.ImplantBuildVerifyReq) (*clientpb.ImplantBuildVerification, error) {
+	build, err := db.ImplantBuildByName(req.ImplantName)
+	if err != nil {
+		rpcLog.Errorf("Failed to find implant %s: %s", req.ImplantName, err)
+		return nil, status.Error(codes.InvalidArgument, "invalid implant name")
+	}
+	rebuildSHA256, err := generate.RebuildSHA256(build)
+	if errors.Is(err, generate.ErrNotReproducible) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		rpcLog.Errorf("Failed to rebuild implant %s: %s", req.ImplantName, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clientpb.ImplantBuildVerification{
+		ImplantName:   build.Name,
+		SHA256:        build.SHA256,
+		RebuildSHA256: rebuildSHA256,
+		Match:         build.SHA256 == rebuildSHA256,
+	}, nil
+}
+
 // ImplantBuilds - List existing implant builds
 func (rpc *Server) ImplantBuilds(ctx context.Context, _ *commonpb.Empty) (*clientpb.ImplantBuilds, error) {
 	dbBuilds, err := db.ImplantBuilds()
@@ -164,6 +239,39 @@ func (rpc *Server) Canaries(ctx context.Context, _ *commonpb.Empty) (*clientpb.C
 	}, nil
 }
 
+// CanaryTokens - List canary tokens
+func (rpc *Server) CanaryTokens(ctx context.Context, _ *commonpb.Empty) (*clientpb.CanaryTokens, error) {
+	tokens, err := canaries.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clientpb.CanaryTokens{Tokens: tokens}, nil
+}
+
+// CanaryTokenNew - Generate an HTTP, AWS credential, or document canary
+func (rpc *Server) CanaryTokenNew(ctx context.Context, req *clientpb.CanaryTokenReq) (*clientpb.CanaryToken, error) {
+	token, err := canaries.New(req)
+	if err == canaries.ErrInvalidType || err == canaries.ErrInvalidURL {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return token, nil
+}
+
+// CanaryTokenRm - Remove a canary token
+func (rpc *Server) CanaryTokenRm(ctx context.Context, req *clientpb.CanaryToken) (*commonpb.Empty, error) {
+	err := canaries.Remove(req.ID)
+	if err == canaries.ErrCanaryNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.Empty{}, nil
+}
+
 // GenerateUniqueIP - Wrapper around generate.GenerateUniqueIP
 func (rpc *Server) GenerateUniqueIP(ctx context.Context, _ *commonpb.Empty) (*clientpb.UniqueWGIP, error) {
 	uniqueIP, err := generate.GenerateUniqueIP()
@@ -189,23 +297,76 @@ func (rpc *Server) ImplantProfiles(ctx context.Context, _ *commonpb.Empty) (*cli
 	}
 	for _, dbProfile := range dbProfiles {
 		implantProfiles.Profiles = append(implantProfiles.Profiles, &clientpb.ImplantProfile{
-			Name:   dbProfile.Name,
-			Config: dbProfile.ImplantConfig.ToProtobuf(),
+			Name:    dbProfile.Name,
+			Version: dbProfile.Version,
+			Config:  dbProfile.ImplantConfig.ToProtobuf(),
 		})
 	}
 	return implantProfiles, nil
 }
 
+// GetImplantProfile - Get a profile by name, optionally at a previous version
+func (rpc *Server) GetImplantProfile(ctx context.Context, req *clientpb.ImplantProfileReq) (*clientpb.ImplantProfile, error) {
+	dbProfile, err := db.ImplantProfileByName(req.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("profile %s not found", req.Name))
+	}
+	if req.Version == 0 || req.Version == dbProfile.Version {
+		return &clientpb.ImplantProfile{
+			Name:    dbProfile.Name,
+			Version: dbProfile.Version,
+			Config:  dbProfile.ImplantConfig.ToProtobuf(),
+		}, nil
+	}
+	profileVersion, err := db.ImplantProfileVersionByName(req.Name, req.Version)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("profile %s version %d not found", req.Name, req.Version))
+	}
+	return profileVersionToProtobuf(profileVersion)
+}
+
+// ImplantProfileHistory - List the saved versions of a profile
+func (rpc *Server) ImplantProfileHistory(ctx context.Context, req *clientpb.ImplantProfileReq) (*clientpb.ImplantProfiles, error) {
+	versions, err := db.ImplantProfileVersions(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	history := &clientpb.ImplantProfiles{Profiles: []*clientpb.ImplantProfile{}}
+	for _, profileVersion := range versions {
+		profile, err := profileVersionToProtobuf(profileVersion)
+		if err != nil {
+			rpcLog.Warnf("Failed to decode profile %s version %d: %s", req.Name, profileVersion.Version, err)
+			continue
+		}
+		history.Profiles = append(history.Profiles, profile)
+	}
+	return history, nil
+}
+
+func profileVersionToProtobuf(profileVersion *models.ImplantProfileVersion) (*clientpb.ImplantProfile, error) {
+	config, err := generate.ImplantProfileVersionConfig(profileVersion)
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.ImplantProfile{
+		Name:      profileVersion.ProfileName,
+		Version:   profileVersion.Version,
+		CreatedAt: profileVersion.CreatedAt.Unix(),
+		Config:    config,
+	}, nil
+}
+
 // SaveImplantProfile - Save a new profile
 func (rpc *Server) SaveImplantProfile(ctx context.Context, profile *clientpb.ImplantProfile) (*clientpb.ImplantProfile, error) {
 	_, config := generate.ImplantConfigFromProtobuf(profile.Config)
 	profile.Name = filepath.Base(profile.Name)
 	if 0 < len(profile.Name) && profile.Name != "." {
 		rpcLog.Infof("Saving new profile with name %#v", profile.Name)
-		err := generate.SaveImplantProfile(profile.Name, config)
+		version, err := generate.SaveImplantProfile(profile.Name, config)
 		if err != nil {
 			return nil, err
 		}
+		profile.Version = version
 		core.EventBroker.Publish(core.Event{
 			EventType: consts.ProfileEvent,
 			Data:      []byte(profile.Name),
@@ -222,6 +383,11 @@ func (rpc *Server) DeleteImplantProfile(ctx context.Context, req *clientpb.Delet
 		return nil, err
 	}
 	err = db.Session().Delete(profile).Error
+	if err == nil {
+		err = db.Session().Where(&models.ImplantProfileVersion{
+			ProfileName: profile.Name,
+		}).Delete(&models.ImplantProfileVersion{}).Error
+	}
 	if err == nil {
 		core.EventBroker.Publish(core.Event{
 			EventType: consts.ProfileEvent,
@@ -251,6 +417,37 @@ func (rpc *Server) DeleteImplantBuild(ctx context.Context, req *clientpb.DeleteR
 	return &commonpb.Empty{}, err
 }
 
+// BurnImplant - Mark an implant build as compromised, listeners will only serve decoy
+// content to it and any live sessions are removed
+func (rpc *Server) BurnImplant(ctx context.Context, req *clientpb.BurnReq) (*commonpb.Empty, error) {
+	build, err := db.ImplantBuildByName(req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "implant build %s not found", req.Name)
+	}
+	build.Compromised = !req.Unburn
+	err = db.Session().Save(build).Error
+	if err != nil {
+		return nil, err
+	}
+	if req.Unburn {
+		core.BurnList.Remove(build)
+		rpcLog.Infof("Implant build %s removed from the burn list", build.Name)
+	} else {
+		core.BurnList.Add(build)
+		rpcLog.Warnf("Implant build %s added to the burn list", build.Name)
+		for _, session := range core.Sessions.All() {
+			if session.Name == build.Name {
+				core.Sessions.Remove(session.ID)
+			}
+		}
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.BuildEvent,
+		Data:      []byte(build.Name),
+	})
+	return &commonpb.Empty{}, nil
+}
+
 // ShellcodeRDI - Generates a RDI shellcode from a given DLL
 func (rpc *Server) ShellcodeRDI(ctx context.Context, req *clientpb.ShellcodeRDIReq) (*clientpb.ShellcodeRDI, error) {
 	shellcode, err := generate.ShellcodeRDIFromBytes(req.GetData(), req.GetFunctionName(), req.GetArguments())
@@ -272,30 +469,53 @@ func (rpc *Server) GetCompiler(ctx context.Context, _ *commonpb.Empty) (*clientp
 
 // *** External builder RPCs ***
 
-// Generate - Generate a new implant
+// GenerateExternal - Queue a new implant build for an external builder, identical
+// requests are deduplicated and completed builds are returned from the cache
 func (rpc *Server) GenerateExternal(ctx context.Context, req *clientpb.ExternalGenerateReq) (*clientpb.ExternalImplantConfig, error) {
-	var err error
-	name, config := generate.ImplantConfigFromProtobuf(req.Config)
-	if name == "" {
-		name, err = codenames.GetCodename()
-		if err != nil {
-			return nil, err
-		}
-	}
-	if config == nil {
+	if req.Config == nil {
 		return nil, errors.New("invalid implant config")
 	}
-	externalConfig, err := generate.SliverExternal(name, config)
+	if core.GetBuilder(req.BuilderName) == nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown builder")
+	}
+	configHash, err := generate.ExternalConfigHash(req.Config)
 	if err != nil {
 		return nil, err
 	}
+	if !req.NoCache {
+		build, err := db.ImplantBuildByConfigHash(configHash)
+		if err == nil {
+			rcpGenLog.Infof("External build cache hit %s -> %s", configHash, build.Name)
+			return &clientpb.ExternalImplantConfig{
+				Config:          build.ImplantConfig.ToProtobuf(),
+				CachedBuildName: build.Name,
+			}, nil
+		}
+	} else {
+		configHash = "" // Do not deduplicate, and do not cache
+	}
 
-	core.EventBroker.Publish(core.Event{
-		EventType: consts.ExternalBuildEvent,
-		Data:      []byte(fmt.Sprintf("%s:%s", req.BuilderName, config.ID.String())),
+	build, duplicate, err := core.BuildQueue.Enqueue(req.BuilderName, configHash, req.Priority, func() (*clientpb.ExternalImplantConfig, error) {
+		name, config := generate.ImplantConfigFromProtobuf(req.Config)
+		if name == "" {
+			name, err = codenames.GetCodename()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if config == nil {
+			return nil, errors.New("invalid implant config")
+		}
+		config.ConfigHash = configHash
+		return generate.SliverExternal(name, config)
 	})
-
-	return externalConfig, err
+	if err != nil {
+		return nil, err
+	}
+	if duplicate {
+		rcpGenLog.Infof("Duplicate external build request, waiting on %s", build.Config.Config.ID)
+	}
+	return build.Config, nil
 }
 
 // GenerateExternalSaveBuild - Allows an external builder to save the build to the server
@@ -333,7 +553,11 @@ func (rpc *Server) GenerateExternalSaveBuild(ctx context.Context, req *clientpb.
 
 	implantConfig.FileName = req.File.Name
 	generate.ImplantConfigSave(implantConfig)
-	err = generate.ImplantBuildSave(req.Name, implantConfig, tmpFile.Name())
+	builderName := req.BuilderName
+	if builderName == "" {
+		builderName = "external"
+	}
+	err = generate.ImplantBuildSaveFrom(req.Name, implantConfig, tmpFile.Name(), builderName, req.Toolchain)
 	if err != nil {
 		rpcLog.Errorf("Failed to save external build: %s", err)
 		return nil, err
@@ -377,10 +601,12 @@ func (rpc *Server) BuilderRegister(req *clientpb.Builder, stream rpcpb.SliverRPC
 
 	rpcEventsLog.Infof("Builder %s (%s) connected", req.Name, req.OperatorName)
 	events := core.EventBroker.Subscribe()
+	go core.BuildQueue.Dispatch()
 	defer func() {
 		rpcEventsLog.Infof("Builder %s disconnected", req.Name)
 		core.EventBroker.Unsubscribe(events)
 		core.RemoveBuilder(req.Name)
+		core.BuildQueue.FailBuilder(req.Name, "builder disconnected")
 	}()
 
 	// Only forward these event types to the builder
@@ -425,7 +651,13 @@ func (rpc *Server) BuilderRegister(req *clientpb.Builder, stream rpcpb.SliverRPC
 
 // Builders - Get a list of all builders
 func (rpc *Server) Builders(ctx context.Context, _ *commonpb.Empty) (*clientpb.Builders, error) {
-	return &clientpb.Builders{Builders: core.AllBuilders()}, nil
+	builders := &clientpb.Builders{Builders: []*clientpb.Builder{}}
+	for _, builder := range core.AllBuilders() {
+		builder = proto.Clone(builder).(*clientpb.Builder)
+		builder.ActiveBuilds, builder.QueuedBuilds = core.BuildQueue.Stats(builder.Name)
+		builders.Builders = append(builders.Builders, builder)
+	}
+	return builders, nil
 }
 
 // BuilderTrigger - Trigger a builder event
@@ -443,6 +675,10 @@ func (rpc *Server) BuilderTrigger(ctx context.Context, req *clientpb.Event) (*co
 			EventType: req.EventType,
 			Data:      req.Data,
 		})
+		if req.EventType != consts.AcknowledgeBuildEvent {
+			configID := strings.SplitN(string(req.Data), ":", 2)[0]
+			core.BuildQueue.Done(configID)
+		}
 
 	}
 
@@ -0,0 +1,42 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/ha"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HASnapshot - Stream the state of this server to a standby server, see server/ha.
+// Only standby identities can call it, see transport.standbyMethods
+func (rpc *Server) HASnapshot(req *clientpb.HASnapshotReq, stream rpcpb.SliverRPC_HASnapshotServer) error {
+	err := ha.SendSnapshot(assets.GetRootAppDir(), req.Recipient, stream.Send)
+	if err == ha.ErrInvalidRecipient {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		rpcLog.Errorf("Failed to snapshot server state: %s", err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
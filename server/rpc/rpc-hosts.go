@@ -20,10 +20,16 @@ package rpc
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/hosts"
+	"github.com/bishopfox/sliver/server/stix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // var (
@@ -52,13 +58,65 @@ func (rpc *Server) Host(ctx context.Context, req *clientpb.Host) (*clientpb.Host
 	return dbHost.ToProtobuf(), nil
 }
 
+// HostDetails - Sessions, beacons, loot, command history, and processes of a host
+func (rpc *Server) HostDetails(ctx context.Context, req *clientpb.HostDetailsReq) (*clientpb.HostDetails, error) {
+	details, err := hosts.Details(req)
+	if err == hosts.ErrHostNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return details, err
+}
+
+// HostTimeline - Everything that happened on a host in chronological order
+func (rpc *Server) HostTimeline(ctx context.Context, req *clientpb.Host) (*clientpb.HostTimeline, error) {
+	timeline, err := hosts.Timeline(req.HostUUID)
+	if err == hosts.ErrHostNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return timeline, err
+}
+
+// EngagementExport - Export the hosts, implants, and observed techniques as a STIX
+// bundle or an Attack Flow document
+func (rpc *Server) EngagementExport(ctx context.Context, req *clientpb.EngagementExportReq) (*commonpb.File, error) {
+	if req.Format != stix.FormatSTIX && req.Format != stix.FormatAttackFlow {
+		return nil, status.Error(codes.InvalidArgument, stix.ErrInvalidFormat.Error())
+	}
+	var since time.Time
+	if req.Since != 0 {
+		since = time.Unix(req.Since, 0)
+	}
+	engagement, err := stix.Collect(req.Name, req.HostUUIDs, since)
+	if err != nil {
+		return nil, err
+	}
+	data, err := stix.Export(engagement, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.File{
+		Name: fmt.Sprintf("%s_%s.json", req.Format, engagement.Created.Format("20060102150405")),
+		Data: data,
+	}, nil
+}
+
+// AttackCoverage - The MITRE ATT&CK techniques of the commands run per host and
+// for the whole engagement
+func (rpc *Server) AttackCoverage(ctx context.Context, req *clientpb.AttackCoverageReq) (*clientpb.AttackCoverage, error) {
+	var since time.Time
+	if req.Since != 0 {
+		since = time.Unix(req.Since, 0)
+	}
+	return hosts.Coverage(req.HostUUIDs, since)
+}
+
 // HostRm - Remove a host from the database
 func (rpc *Server) HostRm(ctx context.Context, req *clientpb.Host) (*commonpb.Empty, error) {
 	dbHost, err := db.HostByHostUUID(req.HostUUID)
 	if err != nil {
 		return nil, err
 	}
-	err = db.Session().Delete(dbHost).Error
+	err = hosts.Remove(dbHost)
 	if err != nil {
 		return nil, err
 	}
@@ -77,3 +135,38 @@ func (rpc *Server) HostIOCRm(ctx context.Context, req *clientpb.IOC) (*commonpb.
 	}
 	return &commonpb.Empty{}, nil
 }
+
+// GetDiscoveredHosts - Hosts found by network discovery run from hosts
+func (rpc *Server) GetDiscoveredHosts(ctx context.Context, req *clientpb.DiscoveredHostsReq) (*clientpb.DiscoveredHosts, error) {
+	hosts, err := db.DiscoveredHosts(req.HostUUID, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	discovered := &clientpb.DiscoveredHosts{Hosts: []*clientpb.DiscoveredHostRecord{}}
+	for _, host := range hosts {
+		discovered.Hosts = append(discovered.Hosts, host.ToProtobuf())
+	}
+	return discovered, nil
+}
+
+// GetScanResults - Scan results stored for hosts
+func (rpc *Server) GetScanResults(ctx context.Context, req *clientpb.ScanResultsReq) (*clientpb.ScanResults, error) {
+	results, err := db.HostScanResults(req.HostUUID, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	scanResults := &clientpb.ScanResults{Results: []*clientpb.ScanResult{}}
+	for _, result := range results {
+		scanResults.Results = append(scanResults.Results, result.ToProtobuf())
+	}
+	return scanResults, nil
+}
+
+// GetHostProcesses - The most recent process list of a host
+func (rpc *Server) GetHostProcesses(ctx context.Context, req *clientpb.HostProcessesReq) (*clientpb.HostProcesses, error) {
+	processes, err := hosts.Processes(req.HostUUID)
+	if err == hosts.ErrHostNotFound || err == hosts.ErrNoProcesses {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return processes, err
+}
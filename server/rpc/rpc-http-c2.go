@@ -0,0 +1,91 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/c2"
+	"github.com/bishopfox/sliver/server/configs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetHTTPC2Config - Get the current HTTP C2 config
+func (rpc *Server) GetHTTPC2Config(ctx context.Context, _ *commonpb.Empty) (*clientpb.HTTPC2Config, error) {
+	config := configs.GetHTTPC2Config()
+	data, err := json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.HTTPC2Config{Version: int32(config.Version), Config: data}, nil
+}
+
+// SaveHTTPC2Config - Save a new version of the HTTP C2 config and apply it to running listeners
+func (rpc *Server) SaveHTTPC2Config(ctx context.Context, req *clientpb.HTTPC2Config) (*clientpb.HTTPC2ConfigReload, error) {
+	config := &configs.HTTPC2Config{}
+	err := json.Unmarshal(req.Config, config)
+	if err != nil {
+		return nil, err
+	}
+	version, err := configs.SaveHTTPC2Config(config)
+	if err != nil {
+		return nil, err
+	}
+	reload := &clientpb.HTTPC2ConfigReload{Version: int32(version)}
+	for _, jobID := range c2.ReloadHTTPC2Config() {
+		reload.JobIDs = append(reload.JobIDs, uint32(jobID))
+	}
+	return reload, nil
+}
+
+// ReloadHTTPC2Config - Apply the HTTP C2 config on disk to running listeners
+func (rpc *Server) ReloadHTTPC2Config(ctx context.Context, _ *commonpb.Empty) (*clientpb.HTTPC2ConfigReload, error) {
+	err := configs.CheckHTTPC2ConfigErrors()
+	if err != nil {
+		return nil, err
+	}
+	reload := &clientpb.HTTPC2ConfigReload{Version: int32(configs.GetHTTPC2Config().Version)}
+	for _, jobID := range c2.ReloadHTTPC2Config() {
+		reload.JobIDs = append(reload.JobIDs, uint32(jobID))
+	}
+	return reload, nil
+}
+
+// GenerateRedirectorConfig - Render a redirector config for a version of the HTTP C2 config
+func (rpc *Server) GenerateRedirectorConfig(ctx context.Context, req *clientpb.RedirectorReq) (*clientpb.RedirectorConfig, error) {
+	config, err := configs.GetHTTPC2ConfigVersion(int(req.Version))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	data, err := c2.RenderRedirectorConfig(req.Type, config, &c2.RedirectorOptions{
+		Upstream:   req.Upstream,
+		Decoy:      req.Decoy,
+		ServerName: req.ServerName,
+		PathPrefix: req.PathPrefix,
+		Port:       req.Port,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &clientpb.RedirectorConfig{Type: req.Type, Version: int32(config.Version), Config: data}, nil
+}
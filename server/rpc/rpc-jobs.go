@@ -29,6 +29,8 @@ import (
 	"github.com/bishopfox/sliver/server/c2"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -39,6 +41,9 @@ const (
 	defaultDNSPort     = 53
 	defaultHTTPPort    = 80
 	defaultHTTPSPort   = 443
+
+	// defaultExternalC2Port - Cobalt Strike's default External C2 port
+	defaultExternalC2Port = 2222
 )
 
 var (
@@ -52,15 +57,7 @@ func (rpc *Server) GetJobs(ctx context.Context, _ *commonpb.Empty) (*clientpb.Jo
 		Active: []*clientpb.Job{},
 	}
 	for _, job := range core.Jobs.All() {
-		jobs.Active = append(jobs.Active, &clientpb.Job{
-			ID:          uint32(job.ID),
-			Name:        job.Name,
-			Description: job.Description,
-			Protocol:    job.Protocol,
-			Port:        uint32(job.Port),
-			Domains:     job.Domains,
-			ProfileName: job.ProfileName,
-		})
+		jobs.Active = append(jobs.Active, job.ToProtobuf())
 	}
 	return jobs, nil
 }
@@ -84,6 +81,20 @@ func (rpc *Server) KillJob(ctx context.Context, kill *clientpb.KillJobReq) (*cli
 	return killJob, err
 }
 
+// DrainJob - Start or stop draining a C2 listener, a draining listener does not accept
+// new implant registrations but existing sessions and beacons keep working
+func (rpc *Server) DrainJob(ctx context.Context, req *clientpb.JobDrainReq) (*clientpb.Job, error) {
+	job := core.Jobs.Get(int(req.ID))
+	if job == nil {
+		return nil, status.Error(codes.NotFound, "invalid Job ID")
+	}
+	if job.Drain == nil {
+		return nil, status.Error(codes.InvalidArgument, "job is not a c2 listener")
+	}
+	job.Drain.SetDraining(req.Draining)
+	return job.ToProtobuf(), nil
+}
+
 // StartMTLSListener - Start an MTLS listener
 func (rpc *Server) StartMTLSListener(ctx context.Context, req *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error) {
 
@@ -95,15 +106,24 @@ func (rpc *Server) StartMTLSListener(ctx context.Context, req *clientpb.MTLSList
 		listenPort = uint16(req.Port)
 	}
 
-	job, err := c2.StartMTLSListenerJob(req.Host, listenPort)
+	knockPorts, err := c2.SPAKnockPorts(req.KnockPorts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if 0 < len(knockPorts) && !req.SPA {
+		return nil, status.Error(codes.InvalidArgument, "knock ports require spa")
+	}
+	job, err := c2.StartMTLSListenerJob(req.Host, listenPort, req.SPA, knockPorts)
 	if err != nil {
 		return nil, err
 	}
 
 	if req.Persistent {
 		cfg := &configs.MTLSJobConfig{
-			Host: req.Host,
-			Port: listenPort,
+			Host:       req.Host,
+			Port:       listenPort,
+			SPA:        req.SPA,
+			KnockPorts: knockPorts,
 		}
 		configs.GetServerConfig().AddMTLSJob(cfg)
 		job.PersistentID = cfg.JobID
@@ -112,6 +132,22 @@ func (rpc *Server) StartMTLSListener(ctx context.Context, req *clientpb.MTLSList
 	return &clientpb.MTLSListener{JobID: uint32(job.ID)}, nil
 }
 
+// StartExternalC2Listener - Start a listener for third-party External C2 controllers
+func (rpc *Server) StartExternalC2Listener(ctx context.Context, req *clientpb.ExternalC2ListenerReq) (*clientpb.ExternalC2Listener, error) {
+	if 65535 <= req.Port {
+		return nil, ErrInvalidPort
+	}
+	listenPort := uint16(defaultExternalC2Port)
+	if req.Port != 0 {
+		listenPort = uint16(req.Port)
+	}
+	job, err := c2.StartExternalC2ListenerJob(req.Host, listenPort, req.Stage)
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.ExternalC2Listener{JobID: uint32(job.ID)}, nil
+}
+
 // StartWGListener - Start a Wireguard listener
 func (rpc *Server) StartWGListener(ctx context.Context, req *clientpb.WGListenerReq) (*clientpb.WGListener, error) {
 
@@ -161,17 +197,18 @@ func (rpc *Server) StartDNSListener(ctx context.Context, req *clientpb.DNSListen
 		listenPort = uint16(req.Port)
 	}
 
-	job, err := c2.StartDNSListenerJob(req.Host, listenPort, req.Domains, req.Canaries, req.EnforceOTP)
+	job, err := c2.StartDNSListenerJob(req.Host, listenPort, req.Domains, req.Canaries, req.EnforceOTP, time.Duration(req.ReplayWindow))
 	if err != nil {
 		return nil, err
 	}
 
 	if req.Persistent {
 		cfg := &configs.DNSJobConfig{
-			Domains:  req.Domains,
-			Host:     req.Host,
-			Port:     listenPort,
-			Canaries: req.Canaries,
+			Domains:      req.Domains,
+			Host:         req.Host,
+			Port:         listenPort,
+			Canaries:     req.Canaries,
+			ReplayWindow: req.ReplayWindow,
 		}
 		configs.GetServerConfig().AddDNSJob(cfg)
 		job.PersistentID = cfg.JobID
@@ -200,9 +237,15 @@ func (rpc *Server) StartHTTPSListener(ctx context.Context, req *clientpb.HTTPLis
 		Cert:            req.Cert,
 		Key:             req.Key,
 		ACME:            req.ACME,
+		ACMEDNSProvider: req.ACMEDNSProvider,
+		ACMEDomains:     req.ACMEDomains,
 		LongPollTimeout: time.Duration(req.LongPollTimeout),
 		LongPollJitter:  time.Duration(req.LongPollJitter),
 		RandomizeJARM:   req.RandomizeJARM,
+		ReplayWindow:    time.Duration(req.ReplayWindow),
+		ACL:             req.ACL,
+
+		RequireClientCert: req.RequireClientCert,
 	}
 	job, err := c2.StartHTTPListenerJob(conf)
 	if err != nil {
@@ -219,10 +262,16 @@ func (rpc *Server) StartHTTPSListener(ctx context.Context, req *clientpb.HTTPLis
 			Cert:            req.Cert,
 			Key:             req.Key,
 			ACME:            req.ACME,
+			ACMEDNSProvider: req.ACMEDNSProvider,
+			ACMEDomains:     req.ACMEDomains,
 			EnforceOTP:      req.EnforceOTP,
 			LongPollTimeout: req.LongPollTimeout,
 			LongPollJitter:  req.LongPollJitter,
 			RandomizeJARM:   req.RandomizeJARM,
+			ReplayWindow:    req.ReplayWindow,
+			ACL:             req.ACL,
+
+			RequireClientCert: req.RequireClientCert,
 		}
 		configs.GetServerConfig().AddHTTPJob(cfg)
 		job.PersistentID = cfg.JobID
@@ -250,6 +299,8 @@ func (rpc *Server) StartHTTPListener(ctx context.Context, req *clientpb.HTTPList
 		ACME:            false,
 		LongPollTimeout: time.Duration(req.LongPollTimeout),
 		LongPollJitter:  time.Duration(req.LongPollJitter),
+		ReplayWindow:    time.Duration(req.ReplayWindow),
+		ACL:             req.ACL,
 	}
 	job, err := c2.StartHTTPListenerJob(conf)
 	if err != nil {
@@ -265,6 +316,8 @@ func (rpc *Server) StartHTTPListener(ctx context.Context, req *clientpb.HTTPList
 			Website:         req.Website,
 			LongPollTimeout: req.LongPollTimeout,
 			LongPollJitter:  req.LongPollJitter,
+			ReplayWindow:    req.ReplayWindow,
+			ACL:             req.ACL,
 		}
 		configs.GetServerConfig().AddHTTPJob(cfg)
 		job.PersistentID = cfg.JobID
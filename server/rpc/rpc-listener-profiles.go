@@ -0,0 +1,122 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/c2"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+var (
+	// ErrInvalidListenerProfileName - Listener profile names cannot be blank
+	ErrInvalidListenerProfileName = errors.New("invalid listener profile name")
+)
+
+// ListenerProfiles - List all listener profiles
+func (rpc *Server) ListenerProfiles(ctx context.Context, _ *commonpb.Empty) (*clientpb.ListenerProfiles, error) {
+	dbProfiles, err := db.ListenerProfiles()
+	if err != nil {
+		return nil, err
+	}
+	profiles := &clientpb.ListenerProfiles{Profiles: []*clientpb.ListenerProfile{}}
+	for _, dbProfile := range dbProfiles {
+		profiles.Profiles = append(profiles.Profiles, listenerProfileWithJobs(dbProfile.ToProtobuf()))
+	}
+	return profiles, nil
+}
+
+// SaveListenerProfile - Create or update a listener profile
+func (rpc *Server) SaveListenerProfile(ctx context.Context, req *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error) {
+	if req.Name == "" {
+		return nil, ErrInvalidListenerProfileName
+	}
+	for _, name := range req.DependsOn {
+		if name == req.Name {
+			return nil, c2.ErrListenerProfileCycle
+		}
+	}
+	dbProfile, err := models.ListenerProfileFromProtobuf(req)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := db.ListenerProfileByName(req.Name)
+	if err == nil {
+		dbProfile.ID = existing.ID
+		dbProfile.CreatedAt = existing.CreatedAt
+		err = db.Session().Save(dbProfile).Error
+	} else if errors.Is(err, db.ErrRecordNotFound) {
+		err = db.Session().Create(dbProfile).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+	return listenerProfileWithJobs(dbProfile.ToProtobuf()), nil
+}
+
+// RmListenerProfile - Remove a listener profile, running jobs are not stopped
+func (rpc *Server) RmListenerProfile(ctx context.Context, req *clientpb.ListenerProfile) (*commonpb.Empty, error) {
+	dbProfile, err := db.ListenerProfileByName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Session().Delete(dbProfile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// StartListenerProfile - Start a listener profile and its dependencies
+func (rpc *Server) StartListenerProfile(ctx context.Context, req *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error) {
+	_, err := c2.StartListenerProfile(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	dbProfile, err := db.ListenerProfileByName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return listenerProfileWithJobs(dbProfile.ToProtobuf()), nil
+}
+
+// StopListenerProfile - Stop all jobs started from a listener profile
+func (rpc *Server) StopListenerProfile(ctx context.Context, req *clientpb.ListenerProfile) (*clientpb.ListenerProfile, error) {
+	dbProfile, err := db.ListenerProfileByName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	profile := dbProfile.ToProtobuf()
+	for _, job := range c2.StopListenerProfile(req.Name) {
+		profile.JobIDs = append(profile.JobIDs, uint32(job.ID))
+	}
+	return profile, nil
+}
+
+func listenerProfileWithJobs(profile *clientpb.ListenerProfile) *clientpb.ListenerProfile {
+	for _, job := range c2.ListenerProfileJobs(profile.Name) {
+		profile.JobIDs = append(profile.JobIDs, uint32(job.ID))
+	}
+	return profile
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/loot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // LootAdd - Add loot
@@ -74,3 +76,30 @@ func (rpc *Server) LootContent(ctx context.Context, lootReq *clientpb.Loot) (*cl
 func (rpc *Server) LootAll(ctx context.Context, _ *commonpb.Empty) (*clientpb.AllLoot, error) {
 	return loot.GetLootStore().All(), nil
 }
+
+// LootTag - Add or remove tags on a piece of loot
+func (rpc *Server) LootTag(ctx context.Context, req *clientpb.LootTagReq) (*clientpb.Loot, error) {
+	loot, err := loot.GetLootStore().Tag(req.ID, req.Add, req.Remove)
+	if err != nil {
+		return nil, err
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(loot.ID),
+	})
+	return loot, nil
+}
+
+// LootSearch - Search loot and credentials
+func (rpc *Server) LootSearch(ctx context.Context, req *clientpb.LootSearchReq) (*clientpb.LootSearchResults, error) {
+	return loot.GetLootStore().Search(req)
+}
+
+// LootExport - Export loot and credentials matching a filter
+func (rpc *Server) LootExport(ctx context.Context, req *clientpb.LootExportReq) (*commonpb.File, error) {
+	file, err := loot.GetLootStore().Export(req)
+	if err == loot.ErrInvalidExportFormat || err == loot.ErrInvalidExportGroup {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return file, err
+}
@@ -20,10 +20,12 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
@@ -33,6 +35,8 @@ import (
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/generate"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/bishopfox/sliver/server/msf"
 )
@@ -175,6 +179,12 @@ func (rpc *Server) MsfStage(ctx context.Context, req *clientpb.MsfStagerReq) (*c
 	default:
 		return MSFStage, errors.New("protocol not supported")
 	}
+	// Stagers for a Metasploit multi/handler, e.g. meterpreter/reverse_https, use the
+	// handler's uri instead of a sliver stager path
+	if req.Payload != "" && req.Payload != payload {
+		payload = req.Payload
+		uri = ""
+	}
 
 	// We only support windows at the moment
 	if req.GetOS() != "windows" {
@@ -199,15 +209,41 @@ func (rpc *Server) MsfStage(ctx context.Context, req *clientpb.MsfStagerReq) (*c
 		return MSFStage, err
 	}
 	MSFStage.File.Data = stage
+	// The custom payloads are only handled by sliver's stage listeners
+	if !strings.HasPrefix(payload, "custom/") {
+		MSFStage.Handler, err = msf.HandlerScript(venomConfig)
+		if err != nil {
+			return MSFStage, err
+		}
+	}
 	name, err := codenames.GetCodename()
 	if err != nil {
 		return MSFStage, err
 	}
 	MSFStage.File.Name = name
+	venomJSON, _ := json.Marshal(venomConfig)
+	err = generate.RecordProvenance(&models.ArtifactProvenance{
+		Name:      name,
+		Kind:      generate.ArtifactStager,
+		Builder:   generate.ServerBuilder,
+		Toolchain: msfToolchain(),
+		Config:    string(venomJSON),
+	}, stage)
+	if err != nil {
+		rpcLog.Warnf("Failed to record stager provenance: %s", err)
+	}
 	return MSFStage, nil
 }
 
 // Utility functions
+func msfToolchain() string {
+	msfVersion, err := msf.Version()
+	if err != nil {
+		return "metasploit (unknown version)"
+	}
+	return "metasploit " + strings.TrimSpace(msfVersion)
+}
+
 func generateCallbackURI() string {
 	currentHTTPC2Config := configs.GetHTTPC2Config()
 	segments := currentHTTPC2Config.ImplantConfig.StagerPaths
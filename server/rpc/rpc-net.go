@@ -35,6 +35,36 @@ func (rpc *Server) Ifconfig(ctx context.Context, req *sliverpb.IfconfigReq) (*sl
 	return resp, nil
 }
 
+// Scan - TCP connect scan from the remote system
+func (rpc *Server) Scan(ctx context.Context, req *sliverpb.ScanReq) (*sliverpb.Scan, error) {
+	resp := &sliverpb.Scan{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DNSResolvers - Get or set the resolvers of the implant's name resolution
+func (rpc *Server) DNSResolvers(ctx context.Context, req *sliverpb.DNSResolversReq) (*sliverpb.DNSResolvers, error) {
+	resp := &sliverpb.DNSResolvers{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Discover - Network discovery from the remote system
+func (rpc *Server) Discover(ctx context.Context, req *sliverpb.DiscoverReq) (*sliverpb.Discover, error) {
+	resp := &sliverpb.Discover{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Netstat - List network connections on the remote system
 func (rpc *Server) Netstat(ctx context.Context, req *sliverpb.NetstatReq) (*sliverpb.Netstat, error) {
 	resp := &sliverpb.Netstat{Response: &commonpb.Response{}}
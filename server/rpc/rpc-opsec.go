@@ -0,0 +1,38 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+// GetOpsecPolicy - The opsec policy and the name of the operator it applies to, clients
+// warn and prompt before running risky commands, blocks are enforced by the server
+func (rpc *Server) GetOpsecPolicy(ctx context.Context, _ *commonpb.Empty) (*clientpb.OpsecPolicy, error) {
+	policy := configs.GetOpsecPolicy()
+	policy.Operator = rpc.getClientCommonName(ctx)
+	if policy.Operator == "" {
+		policy.Operator = "server" // The server's own console
+	}
+	return policy, nil
+}
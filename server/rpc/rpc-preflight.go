@@ -0,0 +1,54 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/preflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Preflight - Check a build config (from the request, a profile, or a build) and the
+// running listeners for opsec mistakes before anything is deployed
+func (rpc *Server) Preflight(ctx context.Context, req *clientpb.PreflightReq) (*clientpb.Preflight, error) {
+	config := req.Config
+	if req.ProfileName != "" {
+		profile, err := db.ImplantProfileByName(req.ProfileName)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "profile %s not found", req.ProfileName)
+		}
+		config = profile.ImplantConfig.ToProtobuf()
+		config.Name = profile.Name
+	} else if req.ImplantName != "" {
+		build, err := db.ImplantBuildByName(req.ImplantName)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "implant build %s not found", req.ImplantName)
+		}
+		config = build.ImplantConfig.ToProtobuf()
+		config.Name = build.Name
+	}
+	if config == nil && req.SkipInfrastructure {
+		return nil, status.Error(codes.InvalidArgument, "nothing to check")
+	}
+	return preflight.Run(config, !req.SkipInfrastructure), nil
+}
@@ -0,0 +1,99 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetPresence - The operator started (or stopped) interacting with a session/beacon, returns
+// the other operators interacting with the target and any lock on it
+func (rpc *Server) SetPresence(ctx context.Context, req *clientpb.PresenceReq) (*clientpb.Presence, error) {
+	operator := rpc.getClientCommonName(ctx)
+	if req.TargetID == "" {
+		core.Presence.Leave(operator)
+		return &clientpb.Presence{}, nil
+	}
+	core.Presence.Touch(operator, req.TargetID)
+	presence := &clientpb.Presence{}
+	for _, other := range core.Presence.Operators(req.TargetID) {
+		if other.Operator != operator {
+			presence.Operators = append(presence.Operators, other)
+		}
+	}
+	for _, lock := range core.Presence.Locks() {
+		if lock.TargetID == req.TargetID {
+			presence.Locks = append(presence.Locks, lock)
+		}
+	}
+	return presence, nil
+}
+
+// GetPresence - Operators interacting with sessions/beacons and the current locks
+func (rpc *Server) GetPresence(ctx context.Context, _ *commonpb.Empty) (*clientpb.Presence, error) {
+	return &clientpb.Presence{
+		Operators: core.Presence.Operators(""),
+		Locks:     core.Presence.Locks(),
+	}, nil
+}
+
+// LockTarget - Soft-lock a session/beacon, other operators' tasks are refused
+func (rpc *Server) LockTarget(ctx context.Context, req *clientpb.TargetLockReq) (*clientpb.TargetLock, error) {
+	if !targetExists(req.TargetID) {
+		return nil, status.Error(codes.NotFound, "session or beacon not found")
+	}
+	operator := rpc.getClientCommonName(ctx)
+	lock, err := core.Presence.Lock(operator, req.TargetID, req.Reason, req.Force)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	core.Presence.Touch(operator, req.TargetID)
+	return lock, nil
+}
+
+// UnlockTarget - Remove a lock, another operator's lock can only be removed with force
+func (rpc *Server) UnlockTarget(ctx context.Context, req *clientpb.TargetLockReq) (*commonpb.Empty, error) {
+	err := core.Presence.Unlock(rpc.getClientCommonName(ctx), req.TargetID, req.Force)
+	if errors.Is(err, core.ErrNotLocked) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &commonpb.Empty{}, nil
+}
+
+func targetExists(targetID string) bool {
+	if targetID == "" {
+		return false
+	}
+	if core.Sessions.Get(targetID) != nil {
+		return true
+	}
+	beacon, err := db.BeaconByID(targetID)
+	return err == nil && beacon != nil
+}
@@ -0,0 +1,80 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyArtifact - Look up the provenance record of an artifact and verify its signature
+func (rpc *Server) VerifyArtifact(ctx context.Context, req *commonpb.File) (*clientpb.ArtifactProvenance, error) {
+	digest := sha256.Sum256(req.Data)
+	provenance, err := db.ArtifactProvenanceBySHA256(hex.EncodeToString(digest[:]))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "No provenance record for artifact")
+	}
+	pbProvenance := provenance.ToProtobuf()
+	pbProvenance.PublicKey = cryptography.ArtifactSigningPublicKey()
+	pbProvenance.Verified = cryptography.ArtifactVerify(provenance.SignedRecord(), provenance.Signature)
+	return pbProvenance, nil
+}
+
+// LookupArtifactMarker - Look up the build a marker found in a (leaked) sample was embedded
+// in, and return the provenance record of that build. Markers don't share a pattern, so
+// samples are searched for the marker of every build
+func (rpc *Server) LookupArtifactMarker(ctx context.Context, req *clientpb.ArtifactMarkerReq) (*clientpb.ArtifactProvenance, error) {
+	if 0 < len(req.Data) {
+		markers, err := db.ImplantBuildMarkers()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		req.Marker = ""
+		for _, marker := range markers {
+			if bytes.Contains(req.Data, []byte(marker)) {
+				req.Marker = marker
+				break
+			}
+		}
+		if req.Marker == "" {
+			return nil, status.Error(codes.NotFound, "No implant build marker found in the sample")
+		}
+	}
+	build, err := db.ImplantBuildByMarker(req.Marker)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "No implant build with marker %s", req.Marker)
+	}
+	provenance, err := db.ArtifactProvenanceBySHA256(build.SHA256)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "No provenance record for implant build %s", build.Name)
+	}
+	pbProvenance := provenance.ToProtobuf()
+	pbProvenance.PublicKey = cryptography.ArtifactSigningPublicKey()
+	pbProvenance.Verified = cryptography.ArtifactVerify(provenance.SignedRecord(), provenance.Signature)
+	return pbProvenance, nil
+}
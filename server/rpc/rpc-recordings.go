@@ -0,0 +1,50 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/recordings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetRecordings - List shell and console recordings
+func (rpc *Server) GetRecordings(ctx context.Context, req *clientpb.RecordingReq) (*clientpb.Recordings, error) {
+	all, err := recordings.List(req.SessionID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clientpb.Recordings{Recordings: all}, nil
+}
+
+// GetRecording - Get the asciicast file of a recording
+func (rpc *Server) GetRecording(ctx context.Context, req *clientpb.RecordingReq) (*commonpb.File, error) {
+	data, err := recordings.Read(req.ID)
+	if err == recordings.ErrRecordingNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &commonpb.File{Name: req.ID + ".cast", Data: data}, nil
+}
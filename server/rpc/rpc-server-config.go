@@ -0,0 +1,45 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReloadServerConfig - Re-read the server config from disk and apply it to running components
+func (rpc *Server) ReloadServerConfig(ctx context.Context, _ *commonpb.Empty) (*clientpb.ServerConfigReload, error) {
+	reloaded, errs, err := configs.ReloadServerConfig()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	reload := &clientpb.ServerConfigReload{
+		Reloaded: reloaded,
+		Errors:   map[string]string{},
+	}
+	for component, err := range errs {
+		reload.Errors[component] = err.Error()
+	}
+	return reload, nil
+}
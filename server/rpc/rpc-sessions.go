@@ -38,12 +38,13 @@ func (rpc *Server) GetSessions(ctx context.Context, _ *commonpb.Empty) (*clientp
 	for _, session := range core.Sessions.All() {
 		build, err := db.ImplantBuildByName(session.Name)
 		if err == nil && build != nil {
-			if build.Burned {
+			if build.Burned || build.Compromised {
 				session.Burned = true
 			}
 		}
 		resp.Sessions = append(resp.Sessions, session.ToProtobuf())
 	}
+	applySessionMetadata(resp.Sessions)
 	return resp, nil
 }
 
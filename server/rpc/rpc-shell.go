@@ -24,6 +24,7 @@ import (
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/recordings"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -54,9 +55,32 @@ func (rpc *Server) Shell(ctx context.Context, req *sliverpb.ShellReq) (*sliverpb
 	}
 	shell := &sliverpb.Shell{}
 	err = proto.Unmarshal(data, shell)
+	if err == nil && shell.Response.GetErr() == "" {
+		recordings.StartShell(tunnel.ID, session, rpc.getClientCommonName(ctx), req.Path)
+	}
 	return shell, err
 }
 
+// ShellResize - Resize the PTY of an interactive shell
+func (rpc *Server) ShellResize(ctx context.Context, req *sliverpb.ShellResizeReq) (*sliverpb.ShellResize, error) {
+	resp := &sliverpb.ShellResize{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ShellSignal - Send a signal to the process running in an interactive shell
+func (rpc *Server) ShellSignal(ctx context.Context, req *sliverpb.ShellSignalReq) (*sliverpb.ShellSignal, error) {
+	resp := &sliverpb.ShellSignal{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // RunSSHCommand runs a SSH command using the client built into the implant
 func (rpc *Server) RunSSHCommand(ctx context.Context, req *sliverpb.SSHCommandReq) (*sliverpb.SSHCommand, error) {
 	resp := &sliverpb.SSHCommand{Response: &commonpb.Response{}}
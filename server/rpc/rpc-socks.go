@@ -117,6 +117,7 @@ func (s *Server) SocksProxy(stream rpcpb.SliverRPC_SocksProxyServer) error {
 							CloseConn: recv.CloseConn,
 							TunnelID:  recv.TunnelID,
 							Data:      recv.Data,
+							Datagram:  recv.Datagram,
 						})
 
 						fromImplantCacheSocks.DeleteSeq(fromClient.TunnelID, socks.FromImplantSequence)
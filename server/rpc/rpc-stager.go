@@ -24,7 +24,11 @@ import (
 	"net"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/c2"
+	"github.com/bishopfox/sliver/server/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // StartTCPStagerListener starts a TCP stager listener
@@ -33,7 +37,11 @@ func (rpc *Server) StartTCPStagerListener(ctx context.Context, req *clientpb.Sta
 	if !checkInterface(req.GetHost()) {
 		host = "0.0.0.0"
 	}
-	job, err := c2.StartTCPStagerListenerJob(host, uint16(req.GetPort()), req.ProfileName, req.GetData())
+	policy, err := c2.NewStagePolicy(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	job, err := c2.StartTCPStagerListenerJob(host, uint16(req.GetPort()), req.GetData(), policy)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +66,11 @@ func (rpc *Server) StartHTTPStagerListener(ctx context.Context, req *clientpb.St
 		conf.Cert = req.Cert
 		conf.ACME = req.ACME
 	}
-	job, err := c2.StartHTTPStagerListenerJob(conf, req.ProfileName, req.Data)
+	policy, err := c2.NewStagePolicy(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	job, err := c2.StartHTTPStagerListenerJob(conf, req.Data, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +80,19 @@ func (rpc *Server) StartHTTPStagerListener(ctx context.Context, req *clientpb.St
 	return &clientpb.StagerListener{JobID: uint32(job.ID)}, err
 }
 
+// GetStageFetches - List every request made to the stage listeners
+func (rpc *Server) GetStageFetches(ctx context.Context, _ *commonpb.Empty) (*clientpb.StageFetches, error) {
+	fetches, err := db.ListStageFetches()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	stageFetches := &clientpb.StageFetches{Fetches: []*clientpb.StageFetch{}}
+	for _, fetch := range fetches {
+		stageFetches.Fetches = append(stageFetches.Fetches, fetch.ToProtobuf())
+	}
+	return stageFetches, nil
+}
+
 // checkInterface verifies if an IP address
 // is attached to an existing network interface
 func checkInterface(a string) bool {
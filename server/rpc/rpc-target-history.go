@@ -0,0 +1,87 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTargetHistoryLimit = 1000
+	maxTargetHistoryLimit     = 10000
+	maxTargetHistoryLine      = 4096
+)
+
+var (
+	targetHistoryRpcLog = log.NamedLogger("rpc", "target-history")
+)
+
+// AddTargetHistory - Record a console command line run on a session/beacon
+func (rpc *Server) AddTargetHistory(ctx context.Context, req *clientpb.TargetHistoryReq) (*commonpb.Empty, error) {
+	line := strings.TrimSpace(req.Line)
+	if line == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty command line")
+	}
+	if maxTargetHistoryLine < len(line) {
+		return nil, status.Errorf(codes.InvalidArgument, "command line exceeds %d bytes", maxTargetHistoryLine)
+	}
+	if !targetExists(req.TargetID) {
+		return nil, status.Error(codes.NotFound, "session or beacon not found")
+	}
+	err := db.Session().Create(&models.TargetHistory{
+		TargetID: req.TargetID,
+		Operator: rpc.getClientCommonName(ctx),
+		Line:     line,
+	}).Error
+	if err != nil {
+		targetHistoryRpcLog.Errorf("Failed to save target history: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// GetTargetHistory - The console command lines run on a session/beacon by any operator
+func (rpc *Server) GetTargetHistory(ctx context.Context, req *clientpb.TargetHistoryReq) (*clientpb.TargetHistory, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultTargetHistoryLimit
+	}
+	if maxTargetHistoryLimit < limit {
+		limit = maxTargetHistoryLimit
+	}
+	history, err := db.TargetHistoryByTargetID(req.TargetID, limit)
+	if err != nil {
+		targetHistoryRpcLog.Errorf("Failed to get target history: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+	targetHistory := &clientpb.TargetHistory{TargetID: req.TargetID}
+	for _, entry := range history {
+		targetHistory.Entries = append(targetHistory.Entries, entry.ToProtobuf())
+	}
+	return targetHistory, nil
+}
@@ -0,0 +1,173 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	targetMetadataRpcLog = log.NamedLogger("rpc", "target-metadata")
+)
+
+// UpdateTargetMetadata - Add/remove tags, and set the note or color of a session/beacon
+func (rpc *Server) UpdateTargetMetadata(ctx context.Context, req *clientpb.TargetMetadataReq) (*clientpb.TargetMetadata, error) {
+	if !targetExists(req.TargetID) {
+		return nil, status.Error(codes.NotFound, "session or beacon not found")
+	}
+	color := strings.ToLower(strings.TrimSpace(req.Color))
+	if req.UpdateColor && color != "" && !targetColor(color) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid color '%s'", req.Color)
+	}
+
+	metadata, err := db.TargetMetadataByTargetID(req.TargetID)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		metadata = &models.TargetMetadata{TargetID: req.TargetID}
+	} else if err != nil {
+		targetMetadataRpcLog.Errorf("Failed to get target metadata: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+	if req.UpdateNote {
+		metadata.Note = strings.TrimSpace(req.Note)
+	}
+	if req.UpdateColor {
+		metadata.Color = color
+	}
+	operator := rpc.getClientCommonName(ctx)
+	metadata.UpdatedBy = operator
+	err = db.Session().Omit("Tags").Save(metadata).Error
+	if err != nil {
+		targetMetadataRpcLog.Errorf("Failed to save target metadata: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+
+	existing := map[string]bool{}
+	for _, tag := range metadata.Tags {
+		existing[tag.Name] = true
+	}
+	for _, name := range targetTags(req.RemoveTags) {
+		if existing[name] {
+			err = db.Session().Where(&models.TargetTag{TargetMetadataID: metadata.ID, Name: name}).Delete(&models.TargetTag{}).Error
+			if err != nil {
+				targetMetadataRpcLog.Errorf("Failed to remove tag: %s", err)
+				return nil, ErrDatabaseFailure
+			}
+			delete(existing, name)
+		}
+	}
+	for _, name := range targetTags(req.AddTags) {
+		if !existing[name] {
+			err = db.Session().Create(&models.TargetTag{TargetMetadataID: metadata.ID, Name: name}).Error
+			if err != nil {
+				targetMetadataRpcLog.Errorf("Failed to add tag: %s", err)
+				return nil, ErrDatabaseFailure
+			}
+			existing[name] = true
+		}
+	}
+
+	metadata, err = db.TargetMetadataByTargetID(req.TargetID)
+	if err != nil {
+		targetMetadataRpcLog.Errorf("Failed to get target metadata: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.TargetMetadataEvent,
+		Data:      []byte(describeTargetMetadata(operator, metadata)),
+	})
+	return metadata.ToProtobuf(), nil
+}
+
+// targetColor - Colors sessions and beacons can be displayed in
+func targetColor(color string) bool {
+	for _, name := range consts.TargetColors {
+		if name == color {
+			return true
+		}
+	}
+	return false
+}
+
+// targetTags - Tags are case-insensitive, blank tags are ignored
+func targetTags(names []string) []string {
+	tags := []string{}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+func describeTargetMetadata(operator string, metadata *models.TargetMetadata) string {
+	msg := fmt.Sprintf("%s updated %s", operator, shortTargetID(metadata.TargetID))
+	if tags := metadata.TagNames(); 0 < len(tags) {
+		msg += fmt.Sprintf(" (tags: %s)", strings.Join(tags, ", "))
+	}
+	return msg
+}
+
+func shortTargetID(targetID string) string {
+	return strings.Split(targetID, "-")[0]
+}
+
+// applySessionMetadata - Add the operator assigned metadata to sessions
+func applySessionMetadata(sessions []*clientpb.Session) {
+	metadata, err := db.AllTargetMetadata()
+	if err != nil {
+		targetMetadataRpcLog.Errorf("Failed to get target metadata: %s", err)
+		return
+	}
+	for _, session := range sessions {
+		if targetMetadata, ok := metadata[session.ID]; ok {
+			session.Tags = targetMetadata.TagNames()
+			session.Note = targetMetadata.Note
+			session.Color = targetMetadata.Color
+		}
+	}
+}
+
+// applyBeaconMetadata - Add the operator assigned metadata to beacons
+func applyBeaconMetadata(beacons []*clientpb.Beacon) {
+	metadata, err := db.AllTargetMetadata()
+	if err != nil {
+		targetMetadataRpcLog.Errorf("Failed to get target metadata: %s", err)
+		return
+	}
+	for _, beacon := range beacons {
+		if targetMetadata, ok := metadata[beacon.ID]; ok {
+			beacon.Tags = targetMetadata.TagNames()
+			beacon.Note = targetMetadata.Note
+			beacon.Color = targetMetadata.Color
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/generate"
+	"github.com/bishopfox/sliver/server/toolchains"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Toolchains - List installed toolchains, the cross-compilers builds will use, and
+// the shared library targets that have no cross-compiler
+func (rpc *Server) Toolchains(ctx context.Context, _ *commonpb.Empty) (*clientpb.Toolchains, error) {
+	crossCompilers := generate.GetCrossCompilers()
+	found := map[string]bool{}
+	for _, crossCompiler := range crossCompilers {
+		found[crossCompiler.TargetGOOS+"/"+crossCompiler.TargetGOARCH] = true
+	}
+	missing := []*clientpb.CompilerTarget{}
+	for target := range generate.SupportedCompilerTargets {
+		platform := strings.SplitN(target, "/", 2)
+		if !generate.IsCrossCompiled(platform[0], platform[1]) || found[target] {
+			continue
+		}
+		missing = append(missing, &clientpb.CompilerTarget{
+			GOOS:   platform[0],
+			GOARCH: platform[1],
+			Format: clientpb.OutputFormat_SHARED_LIB,
+		})
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		return missing[i].GOOS+missing[i].GOARCH < missing[j].GOOS+missing[j].GOARCH
+	})
+	return &clientpb.Toolchains{
+		Toolchains:     toolchains.Installed(),
+		CrossCompilers: crossCompilers,
+		MissingTargets: missing,
+		AutoInstall:    toolchains.AutoInstall(),
+	}, nil
+}
+
+// ToolchainInstall - Download and install a toolchain
+func (rpc *Server) ToolchainInstall(ctx context.Context, req *clientpb.ToolchainReq) (*clientpb.Toolchain, error) {
+	if req.Name != "" && req.Name != toolchains.Zig {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown toolchain %s", req.Name)
+	}
+	toolchain, err := toolchains.Install(req.Version)
+	if errors.Is(err, toolchains.ErrInvalidVersion) || errors.Is(err, toolchains.ErrUnsupportedHost) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		rpcLog.Errorf("Failed to install toolchain: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toolchain, nil
+}
+
+// ToolchainRemove - Remove an installed toolchain
+func (rpc *Server) ToolchainRemove(ctx context.Context, req *clientpb.ToolchainReq) (*commonpb.Empty, error) {
+	if req.Name != "" && req.Name != toolchains.Zig {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown toolchain %s", req.Name)
+	}
+	err := toolchains.Remove(req.Version)
+	if errors.Is(err, toolchains.ErrInvalidVersion) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, toolchains.ErrNotInstalled) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, toolchains.ErrInUse) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.Empty{}, nil
+}
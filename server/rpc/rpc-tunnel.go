@@ -28,6 +28,7 @@ import (
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/recordings"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -109,6 +110,7 @@ func (s *Server) CreateTunnel(ctx context.Context, req *sliverpb.Tunnel) (*slive
 // CloseTunnel - Client requests we close a tunnel
 func (s *Server) CloseTunnel(ctx context.Context, req *sliverpb.Tunnel) (*commonpb.Empty, error) {
 	go core.Tunnels.ScheduleClose(req.TunnelID)
+	recordings.StopShell(req.TunnelID)
 	toImplantCache.DeleteTun(req.TunnelID)
 	fromImplantCache.DeleteTun(req.TunnelID)
 
@@ -162,6 +164,7 @@ func (s *Server) TunnelData(stream rpcpb.SliverRPC_TunnelDataServer) error {
 						fromImplantCache.Add(tunnel.ID, tunnelData.Sequence, tunnelData)
 
 						for recv, ok := fromImplantCache.Get(tunnel.ID, tunnel.FromImplantSequence); ok; recv, ok = fromImplantCache.Get(tunnel.ID, tunnel.FromImplantSequence) {
+							recordings.ShellOutput(tunnel.ID, recv.Data)
 							tunnel.Client.Send(&sliverpb.TunnelData{
 								TunnelID:  tunnel.ID,
 								SessionID: tunnel.SessionID,
@@ -195,6 +198,7 @@ func (s *Server) TunnelData(stream rpcpb.SliverRPC_TunnelDataServer) error {
 					}
 				}
 				tunnelLog.Debugf("Closing tunnel %d (To Client)", tunnel.ID)
+				recordings.StopShell(tunnel.ID)
 				tunnel.Client.Send(&sliverpb.TunnelData{
 					TunnelID:  tunnel.ID,
 					SessionID: tunnel.SessionID,
@@ -241,6 +245,7 @@ func (s *Server) TunnelData(stream rpcpb.SliverRPC_TunnelDataServer) error {
 		} else if tunnel.Client == stream {
 			tunnelLog.Debugf("Tunnel %d: From client %d byte(s) to implant...",
 				fromClient.TunnelID, len(fromClient.Data))
+			recordings.ShellInput(tunnel.ID, fromClient.GetData())
 			tunnel.ToImplant <- fromClient.GetData()
 		}
 	}
@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/client/version"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
@@ -32,9 +33,14 @@ import (
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/hosts"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/bishopfox/sliver/server/recordings"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -77,6 +83,8 @@ type GenericResponse interface {
 // NewServer - Create new server instance
 func NewServer() *Server {
 	core.StartEventAutomation()
+	recordings.StartEventAutomation()
+	hosts.StartEventAutomation()
 	return &Server{}
 }
 
@@ -94,6 +102,10 @@ func (rpc *Server) GetVersion(ctx context.Context, _ *commonpb.Empty) (*clientpb
 		CompiledAt: compiled.Unix(),
 		OS:         runtime.GOOS,
 		Arch:       runtime.GOARCH,
+
+		APIVersion:             version.APIVersion,
+		MinAPIVersion:          version.MinAPIVersion,
+		ImplantProtocolVersion: sliverpb.ProtocolVersion,
 	}, nil
 }
 
@@ -115,6 +127,14 @@ func (rpc *Server) GenericHandler(req GenericRequest, resp GenericResponse) erro
 		return ErrInvalidSessionID
 	}
 
+	// The implant sends the task's output as it's produced, and only the rest with the response
+	if request.StreamID != "" {
+		if !core.TaskStreams.Add(request.StreamID, session) {
+			return ErrInvalidStreamID
+		}
+		defer core.TaskStreams.Finish(request.StreamID)
+	}
+
 	// Overwrite unused implant fields before re-serializing
 	request.SessionID = ""
 	request.BeaconID = ""
@@ -128,6 +148,9 @@ func (rpc *Server) GenericHandler(req GenericRequest, resp GenericResponse) erro
 	if err != nil {
 		return err
 	}
+	if loot.IsOversizedSessionResult(data) {
+		return divertSessionResult(session, sliverpb.MsgNumber(req), data)
+	}
 	err = proto.Unmarshal(data, resp)
 	if err != nil {
 		return err
@@ -135,6 +158,21 @@ func (rpc *Server) GenericHandler(req GenericRequest, resp GenericResponse) erro
 	return rpc.getError(resp)
 }
 
+// divertSessionResult - Save an oversized result as loot instead of sending it to the client
+func divertSessionResult(session *core.Session, reqType uint32, data []byte) error {
+	lootResult, err := loot.GetLootStore().DivertResult(session.Name, session.UUID, reqType, data)
+	if err != nil {
+		rpcLog.Errorf("Failed to save task result as loot: %s", err)
+		return status.Errorf(codes.ResourceExhausted, "result of %d bytes exceeds the %d byte limit", len(data), loot.MaxSessionResultSize)
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(lootResult.ID),
+	})
+	return status.Errorf(codes.ResourceExhausted, "result of %d bytes exceeds the %d byte limit, saved as loot %s",
+		len(data), loot.MaxSessionResultSize, lootResult.ID)
+}
+
 // asyncGenericHandler - Generic handler for async request/response's for beacon tasks
 func (rpc *Server) asyncGenericHandler(req GenericRequest, resp GenericResponse) error {
 	// VERY VERBOSE
@@ -150,9 +188,10 @@ func (rpc *Server) asyncGenericHandler(req GenericRequest, resp GenericResponse)
 		return ErrInvalidBeaconID
 	}
 
-	// Overwrite unused implant fields before re-serializing
+	// Overwrite unused implant fields before re-serializing, beacon output isn't streamed
 	request.SessionID = ""
 	request.BeaconID = ""
+	request.StreamID = ""
 	reqData, err := proto.Marshal(req)
 	if err != nil {
 		return err
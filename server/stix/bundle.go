@@ -0,0 +1,249 @@
+package stix
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/attack"
+	"github.com/gofrs/uuid"
+)
+
+// Export formats
+const (
+	FormatSTIX       = "stix"
+	FormatAttackFlow = "attack-flow"
+
+	specVersion = "2.1"
+	// timestampFormat - STIX timestamps are UTC with millisecond precision
+	timestampFormat = "2006-01-02T15:04:05.000Z"
+)
+
+var (
+	// ErrInvalidFormat - The export format is not supported
+	ErrInvalidFormat = errors.New("invalid format, must be stix or attack-flow")
+
+	// namespace - Object ids are derived from their content so exporting the same
+	// engagement twice results in the same ids, which lets consumers de-duplicate
+	namespace = uuid.Must(uuid.FromString("00abedb4-aa42-466c-9c01-fed23315a9b7"))
+)
+
+// object - A STIX object, maps are marshaled with sorted keys so exports are stable
+type object map[string]interface{}
+
+// Export - Convert an engagement to a STIX bundle or an Attack Flow document
+func Export(engagement *Engagement, format string) ([]byte, error) {
+	var objects []object
+	switch format {
+	case FormatSTIX:
+		objects = bundleObjects(engagement)
+	case FormatAttackFlow:
+		objects = flowObjects(engagement)
+	default:
+		return nil, ErrInvalidFormat
+	}
+	bundle := object{
+		"type":    "bundle",
+		"id":      objectID("bundle", format, engagement.Name, timestamp(engagement.Created)),
+		"objects": objects,
+	}
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+// bundleObjects - The hosts as system identities, implants as malware, and the observed
+// techniques as attack patterns with sightings on each host
+func bundleObjects(engagement *Engagement) []object {
+	author := authorIdentity(engagement)
+	objects := []object{author}
+	patterns := map[string]object{}
+
+	for _, host := range engagement.Hosts {
+		hostIdentity := hostIdentity(engagement, host)
+		objects = append(objects, hostIdentity)
+
+		observed := sightings{}
+		for _, implant := range host.Implants {
+			malware := newObject("malware", engagement.Created, author, host.UUID, implant.Name)
+			malware["name"] = implant.Name
+			malware["is_family"] = false
+			malware["malware_types"] = []string{"remote-access-trojan"}
+			malware["first_seen"] = timestamp(implant.FirstContact)
+			objects = append(objects, malware)
+			objects = append(objects, relationship(engagement, author, "targets", malware, hostIdentity))
+			if technique, ok := attack.TransportTechnique(implant.Transport); ok {
+				observed.add(technique, implant.Name, implant.FirstContact)
+			}
+		}
+		for _, command := range host.Commands {
+			if technique, ok := attack.MethodTechnique(command.Method); ok {
+				observed.add(technique, command.ImplantName, command.Timestamp)
+			}
+		}
+
+		for _, techniqueID := range observed.techniqueIDs() {
+			seen := observed[techniqueID]
+			pattern, ok := patterns[techniqueID]
+			if !ok {
+				pattern = attackPattern(engagement, author, seen.technique)
+				patterns[techniqueID] = pattern
+				objects = append(objects, pattern)
+			}
+			for _, implantName := range seen.implantNames() {
+				malware := object{"id": objectID("malware", host.UUID, implantName)}
+				objects = append(objects, relationship(engagement, author, "uses", malware, pattern))
+			}
+			sightingObj := newObject("sighting", engagement.Created, author, host.UUID, techniqueID)
+			sightingObj["sighting_of_ref"] = pattern["id"]
+			sightingObj["where_sighted_refs"] = []interface{}{hostIdentity["id"]}
+			sightingObj["first_seen"] = timestamp(seen.first)
+			sightingObj["last_seen"] = timestamp(seen.last)
+			sightingObj["count"] = seen.count
+			objects = append(objects, sightingObj)
+		}
+	}
+	return objects
+}
+
+// sighting - How often and when a technique was observed on a host
+type sighting struct {
+	technique attack.Technique
+	first     time.Time
+	last      time.Time
+	count     int
+	implants  map[string]bool
+}
+
+type sightings map[string]*sighting
+
+func (s sightings) add(technique attack.Technique, implantName string, when time.Time) {
+	seen, ok := s[technique.ID]
+	if !ok {
+		seen = &sighting{technique: technique, first: when, last: when, implants: map[string]bool{}}
+		s[technique.ID] = seen
+	}
+	if when.Before(seen.first) {
+		seen.first = when
+	}
+	if seen.last.Before(when) {
+		seen.last = when
+	}
+	seen.count++
+	if implantName != "" {
+		seen.implants[implantName] = true
+	}
+}
+
+func (s *sighting) implantNames() []string {
+	names := []string{}
+	for name := range s.implants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s sightings) techniqueIDs() []string {
+	ids := []string{}
+	for id := range s {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func authorIdentity(engagement *Engagement) object {
+	identity := object{
+		"type":           "identity",
+		"spec_version":   specVersion,
+		"id":             objectID("identity", "author", engagement.Name),
+		"created":        timestamp(engagement.Created),
+		"modified":       timestamp(engagement.Created),
+		"name":           engagement.Name,
+		"identity_class": "organization",
+	}
+	return identity
+}
+
+func hostIdentity(engagement *Engagement, host *Host) object {
+	identity := object{
+		"type":           "identity",
+		"spec_version":   specVersion,
+		"id":             objectID("identity", "host", host.UUID),
+		"created":        timestamp(engagement.Created),
+		"modified":       timestamp(engagement.Created),
+		"name":           host.Hostname,
+		"identity_class": "system",
+	}
+	if host.OS != "" {
+		identity["description"] = host.OS
+	}
+	return identity
+}
+
+func attackPattern(engagement *Engagement, author object, technique attack.Technique) object {
+	pattern := newObject("attack-pattern", engagement.Created, author, technique.ID)
+	pattern["name"] = technique.Name
+	pattern["external_references"] = []object{{
+		"source_name": "mitre-attack",
+		"external_id": technique.ID,
+		"url":         technique.URL(),
+	}}
+	pattern["kill_chain_phases"] = []object{{
+		"kill_chain_name": "mitre-attack",
+		"phase_name":      technique.Tactic,
+	}}
+	return pattern
+}
+
+func relationship(engagement *Engagement, author object, relationshipType string, source object, target object) object {
+	rel := newObject("relationship", engagement.Created, author, relationshipType, source["id"], target["id"])
+	rel["relationship_type"] = relationshipType
+	rel["source_ref"] = source["id"]
+	rel["target_ref"] = target["id"]
+	return rel
+}
+
+// newObject - A STIX domain object, the id is derived from the object type and the key
+func newObject(objectType string, created time.Time, author object, key ...interface{}) object {
+	return object{
+		"type":           objectType,
+		"spec_version":   specVersion,
+		"id":             objectID(objectType, key...),
+		"created":        timestamp(created),
+		"modified":       timestamp(created),
+		"created_by_ref": author["id"],
+	}
+}
+
+func objectID(objectType string, key ...interface{}) string {
+	parts := []string{objectType}
+	for _, part := range key {
+		parts = append(parts, fmt.Sprint(part))
+	}
+	return fmt.Sprintf("%s--%s", objectType, uuid.NewV5(namespace, strings.Join(parts, "|")))
+}
+
+func timestamp(when time.Time) string {
+	return when.UTC().Format(timestampFormat)
+}
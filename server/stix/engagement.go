@@ -0,0 +1,133 @@
+package stix
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/gofrs/uuid"
+)
+
+// Engagement - What the operators did, the input of the exports
+type Engagement struct {
+	Name    string
+	Created time.Time
+	Hosts   []*Host
+}
+
+// Host - A host and the implants and commands run on it
+type Host struct {
+	UUID         string
+	Hostname     string
+	OS           string
+	FirstContact time.Time
+	Implants     []*Implant
+	Commands     []*Command
+}
+
+// Implant - A session or beacon
+type Implant struct {
+	Name         string
+	Transport    string
+	FirstContact time.Time
+}
+
+// Command - A successful command, in chronological order
+type Command struct {
+	Timestamp   time.Time
+	Method      string
+	ImplantName string
+	Operator    string
+}
+
+// Collect - Gather the hosts (all if blank) and their implants and commands, commands
+// before since (if not zero) are left out
+func Collect(name string, hostUUIDs []string, since time.Time) (*Engagement, error) {
+	engagement := &Engagement{Name: name, Created: time.Now().UTC()}
+	if engagement.Name == "" {
+		engagement.Name = "Sliver engagement"
+	}
+	selected := map[string]bool{}
+	for _, hostUUID := range hostUUIDs {
+		selected[hostUUID] = true
+	}
+	dbHosts, err := db.ListHosts()
+	if err != nil {
+		return nil, err
+	}
+	for _, dbHost := range dbHosts {
+		hostUUID := dbHost.HostUUID.String()
+		if 0 < len(selected) && !selected[hostUUID] {
+			continue
+		}
+		host := &Host{
+			UUID:         hostUUID,
+			Hostname:     dbHost.Hostname,
+			OS:           dbHost.OSVersion,
+			FirstContact: dbHost.CreatedAt.UTC(),
+		}
+		for _, session := range core.Sessions.All() {
+			if session.UUID != hostUUID {
+				continue
+			}
+			host.Implants = append(host.Implants, &Implant{
+				Name:         session.Name,
+				Transport:    session.Connection.Transport,
+				FirstContact: time.Unix(session.FirstContact, 0).UTC(),
+			})
+		}
+		beacons, err := db.BeaconsByHostUUID(uuid.FromStringOrNil(hostUUID))
+		if err != nil {
+			return nil, err
+		}
+		for _, beacon := range beacons {
+			host.Implants = append(host.Implants, &Implant{
+				Name:         beacon.Name,
+				Transport:    beacon.Transport,
+				FirstContact: beacon.CreatedAt.UTC(),
+			})
+		}
+		commands, err := db.HostCommands(dbHost.HostUUID, -1)
+		if err != nil {
+			return nil, err
+		}
+		for _, command := range commands {
+			if command.Error != "" || command.CreatedAt.Before(since) {
+				continue
+			}
+			host.Commands = append(host.Commands, &Command{
+				Timestamp:   command.CreatedAt.UTC(),
+				Method:      command.Method,
+				ImplantName: command.ImplantName,
+				Operator:    command.Operator,
+			})
+		}
+		sort.SliceStable(host.Commands, func(i, j int) bool {
+			return host.Commands[i].Timestamp.Before(host.Commands[j].Timestamp)
+		})
+		sort.SliceStable(host.Implants, func(i, j int) bool {
+			return host.Implants[i].FirstContact.Before(host.Implants[j].FirstContact)
+		})
+		engagement.Hosts = append(engagement.Hosts, host)
+	}
+	return engagement, nil
+}
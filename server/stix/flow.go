@@ -0,0 +1,180 @@
+package stix
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/attack"
+)
+
+const (
+	// Attack Flow is a STIX extension by MITRE's Center for Threat-Informed Defense,
+	// see https://center-for-threat-informed-defense.github.io/attack-flow/
+	attackFlowExtensionID = "extension-definition--fb9c968a-745b-4ade-9b25-c324172197f4"
+	attackFlowAuthorID    = "identity--fb9c968a-745b-4ade-9b25-c324172197f4"
+	attackFlowSchema      = "https://center-for-threat-informed-defense.github.io/attack-flow/stix/attack-flow-schema-2.0.0.json"
+	attackFlowVersion     = "2.0.0"
+)
+
+// action - Consecutive uses of the same technique on a host are a single action
+type action struct {
+	technique attack.Technique
+	start     time.Time
+	end       time.Time
+	methods   []string
+	implants  []string
+	operators []string
+}
+
+// flowObjects - A flow per host of the techniques in the order they were used,
+// the flows start at each host's first contact
+func flowObjects(engagement *Engagement) []object {
+	author := authorIdentity(engagement)
+	objects := []object{attackFlowExtension(), author}
+	extensions := object{attackFlowExtensionID: object{"extension_type": "new-sdo"}}
+
+	startRefs := []interface{}{}
+	for _, host := range engagement.Hosts {
+		actions := hostActions(host)
+		if len(actions) == 0 {
+			continue
+		}
+		hostIdentity := hostIdentity(engagement, host)
+		asset := newObject("attack-asset", engagement.Created, author, host.UUID)
+		asset["extensions"] = extensions
+		asset["name"] = host.Hostname
+		asset["object_ref"] = hostIdentity["id"]
+		objects = append(objects, hostIdentity, asset)
+
+		actionObjs := []object{}
+		for index, hostAction := range actions {
+			actionObj := newObject("attack-action", engagement.Created, author, host.UUID, index)
+			actionObj["extensions"] = extensions
+			actionObj["name"] = hostAction.technique.Name
+			actionObj["technique_id"] = hostAction.technique.ID
+			actionObj["description"] = hostAction.description()
+			actionObj["execution_start"] = timestamp(hostAction.start)
+			actionObj["execution_end"] = timestamp(hostAction.end)
+			actionObj["asset_refs"] = []interface{}{asset["id"]}
+			actionObjs = append(actionObjs, actionObj)
+		}
+		for index := 0; index < len(actionObjs)-1; index++ {
+			actionObjs[index]["effect_refs"] = []interface{}{actionObjs[index+1]["id"]}
+		}
+		startRefs = append(startRefs, actionObjs[0]["id"])
+		objects = append(objects, actionObjs...)
+	}
+
+	flow := newObject("attack-flow", engagement.Created, author, engagement.Name)
+	flow["extensions"] = extensions
+	flow["name"] = engagement.Name
+	flow["description"] = fmt.Sprintf("Techniques used on %d host(s), exported from Sliver", len(startRefs))
+	flow["scope"] = "incident"
+	flow["start_refs"] = startRefs
+	return append(objects, flow)
+}
+
+// hostActions - The host's C2 channels followed by its commands, consecutive uses
+// of the same technique are merged
+func hostActions(host *Host) []*action {
+	type use struct {
+		technique attack.Technique
+		when      time.Time
+		method    string
+		implant   string
+		operator  string
+	}
+	uses := []use{}
+	for _, implant := range host.Implants {
+		if technique, ok := attack.TransportTechnique(implant.Transport); ok {
+			uses = append(uses, use{technique, implant.FirstContact, implant.Transport, implant.Name, ""})
+		}
+	}
+	for _, command := range host.Commands {
+		if technique, ok := attack.MethodTechnique(command.Method); ok {
+			uses = append(uses, use{technique, command.Timestamp, command.Method, command.ImplantName, command.Operator})
+		}
+	}
+	sort.SliceStable(uses, func(i, j int) bool {
+		return uses[i].when.Before(uses[j].when)
+	})
+
+	actions := []*action{}
+	for _, u := range uses {
+		var last *action
+		if 0 < len(actions) {
+			last = actions[len(actions)-1]
+		}
+		if last == nil || last.technique.ID != u.technique.ID {
+			last = &action{technique: u.technique, start: u.when}
+			actions = append(actions, last)
+		}
+		last.end = u.when
+		last.methods = appendUnique(last.methods, u.method)
+		last.implants = appendUnique(last.implants, u.implant)
+		last.operators = appendUnique(last.operators, u.operator)
+	}
+	return actions
+}
+
+func (a *action) description() string {
+	description := fmt.Sprintf("%s via %s", strings.Join(a.methods, ", "), strings.Join(a.implants, ", "))
+	if 0 < len(a.operators) {
+		description += fmt.Sprintf(" by %s", strings.Join(a.operators, ", "))
+	}
+	return description
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// attackFlowExtension - The extension definition consumers use to validate the flow
+func attackFlowExtension() object {
+	return object{
+		"type":            "extension-definition",
+		"spec_version":    specVersion,
+		"id":              attackFlowExtensionID,
+		"created_by_ref":  attackFlowAuthorID,
+		"created":         "2022-08-02T19:34:35.143Z",
+		"modified":        "2022-08-02T19:34:35.143Z",
+		"name":            "Attack Flow",
+		"description":     "Extends STIX 2.1 with features to create Attack Flows.",
+		"schema":          attackFlowSchema,
+		"version":         attackFlowVersion,
+		"extension_types": []string{"new-sdo"},
+		"external_references": []object{{
+			"source_name": "Documentation",
+			"description": "Documentation for Attack Flow",
+			"url":         "https://center-for-threat-informed-defense.github.io/attack-flow",
+		}},
+	}
+}
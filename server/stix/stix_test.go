@@ -0,0 +1,140 @@
+package stix
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func testEngagement() *Engagement {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	return &Engagement{
+		Name:    "Purple Team",
+		Created: start.Add(24 * time.Hour),
+		Hosts: []*Host{{
+			UUID:         "0b1d7c5e-2c3f-4a4e-9e4a-0c5e7d6f8a91",
+			Hostname:     "WS01",
+			OS:           "Windows 10",
+			FirstContact: start,
+			Implants:     []*Implant{{Name: "WISE_OTTER", Transport: "mtls", FirstContact: start}},
+			Commands: []*Command{
+				{Timestamp: start.Add(time.Minute), Method: "Ps", ImplantName: "WISE_OTTER", Operator: "alice"},
+				{Timestamp: start.Add(2 * time.Minute), Method: "Ping", ImplantName: "WISE_OTTER", Operator: "alice"},
+				{Timestamp: start.Add(3 * time.Minute), Method: "Ps", ImplantName: "WISE_OTTER", Operator: "bob"},
+				{Timestamp: start.Add(4 * time.Minute), Method: "ProcessDump", ImplantName: "WISE_OTTER", Operator: "bob"},
+			},
+		}},
+	}
+}
+
+type testBundle struct {
+	Type    string                   `json:"type"`
+	Objects []map[string]interface{} `json:"objects"`
+}
+
+func objectsOfType(t *testing.T, data []byte, objectType string) []map[string]interface{} {
+	bundle := &testBundle{}
+	err := json.Unmarshal(data, bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Type != "bundle" {
+		t.Fatalf("expected a bundle, got %s", bundle.Type)
+	}
+	objects := []map[string]interface{}{}
+	for _, obj := range bundle.Objects {
+		if obj["type"] == objectType {
+			objects = append(objects, obj)
+		}
+	}
+	return objects
+}
+
+func TestExportSTIX(t *testing.T) {
+	data, err := Export(testEngagement(), FormatSTIX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	patterns := objectsOfType(t, data, "attack-pattern")
+	if len(patterns) != 3 {
+		t.Fatalf("expected 3 attack patterns (mtls, ps, procdump), got %d", len(patterns))
+	}
+	sightings := objectsOfType(t, data, "sighting")
+	counts := map[string]float64{}
+	for _, sighting := range sightings {
+		for _, pattern := range patterns {
+			if pattern["id"] == sighting["sighting_of_ref"] {
+				counts[pattern["name"].(string)] = sighting["count"].(float64)
+			}
+		}
+	}
+	if counts["Process Discovery"] != 2 {
+		t.Errorf("expected process discovery to be sighted twice, got %v", counts)
+	}
+	if len(objectsOfType(t, data, "malware")) != 1 {
+		t.Error("expected one malware object")
+	}
+
+	// Ids are derived from the content
+	again, _ := Export(testEngagement(), FormatSTIX)
+	if !bytes.Equal(data, again) {
+		t.Error("expected the same export twice")
+	}
+}
+
+func TestExportAttackFlow(t *testing.T) {
+	data, err := Export(testEngagement(), FormatAttackFlow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flows := objectsOfType(t, data, "attack-flow")
+	if len(flows) != 1 {
+		t.Fatalf("expected one flow, got %d", len(flows))
+	}
+	actions := objectsOfType(t, data, "attack-action")
+	// mtls, ps, ps (merged), procdump
+	if len(actions) != 3 {
+		t.Fatalf("expected 3 actions, got %d", len(actions))
+	}
+	if actions[1]["technique_id"] != "T1057" || actions[1]["description"] != "Ps via WISE_OTTER by alice, bob" {
+		t.Errorf("unexpected action %v", actions[1])
+	}
+	if actions[0]["effect_refs"].([]interface{})[0] != actions[1]["id"] {
+		t.Error("expected actions to be chained")
+	}
+	if _, ok := actions[2]["effect_refs"]; ok {
+		t.Error("expected the last action to have no effects")
+	}
+	if flows[0]["start_refs"].([]interface{})[0] != actions[0]["id"] {
+		t.Error("expected the flow to start with the first action")
+	}
+	if len(objectsOfType(t, data, "extension-definition")) != 1 {
+		t.Error("expected the attack flow extension definition")
+	}
+}
+
+func TestExportInvalidFormat(t *testing.T) {
+	_, err := Export(testEngagement(), "pdf")
+	if err != ErrInvalidFormat {
+		t.Errorf("expected %s, got %v", ErrInvalidFormat, err)
+	}
+}
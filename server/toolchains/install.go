@@ -0,0 +1,216 @@
+package toolchains
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	indexTimeout    = time.Minute
+	downloadTimeout = 30 * time.Minute
+
+	maxIndexSize   = 8 * 1024 * 1024
+	maxArchiveSize = 512 * 1024 * 1024
+)
+
+// zigRelease - A host's download in zig's release index
+type zigRelease struct {
+	Tarball string `json:"tarball"`
+	SHA256  string `json:"shasum"`
+	Size    string `json:"size"`
+}
+
+// Install - Download, verify and install a zig release, a blank version is the
+// version in the server config. Installing an installed version is a no-op.
+func Install(version string) (*clientpb.Toolchain, error) {
+	if version == "" {
+		version = configuredZigVersion()
+	}
+	if !validVersion.MatchString(version) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+	host, ok := zigHosts[hostPlatform()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedHost, hostPlatform())
+	}
+	if _, err := exec.LookPath("tar"); err != nil {
+		return nil, fmt.Errorf("%w: tar not found", ErrUnsupportedHost)
+	}
+
+	installMutex.Lock()
+	defer installMutex.Unlock()
+	dir := zigDir(version)
+	if _, err := os.Stat(dir); err == nil {
+		return zigToolchain(version, version == activeZigVersion()), nil
+	}
+
+	release, err := fetchZigRelease(zigIndexURL(), version, host)
+	if err != nil {
+		return nil, err
+	}
+	toolchainsLog.Infof("Downloading zig %s from %s", version, release.Tarball)
+	archive, err := download(release)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(archive)
+
+	// Extract next to the final location, so a failed extraction never looks installed
+	err = os.MkdirAll(filepath.Dir(dir), 0700)
+	if err != nil {
+		return nil, err
+	}
+	tmpDir, err := os.MkdirTemp(filepath.Dir(dir), "."+version+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	err = extract(archive, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "zig")); err != nil {
+		return nil, fmt.Errorf("zig %s archive has no zig executable", version)
+	}
+	targets := []string{}
+	for _, target := range zigTargets {
+		targets = append(targets, target)
+	}
+	err = writeWrappers(dir, tmpDir, targets...)
+	if err != nil {
+		return nil, err
+	}
+	err = os.Rename(tmpDir, dir)
+	if err != nil {
+		return nil, err
+	}
+	toolchainsLog.Infof("Installed zig %s to %s", version, dir)
+	return zigToolchain(version, version == activeZigVersion()), nil
+}
+
+// fetchZigRelease - Find a host's download of a version in zig's release index
+func fetchZigRelease(indexURL string, version string, host string) (*zigRelease, error) {
+	client := &http.Client{
+		Timeout:   indexTimeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+	}
+	resp, err := client.Get(indexURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch zig release index: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxIndexSize))
+	if err != nil {
+		return nil, err
+	}
+	return parseZigIndex(data, version, host)
+}
+
+// parseZigIndex - Index entries are keyed by version, then by host platform,
+// alongside non-platform keys like "date" and "notes"
+func parseZigIndex(data []byte, version string, host string) (*zigRelease, error) {
+	index := map[string]map[string]json.RawMessage{}
+	err := json.Unmarshal(data, &index)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zig release index: %w", err)
+	}
+	releases, ok := index[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: zig %s is not in the release index", ErrInvalidVersion, version)
+	}
+	rawRelease, ok := releases[host]
+	if !ok {
+		return nil, fmt.Errorf("%w: zig %s is not released for %s", ErrUnsupportedHost, version, host)
+	}
+	release := &zigRelease{}
+	err = json.Unmarshal(rawRelease, release)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zig release index: %w", err)
+	}
+	if release.Tarball == "" || len(release.SHA256) != sha256.Size*2 {
+		return nil, fmt.Errorf("invalid zig release index: zig %s %s has no tarball or sha256", version, host)
+	}
+	return release, nil
+}
+
+// download - Download a release to a temp file, and verify its size and sha256
+func download(release *zigRelease) (string, error) {
+	client := &http.Client{
+		Timeout:   downloadTimeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+	}
+	resp, err := client.Get(release.Tarball)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download %s: %s", release.Tarball, resp.Status)
+	}
+
+	archive, err := os.CreateTemp(GetToolchainsDir(), ".download-*")
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+	digest := sha256.New()
+	size, err := io.Copy(io.MultiWriter(archive, digest), io.LimitReader(resp.Body, maxArchiveSize+1))
+	if err == nil && maxArchiveSize < size {
+		err = fmt.Errorf("%s is larger than %d bytes", release.Tarball, maxArchiveSize)
+	}
+	if err == nil && release.Size != "" && release.Size != strconv.FormatInt(size, 10) {
+		err = fmt.Errorf("%s is %d bytes, expected %s", release.Tarball, size, release.Size)
+	}
+	if err == nil && hex.EncodeToString(digest.Sum(nil)) != strings.ToLower(release.SHA256) {
+		err = fmt.Errorf("%s does not match the sha256 of the release index", release.Tarball)
+	}
+	if err != nil {
+		os.Remove(archive.Name())
+		return "", err
+	}
+	return archive.Name(), nil
+}
+
+// extract - zig is only released as .tar.xz for unix hosts, which the standard
+// library can't decompress, so this relies on the system's tar
+func extract(archive string, dest string) error {
+	cmd := exec.Command("tar", "-xJf", archive, "-C", dest, "--strip-components=1")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to extract %s: %s (%s)", archive, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
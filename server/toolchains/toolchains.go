@@ -0,0 +1,345 @@
+package toolchains
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	// DirName - Toolchains are installed in this directory of the app dir
+	DirName = "toolchains"
+
+	// Zig - zig cc bundles the mingw, musl and macos libcs, so a single download
+	// can cross-compile every cgo target the server supports
+	Zig = "zig"
+
+	// DefaultZigVersion - The zig release installed unless the server config pins another
+	DefaultZigVersion = "0.13.0"
+	// DefaultZigIndexURL - zig's release index, with the download url and sha256 of every release
+	DefaultZigIndexURL = "https://ziglang.org/download/index.json"
+
+	wrappersDirName = "sliver"
+	cacheDirName    = "cache"
+)
+
+var (
+	toolchainsLog = log.NamedLogger("toolchains", "zig")
+
+	// ErrUnsupportedHost - zig isn't released for the server's platform, or it has no tar
+	ErrUnsupportedHost = errors.New("toolchains cannot be installed on this server platform")
+	// ErrNotInstalled - No such toolchain is installed
+	ErrNotInstalled = errors.New("toolchain not installed")
+	// ErrInvalidVersion - The version isn't in the release index
+	ErrInvalidVersion = errors.New("invalid toolchain version")
+	// ErrUnsupportedTarget - zig can't build the target, or link it against the glibc version
+	ErrUnsupportedTarget = errors.New("unsupported toolchain target")
+	// ErrInUse - A build is using the toolchain
+	ErrInUse = errors.New("toolchain in use")
+
+	installMutex = &sync.Mutex{}
+	// zigInUse - Builds using each zig version, guarded by installMutex
+	zigInUse = map[string]int{}
+
+	validVersion      = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+([+-][a-zA-Z0-9.+-]+)?$`)
+	validGlibcVersion = regexp.MustCompile(`^2\.[0-9]{1,2}$`)
+
+	// zigTargets - The zig cc targets of cgo (shared library) targets, linux targets
+	// are statically linked against musl unless a glibc version is given
+	zigTargets = map[string]string{
+		"windows/amd64": "x86_64-windows-gnu",
+		"windows/386":   "x86-windows-gnu",
+		"windows/arm64": "aarch64-windows-gnu",
+		"linux/amd64":   "x86_64-linux-musl",
+		"linux/386":     "x86-linux-musl",
+		"linux/arm64":   "aarch64-linux-musl",
+		"darwin/amd64":  "x86_64-macos",
+		"darwin/arm64":  "aarch64-macos",
+	}
+
+	// zigHosts - The platform names of zig's release index
+	zigHosts = map[string]string{
+		"linux/amd64":  "x86_64-linux",
+		"linux/arm64":  "aarch64-linux",
+		"darwin/amd64": "x86_64-macos",
+		"darwin/arm64": "aarch64-macos",
+	}
+)
+
+// GetToolchainsDir - The directory toolchains are installed in
+func GetToolchainsDir() string {
+	dir := filepath.Join(assets.GetRootAppDir(), DirName)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		err = os.MkdirAll(dir, 0700)
+		if err != nil {
+			toolchainsLog.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func zigDir(version string) string {
+	return filepath.Join(GetToolchainsDir(), Zig, version)
+}
+
+// AutoInstall - Install zig when a build needs a cross-compiler the server doesn't
+// have, if zig is released for the server's platform
+func AutoInstall() bool {
+	if _, ok := zigHosts[hostPlatform()]; !ok {
+		return false
+	}
+	config := configs.GetServerConfig().Toolchains
+	return config == nil || !config.DisableAutoInstall
+}
+
+func configuredZigVersion() string {
+	config := configs.GetServerConfig().Toolchains
+	if config == nil || config.ZigVersion == "" {
+		return DefaultZigVersion
+	}
+	return config.ZigVersion
+}
+
+func zigIndexURL() string {
+	config := configs.GetServerConfig().Toolchains
+	if config == nil || config.IndexURL == "" {
+		return DefaultZigIndexURL
+	}
+	return config.IndexURL
+}
+
+// installedZigVersions - Versions are only moved into the toolchains dir once they're
+// completely extracted, so every version directory is a usable install
+func installedZigVersions() []string {
+	entries, err := os.ReadDir(filepath.Join(GetToolchainsDir(), Zig))
+	if err != nil {
+		return []string{}
+	}
+	versions := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && validVersion.MatchString(entry.Name()) {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare("v"+versions[i], "v"+versions[j]) < 0
+	})
+	return versions
+}
+
+// activeZigVersion - The configured version if it's installed, otherwise the
+// latest installed version
+func activeZigVersion() string {
+	versions := installedZigVersions()
+	if len(versions) == 0 {
+		return ""
+	}
+	configured := configuredZigVersion()
+	for _, version := range versions {
+		if version == configured {
+			return version
+		}
+	}
+	return versions[len(versions)-1]
+}
+
+// Installed - List the installed toolchains
+func Installed() []*clientpb.Toolchain {
+	active := activeZigVersion()
+	toolchains := []*clientpb.Toolchain{}
+	for _, version := range installedZigVersions() {
+		toolchains = append(toolchains, zigToolchain(version, version == active))
+	}
+	return toolchains
+}
+
+func zigToolchain(version string, active bool) *clientpb.Toolchain {
+	toolchain := &clientpb.Toolchain{
+		Name:    Zig,
+		Version: version,
+		Path:    zigDir(version),
+		Active:  active,
+	}
+	for target := range zigTargets {
+		platform := strings.SplitN(target, "/", 2)
+		toolchain.Targets = append(toolchain.Targets, &clientpb.CompilerTarget{
+			GOOS:   platform[0],
+			GOARCH: platform[1],
+			Format: clientpb.OutputFormat_SHARED_LIB,
+		})
+	}
+	sort.Slice(toolchain.Targets, func(i, j int) bool {
+		return toolchain.Targets[i].GOOS+toolchain.Targets[i].GOARCH < toolchain.Targets[j].GOOS+toolchain.Targets[j].GOARCH
+	})
+	return toolchain
+}
+
+// CrossCompiler - The cc and c++ of the active zig install for a target, blank
+// if zig isn't installed or can't target the platform
+func CrossCompiler(targetGoos string, targetGoarch string) (string, string) {
+	cc, cxx, err := ZigCrossCompiler(targetGoos, targetGoarch, "")
+	if err != nil {
+		toolchainsLog.Debugf("No zig cross-compiler for %s/%s: %s", targetGoos, targetGoarch, err)
+		return "", ""
+	}
+	return cc, cxx
+}
+
+// ZigCrossCompiler - The cc and c++ of the active zig install for a target, linux
+// targets are linked against glibcVersion (e.g. 2.17) if it's not blank
+func ZigCrossCompiler(targetGoos string, targetGoarch string, glibcVersion string) (string, string, error) {
+	target, err := zigTarget(fmt.Sprintf("%s/%s", targetGoos, targetGoarch), glibcVersion)
+	if err != nil {
+		return "", "", err
+	}
+	version := activeZigVersion()
+	if version == "" {
+		return "", "", fmt.Errorf("%w: %s", ErrNotInstalled, Zig)
+	}
+	dir := zigDir(version)
+	wrappersDir := filepath.Join(dir, wrappersDirName)
+	cc := filepath.Join(wrappersDir, wrapperName(target, "cc"))
+	if _, err := os.Stat(cc); os.IsNotExist(err) {
+		installMutex.Lock()
+		err = writeWrappers(dir, dir, target)
+		installMutex.Unlock()
+		if err != nil {
+			return "", "", err
+		}
+	}
+	return cc, filepath.Join(wrappersDir, wrapperName(target, "c++")), nil
+}
+
+// IsZigCrossCompiler - The cc is a wrapper of an installed zig
+func IsZigCrossCompiler(cc string) bool {
+	return strings.HasPrefix(cc, filepath.Join(GetToolchainsDir(), Zig)+string(filepath.Separator))
+}
+
+// Acquire - Keep the zig install of a cc from being removed until the returned
+// func is called, a no-op for other compilers
+func Acquire(cc string) (func(), error) {
+	if !IsZigCrossCompiler(cc) {
+		return func() {}, nil
+	}
+	relPath, err := filepath.Rel(filepath.Join(GetToolchainsDir(), Zig), cc)
+	if err != nil {
+		return nil, err
+	}
+	version := strings.SplitN(filepath.ToSlash(relPath), "/", 2)[0]
+
+	installMutex.Lock()
+	defer installMutex.Unlock()
+	if _, err := os.Stat(cc); err != nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotInstalled, Zig, version)
+	}
+	zigInUse[version]++
+	once := &sync.Once{}
+	return func() {
+		once.Do(func() {
+			installMutex.Lock()
+			defer installMutex.Unlock()
+			zigInUse[version]--
+			if zigInUse[version] <= 0 {
+				delete(zigInUse, version)
+			}
+		})
+	}, nil
+}
+
+func zigTarget(target string, glibcVersion string) (string, error) {
+	zigTarget, ok := zigTargets[target]
+	if !ok {
+		return "", fmt.Errorf("%w: zig cannot build %s", ErrUnsupportedTarget, target)
+	}
+	if glibcVersion == "" {
+		return zigTarget, nil
+	}
+	if !strings.HasSuffix(zigTarget, "-linux-musl") || !validGlibcVersion.MatchString(glibcVersion) {
+		return "", fmt.Errorf("%w: cannot link %s against glibc %s", ErrUnsupportedTarget, target, glibcVersion)
+	}
+	return strings.TrimSuffix(zigTarget, "musl") + "gnu." + glibcVersion, nil
+}
+
+func wrapperName(zigTarget string, tool string) string {
+	return zigTarget + "-" + tool
+}
+
+// writeWrappers - The go toolchain runs CC as a single executable, so each target
+// gets a script that runs zig with its target. The zig cache is set explicitly
+// because builds run without a HOME. The scripts point to the final install dir,
+// while they're written to the dir zig was extracted to.
+func writeWrappers(dir string, extractedDir string, targets ...string) error {
+	zigBin := filepath.Join(dir, "zig")
+	cacheDir := filepath.Join(GetToolchainsDir(), Zig, cacheDirName)
+	if strings.Contains(zigBin+cacheDir, "'") {
+		return fmt.Errorf("unsupported toolchain path: %s", dir)
+	}
+	wrappersDir := filepath.Join(extractedDir, wrappersDirName)
+	err := os.MkdirAll(wrappersDir, 0700)
+	if err != nil {
+		return err
+	}
+	for _, zigTarget := range targets {
+		for _, tool := range []string{"cc", "c++"} {
+			script := fmt.Sprintf("#!/bin/sh\nZIG_GLOBAL_CACHE_DIR='%s' ZIG_LOCAL_CACHE_DIR='%s' exec '%s' %s -target %s \"$@\"\n",
+				cacheDir, cacheDir, zigBin, tool, zigTarget)
+			err = os.WriteFile(filepath.Join(wrappersDir, wrapperName(zigTarget, tool)), []byte(script), 0700)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Remove - Delete an installed zig version, unless a build is using it
+func Remove(version string) error {
+	if !validVersion.MatchString(version) {
+		return fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+	installMutex.Lock()
+	defer installMutex.Unlock()
+	dir := zigDir(version)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return fmt.Errorf("%w: %s %s", ErrNotInstalled, Zig, version)
+	}
+	if 0 < zigInUse[version] {
+		return fmt.Errorf("%w: %d build(s) are using %s %s", ErrInUse, zigInUse[version], Zig, version)
+	}
+	toolchainsLog.Infof("Removing zig %s", version)
+	return os.RemoveAll(dir)
+}
+
+func hostPlatform() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
@@ -0,0 +1,175 @@
+package toolchains
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testIndex = `{
+	"master": {"version": "0.14.0-dev.1", "date": "2024-06-07"},
+	"0.13.0": {
+		"date": "2024-06-07",
+		"notes": "https://ziglang.org/download/0.13.0/release-notes.html",
+		"x86_64-linux": {
+			"tarball": "https://ziglang.org/download/0.13.0/zig-linux-x86_64-0.13.0.tar.xz",
+			"shasum": "d45312e61ebcc48032b77bc4cf7fd6915c11fa16e4aad116b66c9468211230ea",
+			"size": "47082308"
+		},
+		"aarch64-macos": {"tarball": "https://ziglang.org/download/0.13.0/zig-macos-aarch64-0.13.0.tar.xz"}
+	}
+}`
+
+func TestParseZigIndex(t *testing.T) {
+	release, err := parseZigIndex([]byte(testIndex), "0.13.0", "x86_64-linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if release.Size != "47082308" || !strings.HasSuffix(release.Tarball, "zig-linux-x86_64-0.13.0.tar.xz") {
+		t.Fatalf("Unexpected release %+v", release)
+	}
+	if _, err := parseZigIndex([]byte(testIndex), "0.12.0", "x86_64-linux"); !errors.Is(err, ErrInvalidVersion) {
+		t.Fatalf("Expected a missing version to be invalid, got %v", err)
+	}
+	if _, err := parseZigIndex([]byte(testIndex), "0.13.0", "x86_64-windows"); !errors.Is(err, ErrUnsupportedHost) {
+		t.Fatalf("Expected a missing host to be unsupported, got %v", err)
+	}
+	if _, err := parseZigIndex([]byte(testIndex), "0.13.0", "aarch64-macos"); err == nil {
+		t.Fatal("Expected a release without a sha256 to be rejected")
+	}
+}
+
+func TestDownload(t *testing.T) {
+	t.Setenv("SLIVER_ROOT_DIR", t.TempDir())
+	archive := []byte("not really a tar.xz")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive)
+	}))
+	defer server.Close()
+
+	digest := sha256.Sum256(archive)
+	fPath, err := download(&zigRelease{Tarball: server.URL, SHA256: hex.EncodeToString(digest[:])})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fPath)
+	data, _ := os.ReadFile(fPath)
+	if string(data) != string(archive) {
+		t.Fatal("Downloaded archive does not match")
+	}
+
+	digest[0]++
+	_, err = download(&zigRelease{Tarball: server.URL, SHA256: hex.EncodeToString(digest[:])})
+	if err == nil {
+		t.Fatal("Expected a sha256 mismatch")
+	}
+	entries, _ := os.ReadDir(GetToolchainsDir())
+	if len(entries) != 1 {
+		t.Fatalf("Expected the mismatched download to be removed, found %d files", len(entries))
+	}
+}
+
+func TestCrossCompiler(t *testing.T) {
+	t.Setenv("SLIVER_ROOT_DIR", t.TempDir())
+	if cc, _ := CrossCompiler("windows", "amd64"); cc != "" {
+		t.Fatalf("Expected no cross-compiler before zig is installed, got %s", cc)
+	}
+
+	dir := zigDir(DefaultZigVersion)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc, cxx := CrossCompiler("windows", "amd64")
+	if filepath.Dir(cc) != filepath.Join(dir, wrappersDirName) || filepath.Base(cxx) != "x86_64-windows-gnu-c++" {
+		t.Fatalf("Unexpected cross-compilers %s %s", cc, cxx)
+	}
+	script, err := os.ReadFile(cc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(script), filepath.Join(dir, "zig")+"' cc -target x86_64-windows-gnu") {
+		t.Fatalf("Unexpected wrapper script %s", script)
+	}
+	if !IsZigCrossCompiler(cc) {
+		t.Fatalf("Expected %s to be a zig cross-compiler", cc)
+	}
+	if cc, _ := CrossCompiler("linux", "riscv64"); cc != "" {
+		t.Fatalf("Expected no cross-compiler for an unsupported target, got %s", cc)
+	}
+
+	cc, _, err = ZigCrossCompiler("linux", "amd64", "2.17")
+	if err != nil || filepath.Base(cc) != "x86_64-linux-gnu.2.17-cc" {
+		t.Fatalf("Unexpected glibc cross-compiler %s (%v)", cc, err)
+	}
+	for _, glibc := range []string{"2", "3.1", "2.17; id"} {
+		if _, _, err := ZigCrossCompiler("linux", "amd64", glibc); !errors.Is(err, ErrUnsupportedTarget) {
+			t.Fatalf("Expected glibc %q to be unsupported, got %v", glibc, err)
+		}
+	}
+	if _, _, err := ZigCrossCompiler("windows", "amd64", "2.17"); !errors.Is(err, ErrUnsupportedTarget) {
+		t.Fatalf("Expected a glibc windows target to be unsupported, got %v", err)
+	}
+
+	installed := Installed()
+	if len(installed) != 1 || !installed[0].Active || installed[0].Version != DefaultZigVersion {
+		t.Fatalf("Unexpected installed toolchains %v", installed)
+	}
+	release, err := Acquire(cc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Remove(DefaultZigVersion); !errors.Is(err, ErrInUse) {
+		t.Fatalf("Expected a toolchain used by a build to be in use, got %v", err)
+	}
+	release()
+	release()
+	err = Remove(DefaultZigVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Remove(DefaultZigVersion); !errors.Is(err, ErrNotInstalled) {
+		t.Fatalf("Expected a removed toolchain to not be installed, got %v", err)
+	}
+	if err := Remove("../configs"); !errors.Is(err, ErrInvalidVersion) {
+		t.Fatalf("Expected a path to be an invalid version, got %v", err)
+	}
+}
+
+func TestInstalledZigVersions(t *testing.T) {
+	t.Setenv("SLIVER_ROOT_DIR", t.TempDir())
+	for _, version := range []string{"0.9.1", "0.13.0", "0.10.0", "0.14.0-dev.1+abc", "0.14.0"} {
+		err := os.MkdirAll(zigDir(version), 0700)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []string{"0.9.1", "0.10.0", "0.13.0", "0.14.0-dev.1+abc", "0.14.0"}
+	if versions := installedZigVersions(); strings.Join(versions, " ") != strings.Join(expected, " ") {
+		t.Fatalf("Expected versions %v, got %v", expected, versions)
+	}
+}
@@ -0,0 +1,110 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/server/automation"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/rpc"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	grpc_tags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// inProcessAddr - The peer address of in process rpcs, the name of the operator
+type inProcessAddr string
+
+func (a inProcessAddr) Network() string { return "inprocess" }
+func (a inProcessAddr) String() string  { return string(a) }
+
+// inProcessConn - Calls the rpc server's handlers directly, through the same unary
+// interceptors as an operator's rpcs. Streams are not supported.
+type inProcessConn struct {
+	server      rpcpb.SliverRPCServer
+	operator    string
+	methods     map[string]grpc.MethodDesc
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// InProcessClient - An rpc client for server components that task implants on their
+// own (automation rules, the mythic bridge), rpcs are audit logged and checked against
+// the opsec policies and target locks as the given operator
+func InProcessClient(server rpcpb.SliverRPCServer, operator string) rpcpb.SliverRPCClient {
+	logrusEntry := log.NamedLogger("transport", "grpc")
+	conn := &inProcessConn{
+		server:   server,
+		operator: operator,
+		methods:  map[string]grpc.MethodDesc{},
+		interceptor: grpc_middleware.ChainUnaryServer(
+			standbyUnaryServerInterceptor(),
+			auditLogUnaryServerInterceptor(),
+			grpc_tags.UnaryServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
+			grpc_logrus.UnaryServerInterceptor(logrusEntry, grpc_logrus.WithLevels(codeToLevel)),
+			grpc_logrus.PayloadUnaryServerInterceptor(logrusEntry, deciderUnary),
+		),
+	}
+	for _, method := range rpcpb.SliverRPC_ServiceDesc.Methods {
+		conn.methods["/"+rpcpb.SliverRPC_ServiceDesc.ServiceName+"/"+method.MethodName] = method
+	}
+	return rpcpb.NewSliverRPCClient(conn)
+}
+
+// newRPCServer - The rpc server of a listener, automation rules and schedules task
+// implants through an in process client of it
+func newRPCServer() *rpc.Server {
+	server := rpc.NewServer()
+	automation.Start(InProcessClient(server, automation.Operator))
+	return server
+}
+
+// Invoke - Call a unary rpc
+func (c *inProcessConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, _ ...grpc.CallOption) error {
+	desc, ok := c.methods[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: inProcessAddr(c.operator)})
+	ctx = context.WithValue(ctx, Transport, "local")
+	ctx = context.WithValue(ctx, Operator, c.operator)
+	dec := func(req interface{}) error {
+		proto.Merge(req.(proto.Message), args.(proto.Message))
+		return nil
+	}
+	resp, err := desc.Handler(c.server, ctx, dec, c.interceptor)
+	if err != nil {
+		return err
+	}
+	if msg, ok := resp.(proto.Message); ok && msg.ProtoReflect().IsValid() {
+		proto.Merge(reply.(proto.Message), msg)
+	}
+	return nil
+}
+
+// NewStream - Streaming rpcs are only available to operators
+func (c *inProcessConn) NewStream(_ context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "%s is not available in process", method)
+}
@@ -23,7 +23,6 @@ import (
 
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/bishopfox/sliver/server/log"
-	"github.com/bishopfox/sliver/server/rpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
 )
@@ -46,7 +45,7 @@ func LocalListener() (*grpc.Server, *bufconn.Listener, error) {
 	}
 	options = append(options, initMiddleware(false)...)
 	grpcServer := grpc.NewServer(options...)
-	rpcpb.RegisterSliverRPCServer(grpcServer, rpc.NewServer())
+	rpcpb.RegisterSliverRPCServer(grpcServer, newRPCServer())
 	go func() {
 		panicked := true
 		defer func() {
@@ -24,12 +24,17 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"sync"
+	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/exporter"
+	"github.com/bishopfox/sliver/server/hosts"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/metrics"
+	"github.com/bishopfox/sliver/server/recordings"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	grpc_tags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
@@ -39,11 +44,13 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
-	serverConfig  = configs.GetServerConfig()
-	middlewareLog = log.NamedLogger("transport", "middleware")
+	serverConfig      = configs.GetServerConfig()
+	serverConfigMutex = &sync.RWMutex{}
+	middlewareLog     = log.NamedLogger("transport", "middleware")
 )
 
 type contextKey int
@@ -51,6 +58,7 @@ type contextKey int
 const (
 	Transport contextKey = iota
 	Operator
+	Standby
 )
 
 // initMiddleware - Initialize middleware logger
@@ -64,6 +72,8 @@ func initMiddleware(remoteAuth bool) []grpc.ServerOption {
 		return []grpc.ServerOption{
 			grpc.ChainUnaryInterceptor(
 				grpc_auth.UnaryServerInterceptor(tokenAuthFunc),
+				standbyUnaryServerInterceptor(),
+				apiVersionUnaryServerInterceptor(),
 				auditLogUnaryServerInterceptor(),
 				grpc_tags.UnaryServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
 				grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
@@ -71,6 +81,8 @@ func initMiddleware(remoteAuth bool) []grpc.ServerOption {
 			),
 			grpc.ChainStreamInterceptor(
 				grpc_auth.StreamServerInterceptor(tokenAuthFunc),
+				standbyStreamServerInterceptor(),
+				apiVersionStreamServerInterceptor(),
 				grpc_tags.StreamServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
 				grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
 				grpc_logrus.PayloadStreamServerInterceptor(logrusEntry, deciderStream),
@@ -80,6 +92,7 @@ func initMiddleware(remoteAuth bool) []grpc.ServerOption {
 		return []grpc.ServerOption{
 			grpc.ChainUnaryInterceptor(
 				grpc_auth.UnaryServerInterceptor(serverAuthFunc),
+				standbyUnaryServerInterceptor(),
 				auditLogUnaryServerInterceptor(),
 				grpc_tags.UnaryServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
 				grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
@@ -87,6 +100,7 @@ func initMiddleware(remoteAuth bool) []grpc.ServerOption {
 			),
 			grpc.ChainStreamInterceptor(
 				grpc_auth.StreamServerInterceptor(serverAuthFunc),
+				standbyStreamServerInterceptor(),
 				grpc_tags.StreamServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
 				grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
 				grpc_logrus.PayloadStreamServerInterceptor(logrusEntry, deciderStream),
@@ -100,6 +114,12 @@ var (
 	tokenCache = sync.Map{}
 )
 
+// cachedOperator - What the auth cache remembers about an operator's token
+type cachedOperator struct {
+	name    string
+	standby bool
+}
+
 // ClearTokenCache - Clear the auth token cache
 func ClearTokenCache() {
 	tokenCache = sync.Map{}
@@ -123,9 +143,10 @@ func tokenAuthFunc(ctx context.Context) (context.Context, error) {
 	digest := sha256.Sum256([]byte(rawToken))
 	token := hex.EncodeToString(digest[:])
 	newCtx := context.WithValue(ctx, Transport, "mtls")
-	if name, ok := tokenCache.Load(token); ok {
+	if cached, ok := tokenCache.Load(token); ok {
 		mtlsLog.Debugf("Token in cache!")
-		newCtx = context.WithValue(newCtx, Operator, name.(string))
+		newCtx = context.WithValue(newCtx, Operator, cached.(cachedOperator).name)
+		newCtx = context.WithValue(newCtx, Standby, cached.(cachedOperator).standby)
 		return newCtx, nil
 	}
 	operator, err := db.OperatorByToken(token)
@@ -134,18 +155,32 @@ func tokenAuthFunc(ctx context.Context) (context.Context, error) {
 		return nil, status.Error(codes.Unauthenticated, "Authentication failure")
 	}
 	mtlsLog.Debugf("Valid user token for %s", operator.Name)
-	tokenCache.Store(token, operator.Name)
+	tokenCache.Store(token, cachedOperator{name: operator.Name, standby: operator.Standby})
 
 	newCtx = context.WithValue(newCtx, Operator, operator.Name)
+	newCtx = context.WithValue(newCtx, Standby, operator.Standby)
 	return newCtx, nil
 }
 
+// ReloadConfig - Apply a reloaded server config to the grpc payload logging options
+func ReloadConfig(config *configs.ServerConfig) {
+	serverConfigMutex.Lock()
+	defer serverConfigMutex.Unlock()
+	serverConfig = config
+}
+
+func logsConfig() *configs.LogConfig {
+	serverConfigMutex.RLock()
+	defer serverConfigMutex.RUnlock()
+	return serverConfig.Logs
+}
+
 func deciderUnary(_ context.Context, _ string, _ interface{}) bool {
-	return serverConfig.Logs.GRPCUnaryPayloads
+	return logsConfig().GRPCUnaryPayloads
 }
 
 func deciderStream(_ context.Context, _ string, _ interface{}) bool {
-	return serverConfig.Logs.GRPCStreamPayloads
+	return logsConfig().GRPCStreamPayloads
 }
 
 // Maps a grpc response code to a logging level
@@ -201,7 +236,12 @@ type auditUnaryLogMsg struct {
 
 func auditLogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
-		rawRequest, err := json.Marshal(req)
+		var rawRequest []byte
+		if msg, ok := req.(proto.Message); ok {
+			rawRequest, err = json.Marshal(core.RedactRequest(msg))
+		} else {
+			rawRequest, err = json.Marshal(req)
+		}
 		if err != nil {
 			middlewareLog.Errorf("Failed to serialize %s", err)
 			return
@@ -218,7 +258,7 @@ func auditLogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		msg := &auditUnaryLogMsg{
 			Request:  string(rawRequest),
 			Method:   info.FullMethod,
-			User:     getUser(p),
+			User:     contextOperator(ctx, getUser(p)),
 			RemoteIP: p.Addr.String(),
 		}
 		if session != nil {
@@ -233,11 +273,68 @@ func auditLogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		msgData, _ := json.Marshal(msg)
 		log.AuditLogger.Info(string(msgData))
 
+		operator := msg.User
+		if targetID := activeTargetID(session, beacon); targetID != "" {
+			core.Presence.Touch(operator, targetID)
+			if err := core.Presence.Check(operator, targetID); err != nil {
+				middlewareLog.Warnf("Refused %s from %s: %s", info.FullMethod, operator, err)
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+		}
+		if err := checkOpsecPolicy(operator, info.FullMethod, rawRequest, session, beacon); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		started := time.Now()
 		resp, err := handler(ctx, req)
+		metrics.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(started))
+		if session != nil {
+			recordings.Console(session.ID, operator, info.FullMethod, rawRequest, resp, err)
+		}
+		if session != nil || beacon != nil {
+			hosts.RecordCommand(info.FullMethod, operator, session, beacon, rawRequest, resp, err)
+		}
+		if exporter.Enabled() && (session != nil || beacon != nil) {
+			exportTask(info.FullMethod, operator, session, beacon, len(rawRequest), resp, err, time.Since(started))
+		}
 		return resp, err
 	}
 }
 
+// exportTask - Send task metadata (never the task or its result) to the SIEM exporters
+func exportTask(method string, operator string, session *clientpb.Session, beacon *clientpb.Beacon, requestSize int, resp interface{}, err error, duration time.Duration) {
+	record := exporter.TaskRecord(method, operator, session, beacon)
+	record.Status = status.Code(err).String()
+	record.DurationMs = duration.Milliseconds()
+	record.RequestSize = requestSize
+	if msg, ok := resp.(proto.Message); ok {
+		record.ResponseSize = proto.Size(msg)
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	exporter.Export(record)
+}
+
+// activeTargetID - The ID of the session or beacon an rpc is tasking
+func activeTargetID(session *clientpb.Session, beacon *clientpb.Beacon) string {
+	if session != nil {
+		return session.ID
+	}
+	if beacon != nil {
+		return beacon.ID
+	}
+	return ""
+}
+
+// contextOperator - Operator name set by the auth interceptor, or the client certificate CN
+func contextOperator(ctx context.Context, user string) string {
+	if operator, ok := ctx.Value(Operator).(string); ok && operator != "" {
+		return operator
+	}
+	return user
+}
+
 func getUser(client *peer.Peer) string {
 	tlsAuth, ok := client.AuthInfo.(credentials.TLSInfo)
 	if !ok {
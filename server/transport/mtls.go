@@ -28,7 +28,6 @@ import (
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/log"
-	"github.com/bishopfox/sliver/server/rpc"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -66,7 +65,7 @@ func StartMtlsClientListener(host string, port uint16) (*grpc.Server, net.Listen
 	}
 	options = append(options, initMiddleware(true)...)
 	grpcServer := grpc.NewServer(options...)
-	rpcpb.RegisterSliverRPCServer(grpcServer, rpc.NewServer())
+	rpcpb.RegisterSliverRPCServer(grpcServer, newRPCServer())
 	go func() {
 		panicked := true
 		defer func() {
@@ -86,9 +85,28 @@ func StartMtlsClientListener(host string, port uint16) (*grpc.Server, net.Listen
 // getOperatorServerTLSConfig - Generate the TLS configuration, we do now allow the end user
 // to specify any TLS paramters, we choose sensible defaults instead
 func getOperatorServerTLSConfig(host string) *tls.Config {
+	tlsConfig, err := loadOperatorServerTLSConfig(host)
+	if err != nil {
+		mtlsLog.Fatalf("Error loading operator tls config: %v", err)
+	}
+	// The CA and server certificate are reloaded for each handshake so that a
+	// rotated operator CA takes effect without restarting the listener
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		config, err := loadOperatorServerTLSConfig(host)
+		if err != nil {
+			mtlsLog.Errorf("Error loading operator tls config: %v", err)
+			return nil, err
+		}
+		config.NextProtos = []string{"h2"}
+		return config, nil
+	}
+	return tlsConfig
+}
+
+func loadOperatorServerTLSConfig(host string) (*tls.Config, error) {
 	caCertPtr, _, err := certs.GetCertificateAuthority(certs.OperatorCA)
 	if err != nil {
-		mtlsLog.Fatalf("Invalid ca type (%s): %v", certs.OperatorCA, host)
+		return nil, fmt.Errorf("invalid ca type (%s): %v", certs.OperatorCA, err)
 	}
 	caCertPool := x509.NewCertPool()
 	caCertPool.AddCert(caCertPtr)
@@ -100,21 +118,21 @@ func getOperatorServerTLSConfig(host string) *tls.Config {
 
 	certPEM, keyPEM, err := certs.OperatorServerGetCertificate(host)
 	if err != nil {
-		mtlsLog.Errorf("Failed to generate or fetch certificate %s", err)
-		return nil
+		return nil, fmt.Errorf("failed to generate or fetch certificate %s", err)
 	}
 	cert, err := tls.X509KeyPair(certPEM, keyPEM)
 	if err != nil {
-		mtlsLog.Fatalf("Error loading server certificate: %v", err)
+		return nil, fmt.Errorf("error loading server certificate: %v", err)
 	}
 
 	tlsConfig := &tls.Config{
-		RootCAs:      caCertPool,
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-		ClientCAs:    caCertPool,
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS13,
+		RootCAs:               caCertPool,
+		ClientAuth:            tls.RequireAndVerifyClientCert,
+		ClientCAs:             caCertPool,
+		Certificates:          []tls.Certificate{cert},
+		VerifyPeerCertificate: certs.VerifyOperatorCertificate,
+		MinVersion:            tls.VersionTLS13,
 	}
 
-	return tlsConfig
+	return tlsConfig, nil
 }
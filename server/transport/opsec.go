@@ -0,0 +1,125 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/hosts"
+	"github.com/bishopfox/sliver/util/opsec"
+)
+
+// OpsecBlockedError - An rpc is blocked by the opsec policy
+type OpsecBlockedError struct {
+	Rule *clientpb.OpsecRule
+}
+
+func (e *OpsecBlockedError) Error() string {
+	msg := fmt.Sprintf("blocked by the '%s' opsec policy", e.Rule.Category)
+	if e.Rule.Description != "" {
+		msg += fmt.Sprintf(" (%s)", e.Rule.Description)
+	}
+	return msg
+}
+
+// checkOpsecPolicy - Returns an error if the rpc is blocked for the operator, operators
+// listed in a rule's overrides are allowed through and the other operators are notified
+func checkOpsecPolicy(operator string, method string, rawRequest []byte, session *clientpb.Session, beacon *clientpb.Beacon) error {
+	rule := opsecRule(path.Base(method), rawRequest, session, beacon)
+	if rule == nil || opsec.Rank(rule.Action) < opsec.Rank(opsec.ActionBlock) {
+		return nil
+	}
+	target := targetName(session, beacon)
+	if !opsec.CanOverride(rule, operator) {
+		publishOpsecEvent(fmt.Sprintf("%s was blocked from running %s on %s by the '%s' opsec policy",
+			operator, path.Base(method), target, rule.Category))
+		return &OpsecBlockedError{Rule: rule}
+	}
+	publishOpsecEvent(fmt.Sprintf("%s overrode the '%s' opsec policy to run %s on %s",
+		operator, rule.Category, path.Base(method), target))
+	return nil
+}
+
+// opsecRule - The strictest rule that applies to an rpc method, requests for a pid are
+// also matched against the executable in the host's most recent process list so rules
+// can match e.g. lsass
+func opsecRule(method string, rawRequest []byte, session *clientpb.Session, beacon *clientpb.Beacon) *clientpb.OpsecRule {
+	rules := opsec.MethodRules(configs.GetOpsecPolicy().Rules, method)
+	if len(rules) == 0 {
+		return nil
+	}
+	subject := string(rawRequest)
+	if executable := requestExecutable(rawRequest, session, beacon); executable != "" {
+		subject += " " + executable
+	}
+	matched := []*clientpb.OpsecRule{}
+	for _, rule := range rules {
+		if opsec.Matches(rule, subject) {
+			matched = append(matched, rule)
+		}
+	}
+	return opsec.Strictest(matched)
+}
+
+func requestExecutable(rawRequest []byte, session *clientpb.Session, beacon *clientpb.Beacon) string {
+	request := struct{ Pid int32 }{}
+	if json.Unmarshal(rawRequest, &request) != nil || request.Pid == 0 {
+		return ""
+	}
+	hostUUID := ""
+	if session != nil {
+		hostUUID = session.UUID
+	} else if beacon != nil {
+		hostUUID = beacon.UUID
+	}
+	processes, err := hosts.Processes(hostUUID)
+	if err != nil {
+		return ""
+	}
+	for _, process := range processes.Processes {
+		if process.Pid == request.Pid {
+			return process.Executable
+		}
+	}
+	return ""
+}
+
+func targetName(session *clientpb.Session, beacon *clientpb.Beacon) string {
+	if session != nil {
+		return session.Name
+	}
+	if beacon != nil {
+		return beacon.Name
+	}
+	return "the server"
+}
+
+func publishOpsecEvent(msg string) {
+	middlewareLog.Warnf("%s", msg)
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.OpsecEvent,
+		Data:      []byte(msg),
+	})
+}
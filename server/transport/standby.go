@@ -0,0 +1,71 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// haSnapshotMethod - Copies the server's state, only standby identities can call it
+const haSnapshotMethod = "/rpcpb.SliverRPC/HASnapshot"
+
+// standbyMethods - The only methods a standby identity can call (see server/ha)
+var standbyMethods = map[string]bool{
+	getVersionMethod: true,
+	haSnapshotMethod: true,
+}
+
+// standbyUnaryServerInterceptor - Keep standby identities and operators apart
+func standbyUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkStandby(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// standbyStreamServerInterceptor - Keep standby identities and operators apart
+func standbyStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkStandby(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// checkStandby - Standby identities can only copy the server's state, and nobody
+// else can, including operators and the server's own console
+func checkStandby(ctx context.Context, method string) error {
+	standby, _ := ctx.Value(Standby).(bool)
+	if standby && !standbyMethods[method] {
+		middlewareLog.Warnf("Refused %s from standby %v", method, ctx.Value(Operator))
+		return status.Error(codes.PermissionDenied, "standby identities can only copy the server's state")
+	}
+	if !standby && method == haSnapshotMethod {
+		middlewareLog.Warnf("Refused %s from %v, not a standby identity", method, ctx.Value(Operator))
+		return status.Error(codes.PermissionDenied, "only standby identities can copy the server's state")
+	}
+	return nil
+}
@@ -28,7 +28,6 @@ import (
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/bishopfox/sliver/server/assets"
 	"github.com/bishopfox/sliver/server/log"
-	"github.com/bishopfox/sliver/server/rpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"tailscale.com/tsnet"
@@ -83,7 +82,7 @@ func StartTsNetClientListener(hostname string, port uint16) (*grpc.Server, net.L
 	}
 	options = append(options, initMiddleware(true)...)
 	grpcServer := grpc.NewServer(options...)
-	rpcpb.RegisterSliverRPCServer(grpcServer, rpc.NewServer())
+	rpcpb.RegisterSliverRPCServer(grpcServer, newRPCServer())
 	go func() {
 		panicked := true
 		defer func() {
@@ -0,0 +1,71 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bishopfox/sliver/client/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// getVersionMethod - Always allowed so any client can find out which version it needs
+const getVersionMethod = "/rpcpb.SliverRPC/GetVersion"
+
+// apiVersionUnaryServerInterceptor - Reject clients older than the oldest supported API version
+func apiVersionUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod != getVersionMethod {
+			if err := checkClientAPIVersion(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// apiVersionStreamServerInterceptor - Reject clients older than the oldest supported API version
+func apiVersionStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkClientAPIVersion(stream.Context()); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// checkClientAPIVersion - Clients that don't send a version predate API versioning (v0)
+func checkClientAPIVersion(ctx context.Context) error {
+	clientVersion := 0
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(version.APIVersionKey); 0 < len(values) {
+			clientVersion, _ = strconv.Atoi(values[0])
+		}
+	}
+	err := version.CheckAPIVersion("client", int32(clientVersion), 0)
+	if err != nil {
+		middlewareLog.Warnf("Rejected client: %s", err)
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
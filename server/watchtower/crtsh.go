@@ -0,0 +1,113 @@
+package watchtower
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const crtShURL = "https://crt.sh/"
+
+// crtSh - Searches certificate transparency logs for certificates issued for our domains
+type crtSh struct {
+	baseURL string
+	client  *http.Client
+}
+
+func newCrtSh() *crtSh {
+	return &crtSh{
+		baseURL: crtShURL,
+		client:  &http.Client{Timeout: providerTimeout},
+	}
+}
+
+func (c *crtSh) Name() string {
+	return "crtsh"
+}
+
+func (c *crtSh) DisplayName() string {
+	return "crt.sh"
+}
+
+func (c *crtSh) Supports(kind string) bool {
+	return kind == TargetDomain
+}
+
+// DefaultRateLimit - crt.sh has no published limit, but is slow and easily overloaded
+func (c *crtSh) DefaultRateLimit() int {
+	return 5
+}
+
+type crtShCertificate struct {
+	ID         int64  `json:"id"`
+	IssuerName string `json:"issuer_name"`
+	CommonName string `json:"common_name"`
+	NotBefore  string `json:"not_before"`
+}
+
+// Baseline - The certificates already logged when a domain is first looked up are ours
+func (c *crtSh) Baseline() bool {
+	return true
+}
+
+// Lookup - Every logged certificate is a finding, so operators are alerted to new
+// certificates for a domain (including ones issued by someone else)
+func (c *crtSh) Lookup(target *Target) ([]*Finding, error) {
+	query := url.Values{"q": {target.Value}, "output": {"json"}, "exclude": {"expired"}}
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	data, err := getJSON(c.client, req)
+	if err == errNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	certificates := []*crtShCertificate{}
+	err = json.Unmarshal(data, &certificates)
+	if err != nil {
+		return nil, err
+	}
+	findings := []*Finding{}
+	for _, certificate := range certificates {
+		findings = append(findings, &Finding{
+			Key: fmt.Sprintf("%d", certificate.ID),
+			Detail: fmt.Sprintf("certificate for %s issued by %s on %s", certificate.CommonName,
+				issuerOrganization(certificate.IssuerName), strings.Split(certificate.NotBefore, "T")[0]),
+		})
+	}
+	return findings, nil
+}
+
+// issuerOrganization - The O= of an issuer DN, or the whole DN
+func issuerOrganization(issuer string) string {
+	for _, part := range strings.Split(issuer, ",") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "O=") {
+			return strings.Trim(strings.TrimPrefix(part, "O="), `"`)
+		}
+	}
+	return issuer
+}
@@ -0,0 +1,333 @@
+package watchtower
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultScanInterval = 6 * time.Hour
+	minScanInterval     = 5 * time.Minute
+	resolveTimeout      = 5 * time.Second
+)
+
+// monitor - Periodically looks up every target a provider supports, each provider has
+// its own rate limit so a slow provider does not hold up the others
+type monitor struct {
+	provider Provider
+	limiter  *rate.Limiter
+	config   *configs.WatchTowerConfig
+
+	// seen - Keys of the findings already alerted (or part of a baseline) and of the
+	// targets looked up, saved to seenPath so restarts don't alert them again
+	seen     map[string]bool
+	seenPath string
+	dirty    bool
+}
+
+var (
+	monitorCancel context.CancelFunc
+	monitorGroup  sync.WaitGroup
+)
+
+// configuredProviders - Providers with credentials in the watchtower config
+func configuredProviders(config *configs.WatchTowerConfig) []Provider {
+	providers := []Provider{}
+	if config.VTApiKey != "" {
+		providers = append(providers, newVirusTotal(config.VTApiKey))
+	}
+	if config.CrtSh {
+		providers = append(providers, newCrtSh())
+	}
+	if config.ShodanApiKey != "" {
+		providers = append(providers, newShodan(config.ShodanApiKey))
+	}
+	if config.CensysApiID != "" && config.CensysApiSecret != "" {
+		providers = append(providers, newCensys(config.CensysApiID, config.CensysApiSecret))
+	}
+	return providers
+}
+
+func newMonitor(provider Provider, config *configs.WatchTowerConfig) *monitor {
+	perMinute := provider.DefaultRateLimit()
+	if limit, ok := config.RateLimits[provider.Name()]; ok && 0 < limit {
+		perMinute = limit
+	}
+	return &monitor{
+		provider: provider,
+		limiter:  rate.NewLimiter(rate.Every(time.Minute/time.Duration(perMinute)), 1),
+		config:   config,
+		seen:     map[string]bool{},
+	}
+}
+
+// seenPath - File the seen findings of a provider are saved to
+func seenPath(provider Provider) string {
+	dir := filepath.Join(assets.GetRootAppDir(), "watchtower")
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		os.MkdirAll(dir, 0700)
+	}
+	return filepath.Join(dir, provider.Name()+".json")
+}
+
+func (m *monitor) loadSeen() {
+	data, err := os.ReadFile(m.seenPath)
+	if err != nil {
+		return
+	}
+	keys := []string{}
+	if err := json.Unmarshal(data, &keys); err != nil {
+		watchtowerLog.Warnf("Invalid %s: %s", m.seenPath, err)
+		return
+	}
+	for _, key := range keys {
+		m.seen[key] = true
+	}
+}
+
+func (m *monitor) saveSeen() {
+	if !m.dirty {
+		return
+	}
+	data, _ := json.Marshal(sortedKeys(m.seen))
+	if err := os.WriteFile(m.seenPath, data, 0600); err != nil {
+		watchtowerLog.Warnf("Failed to save %s: %s", m.seenPath, err)
+		return
+	}
+	m.dirty = false
+}
+
+func startMonitors(config *configs.WatchTowerConfig, providers []Provider) {
+	interval := defaultScanInterval
+	if config.ScanInterval != "" {
+		parsed, err := time.ParseDuration(config.ScanInterval)
+		if err != nil || parsed < minScanInterval {
+			watchtowerLog.Warnf("Invalid scan interval '%s', using %s", config.ScanInterval, defaultScanInterval)
+		} else {
+			interval = parsed
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	monitorCancel = cancel
+	for _, provider := range providers {
+		watchtowerLog.Infof("Monitoring with %s every %s", provider.DisplayName(), interval)
+		m := newMonitor(provider, config)
+		m.seenPath = seenPath(provider)
+		m.loadSeen()
+		monitorGroup.Add(1)
+		go m.run(ctx, interval)
+	}
+}
+
+func stopMonitors() {
+	if monitorCancel != nil {
+		monitorCancel()
+		monitorGroup.Wait()
+	}
+	monitorCancel = nil
+}
+
+func (m *monitor) run(ctx context.Context, interval time.Duration) {
+	defer monitorGroup.Done()
+	for {
+		m.scan(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (m *monitor) scan(ctx context.Context) {
+	for _, target := range Targets(ctx, m.config) {
+		if !m.provider.Supports(target.Kind) {
+			continue
+		}
+		if m.limiter.Wait(ctx) != nil {
+			return
+		}
+		findings, err := m.provider.Lookup(target)
+		if err == errRateLimited {
+			watchtowerLog.Warnf("%s rate limit exceeded, consider lowering its rate limit", m.provider.DisplayName())
+			continue
+		}
+		if err != nil {
+			watchtowerLog.Warnf("%s lookup of %s %s failed: %s", m.provider.DisplayName(), target.Kind, target.Value, err)
+			continue
+		}
+		unseen := m.unseen(target, findings)
+		m.saveSeen()
+		if 0 < len(unseen) {
+			alert(m.provider, target, unseen)
+		}
+	}
+}
+
+// unseen - Findings that have not been alerted yet, none on the first lookup of a
+// target if the provider's first findings are a baseline
+func (m *monitor) unseen(target *Target, findings []*Finding) []*Finding {
+	targetKey := strings.Join([]string{target.Kind, target.Value}, "|")
+	baseline := false
+	if provider, ok := m.provider.(baselineProvider); ok && provider.Baseline() {
+		baseline = !m.seen[targetKey]
+	}
+	if !m.seen[targetKey] {
+		m.seen[targetKey] = true
+		m.dirty = true
+	}
+	unseen := []*Finding{}
+	for _, finding := range findings {
+		key := strings.Join([]string{target.Kind, target.Value, finding.Key}, "|")
+		if m.seen[key] {
+			continue
+		}
+		m.seen[key] = true
+		m.dirty = true
+		if !baseline {
+			unseen = append(unseen, finding)
+		}
+	}
+	return unseen
+}
+
+// alert - Burn an implant build whose hash was found, other findings are published
+// as watchtower events so they reach operators and any exporters/webhooks
+func alert(provider Provider, target *Target, findings []*Finding) {
+	for _, finding := range findings {
+		watchtowerLog.Warnf("%s %s %s: %s", provider.DisplayName(), target.Kind, target.Value, finding.Detail)
+	}
+	detail := findings[0].Detail
+	if 1 < len(findings) {
+		detail = fmt.Sprintf("%s (and %d more)", detail, len(findings)-1)
+	}
+	if target.Kind == TargetHash {
+		burnedImplant(target.BuildName, fmt.Sprintf("%s - %s", provider.DisplayName(), detail))
+		return
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.WatchtowerEvent,
+		Data:      []byte(fmt.Sprintf("%s %s seen on %s - %s", target.Kind, target.Value, provider.DisplayName(), detail)),
+	})
+}
+
+// Targets - Hashes of implant builds that are not yet burned, and the public domains
+// and addresses of implant C2s, listeners, and the watchtower config
+func Targets(ctx context.Context, config *configs.WatchTowerConfig) []*Target {
+	targets := []*Target{}
+	domains := map[string]bool{}
+	addresses := map[string]bool{}
+	addHost := func(host string) {
+		host = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(host)), "*.")
+		if host == "" {
+			return
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			if isPublic(ip) {
+				addresses[ip.String()] = true
+			}
+			return
+		}
+		domains[strings.TrimSuffix(host, ".")] = true
+	}
+
+	builds, err := db.ImplantBuilds()
+	if err != nil {
+		watchtowerLog.Errorf("Failed to load implant builds: %s", err)
+	}
+	for _, build := range builds {
+		if !build.Burned {
+			hash := build.SHA256
+			if hash == "" {
+				hash = build.MD5
+			}
+			if hash != "" {
+				targets = append(targets, &Target{Kind: TargetHash, Value: hash, BuildName: build.Name})
+			}
+		}
+		for _, c2 := range build.ImplantConfig.C2 {
+			c2URL, err := url.Parse(c2.URL)
+			if err != nil {
+				continue
+			}
+			switch c2URL.Scheme {
+			case "mtls", "wg", "http", "https", "dns":
+				addHost(c2URL.Hostname())
+			}
+		}
+	}
+	for _, job := range core.Jobs.All() {
+		for _, domain := range job.Domains {
+			addHost(domain)
+		}
+	}
+	for _, host := range append(config.Domains, config.Addresses...) {
+		addHost(host)
+	}
+
+	for _, domain := range sortedKeys(domains) {
+		targets = append(targets, &Target{Kind: TargetDomain, Value: domain})
+		resolveCtx, cancel := context.WithTimeout(ctx, resolveTimeout)
+		ips, err := net.DefaultResolver.LookupIPAddr(resolveCtx, domain)
+		cancel()
+		if err != nil {
+			continue
+		}
+		for _, ip := range ips {
+			if isPublic(ip.IP) {
+				addresses[ip.IP.String()] = true
+			}
+		}
+	}
+	for _, address := range sortedKeys(addresses) {
+		targets = append(targets, &Target{Kind: TargetAddress, Value: address})
+	}
+	return targets
+}
+
+func isPublic(ip net.IP) bool {
+	return !(ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsMulticast())
+}
+
+func sortedKeys(values map[string]bool) []string {
+	keys := []string{}
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,105 @@
+package watchtower
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// TargetHash - An implant build's SHA256 (or MD5) hash
+	TargetHash = "hash"
+	// TargetDomain - A C2 or listener domain
+	TargetDomain = "domain"
+	// TargetAddress - A public C2 or listener IP address
+	TargetAddress = "address"
+
+	providerTimeout = 30 * time.Second
+)
+
+var (
+	// errNotFound - The provider has no record of the target
+	errNotFound = errors.New("not found")
+	// errRateLimited - The provider rejected a request because of its rate limit
+	errRateLimited = errors.New("rate limited")
+)
+
+// Target - Something of ours a provider can look up
+type Target struct {
+	Kind  string
+	Value string
+
+	// BuildName - Implant build a hash belongs to
+	BuildName string
+}
+
+// Finding - A provider has seen a target, Key identifies the finding so it is only
+// alerted once (e.g. a certificate ID), Detail is shown to operators
+type Finding struct {
+	Key    string
+	Detail string
+}
+
+// Provider - A threat intel, scanning, or certificate transparency service
+type Provider interface {
+	// Name - Configuration name of the provider, used for rate limits
+	Name() string
+	// DisplayName - Name of the provider shown to operators
+	DisplayName() string
+	// Supports - Check if the provider can look up a target kind
+	Supports(kind string) bool
+	// DefaultRateLimit - Requests per minute allowed by the provider's free tier
+	DefaultRateLimit() int
+	// Lookup - Returns nothing if the provider has not seen the target
+	Lookup(target *Target) ([]*Finding, error)
+}
+
+// baselineProvider - A provider whose findings are expected for any target (e.g. the
+// certificates of our own domains), the findings of the first lookup of a target are
+// only recorded as a baseline and the ones found later are alerted
+type baselineProvider interface {
+	Baseline() bool
+}
+
+// getJSON - GET a provider API, not found responses return errNotFound
+func getJSON(client *http.Client, req *http.Request) ([]byte, error) {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 16*1024*1024))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, errRateLimited
+	case resp.StatusCode < 200 || 300 <= resp.StatusCode:
+		return nil, fmt.Errorf("%s returned %s", req.URL.Host, resp.Status)
+	}
+	return data, nil
+}
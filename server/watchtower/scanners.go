@@ -0,0 +1,214 @@
+package watchtower
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	shodanURL = "https://api.shodan.io"
+	censysURL = "https://search.censys.io/api/v2"
+)
+
+// c2Labels - Tags scanners use for hosts they identified as command and control servers
+var c2Labels = []string{"c2", "malware"}
+
+// isC2Label - Check if a scanner tag, product, or label identifies a C2 server
+func isC2Label(label string) bool {
+	label = strings.ToLower(label)
+	for _, c2Label := range c2Labels {
+		if label == c2Label {
+			return true
+		}
+	}
+	return strings.Contains(label, "sliver")
+}
+
+// shodan - Checks if Shodan has fingerprinted one of our addresses as a C2 server
+type shodan struct {
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+func newShodan(apiKey string) *shodan {
+	return &shodan{
+		apiKey:  apiKey,
+		baseURL: shodanURL,
+		client:  &http.Client{Timeout: providerTimeout},
+	}
+}
+
+func (s *shodan) Name() string {
+	return "shodan"
+}
+
+func (s *shodan) DisplayName() string {
+	return "Shodan"
+}
+
+func (s *shodan) Supports(kind string) bool {
+	return kind == TargetAddress
+}
+
+// DefaultRateLimit - Shodan allows one request a second
+func (s *shodan) DefaultRateLimit() int {
+	return 60
+}
+
+type shodanHost struct {
+	Tags []string `json:"tags"`
+	Data []struct {
+		Port    int      `json:"port"`
+		Product string   `json:"product"`
+		Tags    []string `json:"tags"`
+	} `json:"data"`
+}
+
+func (s *shodan) Lookup(target *Target) ([]*Finding, error) {
+	query := url.Values{"key": {s.apiKey}, "minify": {"false"}}
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/shodan/host/%s?%s", s.baseURL, url.PathEscape(target.Value), query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	data, err := getJSON(s.client, req)
+	if err == errNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	host := &shodanHost{}
+	err = json.Unmarshal(data, host)
+	if err != nil {
+		return nil, err
+	}
+	findings := []*Finding{}
+	for _, tag := range host.Tags {
+		if isC2Label(tag) {
+			findings = append(findings, &Finding{Key: "tag:" + tag, Detail: fmt.Sprintf("host is tagged '%s'", tag)})
+		}
+	}
+	for _, service := range host.Data {
+		labels := append([]string{service.Product}, service.Tags...)
+		for _, label := range labels {
+			if isC2Label(label) {
+				findings = append(findings, &Finding{
+					Key:    fmt.Sprintf("%d:%s", service.Port, label),
+					Detail: fmt.Sprintf("port %d is identified as '%s'", service.Port, label),
+				})
+			}
+		}
+	}
+	return findings, nil
+}
+
+// censys - Checks if Censys has fingerprinted one of our addresses as a C2 server
+type censys struct {
+	apiID     string
+	apiSecret string
+	baseURL   string
+	client    *http.Client
+}
+
+func newCensys(apiID string, apiSecret string) *censys {
+	return &censys{
+		apiID:     apiID,
+		apiSecret: apiSecret,
+		baseURL:   censysURL,
+		client:    &http.Client{Timeout: providerTimeout},
+	}
+}
+
+func (c *censys) Name() string {
+	return "censys"
+}
+
+func (c *censys) DisplayName() string {
+	return "Censys"
+}
+
+func (c *censys) Supports(kind string) bool {
+	return kind == TargetAddress
+}
+
+// DefaultRateLimit - Free accounts are limited to 0.4 requests a second
+func (c *censys) DefaultRateLimit() int {
+	return 24
+}
+
+type censysHost struct {
+	Result struct {
+		Labels   []string `json:"labels"`
+		Services []struct {
+			Port        int      `json:"port"`
+			ServiceName string   `json:"service_name"`
+			Labels      []string `json:"labels"`
+			Software    []struct {
+				Product string `json:"product"`
+			} `json:"software"`
+		} `json:"services"`
+	} `json:"result"`
+}
+
+func (c *censys) Lookup(target *Target) ([]*Finding, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+"/hosts/"+url.PathEscape(target.Value), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.apiID, c.apiSecret)
+	data, err := getJSON(c.client, req)
+	if err == errNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	host := &censysHost{}
+	err = json.Unmarshal(data, host)
+	if err != nil {
+		return nil, err
+	}
+	findings := []*Finding{}
+	for _, label := range host.Result.Labels {
+		if isC2Label(label) {
+			findings = append(findings, &Finding{Key: "label:" + label, Detail: fmt.Sprintf("host is labeled '%s'", label)})
+		}
+	}
+	for _, service := range host.Result.Services {
+		labels := service.Labels
+		for _, software := range service.Software {
+			labels = append(labels, software.Product)
+		}
+		for _, label := range labels {
+			if isC2Label(label) {
+				findings = append(findings, &Finding{
+					Key:    fmt.Sprintf("%d:%s", service.Port, label),
+					Detail: fmt.Sprintf("port %d (%s) is identified as '%s'", service.Port, service.ServiceName, label),
+				})
+			}
+		}
+	}
+	return findings, nil
+}
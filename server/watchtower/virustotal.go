@@ -0,0 +1,122 @@
+package watchtower
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const virusTotalURL = "https://www.virustotal.com/api/v3"
+
+// virusTotal - Looks up implant hashes, and the reputation of our domains and addresses
+type virusTotal struct {
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+func newVirusTotal(apiKey string) *virusTotal {
+	return &virusTotal{
+		apiKey:  apiKey,
+		baseURL: virusTotalURL,
+		client:  &http.Client{Timeout: providerTimeout},
+	}
+}
+
+func (v *virusTotal) Name() string {
+	return "virustotal"
+}
+
+func (v *virusTotal) DisplayName() string {
+	return "VirusTotal"
+}
+
+func (v *virusTotal) Supports(kind string) bool {
+	return kind == TargetHash || kind == TargetDomain || kind == TargetAddress
+}
+
+// DefaultRateLimit - The public API allows 4 requests a minute
+func (v *virusTotal) DefaultRateLimit() int {
+	return 4
+}
+
+type virusTotalObject struct {
+	Data struct {
+		Attributes struct {
+			LastAnalysisStats struct {
+				Malicious  int `json:"malicious"`
+				Suspicious int `json:"suspicious"`
+				Undetected int `json:"undetected"`
+				Harmless   int `json:"harmless"`
+			} `json:"last_analysis_stats"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// Lookup - Any file report means the implant has been uploaded, domains and
+// addresses are only reported once an engine flags them
+func (v *virusTotal) Lookup(target *Target) ([]*Finding, error) {
+	var path string
+	switch target.Kind {
+	case TargetHash:
+		path = "/files/" + url.PathEscape(target.Value)
+	case TargetDomain:
+		path = "/domains/" + url.PathEscape(target.Value)
+	case TargetAddress:
+		path = "/ip_addresses/" + url.PathEscape(target.Value)
+	default:
+		return nil, nil
+	}
+	req, err := http.NewRequest(http.MethodGet, v.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-apikey", v.apiKey)
+	data, err := getJSON(v.client, req)
+	if err == errNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	object := &virusTotalObject{}
+	err = json.Unmarshal(data, object)
+	if err != nil {
+		return nil, err
+	}
+	stats := object.Data.Attributes.LastAnalysisStats
+	flagged := stats.Malicious + stats.Suspicious
+	total := flagged + stats.Undetected + stats.Harmless
+	if target.Kind == TargetHash {
+		return []*Finding{{
+			Key:    "file",
+			Detail: fmt.Sprintf("file report exists, %d/%d engines flag it", flagged, total),
+		}}, nil
+	}
+	if flagged == 0 {
+		return nil, nil
+	}
+	return []*Finding{{
+		Key:    "flagged",
+		Detail: fmt.Sprintf("%d/%d engines flag it as malicious or suspicious", flagged, total),
+	}}, nil
+}
@@ -28,21 +28,35 @@ func update(implantBuild *models.ImplantBuild) {
 }
 
 func handleBurnedImplant(result *snitch.ScanResult) {
-	build, err := db.ImplantBuildByName(result.Sample.Name())
+	burnedImplant(result.Sample.Name(), fmt.Sprintf("%s - %v", result.Provider, result.LastSeen))
+}
+
+// burnedImplant - Mark an implant build as burned and alert the operators of its
+// sessions, or all operators if it has no sessions
+func burnedImplant(name string, seenOn string) {
+	build, err := db.ImplantBuildByName(name)
 	if build != nil && err == nil {
 		build.Burned = true
 		db.Session().Save(build)
 	}
+	alerted := false
 	for _, session := range core.Sessions.All() {
 		// Won't work for sessions that have been renamed
-		if session.Name == result.Sample.Name() {
+		if session.Name == name {
 			core.EventBroker.Publish(core.Event{
 				Session:   session,
 				EventType: consts.WatchtowerEvent,
-				Data:      []byte(fmt.Sprintf("%s - %v", result.Provider, result.LastSeen)),
+				Data:      []byte(seenOn),
 			})
+			alerted = true
 		}
 	}
+	if !alerted {
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.WatchtowerEvent,
+			Data:      []byte(fmt.Sprintf("implant %s has been burned (seen on %s)", name, seenOn)),
+		})
+	}
 }
 
 func addExistingImplants() error {
@@ -60,21 +74,24 @@ func addExistingImplants() error {
 
 func StartWatchTower(config *configs.ServerConfig) error {
 	var scanners []snitch.Scanner
-	if watcher != nil {
+	if initialized {
 		return errors.New("monitoring already started")
 	}
 	if config.Watchtower == nil {
 		return errors.New("no provider info")
 	}
-	if config.Watchtower.VTApiKey != "" {
-		scanners = append(scanners, snitch.NewVTScanner(config.Watchtower.VTApiKey, snitch.VTMaxRequests, "Virus Total"))
-	}
 	if config.Watchtower.XForceApiKey != "" && config.Watchtower.XForceApiPassword != "" {
 		scanners = append(scanners, snitch.NewXForceScanner(config.Watchtower.XForceApiKey, config.Watchtower.XForceApiPassword, snitch.XForceMaxRequests, "IBM X-Force"))
 	}
-	if len(scanners) == 0 {
+	providers := configuredProviders(config.Watchtower)
+	if len(scanners) == 0 && len(providers) == 0 {
 		return errors.New("missing provider credentials")
 	}
+	initialized = true
+	startMonitors(config.Watchtower, providers)
+	if len(scanners) == 0 {
+		return nil
+	}
 	watcher = snitch.WithHandleFlagged(handleBurnedImplant)
 	// Add providers
 	for _, s := range scanners {
@@ -82,7 +99,6 @@ func StartWatchTower(config *configs.ServerConfig) error {
 	}
 	// Start the loop
 	watcher.Start()
-	initialized = true
 	err := addExistingImplants()
 	if err != nil {
 		return err
@@ -95,6 +111,7 @@ func AddImplantToWatchlist(implant *models.ImplantBuild) {
 }
 
 func StopWatchTower() {
+	stopMonitors()
 	if watcher != nil {
 		watcher.Stop()
 	}
@@ -0,0 +1,162 @@
+package watchtower
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+func TestVirusTotal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("x-apikey") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/files/burned":
+			w.Write([]byte(`{"data":{"attributes":{"last_analysis_stats":{"malicious":3,"undetected":60}}}}`))
+		case "/domains/clean.example.com":
+			w.Write([]byte(`{"data":{"attributes":{"last_analysis_stats":{"harmless":70}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	vt := newVirusTotal("key")
+	vt.baseURL = server.URL
+
+	findings, err := vt.Lookup(&Target{Kind: TargetHash, Value: "burned"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || !strings.Contains(findings[0].Detail, "3/63") {
+		t.Fatalf("Unexpected findings %v", findings)
+	}
+	findings, err = vt.Lookup(&Target{Kind: TargetHash, Value: "unknown"})
+	if err != nil || len(findings) != 0 {
+		t.Fatalf("Expected no findings for an unknown hash, got %v (%v)", findings, err)
+	}
+	findings, err = vt.Lookup(&Target{Kind: TargetDomain, Value: "clean.example.com"})
+	if err != nil || len(findings) != 0 {
+		t.Fatalf("Expected no findings for a clean domain, got %v (%v)", findings, err)
+	}
+}
+
+func TestCrtSh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("q") != "example.com" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"id":1,"issuer_name":"C=US, O=Let's Encrypt, CN=R3","common_name":"example.com","not_before":"2023-09-01T00:00:00"},
+			{"id":2,"issuer_name":"CN=Other","common_name":"www.example.com","not_before":"2023-09-02T00:00:00"}]`))
+	}))
+	defer server.Close()
+	crt := newCrtSh()
+	crt.baseURL = server.URL + "/"
+
+	target := &Target{Kind: TargetDomain, Value: "example.com"}
+	findings, err := crt.Lookup(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 2 || !strings.Contains(findings[0].Detail, "Let's Encrypt") || !strings.Contains(findings[1].Detail, "CN=Other") {
+		t.Fatalf("Unexpected findings %v", findings)
+	}
+
+	// The certificates of the first lookup are a baseline, later ones are only alerted once
+	m := newMonitor(crt, &configs.WatchTowerConfig{})
+	m.seenPath = filepath.Join(t.TempDir(), "crtsh.json")
+	if len(m.unseen(target, findings)) != 0 {
+		t.Fatal("Expected the first findings to be a baseline")
+	}
+	findings = append(findings, &Finding{Key: "3", Detail: "certificate for mail.example.com"})
+	if len(m.unseen(target, findings)) != 1 || len(m.unseen(target, findings)) != 0 {
+		t.Fatal("Expected new findings to only be unseen once")
+	}
+
+	// Seen findings are kept across restarts
+	m.saveSeen()
+	restarted := newMonitor(crt, &configs.WatchTowerConfig{})
+	restarted.seenPath = m.seenPath
+	restarted.loadSeen()
+	findings = append(findings, &Finding{Key: "4", Detail: "certificate for vpn.example.com"})
+	if unseen := restarted.unseen(target, findings); len(unseen) != 1 || unseen[0].Key != "4" {
+		t.Fatalf("Unexpected findings after a restart %v", unseen)
+	}
+}
+
+func TestShodanAndCensys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/shodan/host/203.0.113.10":
+			w.Write([]byte(`{"tags":["cloud"],"data":[{"port":443,"product":"nginx"},{"port":8888,"product":"Sliver C2"}]}`))
+		case "/hosts/203.0.113.10":
+			if _, secret, _ := req.BasicAuth(); secret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"result":{"labels":["c2"],"services":[{"port":443,"service_name":"HTTP","software":[{"product":"nginx"}]}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	target := &Target{Kind: TargetAddress, Value: "203.0.113.10"}
+
+	sh := newShodan("key")
+	sh.baseURL = server.URL
+	findings, err := sh.Lookup(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].Key != "8888:Sliver C2" {
+		t.Fatalf("Unexpected shodan findings %v", findings)
+	}
+
+	cs := newCensys("id", "secret")
+	cs.baseURL = server.URL
+	findings, err = cs.Lookup(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].Key != "label:c2" {
+		t.Fatalf("Unexpected censys findings %v", findings)
+	}
+	findings, err = cs.Lookup(&Target{Kind: TargetAddress, Value: "198.51.100.1"})
+	if err != nil || len(findings) != 0 {
+		t.Fatalf("Expected no findings for an unknown host, got %v (%v)", findings, err)
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	config := &configs.WatchTowerConfig{RateLimits: map[string]int{"shodan": 120}}
+	if limit := newMonitor(newShodan("key"), config).limiter.Limit(); limit != 2 {
+		t.Fatalf("Expected configured rate limit of 2/s, got %v", limit)
+	}
+	if limit := newMonitor(newVirusTotal("key"), config).limiter.Limit(); 0.07 < limit {
+		t.Fatalf("Expected default VirusTotal rate limit of 4/min, got %v", limit)
+	}
+}
@@ -0,0 +1,76 @@
+package encoders
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"testing"
+)
+
+// fuzzEncoder - Encoded data has to decode to the original, and decoding whatever
+// is sent to a c2 listener must not panic
+func fuzzEncoder(f *testing.F, encoder Encoder) {
+	f.Add([]byte{})
+	f.Add([]byte("hello world"))
+	f.Add([]byte{0, 0, 1, 0xff})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		encoder.Decode(data)
+
+		encoded, err := encoder.Encode(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := encoder.Decode(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, decoded) {
+			t.Fatalf("decoded data does not match\n%#v != %#v", data, decoded)
+		}
+	})
+}
+
+func FuzzBase32(f *testing.F) {
+	fuzzEncoder(f, Base32{})
+}
+
+func FuzzBase58(f *testing.F) {
+	fuzzEncoder(f, Base58{})
+}
+
+func FuzzBase64(f *testing.F) {
+	fuzzEncoder(f, Base64{})
+}
+
+func FuzzEnglish(f *testing.F) {
+	SetEnglishDictionary(getTestEnglishDictionary())
+	fuzzEncoder(f, English{})
+}
+
+func FuzzGzip(f *testing.F) {
+	fuzzEncoder(f, Gzip{})
+}
+
+func FuzzHex(f *testing.F) {
+	fuzzEncoder(f, Hex{})
+}
+
+func FuzzPNG(f *testing.F) {
+	fuzzEncoder(f, PNGEncoder{})
+}
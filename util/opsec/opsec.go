@@ -0,0 +1,133 @@
+package opsec
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// Actions of a rule, from least to most restrictive
+const (
+	ActionAllow  = "allow"
+	ActionWarn   = "warn"
+	ActionPrompt = "prompt"
+	ActionBlock  = "block"
+)
+
+var actionRanks = map[string]int{
+	ActionAllow:  0,
+	ActionWarn:   1,
+	ActionPrompt: 2,
+	ActionBlock:  3,
+}
+
+// Rank - How restrictive an action is
+func Rank(action string) int {
+	return actionRanks[strings.ToLower(action)]
+}
+
+// Validate - Check the actions and match expressions of a policy's rules
+func Validate(policy *clientpb.OpsecPolicy) error {
+	for index, rule := range policy.Rules {
+		if rule.Category == "" {
+			return fmt.Errorf("rule %d has no category", index+1)
+		}
+		if _, ok := actionRanks[strings.ToLower(rule.Action)]; !ok {
+			return fmt.Errorf("rule '%s' has an invalid action '%s' (allow, warn, prompt, block)", rule.Category, rule.Action)
+		}
+		if rule.Match != "" {
+			if _, err := regexp.Compile(rule.Match); err != nil {
+				return fmt.Errorf("rule '%s' has an invalid match expression: %s", rule.Category, err)
+			}
+		}
+	}
+	return nil
+}
+
+// CommandRules - Rules that apply to a console command (e.g. "registry write"), line is
+// the full command line and is checked against the rules' match expressions
+func CommandRules(rules []*clientpb.OpsecRule, command string, line string) []*clientpb.OpsecRule {
+	topLevel := strings.SplitN(command, " ", 2)[0]
+	matched := []*clientpb.OpsecRule{}
+	for _, rule := range rules {
+		for _, ruleCommand := range rule.Commands {
+			if (ruleCommand == command || ruleCommand == topLevel) && Matches(rule, line) {
+				matched = append(matched, rule)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// MethodRules - Rules that apply to an rpc method (e.g. "ProcessDump"), the rules' match
+// expressions are not checked
+func MethodRules(rules []*clientpb.OpsecRule, method string) []*clientpb.OpsecRule {
+	matched := []*clientpb.OpsecRule{}
+	for _, rule := range rules {
+		for _, ruleMethod := range rule.Methods {
+			if ruleMethod == method {
+				matched = append(matched, rule)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// Matches - Whether a rule's match expression matches, rules without one always match.
+// Invalid expressions match so a typo doesn't disable a rule.
+func Matches(rule *clientpb.OpsecRule, subject string) bool {
+	if rule.Match == "" {
+		return true
+	}
+	expr, err := regexp.Compile(rule.Match)
+	if err != nil {
+		return true
+	}
+	return expr.MatchString(subject)
+}
+
+// Strictest - The most restrictive of the rules, nil if none of them do more than allow
+func Strictest(rules []*clientpb.OpsecRule) *clientpb.OpsecRule {
+	var strictest *clientpb.OpsecRule
+	for _, rule := range rules {
+		if Rank(rule.Action) == 0 {
+			continue
+		}
+		if strictest == nil || Rank(strictest.Action) < Rank(rule.Action) {
+			strictest = rule
+		}
+	}
+	return strictest
+}
+
+// CanOverride - Whether the operator is allowed to override a blocking rule
+func CanOverride(rule *clientpb.OpsecRule, operator string) bool {
+	for _, name := range rule.Overrides {
+		if name == operator || name == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package opsec
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+var testRules = []*clientpb.OpsecRule{
+	{Category: "disk-write", Commands: []string{"upload", "registry write"}, Methods: []string{"Upload"}, Action: ActionWarn},
+	{Category: "lsass-access", Commands: []string{"procdump"}, Methods: []string{"ProcessDump"}, Match: "(?i)lsass", Action: ActionBlock, Overrides: []string{"alice"}},
+	{Category: "credential-access", Commands: []string{"procdump"}, Action: ActionPrompt},
+}
+
+func TestCommandRules(t *testing.T) {
+	rules := CommandRules(testRules, "procdump", "procdump --name LSASS.exe")
+	if len(rules) != 2 || Strictest(rules).Category != "lsass-access" {
+		t.Fatalf("Unexpected rules %v", rules)
+	}
+	rules = CommandRules(testRules, "procdump", "procdump --pid 1234")
+	if len(rules) != 1 || Strictest(rules).Action != ActionPrompt {
+		t.Fatalf("Expected the match expression to exclude the lsass rule, got %v", rules)
+	}
+	if len(CommandRules(testRules, "registry write", "registry write HKLM\\Foo")) != 1 {
+		t.Fatal("Expected subcommand to match")
+	}
+	if len(CommandRules(testRules, "registry read", "registry read HKLM\\Foo")) != 0 {
+		t.Fatal("Expected other subcommands not to match")
+	}
+	if Strictest(CommandRules(testRules, "ls", "ls")) != nil {
+		t.Fatal("Expected no rule for ls")
+	}
+}
+
+func TestMethodRules(t *testing.T) {
+	rules := MethodRules(testRules, "ProcessDump")
+	if len(rules) != 1 || !Matches(rules[0], `{"Pid":624} lsass.exe`) || Matches(rules[0], `{"Pid":4}`) {
+		t.Fatalf("Unexpected rules %v", rules)
+	}
+	if !CanOverride(rules[0], "alice") || CanOverride(rules[0], "bob") {
+		t.Fatal("Expected only alice to be able to override")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(&clientpb.OpsecPolicy{Rules: testRules}); err != nil {
+		t.Fatal(err)
+	}
+	if Validate(&clientpb.OpsecPolicy{Rules: []*clientpb.OpsecRule{{Category: "x", Action: "deny"}}}) == nil {
+		t.Fatal("Expected invalid action to fail validation")
+	}
+	if Validate(&clientpb.OpsecPolicy{Rules: []*clientpb.OpsecRule{{Category: "x", Action: "warn", Match: "("}}}) == nil {
+		t.Fatal("Expected invalid match expression to fail validation")
+	}
+}